@@ -0,0 +1,9336 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/adminext/v1/service.proto
+
+package adminextv1
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/uber/cadence-idl/go/proto/api/v1"
+
+	v11 "github.com/uber/cadence/.gen/proto/shared/v1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AuditLogOutcome int32
+
+const (
+	AuditLogOutcome_AUDIT_LOG_OUTCOME_INVALID AuditLogOutcome = 0
+	AuditLogOutcome_AUDIT_LOG_OUTCOME_SUCCESS AuditLogOutcome = 1
+	AuditLogOutcome_AUDIT_LOG_OUTCOME_DENIED  AuditLogOutcome = 2
+	AuditLogOutcome_AUDIT_LOG_OUTCOME_FAILED  AuditLogOutcome = 3
+)
+
+var AuditLogOutcome_name = map[int32]string{
+	0: "AUDIT_LOG_OUTCOME_INVALID",
+	1: "AUDIT_LOG_OUTCOME_SUCCESS",
+	2: "AUDIT_LOG_OUTCOME_DENIED",
+	3: "AUDIT_LOG_OUTCOME_FAILED",
+}
+
+var AuditLogOutcome_value = map[string]int32{
+	"AUDIT_LOG_OUTCOME_INVALID": 0,
+	"AUDIT_LOG_OUTCOME_SUCCESS": 1,
+	"AUDIT_LOG_OUTCOME_DENIED":  2,
+	"AUDIT_LOG_OUTCOME_FAILED":  3,
+}
+
+func (x AuditLogOutcome) String() string {
+	return proto.EnumName(AuditLogOutcome_name, int32(x))
+}
+
+func (AuditLogOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{0}
+}
+
+type HistoryTaskCategory int32
+
+const (
+	HistoryTaskCategory_HISTORY_TASK_CATEGORY_INVALID  HistoryTaskCategory = 0
+	HistoryTaskCategory_HISTORY_TASK_CATEGORY_TRANSFER HistoryTaskCategory = 1
+	HistoryTaskCategory_HISTORY_TASK_CATEGORY_TIMER    HistoryTaskCategory = 2
+)
+
+var HistoryTaskCategory_name = map[int32]string{
+	0: "HISTORY_TASK_CATEGORY_INVALID",
+	1: "HISTORY_TASK_CATEGORY_TRANSFER",
+	2: "HISTORY_TASK_CATEGORY_TIMER",
+}
+
+var HistoryTaskCategory_value = map[string]int32{
+	"HISTORY_TASK_CATEGORY_INVALID":  0,
+	"HISTORY_TASK_CATEGORY_TRANSFER": 1,
+	"HISTORY_TASK_CATEGORY_TIMER":    2,
+}
+
+func (x HistoryTaskCategory) String() string {
+	return proto.EnumName(HistoryTaskCategory_name, int32(x))
+}
+
+func (HistoryTaskCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{1}
+}
+
+type UpdateActivityOptionsRequest struct {
+	Domain                 string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution      *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ActivityId             string                `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	TaskList               *v1.TaskList          `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	ScheduleToStartTimeout *types.Duration       `protobuf:"bytes,5,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
+	ScheduleToCloseTimeout *types.Duration       `protobuf:"bytes,6,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3" json:"schedule_to_close_timeout,omitempty"`
+	StartToCloseTimeout    *types.Duration       `protobuf:"bytes,7,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3" json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout       *types.Duration       `protobuf:"bytes,8,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3" json:"heartbeat_timeout,omitempty"`
+	RetryPolicy            *v1.RetryPolicy       `protobuf:"bytes,9,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	ResetAttempts          bool                  `protobuf:"varint,10,opt,name=reset_attempts,json=resetAttempts,proto3" json:"reset_attempts,omitempty"`
+	Identity               string                `protobuf:"bytes,11,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
+	XXX_unrecognized       []byte                `json:"-"`
+	XXX_sizecache          int32                 `json:"-"`
+}
+
+func (m *UpdateActivityOptionsRequest) Reset()         { *m = UpdateActivityOptionsRequest{} }
+func (m *UpdateActivityOptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateActivityOptionsRequest) ProtoMessage()    {}
+func (*UpdateActivityOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{0}
+}
+func (m *UpdateActivityOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateActivityOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateActivityOptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateActivityOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsRequest.Merge(m, src)
+}
+func (m *UpdateActivityOptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateActivityOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateActivityOptionsRequest proto.InternalMessageInfo
+
+func (m *UpdateActivityOptionsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *UpdateActivityOptionsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *UpdateActivityOptionsRequest) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *UpdateActivityOptionsRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *UpdateActivityOptionsRequest) GetScheduleToStartTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *UpdateActivityOptionsRequest) GetScheduleToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (m *UpdateActivityOptionsRequest) GetStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *UpdateActivityOptionsRequest) GetHeartbeatTimeout() *types.Duration {
+	if m != nil {
+		return m.HeartbeatTimeout
+	}
+	return nil
+}
+
+func (m *UpdateActivityOptionsRequest) GetRetryPolicy() *v1.RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *UpdateActivityOptionsRequest) GetResetAttempts() bool {
+	if m != nil {
+		return m.ResetAttempts
+	}
+	return false
+}
+
+func (m *UpdateActivityOptionsRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type UpdateActivityOptionsResponse struct {
+	Activity             *v1.PendingActivityInfo `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *UpdateActivityOptionsResponse) Reset()         { *m = UpdateActivityOptionsResponse{} }
+func (m *UpdateActivityOptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateActivityOptionsResponse) ProtoMessage()    {}
+func (*UpdateActivityOptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{1}
+}
+func (m *UpdateActivityOptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateActivityOptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateActivityOptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateActivityOptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsResponse.Merge(m, src)
+}
+func (m *UpdateActivityOptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateActivityOptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateActivityOptionsResponse proto.InternalMessageInfo
+
+func (m *UpdateActivityOptionsResponse) GetActivity() *v1.PendingActivityInfo {
+	if m != nil {
+		return m.Activity
+	}
+	return nil
+}
+
+type AuditLogEntry struct {
+	Timestamp            *types.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Actor                string           `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	ApiName              string           `protobuf:"bytes,3,opt,name=api_name,json=apiName,proto3" json:"api_name,omitempty"`
+	Domain               string           `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	Request              string           `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	Outcome              AuditLogOutcome  `protobuf:"varint,6,opt,name=outcome,proto3,enum=uber.cadence.adminext.v1.AuditLogOutcome" json:"outcome,omitempty"`
+	Error                string           `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AuditLogEntry) Reset()         { *m = AuditLogEntry{} }
+func (m *AuditLogEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditLogEntry) ProtoMessage()    {}
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{2}
+}
+func (m *AuditLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogEntry.Merge(m, src)
+}
+func (m *AuditLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogEntry proto.InternalMessageInfo
+
+func (m *AuditLogEntry) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *AuditLogEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetApiName() string {
+	if m != nil {
+		return m.ApiName
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetOutcome() AuditLogOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return AuditLogOutcome_AUDIT_LOG_OUTCOME_INVALID
+}
+
+func (m *AuditLogEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListAuditLogEntriesRequest struct {
+	Domain               string           `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Actor                string           `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	ApiName              string           `protobuf:"bytes,3,opt,name=api_name,json=apiName,proto3" json:"api_name,omitempty"`
+	StartTime            *types.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *types.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PageSize             int32            `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken        []byte           `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListAuditLogEntriesRequest) Reset()         { *m = ListAuditLogEntriesRequest{} }
+func (m *ListAuditLogEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditLogEntriesRequest) ProtoMessage()    {}
+func (*ListAuditLogEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{3}
+}
+func (m *ListAuditLogEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuditLogEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuditLogEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAuditLogEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditLogEntriesRequest.Merge(m, src)
+}
+func (m *ListAuditLogEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuditLogEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditLogEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditLogEntriesRequest proto.InternalMessageInfo
+
+func (m *ListAuditLogEntriesRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ListAuditLogEntriesRequest) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *ListAuditLogEntriesRequest) GetApiName() string {
+	if m != nil {
+		return m.ApiName
+	}
+	return ""
+}
+
+func (m *ListAuditLogEntriesRequest) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ListAuditLogEntriesRequest) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *ListAuditLogEntriesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAuditLogEntriesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type ListAuditLogEntriesResponse struct {
+	Entries              []*AuditLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken        []byte           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListAuditLogEntriesResponse) Reset()         { *m = ListAuditLogEntriesResponse{} }
+func (m *ListAuditLogEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditLogEntriesResponse) ProtoMessage()    {}
+func (*ListAuditLogEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{4}
+}
+func (m *ListAuditLogEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuditLogEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuditLogEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAuditLogEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditLogEntriesResponse.Merge(m, src)
+}
+func (m *ListAuditLogEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuditLogEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditLogEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditLogEntriesResponse proto.InternalMessageInfo
+
+func (m *ListAuditLogEntriesResponse) GetEntries() []*AuditLogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ListAuditLogEntriesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type HistoryTaskDLQMessage struct {
+	MessageId            int64                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ShardId              int32                 `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	DomainId             string                `protobuf:"bytes,3,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,4,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	TaskCategory         HistoryTaskCategory   `protobuf:"varint,5,opt,name=task_category,json=taskCategory,proto3,enum=uber.cadence.adminext.v1.HistoryTaskCategory" json:"task_category,omitempty"`
+	TaskType             int32                 `protobuf:"varint,6,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	TaskId               int64                 `protobuf:"varint,7,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VisibilityTime       *types.Timestamp      `protobuf:"bytes,8,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
+	Attempt              int32                 `protobuf:"varint,9,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	FirstAttemptTime     *types.Timestamp      `protobuf:"bytes,10,opt,name=first_attempt_time,json=firstAttemptTime,proto3" json:"first_attempt_time,omitempty"`
+	EnqueuedTime         *types.Timestamp      `protobuf:"bytes,11,opt,name=enqueued_time,json=enqueuedTime,proto3" json:"enqueued_time,omitempty"`
+	LastError            string                `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	TaskInfo             string                `protobuf:"bytes,13,opt,name=task_info,json=taskInfo,proto3" json:"task_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HistoryTaskDLQMessage) Reset()         { *m = HistoryTaskDLQMessage{} }
+func (m *HistoryTaskDLQMessage) String() string { return proto.CompactTextString(m) }
+func (*HistoryTaskDLQMessage) ProtoMessage()    {}
+func (*HistoryTaskDLQMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{5}
+}
+func (m *HistoryTaskDLQMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryTaskDLQMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryTaskDLQMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryTaskDLQMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryTaskDLQMessage.Merge(m, src)
+}
+func (m *HistoryTaskDLQMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryTaskDLQMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryTaskDLQMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryTaskDLQMessage proto.InternalMessageInfo
+
+func (m *HistoryTaskDLQMessage) GetMessageId() int64 {
+	if m != nil {
+		return m.MessageId
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *HistoryTaskDLQMessage) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *HistoryTaskDLQMessage) GetTaskCategory() HistoryTaskCategory {
+	if m != nil {
+		return m.TaskCategory
+	}
+	return HistoryTaskCategory_HISTORY_TASK_CATEGORY_INVALID
+}
+
+func (m *HistoryTaskDLQMessage) GetTaskType() int32 {
+	if m != nil {
+		return m.TaskType
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetVisibilityTime() *types.Timestamp {
+	if m != nil {
+		return m.VisibilityTime
+	}
+	return nil
+}
+
+func (m *HistoryTaskDLQMessage) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetFirstAttemptTime() *types.Timestamp {
+	if m != nil {
+		return m.FirstAttemptTime
+	}
+	return nil
+}
+
+func (m *HistoryTaskDLQMessage) GetEnqueuedTime() *types.Timestamp {
+	if m != nil {
+		return m.EnqueuedTime
+	}
+	return nil
+}
+
+func (m *HistoryTaskDLQMessage) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *HistoryTaskDLQMessage) GetTaskInfo() string {
+	if m != nil {
+		return m.TaskInfo
+	}
+	return ""
+}
+
+type ReadHistoryTaskDLQMessagesRequest struct {
+	ShardId               int32             `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Domain                string            `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,3,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	PageSize              int32             `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken         []byte            `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *ReadHistoryTaskDLQMessagesRequest) Reset()         { *m = ReadHistoryTaskDLQMessagesRequest{} }
+func (m *ReadHistoryTaskDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadHistoryTaskDLQMessagesRequest) ProtoMessage()    {}
+func (*ReadHistoryTaskDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{6}
+}
+func (m *ReadHistoryTaskDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadHistoryTaskDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadHistoryTaskDLQMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadHistoryTaskDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadHistoryTaskDLQMessagesRequest.Merge(m, src)
+}
+func (m *ReadHistoryTaskDLQMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadHistoryTaskDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadHistoryTaskDLQMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadHistoryTaskDLQMessagesRequest proto.InternalMessageInfo
+
+func (m *ReadHistoryTaskDLQMessagesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ReadHistoryTaskDLQMessagesRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ReadHistoryTaskDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+	if m != nil {
+		return m.InclusiveEndMessageId
+	}
+	return nil
+}
+
+func (m *ReadHistoryTaskDLQMessagesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ReadHistoryTaskDLQMessagesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type ReadHistoryTaskDLQMessagesResponse struct {
+	Messages             []*HistoryTaskDLQMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextPageToken        []byte                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ReadHistoryTaskDLQMessagesResponse) Reset()         { *m = ReadHistoryTaskDLQMessagesResponse{} }
+func (m *ReadHistoryTaskDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadHistoryTaskDLQMessagesResponse) ProtoMessage()    {}
+func (*ReadHistoryTaskDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{7}
+}
+func (m *ReadHistoryTaskDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadHistoryTaskDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadHistoryTaskDLQMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadHistoryTaskDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadHistoryTaskDLQMessagesResponse.Merge(m, src)
+}
+func (m *ReadHistoryTaskDLQMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadHistoryTaskDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadHistoryTaskDLQMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadHistoryTaskDLQMessagesResponse proto.InternalMessageInfo
+
+func (m *ReadHistoryTaskDLQMessagesResponse) GetMessages() []*HistoryTaskDLQMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *ReadHistoryTaskDLQMessagesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type DescribeHistoryTaskDLQMessageRequest struct {
+	ShardId              int32    `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	MessageId            int64    `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeHistoryTaskDLQMessageRequest) Reset()         { *m = DescribeHistoryTaskDLQMessageRequest{} }
+func (m *DescribeHistoryTaskDLQMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeHistoryTaskDLQMessageRequest) ProtoMessage()    {}
+func (*DescribeHistoryTaskDLQMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{8}
+}
+func (m *DescribeHistoryTaskDLQMessageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeHistoryTaskDLQMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeHistoryTaskDLQMessageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeHistoryTaskDLQMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeHistoryTaskDLQMessageRequest.Merge(m, src)
+}
+func (m *DescribeHistoryTaskDLQMessageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeHistoryTaskDLQMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeHistoryTaskDLQMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeHistoryTaskDLQMessageRequest proto.InternalMessageInfo
+
+func (m *DescribeHistoryTaskDLQMessageRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *DescribeHistoryTaskDLQMessageRequest) GetMessageId() int64 {
+	if m != nil {
+		return m.MessageId
+	}
+	return 0
+}
+
+type DescribeHistoryTaskDLQMessageResponse struct {
+	Message              *HistoryTaskDLQMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DescribeHistoryTaskDLQMessageResponse) Reset()         { *m = DescribeHistoryTaskDLQMessageResponse{} }
+func (m *DescribeHistoryTaskDLQMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeHistoryTaskDLQMessageResponse) ProtoMessage()    {}
+func (*DescribeHistoryTaskDLQMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{9}
+}
+func (m *DescribeHistoryTaskDLQMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeHistoryTaskDLQMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeHistoryTaskDLQMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeHistoryTaskDLQMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeHistoryTaskDLQMessageResponse.Merge(m, src)
+}
+func (m *DescribeHistoryTaskDLQMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeHistoryTaskDLQMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeHistoryTaskDLQMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeHistoryTaskDLQMessageResponse proto.InternalMessageInfo
+
+func (m *DescribeHistoryTaskDLQMessageResponse) GetMessage() *HistoryTaskDLQMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type MergeHistoryTaskDLQMessagesRequest struct {
+	ShardId               int32             `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Domain                string            `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,3,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	PageSize              int32             `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken         []byte            `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *MergeHistoryTaskDLQMessagesRequest) Reset()         { *m = MergeHistoryTaskDLQMessagesRequest{} }
+func (m *MergeHistoryTaskDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeHistoryTaskDLQMessagesRequest) ProtoMessage()    {}
+func (*MergeHistoryTaskDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{10}
+}
+func (m *MergeHistoryTaskDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeHistoryTaskDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeHistoryTaskDLQMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeHistoryTaskDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeHistoryTaskDLQMessagesRequest.Merge(m, src)
+}
+func (m *MergeHistoryTaskDLQMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeHistoryTaskDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeHistoryTaskDLQMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeHistoryTaskDLQMessagesRequest proto.InternalMessageInfo
+
+func (m *MergeHistoryTaskDLQMessagesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *MergeHistoryTaskDLQMessagesRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *MergeHistoryTaskDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+	if m != nil {
+		return m.InclusiveEndMessageId
+	}
+	return nil
+}
+
+func (m *MergeHistoryTaskDLQMessagesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *MergeHistoryTaskDLQMessagesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type MergeHistoryTaskDLQMessagesResponse struct {
+	NextPageToken        []byte   `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeHistoryTaskDLQMessagesResponse) Reset()         { *m = MergeHistoryTaskDLQMessagesResponse{} }
+func (m *MergeHistoryTaskDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeHistoryTaskDLQMessagesResponse) ProtoMessage()    {}
+func (*MergeHistoryTaskDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{11}
+}
+func (m *MergeHistoryTaskDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeHistoryTaskDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeHistoryTaskDLQMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeHistoryTaskDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeHistoryTaskDLQMessagesResponse.Merge(m, src)
+}
+func (m *MergeHistoryTaskDLQMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeHistoryTaskDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeHistoryTaskDLQMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeHistoryTaskDLQMessagesResponse proto.InternalMessageInfo
+
+func (m *MergeHistoryTaskDLQMessagesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type PurgeHistoryTaskDLQMessagesRequest struct {
+	ShardId               int32             `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Domain                string            `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,3,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *PurgeHistoryTaskDLQMessagesRequest) Reset()         { *m = PurgeHistoryTaskDLQMessagesRequest{} }
+func (m *PurgeHistoryTaskDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeHistoryTaskDLQMessagesRequest) ProtoMessage()    {}
+func (*PurgeHistoryTaskDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{12}
+}
+func (m *PurgeHistoryTaskDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeHistoryTaskDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeHistoryTaskDLQMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeHistoryTaskDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeHistoryTaskDLQMessagesRequest.Merge(m, src)
+}
+func (m *PurgeHistoryTaskDLQMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeHistoryTaskDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeHistoryTaskDLQMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeHistoryTaskDLQMessagesRequest proto.InternalMessageInfo
+
+func (m *PurgeHistoryTaskDLQMessagesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *PurgeHistoryTaskDLQMessagesRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *PurgeHistoryTaskDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+	if m != nil {
+		return m.InclusiveEndMessageId
+	}
+	return nil
+}
+
+type PurgeHistoryTaskDLQMessagesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeHistoryTaskDLQMessagesResponse) Reset()         { *m = PurgeHistoryTaskDLQMessagesResponse{} }
+func (m *PurgeHistoryTaskDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeHistoryTaskDLQMessagesResponse) ProtoMessage()    {}
+func (*PurgeHistoryTaskDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{13}
+}
+func (m *PurgeHistoryTaskDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeHistoryTaskDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeHistoryTaskDLQMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeHistoryTaskDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeHistoryTaskDLQMessagesResponse.Merge(m, src)
+}
+func (m *PurgeHistoryTaskDLQMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeHistoryTaskDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeHistoryTaskDLQMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeHistoryTaskDLQMessagesResponse proto.InternalMessageInfo
+
+type DeleteDomainRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SecurityToken        string   `protobuf:"bytes,2,opt,name=security_token,json=securityToken,proto3" json:"security_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDomainRequest) Reset()         { *m = DeleteDomainRequest{} }
+func (m *DeleteDomainRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDomainRequest) ProtoMessage()    {}
+func (*DeleteDomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{14}
+}
+func (m *DeleteDomainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteDomainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteDomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDomainRequest.Merge(m, src)
+}
+func (m *DeleteDomainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteDomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDomainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDomainRequest proto.InternalMessageInfo
+
+func (m *DeleteDomainRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeleteDomainRequest) GetSecurityToken() string {
+	if m != nil {
+		return m.SecurityToken
+	}
+	return ""
+}
+
+type DeleteDomainResponse struct {
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DeleteDomainResponse) Reset()         { *m = DeleteDomainResponse{} }
+func (m *DeleteDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteDomainResponse) ProtoMessage()    {}
+func (*DeleteDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{15}
+}
+func (m *DeleteDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDomainResponse.Merge(m, src)
+}
+func (m *DeleteDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDomainResponse proto.InternalMessageInfo
+
+func (m *DeleteDomainResponse) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+type ReplicationShardStatus struct {
+	ShardId              int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	RemoteCluster        string           `protobuf:"bytes,2,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
+	ReadLevel            int64            `protobuf:"varint,3,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
+	AckedLevel           int64            `protobuf:"varint,4,opt,name=acked_level,json=ackedLevel,proto3" json:"acked_level,omitempty"`
+	MaxReadLevel         int64            `protobuf:"varint,5,opt,name=max_read_level,json=maxReadLevel,proto3" json:"max_read_level,omitempty"`
+	LastReplicatedTime   *types.Timestamp `protobuf:"bytes,6,opt,name=last_replicated_time,json=lastReplicatedTime,proto3" json:"last_replicated_time,omitempty"`
+	DlqSize              int64            `protobuf:"varint,7,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	OldestPendingTaskAge *types.Duration  `protobuf:"bytes,8,opt,name=oldest_pending_task_age,json=oldestPendingTaskAge,proto3" json:"oldest_pending_task_age,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplicationShardStatus) Reset()         { *m = ReplicationShardStatus{} }
+func (m *ReplicationShardStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationShardStatus) ProtoMessage()    {}
+func (*ReplicationShardStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{16}
+}
+func (m *ReplicationShardStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationShardStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationShardStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationShardStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationShardStatus.Merge(m, src)
+}
+func (m *ReplicationShardStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationShardStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationShardStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationShardStatus proto.InternalMessageInfo
+
+func (m *ReplicationShardStatus) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetRemoteCluster() string {
+	if m != nil {
+		return m.RemoteCluster
+	}
+	return ""
+}
+
+func (m *ReplicationShardStatus) GetReadLevel() int64 {
+	if m != nil {
+		return m.ReadLevel
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetAckedLevel() int64 {
+	if m != nil {
+		return m.AckedLevel
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetMaxReadLevel() int64 {
+	if m != nil {
+		return m.MaxReadLevel
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetLastReplicatedTime() *types.Timestamp {
+	if m != nil {
+		return m.LastReplicatedTime
+	}
+	return nil
+}
+
+func (m *ReplicationShardStatus) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetOldestPendingTaskAge() *types.Duration {
+	if m != nil {
+		return m.OldestPendingTaskAge
+	}
+	return nil
+}
+
+type ReplicationClusterStatus struct {
+	RemoteCluster        string           `protobuf:"bytes,1,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
+	ShardCount           int32            `protobuf:"varint,2,opt,name=shard_count,json=shardCount,proto3" json:"shard_count,omitempty"`
+	TaskLag              int64            `protobuf:"varint,3,opt,name=task_lag,json=taskLag,proto3" json:"task_lag,omitempty"`
+	LastReplicatedTime   *types.Timestamp `protobuf:"bytes,4,opt,name=last_replicated_time,json=lastReplicatedTime,proto3" json:"last_replicated_time,omitempty"`
+	DlqSize              int64            `protobuf:"varint,5,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	OldestPendingTaskAge *types.Duration  `protobuf:"bytes,6,opt,name=oldest_pending_task_age,json=oldestPendingTaskAge,proto3" json:"oldest_pending_task_age,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplicationClusterStatus) Reset()         { *m = ReplicationClusterStatus{} }
+func (m *ReplicationClusterStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationClusterStatus) ProtoMessage()    {}
+func (*ReplicationClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{17}
+}
+func (m *ReplicationClusterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationClusterStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationClusterStatus.Merge(m, src)
+}
+func (m *ReplicationClusterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationClusterStatus proto.InternalMessageInfo
+
+func (m *ReplicationClusterStatus) GetRemoteCluster() string {
+	if m != nil {
+		return m.RemoteCluster
+	}
+	return ""
+}
+
+func (m *ReplicationClusterStatus) GetShardCount() int32 {
+	if m != nil {
+		return m.ShardCount
+	}
+	return 0
+}
+
+func (m *ReplicationClusterStatus) GetTaskLag() int64 {
+	if m != nil {
+		return m.TaskLag
+	}
+	return 0
+}
+
+func (m *ReplicationClusterStatus) GetLastReplicatedTime() *types.Timestamp {
+	if m != nil {
+		return m.LastReplicatedTime
+	}
+	return nil
+}
+
+func (m *ReplicationClusterStatus) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
+	}
+	return 0
+}
+
+func (m *ReplicationClusterStatus) GetOldestPendingTaskAge() *types.Duration {
+	if m != nil {
+		return m.OldestPendingTaskAge
+	}
+	return nil
+}
+
+type GetReplicationStatusRequest struct {
+	Clusters             []string `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	ForceFetch           bool     `protobuf:"varint,2,opt,name=force_fetch,json=forceFetch,proto3" json:"force_fetch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReplicationStatusRequest) Reset()         { *m = GetReplicationStatusRequest{} }
+func (m *GetReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationStatusRequest) ProtoMessage()    {}
+func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{18}
+}
+func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusRequest.Merge(m, src)
+}
+func (m *GetReplicationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationStatusRequest proto.InternalMessageInfo
+
+func (m *GetReplicationStatusRequest) GetClusters() []string {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func (m *GetReplicationStatusRequest) GetForceFetch() bool {
+	if m != nil {
+		return m.ForceFetch
+	}
+	return false
+}
+
+type GetReplicationStatusResponse struct {
+	Clusters             []*ReplicationClusterStatus `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Shards               []*ReplicationShardStatus   `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *GetReplicationStatusResponse) Reset()         { *m = GetReplicationStatusResponse{} }
+func (m *GetReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationStatusResponse) ProtoMessage()    {}
+func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{19}
+}
+func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusResponse.Merge(m, src)
+}
+func (m *GetReplicationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationStatusResponse proto.InternalMessageInfo
+
+func (m *GetReplicationStatusResponse) GetClusters() []*ReplicationClusterStatus {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func (m *GetReplicationStatusResponse) GetShards() []*ReplicationShardStatus {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+type RenameDomainRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	SecurityToken        string   `protobuf:"bytes,3,opt,name=security_token,json=securityToken,proto3" json:"security_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameDomainRequest) Reset()         { *m = RenameDomainRequest{} }
+func (m *RenameDomainRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameDomainRequest) ProtoMessage()    {}
+func (*RenameDomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{20}
+}
+func (m *RenameDomainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameDomainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenameDomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameDomainRequest.Merge(m, src)
+}
+func (m *RenameDomainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenameDomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameDomainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameDomainRequest proto.InternalMessageInfo
+
+func (m *RenameDomainRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RenameDomainRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *RenameDomainRequest) GetSecurityToken() string {
+	if m != nil {
+		return m.SecurityToken
+	}
+	return ""
+}
+
+type RenameDomainResponse struct {
+	DomainId               string           `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PreviousNameExpiryTime *types.Timestamp `protobuf:"bytes,2,opt,name=previous_name_expiry_time,json=previousNameExpiryTime,proto3" json:"previous_name_expiry_time,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}         `json:"-"`
+	XXX_unrecognized       []byte           `json:"-"`
+	XXX_sizecache          int32            `json:"-"`
+}
+
+func (m *RenameDomainResponse) Reset()         { *m = RenameDomainResponse{} }
+func (m *RenameDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*RenameDomainResponse) ProtoMessage()    {}
+func (*RenameDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{21}
+}
+func (m *RenameDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenameDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameDomainResponse.Merge(m, src)
+}
+func (m *RenameDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenameDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameDomainResponse proto.InternalMessageInfo
+
+func (m *RenameDomainResponse) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *RenameDomainResponse) GetPreviousNameExpiryTime() *types.Timestamp {
+	if m != nil {
+		return m.PreviousNameExpiryTime
+	}
+	return nil
+}
+
+type DescribeWorkflowVersionHistoriesRequest struct {
+	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Cluster              string                `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeWorkflowVersionHistoriesRequest) Reset() {
+	*m = DescribeWorkflowVersionHistoriesRequest{}
+}
+func (m *DescribeWorkflowVersionHistoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeWorkflowVersionHistoriesRequest) ProtoMessage()    {}
+func (*DescribeWorkflowVersionHistoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{22}
+}
+func (m *DescribeWorkflowVersionHistoriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeWorkflowVersionHistoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeWorkflowVersionHistoriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeWorkflowVersionHistoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeWorkflowVersionHistoriesRequest.Merge(m, src)
+}
+func (m *DescribeWorkflowVersionHistoriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeWorkflowVersionHistoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeWorkflowVersionHistoriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeWorkflowVersionHistoriesRequest proto.InternalMessageInfo
+
+func (m *DescribeWorkflowVersionHistoriesRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DescribeWorkflowVersionHistoriesRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *DescribeWorkflowVersionHistoriesRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+type DescribeWorkflowVersionHistoriesResponse struct {
+	Cluster              string                `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	VersionHistories     *v11.VersionHistories `protobuf:"bytes,3,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeWorkflowVersionHistoriesResponse) Reset() {
+	*m = DescribeWorkflowVersionHistoriesResponse{}
+}
+func (m *DescribeWorkflowVersionHistoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeWorkflowVersionHistoriesResponse) ProtoMessage()    {}
+func (*DescribeWorkflowVersionHistoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{23}
+}
+func (m *DescribeWorkflowVersionHistoriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeWorkflowVersionHistoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeWorkflowVersionHistoriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeWorkflowVersionHistoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeWorkflowVersionHistoriesResponse.Merge(m, src)
+}
+func (m *DescribeWorkflowVersionHistoriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeWorkflowVersionHistoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeWorkflowVersionHistoriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeWorkflowVersionHistoriesResponse proto.InternalMessageInfo
+
+func (m *DescribeWorkflowVersionHistoriesResponse) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *DescribeWorkflowVersionHistoriesResponse) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *DescribeWorkflowVersionHistoriesResponse) GetVersionHistories() *v11.VersionHistories {
+	if m != nil {
+		return m.VersionHistories
+	}
+	return nil
+}
+
+type RebuildWorkflowBranchRequest struct {
+	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	BranchToken          []byte                `protobuf:"bytes,3,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
+	Cluster              string                `protobuf:"bytes,4,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RebuildWorkflowBranchRequest) Reset()         { *m = RebuildWorkflowBranchRequest{} }
+func (m *RebuildWorkflowBranchRequest) String() string { return proto.CompactTextString(m) }
+func (*RebuildWorkflowBranchRequest) ProtoMessage()    {}
+func (*RebuildWorkflowBranchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{24}
+}
+func (m *RebuildWorkflowBranchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebuildWorkflowBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebuildWorkflowBranchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RebuildWorkflowBranchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildWorkflowBranchRequest.Merge(m, src)
+}
+func (m *RebuildWorkflowBranchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebuildWorkflowBranchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildWorkflowBranchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildWorkflowBranchRequest proto.InternalMessageInfo
+
+func (m *RebuildWorkflowBranchRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *RebuildWorkflowBranchRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *RebuildWorkflowBranchRequest) GetBranchToken() []byte {
+	if m != nil {
+		return m.BranchToken
+	}
+	return nil
+}
+
+func (m *RebuildWorkflowBranchRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+type RebuildWorkflowBranchResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildWorkflowBranchResponse) Reset()         { *m = RebuildWorkflowBranchResponse{} }
+func (m *RebuildWorkflowBranchResponse) String() string { return proto.CompactTextString(m) }
+func (*RebuildWorkflowBranchResponse) ProtoMessage()    {}
+func (*RebuildWorkflowBranchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{25}
+}
+func (m *RebuildWorkflowBranchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebuildWorkflowBranchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebuildWorkflowBranchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RebuildWorkflowBranchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildWorkflowBranchResponse.Merge(m, src)
+}
+func (m *RebuildWorkflowBranchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebuildWorkflowBranchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildWorkflowBranchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildWorkflowBranchResponse proto.InternalMessageInfo
+
+type DescribeTaskListRequest struct {
+	Domain                string          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList              *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType          v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	IncludeTaskListStatus bool            `protobuf:"varint,4,opt,name=include_task_list_status,json=includeTaskListStatus,proto3" json:"include_task_list_status,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}        `json:"-"`
+	XXX_unrecognized      []byte          `json:"-"`
+	XXX_sizecache         int32           `json:"-"`
+}
+
+func (m *DescribeTaskListRequest) Reset()         { *m = DescribeTaskListRequest{} }
+func (m *DescribeTaskListRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeTaskListRequest) ProtoMessage()    {}
+func (*DescribeTaskListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{26}
+}
+func (m *DescribeTaskListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeTaskListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskListRequest.Merge(m, src)
+}
+func (m *DescribeTaskListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeTaskListRequest proto.InternalMessageInfo
+
+func (m *DescribeTaskListRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DescribeTaskListRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *DescribeTaskListRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+func (m *DescribeTaskListRequest) GetIncludeTaskListStatus() bool {
+	if m != nil {
+		return m.IncludeTaskListStatus
+	}
+	return false
+}
+
+type DescribeTaskListResponse struct {
+	Pollers              []*v1.PollerInfo               `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	TaskListStatus       *v1.TaskListStatus             `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
+	PartitionConfig      *v1.TaskListPartitionConfig    `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	DispatchStats        *v11.TaskListDispatchStats     `protobuf:"bytes,4,opt,name=dispatch_stats,json=dispatchStats,proto3" json:"dispatch_stats,omitempty"`
+	ScalingDecisions     []*v11.TaskListScalingDecision `protobuf:"bytes,5,rep,name=scaling_decisions,json=scalingDecisions,proto3" json:"scaling_decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
+func (m *DescribeTaskListResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeTaskListResponse) ProtoMessage()    {}
+func (*DescribeTaskListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38d8bd4ba4c870e, []int{27}
+}
+func (m *DescribeTaskListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeTaskListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskListResponse.Merge(m, src)
+}
+func (m *DescribeTaskListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeTaskListResponse proto.InternalMessageInfo
+
+func (m *DescribeTaskListResponse) GetPollers() []*v1.PollerInfo {
+	if m != nil {
+		return m.Pollers
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetTaskListStatus() *v1.TaskListStatus {
+	if m != nil {
+		return m.TaskListStatus
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetPartitionConfig() *v1.TaskListPartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetDispatchStats() *v11.TaskListDispatchStats {
+	if m != nil {
+		return m.DispatchStats
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetScalingDecisions() []*v11.TaskListScalingDecision {
+	if m != nil {
+		return m.ScalingDecisions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("uber.cadence.adminext.v1.AuditLogOutcome", AuditLogOutcome_name, AuditLogOutcome_value)
+	proto.RegisterEnum("uber.cadence.adminext.v1.HistoryTaskCategory", HistoryTaskCategory_name, HistoryTaskCategory_value)
+	proto.RegisterType((*UpdateActivityOptionsRequest)(nil), "uber.cadence.adminext.v1.UpdateActivityOptionsRequest")
+	proto.RegisterType((*UpdateActivityOptionsResponse)(nil), "uber.cadence.adminext.v1.UpdateActivityOptionsResponse")
+	proto.RegisterType((*AuditLogEntry)(nil), "uber.cadence.adminext.v1.AuditLogEntry")
+	proto.RegisterType((*ListAuditLogEntriesRequest)(nil), "uber.cadence.adminext.v1.ListAuditLogEntriesRequest")
+	proto.RegisterType((*ListAuditLogEntriesResponse)(nil), "uber.cadence.adminext.v1.ListAuditLogEntriesResponse")
+	proto.RegisterType((*HistoryTaskDLQMessage)(nil), "uber.cadence.adminext.v1.HistoryTaskDLQMessage")
+	proto.RegisterType((*ReadHistoryTaskDLQMessagesRequest)(nil), "uber.cadence.adminext.v1.ReadHistoryTaskDLQMessagesRequest")
+	proto.RegisterType((*ReadHistoryTaskDLQMessagesResponse)(nil), "uber.cadence.adminext.v1.ReadHistoryTaskDLQMessagesResponse")
+	proto.RegisterType((*DescribeHistoryTaskDLQMessageRequest)(nil), "uber.cadence.adminext.v1.DescribeHistoryTaskDLQMessageRequest")
+	proto.RegisterType((*DescribeHistoryTaskDLQMessageResponse)(nil), "uber.cadence.adminext.v1.DescribeHistoryTaskDLQMessageResponse")
+	proto.RegisterType((*MergeHistoryTaskDLQMessagesRequest)(nil), "uber.cadence.adminext.v1.MergeHistoryTaskDLQMessagesRequest")
+	proto.RegisterType((*MergeHistoryTaskDLQMessagesResponse)(nil), "uber.cadence.adminext.v1.MergeHistoryTaskDLQMessagesResponse")
+	proto.RegisterType((*PurgeHistoryTaskDLQMessagesRequest)(nil), "uber.cadence.adminext.v1.PurgeHistoryTaskDLQMessagesRequest")
+	proto.RegisterType((*PurgeHistoryTaskDLQMessagesResponse)(nil), "uber.cadence.adminext.v1.PurgeHistoryTaskDLQMessagesResponse")
+	proto.RegisterType((*DeleteDomainRequest)(nil), "uber.cadence.adminext.v1.DeleteDomainRequest")
+	proto.RegisterType((*DeleteDomainResponse)(nil), "uber.cadence.adminext.v1.DeleteDomainResponse")
+	proto.RegisterType((*ReplicationShardStatus)(nil), "uber.cadence.adminext.v1.ReplicationShardStatus")
+	proto.RegisterType((*ReplicationClusterStatus)(nil), "uber.cadence.adminext.v1.ReplicationClusterStatus")
+	proto.RegisterType((*GetReplicationStatusRequest)(nil), "uber.cadence.adminext.v1.GetReplicationStatusRequest")
+	proto.RegisterType((*GetReplicationStatusResponse)(nil), "uber.cadence.adminext.v1.GetReplicationStatusResponse")
+	proto.RegisterType((*RenameDomainRequest)(nil), "uber.cadence.adminext.v1.RenameDomainRequest")
+	proto.RegisterType((*RenameDomainResponse)(nil), "uber.cadence.adminext.v1.RenameDomainResponse")
+	proto.RegisterType((*DescribeWorkflowVersionHistoriesRequest)(nil), "uber.cadence.adminext.v1.DescribeWorkflowVersionHistoriesRequest")
+	proto.RegisterType((*DescribeWorkflowVersionHistoriesResponse)(nil), "uber.cadence.adminext.v1.DescribeWorkflowVersionHistoriesResponse")
+	proto.RegisterType((*RebuildWorkflowBranchRequest)(nil), "uber.cadence.adminext.v1.RebuildWorkflowBranchRequest")
+	proto.RegisterType((*RebuildWorkflowBranchResponse)(nil), "uber.cadence.adminext.v1.RebuildWorkflowBranchResponse")
+	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.adminext.v1.DescribeTaskListRequest")
+	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.adminext.v1.DescribeTaskListResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/adminext/v1/service.proto", fileDescriptor_a38d8bd4ba4c870e)
+}
+
+var fileDescriptor_a38d8bd4ba4c870e = []byte{
+	// 2328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xdb, 0x6f, 0x1b, 0x59,
+	0x19, 0x67, 0x9c, 0x8b, 0xed, 0xcf, 0x49, 0xea, 0x9e, 0x66, 0xdb, 0x89, 0xd3, 0x34, 0xe9, 0xf4,
+	0xb2, 0x61, 0x45, 0x1d, 0x1a, 0x76, 0x5b, 0xca, 0x02, 0x2b, 0xd7, 0x76, 0x5b, 0x6b, 0x73, 0x31,
+	0xc7, 0x4e, 0x57, 0xac, 0x90, 0x86, 0xc9, 0xcc, 0x89, 0x33, 0x8a, 0x3d, 0x33, 0x9d, 0x39, 0xe3,
+	0x24, 0x2b, 0xf1, 0x82, 0x84, 0x58, 0xc1, 0x1f, 0x80, 0x40, 0x3c, 0xf0, 0x8a, 0x04, 0x0f, 0xf0,
+	0x80, 0xf6, 0x4f, 0x40, 0x3c, 0xf1, 0x27, 0xa0, 0xfe, 0x03, 0x08, 0x24, 0x9e, 0x78, 0x41, 0xe7,
+	0x32, 0xbe, 0x8e, 0x3d, 0x49, 0xab, 0x15, 0x42, 0xbc, 0xf9, 0x7c, 0xf3, 0xfd, 0xbe, 0xf3, 0xdd,
+	0xcf, 0x77, 0x4e, 0x02, 0xf7, 0xc3, 0x43, 0xe2, 0x6f, 0x99, 0x86, 0x45, 0x1c, 0x93, 0x6c, 0x19,
+	0x56, 0xc7, 0x76, 0xc8, 0x19, 0xdd, 0xea, 0x3e, 0xdc, 0x0a, 0x88, 0xdf, 0xb5, 0x4d, 0x52, 0xf4,
+	0x7c, 0x97, 0xba, 0x48, 0x65, 0x7c, 0x45, 0xc9, 0x57, 0x8c, 0xf8, 0x8a, 0xdd, 0x87, 0x85, 0x5b,
+	0x2d, 0xd7, 0x6d, 0xb5, 0xc9, 0x16, 0xe7, 0x3b, 0x0c, 0x8f, 0xb6, 0xac, 0xd0, 0x37, 0xa8, 0xed,
+	0x3a, 0x02, 0x59, 0x58, 0x1f, 0xfd, 0x4e, 0xed, 0x0e, 0x09, 0xa8, 0xd1, 0xf1, 0x24, 0xc3, 0x98,
+	0x80, 0x53, 0xdf, 0xf0, 0x3c, 0xe2, 0x07, 0xf2, 0xfb, 0xc6, 0xb0, 0x8a, 0x9e, 0xcd, 0xb4, 0x33,
+	0xdd, 0x4e, 0xa7, 0xb7, 0x85, 0x16, 0xc7, 0x41, 0x8d, 0xe0, 0xa4, 0x6d, 0x07, 0x74, 0x1a, 0xcf,
+	0xa9, 0xeb, 0x9f, 0x1c, 0xb5, 0xdd, 0x53, 0xc9, 0x73, 0x77, 0x88, 0x27, 0x38, 0x36, 0x7c, 0x62,
+	0x31, 0xb6, 0x63, 0x3b, 0xa0, 0xae, 0x7f, 0x2e, 0xb9, 0xee, 0x4d, 0xe0, 0x1a, 0xde, 0x50, 0xfb,
+	0xd3, 0x1c, 0xdc, 0x3c, 0xf0, 0x2c, 0x83, 0x92, 0x92, 0x49, 0xed, 0xae, 0x4d, 0xcf, 0xf7, 0x3d,
+	0xe6, 0x96, 0x00, 0x93, 0x57, 0x21, 0x09, 0x28, 0xba, 0x0e, 0xf3, 0x96, 0xdb, 0x31, 0x6c, 0x47,
+	0x55, 0x36, 0x94, 0xcd, 0x2c, 0x96, 0x2b, 0x74, 0x00, 0x28, 0xd2, 0x4b, 0x27, 0x67, 0xc4, 0x0c,
+	0x19, 0x4a, 0x4d, 0x6d, 0x28, 0x9b, 0xb9, 0xed, 0xfb, 0xc5, 0xe1, 0x38, 0x78, 0x76, 0xb1, 0xfb,
+	0xb0, 0xf8, 0x89, 0x64, 0xaf, 0x46, 0xdc, 0xf8, 0xea, 0xe9, 0x28, 0x09, 0xad, 0x43, 0xce, 0x90,
+	0x8a, 0xe8, 0xb6, 0xa5, 0xce, 0xf0, 0x3d, 0x21, 0x22, 0xd5, 0x2c, 0xf4, 0x2d, 0xc8, 0x32, 0x13,
+	0x74, 0x66, 0x83, 0x3a, 0xcb, 0xb7, 0x5b, 0x8b, 0xdd, 0xae, 0x69, 0x04, 0x27, 0x3b, 0x76, 0x40,
+	0x71, 0x86, 0xca, 0x5f, 0xa8, 0x09, 0x2b, 0x81, 0x79, 0x4c, 0xac, 0xb0, 0x4d, 0x74, 0xea, 0xea,
+	0x01, 0x35, 0x7c, 0xaa, 0xb3, 0x40, 0xbb, 0x21, 0x55, 0xe7, 0xb8, 0xac, 0x95, 0xa2, 0x88, 0x73,
+	0x31, 0x8a, 0x73, 0xb1, 0x22, 0x13, 0x05, 0x5f, 0x8f, 0xb0, 0x4d, 0xb7, 0xc1, 0x90, 0x4d, 0x01,
+	0x1c, 0x95, 0x6a, 0xb6, 0xdd, 0x80, 0xf4, 0xa4, 0xce, 0x5f, 0x42, 0x6a, 0x99, 0x21, 0x23, 0xa9,
+	0x7b, 0x70, 0x5d, 0xea, 0x37, 0x2a, 0x32, 0x9d, 0x24, 0xf2, 0x1a, 0x07, 0x8e, 0xc8, 0x7b, 0x06,
+	0x57, 0x8f, 0x89, 0xe1, 0xd3, 0x43, 0x62, 0xf4, 0x6d, 0xce, 0x24, 0x89, 0xca, 0xf7, 0x30, 0x91,
+	0x9c, 0x32, 0x2c, 0xf8, 0x84, 0xfa, 0xe7, 0xba, 0xe7, 0xb6, 0x6d, 0xf3, 0x5c, 0xcd, 0x72, 0x11,
+	0x1b, 0xb1, 0x21, 0xc0, 0x8c, 0xb1, 0xce, 0xf9, 0x70, 0xce, 0xef, 0x2f, 0xd0, 0x3d, 0x58, 0xf2,
+	0x49, 0x40, 0xa8, 0x6e, 0x50, 0x4a, 0x3a, 0x1e, 0x0d, 0x54, 0xd8, 0x50, 0x36, 0x33, 0x78, 0x91,
+	0x53, 0x4b, 0x92, 0x88, 0x0a, 0x90, 0xb1, 0x2d, 0xe2, 0x50, 0x9b, 0x9e, 0xab, 0x39, 0x9e, 0x09,
+	0xbd, 0xb5, 0x46, 0x60, 0x6d, 0x42, 0xde, 0x06, 0x9e, 0xeb, 0x04, 0x04, 0x55, 0x20, 0x13, 0xa5,
+	0x0d, 0x4f, 0xdd, 0xdc, 0xf6, 0x66, 0xac, 0x92, 0x75, 0xe2, 0x58, 0xb6, 0xd3, 0x8a, 0xc4, 0xd4,
+	0x9c, 0x23, 0x17, 0xf7, 0x90, 0xda, 0x4f, 0x53, 0xb0, 0x58, 0x0a, 0x2d, 0x9b, 0xee, 0xb8, 0xad,
+	0xaa, 0x43, 0xfd, 0x73, 0xf4, 0x4d, 0xc8, 0xf6, 0x7a, 0x83, 0x14, 0x5c, 0x18, 0x73, 0x60, 0x33,
+	0xe2, 0xc0, 0x7d, 0x66, 0xb4, 0x0c, 0x73, 0x86, 0x49, 0x5d, 0x9f, 0x57, 0x49, 0x16, 0x8b, 0x05,
+	0x5a, 0x81, 0x8c, 0xe1, 0xd9, 0xba, 0x63, 0x74, 0x88, 0x4c, 0xf7, 0xb4, 0xe1, 0xd9, 0x7b, 0x46,
+	0x87, 0x0c, 0xd4, 0xde, 0xec, 0x50, 0xed, 0xa9, 0x90, 0xf6, 0x45, 0x79, 0xf2, 0xac, 0xcd, 0xe2,
+	0x68, 0x89, 0xca, 0x90, 0x76, 0x43, 0x6a, 0xba, 0x1d, 0xc2, 0x33, 0x6f, 0x69, 0xfb, 0xab, 0xc5,
+	0x49, 0x2d, 0xb1, 0x18, 0x99, 0xb5, 0x2f, 0x00, 0x38, 0x42, 0x32, 0x3d, 0x89, 0xef, 0xbb, 0x3e,
+	0xcf, 0xb4, 0x2c, 0x16, 0x0b, 0xed, 0xd7, 0x29, 0x28, 0xb0, 0x2a, 0x1a, 0xf4, 0x86, 0x4d, 0x12,
+	0xfb, 0xc4, 0xa5, 0x8d, 0x7e, 0x02, 0xd0, 0x2f, 0x4c, 0x75, 0x36, 0xd9, 0xc1, 0x41, 0x54, 0x8c,
+	0xe8, 0x03, 0xc8, 0x10, 0xc7, 0x12, 0xc0, 0xb9, 0x44, 0x60, 0x9a, 0x38, 0x16, 0x87, 0xad, 0x42,
+	0xd6, 0x33, 0x5a, 0x44, 0x0f, 0xec, 0xcf, 0x84, 0xdb, 0xe6, 0x70, 0x86, 0x11, 0x1a, 0xf6, 0x67,
+	0x04, 0xdd, 0x87, 0x2b, 0xcc, 0x61, 0x3a, 0xe7, 0xa0, 0xee, 0x09, 0x71, 0xb8, 0x5b, 0x16, 0xf0,
+	0x22, 0x23, 0xd7, 0x8d, 0x16, 0x69, 0x32, 0xa2, 0xf6, 0xb9, 0x02, 0xab, 0xb1, 0xee, 0x91, 0xe9,
+	0x58, 0x82, 0x34, 0x11, 0x24, 0x55, 0xd9, 0x98, 0xd9, 0xcc, 0x6d, 0xbf, 0x9b, 0x1c, 0x19, 0x9e,
+	0x70, 0x38, 0xc2, 0xc5, 0xa9, 0x92, 0x8a, 0x53, 0xe5, 0x5f, 0xb3, 0xf0, 0xce, 0x0b, 0x71, 0x18,
+	0xb0, 0x26, 0x58, 0xd9, 0xf9, 0xde, 0x2e, 0x09, 0x02, 0xa3, 0x45, 0xd0, 0x1a, 0x40, 0x47, 0xfc,
+	0x64, 0xcd, 0x95, 0x05, 0x6a, 0x06, 0x67, 0x25, 0xa5, 0x66, 0xb1, 0xa8, 0xb0, 0x83, 0xc2, 0x62,
+	0x1f, 0x53, 0xdc, 0x0f, 0x69, 0xbe, 0xae, 0x59, 0xcc, 0x47, 0x22, 0xa0, 0xfd, 0xae, 0x9c, 0x11,
+	0x84, 0x9a, 0x35, 0xe1, 0x2c, 0x98, 0x7d, 0xdb, 0xb3, 0x00, 0xc3, 0x22, 0x6f, 0xf5, 0xa6, 0x41,
+	0x49, 0xcb, 0xf5, 0xcf, 0x79, 0x4c, 0x97, 0xb6, 0x1f, 0x4c, 0x76, 0xdc, 0x80, 0xd5, 0x65, 0x09,
+	0xc2, 0x0b, 0x74, 0x60, 0xc5, 0xec, 0xe0, 0x32, 0xe9, 0xb9, 0xd7, 0x8b, 0x35, 0x23, 0x34, 0xcf,
+	0x3d, 0x82, 0x6e, 0x40, 0x9a, 0x7f, 0xb4, 0x2d, 0x1e, 0xe3, 0x19, 0x3c, 0xcf, 0x96, 0x35, 0x0b,
+	0x95, 0xe1, 0x4a, 0xd7, 0x0e, 0xec, 0x43, 0xbb, 0xcd, 0xce, 0x25, 0x9e, 0x5f, 0x99, 0xc4, 0xfc,
+	0x5a, 0xea, 0x43, 0x78, 0x9a, 0xa9, 0x90, 0x96, 0xed, 0x8e, 0x37, 0xcd, 0x39, 0x1c, 0x2d, 0xd1,
+	0x0b, 0x40, 0x47, 0xb6, 0x1f, 0xf4, 0xda, 0xa1, 0xd8, 0x01, 0x12, 0x77, 0xc8, 0x73, 0x94, 0x6c,
+	0x97, 0x7c, 0x8f, 0x8f, 0x60, 0x91, 0x38, 0xaf, 0x42, 0x12, 0x12, 0x59, 0x06, 0xb9, 0x44, 0x21,
+	0x0b, 0x11, 0x80, 0x0b, 0x58, 0x03, 0x68, 0x1b, 0x01, 0xd5, 0x45, 0x03, 0x58, 0xe0, 0x81, 0xce,
+	0x32, 0x4a, 0x95, 0x11, 0x7a, 0xee, 0xb3, 0x9d, 0x23, 0x57, 0x5d, 0x14, 0x69, 0xc0, 0x7d, 0xe4,
+	0x1c, 0xb9, 0xda, 0x3f, 0x14, 0xb8, 0x8d, 0x89, 0x61, 0xc5, 0xe6, 0x5e, 0xaf, 0x51, 0x0c, 0x26,
+	0x99, 0x32, 0x9c, 0x64, 0xfd, 0x1e, 0x92, 0x1a, 0xea, 0x21, 0x4d, 0x50, 0x6d, 0xc7, 0x6c, 0x87,
+	0x81, 0xdd, 0x25, 0x3a, 0xab, 0xf0, 0x81, 0x24, 0x9e, 0xe1, 0x06, 0xae, 0x8e, 0x19, 0x58, 0x73,
+	0xe8, 0xa3, 0xf7, 0x5f, 0x1a, 0xed, 0x90, 0xe0, 0x77, 0x7a, 0xe0, 0xaa, 0x63, 0xed, 0xf6, 0xb2,
+	0x7d, 0xa8, 0xec, 0x67, 0x93, 0xcb, 0x7e, 0x2e, 0xae, 0xd6, 0x7e, 0xa9, 0x80, 0x36, 0xcd, 0x66,
+	0x59, 0xfd, 0x1f, 0x43, 0x46, 0xea, 0x1c, 0x95, 0xff, 0xd6, 0x85, 0xb2, 0xb8, 0x2f, 0x0b, 0xf7,
+	0x04, 0x5c, 0xb8, 0x0f, 0xfc, 0x10, 0xee, 0x56, 0x48, 0x60, 0xfa, 0xf6, 0x21, 0x89, 0x17, 0x99,
+	0x1c, 0x91, 0xe1, 0x86, 0x91, 0x1a, 0x69, 0x18, 0x9a, 0x0f, 0xf7, 0x12, 0x76, 0x90, 0xf6, 0xd7,
+	0x20, 0x2d, 0x51, 0xf2, 0xc8, 0xbc, 0xb4, 0xf9, 0x11, 0x5e, 0xfb, 0xa7, 0x02, 0xda, 0x2e, 0xf1,
+	0x5b, 0xe4, 0xff, 0x29, 0xcd, 0x76, 0xe1, 0xce, 0x54, 0x9b, 0xa5, 0x9b, 0x63, 0xc4, 0x29, 0x71,
+	0xe2, 0x7e, 0xaf, 0x80, 0x56, 0x0f, 0xff, 0x67, 0x7c, 0xa8, 0xdd, 0x83, 0x3b, 0xf5, 0x30, 0xd1,
+	0x7c, 0xad, 0x0e, 0xd7, 0x2a, 0xa4, 0x4d, 0x28, 0xa9, 0x70, 0x65, 0x22, 0x33, 0x10, 0xcc, 0xf2,
+	0x41, 0x43, 0x0c, 0x26, 0xfc, 0x37, 0x9b, 0x40, 0x03, 0x62, 0x86, 0x3e, 0xef, 0xe7, 0xbd, 0x12,
+	0xca, 0xe2, 0xc5, 0x88, 0x2a, 0x1c, 0xd5, 0x81, 0xe5, 0x61, 0x89, 0xd2, 0xd1, 0xf1, 0x27, 0x9e,
+	0xf2, 0x96, 0x27, 0x9e, 0xf6, 0xef, 0x14, 0x5c, 0xc7, 0xc4, 0x6b, 0xdb, 0x26, 0x1f, 0xbf, 0x1b,
+	0xcc, 0xd9, 0x0d, 0x6a, 0xd0, 0x30, 0x98, 0x16, 0x0b, 0x3e, 0x4d, 0x77, 0x5c, 0x4a, 0x74, 0xe6,
+	0x3b, 0x4a, 0xa2, 0x59, 0x6b, 0x51, 0x50, 0xcb, 0x82, 0xc8, 0x6a, 0xd9, 0x27, 0x86, 0xa5, 0xb7,
+	0x49, 0x97, 0xb4, 0x79, 0x30, 0x66, 0x70, 0x96, 0x51, 0x76, 0x18, 0x41, 0xdc, 0xbc, 0x4e, 0x48,
+	0xf4, 0x7d, 0x96, 0x7f, 0x07, 0x4e, 0x12, 0x0c, 0x77, 0x61, 0xa9, 0x63, 0x9c, 0xe9, 0x03, 0x32,
+	0xe6, 0x38, 0xcf, 0x42, 0xc7, 0x38, 0xc3, 0x3d, 0x31, 0x3b, 0xb0, 0xcc, 0x0f, 0x10, 0x5f, 0x9a,
+	0x11, 0x1d, 0x44, 0xf3, 0x89, 0x07, 0x11, 0x62, 0x38, 0xdc, 0x83, 0xb1, 0x0f, 0xcc, 0x6a, 0xab,
+	0xfd, 0x4a, 0xd4, 0x8e, 0x38, 0x92, 0xd3, 0x56, 0xfb, 0x15, 0x2f, 0x9d, 0x3a, 0xdc, 0x70, 0xdb,
+	0x16, 0x09, 0xa8, 0xee, 0x89, 0x09, 0x5e, 0xe7, 0x27, 0x93, 0xd1, 0x8a, 0xce, 0xe6, 0x29, 0xd7,
+	0x9a, 0x65, 0x81, 0x94, 0xa3, 0x3f, 0x4b, 0xa7, 0x52, 0x8b, 0x68, 0x5f, 0xa4, 0x40, 0x1d, 0xf0,
+	0xbe, 0xf4, 0x9b, 0xf4, 0xff, 0xb8, 0x93, 0x95, 0x38, 0x27, 0xaf, 0x43, 0x4e, 0x84, 0xc9, 0x74,
+	0x43, 0x87, 0xca, 0x29, 0x0a, 0x38, 0xa9, 0xcc, 0x28, 0xcc, 0x22, 0x71, 0x7f, 0x35, 0x5a, 0x32,
+	0x06, 0x7c, 0xe6, 0xd8, 0x31, 0x5a, 0x13, 0x5d, 0x37, 0xfb, 0xd6, 0xae, 0x9b, 0xbb, 0xb0, 0xeb,
+	0xe6, 0xdf, 0xcc, 0x75, 0x9f, 0xc2, 0xea, 0x73, 0x42, 0x07, 0x53, 0x97, 0x7b, 0x2d, 0xaa, 0xc0,
+	0x02, 0x64, 0xa4, 0xd7, 0xc4, 0xf1, 0x97, 0xc5, 0xbd, 0x35, 0xf3, 0xd8, 0x91, 0xeb, 0x9b, 0x44,
+	0x3f, 0x22, 0xd4, 0x3c, 0xe6, 0x1e, 0xcb, 0x60, 0xe0, 0xa4, 0x67, 0x8c, 0xa2, 0x7d, 0xa1, 0xc0,
+	0xcd, 0x78, 0xe1, 0xb2, 0x18, 0xf7, 0x46, 0xa4, 0xe7, 0xb6, 0xb7, 0x27, 0x9f, 0x2e, 0x93, 0x02,
+	0x3c, 0xa0, 0xd1, 0x0b, 0x98, 0xe7, 0x01, 0x0b, 0xd4, 0x14, 0x97, 0xf6, 0xf5, 0x0b, 0x49, 0x1b,
+	0x28, 0x56, 0x2c, 0xf1, 0xda, 0x09, 0x5c, 0xc3, 0x84, 0xf5, 0x9b, 0xe4, 0x86, 0xb4, 0x02, 0x19,
+	0x87, 0x9c, 0x8a, 0x1b, 0x91, 0x28, 0xdf, 0xb4, 0x43, 0x4e, 0xf7, 0xe2, 0x7b, 0xd5, 0x4c, 0x5c,
+	0xaf, 0xfa, 0x99, 0x02, 0xcb, 0xc3, 0xbb, 0x49, 0xff, 0x0c, 0xcd, 0xee, 0xca, 0xd8, 0xec, 0xbe,
+	0xe2, 0xf9, 0xa4, 0x6b, 0xbb, 0x61, 0xc0, 0x37, 0xd7, 0xc9, 0x99, 0x67, 0xfb, 0x72, 0xc8, 0x4d,
+	0x25, 0x66, 0xde, 0xf5, 0x08, 0xcc, 0x34, 0xad, 0x72, 0x28, 0xfb, 0xa8, 0xfd, 0x51, 0x81, 0x77,
+	0xa3, 0xd1, 0x20, 0x6a, 0x7d, 0x2f, 0x89, 0x1f, 0xd8, 0xae, 0x23, 0x9a, 0xf8, 0x05, 0xae, 0x8e,
+	0x5f, 0xd2, 0x13, 0x93, 0x0a, 0xe9, 0xa8, 0x84, 0xe5, 0xd5, 0x53, 0x2e, 0xd9, 0x68, 0xb1, 0x99,
+	0xac, 0xb4, 0xf4, 0xea, 0x80, 0x18, 0x65, 0x48, 0xcc, 0x97, 0xa5, 0xf7, 0x01, 0x5c, 0xed, 0x0a,
+	0x65, 0xf4, 0xe3, 0x48, 0x1b, 0x75, 0x26, 0xee, 0x65, 0x43, 0xbc, 0xf6, 0x31, 0xc1, 0x63, 0xda,
+	0xe7, 0xbb, 0x23, 0x14, 0xed, 0x2f, 0x0a, 0xdc, 0xc4, 0xe4, 0x30, 0xb4, 0xdb, 0x56, 0xa4, 0xc6,
+	0x53, 0xdf, 0x70, 0xcc, 0xe3, 0xff, 0x52, 0x78, 0x6e, 0xc3, 0xc2, 0x21, 0xdf, 0x7f, 0x20, 0xd7,
+	0x17, 0x70, 0x4e, 0xd0, 0x78, 0xa6, 0x0f, 0xba, 0x7e, 0x76, 0x38, 0x82, 0xeb, 0xb0, 0x36, 0xc1,
+	0x16, 0x39, 0x22, 0xfc, 0x5d, 0x81, 0x1b, 0x51, 0x88, 0x7b, 0x2f, 0x84, 0x09, 0x86, 0x0e, 0x3d,
+	0x39, 0xa6, 0x2e, 0xf7, 0xe4, 0xf8, 0x1c, 0x96, 0x7a, 0x58, 0x71, 0xe9, 0x9c, 0xe1, 0x97, 0xd8,
+	0xdb, 0x53, 0x05, 0xb0, 0xdb, 0xa8, 0xb8, 0xb8, 0x46, 0x2b, 0xf4, 0x58, 0x0e, 0x56, 0x16, 0xd1,
+	0xfb, 0x02, 0x03, 0xde, 0x6e, 0xb8, 0x13, 0x32, 0x72, 0x76, 0xb2, 0x7a, 0x66, 0x89, 0x5e, 0xa4,
+	0xfd, 0x61, 0x06, 0xd4, 0x71, 0x8b, 0x65, 0x12, 0x3f, 0x81, 0xb4, 0xe7, 0xb6, 0xdb, 0xfd, 0xce,
+	0xb9, 0x1e, 0xff, 0x46, 0xc6, 0x79, 0xf8, 0xd3, 0x58, 0xc4, 0x8f, 0x76, 0x21, 0x3f, 0xa6, 0x88,
+	0x70, 0xce, 0x9d, 0xa9, 0xb6, 0xc9, 0x16, 0xb9, 0x44, 0x87, 0xd6, 0xe8, 0x13, 0xc8, 0x7b, 0x86,
+	0x4f, 0x6d, 0x96, 0x03, 0xba, 0xe9, 0x3a, 0x47, 0x76, 0x4b, 0x26, 0xf7, 0xd7, 0xa6, 0x8a, 0xab,
+	0x47, 0xa0, 0x32, 0xc7, 0xe0, 0x2b, 0xde, 0x30, 0x01, 0x35, 0x61, 0xc9, 0xb2, 0x03, 0xcf, 0xa0,
+	0xe6, 0x31, 0x57, 0x33, 0x90, 0xe7, 0xe9, 0x83, 0x49, 0x35, 0x13, 0x49, 0xae, 0x48, 0x14, 0x53,
+	0x30, 0xc0, 0x8b, 0xd6, 0xe0, 0x12, 0xfd, 0x00, 0xae, 0x06, 0xa6, 0xd1, 0x66, 0x67, 0xa7, 0x45,
+	0x4c, 0x9b, 0x95, 0x54, 0xa0, 0xce, 0xc5, 0xdd, 0xec, 0xc6, 0x05, 0x37, 0x04, 0xb0, 0x22, 0x71,
+	0x38, 0x1f, 0x0c, 0x13, 0x82, 0xf7, 0x7e, 0xae, 0xc0, 0x95, 0x91, 0xe7, 0x39, 0xb4, 0x06, 0x2b,
+	0xa5, 0x83, 0x4a, 0xad, 0xa9, 0xef, 0xec, 0x3f, 0xd7, 0xf7, 0x0f, 0x9a, 0xe5, 0xfd, 0xdd, 0xaa,
+	0x5e, 0xdb, 0x7b, 0x59, 0xda, 0xa9, 0x55, 0xf2, 0x5f, 0x89, 0xff, 0xdc, 0x38, 0x28, 0x97, 0xab,
+	0x8d, 0x46, 0x5e, 0x41, 0x37, 0x41, 0x1d, 0xff, 0x5c, 0xa9, 0xee, 0xd5, 0xaa, 0x95, 0x7c, 0x2a,
+	0xfe, 0xeb, 0xb3, 0x52, 0x6d, 0xa7, 0x5a, 0xc9, 0xcf, 0xbc, 0xf7, 0x23, 0xb8, 0x16, 0xf3, 0xb0,
+	0x82, 0x6e, 0xc3, 0xda, 0x8b, 0x5a, 0xa3, 0xb9, 0x8f, 0xbf, 0xaf, 0x37, 0x4b, 0x8d, 0x8f, 0xf5,
+	0x72, 0xa9, 0x59, 0x7d, 0xce, 0x56, 0x7d, 0xa5, 0x34, 0xb8, 0x15, 0xcf, 0xd2, 0xc4, 0xa5, 0xbd,
+	0xc6, 0xb3, 0x2a, 0xce, 0x2b, 0x68, 0x1d, 0x56, 0x27, 0xf0, 0xd4, 0x76, 0xab, 0x38, 0x9f, 0xda,
+	0xfe, 0xdd, 0x22, 0xe4, 0x4a, 0xec, 0xcc, 0xad, 0x9e, 0xd1, 0x52, 0xbd, 0x86, 0x3e, 0x57, 0xe0,
+	0x9d, 0xd8, 0xa7, 0x5f, 0xf4, 0x68, 0xf2, 0x41, 0x3d, 0xed, 0x6f, 0x1c, 0x85, 0xc7, 0x97, 0xc6,
+	0xc9, 0xf2, 0xf9, 0xb1, 0x02, 0xd7, 0x62, 0x1e, 0xfd, 0xd0, 0xfb, 0x93, 0x05, 0x4e, 0x7e, 0x42,
+	0x2d, 0x7c, 0x70, 0x49, 0x94, 0x54, 0xe2, 0x17, 0x0a, 0x14, 0x26, 0x3f, 0x41, 0xa0, 0x0f, 0xa7,
+	0x4d, 0x2f, 0x09, 0x8f, 0x35, 0x85, 0x6f, 0xbf, 0x19, 0x58, 0x6a, 0xf6, 0x1b, 0x05, 0xd6, 0xa6,
+	0xbe, 0x0f, 0xa0, 0xef, 0x4e, 0x96, 0x7f, 0x91, 0xa7, 0x8b, 0xc2, 0x47, 0x6f, 0x8c, 0x97, 0x2a,
+	0xfe, 0x4a, 0x81, 0xd5, 0x29, 0x37, 0x6b, 0x34, 0xc5, 0x01, 0xc9, 0x8f, 0x10, 0x85, 0xef, 0xbc,
+	0x21, 0x7a, 0x40, 0xb9, 0x7a, 0xf8, 0x46, 0xca, 0xd5, 0xc3, 0xb7, 0x51, 0xee, 0x02, 0x97, 0x6d,
+	0xd4, 0x81, 0x85, 0xc1, 0xab, 0x31, 0x7a, 0x30, 0x2d, 0x14, 0x63, 0x97, 0xf2, 0x42, 0xf1, 0xa2,
+	0xec, 0x72, 0xbb, 0x9f, 0x28, 0xb0, 0x1c, 0x77, 0x0b, 0x40, 0x53, 0xaa, 0x66, 0xca, 0x95, 0xa4,
+	0xf0, 0xe8, 0xb2, 0xb0, 0xbe, 0xd9, 0x83, 0x43, 0xf6, 0x34, 0xb3, 0x63, 0x46, 0xff, 0x42, 0xf1,
+	0xa2, 0xec, 0x72, 0xbb, 0xdf, 0x2a, 0xb0, 0x91, 0x34, 0x92, 0xa2, 0x52, 0x72, 0x15, 0x24, 0xcc,
+	0xe0, 0x85, 0xa7, 0x6f, 0x23, 0x42, 0xea, 0xca, 0x1a, 0x73, 0xec, 0xf4, 0x35, 0xad, 0x31, 0x4f,
+	0x1b, 0x3d, 0x0b, 0x8f, 0x2f, 0x8d, 0x93, 0xaa, 0x9c, 0x43, 0x7e, 0x74, 0xe6, 0x41, 0x0f, 0x93,
+	0x4d, 0x1c, 0x99, 0x08, 0x0b, 0xdb, 0x97, 0x81, 0x88, 0xad, 0x9f, 0x3e, 0xff, 0xf3, 0xeb, 0x5b,
+	0xca, 0x5f, 0x5f, 0xdf, 0x52, 0xfe, 0xf6, 0xfa, 0x96, 0xf2, 0xe9, 0x93, 0x96, 0x4d, 0x8f, 0xc3,
+	0xc3, 0xa2, 0xe9, 0x76, 0xb6, 0x86, 0xfe, 0x22, 0x5f, 0x6c, 0x11, 0x47, 0xfc, 0x33, 0xc1, 0xe0,
+	0xff, 0x33, 0x7c, 0x18, 0xfd, 0xee, 0x3e, 0x3c, 0x9c, 0xe7, 0x5f, 0xbf, 0xf1, 0x9f, 0x01, 0x00,
+	0x65, 0xc7, 0x45, 0x9a, 0xfd, 0x20, 0x00, 0x00,
+}
+
+func (m *UpdateActivityOptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateActivityOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateActivityOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ResetAttempts {
+		i--
+		if m.ResetAttempts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.HeartbeatTimeout != nil {
+		{
+			size, err := m.HeartbeatTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StartToCloseTimeout != nil {
+		{
+			size, err := m.StartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		{
+			size, err := m.ScheduleToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ScheduleToStartTimeout != nil {
+		{
+			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateActivityOptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateActivityOptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateActivityOptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Activity != nil {
+		{
+			size, err := m.Activity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Request) > 0 {
+		i -= len(m.Request)
+		copy(dAtA[i:], m.Request)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Request)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApiName) > 0 {
+		i -= len(m.ApiName)
+		copy(dAtA[i:], m.ApiName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ApiName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAuditLogEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuditLogEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuditLogEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApiName) > 0 {
+		i -= len(m.ApiName)
+		copy(dAtA[i:], m.ApiName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ApiName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAuditLogEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuditLogEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuditLogEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryTaskDLQMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryTaskDLQMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryTaskDLQMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskInfo) > 0 {
+		i -= len(m.TaskInfo)
+		copy(dAtA[i:], m.TaskInfo)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TaskInfo)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintService(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.EnqueuedTime != nil {
+		{
+			size, err := m.EnqueuedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.FirstAttemptTime != nil {
+		{
+			size, err := m.FirstAttemptTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.VisibilityTime != nil {
+		{
+			size, err := m.VisibilityTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TaskCategory != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskCategory))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MessageId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MessageId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadHistoryTaskDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadHistoryTaskDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadHistoryTaskDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadHistoryTaskDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadHistoryTaskDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadHistoryTaskDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeHistoryTaskDLQMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryTaskDLQMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryTaskDLQMessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MessageId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MessageId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeHistoryTaskDLQMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryTaskDLQMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryTaskDLQMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeHistoryTaskDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeHistoryTaskDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeHistoryTaskDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeHistoryTaskDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeHistoryTaskDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeHistoryTaskDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeHistoryTaskDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeHistoryTaskDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeHistoryTaskDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeHistoryTaskDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeHistoryTaskDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeHistoryTaskDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteDomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SecurityToken) > 0 {
+		i -= len(m.SecurityToken)
+		copy(dAtA[i:], m.SecurityToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SecurityToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationShardStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationShardStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OldestPendingTaskAge != nil {
+		{
+			size, err := m.OldestPendingTaskAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DlqSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastReplicatedTime != nil {
+		{
+			size, err := m.LastReplicatedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxReadLevel != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxReadLevel))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AckedLevel != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.AckedLevel))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadLevel != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ReadLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RemoteCluster) > 0 {
+		i -= len(m.RemoteCluster)
+		copy(dAtA[i:], m.RemoteCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RemoteCluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationClusterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationClusterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OldestPendingTaskAge != nil {
+		{
+			size, err := m.OldestPendingTaskAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DlqSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastReplicatedTime != nil {
+		{
+			size, err := m.LastReplicatedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskLag != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskLag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ShardCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RemoteCluster) > 0 {
+		i -= len(m.RemoteCluster)
+		copy(dAtA[i:], m.RemoteCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RemoteCluster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ForceFetch {
+		i--
+		if m.ForceFetch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenameDomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SecurityToken) > 0 {
+		i -= len(m.SecurityToken)
+		copy(dAtA[i:], m.SecurityToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SecurityToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenameDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PreviousNameExpiryTime != nil {
+		{
+			size, err := m.PreviousNameExpiryTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowVersionHistoriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowVersionHistoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowVersionHistoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowVersionHistoriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowVersionHistoriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowVersionHistoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.VersionHistories != nil {
+		{
+			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebuildWorkflowBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebuildWorkflowBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebuildWorkflowBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebuildWorkflowBranchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebuildWorkflowBranchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebuildWorkflowBranchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeTaskListStatus {
+		i--
+		if m.IncludeTaskListStatus {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ScalingDecisions) > 0 {
+		for iNdEx := len(m.ScalingDecisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScalingDecisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DispatchStats != nil {
+		{
+			size, err := m.DispatchStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskListStatus != nil {
+		{
+			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pollers) > 0 {
+		for iNdEx := len(m.Pollers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pollers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateActivityOptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = m.ScheduleToStartTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = m.ScheduleToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = m.StartToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = m.HeartbeatTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ResetAttempts {
+		n += 2
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateActivityOptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Activity != nil {
+		l = m.Activity.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuditLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ApiName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovService(uint64(m.Outcome))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAuditLogEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ApiName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAuditLogEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoryTaskDLQMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageId != 0 {
+		n += 1 + sovService(uint64(m.MessageId))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskCategory != 0 {
+		n += 1 + sovService(uint64(m.TaskCategory))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovService(uint64(m.TaskType))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovService(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = m.VisibilityTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.FirstAttemptTime != nil {
+		l = m.FirstAttemptTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EnqueuedTime != nil {
+		l = m.EnqueuedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.TaskInfo)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadHistoryTaskDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadHistoryTaskDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeHistoryTaskDLQMessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.MessageId != 0 {
+		n += 1 + sovService(uint64(m.MessageId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeHistoryTaskDLQMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeHistoryTaskDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeHistoryTaskDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeHistoryTaskDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeHistoryTaskDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.SecurityToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationShardStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.RemoteCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ReadLevel != 0 {
+		n += 1 + sovService(uint64(m.ReadLevel))
+	}
+	if m.AckedLevel != 0 {
+		n += 1 + sovService(uint64(m.AckedLevel))
+	}
+	if m.MaxReadLevel != 0 {
+		n += 1 + sovService(uint64(m.MaxReadLevel))
+	}
+	if m.LastReplicatedTime != nil {
+		l = m.LastReplicatedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovService(uint64(m.DlqSize))
+	}
+	if m.OldestPendingTaskAge != nil {
+		l = m.OldestPendingTaskAge.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationClusterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RemoteCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ShardCount != 0 {
+		n += 1 + sovService(uint64(m.ShardCount))
+	}
+	if m.TaskLag != 0 {
+		n += 1 + sovService(uint64(m.TaskLag))
+	}
+	if m.LastReplicatedTime != nil {
+		l = m.LastReplicatedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovService(uint64(m.DlqSize))
+	}
+	if m.OldestPendingTaskAge != nil {
+		l = m.OldestPendingTaskAge.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.ForceFetch {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenameDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.SecurityToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenameDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PreviousNameExpiryTime != nil {
+		l = m.PreviousNameExpiryTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowVersionHistoriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowVersionHistoriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.VersionHistories != nil {
+		l = m.VersionHistories.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RebuildWorkflowBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RebuildWorkflowBranchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeTaskListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.IncludeTaskListStatus {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeTaskListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pollers) > 0 {
+		for _, e := range m.Pollers {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.TaskListStatus != nil {
+		l = m.TaskListStatus.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.DispatchStats != nil {
+		l = m.DispatchStats.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.ScalingDecisions) > 0 {
+		for _, e := range m.ScalingDecisions {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateActivityOptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = &types.Duration{}
+			}
+			if err := m.HeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &v1.RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetAttempts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetAttempts = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateActivityOptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Activity == nil {
+				m.Activity = &v1.PendingActivityInfo{}
+			}
+			if err := m.Activity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditLogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditLogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= AuditLogOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAuditLogEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAuditLogEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAuditLogEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAuditLogEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAuditLogEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAuditLogEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &AuditLogEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryTaskDLQMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryTaskDLQMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryTaskDLQMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			m.MessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskCategory", wireType)
+			}
+			m.TaskCategory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskCategory |= HistoryTaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = &types.Timestamp{}
+			}
+			if err := m.VisibilityTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstAttemptTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstAttemptTime == nil {
+				m.FirstAttemptTime = &types.Timestamp{}
+			}
+			if err := m.FirstAttemptTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueuedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueuedTime == nil {
+				m.EnqueuedTime = &types.Timestamp{}
+			}
+			if err := m.EnqueuedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadHistoryTaskDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadHistoryTaskDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadHistoryTaskDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
+			}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadHistoryTaskDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadHistoryTaskDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadHistoryTaskDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &HistoryTaskDLQMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryTaskDLQMessageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryTaskDLQMessageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryTaskDLQMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			m.MessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryTaskDLQMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryTaskDLQMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryTaskDLQMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &HistoryTaskDLQMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeHistoryTaskDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeHistoryTaskDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeHistoryTaskDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
+			}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeHistoryTaskDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeHistoryTaskDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeHistoryTaskDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeHistoryTaskDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeHistoryTaskDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeHistoryTaskDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
+			}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeHistoryTaskDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeHistoryTaskDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeHistoryTaskDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationShardStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationShardStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationShardStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadLevel", wireType)
+			}
+			m.ReadLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedLevel", wireType)
+			}
+			m.AckedLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckedLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReadLevel", wireType)
+			}
+			m.MaxReadLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReadLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplicatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastReplicatedTime == nil {
+				m.LastReplicatedTime = &types.Timestamp{}
+			}
+			if err := m.LastReplicatedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
+			}
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingTaskAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestPendingTaskAge == nil {
+				m.OldestPendingTaskAge = &types.Duration{}
+			}
+			if err := m.OldestPendingTaskAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationClusterStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationClusterStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationClusterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardCount", wireType)
+			}
+			m.ShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskLag", wireType)
+			}
+			m.TaskLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplicatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastReplicatedTime == nil {
+				m.LastReplicatedTime = &types.Timestamp{}
+			}
+			if err := m.LastReplicatedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
+			}
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingTaskAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestPendingTaskAge == nil {
+				m.OldestPendingTaskAge = &types.Duration{}
+			}
+			if err := m.OldestPendingTaskAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceFetch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceFetch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, &ReplicationClusterStatus{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &ReplicationShardStatus{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenameDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenameDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenameDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenameDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenameDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenameDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousNameExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousNameExpiryTime == nil {
+				m.PreviousNameExpiryTime = &types.Timestamp{}
+			}
+			if err := m.PreviousNameExpiryTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeWorkflowVersionHistoriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowVersionHistoriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowVersionHistoriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeWorkflowVersionHistoriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowVersionHistoriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowVersionHistoriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v11.VersionHistories{}
+			}
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RebuildWorkflowBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebuildWorkflowBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebuildWorkflowBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RebuildWorkflowBranchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebuildWorkflowBranchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebuildWorkflowBranchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTaskListStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeTaskListStatus = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pollers = append(m.Pollers, &v1.PollerInfo{})
+			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskListStatus == nil {
+				m.TaskListStatus = &v1.TaskListStatus{}
+			}
+			if err := m.TaskListStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v1.TaskListPartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DispatchStats == nil {
+				m.DispatchStats = &v11.TaskListDispatchStats{}
+			}
+			if err := m.DispatchStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingDecisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScalingDecisions = append(m.ScalingDecisions, &v11.TaskListScalingDecision{})
+			if err := m.ScalingDecisions[len(m.ScalingDecisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipService(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthService
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupService
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthService
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthService        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowService          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupService = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1611 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/adminext/v1/service.proto
+
+package adminextv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// AdminExtAPIYARPCClient is the YARPC client-side interface for the AdminExtAPI service.
+type AdminExtAPIYARPCClient interface {
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest, ...yarpc.CallOption) (*UpdateActivityOptionsResponse, error)
+	ListAuditLogEntries(context.Context, *ListAuditLogEntriesRequest, ...yarpc.CallOption) (*ListAuditLogEntriesResponse, error)
+	ReadHistoryTaskDLQMessages(context.Context, *ReadHistoryTaskDLQMessagesRequest, ...yarpc.CallOption) (*ReadHistoryTaskDLQMessagesResponse, error)
+	DescribeHistoryTaskDLQMessage(context.Context, *DescribeHistoryTaskDLQMessageRequest, ...yarpc.CallOption) (*DescribeHistoryTaskDLQMessageResponse, error)
+	MergeHistoryTaskDLQMessages(context.Context, *MergeHistoryTaskDLQMessagesRequest, ...yarpc.CallOption) (*MergeHistoryTaskDLQMessagesResponse, error)
+	PurgeHistoryTaskDLQMessages(context.Context, *PurgeHistoryTaskDLQMessagesRequest, ...yarpc.CallOption) (*PurgeHistoryTaskDLQMessagesResponse, error)
+	DeleteDomain(context.Context, *DeleteDomainRequest, ...yarpc.CallOption) (*DeleteDomainResponse, error)
+	GetReplicationStatus(context.Context, *GetReplicationStatusRequest, ...yarpc.CallOption) (*GetReplicationStatusResponse, error)
+	RenameDomain(context.Context, *RenameDomainRequest, ...yarpc.CallOption) (*RenameDomainResponse, error)
+	DescribeWorkflowVersionHistories(context.Context, *DescribeWorkflowVersionHistoriesRequest, ...yarpc.CallOption) (*DescribeWorkflowVersionHistoriesResponse, error)
+	RebuildWorkflowBranch(context.Context, *RebuildWorkflowBranchRequest, ...yarpc.CallOption) (*RebuildWorkflowBranchResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
+}
+
+func newAdminExtAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) AdminExtAPIYARPCClient {
+	return &_AdminExtAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.adminext.v1.AdminExtAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewAdminExtAPIYARPCClient builds a new YARPC client for the AdminExtAPI service.
+func NewAdminExtAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) AdminExtAPIYARPCClient {
+	return newAdminExtAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// AdminExtAPIYARPCServer is the YARPC server-side interface for the AdminExtAPI service.
+type AdminExtAPIYARPCServer interface {
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error)
+	ListAuditLogEntries(context.Context, *ListAuditLogEntriesRequest) (*ListAuditLogEntriesResponse, error)
+	ReadHistoryTaskDLQMessages(context.Context, *ReadHistoryTaskDLQMessagesRequest) (*ReadHistoryTaskDLQMessagesResponse, error)
+	DescribeHistoryTaskDLQMessage(context.Context, *DescribeHistoryTaskDLQMessageRequest) (*DescribeHistoryTaskDLQMessageResponse, error)
+	MergeHistoryTaskDLQMessages(context.Context, *MergeHistoryTaskDLQMessagesRequest) (*MergeHistoryTaskDLQMessagesResponse, error)
+	PurgeHistoryTaskDLQMessages(context.Context, *PurgeHistoryTaskDLQMessagesRequest) (*PurgeHistoryTaskDLQMessagesResponse, error)
+	DeleteDomain(context.Context, *DeleteDomainRequest) (*DeleteDomainResponse, error)
+	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
+	RenameDomain(context.Context, *RenameDomainRequest) (*RenameDomainResponse, error)
+	DescribeWorkflowVersionHistories(context.Context, *DescribeWorkflowVersionHistoriesRequest) (*DescribeWorkflowVersionHistoriesResponse, error)
+	RebuildWorkflowBranch(context.Context, *RebuildWorkflowBranchRequest) (*RebuildWorkflowBranchResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
+}
+
+type buildAdminExtAPIYARPCProceduresParams struct {
+	Server      AdminExtAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildAdminExtAPIYARPCProcedures(params buildAdminExtAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_AdminExtAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.adminext.v1.AdminExtAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "UpdateActivityOptions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateActivityOptions,
+							NewRequest:  newAdminExtAPIServiceUpdateActivityOptionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListAuditLogEntries",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListAuditLogEntries,
+							NewRequest:  newAdminExtAPIServiceListAuditLogEntriesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ReadHistoryTaskDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ReadHistoryTaskDLQMessages,
+							NewRequest:  newAdminExtAPIServiceReadHistoryTaskDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeHistoryTaskDLQMessage",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeHistoryTaskDLQMessage,
+							NewRequest:  newAdminExtAPIServiceDescribeHistoryTaskDLQMessageYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MergeHistoryTaskDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MergeHistoryTaskDLQMessages,
+							NewRequest:  newAdminExtAPIServiceMergeHistoryTaskDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PurgeHistoryTaskDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PurgeHistoryTaskDLQMessages,
+							NewRequest:  newAdminExtAPIServicePurgeHistoryTaskDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeleteDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeleteDomain,
+							NewRequest:  newAdminExtAPIServiceDeleteDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetReplicationStatus",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetReplicationStatus,
+							NewRequest:  newAdminExtAPIServiceGetReplicationStatusYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RenameDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RenameDomain,
+							NewRequest:  newAdminExtAPIServiceRenameDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeWorkflowVersionHistories",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeWorkflowVersionHistories,
+							NewRequest:  newAdminExtAPIServiceDescribeWorkflowVersionHistoriesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RebuildWorkflowBranch",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RebuildWorkflowBranch,
+							NewRequest:  newAdminExtAPIServiceRebuildWorkflowBranchYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeTaskList,
+							NewRequest:  newAdminExtAPIServiceDescribeTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildAdminExtAPIYARPCProcedures prepares an implementation of the AdminExtAPI service for YARPC registration.
+func BuildAdminExtAPIYARPCProcedures(server AdminExtAPIYARPCServer) []transport.Procedure {
+	return buildAdminExtAPIYARPCProcedures(buildAdminExtAPIYARPCProceduresParams{Server: server})
+}
+
+// FxAdminExtAPIYARPCClientParams defines the input
+// for NewFxAdminExtAPIYARPCClient. It provides the
+// paramaters to get a AdminExtAPIYARPCClient in an
+// Fx application.
+type FxAdminExtAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxAdminExtAPIYARPCClientResult defines the output
+// of NewFxAdminExtAPIYARPCClient. It provides a
+// AdminExtAPIYARPCClient to an Fx application.
+type FxAdminExtAPIYARPCClientResult struct {
+	fx.Out
+
+	Client AdminExtAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxAdminExtAPIYARPCClient provides a AdminExtAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  adminextv1.NewFxAdminExtAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxAdminExtAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxAdminExtAPIYARPCClientParams) FxAdminExtAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxAdminExtAPIYARPCClientResult{
+			Client: newAdminExtAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxAdminExtAPIYARPCProceduresParams defines the input
+// for NewFxAdminExtAPIYARPCProcedures. It provides the
+// paramaters to get AdminExtAPIYARPCServer procedures in an
+// Fx application.
+type FxAdminExtAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      AdminExtAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxAdminExtAPIYARPCProceduresResult defines the output
+// of NewFxAdminExtAPIYARPCProcedures. It provides
+// AdminExtAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxAdminExtAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxAdminExtAPIYARPCProcedures provides AdminExtAPIYARPCServer procedures to an Fx application.
+// It expects a AdminExtAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  adminextv1.NewFxAdminExtAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxAdminExtAPIYARPCProcedures() interface{} {
+	return func(params FxAdminExtAPIYARPCProceduresParams) FxAdminExtAPIYARPCProceduresResult {
+		return FxAdminExtAPIYARPCProceduresResult{
+			Procedures: buildAdminExtAPIYARPCProcedures(buildAdminExtAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: AdminExtAPIReflectionMeta,
+		}
+	}
+}
+
+// AdminExtAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var AdminExtAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.adminext.v1.AdminExtAPI",
+	FileDescriptors: yarpcFileDescriptorClosurea38d8bd4ba4c870e,
+}
+
+type _AdminExtAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_AdminExtAPIYARPCCaller) UpdateActivityOptions(ctx context.Context, request *UpdateActivityOptionsRequest, options ...yarpc.CallOption) (*UpdateActivityOptionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateActivityOptions", request, newAdminExtAPIServiceUpdateActivityOptionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateActivityOptionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceUpdateActivityOptionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) ListAuditLogEntries(ctx context.Context, request *ListAuditLogEntriesRequest, options ...yarpc.CallOption) (*ListAuditLogEntriesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListAuditLogEntries", request, newAdminExtAPIServiceListAuditLogEntriesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListAuditLogEntriesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceListAuditLogEntriesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) ReadHistoryTaskDLQMessages(ctx context.Context, request *ReadHistoryTaskDLQMessagesRequest, options ...yarpc.CallOption) (*ReadHistoryTaskDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ReadHistoryTaskDLQMessages", request, newAdminExtAPIServiceReadHistoryTaskDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ReadHistoryTaskDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceReadHistoryTaskDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) DescribeHistoryTaskDLQMessage(ctx context.Context, request *DescribeHistoryTaskDLQMessageRequest, options ...yarpc.CallOption) (*DescribeHistoryTaskDLQMessageResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeHistoryTaskDLQMessage", request, newAdminExtAPIServiceDescribeHistoryTaskDLQMessageYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeHistoryTaskDLQMessageResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceDescribeHistoryTaskDLQMessageYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) MergeHistoryTaskDLQMessages(ctx context.Context, request *MergeHistoryTaskDLQMessagesRequest, options ...yarpc.CallOption) (*MergeHistoryTaskDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MergeHistoryTaskDLQMessages", request, newAdminExtAPIServiceMergeHistoryTaskDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MergeHistoryTaskDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceMergeHistoryTaskDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) PurgeHistoryTaskDLQMessages(ctx context.Context, request *PurgeHistoryTaskDLQMessagesRequest, options ...yarpc.CallOption) (*PurgeHistoryTaskDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PurgeHistoryTaskDLQMessages", request, newAdminExtAPIServicePurgeHistoryTaskDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PurgeHistoryTaskDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServicePurgeHistoryTaskDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) DeleteDomain(ctx context.Context, request *DeleteDomainRequest, options ...yarpc.CallOption) (*DeleteDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeleteDomain", request, newAdminExtAPIServiceDeleteDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeleteDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceDeleteDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) GetReplicationStatus(ctx context.Context, request *GetReplicationStatusRequest, options ...yarpc.CallOption) (*GetReplicationStatusResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetReplicationStatus", request, newAdminExtAPIServiceGetReplicationStatusYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetReplicationStatusResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceGetReplicationStatusYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) RenameDomain(ctx context.Context, request *RenameDomainRequest, options ...yarpc.CallOption) (*RenameDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RenameDomain", request, newAdminExtAPIServiceRenameDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RenameDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceRenameDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) DescribeWorkflowVersionHistories(ctx context.Context, request *DescribeWorkflowVersionHistoriesRequest, options ...yarpc.CallOption) (*DescribeWorkflowVersionHistoriesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowVersionHistories", request, newAdminExtAPIServiceDescribeWorkflowVersionHistoriesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeWorkflowVersionHistoriesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceDescribeWorkflowVersionHistoriesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) RebuildWorkflowBranch(ctx context.Context, request *RebuildWorkflowBranchRequest, options ...yarpc.CallOption) (*RebuildWorkflowBranchResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RebuildWorkflowBranch", request, newAdminExtAPIServiceRebuildWorkflowBranchYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RebuildWorkflowBranchResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceRebuildWorkflowBranchYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtAPIYARPCCaller) DescribeTaskList(ctx context.Context, request *DescribeTaskListRequest, options ...yarpc.CallOption) (*DescribeTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskList", request, newAdminExtAPIServiceDescribeTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtAPIServiceDescribeTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _AdminExtAPIYARPCHandler struct {
+	server AdminExtAPIYARPCServer
+}
+
+func (h *_AdminExtAPIYARPCHandler) UpdateActivityOptions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateActivityOptionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateActivityOptionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceUpdateActivityOptionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateActivityOptions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) ListAuditLogEntries(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListAuditLogEntriesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListAuditLogEntriesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceListAuditLogEntriesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListAuditLogEntries(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) ReadHistoryTaskDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ReadHistoryTaskDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ReadHistoryTaskDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceReadHistoryTaskDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ReadHistoryTaskDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) DescribeHistoryTaskDLQMessage(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeHistoryTaskDLQMessageRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeHistoryTaskDLQMessageRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceDescribeHistoryTaskDLQMessageYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeHistoryTaskDLQMessage(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) MergeHistoryTaskDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MergeHistoryTaskDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MergeHistoryTaskDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceMergeHistoryTaskDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MergeHistoryTaskDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) PurgeHistoryTaskDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PurgeHistoryTaskDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PurgeHistoryTaskDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServicePurgeHistoryTaskDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PurgeHistoryTaskDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) DeleteDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeleteDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeleteDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceDeleteDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeleteDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) GetReplicationStatus(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetReplicationStatusRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetReplicationStatusRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceGetReplicationStatusYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetReplicationStatus(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) RenameDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RenameDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RenameDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceRenameDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RenameDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) DescribeWorkflowVersionHistories(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeWorkflowVersionHistoriesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeWorkflowVersionHistoriesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceDescribeWorkflowVersionHistoriesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeWorkflowVersionHistories(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) RebuildWorkflowBranch(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RebuildWorkflowBranchRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RebuildWorkflowBranchRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceRebuildWorkflowBranchYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RebuildWorkflowBranch(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtAPIYARPCHandler) DescribeTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtAPIServiceDescribeTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newAdminExtAPIServiceUpdateActivityOptionsYARPCRequest() proto.Message {
+	return &UpdateActivityOptionsRequest{}
+}
+
+func newAdminExtAPIServiceUpdateActivityOptionsYARPCResponse() proto.Message {
+	return &UpdateActivityOptionsResponse{}
+}
+
+func newAdminExtAPIServiceListAuditLogEntriesYARPCRequest() proto.Message {
+	return &ListAuditLogEntriesRequest{}
+}
+
+func newAdminExtAPIServiceListAuditLogEntriesYARPCResponse() proto.Message {
+	return &ListAuditLogEntriesResponse{}
+}
+
+func newAdminExtAPIServiceReadHistoryTaskDLQMessagesYARPCRequest() proto.Message {
+	return &ReadHistoryTaskDLQMessagesRequest{}
+}
+
+func newAdminExtAPIServiceReadHistoryTaskDLQMessagesYARPCResponse() proto.Message {
+	return &ReadHistoryTaskDLQMessagesResponse{}
+}
+
+func newAdminExtAPIServiceDescribeHistoryTaskDLQMessageYARPCRequest() proto.Message {
+	return &DescribeHistoryTaskDLQMessageRequest{}
+}
+
+func newAdminExtAPIServiceDescribeHistoryTaskDLQMessageYARPCResponse() proto.Message {
+	return &DescribeHistoryTaskDLQMessageResponse{}
+}
+
+func newAdminExtAPIServiceMergeHistoryTaskDLQMessagesYARPCRequest() proto.Message {
+	return &MergeHistoryTaskDLQMessagesRequest{}
+}
+
+func newAdminExtAPIServiceMergeHistoryTaskDLQMessagesYARPCResponse() proto.Message {
+	return &MergeHistoryTaskDLQMessagesResponse{}
+}
+
+func newAdminExtAPIServicePurgeHistoryTaskDLQMessagesYARPCRequest() proto.Message {
+	return &PurgeHistoryTaskDLQMessagesRequest{}
+}
+
+func newAdminExtAPIServicePurgeHistoryTaskDLQMessagesYARPCResponse() proto.Message {
+	return &PurgeHistoryTaskDLQMessagesResponse{}
+}
+
+func newAdminExtAPIServiceDeleteDomainYARPCRequest() proto.Message {
+	return &DeleteDomainRequest{}
+}
+
+func newAdminExtAPIServiceDeleteDomainYARPCResponse() proto.Message {
+	return &DeleteDomainResponse{}
+}
+
+func newAdminExtAPIServiceGetReplicationStatusYARPCRequest() proto.Message {
+	return &GetReplicationStatusRequest{}
+}
+
+func newAdminExtAPIServiceGetReplicationStatusYARPCResponse() proto.Message {
+	return &GetReplicationStatusResponse{}
+}
+
+func newAdminExtAPIServiceRenameDomainYARPCRequest() proto.Message {
+	return &RenameDomainRequest{}
+}
+
+func newAdminExtAPIServiceRenameDomainYARPCResponse() proto.Message {
+	return &RenameDomainResponse{}
+}
+
+func newAdminExtAPIServiceDescribeWorkflowVersionHistoriesYARPCRequest() proto.Message {
+	return &DescribeWorkflowVersionHistoriesRequest{}
+}
+
+func newAdminExtAPIServiceDescribeWorkflowVersionHistoriesYARPCResponse() proto.Message {
+	return &DescribeWorkflowVersionHistoriesResponse{}
+}
+
+func newAdminExtAPIServiceRebuildWorkflowBranchYARPCRequest() proto.Message {
+	return &RebuildWorkflowBranchRequest{}
+}
+
+func newAdminExtAPIServiceRebuildWorkflowBranchYARPCResponse() proto.Message {
+	return &RebuildWorkflowBranchResponse{}
+}
+
+func newAdminExtAPIServiceDescribeTaskListYARPCRequest() proto.Message {
+	return &DescribeTaskListRequest{}
+}
+
+func newAdminExtAPIServiceDescribeTaskListYARPCResponse() proto.Message {
+	return &DescribeTaskListResponse{}
+}
+
+var (
+	emptyAdminExtAPIServiceUpdateActivityOptionsYARPCRequest             = &UpdateActivityOptionsRequest{}
+	emptyAdminExtAPIServiceUpdateActivityOptionsYARPCResponse            = &UpdateActivityOptionsResponse{}
+	emptyAdminExtAPIServiceListAuditLogEntriesYARPCRequest               = &ListAuditLogEntriesRequest{}
+	emptyAdminExtAPIServiceListAuditLogEntriesYARPCResponse              = &ListAuditLogEntriesResponse{}
+	emptyAdminExtAPIServiceReadHistoryTaskDLQMessagesYARPCRequest        = &ReadHistoryTaskDLQMessagesRequest{}
+	emptyAdminExtAPIServiceReadHistoryTaskDLQMessagesYARPCResponse       = &ReadHistoryTaskDLQMessagesResponse{}
+	emptyAdminExtAPIServiceDescribeHistoryTaskDLQMessageYARPCRequest     = &DescribeHistoryTaskDLQMessageRequest{}
+	emptyAdminExtAPIServiceDescribeHistoryTaskDLQMessageYARPCResponse    = &DescribeHistoryTaskDLQMessageResponse{}
+	emptyAdminExtAPIServiceMergeHistoryTaskDLQMessagesYARPCRequest       = &MergeHistoryTaskDLQMessagesRequest{}
+	emptyAdminExtAPIServiceMergeHistoryTaskDLQMessagesYARPCResponse      = &MergeHistoryTaskDLQMessagesResponse{}
+	emptyAdminExtAPIServicePurgeHistoryTaskDLQMessagesYARPCRequest       = &PurgeHistoryTaskDLQMessagesRequest{}
+	emptyAdminExtAPIServicePurgeHistoryTaskDLQMessagesYARPCResponse      = &PurgeHistoryTaskDLQMessagesResponse{}
+	emptyAdminExtAPIServiceDeleteDomainYARPCRequest                      = &DeleteDomainRequest{}
+	emptyAdminExtAPIServiceDeleteDomainYARPCResponse                     = &DeleteDomainResponse{}
+	emptyAdminExtAPIServiceGetReplicationStatusYARPCRequest              = &GetReplicationStatusRequest{}
+	emptyAdminExtAPIServiceGetReplicationStatusYARPCResponse             = &GetReplicationStatusResponse{}
+	emptyAdminExtAPIServiceRenameDomainYARPCRequest                      = &RenameDomainRequest{}
+	emptyAdminExtAPIServiceRenameDomainYARPCResponse                     = &RenameDomainResponse{}
+	emptyAdminExtAPIServiceDescribeWorkflowVersionHistoriesYARPCRequest  = &DescribeWorkflowVersionHistoriesRequest{}
+	emptyAdminExtAPIServiceDescribeWorkflowVersionHistoriesYARPCResponse = &DescribeWorkflowVersionHistoriesResponse{}
+	emptyAdminExtAPIServiceRebuildWorkflowBranchYARPCRequest             = &RebuildWorkflowBranchRequest{}
+	emptyAdminExtAPIServiceRebuildWorkflowBranchYARPCResponse            = &RebuildWorkflowBranchResponse{}
+	emptyAdminExtAPIServiceDescribeTaskListYARPCRequest                  = &DescribeTaskListRequest{}
+	emptyAdminExtAPIServiceDescribeTaskListYARPCResponse                 = &DescribeTaskListResponse{}
+)
+
+var yarpcFileDescriptorClosurea38d8bd4ba4c870e = [][]byte{
+	// uber/cadence/adminext/v1/service.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5d, 0x6f, 0x1b, 0x59,
+		0xf9, 0xff, 0x8f, 0x9d, 0xc4, 0xf6, 0xe3, 0x24, 0x75, 0x4f, 0xb3, 0xed, 0xc4, 0x69, 0x36, 0xe9,
+		0xf4, 0x65, 0xf3, 0x5f, 0x51, 0x87, 0x86, 0xdd, 0x96, 0x52, 0x60, 0xe5, 0xda, 0x6e, 0x6b, 0x6d,
+		0x5e, 0xcc, 0xb1, 0xd3, 0x15, 0x2b, 0xa4, 0x61, 0x32, 0x73, 0xe2, 0x8c, 0x62, 0xcf, 0x4c, 0x67,
+		0xce, 0x38, 0xc9, 0x4a, 0xdc, 0x20, 0x21, 0x56, 0xf0, 0x01, 0x10, 0x88, 0x0b, 0x6e, 0x91, 0xe0,
+		0x02, 0x2e, 0xd0, 0x7e, 0x06, 0xbe, 0x0c, 0x02, 0x89, 0x2b, 0x6e, 0xd0, 0x79, 0x19, 0xbf, 0x8e,
+		0x3d, 0x49, 0xab, 0x15, 0x42, 0xdc, 0xf9, 0x3c, 0xf3, 0xfc, 0x9e, 0xf3, 0xbc, 0x9f, 0xe7, 0x9c,
+		0x04, 0x1e, 0x84, 0x47, 0xc4, 0xdf, 0x36, 0x0d, 0x8b, 0x38, 0x26, 0xd9, 0x36, 0xac, 0xae, 0xed,
+		0x90, 0x73, 0xba, 0xdd, 0x7b, 0xb4, 0x1d, 0x10, 0xbf, 0x67, 0x9b, 0xa4, 0xe4, 0xf9, 0x2e, 0x75,
+		0x91, 0xca, 0xf8, 0x4a, 0x92, 0xaf, 0x14, 0xf1, 0x95, 0x7a, 0x8f, 0x8a, 0xef, 0xb7, 0x5d, 0xb7,
+		0xdd, 0x21, 0xdb, 0x9c, 0xef, 0x28, 0x3c, 0xde, 0xb6, 0x42, 0xdf, 0xa0, 0xb6, 0xeb, 0x08, 0x64,
+		0x71, 0x63, 0xfc, 0x3b, 0xb5, 0xbb, 0x24, 0xa0, 0x46, 0xd7, 0x93, 0x0c, 0x13, 0x02, 0xce, 0x7c,
+		0xc3, 0xf3, 0x88, 0x1f, 0xc8, 0xef, 0x9b, 0xa3, 0x2a, 0x7a, 0x36, 0xd3, 0xce, 0x74, 0xbb, 0xdd,
+		0xfe, 0x16, 0x5a, 0x1c, 0x07, 0x35, 0x82, 0xd3, 0x8e, 0x1d, 0xd0, 0x59, 0x3c, 0x67, 0xae, 0x7f,
+		0x7a, 0xdc, 0x71, 0xcf, 0x24, 0xcf, 0xbd, 0x11, 0x9e, 0xe0, 0xc4, 0xf0, 0x89, 0xc5, 0xd8, 0x4e,
+		0xec, 0x80, 0xba, 0xfe, 0x85, 0xe4, 0xba, 0x3f, 0x85, 0x6b, 0x74, 0x43, 0xed, 0x2f, 0xf3, 0x70,
+		0xfb, 0xd0, 0xb3, 0x0c, 0x4a, 0xca, 0x26, 0xb5, 0x7b, 0x36, 0xbd, 0x38, 0xf0, 0x98, 0x5b, 0x02,
+		0x4c, 0xde, 0x84, 0x24, 0xa0, 0xe8, 0x26, 0x2c, 0x58, 0x6e, 0xd7, 0xb0, 0x1d, 0x55, 0xd9, 0x54,
+		0xb6, 0x72, 0x58, 0xae, 0xd0, 0x21, 0xa0, 0x48, 0x2f, 0x9d, 0x9c, 0x13, 0x33, 0x64, 0x28, 0x35,
+		0xb5, 0xa9, 0x6c, 0xe5, 0x77, 0x1e, 0x94, 0x46, 0xe3, 0xe0, 0xd9, 0xa5, 0xde, 0xa3, 0xd2, 0x67,
+		0x92, 0xbd, 0x16, 0x71, 0xe3, 0xeb, 0x67, 0xe3, 0x24, 0xb4, 0x01, 0x79, 0x43, 0x2a, 0xa2, 0xdb,
+		0x96, 0x9a, 0xe6, 0x7b, 0x42, 0x44, 0xaa, 0x5b, 0xe8, 0x3b, 0x90, 0x63, 0x26, 0xe8, 0xcc, 0x06,
+		0x75, 0x8e, 0x6f, 0xb7, 0x1e, 0xbb, 0x5d, 0xcb, 0x08, 0x4e, 0x77, 0xed, 0x80, 0xe2, 0x2c, 0x95,
+		0xbf, 0x50, 0x0b, 0x56, 0x03, 0xf3, 0x84, 0x58, 0x61, 0x87, 0xe8, 0xd4, 0xd5, 0x03, 0x6a, 0xf8,
+		0x54, 0x67, 0x81, 0x76, 0x43, 0xaa, 0xce, 0x73, 0x59, 0xab, 0x25, 0x11, 0xe7, 0x52, 0x14, 0xe7,
+		0x52, 0x55, 0x26, 0x0a, 0xbe, 0x19, 0x61, 0x5b, 0x6e, 0x93, 0x21, 0x5b, 0x02, 0x38, 0x2e, 0xd5,
+		0xec, 0xb8, 0x01, 0xe9, 0x4b, 0x5d, 0xb8, 0x82, 0xd4, 0x0a, 0x43, 0x46, 0x52, 0xf7, 0xe1, 0xa6,
+		0xd4, 0x6f, 0x5c, 0x64, 0x26, 0x49, 0xe4, 0x0d, 0x0e, 0x1c, 0x93, 0xf7, 0x02, 0xae, 0x9f, 0x10,
+		0xc3, 0xa7, 0x47, 0xc4, 0x18, 0xd8, 0x9c, 0x4d, 0x12, 0x55, 0xe8, 0x63, 0x22, 0x39, 0x15, 0x58,
+		0xf4, 0x09, 0xf5, 0x2f, 0x74, 0xcf, 0xed, 0xd8, 0xe6, 0x85, 0x9a, 0xe3, 0x22, 0x36, 0x63, 0x43,
+		0x80, 0x19, 0x63, 0x83, 0xf3, 0xe1, 0xbc, 0x3f, 0x58, 0xa0, 0xfb, 0xb0, 0xec, 0x93, 0x80, 0x50,
+		0xdd, 0xa0, 0x94, 0x74, 0x3d, 0x1a, 0xa8, 0xb0, 0xa9, 0x6c, 0x65, 0xf1, 0x12, 0xa7, 0x96, 0x25,
+		0x11, 0x15, 0x21, 0x6b, 0x5b, 0xc4, 0xa1, 0x36, 0xbd, 0x50, 0xf3, 0x3c, 0x13, 0xfa, 0x6b, 0x8d,
+		0xc0, 0xfa, 0x94, 0xbc, 0x0d, 0x3c, 0xd7, 0x09, 0x08, 0xaa, 0x42, 0x36, 0x4a, 0x1b, 0x9e, 0xba,
+		0xf9, 0x9d, 0xad, 0x58, 0x25, 0x1b, 0xc4, 0xb1, 0x6c, 0xa7, 0x1d, 0x89, 0xa9, 0x3b, 0xc7, 0x2e,
+		0xee, 0x23, 0xb5, 0x9f, 0xa7, 0x60, 0xa9, 0x1c, 0x5a, 0x36, 0xdd, 0x75, 0xdb, 0x35, 0x87, 0xfa,
+		0x17, 0xe8, 0xdb, 0x90, 0xeb, 0xf7, 0x06, 0x29, 0xb8, 0x38, 0xe1, 0xc0, 0x56, 0xc4, 0x81, 0x07,
+		0xcc, 0x68, 0x05, 0xe6, 0x0d, 0x93, 0xba, 0x3e, 0xaf, 0x92, 0x1c, 0x16, 0x0b, 0xb4, 0x0a, 0x59,
+		0xc3, 0xb3, 0x75, 0xc7, 0xe8, 0x12, 0x99, 0xee, 0x19, 0xc3, 0xb3, 0xf7, 0x8d, 0x2e, 0x19, 0xaa,
+		0xbd, 0xb9, 0x91, 0xda, 0x53, 0x21, 0xe3, 0x8b, 0xf2, 0xe4, 0x59, 0x9b, 0xc3, 0xd1, 0x12, 0x55,
+		0x20, 0xe3, 0x86, 0xd4, 0x74, 0xbb, 0x84, 0x67, 0xde, 0xf2, 0xce, 0xff, 0x97, 0xa6, 0xb5, 0xc4,
+		0x52, 0x64, 0xd6, 0x81, 0x00, 0xe0, 0x08, 0xc9, 0xf4, 0x24, 0xbe, 0xef, 0xfa, 0x3c, 0xd3, 0x72,
+		0x58, 0x2c, 0xb4, 0xdf, 0xa6, 0xa0, 0xc8, 0xaa, 0x68, 0xd8, 0x1b, 0x36, 0x49, 0xec, 0x13, 0x57,
+		0x36, 0xfa, 0x29, 0xc0, 0xa0, 0x30, 0xd5, 0xb9, 0x64, 0x07, 0x07, 0x51, 0x31, 0xa2, 0x8f, 0x21,
+		0x4b, 0x1c, 0x4b, 0x00, 0xe7, 0x13, 0x81, 0x19, 0xe2, 0x58, 0x1c, 0xb6, 0x06, 0x39, 0xcf, 0x68,
+		0x13, 0x3d, 0xb0, 0xbf, 0x10, 0x6e, 0x9b, 0xc7, 0x59, 0x46, 0x68, 0xda, 0x5f, 0x10, 0xf4, 0x00,
+		0xae, 0x31, 0x87, 0xe9, 0x9c, 0x83, 0xba, 0xa7, 0xc4, 0xe1, 0x6e, 0x59, 0xc4, 0x4b, 0x8c, 0xdc,
+		0x30, 0xda, 0xa4, 0xc5, 0x88, 0xda, 0x97, 0x0a, 0xac, 0xc5, 0xba, 0x47, 0xa6, 0x63, 0x19, 0x32,
+		0x44, 0x90, 0x54, 0x65, 0x33, 0xbd, 0x95, 0xdf, 0xf9, 0x20, 0x39, 0x32, 0x3c, 0xe1, 0x70, 0x84,
+		0x8b, 0x53, 0x25, 0x15, 0xa7, 0xca, 0x3f, 0xe7, 0xe0, 0xbd, 0x57, 0xe2, 0x30, 0x60, 0x4d, 0xb0,
+		0xba, 0xfb, 0x83, 0x3d, 0x12, 0x04, 0x46, 0x9b, 0xa0, 0x75, 0x80, 0xae, 0xf8, 0xc9, 0x9a, 0x2b,
+		0x0b, 0x54, 0x1a, 0xe7, 0x24, 0xa5, 0x6e, 0xb1, 0xa8, 0xb0, 0x83, 0xc2, 0x62, 0x1f, 0x53, 0xdc,
+		0x0f, 0x19, 0xbe, 0xae, 0x5b, 0xcc, 0x47, 0x22, 0xa0, 0x83, 0xae, 0x9c, 0x15, 0x84, 0xba, 0x35,
+		0xe5, 0x2c, 0x98, 0x7b, 0xd7, 0xb3, 0x00, 0xc3, 0x12, 0x6f, 0xf5, 0xa6, 0x41, 0x49, 0xdb, 0xf5,
+		0x2f, 0x78, 0x4c, 0x97, 0x77, 0x1e, 0x4e, 0x77, 0xdc, 0x90, 0xd5, 0x15, 0x09, 0xc2, 0x8b, 0x74,
+		0x68, 0xc5, 0xec, 0xe0, 0x32, 0xe9, 0x85, 0xd7, 0x8f, 0x35, 0x23, 0xb4, 0x2e, 0x3c, 0x82, 0x6e,
+		0x41, 0x86, 0x7f, 0xb4, 0x2d, 0x1e, 0xe3, 0x34, 0x5e, 0x60, 0xcb, 0xba, 0x85, 0x2a, 0x70, 0xad,
+		0x67, 0x07, 0xf6, 0x91, 0xdd, 0x61, 0xe7, 0x12, 0xcf, 0xaf, 0x6c, 0x62, 0x7e, 0x2d, 0x0f, 0x20,
+		0x3c, 0xcd, 0x54, 0xc8, 0xc8, 0x76, 0xc7, 0x9b, 0xe6, 0x3c, 0x8e, 0x96, 0xe8, 0x15, 0xa0, 0x63,
+		0xdb, 0x0f, 0xfa, 0xed, 0x50, 0xec, 0x00, 0x89, 0x3b, 0x14, 0x38, 0x4a, 0xb6, 0x4b, 0xbe, 0xc7,
+		0x27, 0xb0, 0x44, 0x9c, 0x37, 0x21, 0x09, 0x89, 0x2c, 0x83, 0x7c, 0xa2, 0x90, 0xc5, 0x08, 0xc0,
+		0x05, 0xac, 0x03, 0x74, 0x8c, 0x80, 0xea, 0xa2, 0x01, 0x2c, 0xf2, 0x40, 0xe7, 0x18, 0xa5, 0xc6,
+		0x08, 0x7d, 0xf7, 0xd9, 0xce, 0xb1, 0xab, 0x2e, 0x89, 0x34, 0xe0, 0x3e, 0x72, 0x8e, 0x5d, 0xed,
+		0xef, 0x0a, 0xdc, 0xc1, 0xc4, 0xb0, 0x62, 0x73, 0xaf, 0xdf, 0x28, 0x86, 0x93, 0x4c, 0x19, 0x4d,
+		0xb2, 0x41, 0x0f, 0x49, 0x8d, 0xf4, 0x90, 0x16, 0xa8, 0xb6, 0x63, 0x76, 0xc2, 0xc0, 0xee, 0x11,
+		0x9d, 0x55, 0xf8, 0x50, 0x12, 0xa7, 0xb9, 0x81, 0x6b, 0x13, 0x06, 0xd6, 0x1d, 0xfa, 0xf8, 0xa3,
+		0xd7, 0x46, 0x27, 0x24, 0xf8, 0xbd, 0x3e, 0xb8, 0xe6, 0x58, 0x7b, 0xfd, 0x6c, 0x1f, 0x29, 0xfb,
+		0xb9, 0xe4, 0xb2, 0x9f, 0x8f, 0xab, 0xb5, 0x5f, 0x2b, 0xa0, 0xcd, 0xb2, 0x59, 0x56, 0xff, 0xa7,
+		0x90, 0x95, 0x3a, 0x47, 0xe5, 0xbf, 0x7d, 0xa9, 0x2c, 0x1e, 0xc8, 0xc2, 0x7d, 0x01, 0x97, 0xee,
+		0x03, 0x3f, 0x86, 0x7b, 0x55, 0x12, 0x98, 0xbe, 0x7d, 0x44, 0xe2, 0x45, 0x26, 0x47, 0x64, 0xb4,
+		0x61, 0xa4, 0xc6, 0x1a, 0x86, 0xe6, 0xc3, 0xfd, 0x84, 0x1d, 0xa4, 0xfd, 0x75, 0xc8, 0x48, 0x94,
+		0x3c, 0x32, 0xaf, 0x6c, 0x7e, 0x84, 0xd7, 0xfe, 0xa1, 0x80, 0xb6, 0x47, 0xfc, 0x36, 0xf9, 0x5f,
+		0x4a, 0xb3, 0x3d, 0xb8, 0x3b, 0xd3, 0x66, 0xe9, 0xe6, 0x18, 0x71, 0x4a, 0x9c, 0xb8, 0x3f, 0x2a,
+		0xa0, 0x35, 0xc2, 0xff, 0x1a, 0x1f, 0x6a, 0xf7, 0xe1, 0x6e, 0x23, 0x4c, 0x34, 0x5f, 0x6b, 0xc0,
+		0x8d, 0x2a, 0xe9, 0x10, 0x4a, 0xaa, 0x5c, 0x99, 0xc8, 0x0c, 0x04, 0x73, 0x7c, 0xd0, 0x10, 0x83,
+		0x09, 0xff, 0xcd, 0x26, 0xd0, 0x80, 0x98, 0xa1, 0xcf, 0xfb, 0x79, 0xbf, 0x84, 0x72, 0x78, 0x29,
+		0xa2, 0x0a, 0x47, 0x75, 0x61, 0x65, 0x54, 0xa2, 0x74, 0x74, 0xfc, 0x89, 0xa7, 0xbc, 0xe3, 0x89,
+		0xa7, 0xfd, 0x2b, 0x05, 0x37, 0x31, 0xf1, 0x3a, 0xb6, 0xc9, 0xc7, 0xef, 0x26, 0x73, 0x76, 0x93,
+		0x1a, 0x34, 0x0c, 0x66, 0xc5, 0x82, 0x4f, 0xd3, 0x5d, 0x97, 0x12, 0x9d, 0xf9, 0x8e, 0x92, 0x68,
+		0xd6, 0x5a, 0x12, 0xd4, 0x8a, 0x20, 0xb2, 0x5a, 0xf6, 0x89, 0x61, 0xe9, 0x1d, 0xd2, 0x23, 0x1d,
+		0x1e, 0x8c, 0x34, 0xce, 0x31, 0xca, 0x2e, 0x23, 0x88, 0x9b, 0xd7, 0x29, 0x89, 0xbe, 0xcf, 0xf1,
+		0xef, 0xc0, 0x49, 0x82, 0xe1, 0x1e, 0x2c, 0x77, 0x8d, 0x73, 0x7d, 0x48, 0xc6, 0x3c, 0xe7, 0x59,
+		0xec, 0x1a, 0xe7, 0xb8, 0x2f, 0x66, 0x17, 0x56, 0xf8, 0x01, 0xe2, 0x4b, 0x33, 0xa2, 0x83, 0x68,
+		0x21, 0xf1, 0x20, 0x42, 0x0c, 0x87, 0xfb, 0x30, 0xf6, 0x81, 0x59, 0x6d, 0x75, 0xde, 0x88, 0xda,
+		0x11, 0x47, 0x72, 0xc6, 0xea, 0xbc, 0xe1, 0xa5, 0xd3, 0x80, 0x5b, 0x6e, 0xc7, 0x22, 0x01, 0xd5,
+		0x3d, 0x31, 0xc1, 0xeb, 0xfc, 0x64, 0x32, 0xda, 0xd1, 0xd9, 0x3c, 0xe3, 0x5a, 0xb3, 0x22, 0x90,
+		0x72, 0xf4, 0x67, 0xe9, 0x54, 0x6e, 0x13, 0xed, 0xab, 0x14, 0xa8, 0x43, 0xde, 0x97, 0x7e, 0x93,
+		0xfe, 0x9f, 0x74, 0xb2, 0x12, 0xe7, 0xe4, 0x0d, 0xc8, 0x8b, 0x30, 0x99, 0x6e, 0xe8, 0x50, 0x39,
+		0x45, 0x01, 0x27, 0x55, 0x18, 0x85, 0x59, 0x24, 0xee, 0xaf, 0x46, 0x5b, 0xc6, 0x80, 0xcf, 0x1c,
+		0xbb, 0x46, 0x7b, 0xaa, 0xeb, 0xe6, 0xde, 0xd9, 0x75, 0xf3, 0x97, 0x76, 0xdd, 0xc2, 0xdb, 0xb9,
+		0xee, 0x73, 0x58, 0x7b, 0x49, 0xe8, 0x70, 0xea, 0x72, 0xaf, 0x45, 0x15, 0x58, 0x84, 0xac, 0xf4,
+		0x9a, 0x38, 0xfe, 0x72, 0xb8, 0xbf, 0x66, 0x1e, 0x3b, 0x76, 0x7d, 0x93, 0xe8, 0xc7, 0x84, 0x9a,
+		0x27, 0xdc, 0x63, 0x59, 0x0c, 0x9c, 0xf4, 0x82, 0x51, 0xb4, 0xaf, 0x14, 0xb8, 0x1d, 0x2f, 0x5c,
+		0x16, 0xe3, 0xfe, 0x98, 0xf4, 0xfc, 0xce, 0xce, 0xf4, 0xd3, 0x65, 0x5a, 0x80, 0x87, 0x34, 0x7a,
+		0x05, 0x0b, 0x3c, 0x60, 0x81, 0x9a, 0xe2, 0xd2, 0xbe, 0x79, 0x29, 0x69, 0x43, 0xc5, 0x8a, 0x25,
+		0x5e, 0x3b, 0x85, 0x1b, 0x98, 0xb0, 0x7e, 0x93, 0xdc, 0x90, 0x56, 0x21, 0xeb, 0x90, 0x33, 0x71,
+		0x23, 0x12, 0xe5, 0x9b, 0x71, 0xc8, 0xd9, 0x7e, 0x7c, 0xaf, 0x4a, 0xc7, 0xf5, 0xaa, 0x5f, 0x28,
+		0xb0, 0x32, 0xba, 0x9b, 0xf4, 0xcf, 0xc8, 0xec, 0xae, 0x4c, 0xcc, 0xee, 0xab, 0x9e, 0x4f, 0x7a,
+		0xb6, 0x1b, 0x06, 0x7c, 0x73, 0x9d, 0x9c, 0x7b, 0xb6, 0x2f, 0x87, 0xdc, 0x54, 0x62, 0xe6, 0xdd,
+		0x8c, 0xc0, 0x4c, 0xd3, 0x1a, 0x87, 0xb2, 0x8f, 0xda, 0x9f, 0x15, 0xf8, 0x20, 0x1a, 0x0d, 0xa2,
+		0xd6, 0xf7, 0x9a, 0xf8, 0x81, 0xed, 0x3a, 0xa2, 0x89, 0x5f, 0xe2, 0xea, 0xf8, 0x35, 0x3d, 0x31,
+		0xa9, 0x90, 0x89, 0x4a, 0x58, 0x5e, 0x3d, 0xe5, 0x92, 0x8d, 0x16, 0x5b, 0xc9, 0x4a, 0x4b, 0xaf,
+		0x0e, 0x89, 0x51, 0x46, 0xc4, 0x7c, 0x5d, 0x7a, 0x1f, 0xc2, 0xf5, 0x9e, 0x50, 0x46, 0x3f, 0x89,
+		0xb4, 0x51, 0xd3, 0x71, 0x2f, 0x1b, 0xe2, 0xb5, 0x8f, 0x09, 0x9e, 0xd0, 0xbe, 0xd0, 0x1b, 0xa3,
+		0x68, 0x7f, 0x55, 0xe0, 0x36, 0x26, 0x47, 0xa1, 0xdd, 0xb1, 0x22, 0x35, 0x9e, 0xfb, 0x86, 0x63,
+		0x9e, 0xfc, 0x87, 0xc2, 0x73, 0x07, 0x16, 0x8f, 0xf8, 0xfe, 0x43, 0xb9, 0xbe, 0x88, 0xf3, 0x82,
+		0xc6, 0x33, 0x7d, 0xd8, 0xf5, 0x73, 0xa3, 0x11, 0xdc, 0x80, 0xf5, 0x29, 0xb6, 0xc8, 0x11, 0xe1,
+		0x6f, 0x0a, 0xdc, 0x8a, 0x42, 0xdc, 0x7f, 0x21, 0x4c, 0x30, 0x74, 0xe4, 0xc9, 0x31, 0x75, 0xb5,
+		0x27, 0xc7, 0x97, 0xb0, 0xdc, 0xc7, 0x8a, 0x4b, 0x67, 0x9a, 0x5f, 0x62, 0xef, 0xcc, 0x14, 0xc0,
+		0x6e, 0xa3, 0xe2, 0xe2, 0x1a, 0xad, 0xd0, 0x13, 0x39, 0x58, 0x59, 0x44, 0x1f, 0x08, 0x0c, 0x78,
+		0xbb, 0xe1, 0x4e, 0xc8, 0xca, 0xd9, 0xc9, 0xea, 0x9b, 0x25, 0x7a, 0x91, 0xf6, 0xa7, 0x34, 0xa8,
+		0x93, 0x16, 0xcb, 0x24, 0x7e, 0x0a, 0x19, 0xcf, 0xed, 0x74, 0x06, 0x9d, 0x73, 0x23, 0xfe, 0x8d,
+		0x8c, 0xf3, 0xf0, 0xa7, 0xb1, 0x88, 0x1f, 0xed, 0x41, 0x61, 0x42, 0x11, 0xe1, 0x9c, 0xbb, 0x33,
+		0x6d, 0x93, 0x2d, 0x72, 0x99, 0x8e, 0xac, 0xd1, 0x67, 0x50, 0xf0, 0x0c, 0x9f, 0xda, 0x2c, 0x07,
+		0x74, 0xd3, 0x75, 0x8e, 0xed, 0xb6, 0x4c, 0xee, 0x6f, 0xcc, 0x14, 0xd7, 0x88, 0x40, 0x15, 0x8e,
+		0xc1, 0xd7, 0xbc, 0x51, 0x02, 0x6a, 0xc1, 0xb2, 0x65, 0x07, 0x9e, 0x41, 0xcd, 0x13, 0xae, 0x66,
+		0x20, 0xcf, 0xd3, 0x87, 0xd3, 0x6a, 0x26, 0x92, 0x5c, 0x95, 0x28, 0xa6, 0x60, 0x80, 0x97, 0xac,
+		0xe1, 0x25, 0xfa, 0x11, 0x5c, 0x0f, 0x4c, 0xa3, 0xc3, 0xce, 0x4e, 0x8b, 0x98, 0x36, 0x2b, 0xa9,
+		0x40, 0x9d, 0x8f, 0xbb, 0xd9, 0x4d, 0x0a, 0x6e, 0x0a, 0x60, 0x55, 0xe2, 0x70, 0x21, 0x18, 0x25,
+		0x04, 0x1f, 0xfe, 0x52, 0x81, 0x6b, 0x63, 0xcf, 0x73, 0x68, 0x1d, 0x56, 0xcb, 0x87, 0xd5, 0x7a,
+		0x4b, 0xdf, 0x3d, 0x78, 0xa9, 0x1f, 0x1c, 0xb6, 0x2a, 0x07, 0x7b, 0x35, 0xbd, 0xbe, 0xff, 0xba,
+		0xbc, 0x5b, 0xaf, 0x16, 0xfe, 0x2f, 0xfe, 0x73, 0xf3, 0xb0, 0x52, 0xa9, 0x35, 0x9b, 0x05, 0x05,
+		0xdd, 0x06, 0x75, 0xf2, 0x73, 0xb5, 0xb6, 0x5f, 0xaf, 0x55, 0x0b, 0xa9, 0xf8, 0xaf, 0x2f, 0xca,
+		0xf5, 0xdd, 0x5a, 0xb5, 0x90, 0xfe, 0xf0, 0x27, 0x70, 0x23, 0xe6, 0x61, 0x05, 0xdd, 0x81, 0xf5,
+		0x57, 0xf5, 0x66, 0xeb, 0x00, 0xff, 0x50, 0x6f, 0x95, 0x9b, 0x9f, 0xea, 0x95, 0x72, 0xab, 0xf6,
+		0x92, 0xad, 0x06, 0x4a, 0x69, 0xf0, 0x7e, 0x3c, 0x4b, 0x0b, 0x97, 0xf7, 0x9b, 0x2f, 0x6a, 0xb8,
+		0xa0, 0xa0, 0x0d, 0x58, 0x9b, 0xc2, 0x53, 0xdf, 0xab, 0xe1, 0x42, 0x6a, 0xe7, 0x0f, 0x4b, 0x90,
+		0x2f, 0xb3, 0x33, 0xb7, 0x76, 0x4e, 0xcb, 0x8d, 0x3a, 0xfa, 0x52, 0x81, 0xf7, 0x62, 0x9f, 0x7e,
+		0xd1, 0xe3, 0xe9, 0x07, 0xf5, 0xac, 0xbf, 0x71, 0x14, 0x9f, 0x5c, 0x19, 0x27, 0xcb, 0xe7, 0xa7,
+		0x0a, 0xdc, 0x88, 0x79, 0xf4, 0x43, 0x1f, 0x4d, 0x17, 0x38, 0xfd, 0x09, 0xb5, 0xf8, 0xf1, 0x15,
+		0x51, 0x52, 0x89, 0x5f, 0x29, 0x50, 0x9c, 0xfe, 0x04, 0x81, 0x9e, 0xcd, 0x9a, 0x5e, 0x12, 0x1e,
+		0x6b, 0x8a, 0xdf, 0x7d, 0x3b, 0xb0, 0xd4, 0xec, 0x77, 0x0a, 0xac, 0xcf, 0x7c, 0x1f, 0x40, 0xdf,
+		0x9f, 0x2e, 0xff, 0x32, 0x4f, 0x17, 0xc5, 0x4f, 0xde, 0x1a, 0x2f, 0x55, 0xfc, 0x8d, 0x02, 0x6b,
+		0x33, 0x6e, 0xd6, 0x68, 0x86, 0x03, 0x92, 0x1f, 0x21, 0x8a, 0xdf, 0x7b, 0x4b, 0xf4, 0x90, 0x72,
+		0x8d, 0xf0, 0xad, 0x94, 0x6b, 0x84, 0xef, 0xa2, 0xdc, 0x25, 0x2e, 0xdb, 0xa8, 0x0b, 0x8b, 0xc3,
+		0x57, 0x63, 0xf4, 0x70, 0x56, 0x28, 0x26, 0x2e, 0xe5, 0xc5, 0xd2, 0x65, 0xd9, 0xe5, 0x76, 0x3f,
+		0x53, 0x60, 0x25, 0xee, 0x16, 0x80, 0x66, 0x54, 0xcd, 0x8c, 0x2b, 0x49, 0xf1, 0xf1, 0x55, 0x61,
+		0x03, 0xb3, 0x87, 0x87, 0xec, 0x59, 0x66, 0xc7, 0x8c, 0xfe, 0xc5, 0xd2, 0x65, 0xd9, 0xe5, 0x76,
+		0xbf, 0x57, 0x60, 0x33, 0x69, 0x24, 0x45, 0xe5, 0xe4, 0x2a, 0x48, 0x98, 0xc1, 0x8b, 0xcf, 0xdf,
+		0x45, 0x84, 0xd4, 0x95, 0x35, 0xe6, 0xd8, 0xe9, 0x6b, 0x56, 0x63, 0x9e, 0x35, 0x7a, 0x16, 0x9f,
+		0x5c, 0x19, 0x27, 0x55, 0xb9, 0x80, 0xc2, 0xf8, 0xcc, 0x83, 0x1e, 0x25, 0x9b, 0x38, 0x36, 0x11,
+		0x16, 0x77, 0xae, 0x02, 0x11, 0x5b, 0x3f, 0x7f, 0xf6, 0xf9, 0xd3, 0xb6, 0x4d, 0x4f, 0xc2, 0xa3,
+		0x92, 0xe9, 0x76, 0xb7, 0x47, 0xfe, 0x0a, 0x5f, 0x6a, 0x13, 0x47, 0xfc, 0x03, 0xc1, 0xf0, 0xff,
+		0x30, 0x3c, 0x8b, 0x7e, 0xf7, 0x1e, 0x1d, 0x2d, 0xf0, 0xaf, 0xdf, 0xfa, 0xf7, 0x00, 0x9b, 0x40,
+		0x0e, 0x4d, 0xf1, 0x20, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x72, 0xdb, 0xc4,
+		0x17, 0xfe, 0x29, 0x8e, 0x9d, 0xe4, 0xd8, 0x4d, 0xfc, 0xdb, 0x90, 0xc4, 0x49, 0x09, 0xa4, 0x9a,
+		0x61, 0x1a, 0x3a, 0x20, 0x4f, 0xdc, 0x9b, 0x0e, 0x9d, 0x02, 0x4e, 0xec, 0x24, 0x6a, 0x83, 0x6d,
+		0x64, 0xd3, 0x4c, 0x61, 0x06, 0xcd, 0x5a, 0x5a, 0xb9, 0x8b, 0xa5, 0x5d, 0xb1, 0x5a, 0x39, 0xf1,
+		0x0d, 0xc3, 0x93, 0x70, 0xc1, 0xeb, 0x70, 0xc9, 0x0b, 0x31, 0xab, 0x3f, 0xb1, 0x5d, 0x9c, 0x29,
+		0x17, 0x0c, 0x77, 0xbb, 0xe7, 0xfb, 0xce, 0x39, 0xdf, 0xae, 0xce, 0x39, 0x5a, 0x38, 0x8a, 0x87,
+		0x44, 0xd4, 0x1d, 0xec, 0x12, 0xe6, 0x90, 0x3a, 0x0e, 0x69, 0x7d, 0x72, 0x52, 0x77, 0x78, 0x10,
+		0x70, 0x66, 0x84, 0x82, 0x4b, 0x8e, 0xb6, 0x15, 0xc3, 0xc8, 0x18, 0x06, 0x0e, 0xa9, 0x31, 0x39,
+		0x39, 0xf8, 0x68, 0xc4, 0xf9, 0xc8, 0x27, 0xf5, 0x84, 0x32, 0x8c, 0xbd, 0xba, 0x1b, 0x0b, 0x2c,
+		0x69, 0xee, 0xa4, 0xbf, 0x82, 0xff, 0x5f, 0x73, 0x31, 0xf6, 0x7c, 0x7e, 0xd3, 0xbe, 0x25, 0x4e,
+		0xac, 0x20, 0xf4, 0x31, 0x94, 0x6f, 0x32, 0xa3, 0x4d, 0xdd, 0x9a, 0x76, 0xa4, 0x1d, 0x6f, 0x58,
+		0x90, 0x9b, 0x4c, 0x17, 0xed, 0x40, 0x49, 0xc4, 0x4c, 0x61, 0x2b, 0x09, 0x56, 0x14, 0x31, 0x33,
+		0x5d, 0x5d, 0x87, 0x4a, 0x1e, 0x6c, 0x30, 0x0d, 0x09, 0x42, 0xb0, 0xca, 0x70, 0x40, 0xb2, 0x00,
+		0xc9, 0x5a, 0x71, 0x9a, 0x8e, 0xa4, 0x13, 0x2a, 0xa7, 0xf7, 0x72, 0x0e, 0x61, 0xad, 0x87, 0xa7,
+		0x3e, 0xc7, 0xae, 0x82, 0x5d, 0x2c, 0x71, 0x02, 0x57, 0xac, 0x64, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x59, 0xe6, 0x9f, 0xed, 0x50, 0x0d,
+		0xd6, 0x5c, 0x22, 0x31, 0xf5, 0xa3, 0x44, 0x61, 0xc5, 0xca, 0xb7, 0xfa, 0x6f, 0x1a, 0xac, 0x7e,
+		0x43, 0x02, 0x8e, 0x5e, 0x40, 0xc9, 0xa3, 0xc4, 0x77, 0xa3, 0x9a, 0x76, 0x54, 0x38, 0x2e, 0x37,
+		0x3e, 0x31, 0x96, 0xdc, 0x9f, 0xa1, 0xa8, 0xc6, 0x79, 0xc2, 0x6b, 0x33, 0x29, 0xa6, 0x56, 0xe6,
+		0x74, 0x70, 0x0d, 0xe5, 0x39, 0x33, 0xaa, 0x42, 0x61, 0x4c, 0xa6, 0x99, 0x0a, 0xb5, 0x44, 0x0d,
+		0x28, 0x4e, 0xb0, 0x1f, 0x93, 0x44, 0x40, 0xb9, 0xf1, 0xe1, 0xd2, 0xf0, 0xd9, 0x31, 0xad, 0x94,
+		0xfa, 0xc5, 0xca, 0x33, 0x4d, 0xff, 0x5d, 0x83, 0xd2, 0x25, 0xc1, 0x2e, 0x11, 0xe8, 0xab, 0x77,
+		0x24, 0x3e, 0x5e, 0x1a, 0x23, 0x25, 0xff, 0xb7, 0x22, 0xff, 0xd4, 0xa0, 0xda, 0x27, 0x58, 0x38,
+		0x6f, 0x9b, 0x52, 0x0a, 0x3a, 0x8c, 0x25, 0x89, 0x90, 0x0d, 0x9b, 0x94, 0xb9, 0xe4, 0x96, 0xb8,
+		0xf6, 0x82, 0xec, 0x67, 0x4b, 0xa3, 0xbe, 0xeb, 0x6e, 0x98, 0xa9, 0xef, 0xfc, 0x39, 0x1e, 0xd0,
+		0x79, 0xdb, 0xc1, 0x8f, 0x80, 0xfe, 0x4e, 0xfa, 0x17, 0x4f, 0xe5, 0xc1, 0x7a, 0x0b, 0x4b, 0x7c,
+		0xea, 0xf3, 0x21, 0x3a, 0x87, 0x07, 0x84, 0x39, 0xdc, 0xa5, 0x6c, 0x64, 0xcb, 0x69, 0x98, 0x16,
+		0xe8, 0x66, 0xe3, 0xd1, 0xd2, 0x58, 0xed, 0x8c, 0xa9, 0x2a, 0xda, 0xaa, 0x90, 0xb9, 0xdd, 0x5d,
+		0x01, 0xaf, 0xcc, 0x15, 0x70, 0x2f, 0x6d, 0x3a, 0x22, 0x5e, 0x13, 0x11, 0x51, 0xce, 0x4c, 0xe6,
+		0x71, 0x45, 0xa4, 0x41, 0xe8, 0xe7, 0x8d, 0xa0, 0xd6, 0xe8, 0x31, 0x6c, 0x79, 0x04, 0xcb, 0x58,
+		0x10, 0x7b, 0x92, 0x52, 0xb3, 0x86, 0xdb, 0xcc, 0xcc, 0x59, 0x00, 0xfd, 0x15, 0xec, 0xf5, 0xe3,
+		0x30, 0xe4, 0x42, 0x12, 0xf7, 0xcc, 0xa7, 0x84, 0xc9, 0x0c, 0x89, 0x54, 0xaf, 0x8e, 0xb8, 0x1d,
+		0xb9, 0xe3, 0x2c, 0x72, 0x71, 0xc4, 0xfb, 0xee, 0x18, 0xed, 0xc3, 0xfa, 0x4f, 0x78, 0x82, 0x13,
+		0x20, 0x8d, 0xb9, 0xa6, 0xf6, 0x7d, 0x77, 0xac, 0xff, 0x5a, 0x80, 0xb2, 0x45, 0xa4, 0x98, 0xf6,
+		0xb8, 0x4f, 0x9d, 0x29, 0x6a, 0x41, 0x95, 0x32, 0x2a, 0x29, 0xf6, 0x6d, 0xca, 0x24, 0x11, 0x13,
+		0x9c, 0xaa, 0x2c, 0x37, 0xf6, 0x8d, 0x74, 0xbc, 0x18, 0xf9, 0x78, 0x31, 0x5a, 0xd9, 0x78, 0xb1,
+		0xb6, 0x32, 0x17, 0x33, 0xf3, 0x40, 0x75, 0xd8, 0x1e, 0x62, 0x67, 0xcc, 0x3d, 0xcf, 0x76, 0x38,
+		0xf1, 0x3c, 0xea, 0x28, 0x99, 0x49, 0x6e, 0xcd, 0x42, 0x19, 0x74, 0x36, 0x43, 0x54, 0xda, 0x00,
+		0xdf, 0xd2, 0x20, 0x0e, 0x66, 0x69, 0x0b, 0xef, 0x4d, 0x9b, 0xb9, 0xdc, 0xa5, 0xfd, 0x74, 0x16,
+		0x05, 0x4b, 0x49, 0x82, 0x50, 0x46, 0xb5, 0xd5, 0x23, 0xed, 0xb8, 0x78, 0x47, 0x6d, 0x66, 0x66,
+		0xf4, 0x02, 0x1e, 0x32, 0xce, 0x6c, 0xa1, 0x8e, 0x8e, 0x87, 0x3e, 0xb1, 0x89, 0x10, 0x5c, 0xd8,
+		0xe9, 0x48, 0x89, 0x6a, 0xc5, 0xa3, 0xc2, 0xf1, 0x86, 0x55, 0x63, 0x9c, 0x59, 0x39, 0xa3, 0xad,
+		0x08, 0x56, 0x8a, 0xa3, 0x97, 0xb0, 0x4d, 0x6e, 0x43, 0x9a, 0x0a, 0x99, 0x49, 0x2e, 0xbd, 0x4f,
+		0x32, 0x9a, 0x79, 0xe5, 0xaa, 0xf5, 0x00, 0xf6, 0xcc, 0x88, 0xfb, 0x89, 0xf1, 0x42, 0xf0, 0x38,
+		0xec, 0x61, 0x21, 0xa9, 0xda, 0x2d, 0x1b, 0x98, 0xe8, 0x4b, 0x28, 0x46, 0x12, 0xcb, 0xb4, 0xe0,
+		0x37, 0x1b, 0xc7, 0x4b, 0x8b, 0x74, 0x31, 0x60, 0x5f, 0xf1, 0xad, 0xd4, 0x4d, 0x9f, 0xc0, 0xc3,
+		0x45, 0xf4, 0x8c, 0x33, 0x8f, 0x8e, 0x32, 0x85, 0xe8, 0x1a, 0xaa, 0x34, 0x87, 0xed, 0x91, 0xc2,
+		0xf3, 0xd6, 0xfe, 0xec, 0x1f, 0x64, 0xba, 0x93, 0x6e, 0x6d, 0xd1, 0x05, 0x20, 0xd2, 0xff, 0xd0,
+		0xe0, 0xa0, 0x19, 0x4d, 0x99, 0x93, 0xff, 0x36, 0x16, 0xf3, 0xd6, 0x60, 0x8d, 0x30, 0x75, 0xcf,
+		0xe9, 0x3f, 0x68, 0xdd, 0xca, 0xb7, 0xa8, 0x01, 0x3b, 0xa1, 0x20, 0x2e, 0xf1, 0x28, 0x23, 0xae,
+		0xfd, 0x73, 0x4c, 0x62, 0x62, 0x27, 0xb7, 0x92, 0x96, 0xf2, 0xf6, 0x0c, 0xfc, 0x56, 0x61, 0x1d,
+		0x75, 0x49, 0x87, 0x00, 0x29, 0x31, 0x69, 0xe7, 0x42, 0x42, 0xdc, 0x48, 0x2c, 0x49, 0xa3, 0x7e,
+		0x0d, 0x95, 0x14, 0x76, 0x12, 0x0d, 0x49, 0x91, 0x94, 0x1b, 0x87, 0x4b, 0x0f, 0x98, 0x4f, 0x09,
+		0xab, 0x9c, 0xb8, 0xa4, 0xaa, 0x9f, 0xdc, 0x40, 0x65, 0x7e, 0x10, 0xa0, 0x7d, 0xd8, 0x69, 0x77,
+		0xce, 0xba, 0x2d, 0xb3, 0x73, 0x61, 0x0f, 0xde, 0xf4, 0xda, 0xb6, 0xd9, 0x79, 0xdd, 0xbc, 0x32,
+		0x5b, 0xd5, 0xff, 0xa1, 0x03, 0xd8, 0x5d, 0x84, 0x06, 0x97, 0x96, 0x79, 0x3e, 0xb0, 0xae, 0xab,
+		0x1a, 0xda, 0x05, 0xb4, 0x88, 0xbd, 0xec, 0x77, 0x3b, 0xd5, 0x15, 0x54, 0x83, 0x0f, 0x16, 0xed,
+		0x3d, 0xab, 0x3b, 0xe8, 0x3e, 0xad, 0x16, 0x9e, 0xfc, 0x02, 0xdb, 0x4b, 0x3e, 0x2e, 0x7a, 0x04,
+		0x87, 0x66, 0xbf, 0x7b, 0xd5, 0x1c, 0x98, 0xdd, 0x8e, 0x7d, 0x61, 0x75, 0xbf, 0xeb, 0xd9, 0xfd,
+		0x41, 0x73, 0x30, 0xaf, 0xe3, 0x5e, 0xca, 0x65, 0xbb, 0x79, 0x35, 0xb8, 0x7c, 0x53, 0xd5, 0xee,
+		0xa7, 0xb4, 0xac, 0xa6, 0xd9, 0x69, 0xb7, 0xaa, 0x2b, 0xa7, 0x3f, 0xc0, 0x9e, 0xc3, 0x83, 0x65,
+		0x37, 0x75, 0x5a, 0x3e, 0x4b, 0x9e, 0x28, 0x3d, 0x55, 0xf5, 0x3d, 0xed, 0xfb, 0x93, 0x11, 0x95,
+		0x6f, 0xe3, 0xa1, 0xe1, 0xf0, 0xa0, 0x3e, 0xff, 0xa0, 0xf9, 0x9c, 0xba, 0x7e, 0x7d, 0xc4, 0xd3,
+		0x67, 0x4a, 0xf6, 0xba, 0x79, 0x8e, 0x43, 0x3a, 0x39, 0x19, 0x96, 0x12, 0xdb, 0xd3, 0xbf, 0x06,
+		0x00, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xed, 0x4e, 0xe3, 0x46,
+		0x17, 0x7e, 0xf3, 0xb5, 0xc0, 0xc9, 0x02, 0x66, 0x80, 0x25, 0xc9, 0xbe, 0xdb, 0xb2, 0xf9, 0x81,
+		0x28, 0x6a, 0x1d, 0x41, 0x5b, 0xa9, 0x6a, 0xab, 0xed, 0x06, 0x82, 0x76, 0x2d, 0x3e, 0x16, 0x39,
+		0x5e, 0x2a, 0x2a, 0x55, 0xee, 0xc4, 0x1e, 0xc2, 0x34, 0xb6, 0xc7, 0x9a, 0x19, 0x27, 0x70, 0x05,
+		0xbd, 0x83, 0x5e, 0x4c, 0xef, 0xa1, 0xf7, 0x54, 0xcd, 0xd8, 0x81, 0x7c, 0x18, 0xd4, 0xfd, 0xd1,
+		0x7f, 0x99, 0x73, 0xe6, 0x99, 0xe7, 0x3c, 0xe7, 0x2b, 0x86, 0x66, 0xd2, 0x23, 0xbc, 0xe5, 0x61,
+		0x9f, 0x44, 0x1e, 0x69, 0xe1, 0x98, 0xb6, 0x86, 0xfb, 0x2d, 0x89, 0xc5, 0x20, 0xa0, 0x42, 0x9a,
+		0x31, 0x67, 0x92, 0xa1, 0x75, 0x75, 0xc7, 0xcc, 0xee, 0x98, 0x38, 0xa6, 0xe6, 0x70, 0xbf, 0xf1,
+		0x59, 0x9f, 0xb1, 0x7e, 0x40, 0x5a, 0xfa, 0x4a, 0x2f, 0xb9, 0x6e, 0xf9, 0x09, 0xc7, 0x92, 0xb2,
+		0x28, 0x05, 0x35, 0x3e, 0x9f, 0xf5, 0x4b, 0x1a, 0x12, 0x21, 0x71, 0x18, 0x67, 0x17, 0xe6, 0x1e,
+		0x18, 0x71, 0x1c, 0xc7, 0x84, 0x8b, 0xd4, 0xdf, 0xfc, 0x08, 0x8b, 0x0e, 0x16, 0x83, 0x53, 0x2a,
+		0x24, 0x42, 0x50, 0x8e, 0x70, 0x48, 0x6a, 0x85, 0xed, 0xc2, 0xee, 0x92, 0xad, 0x7f, 0xa3, 0x6f,
+		0xa1, 0x3c, 0xa0, 0x91, 0x5f, 0x2b, 0x6e, 0x17, 0x76, 0x57, 0x0e, 0x5e, 0x9b, 0x39, 0x41, 0x9a,
+		0xe3, 0x07, 0x4e, 0x68, 0xe4, 0xdb, 0xfa, 0x7a, 0x13, 0x83, 0x31, 0xb6, 0x9e, 0x11, 0x89, 0x7d,
+		0x2c, 0x31, 0x3a, 0x83, 0x8d, 0x10, 0xdf, 0xba, 0x4a, 0xb6, 0x70, 0x63, 0xc2, 0x5d, 0x41, 0x3c,
+		0x16, 0xf9, 0x9a, 0xae, 0x7a, 0xf0, 0x7f, 0x33, 0x8d, 0xd4, 0x1c, 0x47, 0x6a, 0x76, 0x58, 0xd2,
+		0x0b, 0xc8, 0x25, 0x0e, 0x12, 0x62, 0xaf, 0x85, 0xf8, 0x56, 0x3d, 0x28, 0x2e, 0x08, 0xef, 0x6a,
+		0x58, 0xf3, 0x23, 0xd4, 0xc7, 0x14, 0x17, 0x98, 0x4b, 0xaa, 0xb2, 0x72, 0xcf, 0x65, 0x40, 0x69,
+		0x40, 0xee, 0x32, 0x25, 0xea, 0x27, 0xda, 0x81, 0x55, 0x36, 0x8a, 0x08, 0x77, 0x6f, 0x98, 0x90,
+		0xae, 0xd6, 0x59, 0xd4, 0xde, 0x65, 0x6d, 0x7e, 0xcf, 0x84, 0x3c, 0xc7, 0x21, 0x69, 0x0e, 0x60,
+		0xd3, 0x12, 0x2c, 0xd0, 0x49, 0x7e, 0xc7, 0x59, 0x12, 0x9f, 0x11, 0xc9, 0xa9, 0x27, 0x50, 0x0b,
+		0x36, 0x22, 0x32, 0xca, 0x0f, 0xbf, 0x60, 0xaf, 0x45, 0x64, 0x34, 0x1d, 0x20, 0x7a, 0x0d, 0xcf,
+		0x63, 0x16, 0x04, 0x84, 0xbb, 0x1e, 0x4b, 0x22, 0xa9, 0xe9, 0x4a, 0x76, 0x35, 0xb5, 0x1d, 0x29,
+		0x53, 0xf3, 0x8f, 0x32, 0xac, 0x8c, 0x45, 0x74, 0x25, 0x96, 0x89, 0x40, 0x5f, 0x02, 0xea, 0x61,
+		0x6f, 0x10, 0xb0, 0x7e, 0x0a, 0x73, 0x6f, 0x68, 0x24, 0x35, 0x49, 0xc9, 0x36, 0x32, 0x8f, 0x06,
+		0xbf, 0xa7, 0x91, 0x44, 0xaf, 0x00, 0x38, 0xc1, 0xbe, 0x1b, 0x90, 0x21, 0x09, 0x32, 0x86, 0x25,
+		0x65, 0x39, 0x55, 0x06, 0xf4, 0x12, 0x96, 0xb0, 0x37, 0xc8, 0xbc, 0x25, 0xed, 0x5d, 0xc4, 0xde,
+		0x20, 0x75, 0xee, 0xc0, 0x2a, 0xc7, 0x92, 0x4c, 0x6a, 0x29, 0x6b, 0x2d, 0xcb, 0xca, 0xfc, 0xa0,
+		0xa3, 0x03, 0xcb, 0x4a, 0xb4, 0x4b, 0x7d, 0xb7, 0x17, 0x30, 0x6f, 0x50, 0xab, 0xe8, 0x82, 0x6d,
+		0x3f, 0xda, 0x0b, 0x56, 0xe7, 0x50, 0xdd, 0xb3, 0xab, 0x0a, 0x66, 0xf9, 0xfa, 0x80, 0x86, 0xb0,
+		0x45, 0xc7, 0x79, 0x75, 0xfb, 0x2a, 0xb1, 0x6e, 0x98, 0x66, 0xb6, 0xf6, 0x6c, 0xbb, 0xb4, 0x5b,
+		0x3d, 0x78, 0xf3, 0x64, 0x6f, 0xa5, 0xd9, 0x31, 0x73, 0x4b, 0x73, 0x1c, 0x49, 0x7e, 0x67, 0x6f,
+		0xd2, 0x4f, 0x2a, 0xdb, 0xc2, 0x23, 0x65, 0x6b, 0x48, 0x68, 0x3c, 0xce, 0x92, 0xd3, 0x58, 0x6f,
+		0xa1, 0x32, 0x54, 0x3d, 0xaa, 0xb3, 0x5f, 0x3d, 0xd8, 0xcb, 0x95, 0x91, 0xfb, 0xa2, 0x9d, 0x02,
+		0xbf, 0x2f, 0x7e, 0x57, 0x68, 0xfe, 0x04, 0xd5, 0x89, 0xd4, 0xa1, 0x3a, 0x2c, 0x0a, 0x89, 0xb9,
+		0x74, 0xa9, 0x9f, 0xd5, 0x7e, 0x41, 0x9f, 0x2d, 0x1f, 0x6d, 0xc2, 0x33, 0x12, 0xf9, 0xca, 0x91,
+		0x96, 0xbb, 0x42, 0x22, 0xdf, 0xf2, 0x9b, 0x7f, 0x16, 0x00, 0x2e, 0x74, 0x6b, 0x59, 0xd1, 0x35,
+		0x43, 0x1d, 0x30, 0x02, 0x2c, 0xa4, 0x8b, 0x3d, 0x8f, 0x08, 0xe1, 0xaa, 0xb5, 0x90, 0x0d, 0x5a,
+		0x63, 0x6e, 0xd0, 0x9c, 0xf1, 0xce, 0xb0, 0x57, 0x14, 0xa6, 0xad, 0x21, 0xca, 0x88, 0x1a, 0xb0,
+		0x48, 0x7d, 0x12, 0x49, 0x2a, 0xef, 0xb2, 0x69, 0xb9, 0x3f, 0xe7, 0xb5, 0x4f, 0x29, 0xa7, 0x7d,
+		0x9a, 0x7f, 0x15, 0xa0, 0xde, 0x95, 0xd4, 0x1b, 0xdc, 0x1d, 0xdf, 0x12, 0x2f, 0x51, 0x49, 0x68,
+		0x4b, 0xc9, 0x69, 0x2f, 0x91, 0x44, 0xa0, 0x77, 0x60, 0x8c, 0x18, 0x1f, 0x10, 0xae, 0x2b, 0xe4,
+		0xaa, 0x7d, 0x98, 0xc5, 0xf9, 0xea, 0xc9, 0x7e, 0xb0, 0x57, 0x52, 0xd8, 0xf8, 0x8c, 0x1c, 0xa8,
+		0x0b, 0xef, 0x86, 0xf8, 0x49, 0x40, 0x5c, 0xc9, 0xdc, 0x34, 0x7b, 0x4a, 0x36, 0x4b, 0x64, 0x56,
+		0x9a, 0xfa, 0xfc, 0x8a, 0xc9, 0xb6, 0xa9, 0xfd, 0x62, 0x8c, 0x75, 0x58, 0x57, 0x21, 0x9d, 0x14,
+		0xd8, 0x7c, 0x03, 0x6b, 0x73, 0x4b, 0x06, 0x7d, 0x01, 0xc6, 0x4c, 0x2b, 0x8b, 0x5a, 0x61, 0xbb,
+		0xb4, 0xbb, 0x64, 0xaf, 0x4e, 0xf7, 0xa0, 0x68, 0xfe, 0x5d, 0x86, 0xad, 0xb9, 0x07, 0x8e, 0x58,
+		0x74, 0x4d, 0xfb, 0xa8, 0x06, 0x0b, 0x43, 0xc2, 0x05, 0x65, 0xd1, 0xb8, 0xc4, 0xd9, 0x11, 0x1d,
+		0xc0, 0x7a, 0x94, 0x84, 0xae, 0x9e, 0xec, 0x78, 0x8c, 0x12, 0x5a, 0x45, 0xe5, 0xb0, 0x58, 0x53,
+		0x6d, 0x9b, 0x84, 0x36, 0xc1, 0xfe, 0xfd, 0x93, 0x02, 0x7d, 0x03, 0x1b, 0x0a, 0x33, 0xe2, 0x54,
+		0xd5, 0xe4, 0x01, 0x54, 0xba, 0x07, 0xa1, 0x28, 0x09, 0x7f, 0x56, 0xee, 0x09, 0x14, 0x85, 0xd5,
+		0x59, 0x96, 0xb2, 0x9e, 0xc6, 0xb7, 0x4f, 0x66, 0x7f, 0x46, 0x8a, 0x39, 0x1d, 0x4b, 0x3a, 0x8f,
+		0x2b, 0x7c, 0x3a, 0xc0, 0x00, 0x8c, 0xb9, 0xe0, 0x2a, 0x9a, 0xab, 0xfd, 0x49, 0x5c, 0x33, 0x12,
+		0x52, 0xb2, 0xd5, 0xd1, 0xb4, 0xb5, 0x41, 0x61, 0x3d, 0x27, 0xa8, 0xc9, 0xf1, 0xad, 0xa4, 0xe3,
+		0xfb, 0xe3, 0xf4, 0xf8, 0xee, 0xfc, 0xbb, 0x58, 0x26, 0x46, 0xb7, 0xf1, 0x3b, 0x6c, 0xe4, 0xc5,
+		0xf4, 0x5f, 0x70, 0xed, 0xfd, 0x06, 0xcf, 0x27, 0xff, 0x6d, 0x51, 0x03, 0x5e, 0x38, 0xed, 0xee,
+		0x89, 0x7b, 0x6a, 0x75, 0x1d, 0xf7, 0xc4, 0x3a, 0xef, 0xb8, 0xd6, 0xf9, 0x65, 0xfb, 0xd4, 0xea,
+		0x18, 0xff, 0x43, 0x75, 0xd8, 0x9c, 0xf1, 0x9d, 0x7f, 0xb0, 0xcf, 0xda, 0xa7, 0x46, 0x21, 0xc7,
+		0xd5, 0x75, 0xac, 0xa3, 0x93, 0x2b, 0xa3, 0xb8, 0xe7, 0x3f, 0x30, 0x38, 0x77, 0x31, 0x99, 0x66,
+		0x70, 0xae, 0x2e, 0x8e, 0x27, 0x18, 0x5e, 0xc2, 0xd6, 0x8c, 0xaf, 0x73, 0x7c, 0x64, 0x75, 0xad,
+		0x0f, 0xe7, 0x46, 0x21, 0xc7, 0xd9, 0x3e, 0x72, 0xac, 0x4b, 0xcb, 0xb9, 0x32, 0x8a, 0x87, 0xbf,
+		0xc2, 0x96, 0xc7, 0xc2, 0x3c, 0xfd, 0x87, 0xcb, 0xf7, 0x09, 0x50, 0x53, 0x7a, 0x51, 0xf8, 0x65,
+		0xbf, 0x4f, 0xe5, 0x4d, 0xd2, 0x33, 0x3d, 0x16, 0xb6, 0x26, 0xbf, 0xa3, 0xbe, 0xa2, 0x7e, 0xd0,
+		0xea, 0xb3, 0xf4, 0xd3, 0x26, 0xfb, 0xa8, 0xfa, 0x01, 0xc7, 0x74, 0xb8, 0xdf, 0x7b, 0xa6, 0x6d,
+		0x5f, 0xff, 0x33, 0x00, 0xe6, 0xf6, 0xce, 0x1e, 0x78, 0x09, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x72, 0xdb, 0xc8,
+		0xf1, 0xfe, 0x81, 0x94, 0x64, 0xa9, 0xa9, 0x3f, 0xd0, 0x48, 0xb2, 0x68, 0x79, 0xd7, 0x96, 0xb9,
+		0x6b, 0x5b, 0xe6, 0x6f, 0x45, 0xad, 0xec, 0xb5, 0xbd, 0xb6, 0xe2, 0x38, 0x10, 0x08, 0x59, 0xb0,
+		0x29, 0x90, 0x19, 0x80, 0x96, 0xb5, 0x95, 0x04, 0x05, 0x91, 0x90, 0x84, 0x98, 0x04, 0x58, 0xc0,
+		0xd0, 0xb6, 0xee, 0xa9, 0xca, 0x39, 0x39, 0xa5, 0x72, 0xca, 0x03, 0x24, 0x95, 0x4a, 0xe5, 0x90,
+		0x53, 0x2a, 0x4f, 0x90, 0x6b, 0x5e, 0x21, 0x95, 0xb7, 0x48, 0xcd, 0x60, 0x40, 0x82, 0x7f, 0x41,
+		0x25, 0x55, 0x9b, 0x9b, 0xd0, 0xf3, 0x7d, 0x8d, 0x9e, 0x9e, 0xee, 0xaf, 0x87, 0x10, 0xe4, 0xda,
+		0xa7, 0xb6, 0xbf, 0x53, 0xb3, 0xea, 0xb6, 0x5b, 0xb3, 0x77, 0xac, 0x96, 0xb3, 0xf3, 0x61, 0x77,
+		0xe7, 0xa3, 0xe7, 0xbf, 0x3f, 0x6b, 0x78, 0x1f, 0x0b, 0x2d, 0xdf, 0x23, 0x1e, 0x5a, 0xa1, 0x98,
+		0x02, 0xc7, 0x14, 0xac, 0x96, 0x53, 0xf8, 0xb0, 0xbb, 0x71, 0xeb, 0xdc, 0xf3, 0xce, 0x1b, 0xf6,
+		0x0e, 0x83, 0x9c, 0xb6, 0xcf, 0x76, 0xea, 0x6d, 0xdf, 0x22, 0x8e, 0xe7, 0x86, 0xa4, 0x8d, 0xdb,
+		0xfd, 0xeb, 0xc4, 0x69, 0xda, 0x01, 0xb1, 0x9a, 0x2d, 0x0e, 0xd8, 0x1c, 0xf6, 0xe6, 0x9a, 0xd7,
+		0x6c, 0x76, 0x5c, 0x0c, 0x8d, 0x8d, 0x58, 0xc1, 0xfb, 0x86, 0x13, 0x90, 0x10, 0x93, 0xfb, 0xc3,
+		0x2c, 0xac, 0x1d, 0xf3, 0x70, 0x95, 0x4f, 0x76, 0xad, 0x4d, 0x43, 0x50, 0xdd, 0x33, 0x0f, 0x55,
+		0x01, 0x45, 0xfb, 0x30, 0xed, 0x68, 0x25, 0x2b, 0x6c, 0x0a, 0x5b, 0x99, 0x87, 0xf7, 0x0a, 0x43,
+		0xb6, 0x54, 0x18, 0xf0, 0x83, 0x97, 0x3f, 0xf6, 0x9b, 0xd0, 0x63, 0x98, 0x22, 0x97, 0x2d, 0x3b,
+		0x9b, 0x62, 0x8e, 0xee, 0x8c, 0x75, 0x64, 0x5c, 0xb6, 0x6c, 0xcc, 0xe0, 0xe8, 0x19, 0x40, 0x40,
+		0x2c, 0x9f, 0x98, 0x34, 0x0d, 0xd9, 0x34, 0x23, 0x6f, 0x14, 0xc2, 0x1c, 0x15, 0xa2, 0x1c, 0x15,
+		0x8c, 0x28, 0x47, 0x78, 0x8e, 0xa1, 0xe9, 0x33, 0xa5, 0xd6, 0x1a, 0x5e, 0x60, 0x87, 0xd4, 0xa9,
+		0x64, 0x2a, 0x43, 0x33, 0xaa, 0x01, 0xf3, 0x21, 0x35, 0x20, 0x16, 0x69, 0x07, 0xd9, 0xe9, 0x4d,
+		0x61, 0x6b, 0xf1, 0xe1, 0xee, 0x64, 0xbb, 0x97, 0x29, 0x53, 0x67, 0x44, 0x9c, 0xa9, 0x75, 0x1f,
+		0xd0, 0x5d, 0x58, 0xbc, 0x70, 0x02, 0xe2, 0xf9, 0x97, 0x66, 0xc3, 0x76, 0xcf, 0xc9, 0x45, 0x76,
+		0x66, 0x53, 0xd8, 0x4a, 0xe3, 0x05, 0x6e, 0x2d, 0x31, 0x23, 0xfa, 0x09, 0xac, 0xb5, 0x2c, 0xdf,
+		0x76, 0x49, 0x37, 0xfd, 0xa6, 0xe3, 0x9e, 0x79, 0xd9, 0x6b, 0x6c, 0x0b, 0x5b, 0x43, 0xa3, 0xa8,
+		0x30, 0x46, 0xcf, 0x49, 0xe2, 0x95, 0xd6, 0xa0, 0x11, 0x49, 0xb0, 0xd8, 0x75, 0xcb, 0x32, 0x33,
+		0x9b, 0x98, 0x99, 0x85, 0x0e, 0x83, 0x65, 0x67, 0x1b, 0xa6, 0x9a, 0x76, 0xd3, 0xcb, 0xce, 0x31,
+		0xe2, 0x8d, 0xa1, 0xf1, 0x1c, 0xd9, 0x4d, 0x0f, 0x33, 0x18, 0xc2, 0xb0, 0x1c, 0xd8, 0x96, 0x5f,
+		0xbb, 0x30, 0x2d, 0x42, 0x7c, 0xe7, 0xb4, 0x4d, 0xec, 0x20, 0x0b, 0x8c, 0x7b, 0x77, 0x28, 0x57,
+		0x67, 0x68, 0xa9, 0x03, 0xc6, 0x62, 0xd0, 0x67, 0x41, 0x25, 0x58, 0xb6, 0xda, 0xc4, 0x33, 0x7d,
+		0x3b, 0xb0, 0x89, 0xd9, 0xf2, 0x1c, 0x97, 0x04, 0xd9, 0x0c, 0xf3, 0xb9, 0x39, 0xd4, 0x27, 0xa6,
+		0xc0, 0x0a, 0xc3, 0xe1, 0x25, 0x4a, 0x8d, 0x19, 0xd0, 0x4d, 0x98, 0xa3, 0xed, 0x61, 0xd2, 0xfe,
+		0xc8, 0xce, 0x6f, 0x0a, 0x5b, 0x73, 0x78, 0x96, 0x1a, 0x4a, 0x4e, 0x40, 0xd0, 0x3a, 0x5c, 0x73,
+		0x02, 0xb3, 0xe6, 0x7b, 0x6e, 0x76, 0x61, 0x53, 0xd8, 0x9a, 0xc5, 0x33, 0x4e, 0x20, 0xfb, 0x9e,
+		0x8b, 0xf6, 0x20, 0xd3, 0x6e, 0xd5, 0x2d, 0xc2, 0x0b, 0x6c, 0x31, 0x31, 0x8d, 0x10, 0xc2, 0x59,
+		0x0e, 0x7f, 0x0e, 0x62, 0xcb, 0xf2, 0x89, 0xc3, 0x8e, 0xa1, 0xe6, 0xb9, 0x67, 0xce, 0x79, 0x76,
+		0x69, 0x33, 0xbd, 0x95, 0x79, 0xf8, 0x72, 0xb2, 0x2a, 0xa3, 0x87, 0x59, 0xa8, 0x44, 0x2e, 0x64,
+		0xe6, 0x41, 0x71, 0x89, 0x7f, 0x89, 0x97, 0x5a, 0xbd, 0xd6, 0x8d, 0x7d, 0x58, 0x1d, 0x06, 0x44,
+		0x22, 0xa4, 0xdf, 0xdb, 0x97, 0xac, 0xb5, 0xe7, 0x30, 0xfd, 0x13, 0xad, 0xc2, 0xf4, 0x07, 0xab,
+		0xd1, 0x0e, 0xbb, 0x74, 0x0e, 0x87, 0x0f, 0xcf, 0x53, 0xdf, 0x0a, 0xb9, 0xdf, 0xa4, 0xe0, 0xd6,
+		0x60, 0xa5, 0x33, 0x67, 0x5c, 0xbf, 0xd0, 0xf3, 0x78, 0x16, 0x43, 0xbd, 0xf8, 0x7c, 0xe8, 0x5e,
+		0x0c, 0x9e, 0xda, 0x58, 0x92, 0x2d, 0xd8, 0xec, 0x56, 0x25, 0x6f, 0x78, 0xcf, 0xec, 0xb6, 0xaf,
+		0xd7, 0x26, 0x5c, 0x39, 0x6e, 0x0c, 0x24, 0xb8, 0xc8, 0x03, 0xc0, 0x9f, 0x75, 0x5c, 0xe8, 0x4c,
+		0x04, 0x3c, 0x39, 0x6a, 0x68, 0xaf, 0x4d, 0xd0, 0x31, 0xdc, 0x64, 0xe1, 0x8d, 0xf0, 0x9e, 0x4e,
+		0xf2, 0xbe, 0x4e, 0xd9, 0x43, 0x1c, 0xe7, 0xfe, 0x2e, 0xc0, 0xca, 0x90, 0xf6, 0xa3, 0x55, 0x55,
+		0xf7, 0x9a, 0x96, 0xe3, 0x9a, 0x4e, 0x9d, 0x27, 0x79, 0x36, 0x34, 0xa8, 0x75, 0x74, 0x1b, 0x32,
+		0x7c, 0xd1, 0xb5, 0x9a, 0x51, 0xbe, 0x21, 0x34, 0x69, 0x56, 0xd3, 0x1e, 0x21, 0xc3, 0xe9, 0xff,
+		0x56, 0x86, 0xef, 0xc0, 0xbc, 0xe3, 0x3a, 0xc4, 0xb1, 0x88, 0x5d, 0xa7, 0x71, 0x4d, 0x31, 0x05,
+		0xca, 0x74, 0x6c, 0x6a, 0x3d, 0xf7, 0x2b, 0x01, 0xd6, 0x94, 0x4f, 0xc4, 0xf6, 0x5d, 0xab, 0xf1,
+		0xbd, 0x8c, 0x86, 0xfe, 0x98, 0x52, 0x83, 0x31, 0xfd, 0x65, 0x06, 0x56, 0x2a, 0xb6, 0x5b, 0x77,
+		0xdc, 0x73, 0xa9, 0x46, 0x9c, 0x0f, 0x0e, 0xb9, 0x64, 0x11, 0xdd, 0x86, 0x8c, 0xc5, 0x9f, 0xbb,
+		0x59, 0x86, 0xc8, 0xa4, 0xd6, 0xd1, 0x01, 0x2c, 0x74, 0x00, 0x89, 0xf3, 0x27, 0x72, 0xcd, 0xe6,
+		0xcf, 0xbc, 0x15, 0x7b, 0x42, 0x2f, 0x61, 0x9a, 0xce, 0x82, 0x70, 0x04, 0x2d, 0x3e, 0x7c, 0x30,
+		0x5c, 0x84, 0x7b, 0x23, 0xa4, 0xb2, 0x6f, 0xe3, 0x90, 0x87, 0x54, 0x58, 0xbe, 0xb0, 0x2d, 0x9f,
+		0x9c, 0xda, 0x16, 0x31, 0xeb, 0x36, 0xb1, 0x9c, 0x46, 0xc0, 0x87, 0xd2, 0x67, 0x23, 0x14, 0xfd,
+		0xb2, 0xe1, 0x59, 0x75, 0x2c, 0x76, 0x68, 0xc5, 0x90, 0x85, 0x5e, 0xc3, 0x4a, 0xc3, 0x0a, 0x88,
+		0xd9, 0xf5, 0xc7, 0x04, 0x68, 0x3a, 0x51, 0x80, 0x96, 0x29, 0xed, 0x30, 0x62, 0x51, 0x3b, 0x3a,
+		0x00, 0x66, 0x0c, 0xbb, 0xc2, 0xae, 0x87, 0x9e, 0x66, 0x12, 0x3d, 0x2d, 0x51, 0x92, 0x1e, 0x72,
+		0x98, 0x9f, 0x2c, 0x5c, 0xb3, 0x08, 0xb1, 0x9b, 0x2d, 0xc2, 0xc6, 0xd4, 0x34, 0x8e, 0x1e, 0xd1,
+		0x03, 0x10, 0x9b, 0xd6, 0x27, 0xa7, 0xd9, 0x6e, 0x9a, 0xdc, 0x14, 0xb0, 0x91, 0x33, 0x8d, 0x97,
+		0xb8, 0x5d, 0xe2, 0x66, 0x3a, 0x9b, 0x82, 0xda, 0x85, 0x5d, 0x6f, 0x37, 0xa2, 0x48, 0xe6, 0x92,
+		0x67, 0x53, 0x87, 0xc1, 0xe2, 0x90, 0x61, 0xc9, 0xfe, 0xd4, 0x72, 0xc2, 0x9e, 0x0d, 0x7d, 0x40,
+		0xa2, 0x8f, 0xc5, 0x2e, 0x85, 0x39, 0x79, 0x09, 0xf3, 0x2c, 0x29, 0x67, 0x96, 0xd3, 0x68, 0xfb,
+		0x76, 0x36, 0x33, 0xe6, 0x98, 0x0e, 0x42, 0x0c, 0xce, 0x50, 0x06, 0x7f, 0x40, 0x5f, 0xc3, 0x2a,
+		0x73, 0x40, 0x6b, 0xdd, 0xf6, 0x4d, 0xa7, 0x6e, 0xbb, 0xc4, 0x21, 0x97, 0x7c, 0xb6, 0x20, 0xba,
+		0x76, 0xcc, 0x96, 0x54, 0xbe, 0x82, 0x9e, 0xc0, 0x7a, 0x74, 0x04, 0xfd, 0xa4, 0x05, 0x46, 0x5a,
+		0xe3, 0xcb, 0x7d, 0xbc, 0xdb, 0x90, 0x89, 0x12, 0x40, 0x1b, 0x60, 0x91, 0xb5, 0x0e, 0x44, 0x26,
+		0xb5, 0x9e, 0xfb, 0x73, 0x0a, 0x6e, 0xf0, 0xba, 0x94, 0x2f, 0x9c, 0x46, 0xfd, 0x7b, 0xe9, 0xe8,
+		0xaf, 0x62, 0x6e, 0x69, 0xd7, 0xc5, 0x45, 0x4e, 0xfc, 0x18, 0xbb, 0xe5, 0x31, 0xa9, 0xeb, 0xef,
+		0xff, 0xf4, 0x40, 0xff, 0xa3, 0xb7, 0xc0, 0x2f, 0x33, 0x5c, 0xb5, 0x5b, 0x5e, 0xc3, 0xa9, 0x5d,
+		0xb2, 0xfe, 0x59, 0x1c, 0x11, 0x68, 0x28, 0xc9, 0x4c, 0xa9, 0x2b, 0x0c, 0x8d, 0x97, 0x5b, 0xfd,
+		0x26, 0x74, 0x1d, 0x66, 0x42, 0xcd, 0x65, 0xdd, 0x33, 0x87, 0xf9, 0x53, 0xee, 0x9f, 0xa9, 0x8e,
+		0xde, 0x14, 0xed, 0x9a, 0x13, 0x44, 0xf9, 0xea, 0xc8, 0x80, 0x90, 0x2c, 0x03, 0x11, 0xb1, 0x47,
+		0x06, 0x06, 0x4b, 0x3c, 0x75, 0xd5, 0x12, 0x7f, 0x01, 0xf3, 0x3d, 0xdd, 0x9a, 0x7c, 0x29, 0xce,
+		0x04, 0xc3, 0x3b, 0x75, 0xaa, 0xb7, 0x53, 0x31, 0xac, 0x7b, 0xbe, 0x73, 0xee, 0xb8, 0x56, 0xc3,
+		0xec, 0x0b, 0x32, 0x59, 0x5b, 0xd6, 0x22, 0xaa, 0xde, 0x13, 0x6c, 0x5f, 0x7d, 0xce, 0x0c, 0xd4,
+		0xe7, 0x5f, 0x53, 0x70, 0x23, 0x12, 0xcc, 0x92, 0x57, 0xb3, 0x1a, 0x45, 0x27, 0x68, 0x59, 0xa4,
+		0x76, 0x31, 0x99, 0xbe, 0xff, 0xef, 0xf3, 0xf9, 0x33, 0xb8, 0xd5, 0x1b, 0x81, 0xe9, 0x9d, 0x99,
+		0xe4, 0xc2, 0x09, 0xcc, 0x78, 0x9a, 0xc7, 0x3b, 0xdc, 0xe8, 0x89, 0xa8, 0x7c, 0x66, 0x5c, 0x38,
+		0x01, 0x57, 0x45, 0xf4, 0x39, 0x00, 0xbb, 0xb7, 0x10, 0xef, 0xbd, 0x1d, 0x96, 0xe9, 0x3c, 0x66,
+		0x17, 0x2d, 0x83, 0x1a, 0x72, 0xaf, 0x21, 0x13, 0xbf, 0xca, 0xee, 0xc1, 0x0c, 0xbf, 0x0d, 0x0b,
+		0xec, 0x36, 0xf9, 0x45, 0xc2, 0x6d, 0x98, 0xfd, 0x50, 0xe0, 0x94, 0xdc, 0x1f, 0x53, 0xb0, 0xd8,
+		0xbb, 0x84, 0xee, 0xc3, 0xd2, 0xa9, 0xe3, 0x5a, 0xfe, 0xa5, 0x59, 0xbb, 0xb0, 0x6b, 0xef, 0x83,
+		0x76, 0x93, 0x1f, 0xc2, 0x62, 0x68, 0x96, 0xb9, 0x15, 0xad, 0xc1, 0x8c, 0xdf, 0x76, 0xa3, 0xf1,
+		0x3d, 0x87, 0xa7, 0xfd, 0x36, 0xbd, 0xe7, 0xbc, 0x80, 0x9b, 0x67, 0x8e, 0x1f, 0xd0, 0x91, 0x17,
+		0x76, 0x83, 0x59, 0xf3, 0x9a, 0xad, 0x86, 0xdd, 0xd3, 0xea, 0x59, 0x06, 0x89, 0xfa, 0x45, 0x8e,
+		0x00, 0x8c, 0x3e, 0x5f, 0xf3, 0x6d, 0xab, 0x73, 0x36, 0xc9, 0xa9, 0xcc, 0x70, 0x3c, 0x17, 0xf2,
+		0x05, 0x26, 0xed, 0x8e, 0x7b, 0x3e, 0x69, 0x1d, 0xcf, 0x47, 0x04, 0xe6, 0xe0, 0x16, 0x00, 0xfb,
+		0x89, 0x41, 0xac, 0xd3, 0x46, 0x38, 0x17, 0x67, 0x71, 0xcc, 0x92, 0xff, 0x93, 0x00, 0xab, 0xc3,
+		0xa6, 0x3e, 0xca, 0xc1, 0xad, 0x8a, 0xa2, 0x15, 0x55, 0xed, 0x95, 0x29, 0xc9, 0x86, 0xfa, 0x56,
+		0x35, 0x4e, 0x4c, 0xdd, 0x90, 0x0c, 0xc5, 0x54, 0xb5, 0xb7, 0x52, 0x49, 0x2d, 0x8a, 0xff, 0x87,
+		0xbe, 0x84, 0xcd, 0x11, 0x18, 0x5d, 0x3e, 0x54, 0x8a, 0xd5, 0x92, 0x52, 0x14, 0x85, 0x31, 0x9e,
+		0x74, 0x43, 0xc2, 0x86, 0x52, 0x14, 0x53, 0xe8, 0xff, 0xe1, 0xfe, 0x08, 0x8c, 0x2c, 0x69, 0xb2,
+		0x52, 0x32, 0xb1, 0xf2, 0xe3, 0xaa, 0xa2, 0x53, 0x70, 0x3a, 0xff, 0x8b, 0x6e, 0xcc, 0x3d, 0x12,
+		0x15, 0x7f, 0x53, 0x51, 0x91, 0x55, 0x5d, 0x2d, 0x6b, 0xe3, 0x62, 0xee, 0xc3, 0x8c, 0x88, 0xb9,
+		0x1f, 0x15, 0xc5, 0x9c, 0xff, 0x65, 0xaa, 0xfb, 0x05, 0x42, 0xad, 0x63, 0xbb, 0xdd, 0x11, 0xe5,
+		0x2f, 0x61, 0xf3, 0xb8, 0x8c, 0xdf, 0x1c, 0x94, 0xca, 0xc7, 0xa6, 0x5a, 0x34, 0xb1, 0x52, 0xd5,
+		0x15, 0xb3, 0x52, 0x2e, 0xa9, 0xf2, 0x49, 0x2c, 0x92, 0x6f, 0xe1, 0x9b, 0x91, 0x28, 0xa9, 0x44,
+		0xad, 0xc5, 0x6a, 0xa5, 0xa4, 0xca, 0xf4, 0xad, 0x07, 0x92, 0x5a, 0x52, 0x8a, 0x66, 0x59, 0x2b,
+		0x9d, 0x88, 0x02, 0xfa, 0x0a, 0xb6, 0x26, 0x65, 0x8a, 0x29, 0xb4, 0x0d, 0x0f, 0x46, 0xa2, 0xb1,
+		0xf2, 0x5a, 0x91, 0x8d, 0x18, 0x3c, 0x8d, 0x76, 0x61, 0x7b, 0x24, 0xdc, 0x50, 0xf0, 0x91, 0xaa,
+		0xb1, 0x84, 0x1e, 0x98, 0xb8, 0xaa, 0x69, 0xaa, 0xf6, 0x4a, 0x9c, 0xca, 0xff, 0x4e, 0x80, 0xe5,
+		0x81, 0x69, 0x85, 0x6e, 0xc3, 0xcd, 0x8a, 0x84, 0x15, 0xcd, 0x30, 0xe5, 0x52, 0x79, 0x58, 0x02,
+		0x46, 0x00, 0xa4, 0x7d, 0x49, 0x2b, 0x96, 0x35, 0x51, 0x40, 0xf7, 0x20, 0x37, 0x0c, 0xc0, 0x6b,
+		0x81, 0x97, 0x86, 0x98, 0x42, 0x77, 0xe0, 0xf3, 0x61, 0xb8, 0x4e, 0xb4, 0x62, 0x3a, 0xff, 0xaf,
+		0x14, 0x7c, 0x36, 0xee, 0x43, 0x07, 0xad, 0xc0, 0xce, 0xb6, 0x95, 0x77, 0x8a, 0x5c, 0x35, 0xe8,
+		0x99, 0x87, 0xfe, 0xe8, 0xc9, 0x57, 0xf5, 0x58, 0xe4, 0xf1, 0x94, 0x8e, 0x00, 0xcb, 0xe5, 0xa3,
+		0x4a, 0x49, 0x31, 0x58, 0x35, 0xe5, 0xe1, 0x5e, 0x12, 0x3c, 0x3c, 0x60, 0x31, 0xd5, 0x73, 0xb6,
+		0xa3, 0x5c, 0xb3, 0x7d, 0xd3, 0x56, 0x40, 0x05, 0xc8, 0x27, 0xa1, 0x3b, 0x59, 0x28, 0x8a, 0x53,
+		0xe8, 0x1b, 0xf8, 0x3a, 0x39, 0x70, 0xcd, 0x50, 0xb5, 0xaa, 0x52, 0x34, 0x25, 0xdd, 0xd4, 0x94,
+		0x63, 0x71, 0x7a, 0x92, 0xed, 0x1a, 0xea, 0x11, 0xad, 0xcf, 0xaa, 0x21, 0xce, 0xe4, 0xff, 0x26,
+		0xc0, 0x75, 0xd9, 0x73, 0x89, 0xe3, 0xb6, 0x6d, 0x29, 0xd0, 0xec, 0x8f, 0x6a, 0x78, 0x11, 0xf2,
+		0x7c, 0x74, 0x17, 0xee, 0x44, 0xfe, 0xb9, 0x7b, 0x53, 0xd5, 0x54, 0x43, 0x95, 0x8c, 0x32, 0x8e,
+		0xe5, 0x77, 0x2c, 0x8c, 0x36, 0x64, 0x51, 0xc1, 0x61, 0x5e, 0x47, 0xc3, 0xb0, 0x62, 0xe0, 0x13,
+		0x5e, 0x0a, 0xa1, 0xc2, 0x8c, 0xc6, 0xca, 0xb8, 0xac, 0x75, 0xfa, 0x5f, 0x4c, 0xe7, 0x7f, 0x2f,
+		0x40, 0x86, 0xff, 0x3a, 0x66, 0x3f, 0x9e, 0xb2, 0xb0, 0x4a, 0x37, 0x58, 0xae, 0x1a, 0xa6, 0x71,
+		0x52, 0x51, 0x7a, 0x6b, 0xb8, 0x67, 0x85, 0xc9, 0x83, 0x69, 0x94, 0xc3, 0xec, 0x84, 0x4a, 0xd2,
+		0x0b, 0xe0, 0x6f, 0xa1, 0x18, 0x06, 0x16, 0x53, 0x63, 0x31, 0xa1, 0x9f, 0x34, 0xda, 0x80, 0xeb,
+		0x3d, 0x98, 0x43, 0x45, 0xc2, 0xc6, 0xbe, 0x22, 0x19, 0xe2, 0x54, 0xfe, 0xb7, 0x02, 0xdc, 0x88,
+		0x94, 0x90, 0x7e, 0x9b, 0xa0, 0xa1, 0xd7, 0xcb, 0x6d, 0x22, 0x5b, 0xed, 0xc0, 0x46, 0x0f, 0xe0,
+		0x6e, 0x47, 0xc3, 0x0c, 0x49, 0x7f, 0xd3, 0x3d, 0x2b, 0x53, 0x96, 0xaa, 0x7a, 0x7c, 0x37, 0x89,
+		0x50, 0x1e, 0x82, 0x28, 0xa0, 0xfb, 0xf0, 0xc5, 0x78, 0x28, 0x56, 0x74, 0xc5, 0x10, 0x53, 0xf9,
+		0x7f, 0x64, 0x60, 0x3d, 0x1e, 0x1c, 0xfd, 0x89, 0x61, 0xd7, 0xc3, 0xd0, 0xee, 0x41, 0xae, 0xd7,
+		0x09, 0xd7, 0xb9, 0xfe, 0xb8, 0x76, 0x61, 0x7b, 0x0c, 0xae, 0xaa, 0x1d, 0x4a, 0x5a, 0x91, 0x3e,
+		0x47, 0x20, 0x51, 0x40, 0x2f, 0x61, 0x6f, 0x0c, 0x65, 0x5f, 0x2a, 0x76, 0xb3, 0xdc, 0x99, 0x38,
+		0x92, 0x61, 0x60, 0x75, 0xbf, 0x6a, 0x28, 0xba, 0x98, 0x42, 0x0a, 0x48, 0x09, 0x0e, 0x7a, 0x75,
+		0x68, 0xa8, 0x9b, 0x34, 0x7a, 0x06, 0x8f, 0x93, 0xe2, 0x08, 0x4b, 0x46, 0x3d, 0x52, 0x70, 0x9c,
+		0x3a, 0x85, 0x9e, 0xc3, 0x93, 0x04, 0x2a, 0x7f, 0xf3, 0x00, 0x77, 0x1a, 0xed, 0xc1, 0xd3, 0xc4,
+		0xe8, 0xe5, 0x32, 0x2e, 0x9a, 0x47, 0x12, 0x7e, 0xd3, 0x4b, 0x9e, 0x41, 0x2a, 0x28, 0x49, 0x2f,
+		0xe6, 0xea, 0x66, 0x0e, 0xd1, 0x85, 0x98, 0xab, 0x6b, 0x13, 0x64, 0x91, 0x1a, 0x12, 0xdc, 0xcc,
+		0xa2, 0x57, 0x20, 0x4f, 0x96, 0x8a, 0xf1, 0x8e, 0xe6, 0xd0, 0x3b, 0x30, 0xae, 0x76, 0xaa, 0xca,
+		0x3b, 0x43, 0xc1, 0x9a, 0x94, 0xe4, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x98, 0xb4, 0x5e, 0xfd, 0x89,
+		0xd1, 0x33, 0xe8, 0x29, 0x3c, 0x1a, 0x43, 0x8f, 0xd7, 0x48, 0xf7, 0x56, 0xa0, 0x16, 0xc5, 0x79,
+		0xf4, 0x18, 0x76, 0xc7, 0x10, 0x59, 0x17, 0x9a, 0xba, 0xa1, 0xca, 0x6f, 0x4e, 0xc2, 0xe5, 0x92,
+		0xaa, 0x1b, 0xe2, 0x02, 0xfa, 0x11, 0xfc, 0x60, 0x0c, 0xad, 0xb3, 0x59, 0xfa, 0x87, 0x82, 0x63,
+		0x2d, 0x46, 0x61, 0x55, 0xac, 0x88, 0x8b, 0x13, 0x9c, 0x89, 0xae, 0xbe, 0x4a, 0xce, 0xdc, 0x12,
+		0x92, 0xe1, 0xe5, 0x44, 0x2d, 0x22, 0x1f, 0xaa, 0xa5, 0xe2, 0x70, 0x27, 0x22, 0x7a, 0x04, 0x3b,
+		0x63, 0x9c, 0x1c, 0x94, 0xb1, 0xac, 0xf0, 0x89, 0xd5, 0x11, 0x89, 0x65, 0xf4, 0x04, 0x1e, 0x8e,
+		0x23, 0x49, 0x6a, 0xa9, 0xfc, 0x56, 0xc1, 0xfd, 0x3c, 0x44, 0xc7, 0xe8, 0x64, 0x5b, 0x57, 0xb5,
+		0x4a, 0xd5, 0x30, 0x75, 0xf5, 0x3b, 0x45, 0x5c, 0xa1, 0x63, 0x34, 0xf1, 0xa4, 0xa2, 0x5c, 0x89,
+		0xab, 0x83, 0x62, 0x3c, 0xf0, 0x92, 0x7d, 0x55, 0x93, 0xf0, 0x89, 0xb8, 0x96, 0x50, 0x7b, 0x83,
+		0x42, 0xd7, 0x53, 0x42, 0xd7, 0x27, 0xd9, 0x8e, 0x22, 0x61, 0xf9, 0x30, 0x9e, 0xf1, 0x75, 0x3a,
+		0x75, 0xee, 0xb0, 0x2f, 0x32, 0x03, 0xf7, 0xaa, 0xb8, 0xc4, 0xef, 0xc2, 0x76, 0x78, 0x6e, 0x43,
+		0xaa, 0x60, 0x84, 0xda, 0xef, 0xc3, 0x0f, 0x27, 0xa3, 0x74, 0xd6, 0xa5, 0x12, 0x56, 0xa4, 0xe2,
+		0x49, 0xe7, 0x4a, 0x2a, 0xe4, 0x7f, 0x9d, 0x82, 0xbc, 0x6c, 0xb9, 0x35, 0xbb, 0x11, 0x7d, 0x09,
+		0x1e, 0x1b, 0xe5, 0x1e, 0x3c, 0x9d, 0xa0, 0xdf, 0x47, 0xc4, 0x7b, 0x0c, 0xfa, 0x55, 0xc9, 0x55,
+		0xed, 0x8d, 0x56, 0x3e, 0xd6, 0xc6, 0x11, 0x44, 0x01, 0x69, 0xf0, 0xfa, 0xaa, 0x8e, 0x07, 0x52,
+		0xd2, 0xbd, 0x87, 0xa6, 0x58, 0x52, 0x74, 0xe7, 0xdc, 0xb5, 0x26, 0x4e, 0x0a, 0x2f, 0xe3, 0xff,
+		0x2c, 0x29, 0x57, 0x25, 0x4f, 0x9c, 0x94, 0xab, 0x3a, 0x1e, 0x97, 0x94, 0xfd, 0x9f, 0xc2, 0x7a,
+		0xcd, 0x6b, 0x0e, 0xfb, 0xca, 0xb0, 0xbf, 0x10, 0xa5, 0xa7, 0x42, 0x7f, 0x66, 0x57, 0x84, 0xef,
+		0x76, 0xcf, 0x1d, 0x72, 0xd1, 0x3e, 0x2d, 0xd4, 0xbc, 0xe6, 0x4e, 0xfc, 0x7f, 0xd4, 0xdb, 0x4e,
+		0xbd, 0xb1, 0x73, 0xee, 0x85, 0xff, 0xf3, 0xe6, 0xff, 0xb0, 0xde, 0xb3, 0x5a, 0xce, 0x87, 0xdd,
+		0xd3, 0x19, 0x66, 0x7b, 0xf4, 0xef, 0x01, 0x00, 0x00, 0x8a, 0xd0, 0x5a, 0x70, 0x1f, 0x00, 0x00,
+	},
+	// uber/cadence/shared/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x4f, 0x4b, 0xfb, 0x30,
+		0x18, 0xc7, 0xe9, 0x7e, 0xfc, 0x04, 0x33, 0xff, 0x51, 0x50, 0x46, 0x41, 0xd8, 0xa6, 0xc2, 0x4e,
+		0x09, 0x9d, 0x88, 0x07, 0x4f, 0xfe, 0xc5, 0x79, 0x2c, 0xe2, 0xc1, 0x4b, 0x49, 0x93, 0xc7, 0x35,
+		0xe0, 0x92, 0x92, 0xa4, 0xc1, 0xbd, 0x15, 0xdf, 0x82, 0x6f, 0x52, 0xd2, 0xd6, 0x8d, 0xb8, 0x8b,
+		0xb7, 0x3e, 0x3c, 0x9f, 0xcf, 0x97, 0x6f, 0x9f, 0xa0, 0xd3, 0xba, 0x00, 0x4d, 0x18, 0xe5, 0x20,
+		0x19, 0x10, 0x53, 0x52, 0x0d, 0x9c, 0xb8, 0x94, 0x94, 0xc2, 0x58, 0xa5, 0x97, 0xb8, 0xd2, 0xca,
+		0xaa, 0xf8, 0xc8, 0x53, 0xb8, 0xa3, 0x70, 0x4b, 0x61, 0x97, 0x26, 0xa3, 0xc0, 0xa6, 0x95, 0xd8,
+		0x50, 0x93, 0x93, 0x10, 0xe1, 0x0b, 0x21, 0x37, 0xa0, 0xf1, 0x57, 0x84, 0x0e, 0x9f, 0x35, 0x95,
+		0x46, 0x80, 0xb4, 0x77, 0xc0, 0x84, 0x11, 0x4a, 0xce, 0xe4, 0x9b, 0x8a, 0x9f, 0xd0, 0xbe, 0x61,
+		0x25, 0xf0, 0xfa, 0x1d, 0x78, 0x0e, 0x0e, 0xa4, 0x1d, 0x44, 0xc3, 0x68, 0xd2, 0x9f, 0x8e, 0x70,
+		0xd0, 0x89, 0x56, 0x02, 0xbb, 0x14, 0x3f, 0xb6, 0xb1, 0xf7, 0x1e, 0xcc, 0xf6, 0x56, 0x66, 0x33,
+		0xc7, 0x0f, 0x68, 0xd7, 0x58, 0xaa, 0xed, 0x2a, 0xa9, 0xf7, 0xd7, 0xa4, 0x9d, 0xce, 0x6b, 0xa6,
+		0xf1, 0x67, 0x84, 0x0e, 0x5e, 0x40, 0xfb, 0x8e, 0x2d, 0x25, 0xc0, 0xc4, 0xd7, 0xe8, 0x98, 0xd5,
+		0x5a, 0x83, 0xb4, 0xb9, 0x6b, 0x77, 0x79, 0xf7, 0x8f, 0xb9, 0x90, 0x1c, 0x3e, 0x9a, 0xda, 0xff,
+		0xb3, 0xa4, 0x83, 0x02, 0x7f, 0x39, 0xf3, 0x44, 0x7c, 0x8b, 0xb6, 0xcb, 0x9f, 0xbc, 0x41, 0x6f,
+		0xf8, 0x6f, 0xd2, 0x9f, 0x9e, 0xfd, 0xea, 0xe6, 0xcf, 0xe7, 0xdb, 0x85, 0x7a, 0xb6, 0xf6, 0x6e,
+		0x2e, 0x5f, 0x2f, 0xe6, 0xc2, 0x96, 0x75, 0x81, 0x99, 0x5a, 0x90, 0xe0, 0xf8, 0x78, 0x0e, 0x92,
+		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x0f, 0x00,
+		0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc7,
+		0x91, 0xf6, 0xec, 0x92, 0x4b, 0x6d, 0x2d, 0x45, 0x91, 0x4d, 0x8a, 0x5a, 0x4a, 0x94, 0x44, 0xad,
+		0x64, 0x89, 0xa6, 0xc8, 0xa5, 0x44, 0xc9, 0x92, 0x25, 0xf9, 0xe7, 0x48, 0x8a, 0x84, 0x16, 0xe0,
+		0x49, 0xbc, 0x11, 0x25, 0xdf, 0x1d, 0x0c, 0xec, 0x0d, 0x77, 0x9a, 0xe2, 0x1c, 0x77, 0x77, 0xd6,
+		0x33, 0xbd, 0x5c, 0xf1, 0x70, 0xf7, 0x74, 0x0f, 0x07, 0x1c, 0xce, 0xf0, 0x19, 0x46, 0x80, 0x18,
+		0x48, 0x90, 0x20, 0x40, 0x82, 0x38, 0x09, 0xe0, 0x20, 0x41, 0x90, 0xbf, 0x97, 0x24, 0x40, 0xe0,
+		0x00, 0x09, 0x9c, 0x3c, 0xe5, 0xc5, 0xaf, 0x41, 0x10, 0xbf, 0xe5, 0x21, 0xce, 0x5b, 0x80, 0x60,
+		0x7a, 0x7a, 0x76, 0x77, 0x66, 0xba, 0x67, 0x7a, 0x96, 0x94, 0x9d, 0xc0, 0x7a, 0xe3, 0xf4, 0x54,
+		0xd5, 0x7c, 0xd5, 0x5d, 0x55, 0x5d, 0xdd, 0x55, 0x4b, 0x38, 0xd3, 0xdc, 0xc4, 0xd6, 0x7c, 0x45,
+		0xd3, 0x71, 0xbd, 0x82, 0xe7, 0xb5, 0x86, 0x31, 0xbf, 0x7b, 0x79, 0x7e, 0xdb, 0xb0, 0x89, 0x69,
+		0xed, 0x15, 0x1b, 0x96, 0x49, 0x4c, 0x34, 0xea, 0x90, 0x14, 0x19, 0x49, 0x51, 0x6b, 0x18, 0xc5,
+		0xdd, 0xcb, 0xc7, 0x4f, 0x3d, 0x32, 0xcd, 0x47, 0x55, 0x3c, 0x4f, 0x49, 0x36, 0x9b, 0x5b, 0xf3,
+		0x7a, 0xd3, 0xd2, 0x88, 0x61, 0xd6, 0x5d, 0xa6, 0xe3, 0xa7, 0x83, 0xef, 0x89, 0x51, 0xc3, 0x36,
+		0xd1, 0x6a, 0x0d, 0x46, 0x30, 0xc5, 0xfb, 0x70, 0xc5, 0xac, 0xd5, 0xda, 0x22, 0x0a, 0x3c, 0x0a,
+		0xa2, 0xd9, 0x3b, 0x55, 0xc3, 0x26, 0x51, 0x34, 0x2d, 0xd3, 0xda, 0xd9, 0xaa, 0x9a, 0x2d, 0x97,
+		0xa6, 0x70, 0x1b, 0x06, 0xee, 0xb8, 0x0a, 0xa1, 0x1b, 0x90, 0xc1, 0xbb, 0xb8, 0x4e, 0xec, 0xbc,
+		0x32, 0x95, 0x9e, 0xce, 0x2d, 0x9c, 0x29, 0x72, 0x74, 0x2b, 0x32, 0xea, 0x15, 0x87, 0x52, 0x65,
+		0x0c, 0x85, 0x8f, 0xae, 0xc3, 0x60, 0xf7, 0x0b, 0x34, 0x01, 0x87, 0xe8, 0xab, 0xb2, 0xa1, 0xe7,
+		0x95, 0x29, 0x65, 0x3a, 0xad, 0x0e, 0xd0, 0xe7, 0x92, 0x8e, 0x6e, 0x00, 0xb8, 0xaf, 0x1c, 0xa5,
+		0xf3, 0xa9, 0x29, 0x65, 0x3a, 0xb7, 0x70, 0xbc, 0xe8, 0xce, 0x48, 0xd1, 0x9b, 0x91, 0xe2, 0x86,
+		0x37, 0x23, 0x6a, 0x96, 0x52, 0x3b, 0xcf, 0x28, 0x0f, 0x03, 0xbb, 0xd8, 0xb2, 0x0d, 0xb3, 0x9e,
+		0x4f, 0xbb, 0x42, 0xd9, 0x23, 0x3a, 0x06, 0x03, 0x8e, 0xf2, 0xce, 0xe7, 0xfa, 0xe8, 0x9b, 0x8c,
+		0xf3, 0x58, 0xd2, 0xd1, 0x17, 0x14, 0xb8, 0xe8, 0xa9, 0x5c, 0xc6, 0x8f, 0x71, 0xa5, 0xe9, 0xac,
+		0x43, 0xd9, 0x26, 0x9a, 0x45, 0xb0, 0x5e, 0x76, 0x91, 0x68, 0x84, 0x58, 0xc6, 0x66, 0x93, 0x60,
+		0x3b, 0xdf, 0x4f, 0xf1, 0xbc, 0xc8, 0x55, 0xfd, 0x55, 0x26, 0x67, 0xc5, 0x13, 0x73, 0xdf, 0x95,
+		0x42, 0x55, 0x5e, 0x6c, 0xcb, 0xb8, 0xf3, 0x8c, 0x7a, 0xa1, 0x25, 0x47, 0x8a, 0xbe, 0xa2, 0xc0,
+		0x1c, 0x07, 0x5e, 0xc5, 0xac, 0x35, 0xaa, 0x98, 0x0b, 0x30, 0x43, 0x01, 0xbe, 0x2c, 0x07, 0x70,
+		0xd9, 0x93, 0x13, 0x86, 0xf8, 0x5c, 0x4b, 0x96, 0x18, 0xbd, 0xa3, 0xc0, 0x0c, 0x07, 0xe4, 0x96,
+		0x66, 0x54, 0x79, 0x08, 0x07, 0x28, 0xc2, 0x5b, 0x72, 0x08, 0x57, 0xa9, 0x90, 0x30, 0xbc, 0xf3,
+		0x2d, 0x29, 0x4a, 0xf4, 0x65, 0xfe, 0x04, 0x3a, 0xb6, 0xa5, 0x97, 0xcd, 0x26, 0x09, 0xc3, 0x3b,
+		0x44, 0xe1, 0xbd, 0x24, 0x07, 0xcf, 0x31, 0x3b, 0xfd, 0x5e, 0x93, 0x84, 0x01, 0x4e, 0xb7, 0x24,
+		0x69, 0xd1, 0xdb, 0x0a, 0x4c, 0xeb, 0xb8, 0x62, 0xd8, 0x14, 0x98, 0x63, 0xa5, 0x76, 0x65, 0x1b,
+		0xeb, 0x4d, 0xee, 0xe4, 0x65, 0x29, 0xba, 0x1b, 0x5c, 0x74, 0xb7, 0x99, 0x90, 0x0d, 0xcd, 0xde,
+		0xb9, 0xef, 0x89, 0x08, 0x23, 0x3b, 0xa7, 0x4b, 0xd0, 0xa1, 0x37, 0x14, 0x38, 0x1f, 0x40, 0x25,
+		0xf2, 0x09, 0xa0, 0x98, 0xae, 0xc7, 0x63, 0x12, 0xb9, 0x43, 0x41, 0x8f, 0xa5, 0xe2, 0xcc, 0x52,
+		0x84, 0x13, 0xe4, 0x24, 0x67, 0x29, 0xc2, 0xfe, 0xcf, 0xe9, 0x12, 0x74, 0xe8, 0xad, 0x10, 0xaa,
+		0x08, 0xcb, 0x1a, 0xa4, 0xa8, 0x5e, 0x88, 0x45, 0x25, 0x36, 0xaa, 0xb3, 0x7a, 0x3c, 0x19, 0xfa,
+		0x5f, 0x05, 0x9e, 0xf5, 0x63, 0x12, 0x79, 0xe2, 0x61, 0x0a, 0xe8, 0x5a, 0x2c, 0x20, 0x91, 0x13,
+		0x9e, 0xd1, 0xe3, 0x88, 0xe8, 0xb2, 0x69, 0x15, 0x62, 0xec, 0x1a, 0x64, 0x2f, 0xd6, 0xb8, 0x87,
+		0x22, 0x96, 0x6d, 0x91, 0x09, 0x89, 0x33, 0x6e, 0x4d, 0x82, 0x8e, 0x1a, 0x77, 0x00, 0x95, 0xc8,
+		0xb8, 0x8f, 0x44, 0x18, 0xb7, 0x0f, 0x93, 0xd0, 0xb8, 0xb5, 0x58, 0x2a, 0xce, 0x2c, 0x45, 0x18,
+		0xf7, 0xb0, 0xe4, 0x2c, 0x45, 0x19, 0xb7, 0x26, 0x41, 0x47, 0x0d, 0xc9, 0x8f, 0x4a, 0x64, 0x48,
+		0x23, 0x11, 0x86, 0xd4, 0x0d, 0x49, 0x68, 0x48, 0x5a, 0x1c, 0x11, 0xf5, 0x34, 0x3f, 0x98, 0x08,
+		0x4f, 0x43, 0x11, 0x9e, 0xd6, 0x8d, 0x27, 0xc2, 0xd3, 0xb4, 0x78, 0x32, 0xd4, 0x82, 0x53, 0x0e,
+		0x08, 0x4b, 0x6c, 0x3d, 0xa3, 0x14, 0xc8, 0x25, 0x2e, 0x10, 0x47, 0xaa, 0x25, 0x34, 0x9b, 0x13,
+		0x44, 0xfc, 0x1a, 0xbd, 0x0e, 0x93, 0xee, 0x87, 0xb7, 0x0c, 0x8b, 0xf7, 0xd9, 0x31, 0xfa, 0xd9,
+		0xa2, 0xf8, 0xb3, 0xab, 0x86, 0x15, 0x92, 0x7a, 0xe7, 0x19, 0x75, 0x82, 0x88, 0x5e, 0xa2, 0xaf,
+		0x29, 0x30, 0x1f, 0x30, 0x51, 0xad, 0x5e, 0xc1, 0xd5, 0xb2, 0x85, 0x5f, 0x6f, 0x62, 0x9b, 0xab,
+		0xfd, 0x51, 0x0a, 0xe3, 0x95, 0x78, 0x4b, 0xa5, 0x92, 0x54, 0x4f, 0x50, 0x18, 0xd7, 0x8c, 0x26,
+		0x4d, 0x8d, 0xbe, 0xab, 0xc0, 0x55, 0x86, 0xc9, 0x83, 0x28, 0x67, 0xc4, 0xe3, 0x14, 0xed, 0x32,
+		0x17, 0x2d, 0xfb, 0x9a, 0xfb, 0x69, 0x19, 0x8b, 0x2e, 0x5a, 0x89, 0x38, 0xd0, 0xff, 0x2b, 0x70,
+		0x81, 0x37, 0xbd, 0x3c, 0xa0, 0xc7, 0x24, 0xad, 0x7b, 0x99, 0x49, 0x88, 0xb1, 0x6e, 0x01, 0x19,
+		0xfa, 0x0f, 0x38, 0xed, 0x1a, 0x99, 0x18, 0x49, 0x9e, 0x22, 0xb9, 0x2c, 0xb6, 0x33, 0x31, 0x84,
+		0x49, 0x12, 0xf1, 0x1e, 0xfd, 0x8f, 0x02, 0xe7, 0xd8, 0xe2, 0x31, 0x43, 0x17, 0x2c, 0xda, 0x04,
+		0x45, 0xf0, 0x3c, 0x17, 0x81, 0x2b, 0xdc, 0xb5, 0x77, 0xc1, 0x32, 0x4d, 0x55, 0x62, 0x68, 0xd0,
+		0x7f, 0xc1, 0x54, 0x4d, 0xb3, 0x76, 0xb0, 0x55, 0xb6, 0x70, 0xc5, 0xb4, 0x74, 0x1e, 0x88, 0xe3,
+		0x14, 0xc4, 0x02, 0x17, 0xc4, 0x3f, 0x52, 0x66, 0x95, 0xf1, 0x86, 0x11, 0x9c, 0xac, 0x45, 0x11,
+		0xa0, 0x2f, 0x29, 0x30, 0xcb, 0x3b, 0x9f, 0x18, 0x8f, 0xea, 0x1a, 0x77, 0x42, 0x4e, 0x24, 0x49,
+		0x5f, 0xef, 0x33, 0x31, 0x32, 0xe9, 0xab, 0x80, 0x16, 0x7d, 0x55, 0x81, 0x22, 0x07, 0x21, 0xc1,
+		0x56, 0xcd, 0xa8, 0x6b, 0xdc, 0xb8, 0x30, 0x19, 0x11, 0x17, 0xc2, 0x29, 0x76, 0x5b, 0x10, 0x27,
+		0x2e, 0xb4, 0xa4, 0xa9, 0xd1, 0xf7, 0x14, 0xb8, 0xca, 0x3b, 0x4a, 0xc5, 0x46, 0xb1, 0x93, 0x14,
+		0xed, 0x6d, 0xc9, 0x13, 0x55, 0x5c, 0x28, 0x9b, 0x6f, 0x25, 0x63, 0x11, 0x59, 0x80, 0xd8, 0x29,
+		0x4f, 0x25, 0xb1, 0x00, 0xb1, 0x83, 0x4e, 0xb7, 0x24, 0x69, 0xd1, 0xef, 0x14, 0x58, 0x09, 0x44,
+		0x5c, 0xfc, 0x98, 0x60, 0xab, 0xae, 0x55, 0xcb, 0x1c, 0xe4, 0x46, 0xdd, 0x20, 0x06, 0xdf, 0x30,
+		0x4e, 0x53, 0xe8, 0xf7, 0xe3, 0x43, 0xf0, 0x0a, 0x93, 0x1f, 0xd2, 0xa7, 0xe4, 0x09, 0x0f, 0x2b,
+		0xf4, 0xb2, 0xb5, 0x2f, 0x09, 0xe8, 0x43, 0x05, 0x96, 0x12, 0xa8, 0x29, 0x8a, 0x58, 0x53, 0x54,
+		0xc7, 0xf5, 0x7d, 0xe8, 0x28, 0x0a, 0x66, 0xb7, 0xac, 0xde, 0xd9, 0xd1, 0x07, 0x0a, 0xbc, 0x14,
+		0xa5, 0x4e, 0xbc, 0x9f, 0x9c, 0xa1, 0x8a, 0xad, 0x71, 0x15, 0x13, 0x82, 0x89, 0xf5, 0x97, 0xeb,
+		0xb8, 0x37, 0x56, 0x9a, 0x07, 0xf0, 0xf4, 0x30, 0xeb, 0xc4, 0xa8, 0x37, 0xb1, 0x5e, 0xd6, 0xec,
+		0x72, 0x1d, 0xb7, 0xc2, 0x7a, 0x14, 0x22, 0xf2, 0x80, 0x30, 0x08, 0x4f, 0xdc, 0xa2, 0x7d, 0x17,
+		0xb7, 0xc2, 0xf0, 0x8b, 0xad, 0x44, 0x1c, 0xe8, 0x67, 0x0a, 0xdc, 0xa0, 0xd9, 0x64, 0xb9, 0xb2,
+		0x6d, 0x54, 0xf5, 0x84, 0xfe, 0x73, 0x96, 0x42, 0xbf, 0xc3, 0x85, 0x4e, 0x53, 0xc9, 0x65, 0x47,
+		0x68, 0x12, 0xa7, 0xb9, 0x62, 0x27, 0x67, 0x43, 0x3f, 0x54, 0xe0, 0x5a, 0x8c, 0x12, 0x22, 0xef,
+		0x38, 0x47, 0x35, 0x58, 0x49, 0xaa, 0x81, 0xc8, 0x25, 0x2e, 0xd9, 0x09, 0x79, 0xd0, 0xb7, 0x14,
+		0xb8, 0x2c, 0x44, 0x2d, 0xcc, 0xf3, 0x9f, 0xa5, 0xb0, 0x17, 0xf9, 0x69, 0x08, 0xf7, 0xeb, 0xc2,
+		0xc4, 0x7f, 0xb6, 0x92, 0x80, 0x1e, 0x7d, 0x47, 0x81, 0x2b, 0x42, 0xb8, 0x11, 0x87, 0xc8, 0xf3,
+		0x11, 0x46, 0xce, 0x07, 0x1c, 0x71, 0x9c, 0x2c, 0x56, 0x12, 0x71, 0xa0, 0x77, 0x15, 0xb8, 0x94,
+		0xd8, 0x32, 0x2e, 0x50, 0xc4, 0xff, 0x90, 0x00, 0xb1, 0xc8, 0x28, 0x2e, 0x56, 0x12, 0xd8, 0xc3,
+		0x7b, 0x0a, 0x2c, 0x88, 0x27, 0x58, 0xb8, 0x09, 0x4f, 0x53, 0xb4, 0x4b, 0x49, 0xe6, 0x57, 0xb8,
+		0x13, 0xcf, 0x55, 0x92, 0x30, 0xa0, 0x6f, 0x47, 0x99, 0x44, 0xc4, 0xa1, 0xf9, 0xb9, 0xc4, 0x90,
+		0xc5, 0xc7, 0xe7, 0xb9, 0x4a, 0x12, 0x06, 0x9a, 0x9b, 0x89, 0x21, 0x47, 0x64, 0x92, 0x33, 0x11,
+		0xb9, 0x99, 0x00, 0x73, 0x44, 0x3a, 0x39, 0x5f, 0x49, 0xc6, 0x42, 0x37, 0x4d, 0x37, 0x15, 0xef,
+		0x35, 0xe3, 0xb9, 0x18, 0xb1, 0x69, 0xba, 0x19, 0x77, 0x2f, 0xa9, 0xce, 0x75, 0xbb, 0x37, 0x56,
+		0xf4, 0x73, 0x05, 0x6e, 0x4a, 0x28, 0x24, 0xf2, 0xd1, 0x59, 0xaa, 0x4d, 0xa9, 0x17, 0x6d, 0x44,
+		0xce, 0x7a, 0xd5, 0xee, 0x81, 0x0f, 0xfd, 0x40, 0x81, 0xe7, 0xa3, 0x14, 0x10, 0x9f, 0x9f, 0xe6,
+		0x22, 0x36, 0x20, 0x21, 0x08, 0xf1, 0x39, 0xea, 0x12, 0x4e, 0xc8, 0x43, 0x03, 0x4e, 0xb3, 0x61,
+		0x63, 0x8b, 0x74, 0x80, 0xdb, 0x58, 0xb3, 0x2a, 0xdb, 0x5d, 0x30, 0xc3, 0xb8, 0x8b, 0x11, 0xde,
+		0xfb, 0x80, 0x8a, 0xf3, 0x10, 0xdc, 0xa7, 0xc2, 0x3a, 0x5f, 0xe4, 0x78, 0x6f, 0x33, 0x09, 0xc3,
+		0xd2, 0x20, 0x40, 0x07, 0x48, 0xe1, 0xcd, 0x21, 0xb8, 0x20, 0xbb, 0x7b, 0xad, 0xc2, 0xe1, 0xb6,
+		0x8e, 0x64, 0xaf, 0x81, 0x69, 0x2d, 0x50, 0x54, 0x59, 0xf4, 0x84, 0x6e, 0xec, 0x35, 0xb0, 0x3a,
+		0xd8, 0xea, 0x7a, 0x42, 0xaf, 0xc1, 0xd1, 0x86, 0x66, 0x39, 0x33, 0xd2, 0xed, 0x74, 0x5b, 0x26,
+		0x2b, 0x1f, 0x4e, 0x73, 0xe5, 0xad, 0x53, 0x8e, 0x2e, 0x9f, 0xd8, 0x32, 0xd5, 0xd1, 0x46, 0x78,
+		0x10, 0xdd, 0x84, 0x2c, 0xbd, 0x91, 0xa9, 0x1a, 0x36, 0xa1, 0x85, 0xc5, 0xdc, 0xc2, 0x49, 0xfe,
+		0x95, 0x87, 0x66, 0xef, 0xac, 0x19, 0x36, 0x51, 0x0f, 0x11, 0xf6, 0x17, 0x5a, 0x80, 0x7e, 0xa3,
+		0xde, 0x68, 0x12, 0x5a, 0x76, 0xcc, 0x2d, 0x4c, 0x0a, 0x90, 0xec, 0x55, 0x4d, 0x4d, 0x57, 0x5d,
+		0x52, 0xa4, 0xc1, 0x54, 0x20, 0xe5, 0x28, 0x13, 0xb3, 0x5c, 0xa9, 0x9a, 0x36, 0xa6, 0xf1, 0xdb,
+		0x6c, 0x12, 0x56, 0x87, 0x9c, 0x08, 0xd5, 0x45, 0x6f, 0xb3, 0x4a, 0xb2, 0x3a, 0x89, 0x7d, 0x73,
+		0xbf, 0x61, 0x2e, 0x3b, 0xfc, 0x1b, 0x2e, 0x3b, 0x7a, 0x15, 0x4e, 0x74, 0xae, 0xbd, 0xc3, 0xd2,
+		0x33, 0x71, 0xd2, 0x8f, 0x11, 0xef, 0x32, 0x3b, 0x20, 0xf8, 0x16, 0x1c, 0xef, 0x64, 0xd8, 0x1d,
+		0x2d, 0xac, 0x66, 0xdd, 0xa9, 0xbd, 0x3a, 0xa5, 0xbf, 0xac, 0x7a, 0xac, 0x4d, 0xd1, 0x9e, 0x67,
+		0xb5, 0x59, 0x2f, 0xe9, 0xa8, 0x04, 0x59, 0x16, 0x2a, 0x4d, 0x8b, 0xd6, 0xe1, 0x86, 0x16, 0x2e,
+		0xf2, 0x43, 0x3b, 0x13, 0x40, 0x53, 0xe8, 0x92, 0xc7, 0xa2, 0x76, 0xb8, 0x51, 0x09, 0x46, 0x3a,
+		0x38, 0x9c, 0x70, 0xd5, 0xb4, 0x70, 0x3e, 0x1b, 0xb1, 0x06, 0xab, 0x2e, 0x8d, 0x3a, 0xdc, 0x66,
+		0x63, 0x23, 0x48, 0x85, 0xf1, 0xaa, 0xe6, 0x9c, 0xf9, 0xdc, 0x74, 0x86, 0xaa, 0x83, 0xed, 0x66,
+		0x95, 0xe4, 0x21, 0x42, 0x9e, 0xb7, 0xa6, 0x63, 0x0e, 0xef, 0x72, 0x9b, 0x55, 0xa5, 0x9c, 0xe8,
+		0x06, 0x4c, 0x98, 0x96, 0xf1, 0xc8, 0x70, 0x03, 0x6d, 0x60, 0x96, 0x72, 0x74, 0x96, 0xc6, 0x3d,
+		0x82, 0xc0, 0x24, 0x1d, 0x87, 0x43, 0x86, 0x8e, 0xeb, 0xc4, 0x20, 0x7b, 0xb4, 0xa2, 0x94, 0x55,
+		0xdb, 0xcf, 0xe8, 0x0a, 0x8c, 0x6f, 0x19, 0x96, 0x4d, 0xc2, 0x32, 0x0f, 0x53, 0xca, 0x51, 0xfa,
+		0x36, 0x20, 0x70, 0x19, 0x06, 0x2d, 0x4c, 0xac, 0xbd, 0x72, 0xc3, 0xac, 0x1a, 0x95, 0x3d, 0x56,
+		0x85, 0x99, 0x12, 0x1c, 0x50, 0x89, 0xb5, 0xb7, 0x4e, 0xe9, 0xd4, 0x9c, 0xd5, 0x79, 0x70, 0x4a,
+		0xef, 0x1a, 0x21, 0xb8, 0xd6, 0x20, 0xb4, 0x62, 0xd2, 0xaf, 0x7a, 0x8f, 0x68, 0x19, 0x8e, 0xe0,
+		0xc7, 0x0d, 0xc3, 0x35, 0x1c, 0xb7, 0xa8, 0x3f, 0x1c, 0x5b, 0xd4, 0x1f, 0xea, 0xb0, 0x38, 0x83,
+		0xe8, 0x2c, 0x1c, 0xae, 0x58, 0x8e, 0x37, 0xb0, 0x8a, 0x0e, 0xad, 0x38, 0x64, 0xd5, 0x41, 0x67,
+		0xd0, 0xab, 0xf2, 0xa0, 0x7f, 0x86, 0x13, 0xae, 0xf6, 0xfe, 0xea, 0xd7, 0xa6, 0x56, 0xd9, 0x31,
+		0xb7, 0xb6, 0xf2, 0x28, 0xce, 0xa8, 0xf3, 0x94, 0xbb, 0xbb, 0xf0, 0xb5, 0xe4, 0xb2, 0xa2, 0x39,
+		0xe8, 0xab, 0xe1, 0x9a, 0xc9, 0xae, 0xf3, 0x27, 0xf8, 0x17, 0x7d, 0xb8, 0x66, 0xaa, 0x94, 0x0c,
+		0xa9, 0x30, 0x12, 0x8a, 0xd8, 0xec, 0x4e, 0xfe, 0x59, 0xfe, 0xde, 0x18, 0x88, 0xb0, 0xea, 0xb0,
+		0x1d, 0x18, 0x41, 0x0f, 0x60, 0xbc, 0x61, 0xe1, 0xdd, 0xb2, 0xd6, 0x24, 0xa6, 0x63, 0x7f, 0x98,
+		0x94, 0x1b, 0xa6, 0x51, 0x27, 0xde, 0x2d, 0xbb, 0x68, 0xbd, 0x6c, 0x4c, 0xd6, 0x29, 0x9d, 0x3a,
+		0xea, 0xf0, 0x2f, 0x36, 0x89, 0xd9, 0x35, 0x88, 0xae, 0x40, 0x66, 0x1b, 0x6b, 0x3a, 0xb6, 0xd8,
+		0xf5, 0xf7, 0x09, 0x7e, 0x53, 0x07, 0x25, 0x51, 0x19, 0x29, 0x5a, 0x83, 0x31, 0x77, 0xa2, 0x3b,
+		0xb5, 0x3c, 0xba, 0xae, 0xc7, 0x62, 0xd7, 0x15, 0x51, 0xbe, 0x76, 0x5d, 0x8e, 0xae, 0xed, 0x7f,
+		0xc2, 0x70, 0x43, 0xb3, 0x88, 0xe1, 0x1d, 0xcf, 0xb7, 0x8c, 0x47, 0xf9, 0x3c, 0xed, 0x30, 0xf9,
+		0xa7, 0xfd, 0xb4, 0x59, 0x14, 0xd7, 0x3d, 0xa1, 0xcb, 0x54, 0xe6, 0x4a, 0x9d, 0x58, 0x7b, 0xea,
+		0x91, 0x86, 0x7f, 0x14, 0x9d, 0x04, 0xf0, 0x2e, 0x75, 0x0c, 0x9d, 0x5e, 0x27, 0x67, 0xd5, 0x2c,
+		0x1b, 0x29, 0xe9, 0xc7, 0x97, 0x60, 0x8c, 0x27, 0x07, 0x0d, 0x43, 0x7a, 0x07, 0xef, 0xd1, 0xfd,
+		0x2a, 0xab, 0x3a, 0x7f, 0xa2, 0x31, 0xe8, 0xdf, 0xd5, 0xaa, 0x4d, 0xb7, 0x65, 0x25, 0xab, 0xba,
+		0x0f, 0x37, 0x53, 0x2f, 0x28, 0x85, 0x77, 0x15, 0x78, 0x4e, 0xfe, 0x70, 0x74, 0x15, 0x32, 0x2c,
+		0xbc, 0x28, 0x12, 0xe1, 0x85, 0xd1, 0xa2, 0x55, 0x98, 0x8a, 0xae, 0x8e, 0x1b, 0x3a, 0x05, 0x96,
+		0x56, 0x27, 0xc5, 0x85, 0xed, 0x92, 0x5e, 0xf8, 0xba, 0x02, 0xe7, 0x25, 0x73, 0xac, 0x6b, 0x30,
+		0xe0, 0x05, 0x56, 0x45, 0x22, 0xb0, 0x7a, 0xc4, 0x07, 0x06, 0xd5, 0x84, 0x69, 0xe9, 0x03, 0xc6,
+		0x32, 0x0c, 0xb2, 0xbd, 0xad, 0x93, 0x67, 0x0c, 0x09, 0x7c, 0x86, 0x6d, 0x65, 0x34, 0xcd, 0xc8,
+		0x91, 0xce, 0x43, 0xe1, 0x57, 0x0a, 0x9c, 0x93, 0xe9, 0xb1, 0xf0, 0x27, 0x0c, 0x4a, 0xb2, 0x84,
+		0xe1, 0x2e, 0x8c, 0x0b, 0x36, 0xe5, 0x54, 0x5c, 0xfc, 0x1a, 0xb5, 0x39, 0x1b, 0x72, 0x57, 0x60,
+		0x4e, 0xfb, 0x02, 0x73, 0xe1, 0x0d, 0x05, 0x0a, 0xf1, 0xed, 0x19, 0x68, 0x16, 0x50, 0xb0, 0x64,
+		0xdf, 0x6e, 0xda, 0x1a, 0xb6, 0x7d, 0x53, 0x10, 0xd8, 0x9d, 0x52, 0x81, 0xdd, 0xc9, 0xef, 0x6a,
+		0xe9, 0x80, 0xab, 0x15, 0xfe, 0x18, 0x98, 0x5e, 0xa1, 0x87, 0x24, 0x43, 0x34, 0x0d, 0xc3, 0xfe,
+		0x6b, 0x9b, 0xb6, 0x79, 0x0d, 0xd9, 0x5d, 0x1a, 0x07, 0xb0, 0xa7, 0x03, 0xd8, 0x2f, 0xc0, 0x91,
+		0x4d, 0xa3, 0xae, 0x59, 0x7b, 0xe5, 0xca, 0x36, 0xae, 0xec, 0xd8, 0xcd, 0x1a, 0xcd, 0xe8, 0xb2,
+		0xea, 0x90, 0x3b, 0xbc, 0xcc, 0x46, 0xd1, 0x45, 0x18, 0xf1, 0x5f, 0x36, 0xe2, 0xc7, 0x6e, 0xb6,
+		0x36, 0xa8, 0x0e, 0xe3, 0xee, 0x3b, 0x40, 0xfc, 0x98, 0x14, 0xbe, 0x99, 0x86, 0xb3, 0x12, 0x9d,
+		0x1f, 0x4f, 0x4c, 0xe3, 0xa0, 0x5b, 0xa4, 0x7b, 0x70, 0x0b, 0x74, 0x0a, 0x72, 0x9b, 0x9a, 0x8d,
+		0xbd, 0x4c, 0xc3, 0x9d, 0x96, 0xac, 0x33, 0xe4, 0xe6, 0x17, 0x93, 0x00, 0xce, 0x3d, 0x2b, 0x7b,
+		0xdd, 0xef, 0x4e, 0x6c, 0x1d, 0xb7, 0xdc, 0xb7, 0xb3, 0x80, 0xb6, 0x4c, 0x6b, 0x87, 0x21, 0xf5,
+		0xda, 0xf7, 0x32, 0xae, 0x6a, 0xce, 0x1b, 0x8a, 0xf5, 0xa1, 0x3b, 0x8e, 0xc6, 0x9d, 0xe0, 0xa8,
+		0xd9, 0x66, 0x9d, 0xa5, 0x92, 0xec, 0x09, 0xdd, 0x86, 0xfe, 0x8a, 0xd6, 0xb4, 0x31, 0xcb, 0x1a,
+		0x8b, 0xd2, 0x3d, 0x36, 0xcb, 0x0e, 0x97, 0xea, 0x32, 0x07, 0x0c, 0x34, 0x1b, 0x34, 0xd0, 0xf7,
+		0xd3, 0x70, 0x26, 0xb6, 0x2d, 0xe6, 0x89, 0xad, 0xd5, 0x92, 0xa7, 0xa2, 0xbb, 0x48, 0xb3, 0x92,
+		0x5d, 0x3b, 0x3e, 0x05, 0xbb, 0x42, 0x76, 0x5f, 0x92, 0x90, 0xdd, 0xed, 0x19, 0xfd, 0x01, 0xcf,
+		0x08, 0x2c, 0x7f, 0x26, 0x7a, 0xf9, 0x07, 0xa4, 0x96, 0xff, 0x90, 0x60, 0xf9, 0x39, 0x5e, 0x98,
+		0xe5, 0x7a, 0xa1, 0x7f, 0x25, 0x21, 0xb8, 0x92, 0x5f, 0xcc, 0xc0, 0x39, 0x99, 0x86, 0x22, 0x74,
+		0x1a, 0x72, 0xed, 0xaa, 0x3c, 0x5b, 0xc5, 0xac, 0x0a, 0xde, 0x50, 0x49, 0x77, 0x4e, 0xb0, 0x6d,
+		0x02, 0xea, 0x42, 0xa9, 0x88, 0x13, 0x6c, 0xfb, 0x93, 0xf4, 0x04, 0xab, 0x75, 0x3d, 0x39, 0x86,
+		0xad, 0x9b, 0x35, 0xcd, 0xa8, 0xb3, 0xc8, 0xc3, 0x9e, 0xfc, 0x5b, 0x49, 0x5f, 0x8f, 0x67, 0xcf,
+		0x8c, 0xfc, 0xd9, 0x73, 0x03, 0x26, 0x3c, 0x1b, 0x0d, 0xef, 0x40, 0x03, 0x71, 0x3b, 0xd0, 0xb8,
+		0xc7, 0x1b, 0xd8, 0x84, 0x02, 0x52, 0xd9, 0x06, 0xc7, 0xa4, 0x1e, 0x4a, 0x20, 0xd5, 0x3d, 0x72,
+		0x32, 0xa9, 0xe2, 0xad, 0x32, 0xdb, 0xd3, 0x56, 0xb9, 0x0a, 0x23, 0xdb, 0x58, 0xb3, 0xc8, 0x26,
+		0xd6, 0x3a, 0xe8, 0x20, 0x4e, 0xd4, 0x70, 0x9b, 0xa7, 0x23, 0x27, 0x3e, 0xc1, 0xc9, 0xc5, 0x27,
+		0x38, 0xa1, 0x83, 0xd9, 0x60, 0x2f, 0x07, 0xb3, 0x4e, 0x82, 0x7f, 0x58, 0x3a, 0xc1, 0x2f, 0xfc,
+		0x41, 0x81, 0x42, 0x7c, 0x73, 0xdb, 0x27, 0x96, 0x1a, 0x74, 0x27, 0x31, 0x7d, 0xfe, 0xd3, 0xe5,
+		0x2b, 0x30, 0x48, 0x0f, 0xe7, 0x5e, 0x58, 0xeb, 0x97, 0x08, 0x6b, 0x39, 0x87, 0x83, 0x3d, 0x14,
+		0x7e, 0xa3, 0xf8, 0x43, 0xc1, 0x01, 0xe7, 0xe5, 0xfc, 0x29, 0x4a, 0x25, 0xd8, 0x0d, 0xd2, 0xb1,
+		0xb9, 0x4a, 0x9f, 0x7f, 0x32, 0x0b, 0xbf, 0x56, 0xe0, 0x4c, 0x7c, 0xc7, 0x51, 0xaf, 0xe9, 0xfb,
+		0xa7, 0xa1, 0xd1, 0x8f, 0x53, 0x70, 0x56, 0xa2, 0x6f, 0xcf, 0xd1, 0x49, 0xc7, 0x44, 0x33, 0xaa,
+		0xb6, 0xd4, 0x22, 0x79, 0xc4, 0x4f, 0x4c, 0xa7, 0x60, 0x7e, 0xd5, 0xd7, 0x4b, 0x7e, 0xb5, 0x6f,
+		0x13, 0xff, 0x9c, 0x02, 0x33, 0xf2, 0xed, 0x76, 0x32, 0x7b, 0xde, 0xc1, 0x1c, 0xe0, 0xde, 0x53,
+		0x20, 0x61, 0x63, 0x5d, 0x3c, 0xb6, 0x31, 0x2f, 0x4b, 0x62, 0xa7, 0x70, 0xfa, 0x20, 0x85, 0x38,
+		0x2d, 0x81, 0xf8, 0x9d, 0x80, 0x1d, 0x8a, 0x4a, 0x70, 0xbd, 0xda, 0xe1, 0x2a, 0x4c, 0x55, 0x35,
+		0xd2, 0xd5, 0x60, 0x12, 0x6c, 0xb7, 0xe8, 0xcc, 0xac, 0x4b, 0xc7, 0x5b, 0x4a, 0x37, 0xab, 0xe2,
+		0xd8, 0x73, 0x3a, 0x81, 0x3d, 0xf7, 0xc5, 0xfa, 0x68, 0x20, 0x0f, 0x2c, 0x7c, 0xa0, 0xc0, 0x89,
+		0x88, 0x96, 0x56, 0xe7, 0x27, 0x3f, 0x6e, 0x2b, 0x5f, 0x7b, 0xdd, 0x06, 0xe8, 0x73, 0x49, 0x47,
+		0x6b, 0x70, 0xb4, 0xbd, 0x91, 0x6f, 0x19, 0x56, 0x82, 0x23, 0x2f, 0x62, 0xfb, 0xb8, 0xd3, 0xb2,
+		0x9a, 0x64, 0xfb, 0x95, 0x59, 0xec, 0x7f, 0x83, 0x09, 0x61, 0xaf, 0x6c, 0x94, 0x36, 0xd2, 0x29,
+		0x7d, 0xe1, 0x7d, 0x05, 0x26, 0xa3, 0xda, 0x24, 0x0f, 0xe4, 0x2b, 0x07, 0x35, 0x1f, 0x91, 0x01,
+		0xfa, 0xfb, 0x0a, 0x4c, 0xc5, 0xb5, 0x5b, 0x46, 0x69, 0xf3, 0x44, 0xdd, 0x36, 0x12, 0xf9, 0x5f,
+		0x06, 0x20, 0x61, 0x57, 0x0f, 0x9a, 0x87, 0x31, 0xda, 0x38, 0x14, 0xbc, 0x63, 0x77, 0x75, 0x1a,
+		0xa9, 0xe3, 0x56, 0xe0, 0x86, 0x3d, 0x54, 0xe6, 0x4a, 0xf5, 0x56, 0xe6, 0x7a, 0x5a, 0x88, 0x92,
+		0x2f, 0x44, 0xc9, 0xd8, 0xce, 0x80, 0x84, 0xed, 0xdc, 0x83, 0x71, 0x56, 0x40, 0x60, 0x18, 0x8d,
+		0x3a, 0xc1, 0xd6, 0xae, 0x56, 0x8d, 0x3f, 0xb7, 0x8c, 0x31, 0x46, 0x0a, 0xaf, 0xc4, 0xd8, 0xfc,
+		0x45, 0xae, 0xec, 0xbe, 0x8a, 0x5c, 0x5d, 0x29, 0x1c, 0x24, 0x49, 0xe1, 0xc4, 0x15, 0xad, 0x5c,
+		0xcf, 0x15, 0xad, 0xce, 0x39, 0x63, 0x50, 0xbe, 0x90, 0xe0, 0xd5, 0x55, 0x0e, 0xef, 0xa3, 0xae,
+		0x32, 0xb4, 0xaf, 0xba, 0x4a, 0xe1, 0xf7, 0x0a, 0xcc, 0x27, 0x6d, 0x2d, 0x6c, 0x47, 0x2b, 0xa5,
+		0x3b, 0x5a, 0x45, 0x9d, 0x6f, 0x36, 0xe1, 0x58, 0xbb, 0x1d, 0x21, 0x50, 0xa2, 0x76, 0xfd, 0x78,
+		0x26, 0xb2, 0xe1, 0xc0, 0x5f, 0xa4, 0x3e, 0x8a, 0x79, 0xc3, 0x81, 0x33, 0x54, 0x5f, 0xf0, 0xce,
+		0xe3, 0x1b, 0x0a, 0x4c, 0x0b, 0x14, 0xe5, 0x15, 0xe6, 0xe3, 0xbd, 0x47, 0x91, 0xf0, 0x9e, 0xae,
+		0x44, 0x28, 0x95, 0x20, 0x11, 0x2a, 0x7c, 0xac, 0xc0, 0xc9, 0xc8, 0xce, 0x79, 0x27, 0x13, 0x64,
+		0x7d, 0xf9, 0x75, 0xad, 0xe6, 0xad, 0x04, 0xb8, 0x43, 0x77, 0xb5, 0x1a, 0xee, 0xf5, 0xd3, 0x07,
+		0xb6, 0xe9, 0x74, 0x1c, 0xa2, 0x4f, 0xfe, 0xe0, 0xfd, 0x23, 0xde, 0x22, 0x89, 0x3a, 0x45, 0x4e,
+		0x43, 0x8e, 0xf5, 0xea, 0x74, 0x4f, 0x81, 0x3b, 0x44, 0xa7, 0xa0, 0x1d, 0xf3, 0x53, 0xf2, 0x31,
+		0x3f, 0xea, 0x12, 0x3c, 0xc6, 0xc2, 0x3e, 0xaf, 0xc0, 0x4c, 0x82, 0xe6, 0xa9, 0xce, 0x5d, 0xae,
+		0xe2, 0xbb, 0xcb, 0xed, 0x75, 0xe1, 0x22, 0x90, 0x17, 0x7e, 0x9a, 0x82, 0x97, 0xf7, 0xd7, 0x40,
+		0x7e, 0x60, 0x2e, 0xd1, 0xb9, 0xe9, 0x4b, 0xf9, 0x6e, 0xfa, 0x1e, 0x00, 0x0a, 0x37, 0x2a, 0xb1,
+		0xe8, 0x70, 0x5e, 0xae, 0x10, 0xaa, 0x8e, 0x84, 0xba, 0x8d, 0x9d, 0xab, 0x93, 0x8a, 0x59, 0x27,
+		0x96, 0x59, 0xa5, 0x0b, 0x36, 0xa8, 0x7a, 0x8f, 0xa8, 0x08, 0xa3, 0x81, 0x9e, 0x3b, 0xb3, 0x5e,
+		0x75, 0xf3, 0xfa, 0x43, 0xea, 0x88, 0xaf, 0x15, 0xee, 0x5e, 0xbd, 0xba, 0x57, 0x78, 0x3b, 0x0d,
+		0xb7, 0xf6, 0xd1, 0xa0, 0x8e, 0x1e, 0x74, 0x47, 0xcd, 0x21, 0xc1, 0xcf, 0x3f, 0xa4, 0x24, 0xfb,
+		0xee, 0xb4, 0x0f, 0xe8, 0x34, 0x2a, 0xbc, 0x81, 0xe5, 0xaf, 0x4b, 0xdf, 0x7e, 0xd7, 0x65, 0x16,
+		0x50, 0xb0, 0x2d, 0x90, 0x55, 0x47, 0xd2, 0xea, 0xb0, 0xe1, 0x33, 0x42, 0xf7, 0x02, 0xcc, 0x5b,
+		0xc5, 0x8c, 0x6f, 0x15, 0x0b, 0xbf, 0x55, 0xe0, 0x7a, 0x8f, 0xdd, 0xf5, 0x02, 0x0c, 0x8a, 0x00,
+		0xc3, 0x27, 0x6b, 0xb8, 0x85, 0x37, 0xd3, 0x70, 0xbd, 0xc7, 0x0e, 0xc8, 0xbf, 0x57, 0x5f, 0x0d,
+		0x04, 0xf4, 0x3e, 0x71, 0x40, 0xef, 0x97, 0x0f, 0xe8, 0x42, 0xd3, 0x11, 0x05, 0x80, 0x01, 0x51,
+		0x00, 0xf8, 0xbf, 0x34, 0x5c, 0xed, 0xa5, 0x8b, 0x53, 0xce, 0xf3, 0xa5, 0x24, 0x3f, 0xf5, 0xfc,
+		0x8e, 0xe7, 0x7f, 0xa4, 0xc0, 0xa5, 0xa4, 0x1d, 0xa9, 0x7f, 0xd3, 0x2e, 0x2f, 0xde, 0xab, 0x0a,
+		0xbf, 0x54, 0x60, 0x2e, 0x51, 0x17, 0xeb, 0x81, 0x85, 0x00, 0xee, 0x99, 0x23, 0xb5, 0xbf, 0x33,
+		0xc7, 0x87, 0x59, 0xb8, 0xd2, 0xc3, 0xcf, 0x71, 0xba, 0x96, 0x43, 0xf1, 0x2d, 0xc7, 0x69, 0xc8,
+		0xb5, 0x97, 0x83, 0xd9, 0x7c, 0x56, 0x05, 0x6f, 0x88, 0x77, 0x01, 0x91, 0x3e, 0x80, 0x0b, 0x88,
+		0x5e, 0xab, 0x91, 0xfd, 0x07, 0x7b, 0x01, 0x91, 0x79, 0xa2, 0x17, 0x10, 0x03, 0x3d, 0x5f, 0x40,
+		0x3c, 0x04, 0xd6, 0x4c, 0xcc, 0x24, 0xb2, 0x22, 0x9e, 0xdb, 0xa0, 0x70, 0x3e, 0xa2, 0x23, 0x99,
+		0x4a, 0x61, 0xa5, 0xbc, 0x91, 0x46, 0x70, 0xa8, 0xdb, 0x49, 0xb2, 0xfe, 0x78, 0x2e, 0x63, 0xf2,
+		0x20, 0x61, 0xf2, 0x15, 0xc8, 0x77, 0x99, 0x53, 0xd9, 0xc2, 0xcd, 0x0e, 0xfc, 0x1c, 0x85, 0x3f,
+		0x13, 0x69, 0x38, 0x25, 0x5d, 0xc5, 0x4d, 0x0f, 0xaf, 0x7a, 0xb4, 0xc5, 0x1b, 0x0e, 0x15, 0x37,
+		0x0f, 0xf7, 0x52, 0xdc, 0x0c, 0xb5, 0x85, 0x0e, 0x71, 0xda, 0x42, 0x3b, 0x07, 0xb1, 0x23, 0xc9,
+		0x6f, 0x26, 0x86, 0xf7, 0x71, 0x33, 0x31, 0xb2, 0xbf, 0x8e, 0xcf, 0x9b, 0x90, 0xd3, 0x71, 0x55,
+		0xdb, 0x73, 0x4d, 0x33, 0xbe, 0x7d, 0x15, 0x28, 0x35, 0x35, 0x45, 0xf4, 0x22, 0x0c, 0xfe, 0xbb,
+		0x41, 0x88, 0xf7, 0xaf, 0x29, 0xf2, 0xa3, 0x71, 0xcc, 0x39, 0x97, 0xbc, 0xcd, 0xed, 0xf6, 0x77,
+		0x3a, 0x17, 0x9b, 0x1a, 0xc9, 0x8f, 0xc5, 0xf6, 0x75, 0x02, 0xa5, 0x57, 0x9b, 0xf5, 0x45, 0x52,
+		0x78, 0x2b, 0x0d, 0x97, 0x92, 0xfe, 0x54, 0xef, 0xd3, 0x0f, 0x6d, 0x6b, 0x5e, 0x8e, 0xe2, 0xd6,
+		0xe8, 0xae, 0x25, 0xfe, 0x9d, 0x99, 0x2f, 0x35, 0xe9, 0x72, 0xd2, 0x7e, 0xbf, 0x93, 0xf2, 0x37,
+		0xe0, 0x8c, 0x60, 0x03, 0x3e, 0xa0, 0x5b, 0xcc, 0xc2, 0x2f, 0x52, 0x30, 0x9b, 0xe4, 0x77, 0x88,
+		0xc2, 0xf5, 0xe0, 0xef, 0xfc, 0xa9, 0xfd, 0xee, 0xfc, 0x07, 0xb5, 0x8a, 0xfc, 0xd9, 0xed, 0x13,
+		0xcc, 0x6e, 0x27, 0x32, 0xf4, 0xcb, 0x5f, 0xd1, 0x7c, 0x9c, 0x82, 0x84, 0xbf, 0x90, 0xfc, 0x6c,
+		0x4c, 0x26, 0xaf, 0x20, 0xd5, 0xcf, 0x2d, 0x48, 0x75, 0x3a, 0x29, 0x32, 0xf2, 0x9d, 0x14, 0x85,
+		0x3f, 0xa5, 0xe0, 0xe2, 0x41, 0x44, 0x94, 0xcf, 0xe8, 0xa4, 0x77, 0xd5, 0x0a, 0x32, 0x09, 0x6a,
+		0x05, 0x85, 0x3f, 0xa7, 0x60, 0x2e, 0xd1, 0x0f, 0x56, 0x9f, 0x4e, 0x7c, 0x68, 0xe2, 0xbd, 0xeb,
+		0xcc, 0x4c, 0x92, 0x2b, 0xf0, 0xff, 0x4e, 0x8b, 0x26, 0x5e, 0xd4, 0xfd, 0xf2, 0x74, 0xe2, 0x23,
+		0x9b, 0x6f, 0x32, 0xbd, 0xf4, 0xfc, 0xff, 0x24, 0x05, 0xf3, 0x09, 0x7f, 0x48, 0xfc, 0x74, 0x1d,
+		0x7c, 0xeb, 0x30, 0x43, 0xe0, 0x08, 0xfd, 0x73, 0xd5, 0xa8, 0x12, 0x6c, 0xd1, 0x4f, 0x9d, 0x84,
+		0x89, 0x95, 0x87, 0x2b, 0x77, 0x37, 0xca, 0xab, 0xa5, 0xb5, 0x8d, 0x15, 0xb5, 0xbc, 0xf1, 0x2f,
+		0xeb, 0x2b, 0xe5, 0xd2, 0xdd, 0x87, 0x8b, 0x6b, 0xa5, 0xdb, 0xc3, 0xcf, 0xa0, 0xd3, 0x70, 0x22,
+		0xfc, 0x7a, 0x71, 0x6d, 0xad, 0x4c, 0x47, 0x87, 0x15, 0x74, 0x06, 0x4e, 0x86, 0x09, 0x96, 0xd7,
+		0xee, 0xdd, 0x5f, 0x61, 0x24, 0xa9, 0xa5, 0xd7, 0xe0, 0x58, 0xc5, 0xac, 0xf1, 0xe6, 0x60, 0xc9,
+		0xfb, 0x57, 0xb4, 0xeb, 0x96, 0x49, 0xcc, 0x75, 0xe5, 0x5f, 0x2f, 0x3f, 0x32, 0xc8, 0x76, 0x73,
+		0xb3, 0x58, 0x31, 0x6b, 0xf3, 0xdd, 0xff, 0x12, 0x77, 0xce, 0xd0, 0xab, 0xf3, 0x8f, 0x4c, 0xf7,
+		0xdf, 0xf0, 0xb2, 0xff, 0x8f, 0x7b, 0x4b, 0x6b, 0x18, 0xbb, 0x97, 0x37, 0x33, 0x74, 0xec, 0xca,
+		0x5f, 0x07, 0x00, 0xf6, 0x46, 0xec, 0xce, 0x02, 0x58, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4d, 0x4a, 0x2d,
+		0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0x4c, 0xc9, 0xcd, 0xcc, 0xd3, 0x2f,
+		0x33, 0xd4, 0xcf, 0xc8, 0x2c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+		0x12, 0x05, 0x29, 0xd2, 0x83, 0x2a, 0xd2, 0x03, 0x2b, 0xd2, 0x2b, 0x33, 0x54, 0xf2, 0xe4, 0x12,
+		0x0a, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0xf3, 0x80, 0x28, 0xf7, 0x2c, 0x49, 0xcd, 0x15, 0x92,
+		0xe4, 0xe2, 0x48, 0x2d, 0x4b, 0xcd, 0x2b, 0x89, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60,
+		0x0e, 0x62, 0x07, 0xf3, 0x3d, 0x53, 0x84, 0x24, 0xb8, 0xd8, 0xcb, 0x20, 0x1a, 0x24, 0x98, 0x20,
+		0x32, 0x50, 0xae, 0x52, 0x09, 0x17, 0x1f, 0xaa, 0x51, 0x42, 0x8a, 0x5c, 0x3c, 0x49, 0x45, 0x89,
+		0x79, 0xc9, 0x19, 0xf1, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0x60, 0xa3, 0x78, 0x82, 0xb8, 0x21, 0x62,
+		0x21, 0x20, 0x21, 0x21, 0x7b, 0x2e, 0xd6, 0xcc, 0x92, 0xd4, 0xdc, 0x62, 0x09, 0x26, 0x05, 0x66,
+		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
+		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
+		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
+		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x18, 0x00, 0x44, 0x14, 0xd7, 0xd4, 0x3e, 0x01, 0x00, 0x00,
+	},
+	// uber/cadence/shared/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
+		0x14, 0x87, 0xc9, 0xca, 0x0a, 0x35, 0x42, 0x74, 0x2e, 0x74, 0x51, 0x25, 0x44, 0x35, 0x09, 0x54,
+		0x21, 0xe4, 0x50, 0x10, 0x02, 0x89, 0xab, 0xfe, 0x41, 0xac, 0x5a, 0xb7, 0xa2, 0xa4, 0x80, 0xd8,
+		0x8d, 0xe5, 0x38, 0x26, 0xb5, 0x9a, 0xd8, 0xc1, 0x3e, 0x29, 0xda, 0x1b, 0xf1, 0x98, 0x28, 0x49,
+		0xb3, 0x15, 0x18, 0x17, 0xbb, 0x73, 0xce, 0xef, 0xfb, 0x74, 0x62, 0x9f, 0x83, 0x9e, 0xe6, 0xa1,
+		0x30, 0x1e, 0x67, 0x91, 0x50, 0x5c, 0x78, 0x76, 0xc5, 0x8c, 0x88, 0xbc, 0xcd, 0xd0, 0x03, 0x66,
+		0xd7, 0x89, 0xb4, 0x40, 0x32, 0xa3, 0x41, 0xe3, 0x6e, 0x81, 0x91, 0x2d, 0x46, 0x2a, 0x8c, 0x6c,
+		0x86, 0xbd, 0x27, 0xb1, 0xd6, 0x71, 0x22, 0xbc, 0x92, 0x0a, 0xf3, 0xef, 0x1e, 0xc8, 0x54, 0x58,
+		0x60, 0x69, 0x56, 0x89, 0x47, 0x1a, 0x3d, 0x5a, 0x32, 0xbb, 0x9e, 0x4b, 0x0b, 0x53, 0x69, 0x33,
+		0x06, 0x7c, 0x15, 0x00, 0x03, 0x8b, 0x9f, 0xa1, 0x07, 0xf6, 0x42, 0x71, 0x9a, 0x16, 0x25, 0x6a,
+		0x18, 0x08, 0xd7, 0xe9, 0x3b, 0x03, 0xc7, 0xbf, 0x5f, 0x94, 0x4f, 0x8b, 0xaa, 0xcf, 0x40, 0x60,
+		0x82, 0x3a, 0xd1, 0x56, 0xa4, 0x09, 0x03, 0xa1, 0xf8, 0x05, 0x4d, 0xad, 0xbb, 0x57, 0xb2, 0x07,
+		0x75, 0x34, 0xaf, 0x92, 0x53, 0x7b, 0xf4, 0xab, 0x81, 0x0e, 0xeb, 0x8e, 0x01, 0x67, 0x89, 0x54,
+		0xf1, 0x54, 0x70, 0x69, 0xa5, 0x56, 0xf8, 0x1d, 0x6a, 0x5d, 0xfe, 0x5f, 0xd9, 0xed, 0xde, 0xab,
+		0x1e, 0xa9, 0x6e, 0x40, 0xea, 0x1b, 0x90, 0x65, 0x4d, 0xf8, 0x57, 0x30, 0xee, 0xa2, 0xa6, 0x11,
+		0xcc, 0x6a, 0x55, 0x36, 0x6e, 0xf9, 0xdb, 0x2f, 0x3c, 0x42, 0x8f, 0x33, 0x23, 0x36, 0x52, 0xe7,
+		0x96, 0xaa, 0x3c, 0xa5, 0x3f, 0x8d, 0x04, 0x41, 0x33, 0x66, 0x40, 0x82, 0xd4, 0xca, 0xba, 0x8d,
+		0xbe, 0x33, 0xd8, 0xf7, 0x7b, 0x35, 0x74, 0x96, 0xa7, 0x5f, 0x0b, 0xe4, 0xd3, 0x25, 0x81, 0x5f,
+		0xa2, 0x87, 0xd7, 0x9a, 0xb7, 0x4b, 0x13, 0xab, 0x7f, 0x0d, 0x82, 0x3a, 0x85, 0x61, 0x04, 0x8b,
+		0x76, 0x85, 0xfd, 0x52, 0x38, 0x50, 0x79, 0xea, 0x0b, 0x16, 0xed, 0xf0, 0x6d, 0xd4, 0xf8, 0x91,
+		0x59, 0xb7, 0x59, 0x3e, 0x59, 0x71, 0xc4, 0x2f, 0x10, 0x0e, 0x19, 0x5f, 0x27, 0x3a, 0xa6, 0x5c,
+		0xe7, 0x0a, 0xe8, 0x4a, 0x2a, 0x70, 0xef, 0xf4, 0x9d, 0x41, 0xc3, 0x6f, 0x6f, 0x93, 0x49, 0x11,
+		0x1c, 0x4b, 0x05, 0xd7, 0x8d, 0xea, 0xee, 0x0d, 0x46, 0xd5, 0xfa, 0xcf, 0xa8, 0x9e, 0x9f, 0x23,
+		0x54, 0x4c, 0x2a, 0xd0, 0xb9, 0xe1, 0x02, 0x1f, 0xa2, 0xce, 0x72, 0x14, 0x9c, 0xd0, 0x60, 0xf1,
+		0xd9, 0x9f, 0x7c, 0xa0, 0xb3, 0xb3, 0x2f, 0xa3, 0xf9, 0x6c, 0xda, 0xbe, 0xf5, 0x77, 0x70, 0x3c,
+		0x0b, 0x96, 0x0b, 0xff, 0x5b, 0xdb, 0xc1, 0x3d, 0xd4, 0xdd, 0x0d, 0xa6, 0x63, 0x3a, 0x1e, 0x4d,
+		0x4e, 0xe6, 0x8b, 0x8f, 0xed, 0xbd, 0xf1, 0xdb, 0xf3, 0x37, 0xb1, 0x84, 0x55, 0x1e, 0x12, 0xae,
+		0x53, 0xef, 0x8f, 0x25, 0x27, 0xb1, 0x50, 0xd5, 0xc2, 0x5e, 0xed, 0xfb, 0xfb, 0xea, 0xb4, 0x19,
+		0x86, 0xcd, 0x32, 0x79, 0xfd, 0x7b, 0x00, 0x72, 0xfe, 0x44, 0xd7, 0x19, 0x03, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) AdminExtAPIYARPCClient {
+			return NewAdminExtAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
@@ -0,0 +1,657 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/apiext/v1/service.proto
+
+package apiextv1
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	v1 "github.com/uber/cadence-idl/go/proto/api/v1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StartWorkflowExecutionRequest struct {
+	Request *v1.StartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// request_eager_execution asks for the first decision task to be started on behalf of the caller
+	// and returned in the response instead of being dispatched through the task list.
+	RequestEagerExecution bool     `protobuf:"varint,2,opt,name=request_eager_execution,json=requestEagerExecution,proto3" json:"request_eager_execution,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *StartWorkflowExecutionRequest) Reset()         { *m = StartWorkflowExecutionRequest{} }
+func (m *StartWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*StartWorkflowExecutionRequest) ProtoMessage()    {}
+func (*StartWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b29aeb5cddaa471, []int{0}
+}
+func (m *StartWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *StartWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *StartWorkflowExecutionRequest) GetRequest() *v1.StartWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *StartWorkflowExecutionRequest) GetRequestEagerExecution() bool {
+	if m != nil {
+		return m.RequestEagerExecution
+	}
+	return false
+}
+
+type StartWorkflowExecutionResponse struct {
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// decision_task is the first decision task when it was started eagerly.
+	DecisionTask         *v1.PollForDecisionTaskResponse `protobuf:"bytes,2,opt,name=decision_task,json=decisionTask,proto3" json:"decision_task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *StartWorkflowExecutionResponse) Reset()         { *m = StartWorkflowExecutionResponse{} }
+func (m *StartWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*StartWorkflowExecutionResponse) ProtoMessage()    {}
+func (*StartWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b29aeb5cddaa471, []int{1}
+}
+func (m *StartWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *StartWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartWorkflowExecutionResponse proto.InternalMessageInfo
+
+func (m *StartWorkflowExecutionResponse) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *StartWorkflowExecutionResponse) GetDecisionTask() *v1.PollForDecisionTaskResponse {
+	if m != nil {
+		return m.DecisionTask
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.apiext.v1.StartWorkflowExecutionRequest")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.apiext.v1.StartWorkflowExecutionResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/apiext/v1/service.proto", fileDescriptor_8b29aeb5cddaa471)
+}
+
+var fileDescriptor_8b29aeb5cddaa471 = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4e, 0xf2, 0x40,
+	0x14, 0xc5, 0x33, 0x5f, 0xf2, 0xa1, 0x0e, 0xea, 0x62, 0x12, 0x90, 0x90, 0xd8, 0x10, 0xe2, 0x82,
+	0xb8, 0x98, 0xda, 0x1a, 0xd8, 0xb8, 0xf2, 0x0f, 0x26, 0x24, 0x2e, 0x48, 0xd5, 0x98, 0xb8, 0x69,
+	0x4a, 0x7b, 0xc5, 0x09, 0x38, 0x83, 0x33, 0xd3, 0xc2, 0x1b, 0xb8, 0xf3, 0x01, 0xdc, 0xfb, 0x2e,
+	0x2e, 0x7d, 0x04, 0xc3, 0x93, 0x18, 0x3a, 0x45, 0x90, 0x20, 0xc6, 0xdd, 0xcd, 0xbd, 0xbf, 0x73,
+	0xee, 0x69, 0xe7, 0xe2, 0xbd, 0xb8, 0x03, 0xd2, 0x0e, 0x83, 0x08, 0x78, 0x08, 0x76, 0x30, 0x60,
+	0x30, 0xd2, 0x76, 0xe2, 0xd8, 0x0a, 0x64, 0xc2, 0x42, 0xa0, 0x03, 0x29, 0xb4, 0x20, 0xc5, 0x09,
+	0x45, 0x33, 0x8a, 0x1a, 0x8a, 0x26, 0x4e, 0xb9, 0xb6, 0xa8, 0x9e, 0x93, 0xfa, 0x43, 0x21, 0x7b,
+	0x20, 0x8d, 0x43, 0x79, 0xff, 0x37, 0xf2, 0xae, 0x2f, 0x86, 0x86, 0xad, 0xbe, 0x22, 0xbc, 0x7b,
+	0xa9, 0x03, 0xa9, 0x6f, 0xb2, 0x7e, 0x73, 0x04, 0x61, 0xac, 0x99, 0xe0, 0x1e, 0x3c, 0xc6, 0xa0,
+	0x34, 0xb9, 0xc0, 0x6b, 0xd2, 0x94, 0x25, 0x54, 0x41, 0xb5, 0xbc, 0xeb, 0xd2, 0xc5, 0x84, 0x34,
+	0x71, 0xe8, 0x4a, 0x13, 0x6f, 0x6a, 0x41, 0x1a, 0x78, 0x27, 0x2b, 0x7d, 0x08, 0xba, 0x20, 0x7d,
+	0x98, 0xa2, 0xa5, 0x7f, 0x15, 0x54, 0x5b, 0xf7, 0x0a, 0xd9, 0xb8, 0x39, 0x99, 0x7e, 0xf9, 0x54,
+	0x9f, 0x11, 0xb6, 0x7e, 0x5a, 0xa1, 0x06, 0x82, 0x2b, 0x20, 0x05, 0x9c, 0x93, 0x31, 0xf7, 0x59,
+	0x94, 0xe6, 0xdc, 0xf0, 0xfe, 0xcb, 0x98, 0xb7, 0x22, 0x72, 0x8d, 0xb7, 0x22, 0x08, 0x99, 0x62,
+	0x82, 0xfb, 0x3a, 0x50, 0xbd, 0x74, 0x4f, 0xde, 0x3d, 0x58, 0xfa, 0x15, 0x6d, 0xd1, 0xef, 0x9f,
+	0x0b, 0x79, 0x96, 0x09, 0xae, 0x02, 0xd5, 0x9b, 0xfa, 0x7b, 0x9b, 0xd1, 0x5c, 0xd7, 0x7d, 0x41,
+	0x78, 0x7b, 0x96, 0x45, 0x1f, 0xb7, 0x5b, 0xe4, 0x09, 0xe1, 0xe2, 0xf2, 0x8c, 0xa4, 0x4e, 0x97,
+	0xbf, 0xea, 0xea, 0xdf, 0x56, 0x6e, 0xfc, 0x55, 0x66, 0xa2, 0x9e, 0x9c, 0xbe, 0x8d, 0x2d, 0xf4,
+	0x3e, 0xb6, 0xd0, 0xc7, 0xd8, 0x42, 0xb7, 0xf5, 0x2e, 0xd3, 0xf7, 0x71, 0x87, 0x86, 0xe2, 0xc1,
+	0xfe, 0x76, 0x1a, 0xb4, 0x0b, 0xdc, 0x4e, 0xef, 0x60, 0x76, 0x8d, 0x47, 0xa6, 0x4a, 0x9c, 0x4e,
+	0x2e, 0x9d, 0x1c, 0x7e, 0x0e, 0x00, 0x7e, 0x94, 0x34, 0xa9, 0xb7, 0x02, 0x00, 0x00,
+}
+
+func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RequestEagerExecution {
+		i--
+		if m.RequestEagerExecution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DecisionTask != nil {
+		{
+			size, err := m.DecisionTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.RequestEagerExecution {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.DecisionTask != nil {
+		l = m.DecisionTask.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.StartWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestEagerExecution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestEagerExecution = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionTask == nil {
+				m.DecisionTask = &v1.PollForDecisionTaskResponse{}
+			}
+			if err := m.DecisionTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipService(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthService
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupService
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthService
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthService        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowService          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupService = fmt.Errorf("proto: unexpected end of group")
+)
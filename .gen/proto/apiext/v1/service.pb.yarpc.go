@@ -0,0 +1,1295 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/apiext/v1/service.proto
+
+package apiextv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// WorkflowExtAPIYARPCClient is the YARPC client-side interface for the WorkflowExtAPI service.
+type WorkflowExtAPIYARPCClient interface {
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+}
+
+func newWorkflowExtAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) WorkflowExtAPIYARPCClient {
+	return &_WorkflowExtAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.apiext.v1.WorkflowExtAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewWorkflowExtAPIYARPCClient builds a new YARPC client for the WorkflowExtAPI service.
+func NewWorkflowExtAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) WorkflowExtAPIYARPCClient {
+	return newWorkflowExtAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// WorkflowExtAPIYARPCServer is the YARPC server-side interface for the WorkflowExtAPI service.
+type WorkflowExtAPIYARPCServer interface {
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+}
+
+type buildWorkflowExtAPIYARPCProceduresParams struct {
+	Server      WorkflowExtAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildWorkflowExtAPIYARPCProcedures(params buildWorkflowExtAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_WorkflowExtAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.apiext.v1.WorkflowExtAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "StartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.StartWorkflowExecution,
+							NewRequest:  newWorkflowExtAPIServiceStartWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildWorkflowExtAPIYARPCProcedures prepares an implementation of the WorkflowExtAPI service for YARPC registration.
+func BuildWorkflowExtAPIYARPCProcedures(server WorkflowExtAPIYARPCServer) []transport.Procedure {
+	return buildWorkflowExtAPIYARPCProcedures(buildWorkflowExtAPIYARPCProceduresParams{Server: server})
+}
+
+// FxWorkflowExtAPIYARPCClientParams defines the input
+// for NewFxWorkflowExtAPIYARPCClient. It provides the
+// paramaters to get a WorkflowExtAPIYARPCClient in an
+// Fx application.
+type FxWorkflowExtAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxWorkflowExtAPIYARPCClientResult defines the output
+// of NewFxWorkflowExtAPIYARPCClient. It provides a
+// WorkflowExtAPIYARPCClient to an Fx application.
+type FxWorkflowExtAPIYARPCClientResult struct {
+	fx.Out
+
+	Client WorkflowExtAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxWorkflowExtAPIYARPCClient provides a WorkflowExtAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  apiextv1.NewFxWorkflowExtAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxWorkflowExtAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxWorkflowExtAPIYARPCClientParams) FxWorkflowExtAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxWorkflowExtAPIYARPCClientResult{
+			Client: newWorkflowExtAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxWorkflowExtAPIYARPCProceduresParams defines the input
+// for NewFxWorkflowExtAPIYARPCProcedures. It provides the
+// paramaters to get WorkflowExtAPIYARPCServer procedures in an
+// Fx application.
+type FxWorkflowExtAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      WorkflowExtAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxWorkflowExtAPIYARPCProceduresResult defines the output
+// of NewFxWorkflowExtAPIYARPCProcedures. It provides
+// WorkflowExtAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxWorkflowExtAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxWorkflowExtAPIYARPCProcedures provides WorkflowExtAPIYARPCServer procedures to an Fx application.
+// It expects a WorkflowExtAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  apiextv1.NewFxWorkflowExtAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxWorkflowExtAPIYARPCProcedures() interface{} {
+	return func(params FxWorkflowExtAPIYARPCProceduresParams) FxWorkflowExtAPIYARPCProceduresResult {
+		return FxWorkflowExtAPIYARPCProceduresResult{
+			Procedures: buildWorkflowExtAPIYARPCProcedures(buildWorkflowExtAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: WorkflowExtAPIReflectionMeta,
+		}
+	}
+}
+
+// WorkflowExtAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var WorkflowExtAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.apiext.v1.WorkflowExtAPI",
+	FileDescriptors: yarpcFileDescriptorClosure8b29aeb5cddaa471,
+}
+
+type _WorkflowExtAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_WorkflowExtAPIYARPCCaller) StartWorkflowExecution(ctx context.Context, request *StartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "StartWorkflowExecution", request, newWorkflowExtAPIServiceStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowExtAPIServiceStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _WorkflowExtAPIYARPCHandler struct {
+	server WorkflowExtAPIYARPCServer
+}
+
+func (h *_WorkflowExtAPIYARPCHandler) StartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *StartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*StartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowExtAPIServiceStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.StartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newWorkflowExtAPIServiceStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &StartWorkflowExecutionRequest{}
+}
+
+func newWorkflowExtAPIServiceStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+var (
+	emptyWorkflowExtAPIServiceStartWorkflowExecutionYARPCRequest  = &StartWorkflowExecutionRequest{}
+	emptyWorkflowExtAPIServiceStartWorkflowExecutionYARPCResponse = &StartWorkflowExecutionResponse{}
+)
+
+var yarpcFileDescriptorClosure8b29aeb5cddaa471 = [][]byte{
+	// uber/cadence/apiext/v1/service.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcf, 0x4b, 0x3a, 0x41,
+		0x14, 0x67, 0xbe, 0xf0, 0xb5, 0x1a, 0xab, 0xc3, 0x80, 0x26, 0x42, 0x21, 0xd2, 0x41, 0x3a, 0xcc,
+		0xb6, 0x1b, 0xda, 0xa1, 0x53, 0x91, 0x81, 0xd0, 0x41, 0xb6, 0x22, 0xe8, 0xb2, 0xac, 0xb3, 0x2f,
+		0x1b, 0xb4, 0x19, 0x9b, 0x1f, 0xab, 0xff, 0x41, 0xb7, 0xfe, 0x80, 0xee, 0xfd, 0x9f, 0xe1, 0xce,
+		0x9a, 0x26, 0x66, 0x74, 0x7b, 0xbc, 0xcf, 0x8f, 0xf7, 0x99, 0x79, 0x0f, 0x1f, 0xda, 0x1e, 0x28,
+		0x8f, 0xc5, 0x09, 0x08, 0x06, 0x5e, 0x3c, 0xe2, 0x30, 0x31, 0x5e, 0xea, 0x7b, 0x1a, 0x54, 0xca,
+		0x19, 0xd0, 0x91, 0x92, 0x46, 0x92, 0xf2, 0x94, 0x45, 0x73, 0x16, 0x75, 0x2c, 0x9a, 0xfa, 0xd5,
+		0xc6, 0xb2, 0x7a, 0x41, 0x1a, 0x8d, 0xa5, 0x1a, 0x80, 0x72, 0x0e, 0xd5, 0xa3, 0xdf, 0x98, 0x8f,
+		0x43, 0x39, 0x76, 0xdc, 0xfa, 0x07, 0xc2, 0xfb, 0x37, 0x26, 0x56, 0xe6, 0x3e, 0xef, 0xb7, 0x27,
+		0xc0, 0xac, 0xe1, 0x52, 0x84, 0xf0, 0x62, 0x41, 0x1b, 0x72, 0x8d, 0x37, 0x94, 0x2b, 0x2b, 0xa8,
+		0x86, 0x1a, 0xc5, 0x20, 0xa0, 0xcb, 0x09, 0x69, 0xea, 0xd3, 0xb5, 0x26, 0xe1, 0xcc, 0x82, 0xb4,
+		0xf0, 0x5e, 0x5e, 0x46, 0x10, 0xf7, 0x41, 0x45, 0x30, 0xa3, 0x56, 0xfe, 0xd5, 0x50, 0x63, 0x33,
+		0x2c, 0xe5, 0x70, 0x7b, 0x8a, 0x7e, 0xf9, 0xd4, 0xdf, 0x10, 0x3e, 0xf8, 0x69, 0x84, 0x1e, 0x49,
+		0xa1, 0x81, 0x94, 0x70, 0x41, 0x59, 0x11, 0xf1, 0x24, 0xcb, 0xb9, 0x15, 0xfe, 0x57, 0x56, 0x74,
+		0x12, 0x72, 0x87, 0x77, 0x12, 0x60, 0x5c, 0x73, 0x29, 0x22, 0x13, 0xeb, 0x41, 0x36, 0xa7, 0x18,
+		0x1c, 0xaf, 0x7c, 0x45, 0x57, 0x0e, 0x87, 0x57, 0x52, 0x5d, 0xe6, 0x82, 0xdb, 0x58, 0x0f, 0x66,
+		0xfe, 0xe1, 0x76, 0xb2, 0xd0, 0x0d, 0xde, 0x11, 0xde, 0x9d, 0x67, 0x31, 0xe7, 0xdd, 0x0e, 0x79,
+		0x45, 0xb8, 0xbc, 0x3a, 0x23, 0x69, 0xd2, 0xd5, 0x5b, 0x5d, 0xff, 0x6d, 0xd5, 0xd6, 0x5f, 0x65,
+		0x2e, 0xea, 0xc5, 0xe9, 0x43, 0xb3, 0xcf, 0xcd, 0x93, 0xed, 0x51, 0x26, 0x9f, 0xbd, 0x6f, 0xe7,
+		0x40, 0xfb, 0x20, 0xbc, 0x6c, 0xf7, 0xf3, 0x0b, 0x3c, 0x73, 0x55, 0xea, 0xf7, 0x0a, 0x19, 0x72,
+		0xf2, 0x39, 0x00, 0x8a, 0xdb, 0xfa, 0x27, 0xab, 0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/service_worker.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdb, 0xc8,
+		0x15, 0x06, 0x65, 0xcb, 0x97, 0xe3, 0x9b, 0x3c, 0x46, 0x5d, 0x9a, 0xce, 0xc5, 0x91, 0x93, 0xd8,
+		0xdb, 0x6e, 0xe5, 0xc4, 0xbb, 0xf5, 0x3a, 0xb7, 0xa2, 0xbe, 0xc4, 0x88, 0x8b, 0xcd, 0xd6, 0xcb,
+		0x68, 0x13, 0x60, 0x0b, 0x84, 0x18, 0x91, 0x63, 0x6b, 0x60, 0x8a, 0xa3, 0x90, 0x43, 0x2b, 0x7a,
+		0xe9, 0x43, 0x1f, 0xb7, 0x7d, 0x28, 0x50, 0x74, 0xfb, 0x52, 0x20, 0xcf, 0xfd, 0x01, 0xfd, 0x33,
+		0xed, 0x53, 0x9f, 0xfb, 0x1b, 0x0a, 0x14, 0x9c, 0x19, 0x4a, 0x94, 0x44, 0x51, 0x97, 0x16, 0x70,
+		0x80, 0x7d, 0x33, 0x67, 0xbe, 0xf3, 0xf1, 0xcc, 0x39, 0x87, 0x67, 0xbe, 0x19, 0x0b, 0xb6, 0xc3,
+		0x0a, 0xf1, 0x77, 0x6c, 0xec, 0x10, 0xcf, 0x26, 0x3b, 0xb8, 0x4e, 0x77, 0xae, 0x1e, 0xee, 0x04,
+		0xc4, 0xbf, 0xa2, 0x36, 0xb1, 0x1a, 0xcc, 0xbf, 0x24, 0x7e, 0xa9, 0xee, 0x33, 0xce, 0xd0, 0x4a,
+		0x84, 0x2c, 0x29, 0x64, 0x09, 0xd7, 0x69, 0xe9, 0xea, 0xa1, 0x71, 0xeb, 0x82, 0xb1, 0x0b, 0x97,
+		0xec, 0x08, 0x48, 0x25, 0x3c, 0xdf, 0x71, 0x42, 0x1f, 0x73, 0xca, 0x3c, 0x69, 0x64, 0xdc, 0xee,
+		0x9e, 0xe7, 0xb4, 0x46, 0x02, 0x8e, 0x6b, 0x75, 0x05, 0xe8, 0x21, 0x68, 0xf8, 0xb8, 0x5e, 0x27,
+		0x7e, 0xa0, 0xe6, 0x37, 0xd2, 0xfc, 0xb3, 0x59, 0xad, 0xd6, 0x7a, 0x45, 0x31, 0x0d, 0xe1, 0x10,
+		0x9b, 0x06, 0x6d, 0x37, 0xee, 0xa4, 0x61, 0xaa, 0x34, 0xe0, 0xcc, 0x6f, 0xc6, 0x9e, 0xa6, 0x41,
+		0xde, 0x85, 0xc4, 0x6f, 0x66, 0xbd, 0x87, 0xe3, 0xe0, 0xd2, 0xa5, 0x01, 0xcf, 0xc2, 0x44, 0x51,
+		0x3c, 0x77, 0x59, 0x43, 0x62, 0x8a, 0x7f, 0xd7, 0xc0, 0x38, 0x63, 0xae, 0x7b, 0xc2, 0xfc, 0x63,
+		0xe5, 0x65, 0x19, 0x07, 0x97, 0x26, 0x79, 0x17, 0x92, 0x80, 0xa3, 0x55, 0x98, 0x72, 0x58, 0x0d,
+		0x53, 0x4f, 0xd7, 0x36, 0xb4, 0xed, 0x59, 0x53, 0x3d, 0xa1, 0xc7, 0x30, 0x1b, 0xbd, 0xcc, 0x8a,
+		0xde, 0xa6, 0xe7, 0x36, 0xb4, 0xed, 0xb9, 0xdd, 0x9b, 0xa5, 0x94, 0x94, 0x94, 0x22, 0xb2, 0x2f,
+		0x69, 0xc0, 0xcd, 0x19, 0xae, 0xfe, 0x42, 0x06, 0xcc, 0x50, 0x87, 0x78, 0x9c, 0xf2, 0xa6, 0x3e,
+		0x21, 0x58, 0x5b, 0xcf, 0x68, 0x0b, 0x96, 0x2a, 0xd4, 0xc3, 0x7e, 0xd3, 0xb2, 0xab, 0xc4, 0xbe,
+		0x0c, 0xc2, 0x9a, 0x3e, 0x29, 0x20, 0x8b, 0x72, 0xf8, 0x48, 0x8d, 0x16, 0xff, 0x33, 0x03, 0xeb,
+		0xa9, 0x7e, 0x07, 0x75, 0xe6, 0x05, 0x04, 0xdd, 0x04, 0x10, 0x0e, 0x72, 0x76, 0x49, 0xa4, 0xf3,
+		0xf3, 0xa6, 0x70, 0xb9, 0x1c, 0x0d, 0xa0, 0x6f, 0x00, 0xc5, 0x81, 0xb0, 0xc8, 0x7b, 0x62, 0x87,
+		0x51, 0x95, 0xa8, 0x85, 0xdc, 0x4f, 0x5d, 0xc8, 0x1b, 0x05, 0x7f, 0x1e, 0xa3, 0xcd, 0xe5, 0x46,
+		0xf7, 0x10, 0x3a, 0x81, 0x85, 0x16, 0x2d, 0x6f, 0xd6, 0x89, 0x58, 0xdf, 0xdc, 0xee, 0x9d, 0x4c,
+		0xc6, 0x72, 0xb3, 0x4e, 0xcc, 0xf9, 0x46, 0xe2, 0x09, 0xbd, 0x86, 0xb5, 0xba, 0x4f, 0xae, 0x28,
+		0x0b, 0x03, 0x2b, 0xe0, 0xd8, 0xe7, 0xc4, 0xb1, 0xc8, 0x15, 0xf1, 0xb8, 0x45, 0x1d, 0x11, 0x90,
+		0xb9, 0xdd, 0xf5, 0x92, 0xac, 0xd5, 0x52, 0x5c, 0xab, 0xa5, 0x53, 0x8f, 0xef, 0x7d, 0xfe, 0x1a,
+		0xbb, 0x21, 0x31, 0x57, 0x63, 0xeb, 0x57, 0xd2, 0xf8, 0x79, 0x64, 0x7b, 0xea, 0xa0, 0x6d, 0x28,
+		0xf4, 0xd0, 0xe5, 0x37, 0xb4, 0xed, 0x09, 0x73, 0x31, 0xe8, 0x44, 0xea, 0x30, 0x8d, 0x39, 0x27,
+		0xb5, 0x3a, 0xd7, 0xa7, 0x04, 0x20, 0x7e, 0x44, 0x9f, 0x02, 0xaa, 0x60, 0xfb, 0xd2, 0x65, 0x17,
+		0x96, 0xcd, 0x42, 0x8f, 0x5b, 0x55, 0xea, 0x71, 0x7d, 0x5a, 0x80, 0x0a, 0x6a, 0xe6, 0x28, 0x9a,
+		0x78, 0x41, 0x3d, 0x8e, 0xf6, 0x60, 0x5a, 0x55, 0xb6, 0x3e, 0x23, 0xfc, 0xbe, 0x91, 0x1a, 0x8b,
+		0x17, 0x12, 0x63, 0xc6, 0x60, 0x74, 0x1f, 0x96, 0x3c, 0xf2, 0x9e, 0x5b, 0x75, 0x7c, 0x41, 0x54,
+		0x12, 0x67, 0x45, 0x12, 0x17, 0xa2, 0xe1, 0x33, 0x7c, 0x41, 0x64, 0x22, 0xf7, 0x21, 0x2f, 0x3e,
+		0x0b, 0x1d, 0x04, 0x7b, 0x31, 0x33, 0xd2, 0x5f, 0x47, 0x48, 0x53, 0x1a, 0xa0, 0xb7, 0x70, 0xa3,
+		0xb7, 0x04, 0xac, 0x76, 0x55, 0xcf, 0x0d, 0x53, 0xd5, 0x6b, 0x3d, 0x35, 0x10, 0x4f, 0xa1, 0x03,
+		0x58, 0x0c, 0xec, 0x2a, 0x71, 0x42, 0x97, 0x38, 0x56, 0xd4, 0x68, 0xf4, 0x79, 0xc1, 0x68, 0xf4,
+		0x24, 0xae, 0x1c, 0x77, 0x21, 0x73, 0xa1, 0x65, 0x11, 0x8d, 0xa1, 0x67, 0x30, 0x1f, 0xa7, 0x4b,
+		0x10, 0x2c, 0x0c, 0x24, 0x98, 0x53, 0x78, 0x61, 0xfe, 0x06, 0xa6, 0xa3, 0xa5, 0x52, 0x12, 0xe8,
+		0x8b, 0x1b, 0x13, 0xdb, 0x73, 0xbb, 0xcf, 0x52, 0x17, 0x93, 0xf1, 0x19, 0x95, 0xbe, 0x96, 0xf6,
+		0xcf, 0x3d, 0x1e, 0x25, 0x47, 0xb1, 0xa1, 0x22, 0x88, 0x2c, 0xb4, 0x6b, 0x68, 0x49, 0x64, 0x7f,
+		0x2e, 0x1a, 0x8c, 0x0b, 0xa8, 0x04, 0x2b, 0x9c, 0x71, 0xec, 0x5a, 0x2a, 0xa3, 0x56, 0xa5, 0xc9,
+		0x49, 0xa0, 0x17, 0x04, 0x72, 0x59, 0x4c, 0xa9, 0xa4, 0x1f, 0x46, 0x13, 0xe8, 0x25, 0x14, 0x70,
+		0xc8, 0x99, 0x65, 0x33, 0xef, 0x9c, 0x5e, 0xc8, 0xa2, 0x5a, 0x16, 0xeb, 0xdd, 0x4c, 0xf5, 0xfa,
+		0x20, 0xe4, 0xec, 0x48, 0x60, 0xa3, 0x3a, 0x33, 0x17, 0x71, 0xc7, 0xb3, 0xf1, 0x16, 0xe6, 0x93,
+		0xbe, 0xa3, 0x02, 0x4c, 0x5c, 0x92, 0xa6, 0xea, 0x62, 0xd1, 0x9f, 0x51, 0xe5, 0x5c, 0x45, 0x1f,
+		0x8b, 0x9e, 0x1b, 0xbe, 0x72, 0x84, 0xc1, 0xe3, 0xdc, 0xbe, 0x56, 0xfc, 0x5b, 0x1e, 0x36, 0x65,
+		0x94, 0x9c, 0x64, 0xe0, 0x8e, 0x58, 0xad, 0xee, 0x12, 0x4e, 0x9c, 0xb8, 0x81, 0x0e, 0xe8, 0x43,
+		0x4f, 0x60, 0x36, 0xde, 0x1c, 0x02, 0x3d, 0xb7, 0x31, 0xd1, 0xb7, 0xe2, 0xe2, 0x97, 0x98, 0x6d,
+		0x3c, 0xfa, 0x29, 0x2c, 0xb7, 0x0b, 0xd7, 0x66, 0x1e, 0x27, 0xef, 0xb9, 0xe8, 0x38, 0xf3, 0x66,
+		0xa1, 0x35, 0x71, 0x24, 0xc7, 0x3b, 0xba, 0xee, 0x64, 0x57, 0xd7, 0xfd, 0x0d, 0x2c, 0x07, 0x9c,
+		0xda, 0x97, 0x4d, 0x0b, 0x73, 0xee, 0xd3, 0x4a, 0x18, 0x65, 0x2a, 0x2f, 0xc2, 0x52, 0x4a, 0xf5,
+		0xe6, 0x95, 0x40, 0xb7, 0x6a, 0xfe, 0xa0, 0x65, 0x65, 0x16, 0x24, 0x51, 0x7b, 0x04, 0x7d, 0x01,
+		0xba, 0x4f, 0x78, 0xe8, 0x7b, 0x96, 0x47, 0x1a, 0x56, 0xec, 0xbd, 0xf8, 0xd0, 0x44, 0x6b, 0x99,
+		0x31, 0x7f, 0x24, 0xe7, 0xbf, 0x22, 0x8d, 0x64, 0x28, 0xd1, 0x21, 0xdc, 0x3a, 0x67, 0xbe, 0x4d,
+		0x2c, 0xdb, 0x27, 0x98, 0x93, 0x14, 0xf3, 0x69, 0x61, 0x6e, 0x08, 0xd4, 0x91, 0x00, 0x75, 0x73,
+		0xa4, 0xec, 0x27, 0x33, 0x69, 0xfb, 0x09, 0x62, 0xb0, 0x20, 0xda, 0x82, 0xe5, 0x93, 0x20, 0x74,
+		0x79, 0xa0, 0xcf, 0x8a, 0x64, 0xfc, 0x2a, 0x75, 0xf9, 0x43, 0x24, 0xbe, 0x24, 0x2b, 0x46, 0x92,
+		0xc9, 0xcf, 0x67, 0xfe, 0x5d, 0x62, 0xc8, 0xa0, 0xb0, 0xdc, 0x03, 0x49, 0xa9, 0xd2, 0x5f, 0x74,
+		0x56, 0xe9, 0xf6, 0x10, 0x55, 0x2a, 0x08, 0x93, 0xb5, 0xfa, 0x61, 0x02, 0xee, 0x66, 0xbb, 0xac,
+		0x36, 0xcd, 0x6f, 0x60, 0xa1, 0x33, 0xc0, 0x9a, 0x78, 0xe9, 0x83, 0x51, 0xdb, 0x86, 0x39, 0xef,
+		0x24, 0x93, 0xf0, 0x41, 0x83, 0x5b, 0xd8, 0xe6, 0xf4, 0x8a, 0x72, 0x4a, 0x02, 0x8b, 0x33, 0xcb,
+		0xa1, 0x41, 0x1d, 0x73, 0xbb, 0x6a, 0xb9, 0xcc, 0xc6, 0xae, 0xdb, 0x54, 0xa5, 0xff, 0xed, 0x18,
+		0xd1, 0x56, 0x8d, 0xea, 0xa0, 0xc5, 0x5f, 0x66, 0xc7, 0x8a, 0xfd, 0x4b, 0x49, 0x2e, 0xa3, 0xbf,
+		0x8e, 0xfb, 0x23, 0x8c, 0xdf, 0xc2, 0xc6, 0x20, 0x82, 0x94, 0xdc, 0x1c, 0x77, 0xe6, 0x26, 0xfd,
+		0x53, 0x51, 0xbc, 0x4d, 0xc1, 0x15, 0x13, 0x9f, 0x7a, 0xe7, 0x2c, 0x99, 0xa1, 0xdf, 0xe5, 0x60,
+		0x23, 0x65, 0x99, 0x27, 0x98, 0xba, 0x43, 0xb7, 0x92, 0x43, 0xc8, 0xdb, 0x38, 0x0c, 0xa4, 0x37,
+		0x8b, 0xbb, 0x9f, 0x66, 0xb6, 0x91, 0x36, 0xfb, 0x51, 0x64, 0x63, 0x4a, 0xd3, 0x68, 0xb7, 0x76,
+		0x08, 0xc7, 0xd4, 0x0d, 0xf4, 0x89, 0x8c, 0xdd, 0xfa, 0x0c, 0x37, 0x5d, 0x86, 0x1d, 0x33, 0x06,
+		0x67, 0x36, 0x97, 0x94, 0x4f, 0x30, 0x9f, 0x2a, 0xe9, 0x36, 0xe1, 0x4e, 0x46, 0x0c, 0x64, 0x9e,
+		0x8b, 0xff, 0x6a, 0xeb, 0xd5, 0x38, 0xb2, 0xd7, 0xa9, 0x57, 0x5f, 0x01, 0x6a, 0xf1, 0x5a, 0x35,
+		0xc2, 0xb1, 0x83, 0x39, 0x56, 0x0a, 0xed, 0x5e, 0xe6, 0x0b, 0x5e, 0x2a, 0xb0, 0x59, 0xe0, 0x5d,
+		0x23, 0xc5, 0x7f, 0xb4, 0xb5, 0x6d, 0xe7, 0x1a, 0xaf, 0x55, 0xdb, 0xde, 0x86, 0x39, 0xf5, 0x09,
+		0x35, 0xa3, 0x2d, 0x5f, 0x46, 0x02, 0xe2, 0xa1, 0x53, 0x27, 0x12, 0xbf, 0x2d, 0x80, 0x10, 0xbf,
+		0x93, 0x19, 0xe2, 0xb7, 0xb5, 0x30, 0x21, 0x7e, 0x71, 0xe2, 0x09, 0xed, 0x42, 0x9e, 0x7a, 0xf5,
+		0x90, 0xeb, 0xf9, 0x21, 0x4a, 0x50, 0x42, 0x53, 0xc4, 0xd6, 0xd4, 0xff, 0x2a, 0xb6, 0xa6, 0x47,
+		0x13, 0x5b, 0x65, 0x58, 0x8b, 0xf9, 0xa2, 0x0e, 0x67, 0xbb, 0x2c, 0x20, 0x82, 0x88, 0x85, 0x5c,
+		0x49, 0xdf, 0xb5, 0x1e, 0xae, 0x63, 0x75, 0x3e, 0x35, 0x57, 0x63, 0xdb, 0x32, 0x3b, 0x8a, 0x2c,
+		0xcb, 0xd2, 0x10, 0x7d, 0x05, 0xab, 0xe2, 0x25, 0xbd, 0x94, 0xb3, 0x83, 0x28, 0x57, 0x84, 0x61,
+		0x17, 0xdf, 0x09, 0x2c, 0x57, 0x09, 0xf6, 0x79, 0x85, 0x60, 0xde, 0xa2, 0x82, 0x41, 0x54, 0x85,
+		0x96, 0x4d, 0xcc, 0x93, 0x38, 0x1e, 0x44, 0x3a, 0x39, 0xdf, 0x3e, 0x1e, 0xbc, 0x85, 0x5b, 0x9d,
+		0x99, 0xb0, 0xd8, 0xb9, 0xc5, 0xab, 0x34, 0xb0, 0x62, 0x83, 0xc1, 0x32, 0xd8, 0xe8, 0xc8, 0xcc,
+		0xaf, 0xcf, 0xcb, 0x55, 0x1a, 0x1c, 0x28, 0xfe, 0xd3, 0xe4, 0x0a, 0xe2, 0x66, 0xb5, 0x30, 0x44,
+		0xa5, 0xb4, 0x17, 0x71, 0xac, 0xba, 0x56, 0xcf, 0x69, 0x6d, 0x71, 0xbc, 0xd3, 0xda, 0x16, 0x2c,
+		0xb5, 0x78, 0x54, 0xf7, 0x59, 0x92, 0x1d, 0x2e, 0x1e, 0x3e, 0x16, 0xa3, 0xe8, 0x33, 0x98, 0xaa,
+		0x12, 0xec, 0x10, 0x5f, 0x2f, 0xa8, 0x33, 0x5c, 0xea, 0x59, 0x48, 0x40, 0x4c, 0x05, 0xfd, 0x3f,
+		0x0b, 0xe3, 0xe2, 0xf7, 0x5a, 0x4b, 0xb8, 0x26, 0x9b, 0xcb, 0xa8, 0xc2, 0xf5, 0x73, 0x98, 0x92,
+		0x4a, 0x49, 0xcf, 0x0d, 0x11, 0x7b, 0x85, 0xcd, 0x6a, 0xa5, 0xc5, 0xfb, 0x70, 0x37, 0xdb, 0x2f,
+		0xb5, 0x03, 0xfc, 0x3e, 0x07, 0x5b, 0x59, 0xc0, 0xc3, 0xe6, 0xe9, 0xf1, 0xa0, 0xed, 0xe0, 0xba,
+		0x5a, 0x64, 0x3b, 0x6a, 0x93, 0x63, 0x46, 0x2d, 0xdf, 0x15, 0xb5, 0x9f, 0xc0, 0xf6, 0xe0, 0x60,
+		0xa8, 0xc8, 0xfd, 0x59, 0x83, 0x8d, 0x14, 0xf0, 0x48, 0x2a, 0x63, 0x0f, 0xa6, 0xcf, 0x31, 0x75,
+		0x43, 0x9f, 0x64, 0x26, 0xfe, 0x44, 0x62, 0xcc, 0x18, 0x9c, 0x99, 0xf9, 0xf6, 0xc6, 0x9f, 0xe6,
+		0x96, 0x72, 0xfe, 0xbb, 0x1c, 0xdc, 0xed, 0x8b, 0xfa, 0x98, 0x73, 0x9e, 0x88, 0xd8, 0xe4, 0xb8,
+		0x11, 0xeb, 0xce, 0xfa, 0x16, 0xdc, 0x1b, 0x10, 0x0b, 0x15, 0xb5, 0xbf, 0x68, 0x50, 0x4c, 0xab,
+		0x0f, 0xec, 0xd9, 0x64, 0xa4, 0xa4, 0xc7, 0x9d, 0x36, 0x37, 0xae, 0x2c, 0xec, 0x4e, 0xfa, 0x3d,
+		0xd8, 0xcc, 0x74, 0x4c, 0x2d, 0xe0, 0x0f, 0x39, 0xb8, 0x9f, 0x81, 0xfb, 0xc8, 0x13, 0x1f, 0x47,
+		0x6d, 0x72, 0xdc, 0xa8, 0x75, 0x27, 0xfe, 0x13, 0xd8, 0x1a, 0x18, 0x8d, 0x8e, 0xd4, 0xdb, 0xcc,
+		0xef, 0x80, 0xbe, 0x88, 0x37, 0xc1, 0x6b, 0x4c, 0xfd, 0x19, 0x6c, 0x66, 0x3a, 0xa6, 0x64, 0xee,
+		0x27, 0x50, 0xb0, 0xc5, 0xc2, 0x2c, 0x5f, 0xfa, 0x4a, 0x1c, 0xe1, 0xdf, 0x8c, 0xb9, 0x24, 0xc7,
+		0xcd, 0x78, 0x58, 0x55, 0x49, 0x5f, 0xca, 0x1f, 0x5a, 0x95, 0x94, 0x61, 0x6b, 0x60, 0x34, 0x46,
+		0x0f, 0xf2, 0x3f, 0xdb, 0xdb, 0x87, 0xb8, 0x68, 0x18, 0x47, 0x36, 0xfc, 0xb2, 0x4b, 0x36, 0x0c,
+		0x7f, 0x9f, 0x11, 0x6f, 0x86, 0xaf, 0x61, 0x45, 0xfe, 0x23, 0xc8, 0xba, 0x22, 0xbe, 0xb8, 0xa9,
+		0xa0, 0xde, 0x39, 0xd3, 0x27, 0x06, 0x24, 0x8a, 0xf8, 0xaf, 0x25, 0x5c, 0x1c, 0xbd, 0x97, 0x1b,
+		0xdd, 0x43, 0x89, 0x4d, 0x28, 0x6d, 0x71, 0xb1, 0xf6, 0xd0, 0xc0, 0x30, 0x49, 0x40, 0xb8, 0xbc,
+		0x00, 0x6b, 0x1d, 0x16, 0xaf, 0xa5, 0xb6, 0x8a, 0x37, 0x61, 0x3d, 0xd5, 0x19, 0xe5, 0xac, 0x0f,
+		0x8b, 0x9d, 0x5a, 0x30, 0xba, 0xba, 0x27, 0x1e, 0xae, 0xb8, 0xc4, 0x4a, 0x28, 0x4a, 0x95, 0xee,
+		0x82, 0x9c, 0x69, 0x5b, 0xa0, 0x5d, 0x58, 0xad, 0x33, 0xd7, 0x25, 0xbe, 0xd5, 0xc0, 0x54, 0x9e,
+		0x16, 0x2c, 0xea, 0x59, 0x35, 0xd9, 0x09, 0x26, 0x4c, 0x24, 0x67, 0xdf, 0x60, 0x2a, 0x8e, 0x05,
+		0xa7, 0xde, 0xcb, 0x60, 0xf7, 0xdf, 0x4b, 0x30, 0x2b, 0xc3, 0x7d, 0x70, 0x76, 0x8a, 0xde, 0xc3,
+		0x4a, 0xca, 0x2d, 0x11, 0xda, 0x19, 0xfe, 0x3e, 0x49, 0xc4, 0xd5, 0x18, 0xf9, 0x02, 0x0a, 0xfd,
+		0x49, 0x83, 0x1b, 0x59, 0xf7, 0x46, 0x68, 0x7f, 0xdc, 0x8b, 0x3d, 0xe3, 0xd1, 0xd8, 0x97, 0x54,
+		0xe8, 0x3b, 0x0d, 0xd6, 0xfa, 0x5e, 0x71, 0xa0, 0x9f, 0x0f, 0x4b, 0xdc, 0x21, 0xd8, 0x8c, 0xbd,
+		0x51, 0xcd, 0x94, 0x33, 0xed, 0xe4, 0x24, 0xbb, 0x44, 0x76, 0x72, 0x52, 0xae, 0x5c, 0x8c, 0x07,
+		0xc3, 0x1b, 0xf4, 0x26, 0x27, 0x55, 0xb4, 0x66, 0x27, 0x27, 0xeb, 0xd4, 0x62, 0x3c, 0x1a, 0xc3,
+		0x52, 0x79, 0xf5, 0x21, 0x5d, 0x1d, 0x77, 0x48, 0x69, 0xf4, 0x74, 0x64, 0xfe, 0xc4, 0xde, 0x63,
+		0x3c, 0x1b, 0xd3, 0xba, 0xb7, 0x7c, 0x7a, 0x65, 0x5f, 0x76, 0xf9, 0xf4, 0xd5, 0xfb, 0xc6, 0xde,
+		0xa8, 0x66, 0xca, 0x99, 0xef, 0x35, 0xb8, 0x99, 0xa9, 0x41, 0xd1, 0xa3, 0xd1, 0x98, 0x93, 0x81,
+		0x7a, 0x3c, 0x8e, 0xa9, 0x72, 0xec, 0x8f, 0x1a, 0xac, 0xa7, 0x20, 0x63, 0x8d, 0x84, 0xbe, 0x18,
+		0x3a, 0x09, 0x9d, 0x22, 0xd9, 0xd8, 0x1f, 0xdd, 0x50, 0xb9, 0xf4, 0x57, 0x0d, 0x6e, 0x0f, 0x90,
+		0x6d, 0xe8, 0xc9, 0xa8, 0xec, 0xc9, 0x78, 0x3d, 0x1d, 0xcf, 0xb8, 0x23, 0x62, 0x7d, 0xf5, 0x42,
+		0xdf, 0x88, 0x0d, 0xd2, 0x96, 0xc6, 0xfe, 0xe8, 0x86, 0x1d, 0x11, 0xcb, 0x94, 0x30, 0x7d, 0x23,
+		0x36, 0x8c, 0x0c, 0x34, 0x9e, 0x8e, 0x67, 0xdc, 0xfb, 0x25, 0xf6, 0xaa, 0x85, 0xec, 0x2f, 0xb1,
+		0xaf, 0x74, 0x32, 0xf6, 0x46, 0x35, 0x6b, 0x37, 0xf2, 0x14, 0x19, 0xd0, 0xa7, 0x91, 0xf7, 0x57,
+		0x2f, 0xc6, 0x83, 0xe1, 0x0d, 0xe4, 0x9b, 0x0f, 0x2b, 0xf0, 0x63, 0x9b, 0xd5, 0xd2, 0xcc, 0x0e,
+		0x91, 0x54, 0x01, 0xaf, 0xe4, 0x6f, 0x77, 0xce, 0x7c, 0xc6, 0xd9, 0x99, 0xf6, 0xed, 0xc3, 0x0b,
+		0xca, 0xab, 0x61, 0xa5, 0x64, 0xb3, 0xda, 0x4e, 0xf2, 0xc7, 0x29, 0x3f, 0xa3, 0x8e, 0xbb, 0x73,
+		0xc1, 0xe4, 0xef, 0x6e, 0xd4, 0x2f, 0x55, 0x9e, 0xe0, 0x3a, 0xbd, 0x7a, 0x58, 0x99, 0x12, 0x63,
+		0x9f, 0xfd, 0x77, 0x00, 0x83, 0x33, 0x80, 0xed, 0x1b, 0x24, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x72, 0xdb, 0xc4,
+		0x17, 0xfe, 0x29, 0x8e, 0x9d, 0xe4, 0xd8, 0x4d, 0xfc, 0xdb, 0x90, 0xc4, 0x49, 0x09, 0xa4, 0x9a,
+		0x61, 0x1a, 0x3a, 0x20, 0x4f, 0xdc, 0x9b, 0x0e, 0x9d, 0x02, 0x4e, 0xec, 0x24, 0x6a, 0x83, 0x6d,
+		0x64, 0xd3, 0x4c, 0x61, 0x06, 0xcd, 0x5a, 0x5a, 0xb9, 0x8b, 0xa5, 0x5d, 0xb1, 0x5a, 0x39, 0xf1,
+		0x0d, 0xc3, 0x93, 0x70, 0xc1, 0xeb, 0x70, 0xc9, 0x0b, 0x31, 0xab, 0x3f, 0xb1, 0x5d, 0x9c, 0x29,
+		0x17, 0x0c, 0x77, 0xbb, 0xe7, 0xfb, 0xce, 0x39, 0xdf, 0xae, 0xce, 0x39, 0x5a, 0x38, 0x8a, 0x87,
+		0x44, 0xd4, 0x1d, 0xec, 0x12, 0xe6, 0x90, 0x3a, 0x0e, 0x69, 0x7d, 0x72, 0x52, 0x77, 0x78, 0x10,
+		0x70, 0x66, 0x84, 0x82, 0x4b, 0x8e, 0xb6, 0x15, 0xc3, 0xc8, 0x18, 0x06, 0x0e, 0xa9, 0x31, 0x39,
+		0x39, 0xf8, 0x68, 0xc4, 0xf9, 0xc8, 0x27, 0xf5, 0x84, 0x32, 0x8c, 0xbd, 0xba, 0x1b, 0x0b, 0x2c,
+		0x69, 0xee, 0xa4, 0xbf, 0x82, 0xff, 0x5f, 0x73, 0x31, 0xf6, 0x7c, 0x7e, 0xd3, 0xbe, 0x25, 0x4e,
+		0xac, 0x20, 0xf4, 0x31, 0x94, 0x6f, 0x32, 0xa3, 0x4d, 0xdd, 0x9a, 0x76, 0xa4, 0x1d, 0x6f, 0x58,
+		0x90, 0x9b, 0x4c, 0x17, 0xed, 0x40, 0x49, 0xc4, 0x4c, 0x61, 0x2b, 0x09, 0x56, 0x14, 0x31, 0x33,
+		0x5d, 0x5d, 0x87, 0x4a, 0x1e, 0x6c, 0x30, 0x0d, 0x09, 0x42, 0xb0, 0xca, 0x70, 0x40, 0xb2, 0x00,
+		0xc9, 0x5a, 0x71, 0x9a, 0x8e, 0xa4, 0x13, 0x2a, 0xa7, 0xf7, 0x72, 0x0e, 0x61, 0xad, 0x87, 0xa7,
+		0x3e, 0xc7, 0xae, 0x82, 0x5d, 0x2c, 0x71, 0x02, 0x57, 0xac, 0x64, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x59, 0xe6, 0x9f, 0xed, 0x50, 0x0d,
+		0xd6, 0x5c, 0x22, 0x31, 0xf5, 0xa3, 0x44, 0x61, 0xc5, 0xca, 0xb7, 0xfa, 0x6f, 0x1a, 0xac, 0x7e,
+		0x43, 0x02, 0x8e, 0x5e, 0x40, 0xc9, 0xa3, 0xc4, 0x77, 0xa3, 0x9a, 0x76, 0x54, 0x38, 0x2e, 0x37,
+		0x3e, 0x31, 0x96, 0xdc, 0x9f, 0xa1, 0xa8, 0xc6, 0x79, 0xc2, 0x6b, 0x33, 0x29, 0xa6, 0x56, 0xe6,
+		0x74, 0x70, 0x0d, 0xe5, 0x39, 0x33, 0xaa, 0x42, 0x61, 0x4c, 0xa6, 0x99, 0x0a, 0xb5, 0x44, 0x0d,
+		0x28, 0x4e, 0xb0, 0x1f, 0x93, 0x44, 0x40, 0xb9, 0xf1, 0xe1, 0xd2, 0xf0, 0xd9, 0x31, 0xad, 0x94,
+		0xfa, 0xc5, 0xca, 0x33, 0x4d, 0xff, 0x5d, 0x83, 0xd2, 0x25, 0xc1, 0x2e, 0x11, 0xe8, 0xab, 0x77,
+		0x24, 0x3e, 0x5e, 0x1a, 0x23, 0x25, 0xff, 0xb7, 0x22, 0xff, 0xd4, 0xa0, 0xda, 0x27, 0x58, 0x38,
+		0x6f, 0x9b, 0x52, 0x0a, 0x3a, 0x8c, 0x25, 0x89, 0x90, 0x0d, 0x9b, 0x94, 0xb9, 0xe4, 0x96, 0xb8,
+		0xf6, 0x82, 0xec, 0x67, 0x4b, 0xa3, 0xbe, 0xeb, 0x6e, 0x98, 0xa9, 0xef, 0xfc, 0x39, 0x1e, 0xd0,
+		0x79, 0xdb, 0xc1, 0x8f, 0x80, 0xfe, 0x4e, 0xfa, 0x17, 0x4f, 0xe5, 0xc1, 0x7a, 0x0b, 0x4b, 0x7c,
+		0xea, 0xf3, 0x21, 0x3a, 0x87, 0x07, 0x84, 0x39, 0xdc, 0xa5, 0x6c, 0x64, 0xcb, 0x69, 0x98, 0x16,
+		0xe8, 0x66, 0xe3, 0xd1, 0xd2, 0x58, 0xed, 0x8c, 0xa9, 0x2a, 0xda, 0xaa, 0x90, 0xb9, 0xdd, 0x5d,
+		0x01, 0xaf, 0xcc, 0x15, 0x70, 0x2f, 0x6d, 0x3a, 0x22, 0x5e, 0x13, 0x11, 0x51, 0xce, 0x4c, 0xe6,
+		0x71, 0x45, 0xa4, 0x41, 0xe8, 0xe7, 0x8d, 0xa0, 0xd6, 0xe8, 0x31, 0x6c, 0x79, 0x04, 0xcb, 0x58,
+		0x10, 0x7b, 0x92, 0x52, 0xb3, 0x86, 0xdb, 0xcc, 0xcc, 0x59, 0x00, 0xfd, 0x15, 0xec, 0xf5, 0xe3,
+		0x30, 0xe4, 0x42, 0x12, 0xf7, 0xcc, 0xa7, 0x84, 0xc9, 0x0c, 0x89, 0x54, 0xaf, 0x8e, 0xb8, 0x1d,
+		0xb9, 0xe3, 0x2c, 0x72, 0x71, 0xc4, 0xfb, 0xee, 0x18, 0xed, 0xc3, 0xfa, 0x4f, 0x78, 0x82, 0x13,
+		0x20, 0x8d, 0xb9, 0xa6, 0xf6, 0x7d, 0x77, 0xac, 0xff, 0x5a, 0x80, 0xb2, 0x45, 0xa4, 0x98, 0xf6,
+		0xb8, 0x4f, 0x9d, 0x29, 0x6a, 0x41, 0x95, 0x32, 0x2a, 0x29, 0xf6, 0x6d, 0xca, 0x24, 0x11, 0x13,
+		0x9c, 0xaa, 0x2c, 0x37, 0xf6, 0x8d, 0x74, 0xbc, 0x18, 0xf9, 0x78, 0x31, 0x5a, 0xd9, 0x78, 0xb1,
+		0xb6, 0x32, 0x17, 0x33, 0xf3, 0x40, 0x75, 0xd8, 0x1e, 0x62, 0x67, 0xcc, 0x3d, 0xcf, 0x76, 0x38,
+		0xf1, 0x3c, 0xea, 0x28, 0x99, 0x49, 0x6e, 0xcd, 0x42, 0x19, 0x74, 0x36, 0x43, 0x54, 0xda, 0x00,
+		0xdf, 0xd2, 0x20, 0x0e, 0x66, 0x69, 0x0b, 0xef, 0x4d, 0x9b, 0xb9, 0xdc, 0xa5, 0xfd, 0x74, 0x16,
+		0x05, 0x4b, 0x49, 0x82, 0x50, 0x46, 0xb5, 0xd5, 0x23, 0xed, 0xb8, 0x78, 0x47, 0x6d, 0x66, 0x66,
+		0xf4, 0x02, 0x1e, 0x32, 0xce, 0x6c, 0xa1, 0x8e, 0x8e, 0x87, 0x3e, 0xb1, 0x89, 0x10, 0x5c, 0xd8,
+		0xe9, 0x48, 0x89, 0x6a, 0xc5, 0xa3, 0xc2, 0xf1, 0x86, 0x55, 0x63, 0x9c, 0x59, 0x39, 0xa3, 0xad,
+		0x08, 0x56, 0x8a, 0xa3, 0x97, 0xb0, 0x4d, 0x6e, 0x43, 0x9a, 0x0a, 0x99, 0x49, 0x2e, 0xbd, 0x4f,
+		0x32, 0x9a, 0x79, 0xe5, 0xaa, 0xf5, 0x00, 0xf6, 0xcc, 0x88, 0xfb, 0x89, 0xf1, 0x42, 0xf0, 0x38,
+		0xec, 0x61, 0x21, 0xa9, 0xda, 0x2d, 0x1b, 0x98, 0xe8, 0x4b, 0x28, 0x46, 0x12, 0xcb, 0xb4, 0xe0,
+		0x37, 0x1b, 0xc7, 0x4b, 0x8b, 0x74, 0x31, 0x60, 0x5f, 0xf1, 0xad, 0xd4, 0x4d, 0x9f, 0xc0, 0xc3,
+		0x45, 0xf4, 0x8c, 0x33, 0x8f, 0x8e, 0x32, 0x85, 0xe8, 0x1a, 0xaa, 0x34, 0x87, 0xed, 0x91, 0xc2,
+		0xf3, 0xd6, 0xfe, 0xec, 0x1f, 0x64, 0xba, 0x93, 0x6e, 0x6d, 0xd1, 0x05, 0x20, 0xd2, 0xff, 0xd0,
+		0xe0, 0xa0, 0x19, 0x4d, 0x99, 0x93, 0xff, 0x36, 0x16, 0xf3, 0xd6, 0x60, 0x8d, 0x30, 0x75, 0xcf,
+		0xe9, 0x3f, 0x68, 0xdd, 0xca, 0xb7, 0xa8, 0x01, 0x3b, 0xa1, 0x20, 0x2e, 0xf1, 0x28, 0x23, 0xae,
+		0xfd, 0x73, 0x4c, 0x62, 0x62, 0x27, 0xb7, 0x92, 0x96, 0xf2, 0xf6, 0x0c, 0xfc, 0x56, 0x61, 0x1d,
+		0x75, 0x49, 0x87, 0x00, 0x29, 0x31, 0x69, 0xe7, 0x42, 0x42, 0xdc, 0x48, 0x2c, 0x49, 0xa3, 0x7e,
+		0x0d, 0x95, 0x14, 0x76, 0x12, 0x0d, 0x49, 0x91, 0x94, 0x1b, 0x87, 0x4b, 0x0f, 0x98, 0x4f, 0x09,
+		0xab, 0x9c, 0xb8, 0xa4, 0xaa, 0x9f, 0xdc, 0x40, 0x65, 0x7e, 0x10, 0xa0, 0x7d, 0xd8, 0x69, 0x77,
+		0xce, 0xba, 0x2d, 0xb3, 0x73, 0x61, 0x0f, 0xde, 0xf4, 0xda, 0xb6, 0xd9, 0x79, 0xdd, 0xbc, 0x32,
+		0x5b, 0xd5, 0xff, 0xa1, 0x03, 0xd8, 0x5d, 0x84, 0x06, 0x97, 0x96, 0x79, 0x3e, 0xb0, 0xae, 0xab,
+		0x1a, 0xda, 0x05, 0xb4, 0x88, 0xbd, 0xec, 0x77, 0x3b, 0xd5, 0x15, 0x54, 0x83, 0x0f, 0x16, 0xed,
+		0x3d, 0xab, 0x3b, 0xe8, 0x3e, 0xad, 0x16, 0x9e, 0xfc, 0x02, 0xdb, 0x4b, 0x3e, 0x2e, 0x7a, 0x04,
+		0x87, 0x66, 0xbf, 0x7b, 0xd5, 0x1c, 0x98, 0xdd, 0x8e, 0x7d, 0x61, 0x75, 0xbf, 0xeb, 0xd9, 0xfd,
+		0x41, 0x73, 0x30, 0xaf, 0xe3, 0x5e, 0xca, 0x65, 0xbb, 0x79, 0x35, 0xb8, 0x7c, 0x53, 0xd5, 0xee,
+		0xa7, 0xb4, 0xac, 0xa6, 0xd9, 0x69, 0xb7, 0xaa, 0x2b, 0xa7, 0x3f, 0xc0, 0x9e, 0xc3, 0x83, 0x65,
+		0x37, 0x75, 0x5a, 0x3e, 0x4b, 0x9e, 0x28, 0x3d, 0x55, 0xf5, 0x3d, 0xed, 0xfb, 0x93, 0x11, 0x95,
+		0x6f, 0xe3, 0xa1, 0xe1, 0xf0, 0xa0, 0x3e, 0xff, 0xa0, 0xf9, 0x9c, 0xba, 0x7e, 0x7d, 0xc4, 0xd3,
+		0x67, 0x4a, 0xf6, 0xba, 0x79, 0x8e, 0x43, 0x3a, 0x39, 0x19, 0x96, 0x12, 0xdb, 0xd3, 0xbf, 0x06,
+		0x00, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/decision.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6e, 0x14, 0xc7,
+		0x16, 0xbe, 0xed, 0x9f, 0xf9, 0x39, 0x1e, 0x03, 0x2e, 0x83, 0xb1, 0xc1, 0x60, 0x33, 0x57, 0xd7,
+		0x5c, 0xb0, 0x3c, 0x63, 0x1b, 0x2e, 0x42, 0x80, 0xd0, 0xc5, 0x06, 0x0b, 0x4b, 0x60, 0xac, 0xc6,
+		0x04, 0x29, 0x52, 0xd4, 0x2a, 0x57, 0x97, 0xed, 0x8a, 0x7b, 0xba, 0x26, 0xd5, 0xd5, 0x1e, 0x26,
+		0x52, 0xa4, 0xac, 0x92, 0x6c, 0xf2, 0x00, 0x91, 0xb2, 0xca, 0x2a, 0xd9, 0x90, 0x6d, 0xa2, 0xac,
+		0xf2, 0x08, 0x59, 0xe4, 0x49, 0xf2, 0x02, 0x51, 0x57, 0x57, 0xf7, 0x8c, 0xc7, 0x3d, 0x3d, 0xdd,
+		0x86, 0xb0, 0x73, 0xd7, 0x9c, 0xf3, 0xd5, 0x57, 0x75, 0x4e, 0x9f, 0xef, 0xf3, 0x0c, 0x54, 0xfd,
+		0x5d, 0x2a, 0xea, 0x04, 0xdb, 0xd4, 0x25, 0xb4, 0x8e, 0x9b, 0xac, 0x7e, 0xb4, 0x52, 0xb7, 0x29,
+		0x61, 0x1e, 0xe3, 0x6e, 0xad, 0x29, 0xb8, 0xe4, 0x68, 0x32, 0x88, 0xa9, 0xe9, 0x98, 0x1a, 0x6e,
+		0xb2, 0xda, 0xd1, 0xca, 0xa5, 0xab, 0xfb, 0x9c, 0xef, 0x3b, 0xb4, 0xae, 0x42, 0x76, 0xfd, 0xbd,
+		0xba, 0xed, 0x0b, 0x2c, 0xe3, 0xa4, 0x4b, 0xf3, 0x49, 0xc0, 0x84, 0x37, 0x1a, 0x71, 0x44, 0xe2,
+		0xd6, 0x12, 0x7b, 0x87, 0x0e, 0xf3, 0x64, 0x5a, 0x4c, 0x8b, 0x8b, 0xc3, 0x3d, 0x87, 0xb7, 0xc2,
+		0x98, 0xea, 0x37, 0x13, 0x50, 0x7a, 0xac, 0x19, 0xa3, 0xef, 0x0c, 0xb8, 0xe9, 0x91, 0x03, 0x6a,
+		0xfb, 0x0e, 0xb5, 0x30, 0x91, 0xec, 0x88, 0xc9, 0xb6, 0x15, 0xa0, 0x5a, 0xd1, 0xa9, 0x2c, 0x2c,
+		0xa5, 0x60, 0xbb, 0xbe, 0xa4, 0xde, 0xb4, 0x31, 0x6f, 0xfc, 0x77, 0x6c, 0xf5, 0x7e, 0x2d, 0xe1,
+		0x84, 0xb5, 0x97, 0x1a, 0xe6, 0x91, 0x46, 0xd9, 0xc1, 0xde, 0x61, 0xb4, 0xcf, 0xa3, 0x18, 0xe2,
+		0xe9, 0xbf, 0xcc, 0x05, 0x2f, 0x53, 0x24, 0xfa, 0x1c, 0xe6, 0x3c, 0x89, 0x85, 0xb4, 0x24, 0x6b,
+		0x50, 0x91, 0xc8, 0x67, 0x48, 0xf1, 0x59, 0x49, 0xe6, 0x13, 0xe4, 0xee, 0x04, 0xa9, 0x89, 0x2c,
+		0x66, 0xbd, 0x94, 0xcf, 0xd1, 0x8f, 0x06, 0x04, 0xb7, 0xdf, 0x74, 0xa8, 0xa4, 0x56, 0x74, 0x81,
+		0x16, 0x7d, 0x43, 0x89, 0x1f, 0x14, 0x2d, 0x91, 0xcc, 0xb0, 0x22, 0xf3, 0xff, 0x44, 0x32, 0xeb,
+		0x1a, 0xeb, 0xb5, 0x86, 0x7a, 0x12, 0x21, 0x25, 0x72, 0x5b, 0x24, 0xd9, 0xc3, 0xd1, 0xf7, 0x06,
+		0x2c, 0xee, 0x61, 0xe6, 0x64, 0xa5, 0x39, 0xa2, 0x68, 0x3e, 0x48, 0xa4, 0xb9, 0x81, 0x99, 0x93,
+		0x8d, 0xe2, 0xf5, 0xbd, 0x6c, 0xa1, 0xe8, 0x27, 0x03, 0x96, 0x05, 0xfd, 0xcc, 0xa7, 0x9e, 0xb4,
+		0x08, 0x76, 0x09, 0x75, 0x32, 0xf4, 0xd9, 0x68, 0xca, 0x55, 0x9a, 0x21, 0xd8, 0xba, 0xc2, 0x1a,
+		0xd8, 0x6c, 0x8b, 0x22, 0x7b, 0x38, 0xfa, 0x02, 0xe6, 0x35, 0xc5, 0xfe, 0x2d, 0x57, 0x50, 0xd4,
+		0x56, 0x93, 0xab, 0xac, 0x92, 0xfb, 0xf7, 0xdc, 0x15, 0x92, 0x16, 0x80, 0x7e, 0x30, 0x60, 0x49,
+		0xef, 0x9f, 0xb1, 0x96, 0x45, 0x45, 0xe6, 0x61, 0x0a, 0x99, 0x6c, 0xd5, 0xbc, 0x41, 0xb2, 0x06,
+		0xa3, 0x3f, 0x0c, 0x78, 0xd8, 0x53, 0x4f, 0xfa, 0x46, 0x52, 0xe1, 0xe2, 0xcc, 0xac, 0x4b, 0x8a,
+		0xf5, 0xf3, 0xc1, 0xd5, 0x7d, 0xa2, 0x81, 0xb3, 0x1d, 0xe2, 0xae, 0x38, 0x65, 0x2e, 0xfa, 0xd2,
+		0x80, 0x6b, 0x82, 0x12, 0x2e, 0x6c, 0xab, 0x81, 0xc5, 0x61, 0x9f, 0xca, 0x97, 0x15, 0xed, 0x5b,
+		0x7d, 0x68, 0x07, 0xd9, 0xcf, 0x55, 0x72, 0x22, 0xb9, 0xab, 0x22, 0x35, 0x02, 0xfd, 0x6a, 0xc0,
+		0x1d, 0xc2, 0x5d, 0xc9, 0x5c, 0x9f, 0x5a, 0xd8, 0xb3, 0x5c, 0xda, 0xca, 0x7a, 0x9d, 0xa0, 0x78,
+		0x3d, 0xe9, 0x33, 0x77, 0x42, 0xc8, 0x47, 0xde, 0x16, 0x6d, 0x65, 0xbb, 0xc6, 0x65, 0x92, 0x33,
+		0x07, 0xfd, 0x6c, 0xc0, 0x6a, 0x38, 0xa9, 0xc9, 0x01, 0x73, 0xec, 0xac, 0xbc, 0xc7, 0x14, 0xef,
+		0xb5, 0xfe, 0xc3, 0x7b, 0x3d, 0x40, 0xcb, 0x46, 0x7a, 0xc9, 0xcb, 0x93, 0x80, 0x7e, 0x33, 0xe0,
+		0x8e, 0xc7, 0xf6, 0x5d, 0x9c, 0xbf, 0x79, 0x2b, 0x8a, 0xf5, 0x46, 0x32, 0x6b, 0x05, 0x99, 0xaf,
+		0x6b, 0x57, 0xbc, 0xbc, 0x49, 0xe8, 0x17, 0x03, 0xfe, 0xe7, 0x37, 0x3d, 0x2a, 0x64, 0x87, 0xb4,
+		0x47, 0xb1, 0x20, 0x07, 0x5d, 0x44, 0x13, 0xc9, 0x8f, 0xa7, 0xb4, 0xca, 0x2b, 0x85, 0x18, 0xed,
+		0xff, 0x52, 0xe1, 0x75, 0x36, 0x4d, 0x6e, 0x15, 0x3f, 0x67, 0xce, 0x5a, 0x05, 0xa0, 0x43, 0xa7,
+		0xfa, 0x6d, 0x01, 0x16, 0xb2, 0xd9, 0x06, 0x34, 0x07, 0x63, 0xb1, 0x6c, 0x30, 0x5b, 0x19, 0x91,
+		0xb2, 0x09, 0xd1, 0xd2, 0xa6, 0x8d, 0x36, 0x60, 0x3c, 0x0e, 0x90, 0xed, 0x26, 0xd5, 0xde, 0xe0,
+		0x5a, 0xe2, 0x59, 0xe3, 0xcd, 0xda, 0x4d, 0x6a, 0x56, 0x70, 0xd7, 0x13, 0x9a, 0x82, 0x82, 0xcd,
+		0x1b, 0x98, 0xb9, 0x4a, 0xcf, 0xcb, 0xa6, 0x7e, 0x42, 0xf7, 0xa0, 0xac, 0xe4, 0x2a, 0x70, 0x5b,
+		0x5a, 0x43, 0xaf, 0x24, 0x62, 0x07, 0x07, 0x78, 0xc6, 0x3c, 0x69, 0x96, 0xa4, 0xfe, 0x0b, 0xad,
+		0xc2, 0x28, 0x73, 0x9b, 0xbe, 0xd4, 0xba, 0x36, 0x9b, 0x98, 0xb7, 0x8d, 0xdb, 0x0e, 0xc7, 0xb6,
+		0x19, 0x86, 0xa2, 0x1d, 0x98, 0x89, 0x8d, 0x99, 0xe4, 0x16, 0x71, 0xb8, 0x47, 0x95, 0x2c, 0x71,
+		0x5f, 0x6a, 0x11, 0x9a, 0xa9, 0x85, 0xa6, 0xb2, 0x16, 0x99, 0xca, 0xda, 0x63, 0x6d, 0x2a, 0xcd,
+		0xa9, 0x28, 0x77, 0x87, 0xaf, 0x07, 0x99, 0x3b, 0x61, 0x62, 0x2f, 0x6a, 0xc7, 0x5f, 0x05, 0xa8,
+		0xc5, 0x1c, 0xa8, 0xb1, 0xbb, 0x0a, 0x50, 0xb7, 0x60, 0x4a, 0x23, 0xf5, 0x12, 0x2d, 0x0d, 0x82,
+		0x9c, 0x0c, 0x6d, 0xd8, 0x71, 0x96, 0x1b, 0x30, 0x71, 0x40, 0xb1, 0x90, 0xbb, 0x14, 0x77, 0xd8,
+		0x95, 0x07, 0x41, 0x9d, 0x8b, 0x73, 0x22, 0x9c, 0x75, 0xa8, 0x08, 0x2a, 0x45, 0xdb, 0x6a, 0x72,
+		0x87, 0x91, 0xb6, 0x9e, 0x38, 0xf3, 0x7d, 0x26, 0xb8, 0x14, 0xed, 0x6d, 0x15, 0x67, 0x8e, 0x89,
+		0xce, 0x03, 0xba, 0x05, 0x85, 0x03, 0x8a, 0x6d, 0x2a, 0xf4, 0xab, 0x7f, 0x39, 0x31, 0xfd, 0xa9,
+		0x0a, 0x31, 0x75, 0x28, 0xba, 0x0d, 0x53, 0x91, 0x48, 0x3a, 0x9c, 0x60, 0xc7, 0xb2, 0x99, 0xd7,
+		0xc4, 0x92, 0x1c, 0xa8, 0x57, 0xb0, 0x64, 0x9e, 0xd7, 0x9f, 0x3e, 0x0b, 0x3e, 0x7c, 0xac, 0x3f,
+		0xab, 0x7e, 0x6d, 0xc0, 0x6c, 0x9a, 0x6d, 0x45, 0x33, 0x50, 0x0a, 0x9d, 0x49, 0xfc, 0x0a, 0x14,
+		0xd5, 0xf3, 0xa6, 0x8d, 0x9e, 0xc1, 0x85, 0xb8, 0x06, 0x7b, 0x4c, 0x74, 0x4a, 0x30, 0x34, 0xe8,
+		0xde, 0x90, 0x2e, 0xc1, 0x06, 0x13, 0x51, 0x05, 0xaa, 0x04, 0x16, 0x73, 0x58, 0x56, 0x74, 0x1b,
+		0x0a, 0x82, 0x7a, 0xbe, 0x23, 0xa7, 0x8d, 0x0c, 0x1d, 0xae, 0x63, 0xab, 0x18, 0xae, 0x67, 0x34,
+		0x9c, 0xe8, 0x0e, 0x14, 0x03, 0xc3, 0xe9, 0x0b, 0x9a, 0xba, 0xc3, 0x46, 0x18, 0x63, 0x46, 0xc1,
+		0xd5, 0x2d, 0x58, 0xcc, 0xe1, 0x17, 0x07, 0x4e, 0x99, 0xea, 0x3d, 0xb8, 0x92, 0x6a, 0xf2, 0x52,
+		0x2a, 0x54, 0x25, 0x70, 0x23, 0xb3, 0x27, 0x0b, 0x0e, 0x6c, 0x53, 0x89, 0x99, 0xe3, 0x65, 0xba,
+		0xd2, 0x28, 0xb8, 0xfa, 0x97, 0x01, 0x77, 0x4f, 0xeb, 0xa1, 0xba, 0x66, 0x9f, 0x71, 0x6c, 0xf6,
+		0xbd, 0x02, 0x74, 0x52, 0x1d, 0x75, 0x63, 0x2d, 0x24, 0xf2, 0x3a, 0xb1, 0x9b, 0x39, 0xd1, 0xea,
+		0x5d, 0x42, 0xd3, 0x50, 0x24, 0xdc, 0x95, 0x82, 0x3b, 0x6a, 0xd6, 0x56, 0xcc, 0xe8, 0x11, 0xd5,
+		0x60, 0xb2, 0xc7, 0x4a, 0x70, 0xd7, 0x69, 0xab, 0xb1, 0x5b, 0x32, 0x27, 0x48, 0xb7, 0xcc, 0xbf,
+		0x70, 0x9d, 0x76, 0xf5, 0xad, 0x01, 0x57, 0xd3, 0x2d, 0x58, 0x50, 0x5a, 0xed, 0xed, 0x5c, 0xdc,
+		0xa0, 0x51, 0x69, 0xc3, 0xa5, 0x2d, 0xdc, 0xa0, 0xdd, 0x37, 0x3e, 0x94, 0xe3, 0xc6, 0xbb, 0xe6,
+		0xc3, 0x70, 0xe6, 0xf9, 0x50, 0x7d, 0x5b, 0x82, 0xe5, 0xbc, 0xde, 0x2c, 0x90, 0xb8, 0xf8, 0x3e,
+		0x94, 0xc4, 0x19, 0x29, 0x12, 0x17, 0x01, 0x86, 0x12, 0xd7, 0xea, 0x7a, 0x3a, 0x2e, 0x65, 0x43,
+		0xa7, 0x94, 0xb2, 0xe1, 0xec, 0x52, 0x86, 0x61, 0xbe, 0xe3, 0xa9, 0xfa, 0x08, 0xc5, 0xc8, 0xa0,
+		0x29, 0x35, 0x1b, 0x43, 0xbc, 0x4c, 0x50, 0x8c, 0xd7, 0x70, 0x59, 0x1d, 0xa9, 0x0f, 0xfa, 0xe8,
+		0x20, 0xf4, 0x8b, 0x41, 0x76, 0x12, 0xf0, 0x0b, 0x98, 0xda, 0xc5, 0xe4, 0x90, 0xef, 0xed, 0x69,
+		0x6c, 0xe6, 0x4a, 0x2a, 0x8e, 0xb0, 0x33, 0x58, 0x83, 0xcf, 0xeb, 0x44, 0x05, 0xbb, 0xa9, 0xd3,
+		0x4e, 0x68, 0x52, 0xf1, 0x34, 0x9a, 0xb4, 0x09, 0x65, 0xe6, 0x32, 0xc9, 0xb0, 0xe4, 0x42, 0x69,
+		0xec, 0x99, 0xd5, 0xc5, 0xc1, 0xfe, 0x7f, 0x33, 0x4a, 0x31, 0x3b, 0xd9, 0xdd, 0x93, 0xb5, 0x9c,
+		0x63, 0xb2, 0x22, 0x13, 0xa6, 0x1c, 0x1c, 0xfc, 0x0f, 0x18, 0xca, 0x44, 0x50, 0x5a, 0x2d, 0x01,
+		0x90, 0xa1, 0x33, 0xce, 0x07, 0xb9, 0xeb, 0x71, 0xaa, 0xa9, 0x32, 0xd1, 0xbf, 0x61, 0x9c, 0x88,
+		0xa0, 0x47, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x36, 0x2b, 0xc1, 0x62, 0xe4, 0x13, 0x4f, 0xa7, 0xc7,
+		0x4b, 0x30, 0xd2, 0xa0, 0x0d, 0xae, 0x0d, 0xf0, 0x4c, 0x62, 0xca, 0x73, 0xda, 0xe0, 0xa6, 0x0a,
+		0x43, 0x26, 0x4c, 0x9c, 0x30, 0xd4, 0xd3, 0x67, 0x54, 0xee, 0x7f, 0x92, 0x9d, 0x7f, 0x8f, 0xf5,
+		0x35, 0xcf, 0x79, 0x3d, 0x2b, 0xe8, 0x01, 0x54, 0x3e, 0x65, 0x52, 0x52, 0x11, 0x36, 0xd2, 0xf4,
+		0xd9, 0x41, 0xfd, 0x33, 0x16, 0x86, 0xab, 0xf6, 0xa9, 0xfe, 0x59, 0x84, 0xa5, 0x5c, 0xff, 0x14,
+		0xf5, 0x1d, 0xe6, 0x73, 0x30, 0x16, 0x4f, 0x11, 0x66, 0xab, 0xf7, 0xbf, 0x6c, 0x42, 0xb4, 0x14,
+		0x3a, 0xe9, 0xe3, 0x63, 0x66, 0xf8, 0x3d, 0x8c, 0x99, 0x0f, 0xe0, 0x98, 0xb3, 0x8c, 0x99, 0xc2,
+		0x3f, 0x3a, 0x66, 0x8a, 0xa7, 0x1e, 0x33, 0x1f, 0xc1, 0x64, 0x13, 0x0b, 0xea, 0x4a, 0x8d, 0xa8,
+		0x87, 0x43, 0xf8, 0x6a, 0x2f, 0xf4, 0x39, 0x7d, 0x10, 0xaf, 0x50, 0xf4, 0x88, 0x98, 0x68, 0xf6,
+		0x2e, 0x75, 0x4b, 0x6c, 0xf9, 0xb8, 0xc4, 0x12, 0x98, 0xee, 0x6a, 0x03, 0x4b, 0x50, 0xbf, 0xb3,
+		0x2d, 0xa8, 0x6d, 0x6f, 0xa6, 0x16, 0x7c, 0xd3, 0x36, 0xa9, 0x1f, 0xed, 0x63, 0x5e, 0x68, 0x25,
+		0x2d, 0xbf, 0x1f, 0x03, 0x7e, 0x62, 0x2a, 0x54, 0x52, 0xa7, 0xc2, 0x78, 0xfe, 0xa9, 0x70, 0xe6,
+		0x1d, 0xa6, 0xc2, 0xd9, 0x77, 0x9a, 0x0a, 0xd5, 0xdf, 0x87, 0x60, 0x25, 0xf7, 0xd7, 0x06, 0x1f,
+		0xda, 0xa8, 0xcd, 0xc1, 0x98, 0xfe, 0xb6, 0x44, 0x79, 0xa7, 0xf0, 0x1f, 0x63, 0x08, 0x97, 0x94,
+		0x77, 0x8a, 0x5f, 0xd7, 0x91, 0xec, 0xaf, 0x6b, 0x57, 0x6b, 0x8e, 0x66, 0x72, 0x7f, 0x85, 0x7e,
+		0xee, 0xef, 0x2b, 0x03, 0x96, 0xf3, 0x7e, 0x7b, 0x91, 0x5c, 0x4c, 0xe3, 0x9d, 0x8a, 0xb9, 0xf6,
+		0x09, 0x5c, 0x24, 0xbc, 0x91, 0x94, 0xbd, 0x36, 0x1e, 0x51, 0xd8, 0x16, 0x5c, 0xf2, 0x6d, 0xe3,
+		0xe3, 0x95, 0x7d, 0x26, 0x0f, 0xfc, 0xdd, 0x1a, 0xe1, 0x8d, 0x7a, 0xf7, 0xaf, 0x36, 0x4b, 0xcc,
+		0x76, 0xea, 0xfb, 0x3c, 0xfc, 0xa1, 0x48, 0xff, 0x84, 0x73, 0x1f, 0x37, 0xd9, 0xd1, 0xca, 0x6e,
+		0x41, 0xad, 0xdd, 0xfa, 0x7b, 0x00, 0x7f, 0xc1, 0xe0, 0x1d, 0x85, 0x1a, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xed, 0x4e, 0xe3, 0x46,
+		0x17, 0x7e, 0xf3, 0xb5, 0xc0, 0xc9, 0x02, 0x66, 0x80, 0x25, 0xc9, 0xbe, 0xdb, 0xb2, 0xf9, 0x81,
+		0x28, 0x6a, 0x1d, 0x41, 0x5b, 0xa9, 0x6a, 0xab, 0xed, 0x06, 0x82, 0x76, 0x2d, 0x3e, 0x16, 0x39,
+		0x5e, 0x2a, 0x2a, 0x55, 0xee, 0xc4, 0x1e, 0xc2, 0x34, 0xb6, 0xc7, 0x9a, 0x19, 0x27, 0x70, 0x05,
+		0xbd, 0x83, 0x5e, 0x4c, 0xef, 0xa1, 0xf7, 0x54, 0xcd, 0xd8, 0x81, 0x7c, 0x18, 0xd4, 0xfd, 0xd1,
+		0x7f, 0x99, 0x73, 0xe6, 0x99, 0xe7, 0x3c, 0xe7, 0x2b, 0x86, 0x66, 0xd2, 0x23, 0xbc, 0xe5, 0x61,
+		0x9f, 0x44, 0x1e, 0x69, 0xe1, 0x98, 0xb6, 0x86, 0xfb, 0x2d, 0x89, 0xc5, 0x20, 0xa0, 0x42, 0x9a,
+		0x31, 0x67, 0x92, 0xa1, 0x75, 0x75, 0xc7, 0xcc, 0xee, 0x98, 0x38, 0xa6, 0xe6, 0x70, 0xbf, 0xf1,
+		0x59, 0x9f, 0xb1, 0x7e, 0x40, 0x5a, 0xfa, 0x4a, 0x2f, 0xb9, 0x6e, 0xf9, 0x09, 0xc7, 0x92, 0xb2,
+		0x28, 0x05, 0x35, 0x3e, 0x9f, 0xf5, 0x4b, 0x1a, 0x12, 0x21, 0x71, 0x18, 0x67, 0x17, 0xe6, 0x1e,
+		0x18, 0x71, 0x1c, 0xc7, 0x84, 0x8b, 0xd4, 0xdf, 0xfc, 0x08, 0x8b, 0x0e, 0x16, 0x83, 0x53, 0x2a,
+		0x24, 0x42, 0x50, 0x8e, 0x70, 0x48, 0x6a, 0x85, 0xed, 0xc2, 0xee, 0x92, 0xad, 0x7f, 0xa3, 0x6f,
+		0xa1, 0x3c, 0xa0, 0x91, 0x5f, 0x2b, 0x6e, 0x17, 0x76, 0x57, 0x0e, 0x5e, 0x9b, 0x39, 0x41, 0x9a,
+		0xe3, 0x07, 0x4e, 0x68, 0xe4, 0xdb, 0xfa, 0x7a, 0x13, 0x83, 0x31, 0xb6, 0x9e, 0x11, 0x89, 0x7d,
+		0x2c, 0x31, 0x3a, 0x83, 0x8d, 0x10, 0xdf, 0xba, 0x4a, 0xb6, 0x70, 0x63, 0xc2, 0x5d, 0x41, 0x3c,
+		0x16, 0xf9, 0x9a, 0xae, 0x7a, 0xf0, 0x7f, 0x33, 0x8d, 0xd4, 0x1c, 0x47, 0x6a, 0x76, 0x58, 0xd2,
+		0x0b, 0xc8, 0x25, 0x0e, 0x12, 0x62, 0xaf, 0x85, 0xf8, 0x56, 0x3d, 0x28, 0x2e, 0x08, 0xef, 0x6a,
+		0x58, 0xf3, 0x23, 0xd4, 0xc7, 0x14, 0x17, 0x98, 0x4b, 0xaa, 0xb2, 0x72, 0xcf, 0x65, 0x40, 0x69,
+		0x40, 0xee, 0x32, 0x25, 0xea, 0x27, 0xda, 0x81, 0x55, 0x36, 0x8a, 0x08, 0x77, 0x6f, 0x98, 0x90,
+		0xae, 0xd6, 0x59, 0xd4, 0xde, 0x65, 0x6d, 0x7e, 0xcf, 0x84, 0x3c, 0xc7, 0x21, 0x69, 0x0e, 0x60,
+		0xd3, 0x12, 0x2c, 0xd0, 0x49, 0x7e, 0xc7, 0x59, 0x12, 0x9f, 0x11, 0xc9, 0xa9, 0x27, 0x50, 0x0b,
+		0x36, 0x22, 0x32, 0xca, 0x0f, 0xbf, 0x60, 0xaf, 0x45, 0x64, 0x34, 0x1d, 0x20, 0x7a, 0x0d, 0xcf,
+		0x63, 0x16, 0x04, 0x84, 0xbb, 0x1e, 0x4b, 0x22, 0xa9, 0xe9, 0x4a, 0x76, 0x35, 0xb5, 0x1d, 0x29,
+		0x53, 0xf3, 0x8f, 0x32, 0xac, 0x8c, 0x45, 0x74, 0x25, 0x96, 0x89, 0x40, 0x5f, 0x02, 0xea, 0x61,
+		0x6f, 0x10, 0xb0, 0x7e, 0x0a, 0x73, 0x6f, 0x68, 0x24, 0x35, 0x49, 0xc9, 0x36, 0x32, 0x8f, 0x06,
+		0xbf, 0xa7, 0x91, 0x44, 0xaf, 0x00, 0x38, 0xc1, 0xbe, 0x1b, 0x90, 0x21, 0x09, 0x32, 0x86, 0x25,
+		0x65, 0x39, 0x55, 0x06, 0xf4, 0x12, 0x96, 0xb0, 0x37, 0xc8, 0xbc, 0x25, 0xed, 0x5d, 0xc4, 0xde,
+		0x20, 0x75, 0xee, 0xc0, 0x2a, 0xc7, 0x92, 0x4c, 0x6a, 0x29, 0x6b, 0x2d, 0xcb, 0xca, 0xfc, 0xa0,
+		0xa3, 0x03, 0xcb, 0x4a, 0xb4, 0x4b, 0x7d, 0xb7, 0x17, 0x30, 0x6f, 0x50, 0xab, 0xe8, 0x82, 0x6d,
+		0x3f, 0xda, 0x0b, 0x56, 0xe7, 0x50, 0xdd, 0xb3, 0xab, 0x0a, 0x66, 0xf9, 0xfa, 0x80, 0x86, 0xb0,
+		0x45, 0xc7, 0x79, 0x75, 0xfb, 0x2a, 0xb1, 0x6e, 0x98, 0x66, 0xb6, 0xf6, 0x6c, 0xbb, 0xb4, 0x5b,
+		0x3d, 0x78, 0xf3, 0x64, 0x6f, 0xa5, 0xd9, 0x31, 0x73, 0x4b, 0x73, 0x1c, 0x49, 0x7e, 0x67, 0x6f,
+		0xd2, 0x4f, 0x2a, 0xdb, 0xc2, 0x23, 0x65, 0x6b, 0x48, 0x68, 0x3c, 0xce, 0x92, 0xd3, 0x58, 0x6f,
+		0xa1, 0x32, 0x54, 0x3d, 0xaa, 0xb3, 0x5f, 0x3d, 0xd8, 0xcb, 0x95, 0x91, 0xfb, 0xa2, 0x9d, 0x02,
+		0xbf, 0x2f, 0x7e, 0x57, 0x68, 0xfe, 0x04, 0xd5, 0x89, 0xd4, 0xa1, 0x3a, 0x2c, 0x0a, 0x89, 0xb9,
+		0x74, 0xa9, 0x9f, 0xd5, 0x7e, 0x41, 0x9f, 0x2d, 0x1f, 0x6d, 0xc2, 0x33, 0x12, 0xf9, 0xca, 0x91,
+		0x96, 0xbb, 0x42, 0x22, 0xdf, 0xf2, 0x9b, 0x7f, 0x16, 0x00, 0x2e, 0x74, 0x6b, 0x59, 0xd1, 0x35,
+		0x43, 0x1d, 0x30, 0x02, 0x2c, 0xa4, 0x8b, 0x3d, 0x8f, 0x08, 0xe1, 0xaa, 0xb5, 0x90, 0x0d, 0x5a,
+		0x63, 0x6e, 0xd0, 0x9c, 0xf1, 0xce, 0xb0, 0x57, 0x14, 0xa6, 0xad, 0x21, 0xca, 0x88, 0x1a, 0xb0,
+		0x48, 0x7d, 0x12, 0x49, 0x2a, 0xef, 0xb2, 0x69, 0xb9, 0x3f, 0xe7, 0xb5, 0x4f, 0x29, 0xa7, 0x7d,
+		0x9a, 0x7f, 0x15, 0xa0, 0xde, 0x95, 0xd4, 0x1b, 0xdc, 0x1d, 0xdf, 0x12, 0x2f, 0x51, 0x49, 0x68,
+		0x4b, 0xc9, 0x69, 0x2f, 0x91, 0x44, 0xa0, 0x77, 0x60, 0x8c, 0x18, 0x1f, 0x10, 0xae, 0x2b, 0xe4,
+		0xaa, 0x7d, 0x98, 0xc5, 0xf9, 0xea, 0xc9, 0x7e, 0xb0, 0x57, 0x52, 0xd8, 0xf8, 0x8c, 0x1c, 0xa8,
+		0x0b, 0xef, 0x86, 0xf8, 0x49, 0x40, 0x5c, 0xc9, 0xdc, 0x34, 0x7b, 0x4a, 0x36, 0x4b, 0x64, 0x56,
+		0x9a, 0xfa, 0xfc, 0x8a, 0xc9, 0xb6, 0xa9, 0xfd, 0x62, 0x8c, 0x75, 0x58, 0x57, 0x21, 0x9d, 0x14,
+		0xd8, 0x7c, 0x03, 0x6b, 0x73, 0x4b, 0x06, 0x7d, 0x01, 0xc6, 0x4c, 0x2b, 0x8b, 0x5a, 0x61, 0xbb,
+		0xb4, 0xbb, 0x64, 0xaf, 0x4e, 0xf7, 0xa0, 0x68, 0xfe, 0x5d, 0x86, 0xad, 0xb9, 0x07, 0x8e, 0x58,
+		0x74, 0x4d, 0xfb, 0xa8, 0x06, 0x0b, 0x43, 0xc2, 0x05, 0x65, 0xd1, 0xb8, 0xc4, 0xd9, 0x11, 0x1d,
+		0xc0, 0x7a, 0x94, 0x84, 0xae, 0x9e, 0xec, 0x78, 0x8c, 0x12, 0x5a, 0x45, 0xe5, 0xb0, 0x58, 0x53,
+		0x6d, 0x9b, 0x84, 0x36, 0xc1, 0xfe, 0xfd, 0x93, 0x02, 0x7d, 0x03, 0x1b, 0x0a, 0x33, 0xe2, 0x54,
+		0xd5, 0xe4, 0x01, 0x54, 0xba, 0x07, 0xa1, 0x28, 0x09, 0x7f, 0x56, 0xee, 0x09, 0x14, 0x85, 0xd5,
+		0x59, 0x96, 0xb2, 0x9e, 0xc6, 0xb7, 0x4f, 0x66, 0x7f, 0x46, 0x8a, 0x39, 0x1d, 0x4b, 0x3a, 0x8f,
+		0x2b, 0x7c, 0x3a, 0xc0, 0x00, 0x8c, 0xb9, 0xe0, 0x2a, 0x9a, 0xab, 0xfd, 0x49, 0x5c, 0x33, 0x12,
+		0x52, 0xb2, 0xd5, 0xd1, 0xb4, 0xb5, 0x41, 0x61, 0x3d, 0x27, 0xa8, 0xc9, 0xf1, 0xad, 0xa4, 0xe3,
+		0xfb, 0xe3, 0xf4, 0xf8, 0xee, 0xfc, 0xbb, 0x58, 0x26, 0x46, 0xb7, 0xf1, 0x3b, 0x6c, 0xe4, 0xc5,
+		0xf4, 0x5f, 0x70, 0xed, 0xfd, 0x06, 0xcf, 0x27, 0xff, 0x6d, 0x51, 0x03, 0x5e, 0x38, 0xed, 0xee,
+		0x89, 0x7b, 0x6a, 0x75, 0x1d, 0xf7, 0xc4, 0x3a, 0xef, 0xb8, 0xd6, 0xf9, 0x65, 0xfb, 0xd4, 0xea,
+		0x18, 0xff, 0x43, 0x75, 0xd8, 0x9c, 0xf1, 0x9d, 0x7f, 0xb0, 0xcf, 0xda, 0xa7, 0x46, 0x21, 0xc7,
+		0xd5, 0x75, 0xac, 0xa3, 0x93, 0x2b, 0xa3, 0xb8, 0xe7, 0x3f, 0x30, 0x38, 0x77, 0x31, 0x99, 0x66,
+		0x70, 0xae, 0x2e, 0x8e, 0x27, 0x18, 0x5e, 0xc2, 0xd6, 0x8c, 0xaf, 0x73, 0x7c, 0x64, 0x75, 0xad,
+		0x0f, 0xe7, 0x46, 0x21, 0xc7, 0xd9, 0x3e, 0x72, 0xac, 0x4b, 0xcb, 0xb9, 0x32, 0x8a, 0x87, 0xbf,
+		0xc2, 0x96, 0xc7, 0xc2, 0x3c, 0xfd, 0x87, 0xcb, 0xf7, 0x09, 0x50, 0x53, 0x7a, 0x51, 0xf8, 0x65,
+		0xbf, 0x4f, 0xe5, 0x4d, 0xd2, 0x33, 0x3d, 0x16, 0xb6, 0x26, 0xbf, 0xa3, 0xbe, 0xa2, 0x7e, 0xd0,
+		0xea, 0xb3, 0xf4, 0xd3, 0x26, 0xfb, 0xa8, 0xfa, 0x01, 0xc7, 0x74, 0xb8, 0xdf, 0x7b, 0xa6, 0x6d,
+		0x5f, 0xff, 0x33, 0x00, 0xe6, 0xf6, 0xce, 0x1e, 0x78, 0x09, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x72, 0xdb, 0xc8,
+		0xf1, 0xfe, 0x81, 0x94, 0x64, 0xa9, 0xa9, 0x3f, 0xd0, 0x48, 0xb2, 0x68, 0x79, 0xd7, 0x96, 0xb9,
+		0x6b, 0x5b, 0xe6, 0x6f, 0x45, 0xad, 0xec, 0xb5, 0xbd, 0xb6, 0xe2, 0x38, 0x10, 0x08, 0x59, 0xb0,
+		0x29, 0x90, 0x19, 0x80, 0x96, 0xb5, 0x95, 0x04, 0x05, 0x91, 0x90, 0x84, 0x98, 0x04, 0x58, 0xc0,
+		0xd0, 0xb6, 0xee, 0xa9, 0xca, 0x39, 0x39, 0xa5, 0x72, 0xca, 0x03, 0x24, 0x95, 0x4a, 0xe5, 0x90,
+		0x53, 0x2a, 0x4f, 0x90, 0x6b, 0x5e, 0x21, 0x95, 0xb7, 0x48, 0xcd, 0x60, 0x40, 0x82, 0x7f, 0x41,
+		0x25, 0x55, 0x9b, 0x9b, 0xd0, 0xf3, 0x7d, 0x8d, 0x9e, 0x9e, 0xee, 0xaf, 0x87, 0x10, 0xe4, 0xda,
+		0xa7, 0xb6, 0xbf, 0x53, 0xb3, 0xea, 0xb6, 0x5b, 0xb3, 0x77, 0xac, 0x96, 0xb3, 0xf3, 0x61, 0x77,
+		0xe7, 0xa3, 0xe7, 0xbf, 0x3f, 0x6b, 0x78, 0x1f, 0x0b, 0x2d, 0xdf, 0x23, 0x1e, 0x5a, 0xa1, 0x98,
+		0x02, 0xc7, 0x14, 0xac, 0x96, 0x53, 0xf8, 0xb0, 0xbb, 0x71, 0xeb, 0xdc, 0xf3, 0xce, 0x1b, 0xf6,
+		0x0e, 0x83, 0x9c, 0xb6, 0xcf, 0x76, 0xea, 0x6d, 0xdf, 0x22, 0x8e, 0xe7, 0x86, 0xa4, 0x8d, 0xdb,
+		0xfd, 0xeb, 0xc4, 0x69, 0xda, 0x01, 0xb1, 0x9a, 0x2d, 0x0e, 0xd8, 0x1c, 0xf6, 0xe6, 0x9a, 0xd7,
+		0x6c, 0x76, 0x5c, 0x0c, 0x8d, 0x8d, 0x58, 0xc1, 0xfb, 0x86, 0x13, 0x90, 0x10, 0x93, 0xfb, 0xc3,
+		0x2c, 0xac, 0x1d, 0xf3, 0x70, 0x95, 0x4f, 0x76, 0xad, 0x4d, 0x43, 0x50, 0xdd, 0x33, 0x0f, 0x55,
+		0x01, 0x45, 0xfb, 0x30, 0xed, 0x68, 0x25, 0x2b, 0x6c, 0x0a, 0x5b, 0x99, 0x87, 0xf7, 0x0a, 0x43,
+		0xb6, 0x54, 0x18, 0xf0, 0x83, 0x97, 0x3f, 0xf6, 0x9b, 0xd0, 0x63, 0x98, 0x22, 0x97, 0x2d, 0x3b,
+		0x9b, 0x62, 0x8e, 0xee, 0x8c, 0x75, 0x64, 0x5c, 0xb6, 0x6c, 0xcc, 0xe0, 0xe8, 0x19, 0x40, 0x40,
+		0x2c, 0x9f, 0x98, 0x34, 0x0d, 0xd9, 0x34, 0x23, 0x6f, 0x14, 0xc2, 0x1c, 0x15, 0xa2, 0x1c, 0x15,
+		0x8c, 0x28, 0x47, 0x78, 0x8e, 0xa1, 0xe9, 0x33, 0xa5, 0xd6, 0x1a, 0x5e, 0x60, 0x87, 0xd4, 0xa9,
+		0x64, 0x2a, 0x43, 0x33, 0xaa, 0x01, 0xf3, 0x21, 0x35, 0x20, 0x16, 0x69, 0x07, 0xd9, 0xe9, 0x4d,
+		0x61, 0x6b, 0xf1, 0xe1, 0xee, 0x64, 0xbb, 0x97, 0x29, 0x53, 0x67, 0x44, 0x9c, 0xa9, 0x75, 0x1f,
+		0xd0, 0x5d, 0x58, 0xbc, 0x70, 0x02, 0xe2, 0xf9, 0x97, 0x66, 0xc3, 0x76, 0xcf, 0xc9, 0x45, 0x76,
+		0x66, 0x53, 0xd8, 0x4a, 0xe3, 0x05, 0x6e, 0x2d, 0x31, 0x23, 0xfa, 0x09, 0xac, 0xb5, 0x2c, 0xdf,
+		0x76, 0x49, 0x37, 0xfd, 0xa6, 0xe3, 0x9e, 0x79, 0xd9, 0x6b, 0x6c, 0x0b, 0x5b, 0x43, 0xa3, 0xa8,
+		0x30, 0x46, 0xcf, 0x49, 0xe2, 0x95, 0xd6, 0xa0, 0x11, 0x49, 0xb0, 0xd8, 0x75, 0xcb, 0x32, 0x33,
+		0x9b, 0x98, 0x99, 0x85, 0x0e, 0x83, 0x65, 0x67, 0x1b, 0xa6, 0x9a, 0x76, 0xd3, 0xcb, 0xce, 0x31,
+		0xe2, 0x8d, 0xa1, 0xf1, 0x1c, 0xd9, 0x4d, 0x0f, 0x33, 0x18, 0xc2, 0xb0, 0x1c, 0xd8, 0x96, 0x5f,
+		0xbb, 0x30, 0x2d, 0x42, 0x7c, 0xe7, 0xb4, 0x4d, 0xec, 0x20, 0x0b, 0x8c, 0x7b, 0x77, 0x28, 0x57,
+		0x67, 0x68, 0xa9, 0x03, 0xc6, 0x62, 0xd0, 0x67, 0x41, 0x25, 0x58, 0xb6, 0xda, 0xc4, 0x33, 0x7d,
+		0x3b, 0xb0, 0x89, 0xd9, 0xf2, 0x1c, 0x97, 0x04, 0xd9, 0x0c, 0xf3, 0xb9, 0x39, 0xd4, 0x27, 0xa6,
+		0xc0, 0x0a, 0xc3, 0xe1, 0x25, 0x4a, 0x8d, 0x19, 0xd0, 0x4d, 0x98, 0xa3, 0xed, 0x61, 0xd2, 0xfe,
+		0xc8, 0xce, 0x6f, 0x0a, 0x5b, 0x73, 0x78, 0x96, 0x1a, 0x4a, 0x4e, 0x40, 0xd0, 0x3a, 0x5c, 0x73,
+		0x02, 0xb3, 0xe6, 0x7b, 0x6e, 0x76, 0x61, 0x53, 0xd8, 0x9a, 0xc5, 0x33, 0x4e, 0x20, 0xfb, 0x9e,
+		0x8b, 0xf6, 0x20, 0xd3, 0x6e, 0xd5, 0x2d, 0xc2, 0x0b, 0x6c, 0x31, 0x31, 0x8d, 0x10, 0xc2, 0x59,
+		0x0e, 0x7f, 0x0e, 0x62, 0xcb, 0xf2, 0x89, 0xc3, 0x8e, 0xa1, 0xe6, 0xb9, 0x67, 0xce, 0x79, 0x76,
+		0x69, 0x33, 0xbd, 0x95, 0x79, 0xf8, 0x72, 0xb2, 0x2a, 0xa3, 0x87, 0x59, 0xa8, 0x44, 0x2e, 0x64,
+		0xe6, 0x41, 0x71, 0x89, 0x7f, 0x89, 0x97, 0x5a, 0xbd, 0xd6, 0x8d, 0x7d, 0x58, 0x1d, 0x06, 0x44,
+		0x22, 0xa4, 0xdf, 0xdb, 0x97, 0xac, 0xb5, 0xe7, 0x30, 0xfd, 0x13, 0xad, 0xc2, 0xf4, 0x07, 0xab,
+		0xd1, 0x0e, 0xbb, 0x74, 0x0e, 0x87, 0x0f, 0xcf, 0x53, 0xdf, 0x0a, 0xb9, 0xdf, 0xa4, 0xe0, 0xd6,
+		0x60, 0xa5, 0x33, 0x67, 0x5c, 0xbf, 0xd0, 0xf3, 0x78, 0x16, 0x43, 0xbd, 0xf8, 0x7c, 0xe8, 0x5e,
+		0x0c, 0x9e, 0xda, 0x58, 0x92, 0x2d, 0xd8, 0xec, 0x56, 0x25, 0x6f, 0x78, 0xcf, 0xec, 0xb6, 0xaf,
+		0xd7, 0x26, 0x5c, 0x39, 0x6e, 0x0c, 0x24, 0xb8, 0xc8, 0x03, 0xc0, 0x9f, 0x75, 0x5c, 0xe8, 0x4c,
+		0x04, 0x3c, 0x39, 0x6a, 0x68, 0xaf, 0x4d, 0xd0, 0x31, 0xdc, 0x64, 0xe1, 0x8d, 0xf0, 0x9e, 0x4e,
+		0xf2, 0xbe, 0x4e, 0xd9, 0x43, 0x1c, 0xe7, 0xfe, 0x2e, 0xc0, 0xca, 0x90, 0xf6, 0xa3, 0x55, 0x55,
+		0xf7, 0x9a, 0x96, 0xe3, 0x9a, 0x4e, 0x9d, 0x27, 0x79, 0x36, 0x34, 0xa8, 0x75, 0x74, 0x1b, 0x32,
+		0x7c, 0xd1, 0xb5, 0x9a, 0x51, 0xbe, 0x21, 0x34, 0x69, 0x56, 0xd3, 0x1e, 0x21, 0xc3, 0xe9, 0xff,
+		0x56, 0x86, 0xef, 0xc0, 0xbc, 0xe3, 0x3a, 0xc4, 0xb1, 0x88, 0x5d, 0xa7, 0x71, 0x4d, 0x31, 0x05,
+		0xca, 0x74, 0x6c, 0x6a, 0x3d, 0xf7, 0x2b, 0x01, 0xd6, 0x94, 0x4f, 0xc4, 0xf6, 0x5d, 0xab, 0xf1,
+		0xbd, 0x8c, 0x86, 0xfe, 0x98, 0x52, 0x83, 0x31, 0xfd, 0x65, 0x06, 0x56, 0x2a, 0xb6, 0x5b, 0x77,
+		0xdc, 0x73, 0xa9, 0x46, 0x9c, 0x0f, 0x0e, 0xb9, 0x64, 0x11, 0xdd, 0x86, 0x8c, 0xc5, 0x9f, 0xbb,
+		0x59, 0x86, 0xc8, 0xa4, 0xd6, 0xd1, 0x01, 0x2c, 0x74, 0x00, 0x89, 0xf3, 0x27, 0x72, 0xcd, 0xe6,
+		0xcf, 0xbc, 0x15, 0x7b, 0x42, 0x2f, 0x61, 0x9a, 0xce, 0x82, 0x70, 0x04, 0x2d, 0x3e, 0x7c, 0x30,
+		0x5c, 0x84, 0x7b, 0x23, 0xa4, 0xb2, 0x6f, 0xe3, 0x90, 0x87, 0x54, 0x58, 0xbe, 0xb0, 0x2d, 0x9f,
+		0x9c, 0xda, 0x16, 0x31, 0xeb, 0x36, 0xb1, 0x9c, 0x46, 0xc0, 0x87, 0xd2, 0x67, 0x23, 0x14, 0xfd,
+		0xb2, 0xe1, 0x59, 0x75, 0x2c, 0x76, 0x68, 0xc5, 0x90, 0x85, 0x5e, 0xc3, 0x4a, 0xc3, 0x0a, 0x88,
+		0xd9, 0xf5, 0xc7, 0x04, 0x68, 0x3a, 0x51, 0x80, 0x96, 0x29, 0xed, 0x30, 0x62, 0x51, 0x3b, 0x3a,
+		0x00, 0x66, 0x0c, 0xbb, 0xc2, 0xae, 0x87, 0x9e, 0x66, 0x12, 0x3d, 0x2d, 0x51, 0x92, 0x1e, 0x72,
+		0x98, 0x9f, 0x2c, 0x5c, 0xb3, 0x08, 0xb1, 0x9b, 0x2d, 0xc2, 0xc6, 0xd4, 0x34, 0x8e, 0x1e, 0xd1,
+		0x03, 0x10, 0x9b, 0xd6, 0x27, 0xa7, 0xd9, 0x6e, 0x9a, 0xdc, 0x14, 0xb0, 0x91, 0x33, 0x8d, 0x97,
+		0xb8, 0x5d, 0xe2, 0x66, 0x3a, 0x9b, 0x82, 0xda, 0x85, 0x5d, 0x6f, 0x37, 0xa2, 0x48, 0xe6, 0x92,
+		0x67, 0x53, 0x87, 0xc1, 0xe2, 0x90, 0x61, 0xc9, 0xfe, 0xd4, 0x72, 0xc2, 0x9e, 0x0d, 0x7d, 0x40,
+		0xa2, 0x8f, 0xc5, 0x2e, 0x85, 0x39, 0x79, 0x09, 0xf3, 0x2c, 0x29, 0x67, 0x96, 0xd3, 0x68, 0xfb,
+		0x76, 0x36, 0x33, 0xe6, 0x98, 0x0e, 0x42, 0x0c, 0xce, 0x50, 0x06, 0x7f, 0x40, 0x5f, 0xc3, 0x2a,
+		0x73, 0x40, 0x6b, 0xdd, 0xf6, 0x4d, 0xa7, 0x6e, 0xbb, 0xc4, 0x21, 0x97, 0x7c, 0xb6, 0x20, 0xba,
+		0x76, 0xcc, 0x96, 0x54, 0xbe, 0x82, 0x9e, 0xc0, 0x7a, 0x74, 0x04, 0xfd, 0xa4, 0x05, 0x46, 0x5a,
+		0xe3, 0xcb, 0x7d, 0xbc, 0xdb, 0x90, 0x89, 0x12, 0x40, 0x1b, 0x60, 0x91, 0xb5, 0x0e, 0x44, 0x26,
+		0xb5, 0x9e, 0xfb, 0x73, 0x0a, 0x6e, 0xf0, 0xba, 0x94, 0x2f, 0x9c, 0x46, 0xfd, 0x7b, 0xe9, 0xe8,
+		0xaf, 0x62, 0x6e, 0x69, 0xd7, 0xc5, 0x45, 0x4e, 0xfc, 0x18, 0xbb, 0xe5, 0x31, 0xa9, 0xeb, 0xef,
+		0xff, 0xf4, 0x40, 0xff, 0xa3, 0xb7, 0xc0, 0x2f, 0x33, 0x5c, 0xb5, 0x5b, 0x5e, 0xc3, 0xa9, 0x5d,
+		0xb2, 0xfe, 0x59, 0x1c, 0x11, 0x68, 0x28, 0xc9, 0x4c, 0xa9, 0x2b, 0x0c, 0x8d, 0x97, 0x5b, 0xfd,
+		0x26, 0x74, 0x1d, 0x66, 0x42, 0xcd, 0x65, 0xdd, 0x33, 0x87, 0xf9, 0x53, 0xee, 0x9f, 0xa9, 0x8e,
+		0xde, 0x14, 0xed, 0x9a, 0x13, 0x44, 0xf9, 0xea, 0xc8, 0x80, 0x90, 0x2c, 0x03, 0x11, 0xb1, 0x47,
+		0x06, 0x06, 0x4b, 0x3c, 0x75, 0xd5, 0x12, 0x7f, 0x01, 0xf3, 0x3d, 0xdd, 0x9a, 0x7c, 0x29, 0xce,
+		0x04, 0xc3, 0x3b, 0x75, 0xaa, 0xb7, 0x53, 0x31, 0xac, 0x7b, 0xbe, 0x73, 0xee, 0xb8, 0x56, 0xc3,
+		0xec, 0x0b, 0x32, 0x59, 0x5b, 0xd6, 0x22, 0xaa, 0xde, 0x13, 0x6c, 0x5f, 0x7d, 0xce, 0x0c, 0xd4,
+		0xe7, 0x5f, 0x53, 0x70, 0x23, 0x12, 0xcc, 0x92, 0x57, 0xb3, 0x1a, 0x45, 0x27, 0x68, 0x59, 0xa4,
+		0x76, 0x31, 0x99, 0xbe, 0xff, 0xef, 0xf3, 0xf9, 0x33, 0xb8, 0xd5, 0x1b, 0x81, 0xe9, 0x9d, 0x99,
+		0xe4, 0xc2, 0x09, 0xcc, 0x78, 0x9a, 0xc7, 0x3b, 0xdc, 0xe8, 0x89, 0xa8, 0x7c, 0x66, 0x5c, 0x38,
+		0x01, 0x57, 0x45, 0xf4, 0x39, 0x00, 0xbb, 0xb7, 0x10, 0xef, 0xbd, 0x1d, 0x96, 0xe9, 0x3c, 0x66,
+		0x17, 0x2d, 0x83, 0x1a, 0x72, 0xaf, 0x21, 0x13, 0xbf, 0xca, 0xee, 0xc1, 0x0c, 0xbf, 0x0d, 0x0b,
+		0xec, 0x36, 0xf9, 0x45, 0xc2, 0x6d, 0x98, 0xfd, 0x50, 0xe0, 0x94, 0xdc, 0x1f, 0x53, 0xb0, 0xd8,
+		0xbb, 0x84, 0xee, 0xc3, 0xd2, 0xa9, 0xe3, 0x5a, 0xfe, 0xa5, 0x59, 0xbb, 0xb0, 0x6b, 0xef, 0x83,
+		0x76, 0x93, 0x1f, 0xc2, 0x62, 0x68, 0x96, 0xb9, 0x15, 0xad, 0xc1, 0x8c, 0xdf, 0x76, 0xa3, 0xf1,
+		0x3d, 0x87, 0xa7, 0xfd, 0x36, 0xbd, 0xe7, 0xbc, 0x80, 0x9b, 0x67, 0x8e, 0x1f, 0xd0, 0x91, 0x17,
+		0x76, 0x83, 0x59, 0xf3, 0x9a, 0xad, 0x86, 0xdd, 0xd3, 0xea, 0x59, 0x06, 0x89, 0xfa, 0x45, 0x8e,
+		0x00, 0x8c, 0x3e, 0x5f, 0xf3, 0x6d, 0xab, 0x73, 0x36, 0xc9, 0xa9, 0xcc, 0x70, 0x3c, 0x17, 0xf2,
+		0x05, 0x26, 0xed, 0x8e, 0x7b, 0x3e, 0x69, 0x1d, 0xcf, 0x47, 0x04, 0xe6, 0xe0, 0x16, 0x00, 0xfb,
+		0x89, 0x41, 0xac, 0xd3, 0x46, 0x38, 0x17, 0x67, 0x71, 0xcc, 0x92, 0xff, 0x93, 0x00, 0xab, 0xc3,
+		0xa6, 0x3e, 0xca, 0xc1, 0xad, 0x8a, 0xa2, 0x15, 0x55, 0xed, 0x95, 0x29, 0xc9, 0x86, 0xfa, 0x56,
+		0x35, 0x4e, 0x4c, 0xdd, 0x90, 0x0c, 0xc5, 0x54, 0xb5, 0xb7, 0x52, 0x49, 0x2d, 0x8a, 0xff, 0x87,
+		0xbe, 0x84, 0xcd, 0x11, 0x18, 0x5d, 0x3e, 0x54, 0x8a, 0xd5, 0x92, 0x52, 0x14, 0x85, 0x31, 0x9e,
+		0x74, 0x43, 0xc2, 0x86, 0x52, 0x14, 0x53, 0xe8, 0xff, 0xe1, 0xfe, 0x08, 0x8c, 0x2c, 0x69, 0xb2,
+		0x52, 0x32, 0xb1, 0xf2, 0xe3, 0xaa, 0xa2, 0x53, 0x70, 0x3a, 0xff, 0x8b, 0x6e, 0xcc, 0x3d, 0x12,
+		0x15, 0x7f, 0x53, 0x51, 0x91, 0x55, 0x5d, 0x2d, 0x6b, 0xe3, 0x62, 0xee, 0xc3, 0x8c, 0x88, 0xb9,
+		0x1f, 0x15, 0xc5, 0x9c, 0xff, 0x65, 0xaa, 0xfb, 0x05, 0x42, 0xad, 0x63, 0xbb, 0xdd, 0x11, 0xe5,
+		0x2f, 0x61, 0xf3, 0xb8, 0x8c, 0xdf, 0x1c, 0x94, 0xca, 0xc7, 0xa6, 0x5a, 0x34, 0xb1, 0x52, 0xd5,
+		0x15, 0xb3, 0x52, 0x2e, 0xa9, 0xf2, 0x49, 0x2c, 0x92, 0x6f, 0xe1, 0x9b, 0x91, 0x28, 0xa9, 0x44,
+		0xad, 0xc5, 0x6a, 0xa5, 0xa4, 0xca, 0xf4, 0xad, 0x07, 0x92, 0x5a, 0x52, 0x8a, 0x66, 0x59, 0x2b,
+		0x9d, 0x88, 0x02, 0xfa, 0x0a, 0xb6, 0x26, 0x65, 0x8a, 0x29, 0xb4, 0x0d, 0x0f, 0x46, 0xa2, 0xb1,
+		0xf2, 0x5a, 0x91, 0x8d, 0x18, 0x3c, 0x8d, 0x76, 0x61, 0x7b, 0x24, 0xdc, 0x50, 0xf0, 0x91, 0xaa,
+		0xb1, 0x84, 0x1e, 0x98, 0xb8, 0xaa, 0x69, 0xaa, 0xf6, 0x4a, 0x9c, 0xca, 0xff, 0x4e, 0x80, 0xe5,
+		0x81, 0x69, 0x85, 0x6e, 0xc3, 0xcd, 0x8a, 0x84, 0x15, 0xcd, 0x30, 0xe5, 0x52, 0x79, 0x58, 0x02,
+		0x46, 0x00, 0xa4, 0x7d, 0x49, 0x2b, 0x96, 0x35, 0x51, 0x40, 0xf7, 0x20, 0x37, 0x0c, 0xc0, 0x6b,
+		0x81, 0x97, 0x86, 0x98, 0x42, 0x77, 0xe0, 0xf3, 0x61, 0xb8, 0x4e, 0xb4, 0x62, 0x3a, 0xff, 0xaf,
+		0x14, 0x7c, 0x36, 0xee, 0x43, 0x07, 0xad, 0xc0, 0xce, 0xb6, 0x95, 0x77, 0x8a, 0x5c, 0x35, 0xe8,
+		0x99, 0x87, 0xfe, 0xe8, 0xc9, 0x57, 0xf5, 0x58, 0xe4, 0xf1, 0x94, 0x8e, 0x00, 0xcb, 0xe5, 0xa3,
+		0x4a, 0x49, 0x31, 0x58, 0x35, 0xe5, 0xe1, 0x5e, 0x12, 0x3c, 0x3c, 0x60, 0x31, 0xd5, 0x73, 0xb6,
+		0xa3, 0x5c, 0xb3, 0x7d, 0xd3, 0x56, 0x40, 0x05, 0xc8, 0x27, 0xa1, 0x3b, 0x59, 0x28, 0x8a, 0x53,
+		0xe8, 0x1b, 0xf8, 0x3a, 0x39, 0x70, 0xcd, 0x50, 0xb5, 0xaa, 0x52, 0x34, 0x25, 0xdd, 0xd4, 0x94,
+		0x63, 0x71, 0x7a, 0x92, 0xed, 0x1a, 0xea, 0x11, 0xad, 0xcf, 0xaa, 0x21, 0xce, 0xe4, 0xff, 0x26,
+		0xc0, 0x75, 0xd9, 0x73, 0x89, 0xe3, 0xb6, 0x6d, 0x29, 0xd0, 0xec, 0x8f, 0x6a, 0x78, 0x11, 0xf2,
+		0x7c, 0x74, 0x17, 0xee, 0x44, 0xfe, 0xb9, 0x7b, 0x53, 0xd5, 0x54, 0x43, 0x95, 0x8c, 0x32, 0x8e,
+		0xe5, 0x77, 0x2c, 0x8c, 0x36, 0x64, 0x51, 0xc1, 0x61, 0x5e, 0x47, 0xc3, 0xb0, 0x62, 0xe0, 0x13,
+		0x5e, 0x0a, 0xa1, 0xc2, 0x8c, 0xc6, 0xca, 0xb8, 0xac, 0x75, 0xfa, 0x5f, 0x4c, 0xe7, 0x7f, 0x2f,
+		0x40, 0x86, 0xff, 0x3a, 0x66, 0x3f, 0x9e, 0xb2, 0xb0, 0x4a, 0x37, 0x58, 0xae, 0x1a, 0xa6, 0x71,
+		0x52, 0x51, 0x7a, 0x6b, 0xb8, 0x67, 0x85, 0xc9, 0x83, 0x69, 0x94, 0xc3, 0xec, 0x84, 0x4a, 0xd2,
+		0x0b, 0xe0, 0x6f, 0xa1, 0x18, 0x06, 0x16, 0x53, 0x63, 0x31, 0xa1, 0x9f, 0x34, 0xda, 0x80, 0xeb,
+		0x3d, 0x98, 0x43, 0x45, 0xc2, 0xc6, 0xbe, 0x22, 0x19, 0xe2, 0x54, 0xfe, 0xb7, 0x02, 0xdc, 0x88,
+		0x94, 0x90, 0x7e, 0x9b, 0xa0, 0xa1, 0xd7, 0xcb, 0x6d, 0x22, 0x5b, 0xed, 0xc0, 0x46, 0x0f, 0xe0,
+		0x6e, 0x47, 0xc3, 0x0c, 0x49, 0x7f, 0xd3, 0x3d, 0x2b, 0x53, 0x96, 0xaa, 0x7a, 0x7c, 0x37, 0x89,
+		0x50, 0x1e, 0x82, 0x28, 0xa0, 0xfb, 0xf0, 0xc5, 0x78, 0x28, 0x56, 0x74, 0xc5, 0x10, 0x53, 0xf9,
+		0x7f, 0x64, 0x60, 0x3d, 0x1e, 0x1c, 0xfd, 0x89, 0x61, 0xd7, 0xc3, 0xd0, 0xee, 0x41, 0xae, 0xd7,
+		0x09, 0xd7, 0xb9, 0xfe, 0xb8, 0x76, 0x61, 0x7b, 0x0c, 0xae, 0xaa, 0x1d, 0x4a, 0x5a, 0x91, 0x3e,
+		0x47, 0x20, 0x51, 0x40, 0x2f, 0x61, 0x6f, 0x0c, 0x65, 0x5f, 0x2a, 0x76, 0xb3, 0xdc, 0x99, 0x38,
+		0x92, 0x61, 0x60, 0x75, 0xbf, 0x6a, 0x28, 0xba, 0x98, 0x42, 0x0a, 0x48, 0x09, 0x0e, 0x7a, 0x75,
+		0x68, 0xa8, 0x9b, 0x34, 0x7a, 0x06, 0x8f, 0x93, 0xe2, 0x08, 0x4b, 0x46, 0x3d, 0x52, 0x70, 0x9c,
+		0x3a, 0x85, 0x9e, 0xc3, 0x93, 0x04, 0x2a, 0x7f, 0xf3, 0x00, 0x77, 0x1a, 0xed, 0xc1, 0xd3, 0xc4,
+		0xe8, 0xe5, 0x32, 0x2e, 0x9a, 0x47, 0x12, 0x7e, 0xd3, 0x4b, 0x9e, 0x41, 0x2a, 0x28, 0x49, 0x2f,
+		0xe6, 0xea, 0x66, 0x0e, 0xd1, 0x85, 0x98, 0xab, 0x6b, 0x13, 0x64, 0x91, 0x1a, 0x12, 0xdc, 0xcc,
+		0xa2, 0x57, 0x20, 0x4f, 0x96, 0x8a, 0xf1, 0x8e, 0xe6, 0xd0, 0x3b, 0x30, 0xae, 0x76, 0xaa, 0xca,
+		0x3b, 0x43, 0xc1, 0x9a, 0x94, 0xe4, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x98, 0xb4, 0x5e, 0xfd, 0x89,
+		0xd1, 0x33, 0xe8, 0x29, 0x3c, 0x1a, 0x43, 0x8f, 0xd7, 0x48, 0xf7, 0x56, 0xa0, 0x16, 0xc5, 0x79,
+		0xf4, 0x18, 0x76, 0xc7, 0x10, 0x59, 0x17, 0x9a, 0xba, 0xa1, 0xca, 0x6f, 0x4e, 0xc2, 0xe5, 0x92,
+		0xaa, 0x1b, 0xe2, 0x02, 0xfa, 0x11, 0xfc, 0x60, 0x0c, 0xad, 0xb3, 0x59, 0xfa, 0x87, 0x82, 0x63,
+		0x2d, 0x46, 0x61, 0x55, 0xac, 0x88, 0x8b, 0x13, 0x9c, 0x89, 0xae, 0xbe, 0x4a, 0xce, 0xdc, 0x12,
+		0x92, 0xe1, 0xe5, 0x44, 0x2d, 0x22, 0x1f, 0xaa, 0xa5, 0xe2, 0x70, 0x27, 0x22, 0x7a, 0x04, 0x3b,
+		0x63, 0x9c, 0x1c, 0x94, 0xb1, 0xac, 0xf0, 0x89, 0xd5, 0x11, 0x89, 0x65, 0xf4, 0x04, 0x1e, 0x8e,
+		0x23, 0x49, 0x6a, 0xa9, 0xfc, 0x56, 0xc1, 0xfd, 0x3c, 0x44, 0xc7, 0xe8, 0x64, 0x5b, 0x57, 0xb5,
+		0x4a, 0xd5, 0x30, 0x75, 0xf5, 0x3b, 0x45, 0x5c, 0xa1, 0x63, 0x34, 0xf1, 0xa4, 0xa2, 0x5c, 0x89,
+		0xab, 0x83, 0x62, 0x3c, 0xf0, 0x92, 0x7d, 0x55, 0x93, 0xf0, 0x89, 0xb8, 0x96, 0x50, 0x7b, 0x83,
+		0x42, 0xd7, 0x53, 0x42, 0xd7, 0x27, 0xd9, 0x8e, 0x22, 0x61, 0xf9, 0x30, 0x9e, 0xf1, 0x75, 0x3a,
+		0x75, 0xee, 0xb0, 0x2f, 0x32, 0x03, 0xf7, 0xaa, 0xb8, 0xc4, 0xef, 0xc2, 0x76, 0x78, 0x6e, 0x43,
+		0xaa, 0x60, 0x84, 0xda, 0xef, 0xc3, 0x0f, 0x27, 0xa3, 0x74, 0xd6, 0xa5, 0x12, 0x56, 0xa4, 0xe2,
+		0x49, 0xe7, 0x4a, 0x2a, 0xe4, 0x7f, 0x9d, 0x82, 0xbc, 0x6c, 0xb9, 0x35, 0xbb, 0x11, 0x7d, 0x09,
+		0x1e, 0x1b, 0xe5, 0x1e, 0x3c, 0x9d, 0xa0, 0xdf, 0x47, 0xc4, 0x7b, 0x0c, 0xfa, 0x55, 0xc9, 0x55,
+		0xed, 0x8d, 0x56, 0x3e, 0xd6, 0xc6, 0x11, 0x44, 0x01, 0x69, 0xf0, 0xfa, 0xaa, 0x8e, 0x07, 0x52,
+		0xd2, 0xbd, 0x87, 0xa6, 0x58, 0x52, 0x74, 0xe7, 0xdc, 0xb5, 0x26, 0x4e, 0x0a, 0x2f, 0xe3, 0xff,
+		0x2c, 0x29, 0x57, 0x25, 0x4f, 0x9c, 0x94, 0xab, 0x3a, 0x1e, 0x97, 0x94, 0xfd, 0x9f, 0xc2, 0x7a,
+		0xcd, 0x6b, 0x0e, 0xfb, 0xca, 0xb0, 0xbf, 0x10, 0xa5, 0xa7, 0x42, 0x7f, 0x66, 0x57, 0x84, 0xef,
+		0x76, 0xcf, 0x1d, 0x72, 0xd1, 0x3e, 0x2d, 0xd4, 0xbc, 0xe6, 0x4e, 0xfc, 0x7f, 0xd4, 0xdb, 0x4e,
+		0xbd, 0xb1, 0x73, 0xee, 0x85, 0xff, 0xf3, 0xe6, 0xff, 0xb0, 0xde, 0xb3, 0x5a, 0xce, 0x87, 0xdd,
+		0xd3, 0x19, 0x66, 0x7b, 0xf4, 0xef, 0x01, 0x00, 0x00, 0x8a, 0xd0, 0x5a, 0x70, 0x1f, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc7,
+		0x91, 0xf6, 0xec, 0x92, 0x4b, 0x6d, 0x2d, 0x45, 0x91, 0x4d, 0x8a, 0x5a, 0x4a, 0x94, 0x44, 0xad,
+		0x64, 0x89, 0xa6, 0xc8, 0xa5, 0x44, 0xc9, 0x92, 0x25, 0xf9, 0xe7, 0x48, 0x8a, 0x84, 0x16, 0xe0,
+		0x49, 0xbc, 0x11, 0x25, 0xdf, 0x1d, 0x0c, 0xec, 0x0d, 0x77, 0x9a, 0xe2, 0x1c, 0x77, 0x77, 0xd6,
+		0x33, 0xbd, 0x5c, 0xf1, 0x70, 0xf7, 0x74, 0x0f, 0x07, 0x1c, 0xce, 0xf0, 0x19, 0x46, 0x80, 0x18,
+		0x48, 0x90, 0x20, 0x40, 0x82, 0x38, 0x09, 0xe0, 0x20, 0x41, 0x90, 0xbf, 0x97, 0x24, 0x40, 0xe0,
+		0x00, 0x09, 0x9c, 0x3c, 0xe5, 0xc5, 0xaf, 0x41, 0x10, 0xbf, 0xe5, 0x21, 0xce, 0x5b, 0x80, 0x60,
+		0x7a, 0x7a, 0x76, 0x77, 0x66, 0xba, 0x67, 0x7a, 0x96, 0x94, 0x9d, 0xc0, 0x7a, 0xe3, 0xf4, 0x54,
+		0xd5, 0x7c, 0xd5, 0x5d, 0x55, 0x5d, 0xdd, 0x55, 0x4b, 0x38, 0xd3, 0xdc, 0xc4, 0xd6, 0x7c, 0x45,
+		0xd3, 0x71, 0xbd, 0x82, 0xe7, 0xb5, 0x86, 0x31, 0xbf, 0x7b, 0x79, 0x7e, 0xdb, 0xb0, 0x89, 0x69,
+		0xed, 0x15, 0x1b, 0x96, 0x49, 0x4c, 0x34, 0xea, 0x90, 0x14, 0x19, 0x49, 0x51, 0x6b, 0x18, 0xc5,
+		0xdd, 0xcb, 0xc7, 0x4f, 0x3d, 0x32, 0xcd, 0x47, 0x55, 0x3c, 0x4f, 0x49, 0x36, 0x9b, 0x5b, 0xf3,
+		0x7a, 0xd3, 0xd2, 0x88, 0x61, 0xd6, 0x5d, 0xa6, 0xe3, 0xa7, 0x83, 0xef, 0x89, 0x51, 0xc3, 0x36,
+		0xd1, 0x6a, 0x0d, 0x46, 0x30, 0xc5, 0xfb, 0x70, 0xc5, 0xac, 0xd5, 0xda, 0x22, 0x0a, 0x3c, 0x0a,
+		0xa2, 0xd9, 0x3b, 0x55, 0xc3, 0x26, 0x51, 0x34, 0x2d, 0xd3, 0xda, 0xd9, 0xaa, 0x9a, 0x2d, 0x97,
+		0xa6, 0x70, 0x1b, 0x06, 0xee, 0xb8, 0x0a, 0xa1, 0x1b, 0x90, 0xc1, 0xbb, 0xb8, 0x4e, 0xec, 0xbc,
+		0x32, 0x95, 0x9e, 0xce, 0x2d, 0x9c, 0x29, 0x72, 0x74, 0x2b, 0x32, 0xea, 0x15, 0x87, 0x52, 0x65,
+		0x0c, 0x85, 0x8f, 0xae, 0xc3, 0x60, 0xf7, 0x0b, 0x34, 0x01, 0x87, 0xe8, 0xab, 0xb2, 0xa1, 0xe7,
+		0x95, 0x29, 0x65, 0x3a, 0xad, 0x0e, 0xd0, 0xe7, 0x92, 0x8e, 0x6e, 0x00, 0xb8, 0xaf, 0x1c, 0xa5,
+		0xf3, 0xa9, 0x29, 0x65, 0x3a, 0xb7, 0x70, 0xbc, 0xe8, 0xce, 0x48, 0xd1, 0x9b, 0x91, 0xe2, 0x86,
+		0x37, 0x23, 0x6a, 0x96, 0x52, 0x3b, 0xcf, 0x28, 0x0f, 0x03, 0xbb, 0xd8, 0xb2, 0x0d, 0xb3, 0x9e,
+		0x4f, 0xbb, 0x42, 0xd9, 0x23, 0x3a, 0x06, 0x03, 0x8e, 0xf2, 0xce, 0xe7, 0xfa, 0xe8, 0x9b, 0x8c,
+		0xf3, 0x58, 0xd2, 0xd1, 0x17, 0x14, 0xb8, 0xe8, 0xa9, 0x5c, 0xc6, 0x8f, 0x71, 0xa5, 0xe9, 0xac,
+		0x43, 0xd9, 0x26, 0x9a, 0x45, 0xb0, 0x5e, 0x76, 0x91, 0x68, 0x84, 0x58, 0xc6, 0x66, 0x93, 0x60,
+		0x3b, 0xdf, 0x4f, 0xf1, 0xbc, 0xc8, 0x55, 0xfd, 0x55, 0x26, 0x67, 0xc5, 0x13, 0x73, 0xdf, 0x95,
+		0x42, 0x55, 0x5e, 0x6c, 0xcb, 0xb8, 0xf3, 0x8c, 0x7a, 0xa1, 0x25, 0x47, 0x8a, 0xbe, 0xa2, 0xc0,
+		0x1c, 0x07, 0x5e, 0xc5, 0xac, 0x35, 0xaa, 0x98, 0x0b, 0x30, 0x43, 0x01, 0xbe, 0x2c, 0x07, 0x70,
+		0xd9, 0x93, 0x13, 0x86, 0xf8, 0x5c, 0x4b, 0x96, 0x18, 0xbd, 0xa3, 0xc0, 0x0c, 0x07, 0xe4, 0x96,
+		0x66, 0x54, 0x79, 0x08, 0x07, 0x28, 0xc2, 0x5b, 0x72, 0x08, 0x57, 0xa9, 0x90, 0x30, 0xbc, 0xf3,
+		0x2d, 0x29, 0x4a, 0xf4, 0x65, 0xfe, 0x04, 0x3a, 0xb6, 0xa5, 0x97, 0xcd, 0x26, 0x09, 0xc3, 0x3b,
+		0x44, 0xe1, 0xbd, 0x24, 0x07, 0xcf, 0x31, 0x3b, 0xfd, 0x5e, 0x93, 0x84, 0x01, 0x4e, 0xb7, 0x24,
+		0x69, 0xd1, 0xdb, 0x0a, 0x4c, 0xeb, 0xb8, 0x62, 0xd8, 0x14, 0x98, 0x63, 0xa5, 0x76, 0x65, 0x1b,
+		0xeb, 0x4d, 0xee, 0xe4, 0x65, 0x29, 0xba, 0x1b, 0x5c, 0x74, 0xb7, 0x99, 0x90, 0x0d, 0xcd, 0xde,
+		0xb9, 0xef, 0x89, 0x08, 0x23, 0x3b, 0xa7, 0x4b, 0xd0, 0xa1, 0x37, 0x14, 0x38, 0x1f, 0x40, 0x25,
+		0xf2, 0x09, 0xa0, 0x98, 0xae, 0xc7, 0x63, 0x12, 0xb9, 0x43, 0x41, 0x8f, 0xa5, 0xe2, 0xcc, 0x52,
+		0x84, 0x13, 0xe4, 0x24, 0x67, 0x29, 0xc2, 0xfe, 0xcf, 0xe9, 0x12, 0x74, 0xe8, 0xad, 0x10, 0xaa,
+		0x08, 0xcb, 0x1a, 0xa4, 0xa8, 0x5e, 0x88, 0x45, 0x25, 0x36, 0xaa, 0xb3, 0x7a, 0x3c, 0x19, 0xfa,
+		0x5f, 0x05, 0x9e, 0xf5, 0x63, 0x12, 0x79, 0xe2, 0x61, 0x0a, 0xe8, 0x5a, 0x2c, 0x20, 0x91, 0x13,
+		0x9e, 0xd1, 0xe3, 0x88, 0xe8, 0xb2, 0x69, 0x15, 0x62, 0xec, 0x1a, 0x64, 0x2f, 0xd6, 0xb8, 0x87,
+		0x22, 0x96, 0x6d, 0x91, 0x09, 0x89, 0x33, 0x6e, 0x4d, 0x82, 0x8e, 0x1a, 0x77, 0x00, 0x95, 0xc8,
+		0xb8, 0x8f, 0x44, 0x18, 0xb7, 0x0f, 0x93, 0xd0, 0xb8, 0xb5, 0x58, 0x2a, 0xce, 0x2c, 0x45, 0x18,
+		0xf7, 0xb0, 0xe4, 0x2c, 0x45, 0x19, 0xb7, 0x26, 0x41, 0x47, 0x0d, 0xc9, 0x8f, 0x4a, 0x64, 0x48,
+		0x23, 0x11, 0x86, 0xd4, 0x0d, 0x49, 0x68, 0x48, 0x5a, 0x1c, 0x11, 0xf5, 0x34, 0x3f, 0x98, 0x08,
+		0x4f, 0x43, 0x11, 0x9e, 0xd6, 0x8d, 0x27, 0xc2, 0xd3, 0xb4, 0x78, 0x32, 0xd4, 0x82, 0x53, 0x0e,
+		0x08, 0x4b, 0x6c, 0x3d, 0xa3, 0x14, 0xc8, 0x25, 0x2e, 0x10, 0x47, 0xaa, 0x25, 0x34, 0x9b, 0x13,
+		0x44, 0xfc, 0x1a, 0xbd, 0x0e, 0x93, 0xee, 0x87, 0xb7, 0x0c, 0x8b, 0xf7, 0xd9, 0x31, 0xfa, 0xd9,
+		0xa2, 0xf8, 0xb3, 0xab, 0x86, 0x15, 0x92, 0x7a, 0xe7, 0x19, 0x75, 0x82, 0x88, 0x5e, 0xa2, 0xaf,
+		0x29, 0x30, 0x1f, 0x30, 0x51, 0xad, 0x5e, 0xc1, 0xd5, 0xb2, 0x85, 0x5f, 0x6f, 0x62, 0x9b, 0xab,
+		0xfd, 0x51, 0x0a, 0xe3, 0x95, 0x78, 0x4b, 0xa5, 0x92, 0x54, 0x4f, 0x50, 0x18, 0xd7, 0x8c, 0x26,
+		0x4d, 0x8d, 0xbe, 0xab, 0xc0, 0x55, 0x86, 0xc9, 0x83, 0x28, 0x67, 0xc4, 0xe3, 0x14, 0xed, 0x32,
+		0x17, 0x2d, 0xfb, 0x9a, 0xfb, 0x69, 0x19, 0x8b, 0x2e, 0x5a, 0x89, 0x38, 0xd0, 0xff, 0x2b, 0x70,
+		0x81, 0x37, 0xbd, 0x3c, 0xa0, 0xc7, 0x24, 0xad, 0x7b, 0x99, 0x49, 0x88, 0xb1, 0x6e, 0x01, 0x19,
+		0xfa, 0x0f, 0x38, 0xed, 0x1a, 0x99, 0x18, 0x49, 0x9e, 0x22, 0xb9, 0x2c, 0xb6, 0x33, 0x31, 0x84,
+		0x49, 0x12, 0xf1, 0x1e, 0xfd, 0x8f, 0x02, 0xe7, 0xd8, 0xe2, 0x31, 0x43, 0x17, 0x2c, 0xda, 0x04,
+		0x45, 0xf0, 0x3c, 0x17, 0x81, 0x2b, 0xdc, 0xb5, 0x77, 0xc1, 0x32, 0x4d, 0x55, 0x62, 0x68, 0xd0,
+		0x7f, 0xc1, 0x54, 0x4d, 0xb3, 0x76, 0xb0, 0x55, 0xb6, 0x70, 0xc5, 0xb4, 0x74, 0x1e, 0x88, 0xe3,
+		0x14, 0xc4, 0x02, 0x17, 0xc4, 0x3f, 0x52, 0x66, 0x95, 0xf1, 0x86, 0x11, 0x9c, 0xac, 0x45, 0x11,
+		0xa0, 0x2f, 0x29, 0x30, 0xcb, 0x3b, 0x9f, 0x18, 0x8f, 0xea, 0x1a, 0x77, 0x42, 0x4e, 0x24, 0x49,
+		0x5f, 0xef, 0x33, 0x31, 0x32, 0xe9, 0xab, 0x80, 0x16, 0x7d, 0x55, 0x81, 0x22, 0x07, 0x21, 0xc1,
+		0x56, 0xcd, 0xa8, 0x6b, 0xdc, 0xb8, 0x30, 0x19, 0x11, 0x17, 0xc2, 0x29, 0x76, 0x5b, 0x10, 0x27,
+		0x2e, 0xb4, 0xa4, 0xa9, 0xd1, 0xf7, 0x14, 0xb8, 0xca, 0x3b, 0x4a, 0xc5, 0x46, 0xb1, 0x93, 0x14,
+		0xed, 0x6d, 0xc9, 0x13, 0x55, 0x5c, 0x28, 0x9b, 0x6f, 0x25, 0x63, 0x11, 0x59, 0x80, 0xd8, 0x29,
+		0x4f, 0x25, 0xb1, 0x00, 0xb1, 0x83, 0x4e, 0xb7, 0x24, 0x69, 0xd1, 0xef, 0x14, 0x58, 0x09, 0x44,
+		0x5c, 0xfc, 0x98, 0x60, 0xab, 0xae, 0x55, 0xcb, 0x1c, 0xe4, 0x46, 0xdd, 0x20, 0x06, 0xdf, 0x30,
+		0x4e, 0x53, 0xe8, 0xf7, 0xe3, 0x43, 0xf0, 0x0a, 0x93, 0x1f, 0xd2, 0xa7, 0xe4, 0x09, 0x0f, 0x2b,
+		0xf4, 0xb2, 0xb5, 0x2f, 0x09, 0xe8, 0x43, 0x05, 0x96, 0x12, 0xa8, 0x29, 0x8a, 0x58, 0x53, 0x54,
+		0xc7, 0xf5, 0x7d, 0xe8, 0x28, 0x0a, 0x66, 0xb7, 0xac, 0xde, 0xd9, 0xd1, 0x07, 0x0a, 0xbc, 0x14,
+		0xa5, 0x4e, 0xbc, 0x9f, 0x9c, 0xa1, 0x8a, 0xad, 0x71, 0x15, 0x13, 0x82, 0x89, 0xf5, 0x97, 0xeb,
+		0xb8, 0x37, 0x56, 0x9a, 0x07, 0xf0, 0xf4, 0x30, 0xeb, 0xc4, 0xa8, 0x37, 0xb1, 0x5e, 0xd6, 0xec,
+		0x72, 0x1d, 0xb7, 0xc2, 0x7a, 0x14, 0x22, 0xf2, 0x80, 0x30, 0x08, 0x4f, 0xdc, 0xa2, 0x7d, 0x17,
+		0xb7, 0xc2, 0xf0, 0x8b, 0xad, 0x44, 0x1c, 0xe8, 0x67, 0x0a, 0xdc, 0xa0, 0xd9, 0x64, 0xb9, 0xb2,
+		0x6d, 0x54, 0xf5, 0x84, 0xfe, 0x73, 0x96, 0x42, 0xbf, 0xc3, 0x85, 0x4e, 0x53, 0xc9, 0x65, 0x47,
+		0x68, 0x12, 0xa7, 0xb9, 0x62, 0x27, 0x67, 0x43, 0x3f, 0x54, 0xe0, 0x5a, 0x8c, 0x12, 0x22, 0xef,
+		0x38, 0x47, 0x35, 0x58, 0x49, 0xaa, 0x81, 0xc8, 0x25, 0x2e, 0xd9, 0x09, 0x79, 0xd0, 0xb7, 0x14,
+		0xb8, 0x2c, 0x44, 0x2d, 0xcc, 0xf3, 0x9f, 0xa5, 0xb0, 0x17, 0xf9, 0x69, 0x08, 0xf7, 0xeb, 0xc2,
+		0xc4, 0x7f, 0xb6, 0x92, 0x80, 0x1e, 0x7d, 0x47, 0x81, 0x2b, 0x42, 0xb8, 0x11, 0x87, 0xc8, 0xf3,
+		0x11, 0x46, 0xce, 0x07, 0x1c, 0x71, 0x9c, 0x2c, 0x56, 0x12, 0x71, 0xa0, 0x77, 0x15, 0xb8, 0x94,
+		0xd8, 0x32, 0x2e, 0x50, 0xc4, 0xff, 0x90, 0x00, 0xb1, 0xc8, 0x28, 0x2e, 0x56, 0x12, 0xd8, 0xc3,
+		0x7b, 0x0a, 0x2c, 0x88, 0x27, 0x58, 0xb8, 0x09, 0x4f, 0x53, 0xb4, 0x4b, 0x49, 0xe6, 0x57, 0xb8,
+		0x13, 0xcf, 0x55, 0x92, 0x30, 0xa0, 0x6f, 0x47, 0x99, 0x44, 0xc4, 0xa1, 0xf9, 0xb9, 0xc4, 0x90,
+		0xc5, 0xc7, 0xe7, 0xb9, 0x4a, 0x12, 0x06, 0x9a, 0x9b, 0x89, 0x21, 0x47, 0x64, 0x92, 0x33, 0x11,
+		0xb9, 0x99, 0x00, 0x73, 0x44, 0x3a, 0x39, 0x5f, 0x49, 0xc6, 0x42, 0x37, 0x4d, 0x37, 0x15, 0xef,
+		0x35, 0xe3, 0xb9, 0x18, 0xb1, 0x69, 0xba, 0x19, 0x77, 0x2f, 0xa9, 0xce, 0x75, 0xbb, 0x37, 0x56,
+		0xf4, 0x73, 0x05, 0x6e, 0x4a, 0x28, 0x24, 0xf2, 0xd1, 0x59, 0xaa, 0x4d, 0xa9, 0x17, 0x6d, 0x44,
+		0xce, 0x7a, 0xd5, 0xee, 0x81, 0x0f, 0xfd, 0x40, 0x81, 0xe7, 0xa3, 0x14, 0x10, 0x9f, 0x9f, 0xe6,
+		0x22, 0x36, 0x20, 0x21, 0x08, 0xf1, 0x39, 0xea, 0x12, 0x4e, 0xc8, 0x43, 0x03, 0x4e, 0xb3, 0x61,
+		0x63, 0x8b, 0x74, 0x80, 0xdb, 0x58, 0xb3, 0x2a, 0xdb, 0x5d, 0x30, 0xc3, 0xb8, 0x8b, 0x11, 0xde,
+		0xfb, 0x80, 0x8a, 0xf3, 0x10, 0xdc, 0xa7, 0xc2, 0x3a, 0x5f, 0xe4, 0x78, 0x6f, 0x33, 0x09, 0xc3,
+		0xd2, 0x20, 0x40, 0x07, 0x48, 0xe1, 0xcd, 0x21, 0xb8, 0x20, 0xbb, 0x7b, 0xad, 0xc2, 0xe1, 0xb6,
+		0x8e, 0x64, 0xaf, 0x81, 0x69, 0x2d, 0x50, 0x54, 0x59, 0xf4, 0x84, 0x6e, 0xec, 0x35, 0xb0, 0x3a,
+		0xd8, 0xea, 0x7a, 0x42, 0xaf, 0xc1, 0xd1, 0x86, 0x66, 0x39, 0x33, 0xd2, 0xed, 0x74, 0x5b, 0x26,
+		0x2b, 0x1f, 0x4e, 0x73, 0xe5, 0xad, 0x53, 0x8e, 0x2e, 0x9f, 0xd8, 0x32, 0xd5, 0xd1, 0x46, 0x78,
+		0x10, 0xdd, 0x84, 0x2c, 0xbd, 0x91, 0xa9, 0x1a, 0x36, 0xa1, 0x85, 0xc5, 0xdc, 0xc2, 0x49, 0xfe,
+		0x95, 0x87, 0x66, 0xef, 0xac, 0x19, 0x36, 0x51, 0x0f, 0x11, 0xf6, 0x17, 0x5a, 0x80, 0x7e, 0xa3,
+		0xde, 0x68, 0x12, 0x5a, 0x76, 0xcc, 0x2d, 0x4c, 0x0a, 0x90, 0xec, 0x55, 0x4d, 0x4d, 0x57, 0x5d,
+		0x52, 0xa4, 0xc1, 0x54, 0x20, 0xe5, 0x28, 0x13, 0xb3, 0x5c, 0xa9, 0x9a, 0x36, 0xa6, 0xf1, 0xdb,
+		0x6c, 0x12, 0x56, 0x87, 0x9c, 0x08, 0xd5, 0x45, 0x6f, 0xb3, 0x4a, 0xb2, 0x3a, 0x89, 0x7d, 0x73,
+		0xbf, 0x61, 0x2e, 0x3b, 0xfc, 0x1b, 0x2e, 0x3b, 0x7a, 0x15, 0x4e, 0x74, 0xae, 0xbd, 0xc3, 0xd2,
+		0x33, 0x71, 0xd2, 0x8f, 0x11, 0xef, 0x32, 0x3b, 0x20, 0xf8, 0x16, 0x1c, 0xef, 0x64, 0xd8, 0x1d,
+		0x2d, 0xac, 0x66, 0xdd, 0xa9, 0xbd, 0x3a, 0xa5, 0xbf, 0xac, 0x7a, 0xac, 0x4d, 0xd1, 0x9e, 0x67,
+		0xb5, 0x59, 0x2f, 0xe9, 0xa8, 0x04, 0x59, 0x16, 0x2a, 0x4d, 0x8b, 0xd6, 0xe1, 0x86, 0x16, 0x2e,
+		0xf2, 0x43, 0x3b, 0x13, 0x40, 0x53, 0xe8, 0x92, 0xc7, 0xa2, 0x76, 0xb8, 0x51, 0x09, 0x46, 0x3a,
+		0x38, 0x9c, 0x70, 0xd5, 0xb4, 0x70, 0x3e, 0x1b, 0xb1, 0x06, 0xab, 0x2e, 0x8d, 0x3a, 0xdc, 0x66,
+		0x63, 0x23, 0x48, 0x85, 0xf1, 0xaa, 0xe6, 0x9c, 0xf9, 0xdc, 0x74, 0x86, 0xaa, 0x83, 0xed, 0x66,
+		0x95, 0xe4, 0x21, 0x42, 0x9e, 0xb7, 0xa6, 0x63, 0x0e, 0xef, 0x72, 0x9b, 0x55, 0xa5, 0x9c, 0xe8,
+		0x06, 0x4c, 0x98, 0x96, 0xf1, 0xc8, 0x70, 0x03, 0x6d, 0x60, 0x96, 0x72, 0x74, 0x96, 0xc6, 0x3d,
+		0x82, 0xc0, 0x24, 0x1d, 0x87, 0x43, 0x86, 0x8e, 0xeb, 0xc4, 0x20, 0x7b, 0xb4, 0xa2, 0x94, 0x55,
+		0xdb, 0xcf, 0xe8, 0x0a, 0x8c, 0x6f, 0x19, 0x96, 0x4d, 0xc2, 0x32, 0x0f, 0x53, 0xca, 0x51, 0xfa,
+		0x36, 0x20, 0x70, 0x19, 0x06, 0x2d, 0x4c, 0xac, 0xbd, 0x72, 0xc3, 0xac, 0x1a, 0x95, 0x3d, 0x56,
+		0x85, 0x99, 0x12, 0x1c, 0x50, 0x89, 0xb5, 0xb7, 0x4e, 0xe9, 0xd4, 0x9c, 0xd5, 0x79, 0x70, 0x4a,
+		0xef, 0x1a, 0x21, 0xb8, 0xd6, 0x20, 0xb4, 0x62, 0xd2, 0xaf, 0x7a, 0x8f, 0x68, 0x19, 0x8e, 0xe0,
+		0xc7, 0x0d, 0xc3, 0x35, 0x1c, 0xb7, 0xa8, 0x3f, 0x1c, 0x5b, 0xd4, 0x1f, 0xea, 0xb0, 0x38, 0x83,
+		0xe8, 0x2c, 0x1c, 0xae, 0x58, 0x8e, 0x37, 0xb0, 0x8a, 0x0e, 0xad, 0x38, 0x64, 0xd5, 0x41, 0x67,
+		0xd0, 0xab, 0xf2, 0xa0, 0x7f, 0x86, 0x13, 0xae, 0xf6, 0xfe, 0xea, 0xd7, 0xa6, 0x56, 0xd9, 0x31,
+		0xb7, 0xb6, 0xf2, 0x28, 0xce, 0xa8, 0xf3, 0x94, 0xbb, 0xbb, 0xf0, 0xb5, 0xe4, 0xb2, 0xa2, 0x39,
+		0xe8, 0xab, 0xe1, 0x9a, 0xc9, 0xae, 0xf3, 0x27, 0xf8, 0x17, 0x7d, 0xb8, 0x66, 0xaa, 0x94, 0x0c,
+		0xa9, 0x30, 0x12, 0x8a, 0xd8, 0xec, 0x4e, 0xfe, 0x59, 0xfe, 0xde, 0x18, 0x88, 0xb0, 0xea, 0xb0,
+		0x1d, 0x18, 0x41, 0x0f, 0x60, 0xbc, 0x61, 0xe1, 0xdd, 0xb2, 0xd6, 0x24, 0xa6, 0x63, 0x7f, 0x98,
+		0x94, 0x1b, 0xa6, 0x51, 0x27, 0xde, 0x2d, 0xbb, 0x68, 0xbd, 0x6c, 0x4c, 0xd6, 0x29, 0x9d, 0x3a,
+		0xea, 0xf0, 0x2f, 0x36, 0x89, 0xd9, 0x35, 0x88, 0xae, 0x40, 0x66, 0x1b, 0x6b, 0x3a, 0xb6, 0xd8,
+		0xf5, 0xf7, 0x09, 0x7e, 0x53, 0x07, 0x25, 0x51, 0x19, 0x29, 0x5a, 0x83, 0x31, 0x77, 0xa2, 0x3b,
+		0xb5, 0x3c, 0xba, 0xae, 0xc7, 0x62, 0xd7, 0x15, 0x51, 0xbe, 0x76, 0x5d, 0x8e, 0xae, 0xed, 0x7f,
+		0xc2, 0x70, 0x43, 0xb3, 0x88, 0xe1, 0x1d, 0xcf, 0xb7, 0x8c, 0x47, 0xf9, 0x3c, 0xed, 0x30, 0xf9,
+		0xa7, 0xfd, 0xb4, 0x59, 0x14, 0xd7, 0x3d, 0xa1, 0xcb, 0x54, 0xe6, 0x4a, 0x9d, 0x58, 0x7b, 0xea,
+		0x91, 0x86, 0x7f, 0x14, 0x9d, 0x04, 0xf0, 0x2e, 0x75, 0x0c, 0x9d, 0x5e, 0x27, 0x67, 0xd5, 0x2c,
+		0x1b, 0x29, 0xe9, 0xc7, 0x97, 0x60, 0x8c, 0x27, 0x07, 0x0d, 0x43, 0x7a, 0x07, 0xef, 0xd1, 0xfd,
+		0x2a, 0xab, 0x3a, 0x7f, 0xa2, 0x31, 0xe8, 0xdf, 0xd5, 0xaa, 0x4d, 0xb7, 0x65, 0x25, 0xab, 0xba,
+		0x0f, 0x37, 0x53, 0x2f, 0x28, 0x85, 0x77, 0x15, 0x78, 0x4e, 0xfe, 0x70, 0x74, 0x15, 0x32, 0x2c,
+		0xbc, 0x28, 0x12, 0xe1, 0x85, 0xd1, 0xa2, 0x55, 0x98, 0x8a, 0xae, 0x8e, 0x1b, 0x3a, 0x05, 0x96,
+		0x56, 0x27, 0xc5, 0x85, 0xed, 0x92, 0x5e, 0xf8, 0xba, 0x02, 0xe7, 0x25, 0x73, 0xac, 0x6b, 0x30,
+		0xe0, 0x05, 0x56, 0x45, 0x22, 0xb0, 0x7a, 0xc4, 0x07, 0x06, 0xd5, 0x84, 0x69, 0xe9, 0x03, 0xc6,
+		0x32, 0x0c, 0xb2, 0xbd, 0xad, 0x93, 0x67, 0x0c, 0x09, 0x7c, 0x86, 0x6d, 0x65, 0x34, 0xcd, 0xc8,
+		0x91, 0xce, 0x43, 0xe1, 0x57, 0x0a, 0x9c, 0x93, 0xe9, 0xb1, 0xf0, 0x27, 0x0c, 0x4a, 0xb2, 0x84,
+		0xe1, 0x2e, 0x8c, 0x0b, 0x36, 0xe5, 0x54, 0x5c, 0xfc, 0x1a, 0xb5, 0x39, 0x1b, 0x72, 0x57, 0x60,
+		0x4e, 0xfb, 0x02, 0x73, 0xe1, 0x0d, 0x05, 0x0a, 0xf1, 0xed, 0x19, 0x68, 0x16, 0x50, 0xb0, 0x64,
+		0xdf, 0x6e, 0xda, 0x1a, 0xb6, 0x7d, 0x53, 0x10, 0xd8, 0x9d, 0x52, 0x81, 0xdd, 0xc9, 0xef, 0x6a,
+		0xe9, 0x80, 0xab, 0x15, 0xfe, 0x18, 0x98, 0x5e, 0xa1, 0x87, 0x24, 0x43, 0x34, 0x0d, 0xc3, 0xfe,
+		0x6b, 0x9b, 0xb6, 0x79, 0x0d, 0xd9, 0x5d, 0x1a, 0x07, 0xb0, 0xa7, 0x03, 0xd8, 0x2f, 0xc0, 0x91,
+		0x4d, 0xa3, 0xae, 0x59, 0x7b, 0xe5, 0xca, 0x36, 0xae, 0xec, 0xd8, 0xcd, 0x1a, 0xcd, 0xe8, 0xb2,
+		0xea, 0x90, 0x3b, 0xbc, 0xcc, 0x46, 0xd1, 0x45, 0x18, 0xf1, 0x5f, 0x36, 0xe2, 0xc7, 0x6e, 0xb6,
+		0x36, 0xa8, 0x0e, 0xe3, 0xee, 0x3b, 0x40, 0xfc, 0x98, 0x14, 0xbe, 0x99, 0x86, 0xb3, 0x12, 0x9d,
+		0x1f, 0x4f, 0x4c, 0xe3, 0xa0, 0x5b, 0xa4, 0x7b, 0x70, 0x0b, 0x74, 0x0a, 0x72, 0x9b, 0x9a, 0x8d,
+		0xbd, 0x4c, 0xc3, 0x9d, 0x96, 0xac, 0x33, 0xe4, 0xe6, 0x17, 0x93, 0x00, 0xce, 0x3d, 0x2b, 0x7b,
+		0xdd, 0xef, 0x4e, 0x6c, 0x1d, 0xb7, 0xdc, 0xb7, 0xb3, 0x80, 0xb6, 0x4c, 0x6b, 0x87, 0x21, 0xf5,
+		0xda, 0xf7, 0x32, 0xae, 0x6a, 0xce, 0x1b, 0x8a, 0xf5, 0xa1, 0x3b, 0x8e, 0xc6, 0x9d, 0xe0, 0xa8,
+		0xd9, 0x66, 0x9d, 0xa5, 0x92, 0xec, 0x09, 0xdd, 0x86, 0xfe, 0x8a, 0xd6, 0xb4, 0x31, 0xcb, 0x1a,
+		0x8b, 0xd2, 0x3d, 0x36, 0xcb, 0x0e, 0x97, 0xea, 0x32, 0x07, 0x0c, 0x34, 0x1b, 0x34, 0xd0, 0xf7,
+		0xd3, 0x70, 0x26, 0xb6, 0x2d, 0xe6, 0x89, 0xad, 0xd5, 0x92, 0xa7, 0xa2, 0xbb, 0x48, 0xb3, 0x92,
+		0x5d, 0x3b, 0x3e, 0x05, 0xbb, 0x42, 0x76, 0x5f, 0x92, 0x90, 0xdd, 0xed, 0x19, 0xfd, 0x01, 0xcf,
+		0x08, 0x2c, 0x7f, 0x26, 0x7a, 0xf9, 0x07, 0xa4, 0x96, 0xff, 0x90, 0x60, 0xf9, 0x39, 0x5e, 0x98,
+		0xe5, 0x7a, 0xa1, 0x7f, 0x25, 0x21, 0xb8, 0x92, 0x5f, 0xcc, 0xc0, 0x39, 0x99, 0x86, 0x22, 0x74,
+		0x1a, 0x72, 0xed, 0xaa, 0x3c, 0x5b, 0xc5, 0xac, 0x0a, 0xde, 0x50, 0x49, 0x77, 0x4e, 0xb0, 0x6d,
+		0x02, 0xea, 0x42, 0xa9, 0x88, 0x13, 0x6c, 0xfb, 0x93, 0xf4, 0x04, 0xab, 0x75, 0x3d, 0x39, 0x86,
+		0xad, 0x9b, 0x35, 0xcd, 0xa8, 0xb3, 0xc8, 0xc3, 0x9e, 0xfc, 0x5b, 0x49, 0x5f, 0x8f, 0x67, 0xcf,
+		0x8c, 0xfc, 0xd9, 0x73, 0x03, 0x26, 0x3c, 0x1b, 0x0d, 0xef, 0x40, 0x03, 0x71, 0x3b, 0xd0, 0xb8,
+		0xc7, 0x1b, 0xd8, 0x84, 0x02, 0x52, 0xd9, 0x06, 0xc7, 0xa4, 0x1e, 0x4a, 0x20, 0xd5, 0x3d, 0x72,
+		0x32, 0xa9, 0xe2, 0xad, 0x32, 0xdb, 0xd3, 0x56, 0xb9, 0x0a, 0x23, 0xdb, 0x58, 0xb3, 0xc8, 0x26,
+		0xd6, 0x3a, 0xe8, 0x20, 0x4e, 0xd4, 0x70, 0x9b, 0xa7, 0x23, 0x27, 0x3e, 0xc1, 0xc9, 0xc5, 0x27,
+		0x38, 0xa1, 0x83, 0xd9, 0x60, 0x2f, 0x07, 0xb3, 0x4e, 0x82, 0x7f, 0x58, 0x3a, 0xc1, 0x2f, 0xfc,
+		0x41, 0x81, 0x42, 0x7c, 0x73, 0xdb, 0x27, 0x96, 0x1a, 0x74, 0x27, 0x31, 0x7d, 0xfe, 0xd3, 0xe5,
+		0x2b, 0x30, 0x48, 0x0f, 0xe7, 0x5e, 0x58, 0xeb, 0x97, 0x08, 0x6b, 0x39, 0x87, 0x83, 0x3d, 0x14,
+		0x7e, 0xa3, 0xf8, 0x43, 0xc1, 0x01, 0xe7, 0xe5, 0xfc, 0x29, 0x4a, 0x25, 0xd8, 0x0d, 0xd2, 0xb1,
+		0xb9, 0x4a, 0x9f, 0x7f, 0x32, 0x0b, 0xbf, 0x56, 0xe0, 0x4c, 0x7c, 0xc7, 0x51, 0xaf, 0xe9, 0xfb,
+		0xa7, 0xa1, 0xd1, 0x8f, 0x53, 0x70, 0x56, 0xa2, 0x6f, 0xcf, 0xd1, 0x49, 0xc7, 0x44, 0x33, 0xaa,
+		0xb6, 0xd4, 0x22, 0x79, 0xc4, 0x4f, 0x4c, 0xa7, 0x60, 0x7e, 0xd5, 0xd7, 0x4b, 0x7e, 0xb5, 0x6f,
+		0x13, 0xff, 0x9c, 0x02, 0x33, 0xf2, 0xed, 0x76, 0x32, 0x7b, 0xde, 0xc1, 0x1c, 0xe0, 0xde, 0x53,
+		0x20, 0x61, 0x63, 0x5d, 0x3c, 0xb6, 0x31, 0x2f, 0x4b, 0x62, 0xa7, 0x70, 0xfa, 0x20, 0x85, 0x38,
+		0x2d, 0x81, 0xf8, 0x9d, 0x80, 0x1d, 0x8a, 0x4a, 0x70, 0xbd, 0xda, 0xe1, 0x2a, 0x4c, 0x55, 0x35,
+		0xd2, 0xd5, 0x60, 0x12, 0x6c, 0xb7, 0xe8, 0xcc, 0xac, 0x4b, 0xc7, 0x5b, 0x4a, 0x37, 0xab, 0xe2,
+		0xd8, 0x73, 0x3a, 0x81, 0x3d, 0xf7, 0xc5, 0xfa, 0x68, 0x20, 0x0f, 0x2c, 0x7c, 0xa0, 0xc0, 0x89,
+		0x88, 0x96, 0x56, 0xe7, 0x27, 0x3f, 0x6e, 0x2b, 0x5f, 0x7b, 0xdd, 0x06, 0xe8, 0x73, 0x49, 0x47,
+		0x6b, 0x70, 0xb4, 0xbd, 0x91, 0x6f, 0x19, 0x56, 0x82, 0x23, 0x2f, 0x62, 0xfb, 0xb8, 0xd3, 0xb2,
+		0x9a, 0x64, 0xfb, 0x95, 0x59, 0xec, 0x7f, 0x83, 0x09, 0x61, 0xaf, 0x6c, 0x94, 0x36, 0xd2, 0x29,
+		0x7d, 0xe1, 0x7d, 0x05, 0x26, 0xa3, 0xda, 0x24, 0x0f, 0xe4, 0x2b, 0x07, 0x35, 0x1f, 0x91, 0x01,
+		0xfa, 0xfb, 0x0a, 0x4c, 0xc5, 0xb5, 0x5b, 0x46, 0x69, 0xf3, 0x44, 0xdd, 0x36, 0x12, 0xf9, 0x5f,
+		0x06, 0x20, 0x61, 0x57, 0x0f, 0x9a, 0x87, 0x31, 0xda, 0x38, 0x14, 0xbc, 0x63, 0x77, 0x75, 0x1a,
+		0xa9, 0xe3, 0x56, 0xe0, 0x86, 0x3d, 0x54, 0xe6, 0x4a, 0xf5, 0x56, 0xe6, 0x7a, 0x5a, 0x88, 0x92,
+		0x2f, 0x44, 0xc9, 0xd8, 0xce, 0x80, 0x84, 0xed, 0xdc, 0x83, 0x71, 0x56, 0x40, 0x60, 0x18, 0x8d,
+		0x3a, 0xc1, 0xd6, 0xae, 0x56, 0x8d, 0x3f, 0xb7, 0x8c, 0x31, 0x46, 0x0a, 0xaf, 0xc4, 0xd8, 0xfc,
+		0x45, 0xae, 0xec, 0xbe, 0x8a, 0x5c, 0x5d, 0x29, 0x1c, 0x24, 0x49, 0xe1, 0xc4, 0x15, 0xad, 0x5c,
+		0xcf, 0x15, 0xad, 0xce, 0x39, 0x63, 0x50, 0xbe, 0x90, 0xe0, 0xd5, 0x55, 0x0e, 0xef, 0xa3, 0xae,
+		0x32, 0xb4, 0xaf, 0xba, 0x4a, 0xe1, 0xf7, 0x0a, 0xcc, 0x27, 0x6d, 0x2d, 0x6c, 0x47, 0x2b, 0xa5,
+		0x3b, 0x5a, 0x45, 0x9d, 0x6f, 0x36, 0xe1, 0x58, 0xbb, 0x1d, 0x21, 0x50, 0xa2, 0x76, 0xfd, 0x78,
+		0x26, 0xb2, 0xe1, 0xc0, 0x5f, 0xa4, 0x3e, 0x8a, 0x79, 0xc3, 0x81, 0x33, 0x54, 0x5f, 0xf0, 0xce,
+		0xe3, 0x1b, 0x0a, 0x4c, 0x0b, 0x14, 0xe5, 0x15, 0xe6, 0xe3, 0xbd, 0x47, 0x91, 0xf0, 0x9e, 0xae,
+		0x44, 0x28, 0x95, 0x20, 0x11, 0x2a, 0x7c, 0xac, 0xc0, 0xc9, 0xc8, 0xce, 0x79, 0x27, 0x13, 0x64,
+		0x7d, 0xf9, 0x75, 0xad, 0xe6, 0xad, 0x04, 0xb8, 0x43, 0x77, 0xb5, 0x1a, 0xee, 0xf5, 0xd3, 0x07,
+		0xb6, 0xe9, 0x74, 0x1c, 0xa2, 0x4f, 0xfe, 0xe0, 0xfd, 0x23, 0xde, 0x22, 0x89, 0x3a, 0x45, 0x4e,
+		0x43, 0x8e, 0xf5, 0xea, 0x74, 0x4f, 0x81, 0x3b, 0x44, 0xa7, 0xa0, 0x1d, 0xf3, 0x53, 0xf2, 0x31,
+		0x3f, 0xea, 0x12, 0x3c, 0xc6, 0xc2, 0x3e, 0xaf, 0xc0, 0x4c, 0x82, 0xe6, 0xa9, 0xce, 0x5d, 0xae,
+		0xe2, 0xbb, 0xcb, 0xed, 0x75, 0xe1, 0x22, 0x90, 0x17, 0x7e, 0x9a, 0x82, 0x97, 0xf7, 0xd7, 0x40,
+		0x7e, 0x60, 0x2e, 0xd1, 0xb9, 0xe9, 0x4b, 0xf9, 0x6e, 0xfa, 0x1e, 0x00, 0x0a, 0x37, 0x2a, 0xb1,
+		0xe8, 0x70, 0x5e, 0xae, 0x10, 0xaa, 0x8e, 0x84, 0xba, 0x8d, 0x9d, 0xab, 0x93, 0x8a, 0x59, 0x27,
+		0x96, 0x59, 0xa5, 0x0b, 0x36, 0xa8, 0x7a, 0x8f, 0xa8, 0x08, 0xa3, 0x81, 0x9e, 0x3b, 0xb3, 0x5e,
+		0x75, 0xf3, 0xfa, 0x43, 0xea, 0x88, 0xaf, 0x15, 0xee, 0x5e, 0xbd, 0xba, 0x57, 0x78, 0x3b, 0x0d,
+		0xb7, 0xf6, 0xd1, 0xa0, 0x8e, 0x1e, 0x74, 0x47, 0xcd, 0x21, 0xc1, 0xcf, 0x3f, 0xa4, 0x24, 0xfb,
+		0xee, 0xb4, 0x0f, 0xe8, 0x34, 0x2a, 0xbc, 0x81, 0xe5, 0xaf, 0x4b, 0xdf, 0x7e, 0xd7, 0x65, 0x16,
+		0x50, 0xb0, 0x2d, 0x90, 0x55, 0x47, 0xd2, 0xea, 0xb0, 0xe1, 0x33, 0x42, 0xf7, 0x02, 0xcc, 0x5b,
+		0xc5, 0x8c, 0x6f, 0x15, 0x0b, 0xbf, 0x55, 0xe0, 0x7a, 0x8f, 0xdd, 0xf5, 0x02, 0x0c, 0x8a, 0x00,
+		0xc3, 0x27, 0x6b, 0xb8, 0x85, 0x37, 0xd3, 0x70, 0xbd, 0xc7, 0x0e, 0xc8, 0xbf, 0x57, 0x5f, 0x0d,
+		0x04, 0xf4, 0x3e, 0x71, 0x40, 0xef, 0x97, 0x0f, 0xe8, 0x42, 0xd3, 0x11, 0x05, 0x80, 0x01, 0x51,
+		0x00, 0xf8, 0xbf, 0x34, 0x5c, 0xed, 0xa5, 0x8b, 0x53, 0xce, 0xf3, 0xa5, 0x24, 0x3f, 0xf5, 0xfc,
+		0x8e, 0xe7, 0x7f, 0xa4, 0xc0, 0xa5, 0xa4, 0x1d, 0xa9, 0x7f, 0xd3, 0x2e, 0x2f, 0xde, 0xab, 0x0a,
+		0xbf, 0x54, 0x60, 0x2e, 0x51, 0x17, 0xeb, 0x81, 0x85, 0x00, 0xee, 0x99, 0x23, 0xb5, 0xbf, 0x33,
+		0xc7, 0x87, 0x59, 0xb8, 0xd2, 0xc3, 0xcf, 0x71, 0xba, 0x96, 0x43, 0xf1, 0x2d, 0xc7, 0x69, 0xc8,
+		0xb5, 0x97, 0x83, 0xd9, 0x7c, 0x56, 0x05, 0x6f, 0x88, 0x77, 0x01, 0x91, 0x3e, 0x80, 0x0b, 0x88,
+		0x5e, 0xab, 0x91, 0xfd, 0x07, 0x7b, 0x01, 0x91, 0x79, 0xa2, 0x17, 0x10, 0x03, 0x3d, 0x5f, 0x40,
+		0x3c, 0x04, 0xd6, 0x4c, 0xcc, 0x24, 0xb2, 0x22, 0x9e, 0xdb, 0xa0, 0x70, 0x3e, 0xa2, 0x23, 0x99,
+		0x4a, 0x61, 0xa5, 0xbc, 0x91, 0x46, 0x70, 0xa8, 0xdb, 0x49, 0xb2, 0xfe, 0x78, 0x2e, 0x63, 0xf2,
+		0x20, 0x61, 0xf2, 0x15, 0xc8, 0x77, 0x99, 0x53, 0xd9, 0xc2, 0xcd, 0x0e, 0xfc, 0x1c, 0x85, 0x3f,
+		0x13, 0x69, 0x38, 0x25, 0x5d, 0xc5, 0x4d, 0x0f, 0xaf, 0x7a, 0xb4, 0xc5, 0x1b, 0x0e, 0x15, 0x37,
+		0x0f, 0xf7, 0x52, 0xdc, 0x0c, 0xb5, 0x85, 0x0e, 0x71, 0xda, 0x42, 0x3b, 0x07, 0xb1, 0x23, 0xc9,
+		0x6f, 0x26, 0x86, 0xf7, 0x71, 0x33, 0x31, 0xb2, 0xbf, 0x8e, 0xcf, 0x9b, 0x90, 0xd3, 0x71, 0x55,
+		0xdb, 0x73, 0x4d, 0x33, 0xbe, 0x7d, 0x15, 0x28, 0x35, 0x35, 0x45, 0xf4, 0x22, 0x0c, 0xfe, 0xbb,
+		0x41, 0x88, 0xf7, 0xaf, 0x29, 0xf2, 0xa3, 0x71, 0xcc, 0x39, 0x97, 0xbc, 0xcd, 0xed, 0xf6, 0x77,
+		0x3a, 0x17, 0x9b, 0x1a, 0xc9, 0x8f, 0xc5, 0xf6, 0x75, 0x02, 0xa5, 0x57, 0x9b, 0xf5, 0x45, 0x52,
+		0x78, 0x2b, 0x0d, 0x97, 0x92, 0xfe, 0x54, 0xef, 0xd3, 0x0f, 0x6d, 0x6b, 0x5e, 0x8e, 0xe2, 0xd6,
+		0xe8, 0xae, 0x25, 0xfe, 0x9d, 0x99, 0x2f, 0x35, 0xe9, 0x72, 0xd2, 0x7e, 0xbf, 0x93, 0xf2, 0x37,
+		0xe0, 0x8c, 0x60, 0x03, 0x3e, 0xa0, 0x5b, 0xcc, 0xc2, 0x2f, 0x52, 0x30, 0x9b, 0xe4, 0x77, 0x88,
+		0xc2, 0xf5, 0xe0, 0xef, 0xfc, 0xa9, 0xfd, 0xee, 0xfc, 0x07, 0xb5, 0x8a, 0xfc, 0xd9, 0xed, 0x13,
+		0xcc, 0x6e, 0x27, 0x32, 0xf4, 0xcb, 0x5f, 0xd1, 0x7c, 0x9c, 0x82, 0x84, 0xbf, 0x90, 0xfc, 0x6c,
+		0x4c, 0x26, 0xaf, 0x20, 0xd5, 0xcf, 0x2d, 0x48, 0x75, 0x3a, 0x29, 0x32, 0xf2, 0x9d, 0x14, 0x85,
+		0x3f, 0xa5, 0xe0, 0xe2, 0x41, 0x44, 0x94, 0xcf, 0xe8, 0xa4, 0x77, 0xd5, 0x0a, 0x32, 0x09, 0x6a,
+		0x05, 0x85, 0x3f, 0xa7, 0x60, 0x2e, 0xd1, 0x0f, 0x56, 0x9f, 0x4e, 0x7c, 0x68, 0xe2, 0xbd, 0xeb,
+		0xcc, 0x4c, 0x92, 0x2b, 0xf0, 0xff, 0x4e, 0x8b, 0x26, 0x5e, 0xd4, 0xfd, 0xf2, 0x74, 0xe2, 0x23,
+		0x9b, 0x6f, 0x32, 0xbd, 0xf4, 0xfc, 0xff, 0x24, 0x05, 0xf3, 0x09, 0x7f, 0x48, 0xfc, 0x74, 0x1d,
+		0x7c, 0xeb, 0x30, 0x43, 0xe0, 0x08, 0xfd, 0x73, 0xd5, 0xa8, 0x12, 0x6c, 0xd1, 0x4f, 0x9d, 0x84,
+		0x89, 0x95, 0x87, 0x2b, 0x77, 0x37, 0xca, 0xab, 0xa5, 0xb5, 0x8d, 0x15, 0xb5, 0xbc, 0xf1, 0x2f,
+		0xeb, 0x2b, 0xe5, 0xd2, 0xdd, 0x87, 0x8b, 0x6b, 0xa5, 0xdb, 0xc3, 0xcf, 0xa0, 0xd3, 0x70, 0x22,
+		0xfc, 0x7a, 0x71, 0x6d, 0xad, 0x4c, 0x47, 0x87, 0x15, 0x74, 0x06, 0x4e, 0x86, 0x09, 0x96, 0xd7,
+		0xee, 0xdd, 0x5f, 0x61, 0x24, 0xa9, 0xa5, 0xd7, 0xe0, 0x58, 0xc5, 0xac, 0xf1, 0xe6, 0x60, 0xc9,
+		0xfb, 0x57, 0xb4, 0xeb, 0x96, 0x49, 0xcc, 0x75, 0xe5, 0x5f, 0x2f, 0x3f, 0x32, 0xc8, 0x76, 0x73,
+		0xb3, 0x58, 0x31, 0x6b, 0xf3, 0xdd, 0xff, 0x12, 0x77, 0xce, 0xd0, 0xab, 0xf3, 0x8f, 0x4c, 0xf7,
+		0xdf, 0xf0, 0xb2, 0xff, 0x8f, 0x7b, 0x4b, 0x6b, 0x18, 0xbb, 0x97, 0x37, 0x33, 0x74, 0xec, 0xca,
+		0x5f, 0x07, 0x00, 0xf6, 0x46, 0xec, 0xce, 0x02, 0x58, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/query.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdf, 0x6f, 0x93, 0x50,
+		0x18, 0x95, 0x9a, 0x2c, 0xd9, 0xb7, 0x55, 0xc9, 0x9d, 0xc6, 0xda, 0xec, 0x47, 0xd3, 0xed, 0x61,
+		0x69, 0x14, 0xec, 0xf4, 0x6d, 0x4f, 0x8c, 0x5e, 0x0d, 0x86, 0x01, 0x03, 0xda, 0xa5, 0x7b, 0x21,
+		0x94, 0x5e, 0x2b, 0x8e, 0x72, 0xf1, 0x5e, 0x68, 0xed, 0x3f, 0xe0, 0xbb, 0x7f, 0x8d, 0xff, 0x9e,
+		0x81, 0x52, 0x5b, 0x2d, 0x33, 0xbe, 0x7d, 0x9c, 0xef, 0x1c, 0xce, 0x39, 0xb9, 0xf9, 0xe0, 0x24,
+		0x1b, 0x11, 0x26, 0x07, 0xfe, 0x98, 0xc4, 0x01, 0x91, 0xfd, 0x24, 0x94, 0x67, 0x5d, 0xf9, 0x6b,
+		0x46, 0xd8, 0x42, 0x4a, 0x18, 0x4d, 0x29, 0x3a, 0xc8, 0x09, 0x52, 0x49, 0x90, 0xfc, 0x24, 0x94,
+		0x66, 0xdd, 0x66, 0xab, 0x4a, 0x15, 0xd0, 0xe9, 0x94, 0xc6, 0x4b, 0x59, 0xb3, 0x5d, 0xc5, 0x98,
+		0x53, 0x76, 0xff, 0x29, 0xa2, 0xf3, 0x25, 0xa7, 0x7d, 0x0f, 0xf5, 0xdb, 0x12, 0xb9, 0xc9, 0x1d,
+		0xd1, 0x11, 0x40, 0x61, 0xed, 0xa5, 0x8b, 0x84, 0x34, 0x84, 0x96, 0x70, 0xbe, 0x6b, 0xef, 0x16,
+		0x88, 0xbb, 0x48, 0x08, 0xba, 0x5c, 0xad, 0x7d, 0x36, 0xe1, 0x8d, 0x5a, 0x4b, 0x38, 0xdf, 0xbb,
+		0x38, 0x94, 0x2a, 0xf2, 0x49, 0x96, 0xbf, 0x88, 0xa8, 0x3f, 0x2e, 0xc5, 0x0a, 0x9b, 0xf0, 0xf6,
+		0x4f, 0x01, 0x0e, 0xfe, 0x70, 0xb3, 0x09, 0xcf, 0xa2, 0x14, 0x61, 0xd8, 0x63, 0xc5, 0xb4, 0x36,
+		0x7d, 0x72, 0x71, 0x56, 0xf9, 0xd7, 0x0d, 0x59, 0x9e, 0xc7, 0x06, 0xf6, 0x7b, 0x46, 0xef, 0x60,
+		0xc7, 0x8f, 0xf9, 0x9c, 0xb0, 0xff, 0xca, 0x55, 0x72, 0xd1, 0x29, 0xd4, 0x09, 0x63, 0x94, 0x79,
+		0x53, 0xc2, 0xb9, 0x3f, 0x21, 0x8d, 0xc7, 0x45, 0xe7, 0xfd, 0x02, 0xbc, 0x5e, 0x62, 0x6d, 0x02,
+		0xf5, 0xd2, 0xf9, 0x0b, 0x09, 0x52, 0x32, 0x46, 0x2e, 0xec, 0x07, 0x11, 0xe5, 0xc4, 0xe3, 0xa9,
+		0x9f, 0x66, 0xbc, 0xcc, 0xdc, 0xad, 0x74, 0x5c, 0x55, 0xc6, 0xdf, 0x48, 0x90, 0xa5, 0x21, 0x8d,
+		0xd5, 0x5c, 0xe9, 0x14, 0x42, 0x7b, 0x2f, 0x58, 0x7f, 0x74, 0x62, 0x78, 0xfa, 0x57, 0x41, 0x74,
+		0x04, 0x2f, 0x6f, 0xfa, 0xd8, 0x1e, 0x7a, 0x36, 0x76, 0xfa, 0xba, 0xeb, 0xb9, 0x43, 0x0b, 0x7b,
+		0x9a, 0x31, 0x50, 0x74, 0xad, 0x27, 0x3e, 0x42, 0xc7, 0xd0, 0xdc, 0x5e, 0x2b, 0x86, 0x73, 0x8b,
+		0x6d, 0xdc, 0x13, 0x05, 0x74, 0x08, 0x8d, 0xed, 0xfd, 0x7b, 0x45, 0xd3, 0x71, 0x4f, 0xac, 0x75,
+		0x7e, 0x08, 0xf0, 0x6c, 0xa3, 0x97, 0x4a, 0xe3, 0x71, 0x98, 0x07, 0x44, 0x6d, 0x38, 0x5e, 0xc9,
+		0x3e, 0x62, 0xd5, 0xf5, 0x54, 0xd3, 0xe8, 0x69, 0xae, 0x66, 0x1a, 0x1b, 0xd6, 0xa7, 0x70, 0xf2,
+		0x00, 0xc7, 0x30, 0x5d, 0xcf, 0xb4, 0xb0, 0x21, 0x0a, 0xe8, 0x0d, 0xbc, 0xfa, 0x07, 0x49, 0x35,
+		0xaf, 0x2d, 0x1d, 0xbb, 0xb8, 0xe7, 0xa9, 0x3a, 0x56, 0x0c, 0x7d, 0x28, 0xd6, 0x3a, 0xdf, 0x05,
+		0x78, 0x5e, 0x64, 0x52, 0x69, 0xcc, 0x43, 0x9e, 0x92, 0x38, 0x58, 0xe8, 0x64, 0x46, 0xa2, 0xb5,
+		0xa1, 0x6a, 0x1a, 0x8e, 0xe6, 0xb8, 0xd8, 0x50, 0x87, 0x9e, 0x8e, 0x07, 0x58, 0xdf, 0x48, 0x75,
+		0x06, 0xad, 0x87, 0x48, 0x78, 0x80, 0x0d, 0xb7, 0xaf, 0xe8, 0xa2, 0xb0, 0xee, 0xb7, 0xcd, 0x72,
+		0x5c, 0xdb, 0x34, 0x3e, 0x88, 0xb5, 0xab, 0x3b, 0x78, 0x11, 0xd0, 0x69, 0xd5, 0x8b, 0x5e, 0x41,
+		0x11, 0xd0, 0xca, 0x2f, 0xc8, 0x12, 0xee, 0xba, 0x93, 0x30, 0xfd, 0x9c, 0x8d, 0xa4, 0x80, 0x4e,
+		0xe5, 0xcd, 0x93, 0x7b, 0x1d, 0x8e, 0x23, 0x79, 0x42, 0xe5, 0xe2, 0xd2, 0xca, 0xfb, 0xbb, 0xf4,
+		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x03, 0x00, 0xbd, 0x69, 0x28, 0x5b, 0xfb,
+		0x03, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/service_workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
+		0x11, 0xc6, 0x2c, 0xdf, 0xb5, 0x24, 0x45, 0xb5, 0x44, 0x72, 0xb5, 0x14, 0x5f, 0x23, 0xcb, 0x61,
+		0x64, 0x69, 0x19, 0x92, 0xd6, 0xc3, 0xb2, 0x13, 0x83, 0xa2, 0x44, 0x99, 0x81, 0x64, 0x30, 0x43,
+		0xc6, 0x42, 0x72, 0x19, 0x34, 0x67, 0x9a, 0xcb, 0x16, 0x67, 0x67, 0x86, 0xdd, 0x3d, 0xa4, 0xd7,
+		0x39, 0x04, 0x09, 0x0c, 0x07, 0xc8, 0x0b, 0xc9, 0x31, 0x40, 0x80, 0x1c, 0x72, 0xf0, 0x29, 0xbe,
+		0xe4, 0x2f, 0x04, 0xfe, 0x1f, 0xf9, 0x05, 0xf9, 0x05, 0x31, 0x82, 0xee, 0xe9, 0xd9, 0x17, 0x67,
+		0x66, 0x77, 0x29, 0x18, 0x92, 0x73, 0xdb, 0xee, 0xae, 0xaf, 0xba, 0xba, 0xba, 0xaa, 0xba, 0xaa,
+		0x76, 0xe0, 0x56, 0x74, 0x40, 0xd8, 0xaa, 0x83, 0x5d, 0xe2, 0x3b, 0x64, 0x15, 0x87, 0x74, 0xf5,
+		0x74, 0x6d, 0x95, 0x13, 0x76, 0x4a, 0x1d, 0x62, 0x9f, 0x05, 0xec, 0xf8, 0xd0, 0x0b, 0xce, 0x2a,
+		0x21, 0x0b, 0x44, 0x80, 0xae, 0x48, 0xda, 0x8a, 0xa6, 0xad, 0xe0, 0x90, 0x56, 0x4e, 0xd7, 0xca,
+		0x0b, 0xd5, 0x20, 0xa8, 0x7a, 0x64, 0x55, 0x91, 0x1c, 0x44, 0x87, 0xab, 0x6e, 0xc4, 0xb0, 0xa0,
+		0x81, 0x1f, 0x83, 0xca, 0x8b, 0x9d, 0xeb, 0x82, 0xd6, 0x08, 0x17, 0xb8, 0x16, 0x6a, 0x82, 0xa5,
+		0x34, 0x09, 0x9c, 0xa0, 0x56, 0x6b, 0xb0, 0x58, 0x4e, 0xa3, 0x38, 0xa2, 0x5c, 0x04, 0xac, 0x9e,
+		0xec, 0x92, 0x46, 0x72, 0x12, 0x91, 0x06, 0x81, 0x99, 0x46, 0x20, 0x30, 0x3f, 0xf6, 0x28, 0x17,
+		0x79, 0x34, 0xed, 0x3a, 0x30, 0xff, 0x65, 0xc0, 0xa2, 0x25, 0xe5, 0x67, 0xe2, 0x85, 0x5e, 0x79,
+		0xf2, 0x29, 0x71, 0x22, 0x79, 0x62, 0x8b, 0x9c, 0x44, 0x84, 0x0b, 0x34, 0x03, 0xc3, 0x6e, 0x50,
+		0xc3, 0xd4, 0x2f, 0x19, 0x4b, 0xc6, 0xca, 0x98, 0xa5, 0x47, 0xe8, 0xa7, 0x80, 0x12, 0x6e, 0x36,
+		0x49, 0x40, 0xa5, 0xc2, 0x92, 0xb1, 0x52, 0x5c, 0x7f, 0xbb, 0x92, 0xa2, 0xdc, 0xca, 0xf9, 0x2d,
+		0x2e, 0x9f, 0x75, 0x4e, 0xa1, 0x32, 0x8c, 0x52, 0x97, 0xf8, 0x82, 0x8a, 0x7a, 0x69, 0x40, 0x6d,
+		0xd8, 0x18, 0x4b, 0x51, 0x18, 0xc1, 0x3c, 0xf0, 0x4b, 0x83, 0xb1, 0x28, 0xf1, 0xc8, 0xfc, 0x87,
+		0x01, 0x4b, 0x8f, 0x29, 0xae, 0xfa, 0x01, 0x27, 0xdf, 0x81, 0x73, 0x98, 0x5f, 0x1a, 0xb0, 0x9c,
+		0x23, 0x2f, 0x0f, 0x03, 0x9f, 0x93, 0x4c, 0x81, 0x5f, 0xc2, 0xbc, 0x1b, 0x83, 0x05, 0x75, 0xec,
+		0x57, 0x96, 0x7d, 0xae, 0xc9, 0xec, 0xdc, 0xa2, 0xf9, 0xf5, 0x28, 0xcc, 0xef, 0x5d, 0xc8, 0x3c,
+		0x16, 0xa1, 0xd8, 0x10, 0x8d, 0xba, 0x4a, 0xa6, 0x31, 0x0b, 0x92, 0xa9, 0x1d, 0x17, 0x6d, 0xc3,
+		0x44, 0x83, 0x40, 0xd4, 0x43, 0xa2, 0xb4, 0x54, 0x5c, 0x5f, 0xce, 0x15, 0x7b, 0xbf, 0x1e, 0x12,
+		0x6b, 0xfc, 0xac, 0x65, 0x84, 0x1e, 0xc2, 0x98, 0xb4, 0x7c, 0x5b, 0x9a, 0xbe, 0xb2, 0x8b, 0xe2,
+		0xfa, 0x7c, 0x2a, 0x8f, 0x7d, 0xcc, 0x8f, 0x9f, 0x51, 0x2e, 0xac, 0x51, 0xa1, 0x7f, 0xa1, 0x75,
+		0x18, 0xa2, 0x7e, 0x18, 0x89, 0xd2, 0x90, 0xc2, 0x5d, 0x4f, 0xc5, 0xed, 0xe2, 0xba, 0x17, 0x60,
+		0xd7, 0x8a, 0x49, 0x11, 0x86, 0xa5, 0x86, 0xaa, 0x6d, 0xe5, 0x3a, 0xb6, 0x08, 0x6c, 0xc7, 0x0b,
+		0x38, 0xb1, 0x65, 0x34, 0x08, 0x22, 0x51, 0x1a, 0x56, 0xec, 0xae, 0x55, 0xe2, 0x68, 0x51, 0x49,
+		0xa2, 0x45, 0xe5, 0xb1, 0x8e, 0x26, 0xd6, 0xf5, 0x06, 0x0b, 0xa5, 0xdd, 0xfd, 0x60, 0x4b, 0xe2,
+		0xf7, 0x63, 0x38, 0x7a, 0x01, 0x73, 0xea, 0x48, 0x19, 0xdc, 0x47, 0xba, 0x71, 0x9f, 0x95, 0xe8,
+		0x34, 0xc6, 0xad, 0x46, 0x39, 0xda, 0xe1, 0x5c, 0xf3, 0x00, 0x2c, 0xbe, 0x53, 0x79, 0x5f, 0x63,
+		0x6a, 0x75, 0x4c, 0xcf, 0xec, 0xb8, 0xc8, 0x81, 0x52, 0xcb, 0x7d, 0xda, 0x8c, 0x44, 0x9c, 0xd8,
+		0x61, 0xe0, 0x51, 0xa7, 0x5e, 0x82, 0x25, 0x63, 0x65, 0x72, 0xfd, 0x56, 0xee, 0xcd, 0xed, 0xb8,
+		0x96, 0x84, 0xec, 0x2a, 0x84, 0x35, 0x7d, 0x96, 0x36, 0x8d, 0xb6, 0x60, 0x9c, 0x11, 0xc1, 0xea,
+		0x09, 0xe3, 0xa2, 0x3a, 0xe9, 0x52, 0x2a, 0x63, 0x4b, 0x12, 0x6a, 0x76, 0x45, 0xd6, 0x1c, 0xa0,
+		0x1b, 0x30, 0xe1, 0x30, 0x79, 0x37, 0xce, 0x11, 0x71, 0x23, 0x8f, 0x94, 0xc6, 0xd5, 0x59, 0xc6,
+		0xe5, 0xe4, 0x9e, 0x9e, 0x43, 0x77, 0x60, 0xb0, 0x46, 0x6a, 0x41, 0x69, 0x42, 0xeb, 0x32, 0x6d,
+		0x87, 0xe7, 0xa4, 0x16, 0x58, 0x8a, 0x0c, 0x59, 0x70, 0x99, 0x13, 0xcc, 0x9c, 0x23, 0x1b, 0x0b,
+		0xc1, 0xe8, 0x41, 0x24, 0x08, 0x2f, 0x4d, 0x2a, 0xec, 0xcd, 0x54, 0xec, 0x9e, 0xa2, 0xde, 0x6c,
+		0x10, 0x5b, 0x53, 0xbc, 0x63, 0x06, 0x6d, 0xc0, 0xf0, 0x11, 0xc1, 0x2e, 0x61, 0xa5, 0x4b, 0x8a,
+		0xd1, 0x5c, 0x2a, 0xa3, 0x8f, 0x14, 0x89, 0xa5, 0x49, 0xd1, 0x43, 0x28, 0xba, 0xc4, 0xc3, 0xf5,
+		0xd8, 0x36, 0x4a, 0x53, 0xdd, 0x4c, 0x01, 0x14, 0xb5, 0xb2, 0x05, 0xf4, 0x01, 0x8c, 0xbf, 0xa4,
+		0x42, 0x10, 0xa6, 0xc1, 0x97, 0xbb, 0x81, 0x8b, 0x31, 0x79, 0x03, 0x7d, 0x48, 0x19, 0x17, 0x36,
+		0x8b, 0x7c, 0x1b, 0x8b, 0x12, 0x52, 0xe8, 0xf2, 0x39, 0xf4, 0x7e, 0xf2, 0x22, 0x5a, 0xa0, 0xe8,
+		0xad, 0xc8, 0xdf, 0x14, 0xe6, 0x7d, 0x58, 0xc8, 0x8a, 0x23, 0x3a, 0xdc, 0x4d, 0xc3, 0xb0, 0xe4,
+		0x4c, 0x5d, 0x1d, 0x48, 0x86, 0x58, 0xe4, 0xef, 0xb8, 0x26, 0x03, 0x33, 0x1d, 0xb8, 0xc9, 0xeb,
+		0xbe, 0x93, 0x44, 0xa1, 0x67, 0x30, 0xa2, 0x4d, 0x55, 0xa1, 0x8b, 0xeb, 0xeb, 0xe9, 0xb7, 0x92,
+		0x17, 0xca, 0xac, 0x84, 0x85, 0x79, 0x13, 0x6e, 0xe4, 0xee, 0x19, 0x4b, 0x6c, 0xbe, 0x07, 0x4b,
+		0xd9, 0x8f, 0x67, 0xfe, 0xa9, 0xbe, 0x2e, 0xc0, 0xc2, 0x1e, 0xad, 0xfa, 0xd8, 0xfb, 0x2e, 0xbc,
+		0xbb, 0xed, 0xa1, 0x61, 0xb0, 0x33, 0x34, 0x2c, 0x42, 0x91, 0xab, 0xb3, 0xd8, 0x3e, 0xae, 0x11,
+		0x15, 0x4b, 0xc7, 0x2c, 0x88, 0xa7, 0x3e, 0xc6, 0x35, 0x82, 0x3e, 0x84, 0x71, 0x4d, 0x10, 0x47,
+		0xdb, 0xe1, 0x1e, 0xa2, 0xad, 0x66, 0xb9, 0xa3, 0x62, 0x6e, 0x09, 0x46, 0x9c, 0xc0, 0x17, 0x2c,
+		0xf0, 0x54, 0xf0, 0x1b, 0xb7, 0x92, 0xa1, 0xb9, 0x0c, 0x8b, 0x99, 0x7a, 0xd4, 0xd7, 0xf4, 0x8d,
+		0x01, 0xdf, 0xd3, 0x34, 0x54, 0x1c, 0xe5, 0xbf, 0x66, 0x2f, 0x60, 0x22, 0x0e, 0xba, 0xaf, 0x6e,
+		0x4d, 0xe3, 0x8a, 0x51, 0xc2, 0xb8, 0x43, 0x47, 0x85, 0xae, 0x3a, 0x1a, 0x78, 0x05, 0x1d, 0x0d,
+		0xb6, 0xeb, 0x68, 0x13, 0x56, 0xba, 0x9f, 0x3f, 0xdf, 0x5e, 0xbf, 0x30, 0xe0, 0x76, 0x37, 0x1e,
+		0x6d, 0x0e, 0xf9, 0x49, 0xa7, 0x43, 0x7e, 0x90, 0xae, 0xc2, 0xde, 0xee, 0xa5, 0xe9, 0x9a, 0xab,
+		0x70, 0xa7, 0x47, 0x39, 0xf4, 0xed, 0x7f, 0x55, 0x80, 0x79, 0x8b, 0x70, 0xf2, 0xc6, 0x24, 0xb8,
+		0xcd, 0x24, 0x76, 0xa0, 0x35, 0x89, 0x45, 0xf7, 0xa1, 0xe4, 0x12, 0x87, 0x72, 0x99, 0x56, 0x1c,
+		0x52, 0x9f, 0xf2, 0x23, 0x9b, 0x9c, 0x12, 0xbf, 0xe1, 0x72, 0x03, 0xd6, 0x74, 0xb2, 0xbe, 0xad,
+		0x96, 0x9f, 0xc8, 0xd5, 0x1d, 0xb7, 0xc3, 0x3b, 0x87, 0x3a, 0xbd, 0xb3, 0x02, 0x57, 0xf8, 0x31,
+		0x0d, 0x6d, 0x6d, 0x5d, 0x8c, 0xe0, 0x30, 0xf4, 0xea, 0xca, 0x07, 0x47, 0xad, 0xcb, 0x72, 0x29,
+		0x56, 0xa8, 0x15, 0x2f, 0xc8, 0x48, 0x9d, 0xa5, 0xaf, 0x7c, 0x1b, 0xf9, 0x6b, 0x01, 0x6e, 0x6a,
+		0x9d, 0x6e, 0x61, 0xdf, 0x21, 0xff, 0x0f, 0xa1, 0xed, 0x2a, 0x0c, 0x39, 0x38, 0xe2, 0x49, 0x50,
+		0x8b, 0x07, 0x68, 0x03, 0x66, 0xe2, 0xa7, 0xb0, 0x99, 0x08, 0x6a, 0x85, 0x0c, 0x2b, 0xb2, 0x2b,
+		0x6a, 0xb5, 0x29, 0x93, 0x52, 0xcf, 0x0a, 0xbc, 0xdd, 0x4d, 0x3b, 0xda, 0x64, 0xff, 0x59, 0x80,
+		0xe5, 0x7d, 0xc2, 0x6a, 0xd4, 0xc7, 0x82, 0xbc, 0xe9, 0x66, 0x7b, 0x0f, 0x46, 0x5c, 0x22, 0x30,
+		0xf5, 0x78, 0x69, 0xb0, 0x87, 0x90, 0x95, 0x10, 0xb7, 0x5d, 0xca, 0x50, 0xc7, 0xa5, 0x5c, 0x48,
+		0xbf, 0x6f, 0x81, 0x99, 0xa7, 0x34, 0xad, 0xdb, 0x3f, 0xcb, 0x52, 0x91, 0x70, 0x87, 0xd1, 0x83,
+		0x37, 0x45, 0xb5, 0xe6, 0x37, 0x03, 0xb0, 0x9c, 0x23, 0x93, 0xf6, 0x3a, 0x0f, 0x66, 0x9b, 0xea,
+		0x70, 0x02, 0xff, 0x90, 0x56, 0x75, 0x9e, 0xa6, 0x23, 0xec, 0x46, 0x6f, 0x12, 0x6c, 0xb5, 0x42,
+		0xad, 0x19, 0x92, 0x3a, 0x8f, 0x0e, 0x60, 0xf6, 0xfc, 0x51, 0x6d, 0xea, 0x1f, 0x06, 0xfa, 0xbc,
+		0xb7, 0x7a, 0xdb, 0x6d, 0xc7, 0x3f, 0x0c, 0x9a, 0xd9, 0x7e, 0xdb, 0x34, 0x7a, 0x01, 0x28, 0x24,
+		0xbe, 0x4b, 0xfd, 0xaa, 0x8d, 0x1d, 0x41, 0x4f, 0xa9, 0xa0, 0x84, 0x97, 0x06, 0x96, 0x06, 0x56,
+		0x8a, 0xeb, 0x2b, 0xe9, 0x56, 0x14, 0x93, 0x6f, 0xc6, 0xd4, 0x75, 0xc5, 0xfc, 0x72, 0xd8, 0x36,
+		0x49, 0x09, 0x47, 0x3f, 0x83, 0xa9, 0x84, 0xb1, 0x73, 0x44, 0x3d, 0x97, 0x11, 0xd9, 0x31, 0x90,
+		0x6c, 0x2b, 0x79, 0x6c, 0xb7, 0x24, 0x6d, 0xbb, 0xe4, 0x97, 0xc2, 0x96, 0x25, 0x46, 0x7c, 0xb4,
+		0xd7, 0x64, 0x9d, 0x44, 0x63, 0x5d, 0x3c, 0xe6, 0x4a, 0xfc, 0x58, 0xd3, 0xb6, 0x31, 0x4d, 0x26,
+		0xcd, 0xcf, 0x07, 0xe0, 0xea, 0x4f, 0x64, 0x7b, 0x27, 0x51, 0xdf, 0x6b, 0xf2, 0xf1, 0x07, 0x30,
+		0xa4, 0xba, 0x4c, 0x3a, 0xf9, 0x30, 0x73, 0x39, 0x29, 0x81, 0xad, 0x18, 0x80, 0x6c, 0x98, 0x51,
+		0x3f, 0x6c, 0x46, 0x5e, 0x12, 0x47, 0x48, 0xfb, 0x74, 0xa9, 0x12, 0x6a, 0x50, 0xd5, 0x86, 0xdf,
+		0x4f, 0x65, 0x15, 0xb3, 0x50, 0x88, 0xad, 0x04, 0x60, 0x5d, 0x3d, 0x49, 0x99, 0x95, 0xf6, 0x18,
+		0x6f, 0xe0, 0x04, 0x3e, 0xa7, 0x5c, 0x10, 0xdf, 0xa9, 0xdb, 0x1e, 0x39, 0x25, 0x5e, 0x69, 0x28,
+		0xa7, 0xfa, 0x54, 0x3b, 0x6c, 0x35, 0x21, 0xcf, 0x24, 0xc2, 0x9a, 0x3e, 0x49, 0x9b, 0x36, 0xff,
+		0x6e, 0xc0, 0x74, 0xc7, 0x35, 0x68, 0xdf, 0xfb, 0x10, 0xc6, 0x93, 0xe3, 0xf1, 0xc8, 0x4b, 0x52,
+		0x9a, 0x2e, 0xc9, 0x99, 0x3e, 0x87, 0x04, 0xa0, 0x1d, 0x98, 0x6c, 0xd5, 0x0f, 0x71, 0x4b, 0x85,
+		0x1c, 0x15, 0xb7, 0xe8, 0x85, 0xb8, 0xd6, 0xc4, 0x49, 0xeb, 0xd0, 0xfc, 0x8f, 0x01, 0xb3, 0x49,
+		0xb4, 0x68, 0xb4, 0x34, 0xba, 0xd8, 0x4b, 0x5b, 0x8f, 0xa4, 0xd0, 0x5f, 0x8f, 0xe4, 0x29, 0x4c,
+		0x36, 0xb0, 0xcd, 0x46, 0xcd, 0xe4, 0xfa, 0x72, 0x2e, 0x83, 0xb8, 0x51, 0x23, 0x5a, 0x46, 0x32,
+		0xc1, 0xa1, 0xbe, 0xe3, 0x45, 0x2e, 0xb1, 0x9b, 0x0c, 0xb9, 0xc0, 0x22, 0x8a, 0x9f, 0x8e, 0x51,
+		0x6b, 0x5a, 0xaf, 0x27, 0x4c, 0xf6, 0xd4, 0xa2, 0xf9, 0x5f, 0x03, 0x4a, 0xe7, 0x4f, 0xac, 0xaf,
+		0xe6, 0x3d, 0x18, 0x09, 0x03, 0xcf, 0x23, 0x8c, 0x97, 0x0c, 0xe5, 0xe2, 0x8b, 0xe9, 0xb7, 0xa2,
+		0x68, 0x94, 0xfb, 0x25, 0xf4, 0xe8, 0x39, 0x4c, 0x9d, 0x13, 0x24, 0x56, 0xce, 0x8d, 0xdc, 0xb3,
+		0xc5, 0x62, 0x59, 0x93, 0xa2, 0x6d, 0x8c, 0x5e, 0xc0, 0x54, 0x88, 0x99, 0xa0, 0x2d, 0x01, 0x5a,
+		0x3b, 0xd2, 0xed, 0x5c, 0x76, 0xbb, 0x09, 0x28, 0x8e, 0xc0, 0xd6, 0xa5, 0xb0, 0x7d, 0xc2, 0xbc,
+		0x0b, 0x73, 0x4f, 0x89, 0x48, 0xc8, 0xf9, 0xa3, 0xfa, 0x63, 0x75, 0xab, 0x5d, 0x2e, 0xdd, 0xfc,
+		0xe3, 0x20, 0x5c, 0x4f, 0xc7, 0x69, 0xd5, 0xfd, 0x12, 0x66, 0x1a, 0x19, 0x67, 0x53, 0x11, 0x35,
+		0x1c, 0x6a, 0x4d, 0xfe, 0x38, 0x55, 0xec, 0x3c, 0x96, 0x95, 0x24, 0xa4, 0x25, 0x14, 0xcf, 0x71,
+		0xf8, 0xc4, 0x17, 0xac, 0x6e, 0x5d, 0x71, 0xcf, 0xaf, 0x48, 0x01, 0x74, 0xe0, 0xaf, 0x77, 0x08,
+		0x50, 0xb8, 0xa8, 0x00, 0xc9, 0xd3, 0x70, 0x5e, 0x00, 0x7c, 0x7e, 0xa5, 0x1c, 0x49, 0xc3, 0x4a,
+		0x97, 0x18, 0x4d, 0xc1, 0xc0, 0x31, 0xa9, 0x6b, 0x9d, 0xca, 0x9f, 0x68, 0x0b, 0x86, 0x4e, 0xb1,
+		0x17, 0x11, 0x6d, 0x24, 0x77, 0x52, 0xa5, 0xcb, 0x32, 0x54, 0x2b, 0xc6, 0x3e, 0x2c, 0x3c, 0x30,
+		0xe4, 0xb6, 0x59, 0x72, 0x7e, 0x8b, 0xdb, 0x9a, 0x1c, 0xe6, 0x95, 0x33, 0x76, 0xda, 0x1d, 0xff,
+		0x16, 0xc3, 0x87, 0xf9, 0x45, 0x01, 0x16, 0xb2, 0x76, 0xd5, 0x76, 0x78, 0x02, 0xf3, 0x29, 0x66,
+		0xd0, 0xf0, 0x82, 0xc4, 0xb1, 0x2b, 0xbd, 0x79, 0xd1, 0x73, 0x22, 0xb0, 0x8b, 0x05, 0xb6, 0xca,
+		0x9d, 0x37, 0xde, 0xdc, 0x5a, 0x6e, 0x99, 0x62, 0xfa, 0x2d, 0x5b, 0x16, 0x2e, 0xb6, 0x65, 0xa7,
+		0x95, 0x37, 0xb7, 0x34, 0x67, 0x61, 0xfa, 0x29, 0x11, 0x5b, 0x5e, 0xc4, 0x85, 0x0e, 0x44, 0xba,
+		0xa4, 0xfd, 0xb5, 0x01, 0x33, 0x9d, 0x2b, 0x5a, 0x33, 0x47, 0x70, 0x8d, 0x47, 0x61, 0x18, 0x30,
+		0x41, 0x5c, 0xdb, 0xf1, 0xa8, 0x2c, 0x07, 0x4f, 0x09, 0xe3, 0x5a, 0x2b, 0xd9, 0xb1, 0x65, 0x2f,
+		0x41, 0x6d, 0x29, 0xd0, 0x27, 0x1a, 0x63, 0xcd, 0xf2, 0xf4, 0x05, 0xf3, 0x77, 0x03, 0x60, 0x3e,
+		0x4d, 0x29, 0xfa, 0x3e, 0x8a, 0xff, 0x98, 0x7a, 0x4d, 0x09, 0xc9, 0x1c, 0x8c, 0x85, 0xb8, 0x4a,
+		0x6c, 0x4e, 0x3f, 0x8b, 0x9f, 0x9d, 0x21, 0x6b, 0x54, 0x4e, 0xec, 0xd1, 0xcf, 0x08, 0x7a, 0x1b,
+		0x2e, 0xf9, 0xe4, 0x53, 0x79, 0x6b, 0x55, 0x62, 0x8b, 0xe0, 0x98, 0xf8, 0xba, 0xf1, 0x31, 0x21,
+		0xa7, 0x77, 0x71, 0x95, 0xec, 0xcb, 0x49, 0xf4, 0x0e, 0xa0, 0x33, 0x4c, 0x85, 0x7d, 0x18, 0x30,
+		0xdb, 0x27, 0x67, 0x71, 0x55, 0xad, 0xb2, 0x86, 0x51, 0xeb, 0x92, 0x5c, 0xd9, 0x0e, 0xd8, 0xc7,
+		0xe4, 0x4c, 0x95, 0xd3, 0xc8, 0x86, 0x6b, 0xfa, 0xbf, 0x38, 0x5d, 0x7d, 0x1f, 0x52, 0x4f, 0x76,
+		0x4c, 0xd5, 0xc3, 0x37, 0xac, 0x1e, 0xbe, 0xb7, 0x52, 0xcf, 0xa3, 0xe0, 0xdb, 0x8a, 0x58, 0xbd,
+		0x7d, 0x33, 0x9a, 0x4d, 0xc7, 0xbc, 0xec, 0x4e, 0xab, 0x72, 0x5c, 0x36, 0x83, 0xe9, 0x29, 0x8e,
+		0x1b, 0x5a, 0xa3, 0xd6, 0xb8, 0x9c, 0xdc, 0xd4, 0x73, 0xe6, 0xbf, 0x0d, 0xb8, 0x91, 0x7b, 0x1b,
+		0xda, 0x3e, 0xee, 0xc1, 0x88, 0xde, 0x26, 0x37, 0x25, 0x49, 0x60, 0x09, 0x31, 0xfa, 0x11, 0x14,
+		0x19, 0x3e, 0xb3, 0x13, 0x6c, 0x6c, 0xec, 0xe9, 0x2e, 0xfd, 0x18, 0x0b, 0xfc, 0xc8, 0x0b, 0x0e,
+		0x2c, 0x60, 0xf8, 0x4c, 0x33, 0x4a, 0x53, 0xfd, 0x40, 0x9a, 0xea, 0xcb, 0x30, 0x1a, 0x9f, 0x93,
+		0xb8, 0xfa, 0x89, 0x6f, 0x8c, 0xcd, 0x3a, 0x8c, 0x6f, 0x13, 0x2c, 0x22, 0x46, 0xb6, 0x3d, 0x5c,
+		0xe5, 0x88, 0xc2, 0x7a, 0x4a, 0xc5, 0x81, 0x3d, 0x46, 0xb0, 0x2b, 0xd3, 0xbe, 0x5a, 0xe8, 0x11,
+		0xe9, 0x06, 0x84, 0xb1, 0x80, 0xd9, 0xc4, 0xc7, 0x07, 0x1e, 0x89, 0x3b, 0x10, 0xa3, 0xd6, 0x9d,
+		0x73, 0xa6, 0xb3, 0x19, 0xe3, 0xb6, 0x12, 0xd8, 0x13, 0x89, 0x7a, 0x12, 0x83, 0xcc, 0xdf, 0x1b,
+		0x30, 0x67, 0x91, 0x43, 0x46, 0xf8, 0x51, 0xe3, 0x8f, 0x25, 0xcc, 0x8f, 0xf9, 0x6b, 0xaa, 0xff,
+		0x16, 0xe0, 0x7a, 0xba, 0x34, 0xf1, 0x2d, 0xaf, 0x7f, 0x79, 0x05, 0x8a, 0xc9, 0xca, 0xe6, 0xee,
+		0x0e, 0xfa, 0x8d, 0x01, 0xa5, 0xac, 0xc6, 0x33, 0x7a, 0x37, 0xe3, 0xcf, 0x92, 0xdc, 0x3f, 0x79,
+		0xcb, 0x77, 0xfb, 0x44, 0x69, 0xfb, 0xfb, 0x95, 0x01, 0x33, 0xe9, 0x4d, 0x38, 0x74, 0x81, 0x96,
+		0x69, 0x79, 0xa3, 0x2f, 0x8c, 0x96, 0xe1, 0x4f, 0x06, 0xcc, 0xe5, 0x34, 0x02, 0xd1, 0xfd, 0x3e,
+		0x98, 0xb6, 0xb6, 0x30, 0xcb, 0x0f, 0xfa, 0x07, 0x6a, 0x91, 0x3e, 0x37, 0x60, 0x36, 0xa3, 0x2b,
+		0x8d, 0x36, 0xf2, 0xfa, 0xa0, 0x59, 0x8a, 0x79, 0xb7, 0x3f, 0x90, 0x16, 0xe3, 0x6f, 0x06, 0x2c,
+		0x75, 0x6b, 0x96, 0xa2, 0x57, 0xea, 0xcb, 0x96, 0x7f, 0x78, 0x41, 0xb4, 0x96, 0xf0, 0x2b, 0x03,
+		0x6e, 0xf6, 0xd4, 0xce, 0x45, 0x9b, 0x17, 0xda, 0xa8, 0xed, 0x3e, 0x1f, 0xbd, 0x0a, 0x8b, 0x16,
+		0x83, 0x4f, 0xef, 0x8e, 0x66, 0x18, 0x7c, 0x6e, 0xeb, 0xb9, 0xbc, 0xd1, 0x17, 0x46, 0xcb, 0xf0,
+		0x17, 0x03, 0x16, 0x34, 0x83, 0x8c, 0x4e, 0x22, 0x7a, 0x98, 0xc1, 0xb7, 0x87, 0xe6, 0x6c, 0xf9,
+		0xfd, 0x0b, 0x61, 0xb5, 0x6c, 0x7f, 0x30, 0xa0, 0x9c, 0xdd, 0x85, 0x43, 0xf7, 0xd2, 0xf3, 0xa9,
+		0x6e, 0xbd, 0xce, 0xf2, 0xfd, 0xbe, 0x71, 0x5a, 0x9e, 0xdf, 0x1a, 0x70, 0x2d, 0xb3, 0xb5, 0x86,
+		0xee, 0xe6, 0xa6, 0xd2, 0x99, 0xd2, 0xdc, 0xeb, 0x17, 0xa6, 0x85, 0x39, 0x84, 0x89, 0xb6, 0xf6,
+		0x02, 0xca, 0xe9, 0x8a, 0x74, 0x74, 0x82, 0xca, 0xb7, 0x7a, 0x21, 0xd5, 0xfb, 0x04, 0x30, 0xd5,
+		0x59, 0x0e, 0xa0, 0xdb, 0x3d, 0x56, 0x0d, 0xf1, 0x6e, 0xfd, 0xd5, 0x18, 0xe8, 0x17, 0x70, 0x35,
+		0xad, 0x28, 0x43, 0x3f, 0xe8, 0xa3, 0x7e, 0x8b, 0x37, 0x5e, 0xeb, 0xbb, 0xe2, 0x53, 0x2e, 0x99,
+		0x5e, 0x60, 0x64, 0xb8, 0x64, 0x6e, 0x0d, 0x94, 0xe1, 0x92, 0x5d, 0x2a, 0x18, 0x0a, 0x93, 0xed,
+		0x19, 0x3c, 0xba, 0x95, 0x75, 0x90, 0xf3, 0x05, 0x40, 0xf9, 0x9d, 0x9e, 0x68, 0x5b, 0x9e, 0xbb,
+		0x9c, 0xd4, 0x30, 0xe3, 0xb9, 0xeb, 0x9e, 0xda, 0x97, 0x1f, 0xf4, 0x0f, 0x6c, 0x5e, 0x7f, 0x5a,
+		0xfe, 0x92, 0x71, 0xfd, 0x39, 0x89, 0x57, 0x79, 0xad, 0x0f, 0x44, 0xab, 0x87, 0x67, 0x7d, 0x4b,
+		0x95, 0xe5, 0xe1, 0x5d, 0xbe, 0x15, 0x2b, 0xdf, 0xeb, 0x17, 0x16, 0x0b, 0xf3, 0xc8, 0x85, 0x59,
+		0x27, 0xa8, 0xa5, 0x81, 0x1f, 0x5d, 0x4d, 0x50, 0x7b, 0xf1, 0xe7, 0x88, 0xbb, 0x2c, 0x10, 0xc1,
+		0xae, 0xf1, 0xf3, 0xb5, 0x2a, 0x15, 0x47, 0xd1, 0x41, 0xc5, 0x09, 0x6a, 0xab, 0xad, 0x5f, 0xec,
+		0xdd, 0xa1, 0xae, 0xb7, 0x5a, 0x0d, 0xe2, 0x2f, 0x0d, 0xf5, 0xe7, 0x7b, 0xef, 0xe3, 0x90, 0x9e,
+		0xae, 0x1d, 0x0c, 0xab, 0xb9, 0x8d, 0xff, 0x0d, 0x00, 0x9d, 0x72, 0xa3, 0x04, 0xee, 0x28, 0x00,
+		0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) WorkflowExtAPIYARPCClient {
+			return NewWorkflowExtAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
@@ -61,9 +61,11 @@ type StartWorkflowExecutionRequest struct {
 	LastCompletionResult     *v1.Payload                       `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
 	FirstDecisionTaskBackoff *types.Duration                   `protobuf:"bytes,9,opt,name=first_decision_task_backoff,json=firstDecisionTaskBackoff,proto3" json:"first_decision_task_backoff,omitempty"`
 	PartitionConfig          map[string]string                 `protobuf:"bytes,10,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral     struct{}                          `json:"-"`
-	XXX_unrecognized         []byte                            `json:"-"`
-	XXX_sizecache            int32                             `json:"-"`
+	// Set by frontend when the caller asked for eager execution and is polling the workflow task list.
+	RequestEagerExecution bool     `protobuf:"varint,11,opt,name=request_eager_execution,json=requestEagerExecution,proto3" json:"request_eager_execution,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()         { *m = StartWorkflowExecutionRequest{} }
@@ -169,11 +171,20 @@ func (m *StartWorkflowExecutionRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetRequestEagerExecution() bool {
+	if m != nil {
+		return m.RequestEagerExecution
+	}
+	return false
+}
+
 type StartWorkflowExecutionResponse struct {
-	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Decision task started inline for an eager start request.
+	DecisionTask         *v1.PollForDecisionTaskResponse `protobuf:"bytes,2,opt,name=decision_task,json=decisionTask,proto3" json:"decision_task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
 func (m *StartWorkflowExecutionResponse) Reset()         { *m = StartWorkflowExecutionResponse{} }
@@ -216,6 +227,13 @@ func (m *StartWorkflowExecutionResponse) GetRunId() string {
 	return ""
 }
 
+func (m *StartWorkflowExecutionResponse) GetDecisionTask() *v1.PollForDecisionTaskResponse {
+	if m != nil {
+		return m.DecisionTask
+	}
+	return nil
+}
+
 type SignalWorkflowExecutionRequest struct {
 	Request  *v1.SignalWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId string                             `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -4004,11 +4022,13 @@ func (m *DescribeQueueResponse) GetProcessingQueueStates() []string {
 }
 
 type GetReplicationMessagesRequest struct {
-	Tokens               []*v11.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	ClusterName          string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Tokens      []*v11.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	ClusterName string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// wait_for_new_tasks holds the request until new replication tasks are written to one of the shards
+	WaitForNewTasks      bool     `protobuf:"varint,3,opt,name=wait_for_new_tasks,json=waitForNewTasks,proto3" json:"wait_for_new_tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMessagesRequest{} }
@@ -4058,6 +4078,13 @@ func (m *GetReplicationMessagesRequest) GetClusterName() string {
 	return ""
 }
 
+func (m *GetReplicationMessagesRequest) GetWaitForNewTasks() bool {
+	if m != nil {
+		return m.WaitForNewTasks
+	}
+	return false
+}
+
 type GetReplicationMessagesResponse struct {
 	ShardMessages        map[int32]*v11.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
@@ -4411,25 +4438,28 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
-type CountDLQMessagesRequest struct {
-	ForceFetch           bool     `protobuf:"varint,1,opt,name=forceFetch,proto3" json:"forceFetch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RebuildWorkflowBranchRequest struct {
+	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DomainId             string                `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	BranchToken          []byte                `protobuf:"bytes,4,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *CountDLQMessagesRequest) Reset()         { *m = CountDLQMessagesRequest{} }
-func (m *CountDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*CountDLQMessagesRequest) ProtoMessage()    {}
-func (*CountDLQMessagesRequest) Descriptor() ([]byte, []int) {
+func (m *RebuildWorkflowBranchRequest) Reset()         { *m = RebuildWorkflowBranchRequest{} }
+func (m *RebuildWorkflowBranchRequest) String() string { return proto.CompactTextString(m) }
+func (*RebuildWorkflowBranchRequest) ProtoMessage()    {}
+func (*RebuildWorkflowBranchRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{70}
 }
-func (m *CountDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+func (m *RebuildWorkflowBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CountDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RebuildWorkflowBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CountDLQMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RebuildWorkflowBranchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4439,44 +4469,64 @@ func (m *CountDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *CountDLQMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountDLQMessagesRequest.Merge(m, src)
+func (m *RebuildWorkflowBranchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildWorkflowBranchRequest.Merge(m, src)
 }
-func (m *CountDLQMessagesRequest) XXX_Size() int {
+func (m *RebuildWorkflowBranchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CountDLQMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountDLQMessagesRequest.DiscardUnknown(m)
+func (m *RebuildWorkflowBranchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildWorkflowBranchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CountDLQMessagesRequest proto.InternalMessageInfo
+var xxx_messageInfo_RebuildWorkflowBranchRequest proto.InternalMessageInfo
 
-func (m *CountDLQMessagesRequest) GetForceFetch() bool {
+func (m *RebuildWorkflowBranchRequest) GetDomain() string {
 	if m != nil {
-		return m.ForceFetch
+		return m.Domain
 	}
-	return false
+	return ""
 }
 
-type CountDLQMessagesResponse struct {
-	Entries              []*v11.HistoryDLQCountEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *RebuildWorkflowBranchRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
 }
 
-func (m *CountDLQMessagesResponse) Reset()         { *m = CountDLQMessagesResponse{} }
-func (m *CountDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*CountDLQMessagesResponse) ProtoMessage()    {}
-func (*CountDLQMessagesResponse) Descriptor() ([]byte, []int) {
+func (m *RebuildWorkflowBranchRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *RebuildWorkflowBranchRequest) GetBranchToken() []byte {
+	if m != nil {
+		return m.BranchToken
+	}
+	return nil
+}
+
+type RebuildWorkflowBranchResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildWorkflowBranchResponse) Reset()         { *m = RebuildWorkflowBranchResponse{} }
+func (m *RebuildWorkflowBranchResponse) String() string { return proto.CompactTextString(m) }
+func (*RebuildWorkflowBranchResponse) ProtoMessage()    {}
+func (*RebuildWorkflowBranchResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{71}
 }
-func (m *CountDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *RebuildWorkflowBranchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CountDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RebuildWorkflowBranchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CountDLQMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RebuildWorkflowBranchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4486,49 +4536,48 @@ func (m *CountDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *CountDLQMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountDLQMessagesResponse.Merge(m, src)
+func (m *RebuildWorkflowBranchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildWorkflowBranchResponse.Merge(m, src)
 }
-func (m *CountDLQMessagesResponse) XXX_Size() int {
+func (m *RebuildWorkflowBranchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CountDLQMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountDLQMessagesResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CountDLQMessagesResponse proto.InternalMessageInfo
-
-func (m *CountDLQMessagesResponse) GetEntries() []*v11.HistoryDLQCountEntry {
-	if m != nil {
-		return m.Entries
-	}
-	return nil
-}
-
-type ReadDLQMessagesRequest struct {
-	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
-	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
-	XXX_unrecognized      []byte            `json:"-"`
-	XXX_sizecache         int32             `json:"-"`
-}
-
-func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{} }
-func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadDLQMessagesRequest) ProtoMessage()    {}
-func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
+func (m *RebuildWorkflowBranchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildWorkflowBranchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildWorkflowBranchResponse proto.InternalMessageInfo
+
+type UpdateActivityOptionsRequest struct {
+	Domain                 string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DomainId               string                `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution      *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ActivityId             string                `protobuf:"bytes,4,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	TaskList               *v1.TaskList          `protobuf:"bytes,5,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	ScheduleToStartTimeout *types.Duration       `protobuf:"bytes,6,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
+	ScheduleToCloseTimeout *types.Duration       `protobuf:"bytes,7,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3" json:"schedule_to_close_timeout,omitempty"`
+	StartToCloseTimeout    *types.Duration       `protobuf:"bytes,8,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3" json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout       *types.Duration       `protobuf:"bytes,9,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3" json:"heartbeat_timeout,omitempty"`
+	RetryPolicy            *v1.RetryPolicy       `protobuf:"bytes,10,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	ResetAttempts          bool                  `protobuf:"varint,11,opt,name=reset_attempts,json=resetAttempts,proto3" json:"reset_attempts,omitempty"`
+	Identity               string                `protobuf:"bytes,12,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
+	XXX_unrecognized       []byte                `json:"-"`
+	XXX_sizecache          int32                 `json:"-"`
+}
+
+func (m *UpdateActivityOptionsRequest) Reset()         { *m = UpdateActivityOptionsRequest{} }
+func (m *UpdateActivityOptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateActivityOptionsRequest) ProtoMessage()    {}
+func (*UpdateActivityOptionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{72}
 }
-func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateActivityOptionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReadDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateActivityOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReadDLQMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateActivityOptionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4538,153 +4587,121 @@ func (m *ReadDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ReadDLQMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadDLQMessagesRequest.Merge(m, src)
+func (m *UpdateActivityOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsRequest.Merge(m, src)
 }
-func (m *ReadDLQMessagesRequest) XXX_Size() int {
+func (m *UpdateActivityOptionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReadDLQMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadDLQMessagesRequest.DiscardUnknown(m)
+func (m *UpdateActivityOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadDLQMessagesRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateActivityOptionsRequest proto.InternalMessageInfo
 
-func (m *ReadDLQMessagesRequest) GetType() v11.DLQType {
+func (m *UpdateActivityOptionsRequest) GetDomain() string {
 	if m != nil {
-		return m.Type
+		return m.Domain
 	}
-	return v11.DLQType_DLQ_TYPE_INVALID
+	return ""
 }
 
-func (m *ReadDLQMessagesRequest) GetShardId() int32 {
+func (m *UpdateActivityOptionsRequest) GetDomainId() string {
 	if m != nil {
-		return m.ShardId
+		return m.DomainId
 	}
-	return 0
+	return ""
 }
 
-func (m *ReadDLQMessagesRequest) GetSourceCluster() string {
+func (m *UpdateActivityOptionsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
-		return m.SourceCluster
+		return m.WorkflowExecution
 	}
-	return ""
+	return nil
 }
 
-func (m *ReadDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+func (m *UpdateActivityOptionsRequest) GetActivityId() string {
 	if m != nil {
-		return m.InclusiveEndMessageId
+		return m.ActivityId
 	}
-	return nil
+	return ""
 }
 
-func (m *ReadDLQMessagesRequest) GetPageSize() int32 {
+func (m *UpdateActivityOptionsRequest) GetTaskList() *v1.TaskList {
 	if m != nil {
-		return m.PageSize
+		return m.TaskList
 	}
-	return 0
+	return nil
 }
 
-func (m *ReadDLQMessagesRequest) GetNextPageToken() []byte {
+func (m *UpdateActivityOptionsRequest) GetScheduleToStartTimeout() *types.Duration {
 	if m != nil {
-		return m.NextPageToken
+		return m.ScheduleToStartTimeout
 	}
 	return nil
 }
 
-type ReadDLQMessagesResponse struct {
-	Type                 v11.DLQType                `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
-	ReplicationTasks     []*v11.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	ReplicationTasksInfo []*v11.ReplicationTaskInfo `protobuf:"bytes,3,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
-	NextPageToken        []byte                     `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *UpdateActivityOptionsRequest) GetScheduleToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToCloseTimeout
+	}
+	return nil
 }
 
-func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse{} }
-func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadDLQMessagesResponse) ProtoMessage()    {}
-func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{73}
-}
-func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ReadDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ReadDLQMessagesResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *UpdateActivityOptionsRequest) GetStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
 	}
+	return nil
 }
-func (m *ReadDLQMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadDLQMessagesResponse.Merge(m, src)
-}
-func (m *ReadDLQMessagesResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *ReadDLQMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadDLQMessagesResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ReadDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *ReadDLQMessagesResponse) GetType() v11.DLQType {
+func (m *UpdateActivityOptionsRequest) GetHeartbeatTimeout() *types.Duration {
 	if m != nil {
-		return m.Type
+		return m.HeartbeatTimeout
 	}
-	return v11.DLQType_DLQ_TYPE_INVALID
+	return nil
 }
 
-func (m *ReadDLQMessagesResponse) GetReplicationTasks() []*v11.ReplicationTask {
+func (m *UpdateActivityOptionsRequest) GetRetryPolicy() *v1.RetryPolicy {
 	if m != nil {
-		return m.ReplicationTasks
+		return m.RetryPolicy
 	}
 	return nil
 }
 
-func (m *ReadDLQMessagesResponse) GetReplicationTasksInfo() []*v11.ReplicationTaskInfo {
+func (m *UpdateActivityOptionsRequest) GetResetAttempts() bool {
 	if m != nil {
-		return m.ReplicationTasksInfo
+		return m.ResetAttempts
 	}
-	return nil
+	return false
 }
 
-func (m *ReadDLQMessagesResponse) GetNextPageToken() []byte {
+func (m *UpdateActivityOptionsRequest) GetIdentity() string {
 	if m != nil {
-		return m.NextPageToken
+		return m.Identity
 	}
-	return nil
+	return ""
 }
 
-type PurgeDLQMessagesRequest struct {
-	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
-	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
-	XXX_unrecognized      []byte            `json:"-"`
-	XXX_sizecache         int32             `json:"-"`
+type UpdateActivityOptionsResponse struct {
+	Activity             *v1.PendingActivityInfo `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest{} }
-func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
-func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{74}
+func (m *UpdateActivityOptionsResponse) Reset()         { *m = UpdateActivityOptionsResponse{} }
+func (m *UpdateActivityOptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateActivityOptionsResponse) ProtoMessage()    {}
+func (*UpdateActivityOptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{73}
 }
-func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateActivityOptionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PurgeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateActivityOptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PurgeDLQMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateActivityOptionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4694,64 +4711,44 @@ func (m *PurgeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *PurgeDLQMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurgeDLQMessagesRequest.Merge(m, src)
+func (m *UpdateActivityOptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsResponse.Merge(m, src)
 }
-func (m *PurgeDLQMessagesRequest) XXX_Size() int {
+func (m *UpdateActivityOptionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PurgeDLQMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurgeDLQMessagesRequest.DiscardUnknown(m)
+func (m *UpdateActivityOptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurgeDLQMessagesRequest proto.InternalMessageInfo
-
-func (m *PurgeDLQMessagesRequest) GetType() v11.DLQType {
-	if m != nil {
-		return m.Type
-	}
-	return v11.DLQType_DLQ_TYPE_INVALID
-}
+var xxx_messageInfo_UpdateActivityOptionsResponse proto.InternalMessageInfo
 
-func (m *PurgeDLQMessagesRequest) GetShardId() int32 {
+func (m *UpdateActivityOptionsResponse) GetActivity() *v1.PendingActivityInfo {
 	if m != nil {
-		return m.ShardId
+		return m.Activity
 	}
-	return 0
+	return nil
 }
 
-func (m *PurgeDLQMessagesRequest) GetSourceCluster() string {
-	if m != nil {
-		return m.SourceCluster
-	}
-	return ""
-}
-
-func (m *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
-	if m != nil {
-		return m.InclusiveEndMessageId
-	}
-	return nil
-}
-
-type PurgeDLQMessagesResponse struct {
+type CountDLQMessagesRequest struct {
+	ForceFetch           bool     `protobuf:"varint,1,opt,name=forceFetch,proto3" json:"forceFetch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesResponse{} }
-func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
-func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{75}
+func (m *CountDLQMessagesRequest) Reset()         { *m = CountDLQMessagesRequest{} }
+func (m *CountDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*CountDLQMessagesRequest) ProtoMessage()    {}
+func (*CountDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{74}
 }
-func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *CountDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PurgeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CountDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PurgeDLQMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CountDLQMessagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4761,42 +4758,44 @@ func (m *PurgeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *PurgeDLQMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurgeDLQMessagesResponse.Merge(m, src)
+func (m *CountDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountDLQMessagesRequest.Merge(m, src)
 }
-func (m *PurgeDLQMessagesResponse) XXX_Size() int {
+func (m *CountDLQMessagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PurgeDLQMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurgeDLQMessagesResponse.DiscardUnknown(m)
+func (m *CountDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountDLQMessagesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurgeDLQMessagesResponse proto.InternalMessageInfo
+var xxx_messageInfo_CountDLQMessagesRequest proto.InternalMessageInfo
 
-type MergeDLQMessagesRequest struct {
-	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
-	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
-	XXX_unrecognized      []byte            `json:"-"`
-	XXX_sizecache         int32             `json:"-"`
+func (m *CountDLQMessagesRequest) GetForceFetch() bool {
+	if m != nil {
+		return m.ForceFetch
+	}
+	return false
 }
 
-func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest{} }
-func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*MergeDLQMessagesRequest) ProtoMessage()    {}
-func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{76}
+type CountDLQMessagesResponse struct {
+	Entries              []*v11.HistoryDLQCountEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
-func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *CountDLQMessagesResponse) Reset()         { *m = CountDLQMessagesResponse{} }
+func (m *CountDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*CountDLQMessagesResponse) ProtoMessage()    {}
+func (*CountDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{75}
+}
+func (m *CountDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MergeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CountDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MergeDLQMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CountDLQMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4806,79 +4805,45 @@ func (m *MergeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MergeDLQMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MergeDLQMessagesRequest.Merge(m, src)
+func (m *CountDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountDLQMessagesResponse.Merge(m, src)
 }
-func (m *MergeDLQMessagesRequest) XXX_Size() int {
+func (m *CountDLQMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MergeDLQMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MergeDLQMessagesRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MergeDLQMessagesRequest proto.InternalMessageInfo
-
-func (m *MergeDLQMessagesRequest) GetType() v11.DLQType {
-	if m != nil {
-		return m.Type
-	}
-	return v11.DLQType_DLQ_TYPE_INVALID
-}
-
-func (m *MergeDLQMessagesRequest) GetShardId() int32 {
-	if m != nil {
-		return m.ShardId
-	}
-	return 0
-}
-
-func (m *MergeDLQMessagesRequest) GetSourceCluster() string {
-	if m != nil {
-		return m.SourceCluster
-	}
-	return ""
-}
-
-func (m *MergeDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
-	if m != nil {
-		return m.InclusiveEndMessageId
-	}
-	return nil
+func (m *CountDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountDLQMessagesResponse.DiscardUnknown(m)
 }
 
-func (m *MergeDLQMessagesRequest) GetPageSize() int32 {
-	if m != nil {
-		return m.PageSize
-	}
-	return 0
-}
+var xxx_messageInfo_CountDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *MergeDLQMessagesRequest) GetNextPageToken() []byte {
+func (m *CountDLQMessagesResponse) GetEntries() []*v11.HistoryDLQCountEntry {
 	if m != nil {
-		return m.NextPageToken
+		return m.Entries
 	}
 	return nil
 }
 
-type MergeDLQMessagesResponse struct {
-	NextPageToken        []byte   `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type GetReplicationStatusRequest struct {
+	Clusters             []string `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	ForceFetch           bool     `protobuf:"varint,2,opt,name=force_fetch,json=forceFetch,proto3" json:"force_fetch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesResponse{} }
-func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*MergeDLQMessagesResponse) ProtoMessage()    {}
-func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{77}
+func (m *GetReplicationStatusRequest) Reset()         { *m = GetReplicationStatusRequest{} }
+func (m *GetReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationStatusRequest) ProtoMessage()    {}
+func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{76}
 }
-func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MergeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MergeDLQMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetReplicationStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4888,44 +4853,51 @@ func (m *MergeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MergeDLQMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MergeDLQMessagesResponse.Merge(m, src)
+func (m *GetReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusRequest.Merge(m, src)
 }
-func (m *MergeDLQMessagesResponse) XXX_Size() int {
+func (m *GetReplicationStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MergeDLQMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MergeDLQMessagesResponse.DiscardUnknown(m)
+func (m *GetReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MergeDLQMessagesResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetReplicationStatusRequest proto.InternalMessageInfo
 
-func (m *MergeDLQMessagesResponse) GetNextPageToken() []byte {
+func (m *GetReplicationStatusRequest) GetClusters() []string {
 	if m != nil {
-		return m.NextPageToken
+		return m.Clusters
 	}
 	return nil
 }
 
-type NotifyFailoverMarkersRequest struct {
-	FailoverMarkerTokens []*v11.FailoverMarkerToken `protobuf:"bytes,1,rep,name=failover_marker_tokens,json=failoverMarkerTokens,proto3" json:"failover_marker_tokens,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *GetReplicationStatusRequest) GetForceFetch() bool {
+	if m != nil {
+		return m.ForceFetch
+	}
+	return false
 }
 
-func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMarkersRequest{} }
-func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
-func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
-func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{78}
+type GetReplicationStatusResponse struct {
+	Shards               []*ReplicationShardStatus `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
-func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *GetReplicationStatusResponse) Reset()         { *m = GetReplicationStatusResponse{} }
+func (m *GetReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationStatusResponse) ProtoMessage()    {}
+func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{77}
+}
+func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NotifyFailoverMarkersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NotifyFailoverMarkersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetReplicationStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4935,43 +4907,51 @@ func (m *NotifyFailoverMarkersRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *NotifyFailoverMarkersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NotifyFailoverMarkersRequest.Merge(m, src)
+func (m *GetReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusResponse.Merge(m, src)
 }
-func (m *NotifyFailoverMarkersRequest) XXX_Size() int {
+func (m *GetReplicationStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *NotifyFailoverMarkersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NotifyFailoverMarkersRequest.DiscardUnknown(m)
+func (m *GetReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NotifyFailoverMarkersRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetReplicationStatusResponse proto.InternalMessageInfo
 
-func (m *NotifyFailoverMarkersRequest) GetFailoverMarkerTokens() []*v11.FailoverMarkerToken {
+func (m *GetReplicationStatusResponse) GetShards() []*ReplicationShardStatus {
 	if m != nil {
-		return m.FailoverMarkerTokens
+		return m.Shards
 	}
 	return nil
 }
 
-type NotifyFailoverMarkersResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ReplicationShardStatus struct {
+	ShardId              int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	RemoteCluster        string           `protobuf:"bytes,2,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
+	ReadLevel            int64            `protobuf:"varint,3,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
+	AckedLevel           int64            `protobuf:"varint,4,opt,name=acked_level,json=ackedLevel,proto3" json:"acked_level,omitempty"`
+	MaxReadLevel         int64            `protobuf:"varint,5,opt,name=max_read_level,json=maxReadLevel,proto3" json:"max_read_level,omitempty"`
+	LastReplicatedTime   *types.Timestamp `protobuf:"bytes,6,opt,name=last_replicated_time,json=lastReplicatedTime,proto3" json:"last_replicated_time,omitempty"`
+	DlqSize              int64            `protobuf:"varint,7,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	OldestPendingTaskAge *types.Duration  `protobuf:"bytes,8,opt,name=oldest_pending_task_age,json=oldestPendingTaskAge,proto3" json:"oldest_pending_task_age,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMarkersResponse{} }
-func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
-func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
-func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{79}
+func (m *ReplicationShardStatus) Reset()         { *m = ReplicationShardStatus{} }
+func (m *ReplicationShardStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationShardStatus) ProtoMessage()    {}
+func (*ReplicationShardStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{78}
 }
-func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
+func (m *ReplicationShardStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NotifyFailoverMarkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplicationShardStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NotifyFailoverMarkersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplicationShardStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4981,93 +4961,98 @@ func (m *NotifyFailoverMarkersResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *NotifyFailoverMarkersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NotifyFailoverMarkersResponse.Merge(m, src)
+func (m *ReplicationShardStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationShardStatus.Merge(m, src)
 }
-func (m *NotifyFailoverMarkersResponse) XXX_Size() int {
+func (m *ReplicationShardStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *NotifyFailoverMarkersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NotifyFailoverMarkersResponse.DiscardUnknown(m)
+func (m *ReplicationShardStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationShardStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NotifyFailoverMarkersResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReplicationShardStatus proto.InternalMessageInfo
 
-type GetCrossClusterTasksRequest struct {
-	ShardIds             []int32  `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
-	TargetCluster        string   `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReplicationShardStatus) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
 }
 
-func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTasksRequest{} }
-func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
-func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{80}
-}
-func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GetCrossClusterTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetCrossClusterTasksRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *ReplicationShardStatus) GetRemoteCluster() string {
+	if m != nil {
+		return m.RemoteCluster
 	}
-}
-func (m *GetCrossClusterTasksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCrossClusterTasksRequest.Merge(m, src)
-}
-func (m *GetCrossClusterTasksRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *GetCrossClusterTasksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCrossClusterTasksRequest.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_GetCrossClusterTasksRequest proto.InternalMessageInfo
+func (m *ReplicationShardStatus) GetReadLevel() int64 {
+	if m != nil {
+		return m.ReadLevel
+	}
+	return 0
+}
 
-func (m *GetCrossClusterTasksRequest) GetShardIds() []int32 {
+func (m *ReplicationShardStatus) GetAckedLevel() int64 {
 	if m != nil {
-		return m.ShardIds
+		return m.AckedLevel
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCrossClusterTasksRequest) GetTargetCluster() string {
+func (m *ReplicationShardStatus) GetMaxReadLevel() int64 {
 	if m != nil {
-		return m.TargetCluster
+		return m.MaxReadLevel
 	}
-	return ""
+	return 0
 }
 
-type GetCrossClusterTasksResponse struct {
-	TasksByShard         map[int32]*v11.CrossClusterTaskRequests `protobuf:"bytes,1,rep,name=tasks_by_shard,json=tasksByShard,proto3" json:"tasks_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	FailedCauseByShard   map[int32]v11.GetTaskFailedCause        `protobuf:"bytes,2,rep,name=failed_cause_by_shard,json=failedCauseByShard,proto3" json:"failed_cause_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=uber.cadence.admin.v1.GetTaskFailedCause"`
-	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
-	XXX_unrecognized     []byte                                  `json:"-"`
-	XXX_sizecache        int32                                   `json:"-"`
+func (m *ReplicationShardStatus) GetLastReplicatedTime() *types.Timestamp {
+	if m != nil {
+		return m.LastReplicatedTime
+	}
+	return nil
 }
 
-func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTasksResponse{} }
-func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
-func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{81}
+func (m *ReplicationShardStatus) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
+	}
+	return 0
 }
-func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *ReplicationShardStatus) GetOldestPendingTaskAge() *types.Duration {
+	if m != nil {
+		return m.OldestPendingTaskAge
+	}
+	return nil
+}
+
+type ReadDLQMessagesRequest struct {
+	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{} }
+func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadDLQMessagesRequest) ProtoMessage()    {}
+func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{79}
+}
+func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCrossClusterTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReadDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCrossClusterTasksResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReadDLQMessagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5077,56 +5062,82 @@ func (m *GetCrossClusterTasksResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetCrossClusterTasksResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCrossClusterTasksResponse.Merge(m, src)
+func (m *ReadDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDLQMessagesRequest.Merge(m, src)
 }
-func (m *GetCrossClusterTasksResponse) XXX_Size() int {
+func (m *ReadDLQMessagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCrossClusterTasksResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCrossClusterTasksResponse.DiscardUnknown(m)
+func (m *ReadDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDLQMessagesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCrossClusterTasksResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReadDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *GetCrossClusterTasksResponse) GetTasksByShard() map[int32]*v11.CrossClusterTaskRequests {
+func (m *ReadDLQMessagesRequest) GetType() v11.DLQType {
 	if m != nil {
-		return m.TasksByShard
+		return m.Type
 	}
-	return nil
+	return v11.DLQType_DLQ_TYPE_INVALID
 }
 
-func (m *GetCrossClusterTasksResponse) GetFailedCauseByShard() map[int32]v11.GetTaskFailedCause {
+func (m *ReadDLQMessagesRequest) GetShardId() int32 {
 	if m != nil {
-		return m.FailedCauseByShard
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ReadDLQMessagesRequest) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
+	}
+	return ""
+}
+
+func (m *ReadDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+	if m != nil {
+		return m.InclusiveEndMessageId
 	}
 	return nil
 }
 
-type RespondCrossClusterTasksCompletedRequest struct {
-	ShardId              int32                           `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	TargetCluster        string                          `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
-	TaskResponses        []*v11.CrossClusterTaskResponse `protobuf:"bytes,3,rep,name=task_responses,json=taskResponses,proto3" json:"task_responses,omitempty"`
-	FetchNewTasks        bool                            `protobuf:"varint,4,opt,name=fetchNewTasks,proto3" json:"fetchNewTasks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+func (m *ReadDLQMessagesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
-	*m = RespondCrossClusterTasksCompletedRequest{}
+func (m *ReadDLQMessagesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
 }
-func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
-func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
-func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{82}
+
+type ReadDLQMessagesResponse struct {
+	Type                 v11.DLQType                `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	ReplicationTasks     []*v11.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	ReplicationTasksInfo []*v11.ReplicationTaskInfo `protobuf:"bytes,3,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
+	NextPageToken        []byte                     `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse{} }
+func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadDLQMessagesResponse) ProtoMessage()    {}
+func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{80}
+}
+func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReadDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReadDLQMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5136,69 +5147,68 @@ func (m *RespondCrossClusterTasksCompletedRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Merge(m, src)
+func (m *ReadDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDLQMessagesResponse.Merge(m, src)
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Size() int {
+func (m *ReadDLQMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.DiscardUnknown(m)
+func (m *ReadDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDLQMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondCrossClusterTasksCompletedRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReadDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetShardId() int32 {
+func (m *ReadDLQMessagesResponse) GetType() v11.DLQType {
 	if m != nil {
-		return m.ShardId
+		return m.Type
 	}
-	return 0
+	return v11.DLQType_DLQ_TYPE_INVALID
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetTargetCluster() string {
+func (m *ReadDLQMessagesResponse) GetReplicationTasks() []*v11.ReplicationTask {
 	if m != nil {
-		return m.TargetCluster
+		return m.ReplicationTasks
 	}
-	return ""
+	return nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetTaskResponses() []*v11.CrossClusterTaskResponse {
+func (m *ReadDLQMessagesResponse) GetReplicationTasksInfo() []*v11.ReplicationTaskInfo {
 	if m != nil {
-		return m.TaskResponses
+		return m.ReplicationTasksInfo
 	}
 	return nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetFetchNewTasks() bool {
+func (m *ReadDLQMessagesResponse) GetNextPageToken() []byte {
 	if m != nil {
-		return m.FetchNewTasks
+		return m.NextPageToken
 	}
-	return false
+	return nil
 }
 
-type RespondCrossClusterTasksCompletedResponse struct {
-	Tasks                *v11.CrossClusterTaskRequests `protobuf:"bytes,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+type PurgeDLQMessagesRequest struct {
+	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) Reset() {
-	*m = RespondCrossClusterTasksCompletedResponse{}
-}
-func (m *RespondCrossClusterTasksCompletedResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
-func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{83}
+func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest{} }
+func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
+func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{81}
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
+func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PurgeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PurgeDLQMessagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5208,44 +5218,64 @@ func (m *RespondCrossClusterTasksCompletedResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Merge(m, src)
+func (m *PurgeDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeDLQMessagesRequest.Merge(m, src)
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Size() int {
+func (m *PurgeDLQMessagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.DiscardUnknown(m)
+func (m *PurgeDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeDLQMessagesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondCrossClusterTasksCompletedResponse proto.InternalMessageInfo
+var xxx_messageInfo_PurgeDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *RespondCrossClusterTasksCompletedResponse) GetTasks() *v11.CrossClusterTaskRequests {
+func (m *PurgeDLQMessagesRequest) GetType() v11.DLQType {
 	if m != nil {
-		return m.Tasks
+		return m.Type
+	}
+	return v11.DLQType_DLQ_TYPE_INVALID
+}
+
+func (m *PurgeDLQMessagesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *PurgeDLQMessagesRequest) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
+	}
+	return ""
+}
+
+func (m *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+	if m != nil {
+		return m.InclusiveEndMessageId
 	}
 	return nil
 }
 
-type GetFailoverInfoRequest struct {
-	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+type PurgeDLQMessagesResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{} }
-func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFailoverInfoRequest) ProtoMessage()    {}
-func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{84}
+func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesResponse{} }
+func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
+func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{82}
 }
-func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFailoverInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PurgeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFailoverInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PurgeDLQMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5255,45 +5285,124 @@ func (m *GetFailoverInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetFailoverInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFailoverInfoRequest.Merge(m, src)
+func (m *PurgeDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeDLQMessagesResponse.Merge(m, src)
 }
-func (m *GetFailoverInfoRequest) XXX_Size() int {
+func (m *PurgeDLQMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFailoverInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFailoverInfoRequest.DiscardUnknown(m)
+func (m *PurgeDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeDLQMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFailoverInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_PurgeDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *GetFailoverInfoRequest) GetDomainId() string {
+type MergeDLQMessagesRequest struct {
+	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest{} }
+func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeDLQMessagesRequest) ProtoMessage()    {}
+func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{83}
+}
+func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeDLQMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeDLQMessagesRequest.Merge(m, src)
+}
+func (m *MergeDLQMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeDLQMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeDLQMessagesRequest proto.InternalMessageInfo
+
+func (m *MergeDLQMessagesRequest) GetType() v11.DLQType {
 	if m != nil {
-		return m.DomainId
+		return m.Type
+	}
+	return v11.DLQType_DLQ_TYPE_INVALID
+}
+
+func (m *MergeDLQMessagesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *MergeDLQMessagesRequest) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
 	}
 	return ""
 }
 
-type GetFailoverInfoResponse struct {
-	CompletedShardCount  int32    `protobuf:"varint,1,opt,name=completed_shard_count,json=completedShardCount,proto3" json:"completed_shard_count,omitempty"`
-	PendingShards        []int32  `protobuf:"varint,2,rep,packed,name=pending_shards,json=pendingShards,proto3" json:"pending_shards,omitempty"`
+func (m *MergeDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+	if m != nil {
+		return m.InclusiveEndMessageId
+	}
+	return nil
+}
+
+func (m *MergeDLQMessagesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *MergeDLQMessagesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type MergeDLQMessagesResponse struct {
+	NextPageToken        []byte   `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse{} }
-func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetFailoverInfoResponse) ProtoMessage()    {}
-func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{85}
+func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesResponse{} }
+func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeDLQMessagesResponse) ProtoMessage()    {}
+func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{84}
 }
-func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFailoverInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MergeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFailoverInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MergeDLQMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5303,59 +5412,90 @@ func (m *GetFailoverInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetFailoverInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFailoverInfoResponse.Merge(m, src)
+func (m *MergeDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeDLQMessagesResponse.Merge(m, src)
 }
-func (m *GetFailoverInfoResponse) XXX_Size() int {
+func (m *MergeDLQMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFailoverInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFailoverInfoResponse.DiscardUnknown(m)
+func (m *MergeDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeDLQMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFailoverInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_MergeDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *GetFailoverInfoResponse) GetCompletedShardCount() int32 {
+func (m *MergeDLQMessagesResponse) GetNextPageToken() []byte {
 	if m != nil {
-		return m.CompletedShardCount
+		return m.NextPageToken
 	}
-	return 0
+	return nil
 }
 
-func (m *GetFailoverInfoResponse) GetPendingShards() []int32 {
+type NotifyFailoverMarkersRequest struct {
+	FailoverMarkerTokens []*v11.FailoverMarkerToken `protobuf:"bytes,1,rep,name=failover_marker_tokens,json=failoverMarkerTokens,proto3" json:"failover_marker_tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMarkersRequest{} }
+func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
+func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
+func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{85}
+}
+func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotifyFailoverMarkersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotifyFailoverMarkersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotifyFailoverMarkersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifyFailoverMarkersRequest.Merge(m, src)
+}
+func (m *NotifyFailoverMarkersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotifyFailoverMarkersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifyFailoverMarkersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotifyFailoverMarkersRequest proto.InternalMessageInfo
+
+func (m *NotifyFailoverMarkersRequest) GetFailoverMarkerTokens() []*v11.FailoverMarkerToken {
 	if m != nil {
-		return m.PendingShards
+		return m.FailoverMarkerTokens
 	}
 	return nil
 }
 
-type RatelimitUpdateRequest struct {
-	// impl-specific data.
-	// likely some simple top-level keys and then either:
-	// - map<ratelimit-key-string, something>
-	// - list<something>
-	//
-	// this is a single blob rather than a collection to save on
-	// repeated serialization of the type name, and to allow impls
-	// to choose whatever structures are most-convenient for them.
-	Data                 *v12.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+type NotifyFailoverMarkersResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RatelimitUpdateRequest) Reset()         { *m = RatelimitUpdateRequest{} }
-func (m *RatelimitUpdateRequest) String() string { return proto.CompactTextString(m) }
-func (*RatelimitUpdateRequest) ProtoMessage()    {}
-func (*RatelimitUpdateRequest) Descriptor() ([]byte, []int) {
+func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMarkersResponse{} }
+func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
+func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
+func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{86}
 }
-func (m *RatelimitUpdateRequest) XXX_Unmarshal(b []byte) error {
+func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RatelimitUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NotifyFailoverMarkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RatelimitUpdateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NotifyFailoverMarkersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5365,53 +5505,38 @@ func (m *RatelimitUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *RatelimitUpdateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RatelimitUpdateRequest.Merge(m, src)
+func (m *NotifyFailoverMarkersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifyFailoverMarkersResponse.Merge(m, src)
 }
-func (m *RatelimitUpdateRequest) XXX_Size() int {
+func (m *NotifyFailoverMarkersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RatelimitUpdateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RatelimitUpdateRequest.DiscardUnknown(m)
+func (m *NotifyFailoverMarkersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifyFailoverMarkersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RatelimitUpdateRequest proto.InternalMessageInfo
-
-func (m *RatelimitUpdateRequest) GetData() *v12.Any {
-	if m != nil {
-		return m.Data
-	}
-	return nil
-}
+var xxx_messageInfo_NotifyFailoverMarkersResponse proto.InternalMessageInfo
 
-type RatelimitUpdateResponse struct {
-	// impl-specific data.
-	//
-	// likely some simple top-level keys and then either:
-	// - map<ratelimit-key-string, something>
-	// - list<something>
-	//
-	// this is a single blob rather than a collection to save on
-	// repeated serialization of the type name, and to allow impls
-	// to choose whatever structures are most-convenient for them.
-	Data                 *v12.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+type GetCrossClusterTasksRequest struct {
+	ShardIds             []int32  `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	TargetCluster        string   `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RatelimitUpdateResponse) Reset()         { *m = RatelimitUpdateResponse{} }
-func (m *RatelimitUpdateResponse) String() string { return proto.CompactTextString(m) }
-func (*RatelimitUpdateResponse) ProtoMessage()    {}
-func (*RatelimitUpdateResponse) Descriptor() ([]byte, []int) {
+func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTasksRequest{} }
+func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
+func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{87}
 }
-func (m *RatelimitUpdateResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RatelimitUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCrossClusterTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RatelimitUpdateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCrossClusterTasksRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5421,902 +5546,897 @@ func (m *RatelimitUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *RatelimitUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RatelimitUpdateResponse.Merge(m, src)
+func (m *GetCrossClusterTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCrossClusterTasksRequest.Merge(m, src)
 }
-func (m *RatelimitUpdateResponse) XXX_Size() int {
+func (m *GetCrossClusterTasksRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RatelimitUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RatelimitUpdateResponse.DiscardUnknown(m)
+func (m *GetCrossClusterTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCrossClusterTasksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RatelimitUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetCrossClusterTasksRequest proto.InternalMessageInfo
 
-func (m *RatelimitUpdateResponse) GetData() *v12.Any {
+func (m *GetCrossClusterTasksRequest) GetShardIds() []int32 {
 	if m != nil {
-		return m.Data
+		return m.ShardIds
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
-	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest.PartitionConfigEntry")
-	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.StartWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest")
-	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest.PartitionConfigEntry")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionResponse")
-	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionRequest")
-	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
-	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
-	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.history.v1.QueryWorkflowResponse")
-	proto.RegisterType((*ResetStickyTaskListRequest)(nil), "uber.cadence.history.v1.ResetStickyTaskListRequest")
-	proto.RegisterType((*ResetStickyTaskListResponse)(nil), "uber.cadence.history.v1.ResetStickyTaskListResponse")
-	proto.RegisterType((*GetMutableStateRequest)(nil), "uber.cadence.history.v1.GetMutableStateRequest")
-	proto.RegisterType((*GetMutableStateResponse)(nil), "uber.cadence.history.v1.GetMutableStateResponse")
-	proto.RegisterType((*PollMutableStateRequest)(nil), "uber.cadence.history.v1.PollMutableStateRequest")
-	proto.RegisterType((*PollMutableStateResponse)(nil), "uber.cadence.history.v1.PollMutableStateResponse")
-	proto.RegisterType((*RecordDecisionTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedRequest")
-	proto.RegisterType((*RecordDecisionTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse")
-	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse.QueriesEntry")
-	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedRequest")
-	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedResponse")
-	proto.RegisterType((*RespondDecisionTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedRequest")
-	proto.RegisterType((*RespondDecisionTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse")
-	proto.RegisterMapType((map[string]*v1.ActivityLocalDispatchInfo)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse.ActivitiesToDispatchLocallyEntry")
-	proto.RegisterType((*RespondDecisionTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedRequest")
-	proto.RegisterType((*RespondDecisionTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedResponse")
-	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatRequest")
-	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatResponse")
-	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedRequest")
-	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedResponse")
-	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedRequest")
-	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedResponse")
-	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledRequest")
-	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledResponse")
-	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateRequest")
-	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateResponse")
-	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionRequest")
-	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionResponse")
-	proto.RegisterType((*ScheduleDecisionTaskRequest)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskRequest")
-	proto.RegisterType((*ScheduleDecisionTaskResponse)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskResponse")
-	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedRequest")
-	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedResponse")
-	proto.RegisterType((*ReplicateEventsV2Request)(nil), "uber.cadence.history.v1.ReplicateEventsV2Request")
-	proto.RegisterType((*ReplicateEventsV2Response)(nil), "uber.cadence.history.v1.ReplicateEventsV2Response")
-	proto.RegisterType((*SyncShardStatusRequest)(nil), "uber.cadence.history.v1.SyncShardStatusRequest")
-	proto.RegisterType((*SyncShardStatusResponse)(nil), "uber.cadence.history.v1.SyncShardStatusResponse")
-	proto.RegisterType((*SyncActivityRequest)(nil), "uber.cadence.history.v1.SyncActivityRequest")
-	proto.RegisterType((*SyncActivityResponse)(nil), "uber.cadence.history.v1.SyncActivityResponse")
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "uber.cadence.history.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "uber.cadence.history.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "uber.cadence.history.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "uber.cadence.history.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "uber.cadence.history.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "uber.cadence.history.v1.CloseShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "uber.cadence.history.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "uber.cadence.history.v1.RemoveTaskResponse")
-	proto.RegisterType((*ResetQueueRequest)(nil), "uber.cadence.history.v1.ResetQueueRequest")
-	proto.RegisterType((*ResetQueueResponse)(nil), "uber.cadence.history.v1.ResetQueueResponse")
-	proto.RegisterType((*DescribeQueueRequest)(nil), "uber.cadence.history.v1.DescribeQueueRequest")
-	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.history.v1.DescribeQueueResponse")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v11.ReplicationMessages)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "uber.cadence.history.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.history.v1.ReapplyEventsResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksResponse")
-	proto.RegisterType((*CountDLQMessagesRequest)(nil), "uber.cadence.history.v1.CountDLQMessagesRequest")
-	proto.RegisterType((*CountDLQMessagesResponse)(nil), "uber.cadence.history.v1.CountDLQMessagesResponse")
-	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.history.v1.ReadDLQMessagesRequest")
-	proto.RegisterType((*ReadDLQMessagesResponse)(nil), "uber.cadence.history.v1.ReadDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "uber.cadence.history.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "uber.cadence.history.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "uber.cadence.history.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "uber.cadence.history.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*NotifyFailoverMarkersRequest)(nil), "uber.cadence.history.v1.NotifyFailoverMarkersRequest")
-	proto.RegisterType((*NotifyFailoverMarkersResponse)(nil), "uber.cadence.history.v1.NotifyFailoverMarkersResponse")
-	proto.RegisterType((*GetCrossClusterTasksRequest)(nil), "uber.cadence.history.v1.GetCrossClusterTasksRequest")
-	proto.RegisterType((*GetCrossClusterTasksResponse)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse")
-	proto.RegisterMapType((map[int32]v11.GetTaskFailedCause)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.FailedCauseByShardEntry")
-	proto.RegisterMapType((map[int32]*v11.CrossClusterTaskRequests)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.TasksByShardEntry")
-	proto.RegisterType((*RespondCrossClusterTasksCompletedRequest)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedRequest")
-	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedResponse")
-	proto.RegisterType((*GetFailoverInfoRequest)(nil), "uber.cadence.history.v1.GetFailoverInfoRequest")
-	proto.RegisterType((*GetFailoverInfoResponse)(nil), "uber.cadence.history.v1.GetFailoverInfoResponse")
-	proto.RegisterType((*RatelimitUpdateRequest)(nil), "uber.cadence.history.v1.RatelimitUpdateRequest")
-	proto.RegisterType((*RatelimitUpdateResponse)(nil), "uber.cadence.history.v1.RatelimitUpdateResponse")
+func (m *GetCrossClusterTasksRequest) GetTargetCluster() string {
+	if m != nil {
+		return m.TargetCluster
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("uber/cadence/history/v1/service.proto", fileDescriptor_fee8ff76963a38ed)
+type GetCrossClusterTasksResponse struct {
+	TasksByShard         map[int32]*v11.CrossClusterTaskRequests `protobuf:"bytes,1,rep,name=tasks_by_shard,json=tasksByShard,proto3" json:"tasks_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FailedCauseByShard   map[int32]v11.GetTaskFailedCause        `protobuf:"bytes,2,rep,name=failed_cause_by_shard,json=failedCauseByShard,proto3" json:"failed_cause_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=uber.cadence.admin.v1.GetTaskFailedCause"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
 }
 
-var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4981 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4d, 0x6c, 0x1c, 0x47,
-	0x76, 0x30, 0x7a, 0x46, 0xfc, 0x7b, 0x24, 0x87, 0x64, 0x89, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0x6d,
-	0xcb, 0xa2, 0xe5, 0xf5, 0xd0, 0xa2, 0x6c, 0x49, 0x96, 0xe5, 0xd5, 0x4a, 0xa4, 0x24, 0x8f, 0x3f,
-	0xfd, 0x36, 0x69, 0xf9, 0xcb, 0x9f, 0x7b, 0x9b, 0xd3, 0x35, 0x64, 0x47, 0x3d, 0xdd, 0xa3, 0xee,
-	0x1e, 0x52, 0xf4, 0x21, 0x70, 0xe2, 0x20, 0x40, 0x16, 0x41, 0x76, 0xb3, 0x48, 0x82, 0x00, 0x01,
-	0x02, 0x04, 0x1b, 0x60, 0xb1, 0x46, 0x6e, 0x09, 0x90, 0x43, 0x92, 0x53, 0x2e, 0x7b, 0xdc, 0x6b,
-	0x6e, 0x81, 0xb1, 0x7b, 0x48, 0x80, 0xdc, 0xf6, 0x1c, 0x04, 0xf5, 0xd3, 0x3d, 0xfd, 0x53, 0x5d,
-	0x3d, 0x43, 0x06, 0x91, 0xd7, 0xf1, 0x6d, 0xba, 0xaa, 0xde, 0xab, 0x57, 0xaf, 0xde, 0x7b, 0xfd,
-	0xfe, 0x7a, 0xe0, 0x7c, 0x77, 0x17, 0x7b, 0xeb, 0x4d, 0xc3, 0xc4, 0x4e, 0x13, 0xaf, 0xef, 0x5b,
-	0x7e, 0xe0, 0x7a, 0x47, 0xeb, 0x07, 0x97, 0xd6, 0x7d, 0xec, 0x1d, 0x58, 0x4d, 0x5c, 0xef, 0x78,
-	0x6e, 0xe0, 0xa2, 0x05, 0xb2, 0xac, 0xce, 0x97, 0xd5, 0xf9, 0xb2, 0xfa, 0xc1, 0xa5, 0xda, 0xf2,
-	0x9e, 0xeb, 0xee, 0xd9, 0x78, 0x9d, 0x2e, 0xdb, 0xed, 0xb6, 0xd6, 0xcd, 0xae, 0x67, 0x04, 0x96,
-	0xeb, 0x30, 0xc0, 0xda, 0xb9, 0xf4, 0x7c, 0x60, 0xb5, 0xb1, 0x1f, 0x18, 0xed, 0x0e, 0x5f, 0x90,
-	0x41, 0x70, 0xe8, 0x19, 0x9d, 0x0e, 0xf6, 0x7c, 0x3e, 0xbf, 0x92, 0x20, 0xd0, 0xe8, 0x58, 0x84,
-	0xb8, 0xa6, 0xdb, 0x6e, 0x47, 0x5b, 0xac, 0x8a, 0x56, 0x84, 0x24, 0x72, 0x2a, 0x44, 0x4b, 0x9e,
-	0x77, 0x71, 0xb4, 0x40, 0x15, 0x2d, 0x08, 0x0c, 0xff, 0x99, 0x6d, 0xf9, 0x81, 0x6c, 0xcd, 0xa1,
-	0xeb, 0x3d, 0x6b, 0xd9, 0xee, 0x21, 0x5f, 0x73, 0x51, 0xb4, 0x86, 0xb3, 0x52, 0x4f, 0xad, 0x5d,
-	0x2b, 0x5a, 0x8b, 0x3d, 0xbe, 0xf2, 0x95, 0xe4, 0x4a, 0xb3, 0x6d, 0x39, 0x94, 0x0b, 0x76, 0xd7,
-	0x0f, 0x8a, 0x16, 0x25, 0x19, 0xb1, 0x2a, 0x5e, 0xf4, 0xbc, 0x8b, 0xbb, 0xfc, 0xaa, 0x6b, 0x17,
-	0xc4, 0x4b, 0x3c, 0xdc, 0xb1, 0xad, 0x66, 0xfc, 0x6a, 0x93, 0x37, 0xe3, 0xef, 0x1b, 0x1e, 0x36,
-	0xc9, 0x4a, 0xc3, 0x09, 0x77, 0x7b, 0x35, 0x67, 0x45, 0x92, 0xa6, 0xf3, 0x39, 0xab, 0x92, 0xec,
-	0x52, 0x7f, 0x3e, 0x0c, 0x67, 0xb7, 0x03, 0xc3, 0x0b, 0x3e, 0xe6, 0xe3, 0x77, 0x5e, 0xe0, 0x66,
-	0x97, 0xd0, 0xa3, 0xe1, 0xe7, 0x5d, 0xec, 0x07, 0xe8, 0x3e, 0x8c, 0x78, 0xec, 0x67, 0x55, 0x59,
-	0x51, 0xd6, 0xc6, 0x37, 0x36, 0xea, 0x09, 0xb1, 0x35, 0x3a, 0x56, 0xfd, 0xe0, 0x52, 0x5d, 0x8a,
-	0x44, 0x0b, 0x51, 0xa0, 0x25, 0x18, 0x33, 0xdd, 0xb6, 0x61, 0x39, 0xba, 0x65, 0x56, 0x4b, 0x2b,
-	0xca, 0xda, 0x98, 0x36, 0xca, 0x06, 0x1a, 0x26, 0xfa, 0x4d, 0x98, 0xeb, 0x18, 0x1e, 0x76, 0x02,
-	0x1d, 0x87, 0x08, 0x74, 0xcb, 0x69, 0xb9, 0xd5, 0x32, 0xdd, 0x78, 0x4d, 0xb8, 0xf1, 0x63, 0x0a,
-	0x11, 0xed, 0xd8, 0x70, 0x5a, 0xae, 0x76, 0xba, 0x93, 0x1d, 0x44, 0x55, 0x18, 0x31, 0x82, 0x00,
-	0xb7, 0x3b, 0x41, 0xf5, 0xd4, 0x8a, 0xb2, 0x36, 0xa4, 0x85, 0x8f, 0x68, 0x13, 0xa6, 0xf0, 0x8b,
-	0x8e, 0xc5, 0x54, 0x4c, 0x27, 0xba, 0x54, 0x1d, 0xa2, 0x3b, 0xd6, 0xea, 0x4c, 0x8f, 0xea, 0xa1,
-	0x1e, 0xd5, 0x77, 0x42, 0x45, 0xd3, 0x2a, 0x3d, 0x10, 0x32, 0x88, 0x5a, 0xb0, 0xd8, 0x74, 0x9d,
-	0xc0, 0x72, 0xba, 0x58, 0x37, 0x7c, 0xdd, 0xc1, 0x87, 0xba, 0xe5, 0x58, 0x81, 0x65, 0x04, 0xae,
-	0x57, 0x1d, 0x5e, 0x51, 0xd6, 0x2a, 0x1b, 0x6f, 0x08, 0x0f, 0xb0, 0xc9, 0xa1, 0x6e, 0xf9, 0x0f,
-	0xf1, 0x61, 0x23, 0x04, 0xd1, 0xe6, 0x9b, 0xc2, 0x71, 0xd4, 0x80, 0x99, 0x70, 0xc6, 0xd4, 0x5b,
-	0x86, 0x65, 0x77, 0x3d, 0x5c, 0x1d, 0xa1, 0xe4, 0x9e, 0x11, 0xe2, 0xbf, 0xcb, 0xd6, 0x68, 0xd3,
-	0x11, 0x18, 0x1f, 0x41, 0x1a, 0xcc, 0xdb, 0x86, 0x1f, 0xe8, 0x4d, 0xb7, 0xdd, 0xb1, 0x31, 0x3d,
-	0xbc, 0x87, 0xfd, 0xae, 0x1d, 0x54, 0x47, 0x25, 0xf8, 0x1e, 0x1b, 0x47, 0xb6, 0x6b, 0x98, 0xda,
-	0x2c, 0x81, 0xdd, 0x8c, 0x40, 0x35, 0x0a, 0x89, 0xfe, 0x3f, 0x2c, 0xb5, 0x2c, 0xcf, 0x0f, 0x74,
-	0x13, 0x37, 0x2d, 0x9f, 0xf2, 0xd3, 0xf0, 0x9f, 0xe9, 0xbb, 0x46, 0xf3, 0x99, 0xdb, 0x6a, 0x55,
-	0xc7, 0x28, 0xe2, 0xc5, 0x0c, 0x5f, 0xb7, 0xb8, 0x81, 0xd3, 0xaa, 0x14, 0x7a, 0x8b, 0x03, 0xef,
-	0x18, 0xfe, 0xb3, 0xdb, 0x0c, 0x14, 0x1d, 0xc0, 0x74, 0xc7, 0xf0, 0x02, 0x8b, 0xd2, 0xd9, 0x74,
-	0x9d, 0x96, 0xb5, 0x57, 0x85, 0x95, 0xf2, 0xda, 0xf8, 0xc6, 0xff, 0xab, 0xe7, 0x18, 0x52, 0xb9,
-	0x54, 0x12, 0xd1, 0x61, 0xe8, 0x36, 0x29, 0xb6, 0x3b, 0x4e, 0xe0, 0x1d, 0x69, 0x53, 0x9d, 0xe4,
-	0x68, 0xed, 0x36, 0xcc, 0x8a, 0x16, 0xa2, 0x69, 0x28, 0x3f, 0xc3, 0x47, 0x54, 0x29, 0xc6, 0x34,
-	0xf2, 0x13, 0xcd, 0xc2, 0xd0, 0x81, 0x61, 0x77, 0x31, 0x17, 0x6c, 0xf6, 0x70, 0xbd, 0x74, 0x4d,
-	0x51, 0xaf, 0xc2, 0x72, 0x1e, 0x29, 0x7e, 0xc7, 0x75, 0x7c, 0x8c, 0xe6, 0x60, 0xd8, 0xeb, 0x52,
-	0xad, 0x60, 0x08, 0x87, 0xbc, 0xae, 0xd3, 0x30, 0xd5, 0xbf, 0x29, 0xc1, 0xf2, 0xb6, 0xb5, 0xe7,
-	0x18, 0x76, 0xae, 0x82, 0x3e, 0x48, 0x2b, 0xe8, 0x65, 0xb1, 0x82, 0x4a, 0xb1, 0xf4, 0xa9, 0xa1,
-	0x2d, 0x58, 0xc2, 0x2f, 0x02, 0xec, 0x39, 0x86, 0x1d, 0x19, 0xde, 0x9e, 0xb2, 0x72, 0x3d, 0x7d,
-	0x4d, 0xb8, 0x7f, 0x76, 0xe7, 0xc5, 0x10, 0x55, 0x66, 0x0a, 0xd5, 0xe1, 0x74, 0x73, 0xdf, 0xb2,
-	0xcd, 0xde, 0x26, 0xae, 0x63, 0x1f, 0x51, 0xbd, 0x1d, 0xd5, 0x66, 0xe8, 0x54, 0x08, 0xf4, 0xc8,
-	0xb1, 0x8f, 0xd4, 0x55, 0x38, 0x97, 0x7b, 0x3e, 0xc6, 0x60, 0xf5, 0x17, 0x25, 0xb8, 0xc0, 0xd7,
-	0x58, 0xc1, 0xbe, 0xdc, 0xe6, 0x3d, 0x4d, 0xb3, 0xf4, 0x86, 0x8c, 0xa5, 0x45, 0xe8, 0xfa, 0xe4,
-	0xed, 0x67, 0x8a, 0x40, 0xc0, 0xcb, 0x54, 0xc0, 0x3f, 0xca, 0x17, 0xf0, 0xfe, 0x48, 0xf8, 0x5f,
-	0x14, 0xf5, 0x5b, 0xb0, 0x56, 0x4c, 0x94, 0x5c, 0xe8, 0xbf, 0xa7, 0xc0, 0x59, 0x0d, 0xfb, 0xf8,
-	0xc4, 0x2f, 0x25, 0x29, 0x92, 0xfe, 0xae, 0x85, 0xa8, 0x6e, 0x1e, 0x1a, 0xf9, 0x29, 0xbe, 0x28,
-	0xc1, 0xea, 0x0e, 0xf6, 0xda, 0x96, 0x63, 0x04, 0x38, 0xf7, 0x24, 0x8f, 0xd3, 0x27, 0xb9, 0x22,
-	0x3c, 0x49, 0x21, 0xa2, 0x5f, 0x71, 0x05, 0x7e, 0x15, 0x54, 0xd9, 0x11, 0xb9, 0x0e, 0xff, 0x40,
-	0x81, 0x95, 0x2d, 0xec, 0x37, 0x3d, 0x6b, 0x37, 0x9f, 0xa3, 0x8f, 0xd2, 0x1c, 0x7d, 0x47, 0x78,
-	0x9c, 0x22, 0x3c, 0x7d, 0x8a, 0xc7, 0x7f, 0x95, 0x61, 0x55, 0x82, 0x8a, 0x8b, 0x88, 0x0d, 0x0b,
-	0x3d, 0x97, 0x86, 0xa9, 0x36, 0x7f, 0xe1, 0x49, 0x6d, 0x76, 0x06, 0xe1, 0x66, 0x1c, 0x54, 0x9b,
-	0xc7, 0xc2, 0x71, 0xb4, 0x0b, 0x0b, 0xd9, 0xbb, 0x65, 0x9e, 0x54, 0x89, 0xee, 0x76, 0xb1, 0xbf,
-	0xdd, 0xa8, 0x2f, 0x35, 0x77, 0x28, 0x1a, 0x46, 0x1f, 0x03, 0xea, 0x60, 0xc7, 0xb4, 0x9c, 0x3d,
-	0xdd, 0x68, 0x06, 0xd6, 0x81, 0x15, 0x58, 0xd8, 0xe7, 0xe6, 0x2a, 0xc7, 0x51, 0x63, 0xcb, 0x6f,
-	0xb1, 0xd5, 0x47, 0x14, 0xf9, 0x4c, 0x27, 0x31, 0x68, 0x61, 0x1f, 0xfd, 0x1a, 0x4c, 0x87, 0x88,
-	0xa9, 0x98, 0x78, 0xd8, 0xa9, 0x9e, 0xa2, 0x68, 0xeb, 0x32, 0xb4, 0x9b, 0x64, 0x6d, 0x92, 0xf2,
-	0xa9, 0x4e, 0x6c, 0xca, 0xc3, 0x0e, 0xda, 0xee, 0xa1, 0x0e, 0xbd, 0x13, 0xee, 0xe8, 0x49, 0x29,
-	0x0e, 0x9d, 0x91, 0x04, 0xd2, 0x70, 0x50, 0x7d, 0x01, 0xb3, 0x4f, 0x48, 0xcc, 0x13, 0x72, 0x2f,
-	0x14, 0xc3, 0xcd, 0xb4, 0x18, 0xbe, 0x2e, 0xdc, 0x43, 0x04, 0xdb, 0xa7, 0xe8, 0xfd, 0x48, 0x81,
-	0xb9, 0x14, 0x38, 0x17, 0xb7, 0x9b, 0x30, 0x41, 0xe3, 0xb0, 0xd0, 0x9d, 0x53, 0xfa, 0x70, 0xe7,
-	0xc6, 0x29, 0x04, 0xf7, 0xe2, 0x1a, 0x50, 0x09, 0x11, 0xfc, 0x36, 0x6e, 0x06, 0xd8, 0xe4, 0x82,
-	0xa3, 0xe6, 0x9f, 0x41, 0xe3, 0x2b, 0xb5, 0xc9, 0xe7, 0xf1, 0x47, 0xf5, 0xf7, 0x15, 0xa8, 0x51,
-	0x03, 0xba, 0x1d, 0x58, 0xcd, 0x67, 0x47, 0xc4, 0xa3, 0xbb, 0x6f, 0xf9, 0x41, 0xc8, 0xa6, 0x46,
-	0x9a, 0x4d, 0xeb, 0xf9, 0x96, 0x5c, 0x88, 0xa1, 0x4f, 0x66, 0x9d, 0x85, 0x25, 0x21, 0x0e, 0x6e,
-	0x59, 0x7e, 0x56, 0x82, 0xf9, 0x7b, 0x38, 0x78, 0xd0, 0x0d, 0x8c, 0x5d, 0x1b, 0x6f, 0x07, 0x46,
-	0x80, 0x35, 0x11, 0x5a, 0x25, 0x65, 0x4f, 0x3f, 0x02, 0x24, 0x30, 0xa3, 0xa5, 0x81, 0xcc, 0xe8,
-	0x4c, 0x46, 0xc3, 0xd0, 0x65, 0x98, 0xc7, 0x2f, 0x3a, 0x94, 0x81, 0xba, 0x83, 0x5f, 0x04, 0x3a,
-	0x3e, 0x20, 0x61, 0x91, 0x65, 0x52, 0x0b, 0x5d, 0xd6, 0x4e, 0x87, 0xb3, 0x0f, 0xf1, 0x8b, 0xe0,
-	0x0e, 0x99, 0x6b, 0x98, 0xe8, 0x2d, 0x98, 0x6d, 0x76, 0x3d, 0x1a, 0x3f, 0xed, 0x7a, 0x86, 0xd3,
-	0xdc, 0xd7, 0x03, 0xf7, 0x19, 0xd5, 0x1e, 0x65, 0x6d, 0x42, 0x43, 0x7c, 0xee, 0x36, 0x9d, 0xda,
-	0x21, 0x33, 0xe8, 0x37, 0x60, 0xf6, 0x00, 0x7b, 0xd4, 0x4b, 0xe7, 0x3e, 0x85, 0x6e, 0x05, 0xb8,
-	0xcd, 0x95, 0x22, 0x2d, 0xb0, 0x24, 0x68, 0x25, 0x27, 0x78, 0xca, 0x40, 0x3e, 0x60, 0x10, 0x8d,
-	0x00, 0xb7, 0x35, 0x74, 0x90, 0x19, 0x53, 0xff, 0x61, 0x0c, 0x16, 0x32, 0x2c, 0xe5, 0x02, 0x2a,
-	0x66, 0x9b, 0x72, 0x52, 0xb6, 0xdd, 0x85, 0xc9, 0x08, 0x6d, 0x70, 0xd4, 0xc1, 0xfc, 0x22, 0x56,
-	0xa5, 0x18, 0x77, 0x8e, 0x3a, 0x58, 0x9b, 0x38, 0x8c, 0x3d, 0x21, 0x15, 0x26, 0x45, 0x5c, 0x1f,
-	0x77, 0x62, 0xdc, 0x7e, 0x0a, 0x8b, 0x1d, 0x0f, 0x1f, 0x58, 0x6e, 0xd7, 0xd7, 0x7d, 0xe2, 0xe6,
-	0x60, 0xb3, 0xb7, 0xfe, 0x14, 0xdd, 0x77, 0x29, 0x13, 0xe6, 0x34, 0x9c, 0xe0, 0xca, 0xdb, 0x4f,
-	0x89, 0xaf, 0xa4, 0xcd, 0x87, 0xd0, 0xdb, 0x0c, 0x38, 0xc4, 0xfb, 0x26, 0x9c, 0xa6, 0x41, 0x19,
-	0x8b, 0xa2, 0x22, 0x8c, 0x43, 0x94, 0x82, 0x69, 0x32, 0x75, 0x97, 0xcc, 0x84, 0xcb, 0xaf, 0xc3,
-	0x18, 0x0d, 0xb0, 0x6c, 0xcb, 0x0f, 0x68, 0x98, 0x39, 0xbe, 0x71, 0x56, 0xec, 0x41, 0x84, 0x22,
-	0x3f, 0x1a, 0xf0, 0x5f, 0xe8, 0x1e, 0x4c, 0xfb, 0x54, 0x1d, 0xf4, 0x1e, 0x8a, 0x91, 0x7e, 0x50,
-	0x54, 0xfc, 0x84, 0x16, 0xa1, 0xb7, 0x61, 0xbe, 0x69, 0x5b, 0x84, 0x52, 0xdb, 0xda, 0xf5, 0x0c,
-	0xef, 0x48, 0xe7, 0xf2, 0x40, 0x03, 0xc9, 0x31, 0x6d, 0x96, 0xcd, 0xde, 0x67, 0x93, 0x5c, 0x7e,
-	0x62, 0x50, 0x2d, 0x6c, 0x04, 0x5d, 0x0f, 0x47, 0x50, 0x63, 0x71, 0xa8, 0xbb, 0x6c, 0x32, 0x84,
-	0x3a, 0x07, 0xe3, 0x1c, 0xca, 0x6a, 0x77, 0xec, 0x2a, 0xd0, 0xa5, 0xc0, 0x86, 0x1a, 0xed, 0x8e,
-	0x8d, 0x7c, 0xb8, 0x98, 0x3e, 0x95, 0xee, 0x37, 0xf7, 0xb1, 0xd9, 0xb5, 0xb1, 0x1e, 0xb8, 0xec,
-	0xb2, 0x68, 0x94, 0xef, 0x76, 0x83, 0xea, 0x78, 0x51, 0x40, 0xfa, 0x6a, 0xf2, 0xac, 0xdb, 0x1c,
-	0xd3, 0x8e, 0x4b, 0xef, 0x6d, 0x87, 0xa1, 0x21, 0xfe, 0x0e, 0xbb, 0x2a, 0x22, 0xff, 0xbd, 0x83,
-	0x4c, 0xd0, 0x44, 0xc3, 0x0c, 0x9d, 0xda, 0x26, 0x33, 0xe1, 0x29, 0xf2, 0x74, 0x75, 0x32, 0x57,
-	0x57, 0xef, 0x43, 0x25, 0x92, 0x6d, 0x9f, 0x28, 0x53, 0xb5, 0x42, 0x93, 0x0a, 0xe7, 0x93, 0x57,
-	0xc5, 0x32, 0x3d, 0x71, 0xf9, 0x66, 0x9a, 0x17, 0x29, 0x06, 0x7d, 0x44, 0x4d, 0x98, 0x8d, 0xb0,
-	0x35, 0x6d, 0xd7, 0xc7, 0x1c, 0xe7, 0x14, 0xc5, 0x79, 0xa9, 0x4f, 0x6f, 0x84, 0x00, 0x12, 0x7c,
-	0x5d, 0x5f, 0x8b, 0xf4, 0x39, 0x1a, 0x24, 0x5a, 0x3e, 0x93, 0x34, 0x2f, 0xc4, 0x45, 0x98, 0x16,
-	0xbd, 0x70, 0x7b, 0x54, 0x27, 0x8c, 0x8b, 0x85, 0x7d, 0x6d, 0xfa, 0x20, 0x35, 0x82, 0x6e, 0xc0,
-	0x92, 0x45, 0x74, 0x2e, 0x75, 0xc7, 0xd8, 0x21, 0x76, 0xc6, 0xac, 0xce, 0x50, 0x1f, 0x73, 0xc1,
-	0xf2, 0x93, 0xa6, 0xfe, 0x0e, 0x9b, 0x46, 0xab, 0x30, 0x11, 0xda, 0x3a, 0xdf, 0xfa, 0x14, 0x57,
-	0x11, 0x53, 0x6d, 0x3e, 0xb6, 0x6d, 0x7d, 0x8a, 0xd5, 0x5f, 0x2a, 0xb0, 0xf0, 0xd8, 0xb5, 0xed,
-	0xff, 0x5b, 0x6f, 0x03, 0xf5, 0xc7, 0xa3, 0x50, 0xcd, 0x1e, 0xfb, 0x1b, 0x8b, 0xfd, 0x8d, 0xc5,
-	0xfe, 0x3a, 0x5a, 0xec, 0x3c, 0xfd, 0x98, 0xc8, 0xb5, 0xc0, 0x42, 0x73, 0x36, 0x79, 0x62, 0x73,
-	0xf6, 0xab, 0x67, 0xd8, 0xd5, 0x7f, 0x29, 0xc1, 0x8a, 0x86, 0x9b, 0xae, 0x67, 0xc6, 0x13, 0xb5,
-	0x5c, 0x2d, 0x5e, 0xa6, 0xa5, 0x3c, 0x07, 0xe3, 0x91, 0xe0, 0x44, 0x46, 0x00, 0xc2, 0xa1, 0x86,
-	0x89, 0x16, 0x60, 0x84, 0xca, 0x18, 0xd7, 0xf8, 0xb2, 0x36, 0x4c, 0x1e, 0x1b, 0x26, 0x3a, 0x0b,
-	0xc0, 0xe3, 0x88, 0x50, 0x77, 0xc7, 0xb4, 0x31, 0x3e, 0xd2, 0x30, 0x91, 0x06, 0x13, 0x1d, 0xd7,
-	0xb6, 0xf5, 0x30, 0x56, 0x19, 0x96, 0xc4, 0x2a, 0xc4, 0x86, 0xde, 0x75, 0xbd, 0x38, 0x6b, 0xc2,
-	0x58, 0x65, 0x9c, 0x20, 0xe1, 0x0f, 0xea, 0xef, 0x8d, 0xc2, 0xaa, 0x84, 0x8b, 0xdc, 0xf0, 0x66,
-	0x2c, 0xa4, 0x72, 0x3c, 0x0b, 0x29, 0xb5, 0x7e, 0xa5, 0xe3, 0x5b, 0xbf, 0x6f, 0x01, 0x0a, 0xf9,
-	0x6b, 0xa6, 0xcd, 0xef, 0x74, 0x34, 0x13, 0xae, 0x5e, 0x23, 0x06, 0x4c, 0x60, 0x7a, 0xcb, 0xc4,
-	0x42, 0x25, 0xf0, 0x66, 0x2c, 0xfa, 0x50, 0xd6, 0xa2, 0xc7, 0x4a, 0x3a, 0xc3, 0xc9, 0x92, 0xce,
-	0x35, 0xa8, 0x72, 0x93, 0xd2, 0x4b, 0x80, 0x84, 0x0e, 0xc2, 0x08, 0x75, 0x10, 0xe6, 0xd9, 0x7c,
-	0x24, 0x3b, 0xa1, 0x7f, 0xa0, 0xc1, 0x64, 0x54, 0xba, 0xa0, 0x29, 0x13, 0x56, 0x0b, 0x79, 0x33,
-	0x4f, 0x1b, 0x77, 0x3c, 0xc3, 0xf1, 0x89, 0x29, 0x4b, 0xa4, 0x09, 0x26, 0xcc, 0xd8, 0x13, 0xfa,
-	0x04, 0xce, 0x08, 0x12, 0x32, 0x3d, 0x13, 0x3e, 0xd6, 0x8f, 0x09, 0x5f, 0xcc, 0x88, 0x7b, 0x64,
-	0xcd, 0x73, 0xbc, 0x4f, 0xc8, 0xf3, 0x3e, 0x57, 0x61, 0x22, 0x61, 0xf3, 0xc6, 0xa9, 0xcd, 0x1b,
-	0xdf, 0x8d, 0x19, 0xbb, 0x5b, 0x50, 0xe9, 0x5d, 0x2b, 0x2d, 0x89, 0x4d, 0x14, 0x96, 0xc4, 0x26,
-	0x23, 0x08, 0x5a, 0x11, 0x7b, 0x1f, 0x26, 0xc2, 0xbb, 0xa6, 0x08, 0x26, 0x0b, 0x11, 0x8c, 0xf3,
-	0xf5, 0x14, 0xdc, 0x80, 0x91, 0xe7, 0x5d, 0x4c, 0x8d, 0x6c, 0x85, 0xe6, 0x7f, 0xee, 0xe5, 0x66,
-	0xc1, 0x0b, 0xb5, 0x88, 0xa6, 0x28, 0x2c, 0xec, 0xb3, 0xbc, 0x77, 0x88, 0x37, 0xe3, 0x0b, 0x4e,
-	0x65, 0x7c, 0xc1, 0xda, 0x27, 0x30, 0x11, 0x87, 0x15, 0xa4, 0xc2, 0xaf, 0xc5, 0x53, 0xe1, 0x79,
-	0x29, 0x92, 0x50, 0x31, 0x59, 0xaa, 0x24, 0x96, 0x2e, 0xef, 0x99, 0xd2, 0x30, 0x31, 0xf6, 0x8d,
-	0x29, 0xcd, 0x98, 0xd2, 0x38, 0x6b, 0x84, 0xa6, 0xf4, 0xe7, 0xe5, 0xd0, 0x94, 0x0a, 0xb9, 0xc8,
-	0x4d, 0xe9, 0x87, 0x30, 0x95, 0x32, 0x55, 0x52, 0x63, 0xca, 0x93, 0x19, 0xd4, 0xd8, 0x68, 0x95,
-	0xa4, 0x29, 0xcb, 0x08, 0x77, 0x69, 0x30, 0xe1, 0x8e, 0x59, 0xae, 0x72, 0xd2, 0x72, 0x7d, 0x02,
-	0xcb, 0x49, 0xc5, 0xd3, 0xdd, 0x96, 0x1e, 0xec, 0x5b, 0xbe, 0x1e, 0xaf, 0x5e, 0xcb, 0xb7, 0xaa,
-	0x25, 0x14, 0xf1, 0x51, 0x6b, 0x67, 0xdf, 0xf2, 0x6f, 0x71, 0xfc, 0x0d, 0x98, 0xd9, 0xc7, 0x86,
-	0x17, 0xec, 0x62, 0x23, 0xd0, 0x4d, 0x1c, 0x18, 0x96, 0xed, 0xf3, 0x84, 0x8f, 0x3c, 0x41, 0x38,
-	0x1d, 0x81, 0x6d, 0x31, 0xa8, 0xec, 0xab, 0x69, 0xf8, 0x78, 0xaf, 0xa6, 0x0b, 0x30, 0x15, 0xe1,
-	0x61, 0x62, 0x4d, 0x6d, 0xf4, 0x98, 0x16, 0x39, 0x46, 0x5b, 0x74, 0x54, 0xfd, 0x73, 0x05, 0x5e,
-	0x61, 0xb7, 0x99, 0x50, 0x76, 0x5e, 0x84, 0xee, 0xe9, 0x8b, 0x96, 0x4e, 0x2a, 0x5e, 0xcb, 0x4b,
-	0x2a, 0x16, 0xa1, 0xea, 0x33, 0xbb, 0xf8, 0x77, 0x65, 0x78, 0x55, 0x8e, 0x8d, 0x8b, 0x20, 0xee,
-	0xbd, 0xff, 0x3c, 0x3e, 0xc6, 0x49, 0xbc, 0x7e, 0x7c, 0xeb, 0xa6, 0x4d, 0xf9, 0x29, 0x49, 0xff,
-	0x91, 0x02, 0xcb, 0xbd, 0xb4, 0x3c, 0xf1, 0xa1, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7,
-	0xdd, 0xa6, 0x61, 0xdb, 0x47, 0xd5, 0x12, 0xb5, 0xa9, 0x9f, 0x48, 0x76, 0x2d, 0x3e, 0x4e, 0xbd,
-	0x97, 0xb7, 0xdf, 0x71, 0xb7, 0xf8, 0x0e, 0xf7, 0xd9, 0x06, 0xcc, 0xd4, 0x2e, 0x19, 0xf9, 0x2b,
-	0x6a, 0xbf, 0x03, 0x2b, 0x45, 0x08, 0x04, 0xf6, 0x76, 0x2b, 0x69, 0x6f, 0xc5, 0x55, 0x81, 0xd0,
-	0x0c, 0x50, 0x5c, 0x21, 0x62, 0xfa, 0x66, 0x8e, 0xd9, 0xde, 0x1f, 0x28, 0xc4, 0xf6, 0x66, 0x8e,
-	0x79, 0xd7, 0xb0, 0xec, 0x9e, 0x2c, 0xf5, 0x59, 0x4e, 0x2a, 0xc2, 0xd3, 0xa7, 0x20, 0xbd, 0x42,
-	0xec, 0x58, 0x2e, 0x26, 0x9e, 0xac, 0xfe, 0x53, 0x05, 0xd4, 0xac, 0xb5, 0xfb, 0x20, 0x54, 0xcf,
-	0x90, 0xf2, 0x27, 0x69, 0xca, 0xaf, 0xe6, 0x50, 0x5e, 0x84, 0xa9, 0x4f, 0xda, 0x1f, 0x13, 0xe5,
-	0x94, 0xe0, 0xe2, 0xb2, 0xf9, 0x3a, 0x4c, 0x37, 0x0d, 0xa7, 0x89, 0xa3, 0x37, 0x00, 0x66, 0xef,
-	0xb4, 0x51, 0x6d, 0x8a, 0x8d, 0x6b, 0xe1, 0x70, 0x5c, 0xdf, 0xe3, 0x38, 0x4f, 0xa8, 0xef, 0x32,
-	0x54, 0x7d, 0x1e, 0xf5, 0xb5, 0x48, 0xdd, 0x73, 0x90, 0xc5, 0x0a, 0x96, 0x82, 0x85, 0x27, 0x91,
-	0xb0, 0x5c, 0x3c, 0x03, 0x4b, 0x98, 0x08, 0x53, 0x42, 0xc2, 0xb2, 0x07, 0xa4, 0xf7, 0xd3, 0xa3,
-	0xbc, 0x6f, 0x09, 0x2b, 0xc2, 0xd4, 0x27, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe1, 0xe2, 0xd4, 0xff,
-	0xbd, 0x02, 0xe7, 0x34, 0xdc, 0x76, 0x0f, 0x30, 0xeb, 0x44, 0xf8, 0xaa, 0xe4, 0xf1, 0x92, 0x8e,
-	0x51, 0x39, 0xe5, 0x18, 0xa9, 0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0xb1, 0x04, 0xe7,
-	0xf9, 0x11, 0xd8, 0xb1, 0x73, 0xcb, 0xe0, 0xd2, 0x03, 0x1a, 0x50, 0x49, 0xea, 0x20, 0x3f, 0xdc,
-	0xf5, 0x9c, 0xfb, 0xeb, 0x63, 0x43, 0x6d, 0x32, 0xa1, 0xbd, 0x68, 0x17, 0x16, 0xa2, 0x4e, 0x03,
-	0x61, 0x3b, 0x9f, 0xb8, 0x08, 0x7d, 0x87, 0xc3, 0xa4, 0x8a, 0xd0, 0x58, 0x34, 0x3c, 0x70, 0x97,
-	0xc1, 0x1a, 0xbc, 0x56, 0x74, 0x16, 0xce, 0xe7, 0x7f, 0x56, 0x60, 0x29, 0x4c, 0x1c, 0x09, 0x02,
-	0xf9, 0x97, 0x22, 0x3e, 0x17, 0x61, 0xc6, 0xf2, 0xf5, 0x64, 0x77, 0x1d, 0xe5, 0xe5, 0xa8, 0x36,
-	0x65, 0xf9, 0x77, 0xe3, 0x7d, 0x73, 0xea, 0x32, 0x9c, 0x11, 0x93, 0xcf, 0xcf, 0xf7, 0x39, 0x75,
-	0x58, 0x88, 0xb1, 0x4e, 0x16, 0xce, 0x33, 0xa6, 0xf5, 0x65, 0x1c, 0x74, 0x15, 0x26, 0x78, 0xeb,
-	0x24, 0x36, 0x63, 0xb9, 0xdc, 0x68, 0xac, 0x61, 0xa2, 0x8f, 0xe1, 0x74, 0x33, 0x24, 0x35, 0xb6,
-	0xf5, 0xa9, 0x81, 0xb6, 0x46, 0x11, 0x8a, 0xde, 0xde, 0xf7, 0x61, 0x3a, 0xd6, 0x0e, 0xc9, 0x82,
-	0x84, 0xa1, 0x7e, 0x83, 0x84, 0xa9, 0x1e, 0x28, 0x8b, 0x12, 0xce, 0x02, 0x84, 0xee, 0x9e, 0x65,
-	0x52, 0xf7, 0xb8, 0xac, 0x8d, 0xf1, 0x91, 0x86, 0xa9, 0x5e, 0x20, 0xca, 0x2c, 0xbd, 0x04, 0x7e,
-	0x5d, 0xff, 0x5e, 0x82, 0xaa, 0xc6, 0x7b, 0x85, 0x31, 0x45, 0xed, 0x3f, 0xdd, 0x78, 0x99, 0x57,
-	0xf4, 0x5b, 0x30, 0x27, 0xaa, 0x1c, 0x87, 0x1d, 0x20, 0x03, 0x94, 0x8e, 0x4f, 0x67, 0x4b, 0xc7,
-	0x3e, 0x7a, 0x07, 0x86, 0x29, 0xeb, 0x7d, 0x7e, 0xa3, 0xe2, 0xd4, 0xc8, 0x96, 0x11, 0x18, 0xb7,
-	0x6d, 0x77, 0x57, 0xe3, 0x8b, 0xd1, 0x26, 0x54, 0x1c, 0x7c, 0xa8, 0x7b, 0x5d, 0x7e, 0x73, 0x61,
-	0x60, 0x53, 0x00, 0x3e, 0xe1, 0xe0, 0x43, 0xad, 0xcb, 0xae, 0xcc, 0x57, 0x97, 0x60, 0x51, 0xc0,
-	0x6a, 0x7e, 0x11, 0xdf, 0x53, 0x60, 0x7e, 0xfb, 0xc8, 0x69, 0x6e, 0xef, 0x1b, 0x9e, 0xc9, 0x33,
-	0xa4, 0xfc, 0x1a, 0xce, 0x43, 0xc5, 0x77, 0xbb, 0x5e, 0x13, 0xeb, 0xbc, 0x85, 0x9c, 0xdf, 0xc5,
-	0x24, 0x1b, 0xdd, 0x64, 0x83, 0x68, 0x11, 0x46, 0x7d, 0x02, 0x1c, 0xbe, 0xdf, 0x86, 0xb4, 0x11,
-	0xfa, 0xdc, 0x30, 0x51, 0x1d, 0x4e, 0xd1, 0x58, 0xb2, 0x5c, 0x18, 0xe0, 0xd1, 0x75, 0xea, 0x22,
-	0x2c, 0x64, 0x68, 0xe1, 0x74, 0xfe, 0x74, 0x08, 0x4e, 0x93, 0xb9, 0xf0, 0x3d, 0xf9, 0x32, 0x65,
-	0xa5, 0x0a, 0x23, 0x61, 0x46, 0x8a, 0x69, 0x72, 0xf8, 0x48, 0x14, 0xbd, 0x17, 0xeb, 0x46, 0x79,
-	0x84, 0x28, 0xef, 0x40, 0x78, 0x92, 0xcd, 0x43, 0x0d, 0x0d, 0x9a, 0x87, 0x92, 0x2b, 0x61, 0x26,
-	0x92, 0x1f, 0x19, 0x2c, 0x92, 0xff, 0x90, 0x57, 0x7f, 0x7a, 0x41, 0x35, 0xc5, 0x32, 0x5a, 0x88,
-	0x65, 0x86, 0x80, 0x45, 0xee, 0x31, 0xc5, 0x75, 0x05, 0x46, 0xc2, 0x88, 0x7c, 0xac, 0x8f, 0x88,
-	0x3c, 0x5c, 0x1c, 0xcf, 0x26, 0x40, 0x32, 0x9b, 0x70, 0x13, 0x26, 0x58, 0x6d, 0x8a, 0x37, 0x8a,
-	0x8f, 0xf7, 0xd1, 0x28, 0x3e, 0x4e, 0x4b, 0x56, 0xbc, 0x47, 0xfc, 0x2d, 0xa0, 0x7d, 0xde, 0xfc,
-	0xd3, 0x09, 0xdd, 0x32, 0xb1, 0x13, 0x58, 0xc1, 0x11, 0xcd, 0x06, 0x8e, 0x69, 0x88, 0xcc, 0x7d,
-	0x4c, 0xa7, 0x1a, 0x7c, 0x06, 0x3d, 0x84, 0xa9, 0x94, 0x69, 0xe0, 0x99, 0xbf, 0xf3, 0x7d, 0x19,
-	0x05, 0xad, 0x92, 0x34, 0x08, 0xea, 0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x28, 0xb0,
-	0x14, 0x76, 0xde, 0x7d, 0x45, 0x3c, 0x3c, 0xf5, 0x8f, 0x15, 0x38, 0x23, 0xa6, 0x89, 0x07, 0x3f,
-	0x97, 0x61, 0xbe, 0xcd, 0xc6, 0x59, 0x5d, 0x46, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7,
-	0xf0, 0x74, 0x3b, 0x06, 0xd5, 0x70, 0x36, 0xc9, 0x14, 0x7a, 0x17, 0x16, 0x33, 0x40, 0xa6, 0x11,
-	0x18, 0xbb, 0x86, 0x1f, 0x36, 0xe0, 0xce, 0x27, 0xe1, 0xb6, 0xf8, 0xac, 0x7a, 0x06, 0x6a, 0x21,
-	0x3d, 0x9c, 0x9f, 0x1f, 0xb8, 0x51, 0xeb, 0x94, 0xfa, 0xbb, 0xa5, 0x1e, 0x0b, 0x13, 0xd3, 0x9c,
-	0xda, 0x35, 0x98, 0x76, 0xba, 0xed, 0x5d, 0xec, 0xe9, 0x6e, 0x4b, 0xa7, 0x56, 0xca, 0xa7, 0x74,
-	0x0e, 0x69, 0x15, 0x36, 0xfe, 0xa8, 0x45, 0x8d, 0x8f, 0x4f, 0x98, 0x1d, 0x5a, 0x35, 0x9f, 0xa6,
-	0x16, 0x86, 0xb4, 0x51, 0x6e, 0xd6, 0x7c, 0xd4, 0x80, 0x09, 0x7e, 0x13, 0xec, 0xa8, 0xe2, 0x2e,
-	0xd3, 0x50, 0x1c, 0x58, 0xae, 0x87, 0x9e, 0x9c, 0xfa, 0x7e, 0xe3, 0x66, 0x6f, 0x00, 0x5d, 0x81,
-	0x05, 0xb6, 0x4f, 0xd3, 0x75, 0x02, 0xcf, 0xb5, 0x6d, 0xec, 0x51, 0x9e, 0x74, 0xd9, 0x9b, 0x62,
-	0x4c, 0x9b, 0xa3, 0xd3, 0x9b, 0xd1, 0x2c, 0xb3, 0x8b, 0x54, 0x43, 0x4c, 0xd3, 0xc3, 0xbe, 0xcf,
-	0x13, 0x92, 0xe1, 0xa3, 0x5a, 0x87, 0x19, 0x56, 0xd9, 0x22, 0x70, 0xa1, 0xec, 0xc4, 0x8d, 0xb4,
-	0x92, 0x30, 0xd2, 0xea, 0x2c, 0xa0, 0xf8, 0x7a, 0x2e, 0x8c, 0xff, 0xa9, 0xc0, 0x0c, 0x73, 0xde,
-	0xe3, 0x5e, 0x62, 0x3e, 0x1a, 0x74, 0x83, 0x57, 0x81, 0xa3, 0xa2, 0x77, 0x65, 0xe3, 0x5c, 0x0e,
-	0x43, 0x08, 0x46, 0x9a, 0x35, 0xa3, 0x75, 0x60, 0x9a, 0x31, 0x8b, 0xe5, 0x5e, 0xcb, 0x89, 0xdc,
-	0xeb, 0x26, 0x4c, 0x1d, 0x58, 0xbe, 0xb5, 0x6b, 0xd9, 0x56, 0x70, 0xc4, 0x2c, 0x51, 0x71, 0xba,
-	0xb0, 0xd2, 0x03, 0xa1, 0x66, 0x68, 0x15, 0x26, 0xf8, 0x2b, 0x4c, 0x77, 0x0c, 0x6e, 0x71, 0xc7,
-	0xb4, 0x71, 0x3e, 0xf6, 0xd0, 0x68, 0x63, 0xc2, 0x85, 0xf8, 0x71, 0x39, 0x17, 0xbe, 0x4f, 0xb9,
-	0xe0, 0xe3, 0xe0, 0x49, 0x17, 0x77, 0x71, 0x1f, 0x5c, 0x48, 0xef, 0x54, 0xca, 0xec, 0x94, 0x64,
-	0x54, 0x79, 0x40, 0x46, 0x31, 0x3a, 0x7b, 0x04, 0x71, 0x3a, 0x7f, 0xa8, 0xc0, 0x6c, 0x28, 0xf7,
-	0x5f, 0x19, 0x52, 0x1f, 0xc1, 0x5c, 0x8a, 0x26, 0xae, 0x85, 0x57, 0x60, 0xa1, 0xe3, 0xb9, 0x4d,
-	0xec, 0xfb, 0x96, 0xb3, 0xa7, 0xd3, 0xaf, 0xca, 0x98, 0x1d, 0x20, 0xca, 0x58, 0x26, 0x32, 0xdf,
-	0x9b, 0xa6, 0x90, 0xd4, 0x08, 0xf8, 0xea, 0xe7, 0x0a, 0x9c, 0xbd, 0x87, 0x03, 0xad, 0xf7, 0x8d,
-	0xd9, 0x03, 0xec, 0xfb, 0xc6, 0x1e, 0x8e, 0x5c, 0x96, 0x9b, 0x30, 0x4c, 0x0b, 0x40, 0x0c, 0xd1,
-	0xf8, 0xc6, 0x85, 0x1c, 0x6a, 0x63, 0x28, 0x68, 0x75, 0x48, 0xe3, 0x60, 0x7d, 0x30, 0x85, 0xd8,
-	0x98, 0xe5, 0x3c, 0x2a, 0xf8, 0x01, 0x9f, 0x43, 0x85, 0x71, 0xbd, 0xcd, 0x67, 0x38, 0x39, 0x1f,
-	0xe6, 0x26, 0x27, 0xe5, 0x08, 0xeb, 0x54, 0x37, 0xc3, 0x51, 0x96, 0x88, 0x9c, 0xf4, 0xe3, 0x63,
-	0x35, 0x1b, 0x50, 0x76, 0x51, 0x3c, 0xd9, 0x38, 0xc4, 0x92, 0x8d, 0xdf, 0x49, 0x26, 0x1b, 0x2f,
-	0x16, 0x33, 0x28, 0x22, 0x26, 0x96, 0x68, 0x6c, 0xc3, 0xca, 0x3d, 0x1c, 0x6c, 0xdd, 0x7f, 0x22,
-	0xb9, 0x8b, 0x06, 0x00, 0x53, 0x69, 0xa7, 0xe5, 0x86, 0x0c, 0xe8, 0x63, 0x3b, 0x22, 0x48, 0xd4,
-	0x4c, 0x52, 0xd1, 0x23, 0xbf, 0x7c, 0xf5, 0x05, 0xac, 0x4a, 0xb6, 0xe3, 0x4c, 0xdf, 0x86, 0x99,
-	0xd8, 0xd7, 0x87, 0xb4, 0x18, 0x19, 0x6e, 0xfb, 0x5a, 0x7f, 0xdb, 0x6a, 0xd3, 0x5e, 0x72, 0xc0,
-	0x57, 0xff, 0x55, 0x81, 0x59, 0x0d, 0x1b, 0x9d, 0x8e, 0xcd, 0x22, 0xa2, 0xe8, 0x74, 0xf3, 0x30,
-	0xcc, 0x33, 0xfb, 0xec, 0x3d, 0xc7, 0x9f, 0xe4, 0x1f, 0x2b, 0x88, 0x5f, 0xd2, 0xe5, 0x93, 0xfa,
-	0xa3, 0xc7, 0x0b, 0x2e, 0xd4, 0x05, 0x98, 0x4b, 0x1d, 0x8d, 0x5b, 0x93, 0x9f, 0x28, 0xb0, 0xa4,
-	0xe1, 0x96, 0x87, 0xfd, 0xfd, 0xa8, 0xc8, 0x41, 0xb8, 0xf1, 0x15, 0x3c, 0xbb, 0xba, 0x0c, 0x67,
-	0xc4, 0xa4, 0xf2, 0xb3, 0xbc, 0x0b, 0x0b, 0x9b, 0x6e, 0xd7, 0x21, 0xc2, 0x93, 0x16, 0xd0, 0x65,
-	0x80, 0x96, 0xeb, 0x35, 0xf1, 0x5d, 0x1c, 0x34, 0xf7, 0x79, 0xc6, 0x36, 0x36, 0xa2, 0x1a, 0x50,
-	0xcd, 0x82, 0x72, 0x61, 0xbb, 0x03, 0x23, 0xd8, 0x09, 0x68, 0x2d, 0x97, 0x89, 0xd8, 0x1b, 0x39,
-	0x22, 0xc6, 0xbd, 0x90, 0xad, 0xfb, 0x4f, 0x28, 0x2e, 0x5e, 0xaf, 0xe5, 0xb0, 0xea, 0x4f, 0x4a,
-	0x30, 0xaf, 0x61, 0xc3, 0x14, 0x50, 0xb7, 0x01, 0xa7, 0xa2, 0xee, 0x88, 0xca, 0xc6, 0x72, 0x9e,
-	0x6f, 0x71, 0xff, 0x09, 0xb5, 0xba, 0x74, 0xad, 0x2c, 0x14, 0xcb, 0x06, 0x73, 0x65, 0x51, 0x30,
-	0xb7, 0x03, 0x55, 0xcb, 0x21, 0x2b, 0xac, 0x03, 0xac, 0x63, 0x27, 0xb2, 0x60, 0x7d, 0x76, 0x94,
-	0xcd, 0x45, 0xc0, 0x77, 0x9c, 0xd0, 0x14, 0x35, 0x4c, 0x22, 0x18, 0x1d, 0x82, 0x84, 0xd6, 0xa4,
-	0x87, 0x28, 0x61, 0xa3, 0x64, 0x60, 0xdb, 0xfa, 0x14, 0xa3, 0xd7, 0x60, 0x8a, 0xf6, 0x45, 0xd0,
-	0x15, 0xac, 0x7c, 0x3f, 0x4c, 0xcb, 0xf7, 0xb4, 0x5d, 0xe2, 0xb1, 0xb1, 0x87, 0x59, 0x37, 0xdf,
-	0xdf, 0x96, 0x60, 0x21, 0xc3, 0x2b, 0x7e, 0x1d, 0xc7, 0x61, 0x96, 0xd0, 0x5e, 0x94, 0x4e, 0x66,
-	0x2f, 0xd0, 0x77, 0x61, 0x3e, 0x83, 0x34, 0xcc, 0x11, 0x0e, 0x6a, 0x00, 0x67, 0xd3, 0xd8, 0x69,
-	0x8a, 0x50, 0xc0, 0xae, 0x53, 0x22, 0x76, 0xfd, 0x42, 0x81, 0x85, 0xc7, 0x5d, 0x6f, 0x0f, 0x7f,
-	0xbd, 0x65, 0x4b, 0xad, 0x41, 0x35, 0x7b, 0x4c, 0xae, 0xfc, 0x5f, 0x94, 0x60, 0xe1, 0x01, 0xfe,
-	0xda, 0xf3, 0xe0, 0x7f, 0x46, 0xbf, 0x6e, 0x43, 0x35, 0xcb, 0x2b, 0xae, 0x5f, 0x02, 0x1c, 0x8a,
-	0x08, 0xc7, 0x67, 0x0a, 0x9c, 0x79, 0xe8, 0x06, 0x56, 0xeb, 0x88, 0x84, 0xdb, 0xee, 0x01, 0xf6,
-	0x1e, 0x18, 0x24, 0x96, 0x8e, 0xb8, 0xfe, 0x5d, 0x98, 0x6f, 0xf1, 0x19, 0xbd, 0x4d, 0xa7, 0xf4,
-	0x84, 0xc3, 0x96, 0xa7, 0x1f, 0x49, 0x74, 0xcc, 0x67, 0x9b, 0x6d, 0x65, 0x07, 0x7d, 0xf5, 0x1c,
-	0x9c, 0xcd, 0xa1, 0x80, 0x0b, 0x85, 0x01, 0x4b, 0xf7, 0x70, 0xb0, 0xe9, 0xb9, 0xbe, 0xcf, 0x6f,
-	0x25, 0xf1, 0x72, 0x4b, 0x04, 0x7e, 0x4a, 0x2a, 0xf0, 0x3b, 0x0f, 0x95, 0xc0, 0xf0, 0xf6, 0x70,
-	0x10, 0xdd, 0x32, 0x7b, 0xcd, 0x4d, 0xb2, 0x51, 0x8e, 0x4f, 0xfd, 0x65, 0x19, 0xce, 0x88, 0xf7,
-	0xe0, 0xfc, 0x6c, 0x13, 0x3c, 0xc4, 0x34, 0xec, 0x1e, 0xb1, 0x30, 0x94, 0x1f, 0xff, 0x9e, 0xcc,
-	0x41, 0xcc, 0x45, 0x47, 0x9d, 0x6f, 0xff, 0xf6, 0x11, 0x75, 0x00, 0xd9, 0x1b, 0x66, 0x22, 0x88,
-	0x0d, 0xa1, 0xcf, 0x14, 0x98, 0x6b, 0xd1, 0x82, 0x98, 0xde, 0x34, 0xba, 0x3e, 0xee, 0x6d, 0xcb,
-	0xec, 0xdd, 0x83, 0xe3, 0x6d, 0xcb, 0x6a, 0x6c, 0x9b, 0x04, 0x63, 0x62, 0x73, 0xd4, 0xca, 0x4c,
-	0xd4, 0x3a, 0x30, 0x93, 0xa1, 0x52, 0xe0, 0x9e, 0xde, 0x49, 0xba, 0xa7, 0xeb, 0x39, 0xe2, 0x90,
-	0xa6, 0x89, 0x5f, 0x5e, 0xdc, 0x47, 0xad, 0x75, 0x60, 0x21, 0x87, 0x40, 0xc1, 0xbe, 0x37, 0xe3,
-	0xfb, 0x56, 0x72, 0xd3, 0xbd, 0xf7, 0x70, 0xd0, 0x2b, 0x2e, 0x52, 0xbc, 0x71, 0xaf, 0xf8, 0x3f,
-	0x14, 0x58, 0xe3, 0xe5, 0xbc, 0x0c, 0xd3, 0x32, 0x75, 0x08, 0x49, 0x64, 0xd6, 0x9f, 0x94, 0xa1,
-	0xa7, 0x4c, 0x88, 0xa2, 0xbe, 0x8b, 0x30, 0x57, 0xdd, 0x3f, 0xd3, 0x78, 0xb7, 0xc5, 0x64, 0x10,
-	0x7b, 0xf2, 0xd1, 0xab, 0x30, 0xd9, 0x22, 0x0e, 0xd0, 0x43, 0xcc, 0x7c, 0x29, 0x5e, 0x7e, 0x4a,
-	0x0e, 0xaa, 0x1e, 0xbc, 0xde, 0xc7, 0x59, 0x23, 0x77, 0x69, 0x28, 0xf4, 0xc7, 0x8f, 0x77, 0xad,
-	0x14, 0x5a, 0x7d, 0x87, 0x7e, 0xd3, 0x16, 0x2a, 0x36, 0x7d, 0x49, 0xf6, 0x91, 0x1b, 0x53, 0x03,
-	0xfa, 0xdd, 0x56, 0x12, 0x2c, 0x72, 0x1c, 0xe6, 0x7a, 0x65, 0x97, 0x30, 0x11, 0xd3, 0xe5, 0x7d,
-	0x54, 0x43, 0x5a, 0xaf, 0x26, 0xb3, 0xcd, 0xb2, 0x30, 0x5d, 0x87, 0xe6, 0xc5, 0xc3, 0xaf, 0x2e,
-	0x79, 0x0a, 0x89, 0xe5, 0x87, 0x26, 0xf9, 0x28, 0xcb, 0x20, 0xa9, 0x0d, 0x98, 0xd7, 0x8c, 0x00,
-	0xdb, 0x56, 0xdb, 0x0a, 0x3e, 0xea, 0x98, 0xb1, 0x44, 0xde, 0x3a, 0x9c, 0x32, 0x8d, 0xc0, 0xe0,
-	0xcc, 0x58, 0xca, 0x6b, 0xc4, 0xbc, 0xe5, 0x1c, 0x69, 0x74, 0xa1, 0xfa, 0x21, 0x2c, 0x64, 0x50,
-	0xf1, 0x03, 0x0c, 0x8a, 0x6b, 0xe3, 0x9f, 0xea, 0x00, 0xdc, 0x29, 0xbd, 0xf5, 0xb8, 0x81, 0xfe,
-	0x50, 0x81, 0x79, 0xf1, 0x47, 0xed, 0xe8, 0xca, 0xf1, 0xfe, 0x85, 0xa2, 0x76, 0x75, 0x60, 0x38,
-	0x7e, 0x96, 0x3f, 0x52, 0x60, 0x21, 0xe7, 0x5f, 0x0f, 0xd0, 0xd5, 0xa2, 0x7f, 0x0c, 0xc8, 0xa3,
-	0xe6, 0xda, 0xe0, 0x80, 0x9c, 0x9c, 0x1f, 0x2b, 0xb0, 0x52, 0xf4, 0xe5, 0x3f, 0xfa, 0xce, 0x49,
-	0xff, 0xc9, 0xa0, 0x76, 0xeb, 0x04, 0x18, 0x38, 0xa5, 0xe4, 0x12, 0xc5, 0xdf, 0xf4, 0x4b, 0x2e,
-	0x51, 0xfa, 0x5f, 0x02, 0x92, 0x4b, 0x2c, 0xf8, 0xf3, 0x80, 0x3f, 0x53, 0xa0, 0x96, 0xff, 0xe5,
-	0x3b, 0xca, 0xef, 0x0a, 0x2b, 0xfc, 0x47, 0x80, 0xda, 0x7b, 0xc7, 0x82, 0xe5, 0x74, 0xfd, 0x50,
-	0x81, 0xc5, 0xdc, 0xef, 0xda, 0xd1, 0xbb, 0xb9, 0xa8, 0x8b, 0x3e, 0xab, 0xaf, 0x5d, 0x3f, 0x0e,
-	0x28, 0x27, 0xca, 0x81, 0xc9, 0xc4, 0x07, 0xcf, 0xe8, 0xcd, 0x5c, 0x64, 0xa2, 0xef, 0xaa, 0x6b,
-	0xf5, 0x7e, 0x97, 0xf3, 0xfd, 0x3e, 0x53, 0xe0, 0xb4, 0xe0, 0xab, 0x61, 0x74, 0x59, 0x7e, 0xdb,
-	0xc2, 0xef, 0x94, 0x6b, 0x6f, 0x0f, 0x06, 0xc4, 0x49, 0x08, 0x60, 0x2a, 0xf5, 0x11, 0x2d, 0x5a,
-	0x97, 0xb9, 0x1f, 0x82, 0x4a, 0x48, 0xed, 0xad, 0xfe, 0x01, 0xf8, 0xae, 0x87, 0x30, 0x9d, 0xfe,
-	0x12, 0x0c, 0xe5, 0x63, 0xc9, 0xf9, 0x56, 0xae, 0x76, 0x69, 0x00, 0x88, 0x98, 0xd8, 0xe5, 0xf6,
-	0x3b, 0x4a, 0xc4, 0xae, 0xe8, 0x6b, 0x94, 0xda, 0x09, 0xda, 0x2b, 0xd1, 0x5f, 0x2a, 0x70, 0x46,
-	0xd6, 0x0e, 0x89, 0x6e, 0x1c, 0xb3, 0x8b, 0x92, 0x91, 0xf6, 0xfe, 0x89, 0x7a, 0x30, 0x39, 0xcb,
-	0x72, 0x7a, 0x06, 0xa5, 0x2c, 0x93, 0x77, 0x2c, 0x4a, 0x59, 0x56, 0xd0, 0xa2, 0x18, 0xbb, 0x47,
-	0x41, 0x43, 0x76, 0xe1, 0x3d, 0xe6, 0xb7, 0xc2, 0x17, 0xde, 0xa3, 0xac, 0xff, 0x3b, 0x76, 0x8f,
-	0xc2, 0xb6, 0xbd, 0xe2, 0x7b, 0x94, 0xb5, 0x0e, 0x16, 0xdf, 0xa3, 0xb4, 0x57, 0x30, 0x7e, 0x8f,
-	0xd9, 0xce, 0xbc, 0xe2, 0x7b, 0xcc, 0xed, 0x0b, 0x2c, 0xbe, 0xc7, 0xfc, 0x46, 0x40, 0xf4, 0x17,
-	0x34, 0xb7, 0x99, 0xdb, 0x72, 0x87, 0xde, 0x1b, 0xe8, 0xcc, 0xc9, 0xa6, 0xbf, 0xda, 0x8d, 0xe3,
-	0x01, 0x27, 0x48, 0xcb, 0xed, 0x37, 0x95, 0x92, 0x56, 0xd4, 0xf1, 0x2a, 0x25, 0xad, 0xb8, 0xc5,
-	0xf5, 0xaf, 0x15, 0x58, 0x96, 0x37, 0x9a, 0xa1, 0x6f, 0x4b, 0x36, 0xe8, 0xa3, 0xdb, 0xae, 0x76,
-	0xf3, 0xd8, 0xf0, 0x9c, 0xc6, 0xef, 0x2b, 0x50, 0xcd, 0x6b, 0x37, 0x44, 0xd7, 0x24, 0xd8, 0xa5,
-	0x7d, 0x95, 0xb5, 0x77, 0x8f, 0x01, 0xc9, 0x29, 0xfa, 0x5c, 0x81, 0x59, 0x51, 0xd3, 0x1a, 0xca,
-	0x7f, 0x73, 0x4a, 0x5a, 0xf4, 0x6a, 0xef, 0x0c, 0x08, 0xc5, 0xa9, 0xf8, 0x2b, 0xfa, 0xe7, 0x53,
-	0x92, 0xa6, 0x2c, 0xf4, 0x7e, 0x81, 0x6c, 0xc8, 0x3b, 0xea, 0x6a, 0xdf, 0x3e, 0x2e, 0x38, 0x27,
-	0xf0, 0x53, 0x98, 0xc9, 0xf4, 0x27, 0xa1, 0x4b, 0x12, 0xa4, 0xe2, 0xb6, 0xb1, 0xda, 0xc6, 0x20,
-	0x20, 0x3d, 0x6f, 0x24, 0xd5, 0x71, 0x24, 0xf1, 0x46, 0xc4, 0x7d, 0x52, 0x12, 0x6f, 0x24, 0xa7,
-	0x99, 0x09, 0x3d, 0x83, 0x89, 0x78, 0x07, 0x08, 0xfa, 0x96, 0x14, 0x43, 0xaa, 0xe5, 0xa9, 0xf6,
-	0x66, 0x9f, 0xab, 0x63, 0x52, 0x28, 0x6a, 0xe1, 0x90, 0x48, 0xa1, 0xa4, 0x0b, 0x45, 0x22, 0x85,
-	0xd2, 0x3e, 0x11, 0xe2, 0x79, 0x0a, 0x3a, 0x33, 0x24, 0x9e, 0x67, 0x7e, 0x9b, 0x47, 0xed, 0xed,
-	0xc1, 0x80, 0xa2, 0x4f, 0x55, 0xa0, 0xd7, 0xe8, 0x80, 0x2e, 0xe6, 0xe2, 0xc8, 0x74, 0x4f, 0xd4,
-	0xde, 0xe8, 0x6b, 0x6d, 0x6f, 0x9b, 0x5e, 0x27, 0x81, 0x64, 0x9b, 0x4c, 0x77, 0x85, 0x64, 0x9b,
-	0x6c, 0x6b, 0x02, 0xdb, 0x26, 0x6c, 0x04, 0x90, 0x6e, 0x93, 0x6a, 0x5f, 0x90, 0x6e, 0x93, 0xee,
-	0x2c, 0x20, 0x11, 0x4a, 0xa2, 0x88, 0x2f, 0x89, 0x50, 0x44, 0x0d, 0x08, 0x92, 0x08, 0x45, 0xdc,
-	0x1b, 0x40, 0x42, 0x59, 0x71, 0x31, 0x5c, 0x12, 0xca, 0x4a, 0x9b, 0x02, 0x24, 0xa1, 0x6c, 0x41,
-	0x19, 0x9f, 0x38, 0x30, 0xb9, 0x75, 0x67, 0x89, 0x03, 0x53, 0x54, 0x1a, 0x97, 0x38, 0x30, 0xc5,
-	0x65, 0x6e, 0x07, 0x26, 0x13, 0x55, 0x5b, 0xc9, 0x85, 0x88, 0x0a, 0xd7, 0x92, 0x0b, 0x11, 0x16,
-	0x83, 0xa9, 0xf9, 0x10, 0x55, 0x58, 0x91, 0x2c, 0xfc, 0xcb, 0xad, 0x1d, 0x4b, 0xcc, 0x87, 0xac,
-	0x8c, 0x4b, 0xe2, 0xb7, 0x74, 0x2d, 0x56, 0x12, 0xbf, 0xe5, 0x54, 0x7c, 0x25, 0xf1, 0x5b, 0x6e,
-	0xa1, 0x37, 0x80, 0xa9, 0x54, 0xd1, 0x51, 0xf2, 0x82, 0x10, 0x97, 0x72, 0x25, 0x2f, 0x88, 0xbc,
-	0x7a, 0x26, 0x09, 0x57, 0x53, 0x45, 0x2d, 0x59, 0xb8, 0x2a, 0x2e, 0xf3, 0xc9, 0xc2, 0xd5, 0x9c,
-	0x8a, 0x19, 0xd9, 0x38, 0x5d, 0x04, 0x92, 0x6c, 0x9c, 0x53, 0x5b, 0x93, 0x6c, 0x9c, 0x5b, 0x61,
-	0xfa, 0x03, 0x05, 0xe6, 0x84, 0x75, 0x1b, 0x94, 0x2f, 0x31, 0xb2, 0x4a, 0x53, 0xed, 0xca, 0xa0,
-	0x60, 0x31, 0x79, 0x17, 0x55, 0x3d, 0x24, 0xf2, 0x2e, 0x29, 0x27, 0x49, 0xe4, 0x5d, 0x5a, 0x20,
-	0xfa, 0x42, 0x89, 0xbe, 0x6a, 0xca, 0x4f, 0xaf, 0xa3, 0x5b, 0x45, 0xf1, 0x46, 0x61, 0x19, 0xa2,
-	0x76, 0xfb, 0x24, 0x28, 0x12, 0x29, 0x9d, 0x78, 0x7e, 0x5d, 0x9e, 0xd2, 0x11, 0x24, 0xf0, 0xe5,
-	0x29, 0x1d, 0x61, 0xea, 0x9e, 0x68, 0x66, 0x32, 0x29, 0x2e, 0xd3, 0x4c, 0x61, 0x26, 0x5e, 0xa6,
-	0x99, 0xe2, 0x7c, 0xfb, 0xed, 0x3b, 0x3f, 0xfd, 0x72, 0x59, 0xf9, 0xd9, 0x97, 0xcb, 0xca, 0xbf,
-	0x7d, 0xb9, 0xac, 0xfc, 0xfa, 0xd5, 0x3d, 0x2b, 0xd8, 0xef, 0xee, 0xd6, 0x9b, 0x6e, 0x7b, 0x3d,
-	0xf1, 0xff, 0xe4, 0xf5, 0x3d, 0xec, 0xb0, 0x3f, 0xab, 0x8f, 0xfd, 0x5b, 0xfe, 0x7b, 0xfc, 0xe7,
-	0xc1, 0xa5, 0xdd, 0x61, 0x3a, 0x77, 0xf9, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x7a, 0xca,
-	0x50, 0x59, 0x5f, 0x00, 0x00,
+func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTasksResponse{} }
+func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
+func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{88}
 }
-
-func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCrossClusterTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCrossClusterTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GetCrossClusterTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCrossClusterTasksResponse.Merge(m, src)
+}
+func (m *GetCrossClusterTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCrossClusterTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCrossClusterTasksResponse.DiscardUnknown(m)
 }
 
-func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GetCrossClusterTasksResponse proto.InternalMessageInfo
+
+func (m *GetCrossClusterTasksResponse) GetTasksByShard() map[int32]*v11.CrossClusterTaskRequests {
+	if m != nil {
+		return m.TasksByShard
+	}
+	return nil
 }
 
-func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GetCrossClusterTasksResponse) GetFailedCauseByShard() map[int32]v11.GetTaskFailedCause {
+	if m != nil {
+		return m.FailedCauseByShard
 	}
-	if len(m.PartitionConfig) > 0 {
-		for k := range m.PartitionConfig {
-			v := m.PartitionConfig[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintService(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x52
+	return nil
+}
+
+type RespondCrossClusterTasksCompletedRequest struct {
+	ShardId              int32                           `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	TargetCluster        string                          `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	TaskResponses        []*v11.CrossClusterTaskResponse `protobuf:"bytes,3,rep,name=task_responses,json=taskResponses,proto3" json:"task_responses,omitempty"`
+	FetchNewTasks        bool                            `protobuf:"varint,4,opt,name=fetchNewTasks,proto3" json:"fetchNewTasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
+	*m = RespondCrossClusterTasksCompletedRequest{}
+}
+func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
+func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
+func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{89}
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.FirstDecisionTaskBackoff != nil {
-		{
-			size, err := m.FirstDecisionTaskBackoff.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Merge(m, src)
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondCrossClusterTasksCompletedRequest proto.InternalMessageInfo
+
+func (m *RespondCrossClusterTasksCompletedRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	if m.LastCompletionResult != nil {
-		{
-			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.ContinuedFailure != nil {
-		{
-			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ContinueAsNewInitiator != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ContinueAsNewInitiator))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ExpirationTime != nil {
-		{
-			size, err := m.ExpirationTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Attempt != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ParentExecutionInfo != nil {
-		{
-			size, err := m.ParentExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RespondCrossClusterTasksCompletedRequest) GetTargetCluster() string {
+	if m != nil {
+		return m.TargetCluster
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
+func (m *RespondCrossClusterTasksCompletedRequest) GetTaskResponses() []*v11.CrossClusterTaskResponse {
+	if m != nil {
+		return m.TaskResponses
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SignalWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RespondCrossClusterTasksCompletedRequest) GetFetchNewTasks() bool {
+	if m != nil {
+		return m.FetchNewTasks
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *SignalWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RespondCrossClusterTasksCompletedResponse struct {
+	Tasks                *v11.CrossClusterTaskRequests `protobuf:"bytes,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExternalWorkflowExecution != nil {
-		{
-			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *RespondCrossClusterTasksCompletedResponse) Reset() {
+	*m = RespondCrossClusterTasksCompletedResponse{}
+}
+func (m *RespondCrossClusterTasksCompletedResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
+func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{90}
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Merge(m, src)
 }
-
-func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *SignalWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.DiscardUnknown(m)
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RespondCrossClusterTasksCompletedResponse proto.InternalMessageInfo
+
+func (m *RespondCrossClusterTasksCompletedResponse) GetTasks() *v11.CrossClusterTaskRequests {
+	if m != nil {
+		return m.Tasks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetFailoverInfoRequest struct {
+	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.PartitionConfig) > 0 {
-		for k := range m.PartitionConfig {
-			v := m.PartitionConfig[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintService(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{} }
+func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFailoverInfoRequest) ProtoMessage()    {}
+func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{91}
+}
+func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFailoverInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFailoverInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetFailoverInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFailoverInfoRequest.Merge(m, src)
+}
+func (m *GetFailoverInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFailoverInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFailoverInfoRequest.DiscardUnknown(m)
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetFailoverInfoRequest proto.InternalMessageInfo
+
+func (m *GetFailoverInfoRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetFailoverInfoResponse struct {
+	CompletedShardCount  int32    `protobuf:"varint,1,opt,name=completed_shard_count,json=completedShardCount,proto3" json:"completed_shard_count,omitempty"`
+	PendingShards        []int32  `protobuf:"varint,2,rep,packed,name=pending_shards,json=pendingShards,proto3" json:"pending_shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse{} }
+func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFailoverInfoResponse) ProtoMessage()    {}
+func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{92}
 }
-
-func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFailoverInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFailoverInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetFailoverInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFailoverInfoResponse.Merge(m, src)
+}
+func (m *GetFailoverInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFailoverInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFailoverInfoResponse.DiscardUnknown(m)
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_GetFailoverInfoResponse proto.InternalMessageInfo
+
+func (m *GetFailoverInfoResponse) GetCompletedShardCount() int32 {
+	if m != nil {
+		return m.CompletedShardCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetFailoverInfoResponse) GetPendingShards() []int32 {
+	if m != nil {
+		return m.PendingShards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RatelimitUpdateRequest struct {
+	// impl-specific data.
+	// likely some simple top-level keys and then either:
+	// - map<ratelimit-key-string, something>
+	// - list<something>
+	//
+	// this is a single blob rather than a collection to save on
+	// repeated serialization of the type name, and to allow impls
+	// to choose whatever structures are most-convenient for them.
+	Data                 *v12.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
+func (m *RatelimitUpdateRequest) Reset()         { *m = RatelimitUpdateRequest{} }
+func (m *RatelimitUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*RatelimitUpdateRequest) ProtoMessage()    {}
+func (*RatelimitUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{93}
+}
+func (m *RatelimitUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatelimitUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatelimitUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RatelimitUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatelimitUpdateRequest.Merge(m, src)
+}
+func (m *RatelimitUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatelimitUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatelimitUpdateRequest.DiscardUnknown(m)
 }
 
-func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RatelimitUpdateRequest proto.InternalMessageInfo
+
+func (m *RatelimitUpdateRequest) GetData() *v12.Any {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+type RatelimitUpdateResponse struct {
+	// impl-specific data.
+	//
+	// likely some simple top-level keys and then either:
+	// - map<ratelimit-key-string, something>
+	// - list<something>
+	//
+	// this is a single blob rather than a collection to save on
+	// repeated serialization of the type name, and to allow impls
+	// to choose whatever structures are most-convenient for them.
+	Data                 *v12.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RatelimitUpdateResponse) Reset()         { *m = RatelimitUpdateResponse{} }
+func (m *RatelimitUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*RatelimitUpdateResponse) ProtoMessage()    {}
+func (*RatelimitUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{94}
+}
+func (m *RatelimitUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatelimitUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatelimitUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatelimitUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatelimitUpdateResponse.Merge(m, src)
+}
+func (m *RatelimitUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatelimitUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatelimitUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatelimitUpdateResponse proto.InternalMessageInfo
+
+func (m *RatelimitUpdateResponse) GetData() *v12.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest.PartitionConfigEntry")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.StartWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest.PartitionConfigEntry")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionRequest")
+	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.history.v1.QueryWorkflowResponse")
+	proto.RegisterType((*ResetStickyTaskListRequest)(nil), "uber.cadence.history.v1.ResetStickyTaskListRequest")
+	proto.RegisterType((*ResetStickyTaskListResponse)(nil), "uber.cadence.history.v1.ResetStickyTaskListResponse")
+	proto.RegisterType((*GetMutableStateRequest)(nil), "uber.cadence.history.v1.GetMutableStateRequest")
+	proto.RegisterType((*GetMutableStateResponse)(nil), "uber.cadence.history.v1.GetMutableStateResponse")
+	proto.RegisterType((*PollMutableStateRequest)(nil), "uber.cadence.history.v1.PollMutableStateRequest")
+	proto.RegisterType((*PollMutableStateResponse)(nil), "uber.cadence.history.v1.PollMutableStateResponse")
+	proto.RegisterType((*RecordDecisionTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedRequest")
+	proto.RegisterType((*RecordDecisionTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse")
+	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse.QueriesEntry")
+	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedRequest")
+	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedResponse")
+	proto.RegisterType((*RespondDecisionTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedRequest")
+	proto.RegisterType((*RespondDecisionTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse")
+	proto.RegisterMapType((map[string]*v1.ActivityLocalDispatchInfo)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse.ActivitiesToDispatchLocallyEntry")
+	proto.RegisterType((*RespondDecisionTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedRequest")
+	proto.RegisterType((*RespondDecisionTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedResponse")
+	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatRequest")
+	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedRequest")
+	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedResponse")
+	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedRequest")
+	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledRequest")
+	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledResponse")
+	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateRequest")
+	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionRequest")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*ScheduleDecisionTaskRequest)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskRequest")
+	proto.RegisterType((*ScheduleDecisionTaskResponse)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskResponse")
+	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedRequest")
+	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedResponse")
+	proto.RegisterType((*ReplicateEventsV2Request)(nil), "uber.cadence.history.v1.ReplicateEventsV2Request")
+	proto.RegisterType((*ReplicateEventsV2Response)(nil), "uber.cadence.history.v1.ReplicateEventsV2Response")
+	proto.RegisterType((*SyncShardStatusRequest)(nil), "uber.cadence.history.v1.SyncShardStatusRequest")
+	proto.RegisterType((*SyncShardStatusResponse)(nil), "uber.cadence.history.v1.SyncShardStatusResponse")
+	proto.RegisterType((*SyncActivityRequest)(nil), "uber.cadence.history.v1.SyncActivityRequest")
+	proto.RegisterType((*SyncActivityResponse)(nil), "uber.cadence.history.v1.SyncActivityResponse")
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "uber.cadence.history.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "uber.cadence.history.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "uber.cadence.history.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "uber.cadence.history.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "uber.cadence.history.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "uber.cadence.history.v1.CloseShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "uber.cadence.history.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "uber.cadence.history.v1.RemoveTaskResponse")
+	proto.RegisterType((*ResetQueueRequest)(nil), "uber.cadence.history.v1.ResetQueueRequest")
+	proto.RegisterType((*ResetQueueResponse)(nil), "uber.cadence.history.v1.ResetQueueResponse")
+	proto.RegisterType((*DescribeQueueRequest)(nil), "uber.cadence.history.v1.DescribeQueueRequest")
+	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.history.v1.DescribeQueueResponse")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v11.ReplicationMessages)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "uber.cadence.history.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.history.v1.ReapplyEventsResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*RebuildWorkflowBranchRequest)(nil), "uber.cadence.history.v1.RebuildWorkflowBranchRequest")
+	proto.RegisterType((*RebuildWorkflowBranchResponse)(nil), "uber.cadence.history.v1.RebuildWorkflowBranchResponse")
+	proto.RegisterType((*UpdateActivityOptionsRequest)(nil), "uber.cadence.history.v1.UpdateActivityOptionsRequest")
+	proto.RegisterType((*UpdateActivityOptionsResponse)(nil), "uber.cadence.history.v1.UpdateActivityOptionsResponse")
+	proto.RegisterType((*CountDLQMessagesRequest)(nil), "uber.cadence.history.v1.CountDLQMessagesRequest")
+	proto.RegisterType((*CountDLQMessagesResponse)(nil), "uber.cadence.history.v1.CountDLQMessagesResponse")
+	proto.RegisterType((*GetReplicationStatusRequest)(nil), "uber.cadence.history.v1.GetReplicationStatusRequest")
+	proto.RegisterType((*GetReplicationStatusResponse)(nil), "uber.cadence.history.v1.GetReplicationStatusResponse")
+	proto.RegisterType((*ReplicationShardStatus)(nil), "uber.cadence.history.v1.ReplicationShardStatus")
+	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.history.v1.ReadDLQMessagesRequest")
+	proto.RegisterType((*ReadDLQMessagesResponse)(nil), "uber.cadence.history.v1.ReadDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "uber.cadence.history.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "uber.cadence.history.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "uber.cadence.history.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "uber.cadence.history.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*NotifyFailoverMarkersRequest)(nil), "uber.cadence.history.v1.NotifyFailoverMarkersRequest")
+	proto.RegisterType((*NotifyFailoverMarkersResponse)(nil), "uber.cadence.history.v1.NotifyFailoverMarkersResponse")
+	proto.RegisterType((*GetCrossClusterTasksRequest)(nil), "uber.cadence.history.v1.GetCrossClusterTasksRequest")
+	proto.RegisterType((*GetCrossClusterTasksResponse)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse")
+	proto.RegisterMapType((map[int32]v11.GetTaskFailedCause)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.FailedCauseByShardEntry")
+	proto.RegisterMapType((map[int32]*v11.CrossClusterTaskRequests)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.TasksByShardEntry")
+	proto.RegisterType((*RespondCrossClusterTasksCompletedRequest)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedRequest")
+	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedResponse")
+	proto.RegisterType((*GetFailoverInfoRequest)(nil), "uber.cadence.history.v1.GetFailoverInfoRequest")
+	proto.RegisterType((*GetFailoverInfoResponse)(nil), "uber.cadence.history.v1.GetFailoverInfoResponse")
+	proto.RegisterType((*RatelimitUpdateRequest)(nil), "uber.cadence.history.v1.RatelimitUpdateRequest")
+	proto.RegisterType((*RatelimitUpdateResponse)(nil), "uber.cadence.history.v1.RatelimitUpdateResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/history/v1/service.proto", fileDescriptor_fee8ff76963a38ed)
+}
+
+var fileDescriptor_fee8ff76963a38ed = []byte{
+	// 5503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x4d, 0x6c, 0x1c, 0x47,
+	0x76, 0x30, 0x9a, 0x14, 0xff, 0x1e, 0xff, 0x4b, 0xfc, 0x19, 0x0e, 0x25, 0x8a, 0x6c, 0x4b, 0x36,
+	0x2d, 0xaf, 0x29, 0x8b, 0xb6, 0x65, 0xf9, 0x6f, 0xbd, 0x12, 0x29, 0xc9, 0xe3, 0x4f, 0xbf, 0x4d,
+	0x5a, 0xfe, 0xb2, 0x49, 0xdc, 0xdb, 0x9c, 0xae, 0x21, 0x3b, 0xec, 0xe9, 0x1e, 0x75, 0xf7, 0x90,
+	0x1a, 0x1f, 0x02, 0x27, 0x1b, 0x04, 0xc8, 0x22, 0xd8, 0xdd, 0x2c, 0x92, 0x20, 0x40, 0x82, 0x00,
+	0xc1, 0x06, 0x58, 0xac, 0x91, 0x43, 0x80, 0x04, 0xc8, 0x21, 0xc8, 0x29, 0x97, 0xbd, 0x04, 0x58,
+	0x20, 0xb9, 0xe4, 0x16, 0x18, 0xd9, 0x43, 0x02, 0xe4, 0xb6, 0xb7, 0x00, 0x41, 0x50, 0x7f, 0x3d,
+	0xfd, 0x53, 0x5d, 0x3d, 0x43, 0x2e, 0x60, 0xad, 0xe3, 0x1b, 0xa7, 0xaa, 0xde, 0xab, 0x57, 0xaf,
+	0xde, 0x7b, 0xf5, 0xea, 0xbd, 0x57, 0x4d, 0xb8, 0xd4, 0xde, 0xc3, 0xc1, 0x95, 0xba, 0x65, 0x63,
+	0xaf, 0x8e, 0xaf, 0x1c, 0x38, 0x61, 0xe4, 0x07, 0x9d, 0x2b, 0x47, 0x57, 0xaf, 0x84, 0x38, 0x38,
+	0x72, 0xea, 0x78, 0xa3, 0x15, 0xf8, 0x91, 0x8f, 0x16, 0xc9, 0xb0, 0x0d, 0x3e, 0x6c, 0x83, 0x0f,
+	0xdb, 0x38, 0xba, 0x5a, 0x5d, 0xd9, 0xf7, 0xfd, 0x7d, 0x17, 0x5f, 0xa1, 0xc3, 0xf6, 0xda, 0x8d,
+	0x2b, 0x76, 0x3b, 0xb0, 0x22, 0xc7, 0xf7, 0x18, 0x60, 0xf5, 0x42, 0xb6, 0x3f, 0x72, 0x9a, 0x38,
+	0x8c, 0xac, 0x66, 0x8b, 0x0f, 0xc8, 0x21, 0x38, 0x0e, 0xac, 0x56, 0x0b, 0x07, 0x21, 0xef, 0x5f,
+	0x4d, 0x11, 0x68, 0xb5, 0x1c, 0x42, 0x5c, 0xdd, 0x6f, 0x36, 0xe3, 0x29, 0xd6, 0x64, 0x23, 0x04,
+	0x89, 0x9c, 0x0a, 0xd9, 0x90, 0x27, 0x6d, 0x1c, 0x0f, 0xd0, 0x65, 0x03, 0x22, 0x2b, 0x3c, 0x74,
+	0x9d, 0x30, 0x52, 0x8d, 0x39, 0xf6, 0x83, 0xc3, 0x86, 0xeb, 0x1f, 0xf3, 0x31, 0x97, 0x65, 0x63,
+	0x38, 0x2b, 0xcd, 0xcc, 0xd8, 0xf5, 0xb2, 0xb1, 0x38, 0xe0, 0x23, 0x9f, 0x4b, 0x8f, 0xb4, 0x9b,
+	0x8e, 0x47, 0xb9, 0xe0, 0xb6, 0xc3, 0xa8, 0x6c, 0x50, 0x9a, 0x11, 0x6b, 0xf2, 0x41, 0x4f, 0xda,
+	0xb8, 0xcd, 0xb7, 0xba, 0xfa, 0x82, 0x7c, 0x48, 0x80, 0x5b, 0xae, 0x53, 0x4f, 0x6e, 0x6d, 0x7a,
+	0x67, 0xc2, 0x03, 0x2b, 0xc0, 0x36, 0x19, 0x69, 0x79, 0x62, 0xb6, 0x8b, 0x05, 0x23, 0xd2, 0x34,
+	0x5d, 0x2a, 0x18, 0x95, 0x66, 0x97, 0xfe, 0x67, 0x23, 0x70, 0x7e, 0x27, 0xb2, 0x82, 0xe8, 0x23,
+	0xde, 0x7e, 0xeb, 0x29, 0xae, 0xb7, 0x09, 0x3d, 0x06, 0x7e, 0xd2, 0xc6, 0x61, 0x84, 0xee, 0xc2,
+	0x48, 0xc0, 0xfe, 0xac, 0x68, 0xab, 0xda, 0xfa, 0xf8, 0xe6, 0xe6, 0x46, 0x4a, 0x6c, 0xad, 0x96,
+	0xb3, 0x71, 0x74, 0x75, 0x43, 0x89, 0xc4, 0x10, 0x28, 0xd0, 0x32, 0x8c, 0xd9, 0x7e, 0xd3, 0x72,
+	0x3c, 0xd3, 0xb1, 0x2b, 0x03, 0xab, 0xda, 0xfa, 0x98, 0x31, 0xca, 0x1a, 0x6a, 0x36, 0xfa, 0x35,
+	0x98, 0x6f, 0x59, 0x01, 0xf6, 0x22, 0x13, 0x0b, 0x04, 0xa6, 0xe3, 0x35, 0xfc, 0xca, 0x20, 0x9d,
+	0x78, 0x5d, 0x3a, 0xf1, 0x43, 0x0a, 0x11, 0xcf, 0x58, 0xf3, 0x1a, 0xbe, 0x71, 0xb6, 0x95, 0x6f,
+	0x44, 0x15, 0x18, 0xb1, 0xa2, 0x08, 0x37, 0x5b, 0x51, 0xe5, 0xcc, 0xaa, 0xb6, 0x3e, 0x64, 0x88,
+	0x9f, 0x68, 0x0b, 0xa6, 0xf1, 0xd3, 0x96, 0xc3, 0x54, 0xcc, 0x24, 0xba, 0x54, 0x19, 0xa2, 0x33,
+	0x56, 0x37, 0x98, 0x1e, 0x6d, 0x08, 0x3d, 0xda, 0xd8, 0x15, 0x8a, 0x66, 0x4c, 0x75, 0x41, 0x48,
+	0x23, 0x6a, 0xc0, 0x52, 0xdd, 0xf7, 0x22, 0xc7, 0x6b, 0x63, 0xd3, 0x0a, 0x4d, 0x0f, 0x1f, 0x9b,
+	0x8e, 0xe7, 0x44, 0x8e, 0x15, 0xf9, 0x41, 0x65, 0x78, 0x55, 0x5b, 0x9f, 0xda, 0x7c, 0x49, 0xba,
+	0x80, 0x2d, 0x0e, 0x75, 0x23, 0xbc, 0x8f, 0x8f, 0x6b, 0x02, 0xc4, 0x58, 0xa8, 0x4b, 0xdb, 0x51,
+	0x0d, 0x66, 0x45, 0x8f, 0x6d, 0x36, 0x2c, 0xc7, 0x6d, 0x07, 0xb8, 0x32, 0x42, 0xc9, 0x3d, 0x27,
+	0xc5, 0x7f, 0x9b, 0x8d, 0x31, 0x66, 0x62, 0x30, 0xde, 0x82, 0x0c, 0x58, 0x70, 0xad, 0x30, 0x32,
+	0xeb, 0x7e, 0xb3, 0xe5, 0x62, 0xba, 0xf8, 0x00, 0x87, 0x6d, 0x37, 0xaa, 0x8c, 0x2a, 0xf0, 0x3d,
+	0xb4, 0x3a, 0xae, 0x6f, 0xd9, 0xc6, 0x1c, 0x81, 0xdd, 0x8a, 0x41, 0x0d, 0x0a, 0x89, 0xfe, 0x3f,
+	0x2c, 0x37, 0x9c, 0x20, 0x8c, 0x4c, 0x1b, 0xd7, 0x9d, 0x90, 0xf2, 0xd3, 0x0a, 0x0f, 0xcd, 0x3d,
+	0xab, 0x7e, 0xe8, 0x37, 0x1a, 0x95, 0x31, 0x8a, 0x78, 0x29, 0xc7, 0xd7, 0x6d, 0x6e, 0xe0, 0x8c,
+	0x0a, 0x85, 0xde, 0xe6, 0xc0, 0xbb, 0x56, 0x78, 0x78, 0x93, 0x81, 0xa2, 0x23, 0x98, 0x69, 0x59,
+	0x41, 0xe4, 0x50, 0x3a, 0xeb, 0xbe, 0xd7, 0x70, 0xf6, 0x2b, 0xb0, 0x3a, 0xb8, 0x3e, 0xbe, 0xf9,
+	0xff, 0x36, 0x0a, 0x0c, 0xa9, 0x5a, 0x2a, 0x89, 0xe8, 0x30, 0x74, 0x5b, 0x14, 0xdb, 0x2d, 0x2f,
+	0x0a, 0x3a, 0xc6, 0x74, 0x2b, 0xdd, 0x8a, 0xae, 0xc1, 0x22, 0x97, 0x5e, 0x13, 0x5b, 0xfb, 0x38,
+	0xe8, 0x0a, 0x67, 0x65, 0x7c, 0x55, 0x5b, 0x1f, 0x35, 0xe6, 0x79, 0xf7, 0x2d, 0xd2, 0x1b, 0x4f,
+	0x52, 0xbd, 0x09, 0x73, 0xb2, 0x09, 0xd0, 0x0c, 0x0c, 0x1e, 0xe2, 0x0e, 0x55, 0xa6, 0x31, 0x83,
+	0xfc, 0x89, 0xe6, 0x60, 0xe8, 0xc8, 0x72, 0xdb, 0x98, 0x2b, 0x04, 0xfb, 0xf1, 0xd6, 0xc0, 0x75,
+	0x4d, 0xff, 0xae, 0x06, 0x2b, 0x45, 0x6b, 0x08, 0x5b, 0xbe, 0x17, 0x62, 0x34, 0x0f, 0xc3, 0x41,
+	0x9b, 0xaa, 0x13, 0xc3, 0x38, 0x14, 0xb4, 0x89, 0x2e, 0x7d, 0x08, 0x93, 0xa9, 0x1d, 0xa0, 0xb8,
+	0xc7, 0x37, 0x5f, 0x91, 0x6f, 0xa9, 0xef, 0xba, 0xb7, 0xfd, 0x20, 0xc9, 0x75, 0x81, 0xdf, 0x98,
+	0xb0, 0x13, 0xad, 0xfa, 0x5f, 0x0e, 0xc0, 0xca, 0x8e, 0xb3, 0xef, 0x59, 0x6e, 0xa1, 0xc1, 0xb8,
+	0x97, 0x35, 0x18, 0xaf, 0xca, 0x0d, 0x86, 0x12, 0x4b, 0x8f, 0x16, 0xa3, 0x01, 0xcb, 0xf8, 0x69,
+	0x84, 0x03, 0xcf, 0x72, 0xe3, 0x83, 0x20, 0xb1, 0x3f, 0xcc, 0x6e, 0x3c, 0x2f, 0x9d, 0x3f, 0x3f,
+	0xf3, 0x92, 0x40, 0x95, 0xeb, 0x42, 0x1b, 0x70, 0xb6, 0x7e, 0xe0, 0xb8, 0x76, 0x77, 0x12, 0xdf,
+	0x73, 0x3b, 0xd4, 0x8e, 0x8c, 0x1a, 0xb3, 0xb4, 0x4b, 0x00, 0x3d, 0xf0, 0xdc, 0x8e, 0xbe, 0x06,
+	0x17, 0x0a, 0xd7, 0xc7, 0xf8, 0xaa, 0xff, 0x6c, 0x00, 0x5e, 0xe0, 0x63, 0x9c, 0xe8, 0x40, 0x6d,
+	0x83, 0x1f, 0x67, 0x59, 0xfa, 0x8e, 0x8a, 0xa5, 0x65, 0xe8, 0x7a, 0xe4, 0xed, 0xa7, 0x9a, 0x44,
+	0xe1, 0x06, 0xa9, 0xc2, 0x7d, 0x58, 0xac, 0x70, 0xbd, 0x91, 0xd0, 0x9b, 0xea, 0xfd, 0x42, 0x54,
+	0xe8, 0x06, 0xac, 0x97, 0x13, 0xa5, 0xd4, 0x25, 0xfd, 0x3b, 0x1a, 0x9c, 0x37, 0x70, 0x88, 0x4f,
+	0x7d, 0x48, 0x2a, 0x91, 0xf4, 0xb6, 0x2d, 0xfa, 0x1b, 0xb0, 0x52, 0x84, 0x46, 0xbd, 0x8a, 0xcf,
+	0x06, 0x60, 0x6d, 0x17, 0x07, 0x4d, 0xc7, 0xb3, 0x22, 0x5c, 0xb8, 0x92, 0x87, 0xd9, 0x95, 0x5c,
+	0x93, 0xae, 0xa4, 0x14, 0xd1, 0x2f, 0xb9, 0x02, 0x5f, 0x04, 0x5d, 0xb5, 0x44, 0xae, 0xc3, 0xdf,
+	0xd7, 0x60, 0x75, 0x1b, 0x87, 0xf5, 0xc0, 0xd9, 0x2b, 0xe6, 0xe8, 0x83, 0x2c, 0x47, 0x5f, 0x97,
+	0x2e, 0xa7, 0x0c, 0x4f, 0x8f, 0xe2, 0xf1, 0x3f, 0x83, 0xb0, 0xa6, 0x40, 0xc5, 0x45, 0xc4, 0x85,
+	0xc5, 0xae, 0x8b, 0xc5, 0x54, 0x9b, 0x1f, 0xc0, 0x4a, 0x9b, 0x9d, 0x43, 0xb8, 0x95, 0x04, 0x35,
+	0x16, 0xb0, 0xb4, 0x1d, 0xed, 0xc1, 0x62, 0x7e, 0x6f, 0x99, 0x67, 0xc7, 0x4e, 0xa5, 0xcb, 0xbd,
+	0xcd, 0x46, 0x7d, 0xbb, 0xf9, 0x63, 0x59, 0x33, 0xfa, 0x08, 0x50, 0x0b, 0x7b, 0xb6, 0xe3, 0xed,
+	0x9b, 0x56, 0x3d, 0x72, 0x8e, 0x9c, 0xc8, 0xc1, 0x21, 0x37, 0x57, 0x05, 0x8e, 0x23, 0x1b, 0x7e,
+	0x83, 0x8d, 0xee, 0x50, 0xe4, 0xb3, 0xad, 0x54, 0xa3, 0x83, 0x43, 0xf4, 0x2b, 0x30, 0x23, 0x10,
+	0x53, 0x31, 0x09, 0xb0, 0x57, 0x39, 0x43, 0xd1, 0x6e, 0xa8, 0xd0, 0x6e, 0x91, 0xb1, 0x69, 0xca,
+	0xa7, 0x5b, 0x89, 0xae, 0x00, 0x7b, 0x68, 0xa7, 0x8b, 0x5a, 0x1c, 0xb2, 0xdc, 0xf1, 0x54, 0x52,
+	0x2c, 0x8e, 0xe9, 0x14, 0x52, 0xd1, 0xa8, 0x3f, 0x85, 0xb9, 0x47, 0xe4, 0x0e, 0x26, 0xb8, 0x27,
+	0xc4, 0x70, 0x2b, 0x2b, 0x86, 0x2f, 0x4a, 0xe7, 0x90, 0xc1, 0xf6, 0x28, 0x7a, 0x3f, 0xd4, 0x60,
+	0x3e, 0x03, 0xce, 0xc5, 0xed, 0x3d, 0x98, 0xa0, 0xf7, 0x42, 0xe1, 0x5e, 0x6a, 0x3d, 0xb8, 0x97,
+	0xe3, 0x14, 0x82, 0x7b, 0x95, 0x35, 0x98, 0x12, 0x08, 0x7e, 0x03, 0xd7, 0x23, 0x6c, 0x73, 0xc1,
+	0xd1, 0x8b, 0xd7, 0x60, 0xf0, 0x91, 0xc6, 0xe4, 0x93, 0xe4, 0x4f, 0xfd, 0x77, 0x34, 0xa8, 0x52,
+	0x03, 0xba, 0x13, 0x39, 0xf5, 0xc3, 0x0e, 0xf1, 0x6a, 0xee, 0x3a, 0x61, 0x24, 0xd8, 0x54, 0xcb,
+	0xb2, 0xe9, 0x4a, 0xb1, 0x25, 0x97, 0x62, 0xe8, 0x91, 0x59, 0xe7, 0x61, 0x59, 0x8a, 0x83, 0x5b,
+	0x96, 0x9f, 0x0e, 0xc0, 0xc2, 0x1d, 0x1c, 0xdd, 0x6b, 0x47, 0xd6, 0x9e, 0x8b, 0x77, 0x22, 0x2b,
+	0xc2, 0x86, 0x0c, 0xad, 0x96, 0xb1, 0xa7, 0x1f, 0x02, 0x92, 0x98, 0xd1, 0x81, 0xbe, 0xcc, 0xe8,
+	0x6c, 0x4e, 0xc3, 0xd0, 0xab, 0xb0, 0x80, 0x9f, 0xb6, 0x28, 0x03, 0x4d, 0x0f, 0x3f, 0x8d, 0x4c,
+	0x7c, 0x44, 0xae, 0x69, 0x8e, 0x4d, 0x2d, 0xf4, 0xa0, 0x71, 0x56, 0xf4, 0xde, 0xc7, 0x4f, 0xa3,
+	0x5b, 0xa4, 0xaf, 0x66, 0xa3, 0x57, 0x60, 0xae, 0xde, 0x0e, 0xe8, 0x7d, 0x6e, 0x2f, 0xb0, 0xbc,
+	0xfa, 0x81, 0x19, 0xf9, 0x87, 0x54, 0x7b, 0xb4, 0xf5, 0x09, 0x03, 0xf1, 0xbe, 0x9b, 0xb4, 0x6b,
+	0x97, 0xf4, 0xa0, 0x5f, 0x85, 0xb9, 0x23, 0x1c, 0x50, 0x9f, 0x95, 0xfb, 0x14, 0xa6, 0x13, 0xe1,
+	0x26, 0x57, 0x8a, 0xac, 0xc0, 0x92, 0x4b, 0x34, 0x59, 0xc1, 0x63, 0x06, 0xf2, 0x3e, 0x83, 0xa8,
+	0x45, 0xb8, 0x69, 0xa0, 0xa3, 0x5c, 0x9b, 0xfe, 0x77, 0x63, 0xb0, 0x98, 0x63, 0x29, 0x17, 0x50,
+	0x39, 0xdb, 0xb4, 0xd3, 0xb2, 0xed, 0x36, 0x4c, 0xc6, 0x68, 0xa3, 0x4e, 0x0b, 0xf3, 0x8d, 0x58,
+	0x53, 0x62, 0xdc, 0xed, 0xb4, 0xb0, 0x31, 0x71, 0x9c, 0xf8, 0x85, 0x74, 0x98, 0x94, 0x71, 0x7d,
+	0xdc, 0x4b, 0x70, 0xfb, 0x31, 0x2c, 0xb5, 0x02, 0x7c, 0xe4, 0xf8, 0xed, 0xd0, 0x0c, 0x89, 0x9b,
+	0x83, 0xed, 0xee, 0xf8, 0x33, 0x74, 0xde, 0xe5, 0xdc, 0xb5, 0xab, 0xe6, 0x45, 0xd7, 0x5e, 0x7b,
+	0x4c, 0x7c, 0x25, 0x63, 0x41, 0x40, 0xef, 0x30, 0x60, 0x81, 0xf7, 0x65, 0x38, 0x4b, 0x2f, 0x89,
+	0xec, 0x56, 0x17, 0x63, 0x1c, 0xa2, 0x14, 0xcc, 0x90, 0xae, 0xdb, 0xa4, 0x47, 0x0c, 0x7f, 0x0b,
+	0xc6, 0xe8, 0x85, 0xcf, 0x75, 0xc2, 0x88, 0x5e, 0x7b, 0xc7, 0x37, 0xcf, 0xcb, 0x3d, 0x08, 0x21,
+	0xf2, 0xa3, 0x11, 0xff, 0x0b, 0xdd, 0x81, 0x99, 0x90, 0xaa, 0x83, 0xd9, 0x45, 0x31, 0xd2, 0x0b,
+	0x8a, 0xa9, 0x30, 0xa5, 0x45, 0xe8, 0x35, 0x58, 0xa8, 0xbb, 0x0e, 0xa1, 0xd4, 0x75, 0xf6, 0x02,
+	0x2b, 0xe8, 0x98, 0x5c, 0x1e, 0xe8, 0xc5, 0x76, 0xcc, 0x98, 0x63, 0xbd, 0x77, 0x59, 0x27, 0x97,
+	0x9f, 0x04, 0x54, 0x03, 0x5b, 0x51, 0x3b, 0xc0, 0x31, 0xd4, 0x58, 0x12, 0xea, 0x36, 0xeb, 0x14,
+	0x50, 0x17, 0x60, 0x9c, 0x43, 0x39, 0xcd, 0x96, 0x5b, 0x01, 0x3a, 0x14, 0x58, 0x53, 0xad, 0xd9,
+	0x72, 0x51, 0x08, 0x97, 0xb3, 0xab, 0x32, 0xc3, 0xfa, 0x01, 0xb6, 0xdb, 0x2e, 0x36, 0x23, 0x9f,
+	0x6d, 0x16, 0x8d, 0x3a, 0xf8, 0xed, 0x88, 0x5e, 0x29, 0x95, 0x17, 0xe4, 0x8b, 0xe9, 0xb5, 0xee,
+	0x70, 0x4c, 0xbb, 0x3e, 0xdd, 0xb7, 0x5d, 0x86, 0x86, 0xf8, 0x3b, 0x6c, 0xab, 0x88, 0xfc, 0x77,
+	0x17, 0x32, 0x41, 0x03, 0x1f, 0xb3, 0xb4, 0x6b, 0x87, 0xf4, 0x88, 0x55, 0x14, 0xe9, 0xea, 0x64,
+	0xa1, 0xae, 0xde, 0x85, 0xa9, 0x58, 0xb6, 0x43, 0xa2, 0x4c, 0x95, 0x29, 0x1a, 0xe4, 0xb8, 0x94,
+	0xde, 0x2a, 0x16, 0x79, 0x4a, 0xca, 0x37, 0xd3, 0xbc, 0x58, 0x31, 0xe8, 0x4f, 0x54, 0x87, 0xb9,
+	0x18, 0x5b, 0xdd, 0xf5, 0x43, 0xcc, 0x71, 0x4e, 0x53, 0x9c, 0x57, 0x7b, 0xf4, 0x46, 0x08, 0x20,
+	0xc1, 0xd7, 0x0e, 0x8d, 0x58, 0x9f, 0xe3, 0x46, 0xa2, 0xe5, 0xb3, 0x69, 0xf3, 0x42, 0x5c, 0x84,
+	0x19, 0xd9, 0x81, 0xdb, 0xa5, 0x3a, 0x65, 0x5c, 0x1c, 0x1c, 0x1a, 0x33, 0x47, 0x99, 0x16, 0xf4,
+	0x0e, 0x2c, 0x3b, 0x44, 0xe7, 0x32, 0x7b, 0x8c, 0x3d, 0x62, 0x67, 0xec, 0xca, 0x2c, 0xf5, 0x31,
+	0x17, 0x9d, 0x30, 0x6d, 0xea, 0x6f, 0xb1, 0x6e, 0xb4, 0x06, 0x13, 0xc2, 0xd6, 0x85, 0xce, 0x27,
+	0xb8, 0x82, 0x98, 0x6a, 0xf3, 0xb6, 0x1d, 0xe7, 0x13, 0xac, 0xff, 0x5c, 0x83, 0x45, 0x72, 0x45,
+	0xff, 0xbf, 0x75, 0x1a, 0xe8, 0x3f, 0x1a, 0x85, 0x4a, 0x7e, 0xd9, 0x5f, 0x59, 0xec, 0xaf, 0x2c,
+	0xf6, 0x97, 0xd1, 0x62, 0x17, 0xe9, 0xc7, 0x44, 0xa1, 0x05, 0x96, 0x9a, 0xb3, 0xc9, 0x53, 0x9b,
+	0xb3, 0x5f, 0x3e, 0xc3, 0xae, 0xff, 0xe3, 0x00, 0xac, 0x1a, 0xb8, 0xee, 0x07, 0x76, 0x32, 0x84,
+	0xc9, 0xd5, 0xe2, 0x8b, 0xb4, 0x94, 0x17, 0x60, 0x3c, 0x16, 0x9c, 0xd8, 0x08, 0x80, 0x68, 0xaa,
+	0xd9, 0x68, 0x11, 0x46, 0xa8, 0x8c, 0x71, 0x8d, 0x1f, 0x34, 0x86, 0xc9, 0xcf, 0x9a, 0x8d, 0xce,
+	0x03, 0x88, 0xa8, 0x33, 0xd7, 0xdd, 0x31, 0x63, 0x8c, 0xb7, 0xd4, 0x6c, 0x64, 0xc0, 0x44, 0xcb,
+	0x77, 0x5d, 0x53, 0xdc, 0x55, 0x86, 0x15, 0x77, 0x15, 0x69, 0x74, 0x97, 0xdd, 0x55, 0xc6, 0x09,
+	0x12, 0xfe, 0x43, 0xff, 0xed, 0x51, 0x58, 0x53, 0x70, 0x91, 0x1b, 0xde, 0x9c, 0x85, 0xd4, 0x4e,
+	0x66, 0x21, 0x95, 0xd6, 0x6f, 0xe0, 0xe4, 0xd6, 0xef, 0x6b, 0x80, 0x04, 0x7f, 0xed, 0xac, 0xf9,
+	0x9d, 0x89, 0x7b, 0xc4, 0xe8, 0x75, 0x62, 0xc0, 0x24, 0xa6, 0x77, 0x90, 0x58, 0xa8, 0x14, 0xde,
+	0x9c, 0x45, 0x1f, 0xca, 0x5b, 0xf4, 0x44, 0x8a, 0x69, 0x38, 0x9d, 0x62, 0xba, 0x0e, 0x15, 0x6e,
+	0x52, 0xba, 0x01, 0x10, 0xe1, 0x20, 0x8c, 0x50, 0x07, 0x61, 0x81, 0xf5, 0xc7, 0xb2, 0x23, 0xfc,
+	0x03, 0x23, 0x11, 0xc8, 0xa7, 0x21, 0x13, 0x96, 0x9b, 0x79, 0xb9, 0x48, 0x1b, 0x77, 0x03, 0xcb,
+	0x0b, 0x89, 0x29, 0x4b, 0x85, 0x09, 0xe2, 0x28, 0x3e, 0x0d, 0x96, 0x7c, 0x0c, 0xe7, 0x24, 0x01,
+	0x99, 0xae, 0x09, 0x1f, 0xeb, 0xc5, 0x84, 0x2f, 0xe5, 0xc4, 0x3d, 0xb6, 0xe6, 0x05, 0xde, 0x27,
+	0x14, 0x79, 0x9f, 0x6b, 0x30, 0x91, 0xb2, 0x79, 0xe3, 0xd4, 0xe6, 0x8d, 0xef, 0x25, 0x8c, 0xdd,
+	0x0d, 0x98, 0xea, 0x6e, 0x2b, 0x4d, 0xd1, 0x4d, 0x94, 0xa6, 0xe8, 0x26, 0x63, 0x08, 0x9a, 0xa1,
+	0x7b, 0x17, 0x26, 0xc4, 0x5e, 0x53, 0x04, 0x93, 0xa5, 0x08, 0xc6, 0xf9, 0x78, 0x0a, 0x6e, 0xc1,
+	0xc8, 0x93, 0x36, 0xa6, 0x46, 0x76, 0x8a, 0xc6, 0x7f, 0xee, 0x14, 0x46, 0xc1, 0x4b, 0xb5, 0x88,
+	0x86, 0x28, 0x1c, 0x1c, 0xb2, 0xb8, 0xb7, 0xc0, 0x9b, 0xf3, 0x05, 0xa7, 0x73, 0xbe, 0x60, 0xf5,
+	0x63, 0x98, 0x48, 0xc2, 0x4a, 0x42, 0xe1, 0xd7, 0x93, 0xa1, 0xf0, 0xa2, 0x10, 0x89, 0x50, 0x4c,
+	0x16, 0x2a, 0x49, 0x84, 0xcb, 0xbb, 0xa6, 0x54, 0x04, 0xc6, 0xbe, 0x32, 0xa5, 0x39, 0x53, 0x9a,
+	0x64, 0x8d, 0xd4, 0x94, 0xfe, 0xfb, 0xa0, 0x30, 0xa5, 0x52, 0x2e, 0x72, 0x53, 0xfa, 0x01, 0x4c,
+	0x67, 0x4c, 0x95, 0xd2, 0x98, 0xf2, 0x60, 0x06, 0x35, 0x36, 0xc6, 0x54, 0xda, 0x94, 0xe5, 0x84,
+	0x7b, 0xa0, 0x3f, 0xe1, 0x4e, 0x58, 0xae, 0xc1, 0xb4, 0xe5, 0xfa, 0x18, 0x56, 0xd2, 0x8a, 0x67,
+	0xfa, 0x0d, 0x33, 0x3a, 0x70, 0x42, 0x33, 0x99, 0x4d, 0x57, 0x4f, 0x55, 0x4d, 0x29, 0xe2, 0x83,
+	0xc6, 0xee, 0x81, 0x13, 0xde, 0xe0, 0xf8, 0x6b, 0x30, 0x7b, 0x80, 0xad, 0x20, 0xda, 0xc3, 0x56,
+	0x64, 0xda, 0x38, 0xb2, 0x1c, 0x37, 0xe4, 0x01, 0x1f, 0x75, 0x80, 0x70, 0x26, 0x06, 0xdb, 0x66,
+	0x50, 0xf9, 0xa3, 0x69, 0xf8, 0x64, 0x47, 0xd3, 0x0b, 0x30, 0x1d, 0xe3, 0x61, 0x62, 0x4d, 0x6d,
+	0xf4, 0x98, 0x11, 0x3b, 0x46, 0xdb, 0xb4, 0x55, 0xff, 0x63, 0x0d, 0x9e, 0x63, 0xbb, 0x99, 0x52,
+	0x76, 0x9e, 0x14, 0xef, 0xea, 0x8b, 0x91, 0x0d, 0x2a, 0x5e, 0x2f, 0x0a, 0x2a, 0x96, 0xa1, 0xea,
+	0x31, 0xba, 0xf8, 0x37, 0x83, 0x70, 0x51, 0x8d, 0x8d, 0x8b, 0x20, 0xee, 0x9e, 0x7f, 0x01, 0x6f,
+	0xe3, 0x24, 0xbe, 0x75, 0x72, 0xeb, 0x66, 0x4c, 0x87, 0x19, 0x49, 0xff, 0xa1, 0x06, 0x2b, 0xdd,
+	0xb0, 0x3c, 0xf1, 0xa1, 0x6d, 0x27, 0x6c, 0x59, 0x51, 0xfd, 0xc0, 0x74, 0xfd, 0xba, 0xe5, 0xba,
+	0x9d, 0xca, 0x00, 0xb5, 0xa9, 0x1f, 0x2b, 0x66, 0x2d, 0x5f, 0xce, 0x46, 0x37, 0x6e, 0xbf, 0xeb,
+	0x6f, 0xf3, 0x19, 0xee, 0xb2, 0x09, 0x98, 0xa9, 0x5d, 0xb6, 0x8a, 0x47, 0x54, 0x7f, 0x13, 0x56,
+	0xcb, 0x10, 0x48, 0xec, 0xed, 0x76, 0xda, 0xde, 0xca, 0xb3, 0x02, 0xc2, 0x0c, 0x50, 0x5c, 0x02,
+	0x31, 0x3d, 0x99, 0x13, 0xb6, 0xf7, 0xfb, 0x1a, 0xb1, 0xbd, 0xb9, 0x65, 0xde, 0xb6, 0x1c, 0xb7,
+	0x2b, 0x4b, 0x3d, 0xa6, 0x93, 0xca, 0xf0, 0xf4, 0x28, 0x48, 0xcf, 0x11, 0x3b, 0x56, 0x88, 0x89,
+	0x07, 0xab, 0xff, 0x50, 0x03, 0x3d, 0x6f, 0xed, 0xde, 0x17, 0xea, 0x29, 0x28, 0x7f, 0x94, 0xa5,
+	0xfc, 0x8d, 0x02, 0xca, 0xcb, 0x30, 0xf5, 0x48, 0xfb, 0x43, 0xa2, 0x9c, 0x0a, 0x5c, 0x5c, 0x36,
+	0x5f, 0x84, 0x99, 0xba, 0xe5, 0xd5, 0x71, 0x7c, 0x02, 0x60, 0x76, 0xa6, 0x8d, 0x1a, 0xd3, 0xac,
+	0xdd, 0x10, 0xcd, 0x49, 0x7d, 0x4f, 0xe2, 0x3c, 0xa5, 0xbe, 0xab, 0x50, 0xf5, 0xb8, 0xd4, 0xe7,
+	0x63, 0x75, 0x2f, 0x40, 0x96, 0x48, 0x58, 0x4a, 0x06, 0x9e, 0x46, 0xc2, 0x0a, 0xf1, 0xf4, 0x2d,
+	0x61, 0x32, 0x4c, 0x29, 0x09, 0xcb, 0x2f, 0x90, 0xee, 0x4f, 0x97, 0xf2, 0x9e, 0x25, 0xac, 0x0c,
+	0x53, 0x8f, 0xb4, 0x5f, 0x92, 0x8b, 0x43, 0x8c, 0x8b, 0x53, 0xff, 0xb7, 0x1a, 0x5c, 0x30, 0x70,
+	0xd3, 0x3f, 0xc2, 0xac, 0x12, 0xe1, 0x59, 0x89, 0xe3, 0xa5, 0x1d, 0xa3, 0xc1, 0x8c, 0x63, 0xa4,
+	0xeb, 0x44, 0x56, 0x8a, 0xa8, 0xe6, 0x4b, 0xfb, 0xfb, 0x01, 0xb8, 0xc4, 0x97, 0xc0, 0x96, 0x5d,
+	0x98, 0x06, 0x57, 0x2e, 0xd0, 0x82, 0xa9, 0xb4, 0x0e, 0xf2, 0xc5, 0xbd, 0x55, 0xb0, 0x7f, 0x3d,
+	0x4c, 0x68, 0x4c, 0xa6, 0xb4, 0x17, 0xed, 0xc1, 0x62, 0x5c, 0x69, 0x20, 0x2d, 0x2f, 0x94, 0x27,
+	0xa1, 0x6f, 0x71, 0x98, 0x4c, 0x12, 0x1a, 0xcb, 0x9a, 0xfb, 0xae, 0x32, 0x58, 0x87, 0xe7, 0xcb,
+	0xd6, 0xc2, 0xf9, 0xfc, 0x0f, 0x1a, 0x2c, 0x8b, 0xc0, 0x91, 0xe4, 0x22, 0xff, 0x85, 0x88, 0xcf,
+	0x65, 0x98, 0x75, 0x42, 0x33, 0x5d, 0xed, 0x47, 0x79, 0x39, 0x6a, 0x4c, 0x3b, 0xe1, 0xed, 0x64,
+	0x1d, 0x9f, 0xbe, 0x02, 0xe7, 0xe4, 0xe4, 0xf3, 0xf5, 0x7d, 0x9b, 0x3a, 0x2c, 0xc4, 0x58, 0xa7,
+	0x13, 0xe7, 0x39, 0xd3, 0xfa, 0x45, 0x2c, 0x74, 0x0d, 0x26, 0x78, 0x29, 0x27, 0xb6, 0x13, 0xb1,
+	0xdc, 0xb8, 0xad, 0x66, 0xa3, 0x8f, 0xe0, 0x6c, 0x5d, 0x90, 0x9a, 0x98, 0xfa, 0x4c, 0x5f, 0x53,
+	0xa3, 0x18, 0x45, 0x77, 0xee, 0xbb, 0x30, 0x93, 0x28, 0xcf, 0x64, 0x97, 0x84, 0xa1, 0x5e, 0x2f,
+	0x09, 0xd3, 0x5d, 0x50, 0x76, 0x4b, 0x38, 0x0f, 0x20, 0xdc, 0x3d, 0xc7, 0xa6, 0xee, 0xf1, 0xa0,
+	0x31, 0xc6, 0x5b, 0x6a, 0xb6, 0xfe, 0x02, 0x51, 0x66, 0xe5, 0x26, 0xf0, 0xed, 0xfa, 0x8f, 0x01,
+	0xa8, 0x18, 0xbc, 0x76, 0x19, 0x53, 0xd4, 0xe1, 0xe3, 0xcd, 0x2f, 0x72, 0x8b, 0x7e, 0x1d, 0xe6,
+	0x65, 0x99, 0x63, 0x51, 0x01, 0xd2, 0x47, 0xea, 0xf8, 0x6c, 0x3e, 0x75, 0x1c, 0xa2, 0xd7, 0x61,
+	0x98, 0xb2, 0x3e, 0xe4, 0x3b, 0x2a, 0x0f, 0x8d, 0x6c, 0x5b, 0x91, 0x75, 0xd3, 0xf5, 0xf7, 0x0c,
+	0x3e, 0x18, 0x6d, 0xc1, 0x94, 0x87, 0x8f, 0xcd, 0xa0, 0xcd, 0x77, 0x4e, 0x5c, 0x6c, 0x4a, 0xc0,
+	0x27, 0x3c, 0x7c, 0x6c, 0xb4, 0xd9, 0x96, 0x85, 0xfa, 0x32, 0x2c, 0x49, 0x58, 0xcd, 0x37, 0xe2,
+	0x3b, 0x1a, 0x2c, 0xec, 0x74, 0xbc, 0xfa, 0xce, 0x81, 0x15, 0xd8, 0x3c, 0x42, 0xca, 0xb7, 0xe1,
+	0x12, 0x4c, 0x85, 0x7e, 0x3b, 0xa8, 0x63, 0x93, 0x97, 0xb4, 0xf3, 0xbd, 0x98, 0x64, 0xad, 0x5b,
+	0xac, 0x11, 0x2d, 0xc1, 0x68, 0x48, 0x80, 0xc5, 0xf9, 0x36, 0x64, 0x8c, 0xd0, 0xdf, 0x35, 0x1b,
+	0x6d, 0xc0, 0x19, 0x7a, 0x97, 0x1c, 0x2c, 0xbd, 0xe0, 0xd1, 0x71, 0xfa, 0x12, 0x2c, 0xe6, 0x68,
+	0xe1, 0x74, 0xfe, 0x64, 0x08, 0xce, 0x92, 0x3e, 0x71, 0x4e, 0x7e, 0x91, 0xb2, 0x52, 0x81, 0x11,
+	0x11, 0x91, 0x62, 0x9a, 0x2c, 0x7e, 0x12, 0x45, 0xef, 0xde, 0x75, 0xe3, 0x38, 0x42, 0x1c, 0x77,
+	0x20, 0x3c, 0xc9, 0xc7, 0xa1, 0x86, 0xfa, 0x8d, 0x43, 0xa9, 0x95, 0x30, 0x77, 0x93, 0x1f, 0xe9,
+	0xef, 0x26, 0xff, 0x01, 0xcf, 0xfe, 0x74, 0x2f, 0xd5, 0x14, 0xcb, 0x68, 0x29, 0x96, 0x59, 0x02,
+	0x16, 0xbb, 0xc7, 0x14, 0xd7, 0x35, 0x18, 0x11, 0x37, 0xf2, 0xb1, 0x1e, 0x6e, 0xe4, 0x62, 0x70,
+	0x32, 0x9a, 0x00, 0xe9, 0x68, 0xc2, 0x7b, 0x30, 0xc1, 0x72, 0x53, 0xbc, 0x70, 0x7d, 0xbc, 0x87,
+	0xc2, 0xf5, 0x71, 0x9a, 0xb2, 0xe2, 0x35, 0xeb, 0xaf, 0x00, 0xad, 0x3b, 0xe7, 0x4f, 0x39, 0x4c,
+	0xc7, 0xc6, 0x5e, 0xe4, 0x44, 0x1d, 0x1a, 0x0d, 0x1c, 0x33, 0x10, 0xe9, 0xfb, 0x88, 0x76, 0xd5,
+	0x78, 0x0f, 0xba, 0x0f, 0xd3, 0x19, 0xd3, 0xc0, 0x23, 0x7f, 0x97, 0x7a, 0x32, 0x0a, 0xc6, 0x54,
+	0xda, 0x20, 0xe8, 0x0b, 0x30, 0x97, 0x96, 0x64, 0x2e, 0xe2, 0x7f, 0xa0, 0xc1, 0xb2, 0xa8, 0xbc,
+	0x7b, 0x46, 0x3c, 0x3c, 0xfd, 0xbb, 0x1a, 0x9c, 0x93, 0xd3, 0xc4, 0x2f, 0x3f, 0xaf, 0xc2, 0x42,
+	0x93, 0xb5, 0xb3, 0xbc, 0x8c, 0xe9, 0x78, 0x66, 0xdd, 0xaa, 0x1f, 0x60, 0x4e, 0xe1, 0xd9, 0x66,
+	0x02, 0xaa, 0xe6, 0x6d, 0x91, 0x2e, 0xf4, 0x26, 0x2c, 0xe5, 0x80, 0x6c, 0x2b, 0xb2, 0xf6, 0xac,
+	0x50, 0x14, 0xe0, 0x2e, 0xa4, 0xe1, 0xb6, 0x79, 0xaf, 0x7e, 0x0e, 0xaa, 0x82, 0x1e, 0xce, 0xcf,
+	0xf7, 0xfd, 0xb8, 0x74, 0x4a, 0xff, 0xad, 0x81, 0x2e, 0x0b, 0x53, 0xdd, 0x9c, 0xda, 0x75, 0x98,
+	0xf1, 0xda, 0xcd, 0x3d, 0x1c, 0x98, 0x7e, 0xc3, 0xa4, 0x56, 0x2a, 0xa4, 0x74, 0x0e, 0x19, 0x53,
+	0xac, 0xfd, 0x41, 0x83, 0x1a, 0x9f, 0x90, 0x30, 0x5b, 0x58, 0xb5, 0x90, 0x86, 0x16, 0x86, 0x8c,
+	0x51, 0x6e, 0xd6, 0x42, 0x54, 0x83, 0x09, 0xbe, 0x13, 0x6c, 0xa9, 0xf2, 0x2a, 0x53, 0x21, 0x0e,
+	0x2c, 0xd6, 0x43, 0x57, 0x4e, 0x7d, 0xbf, 0x71, 0xbb, 0xdb, 0x80, 0xae, 0xc1, 0x22, 0x9b, 0xa7,
+	0xee, 0x7b, 0x51, 0xe0, 0xbb, 0x2e, 0x0e, 0x28, 0x4f, 0xda, 0xec, 0xa4, 0x18, 0x33, 0xe6, 0x69,
+	0xf7, 0x56, 0xdc, 0xcb, 0xec, 0x22, 0xd5, 0x10, 0xdb, 0x0e, 0x70, 0x18, 0xf2, 0x80, 0xa4, 0xf8,
+	0xa9, 0x6f, 0xc0, 0x2c, 0xcb, 0x6c, 0x11, 0x38, 0x21, 0x3b, 0x49, 0x23, 0xad, 0xa5, 0x8c, 0xb4,
+	0x3e, 0x07, 0x28, 0x39, 0x9e, 0x0b, 0xe3, 0x7f, 0x69, 0x30, 0xcb, 0x9c, 0xf7, 0xa4, 0x97, 0x58,
+	0x8c, 0x06, 0xbd, 0xc3, 0xb3, 0xc0, 0x71, 0xd2, 0x7b, 0x6a, 0xf3, 0x42, 0x01, 0x43, 0x08, 0x46,
+	0x1a, 0x35, 0xa3, 0x79, 0x60, 0x1a, 0x31, 0x4b, 0xc4, 0x5e, 0x07, 0x53, 0xb1, 0xd7, 0x2d, 0x98,
+	0x3e, 0x72, 0x42, 0x67, 0xcf, 0x71, 0x9d, 0xa8, 0xc3, 0x2c, 0x51, 0x79, 0xb8, 0x70, 0xaa, 0x0b,
+	0x42, 0xcd, 0xd0, 0x1a, 0x4c, 0xf0, 0x23, 0xcc, 0xf4, 0x2c, 0x6e, 0x71, 0xc7, 0x8c, 0x71, 0xde,
+	0x76, 0xdf, 0x6a, 0x62, 0xc2, 0x85, 0xe4, 0x72, 0x39, 0x17, 0xbe, 0x47, 0xb9, 0x10, 0xe2, 0xe8,
+	0x51, 0x1b, 0xb7, 0x71, 0x0f, 0x5c, 0xc8, 0xce, 0x34, 0x90, 0x9b, 0x29, 0xcd, 0xa8, 0xc1, 0x3e,
+	0x19, 0xc5, 0xe8, 0xec, 0x12, 0xc4, 0xe9, 0xfc, 0x81, 0x06, 0x73, 0x42, 0xee, 0x9f, 0x19, 0x52,
+	0x1f, 0xc0, 0x7c, 0x86, 0x26, 0xae, 0x85, 0xd7, 0x60, 0xb1, 0x15, 0xf8, 0x75, 0x1c, 0x86, 0x8e,
+	0xb7, 0x6f, 0xd2, 0x57, 0x6e, 0xcc, 0x0e, 0x10, 0x65, 0x1c, 0x24, 0x32, 0xdf, 0xed, 0xa6, 0x90,
+	0xd4, 0x08, 0x84, 0xfa, 0x5f, 0x6b, 0x70, 0xfe, 0x0e, 0x8e, 0x8c, 0xee, 0x9b, 0xb7, 0x7b, 0x38,
+	0x0c, 0xad, 0x7d, 0x1c, 0xbb, 0x2c, 0xef, 0xc1, 0x30, 0x4d, 0x00, 0x31, 0x44, 0xe3, 0x9b, 0x2f,
+	0x14, 0x50, 0x9b, 0x40, 0x41, 0xb3, 0x43, 0x06, 0x07, 0xeb, 0x85, 0x29, 0x2f, 0x01, 0x3a, 0xb6,
+	0x9c, 0xc8, 0x6c, 0xf8, 0x01, 0x7d, 0xa4, 0x45, 0xd6, 0x1b, 0x8a, 0x6b, 0x0b, 0xe9, 0xb9, 0xed,
+	0x07, 0xf7, 0xf1, 0x31, 0x61, 0x48, 0x48, 0x0c, 0xd2, 0x4a, 0x11, 0xc9, 0x9c, 0x1b, 0x4f, 0x60,
+	0x8a, 0x6d, 0x51, 0x93, 0xf7, 0x70, 0xda, 0x3f, 0x28, 0x8c, 0x64, 0xaa, 0x11, 0x6e, 0x50, 0x45,
+	0x16, 0xad, 0x2c, 0x6a, 0x39, 0x19, 0x26, 0xdb, 0xaa, 0x2e, 0xa0, 0xfc, 0xa0, 0x64, 0x64, 0x72,
+	0x88, 0x45, 0x26, 0xbf, 0x91, 0x8e, 0x4c, 0x5e, 0x2e, 0xe7, 0x66, 0x4c, 0x4c, 0x22, 0x2a, 0xd9,
+	0x84, 0xd5, 0x3b, 0x38, 0xda, 0xbe, 0xfb, 0x48, 0xb1, 0x71, 0x35, 0x00, 0xa6, 0xff, 0x5e, 0xc3,
+	0x17, 0x0c, 0xe8, 0x61, 0x3a, 0xc2, 0x64, 0x6a, 0x53, 0xa9, 0x9c, 0x92, 0xbf, 0x42, 0xfd, 0x29,
+	0xac, 0x29, 0xa6, 0xe3, 0x4c, 0xdf, 0x81, 0xd9, 0xc4, 0xd3, 0x49, 0xbe, 0x87, 0x6c, 0xda, 0xe7,
+	0x7b, 0x9b, 0xd6, 0x98, 0x09, 0xd2, 0x0d, 0xa1, 0xfe, 0xaf, 0x1a, 0xcc, 0x19, 0xd8, 0x6a, 0xb5,
+	0x5c, 0x76, 0x7d, 0x8a, 0x57, 0xb7, 0x00, 0xc3, 0x3c, 0x0d, 0xc0, 0x0e, 0x45, 0xfe, 0x4b, 0xfd,
+	0xb2, 0x41, 0x7e, 0xa2, 0x0f, 0x9e, 0xd6, 0x79, 0x3d, 0xd9, 0x4d, 0x44, 0x5f, 0x84, 0xf9, 0xcc,
+	0xd2, 0xb8, 0xe9, 0xf9, 0xb1, 0x06, 0xcb, 0x06, 0x6e, 0x04, 0x38, 0x3c, 0x88, 0x33, 0x22, 0x84,
+	0x1b, 0xcf, 0xe0, 0xda, 0xf5, 0x15, 0x38, 0x27, 0x27, 0x95, 0xaf, 0xe5, 0x9f, 0x34, 0x32, 0x60,
+	0xaf, 0x9d, 0x08, 0xb3, 0xb0, 0x7a, 0x99, 0x67, 0x71, 0x23, 0xb3, 0x39, 0xef, 0x33, 0xb9, 0x9c,
+	0xb7, 0x7e, 0x01, 0xce, 0x17, 0x2c, 0x87, 0x2f, 0xf8, 0x9f, 0x87, 0xe0, 0xdc, 0x87, 0x2d, 0xdb,
+	0x8a, 0xb0, 0xf0, 0x46, 0x1f, 0xb4, 0x08, 0xf2, 0x67, 0x52, 0x72, 0x2f, 0xc0, 0x38, 0x4f, 0xbe,
+	0x74, 0xc4, 0xdd, 0x6a, 0xcc, 0x00, 0xd1, 0x94, 0x2d, 0x44, 0x1b, 0xea, 0xaf, 0x10, 0x6d, 0x17,
+	0x96, 0x8a, 0x2b, 0xb4, 0x86, 0xcb, 0x2a, 0xb4, 0x16, 0x42, 0x79, 0x4d, 0x56, 0x06, 0x2b, 0xab,
+	0x5f, 0x12, 0x58, 0x47, 0xfa, 0xc0, 0x4a, 0x3d, 0x34, 0x81, 0xf5, 0x3e, 0x2c, 0x70, 0xfa, 0xb2,
+	0x28, 0x47, 0xcb, 0x50, 0x9e, 0xa5, 0x80, 0x19, 0x7c, 0xb7, 0x93, 0x19, 0x54, 0x81, 0xaa, 0xf4,
+	0xa1, 0x6d, 0x37, 0x7d, 0x2a, 0xf0, 0x6c, 0xc1, 0x44, 0x80, 0xa3, 0xa0, 0x63, 0xb6, 0x7c, 0xd7,
+	0xa9, 0x77, 0xe8, 0xd5, 0x6d, 0x7c, 0x73, 0xb5, 0x20, 0x04, 0x1b, 0x05, 0x9d, 0x87, 0x74, 0x9c,
+	0x31, 0x1e, 0x74, 0x7f, 0xa0, 0x4b, 0x30, 0x15, 0x10, 0x07, 0x47, 0x64, 0x87, 0x43, 0xfe, 0x48,
+	0x76, 0x92, 0xb6, 0xf2, 0xa4, 0x6f, 0x88, 0xaa, 0x30, 0x9a, 0xb9, 0xba, 0xc5, 0xbf, 0x75, 0x0c,
+	0xe7, 0x0b, 0x84, 0x9a, 0x5b, 0xff, 0x6d, 0x18, 0x15, 0x62, 0xc3, 0xe3, 0xfc, 0xbd, 0xbf, 0xf0,
+	0x89, 0x21, 0xf5, 0x37, 0x61, 0x71, 0xcb, 0x6f, 0x7b, 0xe4, 0xa8, 0xc9, 0x1e, 0x67, 0x2b, 0x00,
+	0x0d, 0x3f, 0xa8, 0xe3, 0xdb, 0x38, 0xaa, 0x1f, 0xf0, 0x64, 0x50, 0xa2, 0x45, 0xb7, 0xa0, 0x92,
+	0x07, 0xe5, 0xc4, 0xdd, 0x82, 0x11, 0xec, 0x45, 0xb4, 0x4c, 0x84, 0x1d, 0x48, 0x2f, 0x15, 0x1c,
+	0x48, 0xfc, 0x82, 0xb3, 0x7d, 0xf7, 0x11, 0xc5, 0xc5, 0x4b, 0x41, 0x38, 0xac, 0xfe, 0x4d, 0x58,
+	0x4e, 0xfb, 0x09, 0xe9, 0xe0, 0x4e, 0x15, 0x46, 0xb9, 0x53, 0x23, 0x9c, 0xae, 0xf8, 0x37, 0x51,
+	0x34, 0x4a, 0xab, 0xd9, 0xa0, 0xe4, 0x0f, 0xe4, 0xc8, 0xdf, 0x87, 0x73, 0x72, 0xdc, 0x7c, 0x09,
+	0x77, 0x60, 0x38, 0xbe, 0x5c, 0x0d, 0xe6, 0x6b, 0x21, 0x52, 0x49, 0xd9, 0x2e, 0x8e, 0x44, 0xd4,
+	0x87, 0x83, 0xeb, 0xff, 0x3d, 0x00, 0x0b, 0xf2, 0x21, 0x2a, 0xcf, 0x96, 0x8a, 0x50, 0xd3, 0x8f,
+	0xba, 0x81, 0x2b, 0x66, 0xa1, 0x26, 0x59, 0xab, 0x08, 0x5c, 0xd1, 0xec, 0x85, 0x65, 0x9b, 0x2e,
+	0x3e, 0xc2, 0x2e, 0xbf, 0x76, 0x8c, 0x91, 0x96, 0xbb, 0xa4, 0x81, 0x99, 0x9b, 0x43, 0x2c, 0xfa,
+	0x59, 0x28, 0x07, 0x68, 0x13, 0x1b, 0x70, 0x11, 0xa6, 0x9a, 0xd6, 0x53, 0x33, 0x81, 0x83, 0x55,
+	0x74, 0x4d, 0x34, 0xad, 0xa7, 0x46, 0x8c, 0xe6, 0x2e, 0x8f, 0x37, 0x08, 0x6f, 0x41, 0x44, 0x65,
+	0x86, 0x4b, 0x6f, 0x31, 0x34, 0x16, 0x11, 0x47, 0xee, 0x58, 0x70, 0x66, 0x09, 0x46, 0x6d, 0xf7,
+	0x09, 0x2b, 0xee, 0x19, 0x61, 0xb1, 0x27, 0xdb, 0x7d, 0xb2, 0xe3, 0x7c, 0x82, 0xd1, 0x43, 0x58,
+	0xf4, 0x5d, 0x1b, 0x87, 0x91, 0x29, 0xde, 0x84, 0x51, 0x63, 0x68, 0xed, 0xe3, 0x72, 0xb3, 0x30,
+	0xc7, 0x20, 0xb9, 0xbc, 0x13, 0xf3, 0x78, 0x63, 0x1f, 0xeb, 0x3f, 0xa6, 0xdc, 0xb7, 0x6c, 0x89,
+	0x80, 0x6f, 0xc2, 0x99, 0xb8, 0x76, 0x6f, 0x6a, 0x73, 0xa5, 0xe8, 0xe6, 0x7b, 0xf7, 0x11, 0xbd,
+	0x13, 0xd0, 0xb1, 0xaa, 0x40, 0x61, 0x3e, 0xd4, 0x38, 0x28, 0x0b, 0x35, 0xee, 0x42, 0xc5, 0xf1,
+	0xc8, 0x08, 0xe7, 0x08, 0x9b, 0xd8, 0x8b, 0x5d, 0xe6, 0x1e, 0xeb, 0x9d, 0xe7, 0x63, 0xe0, 0x5b,
+	0x9e, 0xf0, 0x7d, 0x6b, 0x36, 0x39, 0xcb, 0x5a, 0x04, 0x09, 0x65, 0xea, 0x10, 0x25, 0x6c, 0x94,
+	0x34, 0x50, 0xae, 0x3e, 0x0f, 0xd3, 0xb4, 0x6a, 0x8f, 0x8e, 0x60, 0x07, 0xed, 0x30, 0x3d, 0x68,
+	0x69, 0x31, 0xdf, 0x43, 0x6b, 0x1f, 0xb3, 0xa3, 0xf6, 0xaf, 0x06, 0x60, 0x31, 0xc7, 0x2b, 0xae,
+	0x0e, 0x27, 0x61, 0x96, 0xd4, 0x41, 0x1d, 0x38, 0x9d, 0x83, 0x8a, 0xbe, 0x05, 0x0b, 0x39, 0xa4,
+	0x22, 0x83, 0xd5, 0xaf, 0xc7, 0x3d, 0x97, 0xc5, 0x4e, 0x13, 0x58, 0x12, 0x76, 0x9d, 0x91, 0xb1,
+	0xeb, 0x67, 0x1a, 0x2c, 0x3e, 0x6c, 0x07, 0xfb, 0xf8, 0xcb, 0x2d, 0x5b, 0x7a, 0x15, 0x2a, 0xf9,
+	0x65, 0x72, 0xe7, 0xeb, 0xb3, 0x01, 0x58, 0xbc, 0x87, 0xbf, 0xf4, 0x3c, 0xf8, 0xc5, 0xe8, 0xd7,
+	0x4d, 0xa8, 0xe4, 0x79, 0xc5, 0xf5, 0x4b, 0x82, 0x43, 0x93, 0xe1, 0xf8, 0x54, 0x83, 0x73, 0xf7,
+	0xfd, 0xc8, 0x69, 0x74, 0x6e, 0x5b, 0x8e, 0xeb, 0x1f, 0xe1, 0xe0, 0x9e, 0x15, 0x1c, 0xe2, 0x20,
+	0xe6, 0xfa, 0xb7, 0x60, 0xa1, 0xc1, 0x7b, 0xcc, 0x26, 0xed, 0x32, 0x53, 0xe1, 0x84, 0x22, 0xfd,
+	0x48, 0xa3, 0x63, 0x11, 0x85, 0xb9, 0x46, 0xbe, 0x31, 0x24, 0x1e, 0x79, 0x01, 0x05, 0x5c, 0x28,
+	0x2c, 0x7a, 0x6c, 0x6f, 0x05, 0x7e, 0x18, 0xf2, 0x5d, 0x49, 0xdd, 0xa6, 0x52, 0x61, 0x49, 0x2d,
+	0x13, 0x96, 0xbc, 0x04, 0x53, 0x91, 0x15, 0xec, 0xe3, 0x28, 0x7b, 0xee, 0xb1, 0x56, 0x8e, 0x4f,
+	0xff, 0xf9, 0x20, 0x3d, 0xbe, 0x25, 0x73, 0x70, 0x7e, 0x36, 0x09, 0x1e, 0x62, 0x1a, 0xf6, 0x3a,
+	0x2c, 0x48, 0xca, 0x97, 0x7f, 0x47, 0x15, 0x91, 0x28, 0x44, 0x47, 0xbd, 0xed, 0xf0, 0x66, 0x87,
+	0x1e, 0xde, 0xcc, 0x49, 0x99, 0x88, 0x12, 0x4d, 0xe8, 0x53, 0x0d, 0xe6, 0x1b, 0xb4, 0x5c, 0xc3,
+	0xac, 0x5b, 0xed, 0x10, 0x77, 0xa7, 0x65, 0xf6, 0xee, 0xde, 0xc9, 0xa6, 0x65, 0x15, 0x20, 0x5b,
+	0x04, 0x63, 0x6a, 0x72, 0xd4, 0xc8, 0x75, 0x54, 0x5b, 0x30, 0x9b, 0xa3, 0x52, 0x12, 0x0f, 0xb9,
+	0x95, 0x8e, 0x87, 0x5c, 0x29, 0x10, 0x87, 0x2c, 0x4d, 0x7c, 0xf3, 0x92, 0x41, 0x91, 0x6a, 0x0b,
+	0x16, 0x0b, 0x08, 0x94, 0xcc, 0xfb, 0x5e, 0x72, 0xde, 0xa9, 0xc2, 0x64, 0xe4, 0x1d, 0x1c, 0x75,
+	0x4b, 0x5f, 0x28, 0xde, 0x64, 0x18, 0xe6, 0x3f, 0x35, 0x58, 0xe7, 0xc5, 0x26, 0x39, 0xa6, 0xe5,
+	0xb2, 0xe4, 0x6a, 0xef, 0xaa, 0x07, 0x29, 0x43, 0x8f, 0x99, 0x10, 0xc5, 0x55, 0x81, 0x22, 0x93,
+	0xda, 0x3b, 0xd3, 0x78, 0x2d, 0xe0, 0x64, 0x94, 0xf8, 0x15, 0xa2, 0x8b, 0x30, 0x49, 0xdd, 0x52,
+	0x11, 0x62, 0xe3, 0xc5, 0x11, 0xe9, 0x46, 0x3d, 0x80, 0x17, 0x7b, 0x58, 0x6b, 0xec, 0x71, 0x0f,
+	0x89, 0x00, 0xd0, 0xc9, 0xb6, 0x95, 0x42, 0xeb, 0xaf, 0xd3, 0x17, 0xd7, 0x42, 0xb1, 0xe9, 0x21,
+	0xd9, 0x43, 0xe6, 0x46, 0x8f, 0xe8, 0xab, 0xe2, 0x34, 0x58, 0xec, 0x38, 0xcc, 0x77, 0x8b, 0x02,
+	0x44, 0x9a, 0xa0, 0xcd, 0xab, 0x7c, 0x87, 0x8c, 0x6e, 0xc5, 0xc0, 0x0e, 0xcb, 0x11, 0xb4, 0x3d,
+	0x9a, 0xb5, 0x15, 0xfe, 0x1f, 0xf7, 0xc1, 0x59, 0xf6, 0x62, 0x92, 0xb7, 0xb2, 0xfc, 0x86, 0x5e,
+	0x83, 0x05, 0xc3, 0x8a, 0xb0, 0xeb, 0x34, 0x9d, 0x88, 0x5d, 0x96, 0x04, 0xb1, 0x57, 0xe0, 0x8c,
+	0x6d, 0x45, 0x16, 0x67, 0xc6, 0x72, 0xd1, 0x33, 0x81, 0x1b, 0x5e, 0xc7, 0xa0, 0x03, 0xf5, 0x0f,
+	0x60, 0x31, 0x87, 0x8a, 0x2f, 0xa0, 0x5f, 0x5c, 0x9b, 0xff, 0x72, 0x15, 0x80, 0xdf, 0x6b, 0x6e,
+	0x3c, 0xac, 0xa1, 0xdf, 0xd3, 0x60, 0x41, 0xfe, 0xc9, 0x15, 0x74, 0xed, 0x64, 0xdf, 0x6c, 0xaa,
+	0xbe, 0xd1, 0x37, 0x1c, 0x5f, 0xcb, 0xef, 0x6b, 0xb0, 0x58, 0xf0, 0x4d, 0x1e, 0xf4, 0x46, 0xd9,
+	0xf7, 0x6c, 0x8a, 0xa8, 0xb9, 0xde, 0x3f, 0x20, 0x27, 0xe7, 0x47, 0x1a, 0xac, 0x96, 0x7d, 0x97,
+	0x06, 0x7d, 0xe3, 0xb4, 0xdf, 0xd9, 0xa9, 0xde, 0x38, 0x05, 0x06, 0x4e, 0x29, 0xd9, 0x44, 0xf9,
+	0x17, 0x67, 0x14, 0x9b, 0xa8, 0xfc, 0xd2, 0x8d, 0x62, 0x13, 0x4b, 0x3e, 0x6d, 0xf3, 0x47, 0x1a,
+	0x54, 0x8b, 0xbf, 0xcb, 0x82, 0x8a, 0x6b, 0x96, 0x4b, 0xbf, 0x57, 0x53, 0x7d, 0xfb, 0x44, 0xb0,
+	0x9c, 0xae, 0x1f, 0x68, 0xb0, 0x54, 0xf8, 0xd5, 0x15, 0xf4, 0x66, 0x21, 0xea, 0xb2, 0x8f, 0xbe,
+	0x54, 0xdf, 0x3a, 0x09, 0x28, 0x27, 0xca, 0x83, 0xc9, 0xd4, 0xe7, 0x38, 0xd0, 0xcb, 0x85, 0xc8,
+	0x64, 0x5f, 0xfd, 0xa8, 0x6e, 0xf4, 0x3a, 0x9c, 0xcf, 0xf7, 0xa9, 0x06, 0x67, 0x25, 0xdf, 0xb4,
+	0x40, 0xaf, 0xaa, 0x77, 0x5b, 0xfa, 0x15, 0x8d, 0xea, 0x6b, 0xfd, 0x01, 0x71, 0x12, 0x22, 0x98,
+	0xce, 0x7c, 0xe2, 0x01, 0x5d, 0x51, 0xb9, 0x1f, 0x92, 0x3c, 0x7d, 0xf5, 0x95, 0xde, 0x01, 0xf8,
+	0xac, 0xc7, 0x30, 0x93, 0x7d, 0xa7, 0x8c, 0x8a, 0xb1, 0x14, 0xbc, 0xe4, 0xae, 0x5e, 0xed, 0x03,
+	0x22, 0x21, 0x76, 0x85, 0xd5, 0xf8, 0x0a, 0xb1, 0x2b, 0x7b, 0x2b, 0x59, 0x3d, 0x45, 0xf1, 0x3f,
+	0xfa, 0x53, 0x1a, 0x85, 0x2f, 0x2e, 0xd6, 0x47, 0xef, 0x9c, 0xb0, 0xc6, 0x9f, 0x91, 0xf6, 0xee,
+	0xa9, 0x5e, 0x08, 0x70, 0x96, 0x15, 0x54, 0xb4, 0x2b, 0x59, 0xa6, 0xae, 0xa7, 0x57, 0xb2, 0xac,
+	0xa4, 0x80, 0x3e, 0xb1, 0x8f, 0x92, 0xe7, 0x42, 0xa5, 0xfb, 0x58, 0xfc, 0x50, 0xab, 0x74, 0x1f,
+	0x55, 0xaf, 0x93, 0x12, 0xfb, 0x28, 0x2d, 0x2a, 0x2f, 0xdf, 0x47, 0x55, 0x61, 0x7b, 0xf9, 0x3e,
+	0x2a, 0x2b, 0xd9, 0x93, 0xfb, 0x98, 0xaf, 0x1b, 0x2f, 0xdf, 0xc7, 0xc2, 0xaa, 0xf5, 0xf2, 0x7d,
+	0x2c, 0x2e, 0x53, 0x47, 0x7f, 0x42, 0x93, 0x69, 0x85, 0x05, 0xe1, 0xe8, 0xed, 0xbe, 0xd6, 0x9c,
+	0x2e, 0x49, 0xaf, 0xbe, 0x73, 0x32, 0xe0, 0x14, 0x69, 0x85, 0xaf, 0x21, 0x94, 0xa4, 0x95, 0xbd,
+	0xc7, 0x50, 0x92, 0x56, 0xfe, 0x00, 0xe3, 0x2f, 0x34, 0x58, 0x51, 0x97, 0x41, 0xa3, 0xaf, 0x2b,
+	0x26, 0xe8, 0xa1, 0x16, 0xbc, 0xfa, 0xde, 0x89, 0xe1, 0x39, 0x8d, 0xdf, 0xd3, 0xa0, 0x52, 0x54,
+	0x0c, 0x8f, 0xae, 0x2b, 0xb0, 0x2b, 0xab, 0xfe, 0xab, 0x6f, 0x9e, 0x00, 0x92, 0x53, 0xf4, 0x6d,
+	0x0d, 0xe6, 0x64, 0x25, 0xd5, 0xa8, 0xf8, 0xe4, 0x54, 0x14, 0x90, 0x57, 0x5f, 0xef, 0x13, 0x8a,
+	0x53, 0xf1, 0xe7, 0xf4, 0xd3, 0x88, 0x8a, 0x92, 0x61, 0xf4, 0x6e, 0x89, 0x6c, 0xa8, 0xeb, 0xbd,
+	0xab, 0x5f, 0x3f, 0x29, 0x38, 0x27, 0xf0, 0x13, 0x98, 0xcd, 0x55, 0xcf, 0xa2, 0xab, 0xa5, 0x09,
+	0x8d, 0x6c, 0x51, 0x73, 0x75, 0xb3, 0x1f, 0x90, 0xae, 0x37, 0x92, 0xa9, 0x87, 0x55, 0x78, 0x23,
+	0xf2, 0x2a, 0x5e, 0x85, 0x37, 0x52, 0x50, 0x6a, 0x8b, 0x0e, 0x61, 0x22, 0x59, 0x9f, 0x88, 0xbe,
+	0xa6, 0xc4, 0x90, 0x29, 0xc8, 0xad, 0xbe, 0xdc, 0xe3, 0xe8, 0x84, 0x14, 0xca, 0x0a, 0x0c, 0x15,
+	0x52, 0xa8, 0xa8, 0x91, 0x54, 0x48, 0xa1, 0xb2, 0x8a, 0x91, 0x78, 0x9e, 0x92, 0xba, 0x41, 0x85,
+	0xe7, 0x59, 0x5c, 0x84, 0x58, 0x7d, 0xad, 0x3f, 0xa0, 0xf8, 0x21, 0x25, 0x74, 0xcb, 0xf0, 0xd0,
+	0xe5, 0x42, 0x1c, 0xb9, 0xda, 0xbe, 0xea, 0x4b, 0x3d, 0x8d, 0xed, 0x4e, 0xd3, 0xad, 0x73, 0x53,
+	0x4c, 0x93, 0xab, 0xfd, 0x53, 0x4c, 0x93, 0x2f, 0x9c, 0x63, 0xd3, 0x88, 0x32, 0x35, 0xe5, 0x34,
+	0x99, 0xe2, 0x3a, 0xe5, 0x34, 0xd9, 0xba, 0x37, 0x72, 0x43, 0x49, 0x95, 0x98, 0x29, 0x6e, 0x28,
+	0xb2, 0xf2, 0x38, 0xc5, 0x0d, 0x45, 0x5e, 0xb9, 0x46, 0xae, 0xb2, 0xf2, 0xea, 0x2b, 0xc5, 0x55,
+	0x56, 0x59, 0xb2, 0xa6, 0xb8, 0xca, 0x96, 0xd4, 0x8d, 0x11, 0x07, 0xa6, 0xb0, 0xd0, 0x49, 0xe1,
+	0xc0, 0x94, 0xd5, 0x62, 0x29, 0x1c, 0x98, 0xf2, 0xba, 0x2a, 0x0f, 0x26, 0x53, 0x65, 0x42, 0x8a,
+	0x0d, 0x91, 0x55, 0x4a, 0x29, 0x36, 0x44, 0x5a, 0x7d, 0x44, 0xcd, 0x87, 0xac, 0xa4, 0x07, 0xa9,
+	0xae, 0x7f, 0x85, 0xc5, 0x4a, 0x0a, 0xf3, 0xa1, 0xaa, 0x1b, 0x42, 0xbf, 0xab, 0xc1, 0xbc, 0xb4,
+	0xd0, 0x06, 0xa9, 0x10, 0x16, 0xd7, 0x19, 0x55, 0xaf, 0xf5, 0x0b, 0x96, 0x20, 0x44, 0x5a, 0xfa,
+	0xa0, 0x20, 0x44, 0x55, 0xff, 0xa3, 0x20, 0x44, 0x5d, 0x61, 0x71, 0x0c, 0x33, 0xd9, 0x02, 0x07,
+	0xc5, 0x8d, 0xb6, 0xa0, 0x8c, 0x42, 0x71, 0xa3, 0x2d, 0xac, 0x9e, 0x20, 0x02, 0x21, 0xab, 0x4d,
+	0x50, 0x08, 0x84, 0xa2, 0x4c, 0x42, 0x21, 0x10, 0xca, 0x02, 0x88, 0x08, 0xa6, 0x33, 0xc9, 0x60,
+	0xa4, 0xaa, 0x81, 0x90, 0xa5, 0xd8, 0x15, 0x07, 0x77, 0x51, 0x9e, 0xf9, 0x18, 0x66, 0xb2, 0xc9,
+	0x46, 0x55, 0x18, 0x41, 0x9e, 0x7e, 0x55, 0x85, 0x11, 0x0a, 0x32, 0x99, 0x64, 0xe2, 0x6c, 0x72,
+	0x4e, 0x31, 0x71, 0x41, 0xce, 0x53, 0x31, 0x71, 0x61, 0xe6, 0x8f, 0xc8, 0xbb, 0x34, 0x9f, 0xa6,
+	0x90, 0x77, 0x55, 0x06, 0x50, 0x21, 0xef, 0xca, 0xb4, 0x9d, 0x10, 0xbb, 0x5c, 0xb2, 0x41, 0x2d,
+	0x76, 0x45, 0x69, 0x3e, 0xb5, 0xd8, 0x15, 0x27, 0xee, 0x3e, 0xd3, 0xe2, 0xb7, 0xd0, 0xc5, 0x69,
+	0x0f, 0x74, 0xa3, 0xec, 0x1e, 0x58, 0x9a, 0x1e, 0xaa, 0xde, 0x3c, 0x0d, 0x8a, 0x54, 0xa8, 0x2d,
+	0x99, 0xf7, 0x50, 0x87, 0xda, 0x24, 0x89, 0x15, 0x75, 0xa8, 0x4d, 0x9a, 0x52, 0x21, 0x9a, 0x99,
+	0x4e, 0x56, 0xa8, 0x34, 0x53, 0x9a, 0x21, 0x51, 0x69, 0xa6, 0x3c, 0x0f, 0x72, 0xf3, 0xd6, 0x4f,
+	0x3e, 0x5f, 0xd1, 0x7e, 0xfa, 0xf9, 0x8a, 0xf6, 0x6f, 0x9f, 0xaf, 0x68, 0xdf, 0x7c, 0x63, 0xdf,
+	0x89, 0x0e, 0xda, 0x7b, 0x1b, 0x75, 0xbf, 0x79, 0x25, 0xf5, 0x5f, 0x56, 0x36, 0xf6, 0xb1, 0xc7,
+	0xfe, 0xe5, 0x4e, 0xe2, 0x7f, 0xfe, 0xbc, 0xcd, 0xff, 0x3c, 0xba, 0xba, 0x37, 0x4c, 0xfb, 0x5e,
+	0xfd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0xd8, 0x4a, 0x83, 0x1f, 0x68, 0x00, 0x00,
+}
+
+func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6325,19 +6445,96 @@ func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChildWorkflowOnly {
+	if m.RequestEagerExecution {
 		i--
-		if m.ChildWorkflowOnly {
+		if m.RequestEagerExecution {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k := range m.PartitionConfig {
+			v := m.PartitionConfig[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.FirstDecisionTaskBackoff != nil {
+		{
+			size, err := m.FirstDecisionTaskBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastCompletionResult != nil {
+		{
+			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ContinuedFailure != nil {
+		{
+			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ContinueAsNewInitiator != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ContinueAsNewInitiator))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpirationTime != nil {
+		{
+			size, err := m.ExpirationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
 		dAtA[i] = 0x20
 	}
-	if m.ExternalWorkflowExecution != nil {
+	if m.ParentExecutionInfo != nil {
 		{
-			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6369,7 +6566,7 @@ func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6379,12 +6576,12 @@ func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6393,10 +6590,29 @@ func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DecisionTask != nil {
+		{
+			size, err := m.DecisionTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignalWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6406,12 +6622,12 @@ func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6420,6 +6636,28 @@ func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ChildWorkflowOnly {
+		i--
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExternalWorkflowExecution != nil {
+		{
+			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DomainId) > 0 {
 		i -= len(m.DomainId)
 		copy(dAtA[i:], m.DomainId)
@@ -6442,7 +6680,7 @@ func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6452,12 +6690,12 @@ func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6466,74 +6704,10 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PendingDecision != nil {
-		{
-			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PendingChildren) > 0 {
-		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.PendingActivities) > 0 {
-		for iNdEx := len(m.PendingActivities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.WorkflowExecutionInfo != nil {
-		{
-			size, err := m.WorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ExecutionConfiguration != nil {
-		{
-			size, err := m.ExecutionConfiguration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6543,12 +6717,12 @@ func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6557,6 +6731,25 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PartitionConfig) > 0 {
+		for k := range m.PartitionConfig {
+			v := m.PartitionConfig[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DomainId) > 0 {
 		i -= len(m.DomainId)
 		copy(dAtA[i:], m.DomainId)
@@ -6579,7 +6772,7 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
+func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6589,12 +6782,12 @@ func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6603,34 +6796,17 @@ func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.QueryRejected != nil {
-		{
-			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.QueryResult != nil {
-		{
-			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResetStickyTaskListRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6640,12 +6816,12 @@ func (m *ResetStickyTaskListRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResetStickyTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetStickyTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6676,7 +6852,7 @@ func (m *ResetStickyTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *ResetStickyTaskListResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6686,12 +6862,12 @@ func (m *ResetStickyTaskListResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResetStickyTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetStickyTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6700,10 +6876,17 @@ func (m *ResetStickyTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetMutableStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6713,12 +6896,12 @@ func (m *GetMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6727,9 +6910,19 @@ func (m *GetMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.VersionHistoryItem != nil {
+	if m.ChildWorkflowOnly {
+		i--
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExternalWorkflowExecution != nil {
 		{
-			size, err := m.VersionHistoryItem.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6737,23 +6930,18 @@ func (m *GetMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if m.ExpectedNextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ExpectedNextEventId))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6761,19 +6949,12 @@ func (m *GetMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetMutableStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6783,12 +6964,12 @@ func (m *GetMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6797,64 +6978,43 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.HistorySize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.IsStickyTaskListEnabled {
-		i--
-		if m.IsStickyTaskListEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if m.VersionHistories != nil {
-		{
-			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.WorkflowCloseState != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.WorkflowCloseState))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.WorkflowState != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.WorkflowState))
-		i--
-		dAtA[i] = 0x70
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x6a
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.EventStoreVersion != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.EventStoreVersion))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x60
+		dAtA[i] = 0x12
 	}
-	if m.StickyTaskListScheduleToStartTimeout != nil {
+	if m.Request != nil {
 		{
-			size, err := m.StickyTaskListScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6862,32 +7022,38 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.ClientImpl) > 0 {
-		i -= len(m.ClientImpl)
-		copy(dAtA[i:], m.ClientImpl)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientImpl)))
-		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0xa
 	}
-	if len(m.ClientFeatureVersion) > 0 {
-		i -= len(m.ClientFeatureVersion)
-		copy(dAtA[i:], m.ClientFeatureVersion)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientFeatureVersion)))
-		i--
-		dAtA[i] = 0x4a
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ClientLibraryVersion) > 0 {
-		i -= len(m.ClientLibraryVersion)
-		copy(dAtA[i:], m.ClientLibraryVersion)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientLibraryVersion)))
-		i--
-		dAtA[i] = 0x42
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.StickyTaskList != nil {
+	if m.PendingDecision != nil {
 		{
-			size, err := m.StickyTaskList.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6895,45 +7061,39 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x2a
 	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.PendingChildren) > 0 {
+		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.LastFirstEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.LastFirstEventId))
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.PreviousStartedEventId != nil {
-		{
-			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.PendingActivities) > 0 {
+		for iNdEx := len(m.PendingActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.WorkflowType != nil {
+	if m.WorkflowExecutionInfo != nil {
 		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6943,9 +7103,9 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.ExecutionConfiguration != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutionConfiguration.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6958,7 +7118,7 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *PollMutableStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6968,12 +7128,12 @@ func (m *PollMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PollMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PollMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6982,21 +7142,16 @@ func (m *PollMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ExpectedNextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ExpectedNextEventId))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7004,19 +7159,12 @@ func (m *PollMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PollMutableStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7026,12 +7174,12 @@ func (m *PollMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PollMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7040,19 +7188,9 @@ func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowCloseState != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.WorkflowCloseState))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.WorkflowState != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.WorkflowState))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.VersionHistories != nil {
+	if m.QueryRejected != nil {
 		{
-			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7060,18 +7198,11 @@ func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x12
 	}
-	if m.StickyTaskListScheduleToStartTimeout != nil {
+	if m.QueryResult != nil {
 		{
-			size, err := m.StickyTaskListScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7079,90 +7210,45 @@ func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0xa
 	}
-	if len(m.ClientImpl) > 0 {
-		i -= len(m.ClientImpl)
-		copy(dAtA[i:], m.ClientImpl)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientImpl)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.ClientFeatureVersion) > 0 {
-		i -= len(m.ClientFeatureVersion)
-		copy(dAtA[i:], m.ClientFeatureVersion)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientFeatureVersion)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ClientLibraryVersion) > 0 {
-		i -= len(m.ClientLibraryVersion)
-		copy(dAtA[i:], m.ClientLibraryVersion)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientLibraryVersion)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.StickyTaskList != nil {
-		{
-			size, err := m.StickyTaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.LastFirstEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.LastFirstEventId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PreviousStartedEventId != nil {
-		{
-			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetStickyTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x18
+	return dAtA[:n], nil
+}
+
+func (m *ResetStickyTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetStickyTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7175,7 +7261,7 @@ func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordDecisionTaskStartedRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResetStickyTaskListResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7185,12 +7271,12 @@ func (m *RecordDecisionTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordDecisionTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetStickyTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordDecisionTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetStickyTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7199,9 +7285,36 @@ func (m *RecordDecisionTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PollRequest != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMutableStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.VersionHistoryItem != nil {
 		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VersionHistoryItem.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7209,22 +7322,17 @@ func (m *RecordDecisionTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
-		i--
 		dAtA[i] = 0x2a
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.ScheduleId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
+	if m.ExpectedNextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ExpectedNextEventId))
 		i--
 		dAtA[i] = 0x18
 	}
@@ -7250,7 +7358,7 @@ func (m *RecordDecisionTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7260,12 +7368,12 @@ func (m *RecordDecisionTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7277,37 +7385,25 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	if m.HistorySize != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.Queries) > 0 {
-		for k := range m.Queries {
-			v := m.Queries[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x72
+	if m.IsStickyTaskListEnabled {
+		i--
+		if m.IsStickyTaskListEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if m.StartedTime != nil {
+	if m.VersionHistories != nil {
 		{
-			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7315,35 +7411,35 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.ScheduledTime != nil {
-		{
-			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if m.WorkflowCloseState != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowCloseState))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x78
 	}
-	if len(m.BranchToken) > 0 {
-		i -= len(m.BranchToken)
-		copy(dAtA[i:], m.BranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.BranchToken)))
+	if m.WorkflowState != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowState))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x70
+	}
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
+		i--
+		dAtA[i] = 0x6a
 	}
 	if m.EventStoreVersion != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.EventStoreVersion))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x60
 	}
-	if m.WorkflowExecutionTaskList != nil {
+	if m.StickyTaskListScheduleToStartTimeout != nil {
 		{
-			size, err := m.WorkflowExecutionTaskList.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StickyTaskListScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7351,53 +7447,78 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x5a
 	}
-	if m.DecisionInfo != nil {
-		{
-			size, err := m.DecisionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.ClientImpl) > 0 {
+		i -= len(m.ClientImpl)
+		copy(dAtA[i:], m.ClientImpl)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientImpl)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x52
 	}
-	if m.StickyExecutionEnabled {
+	if len(m.ClientFeatureVersion) > 0 {
+		i -= len(m.ClientFeatureVersion)
+		copy(dAtA[i:], m.ClientFeatureVersion)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientFeatureVersion)))
 		i--
-		if m.StickyExecutionEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x4a
+	}
+	if len(m.ClientLibraryVersion) > 0 {
+		i -= len(m.ClientLibraryVersion)
+		copy(dAtA[i:], m.ClientLibraryVersion)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientLibraryVersion)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StickyTaskList != nil {
+		{
+			size, err := m.StickyTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x3a
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x32
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
+	if m.LastFirstEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.LastFirstEventId))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.StartedEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.StartedEventId))
+	if m.PreviousStartedEventId != nil {
+		{
+			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.ScheduledEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ScheduledEventId))
+	if m.NextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.PreviousStartedEventId != nil {
+	if m.WorkflowType != nil {
 		{
-			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7407,9 +7528,9 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.WorkflowType != nil {
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7422,7 +7543,7 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskStartedRequest) Marshal() (dAtA []byte, err error) {
+func (m *PollMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7432,12 +7553,12 @@ func (m *RecordActivityTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7446,32 +7567,15 @@ func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.ScheduleId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
+	if m.ExpectedNextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ExpectedNextEventId))
 		i--
 		dAtA[i] = 0x18
 	}
@@ -7497,7 +7601,7 @@ func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
+func (m *PollMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7507,12 +7611,12 @@ func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7521,16 +7625,19 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.WorkflowDomain) > 0 {
-		i -= len(m.WorkflowDomain)
-		copy(dAtA[i:], m.WorkflowDomain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.WorkflowDomain)))
+	if m.WorkflowCloseState != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowCloseState))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x78
 	}
-	if m.WorkflowType != nil {
+	if m.WorkflowState != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowState))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.VersionHistories != nil {
 		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7538,11 +7645,18 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x6a
 	}
-	if m.HeartbeatDetails != nil {
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.StickyTaskListScheduleToStartTimeout != nil {
 		{
-			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StickyTaskListScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7550,11 +7664,61 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x5a
 	}
-	if m.ScheduledTimeOfThisAttempt != nil {
+	if len(m.ClientImpl) > 0 {
+		i -= len(m.ClientImpl)
+		copy(dAtA[i:], m.ClientImpl)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientImpl)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ClientFeatureVersion) > 0 {
+		i -= len(m.ClientFeatureVersion)
+		copy(dAtA[i:], m.ClientFeatureVersion)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientFeatureVersion)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ClientLibraryVersion) > 0 {
+		i -= len(m.ClientLibraryVersion)
+		copy(dAtA[i:], m.ClientLibraryVersion)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientLibraryVersion)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StickyTaskList != nil {
 		{
-			size, err := m.ScheduledTimeOfThisAttempt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StickyTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastFirstEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.LastFirstEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreviousStartedEventId != nil {
+		{
+			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7564,14 +7728,14 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+	if m.NextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.StartedTime != nil {
+	if m.WorkflowType != nil {
 		{
-			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7581,9 +7745,9 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ScheduledEvent != nil {
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7596,7 +7760,7 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondDecisionTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordDecisionTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7606,12 +7770,12 @@ func (m *RespondDecisionTaskCompletedRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RespondDecisionTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordDecisionTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondDecisionTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordDecisionTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7620,16 +7784,9 @@ func (m *RespondDecisionTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
+	if m.PollRequest != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7637,27 +7794,63 @@ func (m *RespondDecisionTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RespondDecisionTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return dAtA[:n], nil
-}
+	if m.TaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *RespondDecisionTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordDecisionTaskStartedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordDecisionTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondDecisionTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7666,9 +7859,14 @@ func (m *RespondDecisionTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActivitiesToDispatchLocally) > 0 {
-		for k := range m.ActivitiesToDispatchLocally {
-			v := m.ActivitiesToDispatchLocally[k]
+	if m.HistorySize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Queries) > 0 {
+		for k := range m.Queries {
+			v := m.Queries[k]
 			baseI := i
 			if v != nil {
 				{
@@ -7689,12 +7887,12 @@ func (m *RespondDecisionTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte)
 			dAtA[i] = 0xa
 			i = encodeVarintService(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x72
 		}
 	}
-	if m.StartedResponse != nil {
+	if m.StartedTime != nil {
 		{
-			size, err := m.StartedResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7702,45 +7900,101 @@ func (m *RespondDecisionTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RespondDecisionTaskFailedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ScheduledTime != nil {
+		{
+			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondDecisionTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RespondDecisionTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if m.EventStoreVersion != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EventStoreVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.WorkflowExecutionTaskList != nil {
+		{
+			size, err := m.WorkflowExecutionTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DecisionInfo != nil {
+		{
+			size, err := m.DecisionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StickyExecutionEnabled {
+		i--
+		if m.StickyExecutionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousStartedEventId != nil {
+		{
+			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Request != nil {
+	if m.WorkflowType != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7753,7 +8007,7 @@ func (m *RespondDecisionTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondDecisionTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7763,12 +8017,12 @@ func (m *RespondDecisionTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RespondDecisionTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondDecisionTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7777,10 +8031,58 @@ func (m *RespondDecisionTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PollRequest != nil {
+		{
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7790,12 +8092,12 @@ func (m *RecordActivityTaskHeartbeatRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7804,16 +8106,16 @@ func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if len(m.WorkflowDomain) > 0 {
+		i -= len(m.WorkflowDomain)
+		copy(dAtA[i:], m.WorkflowDomain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.WorkflowDomain)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if m.Request != nil {
+	if m.WorkflowType != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7821,49 +8123,65 @@ func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RecordActivityTaskHeartbeatResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.HeartbeatDetails != nil {
+		{
+			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordActivityTaskHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RecordActivityTaskHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ScheduledTimeOfThisAttempt != nil {
+		{
+			size, err := m.ScheduledTimeOfThisAttempt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.CancelRequested {
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
 		i--
-		if m.CancelRequested {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x18
+	}
+	if m.StartedTime != nil {
+		{
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.ScheduledEvent != nil {
+		{
+			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondDecisionTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7873,12 +8191,12 @@ func (m *RespondActivityTaskCompletedRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7909,7 +8227,7 @@ func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondDecisionTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7919,12 +8237,12 @@ func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7933,10 +8251,48 @@ func (m *RespondActivityTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ActivitiesToDispatchLocally) > 0 {
+		for k := range m.ActivitiesToDispatchLocally {
+			v := m.ActivitiesToDispatchLocally[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StartedResponse != nil {
+		{
+			size, err := m.StartedResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondDecisionTaskFailedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7946,12 +8302,12 @@ func (m *RespondActivityTaskFailedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7982,7 +8338,7 @@ func (m *RespondActivityTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondDecisionTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7992,12 +8348,12 @@ func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8009,7 +8365,7 @@ func (m *RespondActivityTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCanceledRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8019,12 +8375,12 @@ func (m *RespondActivityTaskCanceledRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCanceledRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCanceledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8055,7 +8411,7 @@ func (m *RespondActivityTaskCanceledRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8065,12 +8421,12 @@ func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCanceledResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCanceledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8079,10 +8435,20 @@ func (m *RespondActivityTaskCanceledResponse) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CancelRequested {
+		i--
+		if m.CancelRequested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSignalMutableStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8092,12 +8458,12 @@ func (m *RemoveSignalMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveSignalMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8106,16 +8472,16 @@ func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8123,19 +8489,12 @@ func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSignalMutableStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8145,12 +8504,12 @@ func (m *RemoveSignalMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveSignalMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8162,7 +8521,7 @@ func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskFailedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8172,12 +8531,12 @@ func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8186,31 +8545,16 @@ func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExternalExecutionInfo != nil {
-		{
-			size, err := m.ExternalExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.CancelRequest != nil {
+	if m.Request != nil {
 		{
-			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8218,19 +8562,12 @@ func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8240,12 +8577,12 @@ func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8257,7 +8594,7 @@ func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCanceledRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8267,12 +8604,12 @@ func (m *ScheduleDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScheduleDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScheduleDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8281,7 +8618,255 @@ func (m *ScheduleDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsFirstDecision {
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskCanceledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondActivityTaskCanceledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveSignalMutableStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSignalMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveSignalMutableStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSignalMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChildWorkflowOnly {
+		i--
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExternalExecutionInfo != nil {
+		{
+			size, err := m.ExternalExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CancelRequest != nil {
+		{
+			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsFirstDecision {
 		i--
 		if m.IsFirstDecision {
 			dAtA[i] = 1
@@ -8973,21 +9558,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA83 := make([]byte, len(m.ShardIds)*10)
-		var j82 int
+		dAtA84 := make([]byte, len(m.ShardIds)*10)
+		var j83 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j82++
+				j83++
 			}
-			dAtA83[j82] = uint8(num)
-			j82++
+			dAtA84[j83] = uint8(num)
+			j83++
 		}
-		i -= j82
-		copy(dAtA[i:], dAtA83[:j82])
-		i = encodeVarintService(dAtA, i, uint64(j82))
+		i -= j83
+		copy(dAtA[i:], dAtA84[:j83])
+		i = encodeVarintService(dAtA, i, uint64(j83))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9321,6 +9906,16 @@ func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WaitForNewTasks {
+		i--
+		if m.WaitForNewTasks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ClusterName) > 0 {
 		i -= len(m.ClusterName)
 		copy(dAtA[i:], m.ClusterName)
@@ -9650,7 +10245,7 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *CountDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *RebuildWorkflowBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9660,12 +10255,12 @@ func (m *CountDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CountDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RebuildWorkflowBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CountDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RebuildWorkflowBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9674,20 +10269,43 @@ func (m *CountDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ForceFetch {
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.BranchToken)))
 		i--
-		if m.ForceFetch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x22
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CountDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *RebuildWorkflowBranchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9697,12 +10315,12 @@ func (m *CountDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CountDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RebuildWorkflowBranchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CountDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RebuildWorkflowBranchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9711,24 +10329,10 @@ func (m *CountDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpdateActivityOptionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9738,12 +10342,12 @@ func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReadDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9752,21 +10356,26 @@ func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Identity)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x62
 	}
-	if m.PageSize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+	if m.ResetAttempts {
 		i--
-		dAtA[i] = 0x28
+		if m.ResetAttempts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.InclusiveEndMessageId != nil {
+	if m.RetryPolicy != nil {
 		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9774,96 +10383,105 @@ func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x52
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+	if m.HeartbeatTimeout != nil {
+		{
+			size, err := m.HeartbeatTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x4a
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if m.StartToCloseTimeout != nil {
+		{
+			size, err := m.StartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x42
 	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
+	if m.ScheduleToCloseTimeout != nil {
+		{
+			size, err := m.ScheduleToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x3a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ScheduleToStartTimeout != nil {
+		{
+			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReadDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReadDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ActivityId)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.ReplicationTasksInfo) > 0 {
-		for iNdEx := len(m.ReplicationTasksInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasksInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpdateActivityOptionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9873,12 +10491,12 @@ func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9887,9 +10505,9 @@ func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.InclusiveEndMessageId != nil {
+	if m.Activity != nil {
 		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Activity.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9897,29 +10515,12 @@ func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *CountDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9929,12 +10530,12 @@ func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CountDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CountDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9943,10 +10544,20 @@ func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ForceFetch {
+		i--
+		if m.ForceFetch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *CountDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9956,12 +10567,12 @@ func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CountDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CountDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9970,51 +10581,24 @@ func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.InclusiveEndMessageId != nil {
-		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10024,12 +10608,12 @@ func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10038,17 +10622,29 @@ func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+	if m.ForceFetch {
 		i--
-		dAtA[i] = 0xa
+		if m.ForceFetch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NotifyFailoverMarkersRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10058,12 +10654,12 @@ func (m *NotifyFailoverMarkersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NotifyFailoverMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NotifyFailoverMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10072,10 +10668,10 @@ func (m *NotifyFailoverMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FailoverMarkerTokens) > 0 {
-		for iNdEx := len(m.FailoverMarkerTokens) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FailoverMarkerTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10089,7 +10685,7 @@ func (m *NotifyFailoverMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *NotifyFailoverMarkersResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReplicationShardStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10099,12 +10695,12 @@ func (m *NotifyFailoverMarkersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NotifyFailoverMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicationShardStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NotifyFailoverMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicationShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10113,10 +10709,66 @@ func (m *NotifyFailoverMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.OldestPendingTaskAge != nil {
+		{
+			size, err := m.OldestPendingTaskAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DlqSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastReplicatedTime != nil {
+		{
+			size, err := m.LastReplicatedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxReadLevel != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxReadLevel))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AckedLevel != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.AckedLevel))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadLevel != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ReadLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RemoteCluster) > 0 {
+		i -= len(m.RemoteCluster)
+		copy(dAtA[i:], m.RemoteCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RemoteCluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetCrossClusterTasksRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10126,12 +10778,12 @@ func (m *GetCrossClusterTasksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetCrossClusterTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReadDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10140,36 +10792,51 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TargetCluster) > 0 {
-		i -= len(m.TargetCluster)
-		copy(dAtA[i:], m.TargetCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA93 := make([]byte, len(m.ShardIds)*10)
-		var j92 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j92++
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA93[j92] = uint8(num)
-			j92++
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
-		i -= j92
-		copy(dAtA[i:], dAtA93[:j92])
-		i = encodeVarintService(dAtA, i, uint64(j92))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetCrossClusterTasksResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10179,12 +10846,12 @@ func (m *GetCrossClusterTasksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetCrossClusterTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReadDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReadDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10193,49 +10860,50 @@ func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FailedCauseByShard) > 0 {
-		for k := range m.FailedCauseByShard {
-			v := m.FailedCauseByShard[k]
-			baseI := i
-			i = encodeVarintService(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReplicationTasksInfo) > 0 {
+		for iNdEx := len(m.ReplicationTasksInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasksInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.TasksByShard) > 0 {
-		for k := range m.TasksByShard {
-			v := m.TasksByShard[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
-				i--
-				dAtA[i] = 0x12
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10245,12 +10913,12 @@ func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10259,46 +10927,39 @@ func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []b
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FetchNewTasks {
-		i--
-		if m.FetchNewTasks {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.TaskResponses) > 0 {
-		for iNdEx := len(m.TaskResponses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.TargetCluster) > 0 {
-		i -= len(m.TargetCluster)
-		copy(dAtA[i:], m.TargetCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if m.ShardId != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10308,12 +10969,12 @@ func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10322,9 +10983,48 @@ func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Tasks != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusiveEndMessageId != nil {
 		{
-			size, err := m.Tasks.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10332,12 +11032,29 @@ func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetFailoverInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10347,12 +11064,12 @@ func (m *GetFailoverInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFailoverInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFailoverInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10361,17 +11078,17 @@ func (m *GetFailoverInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetFailoverInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *NotifyFailoverMarkersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10381,12 +11098,12 @@ func (m *GetFailoverInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFailoverInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *NotifyFailoverMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NotifyFailoverMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10395,34 +11112,24 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PendingShards) > 0 {
-		dAtA97 := make([]byte, len(m.PendingShards)*10)
-		var j96 int
-		for _, num1 := range m.PendingShards {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA97[j96] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j96++
+	if len(m.FailoverMarkerTokens) > 0 {
+		for iNdEx := len(m.FailoverMarkerTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailoverMarkerTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			dAtA97[j96] = uint8(num)
-			j96++
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= j96
-		copy(dAtA[i:], dAtA97[:j96])
-		i = encodeVarintService(dAtA, i, uint64(j96))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CompletedShardCount != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.CompletedShardCount))
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RatelimitUpdateRequest) Marshal() (dAtA []byte, err error) {
+func (m *NotifyFailoverMarkersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10432,12 +11139,12 @@ func (m *RatelimitUpdateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RatelimitUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *NotifyFailoverMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RatelimitUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NotifyFailoverMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10446,22 +11153,10 @@ func (m *RatelimitUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RatelimitUpdateResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetCrossClusterTasksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10471,12 +11166,12 @@ func (m *RatelimitUpdateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RatelimitUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetCrossClusterTasksRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RatelimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10485,261 +11180,378 @@ func (m *RatelimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ShardIds) > 0 {
+		dAtA105 := make([]byte, len(m.ShardIds)*10)
+		var j104 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA105[j104] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j104++
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			dAtA105[j104] = uint8(num)
+			j104++
 		}
+		i -= j104
+		copy(dAtA[i:], dAtA105[:j104])
+		i = encodeVarintService(dAtA, i, uint64(j104))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetCrossClusterTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetCrossClusterTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ParentExecutionInfo != nil {
-		l = m.ParentExecutionInfo.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
-	}
-	if m.ExpirationTime != nil {
-		l = m.ExpirationTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ContinueAsNewInitiator != 0 {
-		n += 1 + sovService(uint64(m.ContinueAsNewInitiator))
-	}
-	if m.ContinuedFailure != nil {
-		l = m.ContinuedFailure.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.LastCompletionResult != nil {
-		l = m.LastCompletionResult.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.FirstDecisionTaskBackoff != nil {
-		l = m.FirstDecisionTaskBackoff.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.PartitionConfig) > 0 {
-		for k, v := range m.PartitionConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *StartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.FailedCauseByShard) > 0 {
+		for k := range m.FailedCauseByShard {
+			v := m.FailedCauseByShard[k]
+			baseI := i
+			i = encodeVarintService(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.TasksByShard) > 0 {
+		for k := range m.TasksByShard {
+			v := m.TasksByShard[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.FetchNewTasks {
+		i--
+		if m.FetchNewTasks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ExternalWorkflowExecution != nil {
-		l = m.ExternalWorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.TaskResponses) > 0 {
+		for iNdEx := len(m.TaskResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.ChildWorkflowOnly {
-		n += 2
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RespondCrossClusterTasksCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PartitionConfig) > 0 {
-		for k, v := range m.PartitionConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+	if m.Tasks != nil {
+		{
+			size, err := m.Tasks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetFailoverInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResetWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *GetFailoverInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetFailoverInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetFailoverInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *GetFailoverInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.PendingShards) > 0 {
+		dAtA109 := make([]byte, len(m.PendingShards)*10)
+		var j108 int
+		for _, num1 := range m.PendingShards {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA109[j108] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j108++
+			}
+			dAtA109[j108] = uint8(num)
+			j108++
+		}
+		i -= j108
+		copy(dAtA[i:], dAtA109[:j108])
+		i = encodeVarintService(dAtA, i, uint64(j108))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ExternalWorkflowExecution != nil {
-		l = m.ExternalWorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.CompletedShardCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.CompletedShardCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.ChildWorkflowOnly {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *RatelimitUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RatelimitUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatelimitUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RatelimitUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RatelimitUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatelimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10753,40 +11565,61 @@ func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.ParentExecutionInfo != nil {
+		l = m.ParentExecutionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.ExpirationTime != nil {
+		l = m.ExpirationTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ContinueAsNewInitiator != 0 {
+		n += 1 + sovService(uint64(m.ContinueAsNewInitiator))
+	}
+	if m.ContinuedFailure != nil {
+		l = m.ContinuedFailure.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.LastCompletionResult != nil {
+		l = m.LastCompletionResult.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.FirstDecisionTaskBackoff != nil {
+		l = m.FirstDecisionTaskBackoff.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k, v := range m.PartitionConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.RequestEagerExecution {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+func (m *StartWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ExecutionConfiguration != nil {
-		l = m.ExecutionConfiguration.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecutionInfo != nil {
-		l = m.WorkflowExecutionInfo.Size()
+	l = len(m.RunId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if len(m.PendingActivities) > 0 {
-		for _, e := range m.PendingActivities {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
-	if len(m.PendingChildren) > 0 {
-		for _, e := range m.PendingChildren {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
-	if m.PendingDecision != nil {
-		l = m.PendingDecision.Size()
+	if m.DecisionTask != nil {
+		l = m.DecisionTask.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -10795,7 +11628,7 @@ func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryWorkflowRequest) Size() (n int) {
+func (m *SignalWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10809,33 +11642,32 @@ func (m *QueryWorkflowRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.ExternalWorkflowExecution != nil {
+		l = m.ExternalWorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ChildWorkflowOnly {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *QueryWorkflowResponse) Size() (n int) {
+func (m *SignalWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.QueryResult != nil {
-		l = m.QueryResult.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.QueryRejected != nil {
-		l = m.QueryRejected.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ResetStickyTaskListRequest) Size() (n int) {
+func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10849,63 +11681,278 @@ func (m *ResetStickyTaskListRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.PartitionConfig) > 0 {
+		for k, v := range m.PartitionConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ResetStickyTaskListResponse) Size() (n int) {
+func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetMutableStateRequest) Size() (n int) {
+func (m *ResetWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.ExpectedNextEventId != 0 {
-		n += 1 + sovService(uint64(m.ExpectedNextEventId))
+	return n
+}
+
+func (m *ResetWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.CurrentBranchToken)
+	var l int
+	_ = l
+	l = len(m.RunId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.VersionHistoryItem != nil {
-		l = m.VersionHistoryItem.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetMutableStateResponse) Size() (n int) {
+func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ExternalWorkflowExecution != nil {
+		l = m.ExternalWorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ChildWorkflowOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecutionConfiguration != nil {
+		l = m.ExecutionConfiguration.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecutionInfo != nil {
+		l = m.WorkflowExecutionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PendingActivities) > 0 {
+		for _, e := range m.PendingActivities {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.PendingChildren) > 0 {
+		for _, e := range m.PendingChildren {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.PendingDecision != nil {
+		l = m.PendingDecision.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueryWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueryWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryResult != nil {
+		l = m.QueryResult.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.QueryRejected != nil {
+		l = m.QueryRejected.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetStickyTaskListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetStickyTaskListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMutableStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ExpectedNextEventId != 0 {
+		n += 1 + sovService(uint64(m.ExpectedNextEventId))
+	}
+	l = len(m.CurrentBranchToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.VersionHistoryItem != nil {
+		l = m.VersionHistoryItem.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMutableStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
 	if m.WorkflowType != nil {
@@ -12016,6 +13063,9 @@ func (m *GetReplicationMessagesRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.WaitForNewTasks {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12159,14 +13209,27 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
-func (m *CountDLQMessagesRequest) Size() (n int) {
+func (m *RebuildWorkflowBranchRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ForceFetch {
-		n += 2
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12174,60 +13237,240 @@ func (m *CountDLQMessagesRequest) Size() (n int) {
 	return n
 }
 
-func (m *CountDLQMessagesResponse) Size() (n int) {
+func (m *RebuildWorkflowBranchResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ReadDLQMessagesRequest) Size() (n int) {
+func (m *UpdateActivityOptionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
+	l = len(m.Domain)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.InclusiveEndMessageId != nil {
-		l = m.InclusiveEndMessageId.Size()
+	l = len(m.DomainId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovService(uint64(m.PageSize))
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.NextPageToken)
+	l = len(m.ActivityId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	return n
-}
-
-func (m *ReadDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ScheduleToStartTimeout != nil {
+		l = m.ScheduleToStartTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = m.ScheduleToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = m.StartToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = m.HeartbeatTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ResetAttempts {
+		n += 2
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateActivityOptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Activity != nil {
+		l = m.Activity.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CountDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForceFetch {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CountDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.ForceFetch {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationShardStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.RemoteCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ReadLevel != 0 {
+		n += 1 + sovService(uint64(m.ReadLevel))
+	}
+	if m.AckedLevel != 0 {
+		n += 1 + sovService(uint64(m.AckedLevel))
+	}
+	if m.MaxReadLevel != 0 {
+		n += 1 + sovService(uint64(m.MaxReadLevel))
+	}
+	if m.LastReplicatedTime != nil {
+		l = m.LastReplicatedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovService(uint64(m.DlqSize))
+	}
+	if m.OldestPendingTaskAge != nil {
+		l = m.OldestPendingTaskAge.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -12503,53 +13746,656 @@ func (m *GetFailoverInfoResponse) Size() (n int) {
 		for _, e := range m.PendingShards {
 			l += sovService(uint64(e))
 		}
-		n += 1 + sovService(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *RatelimitUpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RatelimitUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RatelimitUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.StartWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentExecutionInfo == nil {
+				m.ParentExecutionInfo = &v1.ParentExecutionInfo{}
+			}
+			if err := m.ParentExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = &types.Timestamp{}
+			}
+			if err := m.ExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewInitiator", wireType)
+			}
+			m.ContinueAsNewInitiator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContinueAsNewInitiator |= v1.ContinueAsNewInitiator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinuedFailure == nil {
+				m.ContinuedFailure = &v1.Failure{}
+			}
+			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionResult == nil {
+				m.LastCompletionResult = &v1.Payload{}
+			}
+			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstDecisionTaskBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstDecisionTaskBackoff == nil {
+				m.FirstDecisionTaskBackoff = &types.Duration{}
+			}
+			if err := m.FirstDecisionTaskBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PartitionConfig[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestEagerExecution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestEagerExecution = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionTask == nil {
+				m.DecisionTask = &v1.PollForDecisionTaskResponse{}
+			}
+			if err := m.DecisionTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *RatelimitUpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12572,10 +14418,10 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12608,7 +14454,7 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.StartWorkflowExecutionRequest{}
+				m.Request = &v1.SignalWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12648,7 +14494,7 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12675,18 +14521,18 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentExecutionInfo == nil {
-				m.ParentExecutionInfo = &v1.ParentExecutionInfo{}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.ParentExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
 			}
-			m.Attempt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -12696,14 +14542,117 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.ChildWorkflowOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12730,18 +14679,18 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = &types.Timestamp{}
+			if m.Request == nil {
+				m.Request = &v1.SignalWithStartWorkflowExecutionRequest{}
 			}
-			if err := m.ExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewInitiator", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			m.ContinueAsNewInitiator = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -12751,14 +14700,27 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContinueAsNewInitiator |= v1.ContinueAsNewInitiator(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12785,18 +14747,160 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinuedFailure == nil {
-				m.ContinuedFailure = &v1.Failure{}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = make(map[string]string)
 			}
-			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -12806,31 +14910,78 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionResult == nil {
-				m.LastCompletionResult = &v1.Payload{}
-			}
-			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstDecisionTaskBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12857,18 +15008,18 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FirstDecisionTaskBackoff == nil {
-				m.FirstDecisionTaskBackoff = &types.Duration{}
+			if m.Request == nil {
+				m.Request = &v1.ResetWorkflowExecutionRequest{}
 			}
-			if err := m.FirstDecisionTaskBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -12878,118 +15029,23 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.PartitionConfig[mapkey] = mapvalue
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13013,7 +15069,7 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13036,10 +15092,10 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13096,7 +15152,7 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13119,10 +15175,10 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13155,7 +15211,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.SignalWorkflowExecutionRequest{}
+				m.Request = &v1.TerminateWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13271,7 +15327,58 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13281,25 +15388,93 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowService
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.DescribeWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13322,7 +15497,7 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13345,15 +15520,15 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13380,18 +15555,18 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.SignalWithStartWorkflowExecutionRequest{}
+			if m.ExecutionConfiguration == nil {
+				m.ExecutionConfiguration = &v1.WorkflowExecutionConfiguration{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutionConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13401,27 +15576,31 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowExecutionInfo == nil {
+				m.WorkflowExecutionInfo = &v1.WorkflowExecutionInfo{}
+			}
+			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13448,160 +15627,50 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = make(map[string]string)
+			m.PendingActivities = append(m.PendingActivities, &v1.PendingActivityInfo{})
+			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.PartitionConfig[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingChildren = append(m.PendingChildren, &v1.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDecision", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13611,23 +15680,27 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			if m.PendingDecision == nil {
+				m.PendingDecision = &v1.PendingDecisionInfo{}
+			}
+			if err := m.PendingDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13651,7 +15724,7 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13674,10 +15747,10 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13710,7 +15783,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.ResetWorkflowExecutionRequest{}
+				m.Request = &v1.QueryWorkflowRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13770,7 +15843,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13793,17 +15866,17 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13813,23 +15886,63 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			if m.QueryResult == nil {
+				m.QueryResult = &v1.Payload{}
+			}
+			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueryRejected == nil {
+				m.QueryRejected = &v1.QueryRejected{}
+			}
+			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13853,7 +15966,7 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13876,10 +15989,10 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetStickyTaskListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetStickyTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13912,7 +16025,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.TerminateWorkflowExecutionRequest{}
+				m.Request = &v1.ResetStickyTaskListRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13950,62 +16063,6 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -14028,7 +16085,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14051,10 +16108,10 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetStickyTaskListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetStickyTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14079,7 +16136,7 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14102,15 +16159,47 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14137,18 +16226,37 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.DescribeWorkflowExecutionRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
+			}
+			m.ExpectedNextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14158,23 +16266,61 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItem", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistoryItem == nil {
+				m.VersionHistoryItem = &v11.VersionHistoryItem{}
+			}
+			if err := m.VersionHistoryItem.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14198,7 +16344,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14221,15 +16367,15 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14256,16 +16402,16 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionConfiguration == nil {
-				m.ExecutionConfiguration = &v1.WorkflowExecutionConfiguration{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.ExecutionConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14292,16 +16438,35 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionInfo == nil {
-				m.WorkflowExecutionInfo = &v1.WorkflowExecutionInfo{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
 			}
-			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14328,14 +16493,35 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingActivities = append(m.PendingActivities, &v1.PendingActivityInfo{})
-			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
+			}
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+			}
+			m.LastFirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14362,14 +16548,16 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingChildren = append(m.PendingChildren, &v1.PendingChildExecutionInfo{})
-			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingDecision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14396,69 +16584,18 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingDecision == nil {
-				m.PendingDecision = &v1.PendingDecisionInfo{}
+			if m.StickyTaskList == nil {
+				m.StickyTaskList = &v1.TaskList{}
 			}
-			if err := m.PendingDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14468,31 +16605,27 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14520,62 +16653,43 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ClientImpl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14602,18 +16716,37 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryResult == nil {
-				m.QueryResult = &v1.Payload{}
+			if m.StickyTaskListScheduleToStartTimeout == nil {
+				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
 			}
-			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14623,82 +16756,67 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRejected == nil {
-				m.QueryRejected = &v1.QueryRejected{}
-			}
-			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			m.WorkflowState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WorkflowCloseState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14725,18 +16843,18 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.ResetStickyTaskListRequest{}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v12.VersionHistories{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskListEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14746,75 +16864,31 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.IsStickyTaskListEnabled = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -14837,7 +16911,7 @@ func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14860,10 +16934,10 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14940,58 +17014,24 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ExpectedNextEventId = 0
 			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15001,26 +17041,24 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistoryItem == nil {
-				m.VersionHistoryItem = &v11.VersionHistoryItem{}
-			}
-			if err := m.VersionHistoryItem.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -15045,7 +17083,7 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15068,10 +17106,10 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15425,227 +17463,10 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
-			}
-			m.EventStoreVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
-			}
-			m.WorkflowState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
-			}
-			m.WorkflowCloseState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v12.VersionHistories{}
-			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskListEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsStickyTaskListEnabled = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
-			}
-			m.HistorySize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistorySize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15655,27 +17476,29 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15702,18 +17525,18 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v12.VersionHistories{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
 			}
-			m.ExpectedNextEventId = 0
+			m.WorkflowState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15723,16 +17546,16 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
 			}
-			var byteLen int
+			m.WorkflowCloseState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15742,26 +17565,11 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -15784,7 +17592,7 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15807,17 +17615,17 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15827,31 +17635,27 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15878,18 +17682,18 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			m.NextEventId = 0
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15899,52 +17703,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				m.ScheduleId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
-			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.LastFirstEventId = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15954,16 +17722,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
+				m.TaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15973,31 +17741,27 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16024,82 +17788,69 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskList == nil {
-				m.StickyTaskList = &v1.TaskList{}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollForDecisionTaskRequest{}
 			}
-			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16109,27 +17860,31 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientImpl = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16156,18 +17911,18 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskListScheduleToStartTimeout == nil {
-				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
 			}
-			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
 			}
-			var byteLen int
+			m.ScheduledEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16177,31 +17932,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ScheduledEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
 			}
-			var msglen int
+			m.StartedEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16211,33 +17951,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartedEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v12.VersionHistories{}
-			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			m.WorkflowState = 0
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16247,16 +17970,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.WorkflowCloseState = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16266,67 +17989,36 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16336,27 +18028,31 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.DecisionInfo == nil {
+				m.DecisionInfo = &v12.TransientDecisionInfo{}
+			}
+			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16383,18 +18079,18 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.WorkflowExecutionTaskList == nil {
+				m.WorkflowExecutionTaskList = &v1.TaskList{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
 			}
-			m.ScheduleId = 0
+			m.EventStoreVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16404,16 +18100,50 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
+				m.EventStoreVersion |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
 			}
-			m.TaskId = 0
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16423,16 +18153,33 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16442,27 +18189,31 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16489,13 +18240,125 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollForDecisionTaskRequest{}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v1.WorkflowQuery)
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *v1.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+			}
+			m.HistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -16518,7 +18381,7 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16541,17 +18404,17 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16561,31 +18424,27 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16612,18 +18471,18 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			m.ScheduledEventId = 0
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16633,16 +18492,16 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
+				m.ScheduleId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.StartedEventId = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16652,35 +18511,16 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
+				m.TaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
-			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			m.Attempt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16690,34 +18530,27 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16744,16 +18577,67 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionInfo == nil {
-				m.DecisionInfo = &v12.TransientDecisionInfo{}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollForActivityTaskRequest{}
 			}
-			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16780,18 +18664,18 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTaskList == nil {
-				m.WorkflowExecutionTaskList = &v1.TaskList{}
+			if m.ScheduledEvent == nil {
+				m.ScheduledEvent = &v1.HistoryEvent{}
 			}
-			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
-			m.EventStoreVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16801,16 +18685,33 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			var byteLen int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16820,29 +18721,14 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16869,16 +18755,16 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = &types.Timestamp{}
+			if m.ScheduledTimeOfThisAttempt == nil {
+				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
 			}
-			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16905,16 +18791,16 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v1.Payload{}
 			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16938,114 +18824,21 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v1.WorkflowQuery)
-			}
-			var mapkey string
-			var mapvalue *v1.WorkflowQuery
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v1.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Queries[mapkey] = mapvalue
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
 			}
-			m.HistorySize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17055,11 +18848,24 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistorySize |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -17082,7 +18888,7 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17105,17 +18911,17 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17125,29 +18931,33 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &v1.RespondDecisionTaskCompletedRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17157,71 +18967,80 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17231,27 +19050,31 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			if m.StartedResponse == nil {
+				m.StartedResponse = &RecordDecisionTaskStartedResponse{}
+			}
+			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivitiesToDispatchLocally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17268,22 +19091,115 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollForActivityTaskRequest{}
-			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivitiesToDispatchLocally == nil {
+				m.ActivitiesToDispatchLocally = make(map[string]*v1.ActivityLocalDispatchInfo)
+			}
+			var mapkey string
+			var mapvalue *v1.ActivityLocalDispatchInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.ActivityLocalDispatchInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ActivitiesToDispatchLocally[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17307,7 +19223,7 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17330,15 +19246,15 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondDecisionTaskFailedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondDecisionTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17365,18 +19281,18 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledEvent == nil {
-				m.ScheduledEvent = &v1.HistoryEvent{}
+			if m.Request == nil {
+				m.Request = &v1.RespondDecisionTaskFailedRequest{}
 			}
-			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17386,86 +19302,129 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
-			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTimeOfThisAttempt == nil {
-				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17492,18 +19451,18 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v1.Payload{}
+			if m.Request == nil {
+				m.Request = &v1.RecordActivityTaskHeartbeatRequest{}
 			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17513,33 +19472,80 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17549,24 +19555,12 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.CancelRequested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -17589,7 +19583,7 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17612,10 +19606,10 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17648,7 +19642,7 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.RespondDecisionTaskCompletedRequest{}
+				m.Request = &v1.RespondActivityTaskCompletedRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -17708,7 +19702,7 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17731,15 +19725,66 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17766,18 +19811,18 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedResponse == nil {
-				m.StartedResponse = &RecordDecisionTaskStartedResponse{}
+			if m.Request == nil {
+				m.Request = &v1.RespondActivityTaskFailedRequest{}
 			}
-			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivitiesToDispatchLocally", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17787,121 +19832,75 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActivitiesToDispatchLocally == nil {
-				m.ActivitiesToDispatchLocally = make(map[string]*v1.ActivityLocalDispatchInfo)
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *v1.ActivityLocalDispatchInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v1.ActivityLocalDispatchInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			m.ActivitiesToDispatchLocally[mapkey] = mapvalue
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -17924,7 +19923,7 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17947,10 +19946,10 @@ func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17983,7 +19982,7 @@ func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.RespondDecisionTaskFailedRequest{}
+				m.Request = &v1.RespondActivityTaskCanceledRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -18043,7 +20042,7 @@ func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18066,10 +20065,10 @@ func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18094,7 +20093,7 @@ func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18117,15 +20116,47 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18152,16 +20183,16 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.RecordActivityTaskHeartbeatRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18189,7 +20220,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18213,7 +20244,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18236,32 +20267,12 @@ func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CancelRequested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -18284,7 +20295,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18307,15 +20318,47 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18342,18 +20385,18 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.RespondActivityTaskCompletedRequest{}
+			if m.CancelRequest == nil {
+				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalExecutionInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -18363,24 +20406,48 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.ExternalExecutionInfo == nil {
+				m.ExternalExecutionInfo = &v1.ExternalExecutionInfo{}
+			}
+			if err := m.ExternalExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -18403,7 +20470,7 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18426,10 +20493,10 @@ func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18454,7 +20521,7 @@ func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18477,15 +20544,47 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleDecisionTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18512,18 +20611,18 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.RespondActivityTaskFailedRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstDecision", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -18533,24 +20632,12 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsFirstDecision = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -18573,7 +20660,7 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduleDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18596,10 +20683,10 @@ func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleDecisionTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18624,7 +20711,7 @@ func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18647,15 +20734,47 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18682,18 +20801,37 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.RespondActivityTaskCanceledRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+			}
+			m.InitiatedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -18703,24 +20841,83 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.CompletedExecution == nil {
+				m.CompletedExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionEvent == nil {
+				m.CompletionEvent = &v1.HistoryEvent{}
+			}
+			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -18743,7 +20940,7 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18766,10 +20963,10 @@ func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18794,7 +20991,7 @@ func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18804,30 +21001,98 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowService
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -18837,27 +21102,29 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v11.VersionHistoryItem{})
+			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18884,18 +21151,18 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Events == nil {
+				m.Events = &v1.DataBlob{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -18905,23 +21172,27 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			if m.NewRunEvents == nil {
+				m.NewRunEvents = &v1.DataBlob{}
+			}
+			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18945,7 +21216,7 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18968,10 +21239,10 @@ func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18996,7 +21267,7 @@ func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19019,15 +21290,15 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19055,13 +21326,13 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var msglen int
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19071,31 +21342,14 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CancelRequest == nil {
-				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
-			}
-			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19122,33 +21376,13 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalExecutionInfo == nil {
-				m.ExternalExecutionInfo = &v1.ExternalExecutionInfo{}
+			if m.Time == nil {
+				m.Time = &types.Timestamp{}
 			}
-			if err := m.ExternalExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -19171,7 +21405,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19194,10 +21428,10 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19222,7 +21456,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19245,10 +21479,10 @@ func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDecisionTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19318,12 +21552,105 @@ func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstDecision", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			}
+			m.ScheduledId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19333,119 +21660,33 @@ func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFirstDecision = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScheduleDecisionTaskResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDecisionTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19455,27 +21696,31 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.LastHeartbeatTime == nil {
+				m.LastHeartbeatTime = &types.Timestamp{}
+			}
+			if err := m.LastHeartbeatTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19502,18 +21747,18 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Details == nil {
+				m.Details = &v1.Payload{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.InitiatedId = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19523,14 +21768,14 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitiatedId |= int64(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19557,18 +21802,18 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletedExecution == nil {
-				m.CompletedExecution = &v1.WorkflowExecution{}
+			if m.LastFailure == nil {
+				m.LastFailure = &v1.Failure{}
 			}
-			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19578,33 +21823,29 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletionEvent == nil {
-				m.CompletionEvent = &v1.HistoryEvent{}
-			}
-			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
 			}
-			m.StartedId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19614,11 +21855,28 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v11.VersionHistory{}
+			}
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -19641,7 +21899,7 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19664,10 +21922,10 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19692,7 +21950,7 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
+func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19715,10 +21973,10 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19751,47 +22009,11 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19818,16 +22040,69 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v11.VersionHistoryItem{})
-			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateInCache", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19837,33 +22112,29 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v1.DataBlob{}
-			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MutableStateInCache = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateInDatabase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19873,27 +22144,23 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v1.DataBlob{}
-			}
-			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MutableStateInDatabase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19917,7 +22184,7 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
+func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19940,10 +22207,10 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19968,7 +22235,7 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19991,17 +22258,112 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfShards", wireType)
+			}
+			m.NumberOfShards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfShards |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainCache", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -20011,29 +22373,33 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			if m.DomainCache == nil {
+				m.DomainCache = &v11.DomainCacheInfo{}
+			}
+			if err := m.DomainCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -20043,16 +22409,29 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -20062,27 +22441,23 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Time == nil {
-				m.Time = &types.Timestamp{}
-			}
-			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20106,7 +22481,7 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20129,12 +22504,31 @@ func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20157,7 +22551,7 @@ func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20180,214 +22574,68 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
-			}
-			m.ScheduledId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = &types.Timestamp{}
-			}
-			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
-			}
-			m.StartedId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
-			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var msglen int
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -20397,33 +22645,16 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastHeartbeatTime == nil {
-				m.LastHeartbeatTime = &types.Timestamp{}
-			}
-			if err := m.LastHeartbeatTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
 			}
-			var msglen int
+			m.TaskType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -20433,33 +22664,16 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskType |= v11.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &v1.Payload{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.Attempt = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -20469,14 +22683,14 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				m.TaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20503,16 +22717,16 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastFailure == nil {
-				m.LastFailure = &v1.Failure{}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = &types.Timestamp{}
 			}
-			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VisibilityTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20540,43 +22754,7 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v11.VersionHistory{}
-			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20600,7 +22778,7 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20623,10 +22801,10 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -20651,7 +22829,7 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20674,15 +22852,34 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20710,13 +22907,13 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
 			}
-			var msglen int
+			m.TaskType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -20726,28 +22923,62 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskType |= v11.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20770,7 +23001,7 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20793,15 +23024,34 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateInCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20829,13 +23079,13 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MutableStateInCache = string(dAtA[iNdEx:postIndex])
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateInDatabase", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
 			}
-			var stringLen uint64
+			m.TaskType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -20845,24 +23095,11 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskType |= v11.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MutableStateInDatabase = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20885,7 +23122,7 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20908,12 +23145,44 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingQueueStates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessingQueueStates = append(m.ProcessingQueueStates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20936,7 +23205,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20959,110 +23228,15 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfShards", wireType)
-			}
-			m.NumberOfShards = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumberOfShards |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthService
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthService
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21089,16 +23263,14 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DomainCache == nil {
-				m.DomainCache = &v11.DomainCacheInfo{}
-			}
-			if err := m.DomainCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, &v11.ReplicationToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21126,13 +23298,84 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitForNewTasks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitForNewTasks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21142,23 +23385,106 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.ShardMessages == nil {
+				m.ShardMessages = make(map[int32]*v11.ReplicationMessages)
+			}
+			var mapkey int32
+			var mapvalue *v11.ReplicationMessages
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.ReplicationMessages{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ShardMessages[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21182,7 +23508,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21205,17 +23531,17 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
 			}
-			m.ShardId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21225,11 +23551,26 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskInfos = append(m.TaskInfos, &v11.ReplicationTaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -21252,7 +23593,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21275,12 +23616,46 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -21303,7 +23678,7 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21326,17 +23701,17 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21346,16 +23721,29 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			m.TaskType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21365,33 +23753,27 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21418,18 +23800,18 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = &types.Timestamp{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.VisibilityTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21439,23 +23821,27 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			if m.Events == nil {
+				m.Events = &v1.DataBlob{}
+			}
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21479,7 +23865,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21502,10 +23888,10 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -21530,7 +23916,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21553,17 +23939,17 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21573,14 +23959,27 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21608,13 +24007,13 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			m.TaskType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21624,11 +24023,28 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -21651,7 +24067,7 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21674,10 +24090,10 @@ func (m *ResetQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -21702,7 +24118,7 @@ func (m *ResetQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *RebuildWorkflowBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21725,17 +24141,17 @@ func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebuildWorkflowBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebuildWorkflowBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21745,14 +24161,27 @@ func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21780,13 +24209,13 @@ func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			m.TaskType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21796,11 +24225,62 @@ func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -21823,7 +24303,7 @@ func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *RebuildWorkflowBranchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21846,44 +24326,12 @@ func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebuildWorkflowBranchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebuildWorkflowBranchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingQueueStates", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProcessingQueueStates = append(m.ProcessingQueueStates, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -21906,7 +24354,7 @@ func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateActivityOptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21929,15 +24377,79 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21964,14 +24476,16 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &v11.ReplicationToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21999,62 +24513,11 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22081,146 +24544,16 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v11.ReplicationMessages)
-			}
-			var mapkey int32
-			var mapvalue *v11.ReplicationMessages
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v11.ReplicationMessages{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			m.ShardMessages[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22247,65 +24580,16 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v11.ReplicationTaskInfo{})
-			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22332,67 +24616,18 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = &types.Duration{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ScheduleToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22402,29 +24637,33 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22434,27 +24673,31 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = &types.Duration{}
+			}
+			if err := m.HeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22481,18 +24724,38 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &v1.RetryPolicy{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetAttempts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetAttempts = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22502,27 +24765,23 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v1.DataBlob{}
-			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22546,7 +24805,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateActivityOptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22569,12 +24828,48 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Activity == nil {
+				m.Activity = &v1.PendingActivityInfo{}
+			}
+			if err := m.Activity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22597,7 +24892,7 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22620,17 +24915,17 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceFetch", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22640,59 +24935,66 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
+			m.ForceFetch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22719,10 +25021,8 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &v11.HistoryDLQCountEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22748,7 +25048,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22771,12 +25071,64 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceFetch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceFetch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22799,7 +25151,7 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22822,17 +25174,17 @@ func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceFetch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22842,12 +25194,26 @@ func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceFetch = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &ReplicationShardStatus{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22870,7 +25236,7 @@ func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicationShardStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22893,15 +25259,123 @@ func (m *CountDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationShardStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationShardStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadLevel", wireType)
+			}
+			m.ReadLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedLevel", wireType)
+			}
+			m.AckedLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckedLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReadLevel", wireType)
+			}
+			m.MaxReadLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReadLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplicatedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22928,8 +25402,65 @@ func (m *CountDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &v11.HistoryDLQCountEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastReplicatedTime == nil {
+				m.LastReplicatedTime = &types.Timestamp{}
+			}
+			if err := m.LastReplicatedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
+			}
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingTaskAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestPendingTaskAge == nil {
+				m.OldestPendingTaskAge = &types.Duration{}
+			}
+			if err := m.OldestPendingTaskAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
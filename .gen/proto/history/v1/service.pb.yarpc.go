@@ -79,7 +79,10 @@ type HistoryAPIYARPCClient interface {
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest, ...yarpc.CallOption) (*ReapplyEventsResponse, error)
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest, ...yarpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	RebuildWorkflowBranch(context.Context, *RebuildWorkflowBranchRequest, ...yarpc.CallOption) (*RebuildWorkflowBranchResponse, error)
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest, ...yarpc.CallOption) (*UpdateActivityOptionsResponse, error)
 	CountDLQMessages(context.Context, *CountDLQMessagesRequest, ...yarpc.CallOption) (*CountDLQMessagesResponse, error)
+	GetReplicationStatus(context.Context, *GetReplicationStatusRequest, ...yarpc.CallOption) (*GetReplicationStatusResponse, error)
 	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest, ...yarpc.CallOption) (*ReadDLQMessagesResponse, error)
 	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest, ...yarpc.CallOption) (*PurgeDLQMessagesResponse, error)
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest, ...yarpc.CallOption) (*MergeDLQMessagesResponse, error)
@@ -143,7 +146,10 @@ type HistoryAPIYARPCServer interface {
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	RebuildWorkflowBranch(context.Context, *RebuildWorkflowBranchRequest) (*RebuildWorkflowBranchResponse, error)
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error)
 	CountDLQMessages(context.Context, *CountDLQMessagesRequest) (*CountDLQMessagesResponse, error)
+	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
 	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest) (*ReadDLQMessagesResponse, error)
 	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest) (*PurgeDLQMessagesResponse, error)
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
@@ -515,6 +521,26 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "RebuildWorkflowBranch",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RebuildWorkflowBranch,
+							NewRequest:  newHistoryAPIServiceRebuildWorkflowBranchYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateActivityOptions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateActivityOptions,
+							NewRequest:  newHistoryAPIServiceUpdateActivityOptionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "CountDLQMessages",
 					Handler: protobuf.NewUnaryHandler(
@@ -525,6 +551,16 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "GetReplicationStatus",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetReplicationStatus,
+							NewRequest:  newHistoryAPIServiceGetReplicationStatusYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "ReadDLQMessages",
 					Handler: protobuf.NewUnaryHandler(
@@ -1143,6 +1179,30 @@ func (c *_HistoryAPIYARPCCaller) RefreshWorkflowTasks(ctx context.Context, reque
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) RebuildWorkflowBranch(ctx context.Context, request *RebuildWorkflowBranchRequest, options ...yarpc.CallOption) (*RebuildWorkflowBranchResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RebuildWorkflowBranch", request, newHistoryAPIServiceRebuildWorkflowBranchYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RebuildWorkflowBranchResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceRebuildWorkflowBranchYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) UpdateActivityOptions(ctx context.Context, request *UpdateActivityOptionsRequest, options ...yarpc.CallOption) (*UpdateActivityOptionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateActivityOptions", request, newHistoryAPIServiceUpdateActivityOptionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateActivityOptionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateActivityOptionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) CountDLQMessages(ctx context.Context, request *CountDLQMessagesRequest, options ...yarpc.CallOption) (*CountDLQMessagesResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "CountDLQMessages", request, newHistoryAPIServiceCountDLQMessagesYARPCResponse, options...)
 	if responseMessage == nil {
@@ -1155,6 +1215,18 @@ func (c *_HistoryAPIYARPCCaller) CountDLQMessages(ctx context.Context, request *
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) GetReplicationStatus(ctx context.Context, request *GetReplicationStatusRequest, options ...yarpc.CallOption) (*GetReplicationStatusResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetReplicationStatus", request, newHistoryAPIServiceGetReplicationStatusYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetReplicationStatusResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceGetReplicationStatusYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) ReadDLQMessages(ctx context.Context, request *ReadDLQMessagesRequest, options ...yarpc.CallOption) (*ReadDLQMessagesResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "ReadDLQMessages", request, newHistoryAPIServiceReadDLQMessagesYARPCResponse, options...)
 	if responseMessage == nil {
@@ -1815,6 +1887,38 @@ func (h *_HistoryAPIYARPCHandler) RefreshWorkflowTasks(ctx context.Context, requ
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) RebuildWorkflowBranch(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RebuildWorkflowBranchRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RebuildWorkflowBranchRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceRebuildWorkflowBranchYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RebuildWorkflowBranch(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) UpdateActivityOptions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateActivityOptionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateActivityOptionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateActivityOptionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateActivityOptions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) CountDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *CountDLQMessagesRequest
 	var ok bool
@@ -1831,6 +1935,22 @@ func (h *_HistoryAPIYARPCHandler) CountDLQMessages(ctx context.Context, requestM
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) GetReplicationStatus(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetReplicationStatusRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetReplicationStatusRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceGetReplicationStatusYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetReplicationStatus(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) ReadDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *ReadDLQMessagesRequest
 	var ok bool
@@ -2239,6 +2359,22 @@ func newHistoryAPIServiceRefreshWorkflowTasksYARPCResponse() proto.Message {
 	return &RefreshWorkflowTasksResponse{}
 }
 
+func newHistoryAPIServiceRebuildWorkflowBranchYARPCRequest() proto.Message {
+	return &RebuildWorkflowBranchRequest{}
+}
+
+func newHistoryAPIServiceRebuildWorkflowBranchYARPCResponse() proto.Message {
+	return &RebuildWorkflowBranchResponse{}
+}
+
+func newHistoryAPIServiceUpdateActivityOptionsYARPCRequest() proto.Message {
+	return &UpdateActivityOptionsRequest{}
+}
+
+func newHistoryAPIServiceUpdateActivityOptionsYARPCResponse() proto.Message {
+	return &UpdateActivityOptionsResponse{}
+}
+
 func newHistoryAPIServiceCountDLQMessagesYARPCRequest() proto.Message {
 	return &CountDLQMessagesRequest{}
 }
@@ -2247,6 +2383,14 @@ func newHistoryAPIServiceCountDLQMessagesYARPCResponse() proto.Message {
 	return &CountDLQMessagesResponse{}
 }
 
+func newHistoryAPIServiceGetReplicationStatusYARPCRequest() proto.Message {
+	return &GetReplicationStatusRequest{}
+}
+
+func newHistoryAPIServiceGetReplicationStatusYARPCResponse() proto.Message {
+	return &GetReplicationStatusResponse{}
+}
+
 func newHistoryAPIServiceReadDLQMessagesYARPCRequest() proto.Message {
 	return &ReadDLQMessagesRequest{}
 }
@@ -2382,8 +2526,14 @@ var (
 	emptyHistoryAPIServiceReapplyEventsYARPCResponse                     = &ReapplyEventsResponse{}
 	emptyHistoryAPIServiceRefreshWorkflowTasksYARPCRequest               = &RefreshWorkflowTasksRequest{}
 	emptyHistoryAPIServiceRefreshWorkflowTasksYARPCResponse              = &RefreshWorkflowTasksResponse{}
+	emptyHistoryAPIServiceRebuildWorkflowBranchYARPCRequest              = &RebuildWorkflowBranchRequest{}
+	emptyHistoryAPIServiceRebuildWorkflowBranchYARPCResponse             = &RebuildWorkflowBranchResponse{}
+	emptyHistoryAPIServiceUpdateActivityOptionsYARPCRequest              = &UpdateActivityOptionsRequest{}
+	emptyHistoryAPIServiceUpdateActivityOptionsYARPCResponse             = &UpdateActivityOptionsResponse{}
 	emptyHistoryAPIServiceCountDLQMessagesYARPCRequest                   = &CountDLQMessagesRequest{}
 	emptyHistoryAPIServiceCountDLQMessagesYARPCResponse                  = &CountDLQMessagesResponse{}
+	emptyHistoryAPIServiceGetReplicationStatusYARPCRequest               = &GetReplicationStatusRequest{}
+	emptyHistoryAPIServiceGetReplicationStatusYARPCResponse              = &GetReplicationStatusResponse{}
 	emptyHistoryAPIServiceReadDLQMessagesYARPCRequest                    = &ReadDLQMessagesRequest{}
 	emptyHistoryAPIServiceReadDLQMessagesYARPCResponse                   = &ReadDLQMessagesResponse{}
 	emptyHistoryAPIServicePurgeDLQMessagesYARPCRequest                   = &PurgeDLQMessagesRequest{}
@@ -2405,317 +2555,349 @@ var (
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
-		0x76, 0xe8, 0x19, 0xf1, 0xf7, 0x48, 0x0e, 0xc9, 0x12, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0xbd, 0x96,
-		0x44, 0xcb, 0xeb, 0xa1, 0x45, 0xd9, 0xfa, 0x59, 0x5e, 0xad, 0x44, 0x4a, 0xf2, 0x38, 0xfa, 0x36,
-		0x69, 0x39, 0x5f, 0xf7, 0x36, 0xa7, 0x6b, 0xc8, 0x8e, 0x7a, 0xba, 0x47, 0xdd, 0x3d, 0xa4, 0xe8,
-		0x43, 0xe0, 0xc4, 0x41, 0x80, 0x2c, 0x82, 0xec, 0x66, 0x91, 0x04, 0x01, 0x02, 0x04, 0x08, 0x36,
-		0xc0, 0x62, 0x8d, 0xdc, 0x12, 0x20, 0x87, 0x24, 0xa7, 0x5c, 0x72, 0xcc, 0x35, 0xf7, 0xdd, 0x43,
-		0x02, 0xe4, 0xb6, 0xe7, 0x20, 0xa8, 0x4f, 0xf7, 0xf4, 0xa7, 0xba, 0x7a, 0x86, 0x0c, 0x22, 0xaf,
-		0xe3, 0xdb, 0x74, 0x55, 0xbd, 0x57, 0xaf, 0x5e, 0xbd, 0xf7, 0xfa, 0xfd, 0x7a, 0xe0, 0x7c, 0x77,
-		0x17, 0x7b, 0xeb, 0x4d, 0xc3, 0xc4, 0x4e, 0x13, 0xaf, 0xef, 0x5b, 0x7e, 0xe0, 0x7a, 0x47, 0xeb,
-		0x07, 0x97, 0xd7, 0x7d, 0xec, 0x1d, 0x58, 0x4d, 0x5c, 0xef, 0x78, 0x6e, 0xe0, 0xa2, 0x05, 0xb2,
-		0xac, 0xce, 0x97, 0xd5, 0xf9, 0xb2, 0xfa, 0xc1, 0xe5, 0xda, 0xf2, 0x9e, 0xeb, 0xee, 0xd9, 0x78,
-		0x9d, 0x2e, 0xdb, 0xed, 0xb6, 0xd6, 0xcd, 0xae, 0x67, 0x04, 0x96, 0xeb, 0x30, 0xc0, 0xda, 0xb9,
-		0xf4, 0x7c, 0x60, 0xb5, 0xb1, 0x1f, 0x18, 0xed, 0x0e, 0x5f, 0x90, 0x41, 0x70, 0xe8, 0x19, 0x9d,
-		0x0e, 0xf6, 0x7c, 0x3e, 0xbf, 0x92, 0x20, 0xd0, 0xe8, 0x58, 0x84, 0xb8, 0xa6, 0xdb, 0x6e, 0x47,
-		0x5b, 0xac, 0x8a, 0x56, 0x84, 0x24, 0x72, 0x2a, 0x44, 0x4b, 0x5e, 0x76, 0x71, 0xb4, 0x40, 0x15,
-		0x2d, 0x08, 0x0c, 0xff, 0x85, 0x6d, 0xf9, 0x81, 0x6c, 0xcd, 0xa1, 0xeb, 0xbd, 0x68, 0xd9, 0xee,
-		0x21, 0x5f, 0x73, 0x49, 0xb4, 0x86, 0xb3, 0x52, 0x4f, 0xad, 0x5d, 0x2b, 0x5a, 0x8b, 0x3d, 0xbe,
-		0xf2, 0x5b, 0xc9, 0x95, 0x66, 0xdb, 0x72, 0x28, 0x17, 0xec, 0xae, 0x1f, 0x14, 0x2d, 0x4a, 0x32,
-		0x62, 0x55, 0xbc, 0xe8, 0x65, 0x17, 0x77, 0xf9, 0x55, 0xd7, 0x2e, 0x8a, 0x97, 0x78, 0xb8, 0x63,
-		0x5b, 0xcd, 0xf8, 0xd5, 0x26, 0x6f, 0xc6, 0xdf, 0x37, 0x3c, 0x6c, 0x92, 0x95, 0x86, 0x13, 0xee,
-		0xf6, 0x46, 0xce, 0x8a, 0x24, 0x4d, 0xe7, 0x73, 0x56, 0x25, 0xd9, 0xa5, 0xfe, 0x6c, 0x18, 0xce,
-		0x6e, 0x07, 0x86, 0x17, 0x7c, 0xc2, 0xc7, 0xef, 0xbd, 0xc2, 0xcd, 0x2e, 0xa1, 0x47, 0xc3, 0x2f,
-		0xbb, 0xd8, 0x0f, 0xd0, 0x43, 0x18, 0xf1, 0xd8, 0xcf, 0xaa, 0xb2, 0xa2, 0xac, 0x8d, 0x6f, 0x6c,
-		0xd4, 0x13, 0x62, 0x6b, 0x74, 0xac, 0xfa, 0xc1, 0xe5, 0xba, 0x14, 0x89, 0x16, 0xa2, 0x40, 0x4b,
-		0x30, 0x66, 0xba, 0x6d, 0xc3, 0x72, 0x74, 0xcb, 0xac, 0x96, 0x56, 0x94, 0xb5, 0x31, 0x6d, 0x94,
-		0x0d, 0x34, 0x4c, 0xf4, 0x9b, 0x30, 0xd7, 0x31, 0x3c, 0xec, 0x04, 0x3a, 0x0e, 0x11, 0xe8, 0x96,
-		0xd3, 0x72, 0xab, 0x65, 0xba, 0xf1, 0x9a, 0x70, 0xe3, 0xa7, 0x14, 0x22, 0xda, 0xb1, 0xe1, 0xb4,
-		0x5c, 0xed, 0x74, 0x27, 0x3b, 0x88, 0xaa, 0x30, 0x62, 0x04, 0x01, 0x6e, 0x77, 0x82, 0xea, 0xa9,
-		0x15, 0x65, 0x6d, 0x48, 0x0b, 0x1f, 0xd1, 0x26, 0x4c, 0xe1, 0x57, 0x1d, 0x8b, 0xa9, 0x98, 0x4e,
-		0x74, 0xa9, 0x3a, 0x44, 0x77, 0xac, 0xd5, 0x99, 0x1e, 0xd5, 0x43, 0x3d, 0xaa, 0xef, 0x84, 0x8a,
-		0xa6, 0x55, 0x7a, 0x20, 0x64, 0x10, 0xb5, 0x60, 0xb1, 0xe9, 0x3a, 0x81, 0xe5, 0x74, 0xb1, 0x6e,
-		0xf8, 0xba, 0x83, 0x0f, 0x75, 0xcb, 0xb1, 0x02, 0xcb, 0x08, 0x5c, 0xaf, 0x3a, 0xbc, 0xa2, 0xac,
-		0x55, 0x36, 0xde, 0x12, 0x1e, 0x60, 0x93, 0x43, 0xdd, 0xf1, 0x1f, 0xe3, 0xc3, 0x46, 0x08, 0xa2,
-		0xcd, 0x37, 0x85, 0xe3, 0xa8, 0x01, 0x33, 0xe1, 0x8c, 0xa9, 0xb7, 0x0c, 0xcb, 0xee, 0x7a, 0xb8,
-		0x3a, 0x42, 0xc9, 0x3d, 0x23, 0xc4, 0x7f, 0x9f, 0xad, 0xd1, 0xa6, 0x23, 0x30, 0x3e, 0x82, 0x34,
-		0x98, 0xb7, 0x0d, 0x3f, 0xd0, 0x9b, 0x6e, 0xbb, 0x63, 0x63, 0x7a, 0x78, 0x0f, 0xfb, 0x5d, 0x3b,
-		0xa8, 0x8e, 0x4a, 0xf0, 0x3d, 0x35, 0x8e, 0x6c, 0xd7, 0x30, 0xb5, 0x59, 0x02, 0xbb, 0x19, 0x81,
-		0x6a, 0x14, 0x12, 0xfd, 0x2a, 0x2c, 0xb5, 0x2c, 0xcf, 0x0f, 0x74, 0x13, 0x37, 0x2d, 0x9f, 0xf2,
-		0xd3, 0xf0, 0x5f, 0xe8, 0xbb, 0x46, 0xf3, 0x85, 0xdb, 0x6a, 0x55, 0xc7, 0x28, 0xe2, 0xc5, 0x0c,
-		0x5f, 0xb7, 0xb8, 0x81, 0xd3, 0xaa, 0x14, 0x7a, 0x8b, 0x03, 0xef, 0x18, 0xfe, 0x8b, 0xbb, 0x0c,
-		0x14, 0x1d, 0xc0, 0x74, 0xc7, 0xf0, 0x02, 0x8b, 0xd2, 0xd9, 0x74, 0x9d, 0x96, 0xb5, 0x57, 0x85,
-		0x95, 0xf2, 0xda, 0xf8, 0xc6, 0xaf, 0xd4, 0x73, 0x0c, 0xa9, 0x5c, 0x2a, 0x89, 0xe8, 0x30, 0x74,
-		0x9b, 0x14, 0xdb, 0x3d, 0x27, 0xf0, 0x8e, 0xb4, 0xa9, 0x4e, 0x72, 0xb4, 0x76, 0x17, 0x66, 0x45,
-		0x0b, 0xd1, 0x34, 0x94, 0x5f, 0xe0, 0x23, 0xaa, 0x14, 0x63, 0x1a, 0xf9, 0x89, 0x66, 0x61, 0xe8,
-		0xc0, 0xb0, 0xbb, 0x98, 0x0b, 0x36, 0x7b, 0xb8, 0x59, 0xba, 0xae, 0xa8, 0xd7, 0x60, 0x39, 0x8f,
-		0x14, 0xbf, 0xe3, 0x3a, 0x3e, 0x46, 0x73, 0x30, 0xec, 0x75, 0xa9, 0x56, 0x30, 0x84, 0x43, 0x5e,
-		0xd7, 0x69, 0x98, 0xea, 0xdf, 0x94, 0x60, 0x79, 0xdb, 0xda, 0x73, 0x0c, 0x3b, 0x57, 0x41, 0x1f,
-		0xa5, 0x15, 0xf4, 0x8a, 0x58, 0x41, 0xa5, 0x58, 0xfa, 0xd4, 0xd0, 0x16, 0x2c, 0xe1, 0x57, 0x01,
-		0xf6, 0x1c, 0xc3, 0x8e, 0x0c, 0x6f, 0x4f, 0x59, 0xb9, 0x9e, 0x5e, 0x10, 0xee, 0x9f, 0xdd, 0x79,
-		0x31, 0x44, 0x95, 0x99, 0x42, 0x75, 0x38, 0xdd, 0xdc, 0xb7, 0x6c, 0xb3, 0xb7, 0x89, 0xeb, 0xd8,
-		0x47, 0x54, 0x6f, 0x47, 0xb5, 0x19, 0x3a, 0x15, 0x02, 0x3d, 0x71, 0xec, 0x23, 0x75, 0x15, 0xce,
-		0xe5, 0x9e, 0x8f, 0x31, 0x58, 0xfd, 0x79, 0x09, 0x2e, 0xf2, 0x35, 0x56, 0xb0, 0x2f, 0xb7, 0x79,
-		0xcf, 0xd3, 0x2c, 0xbd, 0x25, 0x63, 0x69, 0x11, 0xba, 0x3e, 0x79, 0xfb, 0xb9, 0x22, 0x10, 0xf0,
-		0x32, 0x15, 0xf0, 0x8f, 0xf3, 0x05, 0xbc, 0x3f, 0x12, 0xfe, 0x0f, 0x45, 0xfd, 0x0e, 0xac, 0x15,
-		0x13, 0x25, 0x17, 0xfa, 0xef, 0x2b, 0x70, 0x56, 0xc3, 0x3e, 0x3e, 0xf1, 0x4b, 0x49, 0x8a, 0xa4,
-		0xbf, 0x6b, 0x21, 0xaa, 0x9b, 0x87, 0x46, 0x7e, 0x8a, 0x2f, 0x4b, 0xb0, 0xba, 0x83, 0xbd, 0xb6,
-		0xe5, 0x18, 0x01, 0xce, 0x3d, 0xc9, 0xd3, 0xf4, 0x49, 0xae, 0x0a, 0x4f, 0x52, 0x88, 0xe8, 0x97,
-		0x5c, 0x81, 0xdf, 0x00, 0x55, 0x76, 0x44, 0xae, 0xc3, 0x3f, 0x54, 0x60, 0x65, 0x0b, 0xfb, 0x4d,
-		0xcf, 0xda, 0xcd, 0xe7, 0xe8, 0x93, 0x34, 0x47, 0xdf, 0x13, 0x1e, 0xa7, 0x08, 0x4f, 0x9f, 0xe2,
-		0xf1, 0xdf, 0x65, 0x58, 0x95, 0xa0, 0xe2, 0x22, 0x62, 0xc3, 0x42, 0xcf, 0xa5, 0x61, 0xaa, 0xcd,
-		0x5f, 0x78, 0x52, 0x9b, 0x9d, 0x41, 0xb8, 0x19, 0x07, 0xd5, 0xe6, 0xb1, 0x70, 0x1c, 0xed, 0xc2,
-		0x42, 0xf6, 0x6e, 0x99, 0x27, 0x55, 0xa2, 0xbb, 0x5d, 0xea, 0x6f, 0x37, 0xea, 0x4b, 0xcd, 0x1d,
-		0x8a, 0x86, 0xd1, 0x27, 0x80, 0x3a, 0xd8, 0x31, 0x2d, 0x67, 0x4f, 0x37, 0x9a, 0x81, 0x75, 0x60,
-		0x05, 0x16, 0xf6, 0xb9, 0xb9, 0xca, 0x71, 0xd4, 0xd8, 0xf2, 0x3b, 0x6c, 0xf5, 0x11, 0x45, 0x3e,
-		0xd3, 0x49, 0x0c, 0x5a, 0xd8, 0x47, 0xbf, 0x06, 0xd3, 0x21, 0x62, 0x2a, 0x26, 0x1e, 0x76, 0xaa,
-		0xa7, 0x28, 0xda, 0xba, 0x0c, 0xed, 0x26, 0x59, 0x9b, 0xa4, 0x7c, 0xaa, 0x13, 0x9b, 0xf2, 0xb0,
-		0x83, 0xb6, 0x7b, 0xa8, 0x43, 0xef, 0x84, 0x3b, 0x7a, 0x52, 0x8a, 0x43, 0x67, 0x24, 0x81, 0x34,
-		0x1c, 0x54, 0x5f, 0xc1, 0xec, 0x33, 0x12, 0xf3, 0x84, 0xdc, 0x0b, 0xc5, 0x70, 0x33, 0x2d, 0x86,
-		0x6f, 0x0a, 0xf7, 0x10, 0xc1, 0xf6, 0x29, 0x7a, 0x3f, 0x56, 0x60, 0x2e, 0x05, 0xce, 0xc5, 0xed,
-		0x36, 0x4c, 0xd0, 0x38, 0x2c, 0x74, 0xe7, 0x94, 0x3e, 0xdc, 0xb9, 0x71, 0x0a, 0xc1, 0xbd, 0xb8,
-		0x06, 0x54, 0x42, 0x04, 0xbf, 0x8d, 0x9b, 0x01, 0x36, 0xb9, 0xe0, 0xa8, 0xf9, 0x67, 0xd0, 0xf8,
-		0x4a, 0x6d, 0xf2, 0x65, 0xfc, 0x51, 0xfd, 0x7d, 0x05, 0x6a, 0xd4, 0x80, 0x6e, 0x07, 0x56, 0xf3,
-		0xc5, 0x11, 0xf1, 0xe8, 0x1e, 0x5a, 0x7e, 0x10, 0xb2, 0xa9, 0x91, 0x66, 0xd3, 0x7a, 0xbe, 0x25,
-		0x17, 0x62, 0xe8, 0x93, 0x59, 0x67, 0x61, 0x49, 0x88, 0x83, 0x5b, 0x96, 0x7f, 0x2b, 0xc1, 0xfc,
-		0x03, 0x1c, 0x3c, 0xea, 0x06, 0xc6, 0xae, 0x8d, 0xb7, 0x03, 0x23, 0xc0, 0x9a, 0x08, 0xad, 0x92,
-		0xb2, 0xa7, 0x1f, 0x03, 0x12, 0x98, 0xd1, 0xd2, 0x40, 0x66, 0x74, 0x26, 0xa3, 0x61, 0xe8, 0x0a,
-		0xcc, 0xe3, 0x57, 0x1d, 0xca, 0x40, 0xdd, 0xc1, 0xaf, 0x02, 0x1d, 0x1f, 0x90, 0xb0, 0xc8, 0x32,
-		0xa9, 0x85, 0x2e, 0x6b, 0xa7, 0xc3, 0xd9, 0xc7, 0xf8, 0x55, 0x70, 0x8f, 0xcc, 0x35, 0x4c, 0xf4,
-		0x0e, 0xcc, 0x36, 0xbb, 0x1e, 0x8d, 0x9f, 0x76, 0x3d, 0xc3, 0x69, 0xee, 0xeb, 0x81, 0xfb, 0x82,
-		0x6a, 0x8f, 0xb2, 0x36, 0xa1, 0x21, 0x3e, 0x77, 0x97, 0x4e, 0xed, 0x90, 0x19, 0xf4, 0x1b, 0x30,
-		0x7b, 0x80, 0x3d, 0xea, 0xa5, 0x73, 0x9f, 0x42, 0xb7, 0x02, 0xdc, 0xe6, 0x4a, 0x91, 0x16, 0x58,
-		0x12, 0xb4, 0x92, 0x13, 0x3c, 0x67, 0x20, 0x1f, 0x32, 0x88, 0x46, 0x80, 0xdb, 0x1a, 0x3a, 0xc8,
-		0x8c, 0xa9, 0xff, 0x30, 0x06, 0x0b, 0x19, 0x96, 0x72, 0x01, 0x15, 0xb3, 0x4d, 0x39, 0x29, 0xdb,
-		0xee, 0xc3, 0x64, 0x84, 0x36, 0x38, 0xea, 0x60, 0x7e, 0x11, 0xab, 0x52, 0x8c, 0x3b, 0x47, 0x1d,
-		0xac, 0x4d, 0x1c, 0xc6, 0x9e, 0x90, 0x0a, 0x93, 0x22, 0xae, 0x8f, 0x3b, 0x31, 0x6e, 0x3f, 0x87,
-		0xc5, 0x8e, 0x87, 0x0f, 0x2c, 0xb7, 0xeb, 0xeb, 0x3e, 0x71, 0x73, 0xb0, 0xd9, 0x5b, 0x7f, 0x8a,
-		0xee, 0xbb, 0x94, 0x09, 0x73, 0x1a, 0x4e, 0x70, 0xf5, 0xdd, 0xe7, 0xc4, 0x57, 0xd2, 0xe6, 0x43,
-		0xe8, 0x6d, 0x06, 0x1c, 0xe2, 0x7d, 0x1b, 0x4e, 0xd3, 0xa0, 0x8c, 0x45, 0x51, 0x11, 0xc6, 0x21,
-		0x4a, 0xc1, 0x34, 0x99, 0xba, 0x4f, 0x66, 0xc2, 0xe5, 0x37, 0x61, 0x8c, 0x06, 0x58, 0xb6, 0xe5,
-		0x07, 0x34, 0xcc, 0x1c, 0xdf, 0x38, 0x2b, 0xf6, 0x20, 0x42, 0x91, 0x1f, 0x0d, 0xf8, 0x2f, 0xf4,
-		0x00, 0xa6, 0x7d, 0xaa, 0x0e, 0x7a, 0x0f, 0xc5, 0x48, 0x3f, 0x28, 0x2a, 0x7e, 0x42, 0x8b, 0xd0,
-		0xbb, 0x30, 0xdf, 0xb4, 0x2d, 0x42, 0xa9, 0x6d, 0xed, 0x7a, 0x86, 0x77, 0xa4, 0x73, 0x79, 0xa0,
-		0x81, 0xe4, 0x98, 0x36, 0xcb, 0x66, 0x1f, 0xb2, 0x49, 0x2e, 0x3f, 0x31, 0xa8, 0x16, 0x36, 0x82,
-		0xae, 0x87, 0x23, 0xa8, 0xb1, 0x38, 0xd4, 0x7d, 0x36, 0x19, 0x42, 0x9d, 0x83, 0x71, 0x0e, 0x65,
-		0xb5, 0x3b, 0x76, 0x15, 0xe8, 0x52, 0x60, 0x43, 0x8d, 0x76, 0xc7, 0x46, 0x3e, 0x5c, 0x4a, 0x9f,
-		0x4a, 0xf7, 0x9b, 0xfb, 0xd8, 0xec, 0xda, 0x58, 0x0f, 0x5c, 0x76, 0x59, 0x34, 0xca, 0x77, 0xbb,
-		0x41, 0x75, 0xbc, 0x28, 0x20, 0x7d, 0x23, 0x79, 0xd6, 0x6d, 0x8e, 0x69, 0xc7, 0xa5, 0xf7, 0xb6,
-		0xc3, 0xd0, 0x10, 0x7f, 0x87, 0x5d, 0x15, 0x91, 0xff, 0xde, 0x41, 0x26, 0x68, 0xa2, 0x61, 0x86,
-		0x4e, 0x6d, 0x93, 0x99, 0xf0, 0x14, 0x79, 0xba, 0x3a, 0x99, 0xab, 0xab, 0x0f, 0xa1, 0x12, 0xc9,
-		0xb6, 0x4f, 0x94, 0xa9, 0x5a, 0xa1, 0x49, 0x85, 0xf3, 0xc9, 0xab, 0x62, 0x99, 0x9e, 0xb8, 0x7c,
-		0x33, 0xcd, 0x8b, 0x14, 0x83, 0x3e, 0xa2, 0x26, 0xcc, 0x46, 0xd8, 0x9a, 0xb6, 0xeb, 0x63, 0x8e,
-		0x73, 0x8a, 0xe2, 0xbc, 0xdc, 0xa7, 0x37, 0x42, 0x00, 0x09, 0xbe, 0xae, 0xaf, 0x45, 0xfa, 0x1c,
-		0x0d, 0x12, 0x2d, 0x9f, 0x49, 0x9a, 0x17, 0xe2, 0x22, 0x4c, 0x8b, 0x5e, 0xb8, 0x3d, 0xaa, 0x13,
-		0xc6, 0xc5, 0xc2, 0xbe, 0x36, 0x7d, 0x90, 0x1a, 0x41, 0xb7, 0x60, 0xc9, 0x22, 0x3a, 0x97, 0xba,
-		0x63, 0xec, 0x10, 0x3b, 0x63, 0x56, 0x67, 0xa8, 0x8f, 0xb9, 0x60, 0xf9, 0x49, 0x53, 0x7f, 0x8f,
-		0x4d, 0xa3, 0x55, 0x98, 0x08, 0x6d, 0x9d, 0x6f, 0x7d, 0x86, 0xab, 0x88, 0xa9, 0x36, 0x1f, 0xdb,
-		0xb6, 0x3e, 0xc3, 0xea, 0x2f, 0x14, 0x58, 0x78, 0xea, 0xda, 0xf6, 0xff, 0xaf, 0xb7, 0x81, 0xfa,
-		0x93, 0x51, 0xa8, 0x66, 0x8f, 0xfd, 0x8d, 0xc5, 0xfe, 0xc6, 0x62, 0x7f, 0x1d, 0x2d, 0x76, 0x9e,
-		0x7e, 0x4c, 0xe4, 0x5a, 0x60, 0xa1, 0x39, 0x9b, 0x3c, 0xb1, 0x39, 0xfb, 0xe5, 0x33, 0xec, 0xea,
-		0xbf, 0x94, 0x60, 0x45, 0xc3, 0x4d, 0xd7, 0x33, 0xe3, 0x89, 0x5a, 0xae, 0x16, 0xaf, 0xd3, 0x52,
-		0x9e, 0x83, 0xf1, 0x48, 0x70, 0x22, 0x23, 0x00, 0xe1, 0x50, 0xc3, 0x44, 0x0b, 0x30, 0x42, 0x65,
-		0x8c, 0x6b, 0x7c, 0x59, 0x1b, 0x26, 0x8f, 0x0d, 0x13, 0x9d, 0x05, 0xe0, 0x71, 0x44, 0xa8, 0xbb,
-		0x63, 0xda, 0x18, 0x1f, 0x69, 0x98, 0x48, 0x83, 0x89, 0x8e, 0x6b, 0xdb, 0x7a, 0x18, 0xab, 0x0c,
-		0x4b, 0x62, 0x15, 0x62, 0x43, 0xef, 0xbb, 0x5e, 0x9c, 0x35, 0x61, 0xac, 0x32, 0x4e, 0x90, 0xf0,
-		0x07, 0xf5, 0xf7, 0x46, 0x61, 0x55, 0xc2, 0x45, 0x6e, 0x78, 0x33, 0x16, 0x52, 0x39, 0x9e, 0x85,
-		0x94, 0x5a, 0xbf, 0xd2, 0xf1, 0xad, 0xdf, 0xb7, 0x01, 0x85, 0xfc, 0x35, 0xd3, 0xe6, 0x77, 0x3a,
-		0x9a, 0x09, 0x57, 0xaf, 0x11, 0x03, 0x26, 0x30, 0xbd, 0x65, 0x62, 0xa1, 0x12, 0x78, 0x33, 0x16,
-		0x7d, 0x28, 0x6b, 0xd1, 0x63, 0x25, 0x9d, 0xe1, 0x64, 0x49, 0xe7, 0x3a, 0x54, 0xb9, 0x49, 0xe9,
-		0x25, 0x40, 0x42, 0x07, 0x61, 0x84, 0x3a, 0x08, 0xf3, 0x6c, 0x3e, 0x92, 0x9d, 0xd0, 0x3f, 0xd0,
-		0x60, 0x32, 0x2a, 0x5d, 0xd0, 0x94, 0x09, 0xab, 0x85, 0xbc, 0x9d, 0xa7, 0x8d, 0x3b, 0x9e, 0xe1,
-		0xf8, 0xc4, 0x94, 0x25, 0xd2, 0x04, 0x13, 0x66, 0xec, 0x09, 0x7d, 0x0a, 0x67, 0x04, 0x09, 0x99,
-		0x9e, 0x09, 0x1f, 0xeb, 0xc7, 0x84, 0x2f, 0x66, 0xc4, 0x3d, 0xb2, 0xe6, 0x39, 0xde, 0x27, 0xe4,
-		0x79, 0x9f, 0xab, 0x30, 0x91, 0xb0, 0x79, 0xe3, 0xd4, 0xe6, 0x8d, 0xef, 0xc6, 0x8c, 0xdd, 0x1d,
-		0xa8, 0xf4, 0xae, 0x95, 0x96, 0xc4, 0x26, 0x0a, 0x4b, 0x62, 0x93, 0x11, 0x04, 0xad, 0x88, 0x7d,
-		0x00, 0x13, 0xe1, 0x5d, 0x53, 0x04, 0x93, 0x85, 0x08, 0xc6, 0xf9, 0x7a, 0x0a, 0x6e, 0xc0, 0xc8,
-		0xcb, 0x2e, 0xa6, 0x46, 0xb6, 0x42, 0xf3, 0x3f, 0x0f, 0x72, 0xb3, 0xe0, 0x85, 0x5a, 0x44, 0x53,
-		0x14, 0x16, 0xf6, 0x59, 0xde, 0x3b, 0xc4, 0x9b, 0xf1, 0x05, 0xa7, 0x32, 0xbe, 0x60, 0xed, 0x53,
-		0x98, 0x88, 0xc3, 0x0a, 0x52, 0xe1, 0xd7, 0xe3, 0xa9, 0xf0, 0xbc, 0x14, 0x49, 0xa8, 0x98, 0x2c,
-		0x55, 0x12, 0x4b, 0x97, 0xf7, 0x4c, 0x69, 0x98, 0x18, 0xfb, 0xc6, 0x94, 0x66, 0x4c, 0x69, 0x9c,
-		0x35, 0x42, 0x53, 0xfa, 0xb3, 0x72, 0x68, 0x4a, 0x85, 0x5c, 0xe4, 0xa6, 0xf4, 0x23, 0x98, 0x4a,
-		0x99, 0x2a, 0xa9, 0x31, 0xe5, 0xc9, 0x0c, 0x6a, 0x6c, 0xb4, 0x4a, 0xd2, 0x94, 0x65, 0x84, 0xbb,
-		0x34, 0x98, 0x70, 0xc7, 0x2c, 0x57, 0x39, 0x69, 0xb9, 0x3e, 0x85, 0xe5, 0xa4, 0xe2, 0xe9, 0x6e,
-		0x4b, 0x0f, 0xf6, 0x2d, 0x5f, 0x8f, 0x57, 0xaf, 0xe5, 0x5b, 0xd5, 0x12, 0x8a, 0xf8, 0xa4, 0xb5,
-		0xb3, 0x6f, 0xf9, 0x77, 0x38, 0xfe, 0x06, 0xcc, 0xec, 0x63, 0xc3, 0x0b, 0x76, 0xb1, 0x11, 0xe8,
-		0x26, 0x0e, 0x0c, 0xcb, 0xf6, 0x79, 0xc2, 0x47, 0x9e, 0x20, 0x9c, 0x8e, 0xc0, 0xb6, 0x18, 0x54,
-		0xf6, 0xd5, 0x34, 0x7c, 0xbc, 0x57, 0xd3, 0x45, 0x98, 0x8a, 0xf0, 0x30, 0xb1, 0xa6, 0x36, 0x7a,
-		0x4c, 0x8b, 0x1c, 0xa3, 0x2d, 0x3a, 0xaa, 0xfe, 0xb9, 0x02, 0xdf, 0x62, 0xb7, 0x99, 0x50, 0x76,
-		0x5e, 0x84, 0xee, 0xe9, 0x8b, 0x96, 0x4e, 0x2a, 0x5e, 0xcf, 0x4b, 0x2a, 0x16, 0xa1, 0xea, 0x33,
-		0xbb, 0xf8, 0x77, 0x65, 0x78, 0x43, 0x8e, 0x8d, 0x8b, 0x20, 0xee, 0xbd, 0xff, 0x3c, 0x3e, 0xc6,
-		0x49, 0xbc, 0x79, 0x7c, 0xeb, 0xa6, 0x4d, 0xf9, 0x29, 0x49, 0xff, 0xb1, 0x02, 0xcb, 0xbd, 0xb4,
-		0x3c, 0xf1, 0xa1, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7, 0xdd, 0xa6, 0x61, 0xdb, 0x47,
-		0xd5, 0x12, 0xb5, 0xa9, 0x9f, 0x4a, 0x76, 0x2d, 0x3e, 0x4e, 0xbd, 0x97, 0xb7, 0xdf, 0x71, 0xb7,
-		0xf8, 0x0e, 0x0f, 0xd9, 0x06, 0xcc, 0xd4, 0x2e, 0x19, 0xf9, 0x2b, 0x6a, 0xbf, 0x03, 0x2b, 0x45,
-		0x08, 0x04, 0xf6, 0x76, 0x2b, 0x69, 0x6f, 0xc5, 0x55, 0x81, 0xd0, 0x0c, 0x50, 0x5c, 0x21, 0x62,
-		0xfa, 0x66, 0x8e, 0xd9, 0xde, 0x1f, 0x2a, 0xc4, 0xf6, 0x66, 0x8e, 0x79, 0xdf, 0xb0, 0xec, 0x9e,
-		0x2c, 0xf5, 0x59, 0x4e, 0x2a, 0xc2, 0xd3, 0xa7, 0x20, 0x7d, 0x8b, 0xd8, 0xb1, 0x5c, 0x4c, 0x3c,
-		0x59, 0xfd, 0xa7, 0x0a, 0xa8, 0x59, 0x6b, 0xf7, 0x61, 0xa8, 0x9e, 0x21, 0xe5, 0xcf, 0xd2, 0x94,
-		0x5f, 0xcb, 0xa1, 0xbc, 0x08, 0x53, 0x9f, 0xb4, 0x3f, 0x25, 0xca, 0x29, 0xc1, 0xc5, 0x65, 0xf3,
-		0x4d, 0x98, 0x6e, 0x1a, 0x4e, 0x13, 0x47, 0x6f, 0x00, 0xcc, 0xde, 0x69, 0xa3, 0xda, 0x14, 0x1b,
-		0xd7, 0xc2, 0xe1, 0xb8, 0xbe, 0xc7, 0x71, 0x9e, 0x50, 0xdf, 0x65, 0xa8, 0xfa, 0x3c, 0xea, 0x85,
-		0x48, 0xdd, 0x73, 0x90, 0xc5, 0x0a, 0x96, 0x82, 0x85, 0x27, 0x91, 0xb0, 0x5c, 0x3c, 0x03, 0x4b,
-		0x98, 0x08, 0x53, 0x42, 0xc2, 0xb2, 0x07, 0xa4, 0xf7, 0xd3, 0xa3, 0xbc, 0x6f, 0x09, 0x2b, 0xc2,
-		0xd4, 0x27, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe1, 0xe2, 0xd4, 0xff, 0xbd, 0x02, 0xe7, 0x34, 0xdc,
-		0x76, 0x0f, 0x30, 0xeb, 0x44, 0xf8, 0xaa, 0xe4, 0xf1, 0x92, 0x8e, 0x51, 0x39, 0xe5, 0x18, 0xa9,
-		0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0xb1, 0x04, 0xe7, 0xf9, 0x11, 0xd8, 0xb1, 0x73,
-		0xcb, 0xe0, 0xd2, 0x03, 0x1a, 0x50, 0x49, 0xea, 0x20, 0x3f, 0xdc, 0xcd, 0x9c, 0xfb, 0xeb, 0x63,
-		0x43, 0x6d, 0x32, 0xa1, 0xbd, 0x68, 0x17, 0x16, 0xa2, 0x4e, 0x03, 0x61, 0x3b, 0x9f, 0xb8, 0x08,
-		0x7d, 0x8f, 0xc3, 0xa4, 0x8a, 0xd0, 0x58, 0x34, 0x3c, 0x70, 0x97, 0xc1, 0x1a, 0x5c, 0x28, 0x3a,
-		0x0b, 0xe7, 0xf3, 0x3f, 0x2b, 0xb0, 0x14, 0x26, 0x8e, 0x04, 0x81, 0xfc, 0x6b, 0x11, 0x9f, 0x4b,
-		0x30, 0x63, 0xf9, 0x7a, 0xb2, 0xbb, 0x8e, 0xf2, 0x72, 0x54, 0x9b, 0xb2, 0xfc, 0xfb, 0xf1, 0xbe,
-		0x39, 0x75, 0x19, 0xce, 0x88, 0xc9, 0xe7, 0xe7, 0xfb, 0x82, 0x3a, 0x2c, 0xc4, 0x58, 0x27, 0x0b,
-		0xe7, 0x19, 0xd3, 0xfa, 0x3a, 0x0e, 0xba, 0x0a, 0x13, 0xbc, 0x75, 0x12, 0x9b, 0xb1, 0x5c, 0x6e,
-		0x34, 0xd6, 0x30, 0xd1, 0x27, 0x70, 0xba, 0x19, 0x92, 0x1a, 0xdb, 0xfa, 0xd4, 0x40, 0x5b, 0xa3,
-		0x08, 0x45, 0x6f, 0xef, 0x87, 0x30, 0x1d, 0x6b, 0x87, 0x64, 0x41, 0xc2, 0x50, 0xbf, 0x41, 0xc2,
-		0x54, 0x0f, 0x94, 0x45, 0x09, 0x67, 0x01, 0x42, 0x77, 0xcf, 0x32, 0xa9, 0x7b, 0x5c, 0xd6, 0xc6,
-		0xf8, 0x48, 0xc3, 0x54, 0x2f, 0x12, 0x65, 0x96, 0x5e, 0x02, 0xbf, 0xae, 0xff, 0x28, 0x41, 0x55,
-		0xe3, 0xbd, 0xc2, 0x98, 0xa2, 0xf6, 0x9f, 0x6f, 0xbc, 0xce, 0x2b, 0xfa, 0x2d, 0x98, 0x13, 0x55,
-		0x8e, 0xc3, 0x0e, 0x90, 0x01, 0x4a, 0xc7, 0xa7, 0xb3, 0xa5, 0x63, 0x1f, 0xbd, 0x07, 0xc3, 0x94,
-		0xf5, 0x3e, 0xbf, 0x51, 0x71, 0x6a, 0x64, 0xcb, 0x08, 0x8c, 0xbb, 0xb6, 0xbb, 0xab, 0xf1, 0xc5,
-		0x68, 0x13, 0x2a, 0x0e, 0x3e, 0xd4, 0xbd, 0x2e, 0xbf, 0xb9, 0x30, 0xb0, 0x29, 0x00, 0x9f, 0x70,
-		0xf0, 0xa1, 0xd6, 0x65, 0x57, 0xe6, 0xab, 0x4b, 0xb0, 0x28, 0x60, 0x35, 0xbf, 0x88, 0xef, 0x2b,
-		0x30, 0xbf, 0x7d, 0xe4, 0x34, 0xb7, 0xf7, 0x0d, 0xcf, 0xe4, 0x19, 0x52, 0x7e, 0x0d, 0xe7, 0xa1,
-		0xe2, 0xbb, 0x5d, 0xaf, 0x89, 0x75, 0xde, 0x42, 0xce, 0xef, 0x62, 0x92, 0x8d, 0x6e, 0xb2, 0x41,
-		0xb4, 0x08, 0xa3, 0x3e, 0x01, 0x0e, 0xdf, 0x6f, 0x43, 0xda, 0x08, 0x7d, 0x6e, 0x98, 0xa8, 0x0e,
-		0xa7, 0x68, 0x2c, 0x59, 0x2e, 0x0c, 0xf0, 0xe8, 0x3a, 0x75, 0x11, 0x16, 0x32, 0xb4, 0x70, 0x3a,
-		0xff, 0x75, 0x08, 0x4e, 0x93, 0xb9, 0xf0, 0x3d, 0xf9, 0x3a, 0x65, 0xa5, 0x0a, 0x23, 0x61, 0x46,
-		0x8a, 0x69, 0x72, 0xf8, 0x48, 0x14, 0xbd, 0x17, 0xeb, 0x46, 0x79, 0x84, 0x28, 0xef, 0x40, 0x78,
-		0x92, 0xcd, 0x43, 0x0d, 0x0d, 0x9a, 0x87, 0x92, 0x2b, 0x61, 0x26, 0x92, 0x1f, 0x19, 0x2c, 0x92,
-		0xff, 0x88, 0x57, 0x7f, 0x7a, 0x41, 0x35, 0xc5, 0x32, 0x5a, 0x88, 0x65, 0x86, 0x80, 0x45, 0xee,
-		0x31, 0xc5, 0x75, 0x15, 0x46, 0xc2, 0x88, 0x7c, 0xac, 0x8f, 0x88, 0x3c, 0x5c, 0x1c, 0xcf, 0x26,
-		0x40, 0x32, 0x9b, 0x70, 0x1b, 0x26, 0x58, 0x6d, 0x8a, 0x37, 0x8a, 0x8f, 0xf7, 0xd1, 0x28, 0x3e,
-		0x4e, 0x4b, 0x56, 0xbc, 0x47, 0xfc, 0x1d, 0xa0, 0x7d, 0xde, 0xfc, 0xd3, 0x09, 0xdd, 0x32, 0xb1,
-		0x13, 0x58, 0xc1, 0x11, 0xcd, 0x06, 0x8e, 0x69, 0x88, 0xcc, 0x7d, 0x42, 0xa7, 0x1a, 0x7c, 0x06,
-		0x3d, 0x86, 0xa9, 0x94, 0x69, 0xe0, 0x99, 0xbf, 0xf3, 0x7d, 0x19, 0x05, 0xad, 0x92, 0x34, 0x08,
-		0xea, 0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x28, 0xb0, 0x14, 0x76, 0xde, 0x7d, 0x45,
-		0x3c, 0x3c, 0xf5, 0x8f, 0x15, 0x38, 0x23, 0xa6, 0x89, 0x07, 0x3f, 0x57, 0x60, 0xbe, 0xcd, 0xc6,
-		0x59, 0x5d, 0x46, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7, 0xf0, 0x74, 0x3b, 0x06, 0xd5,
-		0x70, 0x36, 0xc9, 0x14, 0xba, 0x01, 0x8b, 0x19, 0x20, 0xd3, 0x08, 0x8c, 0x5d, 0xc3, 0x0f, 0x1b,
-		0x70, 0xe7, 0x93, 0x70, 0x5b, 0x7c, 0x56, 0x3d, 0x03, 0xb5, 0x90, 0x1e, 0xce, 0xcf, 0x0f, 0xdd,
-		0xa8, 0x75, 0x4a, 0xfd, 0xdd, 0x52, 0x8f, 0x85, 0x89, 0x69, 0x4e, 0xed, 0x1a, 0x4c, 0x3b, 0xdd,
-		0xf6, 0x2e, 0xf6, 0x74, 0xb7, 0xa5, 0x53, 0x2b, 0xe5, 0x53, 0x3a, 0x87, 0xb4, 0x0a, 0x1b, 0x7f,
-		0xd2, 0xa2, 0xc6, 0xc7, 0x27, 0xcc, 0x0e, 0xad, 0x9a, 0x4f, 0x53, 0x0b, 0x43, 0xda, 0x28, 0x37,
-		0x6b, 0x3e, 0x6a, 0xc0, 0x04, 0xbf, 0x09, 0x76, 0x54, 0x71, 0x97, 0x69, 0x28, 0x0e, 0x2c, 0xd7,
-		0x43, 0x4f, 0x4e, 0x7d, 0xbf, 0x71, 0xb3, 0x37, 0x80, 0xae, 0xc2, 0x02, 0xdb, 0xa7, 0xe9, 0x3a,
-		0x81, 0xe7, 0xda, 0x36, 0xf6, 0x28, 0x4f, 0xba, 0xec, 0x4d, 0x31, 0xa6, 0xcd, 0xd1, 0xe9, 0xcd,
-		0x68, 0x96, 0xd9, 0x45, 0xaa, 0x21, 0xa6, 0xe9, 0x61, 0xdf, 0xe7, 0x09, 0xc9, 0xf0, 0x51, 0xad,
-		0xc3, 0x0c, 0xab, 0x6c, 0x11, 0xb8, 0x50, 0x76, 0xe2, 0x46, 0x5a, 0x49, 0x18, 0x69, 0x75, 0x16,
-		0x50, 0x7c, 0x3d, 0x17, 0xc6, 0xff, 0x52, 0x60, 0x86, 0x39, 0xef, 0x71, 0x2f, 0x31, 0x1f, 0x0d,
-		0xba, 0xc5, 0xab, 0xc0, 0x51, 0xd1, 0xbb, 0xb2, 0x71, 0x2e, 0x87, 0x21, 0x04, 0x23, 0xcd, 0x9a,
-		0xd1, 0x3a, 0x30, 0xcd, 0x98, 0xc5, 0x72, 0xaf, 0xe5, 0x44, 0xee, 0x75, 0x13, 0xa6, 0x0e, 0x2c,
-		0xdf, 0xda, 0xb5, 0x6c, 0x2b, 0x38, 0x62, 0x96, 0xa8, 0x38, 0x5d, 0x58, 0xe9, 0x81, 0x50, 0x33,
-		0xb4, 0x0a, 0x13, 0xfc, 0x15, 0xa6, 0x3b, 0x06, 0xb7, 0xb8, 0x63, 0xda, 0x38, 0x1f, 0x7b, 0x6c,
-		0xb4, 0x31, 0xe1, 0x42, 0xfc, 0xb8, 0x9c, 0x0b, 0x3f, 0xa0, 0x5c, 0xf0, 0x71, 0xf0, 0xac, 0x8b,
-		0xbb, 0xb8, 0x0f, 0x2e, 0xa4, 0x77, 0x2a, 0x65, 0x76, 0x4a, 0x32, 0xaa, 0x3c, 0x20, 0xa3, 0x18,
-		0x9d, 0x3d, 0x82, 0x38, 0x9d, 0x3f, 0x52, 0x60, 0x36, 0x94, 0xfb, 0xaf, 0x0c, 0xa9, 0x4f, 0x60,
-		0x2e, 0x45, 0x13, 0xd7, 0xc2, 0xab, 0xb0, 0xd0, 0xf1, 0xdc, 0x26, 0xf6, 0x7d, 0xcb, 0xd9, 0xd3,
-		0xe9, 0x57, 0x65, 0xcc, 0x0e, 0x10, 0x65, 0x2c, 0x13, 0x99, 0xef, 0x4d, 0x53, 0x48, 0x6a, 0x04,
-		0x7c, 0xf5, 0x0b, 0x05, 0xce, 0x3e, 0xc0, 0x81, 0xd6, 0xfb, 0xc6, 0xec, 0x11, 0xf6, 0x7d, 0x63,
-		0x0f, 0x47, 0x2e, 0xcb, 0x6d, 0x18, 0xa6, 0x05, 0x20, 0x86, 0x68, 0x7c, 0xe3, 0x62, 0x0e, 0xb5,
-		0x31, 0x14, 0xb4, 0x3a, 0xa4, 0x71, 0xb0, 0x3e, 0x98, 0x42, 0x6c, 0xcc, 0x72, 0x1e, 0x15, 0xfc,
-		0x80, 0x2f, 0xa1, 0xc2, 0xb8, 0xde, 0xe6, 0x33, 0x9c, 0x9c, 0x8f, 0x72, 0x93, 0x93, 0x72, 0x84,
-		0x75, 0xaa, 0x9b, 0xe1, 0x28, 0x4b, 0x44, 0x4e, 0xfa, 0xf1, 0xb1, 0x9a, 0x0d, 0x28, 0xbb, 0x28,
-		0x9e, 0x6c, 0x1c, 0x62, 0xc9, 0xc6, 0xef, 0x26, 0x93, 0x8d, 0x97, 0x8a, 0x19, 0x14, 0x11, 0x13,
-		0x4b, 0x34, 0xb6, 0x61, 0xe5, 0x01, 0x0e, 0xb6, 0x1e, 0x3e, 0x93, 0xdc, 0x45, 0x03, 0x80, 0xa9,
-		0xb4, 0xd3, 0x72, 0x43, 0x06, 0xf4, 0xb1, 0x1d, 0x11, 0x24, 0x6a, 0x26, 0xa9, 0xe8, 0x91, 0x5f,
-		0xbe, 0xfa, 0x0a, 0x56, 0x25, 0xdb, 0x71, 0xa6, 0x6f, 0xc3, 0x4c, 0xec, 0xeb, 0x43, 0x5a, 0x8c,
-		0x0c, 0xb7, 0xbd, 0xd0, 0xdf, 0xb6, 0xda, 0xb4, 0x97, 0x1c, 0xf0, 0xd5, 0x7f, 0x57, 0x60, 0x56,
-		0xc3, 0x46, 0xa7, 0x63, 0xb3, 0x88, 0x28, 0x3a, 0xdd, 0x3c, 0x0c, 0xf3, 0xcc, 0x3e, 0x7b, 0xcf,
-		0xf1, 0x27, 0xf9, 0xc7, 0x0a, 0xe2, 0x97, 0x74, 0xf9, 0xa4, 0xfe, 0xe8, 0xf1, 0x82, 0x0b, 0x75,
-		0x01, 0xe6, 0x52, 0x47, 0xe3, 0xd6, 0xe4, 0xa7, 0x0a, 0x2c, 0x69, 0xb8, 0xe5, 0x61, 0x7f, 0x3f,
-		0x2a, 0x72, 0x10, 0x6e, 0x7c, 0x05, 0xcf, 0xae, 0x2e, 0xc3, 0x19, 0x31, 0xa9, 0xfc, 0x2c, 0x37,
-		0x60, 0x61, 0xd3, 0xed, 0x3a, 0x44, 0x78, 0xd2, 0x02, 0xba, 0x0c, 0xd0, 0x72, 0xbd, 0x26, 0xbe,
-		0x8f, 0x83, 0xe6, 0x3e, 0xcf, 0xd8, 0xc6, 0x46, 0x54, 0x03, 0xaa, 0x59, 0x50, 0x2e, 0x6c, 0xf7,
-		0x60, 0x04, 0x3b, 0x01, 0xad, 0xe5, 0x32, 0x11, 0x7b, 0x2b, 0x47, 0xc4, 0xb8, 0x17, 0xb2, 0xf5,
-		0xf0, 0x19, 0xc5, 0xc5, 0xeb, 0xb5, 0x1c, 0x56, 0xfd, 0x69, 0x09, 0xe6, 0x35, 0x6c, 0x98, 0x02,
-		0xea, 0x36, 0xe0, 0x54, 0xd4, 0x1d, 0x51, 0xd9, 0x58, 0xce, 0xf3, 0x2d, 0x1e, 0x3e, 0xa3, 0x56,
-		0x97, 0xae, 0x95, 0x85, 0x62, 0xd9, 0x60, 0xae, 0x2c, 0x0a, 0xe6, 0x76, 0xa0, 0x6a, 0x39, 0x64,
-		0x85, 0x75, 0x80, 0x75, 0xec, 0x44, 0x16, 0xac, 0xcf, 0x8e, 0xb2, 0xb9, 0x08, 0xf8, 0x9e, 0x13,
-		0x9a, 0xa2, 0x86, 0x49, 0x04, 0xa3, 0x43, 0x90, 0xd0, 0x9a, 0xf4, 0x10, 0x25, 0x6c, 0x94, 0x0c,
-		0x6c, 0x5b, 0x9f, 0x61, 0x74, 0x01, 0xa6, 0x68, 0x5f, 0x04, 0x5d, 0xc1, 0xca, 0xf7, 0xc3, 0xb4,
-		0x7c, 0x4f, 0xdb, 0x25, 0x9e, 0x1a, 0x7b, 0x98, 0x75, 0xf3, 0xfd, 0x6d, 0x09, 0x16, 0x32, 0xbc,
-		0xe2, 0xd7, 0x71, 0x1c, 0x66, 0x09, 0xed, 0x45, 0xe9, 0x64, 0xf6, 0x02, 0x7d, 0x0f, 0xe6, 0x33,
-		0x48, 0xc3, 0x1c, 0xe1, 0xa0, 0x06, 0x70, 0x36, 0x8d, 0x9d, 0xa6, 0x08, 0x05, 0xec, 0x3a, 0x25,
-		0x62, 0xd7, 0xcf, 0x15, 0x58, 0x78, 0xda, 0xf5, 0xf6, 0xf0, 0xd7, 0x5b, 0xb6, 0xd4, 0x1a, 0x54,
-		0xb3, 0xc7, 0xe4, 0xca, 0xff, 0x65, 0x09, 0x16, 0x1e, 0xe1, 0xaf, 0x3d, 0x0f, 0xfe, 0x77, 0xf4,
-		0xeb, 0x2e, 0x54, 0xb3, 0xbc, 0xe2, 0xfa, 0x25, 0xc0, 0xa1, 0x88, 0x70, 0x7c, 0xae, 0xc0, 0x99,
-		0xc7, 0x6e, 0x60, 0xb5, 0x8e, 0x48, 0xb8, 0xed, 0x1e, 0x60, 0xef, 0x91, 0x41, 0x62, 0xe9, 0x88,
-		0xeb, 0xdf, 0x83, 0xf9, 0x16, 0x9f, 0xd1, 0xdb, 0x74, 0x4a, 0x4f, 0x38, 0x6c, 0x79, 0xfa, 0x91,
-		0x44, 0xc7, 0x7c, 0xb6, 0xd9, 0x56, 0x76, 0xd0, 0x57, 0xcf, 0xc1, 0xd9, 0x1c, 0x0a, 0xb8, 0x50,
-		0x18, 0xb0, 0xf4, 0x00, 0x07, 0x9b, 0x9e, 0xeb, 0xfb, 0xfc, 0x56, 0x12, 0x2f, 0xb7, 0x44, 0xe0,
-		0xa7, 0xa4, 0x02, 0xbf, 0xf3, 0x50, 0x09, 0x0c, 0x6f, 0x0f, 0x07, 0xd1, 0x2d, 0xb3, 0xd7, 0xdc,
-		0x24, 0x1b, 0xe5, 0xf8, 0xd4, 0x5f, 0x94, 0xe1, 0x8c, 0x78, 0x0f, 0xce, 0xcf, 0x36, 0xc1, 0x43,
-		0x4c, 0xc3, 0xee, 0x11, 0x0b, 0x43, 0xf9, 0xf1, 0x1f, 0xc8, 0x1c, 0xc4, 0x5c, 0x74, 0xd4, 0xf9,
-		0xf6, 0xef, 0x1e, 0x51, 0x07, 0x90, 0xbd, 0x61, 0x26, 0x82, 0xd8, 0x10, 0xfa, 0x5c, 0x81, 0xb9,
-		0x16, 0x2d, 0x88, 0xe9, 0x4d, 0xa3, 0xeb, 0xe3, 0xde, 0xb6, 0xcc, 0xde, 0x3d, 0x3a, 0xde, 0xb6,
-		0xac, 0xc6, 0xb6, 0x49, 0x30, 0x26, 0x36, 0x47, 0xad, 0xcc, 0x44, 0xad, 0x03, 0x33, 0x19, 0x2a,
-		0x05, 0xee, 0xe9, 0xbd, 0xa4, 0x7b, 0xba, 0x9e, 0x23, 0x0e, 0x69, 0x9a, 0xf8, 0xe5, 0xc5, 0x7d,
-		0xd4, 0x5a, 0x07, 0x16, 0x72, 0x08, 0x14, 0xec, 0x7b, 0x3b, 0xbe, 0x6f, 0x25, 0x37, 0xdd, 0xfb,
-		0x00, 0x07, 0xbd, 0xe2, 0x22, 0xc5, 0x1b, 0xf7, 0x8a, 0xff, 0x53, 0x81, 0x35, 0x5e, 0xce, 0xcb,
-		0x30, 0x2d, 0x53, 0x87, 0x90, 0x44, 0x66, 0xfd, 0x49, 0x19, 0x7a, 0xce, 0x84, 0x28, 0xea, 0xbb,
-		0x08, 0x73, 0xd5, 0xfd, 0x33, 0x8d, 0x77, 0x5b, 0x4c, 0x06, 0xb1, 0x27, 0x1f, 0xbd, 0x01, 0x93,
-		0x2d, 0xe2, 0x00, 0x3d, 0xc6, 0xcc, 0x97, 0xe2, 0xe5, 0xa7, 0xe4, 0xa0, 0xea, 0xc1, 0x9b, 0x7d,
-		0x9c, 0x35, 0x72, 0x97, 0x86, 0x42, 0x7f, 0xfc, 0x78, 0xd7, 0x4a, 0xa1, 0xd5, 0xf7, 0xe8, 0x37,
-		0x6d, 0xa1, 0x62, 0xd3, 0x97, 0x64, 0x1f, 0xb9, 0x31, 0x35, 0xa0, 0xdf, 0x6d, 0x25, 0xc1, 0x22,
-		0xc7, 0x61, 0xae, 0x57, 0x76, 0x09, 0x13, 0x31, 0x5d, 0xde, 0x47, 0x35, 0xa4, 0xf5, 0x6a, 0x32,
-		0xdb, 0x2c, 0x0b, 0xd3, 0x75, 0x68, 0x5e, 0x3c, 0xfc, 0xea, 0x92, 0xa7, 0x90, 0x58, 0x7e, 0x68,
-		0x92, 0x8f, 0xb2, 0x0c, 0x92, 0xda, 0x80, 0x79, 0xcd, 0x08, 0xb0, 0x6d, 0xb5, 0xad, 0xe0, 0xe3,
-		0x8e, 0x19, 0x4b, 0xe4, 0xad, 0xc3, 0x29, 0xd3, 0x08, 0x0c, 0xce, 0x8c, 0xa5, 0xbc, 0x46, 0xcc,
-		0x3b, 0xce, 0x91, 0x46, 0x17, 0xaa, 0x1f, 0xc1, 0x42, 0x06, 0x15, 0x3f, 0xc0, 0xa0, 0xb8, 0x36,
-		0xfe, 0xa9, 0x0e, 0xc0, 0x9d, 0xd2, 0x3b, 0x4f, 0x1b, 0xe8, 0x0f, 0x15, 0x98, 0x17, 0x7f, 0xd4,
-		0x8e, 0xae, 0x1e, 0xef, 0x5f, 0x28, 0x6a, 0xd7, 0x06, 0x86, 0xe3, 0x67, 0xf9, 0x23, 0x05, 0x16,
-		0x72, 0xfe, 0xf5, 0x00, 0x5d, 0x2b, 0xfa, 0xc7, 0x80, 0x3c, 0x6a, 0xae, 0x0f, 0x0e, 0xc8, 0xc9,
-		0xf9, 0x89, 0x02, 0x2b, 0x45, 0x5f, 0xfe, 0xa3, 0xef, 0x9e, 0xf4, 0x9f, 0x0c, 0x6a, 0x77, 0x4e,
-		0x80, 0x81, 0x53, 0x4a, 0x2e, 0x51, 0xfc, 0x4d, 0xbf, 0xe4, 0x12, 0xa5, 0xff, 0x25, 0x20, 0xb9,
-		0xc4, 0x82, 0x3f, 0x0f, 0xf8, 0x33, 0x05, 0x6a, 0xf9, 0x5f, 0xbe, 0xa3, 0xfc, 0xae, 0xb0, 0xc2,
-		0x7f, 0x04, 0xa8, 0xbd, 0x7f, 0x2c, 0x58, 0x4e, 0xd7, 0x8f, 0x14, 0x58, 0xcc, 0xfd, 0xae, 0x1d,
-		0xdd, 0xc8, 0x45, 0x5d, 0xf4, 0x59, 0x7d, 0xed, 0xe6, 0x71, 0x40, 0x39, 0x51, 0x0e, 0x4c, 0x26,
-		0x3e, 0x78, 0x46, 0x6f, 0xe7, 0x22, 0x13, 0x7d, 0x57, 0x5d, 0xab, 0xf7, 0xbb, 0x9c, 0xef, 0xf7,
-		0xb9, 0x02, 0xa7, 0x05, 0x5f, 0x0d, 0xa3, 0x2b, 0xf2, 0xdb, 0x16, 0x7e, 0xa7, 0x5c, 0x7b, 0x77,
-		0x30, 0x20, 0x4e, 0x42, 0x00, 0x53, 0xa9, 0x8f, 0x68, 0xd1, 0xba, 0xcc, 0xfd, 0x10, 0x54, 0x42,
-		0x6a, 0xef, 0xf4, 0x0f, 0xc0, 0x77, 0x3d, 0x84, 0xe9, 0xf4, 0x97, 0x60, 0x28, 0x1f, 0x4b, 0xce,
-		0xb7, 0x72, 0xb5, 0xcb, 0x03, 0x40, 0xc4, 0xc4, 0x2e, 0xb7, 0xdf, 0x51, 0x22, 0x76, 0x45, 0x5f,
-		0xa3, 0xd4, 0x4e, 0xd0, 0x5e, 0x89, 0xfe, 0x52, 0x81, 0x33, 0xb2, 0x76, 0x48, 0x74, 0xeb, 0x98,
-		0x5d, 0x94, 0x8c, 0xb4, 0x0f, 0x4e, 0xd4, 0x83, 0xc9, 0x59, 0x96, 0xd3, 0x33, 0x28, 0x65, 0x99,
-		0xbc, 0x63, 0x51, 0xca, 0xb2, 0x82, 0x16, 0xc5, 0xd8, 0x3d, 0x0a, 0x1a, 0xb2, 0x0b, 0xef, 0x31,
-		0xbf, 0x15, 0xbe, 0xf0, 0x1e, 0x65, 0xfd, 0xdf, 0xb1, 0x7b, 0x14, 0xb6, 0xed, 0x15, 0xdf, 0xa3,
-		0xac, 0x75, 0xb0, 0xf8, 0x1e, 0xa5, 0xbd, 0x82, 0xf1, 0x7b, 0xcc, 0x76, 0xe6, 0x15, 0xdf, 0x63,
-		0x6e, 0x5f, 0x60, 0xf1, 0x3d, 0xe6, 0x37, 0x02, 0xa2, 0xbf, 0xa0, 0xb9, 0xcd, 0xdc, 0x96, 0x3b,
-		0xf4, 0xfe, 0x40, 0x67, 0x4e, 0x36, 0xfd, 0xd5, 0x6e, 0x1d, 0x0f, 0x38, 0x41, 0x5a, 0x6e, 0xbf,
-		0xa9, 0x94, 0xb4, 0xa2, 0x8e, 0x57, 0x29, 0x69, 0xc5, 0x2d, 0xae, 0x7f, 0xad, 0xc0, 0xb2, 0xbc,
-		0xd1, 0x0c, 0x7d, 0x47, 0xb2, 0x41, 0x1f, 0xdd, 0x76, 0xb5, 0xdb, 0xc7, 0x86, 0xe7, 0x34, 0xfe,
-		0x40, 0x81, 0x6a, 0x5e, 0xbb, 0x21, 0xba, 0x2e, 0xc1, 0x2e, 0xed, 0xab, 0xac, 0xdd, 0x38, 0x06,
-		0x24, 0xa7, 0xe8, 0x0b, 0x05, 0x66, 0x45, 0x4d, 0x6b, 0x28, 0xff, 0xcd, 0x29, 0x69, 0xd1, 0xab,
-		0xbd, 0x37, 0x20, 0x14, 0xa7, 0xe2, 0xaf, 0xe8, 0x9f, 0x4f, 0x49, 0x9a, 0xb2, 0xd0, 0x07, 0x05,
-		0xb2, 0x21, 0xef, 0xa8, 0xab, 0x7d, 0xe7, 0xb8, 0xe0, 0x9c, 0xc0, 0xcf, 0x60, 0x26, 0xd3, 0x9f,
-		0x84, 0x2e, 0x4b, 0x90, 0x8a, 0xdb, 0xc6, 0x6a, 0x1b, 0x83, 0x80, 0xf4, 0xbc, 0x91, 0x54, 0xc7,
-		0x91, 0xc4, 0x1b, 0x11, 0xf7, 0x49, 0x49, 0xbc, 0x91, 0x9c, 0x66, 0x26, 0xf4, 0x02, 0x26, 0xe2,
-		0x1d, 0x20, 0xe8, 0xdb, 0x52, 0x0c, 0xa9, 0x96, 0xa7, 0xda, 0xdb, 0x7d, 0xae, 0x8e, 0x49, 0xa1,
-		0xa8, 0x85, 0x43, 0x22, 0x85, 0x92, 0x2e, 0x14, 0x89, 0x14, 0x4a, 0xfb, 0x44, 0x88, 0xe7, 0x29,
-		0xe8, 0xcc, 0x90, 0x78, 0x9e, 0xf9, 0x6d, 0x1e, 0xb5, 0x77, 0x07, 0x03, 0x8a, 0x3e, 0x55, 0x81,
-		0x5e, 0xa3, 0x03, 0xba, 0x94, 0x8b, 0x23, 0xd3, 0x3d, 0x51, 0x7b, 0xab, 0xaf, 0xb5, 0xbd, 0x6d,
-		0x7a, 0x9d, 0x04, 0x92, 0x6d, 0x32, 0xdd, 0x15, 0x92, 0x6d, 0xb2, 0xad, 0x09, 0x6c, 0x9b, 0xb0,
-		0x11, 0x40, 0xba, 0x4d, 0xaa, 0x7d, 0x41, 0xba, 0x4d, 0xba, 0xb3, 0x80, 0x44, 0x28, 0x89, 0x22,
-		0xbe, 0x24, 0x42, 0x11, 0x35, 0x20, 0x48, 0x22, 0x14, 0x71, 0x6f, 0x00, 0x09, 0x65, 0xc5, 0xc5,
-		0x70, 0x49, 0x28, 0x2b, 0x6d, 0x0a, 0x90, 0x84, 0xb2, 0x05, 0x65, 0x7c, 0xe2, 0xc0, 0xe4, 0xd6,
-		0x9d, 0x25, 0x0e, 0x4c, 0x51, 0x69, 0x5c, 0xe2, 0xc0, 0x14, 0x97, 0xb9, 0x1d, 0x98, 0x4c, 0x54,
-		0x6d, 0x25, 0x17, 0x22, 0x2a, 0x5c, 0x4b, 0x2e, 0x44, 0x58, 0x0c, 0xa6, 0xe6, 0x43, 0x54, 0x61,
-		0x45, 0xb2, 0xf0, 0x2f, 0xb7, 0x76, 0x2c, 0x31, 0x1f, 0xb2, 0x32, 0x2e, 0x89, 0xdf, 0xd2, 0xb5,
-		0x58, 0x49, 0xfc, 0x96, 0x53, 0xf1, 0x95, 0xc4, 0x6f, 0xb9, 0x85, 0xde, 0x00, 0xa6, 0x52, 0x45,
-		0x47, 0xc9, 0x0b, 0x42, 0x5c, 0xca, 0x95, 0xbc, 0x20, 0xf2, 0xea, 0x99, 0x24, 0x5c, 0x4d, 0x15,
-		0xb5, 0x64, 0xe1, 0xaa, 0xb8, 0xcc, 0x27, 0x0b, 0x57, 0x73, 0x2a, 0x66, 0x64, 0xe3, 0x74, 0x11,
-		0x48, 0xb2, 0x71, 0x4e, 0x6d, 0x4d, 0xb2, 0x71, 0x6e, 0x85, 0xe9, 0x0f, 0x14, 0x98, 0x13, 0xd6,
-		0x6d, 0x50, 0xbe, 0xc4, 0xc8, 0x2a, 0x4d, 0xb5, 0xab, 0x83, 0x82, 0xc5, 0xe4, 0x5d, 0x54, 0xf5,
-		0x90, 0xc8, 0xbb, 0xa4, 0x9c, 0x24, 0x91, 0x77, 0x69, 0x81, 0xe8, 0x4b, 0x25, 0xfa, 0xaa, 0x29,
-		0x3f, 0xbd, 0x8e, 0xee, 0x14, 0xc5, 0x1b, 0x85, 0x65, 0x88, 0xda, 0xdd, 0x93, 0xa0, 0x48, 0xa4,
-		0x74, 0xe2, 0xf9, 0x75, 0x79, 0x4a, 0x47, 0x90, 0xc0, 0x97, 0xa7, 0x74, 0x84, 0xa9, 0x7b, 0xa2,
-		0x99, 0xc9, 0xa4, 0xb8, 0x4c, 0x33, 0x85, 0x99, 0x78, 0x99, 0x66, 0x8a, 0xf3, 0xed, 0x77, 0x6f,
-		0xfc, 0xfa, 0xb5, 0x3d, 0x2b, 0xd8, 0xef, 0xee, 0xd6, 0x9b, 0x6e, 0x7b, 0x3d, 0xf1, 0x9f, 0xe4,
-		0xf5, 0x3d, 0xec, 0xb0, 0x3f, 0xa8, 0x8f, 0xfd, 0x43, 0xfe, 0xfb, 0xfc, 0xe7, 0xc1, 0xe5, 0xdd,
-		0x61, 0x3a, 0x77, 0xe5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x55, 0xea, 0x8b, 0xf1, 0x4d, 0x5f,
-		0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x4b, 0x6c, 0x24, 0x49,
+		0x56, 0x4a, 0xbb, 0xfd, 0x7b, 0xb6, 0xcb, 0x76, 0xb4, 0x3f, 0xe5, 0x72, 0xb7, 0xdb, 0xce, 0xed,
+		0x9e, 0xf1, 0xf4, 0xec, 0xb8, 0xa7, 0x3d, 0x33, 0x3d, 0x3d, 0xbf, 0x9d, 0xed, 0xb6, 0xbb, 0x7b,
+		0x6a, 0xe8, 0x6f, 0xda, 0xd3, 0x03, 0x0b, 0x4c, 0x6e, 0xba, 0x32, 0xca, 0x4e, 0x3a, 0x2b, 0xb3,
+		0x3a, 0x33, 0xcb, 0xee, 0x9a, 0x03, 0x1a, 0x58, 0x84, 0xc4, 0x0a, 0xed, 0x2e, 0x2b, 0x40, 0x48,
+		0x20, 0x24, 0xb4, 0x48, 0xab, 0x1d, 0x71, 0x40, 0x02, 0x89, 0x03, 0xe2, 0xc4, 0x85, 0x0b, 0x12,
+		0x12, 0x5c, 0xb8, 0xb3, 0x07, 0x90, 0xb8, 0xed, 0x0d, 0x09, 0xa1, 0xf8, 0x65, 0xe5, 0x27, 0x32,
+		0xb2, 0xca, 0x5e, 0x69, 0x7a, 0x87, 0xb9, 0xb9, 0x22, 0xe2, 0xbd, 0x78, 0xf1, 0xe2, 0xbd, 0x17,
+		0x2f, 0xde, 0x7b, 0x91, 0x86, 0x4b, 0x9d, 0x7d, 0x1c, 0x5c, 0x69, 0x58, 0x36, 0xf6, 0x1a, 0xf8,
+		0xca, 0xa1, 0x13, 0x46, 0x7e, 0xd0, 0xbd, 0x72, 0x74, 0xf5, 0x4a, 0x88, 0x83, 0x23, 0xa7, 0x81,
+		0x37, 0xdb, 0x81, 0x1f, 0xf9, 0x68, 0x89, 0x0c, 0xdb, 0xe4, 0xc3, 0x36, 0xf9, 0xb0, 0xcd, 0xa3,
+		0xab, 0xb5, 0xd5, 0x03, 0xdf, 0x3f, 0x70, 0xf1, 0x15, 0x3a, 0x6c, 0xbf, 0xd3, 0xbc, 0x62, 0x77,
+		0x02, 0x2b, 0x72, 0x7c, 0x8f, 0x01, 0xd6, 0x2e, 0x64, 0xfb, 0x23, 0xa7, 0x85, 0xc3, 0xc8, 0x6a,
+		0xb5, 0xf9, 0x80, 0x1c, 0x82, 0xe3, 0xc0, 0x6a, 0xb7, 0x71, 0x10, 0xf2, 0xfe, 0xb5, 0x14, 0x81,
+		0x56, 0xdb, 0x21, 0xc4, 0x35, 0xfc, 0x56, 0x2b, 0x9e, 0x62, 0x5d, 0x36, 0x42, 0x90, 0xc8, 0xa9,
+		0x90, 0x0d, 0x79, 0xda, 0xc1, 0xf1, 0x00, 0x5d, 0x36, 0x20, 0xb2, 0xc2, 0x27, 0xae, 0x13, 0x46,
+		0xaa, 0x31, 0xc7, 0x7e, 0xf0, 0xa4, 0xe9, 0xfa, 0xc7, 0x7c, 0xcc, 0x65, 0xd9, 0x18, 0xce, 0x4a,
+		0x33, 0x33, 0x76, 0xa3, 0x6c, 0x2c, 0x0e, 0xf8, 0xc8, 0xaf, 0xa5, 0x47, 0xda, 0x2d, 0xc7, 0xa3,
+		0x5c, 0x70, 0x3b, 0x61, 0x54, 0x36, 0x28, 0xcd, 0x88, 0x75, 0xf9, 0xa0, 0xa7, 0x1d, 0xdc, 0xe1,
+		0x5b, 0x5d, 0x7b, 0x51, 0x3e, 0x24, 0xc0, 0x6d, 0xd7, 0x69, 0x24, 0xb7, 0x36, 0xbd, 0x33, 0xe1,
+		0xa1, 0x15, 0x60, 0x9b, 0x8c, 0xb4, 0x3c, 0x31, 0xdb, 0xc5, 0x82, 0x11, 0x69, 0x9a, 0x2e, 0x15,
+		0x8c, 0x4a, 0xb3, 0x4b, 0xff, 0xb3, 0x31, 0x38, 0xbf, 0x1b, 0x59, 0x41, 0xf4, 0x31, 0x6f, 0xbf,
+		0xf5, 0x0c, 0x37, 0x3a, 0x84, 0x1e, 0x03, 0x3f, 0xed, 0xe0, 0x30, 0x42, 0x77, 0x61, 0x2c, 0x60,
+		0x7f, 0x56, 0xb5, 0x35, 0x6d, 0x63, 0x72, 0x6b, 0x6b, 0x33, 0x25, 0xb6, 0x56, 0xdb, 0xd9, 0x3c,
+		0xba, 0xba, 0xa9, 0x44, 0x62, 0x08, 0x14, 0x68, 0x05, 0x26, 0x6c, 0xbf, 0x65, 0x39, 0x9e, 0xe9,
+		0xd8, 0xd5, 0xa1, 0x35, 0x6d, 0x63, 0xc2, 0x18, 0x67, 0x0d, 0x75, 0x1b, 0xfd, 0x1a, 0x2c, 0xb4,
+		0xad, 0x00, 0x7b, 0x91, 0x89, 0x05, 0x02, 0xd3, 0xf1, 0x9a, 0x7e, 0x75, 0x98, 0x4e, 0xbc, 0x21,
+		0x9d, 0xf8, 0x21, 0x85, 0x88, 0x67, 0xac, 0x7b, 0x4d, 0xdf, 0x38, 0xdb, 0xce, 0x37, 0xa2, 0x2a,
+		0x8c, 0x59, 0x51, 0x84, 0x5b, 0xed, 0xa8, 0x7a, 0x66, 0x4d, 0xdb, 0x18, 0x31, 0xc4, 0x4f, 0xb4,
+		0x0d, 0x33, 0xf8, 0x59, 0xdb, 0x61, 0x2a, 0x66, 0x12, 0x5d, 0xaa, 0x8e, 0xd0, 0x19, 0x6b, 0x9b,
+		0x4c, 0x8f, 0x36, 0x85, 0x1e, 0x6d, 0xee, 0x09, 0x45, 0x33, 0x2a, 0x3d, 0x10, 0xd2, 0x88, 0x9a,
+		0xb0, 0xdc, 0xf0, 0xbd, 0xc8, 0xf1, 0x3a, 0xd8, 0xb4, 0x42, 0xd3, 0xc3, 0xc7, 0xa6, 0xe3, 0x39,
+		0x91, 0x63, 0x45, 0x7e, 0x50, 0x1d, 0x5d, 0xd3, 0x36, 0x2a, 0x5b, 0x2f, 0x4b, 0x17, 0xb0, 0xcd,
+		0xa1, 0x6e, 0x84, 0xf7, 0xf1, 0x71, 0x5d, 0x80, 0x18, 0x8b, 0x0d, 0x69, 0x3b, 0xaa, 0xc3, 0x9c,
+		0xe8, 0xb1, 0xcd, 0xa6, 0xe5, 0xb8, 0x9d, 0x00, 0x57, 0xc7, 0x28, 0xb9, 0xe7, 0xa4, 0xf8, 0x6f,
+		0xb3, 0x31, 0xc6, 0x6c, 0x0c, 0xc6, 0x5b, 0x90, 0x01, 0x8b, 0xae, 0x15, 0x46, 0x66, 0xc3, 0x6f,
+		0xb5, 0x5d, 0x4c, 0x17, 0x1f, 0xe0, 0xb0, 0xe3, 0x46, 0xd5, 0x71, 0x05, 0xbe, 0x87, 0x56, 0xd7,
+		0xf5, 0x2d, 0xdb, 0x98, 0x27, 0xb0, 0xdb, 0x31, 0xa8, 0x41, 0x21, 0xd1, 0x2f, 0xc3, 0x4a, 0xd3,
+		0x09, 0xc2, 0xc8, 0xb4, 0x71, 0xc3, 0x09, 0x29, 0x3f, 0xad, 0xf0, 0x89, 0xb9, 0x6f, 0x35, 0x9e,
+		0xf8, 0xcd, 0x66, 0x75, 0x82, 0x22, 0x5e, 0xce, 0xf1, 0x75, 0x87, 0x1b, 0x38, 0xa3, 0x4a, 0xa1,
+		0x77, 0x38, 0xf0, 0x9e, 0x15, 0x3e, 0xb9, 0xc9, 0x40, 0xd1, 0x11, 0xcc, 0xb6, 0xad, 0x20, 0x72,
+		0x28, 0x9d, 0x0d, 0xdf, 0x6b, 0x3a, 0x07, 0x55, 0x58, 0x1b, 0xde, 0x98, 0xdc, 0xfa, 0xa5, 0xcd,
+		0x02, 0x43, 0xaa, 0x96, 0x4a, 0x22, 0x3a, 0x0c, 0xdd, 0x36, 0xc5, 0x76, 0xcb, 0x8b, 0x82, 0xae,
+		0x31, 0xd3, 0x4e, 0xb7, 0xa2, 0x6b, 0xb0, 0xc4, 0xa5, 0xd7, 0xc4, 0xd6, 0x01, 0x0e, 0x7a, 0xc2,
+		0x59, 0x9d, 0x5c, 0xd3, 0x36, 0xc6, 0x8d, 0x05, 0xde, 0x7d, 0x8b, 0xf4, 0xc6, 0x93, 0xd4, 0x6e,
+		0xc2, 0xbc, 0x6c, 0x02, 0x34, 0x0b, 0xc3, 0x4f, 0x70, 0x97, 0x2a, 0xd3, 0x84, 0x41, 0xfe, 0x44,
+		0xf3, 0x30, 0x72, 0x64, 0xb9, 0x1d, 0xcc, 0x15, 0x82, 0xfd, 0x78, 0x7b, 0xe8, 0xba, 0xa6, 0x7f,
+		0x4f, 0x83, 0xd5, 0xa2, 0x35, 0x84, 0x6d, 0xdf, 0x0b, 0x31, 0x5a, 0x80, 0xd1, 0xa0, 0x43, 0xd5,
+		0x89, 0x61, 0x1c, 0x09, 0x3a, 0x44, 0x97, 0x3e, 0x82, 0xe9, 0xd4, 0x0e, 0x50, 0xdc, 0x93, 0x5b,
+		0xaf, 0xca, 0xb7, 0xd4, 0x77, 0xdd, 0xdb, 0x7e, 0x90, 0xe4, 0xba, 0xc0, 0x6f, 0x4c, 0xd9, 0x89,
+		0x56, 0xfd, 0x2f, 0x87, 0x60, 0x75, 0xd7, 0x39, 0xf0, 0x2c, 0xb7, 0xd0, 0x60, 0xdc, 0xcb, 0x1a,
+		0x8c, 0xd7, 0xe4, 0x06, 0x43, 0x89, 0xa5, 0x4f, 0x8b, 0xd1, 0x84, 0x15, 0xfc, 0x2c, 0xc2, 0x81,
+		0x67, 0xb9, 0xf1, 0x41, 0x90, 0xd8, 0x1f, 0x66, 0x37, 0x5e, 0x90, 0xce, 0x9f, 0x9f, 0x79, 0x59,
+		0xa0, 0xca, 0x75, 0xa1, 0x4d, 0x38, 0xdb, 0x38, 0x74, 0x5c, 0xbb, 0x37, 0x89, 0xef, 0xb9, 0x5d,
+		0x6a, 0x47, 0xc6, 0x8d, 0x39, 0xda, 0x25, 0x80, 0x1e, 0x78, 0x6e, 0x57, 0x5f, 0x87, 0x0b, 0x85,
+		0xeb, 0x63, 0x7c, 0xd5, 0x7f, 0x3a, 0x04, 0x2f, 0xf2, 0x31, 0x4e, 0x74, 0xa8, 0xb6, 0xc1, 0x8f,
+		0xb3, 0x2c, 0x7d, 0x57, 0xc5, 0xd2, 0x32, 0x74, 0x7d, 0xf2, 0xf6, 0x33, 0x4d, 0xa2, 0x70, 0xc3,
+		0x54, 0xe1, 0x3e, 0x2a, 0x56, 0xb8, 0xfe, 0x48, 0xe8, 0x4f, 0xf5, 0x7e, 0x2e, 0x2a, 0x74, 0x03,
+		0x36, 0xca, 0x89, 0x52, 0xea, 0x92, 0xfe, 0x5d, 0x0d, 0xce, 0x1b, 0x38, 0xc4, 0xa7, 0x3e, 0x24,
+		0x95, 0x48, 0xfa, 0xdb, 0x16, 0xfd, 0x4d, 0x58, 0x2d, 0x42, 0xa3, 0x5e, 0xc5, 0xe7, 0x43, 0xb0,
+		0xbe, 0x87, 0x83, 0x96, 0xe3, 0x59, 0x11, 0x2e, 0x5c, 0xc9, 0xc3, 0xec, 0x4a, 0xae, 0x49, 0x57,
+		0x52, 0x8a, 0xe8, 0x17, 0x5c, 0x81, 0x2f, 0x82, 0xae, 0x5a, 0x22, 0xd7, 0xe1, 0x1f, 0x68, 0xb0,
+		0xb6, 0x83, 0xc3, 0x46, 0xe0, 0xec, 0x17, 0x73, 0xf4, 0x41, 0x96, 0xa3, 0x6f, 0x48, 0x97, 0x53,
+		0x86, 0xa7, 0x4f, 0xf1, 0xf8, 0xdf, 0x61, 0x58, 0x57, 0xa0, 0xe2, 0x22, 0xe2, 0xc2, 0x52, 0xcf,
+		0xc5, 0x62, 0xaa, 0xcd, 0x0f, 0x60, 0xa5, 0xcd, 0xce, 0x21, 0xdc, 0x4e, 0x82, 0x1a, 0x8b, 0x58,
+		0xda, 0x8e, 0xf6, 0x61, 0x29, 0xbf, 0xb7, 0xcc, 0xb3, 0x63, 0xa7, 0xd2, 0xe5, 0xfe, 0x66, 0xa3,
+		0xbe, 0xdd, 0xc2, 0xb1, 0xac, 0x19, 0x7d, 0x0c, 0xa8, 0x8d, 0x3d, 0xdb, 0xf1, 0x0e, 0x4c, 0xab,
+		0x11, 0x39, 0x47, 0x4e, 0xe4, 0xe0, 0x90, 0x9b, 0xab, 0x02, 0xc7, 0x91, 0x0d, 0xbf, 0xc1, 0x46,
+		0x77, 0x29, 0xf2, 0xb9, 0x76, 0xaa, 0xd1, 0xc1, 0x21, 0xfa, 0x15, 0x98, 0x15, 0x88, 0xa9, 0x98,
+		0x04, 0xd8, 0xab, 0x9e, 0xa1, 0x68, 0x37, 0x55, 0x68, 0xb7, 0xc9, 0xd8, 0x34, 0xe5, 0x33, 0xed,
+		0x44, 0x57, 0x80, 0x3d, 0xb4, 0xdb, 0x43, 0x2d, 0x0e, 0x59, 0xee, 0x78, 0x2a, 0x29, 0x16, 0xc7,
+		0x74, 0x0a, 0xa9, 0x68, 0xd4, 0x9f, 0xc1, 0xfc, 0x23, 0x72, 0x07, 0x13, 0xdc, 0x13, 0x62, 0xb8,
+		0x9d, 0x15, 0xc3, 0x97, 0xa4, 0x73, 0xc8, 0x60, 0xfb, 0x14, 0xbd, 0x1f, 0x69, 0xb0, 0x90, 0x01,
+		0xe7, 0xe2, 0xf6, 0x3e, 0x4c, 0xd1, 0x7b, 0xa1, 0x70, 0x2f, 0xb5, 0x3e, 0xdc, 0xcb, 0x49, 0x0a,
+		0xc1, 0xbd, 0xca, 0x3a, 0x54, 0x04, 0x82, 0xdf, 0xc0, 0x8d, 0x08, 0xdb, 0x5c, 0x70, 0xf4, 0xe2,
+		0x35, 0x18, 0x7c, 0xa4, 0x31, 0xfd, 0x34, 0xf9, 0x53, 0xff, 0x1d, 0x0d, 0x6a, 0xd4, 0x80, 0xee,
+		0x46, 0x4e, 0xe3, 0x49, 0x97, 0x78, 0x35, 0x77, 0x9d, 0x30, 0x12, 0x6c, 0xaa, 0x67, 0xd9, 0x74,
+		0xa5, 0xd8, 0x92, 0x4b, 0x31, 0xf4, 0xc9, 0xac, 0xf3, 0xb0, 0x22, 0xc5, 0xc1, 0x2d, 0xcb, 0xbf,
+		0x0c, 0xc1, 0xe2, 0x1d, 0x1c, 0xdd, 0xeb, 0x44, 0xd6, 0xbe, 0x8b, 0x77, 0x23, 0x2b, 0xc2, 0x86,
+		0x0c, 0xad, 0x96, 0xb1, 0xa7, 0x1f, 0x01, 0x92, 0x98, 0xd1, 0xa1, 0x81, 0xcc, 0xe8, 0x5c, 0x4e,
+		0xc3, 0xd0, 0x6b, 0xb0, 0x88, 0x9f, 0xb5, 0x29, 0x03, 0x4d, 0x0f, 0x3f, 0x8b, 0x4c, 0x7c, 0x44,
+		0xae, 0x69, 0x8e, 0x4d, 0x2d, 0xf4, 0xb0, 0x71, 0x56, 0xf4, 0xde, 0xc7, 0xcf, 0xa2, 0x5b, 0xa4,
+		0xaf, 0x6e, 0xa3, 0x57, 0x61, 0xbe, 0xd1, 0x09, 0xe8, 0x7d, 0x6e, 0x3f, 0xb0, 0xbc, 0xc6, 0xa1,
+		0x19, 0xf9, 0x4f, 0xa8, 0xf6, 0x68, 0x1b, 0x53, 0x06, 0xe2, 0x7d, 0x37, 0x69, 0xd7, 0x1e, 0xe9,
+		0x41, 0xbf, 0x0a, 0xf3, 0x47, 0x38, 0xa0, 0x3e, 0x2b, 0xf7, 0x29, 0x4c, 0x27, 0xc2, 0x2d, 0xae,
+		0x14, 0x59, 0x81, 0x25, 0x97, 0x68, 0xb2, 0x82, 0xc7, 0x0c, 0xe4, 0x03, 0x06, 0x51, 0x8f, 0x70,
+		0xcb, 0x40, 0x47, 0xb9, 0x36, 0xfd, 0xef, 0x26, 0x60, 0x29, 0xc7, 0x52, 0x2e, 0xa0, 0x72, 0xb6,
+		0x69, 0xa7, 0x65, 0xdb, 0x6d, 0x98, 0x8e, 0xd1, 0x46, 0xdd, 0x36, 0xe6, 0x1b, 0xb1, 0xae, 0xc4,
+		0xb8, 0xd7, 0x6d, 0x63, 0x63, 0xea, 0x38, 0xf1, 0x0b, 0xe9, 0x30, 0x2d, 0xe3, 0xfa, 0xa4, 0x97,
+		0xe0, 0xf6, 0x63, 0x58, 0x6e, 0x07, 0xf8, 0xc8, 0xf1, 0x3b, 0xa1, 0x19, 0x12, 0x37, 0x07, 0xdb,
+		0xbd, 0xf1, 0x67, 0xe8, 0xbc, 0x2b, 0xb9, 0x6b, 0x57, 0xdd, 0x8b, 0xae, 0xbd, 0xfe, 0x98, 0xf8,
+		0x4a, 0xc6, 0xa2, 0x80, 0xde, 0x65, 0xc0, 0x02, 0xef, 0x2b, 0x70, 0x96, 0x5e, 0x12, 0xd9, 0xad,
+		0x2e, 0xc6, 0x38, 0x42, 0x29, 0x98, 0x25, 0x5d, 0xb7, 0x49, 0x8f, 0x18, 0xfe, 0x36, 0x4c, 0xd0,
+		0x0b, 0x9f, 0xeb, 0x84, 0x11, 0xbd, 0xf6, 0x4e, 0x6e, 0x9d, 0x97, 0x7b, 0x10, 0x42, 0xe4, 0xc7,
+		0x23, 0xfe, 0x17, 0xba, 0x03, 0xb3, 0x21, 0x55, 0x07, 0xb3, 0x87, 0x62, 0xac, 0x1f, 0x14, 0x95,
+		0x30, 0xa5, 0x45, 0xe8, 0x75, 0x58, 0x6c, 0xb8, 0x0e, 0xa1, 0xd4, 0x75, 0xf6, 0x03, 0x2b, 0xe8,
+		0x9a, 0x5c, 0x1e, 0xe8, 0xc5, 0x76, 0xc2, 0x98, 0x67, 0xbd, 0x77, 0x59, 0x27, 0x97, 0x9f, 0x04,
+		0x54, 0x13, 0x5b, 0x51, 0x27, 0xc0, 0x31, 0xd4, 0x44, 0x12, 0xea, 0x36, 0xeb, 0x14, 0x50, 0x17,
+		0x60, 0x92, 0x43, 0x39, 0xad, 0xb6, 0x5b, 0x05, 0x3a, 0x14, 0x58, 0x53, 0xbd, 0xd5, 0x76, 0x51,
+		0x08, 0x97, 0xb3, 0xab, 0x32, 0xc3, 0xc6, 0x21, 0xb6, 0x3b, 0x2e, 0x36, 0x23, 0x9f, 0x6d, 0x16,
+		0x8d, 0x3a, 0xf8, 0x9d, 0x88, 0x5e, 0x29, 0x95, 0x17, 0xe4, 0x8b, 0xe9, 0xb5, 0xee, 0x72, 0x4c,
+		0x7b, 0x3e, 0xdd, 0xb7, 0x3d, 0x86, 0x86, 0xf8, 0x3b, 0x6c, 0xab, 0x88, 0xfc, 0xf7, 0x16, 0x32,
+		0x45, 0x03, 0x1f, 0x73, 0xb4, 0x6b, 0x97, 0xf4, 0x88, 0x55, 0x14, 0xe9, 0xea, 0x74, 0xa1, 0xae,
+		0xde, 0x85, 0x4a, 0x2c, 0xdb, 0x21, 0x51, 0xa6, 0x6a, 0x85, 0x06, 0x39, 0x2e, 0xa5, 0xb7, 0x8a,
+		0x45, 0x9e, 0x92, 0xf2, 0xcd, 0x34, 0x2f, 0x56, 0x0c, 0xfa, 0x13, 0x35, 0x60, 0x3e, 0xc6, 0xd6,
+		0x70, 0xfd, 0x10, 0x73, 0x9c, 0x33, 0x14, 0xe7, 0xd5, 0x3e, 0xbd, 0x11, 0x02, 0x48, 0xf0, 0x75,
+		0x42, 0x23, 0xd6, 0xe7, 0xb8, 0x91, 0x68, 0xf9, 0x5c, 0xda, 0xbc, 0x10, 0x17, 0x61, 0x56, 0x76,
+		0xe0, 0xf6, 0xa8, 0x4e, 0x19, 0x17, 0x07, 0x87, 0xc6, 0xec, 0x51, 0xa6, 0x05, 0xbd, 0x0b, 0x2b,
+		0x0e, 0xd1, 0xb9, 0xcc, 0x1e, 0x63, 0x8f, 0xd8, 0x19, 0xbb, 0x3a, 0x47, 0x7d, 0xcc, 0x25, 0x27,
+		0x4c, 0x9b, 0xfa, 0x5b, 0xac, 0x1b, 0xad, 0xc3, 0x94, 0xb0, 0x75, 0xa1, 0xf3, 0x29, 0xae, 0x22,
+		0xa6, 0xda, 0xbc, 0x6d, 0xd7, 0xf9, 0x14, 0xeb, 0x3f, 0xd3, 0x60, 0x89, 0x5c, 0xd1, 0xff, 0x7f,
+		0x9d, 0x06, 0xfa, 0x8f, 0xc7, 0xa1, 0x9a, 0x5f, 0xf6, 0x57, 0x16, 0xfb, 0x2b, 0x8b, 0xfd, 0x65,
+		0xb4, 0xd8, 0x45, 0xfa, 0x31, 0x55, 0x68, 0x81, 0xa5, 0xe6, 0x6c, 0xfa, 0xd4, 0xe6, 0xec, 0x17,
+		0xcf, 0xb0, 0xeb, 0xff, 0x38, 0x04, 0x6b, 0x06, 0x6e, 0xf8, 0x81, 0x9d, 0x0c, 0x61, 0x72, 0xb5,
+		0xf8, 0x22, 0x2d, 0xe5, 0x05, 0x98, 0x8c, 0x05, 0x27, 0x36, 0x02, 0x20, 0x9a, 0xea, 0x36, 0x5a,
+		0x82, 0x31, 0x2a, 0x63, 0x5c, 0xe3, 0x87, 0x8d, 0x51, 0xf2, 0xb3, 0x6e, 0xa3, 0xf3, 0x00, 0x22,
+		0xea, 0xcc, 0x75, 0x77, 0xc2, 0x98, 0xe0, 0x2d, 0x75, 0x1b, 0x19, 0x30, 0xd5, 0xf6, 0x5d, 0xd7,
+		0x14, 0x77, 0x95, 0x51, 0xc5, 0x5d, 0x45, 0x1a, 0xdd, 0x65, 0x77, 0x95, 0x49, 0x82, 0x84, 0xff,
+		0xd0, 0x7f, 0x7b, 0x1c, 0xd6, 0x15, 0x5c, 0xe4, 0x86, 0x37, 0x67, 0x21, 0xb5, 0x93, 0x59, 0x48,
+		0xa5, 0xf5, 0x1b, 0x3a, 0xb9, 0xf5, 0xfb, 0x3a, 0x20, 0xc1, 0x5f, 0x3b, 0x6b, 0x7e, 0x67, 0xe3,
+		0x1e, 0x31, 0x7a, 0x83, 0x18, 0x30, 0x89, 0xe9, 0x1d, 0x26, 0x16, 0x2a, 0x85, 0x37, 0x67, 0xd1,
+		0x47, 0xf2, 0x16, 0x3d, 0x91, 0x62, 0x1a, 0x4d, 0xa7, 0x98, 0xae, 0x43, 0x95, 0x9b, 0x94, 0x5e,
+		0x00, 0x44, 0x38, 0x08, 0x63, 0xd4, 0x41, 0x58, 0x64, 0xfd, 0xb1, 0xec, 0x08, 0xff, 0xc0, 0x48,
+		0x04, 0xf2, 0x69, 0xc8, 0x84, 0xe5, 0x66, 0x5e, 0x29, 0xd2, 0xc6, 0xbd, 0xc0, 0xf2, 0x42, 0x62,
+		0xca, 0x52, 0x61, 0x82, 0x38, 0x8a, 0x4f, 0x83, 0x25, 0x9f, 0xc0, 0x39, 0x49, 0x40, 0xa6, 0x67,
+		0xc2, 0x27, 0xfa, 0x31, 0xe1, 0xcb, 0x39, 0x71, 0x8f, 0xad, 0x79, 0x81, 0xf7, 0x09, 0x45, 0xde,
+		0xe7, 0x3a, 0x4c, 0xa5, 0x6c, 0xde, 0x24, 0xb5, 0x79, 0x93, 0xfb, 0x09, 0x63, 0x77, 0x03, 0x2a,
+		0xbd, 0x6d, 0xa5, 0x29, 0xba, 0xa9, 0xd2, 0x14, 0xdd, 0x74, 0x0c, 0x41, 0x33, 0x74, 0xef, 0xc1,
+		0x94, 0xd8, 0x6b, 0x8a, 0x60, 0xba, 0x14, 0xc1, 0x24, 0x1f, 0x4f, 0xc1, 0x2d, 0x18, 0x7b, 0xda,
+		0xc1, 0xd4, 0xc8, 0x56, 0x68, 0xfc, 0xe7, 0x4e, 0x61, 0x14, 0xbc, 0x54, 0x8b, 0x68, 0x88, 0xc2,
+		0xc1, 0x21, 0x8b, 0x7b, 0x0b, 0xbc, 0x39, 0x5f, 0x70, 0x26, 0xe7, 0x0b, 0xd6, 0x3e, 0x81, 0xa9,
+		0x24, 0xac, 0x24, 0x14, 0x7e, 0x3d, 0x19, 0x0a, 0x2f, 0x0a, 0x91, 0x08, 0xc5, 0x64, 0xa1, 0x92,
+		0x44, 0xb8, 0xbc, 0x67, 0x4a, 0x45, 0x60, 0xec, 0x2b, 0x53, 0x9a, 0x33, 0xa5, 0x49, 0xd6, 0x48,
+		0x4d, 0xe9, 0x7f, 0x0c, 0x0b, 0x53, 0x2a, 0xe5, 0x22, 0x37, 0xa5, 0x1f, 0xc2, 0x4c, 0xc6, 0x54,
+		0x29, 0x8d, 0x29, 0x0f, 0x66, 0x50, 0x63, 0x63, 0x54, 0xd2, 0xa6, 0x2c, 0x27, 0xdc, 0x43, 0x83,
+		0x09, 0x77, 0xc2, 0x72, 0x0d, 0xa7, 0x2d, 0xd7, 0x27, 0xb0, 0x9a, 0x56, 0x3c, 0xd3, 0x6f, 0x9a,
+		0xd1, 0xa1, 0x13, 0x9a, 0xc9, 0x6c, 0xba, 0x7a, 0xaa, 0x5a, 0x4a, 0x11, 0x1f, 0x34, 0xf7, 0x0e,
+		0x9d, 0xf0, 0x06, 0xc7, 0x5f, 0x87, 0xb9, 0x43, 0x6c, 0x05, 0xd1, 0x3e, 0xb6, 0x22, 0xd3, 0xc6,
+		0x91, 0xe5, 0xb8, 0x21, 0x0f, 0xf8, 0xa8, 0x03, 0x84, 0xb3, 0x31, 0xd8, 0x0e, 0x83, 0xca, 0x1f,
+		0x4d, 0xa3, 0x27, 0x3b, 0x9a, 0x5e, 0x84, 0x99, 0x18, 0x0f, 0x13, 0x6b, 0x6a, 0xa3, 0x27, 0x8c,
+		0xd8, 0x31, 0xda, 0xa1, 0xad, 0xfa, 0x1f, 0x6b, 0xf0, 0x35, 0xb6, 0x9b, 0x29, 0x65, 0xe7, 0x49,
+		0xf1, 0x9e, 0xbe, 0x18, 0xd9, 0xa0, 0xe2, 0xf5, 0xa2, 0xa0, 0x62, 0x19, 0xaa, 0x3e, 0xa3, 0x8b,
+		0x7f, 0x33, 0x0c, 0x17, 0xd5, 0xd8, 0xb8, 0x08, 0xe2, 0xde, 0xf9, 0x17, 0xf0, 0x36, 0x4e, 0xe2,
+		0xdb, 0x27, 0xb7, 0x6e, 0xc6, 0x4c, 0x98, 0x91, 0xf4, 0x1f, 0x69, 0xb0, 0xda, 0x0b, 0xcb, 0x13,
+		0x1f, 0xda, 0x76, 0xc2, 0xb6, 0x15, 0x35, 0x0e, 0x4d, 0xd7, 0x6f, 0x58, 0xae, 0xdb, 0xad, 0x0e,
+		0x51, 0x9b, 0xfa, 0x89, 0x62, 0xd6, 0xf2, 0xe5, 0x6c, 0xf6, 0xe2, 0xf6, 0x7b, 0xfe, 0x0e, 0x9f,
+		0xe1, 0x2e, 0x9b, 0x80, 0x99, 0xda, 0x15, 0xab, 0x78, 0x44, 0xed, 0x37, 0x61, 0xad, 0x0c, 0x81,
+		0xc4, 0xde, 0xee, 0xa4, 0xed, 0xad, 0x3c, 0x2b, 0x20, 0xcc, 0x00, 0xc5, 0x25, 0x10, 0xd3, 0x93,
+		0x39, 0x61, 0x7b, 0x7f, 0xa0, 0x11, 0xdb, 0x9b, 0x5b, 0xe6, 0x6d, 0xcb, 0x71, 0x7b, 0xb2, 0xd4,
+		0x67, 0x3a, 0xa9, 0x0c, 0x4f, 0x9f, 0x82, 0xf4, 0x35, 0x62, 0xc7, 0x0a, 0x31, 0xf1, 0x60, 0xf5,
+		0x1f, 0x6a, 0xa0, 0xe7, 0xad, 0xdd, 0x07, 0x42, 0x3d, 0x05, 0xe5, 0x8f, 0xb2, 0x94, 0xbf, 0x59,
+		0x40, 0x79, 0x19, 0xa6, 0x3e, 0x69, 0x7f, 0x48, 0x94, 0x53, 0x81, 0x8b, 0xcb, 0xe6, 0x4b, 0x30,
+		0xdb, 0xb0, 0xbc, 0x06, 0x8e, 0x4f, 0x00, 0xcc, 0xce, 0xb4, 0x71, 0x63, 0x86, 0xb5, 0x1b, 0xa2,
+		0x39, 0xa9, 0xef, 0x49, 0x9c, 0xa7, 0xd4, 0x77, 0x15, 0xaa, 0x3e, 0x97, 0xfa, 0x42, 0xac, 0xee,
+		0x05, 0xc8, 0x12, 0x09, 0x4b, 0xc9, 0xc0, 0xd3, 0x48, 0x58, 0x21, 0x9e, 0x81, 0x25, 0x4c, 0x86,
+		0x29, 0x25, 0x61, 0xf9, 0x05, 0xd2, 0xfd, 0xe9, 0x51, 0xde, 0xb7, 0x84, 0x95, 0x61, 0xea, 0x93,
+		0xf6, 0x4b, 0x72, 0x71, 0x88, 0x71, 0x71, 0xea, 0xff, 0x56, 0x83, 0x0b, 0x06, 0x6e, 0xf9, 0x47,
+		0x98, 0x55, 0x22, 0x3c, 0x2f, 0x71, 0xbc, 0xb4, 0x63, 0x34, 0x9c, 0x71, 0x8c, 0x74, 0x9d, 0xc8,
+		0x4a, 0x11, 0xd5, 0x7c, 0x69, 0x7f, 0x3f, 0x04, 0x97, 0xf8, 0x12, 0xd8, 0xb2, 0x0b, 0xd3, 0xe0,
+		0xca, 0x05, 0x5a, 0x50, 0x49, 0xeb, 0x20, 0x5f, 0xdc, 0xdb, 0x05, 0xfb, 0xd7, 0xc7, 0x84, 0xc6,
+		0x74, 0x4a, 0x7b, 0xd1, 0x3e, 0x2c, 0xc5, 0x95, 0x06, 0xd2, 0xf2, 0x42, 0x79, 0x12, 0xfa, 0x16,
+		0x87, 0xc9, 0x24, 0xa1, 0xb1, 0xac, 0x79, 0xe0, 0x2a, 0x83, 0x0d, 0x78, 0xa1, 0x6c, 0x2d, 0x9c,
+		0xcf, 0xff, 0xa0, 0xc1, 0x8a, 0x08, 0x1c, 0x49, 0x2e, 0xf2, 0x5f, 0x88, 0xf8, 0x5c, 0x86, 0x39,
+		0x27, 0x34, 0xd3, 0xd5, 0x7e, 0x94, 0x97, 0xe3, 0xc6, 0x8c, 0x13, 0xde, 0x4e, 0xd6, 0xf1, 0xe9,
+		0xab, 0x70, 0x4e, 0x4e, 0x3e, 0x5f, 0xdf, 0x77, 0xa8, 0xc3, 0x42, 0x8c, 0x75, 0x3a, 0x71, 0x9e,
+		0x33, 0xad, 0x5f, 0xc4, 0x42, 0xd7, 0x61, 0x8a, 0x97, 0x72, 0x62, 0x3b, 0x11, 0xcb, 0x8d, 0xdb,
+		0xea, 0x36, 0xfa, 0x18, 0xce, 0x36, 0x04, 0xa9, 0x89, 0xa9, 0xcf, 0x0c, 0x34, 0x35, 0x8a, 0x51,
+		0xf4, 0xe6, 0xbe, 0x0b, 0xb3, 0x89, 0xf2, 0x4c, 0x76, 0x49, 0x18, 0xe9, 0xf7, 0x92, 0x30, 0xd3,
+		0x03, 0x65, 0xb7, 0x84, 0xf3, 0x00, 0xc2, 0xdd, 0x73, 0x6c, 0xea, 0x1e, 0x0f, 0x1b, 0x13, 0xbc,
+		0xa5, 0x6e, 0xeb, 0x2f, 0x12, 0x65, 0x56, 0x6e, 0x02, 0xdf, 0xae, 0xff, 0x1c, 0x82, 0xaa, 0xc1,
+		0x6b, 0x97, 0x31, 0x45, 0x1d, 0x3e, 0xde, 0xfa, 0x22, 0xb7, 0xe8, 0xd7, 0x61, 0x41, 0x96, 0x39,
+		0x16, 0x15, 0x20, 0x03, 0xa4, 0x8e, 0xcf, 0xe6, 0x53, 0xc7, 0x21, 0x7a, 0x03, 0x46, 0x29, 0xeb,
+		0x43, 0xbe, 0xa3, 0xf2, 0xd0, 0xc8, 0x8e, 0x15, 0x59, 0x37, 0x5d, 0x7f, 0xdf, 0xe0, 0x83, 0xd1,
+		0x36, 0x54, 0x3c, 0x7c, 0x6c, 0x06, 0x1d, 0xbe, 0x73, 0xe2, 0x62, 0x53, 0x02, 0x3e, 0xe5, 0xe1,
+		0x63, 0xa3, 0xc3, 0xb6, 0x2c, 0xd4, 0x57, 0x60, 0x59, 0xc2, 0x6a, 0xbe, 0x11, 0xdf, 0xd5, 0x60,
+		0x71, 0xb7, 0xeb, 0x35, 0x76, 0x0f, 0xad, 0xc0, 0xe6, 0x11, 0x52, 0xbe, 0x0d, 0x97, 0xa0, 0x12,
+		0xfa, 0x9d, 0xa0, 0x81, 0x4d, 0x5e, 0xd2, 0xce, 0xf7, 0x62, 0x9a, 0xb5, 0x6e, 0xb3, 0x46, 0xb4,
+		0x0c, 0xe3, 0x21, 0x01, 0x16, 0xe7, 0xdb, 0x88, 0x31, 0x46, 0x7f, 0xd7, 0x6d, 0xb4, 0x09, 0x67,
+		0xe8, 0x5d, 0x72, 0xb8, 0xf4, 0x82, 0x47, 0xc7, 0xe9, 0xcb, 0xb0, 0x94, 0xa3, 0x85, 0xd3, 0xf9,
+		0x4f, 0x23, 0x70, 0x96, 0xf4, 0x89, 0x73, 0xf2, 0x8b, 0x94, 0x95, 0x2a, 0x8c, 0x89, 0x88, 0x14,
+		0xd3, 0x64, 0xf1, 0x93, 0x28, 0x7a, 0xef, 0xae, 0x1b, 0xc7, 0x11, 0xe2, 0xb8, 0x03, 0xe1, 0x49,
+		0x3e, 0x0e, 0x35, 0x32, 0x68, 0x1c, 0x4a, 0xad, 0x84, 0xb9, 0x9b, 0xfc, 0xd8, 0x60, 0x37, 0xf9,
+		0x0f, 0x79, 0xf6, 0xa7, 0x77, 0xa9, 0xa6, 0x58, 0xc6, 0x4b, 0xb1, 0xcc, 0x11, 0xb0, 0xd8, 0x3d,
+		0xa6, 0xb8, 0xae, 0xc1, 0x98, 0xb8, 0x91, 0x4f, 0xf4, 0x71, 0x23, 0x17, 0x83, 0x93, 0xd1, 0x04,
+		0x48, 0x47, 0x13, 0xde, 0x87, 0x29, 0x96, 0x9b, 0xe2, 0x85, 0xeb, 0x93, 0x7d, 0x14, 0xae, 0x4f,
+		0xd2, 0x94, 0x15, 0xaf, 0x59, 0x7f, 0x15, 0x68, 0xdd, 0x39, 0x7f, 0xca, 0x61, 0x3a, 0x36, 0xf6,
+		0x22, 0x27, 0xea, 0xd2, 0x68, 0xe0, 0x84, 0x81, 0x48, 0xdf, 0xc7, 0xb4, 0xab, 0xce, 0x7b, 0xd0,
+		0x7d, 0x98, 0xc9, 0x98, 0x06, 0x1e, 0xf9, 0xbb, 0xd4, 0x97, 0x51, 0x30, 0x2a, 0x69, 0x83, 0xa0,
+		0x2f, 0xc2, 0x7c, 0x5a, 0x92, 0xb9, 0x88, 0xff, 0x81, 0x06, 0x2b, 0xa2, 0xf2, 0xee, 0x39, 0xf1,
+		0xf0, 0xf4, 0xef, 0x69, 0x70, 0x4e, 0x4e, 0x13, 0xbf, 0xfc, 0xbc, 0x06, 0x8b, 0x2d, 0xd6, 0xce,
+		0xf2, 0x32, 0xa6, 0xe3, 0x99, 0x0d, 0xab, 0x71, 0x88, 0x39, 0x85, 0x67, 0x5b, 0x09, 0xa8, 0xba,
+		0xb7, 0x4d, 0xba, 0xd0, 0x5b, 0xb0, 0x9c, 0x03, 0xb2, 0xad, 0xc8, 0xda, 0xb7, 0x42, 0x51, 0x80,
+		0xbb, 0x98, 0x86, 0xdb, 0xe1, 0xbd, 0xfa, 0x39, 0xa8, 0x09, 0x7a, 0x38, 0x3f, 0x3f, 0xf0, 0xe3,
+		0xd2, 0x29, 0xfd, 0xb7, 0x86, 0x7a, 0x2c, 0x4c, 0x75, 0x73, 0x6a, 0x37, 0x60, 0xd6, 0xeb, 0xb4,
+		0xf6, 0x71, 0x60, 0xfa, 0x4d, 0x93, 0x5a, 0xa9, 0x90, 0xd2, 0x39, 0x62, 0x54, 0x58, 0xfb, 0x83,
+		0x26, 0x35, 0x3e, 0x21, 0x61, 0xb6, 0xb0, 0x6a, 0x21, 0x0d, 0x2d, 0x8c, 0x18, 0xe3, 0xdc, 0xac,
+		0x85, 0xa8, 0x0e, 0x53, 0x7c, 0x27, 0xd8, 0x52, 0xe5, 0x55, 0xa6, 0x42, 0x1c, 0x58, 0xac, 0x87,
+		0xae, 0x9c, 0xfa, 0x7e, 0x93, 0x76, 0xaf, 0x01, 0x5d, 0x83, 0x25, 0x36, 0x4f, 0xc3, 0xf7, 0xa2,
+		0xc0, 0x77, 0x5d, 0x1c, 0x50, 0x9e, 0x74, 0xd8, 0x49, 0x31, 0x61, 0x2c, 0xd0, 0xee, 0xed, 0xb8,
+		0x97, 0xd9, 0x45, 0xaa, 0x21, 0xb6, 0x1d, 0xe0, 0x30, 0xe4, 0x01, 0x49, 0xf1, 0x53, 0xdf, 0x84,
+		0x39, 0x96, 0xd9, 0x22, 0x70, 0x42, 0x76, 0x92, 0x46, 0x5a, 0x4b, 0x19, 0x69, 0x7d, 0x1e, 0x50,
+		0x72, 0x3c, 0x17, 0xc6, 0xff, 0xd6, 0x60, 0x8e, 0x39, 0xef, 0x49, 0x2f, 0xb1, 0x18, 0x0d, 0x7a,
+		0x97, 0x67, 0x81, 0xe3, 0xa4, 0x77, 0x65, 0xeb, 0x42, 0x01, 0x43, 0x08, 0x46, 0x1a, 0x35, 0xa3,
+		0x79, 0x60, 0x1a, 0x31, 0x4b, 0xc4, 0x5e, 0x87, 0x53, 0xb1, 0xd7, 0x6d, 0x98, 0x39, 0x72, 0x42,
+		0x67, 0xdf, 0x71, 0x9d, 0xa8, 0xcb, 0x2c, 0x51, 0x79, 0xb8, 0xb0, 0xd2, 0x03, 0xa1, 0x66, 0x68,
+		0x1d, 0xa6, 0xf8, 0x11, 0x66, 0x7a, 0x16, 0xb7, 0xb8, 0x13, 0xc6, 0x24, 0x6f, 0xbb, 0x6f, 0xb5,
+		0x30, 0xe1, 0x42, 0x72, 0xb9, 0x9c, 0x0b, 0xdf, 0xa7, 0x5c, 0x08, 0x71, 0xf4, 0xa8, 0x83, 0x3b,
+		0xb8, 0x0f, 0x2e, 0x64, 0x67, 0x1a, 0xca, 0xcd, 0x94, 0x66, 0xd4, 0xf0, 0x80, 0x8c, 0x62, 0x74,
+		0xf6, 0x08, 0xe2, 0x74, 0xfe, 0x50, 0x83, 0x79, 0x21, 0xf7, 0xcf, 0x0d, 0xa9, 0x0f, 0x60, 0x21,
+		0x43, 0x13, 0xd7, 0xc2, 0x6b, 0xb0, 0xd4, 0x0e, 0xfc, 0x06, 0x0e, 0x43, 0xc7, 0x3b, 0x30, 0xe9,
+		0x2b, 0x37, 0x66, 0x07, 0x88, 0x32, 0x0e, 0x13, 0x99, 0xef, 0x75, 0x53, 0x48, 0x6a, 0x04, 0x42,
+		0xfd, 0xaf, 0x35, 0x38, 0x7f, 0x07, 0x47, 0x46, 0xef, 0xcd, 0xdb, 0x3d, 0x1c, 0x86, 0xd6, 0x01,
+		0x8e, 0x5d, 0x96, 0xf7, 0x61, 0x94, 0x26, 0x80, 0x18, 0xa2, 0xc9, 0xad, 0x17, 0x0b, 0xa8, 0x4d,
+		0xa0, 0xa0, 0xd9, 0x21, 0x83, 0x83, 0xf5, 0xc3, 0x94, 0x97, 0x01, 0x1d, 0x5b, 0x4e, 0x64, 0x36,
+		0xfd, 0x80, 0x3e, 0xd2, 0x22, 0xeb, 0x0d, 0xc5, 0xb5, 0x85, 0xf4, 0xdc, 0xf6, 0x83, 0xfb, 0xf8,
+		0x98, 0x30, 0x24, 0x24, 0x06, 0x69, 0xb5, 0x88, 0x64, 0xce, 0x8d, 0xa7, 0x50, 0x61, 0x5b, 0xd4,
+		0xe2, 0x3d, 0x9c, 0xf6, 0x0f, 0x0b, 0x23, 0x99, 0x6a, 0x84, 0x9b, 0x54, 0x91, 0x45, 0x2b, 0x8b,
+		0x5a, 0x4e, 0x87, 0xc9, 0xb6, 0x9a, 0x0b, 0x28, 0x3f, 0x28, 0x19, 0x99, 0x1c, 0x61, 0x91, 0xc9,
+		0x6f, 0xa6, 0x23, 0x93, 0x97, 0xcb, 0xb9, 0x19, 0x13, 0x93, 0x88, 0x4a, 0xb6, 0x60, 0xed, 0x0e,
+		0x8e, 0x76, 0xee, 0x3e, 0x52, 0x6c, 0x5c, 0x1d, 0x80, 0xe9, 0xbf, 0xd7, 0xf4, 0x05, 0x03, 0xfa,
+		0x98, 0x8e, 0x30, 0x99, 0xda, 0x54, 0x2a, 0xa7, 0xe4, 0xaf, 0x50, 0x7f, 0x06, 0xeb, 0x8a, 0xe9,
+		0x38, 0xd3, 0x77, 0x61, 0x2e, 0xf1, 0x74, 0x92, 0xef, 0x21, 0x9b, 0xf6, 0x85, 0xfe, 0xa6, 0x35,
+		0x66, 0x83, 0x74, 0x43, 0xa8, 0xff, 0xbb, 0x06, 0xf3, 0x06, 0xb6, 0xda, 0x6d, 0x97, 0x5d, 0x9f,
+		0xe2, 0xd5, 0x2d, 0xc2, 0x28, 0x4f, 0x03, 0xb0, 0x43, 0x91, 0xff, 0x52, 0xbf, 0x6c, 0x90, 0x9f,
+		0xe8, 0xc3, 0xa7, 0x75, 0x5e, 0x4f, 0x76, 0x13, 0xd1, 0x97, 0x60, 0x21, 0xb3, 0x34, 0x6e, 0x7a,
+		0x7e, 0xa2, 0xc1, 0x8a, 0x81, 0x9b, 0x01, 0x0e, 0x0f, 0xe3, 0x8c, 0x08, 0xe1, 0xc6, 0x73, 0xb8,
+		0x76, 0x7d, 0x15, 0xce, 0xc9, 0x49, 0xe5, 0x6b, 0xf9, 0x67, 0x8d, 0x0c, 0xd8, 0xef, 0x24, 0xc2,
+		0x2c, 0xac, 0x5e, 0xe6, 0x79, 0xdc, 0xc8, 0x6c, 0xce, 0xfb, 0x4c, 0x2e, 0xe7, 0xad, 0x5f, 0x80,
+		0xf3, 0x05, 0xcb, 0xe1, 0x0b, 0xfe, 0xd7, 0x11, 0x38, 0xf7, 0x51, 0xdb, 0xb6, 0x22, 0x2c, 0xbc,
+		0xd1, 0x07, 0x6d, 0x82, 0xfc, 0xb9, 0x94, 0xdc, 0x0b, 0x30, 0xc9, 0x93, 0x2f, 0x5d, 0x71, 0xb7,
+		0x9a, 0x30, 0x40, 0x34, 0x65, 0x0b, 0xd1, 0x46, 0x06, 0x2b, 0x44, 0xdb, 0x83, 0xe5, 0xe2, 0x0a,
+		0xad, 0xd1, 0xb2, 0x0a, 0xad, 0xc5, 0x50, 0x5e, 0x93, 0x95, 0xc1, 0xca, 0xea, 0x97, 0x04, 0xd6,
+		0xb1, 0x01, 0xb0, 0x52, 0x0f, 0x4d, 0x60, 0xbd, 0x0f, 0x8b, 0x9c, 0xbe, 0x2c, 0xca, 0xf1, 0x32,
+		0x94, 0x67, 0x29, 0x60, 0x06, 0xdf, 0xed, 0x64, 0x06, 0x55, 0xa0, 0x2a, 0x7d, 0x68, 0xdb, 0x4b,
+		0x9f, 0x0a, 0x3c, 0xdb, 0x30, 0x15, 0xe0, 0x28, 0xe8, 0x9a, 0x6d, 0xdf, 0x75, 0x1a, 0x5d, 0x7a,
+		0x75, 0x9b, 0xdc, 0x5a, 0x2b, 0x08, 0xc1, 0x46, 0x41, 0xf7, 0x21, 0x1d, 0x67, 0x4c, 0x06, 0xbd,
+		0x1f, 0xe8, 0x12, 0x54, 0x02, 0xe2, 0xe0, 0x88, 0xec, 0x70, 0xc8, 0x1f, 0xc9, 0x4e, 0xd3, 0x56,
+		0x9e, 0xf4, 0x0d, 0x51, 0x0d, 0xc6, 0x33, 0x57, 0xb7, 0xf8, 0xb7, 0x8e, 0xe1, 0x7c, 0x81, 0x50,
+		0x73, 0xeb, 0xbf, 0x03, 0xe3, 0x42, 0x6c, 0x78, 0x9c, 0xbf, 0xff, 0x17, 0x3e, 0x31, 0xa4, 0xfe,
+		0x16, 0x2c, 0x6d, 0xfb, 0x1d, 0x8f, 0x1c, 0x35, 0xd9, 0xe3, 0x6c, 0x15, 0xa0, 0xe9, 0x07, 0x0d,
+		0x7c, 0x1b, 0x47, 0x8d, 0x43, 0x9e, 0x0c, 0x4a, 0xb4, 0xe8, 0x16, 0x54, 0xf3, 0xa0, 0x9c, 0xb8,
+		0x5b, 0x30, 0x86, 0xbd, 0x88, 0x96, 0x89, 0xb0, 0x03, 0xe9, 0xe5, 0x82, 0x03, 0x89, 0x5f, 0x70,
+		0x76, 0xee, 0x3e, 0xa2, 0xb8, 0x78, 0x29, 0x08, 0x87, 0xd5, 0xbf, 0x05, 0x2b, 0x69, 0x3f, 0x21,
+		0x1d, 0xdc, 0xa9, 0xc1, 0x38, 0x77, 0x6a, 0x84, 0xd3, 0x15, 0xff, 0x26, 0x8a, 0x46, 0x69, 0x35,
+		0x9b, 0x94, 0xfc, 0xa1, 0x1c, 0xf9, 0x07, 0x70, 0x4e, 0x8e, 0x9b, 0x2f, 0xe1, 0x0e, 0x8c, 0xc6,
+		0x97, 0xab, 0xe1, 0x7c, 0x2d, 0x44, 0x2a, 0x29, 0xdb, 0xc3, 0x91, 0x88, 0xfa, 0x70, 0x70, 0xfd,
+		0x7f, 0x86, 0x60, 0x51, 0x3e, 0x44, 0xe5, 0xd9, 0x52, 0x11, 0x6a, 0xf9, 0x51, 0x2f, 0x70, 0xc5,
+		0x2c, 0xd4, 0x34, 0x6b, 0x15, 0x81, 0x2b, 0x9a, 0xbd, 0xb0, 0x6c, 0xd3, 0xc5, 0x47, 0xd8, 0xe5,
+		0xd7, 0x8e, 0x09, 0xd2, 0x72, 0x97, 0x34, 0x30, 0x73, 0xf3, 0x04, 0x8b, 0x7e, 0x16, 0xca, 0x01,
+		0xda, 0xc4, 0x06, 0x5c, 0x84, 0x4a, 0xcb, 0x7a, 0x66, 0x26, 0x70, 0xb0, 0x8a, 0xae, 0xa9, 0x96,
+		0xf5, 0xcc, 0x88, 0xd1, 0xdc, 0xe5, 0xf1, 0x06, 0xe1, 0x2d, 0x88, 0xa8, 0xcc, 0x68, 0xe9, 0x2d,
+		0x86, 0xc6, 0x22, 0xe2, 0xc8, 0x1d, 0x0b, 0xce, 0x2c, 0xc3, 0xb8, 0xed, 0x3e, 0x65, 0xc5, 0x3d,
+		0x63, 0x2c, 0xf6, 0x64, 0xbb, 0x4f, 0x77, 0x9d, 0x4f, 0x31, 0x7a, 0x08, 0x4b, 0xbe, 0x6b, 0xe3,
+		0x30, 0x32, 0xc5, 0x9b, 0x30, 0x6a, 0x0c, 0xad, 0x03, 0x5c, 0x6e, 0x16, 0xe6, 0x19, 0x24, 0x97,
+		0x77, 0x62, 0x1e, 0x6f, 0x1c, 0x60, 0xfd, 0x27, 0x94, 0xfb, 0x96, 0x2d, 0x11, 0xf0, 0x2d, 0x38,
+		0x13, 0xd7, 0xee, 0x55, 0xb6, 0x56, 0x8b, 0x6e, 0xbe, 0x77, 0x1f, 0xd1, 0x3b, 0x01, 0x1d, 0xab,
+		0x0a, 0x14, 0xe6, 0x43, 0x8d, 0xc3, 0xb2, 0x50, 0xe3, 0x1e, 0x54, 0x1d, 0x8f, 0x8c, 0x70, 0x8e,
+		0xb0, 0x89, 0xbd, 0xd8, 0x65, 0xee, 0xb3, 0xde, 0x79, 0x21, 0x06, 0xbe, 0xe5, 0x09, 0xdf, 0xb7,
+		0x6e, 0x93, 0xb3, 0xac, 0x4d, 0x90, 0x50, 0xa6, 0x8e, 0x50, 0xc2, 0xc6, 0x49, 0x03, 0xe5, 0xea,
+		0x0b, 0x30, 0x43, 0xab, 0xf6, 0xe8, 0x08, 0x76, 0xd0, 0x8e, 0xd2, 0x83, 0x96, 0x16, 0xf3, 0x3d,
+		0xb4, 0x0e, 0x30, 0x3b, 0x6a, 0xff, 0x6a, 0x08, 0x96, 0x72, 0xbc, 0xe2, 0xea, 0x70, 0x12, 0x66,
+		0x49, 0x1d, 0xd4, 0xa1, 0xd3, 0x39, 0xa8, 0xe8, 0xdb, 0xb0, 0x98, 0x43, 0x2a, 0x32, 0x58, 0x83,
+		0x7a, 0xdc, 0xf3, 0x59, 0xec, 0x34, 0x81, 0x25, 0x61, 0xd7, 0x19, 0x19, 0xbb, 0x7e, 0xaa, 0xc1,
+		0xd2, 0xc3, 0x4e, 0x70, 0x80, 0xbf, 0xdc, 0xb2, 0xa5, 0xd7, 0xa0, 0x9a, 0x5f, 0x26, 0x77, 0xbe,
+		0x3e, 0x1f, 0x82, 0xa5, 0x7b, 0xf8, 0x4b, 0xcf, 0x83, 0x9f, 0x8f, 0x7e, 0xdd, 0x84, 0x6a, 0x9e,
+		0x57, 0x5c, 0xbf, 0x24, 0x38, 0x34, 0x19, 0x8e, 0xcf, 0x34, 0x38, 0x77, 0xdf, 0x8f, 0x9c, 0x66,
+		0xf7, 0xb6, 0xe5, 0xb8, 0xfe, 0x11, 0x0e, 0xee, 0x59, 0xc1, 0x13, 0x1c, 0xc4, 0x5c, 0xff, 0x36,
+		0x2c, 0x36, 0x79, 0x8f, 0xd9, 0xa2, 0x5d, 0x66, 0x2a, 0x9c, 0x50, 0xa4, 0x1f, 0x69, 0x74, 0x2c,
+		0xa2, 0x30, 0xdf, 0xcc, 0x37, 0x86, 0xc4, 0x23, 0x2f, 0xa0, 0x80, 0x0b, 0x85, 0x45, 0x8f, 0xed,
+		0xed, 0xc0, 0x0f, 0x43, 0xbe, 0x2b, 0xa9, 0xdb, 0x54, 0x2a, 0x2c, 0xa9, 0x65, 0xc2, 0x92, 0x97,
+		0xa0, 0x12, 0x59, 0xc1, 0x01, 0x8e, 0xb2, 0xe7, 0x1e, 0x6b, 0xe5, 0xf8, 0xf4, 0x9f, 0x0d, 0xd3,
+		0xe3, 0x5b, 0x32, 0x07, 0xe7, 0x67, 0x8b, 0xe0, 0x21, 0xa6, 0x61, 0xbf, 0xcb, 0x82, 0xa4, 0x7c,
+		0xf9, 0x77, 0x54, 0x11, 0x89, 0x42, 0x74, 0xd4, 0xdb, 0x0e, 0x6f, 0x76, 0xe9, 0xe1, 0xcd, 0x9c,
+		0x94, 0xa9, 0x28, 0xd1, 0x84, 0x3e, 0xd3, 0x60, 0xa1, 0x49, 0xcb, 0x35, 0xcc, 0x86, 0xd5, 0x09,
+		0x71, 0x6f, 0x5a, 0x66, 0xef, 0xee, 0x9d, 0x6c, 0x5a, 0x56, 0x01, 0xb2, 0x4d, 0x30, 0xa6, 0x26,
+		0x47, 0xcd, 0x5c, 0x47, 0xad, 0x0d, 0x73, 0x39, 0x2a, 0x25, 0xf1, 0x90, 0x5b, 0xe9, 0x78, 0xc8,
+		0x95, 0x02, 0x71, 0xc8, 0xd2, 0xc4, 0x37, 0x2f, 0x19, 0x14, 0xa9, 0xb5, 0x61, 0xa9, 0x80, 0x40,
+		0xc9, 0xbc, 0xef, 0x27, 0xe7, 0xad, 0x14, 0x26, 0x23, 0xef, 0xe0, 0xa8, 0x57, 0xfa, 0x42, 0xf1,
+		0x26, 0xc3, 0x30, 0xff, 0xa5, 0xc1, 0x06, 0x2f, 0x36, 0xc9, 0x31, 0x2d, 0x97, 0x25, 0x57, 0x7b,
+		0x57, 0x7d, 0x48, 0x19, 0x7a, 0xcc, 0x84, 0x28, 0xae, 0x0a, 0x14, 0x99, 0xd4, 0xfe, 0x99, 0xc6,
+		0x6b, 0x01, 0xa7, 0xa3, 0xc4, 0xaf, 0x10, 0x5d, 0x84, 0x69, 0xea, 0x96, 0x8a, 0x10, 0x1b, 0x2f,
+		0x8e, 0x48, 0x37, 0xea, 0x01, 0xbc, 0xd4, 0xc7, 0x5a, 0x63, 0x8f, 0x7b, 0x44, 0x04, 0x80, 0x4e,
+		0xb6, 0xad, 0x14, 0x5a, 0x7f, 0x83, 0xbe, 0xb8, 0x16, 0x8a, 0x4d, 0x0f, 0xc9, 0x3e, 0x32, 0x37,
+		0x7a, 0x44, 0x5f, 0x15, 0xa7, 0xc1, 0x62, 0xc7, 0x61, 0xa1, 0x57, 0x14, 0x20, 0xd2, 0x04, 0x1d,
+		0x5e, 0xe5, 0x3b, 0x62, 0xf4, 0x2a, 0x06, 0x76, 0x59, 0x8e, 0xa0, 0xe3, 0xd1, 0xac, 0xad, 0xf0,
+		0xff, 0xb8, 0x0f, 0xce, 0xb2, 0x17, 0xd3, 0xbc, 0x95, 0xe5, 0x37, 0xf4, 0x3a, 0x2c, 0x1a, 0x56,
+		0x84, 0x5d, 0xa7, 0xe5, 0x44, 0xec, 0xb2, 0x24, 0x88, 0xbd, 0x02, 0x67, 0x6c, 0x2b, 0xb2, 0x38,
+		0x33, 0x56, 0x8a, 0x9e, 0x09, 0xdc, 0xf0, 0xba, 0x06, 0x1d, 0xa8, 0x7f, 0x08, 0x4b, 0x39, 0x54,
+		0x7c, 0x01, 0x83, 0xe2, 0xda, 0xfa, 0xb7, 0xab, 0x00, 0xfc, 0x5e, 0x73, 0xe3, 0x61, 0x1d, 0xfd,
+		0x9e, 0x06, 0x8b, 0xf2, 0x4f, 0xae, 0xa0, 0x6b, 0x27, 0xfb, 0x66, 0x53, 0xed, 0xcd, 0x81, 0xe1,
+		0xf8, 0x5a, 0x7e, 0x5f, 0x83, 0xa5, 0x82, 0x6f, 0xf2, 0xa0, 0x37, 0xcb, 0xbe, 0x67, 0x53, 0x44,
+		0xcd, 0xf5, 0xc1, 0x01, 0x39, 0x39, 0x3f, 0xd6, 0x60, 0xad, 0xec, 0xbb, 0x34, 0xe8, 0x9b, 0xa7,
+		0xfd, 0xce, 0x4e, 0xed, 0xc6, 0x29, 0x30, 0x70, 0x4a, 0xc9, 0x26, 0xca, 0xbf, 0x38, 0xa3, 0xd8,
+		0x44, 0xe5, 0x97, 0x6e, 0x14, 0x9b, 0x58, 0xf2, 0x69, 0x9b, 0x3f, 0xd2, 0xa0, 0x56, 0xfc, 0x5d,
+		0x16, 0x54, 0x5c, 0xb3, 0x5c, 0xfa, 0xbd, 0x9a, 0xda, 0x3b, 0x27, 0x82, 0xe5, 0x74, 0xfd, 0x50,
+		0x83, 0xe5, 0xc2, 0xaf, 0xae, 0xa0, 0xb7, 0x0a, 0x51, 0x97, 0x7d, 0xf4, 0xa5, 0xf6, 0xf6, 0x49,
+		0x40, 0x39, 0x51, 0x1e, 0x4c, 0xa7, 0x3e, 0xc7, 0x81, 0x5e, 0x29, 0x44, 0x26, 0xfb, 0xea, 0x47,
+		0x6d, 0xb3, 0xdf, 0xe1, 0x7c, 0xbe, 0xcf, 0x34, 0x38, 0x2b, 0xf9, 0xa6, 0x05, 0x7a, 0x4d, 0xbd,
+		0xdb, 0xd2, 0xaf, 0x68, 0xd4, 0x5e, 0x1f, 0x0c, 0x88, 0x93, 0x10, 0xc1, 0x4c, 0xe6, 0x13, 0x0f,
+		0xe8, 0x8a, 0xca, 0xfd, 0x90, 0xe4, 0xe9, 0x6b, 0xaf, 0xf6, 0x0f, 0xc0, 0x67, 0x3d, 0x86, 0xd9,
+		0xec, 0x3b, 0x65, 0x54, 0x8c, 0xa5, 0xe0, 0x25, 0x77, 0xed, 0xea, 0x00, 0x10, 0x09, 0xb1, 0x2b,
+		0xac, 0xc6, 0x57, 0x88, 0x5d, 0xd9, 0x5b, 0xc9, 0xda, 0x29, 0x8a, 0xff, 0xd1, 0x9f, 0xd2, 0x28,
+		0x7c, 0x71, 0xb1, 0x3e, 0x7a, 0xf7, 0x84, 0x35, 0xfe, 0x8c, 0xb4, 0xf7, 0x4e, 0xf5, 0x42, 0x80,
+		0xb3, 0xac, 0xa0, 0xa2, 0x5d, 0xc9, 0x32, 0x75, 0x3d, 0xbd, 0x92, 0x65, 0x25, 0x05, 0xf4, 0x89,
+		0x7d, 0x94, 0x3c, 0x17, 0x2a, 0xdd, 0xc7, 0xe2, 0x87, 0x5a, 0xa5, 0xfb, 0xa8, 0x7a, 0x9d, 0x94,
+		0xd8, 0x47, 0x69, 0x51, 0x79, 0xf9, 0x3e, 0xaa, 0x0a, 0xdb, 0xcb, 0xf7, 0x51, 0x59, 0xc9, 0x9e,
+		0xdc, 0xc7, 0x7c, 0xdd, 0x78, 0xf9, 0x3e, 0x16, 0x56, 0xad, 0x97, 0xef, 0x63, 0x71, 0x99, 0x3a,
+		0xfa, 0x13, 0x9a, 0x4c, 0x2b, 0x2c, 0x08, 0x47, 0xef, 0x0c, 0xb4, 0xe6, 0x74, 0x49, 0x7a, 0xed,
+		0xdd, 0x93, 0x01, 0xa7, 0x48, 0x2b, 0x7c, 0x0d, 0xa1, 0x24, 0xad, 0xec, 0x3d, 0x86, 0x92, 0xb4,
+		0xf2, 0x07, 0x18, 0x7f, 0xa1, 0xc1, 0xaa, 0xba, 0x0c, 0x1a, 0x7d, 0x43, 0x31, 0x41, 0x1f, 0xb5,
+		0xe0, 0xb5, 0xf7, 0x4f, 0x0c, 0xcf, 0x69, 0xfc, 0xbe, 0x06, 0xd5, 0xa2, 0x62, 0x78, 0x74, 0x5d,
+		0x81, 0x5d, 0x59, 0xf5, 0x5f, 0x7b, 0xeb, 0x04, 0x90, 0x9c, 0xa2, 0xef, 0x68, 0x30, 0x2f, 0x2b,
+		0xa9, 0x46, 0xc5, 0x27, 0xa7, 0xa2, 0x80, 0xbc, 0xf6, 0xc6, 0x80, 0x50, 0x9c, 0x8a, 0x3f, 0xa7,
+		0x9f, 0x46, 0x54, 0x94, 0x0c, 0xa3, 0xf7, 0x4a, 0x64, 0x43, 0x5d, 0xef, 0x5d, 0xfb, 0xc6, 0x49,
+		0xc1, 0x39, 0x81, 0x9f, 0xc2, 0x5c, 0xae, 0x7a, 0x16, 0x5d, 0x2d, 0x4d, 0x68, 0x64, 0x8b, 0x9a,
+		0x6b, 0x5b, 0x83, 0x80, 0xf4, 0xbc, 0x91, 0x4c, 0x3d, 0xac, 0xc2, 0x1b, 0x91, 0x57, 0xf1, 0x2a,
+		0xbc, 0x91, 0x82, 0x52, 0x5b, 0xf4, 0x04, 0xa6, 0x92, 0xf5, 0x89, 0xe8, 0xeb, 0x4a, 0x0c, 0x99,
+		0x82, 0xdc, 0xda, 0x2b, 0x7d, 0x8e, 0x4e, 0x48, 0xa1, 0xac, 0xc0, 0x50, 0x21, 0x85, 0x8a, 0x1a,
+		0x49, 0x85, 0x14, 0x2a, 0xab, 0x18, 0x89, 0xe7, 0x29, 0xa9, 0x1b, 0x54, 0x78, 0x9e, 0xc5, 0x45,
+		0x88, 0xb5, 0xd7, 0x07, 0x03, 0x8a, 0x1f, 0x52, 0x42, 0xaf, 0x0c, 0x0f, 0x5d, 0x2e, 0xc4, 0x91,
+		0xab, 0xed, 0xab, 0xbd, 0xdc, 0xd7, 0xd8, 0xde, 0x34, 0xbd, 0x3a, 0x37, 0xc5, 0x34, 0xb9, 0xda,
+		0x3f, 0xc5, 0x34, 0xf9, 0xc2, 0x39, 0x36, 0x8d, 0x28, 0x53, 0x53, 0x4e, 0x93, 0x29, 0xae, 0x53,
+		0x4e, 0x93, 0xad, 0x7b, 0x23, 0x37, 0x94, 0x54, 0x89, 0x99, 0xe2, 0x86, 0x22, 0x2b, 0x8f, 0x53,
+		0xdc, 0x50, 0xe4, 0x95, 0x6b, 0xe4, 0x2a, 0x2b, 0xaf, 0xbe, 0x52, 0x5c, 0x65, 0x95, 0x25, 0x6b,
+		0x8a, 0xab, 0x6c, 0x49, 0xdd, 0x18, 0x71, 0x60, 0x0a, 0x0b, 0x9d, 0x14, 0x0e, 0x4c, 0x59, 0x2d,
+		0x96, 0xc2, 0x81, 0x29, 0xaf, 0xab, 0xf2, 0x60, 0x3a, 0x55, 0x26, 0xa4, 0xd8, 0x10, 0x59, 0xa5,
+		0x94, 0x62, 0x43, 0xa4, 0xd5, 0x47, 0xd4, 0x7c, 0xc8, 0x4a, 0x7a, 0x90, 0xea, 0xfa, 0x57, 0x58,
+		0xac, 0xa4, 0x30, 0x1f, 0xaa, 0xba, 0x21, 0xf4, 0xbb, 0x1a, 0x2c, 0x48, 0x0b, 0x6d, 0x90, 0x0a,
+		0x61, 0x71, 0x9d, 0x51, 0xed, 0xda, 0xa0, 0x60, 0x09, 0x42, 0xa4, 0xa5, 0x0f, 0x0a, 0x42, 0x54,
+		0xf5, 0x3f, 0x0a, 0x42, 0xd4, 0x15, 0x16, 0xc7, 0x30, 0x9b, 0x2d, 0x70, 0x50, 0xdc, 0x68, 0x0b,
+		0xca, 0x28, 0x14, 0x37, 0xda, 0xc2, 0xea, 0x09, 0x22, 0x10, 0xb2, 0xda, 0x04, 0x85, 0x40, 0x28,
+		0xca, 0x24, 0x14, 0x02, 0xa1, 0x2c, 0x80, 0x88, 0x60, 0x26, 0x93, 0x0c, 0x46, 0xaa, 0x1a, 0x08,
+		0x59, 0x8a, 0x5d, 0x71, 0x70, 0x17, 0xe5, 0x99, 0x8f, 0x61, 0x36, 0x9b, 0x6c, 0x54, 0x85, 0x11,
+		0xe4, 0xe9, 0x57, 0x55, 0x18, 0xa1, 0x20, 0x93, 0x49, 0x26, 0xce, 0x26, 0xe7, 0x14, 0x13, 0x17,
+		0xe4, 0x3c, 0x15, 0x13, 0x17, 0x66, 0xfe, 0x88, 0xbc, 0x4b, 0xf3, 0x69, 0x0a, 0x79, 0x57, 0x65,
+		0x00, 0x15, 0xf2, 0xae, 0x4c, 0xdb, 0x09, 0xb1, 0xcb, 0x25, 0x1b, 0xd4, 0x62, 0x57, 0x94, 0xe6,
+		0x53, 0x8b, 0x5d, 0x71, 0xe2, 0xee, 0x73, 0x2d, 0x7e, 0x0b, 0x5d, 0x9c, 0xf6, 0x40, 0x37, 0xca,
+		0xee, 0x81, 0xa5, 0xe9, 0xa1, 0xda, 0xcd, 0xd3, 0xa0, 0x48, 0x85, 0xda, 0x92, 0x79, 0x0f, 0x75,
+		0xa8, 0x4d, 0x92, 0x58, 0x51, 0x87, 0xda, 0xa4, 0x29, 0x15, 0xa2, 0x99, 0xe9, 0x64, 0x85, 0x4a,
+		0x33, 0xa5, 0x19, 0x12, 0x95, 0x66, 0xca, 0xf3, 0x20, 0x37, 0xdf, 0xfa, 0xd6, 0x9b, 0x07, 0x4e,
+		0x74, 0xd8, 0xd9, 0xdf, 0x6c, 0xf8, 0xad, 0x2b, 0xa9, 0xff, 0xac, 0xb2, 0x79, 0x80, 0x3d, 0xf6,
+		0x6f, 0x76, 0x12, 0xff, 0xe7, 0xe7, 0x1d, 0xfe, 0xe7, 0xd1, 0xd5, 0xfd, 0x51, 0xda, 0xf7, 0xda,
+		0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x79, 0xf2, 0xa1, 0xd1, 0x13, 0x68, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -798,9 +798,13 @@ type AddDecisionTaskRequest struct {
 	Source                 v11.TaskSource        `protobuf:"varint,6,opt,name=source,proto3,enum=uber.cadence.shared.v1.TaskSource" json:"source,omitempty"`
 	ForwardedFrom          string                `protobuf:"bytes,7,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	PartitionConfig        map[string]string     `protobuf:"bytes,8,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
-	XXX_unrecognized       []byte                `json:"-"`
-	XXX_sizecache          int32                 `json:"-"`
+	// priority of the task, tasks with a larger priority are dispatched first.
+	Priority int32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	// fairness_key groups the tasks of the same priority which are dispatched round-robin.
+	FairnessKey          string   `protobuf:"bytes,10,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AddDecisionTaskRequest) Reset()         { *m = AddDecisionTaskRequest{} }
@@ -892,6 +896,20 @@ func (m *AddDecisionTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddDecisionTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddDecisionTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type AddDecisionTaskResponse struct {
 	PartitionConfig      *TaskListPartitionConfig `protobuf:"bytes,1,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
@@ -950,9 +968,13 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom            string                    `protobuf:"bytes,8,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	ActivityTaskDispatchInfo *ActivityTaskDispatchInfo `protobuf:"bytes,9,opt,name=activityTaskDispatchInfo,proto3" json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig          map[string]string         `protobuf:"bytes,10,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral     struct{}                  `json:"-"`
-	XXX_unrecognized         []byte                    `json:"-"`
-	XXX_sizecache            int32                     `json:"-"`
+	// priority of the task, tasks with a larger priority are dispatched first.
+	Priority int32 `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	// fairness_key groups the tasks of the same priority which are dispatched round-robin.
+	FairnessKey          string   `protobuf:"bytes,12,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AddActivityTaskRequest) Reset()         { *m = AddActivityTaskRequest{} }
@@ -1058,6 +1080,20 @@ func (m *AddActivityTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddActivityTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddActivityTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent             *v1.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
 	StartedTime                *types.Timestamp `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
@@ -1602,12 +1638,15 @@ func (m *DescribeTaskListRequest) GetDomainId() string {
 }
 
 type DescribeTaskListResponse struct {
-	Pollers              []*v1.PollerInfo            `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
-	TaskListStatus       *v1.TaskListStatus          `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
-	PartitionConfig      *v1.TaskListPartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Pollers         []*v1.PollerInfo            `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	TaskListStatus  *v1.TaskListStatus          `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
+	PartitionConfig *v1.TaskListPartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	// Set together with task_list_status.
+	DispatchStats        *v11.TaskListDispatchStats     `protobuf:"bytes,4,opt,name=dispatch_stats,json=dispatchStats,proto3" json:"dispatch_stats,omitempty"`
+	ScalingDecisions     []*v11.TaskListScalingDecision `protobuf:"bytes,5,rep,name=scaling_decisions,json=scalingDecisions,proto3" json:"scaling_decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
@@ -1664,6 +1703,20 @@ func (m *DescribeTaskListResponse) GetPartitionConfig() *v1.TaskListPartitionCon
 	return nil
 }
 
+func (m *DescribeTaskListResponse) GetDispatchStats() *v11.TaskListDispatchStats {
+	if m != nil {
+		return m.DispatchStats
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetScalingDecisions() []*v11.TaskListScalingDecision {
+	if m != nil {
+		return m.ScalingDecisions
+	}
+	return nil
+}
+
 type ListTaskListPartitionsRequest struct {
 	Domain               string       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	TaskList             *v1.TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
@@ -2141,165 +2194,171 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x57, 0xdb, 0x1e, 0x7f, 0xbc, 0xb1, 0xc7, 0x76, 0xd9, 0xeb, 0x74, 0x26, 0xb1, 0xe3, 0x4c,
-	0x36, 0x59, 0x2f, 0x2c, 0xe3, 0xb5, 0x77, 0x13, 0xb2, 0x59, 0xb1, 0xc1, 0x1f, 0x71, 0x32, 0x68,
-	0x43, 0xb2, 0x1d, 0x6f, 0x22, 0xc1, 0x2a, 0x4d, 0x79, 0xba, 0xec, 0x69, 0x3c, 0xd3, 0xdd, 0xe9,
-	0xaa, 0xb6, 0xd7, 0x7b, 0xe0, 0x80, 0x00, 0x21, 0x71, 0x85, 0x3b, 0xb0, 0xfc, 0x1d, 0x9c, 0x39,
-	0x72, 0x44, 0x5a, 0x21, 0x41, 0x24, 0xfe, 0x00, 0x90, 0x38, 0x22, 0xa1, 0xfa, 0xe8, 0x99, 0xee,
-	0x99, 0xea, 0xf9, 0xb0, 0x9d, 0x2c, 0x07, 0x6e, 0xee, 0xaa, 0xf7, 0x5d, 0xef, 0xbd, 0xdf, 0xab,
-	0x1a, 0xc3, 0x8d, 0x68, 0x8f, 0x84, 0xab, 0x55, 0xec, 0x10, 0xaf, 0x4a, 0x56, 0x1b, 0x98, 0x55,
-	0x6b, 0xae, 0x77, 0xb0, 0x7a, 0xb4, 0xb6, 0x4a, 0x49, 0x78, 0xe4, 0x56, 0x49, 0x39, 0x08, 0x7d,
-	0xe6, 0x23, 0x93, 0xd3, 0x95, 0x15, 0x5d, 0x39, 0xa6, 0x2b, 0x1f, 0xad, 0x15, 0x97, 0x0e, 0x7c,
-	0xff, 0xa0, 0x4e, 0x56, 0x05, 0xdd, 0x5e, 0xb4, 0xbf, 0xea, 0x44, 0x21, 0x66, 0xae, 0xef, 0x49,
-	0xce, 0xe2, 0x95, 0xf6, 0x7d, 0xe6, 0x36, 0x08, 0x65, 0xb8, 0x11, 0x28, 0x82, 0x0e, 0x01, 0xc7,
-	0x21, 0x0e, 0x02, 0x12, 0x52, 0xb5, 0xbf, 0x9c, 0x32, 0x11, 0x07, 0x2e, 0xb7, 0xae, 0xea, 0x37,
-	0x1a, 0x2d, 0x15, 0x3a, 0x8a, 0x17, 0x11, 0x09, 0x4f, 0x14, 0x41, 0x49, 0x47, 0xc0, 0x30, 0x3d,
-	0xac, 0xbb, 0x94, 0x29, 0x9a, 0x15, 0x1d, 0x8d, 0x0a, 0x82, 0x7d, 0xec, 0x87, 0x87, 0x24, 0x54,
-	0x94, 0xdf, 0xe8, 0x45, 0xb9, 0x5f, 0xf7, 0x8f, 0x15, 0xed, 0x55, 0x1d, 0x6d, 0xcd, 0xa5, 0xcc,
-	0x6f, 0x1a, 0xf7, 0x66, 0x8a, 0x84, 0xd6, 0x70, 0x48, 0x9c, 0x4e, 0xaa, 0xeb, 0x19, 0x54, 0x69,
-	0x2f, 0x4a, 0x1f, 0xc1, 0xec, 0x2e, 0xa6, 0x87, 0x1f, 0xbb, 0x94, 0x3d, 0xc6, 0x21, 0x73, 0xf9,
-	0x41, 0xa0, 0xb7, 0x61, 0xc6, 0xa5, 0x7e, 0x5d, 0x9c, 0x8a, 0x7d, 0x10, 0xfa, 0x51, 0x40, 0x4d,
-	0x63, 0x79, 0x78, 0x65, 0xc2, 0x9a, 0x6e, 0xae, 0xdf, 0x17, 0xcb, 0xa5, 0xbf, 0x8f, 0xc0, 0x85,
-	0x0e, 0x01, 0x5b, 0xbe, 0xb7, 0xef, 0x1e, 0x20, 0x13, 0xc6, 0x8e, 0x48, 0x48, 0x5d, 0xdf, 0x33,
-	0x8d, 0x65, 0x63, 0x65, 0xd8, 0x8a, 0x3f, 0xd1, 0x3a, 0xcc, 0x79, 0x51, 0xc3, 0x0e, 0x09, 0x76,
-	0xec, 0x20, 0xe6, 0xa2, 0xe6, 0xd0, 0xb2, 0xb1, 0x92, 0xdb, 0x1c, 0x32, 0x0d, 0x6b, 0xd6, 0x8b,
-	0x1a, 0x16, 0xc1, 0x4e, 0x53, 0x24, 0x45, 0xef, 0xc3, 0x3c, 0xe7, 0x39, 0x0e, 0x5d, 0x46, 0x92,
-	0x4c, 0xc3, 0x4d, 0x26, 0xe4, 0x45, 0x8d, 0x67, 0x7c, 0x3b, 0xc1, 0xe5, 0xc1, 0x74, 0xbb, 0x96,
-	0x91, 0xe5, 0xe1, 0x95, 0xfc, 0xfa, 0xbd, 0x72, 0x56, 0x86, 0x96, 0x33, 0xfc, 0x29, 0xa7, 0x0d,
-	0xba, 0xe7, 0xb1, 0xf0, 0xc4, 0x2a, 0x84, 0x69, 0x2b, 0x5f, 0xc0, 0x4c, 0x87, 0x85, 0x39, 0xa1,
-	0x70, 0x67, 0x70, 0x85, 0x6d, 0xce, 0x48, 0x8d, 0xd3, 0xc7, 0xe9, 0xd5, 0xa2, 0x07, 0x73, 0x1a,
-	0xcb, 0xd0, 0x0c, 0x0c, 0x1f, 0x92, 0x13, 0x11, 0xf9, 0x9c, 0xc5, 0xff, 0x44, 0x1b, 0x90, 0x3b,
-	0xc2, 0xf5, 0x88, 0x88, 0x38, 0xe7, 0xd7, 0xbf, 0x39, 0x80, 0x41, 0x96, 0xe4, 0xbc, 0x33, 0x74,
-	0xdb, 0x28, 0xfa, 0x30, 0xaf, 0x33, 0xec, 0x95, 0x29, 0x2c, 0xfd, 0x08, 0x66, 0x3f, 0xf6, 0xb1,
-	0xb3, 0x89, 0xeb, 0xd8, 0xab, 0x92, 0xf0, 0x81, 0xeb, 0x31, 0x8a, 0xae, 0xc1, 0xd4, 0x1e, 0xae,
-	0x1e, 0xd6, 0xfd, 0x03, 0xbb, 0xea, 0x47, 0x1e, 0x53, 0x29, 0x36, 0xa9, 0x16, 0xb7, 0xf8, 0x1a,
-	0xba, 0x01, 0xd3, 0x21, 0xe6, 0x87, 0x41, 0x42, 0x9b, 0x92, 0xaa, 0xef, 0x39, 0xc2, 0x14, 0xc3,
-	0x9a, 0xe2, 0xcb, 0x8f, 0x49, 0xf8, 0x44, 0x2c, 0x96, 0xfe, 0x69, 0x40, 0xf1, 0xb1, 0x5f, 0xaf,
-	0xef, 0xf8, 0xe1, 0x36, 0xa9, 0xba, 0x3c, 0x47, 0xb9, 0x45, 0x16, 0x79, 0x11, 0x11, 0xca, 0x50,
-	0x05, 0xc6, 0x42, 0xf9, 0xa7, 0xd0, 0x92, 0x5f, 0x5f, 0x4d, 0x7b, 0x82, 0x03, 0x97, 0x3b, 0x91,
-	0x2d, 0xc1, 0x8a, 0xf9, 0xd1, 0x25, 0x98, 0x70, 0xfc, 0x06, 0x76, 0x3d, 0xdb, 0x95, 0xb6, 0x4c,
-	0x58, 0xe3, 0x72, 0xa1, 0xe2, 0xf0, 0xcd, 0xc0, 0xaf, 0xd7, 0x49, 0xc8, 0x37, 0x87, 0xe5, 0xa6,
-	0x5c, 0xa8, 0x38, 0xe8, 0x3a, 0x14, 0xf6, 0xfd, 0xf0, 0x18, 0x87, 0x0e, 0x71, 0xec, 0xfd, 0xd0,
-	0x6f, 0x98, 0x23, 0x82, 0x62, 0xaa, 0xb9, 0xba, 0x13, 0xfa, 0x0d, 0xf4, 0x16, 0x4c, 0xb7, 0xd5,
-	0xae, 0x99, 0x13, 0x74, 0x85, 0x74, 0xe9, 0x96, 0xfe, 0x98, 0x87, 0x4b, 0x5a, 0x8b, 0x69, 0xe0,
-	0x7b, 0x94, 0xa0, 0x45, 0x00, 0xde, 0x2b, 0x6c, 0xe6, 0x1f, 0x12, 0x59, 0xc0, 0x93, 0xd6, 0x04,
-	0x5f, 0xd9, 0xe5, 0x0b, 0xe8, 0x53, 0x40, 0x71, 0xeb, 0xb2, 0xc9, 0xe7, 0xa4, 0x1a, 0x71, 0xc9,
-	0xea, 0xa0, 0x6f, 0x68, 0xc3, 0xf3, 0x4c, 0x91, 0xdf, 0x8b, 0xa9, 0xad, 0xd9, 0xe3, 0xf6, 0x25,
-	0xb4, 0x03, 0x53, 0x4d, 0xb1, 0xec, 0x24, 0x20, 0x22, 0x0c, 0xf9, 0xf5, 0xab, 0x5d, 0x25, 0xee,
-	0x9e, 0x04, 0xc4, 0x9a, 0x3c, 0x4e, 0x7c, 0xa1, 0xa7, 0x70, 0x31, 0x08, 0xc9, 0x91, 0xeb, 0x47,
-	0xd4, 0xa6, 0x0c, 0x87, 0x8c, 0x38, 0x36, 0x39, 0x22, 0x1e, 0xe3, 0xa1, 0x1d, 0x11, 0x32, 0x2f,
-	0x95, 0x25, 0x90, 0x94, 0x63, 0x20, 0x29, 0x57, 0x3c, 0x76, 0xeb, 0xfd, 0xa7, 0x3c, 0xef, 0xac,
-	0x85, 0x98, 0xfb, 0x89, 0x64, 0xbe, 0xc7, 0x79, 0x2b, 0x0e, 0x5a, 0x81, 0x99, 0x0e, 0x71, 0x39,
-	0x91, 0x79, 0x05, 0x9a, 0xa6, 0x34, 0x61, 0x0c, 0x33, 0x46, 0x1a, 0x01, 0x33, 0x47, 0x45, 0x49,
-	0xc4, 0x9f, 0xa8, 0x04, 0x53, 0x1e, 0xf9, 0x9c, 0xb5, 0x04, 0x8c, 0x09, 0x01, 0x79, 0xbe, 0x18,
-	0x73, 0xbf, 0x03, 0x28, 0x95, 0xde, 0x76, 0xcd, 0xf5, 0x98, 0x39, 0x2e, 0x08, 0x67, 0x92, 0x39,
-	0xce, 0xab, 0x01, 0xdd, 0x06, 0x93, 0x32, 0xb7, 0x7a, 0x78, 0xd2, 0x3a, 0x0a, 0x9b, 0x78, 0x78,
-	0xaf, 0x4e, 0x1c, 0x73, 0x62, 0xd9, 0x58, 0x19, 0xb7, 0x16, 0xe4, 0x7e, 0x33, 0xd0, 0xf7, 0xe4,
-	0x2e, 0xba, 0x0d, 0x39, 0x01, 0x7c, 0x26, 0x88, 0x98, 0x94, 0xba, 0xc6, 0xf9, 0x13, 0x4e, 0x69,
-	0x49, 0x06, 0x64, 0xc1, 0x94, 0xa3, 0xf2, 0xc6, 0x76, 0xbd, 0x7d, 0xdf, 0xcc, 0x0b, 0x09, 0xdf,
-	0x4a, 0x4b, 0x90, 0xc0, 0x23, 0x4a, 0x3c, 0xc4, 0x1e, 0x75, 0x89, 0xc7, 0xe2, 0x6c, 0xab, 0x78,
-	0xfb, 0xbe, 0x35, 0xe9, 0x24, 0xbe, 0xd0, 0x73, 0xb8, 0xdc, 0x99, 0x54, 0xb6, 0x48, 0x43, 0x8e,
-	0x59, 0xe6, 0xa4, 0x50, 0xb1, 0xa8, 0x35, 0x32, 0x6e, 0x21, 0xd6, 0xc5, 0x8e, 0xac, 0x8a, 0xb7,
-	0x50, 0x19, 0xe6, 0x64, 0xd0, 0x39, 0x52, 0x12, 0x3b, 0x46, 0xa7, 0x29, 0x71, 0x3e, 0xb3, 0x62,
-	0xeb, 0x09, 0xdf, 0x79, 0xaa, 0x70, 0xea, 0x2a, 0x4c, 0xee, 0x85, 0xd8, 0xab, 0xd6, 0x54, 0x15,
-	0x14, 0x44, 0x15, 0xe4, 0xe5, 0x9a, 0xac, 0x83, 0x0d, 0x28, 0xd0, 0x6a, 0x8d, 0x38, 0x51, 0x9d,
-	0x38, 0x36, 0x1f, 0x55, 0xcc, 0x69, 0x61, 0x64, 0xb1, 0x23, 0xbb, 0x76, 0xe3, 0x39, 0xc6, 0x9a,
-	0x6a, 0x72, 0xf0, 0x35, 0xf4, 0x1d, 0x98, 0x8c, 0x73, 0x4a, 0x08, 0x98, 0xe9, 0x29, 0x20, 0xaf,
-	0xe8, 0x05, 0xfb, 0x67, 0x30, 0xc6, 0x4f, 0xc4, 0x25, 0xd4, 0x9c, 0x15, 0x48, 0xb3, 0x99, 0xdd,
-	0x67, 0xbb, 0x14, 0x7c, 0xf9, 0x13, 0x29, 0x44, 0xa2, 0x4c, 0x2c, 0x92, 0x87, 0x8c, 0xf9, 0x0c,
-	0xd7, 0x6d, 0x35, 0x5e, 0xd8, 0x7b, 0x27, 0x8c, 0x50, 0x13, 0x89, 0x4c, 0x9c, 0x15, 0x5b, 0x0f,
-	0xe4, 0xce, 0x26, 0xdf, 0x40, 0x9f, 0xc1, 0x4c, 0x13, 0xfa, 0xec, 0xaa, 0xc0, 0x31, 0x73, 0x4e,
-	0x38, 0xb4, 0x36, 0x30, 0x00, 0x5a, 0xd3, 0x41, 0xdb, 0x48, 0xf1, 0x43, 0x98, 0xab, 0xfb, 0xd8,
-	0xb1, 0xf7, 0x14, 0x16, 0x88, 0xb2, 0xa0, 0xe6, 0x7c, 0x2f, 0x7c, 0xe9, 0xc0, 0x0f, 0x6b, 0xb6,
-	0xde, 0x01, 0x29, 0x0f, 0x61, 0x06, 0x47, 0xcc, 0x57, 0x56, 0xcb, 0x8a, 0x7b, 0x43, 0x48, 0xbe,
-	0xa6, 0xcd, 0xb8, 0x8d, 0x88, 0xf9, 0xd2, 0x2e, 0xce, 0x6f, 0x15, 0x70, 0xea, 0xbb, 0xf8, 0x1c,
-	0x26, 0x93, 0x21, 0x4d, 0xe2, 0xe3, 0x84, 0xc4, 0xc7, 0xdb, 0x69, 0x7c, 0xec, 0xab, 0xf8, 0x5a,
-	0xb0, 0x98, 0x00, 0xad, 0x8d, 0x2a, 0x73, 0x8f, 0x5c, 0x76, 0x72, 0x7a, 0xd0, 0xd2, 0x48, 0xf8,
-	0x5f, 0x04, 0xad, 0xdf, 0x40, 0x13, 0xb4, 0xd2, 0x16, 0x7f, 0xad, 0xa0, 0x75, 0x05, 0xf2, 0x58,
-	0x59, 0xd3, 0x0a, 0x02, 0xc4, 0x4b, 0x15, 0x87, 0xa3, 0x5a, 0x93, 0x40, 0xa0, 0xda, 0x48, 0x17,
-	0x54, 0x6b, 0x3a, 0x26, 0x50, 0x0d, 0x27, 0xbe, 0xd0, 0x3a, 0xe4, 0x5c, 0x2f, 0x88, 0x98, 0x88,
-	0x4e, 0x7e, 0xfd, 0xb2, 0xfe, 0x44, 0xf1, 0x09, 0xcf, 0x6d, 0x4b, 0x92, 0x6a, 0x1a, 0xd4, 0xe8,
-	0x59, 0x1b, 0xd4, 0xd8, 0x60, 0x0d, 0x6a, 0x17, 0x2e, 0xc6, 0xf2, 0x6c, 0x5e, 0x5e, 0x75, 0x9f,
-	0x12, 0x21, 0xc8, 0x8f, 0x24, 0xa4, 0xe5, 0xd7, 0x2f, 0x76, 0xc8, 0xda, 0x56, 0xb7, 0x42, 0x6b,
-	0x21, 0xe6, 0xdd, 0xf5, 0xb7, 0x38, 0xe7, 0xae, 0x64, 0x44, 0xdf, 0x87, 0x05, 0xa1, 0xa4, 0x53,
-	0xe4, 0x44, 0x2f, 0x91, 0x73, 0x82, 0xb1, 0x4d, 0xde, 0x0e, 0xcc, 0xd6, 0x08, 0x0e, 0xd9, 0x1e,
-	0xc1, 0xac, 0x29, 0x0a, 0x7a, 0x89, 0x9a, 0x69, 0xf2, 0xc4, 0x72, 0x12, 0xb8, 0x9f, 0x4f, 0xe3,
-	0xfe, 0x73, 0x58, 0x4a, 0x9f, 0x84, 0xed, 0xef, 0xdb, 0xac, 0xe6, 0x52, 0x3b, 0x66, 0x98, 0xec,
-	0x19, 0xd8, 0x62, 0xea, 0x64, 0x1e, 0xed, 0xef, 0xd6, 0x5c, 0xba, 0xa1, 0xe4, 0x57, 0x92, 0x1e,
-	0x38, 0x84, 0x61, 0xb7, 0x4e, 0x05, 0xb6, 0xf5, 0xca, 0x94, 0x96, 0x13, 0xdb, 0x92, 0xab, 0x73,
-	0x0c, 0x2b, 0x9c, 0x6e, 0x0c, 0x7b, 0x0b, 0xa6, 0x9b, 0x72, 0x64, 0xc7, 0x10, 0xf0, 0x38, 0x61,
-	0x15, 0xe2, 0xe5, 0x6d, 0xb1, 0x8a, 0xde, 0x83, 0xd1, 0x1a, 0xc1, 0x0e, 0x09, 0x15, 0xfa, 0x5d,
-	0xd2, 0x6a, 0x7a, 0x20, 0x48, 0x2c, 0x45, 0x9a, 0x85, 0x06, 0xb3, 0xe7, 0x82, 0x06, 0xaf, 0x16,
-	0xc8, 0x74, 0x58, 0x33, 0x7f, 0x6a, 0xac, 0x29, 0xfd, 0x65, 0x04, 0x16, 0x36, 0x1c, 0x47, 0x77,
-	0x79, 0x49, 0x35, 0x6f, 0xa3, 0xad, 0x79, 0xbf, 0xa2, 0x86, 0x78, 0x07, 0x26, 0x5a, 0x43, 0xdb,
-	0x70, 0x3f, 0x43, 0xdb, 0x38, 0x8b, 0x67, 0xb4, 0x2b, 0x90, 0x6f, 0x76, 0x0b, 0x35, 0xab, 0x0f,
-	0x5b, 0x10, 0x2f, 0x55, 0x9c, 0xf6, 0x76, 0xa2, 0x9a, 0x80, 0x2a, 0xd8, 0xdc, 0x00, 0xed, 0x44,
-	0x8c, 0xf6, 0x71, 0xd9, 0xde, 0x81, 0x51, 0xea, 0x47, 0x61, 0x55, 0xb6, 0xc7, 0x42, 0x3b, 0x18,
-	0x27, 0xe6, 0x58, 0x4c, 0x0f, 0x9f, 0x08, 0x4a, 0x4b, 0x71, 0x68, 0x50, 0x6e, 0x4c, 0x87, 0x72,
-	0x81, 0x26, 0xa3, 0xc6, 0x7b, 0x3d, 0x46, 0xe8, 0x4f, 0xb5, 0xdc, 0x96, 0x60, 0xea, 0x69, 0xa0,
-	0x2d, 0xcb, 0x8a, 0x9b, 0x30, 0xaf, 0x23, 0xd4, 0x8c, 0x22, 0xf3, 0xc9, 0x51, 0x64, 0x22, 0x39,
-	0x66, 0x1c, 0xc3, 0x85, 0x0e, 0x1b, 0x14, 0xda, 0xea, 0x4a, 0xc4, 0x38, 0xaf, 0x12, 0x29, 0xfd,
-	0x2b, 0x27, 0x72, 0x5a, 0x37, 0xdb, 0x7c, 0x1d, 0x39, 0xcd, 0x6f, 0x7e, 0xe2, 0xb8, 0xed, 0x96,
-	0x6a, 0x89, 0xf4, 0x05, 0xb9, 0xbe, 0x1d, 0x1b, 0x90, 0xca, 0xfe, 0x91, 0x33, 0x65, 0x7f, 0x6e,
-	0xb0, 0xec, 0x1f, 0x3d, 0x7b, 0xf6, 0x8f, 0x9d, 0x43, 0xf6, 0x8f, 0xeb, 0xb2, 0xdf, 0x03, 0x13,
-	0x27, 0x8e, 0x72, 0xdb, 0xa5, 0x01, 0xcf, 0x0a, 0x7e, 0xef, 0x53, 0x88, 0xbd, 0xde, 0xa5, 0x0a,
-	0x32, 0x38, 0xad, 0x4c, 0x99, 0xda, 0x6a, 0x83, 0x3e, 0xaa, 0x4d, 0x93, 0x6f, 0xaf, 0xb1, 0xda,
-	0xbe, 0x1a, 0x06, 0x33, 0xcb, 0x59, 0xf4, 0x3d, 0x98, 0x6e, 0x0d, 0x10, 0xe2, 0xb6, 0xaa, 0xca,
-	0x4d, 0x8f, 0xcb, 0xea, 0x5e, 0x26, 0x9e, 0x14, 0xac, 0xd6, 0x10, 0x28, 0xbe, 0x3b, 0x66, 0xba,
-	0xa1, 0xc1, 0x66, 0xba, 0xc4, 0x94, 0x33, 0x3c, 0xe8, 0x94, 0x33, 0x72, 0xfe, 0x53, 0x4e, 0xee,
-	0x7c, 0xa6, 0x9c, 0xd1, 0x73, 0x9b, 0x72, 0xc6, 0x74, 0x53, 0x8e, 0xea, 0xa5, 0xda, 0x9b, 0xcb,
-	0xab, 0xed, 0xa5, 0x5f, 0x19, 0x30, 0x2f, 0x2e, 0x90, 0xb1, 0x17, 0x71, 0x27, 0xdd, 0x6a, 0xbf,
-	0x25, 0xbe, 0xad, 0x75, 0x5e, 0xc7, 0xdb, 0xe7, 0xfd, 0xf0, 0x2c, 0xb3, 0x40, 0x7f, 0xd7, 0xc7,
-	0xd2, 0x97, 0x06, 0xbc, 0xd1, 0x66, 0xa1, 0x8a, 0xea, 0x5d, 0x98, 0x14, 0xaf, 0x55, 0x76, 0x48,
-	0x68, 0x54, 0x8f, 0x7d, 0xec, 0x9e, 0x27, 0x79, 0xc1, 0x61, 0x09, 0x06, 0x54, 0x81, 0x42, 0x2c,
-	0xe0, 0xc7, 0xa4, 0xca, 0x88, 0xd3, 0xf5, 0xae, 0x2e, 0xef, 0xe8, 0x8a, 0xd2, 0x9a, 0x7a, 0x91,
-	0xfc, 0x2c, 0xfd, 0xc3, 0x80, 0x65, 0x69, 0x98, 0x23, 0xe8, 0xb8, 0xbf, 0x5b, 0x7e, 0x23, 0xa8,
-	0x13, 0x4e, 0xac, 0x42, 0xf9, 0xa8, 0xfd, 0x3c, 0x6e, 0x6a, 0x15, 0xf5, 0x92, 0xf3, 0x1a, 0xce,
-	0xe6, 0x02, 0x8c, 0x09, 0x5e, 0x35, 0xa3, 0x4d, 0x58, 0xa3, 0xfc, 0xb3, 0xe2, 0x94, 0xae, 0xc1,
-	0xd5, 0x2e, 0xe6, 0xc9, 0x83, 0x29, 0xfd, 0xd5, 0x80, 0xcb, 0x5b, 0x7c, 0xda, 0xae, 0x3f, 0x8a,
-	0x18, 0x65, 0xd8, 0x73, 0x5c, 0xef, 0x80, 0xdf, 0xec, 0xfb, 0x82, 0xf8, 0xd4, 0x9b, 0xc3, 0x50,
-	0xdb, 0x9b, 0xc3, 0x7d, 0x28, 0x34, 0x9d, 0x6a, 0xbd, 0x21, 0x17, 0x32, 0xca, 0x3a, 0xf6, 0x4c,
-	0x96, 0x35, 0x4b, 0x7c, 0x9d, 0x05, 0xc7, 0x4b, 0x57, 0x60, 0x31, 0xc3, 0x3d, 0x15, 0x80, 0x9f,
-	0xc0, 0x85, 0x6d, 0x42, 0xab, 0xa1, 0xbb, 0x47, 0x9a, 0xec, 0xca, 0xf5, 0x9d, 0xf6, 0x1c, 0x78,
-	0x47, 0xab, 0x35, 0x83, 0xbd, 0xbf, 0xa3, 0x2f, 0xfd, 0xc7, 0x00, 0xb3, 0x53, 0x82, 0x2a, 0x9b,
-	0x0f, 0x60, 0x4c, 0x86, 0x53, 0xfe, 0xee, 0x97, 0x5f, 0xbf, 0x92, 0xf9, 0x76, 0x44, 0x42, 0x81,
-	0xc3, 0x31, 0x3d, 0xbf, 0xd8, 0xb4, 0xa2, 0x4f, 0x19, 0x66, 0x11, 0x55, 0x25, 0x73, 0xad, 0x6b,
-	0xec, 0x9e, 0x08, 0x52, 0xab, 0xc0, 0x52, 0xdf, 0xe8, 0x99, 0xa6, 0x2d, 0x0e, 0x77, 0x09, 0x4a,
-	0xdf, 0x1d, 0x91, 0xc2, 0xa2, 0x38, 0xe8, 0x76, 0x7a, 0x1a, 0x9f, 0xc2, 0x02, 0x8c, 0xaa, 0x5e,
-	0x2e, 0xb3, 0x4f, 0x7d, 0xa5, 0xb3, 0x62, 0x68, 0xb0, 0xac, 0xf8, 0xc5, 0x10, 0x2c, 0x65, 0x69,
-	0x55, 0xa1, 0x7f, 0x01, 0x8b, 0xad, 0xa7, 0xa2, 0x66, 0x20, 0x13, 0xbf, 0x26, 0xca, 0x03, 0x29,
-	0xf7, 0xe7, 0xfd, 0x43, 0xc2, 0xb0, 0x83, 0x19, 0xb6, 0x8a, 0xc9, 0x39, 0x29, 0xad, 0x9a, 0xab,
-	0x6c, 0xbe, 0xe4, 0x6b, 0x55, 0x0e, 0x9d, 0x4e, 0xa5, 0x93, 0xb8, 0x33, 0xa4, 0x55, 0x96, 0x6e,
-	0xc2, 0xa5, 0xfb, 0xa4, 0x19, 0x06, 0xba, 0x79, 0x22, 0x01, 0xb2, 0x47, 0xec, 0x4b, 0x7f, 0x18,
-	0x81, 0xcb, 0x7a, 0x3e, 0x15, 0xbd, 0x9f, 0x19, 0xb0, 0xa0, 0xf1, 0xa5, 0x81, 0x03, 0x15, 0xb7,
-	0x47, 0xd9, 0x60, 0xda, 0x4d, 0x70, 0x79, 0xbb, 0xcd, 0x97, 0x87, 0x38, 0x90, 0x53, 0xe0, 0x9c,
-	0xd3, 0xb9, 0x23, 0xcc, 0xd0, 0x9c, 0x22, 0x37, 0x63, 0xe8, 0x4c, 0x66, 0x6c, 0xb4, 0x9d, 0x62,
-	0xcb, 0x0c, 0xdc, 0xb9, 0x53, 0xfc, 0x82, 0x97, 0xb8, 0xde, 0x6e, 0xcd, 0x50, 0xfa, 0x20, 0xfd,
-	0x1a, 0xdd, 0x65, 0x1a, 0xcf, 0xea, 0x1b, 0xc9, 0x5f, 0x89, 0xbf, 0x48, 0xcf, 0xb1, 0xaf, 0x53,
-	0x77, 0xe9, 0x77, 0x43, 0xf0, 0xe6, 0xa7, 0x81, 0x83, 0x19, 0xc9, 0x6a, 0x07, 0xfd, 0x80, 0xcc,
-	0x19, 0x0a, 0xfd, 0xfc, 0x30, 0x48, 0xd7, 0xff, 0x46, 0xce, 0xa3, 0xff, 0xbd, 0x05, 0xd7, 0x7b,
-	0x84, 0x48, 0x01, 0xd5, 0xef, 0x87, 0xe0, 0xba, 0x45, 0xf6, 0x43, 0x42, 0x6b, 0xff, 0x8f, 0x66,
-	0x56, 0x34, 0x57, 0xe0, 0x46, 0xaf, 0x18, 0xc9, 0x70, 0xae, 0xff, 0x7b, 0x12, 0xf2, 0x0f, 0x55,
-	0x3e, 0x6f, 0x3c, 0xae, 0xa0, 0x9f, 0x1a, 0x30, 0xa7, 0xf9, 0x55, 0x0e, 0xbd, 0x3f, 0xe0, 0x8f,
-	0x78, 0xe2, 0x08, 0x8a, 0x37, 0x4f, 0xf5, 0xd3, 0x5f, 0xd2, 0x88, 0x64, 0xd1, 0xf6, 0x61, 0x84,
-	0xe6, 0xb6, 0xdc, 0x87, 0x11, 0xda, 0x1b, 0xd0, 0x11, 0x4c, 0xb7, 0x3d, 0x34, 0xa1, 0x77, 0x07,
-	0x7d, 0x17, 0x2b, 0xae, 0x0d, 0xc0, 0x91, 0xd2, 0x9b, 0xf2, 0xfb, 0xdd, 0x41, 0x5f, 0x08, 0x7a,
-	0xe8, 0xd5, 0xfa, 0x1b, 0xc0, 0x54, 0xea, 0xd2, 0x82, 0xca, 0xd9, 0x32, 0x74, 0xf7, 0xaf, 0xe2,
-	0x6a, 0xdf, 0xf4, 0x4a, 0xe3, 0xaf, 0x0d, 0xb8, 0x98, 0x39, 0x9a, 0xa3, 0x3b, 0xd9, 0xe2, 0x7a,
-	0x5d, 0x37, 0x8a, 0x1f, 0x9e, 0x8a, 0x57, 0x99, 0xf5, 0x4b, 0x03, 0xde, 0xd0, 0x0e, 0xcb, 0xe8,
-	0x56, 0xb6, 0xd8, 0x6e, 0x97, 0x87, 0xe2, 0xb7, 0x07, 0xe6, 0x53, 0xa6, 0x9c, 0xc0, 0x4c, 0x3b,
-	0xc0, 0xa0, 0xb5, 0x41, 0xc0, 0x48, 0xea, 0x3f, 0x05, 0x7e, 0xa1, 0x5f, 0x19, 0xb0, 0xa0, 0x9f,
-	0x0d, 0x51, 0x17, 0x77, 0xba, 0xce, 0xb0, 0xc5, 0xdb, 0x83, 0x33, 0x2a, 0x6b, 0x7e, 0x6e, 0xc0,
-	0xbc, 0x6e, 0x12, 0x41, 0x37, 0x07, 0x9d, 0x5c, 0xa4, 0x25, 0xb7, 0x4e, 0x37, 0xf0, 0xa0, 0xdf,
-	0x1a, 0xb0, 0xd8, 0x15, 0xa7, 0xd0, 0x47, 0xd9, 0x92, 0xfb, 0x99, 0x01, 0x8a, 0x77, 0x4f, 0xcd,
-	0xaf, 0x4c, 0xfc, 0xd2, 0x80, 0xa5, 0xee, 0xcd, 0x1f, 0xdd, 0xed, 0x56, 0x1e, 0x7d, 0x40, 0x6b,
-	0xf1, 0xbb, 0xa7, 0x17, 0x20, 0xad, 0xdc, 0xbc, 0xff, 0xa7, 0x97, 0x4b, 0xc6, 0x9f, 0x5f, 0x2e,
-	0x19, 0x7f, 0x7b, 0xb9, 0x64, 0xfc, 0xe0, 0x83, 0x03, 0x97, 0xd5, 0xa2, 0xbd, 0x72, 0xd5, 0x6f,
-	0xac, 0xa6, 0xfe, 0x51, 0xb4, 0x7c, 0x40, 0x3c, 0xf9, 0x9f, 0xb5, 0xc9, 0x7f, 0xee, 0xfd, 0x30,
-	0xfe, 0xfb, 0x68, 0x6d, 0x6f, 0x54, 0xec, 0xbe, 0xf7, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x61,
-	0xff, 0x4c, 0xcb, 0x0a, 0x2c, 0x00, 0x00,
+	// 2616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x5b, 0x73, 0x23, 0x47,
+	0xf5, 0xaf, 0xb1, 0x2c, 0x5f, 0x8e, 0x2e, 0xb6, 0xdb, 0x8e, 0x77, 0x56, 0xbb, 0xeb, 0xf5, 0x2a,
+	0xd9, 0x8d, 0xf3, 0xff, 0x07, 0x39, 0x56, 0xb2, 0x61, 0xb3, 0x29, 0x12, 0xec, 0xf5, 0x5e, 0x04,
+	0x59, 0x76, 0x33, 0x76, 0x92, 0x2a, 0x48, 0x65, 0x68, 0x6b, 0xda, 0xd6, 0x60, 0x69, 0x66, 0x76,
+	0xba, 0x65, 0x47, 0x79, 0xe0, 0x81, 0x02, 0x8a, 0x2a, 0x5e, 0xa1, 0x78, 0x05, 0xc2, 0x17, 0xc8,
+	0x17, 0xe0, 0x99, 0x47, 0xde, 0x53, 0x54, 0x41, 0xaa, 0xf8, 0x00, 0x3c, 0xf0, 0x4e, 0xf5, 0x65,
+	0xa4, 0x19, 0xa9, 0x47, 0x17, 0xdb, 0x9b, 0xf0, 0xc0, 0x9b, 0xba, 0xfb, 0xdc, 0xfa, 0xf4, 0x39,
+	0xe7, 0x77, 0xba, 0x47, 0x70, 0xab, 0x7d, 0x40, 0xc2, 0xcd, 0x3a, 0x76, 0x88, 0x57, 0x27, 0x9b,
+	0x2d, 0xcc, 0xea, 0x0d, 0xd7, 0x3b, 0xda, 0x3c, 0xd9, 0xda, 0xa4, 0x24, 0x3c, 0x71, 0xeb, 0xa4,
+	0x12, 0x84, 0x3e, 0xf3, 0x91, 0xc9, 0xe9, 0x2a, 0x8a, 0xae, 0x12, 0xd1, 0x55, 0x4e, 0xb6, 0x4a,
+	0x6b, 0x47, 0xbe, 0x7f, 0xd4, 0x24, 0x9b, 0x82, 0xee, 0xa0, 0x7d, 0xb8, 0xe9, 0xb4, 0x43, 0xcc,
+	0x5c, 0xdf, 0x93, 0x9c, 0xa5, 0xeb, 0xfd, 0xeb, 0xcc, 0x6d, 0x11, 0xca, 0x70, 0x2b, 0x50, 0x04,
+	0x03, 0x02, 0x4e, 0x43, 0x1c, 0x04, 0x24, 0xa4, 0x6a, 0x7d, 0x3d, 0x61, 0x22, 0x0e, 0x5c, 0x6e,
+	0x5d, 0xdd, 0x6f, 0xb5, 0x7a, 0x2a, 0x74, 0x14, 0xcf, 0xda, 0x24, 0xec, 0x28, 0x82, 0xb2, 0x8e,
+	0x80, 0x61, 0x7a, 0xdc, 0x74, 0x29, 0x53, 0x34, 0x1b, 0x3a, 0x1a, 0xe5, 0x04, 0xfb, 0xd4, 0x0f,
+	0x8f, 0x49, 0xa8, 0x28, 0xff, 0x6f, 0x14, 0xe5, 0x61, 0xd3, 0x3f, 0x55, 0xb4, 0x37, 0x74, 0xb4,
+	0x0d, 0x97, 0x32, 0xbf, 0x6b, 0xdc, 0x4b, 0x09, 0x12, 0xda, 0xc0, 0x21, 0x71, 0x06, 0xa9, 0x6e,
+	0xa6, 0x50, 0x25, 0x77, 0x51, 0x7e, 0x07, 0x96, 0xf6, 0x31, 0x3d, 0x7e, 0xcf, 0xa5, 0xec, 0x29,
+	0x0e, 0x99, 0xcb, 0x0f, 0x02, 0xbd, 0x02, 0x8b, 0x2e, 0xf5, 0x9b, 0xe2, 0x54, 0xec, 0xa3, 0xd0,
+	0x6f, 0x07, 0xd4, 0x34, 0xd6, 0x33, 0x1b, 0xf3, 0xd6, 0x42, 0x77, 0xfe, 0xa1, 0x98, 0x2e, 0xff,
+	0x63, 0x1a, 0x2e, 0x0d, 0x08, 0xb8, 0xe7, 0x7b, 0x87, 0xee, 0x11, 0x32, 0x61, 0xf6, 0x84, 0x84,
+	0xd4, 0xf5, 0x3d, 0xd3, 0x58, 0x37, 0x36, 0x32, 0x56, 0x34, 0x44, 0x55, 0x58, 0xf6, 0xda, 0x2d,
+	0x3b, 0x24, 0xd8, 0xb1, 0x83, 0x88, 0x8b, 0x9a, 0x53, 0xeb, 0xc6, 0x46, 0x76, 0x67, 0xca, 0x34,
+	0xac, 0x25, 0xaf, 0xdd, 0xb2, 0x08, 0x76, 0xba, 0x22, 0x29, 0x7a, 0x03, 0x56, 0x38, 0xcf, 0x69,
+	0xe8, 0x32, 0x12, 0x67, 0xca, 0x74, 0x99, 0x90, 0xd7, 0x6e, 0x7d, 0xc4, 0x97, 0x63, 0x5c, 0x1e,
+	0x2c, 0xf4, 0x6b, 0x99, 0x5e, 0xcf, 0x6c, 0xe4, 0xaa, 0xf7, 0x2b, 0x69, 0x11, 0x5a, 0x49, 0xd9,
+	0x4f, 0x25, 0x69, 0xd0, 0x7d, 0x8f, 0x85, 0x1d, 0xab, 0x18, 0x26, 0xad, 0x7c, 0x06, 0x8b, 0x03,
+	0x16, 0x66, 0x85, 0xc2, 0x07, 0x93, 0x2b, 0xec, 0xdb, 0x8c, 0xd4, 0xb8, 0x70, 0x9a, 0x9c, 0x2d,
+	0x79, 0xb0, 0xac, 0xb1, 0x0c, 0x2d, 0x42, 0xe6, 0x98, 0x74, 0x84, 0xe7, 0xb3, 0x16, 0xff, 0x89,
+	0xb6, 0x21, 0x7b, 0x82, 0x9b, 0x6d, 0x22, 0xfc, 0x9c, 0xab, 0xfe, 0xff, 0x04, 0x06, 0x59, 0x92,
+	0xf3, 0xee, 0xd4, 0x1d, 0xa3, 0xe4, 0xc3, 0x8a, 0xce, 0xb0, 0xe7, 0xa6, 0xb0, 0xfc, 0x63, 0x58,
+	0x7a, 0xcf, 0xc7, 0xce, 0x0e, 0x6e, 0x62, 0xaf, 0x4e, 0xc2, 0x47, 0xae, 0xc7, 0x28, 0x7a, 0x11,
+	0x0a, 0x07, 0xb8, 0x7e, 0xdc, 0xf4, 0x8f, 0xec, 0xba, 0xdf, 0xf6, 0x98, 0x0a, 0xb1, 0xbc, 0x9a,
+	0xbc, 0xc7, 0xe7, 0xd0, 0x2d, 0x58, 0x08, 0x31, 0x3f, 0x0c, 0x12, 0xda, 0x94, 0xd4, 0x7d, 0xcf,
+	0x11, 0xa6, 0x18, 0x56, 0x81, 0x4f, 0x3f, 0x25, 0xe1, 0x9e, 0x98, 0x2c, 0xff, 0xcb, 0x80, 0xd2,
+	0x53, 0xbf, 0xd9, 0x7c, 0xe0, 0x87, 0xbb, 0xa4, 0xee, 0xf2, 0x18, 0xe5, 0x16, 0x59, 0xe4, 0x59,
+	0x9b, 0x50, 0x86, 0x6a, 0x30, 0x1b, 0xca, 0x9f, 0x42, 0x4b, 0xae, 0xba, 0x99, 0xdc, 0x09, 0x0e,
+	0x5c, 0xbe, 0x89, 0x74, 0x09, 0x56, 0xc4, 0x8f, 0xae, 0xc0, 0xbc, 0xe3, 0xb7, 0xb0, 0xeb, 0xd9,
+	0xae, 0xb4, 0x65, 0xde, 0x9a, 0x93, 0x13, 0x35, 0x87, 0x2f, 0x06, 0x7e, 0xb3, 0x49, 0x42, 0xbe,
+	0x98, 0x91, 0x8b, 0x72, 0xa2, 0xe6, 0xa0, 0x9b, 0x50, 0x3c, 0xf4, 0xc3, 0x53, 0x1c, 0x3a, 0xc4,
+	0xb1, 0x0f, 0x43, 0xbf, 0x65, 0x4e, 0x0b, 0x8a, 0x42, 0x77, 0xf6, 0x41, 0xe8, 0xb7, 0xd0, 0xcb,
+	0xb0, 0xd0, 0x97, 0xbb, 0x66, 0x56, 0xd0, 0x15, 0x93, 0xa9, 0x5b, 0xfe, 0x73, 0x0e, 0xae, 0x68,
+	0x2d, 0xa6, 0x81, 0xef, 0x51, 0x82, 0xae, 0x01, 0xf0, 0x5a, 0x61, 0x33, 0xff, 0x98, 0xc8, 0x04,
+	0xce, 0x5b, 0xf3, 0x7c, 0x66, 0x9f, 0x4f, 0xa0, 0x0f, 0x00, 0x45, 0xa5, 0xcb, 0x26, 0x9f, 0x92,
+	0x7a, 0x9b, 0x4b, 0x56, 0x07, 0x7d, 0x4b, 0xeb, 0x9e, 0x8f, 0x14, 0xf9, 0xfd, 0x88, 0xda, 0x5a,
+	0x3a, 0xed, 0x9f, 0x42, 0x0f, 0xa0, 0xd0, 0x15, 0xcb, 0x3a, 0x01, 0x11, 0x6e, 0xc8, 0x55, 0x6f,
+	0x0c, 0x95, 0xb8, 0xdf, 0x09, 0x88, 0x95, 0x3f, 0x8d, 0x8d, 0xd0, 0x87, 0x70, 0x39, 0x08, 0xc9,
+	0x89, 0xeb, 0xb7, 0xa9, 0x4d, 0x19, 0x0e, 0x19, 0x71, 0x6c, 0x72, 0x42, 0x3c, 0xc6, 0x5d, 0x3b,
+	0x2d, 0x64, 0x5e, 0xa9, 0x48, 0x20, 0xa9, 0x44, 0x40, 0x52, 0xa9, 0x79, 0xec, 0xcd, 0x37, 0x3e,
+	0xe4, 0x71, 0x67, 0xad, 0x46, 0xdc, 0x7b, 0x92, 0xf9, 0x3e, 0xe7, 0xad, 0x39, 0x68, 0x03, 0x16,
+	0x07, 0xc4, 0x65, 0x45, 0xe4, 0x15, 0x69, 0x92, 0xd2, 0x84, 0x59, 0xcc, 0x18, 0x69, 0x05, 0xcc,
+	0x9c, 0x11, 0x29, 0x11, 0x0d, 0x51, 0x19, 0x0a, 0x1e, 0xf9, 0x94, 0xf5, 0x04, 0xcc, 0x0a, 0x01,
+	0x39, 0x3e, 0x19, 0x71, 0xbf, 0x0a, 0x28, 0x11, 0xde, 0x76, 0xc3, 0xf5, 0x98, 0x39, 0x27, 0x08,
+	0x17, 0xe3, 0x31, 0xce, 0xb3, 0x01, 0xdd, 0x01, 0x93, 0x32, 0xb7, 0x7e, 0xdc, 0xe9, 0x1d, 0x85,
+	0x4d, 0x3c, 0x7c, 0xd0, 0x24, 0x8e, 0x39, 0xbf, 0x6e, 0x6c, 0xcc, 0x59, 0xab, 0x72, 0xbd, 0xeb,
+	0xe8, 0xfb, 0x72, 0x15, 0xdd, 0x81, 0xac, 0x00, 0x3e, 0x13, 0x84, 0x4f, 0xca, 0x43, 0xfd, 0xfc,
+	0x3e, 0xa7, 0xb4, 0x24, 0x03, 0xb2, 0xa0, 0xe0, 0xa8, 0xb8, 0xb1, 0x5d, 0xef, 0xd0, 0x37, 0x73,
+	0x42, 0xc2, 0xb7, 0x92, 0x12, 0x24, 0xf0, 0x88, 0x14, 0x0f, 0xb1, 0x47, 0x5d, 0xe2, 0xb1, 0x28,
+	0xda, 0x6a, 0xde, 0xa1, 0x6f, 0xe5, 0x9d, 0xd8, 0x08, 0x7d, 0x02, 0x57, 0x07, 0x83, 0xca, 0x16,
+	0x61, 0xc8, 0x31, 0xcb, 0xcc, 0x0b, 0x15, 0xd7, 0xb4, 0x46, 0x46, 0x25, 0xc4, 0xba, 0x3c, 0x10,
+	0x55, 0xd1, 0x12, 0xaa, 0xc0, 0xb2, 0x74, 0x3a, 0x47, 0x4a, 0x62, 0x47, 0xe8, 0x54, 0x10, 0xe7,
+	0xb3, 0x24, 0x96, 0xf6, 0xf8, 0xca, 0x87, 0x72, 0x01, 0xdd, 0x80, 0xfc, 0x41, 0x88, 0xbd, 0x7a,
+	0x43, 0x65, 0x41, 0x51, 0x64, 0x41, 0x4e, 0xce, 0xc9, 0x3c, 0xd8, 0x86, 0x22, 0xad, 0x37, 0x88,
+	0xd3, 0x6e, 0x12, 0xc7, 0xe6, 0xad, 0x8a, 0xb9, 0x20, 0x8c, 0x2c, 0x0d, 0x44, 0xd7, 0x7e, 0xd4,
+	0xc7, 0x58, 0x85, 0x2e, 0x07, 0x9f, 0x43, 0xdf, 0x81, 0x7c, 0x14, 0x53, 0x42, 0xc0, 0xe2, 0x48,
+	0x01, 0x39, 0x45, 0x2f, 0xd8, 0x3f, 0x86, 0x59, 0x7e, 0x22, 0x2e, 0xa1, 0xe6, 0x92, 0x40, 0x9a,
+	0x9d, 0xf4, 0x3a, 0x3b, 0x24, 0xe1, 0x2b, 0xef, 0x4b, 0x21, 0x12, 0x65, 0x22, 0x91, 0xdc, 0x65,
+	0xcc, 0x67, 0xb8, 0x69, 0xab, 0xf6, 0xc2, 0x3e, 0xe8, 0x30, 0x42, 0x4d, 0x24, 0x22, 0x71, 0x49,
+	0x2c, 0x3d, 0x92, 0x2b, 0x3b, 0x7c, 0x01, 0x7d, 0x0c, 0x8b, 0x5d, 0xe8, 0xb3, 0xeb, 0x02, 0xc7,
+	0xcc, 0x65, 0xb1, 0xa1, 0xad, 0x89, 0x01, 0xd0, 0x5a, 0x08, 0x92, 0x13, 0xe8, 0x47, 0xb0, 0xdc,
+	0xf4, 0xb1, 0x63, 0x1f, 0x28, 0x2c, 0x10, 0x69, 0x41, 0xcd, 0x95, 0x51, 0xf8, 0x32, 0x80, 0x1f,
+	0xd6, 0x52, 0xb3, 0x7f, 0x0a, 0x3d, 0x86, 0x45, 0xdc, 0x66, 0xbe, 0xb2, 0x5a, 0x66, 0xdc, 0x0b,
+	0x42, 0xf2, 0x8b, 0xda, 0x88, 0xdb, 0x6e, 0x33, 0x5f, 0xda, 0xc5, 0xf9, 0xad, 0x22, 0x4e, 0x8c,
+	0x4b, 0x9f, 0x40, 0x3e, 0xee, 0xd2, 0x38, 0x3e, 0xce, 0x4b, 0x7c, 0xbc, 0x93, 0xc4, 0xc7, 0xb1,
+	0x92, 0xaf, 0x07, 0x8b, 0x31, 0xd0, 0xda, 0xae, 0x33, 0xf7, 0xc4, 0x65, 0x9d, 0xb3, 0x83, 0x96,
+	0x46, 0xc2, 0x7f, 0x23, 0x68, 0xfd, 0x16, 0xe0, 0x8a, 0xd6, 0xe2, 0x6f, 0x14, 0xb4, 0xae, 0x43,
+	0x0e, 0x2b, 0x6b, 0x7a, 0x4e, 0x80, 0x68, 0xaa, 0xe6, 0x70, 0x54, 0xeb, 0x12, 0x08, 0x54, 0x9b,
+	0x1e, 0x82, 0x6a, 0xdd, 0x8d, 0x09, 0x54, 0xc3, 0xb1, 0x11, 0xaa, 0x42, 0xd6, 0xf5, 0x82, 0x36,
+	0x13, 0xde, 0xc9, 0x55, 0xaf, 0xea, 0x4f, 0x14, 0x77, 0x78, 0x6c, 0x5b, 0x92, 0x54, 0x53, 0xa0,
+	0x66, 0xce, 0x5b, 0xa0, 0x66, 0x27, 0x2b, 0x50, 0xfb, 0x70, 0x39, 0x92, 0x67, 0xf3, 0xf4, 0x6a,
+	0xfa, 0x94, 0x08, 0x41, 0x7e, 0x5b, 0x42, 0x5a, 0xae, 0x7a, 0x79, 0x40, 0xd6, 0xae, 0xba, 0x15,
+	0x5a, 0xab, 0x11, 0xef, 0xbe, 0x7f, 0x8f, 0x73, 0xee, 0x4b, 0x46, 0xf4, 0x03, 0x58, 0x15, 0x4a,
+	0x06, 0x45, 0xce, 0x8f, 0x12, 0xb9, 0x2c, 0x18, 0xfb, 0xe4, 0x3d, 0x80, 0xa5, 0x06, 0xc1, 0x21,
+	0x3b, 0x20, 0x98, 0x75, 0x45, 0xc1, 0x28, 0x51, 0x8b, 0x5d, 0x9e, 0x48, 0x4e, 0x0c, 0xf7, 0x73,
+	0x49, 0xdc, 0xff, 0x04, 0xd6, 0x92, 0x27, 0x61, 0xfb, 0x87, 0x36, 0x6b, 0xb8, 0xd4, 0x8e, 0x18,
+	0xf2, 0x23, 0x1d, 0x5b, 0x4a, 0x9c, 0xcc, 0x93, 0xc3, 0xfd, 0x86, 0x4b, 0xb7, 0x95, 0xfc, 0x5a,
+	0x7c, 0x07, 0x0e, 0x61, 0xd8, 0x6d, 0x52, 0xb3, 0x30, 0x46, 0xa4, 0xf4, 0x36, 0xb1, 0x2b, 0xb9,
+	0x06, 0xdb, 0xb0, 0xe2, 0xd9, 0xda, 0xb0, 0x97, 0x61, 0xa1, 0x2b, 0x47, 0x56, 0x0c, 0x01, 0x8f,
+	0xf3, 0x56, 0x31, 0x9a, 0xde, 0x15, 0xb3, 0xe8, 0x75, 0x98, 0x69, 0x10, 0xec, 0x90, 0x50, 0xa1,
+	0xdf, 0x15, 0xad, 0xa6, 0x47, 0x82, 0xc4, 0x52, 0xa4, 0x69, 0x68, 0xb0, 0x74, 0x21, 0x68, 0xf0,
+	0x7c, 0x81, 0x4c, 0x87, 0x35, 0x2b, 0x67, 0xc6, 0x9a, 0xf2, 0xef, 0xb2, 0xb0, 0xba, 0xed, 0x38,
+	0xba, 0xcb, 0x4b, 0xa2, 0x78, 0x1b, 0x7d, 0xc5, 0xfb, 0x39, 0x15, 0xc4, 0xbb, 0x30, 0xdf, 0x6b,
+	0xda, 0x32, 0xe3, 0x34, 0x6d, 0x73, 0x4c, 0xfd, 0xe2, 0xc5, 0xb4, 0x5b, 0x2d, 0x54, 0xaf, 0x9e,
+	0xb1, 0x20, 0x9a, 0xaa, 0x39, 0xfd, 0xe5, 0x44, 0x15, 0x01, 0x95, 0xb0, 0xd9, 0x09, 0xca, 0x89,
+	0x68, 0xed, 0xa3, 0xb4, 0xbd, 0x0b, 0x33, 0xd4, 0x6f, 0x87, 0x75, 0x59, 0x1e, 0x8b, 0xd5, 0x72,
+	0x6a, 0x1f, 0x8b, 0xe9, 0xf1, 0x9e, 0xa0, 0xb4, 0x14, 0x87, 0x06, 0xe5, 0x66, 0x75, 0x28, 0x17,
+	0x68, 0x22, 0x6a, 0x6e, 0xd4, 0x63, 0x84, 0xfe, 0x54, 0x2b, 0x7d, 0x01, 0xa6, 0x9e, 0x06, 0xfa,
+	0xa3, 0xac, 0x04, 0x73, 0x41, 0xe8, 0xfa, 0xa1, 0xcb, 0x3a, 0xa2, 0x2a, 0x66, 0xad, 0xee, 0x98,
+	0xf7, 0xb6, 0x87, 0xd8, 0x0d, 0x3d, 0x42, 0xa9, 0xcd, 0xfb, 0x12, 0x10, 0x26, 0xe7, 0xa2, 0xb9,
+	0xef, 0x93, 0x4e, 0x69, 0x07, 0x56, 0x74, 0x7a, 0x34, 0x9d, 0xcc, 0x4a, 0xbc, 0x93, 0x99, 0x8f,
+	0x77, 0x29, 0xa7, 0x70, 0x69, 0x60, 0x0b, 0x0a, 0xac, 0x75, 0x19, 0x66, 0x5c, 0x54, 0x86, 0x95,
+	0xbf, 0x98, 0x11, 0x29, 0xa1, 0x6b, 0x8d, 0xbe, 0x89, 0x94, 0xe0, 0x17, 0x47, 0x11, 0x2d, 0x76,
+	0x4f, 0xb5, 0x6c, 0x14, 0x8a, 0x72, 0x7e, 0x37, 0x32, 0x20, 0x91, 0x3c, 0xd3, 0xe7, 0x4a, 0x9e,
+	0xec, 0x64, 0xc9, 0x33, 0x73, 0xfe, 0xe4, 0x99, 0xbd, 0x80, 0xe4, 0x99, 0xd3, 0x25, 0x8f, 0x07,
+	0x26, 0x8e, 0x1d, 0xe5, 0xae, 0x4b, 0x03, 0x1e, 0x15, 0xfc, 0xda, 0xa8, 0x00, 0xbf, 0x3a, 0x24,
+	0x89, 0x52, 0x38, 0xad, 0x54, 0x99, 0xda, 0x64, 0x85, 0x31, 0x92, 0x55, 0x13, 0x6f, 0x67, 0x48,
+	0xd6, 0xdc, 0x88, 0x64, 0xcd, 0x3f, 0x9f, 0x64, 0xfd, 0x32, 0x03, 0x66, 0x9a, 0xaf, 0xd0, 0xf7,
+	0x60, 0xa1, 0xd7, 0xbe, 0x88, 0xbb, 0xb2, 0x69, 0x0c, 0xe9, 0x0a, 0xd4, 0xad, 0x50, 0x3c, 0x68,
+	0x58, 0xbd, 0x16, 0x54, 0x8c, 0x07, 0x3a, 0xca, 0xa9, 0xc9, 0x3a, 0xca, 0x58, 0x8f, 0x95, 0x99,
+	0xb4, 0xc7, 0x9a, 0xbe, 0xf8, 0x1e, 0x2b, 0x7b, 0x31, 0x3d, 0xd6, 0xcc, 0x85, 0xf5, 0x58, 0xb3,
+	0xba, 0x1e, 0x4b, 0x95, 0x62, 0xed, 0xbd, 0xe9, 0xf9, 0x96, 0xe2, 0x2f, 0x0d, 0x58, 0x11, 0xd7,
+	0xd7, 0x68, 0x17, 0x51, 0x21, 0xbe, 0xd7, 0x7f, 0x47, 0x7d, 0x45, 0xbb, 0x79, 0x1d, 0xef, 0x98,
+	0xb7, 0xd3, 0xf3, 0x74, 0x22, 0xe3, 0x5d, 0x5e, 0xcb, 0x9f, 0x1b, 0xf0, 0x42, 0x9f, 0x85, 0xca,
+	0xab, 0xef, 0x42, 0x5e, 0xbc, 0x95, 0xd9, 0x21, 0xa1, 0xed, 0x66, 0xb4, 0xc7, 0xe1, 0x71, 0x92,
+	0x13, 0x1c, 0x96, 0x60, 0x40, 0x35, 0x28, 0x46, 0x02, 0x7e, 0x42, 0xea, 0x8c, 0x38, 0x43, 0x5f,
+	0x0a, 0xe4, 0x0b, 0x81, 0xa2, 0xb4, 0x0a, 0xcf, 0xe2, 0xc3, 0xf2, 0x3f, 0x0d, 0x58, 0x97, 0x86,
+	0x39, 0x82, 0x8e, 0xef, 0xf7, 0x9e, 0xdf, 0x0a, 0x9a, 0x84, 0x13, 0x2b, 0x57, 0x3e, 0xe9, 0x3f,
+	0x8f, 0xdb, 0x5a, 0x45, 0xa3, 0xe4, 0x7c, 0x0d, 0x67, 0x73, 0x09, 0x66, 0x05, 0xaf, 0xea, 0x10,
+	0xe7, 0xad, 0x19, 0x3e, 0xac, 0x39, 0xe5, 0x17, 0xe1, 0xc6, 0x10, 0xf3, 0xe4, 0xc1, 0x94, 0xff,
+	0x66, 0xc0, 0xd5, 0x7b, 0xbc, 0xd7, 0x6f, 0x3e, 0x69, 0x33, 0xca, 0xb0, 0xe7, 0xb8, 0xde, 0x11,
+	0x7f, 0x57, 0x18, 0xab, 0x43, 0x48, 0xbc, 0x78, 0x4c, 0xf5, 0xbd, 0x78, 0x3c, 0x84, 0x62, 0x77,
+	0x53, 0xbd, 0x17, 0xec, 0x62, 0x4a, 0x5a, 0x47, 0x3b, 0x93, 0x69, 0xcd, 0x62, 0xa3, 0xf3, 0xb4,
+	0x01, 0xe5, 0xeb, 0x70, 0x2d, 0x65, 0x7b, 0xca, 0x01, 0x3f, 0x85, 0x4b, 0xbb, 0x84, 0xd6, 0x43,
+	0xf7, 0x80, 0x74, 0xd9, 0xd5, 0xd6, 0x1f, 0xf4, 0xc7, 0xc0, 0xab, 0x5a, 0xad, 0x29, 0xec, 0xe3,
+	0x1d, 0x7d, 0xf9, 0x8b, 0x0c, 0x98, 0x83, 0x12, 0x54, 0xda, 0xbc, 0x05, 0xb3, 0xd2, 0x9d, 0xf2,
+	0xab, 0x63, 0xae, 0x7a, 0x3d, 0xf5, 0xe5, 0x8a, 0x84, 0x02, 0xc6, 0x23, 0x7a, 0x7e, 0xad, 0xea,
+	0x79, 0x9f, 0x32, 0xcc, 0xda, 0xd4, 0x9c, 0x1a, 0x72, 0xad, 0x8a, 0x74, 0xef, 0x09, 0x52, 0xab,
+	0xc8, 0x12, 0x63, 0xf4, 0x91, 0xa6, 0x2c, 0x66, 0x86, 0x38, 0x65, 0xec, 0xeb, 0xdf, 0x3e, 0x14,
+	0x1d, 0x85, 0xad, 0xc2, 0x4c, 0x6a, 0x4e, 0x8f, 0x78, 0x3d, 0x57, 0x92, 0x23, 0x44, 0xe6, 0x06,
+	0x52, 0xab, 0xe0, 0xc4, 0x87, 0xe8, 0x63, 0x58, 0xa2, 0x75, 0xdc, 0x74, 0xbd, 0x23, 0x3b, 0x7a,
+	0x56, 0x8f, 0xbe, 0x3e, 0x6e, 0x8e, 0x12, 0xbc, 0x27, 0x19, 0xa3, 0x46, 0xdd, 0x5a, 0xa4, 0xc9,
+	0x09, 0x5a, 0xa6, 0x70, 0x4d, 0x04, 0x67, 0xff, 0x1e, 0x69, 0x14, 0x39, 0xab, 0x30, 0xa3, 0xf0,
+	0x47, 0x66, 0x8c, 0x1a, 0x25, 0x23, 0x79, 0x6a, 0xb2, 0x48, 0xfe, 0xe5, 0x14, 0xac, 0xa5, 0x69,
+	0x55, 0xe1, 0xf2, 0x0c, 0xae, 0xf5, 0x1e, 0xd7, 0xba, 0x87, 0x1f, 0xfb, 0xfe, 0x2a, 0x83, 0xa8,
+	0x32, 0xde, 0x89, 0x3d, 0x26, 0x0c, 0x3b, 0x98, 0x61, 0xab, 0x14, 0x6f, 0x0d, 0x93, 0xaa, 0xb9,
+	0xca, 0xee, 0xb7, 0x0f, 0xad, 0xca, 0xa9, 0xb3, 0xa9, 0x74, 0x62, 0xd7, 0xa4, 0xa4, 0xca, 0xf2,
+	0x6d, 0xb8, 0xf2, 0x90, 0x74, 0xdd, 0x40, 0x77, 0x3a, 0x12, 0xd4, 0x47, 0xf8, 0xbe, 0xfc, 0xa7,
+	0x69, 0xb8, 0xaa, 0xe7, 0x53, 0xde, 0xfb, 0xb9, 0x01, 0xab, 0x9a, 0xbd, 0xb4, 0x70, 0xa0, 0xfc,
+	0xf6, 0x24, 0xbd, 0x01, 0x18, 0x26, 0xb8, 0xb2, 0xdb, 0xb7, 0x97, 0xc7, 0x38, 0x90, 0x8d, 0xef,
+	0xb2, 0x33, 0xb8, 0x22, 0xcc, 0xd0, 0x9c, 0x22, 0x37, 0x63, 0xea, 0x5c, 0x66, 0x6c, 0xf7, 0x9d,
+	0x62, 0xcf, 0x0c, 0x3c, 0xb8, 0x52, 0xfa, 0x8c, 0x97, 0x25, 0xbd, 0xdd, 0x9a, 0x46, 0xfa, 0x51,
+	0xf2, 0xfd, 0x7e, 0xc8, 0x05, 0x24, 0xad, 0xd6, 0xc5, 0xbf, 0xab, 0x7f, 0x96, 0xec, 0xbd, 0xbf,
+	0x4e, 0xdd, 0xe5, 0x3f, 0x4c, 0xc1, 0x4b, 0x1f, 0x04, 0x0e, 0x66, 0x24, 0xad, 0x84, 0x8d, 0x03,
+	0x8c, 0xe7, 0x48, 0xf4, 0x8b, 0xc3, 0x4d, 0x5d, 0xcd, 0x9e, 0xbe, 0x80, 0x9a, 0x5d, 0x7e, 0x19,
+	0x6e, 0x8e, 0x70, 0x91, 0x02, 0xd7, 0x3f, 0x4e, 0xc1, 0x4d, 0x8b, 0x1c, 0x86, 0x84, 0x36, 0xfe,
+	0xe7, 0xcd, 0x34, 0x6f, 0x6e, 0xc0, 0xad, 0x51, 0x3e, 0x92, 0xee, 0xac, 0xfe, 0x3b, 0x0f, 0xb9,
+	0xc7, 0x2a, 0x9e, 0xb7, 0x9f, 0xd6, 0xd0, 0xcf, 0x0c, 0x58, 0xd6, 0x7c, 0xc7, 0x44, 0x6f, 0x4c,
+	0xf8, 0xd9, 0x53, 0x1c, 0x41, 0xe9, 0xf6, 0x99, 0x3e, 0x96, 0xc6, 0x8d, 0x88, 0x27, 0xed, 0x18,
+	0x46, 0x68, 0x1e, 0x08, 0x4a, 0xb7, 0x27, 0xe4, 0x52, 0x46, 0x9c, 0xc0, 0x42, 0xdf, 0xdb, 0x1a,
+	0x7a, 0x6d, 0xd2, 0x97, 0xc4, 0xd2, 0xd6, 0x04, 0x1c, 0x09, 0xbd, 0x89, 0x7d, 0xbf, 0x36, 0xe9,
+	0xa3, 0x48, 0x69, 0x6b, 0x02, 0x0e, 0xa5, 0x37, 0x80, 0x42, 0xe2, 0xa2, 0x85, 0x2a, 0xe9, 0x32,
+	0x74, 0x77, 0xc6, 0xd2, 0xe6, 0xd8, 0xf4, 0x4a, 0xe3, 0x6f, 0x0c, 0xb8, 0x9c, 0x7a, 0x9d, 0x40,
+	0x77, 0xd3, 0xc5, 0x8d, 0xba, 0x22, 0x95, 0xde, 0x3e, 0x13, 0xaf, 0x32, 0xeb, 0x57, 0x06, 0xbc,
+	0xa0, 0x6d, 0xf0, 0xd1, 0x9b, 0xe9, 0x62, 0x87, 0x5d, 0x78, 0x4a, 0xdf, 0x9e, 0x98, 0x4f, 0x99,
+	0xd2, 0x81, 0xc5, 0x7e, 0x80, 0x41, 0x5b, 0x93, 0x80, 0x91, 0xd4, 0x7f, 0x06, 0xfc, 0x42, 0xbf,
+	0x36, 0x60, 0x55, 0xdf, 0x1b, 0xa2, 0x21, 0xdb, 0x19, 0xda, 0xc3, 0x96, 0xee, 0x4c, 0xce, 0xa8,
+	0xac, 0xf9, 0x85, 0x01, 0x2b, 0xba, 0x4e, 0x04, 0xdd, 0x9e, 0xb4, 0x73, 0x91, 0x96, 0xbc, 0x79,
+	0xb6, 0x86, 0x07, 0xfd, 0xde, 0x80, 0x6b, 0x43, 0x71, 0x0a, 0xbd, 0x93, 0x2e, 0x79, 0x9c, 0x1e,
+	0xa0, 0xf4, 0xee, 0x99, 0xf9, 0x95, 0x89, 0x9f, 0x1b, 0xb0, 0x36, 0xbc, 0xf8, 0xa3, 0x77, 0x87,
+	0xa5, 0xc7, 0x18, 0xd0, 0x5a, 0xfa, 0xee, 0xd9, 0x05, 0x48, 0x2b, 0x77, 0x1e, 0xfe, 0xe5, 0xab,
+	0x35, 0xe3, 0xaf, 0x5f, 0xad, 0x19, 0x7f, 0xff, 0x6a, 0xcd, 0xf8, 0xe1, 0x5b, 0x47, 0x2e, 0x6b,
+	0xb4, 0x0f, 0x2a, 0x75, 0xbf, 0xb5, 0x99, 0xf8, 0x6b, 0x6d, 0xe5, 0x88, 0x78, 0xf2, 0xbf, 0xc8,
+	0xf1, 0xbf, 0x43, 0xbf, 0x1d, 0xfd, 0x3e, 0xd9, 0x3a, 0x98, 0x11, 0xab, 0xaf, 0xff, 0x67, 0x00,
+	0xf3, 0xac, 0x17, 0xa4, 0x3c, 0x2d, 0x00, 0x00,
 }
 
 func (m *TaskListPartition) Marshal() (dAtA []byte, err error) {
@@ -3116,6 +3175,18 @@ func (m *AddDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Priority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -3261,6 +3332,18 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Priority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -3863,6 +3946,32 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ScalingDecisions) > 0 {
+		for iNdEx := len(m.ScalingDecisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScalingDecisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DispatchStats != nil {
+		{
+			size, err := m.DispatchStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.PartitionConfig != nil {
 		{
 			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
@@ -4681,6 +4790,13 @@ func (m *AddDecisionTaskRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if m.Priority != 0 {
+		n += 1 + sovService(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4751,6 +4867,13 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if m.Priority != 0 {
+		n += 1 + sovService(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4979,6 +5102,16 @@ func (m *DescribeTaskListResponse) Size() (n int) {
 		l = m.PartitionConfig.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.DispatchStats != nil {
+		l = m.DispatchStats.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.ScalingDecisions) > 0 {
+		for _, e := range m.ScalingDecisions {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7951,6 +8084,57 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8494,6 +8678,57 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -9908,6 +10143,76 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DispatchStats == nil {
+				m.DispatchStats = &v11.TaskListDispatchStats{}
+			}
+			if err := m.DispatchStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingDecisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScalingDecisions = append(m.ScalingDecisions, &v11.TaskListScalingDecision{})
+			if err := m.ScalingDecisions[len(m.ScalingDecisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
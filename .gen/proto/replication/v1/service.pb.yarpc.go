@@ -0,0 +1,1495 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/replication/v1/service.proto
+
+package replicationv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// ReplicationStreamAPIYARPCClient is the YARPC client-side interface for the ReplicationStreamAPI service.
+type ReplicationStreamAPIYARPCClient interface {
+	StreamReplicationMessages(context.Context, ...yarpc.CallOption) (ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient, error)
+}
+
+// ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient sends StreamReplicationMessagesRequests and receives StreamReplicationMessagesResponses, returning io.EOF when the stream is complete.
+type ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient interface {
+	Context() context.Context
+	Send(*StreamReplicationMessagesRequest, ...yarpc.StreamOption) error
+	Recv(...yarpc.StreamOption) (*StreamReplicationMessagesResponse, error)
+	CloseSend(...yarpc.StreamOption) error
+}
+
+func newReplicationStreamAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) ReplicationStreamAPIYARPCClient {
+	return &_ReplicationStreamAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.replication.v1.ReplicationStreamAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewReplicationStreamAPIYARPCClient builds a new YARPC client for the ReplicationStreamAPI service.
+func NewReplicationStreamAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) ReplicationStreamAPIYARPCClient {
+	return newReplicationStreamAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// ReplicationStreamAPIYARPCServer is the YARPC server-side interface for the ReplicationStreamAPI service.
+type ReplicationStreamAPIYARPCServer interface {
+	StreamReplicationMessages(ReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) error
+}
+
+// ReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer receives StreamReplicationMessagesRequests and sends StreamReplicationMessagesResponse.
+type ReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer interface {
+	Context() context.Context
+	Recv(...yarpc.StreamOption) (*StreamReplicationMessagesRequest, error)
+	Send(*StreamReplicationMessagesResponse, ...yarpc.StreamOption) error
+}
+
+type buildReplicationStreamAPIYARPCProceduresParams struct {
+	Server      ReplicationStreamAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildReplicationStreamAPIYARPCProcedures(params buildReplicationStreamAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_ReplicationStreamAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName:         "uber.cadence.replication.v1.ReplicationStreamAPI",
+			UnaryHandlerParams:  []protobuf.BuildProceduresUnaryHandlerParams{},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{
+				{
+					MethodName: "StreamReplicationMessages",
+					Handler: protobuf.NewStreamHandler(
+						protobuf.StreamHandlerParams{
+							Handle: handler.StreamReplicationMessages,
+						},
+					),
+				},
+			},
+		},
+	)
+}
+
+// BuildReplicationStreamAPIYARPCProcedures prepares an implementation of the ReplicationStreamAPI service for YARPC registration.
+func BuildReplicationStreamAPIYARPCProcedures(server ReplicationStreamAPIYARPCServer) []transport.Procedure {
+	return buildReplicationStreamAPIYARPCProcedures(buildReplicationStreamAPIYARPCProceduresParams{Server: server})
+}
+
+// FxReplicationStreamAPIYARPCClientParams defines the input
+// for NewFxReplicationStreamAPIYARPCClient. It provides the
+// paramaters to get a ReplicationStreamAPIYARPCClient in an
+// Fx application.
+type FxReplicationStreamAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxReplicationStreamAPIYARPCClientResult defines the output
+// of NewFxReplicationStreamAPIYARPCClient. It provides a
+// ReplicationStreamAPIYARPCClient to an Fx application.
+type FxReplicationStreamAPIYARPCClientResult struct {
+	fx.Out
+
+	Client ReplicationStreamAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxReplicationStreamAPIYARPCClient provides a ReplicationStreamAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  replicationv1.NewFxReplicationStreamAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxReplicationStreamAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxReplicationStreamAPIYARPCClientParams) FxReplicationStreamAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxReplicationStreamAPIYARPCClientResult{
+			Client: newReplicationStreamAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxReplicationStreamAPIYARPCProceduresParams defines the input
+// for NewFxReplicationStreamAPIYARPCProcedures. It provides the
+// paramaters to get ReplicationStreamAPIYARPCServer procedures in an
+// Fx application.
+type FxReplicationStreamAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      ReplicationStreamAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxReplicationStreamAPIYARPCProceduresResult defines the output
+// of NewFxReplicationStreamAPIYARPCProcedures. It provides
+// ReplicationStreamAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxReplicationStreamAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxReplicationStreamAPIYARPCProcedures provides ReplicationStreamAPIYARPCServer procedures to an Fx application.
+// It expects a ReplicationStreamAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  replicationv1.NewFxReplicationStreamAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxReplicationStreamAPIYARPCProcedures() interface{} {
+	return func(params FxReplicationStreamAPIYARPCProceduresParams) FxReplicationStreamAPIYARPCProceduresResult {
+		return FxReplicationStreamAPIYARPCProceduresResult{
+			Procedures: buildReplicationStreamAPIYARPCProcedures(buildReplicationStreamAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: ReplicationStreamAPIReflectionMeta,
+		}
+	}
+}
+
+// ReplicationStreamAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var ReplicationStreamAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.replication.v1.ReplicationStreamAPI",
+	FileDescriptors: yarpcFileDescriptorClosure6277f04da4f1fb4d,
+}
+
+type _ReplicationStreamAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_ReplicationStreamAPIYARPCCaller) StreamReplicationMessages(ctx context.Context, options ...yarpc.CallOption) (ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient, error) {
+	stream, err := c.streamClient.CallStream(ctx, "StreamReplicationMessages", options...)
+	if err != nil {
+		return nil, err
+	}
+	return &_ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient{stream: stream}, nil
+}
+
+type _ReplicationStreamAPIYARPCHandler struct {
+	server ReplicationStreamAPIYARPCServer
+}
+
+func (h *_ReplicationStreamAPIYARPCHandler) StreamReplicationMessages(serverStream *protobuf.ServerStream) error {
+	return h.server.StreamReplicationMessages(&_ReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer{serverStream: serverStream})
+}
+
+type _ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient struct {
+	stream *protobuf.ClientStream
+}
+
+func (c *_ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient) Context() context.Context {
+	return c.stream.Context()
+}
+
+func (c *_ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient) Send(request *StreamReplicationMessagesRequest, options ...yarpc.StreamOption) error {
+	return c.stream.Send(request, options...)
+}
+
+func (c *_ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient) Recv(options ...yarpc.StreamOption) (*StreamReplicationMessagesResponse, error) {
+	responseMessage, err := c.stream.Receive(newReplicationStreamAPIServiceStreamReplicationMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StreamReplicationMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyReplicationStreamAPIServiceStreamReplicationMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient) CloseSend(options ...yarpc.StreamOption) error {
+	return c.stream.Close(options...)
+}
+
+type _ReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer struct {
+	serverStream *protobuf.ServerStream
+}
+
+func (s *_ReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) Context() context.Context {
+	return s.serverStream.Context()
+}
+
+func (s *_ReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) Recv(options ...yarpc.StreamOption) (*StreamReplicationMessagesRequest, error) {
+	requestMessage, err := s.serverStream.Receive(newReplicationStreamAPIServiceStreamReplicationMessagesYARPCRequest, options...)
+	if requestMessage == nil {
+		return nil, err
+	}
+	request, ok := requestMessage.(*StreamReplicationMessagesRequest)
+	if !ok {
+		return nil, protobuf.CastError(emptyReplicationStreamAPIServiceStreamReplicationMessagesYARPCRequest, requestMessage)
+	}
+	return request, err
+}
+
+func (s *_ReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) Send(response *StreamReplicationMessagesResponse, options ...yarpc.StreamOption) error {
+	return s.serverStream.Send(response, options...)
+}
+
+func newReplicationStreamAPIServiceStreamReplicationMessagesYARPCRequest() proto.Message {
+	return &StreamReplicationMessagesRequest{}
+}
+
+func newReplicationStreamAPIServiceStreamReplicationMessagesYARPCResponse() proto.Message {
+	return &StreamReplicationMessagesResponse{}
+}
+
+var (
+	emptyReplicationStreamAPIServiceStreamReplicationMessagesYARPCRequest  = &StreamReplicationMessagesRequest{}
+	emptyReplicationStreamAPIServiceStreamReplicationMessagesYARPCResponse = &StreamReplicationMessagesResponse{}
+)
+
+var yarpcFileDescriptorClosure6277f04da4f1fb4d = [][]byte{
+	// uber/cadence/replication/v1/service.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2c, 0x4d, 0x4a, 0x2d,
+		0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x4a, 0x2d, 0xc8, 0xc9, 0x4c, 0x4e,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5,
+		0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x06, 0x29, 0xd5, 0x83, 0x2a, 0xd5, 0x43, 0x52, 0xaa,
+		0x57, 0x66, 0x28, 0xa5, 0x8c, 0x62, 0x4e, 0x62, 0x4a, 0x6e, 0x26, 0xa6, 0x09, 0x4a, 0x45, 0x5c,
+		0x0a, 0xc1, 0x25, 0x45, 0xa9, 0x89, 0xb9, 0x41, 0x08, 0xcd, 0xbe, 0xa9, 0xc5, 0xc5, 0x89, 0xe9,
+		0xa9, 0xc5, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x7e, 0x5c, 0xec, 0x45, 0x10, 0xa6,
+		0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x89, 0x1e, 0x8a, 0xbd, 0x60, 0xa3, 0xf5, 0xca, 0x0c,
+		0xf5, 0xdc, 0x53, 0x4b, 0x70, 0x1b, 0x13, 0x04, 0x33, 0x44, 0xa9, 0x8c, 0x4b, 0x11, 0x8f, 0x9d,
+		0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x81, 0x5c, 0x1c, 0x45, 0x50, 0x36, 0xd4, 0x56, 0x53,
+		0x12, 0x6d, 0x85, 0x68, 0x0e, 0x82, 0x1b, 0x63, 0xb4, 0x9d, 0x91, 0x4b, 0x04, 0x49, 0x25, 0xc4,
+		0x0d, 0x8e, 0x01, 0x9e, 0x42, 0xf3, 0x18, 0xb9, 0x24, 0x71, 0xba, 0x48, 0xc8, 0x56, 0x0f, 0x4f,
+		0x28, 0xeb, 0x11, 0x0a, 0x3d, 0x29, 0x3b, 0x72, 0xb5, 0x43, 0x5c, 0xad, 0xc1, 0x68, 0xc0, 0xe8,
+		0xe4, 0x18, 0x65, 0x9f, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x12,
+		0xaf, 0x7a, 0xe9, 0xa9, 0x79, 0xfa, 0xe0, 0xb8, 0x44, 0x4b, 0x2a, 0xd6, 0x48, 0xdc, 0x32, 0xc3,
+		0x24, 0x36, 0xb0, 0x1a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x9a, 0x7e, 0xce, 0x5e,
+		0x02, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/service.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x5b, 0x6f, 0xdc, 0xc6,
+		0xd5, 0xe1, 0xea, 0x62, 0xe9, 0xac, 0x75, 0x1b, 0x4b, 0x5a, 0x89, 0x8a, 0x6d, 0x89, 0xb6, 0x63,
+		0x39, 0x89, 0x57, 0xb1, 0x9c, 0x38, 0xb1, 0x1d, 0x7c, 0x8e, 0xbc, 0x92, 0xe5, 0xfd, 0x3e, 0xdb,
+		0xb1, 0x29, 0xc7, 0xf9, 0xf0, 0x7d, 0x45, 0x19, 0xee, 0x72, 0x24, 0x31, 0xda, 0x25, 0x19, 0x0e,
+		0x77, 0x6d, 0x05, 0x41, 0xd3, 0x20, 0x6d, 0x51, 0xa0, 0x97, 0xa0, 0x45, 0x81, 0xa2, 0x45, 0x8b,
+		0x3c, 0x04, 0x28, 0x9a, 0xa2, 0x40, 0x81, 0x3e, 0xf7, 0xb9, 0x8f, 0x05, 0xfa, 0x23, 0xda, 0x87,
+		0xa2, 0x45, 0xd1, 0xb7, 0xbe, 0xb5, 0x98, 0x0b, 0x77, 0xc9, 0x25, 0x87, 0x7b, 0x89, 0x53, 0x07,
+		0x79, 0x5b, 0xce, 0x9c, 0xfb, 0x9c, 0x39, 0xe7, 0xcc, 0x99, 0x59, 0x38, 0xd5, 0xa8, 0x60, 0x7f,
+		0xad, 0x6a, 0x5a, 0xd8, 0xa9, 0xe2, 0x35, 0xd3, 0xaa, 0xdb, 0xce, 0x5a, 0xf3, 0xc2, 0x1a, 0xc1,
+		0x7e, 0xd3, 0xae, 0xe2, 0xa2, 0xe7, 0xbb, 0x81, 0x8b, 0xe6, 0x28, 0x50, 0x51, 0x00, 0x15, 0x19,
+		0x50, 0xb1, 0x79, 0x41, 0x3d, 0xb9, 0xe7, 0xba, 0x7b, 0x35, 0xbc, 0xc6, 0x80, 0x2a, 0x8d, 0xdd,
+		0xb5, 0xc0, 0xae, 0x63, 0x12, 0x98, 0x75, 0x8f, 0xe3, 0xa9, 0x27, 0x3a, 0x01, 0x1e, 0xfa, 0xa6,
+		0xe7, 0x61, 0x9f, 0x88, 0xf9, 0xe5, 0x38, 0x73, 0xcf, 0xa6, 0xac, 0xab, 0x6e, 0xbd, 0xee, 0x3a,
+		0x02, 0x42, 0x4b, 0x83, 0x08, 0x4c, 0x72, 0x50, 0xb3, 0x49, 0x20, 0x60, 0x4e, 0xa7, 0xc1, 0x34,
+		0x6d, 0x62, 0x57, 0xec, 0x9a, 0x1d, 0x1c, 0x0a, 0x28, 0x89, 0xa2, 0xd5, 0x5a, 0x83, 0x04, 0xd8,
+		0xcf, 0x06, 0xda, 0xb7, 0x49, 0xe0, 0xfa, 0x21, 0xa5, 0x95, 0x74, 0xa0, 0x77, 0x1a, 0xb8, 0x21,
+		0x0c, 0xa6, 0x9e, 0x4d, 0x07, 0xf1, 0xb1, 0x57, 0xb3, 0xab, 0x66, 0x60, 0x87, 0xfa, 0x69, 0x3f,
+		0xcb, 0xc1, 0xe9, 0x37, 0x3c, 0xcb, 0x0c, 0xf0, 0x7d, 0x93, 0x1c, 0xdc, 0xb2, 0x49, 0x70, 0xd7,
+		0xf4, 0x03, 0x9b, 0x42, 0x94, 0x5c, 0x67, 0xd7, 0xde, 0xd3, 0xf1, 0x3b, 0x0d, 0x4c, 0x02, 0x34,
+		0x0f, 0xa3, 0x96, 0x5b, 0x37, 0x6d, 0x67, 0x41, 0x59, 0x56, 0x56, 0xc7, 0x75, 0xf1, 0x85, 0xae,
+		0xc0, 0x38, 0x35, 0x87, 0x41, 0xed, 0xb1, 0x90, 0x5b, 0x56, 0x56, 0xf3, 0xeb, 0xc7, 0x8b, 0xf1,
+		0xe5, 0xf2, 0xec, 0x62, 0xf3, 0x42, 0x31, 0xa4, 0xaf, 0x8f, 0x05, 0xe2, 0x17, 0xda, 0x86, 0xc9,
+		0x16, 0xae, 0x11, 0x1c, 0x7a, 0x78, 0x61, 0x68, 0x59, 0x59, 0x9d, 0x5c, 0x5f, 0xc9, 0x24, 0x70,
+		0xff, 0xd0, 0xc3, 0xfa, 0xd1, 0x20, 0xf2, 0x85, 0xde, 0x84, 0x69, 0x2f, 0x14, 0xdb, 0xa8, 0x32,
+		0xb9, 0x17, 0x86, 0x99, 0x2c, 0xcf, 0x67, 0x92, 0xea, 0xd4, 0x75, 0xca, 0x8b, 0x0f, 0x68, 0x67,
+		0xe1, 0x4c, 0x17, 0xeb, 0x10, 0xcf, 0x75, 0x08, 0xd6, 0x7e, 0xa0, 0xc0, 0xf2, 0x26, 0x26, 0x55,
+		0xdf, 0xae, 0xe0, 0x37, 0x5d, 0xff, 0x60, 0xb7, 0xe6, 0x3e, 0xdc, 0x7a, 0x84, 0xab, 0x0d, 0x0a,
+		0xdb, 0xcd, 0x86, 0x6f, 0x00, 0x7a, 0x28, 0x70, 0x0c, 0x1c, 0x22, 0x09, 0x63, 0x3e, 0x93, 0xaa,
+		0x40, 0x92, 0xc5, 0xcc, 0xc3, 0xce, 0x21, 0xed, 0x8f, 0x0a, 0xac, 0x64, 0xc8, 0xc4, 0x25, 0x47,
+		0x8b, 0x30, 0x46, 0xf6, 0x4d, 0xdf, 0x32, 0x6c, 0x8b, 0x89, 0x35, 0xa2, 0x1f, 0x61, 0xdf, 0x65,
+		0x0b, 0xad, 0xc0, 0x51, 0xe1, 0x79, 0x86, 0x69, 0x59, 0x3e, 0x93, 0x68, 0x5c, 0xcf, 0x8b, 0xb1,
+		0x0d, 0xcb, 0xf2, 0xd1, 0x45, 0x98, 0xaf, 0x37, 0x02, 0xb3, 0x52, 0xc3, 0x06, 0x09, 0xcc, 0x00,
+		0x1b, 0xb6, 0x63, 0x54, 0xcd, 0xea, 0x3e, 0x5f, 0xca, 0x71, 0xfd, 0x98, 0x98, 0xdd, 0xa1, 0x93,
+		0x65, 0xa7, 0x44, 0xa7, 0xd0, 0x65, 0x58, 0x4c, 0x20, 0x59, 0x66, 0x60, 0x56, 0x4c, 0x82, 0xd9,
+		0xba, 0x8d, 0xeb, 0xf3, 0x71, 0xbc, 0x4d, 0x31, 0xab, 0xfd, 0x5e, 0x01, 0x35, 0xd4, 0xe9, 0x26,
+		0x97, 0xe3, 0xa6, 0x4b, 0x82, 0xd0, 0xc2, 0xa7, 0xe0, 0xe8, 0xbe, 0x4b, 0x02, 0x26, 0x2e, 0x26,
+		0x84, 0xdb, 0xf9, 0xe6, 0x53, 0x7a, 0x9e, 0x8e, 0x6e, 0xf0, 0x41, 0xb4, 0x14, 0xd1, 0x98, 0xaa,
+		0x34, 0x72, 0xf3, 0xa9, 0xb6, 0xce, 0x6f, 0xa6, 0xae, 0xc5, 0x50, 0x3f, 0x6b, 0x71, 0xf3, 0xa9,
+		0x94, 0xd5, 0xb8, 0x3e, 0x01, 0x79, 0x4b, 0x08, 0x6e, 0x54, 0x0e, 0xb5, 0xff, 0x6d, 0xfb, 0xcb,
+		0x0e, 0x65, 0xbd, 0x69, 0x93, 0xc0, 0xb7, 0x2b, 0x31, 0x7f, 0x59, 0x82, 0x71, 0xcf, 0xdc, 0xc3,
+		0x06, 0xb1, 0xdf, 0xc5, 0x62, 0x6d, 0xc6, 0xe8, 0xc0, 0x8e, 0xfd, 0x2e, 0x46, 0x05, 0x38, 0xc2,
+		0x26, 0x43, 0x25, 0xf4, 0x51, 0xfa, 0x59, 0xb6, 0xb4, 0x3f, 0x47, 0x96, 0x3d, 0x85, 0xb4, 0x58,
+		0xf6, 0x55, 0x98, 0x76, 0x1a, 0xf5, 0x0a, 0xf6, 0x0d, 0x77, 0xd7, 0x60, 0xca, 0x13, 0xc1, 0x62,
+		0x92, 0x8f, 0xbf, 0xbe, 0xcb, 0x90, 0x09, 0xfa, 0x0a, 0x8c, 0x8a, 0xf9, 0xdc, 0xf2, 0xd0, 0x6a,
+		0x7e, 0x7d, 0xb3, 0x98, 0x1a, 0x8d, 0x8b, 0x5d, 0x79, 0x16, 0x39, 0xc1, 0x2d, 0x27, 0xf0, 0x0f,
+		0x75, 0x41, 0x53, 0xbd, 0x0c, 0xf9, 0xc8, 0x30, 0x9a, 0x86, 0xa1, 0x03, 0x7c, 0x28, 0x24, 0xa1,
+		0x3f, 0xd1, 0x2c, 0x8c, 0x34, 0xcd, 0x5a, 0x03, 0x0b, 0xef, 0xe3, 0x1f, 0x57, 0x72, 0xaf, 0x28,
+		0xda, 0x07, 0x39, 0x58, 0x4a, 0xf5, 0x85, 0xbe, 0x55, 0x5c, 0x82, 0xf1, 0xd0, 0x23, 0xb8, 0x96,
+		0x23, 0xfa, 0x98, 0x70, 0x08, 0x82, 0xca, 0x70, 0x94, 0xef, 0xd3, 0x88, 0x63, 0x27, 0x7d, 0xa1,
+		0x65, 0x05, 0x06, 0xca, 0xfc, 0xbc, 0xec, 0xec, 0xba, 0x7a, 0xde, 0x6a, 0x0f, 0xa0, 0x4b, 0x50,
+		0xe0, 0x7c, 0xaa, 0xae, 0x13, 0xf8, 0x6e, 0xad, 0x86, 0x7d, 0xb6, 0x03, 0x1a, 0x44, 0xb8, 0xfd,
+		0x1c, 0x9b, 0x2e, 0xb5, 0x66, 0x77, 0xd8, 0x24, 0x5a, 0x80, 0x23, 0xa1, 0x47, 0x8f, 0x30, 0xb8,
+		0xf0, 0x53, 0x2b, 0xc2, 0x4c, 0xa9, 0xe6, 0x12, 0x6e, 0xf4, 0xd0, 0x6f, 0xe4, 0x5b, 0x5a, 0x9b,
+		0x05, 0x14, 0x85, 0x17, 0xd1, 0xeb, 0x6f, 0x0a, 0xcc, 0xe8, 0xb8, 0xee, 0x36, 0x59, 0x9c, 0xeb,
+		0x4e, 0x06, 0xbd, 0x2a, 0xa2, 0x3e, 0x0b, 0xda, 0x39, 0x16, 0xb4, 0x4f, 0x4a, 0x0c, 0x42, 0x29,
+		0xb2, 0x90, 0xcd, 0xe2, 0x3e, 0x0b, 0xd7, 0x05, 0x38, 0xc2, 0xb0, 0x6d, 0x8b, 0x19, 0x73, 0x48,
+		0x1f, 0xa5, 0x9f, 0x65, 0x0b, 0x95, 0x60, 0xaa, 0x9d, 0x37, 0x0d, 0x9a, 0xcd, 0x45, 0x18, 0x57,
+		0x8b, 0x3c, 0x93, 0x17, 0xc3, 0x4c, 0x5e, 0xbc, 0x1f, 0xa6, 0x7a, 0x7d, 0xb2, 0x8d, 0x42, 0x07,
+		0x69, 0xd4, 0x12, 0x49, 0xd5, 0x70, 0xcc, 0x3a, 0x16, 0x16, 0xcb, 0x8b, 0xb1, 0x3b, 0x66, 0x1d,
+		0x53, 0x2b, 0x44, 0xd5, 0x15, 0x56, 0xf8, 0x88, 0x59, 0x81, 0xe0, 0xe0, 0x1e, 0xcd, 0xa4, 0x3d,
+		0x58, 0xa1, 0x93, 0x53, 0x2e, 0xc1, 0x29, 0x6e, 0xa8, 0xa1, 0x3e, 0x0d, 0xc5, 0xe5, 0x6c, 0x0b,
+		0x24, 0xe4, 0xfc, 0xa1, 0x02, 0xb3, 0xa1, 0xdf, 0x7f, 0x61, 0x44, 0x7d, 0x1d, 0xe6, 0x3a, 0x64,
+		0x12, 0xbb, 0xf0, 0x12, 0x14, 0x3c, 0xdf, 0xad, 0x62, 0x42, 0x6c, 0x67, 0xcf, 0x60, 0x45, 0x0a,
+		0x8f, 0xfa, 0x74, 0x33, 0x0e, 0x51, 0x9f, 0x6f, 0x4f, 0x33, 0x4c, 0x16, 0xf2, 0x89, 0xf6, 0x8f,
+		0x1c, 0x9c, 0xdd, 0xc6, 0x41, 0x32, 0x71, 0x99, 0x0f, 0xc5, 0x66, 0x7f, 0xb0, 0xfe, 0x64, 0x12,
+		0x2b, 0xfa, 0x6f, 0xc8, 0x93, 0xc0, 0xf4, 0x03, 0x03, 0x37, 0xb1, 0x13, 0x88, 0x80, 0x70, 0x4e,
+		0x62, 0xab, 0x07, 0xd8, 0x27, 0x34, 0x29, 0x70, 0x99, 0xcb, 0x01, 0xae, 0xeb, 0xc0, 0xb0, 0xb7,
+		0x28, 0x32, 0xba, 0x01, 0xe3, 0xd8, 0xb1, 0x04, 0xa5, 0xe1, 0x7e, 0x29, 0x8d, 0x61, 0xc7, 0xe2,
+		0x74, 0x62, 0xb9, 0x62, 0xa4, 0x23, 0x57, 0x3c, 0x03, 0x53, 0x0e, 0x7e, 0x14, 0x18, 0x0c, 0x22,
+		0x70, 0x0f, 0xb0, 0xb3, 0x30, 0xba, 0xac, 0xac, 0x1e, 0xd5, 0x27, 0xe8, 0xf0, 0x5d, 0x73, 0x0f,
+		0xdf, 0xa7, 0x83, 0xda, 0x5f, 0x14, 0x58, 0xed, 0x6e, 0x73, 0xb1, 0xb0, 0x29, 0x44, 0x95, 0x14,
+		0xa2, 0xe8, 0x06, 0x4c, 0x85, 0x55, 0x44, 0xc5, 0x0c, 0xaa, 0xfb, 0x38, 0x4c, 0x24, 0xe9, 0x75,
+		0x22, 0x4d, 0xf5, 0xd7, 0x6b, 0x6e, 0x45, 0x9f, 0x14, 0x58, 0xd7, 0x39, 0x12, 0xba, 0x03, 0x53,
+		0x4d, 0x6e, 0x01, 0x43, 0xcc, 0x08, 0xcb, 0x9f, 0xe9, 0xc9, 0x5e, 0xfa, 0x64, 0x33, 0xf6, 0xad,
+		0x7d, 0xa8, 0xc0, 0xf1, 0x6d, 0x1c, 0xe8, 0xed, 0x9a, 0xf8, 0x36, 0x26, 0xc4, 0xdc, 0xc3, 0x24,
+		0x74, 0xab, 0x6b, 0x30, 0xca, 0xf4, 0xe2, 0x9e, 0x9a, 0x5f, 0x3f, 0x2b, 0x61, 0x14, 0x21, 0xc1,
+		0x54, 0xd6, 0x05, 0x5a, 0x0f, 0xbb, 0x4e, 0xfb, 0x97, 0x02, 0x27, 0x64, 0x52, 0x08, 0x43, 0xbb,
+		0x30, 0xc9, 0xb7, 0x75, 0x5d, 0xcc, 0x08, 0x71, 0x6e, 0x4a, 0xc4, 0xc9, 0x26, 0xc7, 0xb3, 0x70,
+		0x38, 0xca, 0x93, 0xf1, 0x04, 0x89, 0x8e, 0xa9, 0x35, 0x40, 0x49, 0xa0, 0x94, 0xd4, 0xfc, 0x5a,
+		0x34, 0x35, 0xe7, 0xd7, 0x9f, 0xed, 0x6e, 0x9e, 0x96, 0x30, 0x91, 0x34, 0x5e, 0x87, 0xe5, 0x6d,
+		0x1c, 0x6c, 0xde, 0xba, 0x97, 0xb1, 0x12, 0x65, 0x00, 0x9e, 0x31, 0x9c, 0x5d, 0x37, 0x54, 0xbf,
+		0x07, 0x76, 0x34, 0x4e, 0xb1, 0x2c, 0xcc, 0x22, 0x1b, 0xfd, 0x45, 0xb4, 0x47, 0xb0, 0x92, 0xc1,
+		0x4e, 0x98, 0x7c, 0x07, 0x66, 0x22, 0x67, 0x25, 0x83, 0x62, 0x87, 0x6c, 0x9f, 0xe9, 0x8d, 0xad,
+		0x3e, 0xed, 0xc7, 0x07, 0x88, 0xf6, 0x4f, 0x05, 0x4e, 0x51, 0xd6, 0x2c, 0x36, 0x65, 0x28, 0xfb,
+		0x00, 0x16, 0x6b, 0x26, 0x09, 0x0c, 0x1f, 0x07, 0xbe, 0x8d, 0x9b, 0xb8, 0xb5, 0xf0, 0x61, 0x5c,
+		0xcf, 0xaf, 0x2f, 0x25, 0xf2, 0x61, 0xd9, 0x09, 0x2e, 0xbd, 0xf8, 0x80, 0x1a, 0x55, 0x9f, 0xa7,
+		0xd8, 0x7a, 0x88, 0x2c, 0xa8, 0x97, 0xad, 0x16, 0x5d, 0x11, 0x6f, 0xe3, 0x74, 0x73, 0x3d, 0xd2,
+		0xbd, 0x1b, 0x22, 0xb7, 0xe9, 0x76, 0x7a, 0xf9, 0x50, 0xd2, 0xcb, 0x1d, 0x38, 0x9d, 0xad, 0xb9,
+		0xb0, 0xfb, 0x0d, 0x18, 0x8b, 0x38, 0x79, 0xbf, 0x4e, 0xd5, 0xc2, 0xd5, 0x7e, 0xa7, 0xc0, 0xac,
+		0x8e, 0x4d, 0xcf, 0xab, 0x1d, 0xb2, 0xf0, 0x48, 0x9e, 0x50, 0xa6, 0x78, 0x09, 0x46, 0x59, 0x64,
+		0x27, 0x22, 0x54, 0x75, 0x09, 0x79, 0x02, 0x58, 0x2b, 0xc0, 0x5c, 0x87, 0xf4, 0x22, 0xf5, 0x7f,
+		0x9c, 0x83, 0xc5, 0x0d, 0xcb, 0xda, 0xc1, 0xa6, 0x5f, 0xdd, 0xdf, 0x08, 0x78, 0x89, 0xdd, 0xca,
+		0xff, 0x1e, 0x4c, 0x13, 0x36, 0x63, 0x98, 0xe1, 0x94, 0x70, 0xda, 0x2d, 0x89, 0x15, 0xa5, 0xb4,
+		0x8a, 0x1d, 0xc3, 0x3c, 0x4e, 0x4c, 0x91, 0xf8, 0x28, 0x3a, 0x03, 0x93, 0x04, 0x57, 0x1b, 0x3e,
+		0x2b, 0xd7, 0x58, 0x0a, 0xe0, 0x21, 0x6e, 0x22, 0x1c, 0x65, 0xf1, 0x50, 0xb5, 0x61, 0x36, 0x8d,
+		0x5e, 0x34, 0xa4, 0x8c, 0xf3, 0x90, 0x72, 0x35, 0x1a, 0x52, 0x26, 0x13, 0xa1, 0x9d, 0xdb, 0xab,
+		0xec, 0x58, 0xf8, 0x11, 0xb6, 0x98, 0x57, 0xb2, 0x32, 0x24, 0x12, 0x4d, 0x9e, 0x06, 0x35, 0x4d,
+		0x29, 0x61, 0xbf, 0x05, 0x98, 0x0f, 0xab, 0x94, 0x12, 0x77, 0x4f, 0xa1, 0xaf, 0xf6, 0x9b, 0x21,
+		0x28, 0x24, 0xa6, 0x84, 0x57, 0xee, 0xc3, 0x22, 0x69, 0x78, 0x9e, 0xeb, 0x07, 0xd8, 0x32, 0xaa,
+		0x35, 0x1b, 0x3b, 0x81, 0x21, 0x92, 0x49, 0xe8, 0xa6, 0xe9, 0x7d, 0x86, 0x9d, 0x10, 0xab, 0xc4,
+		0x90, 0x44, 0x42, 0x22, 0x7a, 0x81, 0xa4, 0x4f, 0xd0, 0x1c, 0x57, 0xc7, 0xf4, 0x68, 0x42, 0xf6,
+		0x6d, 0x8f, 0x45, 0x3b, 0xe1, 0x83, 0xb2, 0x1c, 0x77, 0xbb, 0x05, 0xcd, 0xe2, 0xdc, 0x64, 0x3d,
+		0xf6, 0x8d, 0x1c, 0x98, 0xf6, 0x28, 0x6d, 0x12, 0x50, 0x34, 0x4e, 0x70, 0x88, 0x79, 0x44, 0xa9,
+		0xcb, 0x29, 0xae, 0xc3, 0x06, 0xc5, 0xbb, 0x6d, 0x32, 0x94, 0xb2, 0xf0, 0x07, 0x2f, 0x3e, 0xaa,
+		0xbe, 0x0d, 0xb3, 0x69, 0x80, 0x29, 0x0b, 0xfd, 0x6a, 0x3c, 0x77, 0xc8, 0xa2, 0x6a, 0x07, 0xb5,
+		0xe8, 0x4a, 0x5f, 0x81, 0x42, 0xc9, 0x6d, 0x38, 0x34, 0x94, 0x77, 0x46, 0xd0, 0x93, 0x90, 0xdf,
+		0x75, 0xfd, 0x2a, 0x36, 0x76, 0x71, 0x50, 0xdd, 0x67, 0x6c, 0xc7, 0x74, 0x60, 0x43, 0x37, 0xe8,
+		0x88, 0x76, 0x08, 0x0b, 0x49, 0x5c, 0xb1, 0xda, 0x5b, 0x70, 0x24, 0xac, 0x2f, 0xf8, 0xe6, 0x79,
+		0x4e, 0x22, 0x9b, 0x28, 0x24, 0x36, 0x6f, 0xdd, 0x63, 0xb4, 0xb8, 0x49, 0x42, 0xdc, 0x48, 0xa4,
+		0xc9, 0xf1, 0x33, 0x0e, 0xff, 0xd2, 0x3e, 0xcd, 0xc1, 0xbc, 0x8e, 0x4d, 0x2b, 0x45, 0xec, 0x75,
+		0x18, 0x66, 0xc5, 0xb7, 0xc2, 0x7c, 0xff, 0x84, 0x6c, 0x85, 0x6e, 0xdd, 0x63, 0x4e, 0xcf, 0x60,
+		0x63, 0x35, 0x7f, 0x2e, 0x5e, 0xf3, 0xd3, 0xcd, 0xe9, 0x36, 0xa8, 0x19, 0x44, 0x28, 0x16, 0x91,
+		0x79, 0x82, 0x8f, 0x8a, 0x15, 0x46, 0xf7, 0x61, 0xc1, 0x76, 0x28, 0x84, 0xdd, 0xc4, 0x06, 0xad,
+		0x45, 0x23, 0x59, 0x61, 0xb8, 0x7b, 0x56, 0x98, 0x6b, 0x21, 0x6f, 0x39, 0x91, 0xa4, 0xf0, 0x58,
+		0xea, 0xd1, 0x5f, 0xe7, 0xa0, 0x90, 0xb0, 0x95, 0x58, 0xa6, 0x41, 0x8c, 0x95, 0x9a, 0xd6, 0x73,
+		0x9f, 0x2d, 0xad, 0xa3, 0xb7, 0x60, 0x3e, 0x41, 0x34, 0xba, 0xd3, 0xfa, 0xa9, 0x53, 0x66, 0x3b,
+		0xa9, 0xb3, 0x5d, 0x9c, 0x62, 0xae, 0xe1, 0x34, 0x73, 0xfd, 0x49, 0x81, 0xc2, 0xdd, 0x86, 0xbf,
+		0x87, 0xbf, 0xdc, 0xbe, 0xa5, 0xa9, 0xb0, 0x90, 0x54, 0x53, 0x44, 0xf8, 0x5f, 0xe5, 0xa0, 0x70,
+		0x1b, 0x7f, 0xe9, 0x6d, 0xf0, 0x78, 0xf6, 0xd7, 0x75, 0x58, 0x48, 0xda, 0xaa, 0xbf, 0xe3, 0x9d,
+		0xf6, 0x5d, 0x05, 0x96, 0x74, 0xbc, 0xeb, 0x63, 0xb2, 0x1f, 0x96, 0x44, 0xcc, 0x73, 0x9f, 0x50,
+		0xd3, 0xfb, 0x04, 0x3c, 0x9d, 0x2e, 0x8d, 0xf0, 0x8f, 0x3f, 0xe4, 0xe0, 0xb8, 0x8e, 0x09, 0x76,
+		0xac, 0x8e, 0xfd, 0x47, 0x22, 0x5d, 0x57, 0xd1, 0xef, 0x13, 0xe5, 0xf6, 0xb8, 0x3e, 0xc6, 0x07,
+		0xca, 0xd6, 0xe7, 0x55, 0x27, 0x9e, 0x81, 0x49, 0x1f, 0xd7, 0xdd, 0x20, 0xe1, 0x4a, 0x7c, 0x34,
+		0x74, 0xa5, 0x8e, 0xc6, 0xc3, 0xf0, 0x63, 0x6b, 0x3c, 0x8c, 0x0c, 0xdc, 0x78, 0xd0, 0x96, 0xe1,
+		0x84, 0xcc, 0x9e, 0xc2, 0xe4, 0x26, 0x2c, 0x6d, 0xe3, 0xa0, 0xe4, 0xbb, 0x84, 0x08, 0x45, 0x3a,
+		0xed, 0xdd, 0x6e, 0xbe, 0x2a, 0x1d, 0xcd, 0xd7, 0x33, 0x30, 0x19, 0x98, 0xfe, 0x1e, 0x0e, 0x5a,
+		0x86, 0x11, 0x05, 0x26, 0x1f, 0x15, 0xf4, 0xb4, 0xbf, 0x0f, 0xc1, 0xd3, 0xe9, 0x3c, 0x84, 0x37,
+		0x1f, 0xf0, 0xab, 0x26, 0x62, 0x54, 0x0e, 0x79, 0x2b, 0xb8, 0x4b, 0x61, 0x9c, 0x45, 0x8c, 0xb5,
+		0xbf, 0xc8, 0xf5, 0x43, 0x76, 0x46, 0xe6, 0x59, 0x9f, 0x5d, 0x47, 0x85, 0x43, 0xe8, 0x6b, 0x30,
+		0xb7, 0x6b, 0xda, 0x35, 0x5a, 0x2c, 0x9a, 0x0d, 0x82, 0xdb, 0x3c, 0x79, 0xaa, 0xf9, 0x9f, 0x41,
+		0x78, 0xde, 0x60, 0x04, 0x4b, 0x94, 0x5e, 0x8c, 0x33, 0xda, 0x4d, 0x4c, 0xa8, 0x1e, 0xcc, 0x24,
+		0x44, 0x4c, 0x39, 0xbe, 0x6f, 0xc5, 0x4b, 0xb0, 0x35, 0x89, 0x58, 0x9d, 0x32, 0x89, 0x75, 0x8b,
+		0x9e, 0xe1, 0x55, 0x0f, 0x0a, 0x12, 0x01, 0x53, 0xf8, 0x5e, 0x8b, 0xd7, 0xf8, 0xe7, 0xe4, 0xe6,
+		0xa0, 0xec, 0x22, 0x74, 0xa3, 0xd5, 0xdf, 0x5f, 0x15, 0x58, 0xe5, 0xc6, 0xb1, 0x12, 0x46, 0x2b,
+		0xb9, 0x75, 0xaf, 0x86, 0x03, 0xdc, 0x43, 0x43, 0xbc, 0x47, 0x07, 0x43, 0x0f, 0xc4, 0x55, 0xa5,
+		0x2f, 0xd6, 0x83, 0x88, 0xe4, 0xde, 0xbb, 0xd1, 0x38, 0x1e, 0xa5, 0xdb, 0xfe, 0x22, 0x34, 0xca,
+		0xb2, 0x1a, 0xd5, 0x70, 0xf0, 0x43, 0x51, 0x8f, 0x0c, 0xb3, 0x6a, 0x75, 0x82, 0x0d, 0xdf, 0xc1,
+		0x3c, 0x7c, 0x69, 0x3e, 0x9c, 0xeb, 0x41, 0xdb, 0x56, 0x05, 0x3b, 0x12, 0x76, 0x2c, 0x06, 0x5b,
+		0x58, 0x86, 0xad, 0x7d, 0x5d, 0x81, 0x02, 0x3d, 0xb5, 0x1f, 0x3a, 0x66, 0xdd, 0xae, 0xc6, 0xaf,
+		0x83, 0x4f, 0x42, 0x9e, 0xdf, 0xb3, 0xf2, 0x23, 0x3f, 0x0f, 0x93, 0xc0, 0x87, 0x58, 0x37, 0x79,
+		0x13, 0x8e, 0xec, 0xda, 0xb5, 0x00, 0xfb, 0x61, 0x81, 0x25, 0x2b, 0x83, 0x62, 0xe4, 0x6f, 0x30,
+		0x14, 0x3d, 0x44, 0xd5, 0x5e, 0x87, 0x85, 0xa4, 0x04, 0x42, 0xcb, 0x8b, 0xa1, 0x1b, 0x29, 0xbd,
+		0x1c, 0xad, 0x39, 0xac, 0xf6, 0x3d, 0x05, 0x54, 0x7e, 0xa3, 0x3b, 0x98, 0x5a, 0x77, 0x60, 0x42,
+		0x00, 0x30, 0x7a, 0xa1, 0x72, 0xe7, 0x7a, 0x51, 0x8e, 0xe7, 0xf3, 0xa3, 0xd5, 0xf6, 0x07, 0xd1,
+		0x8e, 0xc3, 0x52, 0xaa, 0x38, 0x22, 0x74, 0x7e, 0x83, 0x25, 0x57, 0x1a, 0x76, 0xf1, 0x93, 0x5c,
+		0x06, 0x96, 0x54, 0xd3, 0xa4, 0x10, 0x62, 0x7e, 0x4b, 0x81, 0xb9, 0x4d, 0x4c, 0x9d, 0x30, 0xcc,
+		0x76, 0x4f, 0x28, 0xfb, 0x7f, 0xac, 0xd0, 0x03, 0x7e, 0x5c, 0x10, 0xe1, 0x2e, 0x67, 0xdb, 0x6d,
+		0x68, 0x8b, 0x41, 0x58, 0xe2, 0x5c, 0x18, 0xf6, 0x99, 0x39, 0x9e, 0x85, 0xce, 0x03, 0x6a, 0x49,
+		0x44, 0x5a, 0xb0, 0x39, 0x06, 0x3b, 0xd3, 0x9e, 0x89, 0x80, 0x47, 0xee, 0xac, 0x42, 0xf0, 0x21,
+		0x0e, 0xde, 0x9e, 0x11, 0xe0, 0xda, 0x47, 0x0a, 0x9c, 0xb8, 0x6d, 0xda, 0x4e, 0x60, 0xda, 0x4e,
+		0xc9, 0xf5, 0xfd, 0x86, 0x17, 0x3c, 0x61, 0x9b, 0xfd, 0x52, 0x81, 0x93, 0x52, 0x89, 0xbe, 0x58,
+		0xc6, 0xbb, 0x0a, 0x0b, 0xb7, 0x6c, 0x32, 0x58, 0x44, 0xd2, 0xde, 0x82, 0xc5, 0x14, 0x64, 0xa1,
+		0x60, 0x09, 0x8e, 0x60, 0x27, 0xf0, 0xed, 0x56, 0x73, 0xbd, 0xa7, 0x1d, 0x2d, 0x8e, 0xfc, 0x02,
+		0x53, 0x3b, 0x00, 0x94, 0x9c, 0x46, 0x08, 0x86, 0x23, 0x12, 0xb1, 0xdf, 0x68, 0x03, 0x46, 0x45,
+		0xfc, 0x18, 0xea, 0x37, 0x7e, 0x08, 0x44, 0xea, 0x48, 0x28, 0x39, 0x3d, 0x50, 0x54, 0x7c, 0x4c,
+		0x51, 0xe2, 0xab, 0x70, 0x2c, 0x65, 0x3e, 0x55, 0xff, 0x8b, 0xf1, 0xd2, 0xa3, 0xb7, 0xd8, 0xbd,
+		0x02, 0x27, 0xb7, 0x71, 0xb0, 0x5d, 0x73, 0x2b, 0x66, 0xad, 0x4c, 0xdc, 0x1a, 0x2b, 0x35, 0xb7,
+		0x7d, 0xb7, 0xe1, 0x85, 0xb5, 0xa4, 0xf6, 0x3e, 0xbb, 0x4b, 0x90, 0x80, 0x88, 0xa5, 0xfe, 0x7f,
+		0x98, 0xb6, 0xc3, 0x29, 0x63, 0x8f, 0xcd, 0x09, 0x63, 0xbd, 0x90, 0xde, 0x6d, 0x8c, 0xd1, 0xe1,
+		0xaa, 0x35, 0x7c, 0x36, 0xa2, 0x4f, 0xd9, 0x71, 0x26, 0xda, 0x07, 0x0a, 0x68, 0x3c, 0xa0, 0x67,
+		0xc9, 0xf9, 0xf9, 0xca, 0x70, 0x06, 0x4e, 0x65, 0x8a, 0x20, 0x82, 0xf6, 0x65, 0x66, 0x4e, 0xde,
+		0x93, 0x97, 0x88, 0x29, 0x89, 0x44, 0xc2, 0xcc, 0x12, 0xd4, 0xff, 0x84, 0x99, 0x7f, 0xd2, 0x32,
+		0xf3, 0x20, 0xf2, 0xa7, 0xca, 0x96, 0x7b, 0xec, 0xe6, 0xcf, 0xb4, 0x8f, 0x56, 0x82, 0x73, 0x2d,
+		0x1b, 0x6e, 0x90, 0x43, 0xa7, 0x1a, 0x06, 0xdd, 0x36, 0xf9, 0xae, 0x2f, 0xc7, 0xb4, 0x0f, 0x15,
+		0x78, 0xb6, 0x17, 0x2a, 0x62, 0x4d, 0xde, 0x08, 0xab, 0x17, 0x21, 0xbc, 0xa4, 0x40, 0xe4, 0x4a,
+		0x4b, 0xc8, 0x51, 0x9d, 0xe3, 0x54, 0xb4, 0x9f, 0x2b, 0xf0, 0x7c, 0x54, 0xe5, 0x41, 0xd5, 0x49,
+		0xca, 0x97, 0x7b, 0x2c, 0xf2, 0xad, 0xc1, 0xf9, 0x1e, 0xc5, 0xe3, 0x76, 0x5a, 0xff, 0xe4, 0x34,
+		0x8c, 0x6d, 0xd0, 0x98, 0xb7, 0x71, 0xb7, 0x8c, 0xbe, 0xaf, 0xc0, 0xa2, 0xf4, 0x19, 0x1d, 0x7a,
+		0xb9, 0x4b, 0x1f, 0x5d, 0xf6, 0x18, 0x50, 0x7d, 0xa5, 0x7f, 0x44, 0xb1, 0x8a, 0xef, 0xc1, 0xb1,
+		0x94, 0x67, 0x4f, 0xe8, 0x42, 0x17, 0x82, 0xc9, 0xe7, 0x72, 0xea, 0x7a, 0x3f, 0x28, 0x82, 0x7b,
+		0xd4, 0x1c, 0x89, 0xa7, 0x5e, 0x5d, 0xcd, 0x21, 0x7b, 0xeb, 0xd6, 0xd5, 0x1c, 0xf2, 0x97, 0x6c,
+		0x26, 0x40, 0xfb, 0x49, 0x13, 0x5a, 0x95, 0x1d, 0x76, 0x3a, 0x5f, 0x49, 0xa9, 0xe7, 0x7a, 0x80,
+		0x6c, 0xb3, 0x68, 0xbf, 0x17, 0x92, 0xb2, 0x48, 0xbc, 0xa0, 0x92, 0xb2, 0x48, 0x3e, 0x3e, 0xe2,
+		0x2c, 0xc2, 0xa7, 0x3e, 0x19, 0x2c, 0x3a, 0x9e, 0x27, 0x65, 0xb0, 0xe8, 0x7c, 0x37, 0x84, 0xde,
+		0x86, 0x89, 0xd8, 0x13, 0x1d, 0xf4, 0x5c, 0x17, 0x9b, 0xc7, 0x18, 0x3d, 0xdf, 0x1b, 0xb0, 0xe0,
+		0xf5, 0x89, 0xc2, 0x52, 0x44, 0xe6, 0x4b, 0x12, 0xf4, 0x5f, 0xf2, 0x93, 0x7f, 0x2f, 0xcf, 0x7e,
+		0xd4, 0x6b, 0x03, 0xe3, 0x0b, 0x29, 0xbf, 0xa9, 0xc0, 0x7c, 0xfa, 0x6b, 0x09, 0xf4, 0x62, 0x9f,
+		0x8f, 0x2b, 0xb8, 0x44, 0x2f, 0x0d, 0xf4, 0x24, 0x83, 0xed, 0x29, 0xe9, 0xa3, 0x04, 0xe9, 0x9e,
+		0xea, 0xf6, 0x6a, 0x42, 0xba, 0xa7, 0xba, 0xbf, 0x7f, 0xf8, 0xb1, 0xc2, 0xfa, 0x69, 0xd2, 0x0b,
+		0x7b, 0x74, 0x25, 0x83, 0x74, 0x97, 0xf7, 0x0d, 0xea, 0xd5, 0x81, 0x70, 0xdb, 0x4e, 0x1c, 0xbb,
+		0x1a, 0x97, 0x3a, 0x71, 0xda, 0xf5, 0xbf, 0xd4, 0x89, 0x53, 0x6f, 0xdb, 0xd1, 0x21, 0xa0, 0xe4,
+		0x5d, 0x32, 0x7a, 0xa1, 0xdf, 0xbb, 0x74, 0xf5, 0x42, 0x1f, 0x18, 0x82, 0xb5, 0x07, 0x53, 0x1d,
+		0x37, 0xb1, 0xe8, 0x7c, 0xaf, 0x37, 0xb6, 0x9c, 0x69, 0xb1, 0xbf, 0x0b, 0x5e, 0x44, 0x60, 0xba,
+		0xf3, 0x4a, 0x14, 0xc9, 0x68, 0x48, 0xee, 0x5d, 0xd5, 0xb5, 0x9e, 0xe1, 0xdb, 0x6a, 0x76, 0xdc,
+		0xef, 0x49, 0xd5, 0x4c, 0xbf, 0x33, 0x95, 0xaa, 0x29, 0xbb, 0x36, 0x24, 0x30, 0xdd, 0x79, 0x77,
+		0x24, 0x55, 0x53, 0x72, 0x97, 0x26, 0x55, 0x53, 0x76, 0x29, 0x45, 0x99, 0x76, 0xde, 0xb3, 0x48,
+		0x99, 0x4a, 0x2e, 0xaf, 0xa4, 0x4c, 0xa5, 0x17, 0x38, 0xef, 0xc3, 0x6c, 0xda, 0x4d, 0x08, 0x5a,
+		0x97, 0x5a, 0x4c, 0x7a, 0x89, 0xa3, 0x5e, 0xec, 0x0b, 0x27, 0x12, 0x5d, 0xd3, 0xaf, 0x06, 0xa4,
+		0xd1, 0x35, 0xf3, 0x66, 0x46, 0x1a, 0x5d, 0xb3, 0xef, 0x1f, 0xa8, 0x21, 0xd2, 0x5a, 0xeb, 0x52,
+		0x43, 0x64, 0x5c, 0x56, 0x48, 0x0d, 0x91, 0x79, 0xf9, 0xf0, 0x0b, 0x05, 0x56, 0xba, 0x76, 0x6f,
+		0xd1, 0x35, 0xb9, 0x76, 0x3d, 0x75, 0xb9, 0xd5, 0xd7, 0x06, 0x27, 0xd0, 0xf6, 0xd3, 0xce, 0x76,
+		0xab, 0xd4, 0x4f, 0x25, 0x9d, 0x61, 0xa9, 0x9f, 0x4a, 0xfb, 0xb8, 0xef, 0xc1, 0xb1, 0x94, 0x16,
+		0xa8, 0xb4, 0x9c, 0x95, 0x77, 0x6f, 0xa5, 0xe5, 0x6c, 0x46, 0x87, 0x95, 0xef, 0x92, 0x64, 0x6b,
+		0x33, 0x63, 0x97, 0x48, 0xbb, 0xb1, 0x19, 0xbb, 0x44, 0xde, 0x3b, 0x45, 0x4d, 0x98, 0x49, 0xb4,
+		0xa5, 0x90, 0xcc, 0x88, 0xb2, 0xee, 0x97, 0xfa, 0x42, 0xef, 0x08, 0x82, 0x6f, 0x1d, 0x26, 0xe3,
+		0x9d, 0x52, 0x24, 0xaf, 0xf0, 0x52, 0x3a, 0xbb, 0xea, 0xf9, 0x1e, 0xa1, 0x05, 0xbb, 0x6f, 0x2b,
+		0x50, 0x90, 0x74, 0x19, 0x91, 0x6c, 0x5f, 0x67, 0xf7, 0x49, 0xd5, 0x4b, 0xfd, 0xa2, 0x09, 0x51,
+		0xbe, 0xa3, 0xb0, 0x5b, 0x85, 0xd4, 0xee, 0x08, 0xba, 0x24, 0x77, 0xdf, 0xac, 0x8e, 0x8e, 0xfa,
+		0x72, 0xdf, 0x78, 0x42, 0x9a, 0x1f, 0x29, 0xe1, 0x15, 0x40, 0xba, 0x40, 0x97, 0x33, 0x9d, 0x3a,
+		0x53, 0xa6, 0x2b, 0x83, 0xa0, 0xc6, 0x8d, 0x94, 0xda, 0xc3, 0xc8, 0x32, 0x52, 0x56, 0x3f, 0x26,
+		0xcb, 0x48, 0xd9, 0xcd, 0xa4, 0xb6, 0x91, 0xd2, 0x05, 0xca, 0x36, 0x52, 0xa6, 0x4c, 0x57, 0x06,
+		0x41, 0x15, 0x62, 0x7d, 0xaa, 0x80, 0xd6, 0xbd, 0xfd, 0x82, 0x5e, 0xeb, 0xa6, 0x76, 0xb7, 0x86,
+		0x89, 0xba, 0xf1, 0x19, 0x28, 0x08, 0x59, 0x7f, 0xab, 0x84, 0xff, 0x65, 0xec, 0x26, 0x6e, 0xa9,
+		0x07, 0x8b, 0x74, 0x95, 0x78, 0xf3, 0xb3, 0x11, 0x11, 0x42, 0xff, 0x54, 0x81, 0xe3, 0x99, 0x7f,
+		0xc0, 0x44, 0x57, 0x33, 0xf9, 0x64, 0xff, 0xa9, 0x55, 0x7d, 0x75, 0x30, 0x64, 0x2e, 0xdc, 0xf5,
+		0x97, 0xff, 0xef, 0xa5, 0x3d, 0x3b, 0xd8, 0x6f, 0x54, 0x8a, 0x55, 0xb7, 0xbe, 0x16, 0xfd, 0xc7,
+		0xed, 0x79, 0xdb, 0xaa, 0xad, 0xed, 0xb9, 0xfc, 0x7f, 0xc7, 0xad, 0xbf, 0xdf, 0x5e, 0x65, 0x3f,
+		0x9a, 0x17, 0x2a, 0xa3, 0x6c, 0xfc, 0xe2, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x66, 0xec, 0xff,
+		0xce, 0xfc, 0x3c, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
+		0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x51, 0x73, 0xdb, 0xc4,
+		0x13, 0xff, 0x2b, 0x8e, 0x9d, 0x64, 0xed, 0x26, 0xfe, 0x5f, 0x48, 0xe2, 0xa4, 0x04, 0x52, 0xcd,
+		0x30, 0x0d, 0x1d, 0x90, 0x27, 0xee, 0x4b, 0x87, 0x4e, 0x01, 0x27, 0x76, 0x12, 0xb5, 0xc1, 0x36,
+		0xb2, 0x69, 0xa6, 0x30, 0x83, 0xe6, 0x2c, 0x9d, 0xdc, 0xc3, 0xd2, 0x9d, 0x38, 0x9d, 0x9c, 0xf8,
+		0x85, 0xe1, 0x93, 0xf0, 0xc0, 0xd7, 0xe1, 0x91, 0x2f, 0xc4, 0x48, 0x3a, 0xc5, 0x76, 0x71, 0xa6,
+		0x3c, 0x30, 0xbc, 0xdd, 0xed, 0xef, 0xb7, 0xbb, 0xbf, 0x3b, 0xed, 0xae, 0x0e, 0x8e, 0xe2, 0x21,
+		0x11, 0x75, 0x07, 0xbb, 0x84, 0x39, 0xa4, 0x8e, 0x43, 0x5a, 0x9f, 0x9c, 0xd4, 0x1d, 0x1e, 0x04,
+		0x9c, 0x19, 0xa1, 0xe0, 0x92, 0xa3, 0xed, 0x84, 0x61, 0x28, 0x86, 0x81, 0x43, 0x6a, 0x4c, 0x4e,
+		0x0e, 0x3e, 0x1a, 0x71, 0x3e, 0xf2, 0x49, 0x3d, 0xa5, 0x0c, 0x63, 0xaf, 0xee, 0xc6, 0x02, 0x4b,
+		0x9a, 0x3b, 0xe9, 0xaf, 0xe0, 0xff, 0xd7, 0x5c, 0x8c, 0x3d, 0x9f, 0xdf, 0xb4, 0x6f, 0x89, 0x13,
+		0x27, 0x10, 0xfa, 0x18, 0xca, 0x37, 0xca, 0x68, 0x53, 0xb7, 0xa6, 0x1d, 0x69, 0xc7, 0x1b, 0x16,
+		0xe4, 0x26, 0xd3, 0x45, 0x3b, 0x50, 0x12, 0x31, 0x4b, 0xb0, 0x95, 0x14, 0x2b, 0x8a, 0x98, 0x99,
+		0xae, 0xae, 0x43, 0x25, 0x0f, 0x36, 0x98, 0x86, 0x04, 0x21, 0x58, 0x65, 0x38, 0x20, 0x2a, 0x40,
+		0xba, 0x4e, 0x38, 0x4d, 0x47, 0xd2, 0x09, 0x95, 0xd3, 0x7b, 0x39, 0x87, 0xb0, 0xd6, 0xc3, 0x53,
+		0x9f, 0x63, 0x37, 0x81, 0x5d, 0x2c, 0x71, 0x0a, 0x57, 0xac, 0x74, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x99, 0xf2, 0x57, 0x3b, 0x54, 0x83,
+		0x35, 0x97, 0x48, 0x4c, 0xfd, 0x28, 0x55, 0x58, 0xb1, 0xf2, 0xad, 0xfe, 0x9b, 0x06, 0xab, 0xdf,
+		0x90, 0x80, 0xa3, 0x17, 0x50, 0xf2, 0x28, 0xf1, 0xdd, 0xa8, 0xa6, 0x1d, 0x15, 0x8e, 0xcb, 0x8d,
+		0x4f, 0x8c, 0x25, 0xf7, 0x67, 0x24, 0x54, 0xe3, 0x3c, 0xe5, 0xb5, 0x99, 0x14, 0x53, 0x4b, 0x39,
+		0x1d, 0x5c, 0x43, 0x79, 0xce, 0x8c, 0xaa, 0x50, 0x18, 0x93, 0xa9, 0x52, 0x91, 0x2c, 0x51, 0x03,
+		0x8a, 0x13, 0xec, 0xc7, 0x24, 0x15, 0x50, 0x6e, 0x7c, 0xb8, 0x34, 0xbc, 0x3a, 0xa6, 0x95, 0x51,
+		0xbf, 0x58, 0x79, 0xa6, 0xe9, 0xbf, 0x6b, 0x50, 0xba, 0x24, 0xd8, 0x25, 0x02, 0x7d, 0xf5, 0x8e,
+		0xc4, 0xc7, 0x4b, 0x63, 0x64, 0xe4, 0xff, 0x56, 0xe4, 0x9f, 0x1a, 0x54, 0xfb, 0x04, 0x0b, 0xe7,
+		0x6d, 0x53, 0x4a, 0x41, 0x87, 0xb1, 0x24, 0x11, 0xb2, 0x61, 0x93, 0x32, 0x97, 0xdc, 0x12, 0xd7,
+		0x5e, 0x90, 0xfd, 0x6c, 0x69, 0xd4, 0x77, 0xdd, 0x0d, 0x33, 0xf3, 0x9d, 0x3f, 0xc7, 0x03, 0x3a,
+		0x6f, 0x3b, 0xf8, 0x11, 0xd0, 0xdf, 0x49, 0xff, 0xe2, 0xa9, 0x3c, 0x58, 0x6f, 0x61, 0x89, 0x4f,
+		0x7d, 0x3e, 0x44, 0xe7, 0xf0, 0x80, 0x30, 0x87, 0xbb, 0x94, 0x8d, 0x6c, 0x39, 0x0d, 0xb3, 0x02,
+		0xdd, 0x6c, 0x3c, 0x5a, 0x1a, 0xab, 0xad, 0x98, 0x49, 0x45, 0x5b, 0x15, 0x32, 0xb7, 0xbb, 0x2b,
+		0xe0, 0x95, 0xb9, 0x02, 0xee, 0x65, 0x4d, 0x47, 0xc4, 0x6b, 0x22, 0x22, 0xca, 0x99, 0xc9, 0x3c,
+		0x9e, 0x10, 0x69, 0x10, 0xfa, 0x79, 0x23, 0x24, 0x6b, 0xf4, 0x18, 0xb6, 0x3c, 0x82, 0x65, 0x2c,
+		0x88, 0x3d, 0xc9, 0xa8, 0xaa, 0xe1, 0x36, 0x95, 0x59, 0x05, 0xd0, 0x5f, 0xc1, 0x5e, 0x3f, 0x0e,
+		0x43, 0x2e, 0x24, 0x71, 0xcf, 0x7c, 0x4a, 0x98, 0x54, 0x48, 0x94, 0xf4, 0xea, 0x88, 0xdb, 0x91,
+		0x3b, 0x56, 0x91, 0x8b, 0x23, 0xde, 0x77, 0xc7, 0x68, 0x1f, 0xd6, 0x7f, 0xc2, 0x13, 0x9c, 0x02,
+		0x59, 0xcc, 0xb5, 0x64, 0xdf, 0x77, 0xc7, 0xfa, 0xaf, 0x05, 0x28, 0x5b, 0x44, 0x8a, 0x69, 0x8f,
+		0xfb, 0xd4, 0x99, 0xa2, 0x16, 0x54, 0x29, 0xa3, 0x92, 0x62, 0xdf, 0xa6, 0x4c, 0x12, 0x31, 0xc1,
+		0x99, 0xca, 0x72, 0x63, 0xdf, 0xc8, 0xc6, 0x8b, 0x91, 0x8f, 0x17, 0xa3, 0xa5, 0xc6, 0x8b, 0xb5,
+		0xa5, 0x5c, 0x4c, 0xe5, 0x81, 0xea, 0xb0, 0x3d, 0xc4, 0xce, 0x98, 0x7b, 0x9e, 0xed, 0x70, 0xe2,
+		0x79, 0xd4, 0x49, 0x64, 0xa6, 0xb9, 0x35, 0x0b, 0x29, 0xe8, 0x6c, 0x86, 0x24, 0x69, 0x03, 0x7c,
+		0x4b, 0x83, 0x38, 0x98, 0xa5, 0x2d, 0xbc, 0x37, 0xad, 0x72, 0xb9, 0x4b, 0xfb, 0xe9, 0x2c, 0x0a,
+		0x96, 0x92, 0x04, 0xa1, 0x8c, 0x6a, 0xab, 0x47, 0xda, 0x71, 0xf1, 0x8e, 0xda, 0x54, 0x66, 0xf4,
+		0x02, 0x1e, 0x32, 0xce, 0x6c, 0x91, 0x1c, 0x1d, 0x0f, 0x7d, 0x62, 0x13, 0x21, 0xb8, 0xb0, 0xb3,
+		0x91, 0x12, 0xd5, 0x8a, 0x47, 0x85, 0xe3, 0x0d, 0xab, 0xc6, 0x38, 0xb3, 0x72, 0x46, 0x3b, 0x21,
+		0x58, 0x19, 0x8e, 0x5e, 0xc2, 0x36, 0xb9, 0x0d, 0x69, 0x26, 0x64, 0x26, 0xb9, 0xf4, 0x3e, 0xc9,
+		0x68, 0xe6, 0x95, 0xab, 0xd6, 0x03, 0xd8, 0x33, 0x23, 0xee, 0xa7, 0xc6, 0x0b, 0xc1, 0xe3, 0xb0,
+		0x87, 0x85, 0xa4, 0xe9, 0x70, 0x5e, 0x32, 0x30, 0xd1, 0x97, 0x50, 0x8c, 0x24, 0x96, 0x59, 0xc1,
+		0x6f, 0x36, 0x8e, 0x97, 0x16, 0xe9, 0x62, 0xc0, 0x7e, 0xc2, 0xb7, 0x32, 0x37, 0x7d, 0x02, 0x0f,
+		0x17, 0xd1, 0x33, 0xce, 0x3c, 0x3a, 0x52, 0x0a, 0xd1, 0x35, 0x54, 0x69, 0x0e, 0xdb, 0xa3, 0x04,
+		0xcf, 0x5b, 0xfb, 0xb3, 0x7f, 0x90, 0xe9, 0x4e, 0xba, 0xb5, 0x45, 0x17, 0x80, 0x48, 0xff, 0x43,
+		0x83, 0x83, 0x66, 0x34, 0x65, 0x4e, 0xfe, 0xdb, 0x58, 0xcc, 0x5b, 0x83, 0x35, 0xc2, 0x92, 0x7b,
+		0xce, 0xfe, 0x41, 0xeb, 0x56, 0xbe, 0x45, 0x0d, 0xd8, 0x09, 0x05, 0x71, 0x89, 0x47, 0x19, 0x71,
+		0xed, 0x9f, 0x63, 0x12, 0x13, 0x3b, 0xbd, 0x95, 0xac, 0x94, 0xb7, 0x67, 0xe0, 0xb7, 0x09, 0xd6,
+		0x49, 0x2e, 0xe9, 0x10, 0x20, 0x23, 0xa6, 0xed, 0x5c, 0x48, 0x89, 0x1b, 0xa9, 0x25, 0x6d, 0xd4,
+		0xaf, 0xa1, 0x92, 0xc1, 0x4e, 0xaa, 0x21, 0x2d, 0x92, 0x72, 0xe3, 0x70, 0xe9, 0x01, 0xf3, 0x29,
+		0x61, 0x95, 0x53, 0x97, 0x4c, 0xf5, 0x93, 0x1b, 0xa8, 0xcc, 0x0f, 0x02, 0xb4, 0x0f, 0x3b, 0xed,
+		0xce, 0x59, 0xb7, 0x65, 0x76, 0x2e, 0xec, 0xc1, 0x9b, 0x5e, 0xdb, 0x36, 0x3b, 0xaf, 0x9b, 0x57,
+		0x66, 0xab, 0xfa, 0x3f, 0x74, 0x00, 0xbb, 0x8b, 0xd0, 0xe0, 0xd2, 0x32, 0xcf, 0x07, 0xd6, 0x75,
+		0x55, 0x43, 0xbb, 0x80, 0x16, 0xb1, 0x97, 0xfd, 0x6e, 0xa7, 0xba, 0x82, 0x6a, 0xf0, 0xc1, 0xa2,
+		0xbd, 0x67, 0x75, 0x07, 0xdd, 0xa7, 0xd5, 0xc2, 0x93, 0x5f, 0x60, 0x7b, 0xc9, 0xc7, 0x45, 0x8f,
+		0xe0, 0xd0, 0xec, 0x77, 0xaf, 0x9a, 0x03, 0xb3, 0xdb, 0xb1, 0x2f, 0xac, 0xee, 0x77, 0x3d, 0xbb,
+		0x3f, 0x68, 0x0e, 0xe6, 0x75, 0xdc, 0x4b, 0xb9, 0x6c, 0x37, 0xaf, 0x06, 0x97, 0x6f, 0xaa, 0xda,
+		0xfd, 0x94, 0x96, 0xd5, 0x34, 0x3b, 0xed, 0x56, 0x75, 0xe5, 0xf4, 0x07, 0xd8, 0x73, 0x78, 0xb0,
+		0xec, 0xa6, 0x4e, 0xcb, 0x67, 0xe9, 0x13, 0xa5, 0x97, 0x54, 0x7d, 0x4f, 0xfb, 0xfe, 0x64, 0x44,
+		0xe5, 0xdb, 0x78, 0x68, 0x38, 0x3c, 0xa8, 0xcf, 0x3f, 0x68, 0x3e, 0xa7, 0xae, 0x5f, 0x1f, 0xf1,
+		0xec, 0x99, 0xa2, 0x5e, 0x37, 0xcf, 0x71, 0x48, 0x27, 0x27, 0xc3, 0x52, 0x6a, 0x7b, 0xfa, 0x57,
+		0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xeb, 0x4e, 0xe3, 0x46,
+		0x14, 0x6e, 0x6e, 0x5c, 0x4e, 0x16, 0x30, 0x03, 0x2c, 0x49, 0xb6, 0xdb, 0xb2, 0xf9, 0x81, 0x28,
+		0x6a, 0x1d, 0x41, 0x5b, 0xa9, 0x6a, 0xab, 0xed, 0x06, 0x82, 0x76, 0x2d, 0x2e, 0x8b, 0x1c, 0x2f,
+		0x15, 0x95, 0x2a, 0x77, 0x62, 0x0f, 0x61, 0xea, 0xcb, 0x58, 0x9e, 0x71, 0x02, 0x4f, 0xd0, 0x37,
+		0xe8, 0xc3, 0xf4, 0x1d, 0xfa, 0x4e, 0xd5, 0x8c, 0x9d, 0x90, 0x8b, 0x41, 0xdd, 0x1f, 0xfb, 0x2f,
+		0x73, 0xce, 0x7c, 0xf3, 0x9d, 0xef, 0xdc, 0x62, 0x68, 0x26, 0x3d, 0x12, 0xb7, 0x1c, 0xec, 0x92,
+		0xd0, 0x21, 0x2d, 0x1c, 0xd1, 0xd6, 0xe0, 0xa0, 0x25, 0x30, 0xf7, 0x7c, 0xca, 0x85, 0x1e, 0xc5,
+		0x4c, 0x30, 0xb4, 0x21, 0xef, 0xe8, 0xd9, 0x1d, 0x1d, 0x47, 0x54, 0x1f, 0x1c, 0x34, 0xbe, 0xe8,
+		0x33, 0xd6, 0xf7, 0x49, 0x4b, 0x5d, 0xe9, 0x25, 0x37, 0x2d, 0x37, 0x89, 0xb1, 0xa0, 0x2c, 0x4c,
+		0x41, 0x8d, 0x2f, 0x67, 0xfd, 0x82, 0x06, 0x84, 0x0b, 0x1c, 0x44, 0xd9, 0x85, 0xb9, 0x07, 0x86,
+		0x31, 0x8e, 0x22, 0x12, 0xf3, 0xd4, 0xdf, 0xfc, 0x00, 0x4b, 0x16, 0xe6, 0xde, 0x19, 0xe5, 0x02,
+		0x21, 0x28, 0x87, 0x38, 0x20, 0xb5, 0xc2, 0x4e, 0x61, 0x6f, 0xd9, 0x54, 0xbf, 0xd1, 0xf7, 0x50,
+		0xf6, 0x68, 0xe8, 0xd6, 0x8a, 0x3b, 0x85, 0xbd, 0xd5, 0xc3, 0x57, 0x7a, 0x4e, 0x90, 0xfa, 0xe8,
+		0x81, 0x53, 0x1a, 0xba, 0xa6, 0xba, 0xde, 0xc4, 0xa0, 0x8d, 0xac, 0xe7, 0x44, 0x60, 0x17, 0x0b,
+		0x8c, 0xce, 0x61, 0x33, 0xc0, 0x77, 0xb6, 0x94, 0xcd, 0xed, 0x88, 0xc4, 0x36, 0x27, 0x0e, 0x0b,
+		0x5d, 0x45, 0x57, 0x3d, 0xfc, 0x5c, 0x4f, 0x23, 0xd5, 0x47, 0x91, 0xea, 0x1d, 0x96, 0xf4, 0x7c,
+		0x72, 0x85, 0xfd, 0x84, 0x98, 0xeb, 0x01, 0xbe, 0x93, 0x0f, 0xf2, 0x4b, 0x12, 0x77, 0x15, 0xac,
+		0xf9, 0x01, 0xea, 0x23, 0x8a, 0x4b, 0x1c, 0x0b, 0x2a, 0xb3, 0x32, 0xe6, 0xd2, 0xa0, 0xe4, 0x91,
+		0xfb, 0x4c, 0x89, 0xfc, 0x89, 0x76, 0x61, 0x8d, 0x0d, 0x43, 0x12, 0xdb, 0xb7, 0x8c, 0x0b, 0x5b,
+		0xe9, 0x2c, 0x2a, 0xef, 0x8a, 0x32, 0xbf, 0x63, 0x5c, 0x5c, 0xe0, 0x80, 0x34, 0x3d, 0xd8, 0x32,
+		0x38, 0xf3, 0x55, 0x92, 0xdf, 0xc6, 0x2c, 0x89, 0xce, 0x89, 0x88, 0xa9, 0xc3, 0x51, 0x0b, 0x36,
+		0x43, 0x32, 0xcc, 0x0f, 0xbf, 0x60, 0xae, 0x87, 0x64, 0x38, 0x1d, 0x20, 0x7a, 0x05, 0xcf, 0x22,
+		0xe6, 0xfb, 0x24, 0xb6, 0x1d, 0x96, 0x84, 0x42, 0xd1, 0x95, 0xcc, 0x6a, 0x6a, 0x3b, 0x96, 0xa6,
+		0xe6, 0x5f, 0x65, 0x58, 0x1d, 0x89, 0xe8, 0x0a, 0x2c, 0x12, 0x8e, 0xbe, 0x06, 0xd4, 0xc3, 0x8e,
+		0xe7, 0xb3, 0x7e, 0x0a, 0xb3, 0x6f, 0x69, 0x28, 0x14, 0x49, 0xc9, 0xd4, 0x32, 0x8f, 0x02, 0xbf,
+		0xa3, 0xa1, 0x40, 0x2f, 0x01, 0x62, 0x82, 0x5d, 0xdb, 0x27, 0x03, 0xe2, 0x67, 0x0c, 0xcb, 0xd2,
+		0x72, 0x26, 0x0d, 0xe8, 0x05, 0x2c, 0x63, 0xc7, 0xcb, 0xbc, 0x25, 0xe5, 0x5d, 0xc2, 0x8e, 0x97,
+		0x3a, 0x77, 0x61, 0x2d, 0xc6, 0x82, 0x4c, 0x6a, 0x29, 0x2b, 0x2d, 0x2b, 0xd2, 0xfc, 0xa0, 0xa3,
+		0x03, 0x2b, 0x52, 0xb4, 0x4d, 0x5d, 0xbb, 0xe7, 0x33, 0xc7, 0xab, 0x55, 0x54, 0xc1, 0x76, 0x1e,
+		0xed, 0x05, 0xa3, 0x73, 0x24, 0xef, 0x99, 0x55, 0x09, 0x33, 0x5c, 0x75, 0x40, 0x03, 0xd8, 0xa6,
+		0xa3, 0xbc, 0xda, 0x7d, 0x99, 0x58, 0x3b, 0x48, 0x33, 0x5b, 0x5b, 0xd8, 0x29, 0xed, 0x55, 0x0f,
+		0x5f, 0x3f, 0xd9, 0x5b, 0x69, 0x76, 0xf4, 0xdc, 0xd2, 0x9c, 0x84, 0x22, 0xbe, 0x37, 0xb7, 0xe8,
+		0x47, 0x95, 0x6d, 0xf1, 0x91, 0xb2, 0x35, 0x04, 0x34, 0x1e, 0x67, 0xc9, 0x69, 0xac, 0x37, 0x50,
+		0x19, 0xc8, 0x1e, 0x55, 0xd9, 0xaf, 0x1e, 0xee, 0xe7, 0xca, 0xc8, 0x7d, 0xd1, 0x4c, 0x81, 0x3f,
+		0x16, 0x7f, 0x28, 0x34, 0x7f, 0x81, 0xea, 0x44, 0xea, 0x50, 0x1d, 0x96, 0xb8, 0xc0, 0xb1, 0xb0,
+		0xa9, 0x9b, 0xd5, 0x7e, 0x51, 0x9d, 0x0d, 0x17, 0x6d, 0xc1, 0x02, 0x09, 0x5d, 0xe9, 0x48, 0xcb,
+		0x5d, 0x21, 0xa1, 0x6b, 0xb8, 0xcd, 0xbf, 0x0b, 0x00, 0x97, 0xaa, 0xb5, 0x8c, 0xf0, 0x86, 0xa1,
+		0x0e, 0x68, 0x3e, 0xe6, 0xc2, 0xc6, 0x8e, 0x43, 0x38, 0xb7, 0xe5, 0x5a, 0xc8, 0x06, 0xad, 0x31,
+		0x37, 0x68, 0xd6, 0x68, 0x67, 0x98, 0xab, 0x12, 0xd3, 0x56, 0x10, 0x69, 0x44, 0x0d, 0x58, 0xa2,
+		0x2e, 0x09, 0x05, 0x15, 0xf7, 0xd9, 0xb4, 0x8c, 0xcf, 0x79, 0xed, 0x53, 0xca, 0x69, 0x9f, 0xe6,
+		0x3f, 0x05, 0xa8, 0x77, 0x05, 0x75, 0xbc, 0xfb, 0x93, 0x3b, 0xe2, 0x24, 0x32, 0x09, 0x6d, 0x21,
+		0x62, 0xda, 0x4b, 0x04, 0xe1, 0xe8, 0x2d, 0x68, 0x43, 0x16, 0x7b, 0x24, 0x56, 0x15, 0xb2, 0xe5,
+		0x3e, 0xcc, 0xe2, 0x7c, 0xf9, 0x64, 0x3f, 0x98, 0xab, 0x29, 0x6c, 0xbc, 0xbc, 0x2c, 0xa8, 0x73,
+		0xe7, 0x96, 0xb8, 0x89, 0x4f, 0x6c, 0xc1, 0xec, 0x34, 0x7b, 0x52, 0x36, 0x4b, 0x44, 0x56, 0x9a,
+		0xfa, 0xfc, 0x8a, 0xc9, 0xb6, 0xa9, 0xf9, 0x7c, 0x84, 0xb5, 0x58, 0x57, 0x22, 0xad, 0x14, 0xd8,
+		0x7c, 0x0d, 0xeb, 0x73, 0x4b, 0x06, 0x7d, 0x05, 0xda, 0x4c, 0x2b, 0xf3, 0x5a, 0x61, 0xa7, 0xb4,
+		0xb7, 0x6c, 0xae, 0x4d, 0xf7, 0x20, 0x6f, 0xfe, 0x5b, 0x86, 0xed, 0xb9, 0x07, 0x8e, 0x59, 0x78,
+		0x43, 0xfb, 0xa8, 0x06, 0x8b, 0x03, 0x12, 0x73, 0xca, 0xc2, 0x51, 0x89, 0xb3, 0x23, 0x3a, 0x84,
+		0x8d, 0x30, 0x09, 0x6c, 0x35, 0xd9, 0xd1, 0x08, 0xc5, 0x95, 0x8a, 0xca, 0x51, 0xb1, 0x26, 0xdb,
+		0x36, 0x09, 0x4c, 0x82, 0xdd, 0xf1, 0x93, 0x1c, 0x7d, 0x07, 0x9b, 0x12, 0x33, 0x8c, 0xa9, 0xac,
+		0xc9, 0x03, 0xa8, 0x34, 0x06, 0xa1, 0x30, 0x09, 0x7e, 0x95, 0xee, 0x09, 0x14, 0x85, 0xb5, 0x59,
+		0x96, 0xb2, 0x9a, 0xc6, 0x37, 0x4f, 0x66, 0x7f, 0x46, 0x8a, 0x3e, 0x1d, 0x4b, 0x3a, 0x8f, 0xab,
+		0xf1, 0x74, 0x80, 0x3e, 0x68, 0x73, 0xc1, 0x55, 0x14, 0x57, 0xfb, 0xa3, 0xb8, 0x66, 0x24, 0xa4,
+		0x64, 0x6b, 0xc3, 0x69, 0x6b, 0x83, 0xc2, 0x46, 0x4e, 0x50, 0x93, 0xe3, 0x5b, 0x49, 0xc7, 0xf7,
+		0xe7, 0xe9, 0xf1, 0xdd, 0xfd, 0x7f, 0xb1, 0x4c, 0x8c, 0x6e, 0xe3, 0x4f, 0xd8, 0xcc, 0x8b, 0xe9,
+		0x53, 0x70, 0xed, 0xff, 0x01, 0xcf, 0x26, 0xff, 0x6d, 0x51, 0x03, 0x9e, 0x5b, 0xed, 0xee, 0xa9,
+		0x7d, 0x66, 0x74, 0x2d, 0xfb, 0xd4, 0xb8, 0xe8, 0xd8, 0xc6, 0xc5, 0x55, 0xfb, 0xcc, 0xe8, 0x68,
+		0x9f, 0xa1, 0x3a, 0x6c, 0xcd, 0xf8, 0x2e, 0xde, 0x9b, 0xe7, 0xed, 0x33, 0xad, 0x90, 0xe3, 0xea,
+		0x5a, 0xc6, 0xf1, 0xe9, 0xb5, 0x56, 0xdc, 0x77, 0x1f, 0x18, 0xac, 0xfb, 0x88, 0x4c, 0x33, 0x58,
+		0xd7, 0x97, 0x27, 0x13, 0x0c, 0x2f, 0x60, 0x7b, 0xc6, 0xd7, 0x39, 0x39, 0x36, 0xba, 0xc6, 0xfb,
+		0x0b, 0xad, 0x90, 0xe3, 0x6c, 0x1f, 0x5b, 0xc6, 0x95, 0x61, 0x5d, 0x6b, 0xc5, 0xa3, 0xdf, 0x61,
+		0xdb, 0x61, 0x41, 0x9e, 0xfe, 0xa3, 0x95, 0x71, 0x02, 0xe4, 0x94, 0x5e, 0x16, 0x7e, 0x3b, 0xe8,
+		0x53, 0x71, 0x9b, 0xf4, 0x74, 0x87, 0x05, 0xad, 0xc9, 0xef, 0xa8, 0x6f, 0xa8, 0xeb, 0xb7, 0xfa,
+		0x2c, 0xfd, 0xb4, 0xc9, 0x3e, 0xaa, 0x7e, 0xc2, 0x11, 0x1d, 0x1c, 0xf4, 0x16, 0x94, 0xed, 0xdb,
+		0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xf6, 0xce, 0x1e, 0x78, 0x09, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/visibility.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+		0x14, 0x85, 0x71, 0x69, 0x23, 0x71, 0x53, 0xa8, 0x35, 0x08, 0x02, 0xae, 0x20, 0xc8, 0x62, 0x51,
+		0x21, 0x31, 0x96, 0xcb, 0xb2, 0x0b, 0x94, 0x60, 0x83, 0x46, 0x84, 0x24, 0x38, 0x6e, 0x4a, 0xd8,
+		0x58, 0x63, 0x7b, 0x1a, 0x46, 0x8c, 0x3d, 0x96, 0x3d, 0x4e, 0xdb, 0xa7, 0xe0, 0x3d, 0x79, 0x0a,
+		0xe4, 0xbf, 0x0a, 0x09, 0x57, 0xec, 0xec, 0x73, 0xcf, 0xf9, 0x34, 0xf7, 0x07, 0x5e, 0x97, 0x21,
+		0xcb, 0xad, 0x88, 0xc6, 0x2c, 0x8d, 0x98, 0x45, 0x33, 0x6e, 0xed, 0x6c, 0x6b, 0xc7, 0x0b, 0x1e,
+		0x72, 0xc1, 0xd5, 0x0d, 0xce, 0x72, 0xa9, 0x24, 0x7a, 0x5c, 0xb9, 0x70, 0xeb, 0xc2, 0x34, 0xe3,
+		0x78, 0x67, 0x1b, 0xe3, 0xad, 0x94, 0x5b, 0xc1, 0xac, 0xda, 0x12, 0x96, 0x97, 0x96, 0xe2, 0x09,
+		0x2b, 0x14, 0x4d, 0xb2, 0x26, 0x65, 0x98, 0x7d, 0xec, 0x2b, 0x99, 0xff, 0xbc, 0x14, 0xf2, 0xaa,
+		0xf1, 0x98, 0x5f, 0x61, 0x74, 0xd1, 0x2a, 0xee, 0x35, 0x8b, 0x4a, 0xc5, 0x65, 0xfa, 0x91, 0x0b,
+		0xc5, 0x72, 0x34, 0x86, 0x61, 0x67, 0x0e, 0x78, 0xfc, 0x4c, 0x7b, 0xa5, 0x9d, 0x3c, 0xf0, 0xa0,
+		0x93, 0x48, 0x8c, 0x9e, 0xc0, 0x20, 0x2f, 0xd3, 0xaa, 0xb6, 0x57, 0xd7, 0x0e, 0xf2, 0x32, 0x25,
+		0xb1, 0x79, 0x02, 0xa8, 0x43, 0xfa, 0x37, 0x19, 0x6b, 0x69, 0x08, 0xf6, 0x53, 0x9a, 0xb0, 0x16,
+		0x53, 0x7f, 0x9b, 0xbf, 0x34, 0x38, 0x5a, 0x29, 0x9a, 0x2b, 0x9f, 0x27, 0x9d, 0xef, 0x3d, 0x3c,
+		0x64, 0x34, 0x17, 0x9c, 0x15, 0x2a, 0xa8, 0x1a, 0xaa, 0x03, 0xc3, 0x53, 0x03, 0x37, 0xdd, 0xe2,
+		0xae, 0x5b, 0xec, 0x77, 0xdd, 0x7a, 0x87, 0x5d, 0xa0, 0x92, 0xd0, 0x19, 0x0c, 0x05, 0x55, 0xb7,
+		0xf1, 0xbd, 0xff, 0xc6, 0xa1, 0xb1, 0x57, 0x82, 0xb9, 0x81, 0xc3, 0x95, 0xa2, 0xaa, 0x2c, 0xda,
+		0xd7, 0x10, 0x18, 0x14, 0xf5, 0x7f, 0xfd, 0x8c, 0x47, 0xa7, 0x36, 0xee, 0xd9, 0x04, 0xfe, 0x67,
+		0x82, 0x1f, 0x84, 0x2c, 0x58, 0x03, 0xf2, 0x5a, 0xc0, 0x9b, 0xdf, 0x1a, 0xe8, 0x24, 0x8d, 0xd9,
+		0x35, 0x8b, 0xd7, 0x54, 0x94, 0xac, 0x9a, 0x0d, 0x7a, 0x09, 0x06, 0x99, 0x3b, 0xee, 0x37, 0xd7,
+		0x09, 0xd6, 0x93, 0xd9, 0xb9, 0x1b, 0xf8, 0x9b, 0xa5, 0x1b, 0x90, 0xf9, 0x7a, 0x32, 0x23, 0x8e,
+		0x7e, 0x0f, 0xbd, 0x80, 0xe7, 0x3d, 0xf5, 0x95, 0xef, 0x91, 0xf9, 0x27, 0x5d, 0xbb, 0x23, 0xfe,
+		0xd9, 0xdd, 0x5c, 0x2c, 0x3c, 0x47, 0xdf, 0x43, 0x06, 0x3c, 0xed, 0xc5, 0xfb, 0xfa, 0xfd, 0x3b,
+		0xd0, 0xce, 0xe2, 0x7c, 0x3a, 0x73, 0xf5, 0x7d, 0x74, 0x0c, 0xa3, 0x9e, 0xf2, 0x74, 0xb1, 0x98,
+		0xe9, 0x07, 0x68, 0x0c, 0xc7, 0x7d, 0xd9, 0x89, 0xef, 0xfa, 0xe4, 0x8b, 0xab, 0x0f, 0xa6, 0x01,
+		0x8c, 0x22, 0x99, 0xf4, 0x0d, 0x6b, 0x7a, 0xb4, 0xbe, 0xbd, 0xee, 0x65, 0xb5, 0x8c, 0xa5, 0xf6,
+		0xdd, 0xde, 0x72, 0xf5, 0xa3, 0x0c, 0x71, 0x24, 0x13, 0xeb, 0xef, 0x9b, 0x7d, 0xcb, 0x63, 0x61,
+		0x6d, 0x65, 0x73, 0xe1, 0xed, 0x01, 0x9f, 0xd1, 0x8c, 0xef, 0xec, 0x70, 0x50, 0x6b, 0xef, 0xfe,
+		0x04, 0x00, 0x00, 0xff, 0xff, 0x46, 0x40, 0x75, 0x5d, 0x40, 0x03, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x73, 0xdb, 0xc8,
+		0xd1, 0x7e, 0x41, 0x4a, 0xb2, 0xd4, 0xd4, 0x07, 0x34, 0x92, 0x2c, 0x5a, 0xde, 0xb5, 0x65, 0xee,
+		0xda, 0x2b, 0xf3, 0x5d, 0x49, 0x2b, 0xef, 0x87, 0xd7, 0x56, 0x1c, 0x07, 0x02, 0x21, 0x0b, 0x36,
+		0x05, 0x32, 0x43, 0xd0, 0xb2, 0xb6, 0x92, 0xa0, 0x20, 0x72, 0x24, 0x21, 0x26, 0x01, 0x16, 0x30,
+		0xb4, 0xad, 0x7b, 0xaa, 0x72, 0x4e, 0x4e, 0xa9, 0x9c, 0xf2, 0x03, 0x92, 0x4a, 0xa5, 0x72, 0xc8,
+		0x29, 0x95, 0x5f, 0x90, 0x6b, 0xfe, 0x42, 0x2a, 0xff, 0x22, 0x35, 0x83, 0x01, 0x09, 0x7e, 0x82,
+		0x4a, 0xaa, 0x36, 0x37, 0xa1, 0xe7, 0x79, 0x1a, 0x3d, 0x3d, 0xdd, 0x4f, 0x0f, 0x21, 0xc8, 0xb5,
+		0xcf, 0x88, 0xbf, 0x5b, 0xb3, 0xeb, 0xc4, 0xad, 0x91, 0x5d, 0xbb, 0xe5, 0xec, 0xbe, 0xdb, 0xdb,
+		0x7d, 0xef, 0xf9, 0x6f, 0xcf, 0x1b, 0xde, 0xfb, 0x9d, 0x96, 0xef, 0x51, 0x0f, 0xad, 0x30, 0xcc,
+		0x8e, 0xc0, 0xec, 0xd8, 0x2d, 0x67, 0xe7, 0xdd, 0xde, 0xc6, 0x9d, 0x0b, 0xcf, 0xbb, 0x68, 0x90,
+		0x5d, 0x0e, 0x39, 0x6b, 0x9f, 0xef, 0xd6, 0xdb, 0xbe, 0x4d, 0x1d, 0xcf, 0x0d, 0x49, 0x1b, 0x77,
+		0xfb, 0xd7, 0xa9, 0xd3, 0x24, 0x01, 0xb5, 0x9b, 0x2d, 0x01, 0xd8, 0x1c, 0xf6, 0xe6, 0x9a, 0xd7,
+		0x6c, 0x76, 0x5c, 0x0c, 0x8d, 0x8d, 0xda, 0xc1, 0xdb, 0x86, 0x13, 0xd0, 0x10, 0x93, 0xfb, 0xc3,
+		0x2c, 0xac, 0x9d, 0x88, 0x70, 0xb5, 0x0f, 0xa4, 0xd6, 0x66, 0x21, 0xe8, 0xee, 0xb9, 0x87, 0xaa,
+		0x80, 0xa2, 0x7d, 0x58, 0x24, 0x5a, 0xc9, 0x4a, 0x9b, 0xd2, 0x56, 0xe6, 0xd1, 0x83, 0x9d, 0x21,
+		0x5b, 0xda, 0x19, 0xf0, 0x83, 0x97, 0xdf, 0xf7, 0x9b, 0xd0, 0xd7, 0x30, 0x45, 0xaf, 0x5a, 0x24,
+		0x9b, 0xe2, 0x8e, 0xee, 0x8d, 0x75, 0x64, 0x5e, 0xb5, 0x08, 0xe6, 0x70, 0xf4, 0x04, 0x20, 0xa0,
+		0xb6, 0x4f, 0x2d, 0x96, 0x86, 0x6c, 0x9a, 0x93, 0x37, 0x76, 0xc2, 0x1c, 0xed, 0x44, 0x39, 0xda,
+		0x31, 0xa3, 0x1c, 0xe1, 0x39, 0x8e, 0x66, 0xcf, 0x8c, 0x5a, 0x6b, 0x78, 0x01, 0x09, 0xa9, 0x53,
+		0xc9, 0x54, 0x8e, 0xe6, 0x54, 0x13, 0xe6, 0x43, 0x6a, 0x40, 0x6d, 0xda, 0x0e, 0xb2, 0xd3, 0x9b,
+		0xd2, 0xd6, 0xe2, 0xa3, 0xbd, 0xc9, 0x76, 0xaf, 0x32, 0x66, 0x85, 0x13, 0x71, 0xa6, 0xd6, 0x7d,
+		0x40, 0xf7, 0x61, 0xf1, 0xd2, 0x09, 0xa8, 0xe7, 0x5f, 0x59, 0x0d, 0xe2, 0x5e, 0xd0, 0xcb, 0xec,
+		0xcc, 0xa6, 0xb4, 0x95, 0xc6, 0x0b, 0xc2, 0x5a, 0xe4, 0x46, 0xf4, 0x13, 0x58, 0x6b, 0xd9, 0x3e,
+		0x71, 0x69, 0x37, 0xfd, 0x96, 0xe3, 0x9e, 0x7b, 0xd9, 0x1b, 0x7c, 0x0b, 0x5b, 0x43, 0xa3, 0x28,
+		0x73, 0x46, 0xcf, 0x49, 0xe2, 0x95, 0xd6, 0xa0, 0x11, 0x29, 0xb0, 0xd8, 0x75, 0xcb, 0x33, 0x33,
+		0x9b, 0x98, 0x99, 0x85, 0x0e, 0x83, 0x67, 0x67, 0x1b, 0xa6, 0x9a, 0xa4, 0xe9, 0x65, 0xe7, 0x38,
+		0xf1, 0xd6, 0xd0, 0x78, 0x8e, 0x49, 0xd3, 0xc3, 0x1c, 0x86, 0x30, 0x2c, 0x07, 0xc4, 0xf6, 0x6b,
+		0x97, 0x96, 0x4d, 0xa9, 0xef, 0x9c, 0xb5, 0x29, 0x09, 0xb2, 0xc0, 0xb9, 0xf7, 0x87, 0x72, 0x2b,
+		0x1c, 0xad, 0x74, 0xc0, 0x58, 0x0e, 0xfa, 0x2c, 0xa8, 0x08, 0xcb, 0x76, 0x9b, 0x7a, 0x96, 0x4f,
+		0x02, 0x42, 0xad, 0x96, 0xe7, 0xb8, 0x34, 0xc8, 0x66, 0xb8, 0xcf, 0xcd, 0xa1, 0x3e, 0x31, 0x03,
+		0x96, 0x39, 0x0e, 0x2f, 0x31, 0x6a, 0xcc, 0x80, 0x6e, 0xc3, 0x1c, 0x6b, 0x0f, 0x8b, 0xf5, 0x47,
+		0x76, 0x7e, 0x53, 0xda, 0x9a, 0xc3, 0xb3, 0xcc, 0x50, 0x74, 0x02, 0x8a, 0xd6, 0xe1, 0x86, 0x13,
+		0x58, 0x35, 0xdf, 0x73, 0xb3, 0x0b, 0x9b, 0xd2, 0xd6, 0x2c, 0x9e, 0x71, 0x02, 0xd5, 0xf7, 0x5c,
+		0xb4, 0x0f, 0x99, 0x76, 0xab, 0x6e, 0x53, 0x51, 0x60, 0x8b, 0x89, 0x69, 0x84, 0x10, 0xce, 0x73,
+		0xf8, 0x73, 0x90, 0x5b, 0xb6, 0x4f, 0x1d, 0x7e, 0x0c, 0x35, 0xcf, 0x3d, 0x77, 0x2e, 0xb2, 0x4b,
+		0x9b, 0xe9, 0xad, 0xcc, 0xa3, 0xe7, 0x93, 0x55, 0x19, 0x3b, 0x4c, 0x76, 0xea, 0xa1, 0x0b, 0x95,
+		0x7b, 0xd0, 0x5c, 0xea, 0x5f, 0xe1, 0xa5, 0x56, 0xaf, 0x75, 0xe3, 0x00, 0x56, 0x87, 0x01, 0x91,
+		0x0c, 0xe9, 0xb7, 0xe4, 0x8a, 0xb7, 0xf6, 0x1c, 0x66, 0x7f, 0xa2, 0x55, 0x98, 0x7e, 0x67, 0x37,
+		0xda, 0x61, 0x97, 0xce, 0xe1, 0xf0, 0xe1, 0x69, 0xea, 0x5b, 0x29, 0xf7, 0x9b, 0x14, 0xdc, 0x19,
+		0xac, 0x74, 0xee, 0x4c, 0xe8, 0x17, 0x7a, 0x1a, 0xcf, 0x62, 0xa8, 0x17, 0x1f, 0x0f, 0xdd, 0x8b,
+		0x29, 0x52, 0x1b, 0x4b, 0xb2, 0x0d, 0x9b, 0xdd, 0xaa, 0x14, 0x0d, 0xef, 0x59, 0xdd, 0xf6, 0xf5,
+		0xda, 0x54, 0x28, 0xc7, 0xad, 0x81, 0x04, 0x17, 0x44, 0x00, 0xf8, 0xa3, 0x8e, 0x8b, 0x0a, 0x17,
+		0x01, 0x4f, 0x8d, 0x1a, 0xda, 0x6b, 0x53, 0x74, 0x02, 0xb7, 0x79, 0x78, 0x23, 0xbc, 0xa7, 0x93,
+		0xbc, 0xaf, 0x33, 0xf6, 0x10, 0xc7, 0xb9, 0xbf, 0x4b, 0xb0, 0x32, 0xa4, 0xfd, 0x58, 0x55, 0xd5,
+		0xbd, 0xa6, 0xed, 0xb8, 0x96, 0x53, 0x17, 0x49, 0x9e, 0x0d, 0x0d, 0x7a, 0x1d, 0xdd, 0x85, 0x8c,
+		0x58, 0x74, 0xed, 0x66, 0x94, 0x6f, 0x08, 0x4d, 0x86, 0xdd, 0x24, 0x23, 0x64, 0x38, 0xfd, 0xdf,
+		0xca, 0xf0, 0x3d, 0x98, 0x77, 0x5c, 0x87, 0x3a, 0x36, 0x25, 0x75, 0x16, 0xd7, 0x14, 0x57, 0xa0,
+		0x4c, 0xc7, 0xa6, 0xd7, 0x73, 0xbf, 0x92, 0x60, 0x4d, 0xfb, 0x40, 0x89, 0xef, 0xda, 0x8d, 0xef,
+		0x65, 0x34, 0xf4, 0xc7, 0x94, 0x1a, 0x8c, 0xe9, 0x2f, 0x33, 0xb0, 0x52, 0x26, 0x6e, 0xdd, 0x71,
+		0x2f, 0x94, 0x1a, 0x75, 0xde, 0x39, 0xf4, 0x8a, 0x47, 0x74, 0x17, 0x32, 0xb6, 0x78, 0xee, 0x66,
+		0x19, 0x22, 0x93, 0x5e, 0x47, 0x87, 0xb0, 0xd0, 0x01, 0x24, 0xce, 0x9f, 0xc8, 0x35, 0x9f, 0x3f,
+		0xf3, 0x76, 0xec, 0x09, 0x3d, 0x87, 0x69, 0x36, 0x0b, 0xc2, 0x11, 0xb4, 0xf8, 0xe8, 0xe1, 0x70,
+		0x11, 0xee, 0x8d, 0x90, 0xc9, 0x3e, 0xc1, 0x21, 0x0f, 0xe9, 0xb0, 0x7c, 0x49, 0x6c, 0x9f, 0x9e,
+		0x11, 0x9b, 0x5a, 0x75, 0x42, 0x6d, 0xa7, 0x11, 0x88, 0xa1, 0xf4, 0xd1, 0x08, 0x45, 0xbf, 0x6a,
+		0x78, 0x76, 0x1d, 0xcb, 0x1d, 0x5a, 0x21, 0x64, 0xa1, 0x97, 0xb0, 0xd2, 0xb0, 0x03, 0x6a, 0x75,
+		0xfd, 0x71, 0x01, 0x9a, 0x4e, 0x14, 0xa0, 0x65, 0x46, 0x3b, 0x8a, 0x58, 0x5c, 0x87, 0x0e, 0x81,
+		0x1b, 0xc3, 0xae, 0x20, 0xf5, 0xd0, 0xd3, 0x4c, 0xa2, 0xa7, 0x25, 0x46, 0xaa, 0x84, 0x1c, 0xee,
+		0x27, 0x0b, 0x37, 0x6c, 0x4a, 0x49, 0xb3, 0x45, 0xf9, 0x98, 0x9a, 0xc6, 0xd1, 0x23, 0x7a, 0x08,
+		0x72, 0xd3, 0xfe, 0xe0, 0x34, 0xdb, 0x4d, 0x4b, 0x98, 0x02, 0x3e, 0x72, 0xa6, 0xf1, 0x92, 0xb0,
+		0x2b, 0xc2, 0xcc, 0x66, 0x53, 0x50, 0xbb, 0x24, 0xf5, 0x76, 0x23, 0x8a, 0x64, 0x2e, 0x79, 0x36,
+		0x75, 0x18, 0x3c, 0x0e, 0x15, 0x96, 0xc8, 0x87, 0x96, 0x13, 0xf6, 0x6c, 0xe8, 0x03, 0x12, 0x7d,
+		0x2c, 0x76, 0x29, 0xdc, 0xc9, 0x73, 0x98, 0xe7, 0x49, 0x39, 0xb7, 0x9d, 0x46, 0xdb, 0x27, 0x62,
+		0xb0, 0x0c, 0x3f, 0xa6, 0xc3, 0x10, 0x83, 0x33, 0x8c, 0x21, 0x1e, 0xd0, 0x17, 0xb0, 0xca, 0x1d,
+		0xb0, 0x5a, 0x27, 0xbe, 0xe5, 0xd4, 0x89, 0x4b, 0x1d, 0x7a, 0x25, 0x66, 0x0b, 0x62, 0x6b, 0x27,
+		0x7c, 0x49, 0x17, 0x2b, 0xe8, 0x1b, 0x58, 0x8f, 0x8e, 0xa0, 0x9f, 0xb4, 0xc0, 0x49, 0x6b, 0x62,
+		0xb9, 0x8f, 0x77, 0x17, 0x32, 0x51, 0x02, 0x58, 0x03, 0x2c, 0xf2, 0xd6, 0x81, 0xc8, 0xa4, 0xd7,
+		0x73, 0x7f, 0x4e, 0xc1, 0x2d, 0x51, 0x97, 0xea, 0xa5, 0xd3, 0xa8, 0x7f, 0x2f, 0x1d, 0xfd, 0x79,
+		0xcc, 0x2d, 0xeb, 0xba, 0xb8, 0xc8, 0xc9, 0xef, 0x63, 0xb7, 0x3c, 0x2e, 0x75, 0xfd, 0xfd, 0x9f,
+		0x1e, 0xe8, 0x7f, 0xf4, 0x1a, 0xc4, 0x65, 0x46, 0xa8, 0x76, 0xcb, 0x6b, 0x38, 0xb5, 0x2b, 0xde,
+		0x3f, 0x8b, 0x23, 0x02, 0x0d, 0x25, 0x99, 0x2b, 0x75, 0x99, 0xa3, 0xf1, 0x72, 0xab, 0xdf, 0x84,
+		0x6e, 0xc2, 0x4c, 0xa8, 0xb9, 0xbc, 0x7b, 0xe6, 0xb0, 0x78, 0xca, 0xfd, 0x33, 0xd5, 0xd1, 0x9b,
+		0x02, 0xa9, 0x39, 0x41, 0x94, 0xaf, 0x8e, 0x0c, 0x48, 0xc9, 0x32, 0x10, 0x11, 0x7b, 0x64, 0x60,
+		0xb0, 0xc4, 0x53, 0xd7, 0x2d, 0xf1, 0x67, 0x30, 0xdf, 0xd3, 0xad, 0xc9, 0x97, 0xe2, 0x4c, 0x30,
+		0xbc, 0x53, 0xa7, 0x7a, 0x3b, 0x15, 0xc3, 0xba, 0xe7, 0x3b, 0x17, 0x8e, 0x6b, 0x37, 0xac, 0xbe,
+		0x20, 0x93, 0xb5, 0x65, 0x2d, 0xa2, 0x56, 0x7a, 0x82, 0xed, 0xab, 0xcf, 0x99, 0x81, 0xfa, 0xfc,
+		0x6b, 0x0a, 0x6e, 0x45, 0x82, 0x59, 0xf4, 0x6a, 0x76, 0xa3, 0xe0, 0x04, 0x2d, 0x9b, 0xd6, 0x2e,
+		0x27, 0xd3, 0xf7, 0xff, 0x7d, 0x3e, 0x7f, 0x06, 0x77, 0x7a, 0x23, 0xb0, 0xbc, 0x73, 0x8b, 0x5e,
+		0x3a, 0x81, 0x15, 0x4f, 0xf3, 0x78, 0x87, 0x1b, 0x3d, 0x11, 0x95, 0xce, 0xcd, 0x4b, 0x27, 0x10,
+		0xaa, 0x88, 0x3e, 0x06, 0xe0, 0xf7, 0x16, 0xea, 0xbd, 0x25, 0x61, 0x99, 0xce, 0x63, 0x7e, 0xd1,
+		0x32, 0x99, 0x21, 0xf7, 0x12, 0x32, 0xf1, 0xab, 0xec, 0x3e, 0xcc, 0x88, 0xdb, 0xb0, 0xc4, 0x6f,
+		0x93, 0x9f, 0x24, 0xdc, 0x86, 0xf9, 0x0f, 0x05, 0x41, 0xc9, 0xfd, 0x31, 0x05, 0x8b, 0xbd, 0x4b,
+		0xe8, 0x33, 0x58, 0x3a, 0x73, 0x5c, 0xdb, 0xbf, 0xb2, 0x6a, 0x97, 0xa4, 0xf6, 0x36, 0x68, 0x37,
+		0xc5, 0x21, 0x2c, 0x86, 0x66, 0x55, 0x58, 0xd1, 0x1a, 0xcc, 0xf8, 0x6d, 0x37, 0x1a, 0xdf, 0x73,
+		0x78, 0xda, 0x6f, 0xb3, 0x7b, 0xce, 0x33, 0xb8, 0x7d, 0xee, 0xf8, 0x01, 0x1b, 0x79, 0x61, 0x37,
+		0x58, 0x35, 0xaf, 0xd9, 0x6a, 0x90, 0x9e, 0x56, 0xcf, 0x72, 0x48, 0xd4, 0x2f, 0x6a, 0x04, 0xe0,
+		0xf4, 0xf9, 0x9a, 0x4f, 0xec, 0xce, 0xd9, 0x24, 0xa7, 0x32, 0x23, 0xf0, 0x42, 0xc8, 0x17, 0xb8,
+		0xb4, 0x3b, 0xee, 0xc5, 0xa4, 0x75, 0x3c, 0x1f, 0x11, 0xb8, 0x83, 0x3b, 0x00, 0xfc, 0x27, 0x06,
+		0xb5, 0xcf, 0x1a, 0xe1, 0x5c, 0x9c, 0xc5, 0x31, 0x4b, 0xfe, 0x4f, 0x12, 0xac, 0x0e, 0x9b, 0xfa,
+		0x28, 0x07, 0x77, 0xca, 0x9a, 0x51, 0xd0, 0x8d, 0x17, 0x96, 0xa2, 0x9a, 0xfa, 0x6b, 0xdd, 0x3c,
+		0xb5, 0x2a, 0xa6, 0x62, 0x6a, 0x96, 0x6e, 0xbc, 0x56, 0x8a, 0x7a, 0x41, 0xfe, 0x3f, 0xf4, 0x29,
+		0x6c, 0x8e, 0xc0, 0x54, 0xd4, 0x23, 0xad, 0x50, 0x2d, 0x6a, 0x05, 0x59, 0x1a, 0xe3, 0xa9, 0x62,
+		0x2a, 0xd8, 0xd4, 0x0a, 0x72, 0x0a, 0xfd, 0x3f, 0x7c, 0x36, 0x02, 0xa3, 0x2a, 0x86, 0xaa, 0x15,
+		0x2d, 0xac, 0xfd, 0xb8, 0xaa, 0x55, 0x18, 0x38, 0x9d, 0xff, 0x45, 0x37, 0xe6, 0x1e, 0x89, 0x8a,
+		0xbf, 0xa9, 0xa0, 0xa9, 0x7a, 0x45, 0x2f, 0x19, 0xe3, 0x62, 0xee, 0xc3, 0x8c, 0x88, 0xb9, 0x1f,
+		0x15, 0xc5, 0x9c, 0xff, 0x65, 0xaa, 0xfb, 0x05, 0x42, 0xaf, 0x63, 0xd2, 0xee, 0x88, 0xf2, 0xa7,
+		0xb0, 0x79, 0x52, 0xc2, 0xaf, 0x0e, 0x8b, 0xa5, 0x13, 0x4b, 0x2f, 0x58, 0x58, 0xab, 0x56, 0x34,
+		0xab, 0x5c, 0x2a, 0xea, 0xea, 0x69, 0x2c, 0x92, 0x6f, 0xe1, 0xab, 0x91, 0x28, 0xa5, 0xc8, 0xac,
+		0x85, 0x6a, 0xb9, 0xa8, 0xab, 0xec, 0xad, 0x87, 0x8a, 0x5e, 0xd4, 0x0a, 0x56, 0xc9, 0x28, 0x9e,
+		0xca, 0x12, 0xfa, 0x1c, 0xb6, 0x26, 0x65, 0xca, 0x29, 0xb4, 0x0d, 0x0f, 0x47, 0xa2, 0xb1, 0xf6,
+		0x52, 0x53, 0xcd, 0x18, 0x3c, 0x8d, 0xf6, 0x60, 0x7b, 0x24, 0xdc, 0xd4, 0xf0, 0xb1, 0x6e, 0xf0,
+		0x84, 0x1e, 0x5a, 0xb8, 0x6a, 0x18, 0xba, 0xf1, 0x42, 0x9e, 0xca, 0xff, 0x4e, 0x82, 0xe5, 0x81,
+		0x69, 0x85, 0xee, 0xc2, 0xed, 0xb2, 0x82, 0x35, 0xc3, 0xb4, 0xd4, 0x62, 0x69, 0x58, 0x02, 0x46,
+		0x00, 0x94, 0x03, 0xc5, 0x28, 0x94, 0x0c, 0x59, 0x42, 0x0f, 0x20, 0x37, 0x0c, 0x20, 0x6a, 0x41,
+		0x94, 0x86, 0x9c, 0x42, 0xf7, 0xe0, 0xe3, 0x61, 0xb8, 0x4e, 0xb4, 0x72, 0x3a, 0xff, 0xaf, 0x14,
+		0x7c, 0x34, 0xee, 0x43, 0x07, 0xab, 0xc0, 0xce, 0xb6, 0xb5, 0x37, 0x9a, 0x5a, 0x35, 0xd9, 0x99,
+		0x87, 0xfe, 0xd8, 0xc9, 0x57, 0x2b, 0xb1, 0xc8, 0xe3, 0x29, 0x1d, 0x01, 0x56, 0x4b, 0xc7, 0xe5,
+		0xa2, 0x66, 0xf2, 0x6a, 0xca, 0xc3, 0x83, 0x24, 0x78, 0x78, 0xc0, 0x72, 0xaa, 0xe7, 0x6c, 0x47,
+		0xb9, 0xe6, 0xfb, 0x66, 0xad, 0x80, 0x76, 0x20, 0x9f, 0x84, 0xee, 0x64, 0xa1, 0x20, 0x4f, 0xa1,
+		0xaf, 0xe0, 0x8b, 0xe4, 0xc0, 0x0d, 0x53, 0x37, 0xaa, 0x5a, 0xc1, 0x52, 0x2a, 0x96, 0xa1, 0x9d,
+		0xc8, 0xd3, 0x93, 0x6c, 0xd7, 0xd4, 0x8f, 0x59, 0x7d, 0x56, 0x4d, 0x79, 0x26, 0xff, 0x37, 0x09,
+		0x6e, 0xaa, 0x9e, 0x4b, 0x1d, 0xb7, 0x4d, 0x94, 0xc0, 0x20, 0xef, 0xf5, 0xf0, 0x22, 0xe4, 0xf9,
+		0xe8, 0x3e, 0xdc, 0x8b, 0xfc, 0x0b, 0xf7, 0x96, 0x6e, 0xe8, 0xa6, 0xae, 0x98, 0x25, 0x1c, 0xcb,
+		0xef, 0x58, 0x18, 0x6b, 0xc8, 0x82, 0x86, 0xc3, 0xbc, 0x8e, 0x86, 0x61, 0xcd, 0xc4, 0xa7, 0xa2,
+		0x14, 0x42, 0x85, 0x19, 0x8d, 0x55, 0x31, 0xeb, 0x6f, 0xd1, 0xff, 0x72, 0x3a, 0xff, 0x7b, 0x09,
+		0x32, 0xe2, 0xd7, 0x31, 0xff, 0xf1, 0x94, 0x85, 0x55, 0xb6, 0xc1, 0x52, 0xd5, 0xb4, 0xcc, 0xd3,
+		0xb2, 0xd6, 0x5b, 0xc3, 0x3d, 0x2b, 0x5c, 0x1e, 0x2c, 0xb3, 0x14, 0x66, 0x27, 0x54, 0x92, 0x5e,
+		0x80, 0x78, 0x0b, 0xc3, 0x70, 0xb0, 0x9c, 0x1a, 0x8b, 0x09, 0xfd, 0xa4, 0xd1, 0x06, 0xdc, 0xec,
+		0xc1, 0x1c, 0x69, 0x0a, 0x36, 0x0f, 0x34, 0xc5, 0x94, 0xa7, 0xf2, 0xbf, 0x95, 0xe0, 0x56, 0xa4,
+		0x84, 0x26, 0x1b, 0xac, 0x4e, 0x93, 0xd4, 0x4b, 0x6d, 0xaa, 0xda, 0xed, 0x80, 0xa0, 0x87, 0x70,
+		0xbf, 0xa3, 0x61, 0xa6, 0x52, 0x79, 0xd5, 0x3d, 0x2b, 0x4b, 0x55, 0x58, 0x73, 0x77, 0x77, 0x93,
+		0x08, 0x15, 0x21, 0xc8, 0x12, 0xfa, 0x0c, 0x3e, 0x19, 0x0f, 0xc5, 0x5a, 0x45, 0x33, 0xe5, 0x54,
+		0xfe, 0x1f, 0x19, 0x58, 0x8f, 0x07, 0xc7, 0x7e, 0x62, 0x90, 0x7a, 0x18, 0xda, 0x03, 0xc8, 0xf5,
+		0x3a, 0x11, 0x3a, 0xd7, 0x1f, 0xd7, 0x1e, 0x6c, 0x8f, 0xc1, 0x55, 0x8d, 0x23, 0xc5, 0x28, 0xb0,
+		0xe7, 0x08, 0x24, 0x4b, 0xe8, 0x39, 0xec, 0x8f, 0xa1, 0x1c, 0x28, 0x85, 0x6e, 0x96, 0x3b, 0x13,
+		0x47, 0x31, 0x4d, 0xac, 0x1f, 0x54, 0x4d, 0xad, 0x22, 0xa7, 0x90, 0x06, 0x4a, 0x82, 0x83, 0x5e,
+		0x1d, 0x1a, 0xea, 0x26, 0x8d, 0x9e, 0xc0, 0xd7, 0x49, 0x71, 0x84, 0x25, 0xa3, 0x1f, 0x6b, 0x38,
+		0x4e, 0x9d, 0x42, 0x4f, 0xe1, 0x9b, 0x04, 0xaa, 0x78, 0xf3, 0x00, 0x77, 0x1a, 0xed, 0xc3, 0xe3,
+		0xc4, 0xe8, 0xd5, 0x12, 0x2e, 0x58, 0xc7, 0x0a, 0x7e, 0xd5, 0x4b, 0x9e, 0x41, 0x3a, 0x68, 0x49,
+		0x2f, 0x16, 0xea, 0x66, 0x0d, 0xd1, 0x85, 0x98, 0xab, 0x1b, 0x13, 0x64, 0x91, 0x19, 0x12, 0xdc,
+		0xcc, 0xa2, 0x17, 0xa0, 0x4e, 0x96, 0x8a, 0xf1, 0x8e, 0xe6, 0xd0, 0x1b, 0x30, 0xaf, 0x77, 0xaa,
+		0xda, 0x1b, 0x53, 0xc3, 0x86, 0x92, 0xe4, 0x19, 0xd0, 0x33, 0x78, 0x92, 0x98, 0xb4, 0x5e, 0xfd,
+		0x89, 0xd1, 0x33, 0xe8, 0x31, 0x7c, 0x39, 0x86, 0x1e, 0xaf, 0x91, 0xee, 0xad, 0x40, 0x2f, 0xc8,
+		0xf3, 0xe8, 0x6b, 0xd8, 0x1b, 0x43, 0xe4, 0x5d, 0x68, 0x55, 0x4c, 0x5d, 0x7d, 0x75, 0x1a, 0x2e,
+		0x17, 0xf5, 0x8a, 0x29, 0x2f, 0xa0, 0x1f, 0xc1, 0x0f, 0xc6, 0xd0, 0x3a, 0x9b, 0x65, 0x7f, 0x68,
+		0x38, 0xd6, 0x62, 0x0c, 0x56, 0xc5, 0x9a, 0xbc, 0x38, 0xc1, 0x99, 0x54, 0xf4, 0x17, 0xc9, 0x99,
+		0x5b, 0x42, 0x2a, 0x3c, 0x9f, 0xa8, 0x45, 0xd4, 0x23, 0xbd, 0x58, 0x18, 0xee, 0x44, 0x46, 0x5f,
+		0xc2, 0xee, 0x18, 0x27, 0x87, 0x25, 0xac, 0x6a, 0x62, 0x62, 0x75, 0x44, 0x62, 0x19, 0x7d, 0x03,
+		0x8f, 0xc6, 0x91, 0x14, 0xbd, 0x58, 0x7a, 0xad, 0xe1, 0x7e, 0x1e, 0x62, 0x63, 0x74, 0xb2, 0xad,
+		0xeb, 0x46, 0xb9, 0x6a, 0x5a, 0x15, 0xfd, 0x3b, 0x4d, 0x5e, 0x61, 0x63, 0x34, 0xf1, 0xa4, 0xa2,
+		0x5c, 0xc9, 0xab, 0x83, 0x62, 0x3c, 0xf0, 0x92, 0x03, 0xdd, 0x50, 0xf0, 0xa9, 0xbc, 0x96, 0x50,
+		0x7b, 0x83, 0x42, 0xd7, 0x53, 0x42, 0x37, 0x27, 0xd9, 0x8e, 0xa6, 0x60, 0xf5, 0x28, 0x9e, 0xf1,
+		0x75, 0x36, 0x75, 0xee, 0xf1, 0x2f, 0x32, 0x03, 0xf7, 0xaa, 0xb8, 0xc4, 0xef, 0xc1, 0x76, 0x78,
+		0x6e, 0x43, 0xaa, 0x60, 0x84, 0xda, 0x1f, 0xc0, 0x0f, 0x27, 0xa3, 0x74, 0xd6, 0x95, 0x22, 0xd6,
+		0x94, 0xc2, 0x69, 0xe7, 0x4a, 0x2a, 0xe5, 0x7f, 0x9d, 0x82, 0xbc, 0x6a, 0xbb, 0x35, 0xd2, 0x88,
+		0xbe, 0x04, 0x8f, 0x8d, 0x72, 0x1f, 0x1e, 0x4f, 0xd0, 0xef, 0x23, 0xe2, 0x3d, 0x81, 0xca, 0x75,
+		0xc9, 0x55, 0xe3, 0x95, 0x51, 0x3a, 0x31, 0xc6, 0x11, 0x64, 0x09, 0x19, 0xf0, 0xf2, 0xba, 0x8e,
+		0x07, 0x52, 0xd2, 0xbd, 0x87, 0xa6, 0x78, 0x52, 0x2a, 0xce, 0x05, 0xff, 0x2c, 0x3e, 0x59, 0x52,
+		0x44, 0x19, 0xff, 0x67, 0x49, 0xb9, 0x2e, 0x79, 0xe2, 0xa4, 0x5c, 0xd7, 0xf1, 0xb8, 0xa4, 0x1c,
+		0xfc, 0x14, 0xd6, 0x6b, 0x5e, 0x73, 0xd8, 0x57, 0x86, 0x83, 0x85, 0x28, 0x3d, 0x65, 0xf6, 0x33,
+		0xbb, 0x2c, 0x7d, 0xb7, 0x77, 0xe1, 0xd0, 0xcb, 0xf6, 0xd9, 0x4e, 0xcd, 0x6b, 0xee, 0xc6, 0xff,
+		0x47, 0xbd, 0xed, 0xd4, 0x1b, 0xbb, 0x17, 0x5e, 0xf8, 0x3f, 0x6f, 0xf1, 0x0f, 0xeb, 0x7d, 0xbb,
+		0xe5, 0xbc, 0xdb, 0x3b, 0x9b, 0xe1, 0xb6, 0x2f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x00, 0x8a,
+		0xd0, 0x5a, 0x70, 0x1f, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/cluster.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x6d, 0x8b, 0xd3, 0x40,
+		0x10, 0x26, 0x17, 0x6b, 0xdb, 0xe9, 0xe1, 0x9d, 0x8b, 0x87, 0x45, 0x11, 0x6b, 0x04, 0xa9, 0x88,
+		0x09, 0x3d, 0x39, 0xc4, 0x17, 0x44, 0x7a, 0x2a, 0xf6, 0x83, 0x2f, 0xac, 0xdf, 0xfc, 0x12, 0x36,
+		0x9b, 0x69, 0xbb, 0x5c, 0x76, 0xf7, 0xd8, 0x6c, 0x0a, 0x05, 0xff, 0x82, 0xff, 0x47, 0xff, 0x9d,
+		0x64, 0x37, 0x09, 0x27, 0xf6, 0xf0, 0xbe, 0xcd, 0xcc, 0x3e, 0xcf, 0xb3, 0xcf, 0xcc, 0x30, 0xf0,
+		0xb0, 0xca, 0xd0, 0x24, 0x9c, 0xe5, 0xa8, 0x38, 0x26, 0x2c, 0x97, 0x42, 0x25, 0x9b, 0x59, 0xc2,
+		0x8b, 0xaa, 0xb4, 0x68, 0xe2, 0x73, 0xa3, 0xad, 0x26, 0x47, 0x35, 0x28, 0x6e, 0x40, 0xb1, 0x03,
+		0xc5, 0x9b, 0x59, 0xf4, 0x08, 0x06, 0x1f, 0x75, 0x69, 0x17, 0x6a, 0xa9, 0xc9, 0x1d, 0x18, 0x88,
+		0x1c, 0x95, 0x15, 0x76, 0x3b, 0x0e, 0x26, 0xc1, 0x74, 0x48, 0xbb, 0x3c, 0xfa, 0x01, 0x03, 0x2a,
+		0xd4, 0xca, 0xe1, 0x08, 0x5c, 0x33, 0xba, 0xc0, 0x06, 0xe3, 0x62, 0xf2, 0x00, 0xf6, 0x25, 0xca,
+		0x0c, 0x4d, 0xca, 0x75, 0xa5, 0xec, 0x78, 0x6f, 0x12, 0x4c, 0x7b, 0x74, 0xe4, 0x6b, 0xa7, 0x75,
+		0x89, 0xbc, 0x80, 0xbe, 0x4f, 0xcb, 0x71, 0x38, 0x09, 0xa7, 0xa3, 0xe3, 0xfb, 0xf1, 0x4e, 0x4f,
+		0x71, 0x6b, 0x88, 0xb6, 0xf8, 0xe8, 0x57, 0x00, 0x37, 0x3e, 0xf9, 0x78, 0x2d, 0xce, 0x9d, 0x89,
+		0x39, 0xec, 0xf3, 0xca, 0x18, 0x54, 0x36, 0x5d, 0xeb, 0xd2, 0x3a, 0x33, 0x57, 0x90, 0x1c, 0x35,
+		0xa4, 0xba, 0x40, 0x9e, 0xc0, 0x4d, 0x83, 0x8c, 0xaf, 0x59, 0x56, 0x60, 0xda, 0x7a, 0xdb, 0x9b,
+		0x84, 0xd3, 0x21, 0x3d, 0xec, 0x1e, 0x9a, 0x7f, 0xc9, 0x09, 0xf4, 0x8c, 0x50, 0xab, 0xff, 0x99,
+		0x6f, 0xa7, 0x44, 0x3d, 0x3a, 0xfa, 0x19, 0xc0, 0xc1, 0x3b, 0x2d, 0x99, 0x50, 0xa7, 0x8c, 0xaf,
+		0xd1, 0x79, 0x7f, 0x09, 0x77, 0x55, 0x25, 0x53, 0xbd, 0x4c, 0x85, 0x45, 0x59, 0xa6, 0x42, 0xa5,
+		0xbc, 0x7e, 0x4c, 0xb3, 0x6d, 0x2a, 0x72, 0xd7, 0x4a, 0x48, 0x8f, 0x54, 0x25, 0xbf, 0x2c, 0x17,
+		0x35, 0x60, 0xe1, 0xb9, 0xf3, 0xed, 0x22, 0x27, 0x6f, 0xe0, 0xde, 0xa5, 0x5c, 0xc5, 0x24, 0xba,
+		0xc9, 0x87, 0xf4, 0xf6, 0x0e, 0xf6, 0x67, 0x26, 0x31, 0x7a, 0x0d, 0xe4, 0x2b, 0x9a, 0x52, 0x94,
+		0xb6, 0xf6, 0xfd, 0x0d, 0xad, 0x15, 0x6a, 0x45, 0x0e, 0x21, 0x3c, 0xc3, 0x76, 0xeb, 0x75, 0x48,
+		0x6e, 0x41, 0x6f, 0xc3, 0x8a, 0xca, 0xeb, 0x0d, 0xa9, 0x4f, 0xa2, 0xb7, 0x7f, 0xb1, 0x3f, 0x20,
+		0xb3, 0x95, 0xc1, 0x1d, 0xec, 0x31, 0xf4, 0x51, 0xd5, 0xd3, 0xcb, 0x1d, 0x7f, 0x40, 0xdb, 0x34,
+		0xfa, 0x1d, 0xc0, 0xc1, 0x05, 0x09, 0x37, 0x8f, 0x31, 0xf4, 0x33, 0xc6, 0xcf, 0x50, 0xe5, 0x8d,
+		0x46, 0x9b, 0x92, 0xf7, 0x30, 0x28, 0xbd, 0x45, 0xbf, 0x98, 0xd1, 0xf1, 0xe3, 0x4b, 0xe6, 0xfe,
+		0x6f, 0x53, 0xb4, 0xa3, 0xd6, 0x32, 0x4b, 0xef, 0xb5, 0x5d, 0xdf, 0x15, 0x64, 0x9a, 0xee, 0x68,
+		0x47, 0x9d, 0x3f, 0xff, 0x7e, 0xb2, 0x12, 0x76, 0x5d, 0x65, 0x31, 0xd7, 0x32, 0xb9, 0x78, 0x75,
+		0x4f, 0x45, 0x5e, 0x24, 0x2b, 0x9d, 0xb8, 0x5b, 0xeb, 0x4e, 0xf0, 0x95, 0x0b, 0x36, 0xb3, 0xec,
+		0xba, 0xab, 0x3f, 0xfb, 0x13, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x49, 0xfd, 0x6d, 0xaa, 0x03, 0x00,
+		0x00,
+	},
+	// uber/cadence/admin/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4d, 0x4a, 0x2d,
+		0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0x4c, 0xc9, 0xcd, 0xcc, 0xd3, 0x2f,
+		0x33, 0xd4, 0xcf, 0xc8, 0x2c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+		0x12, 0x05, 0x29, 0xd2, 0x83, 0x2a, 0xd2, 0x03, 0x2b, 0xd2, 0x2b, 0x33, 0x54, 0xf2, 0xe4, 0x12,
+		0x0a, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0xf3, 0x80, 0x28, 0xf7, 0x2c, 0x49, 0xcd, 0x15, 0x92,
+		0xe4, 0xe2, 0x48, 0x2d, 0x4b, 0xcd, 0x2b, 0x89, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60,
+		0x0e, 0x62, 0x07, 0xf3, 0x3d, 0x53, 0x84, 0x24, 0xb8, 0xd8, 0xcb, 0x20, 0x1a, 0x24, 0x98, 0x20,
+		0x32, 0x50, 0xae, 0x52, 0x09, 0x17, 0x1f, 0xaa, 0x51, 0x42, 0x8a, 0x5c, 0x3c, 0x49, 0x45, 0x89,
+		0x79, 0xc9, 0x19, 0xf1, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0x60, 0xa3, 0x78, 0x82, 0xb8, 0x21, 0x62,
+		0x21, 0x20, 0x21, 0x21, 0x7b, 0x2e, 0xd6, 0xcc, 0x92, 0xd4, 0xdc, 0x62, 0x09, 0x26, 0x05, 0x66,
+		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
+		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
+		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
+		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x14, 0xd7, 0xd4, 0x3e,
+		0x01, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/queue.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
+		0x15, 0x37, 0x25, 0xcb, 0x96, 0x9f, 0x1d, 0x9b, 0x9e, 0x24, 0x8d, 0xe2, 0xc4, 0x88, 0xa2, 0x05,
+		0x12, 0xc1, 0x9b, 0x48, 0xb5, 0xbd, 0x41, 0x16, 0xbb, 0x45, 0x5b, 0x9a, 0x62, 0x2c, 0xae, 0x65,
+		0x49, 0x18, 0x52, 0x49, 0xbc, 0x58, 0x80, 0xa0, 0xa9, 0xb1, 0x43, 0x84, 0x22, 0xb5, 0x24, 0xe5,
+		0x54, 0x1f, 0xa0, 0xd9, 0x7b, 0xb7, 0xd7, 0x5e, 0x8a, 0xde, 0x0a, 0xf4, 0xd4, 0x1e, 0x8a, 0x5e,
+		0x0b, 0xf4, 0xd4, 0x6b, 0x7b, 0xd8, 0x2f, 0xd1, 0x8f, 0x50, 0x70, 0x48, 0x4a, 0x14, 0x45, 0x59,
+		0x74, 0xe2, 0x02, 0x3d, 0xec, 0x4d, 0x7a, 0xfc, 0xcd, 0xe3, 0xef, 0xfd, 0x99, 0xdf, 0xbc, 0x91,
+		0xe0, 0xe1, 0xe0, 0x94, 0xd8, 0x55, 0x4d, 0xed, 0x12, 0x53, 0x23, 0x55, 0xb5, 0xdb, 0xd3, 0xcd,
+		0xea, 0xc5, 0x6e, 0xf5, 0xdb, 0x01, 0x19, 0x90, 0x4a, 0xdf, 0xb6, 0x5c, 0x0b, 0xdd, 0xf6, 0x20,
+		0x95, 0x00, 0x52, 0xa1, 0x90, 0xca, 0xc5, 0xee, 0xd6, 0x83, 0x73, 0xcb, 0x3a, 0x37, 0x48, 0x95,
+		0x82, 0x4e, 0x07, 0x67, 0x55, 0x57, 0xef, 0x11, 0xc7, 0x55, 0x7b, 0x7d, 0x7f, 0xdd, 0x56, 0x71,
+		0xd2, 0x75, 0x5f, 0xf7, 0x1c, 0x6b, 0x56, 0xaf, 0x67, 0x99, 0x01, 0xe2, 0x61, 0x12, 0xe2, 0x8d,
+		0xee, 0xb8, 0x96, 0x3d, 0x0c, 0x20, 0xa5, 0x24, 0xc8, 0x3b, 0xcb, 0x7e, 0x7b, 0x66, 0x58, 0xef,
+		0x7c, 0x4c, 0xe9, 0x87, 0x0c, 0xdc, 0xe2, 0x6d, 0xcb, 0x71, 0x78, 0x63, 0xe0, 0xb8, 0xc4, 0x96,
+		0x55, 0xe7, 0xad, 0x68, 0x9e, 0x59, 0xe8, 0x1e, 0xac, 0x74, 0xad, 0x9e, 0xaa, 0x9b, 0x8a, 0xde,
+		0x2d, 0x30, 0x45, 0xa6, 0xbc, 0x82, 0xf3, 0xbe, 0x41, 0xec, 0xa2, 0x0e, 0xa0, 0xd0, 0x8f, 0x42,
+		0x7e, 0x45, 0xb4, 0x81, 0xab, 0x5b, 0x66, 0x21, 0x53, 0x64, 0xca, 0xab, 0x7b, 0x8f, 0x2a, 0x93,
+		0x31, 0xf7, 0xf5, 0xca, 0xc5, 0x6e, 0xe5, 0x55, 0x00, 0x17, 0x42, 0x34, 0xde, 0x7c, 0x17, 0x37,
+		0xa1, 0x3a, 0xac, 0xb8, 0xaa, 0xf3, 0x56, 0x71, 0x87, 0x7d, 0x52, 0xc8, 0x16, 0x99, 0xf2, 0xfa,
+		0xde, 0xa7, 0x95, 0xc4, 0x0c, 0x56, 0xe2, 0x9c, 0xe5, 0x61, 0x9f, 0xe0, 0xbc, 0x1b, 0x7c, 0x42,
+		0xdb, 0x00, 0xd4, 0x93, 0xe3, 0xaa, 0x2e, 0x29, 0x2c, 0x16, 0x99, 0x72, 0x0e, 0x53, 0xdf, 0x92,
+		0x67, 0x40, 0x77, 0x60, 0x99, 0x3e, 0xd6, 0xbb, 0x85, 0x5c, 0x91, 0x29, 0x67, 0xf1, 0x92, 0xf7,
+		0x55, 0xec, 0xa2, 0x63, 0xb8, 0x75, 0xa1, 0x3b, 0xfa, 0xa9, 0x6e, 0xe8, 0xee, 0x50, 0x19, 0x55,
+		0xa5, 0xb0, 0x44, 0x43, 0xdb, 0xaa, 0xf8, 0x75, 0xab, 0x84, 0x75, 0xab, 0xc8, 0x21, 0x02, 0xdf,
+		0x1c, 0xaf, 0x1b, 0x19, 0x4b, 0x7f, 0x58, 0x84, 0x9f, 0x46, 0x99, 0x4a, 0xae, 0x6a, 0xbb, 0xfc,
+		0x1b, 0xdd, 0xe8, 0x8e, 0xf3, 0x40, 0xbe, 0x1d, 0x10, 0xc7, 0xe5, 0x5c, 0xd7, 0xd6, 0x4f, 0x07,
+		0x2e, 0x71, 0x50, 0x19, 0x58, 0x57, 0xb5, 0xcf, 0x89, 0xab, 0xc4, 0x0b, 0xb0, 0xee, 0xdb, 0x6b,
+		0x61, 0x19, 0xb6, 0x01, 0x6c, 0x7f, 0xb9, 0x87, 0xc9, 0x50, 0xcc, 0x4a, 0x60, 0x11, 0xbb, 0xe8,
+		0x09, 0x20, 0xdd, 0xd4, 0x5d, 0x5d, 0x75, 0x49, 0x57, 0x21, 0x17, 0xc4, 0xa4, 0xb0, 0x2c, 0x0d,
+		0x98, 0x1d, 0x3d, 0x11, 0xbc, 0x07, 0x62, 0x17, 0xbd, 0x67, 0x60, 0x2b, 0x0e, 0x57, 0x47, 0xac,
+		0x68, 0x0e, 0x57, 0xf7, 0xea, 0x89, 0xc5, 0x1d, 0x87, 0x35, 0x55, 0x66, 0x71, 0xe2, 0x35, 0xe3,
+		0x28, 0x71, 0x41, 0x9f, 0xf1, 0x04, 0x95, 0xe0, 0x46, 0x10, 0xbf, 0x3d, 0x30, 0xc3, 0x12, 0xad,
+		0xe0, 0x55, 0xdf, 0x88, 0x07, 0x5e, 0xe4, 0xdf, 0x31, 0xc0, 0xf6, 0x55, 0xdb, 0xd5, 0xbd, 0x77,
+		0x28, 0x9a, 0x65, 0x9e, 0xe9, 0xe7, 0x85, 0xa5, 0x62, 0xb6, 0xbc, 0xba, 0xf7, 0x4d, 0x8a, 0x8e,
+		0x49, 0x53, 0x87, 0x4a, 0x3b, 0xf4, 0xcf, 0x53, 0xf7, 0x82, 0xe9, 0xda, 0x43, 0xbc, 0xd1, 0x9f,
+		0xb4, 0x6e, 0x1d, 0xc0, 0xad, 0x24, 0x20, 0x62, 0x21, 0xfb, 0x96, 0x0c, 0x83, 0xc2, 0x79, 0x1f,
+		0xd1, 0x2d, 0xc8, 0x5d, 0xa8, 0xc6, 0x80, 0x04, 0x85, 0xf2, 0xbf, 0x7c, 0x91, 0xf9, 0x9c, 0x29,
+		0x7d, 0x05, 0xbb, 0x73, 0xd9, 0x39, 0x7d, 0xcb, 0x74, 0x48, 0x24, 0x4d, 0xb7, 0x61, 0x29, 0xc8,
+		0x8f, 0xff, 0x8e, 0x9c, 0xed, 0x65, 0xa6, 0xf4, 0xd7, 0x0c, 0x3c, 0x89, 0x3a, 0xe3, 0x55, 0x53,
+		0x23, 0xc6, 0xb5, 0xb4, 0xdb, 0x29, 0xdc, 0x0d, 0x90, 0x1f, 0xbd, 0xf9, 0xef, 0xf8, 0x8e, 0xa6,
+		0x1e, 0xc4, 0x5a, 0x3a, 0x9b, 0xae, 0xa5, 0x17, 0x67, 0xb4, 0x74, 0x05, 0x6e, 0x6a, 0x5e, 0x1a,
+		0xc7, 0x7c, 0x2d, 0xd3, 0x18, 0xd2, 0x7e, 0xca, 0xe3, 0x4d, 0x2d, 0xda, 0xb0, 0x2d, 0xd3, 0x18,
+		0x96, 0xaa, 0xf0, 0xf4, 0xd2, 0xd4, 0xc5, 0x6b, 0x50, 0xfa, 0x4b, 0x76, 0x32, 0xd9, 0x92, 0x7e,
+		0x6e, 0xaa, 0x3f, 0x26, 0x3b, 0x4d, 0xb2, 0xd1, 0x03, 0x58, 0x75, 0x68, 0xba, 0x14, 0x53, 0xed,
+		0x11, 0xaa, 0xb0, 0x2b, 0x18, 0x7c, 0x53, 0x53, 0xed, 0x11, 0xf4, 0x0b, 0x58, 0x0b, 0x00, 0xba,
+		0xd9, 0x1f, 0xb8, 0x85, 0x65, 0x1a, 0xf4, 0xfd, 0xc4, 0xa0, 0xdb, 0xea, 0xd0, 0xb0, 0xd4, 0x2e,
+		0x0e, 0x5c, 0x8a, 0xde, 0x02, 0x54, 0x80, 0x65, 0xcd, 0x32, 0x5d, 0xdb, 0x32, 0x0a, 0xf9, 0x22,
+		0x53, 0x5e, 0xc3, 0xe1, 0xd7, 0x78, 0xa1, 0xa7, 0xca, 0x36, 0x55, 0xe8, 0x7f, 0x66, 0x80, 0x8b,
+		0xae, 0xc0, 0x44, 0xb3, 0xec, 0x6e, 0xb2, 0xe4, 0xf1, 0x56, 0xaf, 0x6f, 0x10, 0x97, 0xfc, 0xbf,
+		0x57, 0xff, 0x6a, 0xc7, 0x43, 0x03, 0x58, 0xcd, 0x0f, 0xcc, 0x53, 0x5c, 0x0a, 0x0f, 0xce, 0x84,
+		0x87, 0x89, 0x44, 0xea, 0xfe, 0x28, 0x42, 0x97, 0xe3, 0x8d, 0xf1, 0x52, 0x6a, 0x28, 0xd5, 0xe0,
+		0xe0, 0xea, 0xe9, 0x9c, 0xaa, 0xca, 0x7f, 0x18, 0x28, 0x72, 0xfd, 0xbe, 0x31, 0x6c, 0xab, 0x36,
+		0x31, 0x5d, 0xde, 0xb0, 0x1c, 0xd2, 0xb6, 0x0c, 0x5d, 0x1b, 0x46, 0x92, 0xfe, 0x08, 0x36, 0xfc,
+		0xbe, 0x8c, 0xe7, 0xfc, 0x06, 0x35, 0x8f, 0x52, 0xbe, 0x03, 0x9b, 0xb1, 0xfe, 0x1d, 0x9d, 0xa9,
+		0x1b, 0x13, 0xdd, 0x2b, 0x76, 0x51, 0x11, 0xd6, 0x7c, 0x6c, 0xa0, 0xc0, 0xfe, 0xd6, 0x01, 0x6a,
+		0xf3, 0x0f, 0xa8, 0x97, 0x70, 0xb3, 0x4f, 0x49, 0x29, 0x9a, 0xc7, 0x4a, 0xe9, 0x53, 0x5a, 0x34,
+		0x63, 0xeb, 0x33, 0x4a, 0x37, 0x15, 0x04, 0xde, 0xec, 0xc7, 0x4d, 0xa5, 0xef, 0x19, 0xb8, 0x9f,
+		0x1c, 0xb2, 0x37, 0xd9, 0x0c, 0x1c, 0x74, 0x1f, 0x56, 0x82, 0x64, 0x13, 0x3f, 0xd0, 0x3c, 0x1e,
+		0x1b, 0x50, 0x07, 0xd6, 0xce, 0x54, 0xdd, 0x20, 0x5d, 0x45, 0x53, 0x07, 0x8e, 0x7f, 0x14, 0xad,
+		0xef, 0xed, 0xa5, 0x1c, 0xb2, 0x5e, 0xd0, 0xa5, 0xbc, 0xb7, 0x12, 0xaf, 0x9e, 0x8d, 0xbf, 0x94,
+		0xfe, 0xc6, 0xc0, 0x76, 0x32, 0xab, 0x60, 0x13, 0xa0, 0x63, 0xc8, 0xd1, 0xec, 0x50, 0x4a, 0xab,
+		0x7b, 0xcf, 0x67, 0xbc, 0x71, 0x5e, 0x35, 0xb1, 0xef, 0x05, 0x1d, 0xc1, 0x92, 0x43, 0xe3, 0x0d,
+		0x36, 0xc3, 0xfe, 0x95, 0xfc, 0xf9, 0xa9, 0xc2, 0x81, 0x8b, 0xd2, 0x77, 0x0c, 0xec, 0x47, 0x43,
+		0xbd, 0x34, 0x92, 0x48, 0x67, 0xb5, 0x21, 0x4f, 0xd9, 0xd8, 0xc4, 0x2c, 0x30, 0x74, 0xf6, 0xf8,
+		0xec, 0x4a, 0x34, 0x02, 0x8f, 0x78, 0xe4, 0xa5, 0xf4, 0xf7, 0x99, 0xd5, 0xc5, 0xc4, 0x19, 0x18,
+		0xd7, 0x9e, 0xc6, 0xff, 0x51, 0x3b, 0xfc, 0x96, 0x81, 0xcf, 0xd2, 0x24, 0x74, 0x6a, 0xa6, 0xf9,
+		0x26, 0xd8, 0xab, 0x36, 0x31, 0x95, 0xa0, 0xbe, 0x7e, 0x62, 0xf7, 0xaf, 0x98, 0x58, 0x2f, 0x59,
+		0x78, 0x3d, 0xf4, 0xe5, 0xd7, 0xbb, 0xf4, 0xbb, 0x65, 0xb8, 0x13, 0x8f, 0x21, 0xec, 0xcf, 0xf0,
+		0xea, 0xa1, 0x9b, 0x67, 0x56, 0x90, 0xdc, 0xb4, 0x57, 0x0f, 0xef, 0xba, 0xe4, 0x5f, 0x3d, 0xe8,
+		0xc5, 0xe9, 0x37, 0x0c, 0x14, 0x1d, 0x6f, 0x82, 0x53, 0x7c, 0x89, 0x18, 0xe9, 0x76, 0x74, 0x9a,
+		0xf6, 0xbb, 0xf6, 0xf0, 0x9a, 0x46, 0xd5, 0xfa, 0x02, 0xde, 0x76, 0xa6, 0x71, 0x91, 0xc4, 0xfe,
+		0x9a, 0x81, 0x7b, 0x1a, 0x1d, 0x67, 0x92, 0xf9, 0x64, 0x29, 0x1f, 0x3e, 0x05, 0x9f, 0x79, 0xf3,
+		0x64, 0x7d, 0x01, 0xdf, 0xd5, 0x26, 0x31, 0x31, 0x1e, 0xc1, 0xa1, 0x9e, 0xc8, 0x63, 0x31, 0x35,
+		0x8f, 0x79, 0xa3, 0x96, 0xc7, 0xc3, 0x99, 0xc4, 0x44, 0x78, 0xfc, 0x8b, 0x81, 0x2f, 0x6d, 0x7a,
+		0xe8, 0x28, 0x31, 0xd1, 0x1f, 0xd3, 0x0a, 0x55, 0x53, 0x09, 0x67, 0xa4, 0x08, 0xcf, 0x1c, 0xe5,
+		0xf9, 0x3a, 0x05, 0xcf, 0x0f, 0x9a, 0x14, 0xea, 0x0b, 0xf8, 0x99, 0xfd, 0x41, 0x23, 0xc6, 0x1f,
+		0x19, 0x78, 0xa2, 0x7a, 0x9b, 0x42, 0x49, 0x38, 0x7e, 0x92, 0x22, 0xf1, 0x6f, 0xb6, 0x5f, 0xa5,
+		0x88, 0x24, 0xa5, 0x2c, 0xd6, 0x17, 0xf0, 0x63, 0x35, 0x1d, 0xf4, 0x60, 0x0d, 0x60, 0x4c, 0xa5,
+		0xf4, 0xe7, 0x3c, 0x14, 0xa6, 0xf7, 0xa7, 0x2f, 0x12, 0xd1, 0x2b, 0x3b, 0x33, 0x71, 0x65, 0x9f,
+		0xf8, 0xd1, 0x20, 0x73, 0x7d, 0x3f, 0x1a, 0x64, 0xe3, 0x3f, 0x1a, 0xc4, 0xc5, 0x72, 0xf1, 0x5a,
+		0xc4, 0x12, 0x7d, 0x9f, 0x46, 0x2f, 0x72, 0x89, 0xb7, 0xef, 0xd4, 0x7a, 0x11, 0x17, 0xda, 0xf9,
+		0x82, 0xf1, 0x7e, 0x8e, 0x60, 0xf8, 0x6d, 0x53, 0xfb, 0x10, 0xc1, 0x48, 0x20, 0x73, 0x89, 0x62,
+		0xbc, 0x9f, 0xa3, 0x18, 0xcb, 0xa9, 0x89, 0xcc, 0x9d, 0xf2, 0x2f, 0x97, 0x8c, 0x7f, 0x7f, 0xa4,
+		0x64, 0xe4, 0x29, 0xd1, 0x93, 0x6b, 0x93, 0x8c, 0x04, 0xf6, 0x1f, 0xa8, 0x19, 0x7f, 0x62, 0xe0,
+		0xe9, 0x65, 0x9a, 0xe1, 0xbf, 0x29, 0x1a, 0xcb, 0x0a, 0x8d, 0xe5, 0xe8, 0x23, 0x44, 0x23, 0x81,
+		0x7d, 0x59, 0x4d, 0x89, 0x8d, 0xc9, 0x86, 0x95, 0xa4, 0x1a, 0x34, 0x46, 0x07, 0x49, 0x70, 0x83,
+		0x6e, 0xe9, 0xa0, 0x1e, 0xe1, 0x38, 0x51, 0x49, 0xb9, 0x69, 0xc3, 0x09, 0x6d, 0xcd, 0x8d, 0x38,
+		0xdd, 0x79, 0xcf, 0x40, 0x3e, 0x94, 0x0f, 0x74, 0x1b, 0x36, 0x65, 0x4e, 0x3a, 0x52, 0xe4, 0x93,
+		0xb6, 0xa0, 0x88, 0xcd, 0x97, 0x5c, 0x43, 0xac, 0xb1, 0x0b, 0xe8, 0x27, 0x80, 0xc6, 0x66, 0x19,
+		0x73, 0x4d, 0xe9, 0x85, 0x80, 0x59, 0x06, 0xdd, 0x84, 0x8d, 0x88, 0x5d, 0x3c, 0x16, 0x30, 0x9b,
+		0x41, 0x77, 0xe1, 0xf6, 0xd8, 0x88, 0x85, 0x76, 0x43, 0xe4, 0x39, 0x59, 0x6c, 0x35, 0xd9, 0x2c,
+		0xba, 0x07, 0x77, 0xc6, 0x8f, 0x78, 0xdc, 0x92, 0x24, 0x85, 0x6f, 0x74, 0x24, 0x59, 0xc0, 0xec,
+		0xe2, 0xce, 0x3f, 0x12, 0x7e, 0xbc, 0xa5, 0xa4, 0x3e, 0x81, 0x07, 0x13, 0x58, 0x25, 0x89, 0xe2,
+		0x2e, 0x3c, 0x9d, 0x05, 0x92, 0x64, 0x0e, 0xcb, 0x0a, 0x5f, 0x17, 0x1b, 0x35, 0x45, 0x78, 0x2d,
+		0xf0, 0x1d, 0xca, 0x86, 0x41, 0x4f, 0xa0, 0x3c, 0x6b, 0x09, 0xcf, 0x35, 0x79, 0xa1, 0x11, 0x41,
+		0x67, 0x2e, 0x43, 0x4b, 0xe2, 0x61, 0x93, 0x8b, 0xa2, 0xb3, 0xa8, 0x06, 0xbf, 0x9c, 0x85, 0xc6,
+		0x02, 0xdf, 0xc2, 0xb5, 0x80, 0xcf, 0xab, 0x16, 0x3e, 0x3a, 0x6a, 0xb4, 0x5e, 0x8d, 0x17, 0x2b,
+		0x7c, 0xeb, 0xb8, 0xdd, 0x10, 0x64, 0x81, 0x5d, 0x44, 0xcf, 0x60, 0x77, 0x96, 0x17, 0xae, 0xdd,
+		0x6e, 0x9c, 0x28, 0x6d, 0x0e, 0x0b, 0x4d, 0x59, 0xe1, 0x1b, 0x2d, 0x49, 0x50, 0xda, 0xad, 0x86,
+		0xc8, 0x9f, 0xb0, 0xb9, 0x9d, 0xdf, 0x67, 0xe1, 0xde, 0x25, 0x8a, 0x8d, 0x3e, 0x85, 0xc7, 0x09,
+		0x6e, 0x5f, 0x70, 0x62, 0x43, 0xa8, 0x29, 0x3c, 0xd7, 0x91, 0xa2, 0x89, 0x4d, 0xe6, 0x30, 0x01,
+		0xae, 0xb5, 0x8e, 0x39, 0xb1, 0xa9, 0x34, 0x5b, 0xb2, 0xc2, 0xf1, 0xb2, 0xf8, 0x52, 0x60, 0x99,
+		0x2b, 0x2e, 0x13, 0x5e, 0x8b, 0x92, 0x2c, 0xb1, 0x19, 0xf4, 0x33, 0xf8, 0x7c, 0xde, 0x32, 0x2f,
+		0x65, 0x2f, 0xbc, 0x94, 0x71, 0x0d, 0x2c, 0x70, 0xb5, 0x13, 0x05, 0x77, 0x9a, 0x4d, 0xb1, 0x79,
+		0xc8, 0x66, 0xd1, 0x73, 0xd8, 0x4f, 0xbd, 0x3a, 0xf2, 0xda, 0x45, 0xf4, 0x73, 0xf8, 0xe2, 0xca,
+		0xaf, 0x0d, 0xeb, 0x54, 0x63, 0x73, 0x33, 0xba, 0x6f, 0x62, 0x7d, 0xa7, 0xc9, 0x73, 0xb2, 0x70,
+		0xd8, 0xc2, 0xe2, 0xd7, 0x42, 0x8d, 0x5d, 0xda, 0xf9, 0x81, 0x01, 0x74, 0x48, 0xdc, 0x78, 0x6d,
+		0x1e, 0xc2, 0xf6, 0xa1, 0x20, 0x5f, 0x5a, 0x91, 0x47, 0x50, 0x4a, 0x86, 0x48, 0x02, 0x7e, 0x29,
+		0xf2, 0x82, 0x72, 0xd0, 0x91, 0x4e, 0x58, 0x66, 0xb6, 0x2b, 0x6f, 0xa7, 0xb6, 0x3a, 0x32, 0x9b,
+		0x41, 0x15, 0xd8, 0x99, 0xe1, 0xaa, 0xce, 0xe1, 0x9a, 0xd2, 0x7a, 0xd5, 0x14, 0xb0, 0x54, 0x17,
+		0xdb, 0x4a, 0xa3, 0x25, 0xc9, 0x6c, 0x16, 0x3d, 0x86, 0x4f, 0x92, 0xf1, 0x93, 0xd1, 0x2d, 0x1e,
+		0x3c, 0xff, 0xfa, 0xd9, 0xb9, 0xee, 0xbe, 0x19, 0x9c, 0x56, 0x34, 0xab, 0x57, 0x8d, 0xfe, 0x75,
+		0xf3, 0x54, 0xef, 0x1a, 0xd5, 0x73, 0xcb, 0xff, 0xb7, 0x68, 0xf4, 0x3f, 0xd3, 0x97, 0xf4, 0xc3,
+		0xc5, 0xee, 0xe9, 0x12, 0xb5, 0xef, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xe2, 0x06, 0x07,
+		0x8f, 0x1a, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0x57,
+		0xf5, 0xef, 0xec, 0xda, 0x6b, 0xef, 0x59, 0xc7, 0xb1, 0x6f, 0x1c, 0xc7, 0xce, 0xa7, 0x33, 0x49,
+		0x13, 0xd7, 0x71, 0xd6, 0x89, 0x93, 0x26, 0x4d, 0xd2, 0x8f, 0xbf, 0xed, 0xd8, 0xea, 0x4a, 0xfe,
+		0x27, 0xf9, 0x4f, 0x9c, 0xf4, 0x0f, 0xaa, 0xb4, 0x8c, 0x77, 0xae, 0xe3, 0xc1, 0xbb, 0x33, 0xdb,
+		0x99, 0xbb, 0xde, 0x18, 0xc1, 0x13, 0x0f, 0x48, 0x88, 0xaa, 0x54, 0x15, 0x12, 0x95, 0x40, 0x20,
+		0x24, 0x10, 0x05, 0xa4, 0x22, 0x10, 0xe2, 0xeb, 0x05, 0x90, 0x50, 0x91, 0x40, 0x85, 0x27, 0x5e,
+		0xfa, 0x8a, 0x10, 0x7d, 0xe3, 0x81, 0xf2, 0x86, 0x84, 0xe6, 0xce, 0x9d, 0xdd, 0x9d, 0xd9, 0x7b,
+		0x67, 0xee, 0xac, 0x9d, 0x16, 0xd4, 0xbc, 0x79, 0xee, 0x9c, 0x73, 0xe6, 0x77, 0xef, 0xf9, 0xb8,
+		0xe7, 0xde, 0x73, 0xd6, 0x70, 0xb2, 0xb1, 0x8e, 0x9d, 0xb9, 0x8a, 0x6e, 0x60, 0xab, 0x82, 0xe7,
+		0xf4, 0xba, 0x39, 0xb7, 0x7d, 0x71, 0x6e, 0xd3, 0x74, 0x89, 0xed, 0xec, 0x14, 0xeb, 0x8e, 0x4d,
+		0x6c, 0x74, 0xc0, 0x23, 0x29, 0x32, 0x92, 0xa2, 0x5e, 0x37, 0x8b, 0xdb, 0x17, 0x0f, 0x1f, 0x7f,
+		0x60, 0xdb, 0x0f, 0xaa, 0x78, 0x8e, 0x92, 0xac, 0x37, 0x36, 0xe6, 0x8c, 0x86, 0xa3, 0x13, 0xd3,
+		0xb6, 0x7c, 0xa6, 0xc3, 0x27, 0xa2, 0xef, 0x89, 0x59, 0xc3, 0x2e, 0xd1, 0x6b, 0x75, 0x46, 0x30,
+		0xc5, 0xfb, 0x70, 0xc5, 0xae, 0xd5, 0x5a, 0x22, 0x54, 0x1e, 0x05, 0xd1, 0xdd, 0xad, 0xaa, 0xe9,
+		0x92, 0x38, 0x9a, 0xa6, 0xed, 0x6c, 0x6d, 0x54, 0xed, 0xa6, 0x4f, 0xa3, 0xde, 0x84, 0x81, 0x17,
+		0xfd, 0x09, 0xa1, 0x6b, 0x90, 0xc3, 0xdb, 0xd8, 0x22, 0xee, 0x84, 0x32, 0x95, 0x9d, 0x2e, 0xcc,
+		0x9f, 0x2c, 0x72, 0xe6, 0x56, 0x64, 0xd4, 0xcb, 0x1e, 0xa5, 0xc6, 0x18, 0xd4, 0xf7, 0xaf, 0xc2,
+		0x50, 0xe7, 0x0b, 0x34, 0x09, 0x83, 0xf4, 0x55, 0xd9, 0x34, 0x26, 0x94, 0x29, 0x65, 0x3a, 0xab,
+		0x0d, 0xd0, 0xe7, 0x92, 0x81, 0xae, 0x01, 0xf8, 0xaf, 0xbc, 0x49, 0x4f, 0x64, 0xa6, 0x94, 0xe9,
+		0xc2, 0xfc, 0xe1, 0xa2, 0xbf, 0x22, 0xc5, 0x60, 0x45, 0x8a, 0x6b, 0xc1, 0x8a, 0x68, 0x79, 0x4a,
+		0xed, 0x3d, 0xa3, 0x09, 0x18, 0xd8, 0xc6, 0x8e, 0x6b, 0xda, 0xd6, 0x44, 0xd6, 0x17, 0xca, 0x1e,
+		0xd1, 0x21, 0x18, 0xf0, 0x26, 0xef, 0x7d, 0xae, 0x8f, 0xbe, 0xc9, 0x79, 0x8f, 0x25, 0x03, 0x7d,
+		0x4d, 0x81, 0x73, 0xc1, 0x94, 0xcb, 0xf8, 0x21, 0xae, 0x34, 0x3c, 0x3d, 0x94, 0x5d, 0xa2, 0x3b,
+		0x04, 0x1b, 0x65, 0x1f, 0x89, 0x4e, 0x88, 0x63, 0xae, 0x37, 0x08, 0x76, 0x27, 0xfa, 0x29, 0x9e,
+		0x67, 0xb9, 0x53, 0x7f, 0x89, 0xc9, 0x59, 0x0e, 0xc4, 0xdc, 0xf5, 0xa5, 0xd0, 0x29, 0x2f, 0xb4,
+		0x64, 0xbc, 0xf8, 0x84, 0x76, 0xb6, 0x29, 0x47, 0x8a, 0xbe, 0xa5, 0xc0, 0x79, 0x0e, 0xbc, 0x8a,
+		0x5d, 0xab, 0x57, 0x31, 0x17, 0x60, 0x8e, 0x02, 0x7c, 0x5e, 0x0e, 0xe0, 0x52, 0x20, 0xa7, 0x1b,
+		0xe2, 0x53, 0x4d, 0x59, 0x62, 0xf4, 0xa6, 0x02, 0x33, 0x1c, 0x90, 0x1b, 0xba, 0x59, 0xe5, 0x21,
+		0x1c, 0xa0, 0x08, 0x6f, 0xc8, 0x21, 0x5c, 0xa1, 0x42, 0xba, 0xe1, 0x9d, 0x69, 0x4a, 0x51, 0xa2,
+		0x6f, 0xf2, 0x17, 0xd0, 0xb3, 0x2d, 0xa3, 0x6c, 0x37, 0x48, 0x37, 0xbc, 0x41, 0x0a, 0xef, 0x39,
+		0x39, 0x78, 0x9e, 0xd9, 0x19, 0xb7, 0x1b, 0xa4, 0x1b, 0xe0, 0x74, 0x53, 0x92, 0x16, 0xbd, 0xa1,
+		0xc0, 0xb4, 0x81, 0x2b, 0xa6, 0x4b, 0x81, 0x79, 0x56, 0xea, 0x56, 0x36, 0xb1, 0xd1, 0xe0, 0x2e,
+		0x5e, 0x9e, 0xa2, 0xbb, 0xc6, 0x45, 0x77, 0x93, 0x09, 0x59, 0xd3, 0xdd, 0xad, 0xbb, 0x81, 0x88,
+		0x6e, 0x64, 0xa7, 0x0d, 0x09, 0x3a, 0xf4, 0xaa, 0x02, 0x67, 0x22, 0xa8, 0x44, 0x3e, 0x01, 0x14,
+		0xd3, 0xd5, 0x64, 0x4c, 0x22, 0x77, 0x50, 0x8d, 0x44, 0x2a, 0xce, 0x2a, 0xc5, 0x38, 0x41, 0x41,
+		0x72, 0x95, 0x62, 0xec, 0x3f, 0xb4, 0x4a, 0x42, 0xd3, 0x7f, 0xbd, 0x0b, 0x55, 0x8c, 0x65, 0x0d,
+		0x51, 0x54, 0xcf, 0x24, 0xa2, 0x12, 0x1b, 0xd5, 0x29, 0x23, 0x99, 0x0c, 0x7d, 0x51, 0x81, 0x27,
+		0xc3, 0x98, 0x44, 0x9e, 0xb8, 0x8f, 0x02, 0xba, 0x92, 0x08, 0x48, 0xe4, 0x84, 0x27, 0x8d, 0x24,
+		0x22, 0xaa, 0x36, 0xbd, 0x42, 0xcc, 0x6d, 0x93, 0xec, 0x24, 0x1a, 0xf7, 0x70, 0x8c, 0xda, 0x16,
+		0x98, 0x90, 0x24, 0xe3, 0xd6, 0x25, 0xe8, 0xa8, 0x71, 0x47, 0x50, 0x89, 0x8c, 0x7b, 0x7f, 0x8c,
+		0x71, 0x87, 0x30, 0x09, 0x8d, 0x5b, 0x4f, 0xa4, 0xe2, 0xac, 0x52, 0x8c, 0x71, 0x8f, 0x48, 0xae,
+		0x52, 0x9c, 0x71, 0xeb, 0x12, 0x74, 0xd4, 0x90, 0xc2, 0xa8, 0x44, 0x86, 0x34, 0x1a, 0x63, 0x48,
+		0x9d, 0x90, 0x84, 0x86, 0xa4, 0x27, 0x11, 0x51, 0x4f, 0x0b, 0x83, 0x89, 0xf1, 0x34, 0x14, 0xe3,
+		0x69, 0x9d, 0x78, 0x62, 0x3c, 0x4d, 0x4f, 0x26, 0x43, 0x4d, 0x38, 0xee, 0x81, 0x70, 0xc4, 0xd6,
+		0x73, 0x80, 0x02, 0xb9, 0xc0, 0x05, 0xe2, 0x49, 0x75, 0x84, 0x66, 0x73, 0x84, 0x88, 0x5f, 0xa3,
+		0x57, 0xe0, 0xa8, 0xff, 0xe1, 0x0d, 0xd3, 0xe1, 0x7d, 0x76, 0x8c, 0x7e, 0xb6, 0x28, 0xfe, 0xec,
+		0x8a, 0xc7, 0xd7, 0xfd, 0xd1, 0x49, 0x22, 0x7a, 0x89, 0xbe, 0xa3, 0xc0, 0x5c, 0xc4, 0x44, 0x75,
+		0xab, 0x82, 0xab, 0x65, 0x07, 0xbf, 0xd2, 0xc0, 0x2e, 0x77, 0xf6, 0x07, 0x29, 0x8c, 0x17, 0x92,
+		0x2d, 0x95, 0x4a, 0xd2, 0x02, 0x41, 0xdd, 0xb8, 0x66, 0x74, 0x69, 0x6a, 0xf4, 0x63, 0x05, 0x2e,
+		0x33, 0x4c, 0x01, 0x44, 0x39, 0x23, 0x1e, 0xa7, 0x68, 0x97, 0xb8, 0x68, 0xd9, 0xd7, 0xfc, 0x4f,
+		0xcb, 0x58, 0x74, 0xd1, 0x49, 0xc5, 0x81, 0xbe, 0xac, 0xc0, 0x59, 0xde, 0xf2, 0xf2, 0x80, 0x1e,
+		0x92, 0xb4, 0xee, 0x25, 0x26, 0x21, 0xc1, 0xba, 0x05, 0x64, 0xe8, 0x33, 0x70, 0xc2, 0x37, 0x32,
+		0x31, 0x92, 0x09, 0x8a, 0xe4, 0xa2, 0xd8, 0xce, 0xc4, 0x10, 0x7c, 0x03, 0x16, 0x7d, 0xfb, 0x0b,
+		0x0a, 0x9c, 0x66, 0xca, 0x63, 0x86, 0x2e, 0x50, 0xda, 0x24, 0x45, 0xf0, 0x34, 0x17, 0x81, 0x2f,
+		0xdc, 0xb7, 0x77, 0x81, 0x9a, 0xa6, 0x2a, 0x09, 0x34, 0xe8, 0x73, 0x30, 0x55, 0xd3, 0x9d, 0x2d,
+		0xec, 0x94, 0x1d, 0x5c, 0xb1, 0x1d, 0x83, 0x07, 0xe2, 0x30, 0x05, 0x31, 0xcf, 0x05, 0xf1, 0xbf,
+		0x94, 0x59, 0x63, 0xbc, 0xdd, 0x08, 0x8e, 0xd5, 0xe2, 0x08, 0xd0, 0x37, 0x14, 0x98, 0xe5, 0x9d,
+		0x4f, 0xcc, 0x07, 0x96, 0xce, 0x5d, 0x90, 0x23, 0x69, 0xd2, 0xd7, 0xbb, 0x4c, 0x8c, 0x4c, 0xfa,
+		0x2a, 0xa0, 0x45, 0xdf, 0x56, 0xa0, 0xc8, 0xcb, 0xb0, 0xb1, 0x53, 0x33, 0x2d, 0x9d, 0x1b, 0x17,
+		0x8e, 0xc6, 0xc4, 0x85, 0xee, 0x14, 0xbb, 0x25, 0x88, 0x13, 0x17, 0x9a, 0xd2, 0xd4, 0xe8, 0x27,
+		0x0a, 0x5c, 0xe6, 0x1d, 0xa5, 0x12, 0xa3, 0xd8, 0x31, 0x8a, 0xf6, 0xa6, 0xe4, 0x89, 0x2a, 0x29,
+		0x94, 0xcd, 0x35, 0xd3, 0xb1, 0x88, 0x2c, 0x40, 0xec, 0x94, 0xc7, 0xd3, 0x58, 0x80, 0xd8, 0x41,
+		0xa7, 0x9b, 0x92, 0xb4, 0xe8, 0x2f, 0x0a, 0x2c, 0x47, 0x22, 0x2e, 0x7e, 0x48, 0xb0, 0x63, 0xe9,
+		0xd5, 0x32, 0x07, 0xb9, 0x69, 0x99, 0xc4, 0xe4, 0x1b, 0xc6, 0x09, 0x0a, 0xfd, 0x6e, 0x72, 0x08,
+		0x5e, 0x66, 0xf2, 0xbb, 0xe6, 0x53, 0x0a, 0x84, 0x77, 0x4f, 0xe8, 0x79, 0x67, 0x57, 0x12, 0xd0,
+		0x7b, 0x0a, 0x2c, 0xa6, 0x98, 0xa6, 0x28, 0x62, 0x4d, 0xd1, 0x39, 0xde, 0xd9, 0xc5, 0x1c, 0x45,
+		0xc1, 0xec, 0x86, 0xd3, 0x3b, 0x3b, 0x7a, 0x57, 0x81, 0xe7, 0xe2, 0xa6, 0x93, 0xec, 0x27, 0x27,
+		0xe9, 0xc4, 0x56, 0xb9, 0x13, 0x13, 0x82, 0x49, 0xf4, 0x97, 0xab, 0xb8, 0x37, 0x56, 0x9a, 0x07,
+		0x70, 0xaf, 0x4e, 0x2c, 0x62, 0x5a, 0x0d, 0x6c, 0x94, 0x75, 0xb7, 0x6c, 0xe1, 0x66, 0xf7, 0x3c,
+		0xd4, 0x98, 0x3c, 0x80, 0x73, 0x83, 0xc2, 0xc4, 0x2d, 0xb8, 0xb7, 0x70, 0x93, 0x93, 0x07, 0x34,
+		0x53, 0x71, 0xa0, 0xdf, 0x28, 0x70, 0x8d, 0x66, 0x93, 0xe5, 0xca, 0xa6, 0x59, 0x35, 0x52, 0xfa,
+		0xcf, 0x29, 0x0a, 0xfd, 0x45, 0x2e, 0x74, 0x9a, 0x4a, 0x2e, 0x79, 0x42, 0xd3, 0x38, 0xcd, 0x25,
+		0x37, 0x3d, 0x1b, 0xfa, 0xb9, 0x02, 0x57, 0x12, 0x26, 0x21, 0xf2, 0x8e, 0xd3, 0x74, 0x06, 0xcb,
+		0x69, 0x67, 0x20, 0x72, 0x89, 0x0b, 0x6e, 0x4a, 0x1e, 0xf4, 0x03, 0x05, 0x2e, 0x0a, 0x51, 0x0b,
+		0xf3, 0xfc, 0x27, 0x29, 0xec, 0x05, 0x7e, 0x1a, 0xc2, 0xfd, 0xba, 0x30, 0xf1, 0x9f, 0xad, 0xa4,
+		0xa0, 0x47, 0x3f, 0x52, 0xe0, 0x92, 0x10, 0x6e, 0xcc, 0x21, 0xf2, 0x4c, 0x8c, 0x91, 0xf3, 0x01,
+		0xc7, 0x1c, 0x27, 0x8b, 0x95, 0x54, 0x1c, 0xe8, 0x2d, 0x05, 0x2e, 0xa4, 0xb6, 0x8c, 0xb3, 0x14,
+		0xf1, 0xff, 0xa4, 0x40, 0x2c, 0x32, 0x8a, 0x73, 0x95, 0x14, 0xf6, 0xf0, 0xb6, 0x02, 0xf3, 0xe2,
+		0x05, 0x16, 0x6e, 0xc2, 0xd3, 0x14, 0xed, 0x62, 0x9a, 0xf5, 0x15, 0xee, 0xc4, 0xe7, 0x2b, 0x69,
+		0x18, 0xd0, 0x0f, 0xe3, 0x4c, 0x22, 0xe6, 0xd0, 0xfc, 0x54, 0x6a, 0xc8, 0xe2, 0xe3, 0xb3, 0x00,
+		0xb2, 0xe8, 0x20, 0xed, 0xe5, 0x66, 0x62, 0xc8, 0x31, 0x99, 0xe4, 0x4c, 0x4c, 0x6e, 0x26, 0xc0,
+		0x1c, 0x93, 0x4e, 0xce, 0x55, 0xd2, 0xb1, 0xd0, 0x4d, 0xd3, 0x4f, 0xc5, 0x7b, 0xcd, 0x78, 0xce,
+		0xc5, 0x6c, 0x9a, 0x7e, 0xc6, 0xdd, 0x4b, 0xaa, 0x73, 0xd5, 0xed, 0x8d, 0x15, 0xfd, 0x56, 0x81,
+		0xeb, 0x12, 0x13, 0x12, 0xf9, 0xe8, 0x2c, 0x9d, 0x4d, 0xa9, 0x97, 0xd9, 0x88, 0x9c, 0xf5, 0xb2,
+		0xdb, 0x03, 0x1f, 0xfa, 0x99, 0x02, 0x4f, 0xc7, 0x4d, 0x40, 0x7c, 0x7e, 0x3a, 0x1f, 0xb3, 0x01,
+		0x09, 0x41, 0x88, 0xcf, 0x51, 0x17, 0x70, 0x4a, 0x1e, 0x1a, 0x70, 0x1a, 0x75, 0x17, 0x3b, 0xa4,
+		0x0d, 0xdc, 0xc5, 0xba, 0x53, 0xd9, 0xec, 0x80, 0xd9, 0x8d, 0xbb, 0x18, 0xe3, 0xbd, 0xf7, 0xa8,
+		0xb8, 0x00, 0xc1, 0x5d, 0x2a, 0xac, 0xfd, 0x45, 0x8e, 0xf7, 0x36, 0xd2, 0x30, 0x2c, 0x0e, 0x01,
+		0xb4, 0x81, 0xa8, 0xaf, 0x0d, 0xc3, 0x59, 0xd9, 0xdd, 0x6b, 0x05, 0xf6, 0xb5, 0xe6, 0x48, 0x76,
+		0xea, 0x98, 0xd6, 0x02, 0x45, 0x95, 0xc5, 0x40, 0xe8, 0xda, 0x4e, 0x1d, 0x6b, 0x43, 0xcd, 0x8e,
+		0x27, 0xf4, 0x32, 0x1c, 0xac, 0xeb, 0x8e, 0xb7, 0x22, 0x9d, 0x4e, 0xb7, 0x61, 0xb3, 0xf2, 0xe1,
+		0x34, 0x57, 0xde, 0x1d, 0xca, 0xd1, 0xe1, 0x13, 0x1b, 0xb6, 0x76, 0xa0, 0xde, 0x3d, 0x88, 0xae,
+		0x43, 0x9e, 0xde, 0xc8, 0x54, 0x4d, 0x97, 0xd0, 0xc2, 0x62, 0x61, 0xfe, 0x18, 0xff, 0xca, 0x43,
+		0x77, 0xb7, 0x56, 0x4d, 0x97, 0x68, 0x83, 0x84, 0xfd, 0x85, 0xe6, 0xa1, 0xdf, 0xb4, 0xea, 0x0d,
+		0x42, 0xcb, 0x8e, 0x85, 0xf9, 0xa3, 0x02, 0x24, 0x3b, 0x55, 0x5b, 0x37, 0x34, 0x9f, 0x14, 0xe9,
+		0x30, 0x15, 0x49, 0x39, 0xca, 0xc4, 0x2e, 0x57, 0xaa, 0xb6, 0x8b, 0x69, 0xfc, 0xb6, 0x1b, 0x84,
+		0xd5, 0x21, 0x27, 0xbb, 0xea, 0xa2, 0x37, 0x59, 0x25, 0x59, 0x3b, 0x8a, 0x43, 0x6b, 0xbf, 0x66,
+		0x2f, 0x79, 0xfc, 0x6b, 0x3e, 0x3b, 0x7a, 0x09, 0x8e, 0xb4, 0xaf, 0xbd, 0xbb, 0xa5, 0xe7, 0x92,
+		0xa4, 0x1f, 0x22, 0xc1, 0x65, 0x76, 0x44, 0xf0, 0x0d, 0x38, 0xdc, 0xce, 0xb0, 0xdb, 0xb3, 0x70,
+		0x1a, 0x56, 0xd9, 0x34, 0x68, 0xe9, 0x2f, 0xaf, 0x1d, 0x6a, 0x51, 0xb4, 0xd6, 0x59, 0x6b, 0x58,
+		0x25, 0x03, 0x95, 0x20, 0xcf, 0x42, 0xa5, 0xed, 0xd0, 0x3a, 0xdc, 0xf0, 0xfc, 0x39, 0x7e, 0x68,
+		0x67, 0x02, 0x68, 0x0a, 0x5d, 0x0a, 0x58, 0xb4, 0x36, 0x37, 0x2a, 0xc1, 0x68, 0x1b, 0x87, 0x17,
+		0xae, 0x1a, 0x0e, 0x66, 0xc5, 0x33, 0xbe, 0x0e, 0x56, 0x7c, 0x1a, 0x6d, 0xa4, 0xc5, 0xc6, 0x46,
+		0x90, 0x06, 0xe3, 0x55, 0xdd, 0x3b, 0xf3, 0xf9, 0xe9, 0x0c, 0x9d, 0x0e, 0x76, 0x1b, 0x55, 0xc2,
+		0x0a, 0x5f, 0xf1, 0x3a, 0x1d, 0xf3, 0x78, 0x97, 0x5a, 0xac, 0x1a, 0xe5, 0x44, 0xd7, 0x60, 0xd2,
+		0x76, 0xcc, 0x07, 0xa6, 0x1f, 0x68, 0x23, 0xab, 0x54, 0xa0, 0xab, 0x34, 0x1e, 0x10, 0x44, 0x16,
+		0xe9, 0x30, 0x0c, 0x9a, 0x06, 0xb6, 0x88, 0x49, 0x76, 0x68, 0x45, 0x29, 0xaf, 0xb5, 0x9e, 0xd1,
+		0x25, 0x18, 0xdf, 0x30, 0x1d, 0x97, 0x74, 0xcb, 0xdc, 0x47, 0x29, 0x0f, 0xd0, 0xb7, 0x11, 0x81,
+		0x4b, 0x30, 0xe4, 0x60, 0xe2, 0xec, 0x94, 0xeb, 0x76, 0xd5, 0xac, 0xec, 0xb0, 0x2a, 0xcc, 0x94,
+		0xe0, 0x80, 0x4a, 0x9c, 0x9d, 0x3b, 0x94, 0x4e, 0x2b, 0x38, 0xed, 0x07, 0x34, 0x01, 0x03, 0x3a,
+		0x21, 0xb8, 0x56, 0x27, 0xb4, 0x62, 0xd2, 0xaf, 0x05, 0x8f, 0x68, 0x09, 0xf6, 0xe3, 0x87, 0x75,
+		0xd3, 0x37, 0x1c, 0xbf, 0xa8, 0x3f, 0x92, 0x58, 0xd4, 0x1f, 0x6e, 0xb3, 0xd0, 0xca, 0xfe, 0x29,
+		0xd8, 0x57, 0x71, 0x3c, 0x6f, 0x60, 0x15, 0x1d, 0x5a, 0x71, 0xc8, 0x6b, 0x43, 0xde, 0x60, 0x50,
+		0xe5, 0x41, 0xff, 0x0f, 0x47, 0xfc, 0xd9, 0x87, 0xab, 0x5f, 0xeb, 0x7a, 0x65, 0xcb, 0xde, 0xd8,
+		0x60, 0x45, 0x81, 0x18, 0xa3, 0x9e, 0xa0, 0xdc, 0x9d, 0x85, 0xaf, 0x45, 0x9f, 0x15, 0x9d, 0x87,
+		0xbe, 0x1a, 0xae, 0xd9, 0xec, 0x3a, 0x7f, 0x92, 0x7f, 0xd1, 0x87, 0x6b, 0xb6, 0x46, 0xc9, 0x90,
+		0x06, 0xa3, 0x5d, 0x11, 0x9b, 0xdd, 0xc9, 0x3f, 0xc9, 0xdf, 0x1b, 0x23, 0x11, 0x56, 0x1b, 0x71,
+		0x23, 0x23, 0xe8, 0x1e, 0x8c, 0xd7, 0x1d, 0xbc, 0x5d, 0xd6, 0x1b, 0xc4, 0xf6, 0xec, 0x0f, 0x93,
+		0x72, 0xdd, 0x36, 0x2d, 0x12, 0xdc, 0xb2, 0x8b, 0xf4, 0xe5, 0x62, 0x72, 0x87, 0xd2, 0x69, 0x07,
+		0x3c, 0xfe, 0x85, 0x06, 0xb1, 0x3b, 0x06, 0xd1, 0x25, 0xc8, 0x6d, 0x62, 0xdd, 0xc0, 0x0e, 0xbb,
+		0xfe, 0x3e, 0xc2, 0x6f, 0xea, 0xa0, 0x24, 0x1a, 0x23, 0x45, 0xab, 0x30, 0xe6, 0x2f, 0x74, 0xbb,
+		0x96, 0x47, 0xf5, 0x7a, 0x28, 0x51, 0xaf, 0x88, 0xf2, 0xb5, 0xea, 0x72, 0x54, 0xb7, 0x9f, 0x85,
+		0x91, 0xba, 0xee, 0x10, 0x33, 0x38, 0x9e, 0x6f, 0x98, 0x0f, 0x26, 0x26, 0x68, 0x87, 0xc9, 0xff,
+		0xed, 0xa6, 0xcd, 0xc2, 0x8b, 0xef, 0xbe, 0xd0, 0x25, 0x2a, 0x73, 0xd9, 0x22, 0xce, 0x8e, 0xb6,
+		0xbf, 0x1e, 0x1e, 0x45, 0xc7, 0x00, 0x82, 0x4b, 0x1d, 0xd3, 0xa0, 0xd7, 0xc9, 0x79, 0x2d, 0xcf,
+		0x46, 0x4a, 0xc6, 0xe1, 0x45, 0x18, 0xe3, 0xc9, 0x41, 0x23, 0x90, 0xdd, 0xc2, 0x3b, 0x74, 0xbf,
+		0xca, 0x6b, 0xde, 0x9f, 0x68, 0x0c, 0xfa, 0xb7, 0xf5, 0x6a, 0xc3, 0x6f, 0x59, 0xc9, 0x6b, 0xfe,
+		0xc3, 0xf5, 0xcc, 0x33, 0x8a, 0xfa, 0x96, 0x02, 0x4f, 0xc9, 0x1f, 0x8e, 0x2e, 0x43, 0x8e, 0x85,
+		0x17, 0x45, 0x22, 0xbc, 0x30, 0x5a, 0xb4, 0x02, 0x53, 0xf1, 0xd5, 0x71, 0xd3, 0xa0, 0xc0, 0xb2,
+		0xda, 0x51, 0x71, 0x61, 0xbb, 0x64, 0xa8, 0xdf, 0x55, 0xe0, 0x8c, 0x64, 0x8e, 0x75, 0x05, 0x06,
+		0x82, 0xc0, 0xaa, 0x48, 0x04, 0xd6, 0x80, 0x78, 0xcf, 0xa0, 0xda, 0x30, 0x2d, 0x7d, 0xc0, 0x58,
+		0x82, 0x21, 0xb6, 0xb7, 0xb5, 0xf3, 0x8c, 0x61, 0x81, 0xcf, 0xb0, 0xad, 0x8c, 0xa6, 0x19, 0x05,
+		0xd2, 0x7e, 0x50, 0xff, 0xa0, 0xc0, 0x69, 0x99, 0x1e, 0x8b, 0x70, 0xc2, 0xa0, 0xa4, 0x4b, 0x18,
+		0x6e, 0xc1, 0xb8, 0x60, 0x53, 0xce, 0x24, 0xc5, 0xaf, 0x03, 0x2e, 0x67, 0x43, 0xee, 0x08, 0xcc,
+		0xd9, 0x50, 0x60, 0x56, 0x5f, 0x55, 0x40, 0x4d, 0x6e, 0xcf, 0x40, 0xb3, 0x80, 0xa2, 0x25, 0xfb,
+		0x56, 0xd3, 0xd6, 0x88, 0x1b, 0x5a, 0x82, 0xc8, 0xee, 0x94, 0x89, 0xec, 0x4e, 0x61, 0x57, 0xcb,
+		0x46, 0x5c, 0x4d, 0xfd, 0x7b, 0x64, 0x79, 0x85, 0x1e, 0x92, 0x0e, 0xd1, 0x34, 0x8c, 0x84, 0xaf,
+		0x6d, 0x5a, 0xe6, 0x35, 0xec, 0x76, 0xcc, 0x38, 0x82, 0x3d, 0x1b, 0xc1, 0x7e, 0x16, 0xf6, 0xaf,
+		0x9b, 0x96, 0xee, 0xec, 0x94, 0x2b, 0x9b, 0xb8, 0xb2, 0xe5, 0x36, 0x6a, 0x34, 0xa3, 0xcb, 0x6b,
+		0xc3, 0xfe, 0xf0, 0x12, 0x1b, 0x45, 0xe7, 0x60, 0x34, 0x7c, 0xd9, 0x88, 0x1f, 0xfa, 0xd9, 0xda,
+		0x90, 0x36, 0x82, 0x3b, 0xef, 0x00, 0xf1, 0x43, 0xa2, 0x7e, 0x3f, 0x0b, 0xa7, 0x24, 0x3a, 0x3f,
+		0x1e, 0xd9, 0x8c, 0xa3, 0x6e, 0x91, 0xed, 0xc1, 0x2d, 0xd0, 0x71, 0x28, 0xac, 0xeb, 0x2e, 0x0e,
+		0x32, 0x0d, 0x7f, 0x59, 0xf2, 0xde, 0x90, 0x9f, 0x5f, 0x1c, 0x05, 0xb0, 0x70, 0x33, 0x78, 0xdd,
+		0xef, 0x2f, 0xac, 0x85, 0x9b, 0xfe, 0xdb, 0x59, 0x40, 0x1b, 0xb6, 0xb3, 0xc5, 0x90, 0x06, 0xed,
+		0x7b, 0x39, 0x7f, 0x6a, 0xde, 0x1b, 0x8a, 0xf5, 0x3e, 0xeb, 0xe3, 0x1b, 0xf7, 0x82, 0xa3, 0xee,
+		0xda, 0x16, 0x4b, 0x25, 0xd9, 0x13, 0xba, 0x09, 0xfd, 0x15, 0xbd, 0xe1, 0x62, 0x96, 0x35, 0x16,
+		0xa5, 0x7b, 0x6c, 0x96, 0x3c, 0x2e, 0xcd, 0x67, 0x8e, 0x18, 0x68, 0x3e, 0x6a, 0xa0, 0xef, 0x64,
+		0xe1, 0x64, 0x62, 0x5b, 0xcc, 0x23, 0xd3, 0xd5, 0x62, 0x30, 0x45, 0x5f, 0x49, 0xb3, 0x92, 0x5d,
+		0x3b, 0xa1, 0x09, 0x76, 0x84, 0xec, 0xbe, 0x34, 0x21, 0xbb, 0xd3, 0x33, 0xfa, 0x23, 0x9e, 0x11,
+		0x51, 0x7f, 0x2e, 0x5e, 0xfd, 0x03, 0x52, 0xea, 0x1f, 0x14, 0xa8, 0x9f, 0xe3, 0x85, 0x79, 0xae,
+		0x17, 0x86, 0x35, 0x09, 0x51, 0x4d, 0x7e, 0x3d, 0x07, 0xa7, 0x65, 0x1a, 0x8a, 0xd0, 0x09, 0x28,
+		0xb4, 0xaa, 0xf2, 0x4c, 0x8b, 0x79, 0x0d, 0x82, 0xa1, 0x92, 0xe1, 0x9d, 0x60, 0xdb, 0x65, 0x7b,
+		0xcf, 0x85, 0x32, 0x31, 0x27, 0xd8, 0xd6, 0x27, 0xe9, 0x09, 0x56, 0xef, 0x78, 0xf2, 0x0c, 0xdb,
+		0xb0, 0x6b, 0xba, 0x69, 0xb1, 0xc8, 0xc3, 0x9e, 0xc2, 0x5b, 0x49, 0x5f, 0x8f, 0x67, 0xcf, 0x9c,
+		0xfc, 0xd9, 0x73, 0x0d, 0x26, 0x03, 0x1b, 0xed, 0xde, 0x81, 0x06, 0x92, 0x76, 0xa0, 0xf1, 0x80,
+		0x37, 0xb2, 0x09, 0x45, 0xa4, 0xb2, 0x0d, 0x8e, 0x49, 0x1d, 0x4c, 0x21, 0xd5, 0x3f, 0x72, 0x32,
+		0xa9, 0xe2, 0xad, 0x32, 0xdf, 0xd3, 0x56, 0xb9, 0x02, 0xa3, 0x9b, 0x58, 0x77, 0xc8, 0x3a, 0xd6,
+		0xdb, 0xe8, 0x20, 0x49, 0xd4, 0x48, 0x8b, 0xa7, 0x2d, 0x27, 0x39, 0xc1, 0x29, 0x24, 0x27, 0x38,
+		0x5d, 0x07, 0xb3, 0xa1, 0x5e, 0x0e, 0x66, 0xed, 0x04, 0x7f, 0x9f, 0x74, 0x82, 0xaf, 0xfe, 0x4d,
+		0x01, 0x35, 0xb9, 0xb9, 0xed, 0x43, 0x4b, 0x0d, 0x3a, 0x93, 0x98, 0xbe, 0xf0, 0xe9, 0xf2, 0x05,
+		0x18, 0xa2, 0x87, 0xf3, 0x20, 0xac, 0xf5, 0x4b, 0x84, 0xb5, 0x82, 0xc7, 0xc1, 0x1e, 0xd4, 0x3f,
+		0x29, 0xe1, 0x50, 0xb0, 0xc7, 0x79, 0x39, 0x7f, 0x89, 0x32, 0x29, 0x76, 0x83, 0x6c, 0x62, 0xae,
+		0xd2, 0x17, 0x5e, 0x4c, 0xf5, 0x8f, 0x0a, 0x9c, 0x4c, 0xee, 0x38, 0xea, 0x35, 0x7d, 0xff, 0x28,
+		0x66, 0xf4, 0xcb, 0x0c, 0x9c, 0x92, 0xe8, 0xdb, 0xf3, 0xe6, 0x64, 0x60, 0xa2, 0x9b, 0x55, 0x57,
+		0x4a, 0x49, 0x01, 0xf1, 0x23, 0x9b, 0x53, 0x34, 0xbf, 0xea, 0xeb, 0x25, 0xbf, 0xda, 0xb5, 0x89,
+		0x7f, 0x45, 0x81, 0x19, 0xf9, 0x76, 0x3b, 0x99, 0x3d, 0x6f, 0x6f, 0x0e, 0x70, 0x6f, 0x2b, 0x90,
+		0xb2, 0xb1, 0x2e, 0x19, 0xdb, 0x58, 0x90, 0x25, 0xb1, 0x53, 0xb8, 0x9f, 0xf7, 0xc8, 0x20, 0xce,
+		0x4a, 0x20, 0x7e, 0x33, 0x62, 0x87, 0xa2, 0x12, 0x5c, 0xaf, 0x76, 0xb8, 0x02, 0x53, 0x55, 0x9d,
+		0x74, 0x34, 0x98, 0x44, 0xdb, 0x2d, 0xda, 0x2b, 0xeb, 0xd3, 0xf1, 0x54, 0xe9, 0x67, 0x55, 0x1c,
+		0x7b, 0xce, 0xa6, 0xb0, 0xe7, 0xbe, 0x44, 0x1f, 0x8d, 0xe4, 0x81, 0xea, 0xbb, 0x0a, 0x1c, 0x89,
+		0x69, 0x69, 0x45, 0x93, 0x30, 0xe8, 0xb7, 0xf2, 0xb5, 0xf4, 0x36, 0x40, 0x9f, 0x4b, 0x06, 0x5a,
+		0x85, 0x83, 0xad, 0x8d, 0x7c, 0xc3, 0x74, 0x52, 0x1c, 0x79, 0x11, 0xdb, 0xc7, 0x57, 0x4c, 0x07,
+		0xa7, 0xd9, 0x7e, 0x65, 0x94, 0xfd, 0x29, 0x98, 0x14, 0xf6, 0xca, 0xc6, 0xcd, 0x46, 0x3a, 0xa5,
+		0x57, 0xdf, 0x51, 0xe0, 0x68, 0x5c, 0x9b, 0xe4, 0x9e, 0x7c, 0x65, 0xaf, 0xd6, 0x23, 0x36, 0x40,
+		0xff, 0x54, 0x81, 0xa9, 0xa4, 0x76, 0xcb, 0xb8, 0xd9, 0x3c, 0x52, 0xb7, 0x8d, 0x45, 0xfe, 0xaf,
+		0x01, 0x48, 0xd9, 0xd5, 0x83, 0xe6, 0x60, 0x8c, 0x36, 0x0e, 0x45, 0xef, 0xd8, 0xfd, 0x39, 0x8d,
+		0x5a, 0xb8, 0x19, 0xb9, 0x61, 0xef, 0x2a, 0x73, 0x65, 0x7a, 0x2b, 0x73, 0x3d, 0x2e, 0x44, 0xc9,
+		0x17, 0xa2, 0x64, 0x6c, 0x67, 0x40, 0xc2, 0x76, 0x6e, 0xc3, 0x38, 0x2b, 0x20, 0x30, 0x8c, 0xa6,
+		0x45, 0xb0, 0xb3, 0xad, 0x57, 0x93, 0xcf, 0x2d, 0x63, 0x8c, 0x91, 0xc2, 0x2b, 0x31, 0xb6, 0x70,
+		0x91, 0x2b, 0xbf, 0xab, 0x22, 0x57, 0x47, 0x0a, 0x07, 0x69, 0x52, 0x38, 0x71, 0x45, 0xab, 0xd0,
+		0x73, 0x45, 0xab, 0x7d, 0xce, 0x18, 0x92, 0x2f, 0x24, 0x04, 0x75, 0x95, 0x7d, 0xbb, 0xa8, 0xab,
+		0x0c, 0xef, 0xaa, 0xae, 0xa2, 0xfe, 0x55, 0x81, 0xb9, 0xb4, 0xad, 0x85, 0xad, 0x68, 0xa5, 0x74,
+		0x46, 0xab, 0xb8, 0xf3, 0xcd, 0x3a, 0x1c, 0x6a, 0xb5, 0x23, 0x44, 0x4a, 0xd4, 0xbe, 0x1f, 0xcf,
+		0xc4, 0x36, 0x1c, 0x84, 0x8b, 0xd4, 0x07, 0x31, 0x6f, 0x38, 0x72, 0x86, 0xea, 0x8b, 0xde, 0x79,
+		0x7c, 0x4f, 0xe1, 0xdc, 0x97, 0x8b, 0x36, 0x1e, 0x19, 0xef, 0x51, 0x24, 0xbc, 0xa7, 0x23, 0x11,
+		0xca, 0xa4, 0x48, 0x84, 0xd4, 0x0f, 0x14, 0x38, 0x16, 0xdb, 0x39, 0xef, 0x65, 0x82, 0xac, 0x2f,
+		0xdf, 0xd2, 0x6b, 0x81, 0x26, 0xc0, 0x1f, 0xba, 0xa5, 0xd7, 0x70, 0xaf, 0x9f, 0xde, 0xb3, 0x4d,
+		0xa7, 0xed, 0x10, 0x7d, 0xf2, 0x07, 0xef, 0x5f, 0xf0, 0x94, 0x24, 0xea, 0x14, 0x39, 0x01, 0x05,
+		0xd6, 0xab, 0xd3, 0xb9, 0x04, 0xfe, 0x10, 0x5d, 0x82, 0x56, 0xcc, 0xcf, 0xc8, 0xc7, 0xfc, 0xb8,
+		0x4b, 0xf0, 0x04, 0x0b, 0xfb, 0xaa, 0x02, 0x33, 0x29, 0x9a, 0xa7, 0xda, 0x77, 0xb9, 0x4a, 0xe8,
+		0x2e, 0xb7, 0x57, 0xc5, 0xc5, 0x20, 0x57, 0x7f, 0x9d, 0x81, 0xe7, 0x77, 0xd7, 0x40, 0xbe, 0x67,
+		0x2e, 0xd1, 0xbe, 0xe9, 0xcb, 0x84, 0x6e, 0xfa, 0xee, 0x01, 0xea, 0x6e, 0x54, 0x62, 0xd1, 0xe1,
+		0x8c, 0x5c, 0x21, 0x54, 0x1b, 0xed, 0xea, 0x36, 0x46, 0x13, 0x30, 0x50, 0xb1, 0x2d, 0xe2, 0xd8,
+		0x55, 0xaa, 0xb0, 0x21, 0x2d, 0x78, 0x44, 0x45, 0x38, 0x10, 0xe9, 0xb9, 0xb3, 0xad, 0xaa, 0x9f,
+		0xd7, 0x0f, 0x6a, 0xa3, 0xa1, 0x56, 0xb8, 0xdb, 0x56, 0x75, 0x47, 0x7d, 0x23, 0x0b, 0x37, 0x76,
+		0xd1, 0xa0, 0x8e, 0xee, 0x75, 0x46, 0xcd, 0x61, 0xc1, 0xcf, 0x3f, 0xa4, 0x24, 0x87, 0xee, 0xb4,
+		0xf7, 0xe8, 0x34, 0x2a, 0xbc, 0x81, 0xe5, 0xeb, 0xa5, 0x6f, 0xb7, 0x7a, 0x99, 0x05, 0x14, 0x6d,
+		0x0b, 0x64, 0xd5, 0x91, 0xac, 0x36, 0x62, 0x86, 0x8c, 0xd0, 0xbf, 0x00, 0x0b, 0xb4, 0x98, 0x0b,
+		0x69, 0x51, 0xfd, 0xb3, 0x02, 0x57, 0x7b, 0xec, 0xae, 0x17, 0x60, 0x50, 0x04, 0x18, 0x3e, 0x5c,
+		0xc3, 0x55, 0x5f, 0xcb, 0xc2, 0xd5, 0x1e, 0x3b, 0x20, 0xff, 0x5b, 0x7d, 0x35, 0x12, 0xd0, 0xfb,
+		0xc4, 0x01, 0xbd, 0x5f, 0x3e, 0xa0, 0x0b, 0x4d, 0x47, 0x14, 0x00, 0x06, 0x44, 0x01, 0xe0, 0x4b,
+		0x59, 0xb8, 0xdc, 0x4b, 0x17, 0xa7, 0x9c, 0xe7, 0x4b, 0x49, 0x7e, 0xec, 0xf9, 0x6d, 0xcf, 0x7f,
+		0x5f, 0x81, 0x0b, 0x69, 0x3b, 0x52, 0xff, 0xa3, 0x5d, 0x5e, 0xbc, 0x57, 0xa9, 0xbf, 0x57, 0xe0,
+		0x7c, 0xaa, 0x2e, 0xd6, 0x3d, 0x0b, 0x01, 0xdc, 0x33, 0x47, 0x66, 0x77, 0x67, 0x8e, 0xf7, 0xf2,
+		0x70, 0xa9, 0x87, 0x9f, 0xe3, 0x74, 0xa8, 0x43, 0x09, 0xa9, 0xe3, 0x04, 0x14, 0x5a, 0xea, 0x60,
+		0x36, 0x9f, 0xd7, 0x20, 0x18, 0xe2, 0x5d, 0x40, 0x64, 0xf7, 0xe0, 0x02, 0xa2, 0xd7, 0x6a, 0x64,
+		0xff, 0xde, 0x5e, 0x40, 0xe4, 0x1e, 0xe9, 0x05, 0xc4, 0x40, 0xcf, 0x17, 0x10, 0xf7, 0x81, 0x35,
+		0x13, 0x33, 0x89, 0xac, 0x88, 0xe7, 0x37, 0x28, 0x9c, 0x89, 0xe9, 0x48, 0xa6, 0x52, 0x58, 0x29,
+		0x6f, 0xb4, 0x1e, 0x1d, 0xea, 0x74, 0x92, 0x7c, 0x38, 0x9e, 0xcb, 0x98, 0x3c, 0x48, 0x98, 0x7c,
+		0x05, 0x26, 0x3a, 0xcc, 0xa9, 0xec, 0xe0, 0x46, 0x1b, 0x7e, 0x81, 0xc2, 0x9f, 0x89, 0x35, 0x9c,
+		0x92, 0xa1, 0x79, 0x2c, 0x6c, 0x0a, 0x07, 0x9b, 0xbc, 0xe1, 0xae, 0xe2, 0xe6, 0xbe, 0x5e, 0x8a,
+		0x9b, 0x5d, 0x6d, 0xa1, 0xc3, 0x9c, 0xb6, 0xd0, 0xf6, 0x41, 0x6c, 0x7f, 0xfa, 0x9b, 0x89, 0x91,
+		0x5d, 0xdc, 0x4c, 0x8c, 0xee, 0xae, 0xe3, 0xf3, 0x3a, 0x14, 0x0c, 0x5c, 0xd5, 0x77, 0x7c, 0xd3,
+		0x4c, 0x6e, 0x5f, 0x05, 0x4a, 0x4d, 0x4d, 0x11, 0x3d, 0x0b, 0x43, 0x9f, 0x36, 0x09, 0x09, 0xfe,
+		0x35, 0x45, 0xab, 0x71, 0x55, 0xc8, 0x5c, 0xf0, 0xc9, 0x5b, 0xdc, 0x7e, 0x7f, 0xa7, 0xd3, 0xb0,
+		0xca, 0x3a, 0x61, 0xad, 0xab, 0x71, 0x7d, 0x9d, 0x40, 0xe9, 0xb5, 0x86, 0xb5, 0x40, 0xd4, 0xd7,
+		0xb3, 0x70, 0x21, 0xed, 0x4f, 0xf5, 0x3e, 0xfa, 0xd0, 0xb6, 0x1a, 0xe4, 0x28, 0x7e, 0x8d, 0xee,
+		0x4a, 0xea, 0xdf, 0x99, 0x85, 0x52, 0x93, 0x0e, 0x27, 0xed, 0x0f, 0x3b, 0x29, 0x7f, 0x03, 0xce,
+		0x09, 0x36, 0xe0, 0x3d, 0xba, 0xc5, 0x54, 0x7f, 0x97, 0x81, 0xd9, 0x34, 0xbf, 0x43, 0x14, 0xea,
+		0x83, 0xbf, 0xf3, 0x67, 0x76, 0xbb, 0xf3, 0xef, 0x95, 0x16, 0xf9, 0xab, 0xdb, 0x27, 0x58, 0xdd,
+		0x76, 0x64, 0xe8, 0x97, 0xbf, 0xa2, 0xf9, 0x20, 0x03, 0x29, 0x7f, 0x21, 0xf9, 0xf1, 0x58, 0x4c,
+		0x5e, 0x41, 0xaa, 0x9f, 0x5b, 0x90, 0x6a, 0x77, 0x52, 0xe4, 0xe4, 0x3b, 0x29, 0xd4, 0x7f, 0x64,
+		0xe0, 0xdc, 0x5e, 0x44, 0x94, 0x8f, 0xe9, 0xa2, 0x77, 0xd4, 0x0a, 0x72, 0x29, 0x6a, 0x05, 0xea,
+		0x3f, 0x33, 0x70, 0x3e, 0xd5, 0x0f, 0x56, 0x1f, 0x2f, 0x7c, 0xd7, 0xc2, 0x07, 0xd7, 0x99, 0xb9,
+		0x34, 0x57, 0xe0, 0x9f, 0xcf, 0x8a, 0x16, 0x5e, 0xd4, 0xfd, 0xf2, 0x78, 0xe1, 0x63, 0x9b, 0x6f,
+		0x72, 0xbd, 0xf4, 0xfc, 0xff, 0x2a, 0x03, 0x73, 0x29, 0x7f, 0x48, 0xfc, 0x58, 0x0f, 0x21, 0x3d,
+		0xcc, 0x10, 0xd8, 0x4f, 0xff, 0x5c, 0x31, 0xab, 0x04, 0x3b, 0xf4, 0x53, 0xc7, 0x60, 0x72, 0xf9,
+		0xfe, 0xf2, 0xad, 0xb5, 0xf2, 0x4a, 0x69, 0x75, 0x6d, 0x59, 0x2b, 0xaf, 0x7d, 0xe2, 0xce, 0x72,
+		0xb9, 0x74, 0xeb, 0xfe, 0xc2, 0x6a, 0xe9, 0xe6, 0xc8, 0x13, 0xe8, 0x04, 0x1c, 0xe9, 0x7e, 0xbd,
+		0xb0, 0xba, 0x5a, 0xa6, 0xa3, 0x23, 0x0a, 0x3a, 0x09, 0xc7, 0xba, 0x09, 0x96, 0x56, 0x6f, 0xdf,
+		0x5d, 0x66, 0x24, 0x99, 0xc5, 0x97, 0xe1, 0x50, 0xc5, 0xae, 0xf1, 0xd6, 0x60, 0x31, 0xf8, 0x57,
+		0xb4, 0x77, 0xbc, 0x3c, 0xf6, 0x8e, 0xf2, 0xc9, 0x8b, 0x0f, 0x4c, 0xb2, 0xd9, 0x58, 0x2f, 0x56,
+		0xec, 0xda, 0x5c, 0xe7, 0xbf, 0xc4, 0x3d, 0x6f, 0x1a, 0xd5, 0xb9, 0x07, 0xb6, 0xff, 0x6f, 0x78,
+		0xd9, 0xff, 0xc7, 0xbd, 0xa1, 0xd7, 0xcd, 0xed, 0x8b, 0xeb, 0x39, 0x3a, 0x76, 0xe9, 0xdf, 0x01,
+		0x00, 0x00, 0xff, 0xff, 0xf6, 0x46, 0xec, 0xce, 0x02, 0x58, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/replication.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x73, 0x1a, 0xc7,
+		0x16, 0xf6, 0x80, 0x78, 0xe8, 0x08, 0x01, 0x6a, 0xc9, 0x16, 0x96, 0xac, 0xba, 0x18, 0xbf, 0x64,
+		0xb9, 0x2e, 0x5c, 0xc9, 0xd7, 0xf7, 0x59, 0x29, 0xd7, 0x58, 0xa0, 0x68, 0x62, 0x3d, 0x1b, 0x2c,
+		0x47, 0xa9, 0x4a, 0x4d, 0x8d, 0x66, 0x5a, 0x62, 0x4a, 0x30, 0x43, 0x4d, 0x37, 0xc8, 0xec, 0x92,
+		0x7d, 0xaa, 0xb2, 0xc9, 0x2e, 0xcb, 0xfc, 0x8d, 0xac, 0xb3, 0x4d, 0x7e, 0x52, 0x6a, 0xba, 0x7b,
+		0x80, 0x81, 0x01, 0xcb, 0xe5, 0x45, 0x76, 0xf4, 0x39, 0xdf, 0x79, 0xf4, 0xe9, 0xd3, 0xdf, 0x69,
+		0x06, 0x9e, 0x75, 0x2f, 0x88, 0x57, 0x31, 0x0d, 0x8b, 0x38, 0x26, 0xa9, 0x18, 0x56, 0xdb, 0x76,
+		0x2a, 0xbd, 0xed, 0x8a, 0x47, 0x3a, 0x2d, 0xdb, 0x34, 0x98, 0xed, 0x3a, 0xe5, 0x8e, 0xe7, 0x32,
+		0x17, 0xdd, 0xf5, 0x81, 0x65, 0x09, 0x2c, 0x73, 0x60, 0xb9, 0xb7, 0xbd, 0xf6, 0xb7, 0x2b, 0xd7,
+		0xbd, 0x6a, 0x91, 0x0a, 0x07, 0x5d, 0x74, 0x2f, 0x2b, 0xcc, 0x6e, 0x13, 0xca, 0x8c, 0x76, 0x47,
+		0xd8, 0xad, 0x15, 0xc3, 0x01, 0x3a, 0xb6, 0xef, 0xde, 0x74, 0xdb, 0xed, 0xc0, 0x73, 0x34, 0xc2,
+		0x72, 0xdb, 0x86, 0x1d, 0x20, 0x1e, 0x45, 0x27, 0xd9, 0xb4, 0x29, 0x73, 0xbd, 0xbe, 0x00, 0x95,
+		0x7e, 0x8a, 0xc1, 0x32, 0x1e, 0xa6, 0x7d, 0x48, 0x28, 0x35, 0xae, 0x08, 0x45, 0x75, 0x58, 0x1a,
+		0xd9, 0x8d, 0xce, 0x0c, 0x7a, 0x4d, 0x0b, 0x4a, 0x31, 0xbe, 0xb9, 0xb0, 0xf3, 0xb4, 0x1c, 0xb9,
+		0xa9, 0xf2, 0x88, 0x9b, 0x86, 0x41, 0xaf, 0x71, 0xde, 0x0b, 0x0b, 0x28, 0xfa, 0x2f, 0xdc, 0x6f,
+		0x19, 0x94, 0xe9, 0x1e, 0x61, 0x9e, 0x4d, 0x7a, 0xc4, 0xd2, 0xdb, 0x22, 0x9e, 0x6e, 0x5b, 0x85,
+		0x58, 0x51, 0xd9, 0x8c, 0xe3, 0x7b, 0x3e, 0x00, 0x07, 0x7a, 0x99, 0x8e, 0x66, 0xa1, 0xfb, 0x90,
+		0x6e, 0x1a, 0x54, 0x6f, 0xbb, 0x1e, 0x29, 0xc4, 0x8b, 0xca, 0x66, 0x1a, 0xa7, 0x9a, 0x06, 0x3d,
+		0x74, 0x3d, 0x82, 0x30, 0x2c, 0xd1, 0xbe, 0x63, 0xea, 0xb4, 0x69, 0x78, 0x96, 0x4e, 0x99, 0xc1,
+		0xba, 0xb4, 0x30, 0x57, 0x54, 0x66, 0xa4, 0x5a, 0xef, 0x3b, 0x66, 0xdd, 0x87, 0xd7, 0x39, 0x1a,
+		0xe7, 0x68, 0x58, 0x50, 0xfa, 0x31, 0x09, 0xb9, 0xb1, 0xfd, 0xa0, 0x2f, 0x61, 0xde, 0x2f, 0x83,
+		0xce, 0xfa, 0x1d, 0x52, 0x50, 0x8a, 0xca, 0x66, 0x76, 0x67, 0xeb, 0x76, 0xa5, 0x68, 0xf4, 0x3b,
+		0x04, 0xa7, 0x99, 0xfc, 0x85, 0x1e, 0x43, 0x96, 0xba, 0x5d, 0xcf, 0x24, 0xbc, 0xac, 0xc3, 0xbd,
+		0x67, 0x84, 0xd4, 0xb7, 0xd0, 0x2c, 0xf4, 0x1a, 0x16, 0x4d, 0x8f, 0xc8, 0xf2, 0xdb, 0x6d, 0xb1,
+		0xed, 0x85, 0x9d, 0xb5, 0xb2, 0xe8, 0x9d, 0x72, 0xd0, 0x3b, 0xe5, 0x46, 0xd0, 0x3b, 0x38, 0x13,
+		0x18, 0xf8, 0x22, 0x64, 0xc2, 0x3d, 0xd1, 0x0f, 0x22, 0x8c, 0xc1, 0x98, 0x67, 0x5f, 0x74, 0x19,
+		0x09, 0x8a, 0xf3, 0x62, 0x4a, 0xf2, 0x55, 0x6e, 0xe4, 0x67, 0xa1, 0x0e, 0x4c, 0xf6, 0xef, 0xe0,
+		0x15, 0x2b, 0x42, 0x8e, 0xbe, 0x53, 0xe0, 0xe1, 0x44, 0xf5, 0x27, 0x02, 0x26, 0x78, 0xc0, 0x7f,
+		0xde, 0xee, 0x34, 0x26, 0x22, 0x6f, 0xd0, 0x59, 0x00, 0xd4, 0x03, 0x0e, 0xd0, 0x0d, 0x93, 0xd9,
+		0x3d, 0x9b, 0xf5, 0x27, 0xa2, 0x27, 0x79, 0xf4, 0xed, 0x19, 0xd1, 0x55, 0x69, 0x3a, 0x11, 0x7a,
+		0x8d, 0x4e, 0xd5, 0xa2, 0x36, 0xac, 0xc9, 0xbb, 0x24, 0x22, 0xf6, 0x76, 0x46, 0x83, 0xa6, 0x78,
+		0xd0, 0xf2, 0x94, 0xa0, 0xfb, 0xc2, 0xd0, 0xf7, 0x78, 0xb6, 0x13, 0x8a, 0xb8, 0xda, 0x8c, 0x56,
+		0x21, 0x17, 0xd6, 0x2e, 0x0d, 0xbb, 0xe5, 0xf6, 0x88, 0xa7, 0xb7, 0x0d, 0xef, 0x9a, 0x78, 0xa3,
+		0xe1, 0xd2, 0x3c, 0x5c, 0x65, 0x4a, 0xb8, 0x3d, 0x69, 0x78, 0xc8, 0xed, 0x42, 0xf1, 0x0a, 0x97,
+		0x53, 0x74, 0x6f, 0x32, 0x00, 0xc3, 0x00, 0xa5, 0x5f, 0x63, 0xb0, 0x12, 0xd5, 0x19, 0xe8, 0x14,
+		0xf2, 0xb2, 0xcd, 0xdc, 0x0e, 0xf1, 0x78, 0xfb, 0xc9, 0xdb, 0xf1, 0x74, 0x66, 0x83, 0x1d, 0x07,
+		0x68, 0x9c, 0xb3, 0xc2, 0x02, 0x94, 0x85, 0x98, 0xbc, 0x14, 0xf3, 0x38, 0x66, 0x5b, 0xe8, 0x25,
+		0x24, 0x05, 0x44, 0xde, 0x81, 0xf5, 0x31, 0xc7, 0x1d, 0x7b, 0xe8, 0x16, 0x4b, 0x28, 0x7a, 0x02,
+		0x59, 0xd3, 0x75, 0x2e, 0xed, 0x2b, 0xbd, 0x47, 0x3c, 0xea, 0x67, 0x35, 0xc7, 0x6f, 0xd9, 0xa2,
+		0x90, 0x9e, 0x09, 0x21, 0x7a, 0x0e, 0xf9, 0x41, 0x59, 0x03, 0x60, 0x82, 0x03, 0x73, 0x81, 0x3c,
+		0x80, 0xfe, 0x0f, 0xee, 0x77, 0x3c, 0xd2, 0xb3, 0xdd, 0x2e, 0xd5, 0x27, 0x6c, 0x92, 0xdc, 0x66,
+		0x35, 0x00, 0xec, 0x85, 0x6d, 0x4b, 0x3f, 0x2b, 0xb0, 0x31, 0xb3, 0xcf, 0xfd, 0x7c, 0x25, 0x2b,
+		0x98, 0xad, 0x2e, 0x65, 0xc4, 0xe3, 0x55, 0x9c, 0xc7, 0x8b, 0x42, 0xba, 0x2b, 0x84, 0x3e, 0x11,
+		0x8a, 0xab, 0x26, 0x2b, 0x94, 0xc0, 0x29, 0xbe, 0xd6, 0x2c, 0xf4, 0x1f, 0x98, 0x1f, 0xcc, 0x91,
+		0x5b, 0xb0, 0xc5, 0x10, 0x5c, 0xfa, 0x3d, 0x01, 0x6b, 0xd3, 0xef, 0x01, 0x5a, 0x87, 0x79, 0x79,
+		0xc4, 0xb6, 0x25, 0xb3, 0x4a, 0x0b, 0x81, 0x66, 0xa1, 0x77, 0x80, 0x6e, 0x5c, 0xef, 0xfa, 0xb2,
+		0xe5, 0xde, 0xe8, 0xe4, 0x03, 0x31, 0xbb, 0xbc, 0x03, 0x62, 0x91, 0xfc, 0x2b, 0x0e, 0xea, 0xbd,
+		0x84, 0xd7, 0x02, 0x34, 0x5e, 0xba, 0x19, 0x17, 0xa1, 0x02, 0xa4, 0x82, 0xd2, 0xc6, 0x79, 0x69,
+		0x83, 0x25, 0x7a, 0x08, 0x19, 0x6a, 0x36, 0x89, 0xd5, 0x6d, 0x11, 0x5e, 0x05, 0x71, 0xac, 0x0b,
+		0x03, 0x99, 0x66, 0x21, 0x15, 0xb2, 0x43, 0x08, 0x27, 0xcf, 0xc4, 0x47, 0xcb, 0xb1, 0x38, 0xb0,
+		0xe0, 0xec, 0xb9, 0x01, 0x40, 0x99, 0xe1, 0x31, 0x11, 0x43, 0x9c, 0xee, 0xbc, 0x94, 0x68, 0x16,
+		0xfa, 0x02, 0x32, 0x81, 0x9a, 0xfb, 0x4f, 0x7d, 0xd4, 0xff, 0x82, 0xc4, 0x73, 0xef, 0x5f, 0xc1,
+		0x32, 0x9f, 0x84, 0x4d, 0x62, 0x78, 0xec, 0x82, 0x18, 0x4c, 0x78, 0x49, 0x7f, 0xd4, 0xcb, 0x92,
+		0x6f, 0xb6, 0x1f, 0x58, 0x71, 0x5f, 0xff, 0x82, 0x94, 0x45, 0x98, 0x61, 0xb7, 0x68, 0x61, 0x9e,
+		0xdb, 0x3f, 0x88, 0xac, 0xfa, 0x89, 0xd1, 0x6f, 0xb9, 0x86, 0x85, 0x03, 0xb0, 0x5f, 0x61, 0x83,
+		0x31, 0xd2, 0xee, 0xb0, 0x02, 0x88, 0x46, 0x92, 0x4b, 0xf4, 0x1a, 0x32, 0x3c, 0x3b, 0xbf, 0xc9,
+		0xbb, 0x1e, 0x29, 0x2c, 0xcc, 0x70, 0xbb, 0x27, 0x30, 0x78, 0xc1, 0xb7, 0x90, 0x0b, 0xf4, 0x0f,
+		0x58, 0xe1, 0x0e, 0xfc, 0x63, 0x25, 0x9e, 0x6e, 0x5b, 0xc4, 0x61, 0x36, 0xeb, 0x17, 0x32, 0xbc,
+		0x77, 0x90, 0xaf, 0x7b, 0xcf, 0x55, 0x9a, 0xd4, 0xa0, 0x23, 0xc8, 0xc9, 0xf3, 0xd5, 0x25, 0x01,
+		0x16, 0x16, 0x79, 0xd4, 0x27, 0x53, 0x48, 0x44, 0x5e, 0x2c, 0x49, 0xa4, 0x38, 0xdb, 0x0b, 0xad,
+		0x4b, 0xdf, 0xc7, 0x61, 0x75, 0x0a, 0xc9, 0xa2, 0x55, 0x48, 0x05, 0x83, 0x57, 0xe1, 0xe7, 0x9a,
+		0x64, 0x62, 0xe4, 0x86, 0xfa, 0x3c, 0x76, 0xab, 0x3e, 0x8f, 0x7f, 0x6e, 0x9f, 0x7f, 0x0b, 0x77,
+		0xc7, 0x36, 0xae, 0xdb, 0x8c, 0xb4, 0xfd, 0x21, 0xed, 0x3f, 0xb6, 0x9e, 0xdf, 0x6a, 0xfb, 0x1a,
+		0x23, 0x6d, 0xbc, 0xdc, 0x9b, 0x90, 0x51, 0xf4, 0x0a, 0x92, 0xa4, 0x47, 0x1c, 0x16, 0xcc, 0xe0,
+		0x8d, 0x68, 0xea, 0x34, 0x98, 0xf1, 0xa6, 0xe5, 0x5e, 0x60, 0x09, 0x46, 0xbb, 0x90, 0x75, 0xc8,
+		0x8d, 0xee, 0x75, 0x1d, 0x5d, 0x9a, 0x27, 0x6f, 0x63, 0x9e, 0x71, 0xc8, 0x0d, 0xee, 0x3a, 0x35,
+		0x6e, 0x52, 0xfa, 0x45, 0x81, 0xc2, 0xb4, 0xc9, 0x33, 0x9b, 0x53, 0xa2, 0x48, 0x39, 0x16, 0x4d,
+		0xca, 0x9f, 0xfb, 0x4c, 0x2a, 0xfd, 0xa0, 0xc0, 0x72, 0x38, 0xcb, 0x86, 0x7b, 0x4d, 0x1c, 0x3f,
+		0xc1, 0x80, 0x68, 0xc5, 0xcb, 0x37, 0x81, 0xd3, 0x92, 0x69, 0x29, 0xfa, 0x1a, 0x72, 0x63, 0xc3,
+		0x58, 0x32, 0xde, 0xa7, 0x4e, 0x60, 0x9c, 0x0d, 0xcf, 0xdf, 0xd2, 0x6f, 0xe1, 0x07, 0x39, 0x7f,
+		0x0c, 0x3a, 0x97, 0xee, 0x5f, 0xc2, 0xc1, 0xeb, 0xa3, 0x2f, 0xde, 0x38, 0xe7, 0x88, 0xe1, 0x2b,
+		0x76, 0xe4, 0x16, 0xcd, 0x85, 0x6e, 0xd1, 0x08, 0x73, 0x27, 0xc2, 0xcc, 0xfd, 0x18, 0xb2, 0x97,
+		0xb6, 0x47, 0x99, 0xe8, 0xa9, 0x21, 0xaf, 0x66, 0xb8, 0x94, 0x77, 0x8d, 0x66, 0xa1, 0x12, 0x2c,
+		0x3a, 0xe4, 0xc3, 0x08, 0x28, 0x25, 0x08, 0xde, 0x17, 0x06, 0x98, 0xf1, 0x19, 0x90, 0x9e, 0x98,
+		0x01, 0x7e, 0xf7, 0xe5, 0x47, 0x0b, 0xc9, 0x0f, 0x75, 0x74, 0x7a, 0x2a, 0xe1, 0xe9, 0xf9, 0x19,
+		0x7f, 0x4e, 0x02, 0xd3, 0x8e, 0xe7, 0x9a, 0x84, 0xd2, 0xb0, 0x69, 0x7c, 0x68, 0x7a, 0x12, 0xe8,
+		0x07, 0xa6, 0xa5, 0xb7, 0x90, 0x1b, 0x7b, 0x16, 0x84, 0xc7, 0xb8, 0xf2, 0x29, 0x63, 0xdc, 0x81,
+		0x15, 0x79, 0xf9, 0xab, 0x07, 0xa7, 0xbb, 0x6e, 0xd7, 0x61, 0x35, 0x87, 0x79, 0x7d, 0xb4, 0x02,
+		0x09, 0xd3, 0x5f, 0x49, 0xba, 0x13, 0x8b, 0x59, 0x2f, 0x89, 0xc9, 0xb7, 0x48, 0x3c, 0xe2, 0x2d,
+		0xb2, 0xf5, 0xc7, 0x64, 0xaf, 0xf2, 0xd6, 0x78, 0x08, 0x1b, 0xb8, 0x76, 0x72, 0xa0, 0xed, 0xaa,
+		0x0d, 0xed, 0xf8, 0x48, 0x6f, 0xa8, 0xf5, 0xb7, 0x7a, 0xe3, 0xfc, 0xa4, 0xa6, 0x6b, 0x47, 0x67,
+		0xea, 0x81, 0x56, 0xcd, 0xdf, 0x41, 0x45, 0x78, 0x10, 0x0d, 0xa9, 0x1e, 0x1f, 0xaa, 0xda, 0x51,
+		0x5e, 0x99, 0xee, 0x64, 0x5f, 0xab, 0x37, 0x8e, 0xf1, 0x79, 0x3e, 0x86, 0x5e, 0xc0, 0xb3, 0x68,
+		0x48, 0xfd, 0xfc, 0x68, 0x57, 0xaf, 0xef, 0xab, 0xb8, 0xaa, 0xd7, 0x1b, 0x6a, 0xe3, 0x5d, 0x3d,
+		0x1f, 0x47, 0xcf, 0xe0, 0xd1, 0x0c, 0xb0, 0xba, 0xdb, 0xd0, 0xce, 0xb4, 0xc6, 0x79, 0x7e, 0x0e,
+		0x6d, 0xc1, 0xd3, 0x99, 0x81, 0xf5, 0xc3, 0x5a, 0x43, 0xad, 0xaa, 0x0d, 0x35, 0x9f, 0x40, 0x8f,
+		0xa1, 0x38, 0x1b, 0x7b, 0xb6, 0x93, 0x4f, 0xa2, 0xe7, 0xf0, 0x24, 0x1a, 0xb5, 0xa7, 0x6a, 0x07,
+		0xc7, 0x67, 0x35, 0xac, 0x1f, 0xaa, 0xf8, 0x6d, 0x0d, 0xe7, 0x53, 0x5b, 0x36, 0xe4, 0xc6, 0x9e,
+		0xc7, 0xe8, 0x01, 0x14, 0x44, 0x51, 0xf4, 0xe3, 0x93, 0x1a, 0x16, 0x2e, 0x86, 0x85, 0x5c, 0x87,
+		0xd5, 0x09, 0xed, 0x2e, 0xae, 0xa9, 0x8d, 0x5a, 0x5e, 0x89, 0x54, 0xbe, 0x3b, 0xa9, 0xfa, 0xca,
+		0xd8, 0xd6, 0x11, 0xa4, 0xaa, 0x07, 0xa7, 0xfc, 0xc0, 0x56, 0x20, 0x5f, 0x3d, 0x38, 0x1d, 0x3f,
+		0xa3, 0x02, 0xac, 0x0c, 0xa4, 0x23, 0xf9, 0xe7, 0x15, 0xb4, 0x0c, 0xb9, 0x81, 0x46, 0x1e, 0x58,
+		0xec, 0xcd, 0xbf, 0xbf, 0x79, 0x75, 0x65, 0xb3, 0x66, 0xf7, 0xa2, 0x6c, 0xba, 0xed, 0xca, 0xe8,
+		0xc7, 0x87, 0xbf, 0xdb, 0x56, 0xab, 0x72, 0xe5, 0x8a, 0xcf, 0x1d, 0x83, 0x2f, 0x11, 0xff, 0xe7,
+		0x3f, 0x7a, 0xdb, 0x17, 0x49, 0x2e, 0x7f, 0xf9, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0x11,
+		0xc6, 0x58, 0x56, 0x11, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x6f, 0xdb, 0x36,
+		0x10, 0x9f, 0xec, 0x24, 0x4b, 0x4e, 0x8e, 0xe3, 0x32, 0x4d, 0xa3, 0x78, 0xc3, 0xa2, 0xa6, 0x28,
+		0xe0, 0x15, 0x98, 0xdc, 0x78, 0xc3, 0xd6, 0x6e, 0xd8, 0x83, 0x63, 0xa9, 0x99, 0x87, 0x2c, 0x0b,
+		0x64, 0x37, 0x03, 0x96, 0x07, 0x81, 0x96, 0x68, 0x9b, 0xa8, 0x2c, 0x0a, 0x94, 0xe4, 0x34, 0x6f,
+		0xc3, 0x3e, 0xd6, 0x1e, 0xf7, 0x29, 0xf6, 0x71, 0x06, 0x51, 0x94, 0xe2, 0x3f, 0x42, 0xd2, 0x37,
+		0xf2, 0xee, 0xf7, 0xfb, 0xdd, 0xf1, 0x74, 0x47, 0x0a, 0xf4, 0x64, 0x44, 0x78, 0xdb, 0xc5, 0x1e,
+		0x09, 0x5c, 0xd2, 0xc6, 0x21, 0x6d, 0xcf, 0x4f, 0xdb, 0x1e, 0x9b, 0x61, 0x1a, 0x18, 0x21, 0x67,
+		0x31, 0x43, 0xfb, 0x29, 0xc2, 0x90, 0x08, 0x03, 0x87, 0xd4, 0x98, 0x9f, 0x36, 0xbf, 0x9a, 0x30,
+		0x36, 0xf1, 0x49, 0x5b, 0x40, 0x46, 0xc9, 0xb8, 0xed, 0x25, 0x1c, 0xc7, 0x94, 0x49, 0x52, 0xf3,
+		0x78, 0xd5, 0x1f, 0xd3, 0x19, 0x89, 0x62, 0x3c, 0x0b, 0x25, 0xa0, 0x34, 0xae, 0xcb, 0x66, 0xb3,
+		0x5c, 0xe2, 0xe4, 0xbf, 0x1d, 0xd8, 0x32, 0x45, 0x22, 0xa8, 0x0e, 0x15, 0xea, 0x69, 0x8a, 0xae,
+		0xb4, 0x76, 0xec, 0x0a, 0xf5, 0x10, 0x82, 0x8d, 0x00, 0xcf, 0x88, 0x56, 0x11, 0x16, 0xb1, 0x46,
+		0x6f, 0x61, 0x2b, 0x8a, 0x71, 0x9c, 0x44, 0x5a, 0x55, 0x57, 0x5a, 0xf5, 0xce, 0x73, 0xa3, 0x24,
+		0x6f, 0x23, 0x13, 0x1c, 0x08, 0xa0, 0x2d, 0x09, 0x48, 0x07, 0xd5, 0x23, 0x91, 0xcb, 0x69, 0x98,
+		0x9e, 0x40, 0xdb, 0x10, 0xaa, 0x8b, 0x26, 0x74, 0x0c, 0x2a, 0xbb, 0x0d, 0x08, 0x77, 0xc8, 0x0c,
+		0x53, 0x5f, 0xdb, 0x14, 0x08, 0x10, 0x26, 0x2b, 0xb5, 0xa0, 0xb7, 0xb0, 0xe1, 0xe1, 0x18, 0x6b,
+		0x5b, 0x7a, 0xb5, 0xa5, 0x76, 0x5e, 0x3e, 0x10, 0xdb, 0x30, 0x71, 0x8c, 0xad, 0x20, 0xe6, 0x77,
+		0xb6, 0xa0, 0xa0, 0x29, 0xbc, 0xb8, 0x65, 0xfc, 0xc3, 0xd8, 0x67, 0xb7, 0x0e, 0xf9, 0x48, 0xdc,
+		0x24, 0x8d, 0xe8, 0x70, 0x12, 0x93, 0x40, 0xac, 0x42, 0xc2, 0x29, 0xf3, 0xb4, 0xcf, 0x75, 0xa5,
+		0xa5, 0x76, 0x8e, 0x8c, 0xac, 0xb0, 0x46, 0x5e, 0x58, 0xc3, 0x94, 0x85, 0xb7, 0xf5, 0x5c, 0xc5,
+		0xca, 0x45, 0xec, 0x5c, 0xe3, 0x4a, 0x48, 0xa0, 0x1e, 0xd4, 0x46, 0xd8, 0x73, 0x46, 0x34, 0xc0,
+		0x9c, 0x92, 0x48, 0xdb, 0x16, 0x92, 0x7a, 0x69, 0xb2, 0x67, 0xd8, 0x3b, 0x93, 0x38, 0x5b, 0x1d,
+		0xdd, 0x6f, 0xd0, 0x0d, 0x1c, 0x4e, 0x69, 0x14, 0x33, 0x7e, 0xe7, 0x60, 0xee, 0x4e, 0xe9, 0x1c,
+		0xfb, 0x8e, 0x2c, 0xfc, 0x8e, 0x28, 0xfc, 0x8b, 0x52, 0xbd, 0xae, 0xc4, 0xca, 0xd2, 0x1f, 0x48,
+		0x8d, 0x65, 0x33, 0x7a, 0x0d, 0x4f, 0xd7, 0xc4, 0x13, 0x4e, 0x35, 0x10, 0x05, 0x47, 0x2b, 0xa4,
+		0xf7, 0x9c, 0x22, 0x0c, 0xcd, 0x39, 0x8d, 0xe8, 0x88, 0xfa, 0x34, 0x5e, 0xcf, 0x48, 0xfd, 0xf4,
+		0x8c, 0xb4, 0x7b, 0x99, 0x95, 0xa4, 0xbe, 0x87, 0xc3, 0xb2, 0x10, 0x69, 0x5e, 0x35, 0x91, 0xd7,
+		0xc1, 0x3a, 0x35, 0x4d, 0xcd, 0x80, 0x7d, 0xec, 0xc6, 0x74, 0x4e, 0x1c, 0xd7, 0x4f, 0xa2, 0x98,
+		0x70, 0x47, 0x34, 0xed, 0xae, 0xe0, 0x3c, 0xc9, 0x5c, 0xbd, 0xcc, 0x73, 0x99, 0x76, 0xf0, 0x15,
+		0x6c, 0x4b, 0x60, 0xa4, 0xd5, 0x45, 0x1f, 0x7d, 0x57, 0x9a, 0xb8, 0xe4, 0xd8, 0x24, 0xf4, 0xa9,
+		0x2b, 0xbe, 0x7d, 0x8f, 0x05, 0x63, 0x3a, 0xc9, 0x1b, 0xa1, 0x50, 0x41, 0x5f, 0x43, 0x63, 0x8c,
+		0xa9, 0xcf, 0xe6, 0x84, 0x3b, 0x73, 0xc2, 0xa3, 0xb4, 0xbb, 0xf7, 0x74, 0xa5, 0x55, 0xb5, 0xf7,
+		0x72, 0xfb, 0x75, 0x66, 0x46, 0x2d, 0x68, 0xd0, 0xc8, 0x99, 0xf8, 0x6c, 0x84, 0x7d, 0x27, 0x9b,
+		0x7f, 0xad, 0xa1, 0x2b, 0xad, 0x6d, 0xbb, 0x4e, 0xa3, 0x73, 0x61, 0x96, 0xc3, 0xf8, 0x0e, 0x76,
+		0x0b, 0x51, 0x1a, 0x8c, 0x99, 0xf6, 0x44, 0xb4, 0x51, 0xf9, 0xbc, 0xbd, 0x93, 0xc8, 0x7e, 0x30,
+		0x66, 0x76, 0x6d, 0xbc, 0xb0, 0x43, 0x37, 0x69, 0x44, 0xe6, 0x8b, 0x9c, 0x9d, 0x09, 0x67, 0x49,
+		0x18, 0x69, 0x48, 0x48, 0xbd, 0x2e, 0x95, 0xea, 0xe7, 0xe0, 0xf3, 0x14, 0xbb, 0x7c, 0xe4, 0x3d,
+		0xba, 0xe4, 0x8c, 0x90, 0x0b, 0x07, 0x38, 0xba, 0x0b, 0x5c, 0xa7, 0x18, 0x2d, 0x57, 0x10, 0xb4,
+		0x7d, 0x11, 0xa1, 0x5d, 0xde, 0x11, 0x29, 0xe3, 0x0f, 0x49, 0x58, 0x0e, 0xb0, 0x8f, 0xd7, 0x7d,
+		0xcd, 0x1f, 0x60, 0xa7, 0x18, 0x66, 0xd4, 0x80, 0xea, 0x07, 0x72, 0x27, 0x2f, 0xa9, 0x74, 0x89,
+		0x9e, 0xc2, 0xe6, 0x1c, 0xfb, 0x49, 0x7e, 0x4d, 0x65, 0x9b, 0x1f, 0x2b, 0x6f, 0x94, 0x13, 0x13,
+		0x8e, 0x1f, 0xf9, 0x88, 0xe8, 0x39, 0xd4, 0x96, 0xba, 0x26, 0xd3, 0x55, 0xdd, 0xfb, 0x7e, 0x39,
+		0xf9, 0x47, 0x01, 0x75, 0x61, 0x4c, 0xd1, 0xaf, 0xb0, 0x5d, 0x8c, 0xb6, 0x22, 0xfa, 0xc7, 0x78,
+		0x6c, 0xb4, 0x8d, 0x7c, 0x91, 0x5d, 0x48, 0x05, 0xbf, 0xe9, 0xc0, 0xee, 0x92, 0xab, 0xe4, 0x78,
+		0x6f, 0x16, 0x8f, 0xa7, 0x76, 0x4e, 0x1e, 0x8c, 0x75, 0x27, 0x1a, 0x60, 0xa1, 0x04, 0x7f, 0x2b,
+		0xb0, 0xbb, 0xe4, 0x44, 0xcf, 0x60, 0x8b, 0x13, 0x1c, 0xb1, 0x40, 0x06, 0x91, 0x3b, 0xd4, 0x84,
+		0x6d, 0x16, 0x12, 0x8e, 0x63, 0xc6, 0x65, 0x25, 0x8b, 0x3d, 0xfa, 0x19, 0x6a, 0x2e, 0x27, 0x38,
+		0x26, 0x9e, 0x93, 0x3e, 0x30, 0xe2, 0xea, 0x57, 0x3b, 0xcd, 0xb5, 0x4b, 0x72, 0x98, 0xbf, 0x3e,
+		0xb6, 0x2a, 0xf1, 0xa9, 0xe5, 0xe4, 0xdf, 0x0a, 0xd4, 0x16, 0x3b, 0xb4, 0x74, 0x60, 0x94, 0xf2,
+		0x81, 0x19, 0x82, 0x56, 0x40, 0xa3, 0x18, 0xf3, 0xd8, 0x29, 0x9e, 0x38, 0x59, 0x91, 0x87, 0xd2,
+		0x78, 0x96, 0x73, 0x07, 0x29, 0xb5, 0xb0, 0xa3, 0x6b, 0x38, 0x2a, 0x54, 0xc9, 0xc7, 0x90, 0x72,
+		0xb2, 0x20, 0xfb, 0xf8, 0xe9, 0x0e, 0x73, 0xb2, 0x25, 0xb8, 0xf7, 0xba, 0x1d, 0x38, 0x70, 0xd9,
+		0x2c, 0xf4, 0x49, 0x5a, 0xaa, 0x68, 0x8a, 0xb9, 0xe7, 0xb8, 0x2c, 0x09, 0x62, 0xf1, 0xd8, 0x6d,
+		0xda, 0xfb, 0x85, 0x73, 0x90, 0xfa, 0x7a, 0xa9, 0x0b, 0xbd, 0x84, 0x7a, 0x48, 0x02, 0x8f, 0x06,
+		0x93, 0x8c, 0x11, 0x69, 0x9b, 0x7a, 0xb5, 0xb5, 0x69, 0xef, 0x4a, 0xab, 0x80, 0x46, 0xaf, 0xfe,
+		0x52, 0xa0, 0xb6, 0xf8, 0xac, 0xa2, 0x23, 0x38, 0x30, 0x7f, 0xff, 0xad, 0xdb, 0xbf, 0x74, 0x06,
+		0xc3, 0xee, 0xf0, 0xfd, 0xc0, 0xe9, 0x5f, 0x5e, 0x77, 0x2f, 0xfa, 0x66, 0xe3, 0x33, 0xf4, 0x25,
+		0x68, 0xcb, 0x2e, 0xdb, 0x3a, 0xef, 0x0f, 0x86, 0x96, 0x6d, 0x99, 0x0d, 0x65, 0xdd, 0x6b, 0x5a,
+		0x57, 0xb6, 0xd5, 0xeb, 0x0e, 0x2d, 0xb3, 0x51, 0x59, 0x97, 0x35, 0xad, 0x0b, 0x2b, 0x75, 0x55,
+		0x5f, 0x4d, 0xa1, 0xbe, 0x72, 0x67, 0x7f, 0x01, 0x87, 0x5d, 0xbb, 0xf7, 0x4b, 0xff, 0xba, 0x7b,
+		0x51, 0x9a, 0xc5, 0xaa, 0xd3, 0xec, 0x0f, 0xba, 0x67, 0x17, 0x22, 0x8b, 0x12, 0xaa, 0x75, 0x99,
+		0x39, 0x2b, 0x67, 0x37, 0x70, 0xe8, 0xb2, 0x59, 0x59, 0xab, 0x9f, 0xa9, 0x59, 0x11, 0xae, 0xd2,
+		0xaf, 0x72, 0xa5, 0xfc, 0x79, 0x3a, 0xa1, 0xf1, 0x34, 0x19, 0x19, 0x2e, 0x9b, 0xb5, 0x17, 0xff,
+		0x75, 0xbe, 0xa1, 0x9e, 0xdf, 0x9e, 0xb0, 0xec, 0xcf, 0x48, 0xfe, 0xf8, 0xfc, 0x84, 0x43, 0x3a,
+		0x3f, 0x1d, 0x6d, 0x09, 0xdb, 0xb7, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x33, 0x96, 0xec, 0x45,
+		0x94, 0x09, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) ReplicationStreamAPIYARPCClient {
+			return NewReplicationStreamAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
@@ -26,10 +26,14 @@
 package sharedv1
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -74,8 +78,180 @@ func (TaskSource) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e97215b1a3d16713, []int{0}
 }
 
+// TaskListDispatchStats are the dispatch statistics of a task list partition, used by the adaptive scaler.
+type TaskListDispatchStats struct {
+	// Fraction of the added tasks which were sync matched.
+	SyncMatchRate float64 `protobuf:"fixed64,1,opt,name=sync_match_rate,json=syncMatchRate,proto3" json:"sync_match_rate,omitempty"`
+	// Average time between adding and dispatching a task, in milliseconds.
+	DispatchLatencyMs    float64  `protobuf:"fixed64,2,opt,name=dispatch_latency_ms,json=dispatchLatencyMs,proto3" json:"dispatch_latency_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskListDispatchStats) Reset()         { *m = TaskListDispatchStats{} }
+func (m *TaskListDispatchStats) String() string { return proto.CompactTextString(m) }
+func (*TaskListDispatchStats) ProtoMessage()    {}
+func (*TaskListDispatchStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e97215b1a3d16713, []int{0}
+}
+func (m *TaskListDispatchStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListDispatchStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListDispatchStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskListDispatchStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListDispatchStats.Merge(m, src)
+}
+func (m *TaskListDispatchStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListDispatchStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListDispatchStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListDispatchStats proto.InternalMessageInfo
+
+func (m *TaskListDispatchStats) GetSyncMatchRate() float64 {
+	if m != nil {
+		return m.SyncMatchRate
+	}
+	return 0
+}
+
+func (m *TaskListDispatchStats) GetDispatchLatencyMs() float64 {
+	if m != nil {
+		return m.DispatchLatencyMs
+	}
+	return 0
+}
+
+// TaskListScalingDecision is a decision of the adaptive scaler to change the number of partitions of a task list.
+type TaskListScalingDecision struct {
+	Timestamp                  *types.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Reason                     string           `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	PreviousNumWritePartitions int32            `protobuf:"varint,3,opt,name=previous_num_write_partitions,json=previousNumWritePartitions,proto3" json:"previous_num_write_partitions,omitempty"`
+	NumWritePartitions         int32            `protobuf:"varint,4,opt,name=num_write_partitions,json=numWritePartitions,proto3" json:"num_write_partitions,omitempty"`
+	NumReadPartitions          int32            `protobuf:"varint,5,opt,name=num_read_partitions,json=numReadPartitions,proto3" json:"num_read_partitions,omitempty"`
+	Qps                        float64          `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	BacklogCountHint           int64            `protobuf:"varint,7,opt,name=backlog_count_hint,json=backlogCountHint,proto3" json:"backlog_count_hint,omitempty"`
+	SyncMatchRate              float64          `protobuf:"fixed64,8,opt,name=sync_match_rate,json=syncMatchRate,proto3" json:"sync_match_rate,omitempty"`
+	DispatchLatencyMs          float64          `protobuf:"fixed64,9,opt,name=dispatch_latency_ms,json=dispatchLatencyMs,proto3" json:"dispatch_latency_ms,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}         `json:"-"`
+	XXX_unrecognized           []byte           `json:"-"`
+	XXX_sizecache              int32            `json:"-"`
+}
+
+func (m *TaskListScalingDecision) Reset()         { *m = TaskListScalingDecision{} }
+func (m *TaskListScalingDecision) String() string { return proto.CompactTextString(m) }
+func (*TaskListScalingDecision) ProtoMessage()    {}
+func (*TaskListScalingDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e97215b1a3d16713, []int{1}
+}
+func (m *TaskListScalingDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListScalingDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListScalingDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskListScalingDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListScalingDecision.Merge(m, src)
+}
+func (m *TaskListScalingDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListScalingDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListScalingDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListScalingDecision proto.InternalMessageInfo
+
+func (m *TaskListScalingDecision) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *TaskListScalingDecision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TaskListScalingDecision) GetPreviousNumWritePartitions() int32 {
+	if m != nil {
+		return m.PreviousNumWritePartitions
+	}
+	return 0
+}
+
+func (m *TaskListScalingDecision) GetNumWritePartitions() int32 {
+	if m != nil {
+		return m.NumWritePartitions
+	}
+	return 0
+}
+
+func (m *TaskListScalingDecision) GetNumReadPartitions() int32 {
+	if m != nil {
+		return m.NumReadPartitions
+	}
+	return 0
+}
+
+func (m *TaskListScalingDecision) GetQps() float64 {
+	if m != nil {
+		return m.Qps
+	}
+	return 0
+}
+
+func (m *TaskListScalingDecision) GetBacklogCountHint() int64 {
+	if m != nil {
+		return m.BacklogCountHint
+	}
+	return 0
+}
+
+func (m *TaskListScalingDecision) GetSyncMatchRate() float64 {
+	if m != nil {
+		return m.SyncMatchRate
+	}
+	return 0
+}
+
+func (m *TaskListScalingDecision) GetDispatchLatencyMs() float64 {
+	if m != nil {
+		return m.DispatchLatencyMs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("uber.cadence.shared.v1.TaskSource", TaskSource_name, TaskSource_value)
+	proto.RegisterType((*TaskListDispatchStats)(nil), "uber.cadence.shared.v1.TaskListDispatchStats")
+	proto.RegisterType((*TaskListScalingDecision)(nil), "uber.cadence.shared.v1.TaskListScalingDecision")
 }
 
 func init() {
@@ -83,18 +259,621 @@ func init() {
 }
 
 var fileDescriptor_e97215b1a3d16713 = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4d, 0x4a, 0x2d,
-	0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x2f, 0x49, 0x2c, 0xce, 0xce, 0xc9, 0x2c, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x03, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0xd4, 0x8a,
-	0xe2, 0xe2, 0x0a, 0x49, 0x2c, 0xce, 0x0e, 0xce, 0x2f, 0x2d, 0x4a, 0x4e, 0x15, 0x12, 0xe7, 0x12,
-	0x0e, 0x71, 0x0c, 0xf6, 0x8e, 0x0f, 0xf6, 0x0f, 0x0d, 0x72, 0x76, 0x8d, 0xf7, 0xf4, 0x0b, 0x73,
-	0xf4, 0xf1, 0x74, 0x11, 0x60, 0x40, 0x97, 0xf0, 0xf0, 0x0c, 0x0e, 0xf1, 0x0f, 0x8a, 0x14, 0x60,
-	0x14, 0x92, 0xe2, 0x12, 0x43, 0x96, 0x70, 0x71, 0x8a, 0x77, 0x72, 0x74, 0xf6, 0xf6, 0xf1, 0x77,
-	0x17, 0x60, 0x72, 0x72, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0xa3, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x51, 0xdc,
-	0xac, 0x97, 0x9e, 0x9a, 0xa7, 0x0f, 0x76, 0x25, 0xc2, 0xf9, 0xd6, 0x10, 0x56, 0x99, 0x61, 0x12,
-	0x1b, 0x58, 0xc6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xa0, 0xac, 0xa3, 0xe8, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0x87, 0xc9, 0xca, 0x0a, 0x35, 0x42, 0x64, 0x2e, 0x74, 0x51, 0x25, 0x4a, 0x35, 0x09, 0x54,
+	0x21, 0xe4, 0x50, 0x10, 0x12, 0x12, 0x57, 0xfd, 0x83, 0x58, 0xb5, 0x6e, 0x45, 0x49, 0x01, 0xb1,
+	0x1b, 0xcb, 0x71, 0x4c, 0x6a, 0x35, 0xb1, 0x83, 0xed, 0x14, 0xed, 0x8d, 0x78, 0x14, 0x2e, 0x79,
+	0x04, 0xd4, 0x27, 0x41, 0x49, 0x9a, 0xad, 0x40, 0xb9, 0xd8, 0x9d, 0x73, 0x7e, 0xdf, 0xa7, 0x13,
+	0xfb, 0x1c, 0xf0, 0x38, 0x0b, 0x98, 0x72, 0x29, 0x09, 0x99, 0xa0, 0xcc, 0xd5, 0x0b, 0xa2, 0x58,
+	0xe8, 0xae, 0xfa, 0xae, 0x21, 0x7a, 0x19, 0x73, 0x6d, 0x50, 0xaa, 0xa4, 0x91, 0xb0, 0x95, 0x63,
+	0x68, 0x83, 0xa1, 0x12, 0x43, 0xab, 0x7e, 0xfb, 0x51, 0x24, 0x65, 0x14, 0x33, 0xb7, 0xa0, 0x82,
+	0xec, 0x8b, 0x6b, 0x78, 0xc2, 0xb4, 0x21, 0x49, 0x5a, 0x8a, 0x47, 0x12, 0x3c, 0x98, 0x13, 0xbd,
+	0x9c, 0x72, 0x6d, 0xc6, 0x5c, 0xa7, 0xc4, 0xd0, 0x85, 0x6f, 0x88, 0xd1, 0xf0, 0x09, 0xb8, 0xa7,
+	0x2f, 0x04, 0xc5, 0x49, 0x5e, 0xc2, 0x8a, 0x18, 0xe6, 0x58, 0x5d, 0xab, 0x67, 0x79, 0x77, 0xf3,
+	0xf2, 0x69, 0x5e, 0xf5, 0x88, 0x61, 0x10, 0x81, 0x66, 0xb8, 0x11, 0x71, 0x4c, 0x0c, 0x13, 0xf4,
+	0x02, 0x27, 0xda, 0xd9, 0x2b, 0xd8, 0x83, 0x2a, 0x9a, 0x96, 0xc9, 0xa9, 0x3e, 0xfa, 0x5e, 0x03,
+	0x87, 0x55, 0x47, 0x9f, 0x92, 0x98, 0x8b, 0x68, 0xcc, 0x28, 0xd7, 0x5c, 0x0a, 0xf8, 0x1a, 0x34,
+	0x2e, 0xff, 0xaf, 0xe8, 0x76, 0xe7, 0x45, 0x1b, 0x95, 0x37, 0x40, 0xd5, 0x0d, 0xd0, 0xbc, 0x22,
+	0xbc, 0x2b, 0x18, 0xb6, 0x40, 0x5d, 0x31, 0xa2, 0xa5, 0x28, 0x1a, 0x37, 0xbc, 0xcd, 0x17, 0x1c,
+	0x80, 0x87, 0xa9, 0x62, 0x2b, 0x2e, 0x33, 0x8d, 0x45, 0x96, 0xe0, 0x6f, 0x8a, 0x1b, 0x86, 0x53,
+	0xa2, 0x0c, 0x37, 0x5c, 0x0a, 0xed, 0xd4, 0xba, 0x56, 0x6f, 0xdf, 0x6b, 0x57, 0xd0, 0x59, 0x96,
+	0x7c, 0xca, 0x91, 0xf7, 0x97, 0x04, 0x7c, 0x0e, 0xee, 0xef, 0x34, 0x6f, 0x16, 0x26, 0x14, 0xff,
+	0x1a, 0x08, 0x34, 0x73, 0x43, 0x31, 0x12, 0x6e, 0x0b, 0xfb, 0x85, 0x70, 0x20, 0xb2, 0xc4, 0x63,
+	0x24, 0xdc, 0xe2, 0x6d, 0x50, 0xfb, 0x9a, 0x6a, 0xa7, 0x5e, 0x3c, 0x59, 0x7e, 0x84, 0xcf, 0x00,
+	0x0c, 0x08, 0x5d, 0xc6, 0x32, 0xc2, 0x54, 0x66, 0xc2, 0xe0, 0x05, 0x17, 0xc6, 0xb9, 0xd5, 0xb5,
+	0x7a, 0x35, 0xcf, 0xde, 0x24, 0xa3, 0x3c, 0x38, 0xe6, 0xc2, 0xec, 0x1a, 0xd5, 0xed, 0x6b, 0x8c,
+	0xaa, 0xf1, 0x9f, 0x51, 0x3d, 0x3d, 0x07, 0x20, 0x9f, 0x94, 0x2f, 0x33, 0x45, 0x19, 0x3c, 0x04,
+	0xcd, 0xf9, 0xc0, 0x3f, 0xc1, 0xfe, 0xec, 0x83, 0x37, 0x7a, 0x8b, 0x27, 0x67, 0x1f, 0x07, 0xd3,
+	0xc9, 0xd8, 0xbe, 0xf1, 0x77, 0x70, 0x3c, 0xf1, 0xe7, 0x33, 0xef, 0xb3, 0x6d, 0xc1, 0x36, 0x68,
+	0x6d, 0x07, 0xe3, 0x21, 0x1e, 0x0e, 0x46, 0x27, 0xd3, 0xd9, 0x3b, 0x7b, 0x6f, 0x38, 0xfa, 0xb1,
+	0xee, 0x58, 0x3f, 0xd7, 0x1d, 0xeb, 0xd7, 0xba, 0x63, 0x9d, 0xbf, 0x8a, 0xb8, 0x59, 0x64, 0x01,
+	0xa2, 0x32, 0x71, 0xff, 0x58, 0x78, 0x14, 0x31, 0x51, 0x2e, 0xef, 0xd5, 0xee, 0xbf, 0x29, 0x4f,
+	0xab, 0x7e, 0x50, 0x2f, 0x92, 0x97, 0xbf, 0x07, 0x00, 0xb5, 0xff, 0xf9, 0x09, 0x25, 0x03, 0x00,
 	0x00,
 }
+
+func (m *TaskListDispatchStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListDispatchStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListDispatchStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DispatchLatencyMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchLatencyMs))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.SyncMatchRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SyncMatchRate))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskListScalingDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListScalingDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListScalingDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DispatchLatencyMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchLatencyMs))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.SyncMatchRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SyncMatchRate))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.BacklogCountHint != 0 {
+		i = encodeVarintTasklist(dAtA, i, uint64(m.BacklogCountHint))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Qps != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Qps))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.NumReadPartitions != 0 {
+		i = encodeVarintTasklist(dAtA, i, uint64(m.NumReadPartitions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumWritePartitions != 0 {
+		i = encodeVarintTasklist(dAtA, i, uint64(m.NumWritePartitions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousNumWritePartitions != 0 {
+		i = encodeVarintTasklist(dAtA, i, uint64(m.PreviousNumWritePartitions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTasklist(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasklist(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTasklist(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTasklist(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskListDispatchStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncMatchRate != 0 {
+		n += 9
+	}
+	if m.DispatchLatencyMs != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskListScalingDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovTasklist(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTasklist(uint64(l))
+	}
+	if m.PreviousNumWritePartitions != 0 {
+		n += 1 + sovTasklist(uint64(m.PreviousNumWritePartitions))
+	}
+	if m.NumWritePartitions != 0 {
+		n += 1 + sovTasklist(uint64(m.NumWritePartitions))
+	}
+	if m.NumReadPartitions != 0 {
+		n += 1 + sovTasklist(uint64(m.NumReadPartitions))
+	}
+	if m.Qps != 0 {
+		n += 9
+	}
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovTasklist(uint64(m.BacklogCountHint))
+	}
+	if m.SyncMatchRate != 0 {
+		n += 9
+	}
+	if m.DispatchLatencyMs != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTasklist(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTasklist(x uint64) (n int) {
+	return sovTasklist(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TaskListDispatchStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasklist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListDispatchStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListDispatchStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatchRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SyncMatchRate = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchLatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchLatencyMs = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasklist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskListScalingDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasklist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListScalingDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListScalingDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousNumWritePartitions", wireType)
+			}
+			m.PreviousNumWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousNumWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWritePartitions", wireType)
+			}
+			m.NumWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReadPartitions", wireType)
+			}
+			m.NumReadPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReadPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qps", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Qps = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			}
+			m.BacklogCountHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCountHint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatchRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SyncMatchRate = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchLatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchLatencyMs = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasklist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTasklist(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTasklist
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTasklist
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTasklist
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTasklist
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTasklist        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTasklist          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTasklist = fmt.Errorf("proto: unexpected end of group")
+)
@@ -28,17 +28,49 @@ package sharedv1
 var yarpcFileDescriptorClosuree97215b1a3d16713 = [][]byte{
 	// uber/cadence/shared/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4d, 0x4a, 0x2d,
-		0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0xd1,
-		0x2f, 0x33, 0xd4, 0x2f, 0x49, 0x2c, 0xce, 0xce, 0xc9, 0x2c, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f,
-		0xc9, 0x17, 0x12, 0x03, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0xd4, 0x8a,
-		0xe2, 0xe2, 0x0a, 0x49, 0x2c, 0xce, 0x0e, 0xce, 0x2f, 0x2d, 0x4a, 0x4e, 0x15, 0x12, 0xe7, 0x12,
-		0x0e, 0x71, 0x0c, 0xf6, 0x8e, 0x0f, 0xf6, 0x0f, 0x0d, 0x72, 0x76, 0x8d, 0xf7, 0xf4, 0x0b, 0x73,
-		0xf4, 0xf1, 0x74, 0x11, 0x60, 0x40, 0x97, 0xf0, 0xf0, 0x0c, 0x0e, 0xf1, 0x0f, 0x8a, 0x14, 0x60,
-		0x14, 0x92, 0xe2, 0x12, 0x43, 0x96, 0x70, 0x71, 0x8a, 0x77, 0x72, 0x74, 0xf6, 0xf6, 0xf1, 0x77,
-		0x17, 0x60, 0x72, 0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-		0xd5, 0x47, 0x71, 0xa7, 0x5e, 0x7a, 0x6a, 0x9e, 0x3e, 0xd8, 0x65, 0x08, 0x27, 0x5b, 0x43, 0x58,
-		0x65, 0x86, 0x49, 0x6c, 0x60, 0x19, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x73, 0x06,
-		0x1c, 0xdc, 0x00, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
+		0x14, 0x87, 0xc9, 0xca, 0x0a, 0x35, 0x42, 0x74, 0x2e, 0x74, 0x51, 0x25, 0x44, 0x35, 0x09, 0x54,
+		0x21, 0xe4, 0x50, 0x10, 0x02, 0x89, 0xab, 0xfe, 0x41, 0xac, 0x5a, 0xb7, 0xa2, 0xa4, 0x80, 0xd8,
+		0x8d, 0xe5, 0x38, 0x26, 0xb5, 0x9a, 0xd8, 0xc1, 0x3e, 0x29, 0xda, 0x1b, 0xf1, 0x98, 0x28, 0x49,
+		0xb3, 0x15, 0x18, 0x17, 0xbb, 0x73, 0xce, 0xef, 0xfb, 0x74, 0x62, 0x9f, 0x83, 0x9e, 0xe6, 0xa1,
+		0x30, 0x1e, 0x67, 0x91, 0x50, 0x5c, 0x78, 0x76, 0xc5, 0x8c, 0x88, 0xbc, 0xcd, 0xd0, 0x03, 0x66,
+		0xd7, 0x89, 0xb4, 0x40, 0x32, 0xa3, 0x41, 0xe3, 0x6e, 0x81, 0x91, 0x2d, 0x46, 0x2a, 0x8c, 0x6c,
+		0x86, 0xbd, 0x27, 0xb1, 0xd6, 0x71, 0x22, 0xbc, 0x92, 0x0a, 0xf3, 0xef, 0x1e, 0xc8, 0x54, 0x58,
+		0x60, 0x69, 0x56, 0x89, 0x47, 0x1a, 0x3d, 0x5a, 0x32, 0xbb, 0x9e, 0x4b, 0x0b, 0x53, 0x69, 0x33,
+		0x06, 0x7c, 0x15, 0x00, 0x03, 0x8b, 0x9f, 0xa1, 0x07, 0xf6, 0x42, 0x71, 0x9a, 0x16, 0x25, 0x6a,
+		0x18, 0x08, 0xd7, 0xe9, 0x3b, 0x03, 0xc7, 0xbf, 0x5f, 0x94, 0x4f, 0x8b, 0xaa, 0xcf, 0x40, 0x60,
+		0x82, 0x3a, 0xd1, 0x56, 0xa4, 0x09, 0x03, 0xa1, 0xf8, 0x05, 0x4d, 0xad, 0xbb, 0x57, 0xb2, 0x07,
+		0x75, 0x34, 0xaf, 0x92, 0x53, 0x7b, 0xf4, 0xab, 0x81, 0x0e, 0xeb, 0x8e, 0x01, 0x67, 0x89, 0x54,
+		0xf1, 0x54, 0x70, 0x69, 0xa5, 0x56, 0xf8, 0x1d, 0x6a, 0x5d, 0xfe, 0x5f, 0xd9, 0xed, 0xde, 0xab,
+		0x1e, 0xa9, 0x6e, 0x40, 0xea, 0x1b, 0x90, 0x65, 0x4d, 0xf8, 0x57, 0x30, 0xee, 0xa2, 0xa6, 0x11,
+		0xcc, 0x6a, 0x55, 0x36, 0x6e, 0xf9, 0xdb, 0x2f, 0x3c, 0x42, 0x8f, 0x33, 0x23, 0x36, 0x52, 0xe7,
+		0x96, 0xaa, 0x3c, 0xa5, 0x3f, 0x8d, 0x04, 0x41, 0x33, 0x66, 0x40, 0x82, 0xd4, 0xca, 0xba, 0x8d,
+		0xbe, 0x33, 0xd8, 0xf7, 0x7b, 0x35, 0x74, 0x96, 0xa7, 0x5f, 0x0b, 0xe4, 0xd3, 0x25, 0x81, 0x5f,
+		0xa2, 0x87, 0xd7, 0x9a, 0xb7, 0x4b, 0x13, 0xab, 0x7f, 0x0d, 0x82, 0x3a, 0x85, 0x61, 0x04, 0x8b,
+		0x76, 0x85, 0xfd, 0x52, 0x38, 0x50, 0x79, 0xea, 0x0b, 0x16, 0xed, 0xf0, 0x6d, 0xd4, 0xf8, 0x91,
+		0x59, 0xb7, 0x59, 0x3e, 0x59, 0x71, 0xc4, 0x2f, 0x10, 0x0e, 0x19, 0x5f, 0x27, 0x3a, 0xa6, 0x5c,
+		0xe7, 0x0a, 0xe8, 0x4a, 0x2a, 0x70, 0xef, 0xf4, 0x9d, 0x41, 0xc3, 0x6f, 0x6f, 0x93, 0x49, 0x11,
+		0x1c, 0x4b, 0x05, 0xd7, 0x8d, 0xea, 0xee, 0x0d, 0x46, 0xd5, 0xfa, 0xcf, 0xa8, 0x9e, 0x9f, 0x23,
+		0x54, 0x4c, 0x2a, 0xd0, 0xb9, 0xe1, 0x02, 0x1f, 0xa2, 0xce, 0x72, 0x14, 0x9c, 0xd0, 0x60, 0xf1,
+		0xd9, 0x9f, 0x7c, 0xa0, 0xb3, 0xb3, 0x2f, 0xa3, 0xf9, 0x6c, 0xda, 0xbe, 0xf5, 0x77, 0x70, 0x3c,
+		0x0b, 0x96, 0x0b, 0xff, 0x5b, 0xdb, 0xc1, 0x3d, 0xd4, 0xdd, 0x0d, 0xa6, 0x63, 0x3a, 0x1e, 0x4d,
+		0x4e, 0xe6, 0x8b, 0x8f, 0xed, 0xbd, 0xf1, 0xdb, 0xf3, 0x37, 0xb1, 0x84, 0x55, 0x1e, 0x12, 0xae,
+		0x53, 0xef, 0x8f, 0x25, 0x27, 0xb1, 0x50, 0xd5, 0xc2, 0x5e, 0xed, 0xfb, 0xfb, 0xea, 0xb4, 0x19,
+		0x86, 0xcd, 0x32, 0x79, 0xfd, 0x7b, 0x00, 0x72, 0xfe, 0x44, 0xd7, 0x19, 0x03, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 }
@@ -55,6 +55,14 @@ type Client interface {
 	ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest, ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error)
 	ReapplyEvents(context.Context, *types.ReapplyEventsRequest, ...yarpc.CallOption) error
 	RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest, ...yarpc.CallOption) error
+	DescribeWorkflowVersionHistories(context.Context, *types.DescribeWorkflowVersionHistoriesRequest, ...yarpc.CallOption) (*types.DescribeWorkflowVersionHistoriesResponse, error)
+	RebuildWorkflowBranch(context.Context, *types.RebuildWorkflowBranchRequest, ...yarpc.CallOption) error
+	UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest, ...yarpc.CallOption) (*types.UpdateActivityOptionsResponse, error)
+	ListAuditLogEntries(context.Context, *types.ListAuditLogEntriesRequest, ...yarpc.CallOption) (*types.ListAuditLogEntriesResponse, error)
+	ReadHistoryTaskDLQMessages(context.Context, *types.ReadHistoryTaskDLQMessagesRequest, ...yarpc.CallOption) (*types.ReadHistoryTaskDLQMessagesResponse, error)
+	DescribeHistoryTaskDLQMessage(context.Context, *types.DescribeHistoryTaskDLQMessageRequest, ...yarpc.CallOption) (*types.DescribeHistoryTaskDLQMessageResponse, error)
+	MergeHistoryTaskDLQMessages(context.Context, *types.MergeHistoryTaskDLQMessagesRequest, ...yarpc.CallOption) (*types.MergeHistoryTaskDLQMessagesResponse, error)
+	PurgeHistoryTaskDLQMessages(context.Context, *types.PurgeHistoryTaskDLQMessagesRequest, ...yarpc.CallOption) error
 	RemoveTask(context.Context, *types.RemoveTaskRequest, ...yarpc.CallOption) error
 	ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest, ...yarpc.CallOption) error
 	ResetQueue(context.Context, *types.ResetQueueRequest, ...yarpc.CallOption) error
@@ -63,6 +71,10 @@ type Client interface {
 	RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest, ...yarpc.CallOption) error
 	ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest, ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest, ...yarpc.CallOption) (*types.AdminDeleteWorkflowResponse, error)
+	DeleteDomain(context.Context, *types.DeleteDomainRequest, ...yarpc.CallOption) (*types.DeleteDomainResponse, error)
+	GetReplicationStatus(context.Context, *types.GetReplicationStatusRequest, ...yarpc.CallOption) (*types.GetReplicationStatusResponse, error)
+	RenameDomain(context.Context, *types.RenameDomainRequest, ...yarpc.CallOption) (*types.RenameDomainResponse, error)
+	DescribeTaskList(context.Context, *types.DescribeTaskListRequest, ...yarpc.CallOption) (*types.DescribeTaskListResponse, error)
 	MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest, ...yarpc.CallOption) (*types.AdminMaintainWorkflowResponse, error)
 	GetGlobalIsolationGroups(ctx context.Context, request *types.GetGlobalIsolationGroupsRequest, opts ...yarpc.CallOption) (*types.GetGlobalIsolationGroupsResponse, error)
 	UpdateGlobalIsolationGroups(ctx context.Context, request *types.UpdateGlobalIsolationGroupsRequest, opts ...yarpc.CallOption) (*types.UpdateGlobalIsolationGroupsResponse, error)
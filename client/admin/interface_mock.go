@@ -123,6 +123,26 @@ func (mr *MockClientMockRecorder) CountDLQMessages(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDLQMessages", reflect.TypeOf((*MockClient)(nil).CountDLQMessages), varargs...)
 }
 
+// DeleteDomain mocks base method.
+func (m *MockClient) DeleteDomain(arg0 context.Context, arg1 *types.DeleteDomainRequest, arg2 ...yarpc.CallOption) (*types.DeleteDomainResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDomain", varargs...)
+	ret0, _ := ret[0].(*types.DeleteDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDomain indicates an expected call of DeleteDomain.
+func (mr *MockClientMockRecorder) DeleteDomain(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockClient)(nil).DeleteDomain), varargs...)
+}
+
 // DeleteWorkflow mocks base method.
 func (m *MockClient) DeleteWorkflow(arg0 context.Context, arg1 *types.AdminDeleteWorkflowRequest, arg2 ...yarpc.CallOption) (*types.AdminDeleteWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +203,26 @@ func (mr *MockClientMockRecorder) DescribeHistoryHost(arg0, arg1 any, arg2 ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockClient)(nil).DescribeHistoryHost), varargs...)
 }
 
+// DescribeHistoryTaskDLQMessage mocks base method.
+func (m *MockClient) DescribeHistoryTaskDLQMessage(arg0 context.Context, arg1 *types.DescribeHistoryTaskDLQMessageRequest, arg2 ...yarpc.CallOption) (*types.DescribeHistoryTaskDLQMessageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeHistoryTaskDLQMessage", varargs...)
+	ret0, _ := ret[0].(*types.DescribeHistoryTaskDLQMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryTaskDLQMessage indicates an expected call of DescribeHistoryTaskDLQMessage.
+func (mr *MockClientMockRecorder) DescribeHistoryTaskDLQMessage(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryTaskDLQMessage", reflect.TypeOf((*MockClient)(nil).DescribeHistoryTaskDLQMessage), varargs...)
+}
+
 // DescribeQueue mocks base method.
 func (m *MockClient) DescribeQueue(arg0 context.Context, arg1 *types.DescribeQueueRequest, arg2 ...yarpc.CallOption) (*types.DescribeQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +263,26 @@ func (mr *MockClientMockRecorder) DescribeShardDistribution(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardDistribution", reflect.TypeOf((*MockClient)(nil).DescribeShardDistribution), varargs...)
 }
 
+// DescribeTaskList mocks base method.
+func (m *MockClient) DescribeTaskList(arg0 context.Context, arg1 *types.DescribeTaskListRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskList", varargs...)
+	ret0, _ := ret[0].(*types.DescribeTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskList indicates an expected call of DescribeTaskList.
+func (mr *MockClientMockRecorder) DescribeTaskList(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockClient)(nil).DescribeTaskList), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockClient) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.AdminDescribeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +303,26 @@ func (mr *MockClientMockRecorder) DescribeWorkflowExecution(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// DescribeWorkflowVersionHistories mocks base method.
+func (m *MockClient) DescribeWorkflowVersionHistories(arg0 context.Context, arg1 *types.DescribeWorkflowVersionHistoriesRequest, arg2 ...yarpc.CallOption) (*types.DescribeWorkflowVersionHistoriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkflowVersionHistories", varargs...)
+	ret0, _ := ret[0].(*types.DescribeWorkflowVersionHistoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowVersionHistories indicates an expected call of DescribeWorkflowVersionHistories.
+func (mr *MockClientMockRecorder) DescribeWorkflowVersionHistories(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowVersionHistories", reflect.TypeOf((*MockClient)(nil).DescribeWorkflowVersionHistories), varargs...)
+}
+
 // GetDLQReplicationMessages mocks base method.
 func (m *MockClient) GetDLQReplicationMessages(arg0 context.Context, arg1 *types.GetDLQReplicationMessagesRequest, arg2 ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -383,6 +463,26 @@ func (mr *MockClientMockRecorder) GetReplicationMessages(arg0, arg1 any, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockClient) GetReplicationStatus(arg0 context.Context, arg1 *types.GetReplicationStatusRequest, arg2 ...yarpc.CallOption) (*types.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*types.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockClientMockRecorder) GetReplicationStatus(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockClient)(nil).GetReplicationStatus), varargs...)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method.
 func (m *MockClient) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *types.GetWorkflowExecutionRawHistoryV2Request, arg2 ...yarpc.CallOption) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -403,6 +503,26 @@ func (mr *MockClientMockRecorder) GetWorkflowExecutionRawHistoryV2(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ListAuditLogEntries mocks base method.
+func (m *MockClient) ListAuditLogEntries(arg0 context.Context, arg1 *types.ListAuditLogEntriesRequest, arg2 ...yarpc.CallOption) (*types.ListAuditLogEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditLogEntries", varargs...)
+	ret0, _ := ret[0].(*types.ListAuditLogEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogEntries indicates an expected call of ListAuditLogEntries.
+func (mr *MockClientMockRecorder) ListAuditLogEntries(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogEntries", reflect.TypeOf((*MockClient)(nil).ListAuditLogEntries), varargs...)
+}
+
 // ListDynamicConfig mocks base method.
 func (m *MockClient) ListDynamicConfig(arg0 context.Context, arg1 *types.ListDynamicConfigRequest, arg2 ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -463,6 +583,26 @@ func (mr *MockClientMockRecorder) MergeDLQMessages(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MergeHistoryTaskDLQMessages mocks base method.
+func (m *MockClient) MergeHistoryTaskDLQMessages(arg0 context.Context, arg1 *types.MergeHistoryTaskDLQMessagesRequest, arg2 ...yarpc.CallOption) (*types.MergeHistoryTaskDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeHistoryTaskDLQMessages", varargs...)
+	ret0, _ := ret[0].(*types.MergeHistoryTaskDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeHistoryTaskDLQMessages indicates an expected call of MergeHistoryTaskDLQMessages.
+func (mr *MockClientMockRecorder) MergeHistoryTaskDLQMessages(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeHistoryTaskDLQMessages", reflect.TypeOf((*MockClient)(nil).MergeHistoryTaskDLQMessages), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockClient) PurgeDLQMessages(arg0 context.Context, arg1 *types.PurgeDLQMessagesRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -482,6 +622,25 @@ func (mr *MockClientMockRecorder) PurgeDLQMessages(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockClient)(nil).PurgeDLQMessages), varargs...)
 }
 
+// PurgeHistoryTaskDLQMessages mocks base method.
+func (m *MockClient) PurgeHistoryTaskDLQMessages(arg0 context.Context, arg1 *types.PurgeHistoryTaskDLQMessagesRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeHistoryTaskDLQMessages", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeHistoryTaskDLQMessages indicates an expected call of PurgeHistoryTaskDLQMessages.
+func (mr *MockClientMockRecorder) PurgeHistoryTaskDLQMessages(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeHistoryTaskDLQMessages", reflect.TypeOf((*MockClient)(nil).PurgeHistoryTaskDLQMessages), varargs...)
+}
+
 // ReadDLQMessages mocks base method.
 func (m *MockClient) ReadDLQMessages(arg0 context.Context, arg1 *types.ReadDLQMessagesRequest, arg2 ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -502,6 +661,26 @@ func (mr *MockClientMockRecorder) ReadDLQMessages(arg0, arg1 any, arg2 ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDLQMessages", reflect.TypeOf((*MockClient)(nil).ReadDLQMessages), varargs...)
 }
 
+// ReadHistoryTaskDLQMessages mocks base method.
+func (m *MockClient) ReadHistoryTaskDLQMessages(arg0 context.Context, arg1 *types.ReadHistoryTaskDLQMessagesRequest, arg2 ...yarpc.CallOption) (*types.ReadHistoryTaskDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadHistoryTaskDLQMessages", varargs...)
+	ret0, _ := ret[0].(*types.ReadHistoryTaskDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryTaskDLQMessages indicates an expected call of ReadHistoryTaskDLQMessages.
+func (mr *MockClientMockRecorder) ReadHistoryTaskDLQMessages(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryTaskDLQMessages", reflect.TypeOf((*MockClient)(nil).ReadHistoryTaskDLQMessages), varargs...)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockClient) ReapplyEvents(arg0 context.Context, arg1 *types.ReapplyEventsRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -521,6 +700,25 @@ func (mr *MockClientMockRecorder) ReapplyEvents(arg0, arg1 any, arg2 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockClient)(nil).ReapplyEvents), varargs...)
 }
 
+// RebuildWorkflowBranch mocks base method.
+func (m *MockClient) RebuildWorkflowBranch(arg0 context.Context, arg1 *types.RebuildWorkflowBranchRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebuildWorkflowBranch", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildWorkflowBranch indicates an expected call of RebuildWorkflowBranch.
+func (mr *MockClientMockRecorder) RebuildWorkflowBranch(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildWorkflowBranch", reflect.TypeOf((*MockClient)(nil).RebuildWorkflowBranch), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockClient) RefreshWorkflowTasks(arg0 context.Context, arg1 *types.RefreshWorkflowTasksRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -559,6 +757,26 @@ func (mr *MockClientMockRecorder) RemoveTask(arg0, arg1 any, arg2 ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockClient)(nil).RemoveTask), varargs...)
 }
 
+// RenameDomain mocks base method.
+func (m *MockClient) RenameDomain(arg0 context.Context, arg1 *types.RenameDomainRequest, arg2 ...yarpc.CallOption) (*types.RenameDomainResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameDomain", varargs...)
+	ret0, _ := ret[0].(*types.RenameDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameDomain indicates an expected call of RenameDomain.
+func (mr *MockClientMockRecorder) RenameDomain(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockClient)(nil).RenameDomain), varargs...)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockClient) ResendReplicationTasks(arg0 context.Context, arg1 *types.ResendReplicationTasksRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -616,6 +834,26 @@ func (mr *MockClientMockRecorder) RestoreDynamicConfig(arg0, arg1 any, arg2 ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDynamicConfig", reflect.TypeOf((*MockClient)(nil).RestoreDynamicConfig), varargs...)
 }
 
+// UpdateActivityOptions mocks base method.
+func (m *MockClient) UpdateActivityOptions(arg0 context.Context, arg1 *types.UpdateActivityOptionsRequest, arg2 ...yarpc.CallOption) (*types.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", varargs...)
+	ret0, _ := ret[0].(*types.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockClientMockRecorder) UpdateActivityOptions(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockClient)(nil).UpdateActivityOptions), varargs...)
+}
+
 // UpdateDomainAsyncWorkflowConfiguraton mocks base method.
 func (m *MockClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error) {
 	m.ctrl.T.Helper()
@@ -29,6 +29,7 @@ import (
 
 	"go.uber.org/yarpc"
 
+	replicationv1 "github.com/uber/cadence/.gen/proto/replication/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
@@ -36,6 +37,7 @@ import (
 	"github.com/uber/cadence/client/sharddistributor"
 	"github.com/uber/cadence/client/wrappers/timeout"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/rpc"
 )
 
 type (
@@ -49,6 +51,8 @@ type (
 		GetRemoteAdminClient(cluster string) admin.Client
 		SetRemoteAdminClient(cluster string, client admin.Client)
 		GetRemoteFrontendClient(cluster string) frontend.Client
+		// GetRemoteReplicationStreamClient returns nil if the cluster is not reachable over gRPC
+		GetRemoteReplicationStreamClient(cluster string) replicationv1.ReplicationStreamAPIYARPCClient
 	}
 
 	clientBeanImpl struct {
@@ -60,6 +64,7 @@ type (
 		shardDistributorClient sharddistributor.Client
 		remoteAdminClients     map[string]admin.Client
 		remoteFrontendClients  map[string]frontend.Client
+		remoteStreamClients    map[string]replicationv1.ReplicationStreamAPIYARPCClient
 		factory                Factory
 	}
 )
@@ -74,6 +79,7 @@ func NewClientBean(factory Factory, dispatcher *yarpc.Dispatcher, clusterMetadat
 
 	remoteAdminClients := map[string]admin.Client{}
 	remoteFrontendClients := map[string]frontend.Client{}
+	remoteStreamClients := map[string]replicationv1.ReplicationStreamAPIYARPCClient{}
 	for clusterName := range clusterMetadata.GetEnabledClusterInfo() {
 		clientConfig := dispatcher.ClientConfig(clusterName)
 
@@ -97,6 +103,9 @@ func NewClientBean(factory Factory, dispatcher *yarpc.Dispatcher, clusterMetadat
 
 		remoteAdminClients[clusterName] = adminClient
 		remoteFrontendClients[clusterName] = frontendClient
+		if rpc.IsGRPCOutbound(clientConfig) {
+			remoteStreamClients[clusterName] = replicationv1.NewReplicationStreamAPIYARPCClient(clientConfig)
+		}
 	}
 
 	shardDistributorClient, err := factory.NewShardDistributorClient()
@@ -112,6 +121,7 @@ func NewClientBean(factory Factory, dispatcher *yarpc.Dispatcher, clusterMetadat
 		shardDistributorClient: shardDistributorClient,
 		remoteAdminClients:     remoteAdminClients,
 		remoteFrontendClients:  remoteFrontendClients,
+		remoteStreamClients:    remoteStreamClients,
 	}, nil
 }
 
@@ -170,6 +180,10 @@ func (h *clientBeanImpl) GetRemoteFrontendClient(cluster string) frontend.Client
 	return client
 }
 
+func (h *clientBeanImpl) GetRemoteReplicationStreamClient(cluster string) replicationv1.ReplicationStreamAPIYARPCClient {
+	return h.remoteStreamClients[cluster]
+}
+
 func (h *clientBeanImpl) lazyInitMatchingClient(domainIDToName DomainIDToNameFunc) (matching.Client, error) {
 	h.Lock()
 	defer h.Unlock()
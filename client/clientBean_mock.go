@@ -36,6 +36,7 @@ import (
 
 	gomock "go.uber.org/mock/gomock"
 
+	replicationv1 "github.com/uber/cadence/.gen/proto/replication/v1"
 	admin "github.com/uber/cadence/client/admin"
 	frontend "github.com/uber/cadence/client/frontend"
 	history "github.com/uber/cadence/client/history"
@@ -152,6 +153,20 @@ func (mr *MockBeanMockRecorder) GetRemoteFrontendClient(cluster any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteFrontendClient", reflect.TypeOf((*MockBean)(nil).GetRemoteFrontendClient), cluster)
 }
 
+// GetRemoteReplicationStreamClient mocks base method.
+func (m *MockBean) GetRemoteReplicationStreamClient(cluster string) replicationv1.ReplicationStreamAPIYARPCClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteReplicationStreamClient", cluster)
+	ret0, _ := ret[0].(replicationv1.ReplicationStreamAPIYARPCClient)
+	return ret0
+}
+
+// GetRemoteReplicationStreamClient indicates an expected call of GetRemoteReplicationStreamClient.
+func (mr *MockBeanMockRecorder) GetRemoteReplicationStreamClient(cluster any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteReplicationStreamClient", reflect.TypeOf((*MockBean)(nil).GetRemoteReplicationStreamClient), cluster)
+}
+
 // GetShardDistributorClient mocks base method.
 func (m *MockBean) GetShardDistributorClient() sharddistributor.Client {
 	m.ctrl.T.Helper()
@@ -32,6 +32,8 @@ import (
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
 	"github.com/uber/cadence/.gen/go/matching/matchingserviceclient"
+	adminextv1 "github.com/uber/cadence/.gen/proto/adminext/v1"
+	apiextv1 "github.com/uber/cadence/.gen/proto/apiext/v1"
 	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	matchingv1 "github.com/uber/cadence/.gen/proto/matching/v1"
 	sharddistributorv1 "github.com/uber/cadence/.gen/proto/sharddistributor/v1"
@@ -194,9 +196,9 @@ func (cf *rpcClientFactory) NewAdminClientWithTimeoutAndConfig(
 ) (admin.Client, error) {
 	var client admin.Client
 	if rpc.IsGRPCOutbound(config) {
-		client = grpc.NewAdminClient(adminv1.NewAdminAPIYARPCClient(config))
+		client = grpc.NewAdminClient(adminv1.NewAdminAPIYARPCClient(config), adminextv1.NewAdminExtAPIYARPCClient(config))
 	} else {
-		client = thrift.NewAdminClient(adminserviceclient.New(config))
+		client = thrift.NewAdminClient(adminserviceclient.New(config), adminextv1.NewAdminExtAPIYARPCClient(config))
 	}
 
 	client = timeoutwrapper.NewAdminClient(client, largeTimeout, timeout)
@@ -221,9 +223,10 @@ func (cf *rpcClientFactory) NewFrontendClientWithTimeoutAndConfig(
 			apiv1.NewWorkflowAPIYARPCClient(config),
 			apiv1.NewWorkerAPIYARPCClient(config),
 			apiv1.NewVisibilityAPIYARPCClient(config),
+			apiextv1.NewWorkflowExtAPIYARPCClient(config),
 		)
 	} else {
-		client = thrift.NewFrontendClient(workflowserviceclient.New(config))
+		client = thrift.NewFrontendClient(workflowserviceclient.New(config), apiextv1.NewWorkflowExtAPIYARPCClient(config))
 	}
 
 	client = timeoutwrapper.NewFrontendClient(client, longPollTimeout, timeout)
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 
@@ -739,7 +740,8 @@ func (c *clientImpl) GetReplicationMessages(
 
 		if _, ok := requestsByPeer[peer]; !ok {
 			requestsByPeer[peer] = &types.GetReplicationMessagesRequest{
-				ClusterName: request.ClusterName,
+				ClusterName:     request.ClusterName,
+				WaitForNewTasks: request.WaitForNewTasks,
 			}
 		}
 
@@ -747,6 +749,11 @@ func (c *clientImpl) GetReplicationMessages(
 		req.Tokens = append(req.Tokens, token)
 	}
 
+	// When waiting for new tasks every peer holds the request until its shards have new tasks.
+	// Return as soon as one of them responds with tasks, the shards of the other peers are requested again by the caller.
+	waitCtx, cancelWait := context.WithCancel(ctx)
+	defer cancelWait()
+
 	g := &errgroup.Group{}
 	var responseMutex sync.Mutex
 	peerResponses := make([]*getReplicationMessagesWithSize, 0, len(requestsByPeer))
@@ -756,12 +763,16 @@ func (c *clientImpl) GetReplicationMessages(
 		g.Go(func() (e error) {
 			defer func() { log.CapturePanic(recover(), c.logger, &e) }()
 
-			requestContext, cancel := common.CreateChildContext(ctx, 0.05)
+			requestContext, cancel := common.CreateChildContext(waitCtx, 0.05)
 			defer cancel()
 
 			requestContext, responseInfo := rpc.ContextWithResponseInfo(requestContext)
 			resp, err := c.client.GetReplicationMessages(requestContext, req, append(opts, yarpc.WithShardKey(peer))...)
 			if err != nil {
+				if waitCtx.Err() != nil && ctx.Err() == nil {
+					// another peer already returned new tasks
+					return nil
+				}
 				c.logger.Warn("Failed to get replication tasks from client",
 					tag.Error(err),
 					tag.ShardReplicationToken(req),
@@ -779,6 +790,9 @@ func (c *clientImpl) GetReplicationMessages(
 				peer:     peer,
 			})
 			responseMutex.Unlock()
+			if req.WaitForNewTasks && hasReplicationTasks(resp) {
+				cancelWait()
+			}
 			return nil
 		})
 	}
@@ -828,6 +842,15 @@ func (c *clientImpl) GetReplicationMessages(
 	return response, nil
 }
 
+func hasReplicationTasks(response *types.GetReplicationMessagesResponse) bool {
+	for _, messages := range response.GetMessagesByShard() {
+		if len(messages.GetReplicationTasks()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *clientImpl) GetDLQReplicationMessages(
 	ctx context.Context,
 	request *types.GetDLQReplicationMessagesRequest,
@@ -905,6 +928,52 @@ func (c *clientImpl) CountDLQMessages(
 	return &types.HistoryCountDLQMessagesResponse{Entries: entries}, err
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryGetReplicationStatusResponse, error) {
+
+	peers, err := c.peerResolver.GetAllPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	responses := make([]*types.HistoryGetReplicationStatusResponse, 0, len(peers))
+
+	g := &errgroup.Group{}
+	for _, peer := range peers {
+		peer := peer
+		g.Go(func() (e error) {
+			defer func() { log.CapturePanic(recover(), c.logger, &e) }()
+
+			response, err := c.client.GetReplicationStatus(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+			if err == nil {
+				mu.Lock()
+				responses = append(responses, response)
+				mu.Unlock()
+			}
+
+			return err
+		})
+	}
+
+	err = g.Wait()
+
+	var shards []*types.ReplicationShardStatus
+	for _, response := range responses {
+		shards = append(shards, response.Shards...)
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		if shards[i].ShardID != shards[j].ShardID {
+			return shards[i].ShardID < shards[j].ShardID
+		}
+		return shards[i].RemoteCluster < shards[j].RemoteCluster
+	})
+	return &types.HistoryGetReplicationStatusResponse{Shards: shards}, err
+}
+
 func (c *clientImpl) ReadDLQMessages(
 	ctx context.Context,
 	request *types.ReadDLQMessagesRequest,
@@ -960,6 +1029,44 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *clientImpl) RebuildWorkflowBranch(
+	ctx context.Context,
+	request *types.HistoryRebuildWorkflowBranchRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.RebuildWorkflowBranch(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.HistoryUpdateActivityOptionsRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateActivityOptionsResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.UpdateActivityOptionsResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.UpdateActivityOptions(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
@@ -218,6 +218,44 @@ func TestClient_withResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "GetReplicationMessages waiting for new tasks",
+			op: func(c Client) (any, error) {
+				return c.GetReplicationMessages(context.Background(), &types.GetReplicationMessagesRequest{
+					Tokens: []*types.ReplicationToken{
+						{
+							ShardID: 100,
+						},
+						{
+							ShardID: 101,
+						},
+					},
+					WaitForNewTasks: true,
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromShardID(100).Return("test-peer-0", nil).Times(1)
+				p.EXPECT().FromShardID(101).Return("test-peer-1", nil).Times(1)
+				c.EXPECT().GetReplicationMessages(gomock.Any(), &types.GetReplicationMessagesRequest{
+					Tokens:          []*types.ReplicationToken{{ShardID: 100}},
+					WaitForNewTasks: true,
+				}, []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.GetReplicationMessagesResponse{
+						MessagesByShard: map[int32]*types.ReplicationMessages{100: {ReplicationTasks: []*types.ReplicationTask{{SourceTaskID: 1}}}},
+					}, nil).Times(1)
+				// the peer without new tasks is released once the other peer returns
+				c.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					DoAndReturn(func(ctx context.Context, _ *types.GetReplicationMessagesRequest, _ ...yarpc.CallOption) (*types.GetReplicationMessagesResponse, error) {
+						<-ctx.Done()
+						return nil, ctx.Err()
+					}).Times(1)
+			},
+			want: &types.GetReplicationMessagesResponse{
+				MessagesByShard: map[int32]*types.ReplicationMessages{
+					100: {ReplicationTasks: []*types.ReplicationTask{{SourceTaskID: 1}}},
+				},
+			},
+		},
 		{
 			name: "GetDLQReplicationMessages",
 			op: func(c Client) (any, error) {
@@ -376,6 +414,30 @@ func TestClient_withResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "GetReplicationStatus",
+			op: func(c Client) (any, error) {
+				return c.GetReplicationStatus(context.Background(), &types.GetReplicationStatusRequest{})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer-0", "test-peer-1"}, nil).Times(1)
+				c.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.HistoryGetReplicationStatusResponse{
+						Shards: []*types.ReplicationShardStatus{{ShardID: 2, RemoteCluster: "cluster-a"}},
+					}, nil).Times(1)
+				c.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(&types.HistoryGetReplicationStatusResponse{
+						Shards: []*types.ReplicationShardStatus{{ShardID: 1, RemoteCluster: "cluster-b"}, {ShardID: 1, RemoteCluster: "cluster-a"}},
+					}, nil).Times(1)
+			},
+			want: &types.HistoryGetReplicationStatusResponse{
+				Shards: []*types.ReplicationShardStatus{
+					{ShardID: 1, RemoteCluster: "cluster-a"},
+					{ShardID: 1, RemoteCluster: "cluster-b"},
+					{ShardID: 2, RemoteCluster: "cluster-a"},
+				},
+			},
+		},
 		{
 			name: "QueryWorkflow",
 			op: func(c Client) (any, error) {
@@ -826,6 +888,18 @@ func TestClient_withResponse(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "GetReplicationStatus fail",
+			op: func(c Client) (any, error) {
+				return c.GetReplicationStatus(context.Background(), &types.GetReplicationStatusRequest{})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer"}, nil).Times(1)
+				c.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil, fmt.Errorf("GetReplicationStatus failed")).Times(1)
+			},
+			wantError: true,
+		},
 		{
 			name: "CountDLQMessages fail",
 			op: func(c Client) (any, error) {
@@ -984,6 +1058,21 @@ func TestClient_withNoResponse(t *testing.T) {
 					Return(nil).Times(1)
 			},
 		},
+		{
+			name: "RebuildWorkflowBranch",
+			op: func(c Client) error {
+				return c.RebuildWorkflowBranch(context.Background(), &types.HistoryRebuildWorkflowBranchRequest{
+					Request: &types.RebuildWorkflowBranchRequest{
+						Execution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().RebuildWorkflowBranch(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
 		{
 			name: "PurgeDLQMessages",
 			op: func(c Client) error {
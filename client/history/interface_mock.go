@@ -284,6 +284,26 @@ func (mr *MockClientMockRecorder) GetReplicationMessages(arg0, arg1 any, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockClient) GetReplicationStatus(arg0 context.Context, arg1 *types.GetReplicationStatusRequest, arg2 ...yarpc.CallOption) (*types.HistoryGetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*types.HistoryGetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockClientMockRecorder) GetReplicationStatus(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockClient)(nil).GetReplicationStatus), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockClient) MergeDLQMessages(arg0 context.Context, arg1 *types.MergeDLQMessagesRequest, arg2 ...yarpc.CallOption) (*types.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -441,6 +461,25 @@ func (mr *MockClientMockRecorder) ReapplyEvents(arg0, arg1 any, arg2 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockClient)(nil).ReapplyEvents), varargs...)
 }
 
+// RebuildWorkflowBranch mocks base method.
+func (m *MockClient) RebuildWorkflowBranch(arg0 context.Context, arg1 *types.HistoryRebuildWorkflowBranchRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebuildWorkflowBranch", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildWorkflowBranch indicates an expected call of RebuildWorkflowBranch.
+func (mr *MockClientMockRecorder) RebuildWorkflowBranch(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildWorkflowBranch", reflect.TypeOf((*MockClient)(nil).RebuildWorkflowBranch), varargs...)
+}
+
 // RecordActivityTaskHeartbeat mocks base method.
 func (m *MockClient) RecordActivityTaskHeartbeat(arg0 context.Context, arg1 *types.HistoryRecordActivityTaskHeartbeatRequest, arg2 ...yarpc.CallOption) (*types.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -924,3 +963,23 @@ func (mr *MockClientMockRecorder) TerminateWorkflowExecution(arg0, arg1 any, arg
 	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).TerminateWorkflowExecution), varargs...)
 }
+
+// UpdateActivityOptions mocks base method.
+func (m *MockClient) UpdateActivityOptions(arg0 context.Context, arg1 *types.HistoryUpdateActivityOptionsRequest, arg2 ...yarpc.CallOption) (*types.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", varargs...)
+	ret0, _ := ret[0].(*types.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockClientMockRecorder) UpdateActivityOptions(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockClient)(nil).UpdateActivityOptions), varargs...)
+}
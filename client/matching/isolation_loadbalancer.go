@@ -60,7 +60,13 @@ func (i *isolationLoadBalancer) PickWritePartition(taskListType int, req WriteRe
 		return i.fallback.PickWritePartition(taskListType, req)
 	}
 
-	partitions, ok := i.getPartitionsForGroup(taskGroup, nPartitions)
+	var partitions map[int]any
+	if config := i.provider.GetPartitionConfig(req.GetDomainUUID(), taskList, taskListType); config != nil && HasIsolationGroupAssignment(config.WritePartitions) {
+		// matching placed the partitions based on where the pollers are, spill over to any partition if the group has none
+		partitions, ok = getAssignedPartitions(config.WritePartitions, taskGroup, nPartitions)
+	} else {
+		partitions, ok = i.getPartitionsForGroup(taskGroup, nPartitions)
+	}
 	if !ok {
 		return i.fallback.PickWritePartition(taskListType, req)
 	}
@@ -79,6 +85,15 @@ func (i *isolationLoadBalancer) PickReadPartition(taskListType int, req ReadRequ
 		return taskListName
 	}
 
+	if config := i.provider.GetPartitionConfig(req.GetDomainUUID(), taskList, taskListType); config != nil && HasIsolationGroupAssignment(config.ReadPartitions) {
+		// read partitions include the write partitions, so there is no need to consider them separately when scaling down
+		partitions, ok := getAssignedPartitions(config.ReadPartitions, isolationGroup, nRead)
+		if !ok {
+			return i.fallback.PickReadPartition(taskListType, req, isolationGroup)
+		}
+		return getPartitionTaskListName(taskList.GetName(), i.pickBetween(partitions))
+	}
+
 	partitions, ok := i.getPartitionsForGroup(isolationGroup, nRead)
 	if !ok {
 		return i.fallback.PickReadPartition(taskListType, req, isolationGroup)
@@ -139,6 +154,46 @@ func (i *isolationLoadBalancer) getPartitionsForGroup(taskGroup string, partitio
 	return partitions, true
 }
 
+// getAssignedPartitions returns the partitions that matching assigned to the isolation group, ignoring any partition
+// at or above partitionCount
+func getAssignedPartitions(assignment map[int]*types.TaskListPartition, taskGroup string, partitionCount int) (map[int]any, bool) {
+	if taskGroup == "" {
+		return nil, false
+	}
+	partitions := make(map[int]any)
+	for _, id := range PartitionsForIsolationGroup(assignment, taskGroup) {
+		if id < partitionCount {
+			partitions[id] = struct{}{}
+		}
+	}
+	if len(partitions) == 0 {
+		return nil, false
+	}
+	return partitions, true
+}
+
+// HasIsolationGroupAssignment returns true if matching assigned isolation groups to any of the partitions
+func HasIsolationGroupAssignment(partitions map[int]*types.TaskListPartition) bool {
+	for _, p := range partitions {
+		if len(p.GetIsolationGroups()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// PartitionsForIsolationGroup returns the ids of the partitions assigned to the isolation group in ascending order
+func PartitionsForIsolationGroup(partitions map[int]*types.TaskListPartition, isolationGroup string) []int {
+	var result []int
+	for id, p := range partitions {
+		if slices.Contains(p.GetIsolationGroups(), isolationGroup) {
+			result = append(result, id)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
 func (i *isolationLoadBalancer) pickBetween(partitions map[int]any) int {
 	// Could alternatively use backlog weights to make a smarter choice
 	total := len(partitions)
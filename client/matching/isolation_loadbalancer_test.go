@@ -266,12 +266,104 @@ func TestIsolationGetPartitionsForGroup(t *testing.T) {
 	}
 }
 
+func TestIsolationPickPartitionWithAssignment(t *testing.T) {
+	tl := "tl"
+	config := &types.TaskListPartitionConfig{
+		ReadPartitions: map[int]*types.TaskListPartition{
+			0: {IsolationGroups: []string{"a"}},
+			1: {IsolationGroups: []string{"b"}},
+			2: {IsolationGroups: []string{"a"}},
+			3: {IsolationGroups: []string{"c"}},
+		},
+		WritePartitions: map[int]*types.TaskListPartition{
+			0: {IsolationGroups: []string{"a"}},
+			1: {IsolationGroups: []string{"b"}},
+			2: {IsolationGroups: []string{"a"}},
+		},
+	}
+	cases := []struct {
+		name           string
+		group          string
+		shouldFallback bool
+		allowedWrite   []string
+		allowedRead    []string
+	}{
+		{
+			name:         "multiple partitions assigned",
+			group:        "a",
+			allowedWrite: []string{tl, getPartitionTaskListName(tl, 2)},
+			allowedRead:  []string{tl, getPartitionTaskListName(tl, 2)},
+		},
+		{
+			name:         "single partition assigned",
+			group:        "b",
+			allowedWrite: []string{getPartitionTaskListName(tl, 1)},
+			allowedRead:  []string{getPartitionTaskListName(tl, 1)},
+		},
+		{
+			name:           "spillover - group has no pollers",
+			group:          "d",
+			shouldFallback: true,
+			allowedWrite:   []string{"fallback"},
+			allowedRead:    []string{"fallback"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lb, fallback := createWithPartitionConfig(t, []string{"a", "b", "c", "d"}, config)
+			writeReq := &types.AddDecisionTaskRequest{
+				DomainUUID:      "domainId",
+				TaskList:        &types.TaskList{Name: tl},
+				PartitionConfig: map[string]string{partition.IsolationGroupKey: tc.group},
+			}
+			readReq := &types.MatchingQueryWorkflowRequest{
+				DomainUUID: "domainId",
+				TaskList:   &types.TaskList{Name: tl},
+			}
+			if tc.shouldFallback {
+				fallback.EXPECT().PickWritePartition(int(types.TaskListTypeDecision), writeReq).Return("fallback").Times(1)
+				fallback.EXPECT().PickReadPartition(int(types.TaskListTypeDecision), readReq, tc.group).Return("fallback").Times(1)
+			}
+			assert.Contains(t, tc.allowedWrite, lb.PickWritePartition(0, writeReq))
+			assert.Contains(t, tc.allowedRead, lb.PickReadPartition(0, readReq, tc.group))
+		})
+	}
+}
+
+func TestPartitionsForIsolationGroup(t *testing.T) {
+	partitions := map[int]*types.TaskListPartition{
+		0: {IsolationGroups: []string{"a", "b"}},
+		1: {},
+		2: {IsolationGroups: []string{"a"}},
+	}
+	assert.True(t, HasIsolationGroupAssignment(partitions))
+	assert.False(t, HasIsolationGroupAssignment(map[int]*types.TaskListPartition{0: {}, 1: nil}))
+	assert.Equal(t, []int{0, 2}, PartitionsForIsolationGroup(partitions, "a"))
+	assert.Equal(t, []int{0}, PartitionsForIsolationGroup(partitions, "b"))
+	assert.Empty(t, PartitionsForIsolationGroup(partitions, "c"))
+}
+
+func createWithPartitionConfig(t *testing.T, isolationGroups []string, config *types.TaskListPartitionConfig) (*isolationLoadBalancer, *MockLoadBalancer) {
+	ctrl := gomock.NewController(t)
+	fallback := NewMockLoadBalancer(ctrl)
+	cfg := NewMockPartitionConfigProvider(ctrl)
+	cfg.EXPECT().GetNumberOfWritePartitions(gomock.Any(), gomock.Any(), gomock.Any()).Return(len(config.WritePartitions)).AnyTimes()
+	cfg.EXPECT().GetNumberOfReadPartitions(gomock.Any(), gomock.Any(), gomock.Any()).Return(len(config.ReadPartitions)).AnyTimes()
+	cfg.EXPECT().GetPartitionConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(config).AnyTimes()
+	return &isolationLoadBalancer{
+		provider:           cfg,
+		fallback:           fallback,
+		allIsolationGroups: func() []string { return isolationGroups },
+	}, fallback
+}
+
 func createWithMocks(t *testing.T, isolationGroups []string, writePartitions, readPartitions int) (*isolationLoadBalancer, *MockLoadBalancer) {
 	ctrl := gomock.NewController(t)
 	fallback := NewMockLoadBalancer(ctrl)
 	cfg := NewMockPartitionConfigProvider(ctrl)
 	cfg.EXPECT().GetNumberOfWritePartitions(gomock.Any(), gomock.Any(), gomock.Any()).Return(writePartitions).AnyTimes()
 	cfg.EXPECT().GetNumberOfReadPartitions(gomock.Any(), gomock.Any(), gomock.Any()).Return(readPartitions).AnyTimes()
+	cfg.EXPECT().GetPartitionConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	allIsolationGroups := func() []string {
 		return isolationGroups
 	}
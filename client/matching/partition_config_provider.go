@@ -45,6 +45,8 @@ type (
 		GetNumberOfReadPartitions(domainID string, taskList types.TaskList, taskListType int) int
 		// GetNumberOfWritePartitions returns the number of write partitions
 		GetNumberOfWritePartitions(domainID string, taskList types.TaskList, taskListType int) int
+		// GetPartitionConfig returns the cached partition configuration of a task list, or nil if it is not known
+		GetPartitionConfig(domainID string, taskList types.TaskList, taskListType int) *types.TaskListPartitionConfig
 		// UpdatePartitionConfig updates the partition configuration for a task list
 		UpdatePartitionConfig(domainID string, taskList types.TaskList, taskListType int, config *types.TaskListPartitionConfig)
 	}
@@ -156,6 +158,24 @@ func (p *partitionConfigProviderImpl) GetNumberOfWritePartitions(domainID string
 	return int(w)
 }
 
+func (p *partitionConfigProviderImpl) GetPartitionConfig(domainID string, taskList types.TaskList, taskListType int) *types.TaskListPartitionConfig {
+	domainName, err := p.domainIDToName(domainID)
+	if err != nil {
+		return nil
+	}
+	if !p.enableReadFromCache(domainName, taskList.GetName(), taskListType) {
+		return nil
+	}
+	c := p.getPartitionConfig(domainID, taskList, taskListType)
+	if c == nil {
+		return nil
+	}
+	c.RLock()
+	defer c.RUnlock()
+	config := c.TaskListPartitionConfig
+	return &config
+}
+
 func (p *partitionConfigProviderImpl) UpdatePartitionConfig(domainID string, taskList types.TaskList, taskListType int, config *types.TaskListPartitionConfig) {
 	if config == nil || taskList.GetKind() != types.TaskListKindNormal {
 		return
@@ -212,6 +212,50 @@ func TestGetNumberOfWritePartitions(t *testing.T) {
 	}
 }
 
+func TestGetPartitionConfig(t *testing.T) {
+	testCases := []struct {
+		name                string
+		enableReadFromCache bool
+		cachedConfigExists  bool
+		expected            *types.TaskListPartitionConfig
+	}{
+		{
+			name:                "not reading from cache",
+			enableReadFromCache: false,
+		},
+		{
+			name:                "cache config missing",
+			enableReadFromCache: true,
+		},
+		{
+			name:                "get config from cache",
+			enableReadFromCache: true,
+			cachedConfigExists:  true,
+			expected:            &types.TaskListPartitionConfig{Version: 3, ReadPartitions: partitions(2), WritePartitions: partitions(1)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			partitionProvider, mockCache := setUpMocksForPartitionConfigProvider(t, tc.enableReadFromCache)
+
+			if tc.enableReadFromCache {
+				if tc.cachedConfigExists {
+					mockCache.EXPECT().Get(gomock.Any()).Return(&syncedTaskListPartitionConfig{
+						TaskListPartitionConfig: *tc.expected,
+					}).Times(1)
+				} else {
+					mockCache.EXPECT().Get(gomock.Any()).Return(nil).Times(1)
+				}
+			}
+
+			kind := types.TaskListKindNormal
+			taskList := types.TaskList{Name: "test-task-list", Kind: &kind}
+			assert.Equal(t, tc.expected, partitionProvider.GetPartitionConfig("test-domain-id", taskList, 0))
+		})
+	}
+}
+
 func TestUpdatePartitionConfig(t *testing.T) {
 	testCases := []struct {
 		name              string
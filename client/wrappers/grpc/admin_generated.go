@@ -51,6 +51,11 @@ func (g adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQMe
 	return proto.ToAdminCountDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DeleteDomainResponse, err error) {
+	response, err := g.c.DeleteDomain(ctx, proto.FromAdminDeleteDomainRequest(dp1), p1...)
+	return proto.ToAdminDeleteDomainResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	response, err := g.c.DeleteWorkflow(ctx, proto.FromAdminDeleteWorkflowRequest(ap1), p1...)
 	return proto.ToAdminDeleteWorkflowResponse(response), proto.ToError(err)
@@ -66,6 +71,11 @@ func (g adminClient) DescribeHistoryHost(ctx context.Context, dp1 *types.Describ
 	return proto.ToAdminDescribeHistoryHostResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) DescribeHistoryTaskDLQMessage(ctx context.Context, dp1 *types.DescribeHistoryTaskDLQMessageRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeHistoryTaskDLQMessageResponse, err error) {
+	response, err := g.c.DescribeHistoryTaskDLQMessage(ctx, proto.FromAdminDescribeHistoryTaskDLQMessageRequest(dp1), p1...)
+	return proto.ToAdminDescribeHistoryTaskDLQMessageResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueueRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeQueueResponse, err error) {
 	response, err := g.c.DescribeQueue(ctx, proto.FromAdminDescribeQueueRequest(dp1), p1...)
 	return proto.ToAdminDescribeQueueResponse(response), proto.ToError(err)
@@ -76,11 +86,21 @@ func (g adminClient) DescribeShardDistribution(ctx context.Context, dp1 *types.D
 	return proto.ToAdminDescribeShardDistributionResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
+	response, err := g.c.DescribeTaskList(ctx, proto.FromAdminDescribeTaskListRequest(dp1), p1...)
+	return proto.ToAdminDescribeTaskListResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.AdminDescribeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDescribeWorkflowExecutionResponse, err error) {
 	response, err := g.c.DescribeWorkflowExecution(ctx, proto.FromAdminDescribeWorkflowExecutionRequest(ap1), p1...)
 	return proto.ToAdminDescribeWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) DescribeWorkflowVersionHistories(ctx context.Context, dp1 *types.DescribeWorkflowVersionHistoriesRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkflowVersionHistoriesResponse, err error) {
+	response, err := g.c.DescribeWorkflowVersionHistories(ctx, proto.FromAdminDescribeWorkflowVersionHistoriesRequest(dp1), p1...)
+	return proto.ToAdminDescribeWorkflowVersionHistoriesResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types.GetDLQReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDLQReplicationMessagesResponse, err error) {
 	response, err := g.c.GetDLQReplicationMessages(ctx, proto.FromAdminGetDLQReplicationMessagesRequest(gp1), p1...)
 	return proto.ToAdminGetDLQReplicationMessagesResponse(response), proto.ToError(err)
@@ -116,11 +136,21 @@ func (g adminClient) GetReplicationMessages(ctx context.Context, gp1 *types.GetR
 	return proto.ToAdminGetReplicationMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationStatusResponse, err error) {
+	response, err := g.c.GetReplicationStatus(ctx, proto.FromAdminGetReplicationStatusRequest(gp1), p1...)
+	return proto.ToAdminGetReplicationStatusResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1 *types.GetWorkflowExecutionRawHistoryV2Request, p1 ...yarpc.CallOption) (gp2 *types.GetWorkflowExecutionRawHistoryV2Response, err error) {
 	response, err := g.c.GetWorkflowExecutionRawHistoryV2(ctx, proto.FromAdminGetWorkflowExecutionRawHistoryV2Request(gp1), p1...)
 	return proto.ToAdminGetWorkflowExecutionRawHistoryV2Response(response), proto.ToError(err)
 }
 
+func (g adminClient) ListAuditLogEntries(ctx context.Context, lp1 *types.ListAuditLogEntriesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListAuditLogEntriesResponse, err error) {
+	response, err := g.c.ListAuditLogEntries(ctx, proto.FromAdminListAuditLogEntriesRequest(lp1), p1...)
+	return proto.ToAdminListAuditLogEntriesResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigResponse, err error) {
 	response, err := g.c.ListDynamicConfig(ctx, proto.FromAdminListDynamicConfigRequest(lp1), p1...)
 	return proto.ToAdminListDynamicConfigResponse(response), proto.ToError(err)
@@ -136,21 +166,41 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return proto.ToAdminMergeDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) MergeHistoryTaskDLQMessages(ctx context.Context, mp1 *types.MergeHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeHistoryTaskDLQMessagesResponse, err error) {
+	response, err := g.c.MergeHistoryTaskDLQMessages(ctx, proto.FromAdminMergeHistoryTaskDLQMessagesRequest(mp1), p1...)
+	return proto.ToAdminMergeHistoryTaskDLQMessagesResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.PurgeDLQMessages(ctx, proto.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return proto.ToError(err)
 }
 
+func (g adminClient) PurgeHistoryTaskDLQMessages(ctx context.Context, pp1 *types.PurgeHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PurgeHistoryTaskDLQMessages(ctx, proto.FromAdminPurgeHistoryTaskDLQMessagesRequest(pp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	response, err := g.c.ReadDLQMessages(ctx, proto.FromAdminReadDLQMessagesRequest(rp1), p1...)
 	return proto.ToAdminReadDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) ReadHistoryTaskDLQMessages(ctx context.Context, rp1 *types.ReadHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadHistoryTaskDLQMessagesResponse, err error) {
+	response, err := g.c.ReadHistoryTaskDLQMessages(ctx, proto.FromAdminReadHistoryTaskDLQMessagesRequest(rp1), p1...)
+	return proto.ToAdminReadHistoryTaskDLQMessagesResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEventsRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ReapplyEvents(ctx, proto.FromAdminReapplyEventsRequest(rp1), p1...)
 	return proto.ToError(err)
 }
 
+func (g adminClient) RebuildWorkflowBranch(ctx context.Context, rp1 *types.RebuildWorkflowBranchRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.RebuildWorkflowBranch(ctx, proto.FromAdminRebuildWorkflowBranchRequest(rp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g adminClient) RefreshWorkflowTasks(ctx context.Context, rp1 *types.RefreshWorkflowTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.RefreshWorkflowTasks(ctx, proto.FromAdminRefreshWorkflowTasksRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -161,6 +211,11 @@ func (g adminClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReques
 	return proto.ToError(err)
 }
 
+func (g adminClient) RenameDomain(ctx context.Context, rp1 *types.RenameDomainRequest, p1 ...yarpc.CallOption) (rp2 *types.RenameDomainResponse, err error) {
+	response, err := g.c.RenameDomain(ctx, proto.FromAdminRenameDomainRequest(rp1), p1...)
+	return proto.ToAdminRenameDomainResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ResendReplicationTasks(ctx, proto.FromAdminResendReplicationTasksRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -176,6 +231,11 @@ func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Restor
 	return proto.ToError(err)
 }
 
+func (g adminClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	response, err := g.c.UpdateActivityOptions(ctx, proto.FromAdminUpdateActivityOptionsRequest(up1), p1...)
+	return proto.ToAdminUpdateActivityOptionsResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, proto.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return proto.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), proto.ToError(err)
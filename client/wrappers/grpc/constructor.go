@@ -26,6 +26,8 @@ import (
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 
+	adminextv1 "github.com/uber/cadence/.gen/proto/adminext/v1"
+	apiextv1 "github.com/uber/cadence/.gen/proto/apiext/v1"
 	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	matchingv1 "github.com/uber/cadence/.gen/proto/matching/v1"
 	sharddistributorv1 "github.com/uber/cadence/.gen/proto/sharddistributor/v1"
@@ -37,8 +39,12 @@ import (
 )
 
 type (
+	adminGRPCClientWrapper struct {
+		adminv1.AdminAPIYARPCClient
+		adminextv1.AdminExtAPIYARPCClient
+	}
 	adminClient struct {
-		c adminv1.AdminAPIYARPCClient
+		c *adminGRPCClientWrapper
 	}
 
 	frontendGRPCClientWrapper struct {
@@ -49,6 +55,9 @@ type (
 	}
 	frontendClient struct {
 		c *frontendGRPCClientWrapper
+		// ext calls the workflow APIs that are not part of the published IDL,
+		// it can't be embedded in the wrapper as its methods share their names with the WorkflowAPI ones
+		ext apiextv1.WorkflowExtAPIYARPCClient
 	}
 	historyClient struct {
 		c historyv1.HistoryAPIYARPCClient
@@ -61,8 +70,11 @@ type (
 	}
 )
 
-func NewAdminClient(c adminv1.AdminAPIYARPCClient) admin.Client {
-	return adminClient{c}
+func NewAdminClient(
+	c adminv1.AdminAPIYARPCClient,
+	ext adminextv1.AdminExtAPIYARPCClient,
+) admin.Client {
+	return adminClient{&adminGRPCClientWrapper{c, ext}}
 }
 
 func NewFrontendClient(
@@ -70,8 +82,9 @@ func NewFrontendClient(
 	workflow apiv1.WorkflowAPIYARPCClient,
 	worker apiv1.WorkerAPIYARPCClient,
 	visibility apiv1.VisibilityAPIYARPCClient,
+	ext apiextv1.WorkflowExtAPIYARPCClient,
 ) frontend.Client {
-	return frontendClient{&frontendGRPCClientWrapper{domain, workflow, worker, visibility}}
+	return frontendClient{&frontendGRPCClientWrapper{domain, workflow, worker, visibility}, ext}
 }
 
 func NewHistoryClient(c historyv1.HistoryAPIYARPCClient) history.Client {
@@ -237,6 +237,10 @@ func (g frontendClient) SignalWorkflowExecution(ctx context.Context, sp1 *types.
 }
 
 func (g frontendClient) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionResponse, err error) {
+	if sp1.GetRequestEagerExecution() {
+		response, err := g.ext.StartWorkflowExecution(ctx, proto.FromWorkflowExtStartWorkflowExecutionRequest(sp1), p1...)
+		return proto.ToWorkflowExtStartWorkflowExecutionResponse(response), proto.ToError(err)
+	}
 	response, err := g.c.StartWorkflowExecution(ctx, proto.FromStartWorkflowExecutionRequest(sp1), p1...)
 	return proto.ToStartWorkflowExecutionResponse(response), proto.ToError(err)
 }
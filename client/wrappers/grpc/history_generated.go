@@ -90,6 +90,11 @@ func (g historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.Ge
 	return proto.ToHistoryGetReplicationMessagesResponse(response), proto.ToError(err)
 }
 
+func (g historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (hp1 *types.HistoryGetReplicationStatusResponse, err error) {
+	response, err := g.c.GetReplicationStatus(ctx, proto.FromHistoryGetReplicationStatusRequest(gp1), p1...)
+	return proto.ToHistoryGetReplicationStatusResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	response, err := g.c.MergeDLQMessages(ctx, proto.FromHistoryMergeDLQMessagesRequest(mp1), p1...)
 	return proto.ToHistoryMergeDLQMessagesResponse(response), proto.ToError(err)
@@ -130,6 +135,11 @@ func (g historyClient) ReapplyEvents(ctx context.Context, hp1 *types.HistoryReap
 	return proto.ToError(err)
 }
 
+func (g historyClient) RebuildWorkflowBranch(ctx context.Context, hp1 *types.HistoryRebuildWorkflowBranchRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.RebuildWorkflowBranch(ctx, proto.FromHistoryRebuildWorkflowBranchRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) RecordActivityTaskHeartbeat(ctx context.Context, hp1 *types.HistoryRecordActivityTaskHeartbeatRequest, p1 ...yarpc.CallOption) (rp1 *types.RecordActivityTaskHeartbeatResponse, err error) {
 	response, err := g.c.RecordActivityTaskHeartbeat(ctx, proto.FromHistoryRecordActivityTaskHeartbeatRequest(hp1), p1...)
 	return proto.ToHistoryRecordActivityTaskHeartbeatResponse(response), proto.ToError(err)
@@ -254,3 +264,8 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	_, err = g.c.TerminateWorkflowExecution(ctx, proto.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToError(err)
 }
+
+func (g historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	response, err := g.c.UpdateActivityOptions(ctx, proto.FromHistoryUpdateActivityOptionsRequest(hp1), p1...)
+	return proto.ToHistoryUpdateActivityOptionsResponse(response), proto.ToError(err)
+}
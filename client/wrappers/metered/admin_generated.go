@@ -89,6 +89,19 @@ func (c *adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQM
 	return cp2, err
 }
 
+func (c *adminClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DeleteDomainResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteDomainScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteDomainScope, metrics.CadenceClientLatency)
+	dp2, err = c.client.DeleteDomain(ctx, dp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteDomainScope, metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowScope, metrics.CadenceClientRequests)
 
@@ -128,6 +141,19 @@ func (c *adminClient) DescribeHistoryHost(ctx context.Context, dp1 *types.Descri
 	return dp2, err
 }
 
+func (c *adminClient) DescribeHistoryTaskDLQMessage(ctx context.Context, dp1 *types.DescribeHistoryTaskDLQMessageRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeHistoryTaskDLQMessageResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeHistoryTaskDLQMessageScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeHistoryTaskDLQMessageScope, metrics.CadenceClientLatency)
+	dp2, err = c.client.DescribeHistoryTaskDLQMessage(ctx, dp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeHistoryTaskDLQMessageScope, metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *adminClient) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueueRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeQueueResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientDescribeQueueScope, metrics.CadenceClientRequests)
 
@@ -154,6 +180,19 @@ func (c *adminClient) DescribeShardDistribution(ctx context.Context, dp1 *types.
 	return dp2, err
 }
 
+func (c *adminClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTaskListScope, metrics.CadenceClientLatency)
+	dp2, err = c.client.DescribeTaskList(ctx, dp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListScope, metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.AdminDescribeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDescribeWorkflowExecutionResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientDescribeWorkflowExecutionScope, metrics.CadenceClientRequests)
 
@@ -167,6 +206,19 @@ func (c *adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.
 	return ap2, err
 }
 
+func (c *adminClient) DescribeWorkflowVersionHistories(ctx context.Context, dp1 *types.DescribeWorkflowVersionHistoriesRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkflowVersionHistoriesResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeWorkflowVersionHistoriesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeWorkflowVersionHistoriesScope, metrics.CadenceClientLatency)
+	dp2, err = c.client.DescribeWorkflowVersionHistories(ctx, dp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeWorkflowVersionHistoriesScope, metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *adminClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types.GetDLQReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDLQReplicationMessagesResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientGetDLQReplicationMessagesScope, metrics.CadenceClientRequests)
 
@@ -258,6 +310,19 @@ func (c *adminClient) GetReplicationMessages(ctx context.Context, gp1 *types.Get
 	return gp2, err
 }
 
+func (c *adminClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationStatusResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientGetReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetReplicationStatusScope, metrics.CadenceClientLatency)
+	gp2, err = c.client.GetReplicationStatus(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
 func (c *adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1 *types.GetWorkflowExecutionRawHistoryV2Request, p1 ...yarpc.CallOption) (gp2 *types.GetWorkflowExecutionRawHistoryV2Response, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientGetWorkflowExecutionRawHistoryV2Scope, metrics.CadenceClientRequests)
 
@@ -271,6 +336,19 @@ func (c *adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1
 	return gp2, err
 }
 
+func (c *adminClient) ListAuditLogEntries(ctx context.Context, lp1 *types.ListAuditLogEntriesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListAuditLogEntriesResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientListAuditLogEntriesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListAuditLogEntriesScope, metrics.CadenceClientLatency)
+	lp2, err = c.client.ListAuditLogEntries(ctx, lp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListAuditLogEntriesScope, metrics.CadenceClientFailures)
+	}
+	return lp2, err
+}
+
 func (c *adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.CadenceClientRequests)
 
@@ -310,6 +388,19 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return mp2, err
 }
 
+func (c *adminClient) MergeHistoryTaskDLQMessages(ctx context.Context, mp1 *types.MergeHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeHistoryTaskDLQMessagesResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientMergeHistoryTaskDLQMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMergeHistoryTaskDLQMessagesScope, metrics.CadenceClientLatency)
+	mp2, err = c.client.MergeHistoryTaskDLQMessages(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMergeHistoryTaskDLQMessagesScope, metrics.CadenceClientFailures)
+	}
+	return mp2, err
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientPurgeDLQMessagesScope, metrics.CadenceClientRequests)
 
@@ -323,6 +414,19 @@ func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQM
 	return err
 }
 
+func (c *adminClient) PurgeHistoryTaskDLQMessages(ctx context.Context, pp1 *types.PurgeHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientPurgeHistoryTaskDLQMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPurgeHistoryTaskDLQMessagesScope, metrics.CadenceClientLatency)
+	err = c.client.PurgeHistoryTaskDLQMessages(ctx, pp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPurgeHistoryTaskDLQMessagesScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientReadDLQMessagesScope, metrics.CadenceClientRequests)
 
@@ -336,6 +440,19 @@ func (c *adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMes
 	return rp2, err
 }
 
+func (c *adminClient) ReadHistoryTaskDLQMessages(ctx context.Context, rp1 *types.ReadHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadHistoryTaskDLQMessagesResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientReadHistoryTaskDLQMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReadHistoryTaskDLQMessagesScope, metrics.CadenceClientLatency)
+	rp2, err = c.client.ReadHistoryTaskDLQMessages(ctx, rp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReadHistoryTaskDLQMessagesScope, metrics.CadenceClientFailures)
+	}
+	return rp2, err
+}
+
 func (c *adminClient) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEventsRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientReapplyEventsScope, metrics.CadenceClientRequests)
 
@@ -349,6 +466,19 @@ func (c *adminClient) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEvent
 	return err
 }
 
+func (c *adminClient) RebuildWorkflowBranch(ctx context.Context, rp1 *types.RebuildWorkflowBranchRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientRebuildWorkflowBranchScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRebuildWorkflowBranchScope, metrics.CadenceClientLatency)
+	err = c.client.RebuildWorkflowBranch(ctx, rp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRebuildWorkflowBranchScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *adminClient) RefreshWorkflowTasks(ctx context.Context, rp1 *types.RefreshWorkflowTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientRefreshWorkflowTasksScope, metrics.CadenceClientRequests)
 
@@ -375,6 +505,19 @@ func (c *adminClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReque
 	return err
 }
 
+func (c *adminClient) RenameDomain(ctx context.Context, rp1 *types.RenameDomainRequest, p1 ...yarpc.CallOption) (rp2 *types.RenameDomainResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientRenameDomainScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameDomainScope, metrics.CadenceClientLatency)
+	rp2, err = c.client.RenameDomain(ctx, rp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameDomainScope, metrics.CadenceClientFailures)
+	}
+	return rp2, err
+}
+
 func (c *adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientResendReplicationTasksScope, metrics.CadenceClientRequests)
 
@@ -414,6 +557,19 @@ func (c *adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Resto
 	return err
 }
 
+func (c *adminClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityOptionsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateActivityOptionsScope, metrics.CadenceClientLatency)
+	up2, err = c.client.UpdateActivityOptions(ctx, up1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityOptionsScope, metrics.CadenceClientFailures)
+	}
+	return up2, err
+}
+
 func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientUpdateDomainAsyncWorkflowConfiguratonScope, metrics.CadenceClientRequests)
 
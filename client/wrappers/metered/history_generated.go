@@ -193,6 +193,19 @@ func (c *historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.G
 	return gp2, err
 }
 
+func (c *historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (hp1 *types.HistoryGetReplicationStatusResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceClientLatency)
+	hp1, err = c.client.GetReplicationStatus(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+	return hp1, err
+}
+
 func (c *historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientMergeDLQMessagesScope, metrics.CadenceClientRequests)
 
@@ -297,6 +310,19 @@ func (c *historyClient) ReapplyEvents(ctx context.Context, hp1 *types.HistoryRea
 	return err
 }
 
+func (c *historyClient) RebuildWorkflowBranch(ctx context.Context, hp1 *types.HistoryRebuildWorkflowBranchRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientRebuildWorkflowBranchScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRebuildWorkflowBranchScope, metrics.CadenceClientLatency)
+	err = c.client.RebuildWorkflowBranch(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRebuildWorkflowBranchScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *historyClient) RecordActivityTaskHeartbeat(ctx context.Context, hp1 *types.HistoryRecordActivityTaskHeartbeatRequest, p1 ...yarpc.CallOption) (rp1 *types.RecordActivityTaskHeartbeatResponse, err error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientRecordActivityTaskHeartbeatScope, metrics.CadenceClientRequests)
 
@@ -621,3 +647,16 @@ func (c *historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *typ
 	}
 	return err
 }
+
+func (c *historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateActivityOptionsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateActivityOptionsScope, metrics.CadenceClientLatency)
+	up1, err = c.client.UpdateActivityOptions(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateActivityOptionsScope, metrics.CadenceClientFailures)
+	}
+	return up1, err
+}
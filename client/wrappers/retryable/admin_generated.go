@@ -78,6 +78,17 @@ func (c *adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQM
 	return resp, err
 }
 
+func (c *adminClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DeleteDomainResponse, err error) {
+	var resp *types.DeleteDomainResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteDomain(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	var resp *types.AdminDeleteWorkflowResponse
 	op := func() error {
@@ -111,6 +122,17 @@ func (c *adminClient) DescribeHistoryHost(ctx context.Context, dp1 *types.Descri
 	return resp, err
 }
 
+func (c *adminClient) DescribeHistoryTaskDLQMessage(ctx context.Context, dp1 *types.DescribeHistoryTaskDLQMessageRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeHistoryTaskDLQMessageResponse, err error) {
+	var resp *types.DescribeHistoryTaskDLQMessageResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeHistoryTaskDLQMessage(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueueRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeQueueResponse, err error) {
 	var resp *types.DescribeQueueResponse
 	op := func() error {
@@ -133,6 +155,17 @@ func (c *adminClient) DescribeShardDistribution(ctx context.Context, dp1 *types.
 	return resp, err
 }
 
+func (c *adminClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
+	var resp *types.DescribeTaskListResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskList(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.AdminDescribeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDescribeWorkflowExecutionResponse, err error) {
 	var resp *types.AdminDescribeWorkflowExecutionResponse
 	op := func() error {
@@ -144,6 +177,17 @@ func (c *adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.
 	return resp, err
 }
 
+func (c *adminClient) DescribeWorkflowVersionHistories(ctx context.Context, dp1 *types.DescribeWorkflowVersionHistoriesRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkflowVersionHistoriesResponse, err error) {
+	var resp *types.DescribeWorkflowVersionHistoriesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeWorkflowVersionHistories(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types.GetDLQReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDLQReplicationMessagesResponse, err error) {
 	var resp *types.GetDLQReplicationMessagesResponse
 	op := func() error {
@@ -221,6 +265,17 @@ func (c *adminClient) GetReplicationMessages(ctx context.Context, gp1 *types.Get
 	return resp, err
 }
 
+func (c *adminClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationStatusResponse, err error) {
+	var resp *types.GetReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1 *types.GetWorkflowExecutionRawHistoryV2Request, p1 ...yarpc.CallOption) (gp2 *types.GetWorkflowExecutionRawHistoryV2Response, err error) {
 	var resp *types.GetWorkflowExecutionRawHistoryV2Response
 	op := func() error {
@@ -232,6 +287,17 @@ func (c *adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1
 	return resp, err
 }
 
+func (c *adminClient) ListAuditLogEntries(ctx context.Context, lp1 *types.ListAuditLogEntriesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListAuditLogEntriesResponse, err error) {
+	var resp *types.ListAuditLogEntriesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListAuditLogEntries(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigResponse, err error) {
 	var resp *types.ListDynamicConfigResponse
 	op := func() error {
@@ -265,6 +331,17 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return resp, err
 }
 
+func (c *adminClient) MergeHistoryTaskDLQMessages(ctx context.Context, mp1 *types.MergeHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeHistoryTaskDLQMessagesResponse, err error) {
+	var resp *types.MergeHistoryTaskDLQMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MergeHistoryTaskDLQMessages(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.PurgeDLQMessages(ctx, pp1, p1...)
@@ -272,6 +349,13 @@ func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQM
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) PurgeHistoryTaskDLQMessages(ctx context.Context, pp1 *types.PurgeHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.PurgeHistoryTaskDLQMessages(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	var resp *types.ReadDLQMessagesResponse
 	op := func() error {
@@ -283,6 +367,17 @@ func (c *adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMes
 	return resp, err
 }
 
+func (c *adminClient) ReadHistoryTaskDLQMessages(ctx context.Context, rp1 *types.ReadHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadHistoryTaskDLQMessagesResponse, err error) {
+	var resp *types.ReadHistoryTaskDLQMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadHistoryTaskDLQMessages(ctx, rp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEventsRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.ReapplyEvents(ctx, rp1, p1...)
@@ -290,6 +385,13 @@ func (c *adminClient) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEvent
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) RebuildWorkflowBranch(ctx context.Context, rp1 *types.RebuildWorkflowBranchRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.RebuildWorkflowBranch(ctx, rp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *adminClient) RefreshWorkflowTasks(ctx context.Context, rp1 *types.RefreshWorkflowTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.RefreshWorkflowTasks(ctx, rp1, p1...)
@@ -304,6 +406,17 @@ func (c *adminClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReque
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) RenameDomain(ctx context.Context, rp1 *types.RenameDomainRequest, p1 ...yarpc.CallOption) (rp2 *types.RenameDomainResponse, err error) {
+	var resp *types.RenameDomainResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RenameDomain(ctx, rp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.ResendReplicationTasks(ctx, rp1, p1...)
@@ -325,6 +438,17 @@ func (c *adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Resto
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	var resp *types.UpdateActivityOptionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	var resp *types.UpdateDomainAsyncWorkflowConfiguratonResponse
 	op := func() error {
@@ -170,6 +170,17 @@ func (c *historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.G
 	return resp, err
 }
 
+func (c *historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (hp1 *types.HistoryGetReplicationStatusResponse, err error) {
+	var resp *types.HistoryGetReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	var resp *types.MergeDLQMessagesResponse
 	op := func() error {
@@ -246,6 +257,13 @@ func (c *historyClient) ReapplyEvents(ctx context.Context, hp1 *types.HistoryRea
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) RebuildWorkflowBranch(ctx context.Context, hp1 *types.HistoryRebuildWorkflowBranchRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.RebuildWorkflowBranch(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) RecordActivityTaskHeartbeat(ctx context.Context, hp1 *types.HistoryRecordActivityTaskHeartbeatRequest, p1 ...yarpc.CallOption) (rp1 *types.RecordActivityTaskHeartbeatResponse, err error) {
 	var resp *types.RecordActivityTaskHeartbeatResponse
 	op := func() error {
@@ -456,3 +474,14 @@ func (c *historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *typ
 	}
 	return c.throttleRetry.Do(ctx, op)
 }
+
+func (c *historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	var resp *types.UpdateActivityOptionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
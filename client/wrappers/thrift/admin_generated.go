@@ -32,6 +32,7 @@ import (
 	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
@@ -49,6 +50,11 @@ func (g adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQMe
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g adminClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DeleteDomainResponse, err error) {
+	response, err := g.ext.DeleteDomain(ctx, proto.FromAdminDeleteDomainRequest(dp1), p1...)
+	return proto.ToAdminDeleteDomainResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	response, err := g.c.DeleteWorkflow(ctx, thrift.FromAdminDeleteWorkflowRequest(ap1), p1...)
 	return thrift.ToAdminDeleteWorkflowResponse(response), thrift.ToError(err)
@@ -64,6 +70,11 @@ func (g adminClient) DescribeHistoryHost(ctx context.Context, dp1 *types.Describ
 	return thrift.ToAdminDescribeHistoryHostResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) DescribeHistoryTaskDLQMessage(ctx context.Context, dp1 *types.DescribeHistoryTaskDLQMessageRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeHistoryTaskDLQMessageResponse, err error) {
+	response, err := g.ext.DescribeHistoryTaskDLQMessage(ctx, proto.FromAdminDescribeHistoryTaskDLQMessageRequest(dp1), p1...)
+	return proto.ToAdminDescribeHistoryTaskDLQMessageResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueueRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeQueueResponse, err error) {
 	response, err := g.c.DescribeQueue(ctx, thrift.FromAdminDescribeQueueRequest(dp1), p1...)
 	return thrift.ToAdminDescribeQueueResponse(response), thrift.ToError(err)
@@ -74,11 +85,21 @@ func (g adminClient) DescribeShardDistribution(ctx context.Context, dp1 *types.D
 	return thrift.ToAdminDescribeShardDistributionResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
+	response, err := g.ext.DescribeTaskList(ctx, proto.FromAdminDescribeTaskListRequest(dp1), p1...)
+	return proto.ToAdminDescribeTaskListResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.AdminDescribeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDescribeWorkflowExecutionResponse, err error) {
 	response, err := g.c.DescribeWorkflowExecution(ctx, thrift.FromAdminDescribeWorkflowExecutionRequest(ap1), p1...)
 	return thrift.ToAdminDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) DescribeWorkflowVersionHistories(ctx context.Context, dp1 *types.DescribeWorkflowVersionHistoriesRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkflowVersionHistoriesResponse, err error) {
+	response, err := g.ext.DescribeWorkflowVersionHistories(ctx, proto.FromAdminDescribeWorkflowVersionHistoriesRequest(dp1), p1...)
+	return proto.ToAdminDescribeWorkflowVersionHistoriesResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types.GetDLQReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDLQReplicationMessagesResponse, err error) {
 	response, err := g.c.GetDLQReplicationMessages(ctx, thrift.FromAdminGetDLQReplicationMessagesRequest(gp1), p1...)
 	return thrift.ToAdminGetDLQReplicationMessagesResponse(response), thrift.ToError(err)
@@ -114,11 +135,21 @@ func (g adminClient) GetReplicationMessages(ctx context.Context, gp1 *types.GetR
 	return thrift.ToAdminGetReplicationMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationStatusResponse, err error) {
+	response, err := g.ext.GetReplicationStatus(ctx, proto.FromAdminGetReplicationStatusRequest(gp1), p1...)
+	return proto.ToAdminGetReplicationStatusResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1 *types.GetWorkflowExecutionRawHistoryV2Request, p1 ...yarpc.CallOption) (gp2 *types.GetWorkflowExecutionRawHistoryV2Response, err error) {
 	response, err := g.c.GetWorkflowExecutionRawHistoryV2(ctx, thrift.FromAdminGetWorkflowExecutionRawHistoryV2Request(gp1), p1...)
 	return thrift.ToAdminGetWorkflowExecutionRawHistoryV2Response(response), thrift.ToError(err)
 }
 
+func (g adminClient) ListAuditLogEntries(ctx context.Context, lp1 *types.ListAuditLogEntriesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListAuditLogEntriesResponse, err error) {
+	response, err := g.ext.ListAuditLogEntries(ctx, proto.FromAdminListAuditLogEntriesRequest(lp1), p1...)
+	return proto.ToAdminListAuditLogEntriesResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigResponse, err error) {
 	response, err := g.c.ListDynamicConfig(ctx, thrift.FromAdminListDynamicConfigRequest(lp1), p1...)
 	return thrift.ToAdminListDynamicConfigResponse(response), thrift.ToError(err)
@@ -134,21 +165,41 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return thrift.ToAdminMergeDLQMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) MergeHistoryTaskDLQMessages(ctx context.Context, mp1 *types.MergeHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeHistoryTaskDLQMessagesResponse, err error) {
+	response, err := g.ext.MergeHistoryTaskDLQMessages(ctx, proto.FromAdminMergeHistoryTaskDLQMessagesRequest(mp1), p1...)
+	return proto.ToAdminMergeHistoryTaskDLQMessagesResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.PurgeDLQMessages(ctx, thrift.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return thrift.ToError(err)
 }
 
+func (g adminClient) PurgeHistoryTaskDLQMessages(ctx context.Context, pp1 *types.PurgeHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.ext.PurgeHistoryTaskDLQMessages(ctx, proto.FromAdminPurgeHistoryTaskDLQMessagesRequest(pp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	response, err := g.c.ReadDLQMessages(ctx, thrift.FromAdminReadDLQMessagesRequest(rp1), p1...)
 	return thrift.ToAdminReadDLQMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) ReadHistoryTaskDLQMessages(ctx context.Context, rp1 *types.ReadHistoryTaskDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadHistoryTaskDLQMessagesResponse, err error) {
+	response, err := g.ext.ReadHistoryTaskDLQMessages(ctx, proto.FromAdminReadHistoryTaskDLQMessagesRequest(rp1), p1...)
+	return proto.ToAdminReadHistoryTaskDLQMessagesResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEventsRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ReapplyEvents(ctx, thrift.FromAdminReapplyEventsRequest(rp1), p1...)
 	return thrift.ToError(err)
 }
 
+func (g adminClient) RebuildWorkflowBranch(ctx context.Context, rp1 *types.RebuildWorkflowBranchRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.ext.RebuildWorkflowBranch(ctx, proto.FromAdminRebuildWorkflowBranchRequest(rp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g adminClient) RefreshWorkflowTasks(ctx context.Context, rp1 *types.RefreshWorkflowTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.RefreshWorkflowTasks(ctx, thrift.FromAdminRefreshWorkflowTasksRequest(rp1), p1...)
 	return thrift.ToError(err)
@@ -159,6 +210,11 @@ func (g adminClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReques
 	return thrift.ToError(err)
 }
 
+func (g adminClient) RenameDomain(ctx context.Context, rp1 *types.RenameDomainRequest, p1 ...yarpc.CallOption) (rp2 *types.RenameDomainResponse, err error) {
+	response, err := g.ext.RenameDomain(ctx, proto.FromAdminRenameDomainRequest(rp1), p1...)
+	return proto.ToAdminRenameDomainResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ResendReplicationTasks(ctx, thrift.FromAdminResendReplicationTasksRequest(rp1), p1...)
 	return thrift.ToError(err)
@@ -174,6 +230,11 @@ func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Restor
 	return thrift.ToError(err)
 }
 
+func (g adminClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	response, err := g.ext.UpdateActivityOptions(ctx, proto.FromAdminUpdateActivityOptionsRequest(up1), p1...)
+	return proto.ToAdminUpdateActivityOptionsResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, thrift.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return thrift.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), thrift.ToError(err)
@@ -25,6 +25,8 @@ import (
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
 	"github.com/uber/cadence/.gen/go/matching/matchingserviceclient"
+	adminextv1 "github.com/uber/cadence/.gen/proto/adminext/v1"
+	apiextv1 "github.com/uber/cadence/.gen/proto/apiext/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
@@ -34,9 +36,15 @@ import (
 type (
 	adminClient struct {
 		c adminserviceclient.Interface
+		// ext calls the admin APIs that are not part of the published thrift IDL,
+		// the proto procedures are served over TChannel too
+		ext adminextv1.AdminExtAPIYARPCClient
 	}
 	frontendClient struct {
 		c workflowserviceclient.Interface
+		// ext calls the workflow APIs that are not part of the published thrift IDL,
+		// the proto procedures are served over TChannel too
+		ext apiextv1.WorkflowExtAPIYARPCClient
 	}
 	historyClient struct {
 		c historyserviceclient.Interface
@@ -46,12 +54,18 @@ type (
 	}
 )
 
-func NewAdminClient(c adminserviceclient.Interface) admin.Client {
-	return adminClient{c}
+func NewAdminClient(
+	c adminserviceclient.Interface,
+	ext adminextv1.AdminExtAPIYARPCClient,
+) admin.Client {
+	return adminClient{c, ext}
 }
 
-func NewFrontendClient(c workflowserviceclient.Interface) frontend.Client {
-	return frontendClient{c}
+func NewFrontendClient(
+	c workflowserviceclient.Interface,
+	ext apiextv1.WorkflowExtAPIYARPCClient,
+) frontend.Client {
+	return frontendClient{c, ext}
 }
 
 func NewHistoryClient(c historyserviceclient.Interface) history.Client {
@@ -32,6 +32,7 @@ import (
 	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
@@ -236,6 +237,10 @@ func (g frontendClient) SignalWorkflowExecution(ctx context.Context, sp1 *types.
 }
 
 func (g frontendClient) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionResponse, err error) {
+	if sp1.GetRequestEagerExecution() {
+		response, err := g.ext.StartWorkflowExecution(ctx, proto.FromWorkflowExtStartWorkflowExecutionRequest(sp1), p1...)
+		return proto.ToWorkflowExtStartWorkflowExecutionResponse(response), proto.ToError(err)
+	}
 	response, err := g.c.StartWorkflowExecution(ctx, thrift.FromStartWorkflowExecutionRequest(sp1), p1...)
 	return thrift.ToStartWorkflowExecutionResponse(response), thrift.ToError(err)
 }
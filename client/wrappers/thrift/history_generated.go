@@ -85,10 +85,17 @@ func (g historyClient) GetMutableState(ctx context.Context, gp1 *types.GetMutabl
 }
 
 func (g historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.GetReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationMessagesResponse, err error) {
+	if gp1.GetWaitForNewTasks() {
+		return nil, thrift.ToError(&types.BadRequestError{Message: "Waiting for new replication tasks is not supported on TChannel"})
+	}
 	response, err := g.c.GetReplicationMessages(ctx, thrift.FromHistoryGetReplicationMessagesRequest(gp1), p1...)
 	return thrift.ToHistoryGetReplicationMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (hp1 *types.HistoryGetReplicationStatusResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	response, err := g.c.MergeDLQMessages(ctx, thrift.FromHistoryMergeDLQMessagesRequest(mp1), p1...)
 	return thrift.ToHistoryMergeDLQMessagesResponse(response), thrift.ToError(err)
@@ -129,6 +136,10 @@ func (g historyClient) ReapplyEvents(ctx context.Context, hp1 *types.HistoryReap
 	return thrift.ToError(err)
 }
 
+func (g historyClient) RebuildWorkflowBranch(ctx context.Context, hp1 *types.HistoryRebuildWorkflowBranchRequest, p1 ...yarpc.CallOption) (err error) {
+	return thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g historyClient) RecordActivityTaskHeartbeat(ctx context.Context, hp1 *types.HistoryRecordActivityTaskHeartbeatRequest, p1 ...yarpc.CallOption) (rp1 *types.RecordActivityTaskHeartbeatResponse, err error) {
 	response, err := g.c.RecordActivityTaskHeartbeat(ctx, thrift.FromHistoryRecordActivityTaskHeartbeatRequest(hp1), p1...)
 	return thrift.ToHistoryRecordActivityTaskHeartbeatResponse(response), thrift.ToError(err)
@@ -253,3 +264,7 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	err = g.c.TerminateWorkflowExecution(ctx, thrift.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return thrift.ToError(err)
 }
+
+func (g historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
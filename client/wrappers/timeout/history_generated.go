@@ -111,6 +111,10 @@ func (c *historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.G
 	return c.client.GetReplicationMessages(ctx, gp1, p1...)
 }
 
+func (c *historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (hp1 *types.HistoryGetReplicationStatusResponse, err error) {
+	return c.client.GetReplicationStatus(ctx, gp1, p1...)
+}
+
 func (c *historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	return c.client.MergeDLQMessages(ctx, mp1, p1...)
 }
@@ -153,6 +157,12 @@ func (c *historyClient) ReapplyEvents(ctx context.Context, hp1 *types.HistoryRea
 	return c.client.ReapplyEvents(ctx, hp1, p1...)
 }
 
+func (c *historyClient) RebuildWorkflowBranch(ctx context.Context, hp1 *types.HistoryRebuildWorkflowBranchRequest, p1 ...yarpc.CallOption) (err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.RebuildWorkflowBranch(ctx, hp1, p1...)
+}
+
 func (c *historyClient) RecordActivityTaskHeartbeat(ctx context.Context, hp1 *types.HistoryRecordActivityTaskHeartbeatRequest, p1 ...yarpc.CallOption) (rp1 *types.RecordActivityTaskHeartbeatResponse, err error) {
 	ctx, cancel := createContext(ctx, c.timeout)
 	defer cancel()
@@ -302,3 +312,9 @@ func (c *historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *typ
 	defer cancel()
 	return c.client.TerminateWorkflowExecution(ctx, hp1, p1...)
 }
+
+func (c *historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.UpdateActivityOptions(ctx, hp1, p1...)
+}
@@ -0,0 +1,352 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// batchWorkflowTypeName is the workflow type of batch operations, see service/worker/batcher
+	batchWorkflowTypeName = "cadence-sys-batch-workflow"
+	startWorkflowAPIName  = "StartWorkflowExecution"
+
+	defaultBufferSize = 1000
+	// enqueueTimeout bounds how long Record waits for room in a full buffer before dropping the entry
+	enqueueTimeout = 5 * time.Second
+	// writeTimeout bounds the write of an entry to a sink
+	writeTimeout = 5 * time.Second
+	// retentionInterval is how often the entries older than the retention are deleted
+	retentionInterval = time.Hour
+	// retentionTimeout bounds the deletion of the old entries of a sink
+	retentionTimeout = time.Minute
+)
+
+var errNoQueryableSink = &types.BadRequestError{Message: "No queryable audit log sink is configured."}
+
+type (
+	auditorImpl struct {
+		status         int32
+		sinks          []Sink
+		entries        chan *types.AuditLogEntry
+		enqueueTimeout time.Duration
+		retention      time.Duration
+		logger         log.Logger
+		metricsScope   metrics.Scope
+		timeSource     clock.TimeSource
+		shutdownCh     chan struct{}
+		shutdownWG     sync.WaitGroup
+	}
+
+	nopAuditor struct{}
+)
+
+// NewAuditor creates an Auditor writing to the sinks enabled in the config
+func NewAuditor(
+	cfg config.Audit,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	timeSource clock.TimeSource,
+	messagingClient messaging.Client,
+	queueManager persistence.QueueManager,
+) (Auditor, error) {
+	if !cfg.Enable {
+		return NewNopAuditor(), nil
+	}
+
+	var sinks []Sink
+	if cfg.Persistence {
+		sinks = append(sinks, NewPersistenceSink(queueManager))
+	}
+	if cfg.LogFile != "" {
+		sink, err := NewLogFileSink(cfg.LogFile)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.KafkaApplication != "" {
+		if messagingClient == nil {
+			return nil, errors.New("kafka audit log sink requires kafka to be configured")
+		}
+		producer, err := messagingClient.NewProducer(cfg.KafkaApplication)
+		if err != nil {
+			return nil, fmt.Errorf("creating kafka audit log sink: %w", err)
+		}
+		sinks = append(sinks, NewKafkaSink(producer))
+	}
+	bufferSize := cfg.BufferSize
+	if bufferSize == 0 {
+		bufferSize = defaultBufferSize
+	}
+	return newAuditor(sinks, bufferSize, cfg.Retention, logger, metricsClient, timeSource), nil
+}
+
+func newAuditor(
+	sinks []Sink,
+	bufferSize int,
+	retention time.Duration,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	timeSource clock.TimeSource,
+) *auditorImpl {
+	return &auditorImpl{
+		status:         common.DaemonStatusInitialized,
+		sinks:          sinks,
+		entries:        make(chan *types.AuditLogEntry, bufferSize),
+		enqueueTimeout: enqueueTimeout,
+		retention:      retention,
+		logger:         logger,
+		metricsScope:   metricsClient.Scope(metrics.AuditLogScope),
+		timeSource:     timeSource,
+		shutdownCh:     make(chan struct{}),
+	}
+}
+
+// NewNopAuditor creates an Auditor which doesn't record anything
+func NewNopAuditor() Auditor {
+	return &nopAuditor{}
+}
+
+func (a *auditorImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&a.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	a.shutdownWG.Add(1)
+	go a.writeLoop()
+	if a.retention > 0 {
+		a.shutdownWG.Add(1)
+		go a.retentionLoop()
+	}
+}
+
+// Stop writes the entries queued so far and stops the auditor
+func (a *auditorImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&a.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(a.shutdownCh)
+	a.shutdownWG.Wait()
+}
+
+func (a *auditorImpl) Record(ctx context.Context, attributes *authorization.Attributes, err error) {
+	if !shouldAudit(attributes) {
+		return
+	}
+
+	entry := &types.AuditLogEntry{
+		TimestampNano: a.timeSource.Now().UnixNano(),
+		Actor:         actor(ctx, attributes),
+		APIName:       attributes.APIName,
+		Domain:        attributes.DomainName,
+		Outcome:       outcome(err),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if attributes.RequestBody != nil {
+		request, serializeErr := attributes.RequestBody.SerializeForLogging()
+		if serializeErr != nil {
+			a.logger.Warn("failed to serialize audited request", tag.OperationName(attributes.APIName), tag.Error(serializeErr))
+		}
+		entry.Request = request
+	}
+
+	select {
+	case a.entries <- entry:
+		return
+	default:
+	}
+
+	// the buffer is full, hold the caller until the sinks catch up rather than losing the entry
+	a.metricsScope.IncCounter(metrics.AuditLogBackpressure)
+	timer := time.NewTimer(a.enqueueTimeout)
+	defer timer.Stop()
+	select {
+	case a.entries <- entry:
+	case <-timer.C:
+		a.drop(entry, "audit log buffer is full, dropping entry")
+	case <-a.shutdownCh:
+		a.drop(entry, "auditor is stopped, dropping entry")
+	}
+}
+
+func (a *auditorImpl) drop(entry *types.AuditLogEntry, msg string) {
+	a.metricsScope.IncCounter(metrics.AuditLogEntriesDropped)
+	a.logger.Error(msg,
+		tag.OperationName(entry.APIName),
+		tag.WorkflowDomainName(entry.Domain),
+		tag.ActorID(entry.Actor),
+		tag.Timestamp(time.Unix(0, entry.TimestampNano)))
+}
+
+func (a *auditorImpl) writeLoop() {
+	defer a.shutdownWG.Done()
+	for {
+		select {
+		case entry := <-a.entries:
+			a.write(entry)
+		case <-a.shutdownCh:
+			for {
+				select {
+				case entry := <-a.entries:
+					a.write(entry)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (a *auditorImpl) write(entry *types.AuditLogEntry) {
+	for _, sink := range a.sinks {
+		ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+		err := sink.Write(ctx, entry)
+		cancel()
+		if err != nil {
+			a.metricsScope.IncCounter(metrics.AuditLogWriteFailures)
+			a.logger.Error("failed to write audit log entry",
+				tag.OperationName(entry.APIName),
+				tag.WorkflowDomainName(entry.Domain),
+				tag.ActorID(entry.Actor),
+				tag.Error(err))
+			continue
+		}
+		a.metricsScope.IncCounter(metrics.AuditLogEntriesWritten)
+	}
+}
+
+func (a *auditorImpl) retentionLoop() {
+	defer a.shutdownWG.Done()
+	ticker := a.timeSource.NewTicker(retentionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.Chan():
+			a.deleteExpired()
+		case <-a.shutdownCh:
+			return
+		}
+	}
+}
+
+func (a *auditorImpl) deleteExpired() {
+	before := a.timeSource.Now().Add(-a.retention).UnixNano()
+	for _, sink := range a.sinks {
+		retentionSink, ok := sink.(RetentionSink)
+		if !ok {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), retentionTimeout)
+		if err := retentionSink.DeleteBefore(ctx, before); err != nil {
+			a.logger.Error("failed to delete expired audit log entries", tag.Error(err))
+		}
+		cancel()
+	}
+}
+
+func (a *auditorImpl) List(ctx context.Context, request *types.ListAuditLogEntriesRequest) (*types.ListAuditLogEntriesResponse, error) {
+	for _, sink := range a.sinks {
+		if querier, ok := sink.(QueryableSink); ok {
+			return querier.List(ctx, request)
+		}
+	}
+	return nil, errNoQueryableSink
+}
+
+func (a *nopAuditor) Start() {}
+
+func (a *nopAuditor) Stop() {}
+
+func (a *nopAuditor) Record(ctx context.Context, attributes *authorization.Attributes, err error) {}
+
+func (a *nopAuditor) List(ctx context.Context, request *types.ListAuditLogEntriesRequest) (*types.ListAuditLogEntriesResponse, error) {
+	return nil, errNoQueryableSink
+}
+
+// shouldAudit filters out the starts of regular workflows, only batch operations are audited
+func shouldAudit(attributes *authorization.Attributes) bool {
+	if attributes.APIName == startWorkflowAPIName {
+		return attributes.WorkflowType.GetName() == batchWorkflowTypeName
+	}
+	return true
+}
+
+// actor returns the caller identity resolved by the authorizer, or the caller service name
+func actor(ctx context.Context, attributes *authorization.Attributes) string {
+	if attributes.Actor != "" {
+		return attributes.Actor
+	}
+	if call := yarpc.CallFromContext(ctx); call != nil {
+		return call.Caller()
+	}
+	return ""
+}
+
+func outcome(err error) types.AuditLogOutcome {
+	if err == nil {
+		return types.AuditLogOutcomeSuccess
+	}
+	var accessDenied *types.AccessDeniedError
+	if errors.As(err, &accessDenied) {
+		return types.AuditLogOutcomeDenied
+	}
+	return types.AuditLogOutcomeFailed
+}
+
+// matches returns whether the entry matches all the filters set in the request
+func matches(entry *types.AuditLogEntry, request *types.ListAuditLogEntriesRequest) bool {
+	if request.GetDomain() != "" && entry.Domain != request.GetDomain() {
+		return false
+	}
+	if request.GetActor() != "" && entry.Actor != request.GetActor() {
+		return false
+	}
+	if request.GetAPIName() != "" && entry.APIName != request.GetAPIName() {
+		return false
+	}
+	if request.StartTimeNano != nil && entry.TimestampNano < request.GetStartTimeNano() {
+		return false
+	}
+	if request.EndTimeNano != nil && entry.TimestampNano > request.GetEndTimeNano() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestNewAuditor(t *testing.T) {
+	auditor, err := NewAuditor(config.Audit{}, testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewRealTimeSource(), nil, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &nopAuditor{}, auditor)
+
+	_, err = NewAuditor(config.Audit{Enable: true, KafkaApplication: "audit"}, testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewRealTimeSource(), nil, nil)
+	assert.Error(t, err)
+
+	auditor, err = NewAuditor(config.Audit{Enable: true, LogFile: t.TempDir() + "/audit.log"}, testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewRealTimeSource(), nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, auditor.(*auditorImpl).sinks, 1)
+	assert.Equal(t, defaultBufferSize, cap(auditor.(*auditorImpl).entries))
+}
+
+func TestRecord(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	someErr := errors.New("some random err")
+	testCases := []struct {
+		name       string
+		attributes *authorization.Attributes
+		err        error
+		want       *types.AuditLogEntry
+	}{
+		{
+			name: "success",
+			attributes: &authorization.Attributes{
+				Actor:       "alice",
+				APIName:     "TerminateWorkflowExecution",
+				DomainName:  "test-domain",
+				RequestBody: authorization.NewFilteredRequestBody(&types.TerminateWorkflowExecutionRequest{Domain: "test-domain", Reason: "cleanup"}),
+			},
+			want: &types.AuditLogEntry{
+				TimestampNano: now.UnixNano(),
+				Actor:         "alice",
+				APIName:       "TerminateWorkflowExecution",
+				Domain:        "test-domain",
+				Request:       `{"domain":"test-domain","reason":"cleanup"}`,
+				Outcome:       types.AuditLogOutcomeSuccess,
+			},
+		},
+		{
+			name:       "denied",
+			attributes: &authorization.Attributes{Actor: "bob", APIName: "UpdateDomain", DomainName: "test-domain"},
+			err:        &types.AccessDeniedError{Message: "Request unauthorized."},
+			want: &types.AuditLogEntry{
+				TimestampNano: now.UnixNano(),
+				Actor:         "bob",
+				APIName:       "UpdateDomain",
+				Domain:        "test-domain",
+				Outcome:       types.AuditLogOutcomeDenied,
+				Error:         "Request unauthorized.",
+			},
+		},
+		{
+			name:       "failed",
+			attributes: &authorization.Attributes{APIName: "CloseShard"},
+			err:        someErr,
+			want: &types.AuditLogEntry{
+				TimestampNano: now.UnixNano(),
+				APIName:       "CloseShard",
+				Outcome:       types.AuditLogOutcomeFailed,
+				Error:         someErr.Error(),
+			},
+		},
+		{
+			name: "batch workflow start",
+			attributes: &authorization.Attributes{
+				Actor:        "alice",
+				APIName:      "StartWorkflowExecution",
+				DomainName:   "test-domain",
+				WorkflowType: &types.WorkflowType{Name: batchWorkflowTypeName},
+			},
+			want: &types.AuditLogEntry{
+				TimestampNano: now.UnixNano(),
+				Actor:         "alice",
+				APIName:       "StartWorkflowExecution",
+				Domain:        "test-domain",
+				Outcome:       types.AuditLogOutcomeSuccess,
+			},
+		},
+		{
+			name: "regular workflow start is not audited",
+			attributes: &authorization.Attributes{
+				APIName:      "StartWorkflowExecution",
+				WorkflowType: &types.WorkflowType{Name: "some-workflow"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			sink := NewMockSink(ctrl)
+			failingSink := NewMockSink(ctrl)
+			if tc.want != nil {
+				sink.EXPECT().Write(gomock.Any(), tc.want).Return(nil)
+				failingSink.EXPECT().Write(gomock.Any(), tc.want).Return(someErr)
+			}
+
+			auditor := newAuditor([]Sink{failingSink, sink}, defaultBufferSize, 0, testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewMockedTimeSourceAt(now))
+			auditor.Start()
+			auditor.Record(context.Background(), tc.attributes, tc.err)
+			auditor.Stop()
+		})
+	}
+}
+
+func TestRecord_BufferFullAppliesBackpressure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	sink := NewMockSink(ctrl)
+	sink.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	auditor := newAuditor([]Sink{sink}, 1, 0, testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewRealTimeSource())
+	attributes := &authorization.Attributes{APIName: "CloseShard"}
+	// the second entry waits for room, the write loop is not started yet
+	auditor.Record(context.Background(), attributes, nil)
+	recorded := make(chan struct{})
+	go func() {
+		auditor.Record(context.Background(), attributes, nil)
+		close(recorded)
+	}()
+	select {
+	case <-recorded:
+		t.Fatal("Record should wait while the buffer is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	auditor.Start()
+	<-recorded
+	auditor.Stop()
+}
+
+func TestRecord_BufferFullDropsAfterTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	sink := NewMockSink(ctrl)
+	sink.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	auditor := newAuditor([]Sink{sink}, 1, 0, testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewRealTimeSource())
+	auditor.enqueueTimeout = time.Millisecond
+	attributes := &authorization.Attributes{APIName: "CloseShard"}
+	// the second entry is dropped once the enqueue timeout expires
+	auditor.Record(context.Background(), attributes, nil)
+	auditor.Record(context.Background(), attributes, nil)
+	auditor.Start()
+	auditor.Stop()
+}
+
+func TestRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Unix(1700000000, 0)
+	timeSource := clock.NewMockedTimeSourceAt(now)
+	retained := make(chan int64)
+	sink := NewMockRetentionSink(ctrl)
+	sink.EXPECT().DeleteBefore(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, timestampNano int64) error {
+		retained <- timestampNano
+		return nil
+	})
+
+	auditor := newAuditor([]Sink{NewMockSink(ctrl), sink}, defaultBufferSize, 24*time.Hour, testlogger.New(t), metrics.NewNoopMetricsClient(), timeSource)
+	auditor.Start()
+	defer auditor.Stop()
+
+	timeSource.BlockUntil(1)
+	timeSource.Advance(retentionInterval)
+	assert.Equal(t, now.Add(retentionInterval-24*time.Hour).UnixNano(), <-retained)
+}
+
+func TestList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	request := &types.ListAuditLogEntriesRequest{Domain: "test-domain"}
+	response := &types.ListAuditLogEntriesResponse{Entries: []*types.AuditLogEntry{{Domain: "test-domain"}}}
+	querier := NewMockQueryableSink(ctrl)
+	querier.EXPECT().List(gomock.Any(), request).Return(response, nil)
+
+	auditor := newAuditor([]Sink{NewMockSink(ctrl), querier}, defaultBufferSize, 0, testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewRealTimeSource())
+	got, err := auditor.List(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, response, got)
+
+	auditor = newAuditor([]Sink{NewMockSink(ctrl)}, defaultBufferSize, 0, testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewRealTimeSource())
+	_, err = auditor.List(context.Background(), request)
+	assert.ErrorIs(t, err, errNoQueryableSink)
+
+	_, err = NewNopAuditor().List(context.Background(), request)
+	assert.ErrorIs(t, err, errNoQueryableSink)
+}
+
+func TestMatches(t *testing.T) {
+	entry := &types.AuditLogEntry{TimestampNano: 10, Actor: "alice", APIName: "UpdateDomain", Domain: "test-domain"}
+	start, end := int64(5), int64(15)
+	assert.True(t, matches(entry, &types.ListAuditLogEntriesRequest{}))
+	assert.True(t, matches(entry, &types.ListAuditLogEntriesRequest{Domain: "test-domain", Actor: "alice", APIName: "UpdateDomain", StartTimeNano: &start, EndTimeNano: &end}))
+	assert.False(t, matches(entry, &types.ListAuditLogEntriesRequest{Domain: "other-domain"}))
+	assert.False(t, matches(entry, &types.ListAuditLogEntriesRequest{Actor: "bob"}))
+	assert.False(t, matches(entry, &types.ListAuditLogEntriesRequest{APIName: "DeprecateDomain"}))
+	assert.False(t, matches(entry, &types.ListAuditLogEntriesRequest{StartTimeNano: &end}))
+	assert.False(t, matches(entry, &types.ListAuditLogEntriesRequest{EndTimeNano: &start}))
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination interface_mock.go -self_package github.com/uber/cadence/common/audit
+
+package audit
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Auditor records who called the domain, admin and destructive workflow APIs, and with which outcome
+	Auditor interface {
+		common.Daemon
+
+		// Record queues an entry for the call to be written to every sink. err is the error returned to the caller.
+		// Entries are dropped when the queue is full, failures to write are logged and never fail the call.
+		Record(ctx context.Context, attributes *authorization.Attributes, err error)
+		// List returns the entries matching the request from the first queryable sink
+		List(ctx context.Context, request *types.ListAuditLogEntriesRequest) (*types.ListAuditLogEntriesResponse, error)
+	}
+
+	// Sink is a destination of audit log entries
+	Sink interface {
+		Write(ctx context.Context, entry *types.AuditLogEntry) error
+	}
+
+	// QueryableSink is a Sink whose entries can be listed back
+	QueryableSink interface {
+		Sink
+		List(ctx context.Context, request *types.ListAuditLogEntriesRequest) (*types.ListAuditLogEntriesResponse, error)
+	}
+
+	// RetentionSink is a Sink whose old entries can be deleted
+	RetentionSink interface {
+		Sink
+		// DeleteBefore deletes the entries recorded before the given time
+		DeleteBefore(ctx context.Context, timestampNano int64) error
+	}
+)
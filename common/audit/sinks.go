@@ -0,0 +1,308 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	defaultPageSize = 100
+	// maxScannedEntries bounds the entries read by a single List call, the next page token
+	// is returned when it's reached even if the page is not full
+	maxScannedEntries = 10000
+	// emptyMessageID is the ID preceding the first message of a persistence queue
+	emptyMessageID = -1
+	// maxEnqueueAttempts bounds the retries of concurrent writes of the same message ID
+	maxEnqueueAttempts = 3
+)
+
+type (
+	persistenceSink struct {
+		queue persistence.QueueManager
+	}
+
+	logFileSink struct {
+		sync.Mutex
+		path string
+		file *os.File
+	}
+
+	kafkaSink struct {
+		producer messaging.Producer
+	}
+)
+
+// NewPersistenceSink creates a queryable Sink storing the entries in a persistence queue
+func NewPersistenceSink(queue persistence.QueueManager) QueryableSink {
+	return &persistenceSink{queue: queue}
+}
+
+func (s *persistenceSink) Write(ctx context.Context, entry *types.AuditLogEntry) error {
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	for attempt := 1; ; attempt++ {
+		err = s.queue.EnqueueMessage(ctx, payload)
+		var conditionFailed *persistence.ConditionFailedError
+		if err == nil || !errors.As(err, &conditionFailed) || attempt == maxEnqueueAttempts {
+			return err
+		}
+	}
+}
+
+func (s *persistenceSink) List(ctx context.Context, request *types.ListAuditLogEntriesRequest) (*types.ListAuditLogEntriesResponse, error) {
+	lastMessageID, err := deserializePageToken(request.GetNextPageToken(), emptyMessageID)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := getPageSize(request)
+
+	response := &types.ListAuditLogEntriesResponse{}
+	for scanned := 0; scanned < maxScannedEntries; {
+		messages, err := s.queue.ReadMessages(ctx, lastMessageID, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range messages {
+			scanned++
+			lastMessageID = message.ID
+			entry := &types.AuditLogEntry{}
+			if err := json.Unmarshal(message.Payload, entry); err != nil {
+				return nil, fmt.Errorf("decoding audit log entry %v: %w", message.ID, err)
+			}
+			if matches(entry, request) {
+				response.Entries = append(response.Entries, entry)
+			}
+			if len(response.Entries) == pageSize {
+				response.NextPageToken = serializePageToken(lastMessageID)
+				return response, nil
+			}
+		}
+		if len(messages) < pageSize {
+			return response, nil
+		}
+	}
+	response.NextPageToken = serializePageToken(lastMessageID)
+	return response, nil
+}
+
+// DeleteBefore deletes the entries recorded before the given time. The latest entry is always
+// kept, so the IDs of the queue keep increasing.
+func (s *persistenceSink) DeleteBefore(ctx context.Context, timestampNano int64) error {
+	lastMessageID := int64(emptyMessageID)
+	deleteBeforeID := int64(emptyMessageID)
+scan:
+	for scanned := 0; scanned < maxScannedEntries; {
+		messages, err := s.queue.ReadMessages(ctx, lastMessageID, defaultPageSize)
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			scanned++
+			lastMessageID = message.ID
+			entry := &types.AuditLogEntry{}
+			if err := json.Unmarshal(message.Payload, entry); err != nil {
+				return fmt.Errorf("decoding audit log entry %v: %w", message.ID, err)
+			}
+			deleteBeforeID = message.ID
+			if entry.TimestampNano >= timestampNano {
+				break scan
+			}
+		}
+		if len(messages) < defaultPageSize {
+			break
+		}
+	}
+	if deleteBeforeID == emptyMessageID {
+		return nil
+	}
+	return s.queue.DeleteMessagesBefore(ctx, deleteBeforeID)
+}
+
+// NewLogFileSink creates a queryable Sink appending the entries to a file as JSON lines
+func NewLogFileSink(path string) (QueryableSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log file: %w", err)
+	}
+	return &logFileSink{path: path, file: file}, nil
+}
+
+func (s *logFileSink) Write(ctx context.Context, entry *types.AuditLogEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+func (s *logFileSink) List(ctx context.Context, request *types.ListAuditLogEntriesRequest) (*types.ListAuditLogEntriesResponse, error) {
+	offset, err := deserializePageToken(request.GetNextPageToken(), 0)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := getPageSize(request)
+
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log file: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	response := &types.ListAuditLogEntriesResponse{}
+	reader := bufio.NewReader(file)
+	for scanned := 0; ; scanned++ {
+		if len(response.Entries) == pageSize || scanned == maxScannedEntries {
+			response.NextPageToken = serializePageToken(offset)
+			return response, nil
+		}
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// a partial line is still being written
+			return response, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		offset += int64(len(line))
+		entry := &types.AuditLogEntry{}
+		if err := json.Unmarshal(line, entry); err != nil {
+			return nil, fmt.Errorf("decoding audit log entry at offset %v: %w", offset-int64(len(line)), err)
+		}
+		if matches(entry, request) {
+			response.Entries = append(response.Entries, entry)
+		}
+	}
+}
+
+// DeleteBefore rewrites the log file without the entries recorded before the given time.
+// Page tokens returned before the rewrite are no longer valid.
+func (s *logFileSink) DeleteBefore(ctx context.Context, timestampNano int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	file, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("opening audit log file: %w", err)
+	}
+	defer file.Close()
+
+	var offset int64
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		entry := &types.AuditLogEntry{}
+		if err := json.Unmarshal(line, entry); err != nil {
+			return fmt.Errorf("decoding audit log entry at offset %v: %w", offset, err)
+		}
+		if entry.TimestampNano >= timestampNano {
+			break
+		}
+		offset += int64(len(line))
+	}
+	if offset == 0 {
+		return nil
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return fmt.Errorf("creating audit log file: %w", err)
+	}
+	if _, err := io.Copy(tmp, file); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("replacing audit log file: %w", err)
+	}
+
+	newFile, err := os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return fmt.Errorf("opening audit log file: %w", err)
+	}
+	s.file.Close()
+	s.file = newFile
+	return nil
+}
+
+// NewKafkaSink creates a Sink publishing the entries to kafka
+func NewKafkaSink(producer messaging.Producer) Sink {
+	return &kafkaSink{producer: producer}
+}
+
+func (s *kafkaSink) Write(ctx context.Context, entry *types.AuditLogEntry) error {
+	return s.producer.Publish(ctx, entry)
+}
+
+func getPageSize(request *types.ListAuditLogEntriesRequest) int {
+	if request.GetPageSize() <= 0 {
+		return defaultPageSize
+	}
+	return int(request.GetPageSize())
+}
+
+func serializePageToken(cursor int64) []byte {
+	token, _ := json.Marshal(cursor)
+	return token
+}
+
+func deserializePageToken(token []byte, defaultCursor int64) (int64, error) {
+	if len(token) == 0 {
+		return defaultCursor, nil
+	}
+	var cursor int64
+	if err := json.Unmarshal(token, &cursor); err != nil {
+		return 0, &types.BadRequestError{Message: "Invalid next page token."}
+	}
+	return cursor, nil
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func testEntries(n int) []*types.AuditLogEntry {
+	entries := make([]*types.AuditLogEntry, n)
+	for i := range entries {
+		entries[i] = &types.AuditLogEntry{
+			TimestampNano: int64(i),
+			Actor:         fmt.Sprintf("actor-%v", i%2),
+			APIName:       "TerminateWorkflowExecution",
+			Domain:        "test-domain",
+			Outcome:       types.AuditLogOutcomeSuccess,
+		}
+	}
+	return entries
+}
+
+func TestLogFileSink(t *testing.T) {
+	sink, err := NewLogFileSink(filepath.Join(t.TempDir(), "audit.log"))
+	require.NoError(t, err)
+
+	entries := testEntries(5)
+	for _, entry := range entries {
+		require.NoError(t, sink.Write(context.Background(), entry))
+	}
+
+	response, err := sink.List(context.Background(), &types.ListAuditLogEntriesRequest{Actor: "actor-0", PageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AuditLogEntry{entries[0], entries[2]}, response.Entries)
+	require.NotNil(t, response.NextPageToken)
+
+	response, err = sink.List(context.Background(), &types.ListAuditLogEntriesRequest{Actor: "actor-0", PageSize: 2, NextPageToken: response.NextPageToken})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AuditLogEntry{entries[4]}, response.Entries)
+	assert.Nil(t, response.NextPageToken)
+
+	_, err = sink.List(context.Background(), &types.ListAuditLogEntriesRequest{NextPageToken: []byte("invalid")})
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
+
+func TestLogFileSink_DeleteBefore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewLogFileSink(path)
+	require.NoError(t, err)
+
+	entries := testEntries(5)
+	for _, entry := range entries[:3] {
+		require.NoError(t, sink.Write(context.Background(), entry))
+	}
+	require.NoError(t, sink.(RetentionSink).DeleteBefore(context.Background(), 2))
+	// entries written after the deletion are appended to the new file
+	for _, entry := range entries[3:] {
+		require.NoError(t, sink.Write(context.Background(), entry))
+	}
+
+	response, err := sink.List(context.Background(), &types.ListAuditLogEntriesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, entries[2:], response.Entries)
+
+	require.NoError(t, sink.(RetentionSink).DeleteBefore(context.Background(), 0))
+	response, err = sink.List(context.Background(), &types.ListAuditLogEntriesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, entries[2:], response.Entries)
+}
+
+func TestPersistenceSink_Write(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	queue := persistence.NewMockQueueManager(ctrl)
+	entry := testEntries(1)[0]
+	payload, err := json.Marshal(entry)
+	require.NoError(t, err)
+
+	gomock.InOrder(
+		queue.EXPECT().EnqueueMessage(gomock.Any(), payload).Return(&persistence.ConditionFailedError{}),
+		queue.EXPECT().EnqueueMessage(gomock.Any(), payload).Return(nil),
+	)
+	assert.NoError(t, NewPersistenceSink(queue).Write(context.Background(), entry))
+
+	queue.EXPECT().EnqueueMessage(gomock.Any(), payload).Return(&persistence.ConditionFailedError{}).Times(maxEnqueueAttempts)
+	assert.Error(t, NewPersistenceSink(queue).Write(context.Background(), entry))
+}
+
+func TestPersistenceSink_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	queue := persistence.NewMockQueueManager(ctrl)
+	entries := testEntries(5)
+	messages := make([]*persistence.QueueMessage, len(entries))
+	for i, entry := range entries {
+		payload, err := json.Marshal(entry)
+		require.NoError(t, err)
+		messages[i] = &persistence.QueueMessage{ID: int64(i), Payload: payload}
+	}
+	read := func(_ context.Context, lastMessageID int64, maxCount int) (persistence.QueueMessageList, error) {
+		start := int(lastMessageID + 1)
+		end := min(start+maxCount, len(messages))
+		return messages[start:end], nil
+	}
+	queue.EXPECT().ReadMessages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(read).AnyTimes()
+	sink := NewPersistenceSink(queue)
+
+	response, err := sink.List(context.Background(), &types.ListAuditLogEntriesRequest{Actor: "actor-1", PageSize: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AuditLogEntry{entries[1]}, response.Entries)
+	require.NotNil(t, response.NextPageToken)
+
+	response, err = sink.List(context.Background(), &types.ListAuditLogEntriesRequest{Actor: "actor-1", PageSize: 1, NextPageToken: response.NextPageToken})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AuditLogEntry{entries[3]}, response.Entries)
+
+	response, err = sink.List(context.Background(), &types.ListAuditLogEntriesRequest{Actor: "actor-1", PageSize: 1, NextPageToken: response.NextPageToken})
+	require.NoError(t, err)
+	assert.Empty(t, response.Entries)
+	assert.Nil(t, response.NextPageToken)
+}
+
+func TestPersistenceSink_DeleteBefore(t *testing.T) {
+	entries := testEntries(5)
+	messages := make([]*persistence.QueueMessage, len(entries))
+	for i, entry := range entries {
+		payload, err := json.Marshal(entry)
+		require.NoError(t, err)
+		messages[i] = &persistence.QueueMessage{ID: int64(i), Payload: payload}
+	}
+
+	testCases := []struct {
+		name           string
+		timestampNano  int64
+		deleteBeforeID int64
+	}{
+		{name: "delete expired entries", timestampNano: 2, deleteBeforeID: 2},
+		{name: "keep the latest entry", timestampNano: 10, deleteBeforeID: 4},
+		{name: "nothing expired", timestampNano: 0, deleteBeforeID: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queue := persistence.NewMockQueueManager(ctrl)
+			queue.EXPECT().ReadMessages(gomock.Any(), int64(emptyMessageID), defaultPageSize).Return(messages, nil)
+			queue.EXPECT().DeleteMessagesBefore(gomock.Any(), tc.deleteBeforeID).Return(nil)
+			assert.NoError(t, NewPersistenceSink(queue).(RetentionSink).DeleteBefore(context.Background(), tc.timestampNano))
+		})
+	}
+
+	ctrl := gomock.NewController(t)
+	queue := persistence.NewMockQueueManager(ctrl)
+	queue.EXPECT().ReadMessages(gomock.Any(), int64(emptyMessageID), defaultPageSize).Return(nil, nil)
+	assert.NoError(t, NewPersistenceSink(queue).(RetentionSink).DeleteBefore(context.Background(), 10))
+}
+
+func TestKafkaSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	producer := messaging.NewMockProducer(ctrl)
+	entry := testEntries(1)[0]
+	producer.EXPECT().Publish(gomock.Any(), entry).Return(nil)
+	assert.NoError(t, NewKafkaSink(producer).Write(context.Background(), entry))
+}
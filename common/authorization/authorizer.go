@@ -69,6 +69,8 @@ type (
 	// Result is result from authority.
 	Result struct {
 		Decision Decision
+		// Actor is the identity of the authenticated caller, if known
+		Actor string
 	}
 
 	// Decision is enum type for auth decision
@@ -22,12 +22,18 @@ package authorization
 
 import (
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 )
 
-func NewAuthorizer(authorization config.Authorization, logger log.Logger, domainCache cache.DomainCache) (Authorizer, error) {
+func NewAuthorizer(authorization config.Authorization, logger log.Logger, domainCache cache.DomainCache, metricsClient metrics.Client) (Authorizer, error) {
 	switch true {
+	case authorization.PolicyAuthorizer.Enable:
+		return NewPolicyAuthorizer(authorization, logger, domainCache, metricsClient, clock.NewRealTimeSource())
+	case authorization.MTLSAuthorizer.Enable:
+		return NewMTLSAuthorizer(authorization.MTLSAuthorizer, logger, domainCache)
 	case authorization.OAuthAuthorizer.Enable:
 		return NewOAuthAuthorizer(authorization.OAuthAuthorizer, logger, domainCache)
 	default:
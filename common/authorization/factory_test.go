@@ -22,6 +22,7 @@ package authorization
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/suite"
@@ -30,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
 )
 
 type (
@@ -71,6 +73,14 @@ func cfgOAuth() config.Authorization {
 	}
 }
 
+func cfgMTLS() config.Authorization {
+	return config.Authorization{
+		MTLSAuthorizer: config.MTLSAuthorizer{
+			Enable: true,
+		},
+	}
+}
+
 func (s *factorySuite) TestFactoryNoopAuthorizer() {
 	cfgOAuthVar := cfgOAuth()
 
@@ -82,6 +92,10 @@ func (s *factorySuite) TestFactoryNoopAuthorizer() {
 		err      error
 	}{
 		{cfgNoop(), &nopAuthority{}, nil},
+		{cfgMTLS(), &mtlsAuthority{
+			config: config.MTLSAuthorizer{Enable: true, IdentitySource: config.MTLSIdentitySourceSubject},
+			log:    s.logger,
+		}, nil},
 		{cfgOAuthVar, &oauthAuthority{
 			config:    cfgOAuthVar.OAuthAuthorizer,
 			log:       s.logger,
@@ -91,8 +105,35 @@ func (s *factorySuite) TestFactoryNoopAuthorizer() {
 	}
 
 	for _, test := range tests {
-		authorizer, err := NewAuthorizer(test.cfg, s.logger, nil)
+		authorizer, err := NewAuthorizer(test.cfg, s.logger, nil, metrics.NewNoopMetricsClient())
 		s.Equal(authorizer, test.expected)
 		s.Equal(err, test.err)
 	}
 }
+
+func (s *factorySuite) TestFactoryPolicyAuthorizer() {
+	policyFile := writePolicyFile(s.T(), s.T().TempDir(), testPolicy, time.Now())
+	cfg := cfgOAuth()
+	cfg.PolicyAuthorizer = config.PolicyAuthorizer{
+		Enable:     true,
+		PolicyFile: policyFile,
+	}
+
+	authorizer, err := NewAuthorizer(cfg, s.logger, nil, metrics.NewNoopMetricsClient())
+	s.NoError(err)
+	s.IsType(&policyAuthority{}, authorizer)
+}
+
+func (s *factorySuite) TestFactoryPolicyAuthorizerWithMTLS() {
+	policyFile := writePolicyFile(s.T(), s.T().TempDir(), testPolicy, time.Now())
+	cfg := cfgMTLS()
+	cfg.PolicyAuthorizer = config.PolicyAuthorizer{
+		Enable:     true,
+		PolicyFile: policyFile,
+	}
+
+	authorizer, err := NewAuthorizer(cfg, s.logger, nil, metrics.NewNoopMetricsClient())
+	s.NoError(err)
+	s.IsType(&policyAuthority{}, authorizer)
+	s.IsType(&mtlsAuthority{}, authorizer.(*policyAuthority).principals)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	spiffeScheme = "spiffe"
+	// allDomains is the key of the mtls domain permissions applied to every domain
+	allDomains = "*"
+)
+
+type (
+	// TLSConnection is an inbound connection which may have been established with TLS
+	TLSConnection interface {
+		// TLSConnectionState returns the state of the TLS connection, or false if the connection doesn't use TLS
+		TLSConnectionState() (tls.ConnectionState, bool)
+	}
+
+	tlsConnectionContextKey struct{}
+
+	mtlsAuthority struct {
+		config      config.MTLSAuthorizer
+		domainCache cache.DomainCache
+		log         log.Logger
+	}
+)
+
+// ContextWithTLSConnection returns a context carrying the inbound connection of a request,
+// it is used by transports which don't expose the TLS state of their connections (e.g. TChannel)
+func ContextWithTLSConnection(ctx context.Context, conn TLSConnection) context.Context {
+	return context.WithValue(ctx, tlsConnectionContextKey{}, conn)
+}
+
+// NewMTLSAuthorizer creates an Authorizer identifying callers by their verified client certificate
+func NewMTLSAuthorizer(
+	mtlsConfig config.MTLSAuthorizer,
+	log log.Logger,
+	domainCache cache.DomainCache,
+) (Authorizer, error) {
+	return newMTLSAuthority(mtlsConfig, log, domainCache), nil
+}
+
+func newMTLSAuthority(
+	mtlsConfig config.MTLSAuthorizer,
+	log log.Logger,
+	domainCache cache.DomainCache,
+) *mtlsAuthority {
+	if mtlsConfig.IdentitySource == "" {
+		mtlsConfig.IdentitySource = config.MTLSIdentitySourceSubject
+	}
+	return &mtlsAuthority{
+		config:      mtlsConfig,
+		domainCache: domainCache,
+		log:         log,
+	}
+}
+
+// Authorize checks the permissions of the identity of the client certificate on the domain
+func (a *mtlsAuthority) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	p, err := a.resolvePrincipal(ctx)
+	if err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	if p.admin {
+		return Result{Decision: DecisionAllow, Actor: p.name}, nil
+	}
+
+	var data domainData
+	if attributes.DomainName != "" {
+		domain, err := a.domainCache.GetDomain(attributes.DomainName)
+		if err != nil {
+			return Result{Decision: DecisionDeny, Actor: p.name}, err
+		}
+		data = domain.GetInfo().Data
+	}
+
+	if err := a.validateIdentityPermission(p.name, attributes, data); err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return Result{Decision: DecisionDeny, Actor: p.name}, nil
+	}
+
+	return Result{Decision: DecisionAllow, Actor: p.name}, nil
+}
+
+func (a *mtlsAuthority) resolvePrincipal(ctx context.Context) (*principal, error) {
+	cert, err := peerCertificate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := a.identity(cert)
+	if err != nil {
+		return nil, err
+	}
+	return &principal{
+		name:  identity,
+		admin: slices.Contains(a.config.AdminIdentities, identity),
+	}, nil
+}
+
+// identity returns the caller identity from the certificate according to the configured identity source
+func (a *mtlsAuthority) identity(cert *x509.Certificate) (string, error) {
+	switch a.config.IdentitySource {
+	case config.MTLSIdentitySourceURI:
+		if len(cert.URIs) > 0 {
+			return cert.URIs[0].String(), nil
+		}
+	case config.MTLSIdentitySourceSPIFFE:
+		for _, uri := range cert.URIs {
+			if uri.Scheme == spiffeScheme {
+				return uri.String(), nil
+			}
+		}
+	default:
+		if cert.Subject.CommonName != "" {
+			return cert.Subject.CommonName, nil
+		}
+	}
+	return "", fmt.Errorf("client certificate has no %s identity", a.config.IdentitySource)
+}
+
+// validateIdentityPermission checks the identity against the domain permissions from the config and the domain data.
+// Like groups, write identities are allowed to read as well. APIs without a domain (e.g. cluster and admin APIs)
+// are only allowed to the admin identities, the "*" domain permissions don't apply to them.
+func (a *mtlsAuthority) validateIdentityPermission(identity string, attributes *Attributes, data domainData) error {
+	if (attributes.Permission < PermissionRead) || (attributes.Permission > PermissionAdmin) {
+		return fmt.Errorf("permission %v is not supported", attributes.Permission)
+	}
+	if attributes.DomainName == "" {
+		return fmt.Errorf("identity %q is not an admin identity, APIs without a domain require an admin identity", identity)
+	}
+
+	allowed := data.Groups(common.DomainDataKeyForWriteIdentities)
+	if attributes.Permission == PermissionRead {
+		allowed = append(allowed, data.Groups(common.DomainDataKeyForReadIdentities)...)
+	}
+	for _, domain := range []string{allDomains, attributes.DomainName} {
+		permissions, ok := a.config.DomainPermissions[domain]
+		if !ok {
+			continue
+		}
+		allowed = append(allowed, permissions.WriteIdentities...)
+		if attributes.Permission == PermissionRead {
+			allowed = append(allowed, permissions.ReadIdentities...)
+		}
+	}
+
+	if slices.Contains(allowed, identity) {
+		return nil
+	}
+	return fmt.Errorf("identity %q doesn't have the right permission, allowed identities: %v", identity, allowed)
+}
+
+// peerCertificate returns the verified client certificate of the inbound connection of the request
+func peerCertificate(ctx context.Context) (*x509.Certificate, error) {
+	state, ok := tlsConnectionState(ctx)
+	if !ok {
+		return nil, errors.New("request is not received over TLS")
+	}
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil, errors.New("client certificate is not set or not verified")
+	}
+	return state.VerifiedChains[0][0], nil
+}
+
+func tlsConnectionState(ctx context.Context) (tls.ConnectionState, bool) {
+	// gRPC exposes the connection in the request context
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			return info.State, true
+		}
+	}
+	// TChannel connections are added to the context by the rpc factory
+	if conn, ok := ctx.Value(tlsConnectionContextKey{}).(TLSConnection); ok {
+		return conn.TLSConnectionState()
+	}
+	return tls.ConnectionState{}, false
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type fakeTLSConnection struct {
+	state tls.ConnectionState
+	isTLS bool
+}
+
+func (c *fakeTLSConnection) TLSConnectionState() (tls.ConnectionState, bool) {
+	return c.state, c.isTLS
+}
+
+func testCertificate(commonName string, uris ...string) *x509.Certificate {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	for _, u := range uris {
+		parsed, _ := url.Parse(u)
+		cert.URIs = append(cert.URIs, parsed)
+	}
+	return cert
+}
+
+func verifiedState(cert *x509.Certificate) tls.ConnectionState {
+	return tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{cert},
+		VerifiedChains:   [][]*x509.Certificate{{cert}},
+	}
+}
+
+func grpcContext(state tls.ConnectionState) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+func TestMTLSAuthorizer_Identity(t *testing.T) {
+	cert := testCertificate("orders-worker", "https://example.com/orders", "spiffe://example.com/ns/orders/sa/worker")
+
+	tests := map[string]struct {
+		identitySource string
+		cert           *x509.Certificate
+		expected       string
+		wantErr        string
+	}{
+		"subject": {
+			cert:     cert,
+			expected: "orders-worker",
+		},
+		"uri": {
+			identitySource: config.MTLSIdentitySourceURI,
+			cert:           cert,
+			expected:       "https://example.com/orders",
+		},
+		"spiffe": {
+			identitySource: config.MTLSIdentitySourceSPIFFE,
+			cert:           cert,
+			expected:       "spiffe://example.com/ns/orders/sa/worker",
+		},
+		"no spiffe id": {
+			identitySource: config.MTLSIdentitySourceSPIFFE,
+			cert:           testCertificate("orders-worker", "https://example.com/orders"),
+			wantErr:        "client certificate has no spiffe identity",
+		},
+		"no subject": {
+			cert:    testCertificate(""),
+			wantErr: "client certificate has no subject identity",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			authority := newMTLSAuthority(config.MTLSAuthorizer{IdentitySource: tc.identitySource}, testlogger.New(t), nil)
+			identity, err := authority.identity(tc.cert)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, identity)
+		})
+	}
+}
+
+func TestMTLSAuthorizer_PeerCertificate(t *testing.T) {
+	cert := testCertificate("orders-worker")
+
+	tests := map[string]struct {
+		ctx     context.Context
+		wantErr string
+	}{
+		"grpc": {
+			ctx: grpcContext(verifiedState(cert)),
+		},
+		"tchannel": {
+			ctx: ContextWithTLSConnection(context.Background(), &fakeTLSConnection{state: verifiedState(cert), isTLS: true}),
+		},
+		"tchannel without tls": {
+			ctx:     ContextWithTLSConnection(context.Background(), &fakeTLSConnection{}),
+			wantErr: "request is not received over TLS",
+		},
+		"no connection": {
+			ctx:     context.Background(),
+			wantErr: "request is not received over TLS",
+		},
+		"certificate not verified": {
+			ctx:     grpcContext(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}),
+			wantErr: "client certificate is not set or not verified",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := peerCertificate(tc.ctx)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, cert, result)
+		})
+	}
+}
+
+func TestMTLSAuthorizer_Authorize(t *testing.T) {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   "orders-id",
+			Name: "orders",
+			Data: map[string]string{
+				common.DomainDataKeyForReadIdentities:  "dashboard",
+				common.DomainDataKeyForWriteIdentities: "orders-worker",
+			},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		"active",
+	)
+	cfg := config.MTLSAuthorizer{
+		Enable:          true,
+		AdminIdentities: []string{"cadence-admin"},
+		DomainPermissions: map[string]config.MTLSPermissions{
+			"*":      {ReadIdentities: []string{"auditor"}, WriteIdentities: []string{"platform-worker"}},
+			"orders": {WriteIdentities: []string{"orders-batcher"}},
+		},
+	}
+
+	tests := map[string]struct {
+		ctx        context.Context
+		domain     string
+		permission Permission
+		domainErr  error
+		expected   Decision
+		wantErr    bool
+	}{
+		"write identity from domain data": {
+			ctx:        grpcContext(verifiedState(testCertificate("orders-worker"))),
+			domain:     "orders",
+			permission: PermissionWrite,
+			expected:   DecisionAllow,
+		},
+		"write identity can read": {
+			ctx:        grpcContext(verifiedState(testCertificate("orders-worker"))),
+			domain:     "orders",
+			permission: PermissionRead,
+			expected:   DecisionAllow,
+		},
+		"read identity can't write": {
+			ctx:        grpcContext(verifiedState(testCertificate("dashboard"))),
+			domain:     "orders",
+			permission: PermissionWrite,
+			expected:   DecisionDeny,
+		},
+		"write identity from config": {
+			ctx:        grpcContext(verifiedState(testCertificate("orders-batcher"))),
+			domain:     "orders",
+			permission: PermissionWrite,
+			expected:   DecisionAllow,
+		},
+		"read identity for every domain": {
+			ctx:        ContextWithTLSConnection(context.Background(), &fakeTLSConnection{state: verifiedState(testCertificate("auditor")), isTLS: true}),
+			domain:     "orders",
+			permission: PermissionRead,
+			expected:   DecisionAllow,
+		},
+		"write identity for every domain": {
+			ctx:        grpcContext(verifiedState(testCertificate("platform-worker"))),
+			domain:     "orders",
+			permission: PermissionWrite,
+			expected:   DecisionAllow,
+		},
+		"api without domain is denied to read identity for every domain": {
+			ctx:        grpcContext(verifiedState(testCertificate("auditor"))),
+			permission: PermissionRead,
+			expected:   DecisionDeny,
+		},
+		"admin api without domain is denied to write identity for every domain": {
+			ctx:        grpcContext(verifiedState(testCertificate("platform-worker"))),
+			permission: PermissionAdmin,
+			expected:   DecisionDeny,
+		},
+		"admin api without domain": {
+			ctx:        grpcContext(verifiedState(testCertificate("cadence-admin"))),
+			permission: PermissionAdmin,
+			expected:   DecisionAllow,
+		},
+		"unknown identity": {
+			ctx:        grpcContext(verifiedState(testCertificate("someone"))),
+			domain:     "orders",
+			permission: PermissionRead,
+			expected:   DecisionDeny,
+		},
+		"admin identity": {
+			ctx:        grpcContext(verifiedState(testCertificate("cadence-admin"))),
+			domain:     "orders",
+			permission: PermissionAdmin,
+			expected:   DecisionAllow,
+		},
+		"no client certificate": {
+			ctx:        context.Background(),
+			domain:     "orders",
+			permission: PermissionRead,
+			expected:   DecisionDeny,
+		},
+		"domain cache error": {
+			ctx:        grpcContext(verifiedState(testCertificate("orders-worker"))),
+			domain:     "orders",
+			permission: PermissionRead,
+			domainErr:  errors.New("domain cache error"),
+			expected:   DecisionDeny,
+			wantErr:    true,
+		},
+		"unsupported permission": {
+			ctx:        grpcContext(verifiedState(testCertificate("orders-worker"))),
+			domain:     "orders",
+			permission: Permission(15),
+			expected:   DecisionDeny,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			domainCache := cache.NewMockDomainCache(gomock.NewController(t))
+			if tc.domainErr != nil {
+				domainCache.EXPECT().GetDomain(tc.domain).Return(nil, tc.domainErr)
+			} else {
+				domainCache.EXPECT().GetDomain(tc.domain).Return(domainEntry, nil).AnyTimes()
+			}
+			authorizer, err := NewMTLSAuthorizer(cfg, testlogger.New(t), domainCache)
+			require.NoError(t, err)
+
+			result, err := authorizer.Authorize(tc.ctx, &Attributes{APIName: "API", DomainName: tc.domain, Permission: tc.permission})
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, result.Decision)
+		})
+	}
+}
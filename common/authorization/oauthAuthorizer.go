@@ -75,6 +75,18 @@ func NewOAuthAuthorizer(
 	log log.Logger,
 	domainCache cache.DomainCache,
 ) (Authorizer, error) {
+	authority, err := newOAuthAuthority(oauthConfig, log, domainCache)
+	if err != nil {
+		return nil, err
+	}
+	return authority, nil
+}
+
+func newOAuthAuthority(
+	oauthConfig config.OAuthAuthorizer,
+	log log.Logger,
+	domainCache cache.DomainCache,
+) (*oauthAuthority, error) {
 	var jwks *keyfunc.JWKS
 	var key interface{}
 	var err error
@@ -114,54 +126,73 @@ func NewOAuthAuthorizer(
 
 // Authorize defines the logic to verify get claims from token
 func (a *oauthAuthority) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	claims, err := a.getVerifiedClaims(ctx)
+	if err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	if claims.Admin {
+		return Result{Decision: DecisionAllow, Actor: claims.Name}, nil
+	}
+
+	domain, err := a.domainCache.GetDomain(attributes.DomainName)
+	if err != nil {
+		return Result{Decision: DecisionDeny, Actor: claims.Name}, err
+	}
+
+	if err := validatePermission(claims, attributes, domain.GetInfo().Data); err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return Result{Decision: DecisionDeny, Actor: claims.Name}, nil
+	}
+
+	return Result{Decision: DecisionAllow, Actor: claims.Name}, nil
+}
+
+func (a *oauthAuthority) resolvePrincipal(ctx context.Context) (*principal, error) {
+	claims, err := a.getVerifiedClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &principal{
+		name:   claims.Name,
+		groups: claims.GetGroups(),
+		admin:  claims.Admin,
+	}, nil
+}
+
+// getVerifiedClaims returns the claims of the token passed in the request header,
+// or an error if the token is missing or can't be verified
+func (a *oauthAuthority) getVerifiedClaims(ctx context.Context) (*JWTClaims, error) {
 	call := yarpc.CallFromContext(ctx)
 
 	token := call.Header(common.AuthorizationTokenHeaderName)
 	if token == "" {
-		a.log.Debug("request is not authorized", tag.Error(errors.New("token is not set in header")))
-		return Result{Decision: DecisionDeny}, nil
+		return nil, errors.New("token is not set in header")
 	}
 
 	var claims JWTClaims
 	parsedToken, err := a.parser.ParseWithClaims(token, &claims, a.keyFunc)
 	if err != nil {
-		a.log.Debug("request is not authorized", tag.Error(err))
-		return Result{Decision: DecisionDeny}, nil
+		return nil, err
 	}
 
 	if !isTokenInternal(parsedToken) {
 		parsed, _, err := a.parser.ParseUnverified(token, jwt.MapClaims{})
 		if err != nil {
-			a.log.Debug("request is not authorized", tag.Error(err))
-			return Result{Decision: DecisionDeny}, nil
+			return nil, err
 		}
 
 		if err := a.parseExternal(parsed.Claims.(jwt.MapClaims), &claims); err != nil {
-			a.log.Debug("request is not authorized", tag.Error(err))
-			return Result{Decision: DecisionDeny}, nil
+			return nil, err
 		}
 	}
 
 	if err := a.validateTTL(&claims); err != nil {
-		a.log.Debug("request is not authorized", tag.Error(err))
-		return Result{Decision: DecisionDeny}, nil
-	}
-
-	if claims.Admin {
-		return Result{Decision: DecisionAllow}, nil
-	}
-
-	domain, err := a.domainCache.GetDomain(attributes.DomainName)
-	if err != nil {
-		return Result{Decision: DecisionDeny}, err
-	}
-
-	if err := validatePermission(&claims, attributes, domain.GetInfo().Data); err != nil {
-		a.log.Debug("request is not authorized", tag.Error(err))
-		return Result{Decision: DecisionDeny}, nil
+		return nil, err
 	}
 
-	return Result{Decision: DecisionAllow}, nil
+	return &claims, nil
 }
 
 // keyFunc returns correct key to check signature
@@ -0,0 +1,363 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	// PolicyEffectAllow allows the requests matched by a policy rule
+	PolicyEffectAllow = "allow"
+	// PolicyEffectDeny denies the requests matched by a policy rule, deny rules take precedence over allow rules
+	PolicyEffectDeny = "deny"
+
+	defaultPolicyRefreshInterval = 10 * time.Second
+)
+
+// workflowTypeAPIs are the APIs whose requests carry a workflow type
+var workflowTypeAPIs = map[string]struct{}{
+	"StartWorkflowExecution":           {},
+	"SignalWithStartWorkflowExecution": {},
+}
+
+type (
+	// Policy is a declarative list of rules evaluated by the policy authorizer.
+	// A request is allowed if it matches at least one allow rule and no deny rule.
+	Policy struct {
+		Rules []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule matches requests on the caller and the request attributes.
+	// Every field is a list of patterns (see path.Match, e.g. "*" or "orders-*"),
+	// an empty list matches everything. Actors and Groups are matched against
+	// the name and groups of the authenticated caller.
+	// WorkflowTypes and TaskLists only match requests that carry a workflow type
+	// or a task list, e.g. StartWorkflowExecution or PollForDecisionTask.
+	// Rules with WorkflowTypes must list their APIs and may only name the APIs
+	// carrying a workflow type, otherwise a deny rule would not match e.g. a signal
+	// to a workflow of a denied type.
+	PolicyRule struct {
+		Name          string   `yaml:"name"`
+		Effect        string   `yaml:"effect"`
+		Actors        []string `yaml:"actors"`
+		Groups        []string `yaml:"groups"`
+		APIs          []string `yaml:"apis"`
+		Domains       []string `yaml:"domains"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		TaskLists     []string `yaml:"taskLists"`
+	}
+
+	// principal is the authenticated caller of a request
+	principal struct {
+		name   string
+		groups []string
+		admin  bool
+	}
+
+	// principalResolver authenticates the caller of a request
+	principalResolver interface {
+		resolvePrincipal(ctx context.Context) (*principal, error)
+	}
+
+	policyAuthority struct {
+		config     config.PolicyAuthorizer
+		principals principalResolver
+		// authorizer decides the requests in shadow mode, the policy decisions are only logged and counted
+		authorizer   Authorizer
+		metricsScope metrics.Scope
+		log          log.Logger
+		timeSource   clock.TimeSource
+
+		sync.RWMutex
+		policy      *Policy
+		modTime     time.Time
+		lastChecked time.Time
+	}
+)
+
+// NewPolicyAuthorizer creates an Authorizer evaluating the policy file against every request,
+// callers are authenticated with the mtls authorizer settings if enabled, the oauth authorizer settings otherwise.
+// In shadow mode that authorizer decides the requests and the policy decisions are only logged and counted.
+func NewPolicyAuthorizer(
+	authorization config.Authorization,
+	log log.Logger,
+	domainCache cache.DomainCache,
+	metricsClient metrics.Client,
+	timeSource clock.TimeSource,
+) (Authorizer, error) {
+	var principals principalResolver
+	var authorizer Authorizer
+	if authorization.MTLSAuthorizer.Enable {
+		mtls := newMTLSAuthority(authorization.MTLSAuthorizer, log, domainCache)
+		principals, authorizer = mtls, mtls
+	} else {
+		oauth, err := newOAuthAuthority(authorization.OAuthAuthorizer, log, domainCache)
+		if err != nil {
+			return nil, err
+		}
+		principals, authorizer = oauth, oauth
+	}
+	authority, err := newPolicyAuthority(authorization.PolicyAuthorizer, principals, authorizer, metricsClient, log, timeSource)
+	if err != nil {
+		return nil, err
+	}
+	return authority, nil
+}
+
+func newPolicyAuthority(
+	policyConfig config.PolicyAuthorizer,
+	principals principalResolver,
+	authorizer Authorizer,
+	metricsClient metrics.Client,
+	log log.Logger,
+	timeSource clock.TimeSource,
+) (*policyAuthority, error) {
+	if policyConfig.RefreshInterval == 0 {
+		policyConfig.RefreshInterval = defaultPolicyRefreshInterval
+	}
+	policy, modTime, err := loadPolicy(policyConfig.PolicyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &policyAuthority{
+		config:       policyConfig,
+		principals:   principals,
+		authorizer:   authorizer,
+		metricsScope: metricsClient.Scope(metrics.AuthorizationPolicyShadowScope),
+		log:          log,
+		timeSource:   timeSource,
+		policy:       policy,
+		modTime:      modTime,
+		lastChecked:  timeSource.Now(),
+	}, nil
+}
+
+// Authorize evaluates the policy against the caller and the request attributes,
+// in shadow mode it returns the decision of the authenticating authorizer instead
+func (a *policyAuthority) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	policyResult, rule := a.evaluatePolicy(ctx, attributes)
+	if !a.config.ShadowMode {
+		return policyResult, nil
+	}
+
+	result, err := a.authorizer.Authorize(ctx, attributes)
+	a.reportShadowDecision(attributes, policyResult, rule, result, err)
+	return result, err
+}
+
+// evaluatePolicy returns the policy decision for the request and the name of the rule that decided it
+func (a *policyAuthority) evaluatePolicy(ctx context.Context, attributes *Attributes) (Result, string) {
+	a.refreshPolicy()
+
+	p, err := a.principals.resolvePrincipal(ctx)
+	if err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return Result{Decision: DecisionDeny}, ""
+	}
+	if p.admin {
+		return Result{Decision: DecisionAllow, Actor: p.name}, ""
+	}
+
+	a.RLock()
+	policy := a.policy
+	a.RUnlock()
+
+	decision, rule := policy.evaluate(p, attributes)
+	if decision == DecisionDeny {
+		a.log.Debug("request is denied by the authorization policy", policyLogTags(attributes, p.name, rule)...)
+	}
+	return Result{Decision: decision, Actor: p.name}, rule
+}
+
+// reportShadowDecision counts the policy decisions and logs the requests the policy would decide differently
+func (a *policyAuthority) reportShadowDecision(attributes *Attributes, policyResult Result, rule string, result Result, err error) {
+	scope := a.metricsScope.Tagged(metrics.DomainTag(attributes.DomainName))
+	if policyResult.Decision == DecisionAllow {
+		scope.IncCounter(metrics.AuthorizationPolicyShadowAllowed)
+	} else {
+		scope.IncCounter(metrics.AuthorizationPolicyShadowDenied)
+	}
+	if err != nil || result.Decision == policyResult.Decision {
+		return
+	}
+
+	scope.IncCounter(metrics.AuthorizationPolicyShadowMismatches)
+	if policyResult.Decision == DecisionDeny {
+		a.log.Warn("request allowed by the authorizer would be denied by the authorization policy", policyLogTags(attributes, policyResult.Actor, rule)...)
+	} else {
+		a.log.Warn("request denied by the authorizer would be allowed by the authorization policy", policyLogTags(attributes, policyResult.Actor, rule)...)
+	}
+}
+
+func policyLogTags(attributes *Attributes, actor string, rule string) []tag.Tag {
+	return []tag.Tag{
+		tag.OperationName(attributes.APIName),
+		tag.WorkflowDomainName(attributes.DomainName),
+		tag.ActorID(actor),
+		tag.Name(rule),
+	}
+}
+
+// refreshPolicy reloads the policy file if it changed since it was last loaded.
+// The previous policy is kept if the new one can't be loaded.
+func (a *policyAuthority) refreshPolicy() {
+	now := a.timeSource.Now()
+	a.RLock()
+	due := now.Sub(a.lastChecked) >= a.config.RefreshInterval
+	a.RUnlock()
+	if !due {
+		return
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	if now.Sub(a.lastChecked) < a.config.RefreshInterval {
+		return
+	}
+	a.lastChecked = now
+
+	info, err := os.Stat(a.config.PolicyFile)
+	if err != nil {
+		a.log.Error("failed to check authorization policy file", tag.Error(err))
+		return
+	}
+	if info.ModTime().Equal(a.modTime) {
+		return
+	}
+	policy, modTime, err := loadPolicy(a.config.PolicyFile)
+	if err != nil {
+		a.log.Error("failed to reload authorization policy, keeping the previous one", tag.Error(err))
+		return
+	}
+	a.policy = policy
+	a.modTime = modTime
+	a.log.Info("authorization policy reloaded", tag.Value(len(policy.Rules)))
+}
+
+func loadPolicy(policyFile string) (*Policy, time.Time, error) {
+	info, err := os.Stat(policyFile)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("reading authorization policy: %w", err)
+	}
+	content, err := os.ReadFile(policyFile)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("reading authorization policy: %w", err)
+	}
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(content, policy); err != nil {
+		return nil, time.Time{}, fmt.Errorf("parsing authorization policy: %w", err)
+	}
+	if err := policy.validate(); err != nil {
+		return nil, time.Time{}, err
+	}
+	return policy, info.ModTime(), nil
+}
+
+func (p *Policy) validate() error {
+	for i, rule := range p.Rules {
+		if rule.Effect != PolicyEffectAllow && rule.Effect != PolicyEffectDeny {
+			return fmt.Errorf("policy rule %d %q: effect must be %q or %q", i, rule.Name, PolicyEffectAllow, PolicyEffectDeny)
+		}
+		for _, patterns := range [][]string{rule.Actors, rule.Groups, rule.APIs, rule.Domains, rule.WorkflowTypes, rule.TaskLists} {
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return fmt.Errorf("policy rule %d %q: invalid pattern %q", i, rule.Name, pattern)
+				}
+			}
+		}
+		if len(rule.WorkflowTypes) == 0 {
+			continue
+		}
+		if len(rule.APIs) == 0 {
+			return fmt.Errorf("policy rule %d %q: rules with workflowTypes must list their apis", i, rule.Name)
+		}
+		for _, api := range rule.APIs {
+			if _, ok := workflowTypeAPIs[api]; !ok {
+				return fmt.Errorf("policy rule %d %q: workflowTypes can't be combined with api %q, its requests carry no workflow type", i, rule.Name, api)
+			}
+		}
+	}
+	return nil
+}
+
+// evaluate returns the decision for the request and the name of the rule that decided it.
+// Requests not matched by any allow rule are denied.
+func (p *Policy) evaluate(caller *principal, attributes *Attributes) (Decision, string) {
+	decision, decidingRule := DecisionDeny, ""
+	for _, rule := range p.Rules {
+		if !rule.matches(caller, attributes) {
+			continue
+		}
+		if rule.Effect == PolicyEffectDeny {
+			return DecisionDeny, rule.Name
+		}
+		if decision != DecisionAllow {
+			decision, decidingRule = DecisionAllow, rule.Name
+		}
+	}
+	return decision, decidingRule
+}
+
+func (r *PolicyRule) matches(caller *principal, attributes *Attributes) bool {
+	if !matchAny(r.Actors, caller.name) || !matchAny(r.Groups, caller.groups...) {
+		return false
+	}
+	if !matchAny(r.APIs, attributes.APIName) || !matchAny(r.Domains, attributes.DomainName) {
+		return false
+	}
+	if len(r.WorkflowTypes) > 0 && (attributes.WorkflowType == nil || !matchAny(r.WorkflowTypes, attributes.WorkflowType.GetName())) {
+		return false
+	}
+	if len(r.TaskLists) > 0 && (attributes.TaskList == nil || !matchAny(r.TaskLists, attributes.TaskList.GetName())) {
+		return false
+	}
+	return true
+}
+
+// matchAny returns whether any of the values matches any of the patterns, an empty pattern list matches everything
+func matchAny(patterns []string, values ...string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		for _, value := range values {
+			if matched, _ := path.Match(pattern, value); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,338 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const testPolicy = `
+rules:
+  - name: team-x-signal
+    effect: allow
+    groups: ["team-x"]
+    apis: ["SignalWorkflowExecution", "SignalWithStartWorkflowExecution", "Describe*"]
+    domains: ["orders"]
+  - name: team-x-no-payments
+    effect: deny
+    groups: ["team-x"]
+    apis: ["StartWorkflowExecution", "SignalWithStartWorkflowExecution"]
+    workflowTypes: ["payment-*"]
+  - name: workers
+    effect: allow
+    actors: ["worker-*"]
+    apis: ["PollForDecisionTask", "PollForActivityTask"]
+    taskLists: ["orders-tl"]
+`
+
+type fakePrincipalResolver struct {
+	principal *principal
+	err       error
+}
+
+func (f *fakePrincipalResolver) resolvePrincipal(ctx context.Context) (*principal, error) {
+	return f.principal, f.err
+}
+
+func writePolicyFile(t *testing.T, dir string, content string, modTime time.Time) string {
+	policyFile := filepath.Join(dir, "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFile, []byte(content), 0644))
+	require.NoError(t, os.Chtimes(policyFile, modTime, modTime))
+	return policyFile
+}
+
+func TestPolicyAuthorizer_Authorize(t *testing.T) {
+	teamX := &principal{name: "alice", groups: []string{"team-y", "team-x"}}
+	worker := &principal{name: "worker-1"}
+
+	tests := map[string]struct {
+		principal  *principal
+		resolveErr error
+		attributes *Attributes
+		expected   Decision
+	}{
+		"allowed api and domain": {
+			principal:  teamX,
+			attributes: &Attributes{APIName: "SignalWorkflowExecution", DomainName: "orders"},
+			expected:   DecisionAllow,
+		},
+		"allowed api pattern": {
+			principal:  teamX,
+			attributes: &Attributes{APIName: "DescribeWorkflowExecution", DomainName: "orders"},
+			expected:   DecisionAllow,
+		},
+		"api not allowed": {
+			principal:  teamX,
+			attributes: &Attributes{APIName: "TerminateWorkflowExecution", DomainName: "orders"},
+			expected:   DecisionDeny,
+		},
+		"domain not allowed": {
+			principal:  teamX,
+			attributes: &Attributes{APIName: "SignalWorkflowExecution", DomainName: "payments"},
+			expected:   DecisionDeny,
+		},
+		"deny rule takes precedence": {
+			principal: teamX,
+			attributes: &Attributes{
+				APIName:      "SignalWithStartWorkflowExecution",
+				DomainName:   "orders",
+				WorkflowType: &types.WorkflowType{Name: "payment-refund"},
+			},
+			expected: DecisionDeny,
+		},
+		"deny rule does not match other workflow types": {
+			principal: teamX,
+			attributes: &Attributes{
+				APIName:      "SignalWithStartWorkflowExecution",
+				DomainName:   "orders",
+				WorkflowType: &types.WorkflowType{Name: "order-fulfillment"},
+			},
+			expected: DecisionAllow,
+		},
+		"actor and task list allowed": {
+			principal: worker,
+			attributes: &Attributes{
+				APIName:    "PollForDecisionTask",
+				DomainName: "orders",
+				TaskList:   &types.TaskList{Name: "orders-tl"},
+			},
+			expected: DecisionAllow,
+		},
+		"task list not allowed": {
+			principal: worker,
+			attributes: &Attributes{
+				APIName:    "PollForDecisionTask",
+				DomainName: "orders",
+				TaskList:   &types.TaskList{Name: "payments-tl"},
+			},
+			expected: DecisionDeny,
+		},
+		"rule on task list does not match requests without task list": {
+			principal:  worker,
+			attributes: &Attributes{APIName: "PollForDecisionTask", DomainName: "orders"},
+			expected:   DecisionDeny,
+		},
+		"admin bypasses the policy": {
+			principal:  &principal{name: "root", admin: true},
+			attributes: &Attributes{APIName: "TerminateWorkflowExecution", DomainName: "payments"},
+			expected:   DecisionAllow,
+		},
+		"unauthenticated caller": {
+			resolveErr: errors.New("token is not set in header"),
+			attributes: &Attributes{APIName: "SignalWorkflowExecution", DomainName: "orders"},
+			expected:   DecisionDeny,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			policyFile := writePolicyFile(t, t.TempDir(), testPolicy, time.Now())
+			authorizer, err := newPolicyAuthority(
+				config.PolicyAuthorizer{Enable: true, PolicyFile: policyFile},
+				&fakePrincipalResolver{principal: tc.principal, err: tc.resolveErr},
+				nil,
+				metrics.NewNoopMetricsClient(),
+				testlogger.New(t),
+				clock.NewMockedTimeSource(),
+			)
+			require.NoError(t, err)
+
+			result, err := authorizer.Authorize(context.Background(), tc.attributes)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result.Decision)
+		})
+	}
+}
+
+func TestPolicyAuthorizer_ShadowMode(t *testing.T) {
+	teamX := &principal{name: "alice", groups: []string{"team-x"}}
+	authorizerErr := errors.New("authorizer failed")
+
+	tests := map[string]struct {
+		principal          *principal
+		resolveErr         error
+		attributes         *Attributes
+		authorizerResult   Result
+		authorizerErr      error
+		expectedAllowed    int64
+		expectedDenied     int64
+		expectedMismatches int64
+	}{
+		"authorizer allows request denied by the policy": {
+			principal:          teamX,
+			attributes:         &Attributes{APIName: "TerminateWorkflowExecution", DomainName: "orders"},
+			authorizerResult:   Result{Decision: DecisionAllow, Actor: "alice"},
+			expectedDenied:     1,
+			expectedMismatches: 1,
+		},
+		"authorizer denies request allowed by the policy": {
+			principal:          teamX,
+			attributes:         &Attributes{APIName: "SignalWorkflowExecution", DomainName: "orders"},
+			authorizerResult:   Result{Decision: DecisionDeny, Actor: "alice"},
+			expectedAllowed:    1,
+			expectedMismatches: 1,
+		},
+		"authorizer and policy agree": {
+			principal:        teamX,
+			attributes:       &Attributes{APIName: "SignalWorkflowExecution", DomainName: "orders"},
+			authorizerResult: Result{Decision: DecisionAllow, Actor: "alice"},
+			expectedAllowed:  1,
+		},
+		"unauthenticated caller": {
+			resolveErr:       errors.New("token is not set in header"),
+			attributes:       &Attributes{APIName: "SignalWorkflowExecution", DomainName: "orders"},
+			authorizerResult: Result{Decision: DecisionDeny},
+			expectedDenied:   1,
+		},
+		"authorizer error": {
+			principal:      teamX,
+			attributes:     &Attributes{APIName: "TerminateWorkflowExecution", DomainName: "orders"},
+			authorizerErr:  authorizerErr,
+			expectedDenied: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			shadowed := NewMockAuthorizer(ctrl)
+			shadowed.EXPECT().Authorize(gomock.Any(), tc.attributes).Return(tc.authorizerResult, tc.authorizerErr)
+			metricScope := tally.NewTestScope("", nil)
+
+			policyFile := writePolicyFile(t, t.TempDir(), testPolicy, time.Now())
+			authorizer, err := newPolicyAuthority(
+				config.PolicyAuthorizer{Enable: true, PolicyFile: policyFile, ShadowMode: true},
+				&fakePrincipalResolver{principal: tc.principal, err: tc.resolveErr},
+				shadowed,
+				metrics.NewClient(metricScope, metrics.Frontend),
+				testlogger.New(t),
+				clock.NewMockedTimeSource(),
+			)
+			require.NoError(t, err)
+
+			result, err := authorizer.Authorize(context.Background(), tc.attributes)
+			assert.Equal(t, tc.authorizerErr, err)
+			assert.Equal(t, tc.authorizerResult, result)
+
+			counters := map[string]int64{}
+			for _, counter := range metricScope.Snapshot().Counters() {
+				counters[counter.Name()] += counter.Value()
+			}
+			assert.Equal(t, tc.expectedAllowed, counters["authorization_policy_shadow_allowed"])
+			assert.Equal(t, tc.expectedDenied, counters["authorization_policy_shadow_denied"])
+			assert.Equal(t, tc.expectedMismatches, counters["authorization_policy_shadow_mismatches"])
+		})
+	}
+}
+
+func TestPolicyAuthorizer_Reload(t *testing.T) {
+	dir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour)
+	policyFile := writePolicyFile(t, dir, testPolicy, modTime)
+	timeSource := clock.NewMockedTimeSource()
+	authorizer, err := newPolicyAuthority(
+		config.PolicyAuthorizer{Enable: true, PolicyFile: policyFile, RefreshInterval: time.Minute},
+		&fakePrincipalResolver{principal: &principal{name: "alice", groups: []string{"team-x"}}},
+		nil,
+		metrics.NewNoopMetricsClient(),
+		testlogger.New(t),
+		timeSource,
+	)
+	require.NoError(t, err)
+
+	attributes := &Attributes{APIName: "TerminateWorkflowExecution", DomainName: "orders"}
+	authorize := func() Decision {
+		result, err := authorizer.Authorize(context.Background(), attributes)
+		require.NoError(t, err)
+		return result.Decision
+	}
+	assert.Equal(t, DecisionDeny, authorize())
+
+	allowAll := "rules:\n  - name: allow-all\n    effect: allow\n"
+	writePolicyFile(t, dir, allowAll, modTime.Add(time.Minute))
+	assert.Equal(t, DecisionDeny, authorize(), "policy is not reloaded before the refresh interval")
+
+	timeSource.Advance(time.Minute)
+	assert.Equal(t, DecisionAllow, authorize())
+
+	writePolicyFile(t, dir, "rules:\n  - effect: maybe\n", modTime.Add(2*time.Minute))
+	timeSource.Advance(time.Minute)
+	assert.Equal(t, DecisionAllow, authorize(), "invalid policy is ignored")
+}
+
+func TestLoadPolicy_Errors(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"invalid effect": {
+			content: "rules:\n  - name: r\n    effect: maybe\n",
+			wantErr: `policy rule 0 "r": effect must be "allow" or "deny"`,
+		},
+		"invalid pattern": {
+			content: "rules:\n  - name: r\n    effect: allow\n    apis: [\"[\"]\n",
+			wantErr: `policy rule 0 "r": invalid pattern "["`,
+		},
+		"workflow types without apis": {
+			content: "rules:\n  - name: r\n    effect: deny\n    workflowTypes: [\"payment-*\"]\n",
+			wantErr: `policy rule 0 "r": rules with workflowTypes must list their apis`,
+		},
+		"workflow types with api without workflow type": {
+			content: "rules:\n  - name: r\n    effect: deny\n    apis: [\"StartWorkflowExecution\", \"SignalWorkflowExecution\"]\n    workflowTypes: [\"payment-*\"]\n",
+			wantErr: `policy rule 0 "r": workflowTypes can't be combined with api "SignalWorkflowExecution"`,
+		},
+		"workflow types with api pattern": {
+			content: "rules:\n  - name: r\n    effect: deny\n    apis: [\"*\"]\n    workflowTypes: [\"payment-*\"]\n",
+			wantErr: `workflowTypes can't be combined with api "*"`,
+		},
+		"unknown field": {
+			content: "rules:\n  - name: r\n    effect: allow\n    api: [\"*\"]\n",
+			wantErr: "parsing authorization policy",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			policyFile := writePolicyFile(t, t.TempDir(), tc.content, time.Now())
+			_, _, err := loadPolicy(policyFile)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+
+	_, _, err := loadPolicy(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "reading authorization policy")
+}
@@ -475,10 +475,8 @@ func (c *DefaultDomainCache) refreshDomainsLocked() error {
 	var updatedEntries []*DomainCacheEntry
 
 	// make a copy of the existing domain cache, so we can calculate diff and do compare and swap
-	newCacheNameToID := newDomainCache()
 	newCacheByID := newDomainCache()
 	for _, domain := range c.GetAllDomain() {
-		newCacheNameToID.Put(domain.info.Name, domain.info.ID)
 		newCacheByID.Put(domain.info.ID, domain)
 	}
 
@@ -505,13 +503,31 @@ UpdateLoop:
 			metrics.ActiveClusterTag(nextEntry.replicationConfig.ActiveClusterName),
 		).UpdateGauge(metrics.ActiveClusterGauge, 1)
 
-		c.updateNameToIDCache(newCacheNameToID, nextEntry.info.Name, nextEntry.info.ID)
-
 		if triggerCallback {
 			updatedEntries = append(updatedEntries, nextEntry)
 		}
 	}
 
+	// evict the domains which no longer exist, i.e. hard deleted after being purged
+	listedDomainIDs := make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		listedDomainIDs[domain.info.ID] = struct{}{}
+	}
+	for _, domain := range c.GetAllDomain() {
+		if _, ok := listedDomainIDs[domain.info.ID]; ok {
+			continue
+		}
+		newCacheByID.Delete(domain.info.ID)
+		c.logger.Info("Domain is evicted from domain cache as it no longer exists",
+			tag.WorkflowDomainName(domain.info.Name),
+			tag.WorkflowDomainID(domain.info.ID),
+		)
+	}
+
+	// names are rebuilt from the domains, so that previous names of renamed domains stop resolving once they expire
+	newCacheNameToID := newDomainCache()
+	c.updateNameToIDCache(newCacheNameToID, newCacheByID, now)
+
 	// NOTE: READ REF BEFORE MODIFICATION
 	// ref: historyEngine.go registerDomainFailoverCallback function
 	c.callbackLock.Lock()
@@ -544,11 +560,31 @@ func (c *DefaultDomainCache) checkDomainExists(
 
 func (c *DefaultDomainCache) updateNameToIDCache(
 	cacheNameToID Cache,
-	name string,
-	id string,
+	cacheByID Cache,
+	now time.Time,
 ) {
 
-	cacheNameToID.Put(name, id)
+	var infos []*persistence.DomainInfo
+	ite := cacheByID.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next().Value().(*DomainCacheEntry)
+		entry.mu.RLock()
+		if entry.info != nil {
+			infos = append(infos, entry.info)
+		}
+		entry.mu.RUnlock()
+	}
+
+	// the current name of a domain takes precedence over the previous name of a renamed domain
+	for _, info := range infos {
+		if previousName := getActivePreviousName(info, now); previousName != "" {
+			cacheNameToID.Put(previousName, info.ID)
+		}
+	}
+	for _, info := range infos {
+		cacheNameToID.Put(info.Name, info.ID)
+	}
 }
 
 func (c *DefaultDomainCache) updateIDToDomainCache(
@@ -590,7 +626,11 @@ func (c *DefaultDomainCache) getDomain(
 
 	id, cacheHit := c.cacheNameToID.Load().(Cache).Get(name).(string)
 	if cacheHit {
-		return c.getDomainByID(id, true)
+		entry, err := c.getDomainByID(id, true)
+		if err != nil || entry.info.Name == name || getActivePreviousName(entry.info, c.timeSource.Now()) == name {
+			return entry, err
+		}
+		// the previous name of a renamed domain expired, the name may be taken by another domain now
 	}
 
 	if err := c.checkDomainExists(name, ""); err != nil {
@@ -614,6 +654,22 @@ func (c *DefaultDomainCache) getDomain(
 	return nil, &types.InternalServiceError{Message: "DefaultDomainCache encounter case where domain exists but cannot be loaded"}
 }
 
+// getActivePreviousName returns the previous name of a renamed domain if it still resolves to the domain
+func getActivePreviousName(info *persistence.DomainInfo, now time.Time) string {
+	if info == nil {
+		return ""
+	}
+	previousName := info.Data[common.DomainDataKeyForPreviousName]
+	if previousName == "" || previousName == info.Name {
+		return ""
+	}
+	expiry, err := time.Parse(time.RFC3339, info.Data[common.DomainDataKeyForPreviousNameExpiry])
+	if err != nil || !now.Before(expiry) {
+		return ""
+	}
+	return previousName
+}
+
 // getDomainByID retrieves the information from the cache if it exists, otherwise retrieves the information from metadata
 // store and writes it to the cache with an expiry before returning back
 func (c *DefaultDomainCache) getDomainByID(
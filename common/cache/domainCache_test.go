@@ -929,6 +929,90 @@ func (s *domainCacheSuite) Test_refreshDomainsLocked_ListDomainsError() {
 	s.ErrorIs(err, assert.AnError)
 }
 
+func (s *domainCacheSuite) Test_refreshDomainsLocked_EvictDeletedDomain() {
+	newRecord := func(name string, notificationVersion int64) *persistence.GetDomainResponse {
+		return &persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: uuid.New(), Name: name, Data: make(map[string]string)},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters:          []*persistence.ClusterReplicationConfig{{ClusterName: cluster.TestCurrentClusterName}},
+			},
+			NotificationVersion: notificationVersion,
+		}
+	}
+	domainRecord1 := newRecord("some random domain name", 0)
+	domainRecord2 := newRecord("another random domain name", 1)
+
+	s.metadataMgr.On("GetMetadata", mock.Anything).Return(&persistence.GetMetadataResponse{NotificationVersion: 3}, nil).Twice()
+	s.metadataMgr.On("ListDomains", mock.Anything, mock.Anything).Return(&persistence.ListDomainsResponse{
+		Domains: []*persistence.GetDomainResponse{domainRecord1, domainRecord2},
+	}, nil).Once()
+	s.metadataMgr.On("ListDomains", mock.Anything, mock.Anything).Return(&persistence.ListDomainsResponse{
+		Domains: []*persistence.GetDomainResponse{domainRecord1},
+	}, nil).Once()
+
+	s.NoError(s.domainCache.refreshDomainsLocked())
+	s.Len(s.domainCache.GetAllDomain(), 2)
+
+	s.domainCache.lastRefreshTime = time.Time{}
+	s.NoError(s.domainCache.refreshDomainsLocked())
+	allDomains := s.domainCache.GetAllDomain()
+	s.Len(allDomains, 1)
+	s.Contains(allDomains, domainRecord1.Info.ID)
+	s.Nil(s.domainCache.cacheNameToID.Load().(Cache).Get(domainRecord2.Info.Name))
+}
+
+func (s *domainCacheSuite) Test_refreshDomainsLocked_RenamedDomain() {
+	timeSource := clock.NewMockedTimeSource()
+	s.domainCache.timeSource = timeSource
+	newRecord := func(name string, data map[string]string, notificationVersion int64) *persistence.GetDomainResponse {
+		return &persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: "domain-id", Name: name, Data: data},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters:          []*persistence.ClusterReplicationConfig{{ClusterName: cluster.TestCurrentClusterName}},
+			},
+			NotificationVersion: notificationVersion,
+		}
+	}
+	domainRecord := newRecord("old-name", map[string]string{}, 0)
+	renamedRecord := newRecord("new-name", map[string]string{
+		common.DomainDataKeyForPreviousName:       "old-name",
+		common.DomainDataKeyForPreviousNameExpiry: timeSource.Now().Add(time.Hour).Format(time.RFC3339),
+	}, 1)
+
+	s.metadataMgr.On("GetMetadata", mock.Anything).Return(&persistence.GetMetadataResponse{NotificationVersion: 3}, nil).Twice()
+	s.metadataMgr.On("ListDomains", mock.Anything, mock.Anything).Return(&persistence.ListDomainsResponse{
+		Domains: []*persistence.GetDomainResponse{domainRecord},
+	}, nil).Once()
+	s.metadataMgr.On("ListDomains", mock.Anything, mock.Anything).Return(&persistence.ListDomainsResponse{
+		Domains: []*persistence.GetDomainResponse{renamedRecord},
+	}, nil).Once()
+
+	s.NoError(s.domainCache.refreshDomainsLocked())
+	timeSource.Advance(domainCacheMinRefreshInterval)
+	s.NoError(s.domainCache.refreshDomainsLocked())
+
+	// both names resolve to the domain during the alias period
+	for _, name := range []string{"old-name", "new-name"} {
+		entry, err := s.domainCache.GetDomain(name)
+		s.NoError(err)
+		s.Equal("new-name", entry.GetInfo().Name)
+	}
+	domainName, err := s.domainCache.GetDomainName("domain-id")
+	s.NoError(err)
+	s.Equal("new-name", domainName)
+
+	// the previous name stops resolving once the alias expires
+	timeSource.Advance(time.Hour)
+	s.metadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{Name: "old-name"}).
+		Return(nil, &types.EntityNotExistsError{}).Once()
+	_, err = s.domainCache.GetDomain("old-name")
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
 func (s *domainCacheSuite) TestDomainCacheEntry_Getters() {
 	gen := testdatagen.New(s.T())
 
@@ -0,0 +1,57 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+type (
+	// Audit is the config for recording the callers of domain, admin and destructive workflow APIs.
+	// Entries are written to every configured sink.
+	Audit struct {
+		Enable bool `yaml:"enable"`
+		// LogFile is the path of the file the entries are appended to as JSON lines
+		LogFile string `yaml:"logFile"`
+		// KafkaApplication is the kafka application (see kafka.applications) whose topic the entries are published to
+		KafkaApplication string `yaml:"kafkaApplication"`
+		// Persistence stores the entries in the queue table of the default store
+		Persistence bool `yaml:"persistence"`
+		// BufferSize bounds the entries waiting to be written. While the buffer is full the audited
+		// requests wait for room, and entries are only dropped after a few seconds. Default is 1000.
+		BufferSize int `yaml:"bufferSize"`
+		// Retention is how long the entries are kept in the log file and persistence sinks,
+		// zero keeps them forever. Kafka entries are retained by the topic config.
+		Retention time.Duration `yaml:"retention"`
+	}
+)
+
+// Validate validates the audit config
+func (a *Audit) Validate() error {
+	if a.Enable && a.LogFile == "" && a.KafkaApplication == "" && !a.Persistence {
+		return fmt.Errorf("[AuditConfig] at least one sink must be configured")
+	}
+	if a.BufferSize < 0 || a.Retention < 0 {
+		return fmt.Errorf("[AuditConfig] bufferSize and retention must not be negative")
+	}
+	return nil
+}
@@ -23,14 +23,26 @@ package config
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	// MTLSIdentitySourceSubject identifies callers by the common name of the certificate subject
+	MTLSIdentitySourceSubject = "subject"
+	// MTLSIdentitySourceURI identifies callers by the first URI SAN of the certificate
+	MTLSIdentitySourceURI = "uri"
+	// MTLSIdentitySourceSPIFFE identifies callers by the SPIFFE ID (spiffe:// URI SAN) of the certificate
+	MTLSIdentitySourceSPIFFE = "spiffe"
+)
+
 type (
 	Authorization struct {
-		OAuthAuthorizer OAuthAuthorizer `yaml:"oauthAuthorizer"`
-		NoopAuthorizer  NoopAuthorizer  `yaml:"noopAuthorizer"`
+		OAuthAuthorizer  OAuthAuthorizer  `yaml:"oauthAuthorizer"`
+		NoopAuthorizer   NoopAuthorizer   `yaml:"noopAuthorizer"`
+		PolicyAuthorizer PolicyAuthorizer `yaml:"policyAuthorizer"`
+		MTLSAuthorizer   MTLSAuthorizer   `yaml:"mtlsAuthorizer"`
 	}
 
 	NoopAuthorizer struct {
@@ -54,6 +66,40 @@ type (
 		PublicKey string `yaml:"publicKey"`
 	}
 
+	// PolicyAuthorizer evaluates a declarative policy file against every request.
+	// Callers are authenticated with the mtlsAuthorizer or oauthAuthorizer settings, one of which must be enabled as well.
+	PolicyAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// Path of the YAML policy file
+		PolicyFile string `yaml:"policyFile"`
+		// How often the policy file is checked for changes, defaults to 10s
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// In shadow mode requests are decided by the mtlsAuthorizer or oauthAuthorizer,
+		// the policy decisions are only counted and logged when they differ
+		ShadowMode bool `yaml:"shadowMode"`
+	}
+
+	// MTLSAuthorizer authenticates callers with the verified client certificate of the inbound TLS connection.
+	// It requires TLS with client certificate verification on the service inbounds.
+	MTLSAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// Part of the client certificate identifying the caller: "subject" (common name, default),
+		// "uri" (first URI SAN) or "spiffe" (SPIFFE ID URI SAN)
+		IdentitySource string `yaml:"identitySource"`
+		// Identities allowed to call any API
+		AdminIdentities []string `yaml:"adminIdentities"`
+		// Permissions of identities keyed by domain name, "*" applies to every domain.
+		// They are granted in addition to the READ_IDENTITIES and WRITE_IDENTITIES domain data.
+		// APIs without a domain are only allowed to the AdminIdentities.
+		DomainPermissions map[string]MTLSPermissions `yaml:"domainPermissions"`
+	}
+
+	// MTLSPermissions lists the identities allowed to read or write a domain
+	MTLSPermissions struct {
+		ReadIdentities  []string `yaml:"readIdentities"`
+		WriteIdentities []string `yaml:"writeIdentities"`
+	}
+
 	// OAuthProvider is used to validate tokens provided by 3rd party Identity Provider service
 	OAuthProvider struct {
 		JWKSURL             string `yaml:"jwksURL"`
@@ -68,12 +114,58 @@ func (a *Authorization) Validate() error {
 		return fmt.Errorf("[AuthorizationConfig] More than one authorizer is enabled")
 	}
 
+	if a.PolicyAuthorizer.Enable && a.NoopAuthorizer.Enable {
+		return fmt.Errorf("[AuthorizationConfig] More than one authorizer is enabled")
+	}
+
+	if a.MTLSAuthorizer.Enable && (a.OAuthAuthorizer.Enable || a.NoopAuthorizer.Enable) {
+		return fmt.Errorf("[AuthorizationConfig] More than one authorizer is enabled")
+	}
+
 	if a.OAuthAuthorizer.Enable {
 		if err := a.validateOAuth(); err != nil {
 			return err
 		}
 	}
 
+	if a.PolicyAuthorizer.Enable {
+		if err := a.validatePolicy(); err != nil {
+			return err
+		}
+	}
+
+	if a.MTLSAuthorizer.Enable {
+		if err := a.validateMTLS(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *Authorization) validatePolicy() error {
+	if !a.OAuthAuthorizer.Enable && !a.MTLSAuthorizer.Enable {
+		return fmt.Errorf("[PolicyConfig] oauthAuthorizer or mtlsAuthorizer must be enabled to authenticate callers")
+	}
+
+	if a.PolicyAuthorizer.PolicyFile == "" {
+		return fmt.Errorf("[PolicyConfig] PolicyFile can't be empty")
+	}
+
+	if a.PolicyAuthorizer.RefreshInterval < 0 {
+		return fmt.Errorf("[PolicyConfig] RefreshInterval can't be negative")
+	}
+
+	return nil
+}
+
+func (a *Authorization) validateMTLS() error {
+	switch a.MTLSAuthorizer.IdentitySource {
+	case "", MTLSIdentitySourceSubject, MTLSIdentitySourceURI, MTLSIdentitySourceSPIFFE:
+	default:
+		return fmt.Errorf("[MTLSConfig] IdentitySource %q is not supported", a.MTLSAuthorizer.IdentitySource)
+	}
+
 	return nil
 }
 
@@ -112,3 +112,112 @@ func TestCorrectValidation(t *testing.T) {
 	err := cfg.Validate()
 	assert.NoError(t, err)
 }
+
+func TestPolicyValidation(t *testing.T) {
+	oauth := OAuthAuthorizer{
+		Enable: true,
+		JwtCredentials: &JwtCredentials{
+			Algorithm: "RS256",
+			PublicKey: "public",
+		},
+		MaxJwtTTL: 1000000,
+	}
+
+	tests := map[string]struct {
+		cfg     Authorization
+		wantErr string
+	}{
+		"valid": {
+			cfg: Authorization{
+				OAuthAuthorizer:  oauth,
+				PolicyAuthorizer: PolicyAuthorizer{Enable: true, PolicyFile: "policy.yaml"},
+			},
+		},
+		"noop authorizer enabled too": {
+			cfg: Authorization{
+				NoopAuthorizer:   NoopAuthorizer{Enable: true},
+				PolicyAuthorizer: PolicyAuthorizer{Enable: true, PolicyFile: "policy.yaml"},
+			},
+			wantErr: "[AuthorizationConfig] More than one authorizer is enabled",
+		},
+		"valid with mtls": {
+			cfg: Authorization{
+				MTLSAuthorizer:   MTLSAuthorizer{Enable: true},
+				PolicyAuthorizer: PolicyAuthorizer{Enable: true, PolicyFile: "policy.yaml"},
+			},
+		},
+		"oauth authorizer not enabled": {
+			cfg: Authorization{
+				PolicyAuthorizer: PolicyAuthorizer{Enable: true, PolicyFile: "policy.yaml"},
+			},
+			wantErr: "[PolicyConfig] oauthAuthorizer or mtlsAuthorizer must be enabled to authenticate callers",
+		},
+		"policy file not set": {
+			cfg: Authorization{
+				OAuthAuthorizer:  oauth,
+				PolicyAuthorizer: PolicyAuthorizer{Enable: true},
+			},
+			wantErr: "[PolicyConfig] PolicyFile can't be empty",
+		},
+		"negative refresh interval": {
+			cfg: Authorization{
+				OAuthAuthorizer:  oauth,
+				PolicyAuthorizer: PolicyAuthorizer{Enable: true, PolicyFile: "policy.yaml", RefreshInterval: -1},
+			},
+			wantErr: "[PolicyConfig] RefreshInterval can't be negative",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMTLSValidation(t *testing.T) {
+	tests := map[string]struct {
+		cfg     Authorization
+		wantErr string
+	}{
+		"valid": {
+			cfg: Authorization{
+				MTLSAuthorizer: MTLSAuthorizer{Enable: true, IdentitySource: MTLSIdentitySourceSPIFFE},
+			},
+		},
+		"default identity source": {
+			cfg: Authorization{
+				MTLSAuthorizer: MTLSAuthorizer{Enable: true},
+			},
+		},
+		"oauth authorizer enabled too": {
+			cfg: Authorization{
+				OAuthAuthorizer: OAuthAuthorizer{Enable: true},
+				MTLSAuthorizer:  MTLSAuthorizer{Enable: true},
+			},
+			wantErr: "[AuthorizationConfig] More than one authorizer is enabled",
+		},
+		"unsupported identity source": {
+			cfg: Authorization{
+				MTLSAuthorizer: MTLSAuthorizer{Enable: true, IdentitySource: "email"},
+			},
+			wantErr: `[MTLSConfig] IdentitySource "email" is not supported`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -77,6 +77,8 @@ type (
 		Blobstore Blobstore `yaml:"blobstore"`
 		// Authorization is the config for setting up authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Audit is the config for setting up the audit log
+		Audit Audit `yaml:"audit"`
 		// HeaderForwardingRules defines which inbound headers to include or exclude on outbound calls
 		HeaderForwardingRules []HeaderRule `yaml:"headerForwardingRules"`
 		// Note: This is not implemented yet. It's coming in the next release.
@@ -684,6 +686,10 @@ func (c *Config) validate() error {
 		return err
 	}
 
+	if err := c.Audit.Validate(); err != nil {
+		return err
+	}
+
 	return c.Authorization.Validate()
 }
 
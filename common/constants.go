@@ -176,12 +176,52 @@ const (
 	DomainDataKeyForManagedFailover = "IsManagedByCadence"
 	// DomainDataKeyForPreferredCluster is the key of DomainData for domain rebalance
 	DomainDataKeyForPreferredCluster = "PreferredCluster"
+	// DomainDataKeyForAutoFailoverCluster is the key of DomainData for the standby cluster the domain
+	// is automatically failed over to when its active cluster becomes unhealthy
+	DomainDataKeyForAutoFailoverCluster = "AutoFailoverCluster"
 	// DomainDataKeyForFailoverHistory is the key of DomainData for failover history
 	DomainDataKeyForFailoverHistory = "FailoverHistory"
 	// DomainDataKeyForReadGroups stores which groups have read permission of the domain API
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
+	// DomainDataKeyForReadIdentities stores which mTLS identities have read permission of the domain API
+	DomainDataKeyForReadIdentities = "READ_IDENTITIES"
+	// DomainDataKeyForWriteIdentities stores which mTLS identities have write permission of the domain API
+	DomainDataKeyForWriteIdentities = "WRITE_IDENTITIES"
+	// DomainDataKeyForOpenWorkflowsCountLimit is the key of DomainData for the limit of open workflows in the domain,
+	// it requires advanced visibility
+	DomainDataKeyForOpenWorkflowsCountLimit = "OpenWorkflowsCountLimit"
+	// DomainDataKeyForPendingActivitiesCountLimit is the key of DomainData for the limit of pending activities per workflow
+	DomainDataKeyForPendingActivitiesCountLimit = "PendingActivitiesCountLimit"
+	// DomainDataKeyForPendingChildWorkflowsCountLimit is the key of DomainData for the limit of pending child workflows per workflow
+	DomainDataKeyForPendingChildWorkflowsCountLimit = "PendingChildWorkflowsCountLimit"
+	// DomainDataKeyForPreviousName is the key of DomainData for the previous name of a renamed domain,
+	// the previous name keeps resolving to the domain until DomainDataKeyForPreviousNameExpiry
+	DomainDataKeyForPreviousName = "PreviousName"
+	// DomainDataKeyForPreviousNameExpiry is the key of DomainData for the time, in RFC3339 format,
+	// until which the previous name of a renamed domain resolves to the domain
+	DomainDataKeyForPreviousNameExpiry = "PreviousNameExpiry"
+	// DomainDataKeyForMigrationTarget is the key of DomainData for the domain the workflows of the domain are migrated to,
+	// signals and queries of migrated workflows are redirected to that domain until DomainDataKeyForMigrationRedirectExpiry
+	DomainDataKeyForMigrationTarget = "MigrationTargetDomain"
+	// DomainDataKeyForMigrationRedirectExpiry is the key of DomainData for the time, in RFC3339 format, until which
+	// signals and queries of migrated workflows are redirected after the migration completes, empty while it is running
+	DomainDataKeyForMigrationRedirectExpiry = "MigrationRedirectExpiry"
+	// DomainDataKeyForReplicationFilters is the key of DomainData for the JSON encoded filters excluding
+	// workflows of a global domain from cross cluster replication
+	DomainDataKeyForReplicationFilters = "ReplicationFilters"
+)
+
+const (
+	// TaskPriorityHeaderKey is the key of the workflow or activity header which carries the task priority,
+	// the published start and schedule requests have no priority field. History reads it when pushing the
+	// task and hands it to matching with the task. Tasks with a larger priority are dispatched first,
+	// the default priority is 0
+	TaskPriorityHeaderKey = "cadence-task-priority"
+	// TaskFairnessKeyHeaderKey is the key of the workflow or activity header which carries the fairness key.
+	// Tasks with the same priority are dispatched round-robin across fairness keys
+	TaskFairnessKeyHeaderKey = "cadence-task-fairness-key"
 )
 
 type (
@@ -237,9 +277,20 @@ const StickyTaskConditionFailedErrorMsg = "StickyTaskConditionFailedError"
 // MemoKeyForOperator is the memo key for operator
 const MemoKeyForOperator = "operator"
 
+const (
+	// MemoKeyForMigratedFromDomain is the memo key for the domain a migrated workflow was moved from
+	MemoKeyForMigratedFromDomain = "migratedFromDomain"
+	// MemoKeyForMigratedFromRunID is the memo key for the run ID of a migrated workflow in the domain it was moved from
+	MemoKeyForMigratedFromRunID = "migratedFromRunID"
+)
+
 // ReservedTaskListPrefix is the required naming prefix for any task list partition other than partition 0
 const ReservedTaskListPrefix = "/__cadence_sys/"
 
+// ReservedTaskListPriorityPrefix is the naming prefix of the task lists which persist the backlog
+// of a task list partition for a priority other than the default priority 0
+const ReservedTaskListPriorityPrefix = ReservedTaskListPrefix + "__priority/"
+
 type (
 	// VisibilityOperation is an enum that represents visibility message types
 	VisibilityOperation string
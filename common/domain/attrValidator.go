@@ -22,9 +22,12 @@ package domain
 
 import (
 	"fmt"
+	"strconv"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationfilter"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -61,6 +64,27 @@ func (d *AttrValidatorImpl) validateDomainConfig(config *persistence.DomainConfi
 	return nil
 }
 
+func (d *AttrValidatorImpl) validateDomainData(data map[string]string) error {
+	for _, key := range []string{
+		common.DomainDataKeyForOpenWorkflowsCountLimit,
+		common.DomainDataKeyForPendingActivitiesCountLimit,
+		common.DomainDataKeyForPendingChildWorkflowsCountLimit,
+	} {
+		value, ok := data[key]
+		if !ok {
+			continue
+		}
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return errInvalidDomainLimit
+		}
+	}
+	if _, err := replicationfilter.ParseFilters(data); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	return nil
+}
+
 func (d *AttrValidatorImpl) validateDomainReplicationConfigForLocalDomain(
 	replicationConfig *persistence.DomainReplicationConfig,
 ) error {
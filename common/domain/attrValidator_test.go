@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -151,6 +152,46 @@ func (s *attrValidatorSuite) TestClusterName() {
 	s.NoError(err)
 }
 
+func (s *attrValidatorSuite) TestValidateDomainData() {
+	testCases := []struct {
+		data        map[string]string
+		expectedErr error
+	}{
+		{
+			data:        nil,
+			expectedErr: nil,
+		},
+		{
+			data:        map[string]string{"some random key": "some random value"},
+			expectedErr: nil,
+		},
+		{
+			data:        map[string]string{common.DomainDataKeyForOpenWorkflowsCountLimit: "100"},
+			expectedErr: nil,
+		},
+		{
+			data:        map[string]string{common.DomainDataKeyForPendingActivitiesCountLimit: "-1"},
+			expectedErr: errInvalidDomainLimit,
+		},
+		{
+			data:        map[string]string{common.DomainDataKeyForPendingChildWorkflowsCountLimit: "abc"},
+			expectedErr: errInvalidDomainLimit,
+		},
+		{
+			data:        map[string]string{common.DomainDataKeyForReplicationFilters: `[{"workflowType":"local-workflow"},{"searchAttribute":"Region","searchAttributeValue":"us-east"}]`},
+			expectedErr: nil,
+		},
+		{
+			data:        map[string]string{common.DomainDataKeyForReplicationFilters: `[{"searchAttribute":"Region"}]`},
+			expectedErr: &types.BadRequestError{Message: "replication filter on search attribute Region must have a value"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.expectedErr, s.validator.validateDomainData(tc.data))
+	}
+}
+
 func (s *attrValidatorSuite) TestValidateDomainReplicationConfigForLocalDomain() {
 	err := s.validator.validateDomainReplicationConfigForLocalDomain(
 		&persistence.DomainReplicationConfig{
@@ -35,4 +35,11 @@ var (
 
 	errInvalidRetentionPeriod = &types.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &types.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
+	errInvalidDomainLimit     = &types.BadRequestError{Message: "Domain limit in data must be a non-negative integer."}
+
+	errDomainNotDeprecated = &types.BadRequestError{Message: "Domain has to be deprecated before it can be deleted."}
+	errDomainNotRegistered = &types.BadRequestError{Message: "Only registered domains can be renamed."}
+	errSameDomainName      = &types.BadRequestError{Message: "Domain already has the given name."}
+
+	errRenameReplicationDisabled = &types.BadRequestError{Message: "Global domains can not be renamed while domain rename replication is disabled."}
 )
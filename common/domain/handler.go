@@ -56,6 +56,14 @@ type (
 			ctx context.Context,
 			deprecateRequest *types.DeprecateDomainRequest,
 		) error
+		DeleteDomain(
+			ctx context.Context,
+			deleteRequest *types.DeleteDomainRequest,
+		) error
+		RenameDomain(
+			ctx context.Context,
+			renameRequest *types.RenameDomainRequest,
+		) (*types.RenameDomainResponse, error)
 		DescribeDomain(
 			ctx context.Context,
 			describeRequest *types.DescribeDomainRequest,
@@ -103,6 +111,11 @@ type (
 		MaxBadBinaryCount      dynamicconfig.IntPropertyFnWithDomainFilter
 		FailoverCoolDown       dynamicconfig.DurationPropertyFnWithDomainFilter
 		FailoverHistoryMaxSize dynamicconfig.IntPropertyFnWithDomainFilter
+		DeletionGracePeriod    dynamicconfig.DurationPropertyFnWithDomainFilter
+		RenameAliasDuration    dynamicconfig.DurationPropertyFnWithDomainFilter
+		// EnableRenameReplication enables renaming global domains, which replicates the rename to other clusters.
+		// Older clusters can not decode the domain rename operation.
+		EnableRenameReplication dynamicconfig.BoolPropertyFn
 	}
 
 	// FailoverEvent is the failover information to be stored for each failover event in domain data
@@ -170,13 +183,9 @@ func (d *handlerImpl) RegisterDomain(
 	}
 
 	// input validation on domain name
-	matchedRegex, err := regexp.MatchString("^[a-zA-Z0-9-]+$", registerRequest.GetName())
-	if err != nil {
+	if err := validateDomainName(registerRequest.GetName()); err != nil {
 		return err
 	}
-	if !matchedRegex {
-		return errInvalidDomainName
-	}
 
 	activeClusterName := d.clusterMetadata.GetCurrentClusterName()
 	// input validation on cluster names
@@ -256,6 +265,9 @@ func (d *handlerImpl) RegisterDomain(
 	if err := d.domainAttrValidator.validateDomainConfig(config); err != nil {
 		return err
 	}
+	if err := d.domainAttrValidator.validateDomainData(registerRequest.Data); err != nil {
+		return err
+	}
 	if isGlobalDomain {
 		if err := d.domainAttrValidator.validateDomainReplicationConfigForGlobalDomain(
 			replicationConfig,
@@ -493,6 +505,9 @@ func (d *handlerImpl) UpdateDomain(
 	if err = d.domainAttrValidator.validateDomainConfig(config); err != nil {
 		return nil, err
 	}
+	if err = d.domainAttrValidator.validateDomainData(updateRequest.Data); err != nil {
+		return nil, err
+	}
 
 	err = d.validateDomainReplicationConfigForUpdateDomain(replicationConfig, isGlobalDomain, configurationChanged, activeClusterChanged)
 
@@ -654,6 +669,190 @@ func (d *handlerImpl) DeprecateDomain(
 	return nil
 }
 
+// DeleteDomain marks a deprecated domain as deleted once it has stayed deprecated for the deletion grace period.
+// The domain record itself is only removed after its data has been purged by the domain deletion workflow.
+// Deleting an already deleted domain is a no-op so that the purge can be retried.
+func (d *handlerImpl) DeleteDomain(
+	ctx context.Context,
+	deleteRequest *types.DeleteDomainRequest,
+) error {
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	// and since we do not know which table will return the domain afterwards
+	// this call has to be made
+	metadata, err := d.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: deleteRequest.GetName()})
+	if err != nil {
+		return err
+	}
+
+	isGlobalDomain := getResponse.IsGlobalDomain
+	if isGlobalDomain && !d.clusterMetadata.IsPrimaryCluster() {
+		return errNotPrimaryCluster
+	}
+	switch getResponse.Info.Status {
+	case persistence.DomainStatusDeleted:
+		return nil
+	case persistence.DomainStatusDeprecated:
+	default:
+		return errDomainNotDeprecated
+	}
+	gracePeriod := d.config.DeletionGracePeriod(getResponse.Info.Name)
+	if d.timeSource.Now().Before(time.Unix(0, getResponse.LastUpdatedTime).Add(gracePeriod)) {
+		return &types.BadRequestError{Message: fmt.Sprintf(
+			"Domain has to stay deprecated for %v before it can be deleted.", gracePeriod,
+		)}
+	}
+
+	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
+	getResponse.Info.Status = persistence.DomainStatusDeleted
+
+	updateReq := createUpdateRequest(
+		getResponse.Info,
+		getResponse.Config,
+		getResponse.ReplicationConfig,
+		getResponse.ConfigVersion,
+		getResponse.FailoverVersion,
+		getResponse.FailoverNotificationVersion,
+		getResponse.FailoverEndTime,
+		getResponse.PreviousFailoverVersion,
+		d.timeSource.Now(),
+		notificationVersion,
+	)
+
+	err = d.domainManager.UpdateDomain(ctx, &updateReq)
+	if err != nil {
+		return err
+	}
+
+	if isGlobalDomain {
+		if err := d.domainReplicator.HandleTransmissionTask(
+			ctx,
+			types.DomainOperationUpdate,
+			getResponse.Info,
+			getResponse.Config,
+			getResponse.ReplicationConfig,
+			getResponse.ConfigVersion,
+			getResponse.FailoverVersion,
+			getResponse.PreviousFailoverVersion,
+			isGlobalDomain,
+		); err != nil {
+			return err
+		}
+	}
+
+	d.logger.Info("DeleteDomain domain succeeded",
+		tag.WorkflowDomainName(getResponse.Info.Name),
+		tag.WorkflowDomainID(getResponse.Info.ID),
+	)
+	return nil
+}
+
+// RenameDomain renames a domain, keeping its ID. The previous name is kept in the domain data and
+// resolves to the domain until the rename alias duration passes, so clients can move to the new name.
+func (d *handlerImpl) RenameDomain(
+	ctx context.Context,
+	renameRequest *types.RenameDomainRequest,
+) (*types.RenameDomainResponse, error) {
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	// and since we do not know which table will return the domain afterwards
+	// this call has to be made
+	metadata, err := d.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: renameRequest.GetName()})
+	if err != nil {
+		return nil, err
+	}
+
+	isGlobalDomain := getResponse.IsGlobalDomain
+	if isGlobalDomain && !d.clusterMetadata.IsPrimaryCluster() {
+		return nil, errNotPrimaryCluster
+	}
+	if isGlobalDomain && !d.config.EnableRenameReplication() {
+		// without replication the other clusters would keep the previous name
+		return nil, errRenameReplicationDisabled
+	}
+	if getResponse.Info.Status != persistence.DomainStatusRegistered {
+		return nil, errDomainNotRegistered
+	}
+	if err := validateDomainName(renameRequest.GetNewName()); err != nil {
+		return nil, err
+	}
+	if renameRequest.GetNewName() == renameRequest.GetName() {
+		return nil, errSameDomainName
+	}
+
+	previousName := getResponse.Info.Name
+	now := d.timeSource.Now()
+	previousNameExpiry := now.Add(d.config.RenameAliasDuration(previousName))
+	data := make(map[string]string, len(getResponse.Info.Data)+2)
+	for k, v := range getResponse.Info.Data {
+		data[k] = v
+	}
+	data[common.DomainDataKeyForPreviousName] = previousName
+	data[common.DomainDataKeyForPreviousNameExpiry] = previousNameExpiry.Format(time.RFC3339)
+	getResponse.Info.Data = data
+	getResponse.Info.Name = renameRequest.GetNewName()
+	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
+
+	updateReq := createUpdateRequest(
+		getResponse.Info,
+		getResponse.Config,
+		getResponse.ReplicationConfig,
+		getResponse.ConfigVersion,
+		getResponse.FailoverVersion,
+		getResponse.FailoverNotificationVersion,
+		getResponse.FailoverEndTime,
+		getResponse.PreviousFailoverVersion,
+		now,
+		notificationVersion,
+	)
+
+	err = d.domainManager.RenameDomain(ctx, &persistence.RenameDomainRequest{
+		PreviousName:        previousName,
+		UpdateDomainRequest: updateReq,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if isGlobalDomain {
+		if err := d.domainReplicator.HandleTransmissionTask(
+			ctx,
+			types.DomainOperationRename,
+			getResponse.Info,
+			getResponse.Config,
+			getResponse.ReplicationConfig,
+			getResponse.ConfigVersion,
+			getResponse.FailoverVersion,
+			getResponse.PreviousFailoverVersion,
+			isGlobalDomain,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	d.logger.Info("RenameDomain domain succeeded",
+		tag.WorkflowDomainName(getResponse.Info.Name),
+		tag.WorkflowDomainID(getResponse.Info.ID),
+		tag.Value(previousName),
+	)
+	return &types.RenameDomainResponse{
+		DomainID:                    getResponse.Info.ID,
+		PreviousNameExpiryTimestamp: previousNameExpiry.UnixNano(),
+	}, nil
+}
+
 // UpdateIsolationGroups is used for draining and undraining of isolation-groups for a domain.
 // Like the isolation-group API, this controller expects Upsert semantics for
 // isolation-groups and does not modify any other domain information.
@@ -1245,6 +1444,17 @@ func (d *handlerImpl) validateDomainReplicationConfigForUpdateDomain(
 	return nil
 }
 
+func validateDomainName(name string) error {
+	matchedRegex, err := regexp.MatchString("^[a-zA-Z0-9-]+$", name)
+	if err != nil {
+		return err
+	}
+	if !matchedRegex {
+		return errInvalidDomainName
+	}
+	return nil
+}
+
 func getDomainStatus(info *persistence.DomainInfo) *types.DomainStatus {
 	switch info.Status {
 	case persistence.DomainStatusRegistered:
@@ -64,6 +64,20 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// DeleteDomain mocks base method.
+func (m *MockHandler) DeleteDomain(ctx context.Context, deleteRequest *types.DeleteDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomain", ctx, deleteRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomain indicates an expected call of DeleteDomain.
+func (mr *MockHandlerMockRecorder) DeleteDomain(ctx, deleteRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockHandler)(nil).DeleteDomain), ctx, deleteRequest)
+}
+
 // DeprecateDomain mocks base method.
 func (m *MockHandler) DeprecateDomain(ctx context.Context, deprecateRequest *types.DeprecateDomainRequest) error {
 	m.ctrl.T.Helper()
@@ -122,6 +136,21 @@ func (mr *MockHandlerMockRecorder) RegisterDomain(ctx, registerRequest any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDomain", reflect.TypeOf((*MockHandler)(nil).RegisterDomain), ctx, registerRequest)
 }
 
+// RenameDomain mocks base method.
+func (m *MockHandler) RenameDomain(ctx context.Context, renameRequest *types.RenameDomainRequest) (*types.RenameDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDomain", ctx, renameRequest)
+	ret0, _ := ret[0].(*types.RenameDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameDomain indicates an expected call of RenameDomain.
+func (mr *MockHandlerMockRecorder) RenameDomain(ctx, renameRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockHandler)(nil).RenameDomain), ctx, renameRequest)
+}
+
 // UpdateAsyncWorkflowConfiguraton mocks base method.
 func (m *MockHandler) UpdateAsyncWorkflowConfiguraton(ctx context.Context, updateRequest types.UpdateDomainAsyncWorkflowConfiguratonRequest) error {
 	m.ctrl.T.Helper()
@@ -62,11 +62,14 @@ func newTestHandler(domainManager persistence.DomainManager, primaryCluster bool
 	}
 	archivalMetadata := archiver.NewArchivalMetadata(mockDC, "Enabled", true, "Enabled", true, domainDefaults)
 	testConfig := Config{
-		MinRetentionDays:       dynamicconfig.GetIntPropertyFn(1),
-		MaxRetentionDays:       dynamicconfig.GetIntPropertyFn(5),
-		RequiredDomainDataKeys: nil,
-		MaxBadBinaryCount:      func(string) int { return 3 },
-		FailoverCoolDown:       func(string) time.Duration { return time.Second },
+		MinRetentionDays:        dynamicconfig.GetIntPropertyFn(1),
+		MaxRetentionDays:        dynamicconfig.GetIntPropertyFn(5),
+		RequiredDomainDataKeys:  nil,
+		MaxBadBinaryCount:       func(string) int { return 3 },
+		FailoverCoolDown:        func(string) time.Duration { return time.Second },
+		DeletionGracePeriod:     func(string) time.Duration { return time.Hour },
+		RenameAliasDuration:     func(string) time.Duration { return 24 * time.Hour },
+		EnableRenameReplication: dynamicconfig.GetBoolPropertyFn(true),
 	}
 
 	return NewHandler(
@@ -712,6 +715,267 @@ func TestHandler_DeprecateDomain(t *testing.T) {
 	}
 }
 
+func TestHandler_DeleteDomain(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMocks     func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time)
+		primaryCluster bool
+		expectedErr    error
+	}{
+		{
+			name:           "success - delete global domain",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: "test-domain"}).Return(&persistence.GetDomainResponse{
+					Info:              &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusDeprecated},
+					Config:            &persistence.DomainConfig{Retention: 7},
+					ReplicationConfig: &persistence.DomainReplicationConfig{},
+					IsGlobalDomain:    true,
+					ConfigVersion:     1,
+					LastUpdatedTime:   now.Add(-2 * time.Hour).UnixNano(),
+				}, nil)
+				m.EXPECT().UpdateDomain(gomock.Any(), gomock.AssignableToTypeOf(&persistence.UpdateDomainRequest{})).DoAndReturn(
+					func(_ context.Context, req *persistence.UpdateDomainRequest) error {
+						if req.ConfigVersion != 2 || req.Info.Status != persistence.DomainStatusDeleted {
+							return errors.New("unexpected UpdateDomainRequest")
+						}
+						return nil
+					},
+				)
+				r.EXPECT().HandleTransmissionTask(gomock.Any(), types.DomainOperationUpdate, gomock.Any(), gomock.Any(), gomock.Any(), int64(2), gomock.Any(), gomock.Any(), true).Return(nil)
+			},
+		},
+		{
+			name:           "success - domain already deleted",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info: &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusDeleted},
+				}, nil)
+			},
+		},
+		{
+			name:           "failure - domain not deprecated",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info: &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusRegistered},
+				}, nil)
+			},
+			expectedErr: errDomainNotDeprecated,
+		},
+		{
+			name:           "failure - within grace period",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info:            &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusDeprecated},
+					LastUpdatedTime: now.Add(-time.Minute).UnixNano(),
+				}, nil)
+			},
+			expectedErr: &types.BadRequestError{Message: "Domain has to stay deprecated for 1h0m0s before it can be deleted."},
+		},
+		{
+			name:           "failure - not primary cluster for global domain",
+			primaryCluster: false,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info:           &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusDeprecated},
+					IsGlobalDomain: true,
+				}, nil)
+			},
+			expectedErr: errNotPrimaryCluster,
+		},
+		{
+			name:           "failure - update domain error",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info:              &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusDeprecated},
+					Config:            &persistence.DomainConfig{},
+					ReplicationConfig: &persistence.DomainReplicationConfig{},
+				}, nil)
+				m.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(errors.New("update domain error"))
+			},
+			expectedErr: errors.New("update domain error"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			mockDomainManager := persistence.NewMockDomainManager(controller)
+			mockReplicator := NewMockReplicator(controller)
+
+			handler := newTestHandler(mockDomainManager, tc.primaryCluster, mockReplicator)
+			tc.setupMocks(mockDomainManager, mockReplicator, handler.(*handlerImpl).timeSource.Now())
+
+			err := handler.DeleteDomain(context.Background(), &types.DeleteDomainRequest{Name: "test-domain"})
+
+			if tc.expectedErr != nil {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandler_RenameDomain(t *testing.T) {
+	tests := []struct {
+		name           string
+		newName        string
+		setupMocks     func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time)
+		primaryCluster bool
+		// disableReplication turns off domain rename replication
+		disableReplication bool
+		expectedErr        error
+	}{
+		{
+			name:           "success - rename global domain",
+			newName:        "new-domain",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: "test-domain"}).Return(&persistence.GetDomainResponse{
+					Info:              &persistence.DomainInfo{ID: "test-domain-id", Name: "test-domain", Status: persistence.DomainStatusRegistered, Data: map[string]string{"k": "v"}},
+					Config:            &persistence.DomainConfig{Retention: 7},
+					ReplicationConfig: &persistence.DomainReplicationConfig{},
+					IsGlobalDomain:    true,
+					ConfigVersion:     1,
+				}, nil)
+				m.EXPECT().RenameDomain(gomock.Any(), gomock.AssignableToTypeOf(&persistence.RenameDomainRequest{})).DoAndReturn(
+					func(_ context.Context, req *persistence.RenameDomainRequest) error {
+						assert.Equal(t, "test-domain", req.PreviousName)
+						assert.Equal(t, "new-domain", req.Info.Name)
+						assert.Equal(t, int64(2), req.ConfigVersion)
+						assert.Equal(t, map[string]string{
+							"k":                                 "v",
+							common.DomainDataKeyForPreviousName: "test-domain",
+							common.DomainDataKeyForPreviousNameExpiry: now.Add(24 * time.Hour).Format(time.RFC3339),
+						}, req.Info.Data)
+						return nil
+					},
+				)
+				r.EXPECT().HandleTransmissionTask(gomock.Any(), types.DomainOperationRename, gomock.Any(), gomock.Any(), gomock.Any(), int64(2), gomock.Any(), gomock.Any(), true).Return(nil)
+			},
+		},
+		{
+			name:           "failure - domain not registered",
+			newName:        "new-domain",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info: &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusDeprecated},
+				}, nil)
+			},
+			expectedErr: errDomainNotRegistered,
+		},
+		{
+			name:           "failure - invalid new name",
+			newName:        "new domain",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info: &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusRegistered},
+				}, nil)
+			},
+			expectedErr: errInvalidDomainName,
+		},
+		{
+			name:           "failure - same name",
+			newName:        "test-domain",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info: &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusRegistered},
+				}, nil)
+			},
+			expectedErr: errSameDomainName,
+		},
+		{
+			name:           "failure - not primary cluster for global domain",
+			newName:        "new-domain",
+			primaryCluster: false,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info:           &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusRegistered},
+					IsGlobalDomain: true,
+				}, nil)
+			},
+			expectedErr: errNotPrimaryCluster,
+		},
+		{
+			name:               "failure - rename replication disabled for global domain",
+			newName:            "new-domain",
+			primaryCluster:     true,
+			disableReplication: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info:           &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusRegistered},
+					IsGlobalDomain: true,
+				}, nil)
+			},
+			expectedErr: errRenameReplicationDisabled,
+		},
+		{
+			name:           "failure - new name taken",
+			newName:        "new-domain",
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator, now time.Time) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info:              &persistence.DomainInfo{Name: "test-domain", Status: persistence.DomainStatusRegistered},
+					Config:            &persistence.DomainConfig{},
+					ReplicationConfig: &persistence.DomainReplicationConfig{},
+				}, nil)
+				m.EXPECT().RenameDomain(gomock.Any(), gomock.Any()).Return(&types.DomainAlreadyExistsError{Message: "Domain already exists."})
+			},
+			expectedErr: &types.DomainAlreadyExistsError{Message: "Domain already exists."},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			mockDomainManager := persistence.NewMockDomainManager(controller)
+			mockReplicator := NewMockReplicator(controller)
+
+			handler := newTestHandler(mockDomainManager, tc.primaryCluster, mockReplicator)
+			if tc.disableReplication {
+				handler.(*handlerImpl).config.EnableRenameReplication = dynamicconfig.GetBoolPropertyFn(false)
+			}
+			now := handler.(*handlerImpl).timeSource.Now()
+			tc.setupMocks(mockDomainManager, mockReplicator, now)
+
+			resp, err := handler.RenameDomain(context.Background(), &types.RenameDomainRequest{Name: "test-domain", NewName: tc.newName})
+
+			if tc.expectedErr != nil {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tc.expectedErr.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, now.Add(24*time.Hour).UnixNano(), resp.PreviousNameExpiryTimestamp)
+			}
+		})
+	}
+}
+
 func TestHandler_UpdateIsolationGroups(t *testing.T) {
 	tests := []struct {
 		name             string
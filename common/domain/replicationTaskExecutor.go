@@ -26,6 +26,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -64,24 +65,33 @@ type (
 		Execute(task *types.DomainTaskAttributes) error
 	}
 
+	// DeletionWorkflowStarter starts the workflow purging the data of a deleted domain in the current cluster
+	DeletionWorkflowStarter interface {
+		StartDeletionWorkflow(ctx context.Context, domainName string, domainID string) (*types.DeleteDomainResponse, error)
+	}
+
 	domainReplicationTaskExecutorImpl struct {
-		domainManager persistence.DomainManager
-		timeSource    clock.TimeSource
-		logger        log.Logger
+		domainManager   persistence.DomainManager
+		deletionStarter DeletionWorkflowStarter
+		timeSource      clock.TimeSource
+		logger          log.Logger
 	}
 )
 
-// NewReplicationTaskExecutor create a new instance of domain replicator
+// NewReplicationTaskExecutor create a new instance of domain replicator,
+// without a deletion starter replicated domain deletions only remove the domain record
 func NewReplicationTaskExecutor(
 	domainManager persistence.DomainManager,
+	deletionStarter DeletionWorkflowStarter,
 	timeSource clock.TimeSource,
 	logger log.Logger,
 ) ReplicationTaskExecutor {
 
 	return &domainReplicationTaskExecutorImpl{
-		domainManager: domainManager,
-		timeSource:    timeSource,
-		logger:        logger,
+		domainManager:   domainManager,
+		deletionStarter: deletionStarter,
+		timeSource:      timeSource,
+		logger:          logger,
 	}
 }
 
@@ -99,6 +109,10 @@ func (h *domainReplicationTaskExecutorImpl) Execute(task *types.DomainTaskAttrib
 		return h.handleDomainCreationReplicationTask(ctx, task)
 	case types.DomainOperationUpdate:
 		return h.handleDomainUpdateReplicationTask(ctx, task)
+	case types.DomainOperationDelete:
+		return h.handleDomainDeletionReplicationTask(ctx, task)
+	case types.DomainOperationRename:
+		return h.handleDomainRenameReplicationTask(ctx, task)
 	default:
 		return ErrInvalidDomainOperation
 	}
@@ -219,6 +233,65 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainUpdateReplicationTask(ct
 		return err
 	}
 
+	request, recordUpdated := h.newDomainUpdateRequest(task, status, resp, notificationVersion)
+	if !recordUpdated {
+		return nil
+	}
+
+	return h.domainManager.UpdateDomain(ctx, request)
+}
+
+// handleDomainRenameReplicationTask handles the domain rename replication task
+func (h *domainReplicationTaskExecutorImpl) handleDomainRenameReplicationTask(ctx context.Context, task *types.DomainTaskAttributes) error {
+	// task already validated
+	status, err := h.convertDomainStatusFromThrift(task.Info.Status)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := h.domainManager.GetMetadata(ctx)
+	if err != nil {
+		h.logger.Error("Error getting metadata while handling replication task", tag.Error(err))
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+
+	resp, err := h.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{
+		ID: task.GetID(),
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// the domain was never replicated to this cluster, create it under its new name
+			return h.handleDomainCreationReplicationTask(ctx, task)
+		}
+		h.logger.Error("Domain rename failed, error in fetching domain", tag.Error(err))
+		return err
+	}
+	if resp.Info.Name == task.Info.GetName() {
+		// the rename was applied already
+		return h.handleDomainUpdateReplicationTask(ctx, task)
+	}
+	previousName := resp.Info.Name
+	if previousName != task.Info.Data[common.DomainDataKeyForPreviousName] {
+		return ErrNameUUIDCollision
+	}
+
+	request, _ := h.newDomainUpdateRequest(task, status, resp, notificationVersion)
+	request.Info.Name = task.Info.GetName()
+	return h.domainManager.RenameDomain(ctx, &persistence.RenameDomainRequest{
+		PreviousName:        previousName,
+		UpdateDomainRequest: *request,
+	})
+}
+
+// newDomainUpdateRequest creates the request updating the domain to the replicated one,
+// returning false if the domain is newer than the replicated one already
+func (h *domainReplicationTaskExecutorImpl) newDomainUpdateRequest(
+	task *types.DomainTaskAttributes,
+	status int,
+	resp *persistence.GetDomainResponse,
+	notificationVersion int64,
+) (*persistence.UpdateDomainRequest, bool) {
 	recordUpdated := false
 	request := &persistence.UpdateDomainRequest{
 		Info:                        resp.Info,
@@ -266,11 +339,44 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainUpdateReplicationTask(ct
 		request.PreviousFailoverVersion = task.GetPreviousFailoverVersion()
 	}
 
-	if !recordUpdated {
-		return nil
+	return request, recordUpdated
+}
+
+// handleDomainDeletionReplicationTask handles the domain deletion replication task
+func (h *domainReplicationTaskExecutorImpl) handleDomainDeletionReplicationTask(ctx context.Context, task *types.DomainTaskAttributes) error {
+	// task already validated
+	resp, err := h.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{
+		ID: task.GetID(),
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// domain is already deleted or was never replicated to this cluster
+			return nil
+		}
+		h.logger.Error("Domain deletion failed, error in fetching domain", tag.Error(err))
+		return err
+	}
+	if resp.Info.Name != task.Info.GetName() {
+		return ErrNameUUIDCollision
+	}
+	if resp.Info.Status != persistence.DomainStatusDeleted {
+		// the domain must have been marked deleted by an earlier update before its record is removed
+		return ErrInvalidDomainStatus
 	}
 
-	return h.domainManager.UpdateDomain(ctx, request)
+	if h.deletionStarter != nil {
+		// the executions and task lists of the domain in this cluster are purged before the workflow removes the domain record
+		if _, err := h.deletionStarter.StartDeletionWorkflow(ctx, resp.Info.Name, task.GetID()); err != nil {
+			h.logger.Error("Domain deletion failed, error in starting domain deletion workflow", tag.Error(err))
+			return err
+		}
+		return nil
+	}
+	if err := h.domainManager.DeleteDomain(ctx, &persistence.DeleteDomainRequest{ID: task.GetID()}); err != nil {
+		h.logger.Error("Domain deletion failed, error in deleting domain", tag.Error(err))
+		return err
+	}
+	return nil
 }
 
 func (h *domainReplicationTaskExecutorImpl) validateDomainReplicationTask(task *types.DomainTaskAttributes) error {
@@ -312,6 +418,8 @@ func (h *domainReplicationTaskExecutorImpl) convertDomainStatusFromThrift(input
 		return persistence.DomainStatusRegistered, nil
 	case types.DomainStatusDeprecated:
 		return persistence.DomainStatusDeprecated, nil
+	case types.DomainStatusDeleted:
+		return persistence.DomainStatusDeleted, nil
 	default:
 		return 0, ErrInvalidDomainStatus
 	}
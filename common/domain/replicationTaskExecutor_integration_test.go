@@ -64,6 +64,7 @@ func (s *domainReplicationTaskExecutorSuite) SetupTest() {
 
 	s.domainReplicator = NewReplicationTaskExecutor(
 		s.DomainManager,
+		nil,
 		clock.NewRealTimeSource(),
 		s.Logger,
 	).(*domainReplicationTaskExecutorImpl)
@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/testlogger"
@@ -240,7 +241,7 @@ func TestDomainReplicationTaskExecutor_Execute(t *testing.T) {
 			mockTimeSource := clock.NewRealTimeSource()
 			mockLogger := log.NewNoop()
 
-			executor := NewReplicationTaskExecutor(mockDomainManager, mockTimeSource, mockLogger).(*domainReplicationTaskExecutorImpl)
+			executor := NewReplicationTaskExecutor(mockDomainManager, nil, mockTimeSource, mockLogger).(*domainReplicationTaskExecutorImpl)
 			tt.setupMock(*mockDomainManager)
 			err := executor.Execute(tt.task)
 			if tt.wantErr {
@@ -667,3 +668,243 @@ func TestHandleDomainUpdateReplicationTask(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleDomainDeletionReplicationTask(t *testing.T) {
+	deletionTask := func() *types.DomainTaskAttributes {
+		task := domainCreationTask()
+		task.DomainOperation = types.DomainOperationDelete.Ptr()
+		task.Info.Status = types.DomainStatusDeleted.Ptr()
+		return task
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(mockDomainManager *persistence.MockDomainManager)
+		wantError error
+	}{
+		{
+			name: "Successful Domain Deletion",
+			setup: func(mockDomainManager *persistence.MockDomainManager) {
+				mockDomainManager.EXPECT().
+					GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "testDomainID"}).
+					Return(&persistence.GetDomainResponse{
+						Info: &persistence.DomainInfo{ID: "testDomainID", Name: "testDomain", Status: persistence.DomainStatusDeleted},
+					}, nil).Times(1)
+				mockDomainManager.EXPECT().
+					DeleteDomain(gomock.Any(), &persistence.DeleteDomainRequest{ID: "testDomainID"}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Domain Already Deleted",
+			setup: func(mockDomainManager *persistence.MockDomainManager) {
+				mockDomainManager.EXPECT().
+					GetDomain(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{}).Times(1)
+			},
+		},
+		{
+			name: "Domain Not Marked Deleted",
+			setup: func(mockDomainManager *persistence.MockDomainManager) {
+				mockDomainManager.EXPECT().
+					GetDomain(gomock.Any(), gomock.Any()).
+					Return(&persistence.GetDomainResponse{
+						Info: &persistence.DomainInfo{ID: "testDomainID", Name: "testDomain", Status: persistence.DomainStatusDeprecated},
+					}, nil).Times(1)
+			},
+			wantError: ErrInvalidDomainStatus,
+		},
+		{
+			name: "Name UUID Collision",
+			setup: func(mockDomainManager *persistence.MockDomainManager) {
+				mockDomainManager.EXPECT().
+					GetDomain(gomock.Any(), gomock.Any()).
+					Return(&persistence.GetDomainResponse{
+						Info: &persistence.DomainInfo{ID: "testDomainID", Name: "otherDomain", Status: persistence.DomainStatusDeleted},
+					}, nil).Times(1)
+			},
+			wantError: ErrNameUUIDCollision,
+		},
+		{
+			name: "Delete Domain Failure",
+			setup: func(mockDomainManager *persistence.MockDomainManager) {
+				mockDomainManager.EXPECT().
+					GetDomain(gomock.Any(), gomock.Any()).
+					Return(&persistence.GetDomainResponse{
+						Info: &persistence.DomainInfo{ID: "testDomainID", Name: "testDomain", Status: persistence.DomainStatusDeleted},
+					}, nil).Times(1)
+				mockDomainManager.EXPECT().
+					DeleteDomain(gomock.Any(), gomock.Any()).
+					Return(errors.New("delete failed")).Times(1)
+			},
+			wantError: errors.New("delete failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainManager := persistence.NewMockDomainManager(ctrl)
+			executor := NewReplicationTaskExecutor(mockDomainManager, nil, clock.NewRealTimeSource(), testlogger.New(t))
+			tt.setup(mockDomainManager)
+
+			err := executor.Execute(deletionTask())
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandleDomainDeletionReplicationTask_StartsDeletionWorkflow(t *testing.T) {
+	task := domainCreationTask()
+	task.DomainOperation = types.DomainOperationDelete.Ptr()
+	task.Info.Status = types.DomainStatusDeleted.Ptr()
+
+	tests := []struct {
+		name      string
+		startErr  error
+		wantError error
+	}{
+		{
+			name: "workflow started",
+		},
+		{
+			name:      "start failure",
+			startErr:  errors.New("start failed"),
+			wantError: errors.New("start failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainManager := persistence.NewMockDomainManager(ctrl)
+			mockStarter := NewMockDeletionWorkflowStarter(ctrl)
+			executor := NewReplicationTaskExecutor(mockDomainManager, mockStarter, clock.NewRealTimeSource(), testlogger.New(t))
+
+			mockDomainManager.EXPECT().
+				GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "testDomainID"}).
+				Return(&persistence.GetDomainResponse{
+					Info: &persistence.DomainInfo{ID: "testDomainID", Name: "testDomain", Status: persistence.DomainStatusDeleted},
+				}, nil).Times(1)
+			// the domain record is removed by the workflow after the domain data is purged
+			mockDomainManager.EXPECT().DeleteDomain(gomock.Any(), gomock.Any()).Times(0)
+			mockStarter.EXPECT().
+				StartDeletionWorkflow(gomock.Any(), "testDomain", "testDomainID").
+				Return(&types.DeleteDomainResponse{}, tt.startErr).Times(1)
+
+			err := executor.Execute(task)
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandleDomainRenameReplicationTask(t *testing.T) {
+	renameTask := func() *types.DomainTaskAttributes {
+		task := domainCreationTask()
+		task.DomainOperation = types.DomainOperationRename.Ptr()
+		task.Info.Name = "newTestDomain"
+		task.Info.Data = map[string]string{
+			"key1":                              "value1",
+			common.DomainDataKeyForPreviousName: "testDomain",
+		}
+		task.ConfigVersion = 2
+		return task
+	}
+	currentDomain := func(name string) *persistence.GetDomainResponse {
+		return &persistence.GetDomainResponse{
+			Info:              &persistence.DomainInfo{ID: "testDomainID", Name: name, Status: persistence.DomainStatusRegistered},
+			Config:            &persistence.DomainConfig{},
+			ReplicationConfig: &persistence.DomainReplicationConfig{},
+			ConfigVersion:     1,
+			FailoverVersion:   1,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(mockDomainManager *persistence.MockDomainManager)
+		wantError error
+	}{
+		{
+			name: "Successful Domain Rename",
+			setup: func(mockDomainManager *persistence.MockDomainManager) {
+				mockDomainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil).Times(1)
+				mockDomainManager.EXPECT().
+					GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "testDomainID"}).
+					Return(currentDomain("testDomain"), nil).Times(1)
+				mockDomainManager.EXPECT().
+					RenameDomain(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.RenameDomainRequest) error {
+						assert.Equal(t, "testDomain", req.PreviousName)
+						assert.Equal(t, "newTestDomain", req.Info.Name)
+						assert.Equal(t, "testDomain", req.Info.Data[common.DomainDataKeyForPreviousName])
+						assert.Equal(t, int64(2), req.ConfigVersion)
+						assert.Equal(t, int64(5), req.NotificationVersion)
+						return nil
+					}).Times(1)
+			},
+		},
+		{
+			name: "Domain Not Replicated Yet",
+			setup: func(mockDomainManager *persistence.MockDomainManager) {
+				mockDomainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil).Times(1)
+				mockDomainManager.EXPECT().
+					GetDomain(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{}).Times(1)
+				mockDomainManager.EXPECT().
+					CreateDomain(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.CreateDomainRequest) (*persistence.CreateDomainResponse, error) {
+						assert.Equal(t, "newTestDomain", req.Info.Name)
+						return &persistence.CreateDomainResponse{ID: "testDomainID"}, nil
+					}).Times(1)
+			},
+		},
+		{
+			name: "Name UUID Collision",
+			setup: func(mockDomainManager *persistence.MockDomainManager) {
+				mockDomainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil).Times(1)
+				mockDomainManager.EXPECT().
+					GetDomain(gomock.Any(), gomock.Any()).
+					Return(currentDomain("otherDomain"), nil).Times(1)
+			},
+			wantError: ErrNameUUIDCollision,
+		},
+		{
+			name: "Rename Domain Failure",
+			setup: func(mockDomainManager *persistence.MockDomainManager) {
+				mockDomainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil).Times(1)
+				mockDomainManager.EXPECT().
+					GetDomain(gomock.Any(), gomock.Any()).
+					Return(currentDomain("testDomain"), nil).Times(1)
+				mockDomainManager.EXPECT().
+					RenameDomain(gomock.Any(), gomock.Any()).
+					Return(errors.New("rename failed")).Times(1)
+			},
+			wantError: errors.New("rename failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainManager := persistence.NewMockDomainManager(ctrl)
+			executor := NewReplicationTaskExecutor(mockDomainManager, nil, clock.NewRealTimeSource(), testlogger.New(t))
+			tt.setup(mockDomainManager)
+
+			err := executor.Execute(renameTask())
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@
 package domain
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -76,3 +77,42 @@ func (mr *MockReplicationTaskExecutorMockRecorder) Execute(task any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReplicationTaskExecutor)(nil).Execute), task)
 }
+
+// MockDeletionWorkflowStarter is a mock of DeletionWorkflowStarter interface.
+type MockDeletionWorkflowStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeletionWorkflowStarterMockRecorder
+	isgomock struct{}
+}
+
+// MockDeletionWorkflowStarterMockRecorder is the mock recorder for MockDeletionWorkflowStarter.
+type MockDeletionWorkflowStarterMockRecorder struct {
+	mock *MockDeletionWorkflowStarter
+}
+
+// NewMockDeletionWorkflowStarter creates a new mock instance.
+func NewMockDeletionWorkflowStarter(ctrl *gomock.Controller) *MockDeletionWorkflowStarter {
+	mock := &MockDeletionWorkflowStarter{ctrl: ctrl}
+	mock.recorder = &MockDeletionWorkflowStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeletionWorkflowStarter) EXPECT() *MockDeletionWorkflowStarterMockRecorder {
+	return m.recorder
+}
+
+// StartDeletionWorkflow mocks base method.
+func (m *MockDeletionWorkflowStarter) StartDeletionWorkflow(ctx context.Context, domainName, domainID string) (*types.DeleteDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartDeletionWorkflow", ctx, domainName, domainID)
+	ret0, _ := ret[0].(*types.DeleteDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartDeletionWorkflow indicates an expected call of StartDeletionWorkflow.
+func (mr *MockDeletionWorkflowStarterMockRecorder) StartDeletionWorkflow(ctx, domainName, domainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDeletionWorkflow", reflect.TypeOf((*MockDeletionWorkflowStarter)(nil).StartDeletionWorkflow), ctx, domainName, domainID)
+}
@@ -144,6 +144,9 @@ func (domainReplicator *domainReplicatorImpl) convertDomainStatusToThrift(input
 	case persistence.DomainStatusDeprecated:
 		output := types.DomainStatusDeprecated
 		return &output, nil
+	case persistence.DomainStatusDeleted:
+		output := types.DomainStatusDeleted
+		return &output, nil
 	default:
 		return nil, ErrInvalidDomainStatus
 	}
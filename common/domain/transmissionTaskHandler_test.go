@@ -414,3 +414,39 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateDomainTask_NotG
 	)
 	s.Nil(err)
 }
+
+func (s *transmissionTaskSuite) TestHandleTransmissionTask_DeletedDomain() {
+	for _, domainOperation := range []types.DomainOperation{types.DomainOperationUpdate, types.DomainOperationDelete} {
+		id := uuid.New()
+		info := &p.DomainInfo{
+			ID:     id,
+			Name:   "some random domain test name",
+			Status: p.DomainStatusDeleted,
+		}
+		replicationConfig := &p.DomainReplicationConfig{
+			ActiveClusterName: "some random active cluster name",
+			Clusters:          []*p.ClusterReplicationConfig{{ClusterName: "some random active cluster name"}},
+		}
+
+		s.kafkaProducer.On("Publish", mock.Anything, mock.MatchedBy(func(task *types.ReplicationTask) bool {
+			attr := task.DomainTaskAttributes
+			return attr.ID == id &&
+				attr.GetDomainOperation() == domainOperation &&
+				attr.Info.GetStatus() == types.DomainStatusDeleted &&
+				attr.ConfigVersion == 3
+		})).Return(nil).Once()
+
+		err := s.domainReplicator.HandleTransmissionTask(
+			context.Background(),
+			domainOperation,
+			info,
+			&p.DomainConfig{},
+			replicationConfig,
+			3,
+			4,
+			0,
+			true,
+		)
+		s.NoError(err)
+	}
+}
@@ -95,6 +95,9 @@ type IntPropertyFnWithShardIDFilter func(shardID int) int
 // FloatPropertyFn is a wrapper to get float property from dynamic config
 type FloatPropertyFn func(opts ...FilterOption) float64
 
+// FloatPropertyFnWithDomainFilter is a wrapper to get float property from dynamic config with domain as filter
+type FloatPropertyFnWithDomainFilter func(domain string) float64
+
 // FloatPropertyFnWithShardIDFilter is a wrapper to get float property from dynamic config with shardID as filter
 type FloatPropertyFnWithShardIDFilter func(shardID int) float64
 
@@ -155,6 +158,9 @@ type ListPropertyFn func(opts ...FilterOption) []interface{}
 // StringPropertyWithRatelimitKeyFilter is a wrapper to get strings (currently global ratelimiter modes) per global ratelimit key
 type StringPropertyWithRatelimitKeyFilter func(globalRatelimitKey string) string
 
+// IntPropertyWithRatelimitKeyFilter is a wrapper to get ints (currently global ratelimiter target RPS) per global ratelimit key
+type IntPropertyWithRatelimitKeyFilter func(globalRatelimitKey string) int
+
 // GetProperty gets a interface property and returns defaultValue if property is not found
 func (c *Collection) GetProperty(key Key) PropertyFn {
 	return func() interface{} {
@@ -289,6 +295,22 @@ func (c *Collection) GetFloat64Property(key FloatKey) FloatPropertyFn {
 	}
 }
 
+// GetFloat64PropertyFilteredByDomain gets property with domain filter and asserts that it's a float64
+func (c *Collection) GetFloat64PropertyFilteredByDomain(key FloatKey) FloatPropertyFnWithDomainFilter {
+	return func(domain string) float64 {
+		filters := c.toFilterMap(DomainFilter(domain))
+		val, err := c.client.GetFloatValue(
+			key,
+			filters,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+			return key.DefaultFloat()
+		}
+		return val
+	}
+}
+
 // GetFloat64PropertyFilteredByShardID gets property with shardID filter and asserts that it's a float64
 func (c *Collection) GetFloat64PropertyFilteredByShardID(key FloatKey) FloatPropertyFnWithShardIDFilter {
 	return func(shardID int) float64 {
@@ -590,6 +612,22 @@ func (c *Collection) GetStringPropertyFilteredByRatelimitKey(key StringKey) Stri
 	}
 }
 
+// GetIntPropertyFilteredByRatelimitKey gets property with global ratelimit key as filter and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByRatelimitKey(key IntKey) IntPropertyWithRatelimitKeyFilter {
+	return func(ratelimitKey string) int {
+		filters := c.toFilterMap(RatelimitKeyFilter(ratelimitKey))
+		val, err := c.client.GetIntValue(
+			key,
+			filters,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+			return key.DefaultInt()
+		}
+		return val
+	}
+}
+
 func (c *Collection) toFilterMap(opts ...FilterOption) map[Filter]interface{} {
 	l := len(opts)
 	m := make(map[Filter]interface{}, l)
@@ -59,6 +59,11 @@ func GetFloatPropertyFn(value float64) func(opts ...FilterOption) float64 {
 	return func(...FilterOption) float64 { return value }
 }
 
+// GetFloatPropertyFnFilteredByDomain returns value as FloatPropertyFnWithDomainFilter
+func GetFloatPropertyFnFilteredByDomain(value float64) func(domain string) float64 {
+	return func(domain string) float64 { return value }
+}
+
 // GetBoolPropertyFn returns value as BoolPropertyFn
 func GetBoolPropertyFn(value bool) func(opts ...FilterOption) bool {
 	return func(...FilterOption) bool { return value }
@@ -113,3 +118,8 @@ func GetStringPropertyFnFilteredByDomain(value string) func(domain string) strin
 func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) map[string]interface{} {
 	return func(...FilterOption) map[string]interface{} { return value }
 }
+
+// GetListPropertyFn returns value as ListPropertyFn
+func GetListPropertyFn(value []interface{}) func(opts ...FilterOption) []interface{} {
+	return func(...FilterOption) []interface{} { return value }
+}
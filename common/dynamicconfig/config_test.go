@@ -129,6 +129,15 @@ func (s *configSuite) TestGetStringPropertyFilteredByRatelimitKey() {
 	s.Equal("fake-mode", value(ratelimitKey))
 }
 
+func (s *configSuite) TestGetIntPropertyFilteredByRatelimitKey() {
+	key := PersistenceGlobalOperationMaxQPS
+	ratelimitKey := "history-persistence:TaskManager.CreateTasks"
+	value := s.cln.GetIntPropertyFilteredByRatelimitKey(key)
+	s.Equal(key.DefaultInt(), value(ratelimitKey))
+	s.client.SetValue(key, 50)
+	s.Equal(50, value(ratelimitKey))
+}
+
 func (s *configSuite) TestGetIntPropertyFilteredByTaskListInfo() {
 	key := TestGetIntPropertyFilteredByTaskListInfoKey
 	domain := "testDomain"
@@ -148,6 +157,15 @@ func (s *configSuite) TestGetFloat64Property() {
 	s.Equal(0.01, value())
 }
 
+func (s *configSuite) TestGetFloat64PropertyFilteredByDomain() {
+	key := TestGetFloat64PropertyKey
+	domain := "testDomain"
+	value := s.cln.GetFloat64PropertyFilteredByDomain(key)
+	s.Equal(key.DefaultFloat(), value(domain))
+	s.client.SetValue(key, 0.01)
+	s.Equal(0.01, value(domain))
+}
+
 func (s *configSuite) TestGetFloat64PropertyFilteredByShardID() {
 	key := TestGetFloat64PropertyFilteredByShardIDKey
 	shardID := 1
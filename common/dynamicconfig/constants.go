@@ -391,6 +391,25 @@ const (
 	// Value type: Int
 	// Default value: 512
 	PendingActivitiesCountLimitWarn
+	// DomainOpenWorkflowsCountLimit is the limit of how many open workflows a domain can have. 0 means unlimited.
+	// Child workflows are not rejected by the limit. It requires advanced visibility and is not enforced with DB visibility
+	// KeyName: limit.domainOpenWorkflowCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	DomainOpenWorkflowsCountLimit
+	// DomainPendingActivitiesCountLimit is the limit of how many pending activities a workflow in the domain can have. 0 means unlimited
+	// KeyName: limit.domainPendingActivityCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	DomainPendingActivitiesCountLimit
+	// DomainPendingChildWorkflowsCountLimit is the limit of how many pending child workflows a workflow in the domain can have. 0 means unlimited
+	// KeyName: limit.domainPendingChildWorkflowCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	DomainPendingChildWorkflowsCountLimit
 	// DomainNameMaxLength is the length limit for domain name
 	// KeyName: limit.domainNameLength
 	// Value type: Int
@@ -646,6 +665,12 @@ const (
 	// Default value: 100000
 	// Allowed filters: DomainName
 	FrontendGlobalDomainAsyncRPS
+	// FrontendLoadSheddingMaxInFlightRequests is the number of in-flight requests a frontend host can serve before load shedding starts rejecting lower criticality tiers
+	// KeyName: frontend.loadSheddingMaxInFlightRequests
+	// Value type: Int
+	// Default value: 2000
+	// Allowed filters: N/A
+	FrontendLoadSheddingMaxInFlightRequests
 	// FrontendDecisionResultCountLimit is max number of decisions per RespondDecisionTaskCompleted request
 	// KeyName: frontend.decisionResultCountLimit
 	// Value type: Int
@@ -701,6 +726,12 @@ const (
 	// Default value: 5
 	// Allowed filters: DomainName
 	FrontendFailoverHistoryMaxSize
+	// FrontendReplicationTaskMaxBatchSize is the max number of consecutive replication tasks of a workflow sent in one batch to a remote cluster
+	// KeyName: frontend.replicationTaskMaxBatchSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	FrontendReplicationTaskMaxBatchSize
 
 	// key for matching
 
@@ -752,6 +783,15 @@ const (
 	// Default value: 1000
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingGetTasksBatchSize
+	// MatchingTaskPriorityLevels is the number of task priorities of a task list, every priority keeps its backlog in
+	// a separate persisted task list so that the backlog of a higher priority is read first. Task priorities are
+	// capped to the range [0, levels-1]. It is read when the task list is loaded, lowering it leaves the backlog of
+	// the removed priorities in persistence until it is raised again. Sticky task lists always have a single priority.
+	// KeyName: matching.taskPriorityLevels
+	// Value type: Int
+	// Default value: 1
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskPriorityLevels
 	// MatchingOutstandingTaskAppendsThreshold is the threshold for outstanding task appends
 	// KeyName: matching.outstandingTaskAppendsThreshold
 	// Value type: Int
@@ -820,6 +860,18 @@ const (
 	MatchingReadRangeSize
 
 	MatchingPartitionUpscaleRPS
+	// MatchingPartitionUpscaleBacklogPerPartition is the backlog per write partition above which a task list with a growing backlog is upscaled
+	// KeyName: matching.partitionUpscaleBacklogPerPartition
+	// Value type: Int
+	// Default value: 0 (disabled)
+	// Allowed filters: DomainName, TaskListName, TaskType
+	MatchingPartitionUpscaleBacklogPerPartition
+	// MatchingPartitionUpscaleMaxPartitions is the max number of write partitions the adaptive scaler adds because of backlog, sync match rate or dispatch latency. QPS based upscaling is not capped
+	// KeyName: matching.partitionUpscaleMaxPartitions
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName, TaskListName, TaskType
+	MatchingPartitionUpscaleMaxPartitions
 
 	// key for history
 
@@ -952,12 +1004,31 @@ const (
 	// Default value: 50
 	// Allowed filters: N/A
 	TaskCriticalRetryCount
+	// TaskDLQMaxAttempts is the number of attempts after which a permanently failing transfer/timer task
+	// can be moved to the history task DLQ, it must also have been failing for TaskDLQMaxAge
+	// KeyName: history.taskDLQMaxAttempts
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	TaskDLQMaxAttempts
 	// QueueProcessorSplitMaxLevel is the max processing queue level
 	// KeyName: history.queueProcessorSplitMaxLevel
 	// Value type: Int
 	// Default value: 2 // 3 levels, start from 0
 	// Allowed filters: N/A
 	QueueProcessorSplitMaxLevel
+	// QueueProcessorDomainIsolationHashBuckets is the number of processing queues isolated domains are hashed into, 0 gives every isolated domain its own queue
+	// KeyName: history.queueProcessorDomainIsolationHashBuckets
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueProcessorDomainIsolationHashBuckets
+	// QueueProcessorIsolatedQueueMaxPollRPS is the max poll rate of each isolated domain processing queue
+	// KeyName: history.queueProcessorIsolatedQueueMaxPollRPS
+	// Value type: Int
+	// Default value: 20
+	// Allowed filters: N/A
+	QueueProcessorIsolatedQueueMaxPollRPS
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
 	// KeyName: history.timerTaskBatchSize
 	// Value type: Int
@@ -1290,6 +1361,18 @@ const (
 	// Default value: 5
 	// Allowed filters: N/A
 	ScannerPersistenceMaxQPS
+	// WorkerDomainDeletionRPS is the rate limit on number of workflow executions and task lists purged per second by the domain deletion workflow
+	// KeyName: worker.domainDeletionRPS
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	WorkerDomainDeletionRPS
+	// WorkerDomainMigrationRPS is the rate limit on number of workflow executions moved per second by the domain migration workflow
+	// KeyName: worker.domainMigrationRPS
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	WorkerDomainMigrationRPS
 	// ScannerGetOrphanTasksPageSize is the maximum number of orphans to delete in one batch
 	// KeyName: worker.scannerGetOrphanTasksPageSize
 	// Value type: Int
@@ -1412,6 +1495,18 @@ const (
 	// Value type: Int
 	// Default value: 100
 	ESAnalyzerMinNumWorkflowsForAvg
+	// IsolationGroupDrainerMaxDrainedGroups is the maximum number of isolation groups that may be drained at the same time, counting drains that were applied manually
+	// KeyName: worker.isolationGroupDrainerMaxDrainedGroups
+	// Value type: Int
+	// Default value: 1
+	// Allowed filters: N/A
+	IsolationGroupDrainerMaxDrainedGroups
+	// IsolationGroupDrainerUnhealthyEvaluations is the number of consecutive evaluations an isolation group must be unhealthy for before a drain is proposed
+	// KeyName: worker.isolationGroupDrainerUnhealthyEvaluations
+	// Value type: Int
+	// Default value: 3
+	// Allowed filters: N/A
+	IsolationGroupDrainerUnhealthyEvaluations
 
 	// key for shard manager
 
@@ -1485,6 +1580,14 @@ const (
 	// Value type: Int
 	// Default value: 30
 	DeleteHistoryEventContextTimeout
+	// PersistenceGlobalOperationMaxQPS is the cluster-wide QPS limit of one persistence operation of one domain,
+	// shared by all hosts of a service when PersistenceGlobalRatelimiterMode is "local" or "global" for the key.
+	// The filter is the global key of the operation without the domain, e.g. "history-persistence:ExecutionManager.UpdateWorkflowExecution".
+	// KeyName: system.persistenceGlobalOperationMaxQPS
+	// Value type: Int
+	// Default value: UnlimitedRPS
+	// Allowed filters: RatelimitKey
+	PersistenceGlobalOperationMaxQPS
 
 	// LastIntKey must be the last one in this const group
 	LastIntKey
@@ -1596,6 +1699,12 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	EnableClientVersionCheck
+	// FrontendEnableLoadShedding enables priority-aware load shedding, which rejects less critical APIs first when the frontend host is overloaded
+	// KeyName: frontend.enableLoadShedding
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	FrontendEnableLoadShedding
 	// SendRawWorkflowHistory is whether to enable raw history retrieving
 	// KeyName: frontend.sendRawWorkflowHistory
 	// Value type: Bool
@@ -1608,6 +1717,18 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	FrontendEmitSignalNameMetricsTag
+	// FrontendEnableReplicationTaskBatching enables batching consecutive replication tasks of a workflow for remote clusters supporting it
+	// KeyName: frontend.enableReplicationTaskBatching
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	FrontendEnableReplicationTaskBatching
+	// FrontendEnableReplicationTaskCompression enables zstd compression of replication tasks for remote clusters supporting it
+	// KeyName: frontend.enableReplicationTaskCompression
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	FrontendEnableReplicationTaskCompression
 	// EnableQueryAttributeValidation enables validation of queries' search attributes against the dynamic config whitelist
 	// Keyname: frontend.enableQueryAttributeValidation
 	// Value type: Bool
@@ -1645,6 +1766,12 @@ const (
 
 	MatchingEnableGetNumberOfPartitionsFromCache
 	MatchingEnableAdaptiveScaler
+	// MatchingEnablePartitionIsolationGroupAssignment is to enable assigning isolation groups to task list partitions based on the isolation groups of their pollers. It requires the adaptive scaler and task list isolation to be enabled
+	// KeyName: matching.enablePartitionIsolationGroupAssignment
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName, TaskListName, TaskType
+	MatchingEnablePartitionIsolationGroupAssignment
 
 	// key for history
 
@@ -1660,6 +1787,18 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	QueueProcessorEnableSplit
+	// QueueProcessorEnableDomainIsolation indicates whether domains can be isolated into their own processing queues
+	// KeyName: history.queueProcessorEnableDomainIsolation
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	QueueProcessorEnableDomainIsolation
+	// QueueProcessorEnableDomainIsolationForAllDomains indicates whether every domain should be isolated, hashed into history.queueProcessorDomainIsolationHashBuckets processing queues
+	// KeyName: history.queueProcessorEnableDomainIsolationForAllDomains
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	QueueProcessorEnableDomainIsolationForAllDomains
 	// QueueProcessorEnableRandomSplitByDomainID indicates whether random queue split policy should be enabled for a domain
 	// KeyName: history.queueProcessorEnableRandomSplitByDomainID
 	// Value type: Bool
@@ -1678,6 +1817,12 @@ const (
 	// Default value: false
 	// Allowed filters: DomainID
 	QueueProcessorEnableStuckTaskSplitByDomainID
+	// QueueProcessorEnableDomainIsolationByDomainID indicates whether the domain should always be processed in its own processing queue
+	// KeyName: history.queueProcessorEnableDomainIsolationByDomainID
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainID
+	QueueProcessorEnableDomainIsolationByDomainID
 	// QueueProcessorEnablePersistQueueStates indicates whether processing queue states should be persisted
 	// KeyName: history.queueProcessorEnablePersistQueueStates
 	// Value type: Bool
@@ -1720,6 +1865,12 @@ const (
 	// Default value: false
 	// Allowed filters: DomainID
 	EnableDropStuckTaskByDomainID
+	// EnableTaskDLQByDomainID is whether permanently failing timer/transfer tasks of a domain are moved to the history task DLQ
+	// KeyName: history.enableTaskDLQByDomain
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainID
+	EnableTaskDLQByDomainID
 	// EnableConsistentQuery indicates if consistent query is enabled for the cluster
 	// KeyName: history.EnableConsistentQuery
 	// Value type: Bool
@@ -1768,6 +1919,12 @@ const (
 	// Default value: true
 	// Allowed filters: DomainID, WorkflowID
 	EnableReplicationTaskGeneration
+	// ReplicationTaskFetcherEnableStreaming enables fetching replication tasks through a stream pushing them as soon as they are written in the source cluster
+	// KeyName: history.replicationTaskFetcherEnableStreaming
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ReplicationTaskFetcherEnableStreaming
 	// UseNewInitialFailoverVersion is a switch to issue a failover version based on the minFailoverVersion
 	// rather than the default initialFailoverVersion. USed as a per-domain migration switch
 	// KeyName: history.useNewInitialFailoverVersion
@@ -1884,6 +2041,24 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	EnableESAnalyzer
+	// EnableIsolationGroupDrainer decides whether to start the controller that proposes isolation-group drains based on health signals
+	// KeyName: worker.enableIsolationGroupDrainer
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableIsolationGroupDrainer
+	// IsolationGroupDrainerAutoApply decides whether proposed isolation-group drains are applied automatically. When false drains are only logged and recorded
+	// KeyName: worker.isolationGroupDrainerAutoApply
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	IsolationGroupDrainerAutoApply
+	// EnableAutoFailoverMonitor decides whether to start the monitor that fails over opted in domains to the current cluster when their active cluster stays unhealthy
+	// KeyName: worker.enableAutoFailoverMonitor
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableAutoFailoverMonitor
 	// EnableAsyncWorkflowConsumption decides whether to enable system workers for processing async workflows
 	// KeyName: worker.enableAsyncWorkflowConsumption
 	// Value type: Bool
@@ -1903,6 +2078,32 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableFailoverManager
+	// EnableDomainDeletion indicates if the domain deletion worker is enabled
+	// KeyName: system.enableDomainDeletion
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableDomainDeletion
+	// EnableDomainDeletionReplication indicates if the removal of a deleted global domain record is replicated to other clusters.
+	// Only enable it once every cluster runs a version that understands the domain delete operation.
+	// KeyName: system.enableDomainDeletionReplication
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableDomainDeletionReplication
+	// EnableDomainRenameReplication indicates if global domains can be renamed, which replicates the rename to other clusters.
+	// Only enable it once every cluster runs a version that understands the domain rename operation.
+	// KeyName: system.enableDomainRenameReplication
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableDomainRenameReplication
+	// EnableDomainMigration indicates if the domain migration worker is enabled
+	// KeyName: system.enableDomainMigration
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableDomainMigration
 	// ConcreteExecutionFixerDomainAllow is which domains are allowed to be fixed by concrete fixer workflow
 	// KeyName: worker.concreteExecutionFixerDomainAllow
 	// Value type: Bool
@@ -2042,6 +2243,29 @@ const (
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingEnableClientAutoConfig
 
+	// MatchingEnableTaskFairness enables dispatching the backlog tasks of the same priority round-robin across fairness keys
+	// KeyName: matching.enableTaskFairness
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableTaskFairness
+
+	// EnableTaskPriority enables reading the task priority and fairness key from workflow and activity headers
+	// and sending them to matching with the decision and activity tasks
+	// KeyName: history.enableTaskPriority
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableTaskPriority
+
+	// FrontendEnableEagerWorkflowStart enables returning the first decision task in the start workflow response
+	// when requested by the caller
+	// KeyName: frontend.enableEagerWorkflowStart
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	FrontendEnableEagerWorkflowStart
+
 	// LastBoolKey must be the last one in this const group
 	LastBoolKey
 )
@@ -2082,6 +2306,24 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	FrontendErrorInjectionRate
+	// FrontendLoadSheddingLowTierThreshold is the fraction of FrontendLoadSheddingMaxInFlightRequests above which low criticality APIs (visibility List/Scan/Count, async starts) are shed
+	// KeyName: frontend.loadSheddingLowTierThreshold
+	// Value type: Float64
+	// Default value: 0.5
+	// Allowed filters: DomainName
+	FrontendLoadSheddingLowTierThreshold
+	// FrontendLoadSheddingNormalTierThreshold is the fraction of FrontendLoadSheddingMaxInFlightRequests above which normal criticality APIs (describe, history reads, queries) are shed
+	// KeyName: frontend.loadSheddingNormalTierThreshold
+	// Value type: Float64
+	// Default value: 0.75
+	// Allowed filters: DomainName
+	FrontendLoadSheddingNormalTierThreshold
+	// FrontendLoadSheddingHighTierThreshold is the fraction of FrontendLoadSheddingMaxInFlightRequests above which high criticality APIs (start, signal, cancel, terminate) are shed
+	// KeyName: frontend.loadSheddingHighTierThreshold
+	// Value type: Float64
+	// Default value: 0.9
+	// Allowed filters: DomainName
+	FrontendLoadSheddingHighTierThreshold
 
 	// key for matching
 
@@ -2215,6 +2457,12 @@ const (
 	HistoryGlobalRatelimiterNewDataWeight
 
 	MatchingPartitionDownscaleFactor
+	// MatchingPartitionUpscaleMinSyncMatchRate is the fraction of added tasks that must be sync matched, below which the task list is upscaled
+	// KeyName: matching.partitionUpscaleMinSyncMatchRate
+	// Value type: Float64
+	// Default value: 0 (disabled)
+	// Allowed filters: DomainName, TaskListName, TaskType
+	MatchingPartitionUpscaleMinSyncMatchRate
 
 	// Key for shard distributor
 
@@ -2300,7 +2548,7 @@ const (
 	ESAnalyzerWorkflowTypeMetricDomains
 
 	// FrontendGlobalRatelimiterMode controls what keys use global vs fallback behavior,
-	// and whether shadowing is enabled.  This is only available for frontend usage for now.
+	// and whether shadowing is enabled.  This is only used by frontend request limits, see PersistenceGlobalRatelimiterMode for persistence limits.
 	//
 	//   - "disabled" stops usage-tracking and all Update requests, in an attempt to be as close to "do not use at all" as possible.
 	//   - "local" uses the new limiters with call tracking and metrics, but forces local-only behavior and does not submit usage data to aggregators.
@@ -2315,6 +2563,19 @@ const (
 	// Default value: "disabled"
 	// Allowed filters: RatelimitKey (on global key, e.g. prefixed by collection name)
 	FrontendGlobalRatelimiterMode
+	// PersistenceGlobalRatelimiterMode defines which mode a global persistence ratelimit key should be in, see FrontendGlobalRatelimiterMode for the modes
+	// KeyName: system.persistenceGlobalRatelimiterMode
+	// Value type: string enum: "disabled", "local", "global", "local-shadow-global", or "global-shadow-local"
+	// Default value: "disabled"
+	// Allowed filters: RatelimitKey
+	PersistenceGlobalRatelimiterMode
+	// MatchingGlobalDispatchRatelimiterMode defines which mode the dispatch limit of a task list should be in, see FrontendGlobalRatelimiterMode for the modes.
+	// In "disabled" mode each task list partition limits its own dispatch rate to its share of the poller-provided rate.
+	// KeyName: matching.globalDispatchRatelimiterMode
+	// Value type: string enum: "disabled", "local", "global", "local-shadow-global", or "global-shadow-local"
+	// Default value: "disabled"
+	// Allowed filters: RatelimitKey
+	MatchingGlobalDispatchRatelimiterMode
 
 	TasklistLoadBalancerStrategy
 
@@ -2339,12 +2600,30 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	FrontendShutdownDrainDuration
+	// FrontendReplicationStreamRequestTimeout is the timeout of a request served on a replication stream, it must exceed history.replicationTaskLongPollTimeout
+	// KeyName: frontend.replicationStreamRequestTimeout
+	// Value type: Duration
+	// Default value: 30s (30 * time.Second)
+	// Allowed filters: N/A
+	FrontendReplicationStreamRequestTimeout
 	// FrontendFailoverCoolDown is duration between two domain failvoers
 	// KeyName: frontend.failoverCoolDown
 	// Value type: Duration
 	// Default value: 1m (one minute, see domain.FailoverCoolDown)
 	// Allowed filters: DomainName
 	FrontendFailoverCoolDown
+	// FrontendDomainDeletionGracePeriod is the minimum duration a domain has to stay deprecated before it can be deleted
+	// KeyName: frontend.domainDeletionGracePeriod
+	// Value type: Duration
+	// Default value: 168h (7 days)
+	// Allowed filters: DomainName
+	FrontendDomainDeletionGracePeriod
+	// FrontendDomainRenameAliasDuration is how long the previous name of a renamed domain keeps resolving to the domain
+	// KeyName: frontend.domainRenameAliasDuration
+	// Value type: Duration
+	// Default value: 720h (30 days)
+	// Allowed filters: DomainName
+	FrontendDomainRenameAliasDuration
 	// DomainFailoverRefreshInterval is the domain failover refresh timer
 	// KeyName: frontend.domainFailoverRefreshInterval
 	// Value type: Duration
@@ -2399,6 +2678,18 @@ const (
 	MatchingPartitionDownscaleSustainedDuration
 	MatchingAdaptiveScalerUpdateInterval
 	MatchingQPSTrackerInterval
+	// MatchingPartitionUpscaleDispatchLatency is the average time from task creation to dispatch above which the task list is upscaled
+	// KeyName: matching.partitionUpscaleDispatchLatency
+	// Value type: Duration
+	// Default value: 0 (disabled)
+	// Allowed filters: DomainName, TaskListName, TaskType
+	MatchingPartitionUpscaleDispatchLatency
+	// MatchingPartitionScalingCooldown is the minimum time between two changes to the number of write partitions made by the adaptive scaler
+	// KeyName: matching.partitionScalingCooldown
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: DomainName, TaskListName, TaskType
+	MatchingPartitionScalingCooldown
 
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	// KeyName: history.longPollExpirationInterval
@@ -2412,6 +2703,12 @@ const (
 	// Default value: 1h (time.Hour)
 	// Allowed filters: N/A
 	HistoryCacheTTL
+	// DomainOpenWorkflowsCountCacheTTL is how long the open workflow count of a domain is cached when enforcing DomainOpenWorkflowsCountLimit
+	// KeyName: history.domainOpenWorkflowCountCacheTTL
+	// Value type: Duration
+	// Default value: 10s (10*time.Second)
+	// Allowed filters: N/A
+	DomainOpenWorkflowsCountCacheTTL
 	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
 	// KeyName: history.shutdownDrainDuration
 	// Value type: Duration
@@ -2442,6 +2739,13 @@ const (
 	// Default value: 15m (15*time.Minute)
 	// Allowed filters: N/A
 	StandbyTaskMissingEventsResendDelay
+	// TaskDLQMaxAge is the duration since the first attempt after which a permanently failing transfer/timer task
+	// can be moved to the history task DLQ, it must also have been attempted TaskDLQMaxAttempts times
+	// KeyName: history.taskDLQMaxAge
+	// Value type: Duration
+	// Default value: 1h (1*time.Hour)
+	// Allowed filters: N/A
+	TaskDLQMaxAge
 	// StandbyTaskMissingEventsDiscardDelay is the amount of time standby cluster's will wait (if events are missing)before discarding the task
 	// KeyName: history.standbyTaskMissingEventsDiscardDelay
 	// Value type: Duration
@@ -2641,6 +2945,18 @@ const (
 	// Default value: 60s (60 * time.Second)
 	// Allowed filters: N/A
 	ReplicationTaskFetcherServiceBusyWait
+	// ReplicationTaskFetcherStreamRetryWait is the wait time before reopening a broken replication stream, replication tasks are polled in the meantime
+	// KeyName: history.replicationTaskFetcherStreamRetryWait
+	// Value type: Duration
+	// Default value: 1m (1 * time.Minute)
+	// Allowed filters: N/A
+	ReplicationTaskFetcherStreamRetryWait
+	// ReplicationTaskLongPollTimeout is the max time a streamed replication request waits for new replication tasks in the source cluster
+	// KeyName: history.replicationTaskLongPollTimeout
+	// Value type: Duration
+	// Default value: 20s (20 * time.Second)
+	// Allowed filters: N/A
+	ReplicationTaskLongPollTimeout
 	// ReplicationTaskProcessorErrorRetryWait is the initial retry wait when we see errors in applying replication tasks
 	// KeyName: history.ReplicationTaskProcessorErrorRetryWait
 	// Value type: Duration
@@ -2701,6 +3017,13 @@ const (
 	// Default value: 10m (time.Minute*10)
 	// Allowed filters: N/A
 	WorkerReplicationTaskMaxRetryDuration
+	// WorkerDomainMigrationRedirectDuration is how long signals and queries of migrated workflows are still redirected
+	// to the target domain after the domain migration workflow completes
+	// KeyName: worker.domainMigrationRedirectDuration
+	// Value type: Duration
+	// Default value: 720h (30 days)
+	// Allowed filters: DomainName
+	WorkerDomainMigrationRedirectDuration
 	// ESAnalyzerTimeWindow defines the time window ElasticSearch Analyzer will consider while taking workflow averages
 	// KeyName: worker.ESAnalyzerTimeWindow
 	// Value type: Duration
@@ -2711,6 +3034,48 @@ const (
 	// Value type: Duration
 	// Default value: 30 minutes
 	ESAnalyzerBufferWaitTime
+	// IsolationGroupDrainerInterval is the interval at which isolation group health signals are evaluated
+	// KeyName: worker.isolationGroupDrainerInterval
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: N/A
+	IsolationGroupDrainerInterval
+	// IsolationGroupDrainerMinDrainInterval is the minimum time between two drains proposed by the isolation group drainer
+	// KeyName: worker.isolationGroupDrainerMinDrainInterval
+	// Value type: Duration
+	// Default value: 30m
+	// Allowed filters: N/A
+	IsolationGroupDrainerMinDrainInterval
+	// AutoFailoverMonitorInterval is the interval at which the auto failover monitor evaluates the health of the remote clusters
+	// KeyName: worker.autoFailoverMonitorInterval
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: N/A
+	AutoFailoverMonitorInterval
+	// AutoFailoverUnhealthyDuration is how long a cluster must stay unhealthy before the domains active in it are failed over
+	// KeyName: worker.autoFailoverUnhealthyDuration
+	// Value type: Duration
+	// Default value: 5m
+	// Allowed filters: N/A
+	AutoFailoverUnhealthyDuration
+	// AutoFailoverCooldown is the minimum time between the last failover of a domain and its automatic failover
+	// KeyName: worker.autoFailoverCooldown
+	// Value type: Duration
+	// Default value: 1h
+	// Allowed filters: N/A
+	AutoFailoverCooldown
+	// AutoFailoverGracefulFailoverTimeout is the graceful failover timeout used by automatic failovers
+	// KeyName: worker.autoFailoverGracefulFailoverTimeout
+	// Value type: Duration
+	// Default value: 5m
+	// Allowed filters: N/A
+	AutoFailoverGracefulFailoverTimeout
+	// AutoFailoverMaxReplicationLag is the age of the oldest replication task not yet received from a cluster above which it is considered unhealthy
+	// KeyName: worker.autoFailoverMaxReplicationLag
+	// Value type: Duration
+	// Default value: 10m
+	// Allowed filters: N/A
+	AutoFailoverMaxReplicationLag
 	// IsolationGroupStateRefreshInterval
 	// KeyName: system.isolationGroupStateRefreshInterval
 	// Value type: Duration
@@ -2836,6 +3201,12 @@ const (
 	// Default value: N/A
 	// Allowed filters: N/A
 	AllIsolationGroups
+	// IsolationGroupDrainerMonitoredTaskLists is the list of decision task lists, in the form of domain/tasklist, whose per isolation group poller counts are used as a liveness signal
+	// KeyName: worker.isolationGroupDrainerMonitoredTaskLists
+	// Value type: []string
+	// Default value: N/A
+	// Allowed filters: N/A
+	IsolationGroupDrainerMonitoredTaskLists
 
 	// HeaderForwardingRules defines which headers are forwarded from inbound calls to outbound.
 	// This value is only loaded at startup.
@@ -2948,6 +3319,24 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "PendingActivitiesCountLimitWarn is the limit of how many activities a workflow can have before a warning is logged",
 		DefaultValue: 512,
 	},
+	DomainOpenWorkflowsCountLimit: {
+		KeyName:      "limit.domainOpenWorkflowCount",
+		Filters:      []Filter{DomainName},
+		Description:  "DomainOpenWorkflowsCountLimit is the limit of how many open workflows a domain can have. 0 means unlimited. Child workflows are not rejected by the limit. It requires advanced visibility and is not enforced with DB visibility",
+		DefaultValue: 0,
+	},
+	DomainPendingActivitiesCountLimit: {
+		KeyName:      "limit.domainPendingActivityCount",
+		Filters:      []Filter{DomainName},
+		Description:  "DomainPendingActivitiesCountLimit is the limit of how many pending activities a workflow in the domain can have. 0 means unlimited",
+		DefaultValue: 0,
+	},
+	DomainPendingChildWorkflowsCountLimit: {
+		KeyName:      "limit.domainPendingChildWorkflowCount",
+		Filters:      []Filter{DomainName},
+		Description:  "DomainPendingChildWorkflowsCountLimit is the limit of how many pending child workflows a workflow in the domain can have. 0 means unlimited",
+		DefaultValue: 0,
+	},
 	DomainNameMaxLength: {
 		KeyName:      "limit.domainNameLength",
 		Filters:      []Filter{DomainName},
@@ -3126,6 +3515,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "FrontendGlobalDomainAsyncRPS is the per-domain async workflow request rate limit per second",
 		DefaultValue: 100000,
 	},
+	FrontendLoadSheddingMaxInFlightRequests: {
+		KeyName:      "frontend.loadSheddingMaxInFlightRequests",
+		Description:  "FrontendLoadSheddingMaxInFlightRequests is the number of in-flight requests a frontend host can serve before load shedding starts rejecting lower criticality tiers",
+		DefaultValue: 2000,
+	},
 	FrontendDecisionResultCountLimit: {
 		KeyName:      "frontend.decisionResultCountLimit",
 		Filters:      []Filter{DomainName},
@@ -3177,6 +3571,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "FrontendFailoverHistoryMaxSize is the maximum size for the number of failover event records in a domain failover history",
 		DefaultValue: 5,
 	},
+	FrontendReplicationTaskMaxBatchSize: {
+		KeyName:      "frontend.replicationTaskMaxBatchSize",
+		Description:  "FrontendReplicationTaskMaxBatchSize is the max number of consecutive replication tasks of a workflow sent in one batch to a remote cluster",
+		DefaultValue: 100,
+	},
 	MatchingUserRPS: {
 		KeyName:      "matching.rps",
 		Description:  "MatchingUserRPS is request rate per second for each matching host",
@@ -3219,6 +3618,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer",
 		DefaultValue: 1000,
 	},
+	MatchingTaskPriorityLevels: {
+		KeyName:      "matching.taskPriorityLevels",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingTaskPriorityLevels is the number of task priorities of a task list, every priority keeps its backlog in a separate persisted task list so that the backlog of a higher priority is read first. It is read when the task list is loaded, lowering it leaves the backlog of the removed priorities in persistence until it is raised again",
+		DefaultValue: 1,
+	},
 	MatchingOutstandingTaskAppendsThreshold: {
 		KeyName:      "matching.outstandingTaskAppendsThreshold",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -3289,6 +3694,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingPartitionUpscaleRPS is the threshold of adding tasks RPS per partition to trigger upscale",
 		DefaultValue: 200,
 	},
+	MatchingPartitionUpscaleBacklogPerPartition: {
+		KeyName:      "matching.partitionUpscaleBacklogPerPartition",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionUpscaleBacklogPerPartition is the backlog per write partition above which a task list with a growing backlog is upscaled",
+		DefaultValue: 0,
+	},
+	MatchingPartitionUpscaleMaxPartitions: {
+		KeyName:      "matching.partitionUpscaleMaxPartitions",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionUpscaleMaxPartitions is the max number of write partitions the adaptive scaler adds because of backlog, sync match rate or dispatch latency. QPS based upscaling is not capped",
+		DefaultValue: 10,
+	},
 	HistoryRPS: {
 		KeyName:      "history.rps",
 		Description:  "HistoryRPS is request rate per second for each history host",
@@ -3397,11 +3814,26 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TaskCriticalRetryCount is the critical retry count for background tasks, when task attempt exceeds this threshold:- task attempt metrics and additional error logs will be emitted- task priority will be lowered",
 		DefaultValue: 50,
 	},
+	TaskDLQMaxAttempts: {
+		KeyName:      "history.taskDLQMaxAttempts",
+		Description:  "TaskDLQMaxAttempts is the number of attempts after which a permanently failing transfer/timer task can be moved to the history task DLQ, it must also have been failing for TaskDLQMaxAge",
+		DefaultValue: 100,
+	},
 	QueueProcessorSplitMaxLevel: {
 		KeyName:      "history.queueProcessorSplitMaxLevel",
 		Description:  "QueueProcessorSplitMaxLevel is the max processing queue level",
 		DefaultValue: 2, // 3 levels, start from 0
 	},
+	QueueProcessorDomainIsolationHashBuckets: {
+		KeyName:      "history.queueProcessorDomainIsolationHashBuckets",
+		Description:  "QueueProcessorDomainIsolationHashBuckets is the number of processing queues isolated domains are hashed into, 0 gives every isolated domain its own queue",
+		DefaultValue: 0,
+	},
+	QueueProcessorIsolatedQueueMaxPollRPS: {
+		KeyName:      "history.queueProcessorIsolatedQueueMaxPollRPS",
+		Description:  "QueueProcessorIsolatedQueueMaxPollRPS is the max poll rate of each isolated domain processing queue",
+		DefaultValue: 20,
+	},
 	TimerTaskBatchSize: {
 		KeyName:      "history.timerTaskBatchSize",
 		Description:  "TimerTaskBatchSize is batch size for timer processor to process tasks",
@@ -3683,6 +4115,16 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner",
 		DefaultValue: 5,
 	},
+	WorkerDomainDeletionRPS: {
+		KeyName:      "worker.domainDeletionRPS",
+		Description:  "WorkerDomainDeletionRPS is the rate limit on number of workflow executions and task lists purged per second by the domain deletion workflow",
+		DefaultValue: 10,
+	},
+	WorkerDomainMigrationRPS: {
+		KeyName:      "worker.domainMigrationRPS",
+		Description:  "WorkerDomainMigrationRPS is the rate limit on number of workflow executions moved per second by the domain migration workflow",
+		DefaultValue: 10,
+	},
 	ScannerGetOrphanTasksPageSize: {
 		KeyName:      "worker.scannerGetOrphanTasksPageSize",
 		Description:  "ScannerGetOrphanTasksPageSize is the maximum number of orphans to delete in one batch",
@@ -3788,6 +4230,16 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "ESAnalyzerMinNumWorkflowsForAvg controls how many workflows to have at least to rely on workflow run time avg per type",
 		DefaultValue: 100,
 	},
+	IsolationGroupDrainerMaxDrainedGroups: {
+		KeyName:      "worker.isolationGroupDrainerMaxDrainedGroups",
+		Description:  "IsolationGroupDrainerMaxDrainedGroups is the maximum number of isolation groups that may be drained at the same time, counting drains that were applied manually",
+		DefaultValue: 1,
+	},
+	IsolationGroupDrainerUnhealthyEvaluations: {
+		KeyName:      "worker.isolationGroupDrainerUnhealthyEvaluations",
+		Description:  "IsolationGroupDrainerUnhealthyEvaluations is the number of consecutive evaluations an isolation group must be unhealthy for before a drain is proposed",
+		DefaultValue: 3,
+	},
 	ShardManagerPersistenceMaxQPS: {
 		KeyName:      "shardManager.persistenceMaxQPS",
 		Description:  "ShardManagerPersistenceMaxQPS is the max qps shard manager host can query DB",
@@ -3848,6 +4300,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "This is the number of seconds allowed for a deleteHistoryEvent task to the database",
 		DefaultValue: 30,
 	},
+	PersistenceGlobalOperationMaxQPS: {
+		KeyName:      "system.persistenceGlobalOperationMaxQPS",
+		Filters:      []Filter{RatelimitKey},
+		Description:  "PersistenceGlobalOperationMaxQPS is the cluster-wide QPS limit of one persistence operation of one domain, shared by all hosts of a service through the global ratelimiter",
+		DefaultValue: UnlimitedRPS,
+	},
 }
 
 var BoolKeys = map[BoolKey]DynamicBool{
@@ -3963,6 +4421,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableClientVersionCheck is enables client version check for frontend",
 		DefaultValue: false,
 	},
+	FrontendEnableLoadShedding: {
+		KeyName:      "frontend.enableLoadShedding",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendEnableLoadShedding enables priority-aware load shedding, which rejects less critical APIs first when the frontend host is overloaded",
+		DefaultValue: false,
+	},
 	SendRawWorkflowHistory: {
 		KeyName:      "frontend.sendRawWorkflowHistory",
 		Filters:      []Filter{DomainName},
@@ -3975,6 +4439,16 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "FrontendEmitSignalNameMetricsTag enables emitting signal name tag in metrics in frontend client",
 		DefaultValue: false,
 	},
+	FrontendEnableReplicationTaskBatching: {
+		KeyName:      "frontend.enableReplicationTaskBatching",
+		Description:  "FrontendEnableReplicationTaskBatching enables batching consecutive replication tasks of a workflow for remote clusters supporting it",
+		DefaultValue: false,
+	},
+	FrontendEnableReplicationTaskCompression: {
+		KeyName:      "frontend.enableReplicationTaskCompression",
+		Description:  "FrontendEnableReplicationTaskCompression enables zstd compression of replication tasks for remote clusters supporting it",
+		DefaultValue: false,
+	},
 	EnableQueryAttributeValidation: {
 		KeyName:      "frontend.enableQueryAttributeValidation",
 		Description:  "EnableQueryAttributeValidation enables validation of queries' search attributes against the dynamic config whitelist",
@@ -4015,6 +4489,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnableAdaptiveScaler is to enable adaptive task list scaling",
 		DefaultValue: false,
 	},
+	MatchingEnablePartitionIsolationGroupAssignment: {
+		KeyName:      "matching.enablePartitionIsolationGroupAssignment",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnablePartitionIsolationGroupAssignment is to enable assigning isolation groups to task list partitions based on the isolation groups of their pollers. It requires the adaptive scaler and task list isolation to be enabled",
+		DefaultValue: false,
+	},
 	EventsCacheGlobalEnable: {
 		KeyName:      "history.eventsCacheGlobalEnable",
 		Description:  "EventsCacheGlobalEnable is enables global cache over all history shards",
@@ -4025,6 +4505,16 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "QueueProcessorEnableSplit indicates whether processing queue split policy should be enabled",
 		DefaultValue: false,
 	},
+	QueueProcessorEnableDomainIsolation: {
+		KeyName:      "history.queueProcessorEnableDomainIsolation",
+		Description:  "QueueProcessorEnableDomainIsolation indicates whether domains can be isolated into their own processing queues",
+		DefaultValue: false,
+	},
+	QueueProcessorEnableDomainIsolationForAllDomains: {
+		KeyName:      "history.queueProcessorEnableDomainIsolationForAllDomains",
+		Description:  "QueueProcessorEnableDomainIsolationForAllDomains indicates whether every domain should be isolated, hashed into history.queueProcessorDomainIsolationHashBuckets processing queues",
+		DefaultValue: false,
+	},
 	QueueProcessorEnableRandomSplitByDomainID: {
 		KeyName:      "history.queueProcessorEnableRandomSplitByDomainID",
 		Filters:      []Filter{DomainID},
@@ -4043,6 +4533,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "QueueProcessorEnableStuckTaskSplitByDomainID indicates whether stuck task split policy should be enabled",
 		DefaultValue: false,
 	},
+	QueueProcessorEnableDomainIsolationByDomainID: {
+		KeyName:      "history.queueProcessorEnableDomainIsolationByDomainID",
+		Filters:      []Filter{DomainID},
+		Description:  "QueueProcessorEnableDomainIsolationByDomainID indicates whether the domain should always be processed in its own processing queue",
+		DefaultValue: false,
+	},
 	QueueProcessorEnablePersistQueueStates: {
 		KeyName:      "history.queueProcessorEnablePersistQueueStates",
 		Description:  "QueueProcessorEnablePersistQueueStates indicates whether processing queue states should be persisted",
@@ -4080,6 +4576,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableDropStuckTaskByDomainID is whether stuck timer/transfer task should be dropped for a domain",
 		DefaultValue: false,
 	},
+	EnableTaskDLQByDomainID: {
+		KeyName:      "history.enableTaskDLQByDomain",
+		Filters:      []Filter{DomainID},
+		Description:  "EnableTaskDLQByDomainID is whether permanently failing timer/transfer tasks of a domain are moved to the history task DLQ",
+		DefaultValue: false,
+	},
 	EnableConsistentQuery: {
 		KeyName:      "history.EnableConsistentQuery",
 		Description:  "EnableConsistentQuery indicates if consistent query is enabled for the cluster",
@@ -4127,6 +4629,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableReplicationTaskGeneration is the flag to control replication generation",
 		DefaultValue: true,
 	},
+	ReplicationTaskFetcherEnableStreaming: {
+		KeyName:      "history.replicationTaskFetcherEnableStreaming",
+		Description:  "ReplicationTaskFetcherEnableStreaming enables fetching replication tasks through a stream pushing them as soon as they are written in the source cluster",
+		DefaultValue: false,
+	},
 	UseNewInitialFailoverVersion: {
 		KeyName:      "history.useNewInitialFailoverVersion",
 		Description:  "use the minInitialFailover version",
@@ -4217,6 +4724,21 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableESAnalyzer decides whether to enable system workers for processing ElasticSearch Analyzer",
 		DefaultValue: false,
 	},
+	EnableIsolationGroupDrainer: {
+		KeyName:      "worker.enableIsolationGroupDrainer",
+		Description:  "EnableIsolationGroupDrainer decides whether to start the controller that proposes isolation-group drains based on health signals",
+		DefaultValue: false,
+	},
+	IsolationGroupDrainerAutoApply: {
+		KeyName:      "worker.isolationGroupDrainerAutoApply",
+		Description:  "IsolationGroupDrainerAutoApply decides whether proposed isolation-group drains are applied automatically. When false drains are only logged and recorded",
+		DefaultValue: false,
+	},
+	EnableAutoFailoverMonitor: {
+		KeyName:      "worker.enableAutoFailoverMonitor",
+		Description:  "EnableAutoFailoverMonitor decides whether to start the monitor that fails over opted in domains to the current cluster when their active cluster stays unhealthy",
+		DefaultValue: false,
+	},
 	EnableAsyncWorkflowConsumption: {
 		KeyName:      "worker.enableAsyncWorkflowConsumption",
 		Description:  "EnableAsyncWorkflowConsumption decides whether to enable async workflows",
@@ -4233,6 +4755,26 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableFailoverManager indicates if failover manager is enabled",
 		DefaultValue: true,
 	},
+	EnableDomainDeletion: {
+		KeyName:      "system.enableDomainDeletion",
+		Description:  "EnableDomainDeletion indicates if the domain deletion worker is enabled",
+		DefaultValue: true,
+	},
+	EnableDomainDeletionReplication: {
+		KeyName:      "system.enableDomainDeletionReplication",
+		Description:  "EnableDomainDeletionReplication indicates if the removal of a deleted global domain record is replicated to other clusters. Only enable it once every cluster runs a version that understands the domain delete operation",
+		DefaultValue: false,
+	},
+	EnableDomainRenameReplication: {
+		KeyName:      "system.enableDomainRenameReplication",
+		Description:  "EnableDomainRenameReplication indicates if global domains can be renamed, which replicates the rename to other clusters. Only enable it once every cluster runs a version that understands the domain rename operation",
+		DefaultValue: false,
+	},
+	EnableDomainMigration: {
+		KeyName:      "system.enableDomainMigration",
+		Description:  "EnableDomainMigration indicates if the domain migration worker is enabled",
+		DefaultValue: true,
+	},
 	ConcreteExecutionFixerDomainAllow: {
 		KeyName:      "worker.concreteExecutionFixerDomainAllow",
 		Filters:      []Filter{DomainName},
@@ -4361,6 +4903,24 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnableClientAutoConfig is to enable auto config on worker side",
 		DefaultValue: false,
 	},
+	MatchingEnableTaskFairness: {
+		KeyName:      "matching.enableTaskFairness",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableTaskFairness enables dispatching the backlog tasks of the same priority buffered in memory round-robin across fairness keys. Sync-matched and forwarded tasks are not reordered",
+		DefaultValue: false,
+	},
+	EnableTaskPriority: {
+		KeyName:      "history.enableTaskPriority",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableTaskPriority enables reading the task priority and fairness key from workflow and activity headers and sending them to matching with the decision and activity tasks",
+		DefaultValue: false,
+	},
+	FrontendEnableEagerWorkflowStart: {
+		KeyName:      "frontend.enableEagerWorkflowStart",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendEnableEagerWorkflowStart enables returning the first decision task in the start workflow response when requested by the caller",
+		DefaultValue: false,
+	},
 }
 
 var FloatKeys = map[FloatKey]DynamicFloat{
@@ -4395,6 +4955,24 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Description:  "FrontendErrorInjectionRate is rate for injecting random error in frontend client",
 		DefaultValue: 0,
 	},
+	FrontendLoadSheddingLowTierThreshold: {
+		KeyName:      "frontend.loadSheddingLowTierThreshold",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendLoadSheddingLowTierThreshold is the fraction of FrontendLoadSheddingMaxInFlightRequests above which low criticality APIs (visibility List/Scan/Count, async starts) are shed",
+		DefaultValue: 0.5,
+	},
+	FrontendLoadSheddingNormalTierThreshold: {
+		KeyName:      "frontend.loadSheddingNormalTierThreshold",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendLoadSheddingNormalTierThreshold is the fraction of FrontendLoadSheddingMaxInFlightRequests above which normal criticality APIs (describe, history reads, queries) are shed",
+		DefaultValue: 0.75,
+	},
+	FrontendLoadSheddingHighTierThreshold: {
+		KeyName:      "frontend.loadSheddingHighTierThreshold",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendLoadSheddingHighTierThreshold is the fraction of FrontendLoadSheddingMaxInFlightRequests above which high criticality APIs (start, signal, cancel, terminate) are shed",
+		DefaultValue: 0.9,
+	},
 	MatchingErrorInjectionRate: {
 		KeyName:      "matching.errorInjectionRate",
 		Description:  "MatchingErrorInjectionRate is rate for injecting random error in matching client",
@@ -4513,6 +5091,12 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
 		DefaultValue: 0.75,
 	},
+	MatchingPartitionUpscaleMinSyncMatchRate: {
+		KeyName:      "matching.partitionUpscaleMinSyncMatchRate",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionUpscaleMinSyncMatchRate is the fraction of added tasks that must be sync matched, below which the task list is upscaled",
+		DefaultValue: 0,
+	},
 	ShardDistributorErrorInjectionRate: {
 		KeyName:      "sharddistributor.errorInjectionRate",
 		Description:  "ShardDistributorInjectionRate is rate for injecting random error in shard distributor client",
@@ -4583,6 +5167,18 @@ var StringKeys = map[StringKey]DynamicString{
 		DefaultValue: "disabled",
 		Filters:      []Filter{RatelimitKey},
 	},
+	PersistenceGlobalRatelimiterMode: {
+		KeyName:      "system.persistenceGlobalRatelimiterMode",
+		Filters:      []Filter{RatelimitKey},
+		Description:  "PersistenceGlobalRatelimiterMode defines which mode a global persistence ratelimit key should be in, see FrontendGlobalRatelimiterMode for the modes",
+		DefaultValue: "disabled",
+	},
+	MatchingGlobalDispatchRatelimiterMode: {
+		KeyName:      "matching.globalDispatchRatelimiterMode",
+		Filters:      []Filter{RatelimitKey},
+		Description:  "MatchingGlobalDispatchRatelimiterMode defines which mode the dispatch limit of a task list should be in, see FrontendGlobalRatelimiterMode for the modes",
+		DefaultValue: "disabled",
+	},
 	TasklistLoadBalancerStrategy: {
 		KeyName:      "system.tasklistLoadBalancerStrategy",
 		Description:  "TasklistLoadBalancerStrategy is the key for tasklist load balancer strategy",
@@ -4636,12 +5232,29 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "FrontendShutdownDrainDuration is the duration of traffic drain during shutdown",
 		DefaultValue: 0,
 	},
+	FrontendReplicationStreamRequestTimeout: {
+		KeyName:      "frontend.replicationStreamRequestTimeout",
+		Description:  "FrontendReplicationStreamRequestTimeout is the timeout of a request served on a replication stream, it must exceed history.replicationTaskLongPollTimeout",
+		DefaultValue: time.Second * 30,
+	},
 	FrontendFailoverCoolDown: {
 		KeyName:      "frontend.failoverCoolDown",
 		Filters:      []Filter{DomainName},
 		Description:  "FrontendFailoverCoolDown is duration between two domain failvoers",
 		DefaultValue: time.Minute,
 	},
+	FrontendDomainDeletionGracePeriod: {
+		KeyName:      "frontend.domainDeletionGracePeriod",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendDomainDeletionGracePeriod is the minimum duration a domain has to stay deprecated before it can be deleted",
+		DefaultValue: 7 * 24 * time.Hour,
+	},
+	FrontendDomainRenameAliasDuration: {
+		KeyName:      "frontend.domainRenameAliasDuration",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendDomainRenameAliasDuration is how long the previous name of a renamed domain keeps resolving to the domain",
+		DefaultValue: 30 * 24 * time.Hour,
+	},
 	DomainFailoverRefreshInterval: {
 		KeyName:      "frontend.domainFailoverRefreshInterval",
 		Description:  "DomainFailoverRefreshInterval is the domain failover refresh timer",
@@ -4711,6 +5324,18 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "MatchingQPSTrackerInterval is the interval for qps tracker's loop. Changes are not reflected until service restart",
 		DefaultValue: time.Second * 10,
 	},
+	MatchingPartitionUpscaleDispatchLatency: {
+		KeyName:      "matching.partitionUpscaleDispatchLatency",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionUpscaleDispatchLatency is the average time from task creation to dispatch above which the task list is upscaled",
+		DefaultValue: 0,
+	},
+	MatchingPartitionScalingCooldown: {
+		KeyName:      "matching.partitionScalingCooldown",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionScalingCooldown is the minimum time between two changes to the number of write partitions made by the adaptive scaler",
+		DefaultValue: time.Minute,
+	},
 	HistoryLongPollExpirationInterval: {
 		KeyName:      "history.longPollExpirationInterval",
 		Filters:      []Filter{DomainName},
@@ -4722,6 +5347,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "HistoryCacheTTL is TTL of history cache",
 		DefaultValue: time.Hour,
 	},
+	DomainOpenWorkflowsCountCacheTTL: {
+		KeyName:      "history.domainOpenWorkflowCountCacheTTL",
+		Description:  "DomainOpenWorkflowsCountCacheTTL is how long the open workflow count of a domain is cached when enforcing DomainOpenWorkflowsCountLimit",
+		DefaultValue: time.Second * 10,
+	},
 	HistoryShutdownDrainDuration: {
 		KeyName:      "history.shutdownDrainDuration",
 		Description:  "HistoryShutdownDrainDuration is the duration of traffic drain during shutdown",
@@ -4747,6 +5377,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)before calling remote for missing events",
 		DefaultValue: time.Minute * 15,
 	},
+	TaskDLQMaxAge: {
+		KeyName:      "history.taskDLQMaxAge",
+		Description:  "TaskDLQMaxAge is the duration since the first attempt after which a permanently failing transfer/timer task can be moved to the history task DLQ, it must also have been attempted TaskDLQMaxAttempts times",
+		DefaultValue: time.Hour,
+	},
 	StandbyTaskMissingEventsDiscardDelay: {
 		KeyName:      "history.standbyTaskMissingEventsDiscardDelay",
 		Description:  "StandbyTaskMissingEventsDiscardDelay is the amount of time standby cluster's will wait (if events are missing)before discarding the task",
@@ -4914,6 +5549,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ReplicationTaskFetcherServiceBusyWait is the wait time when fetcher encounters service busy error",
 		DefaultValue: time.Minute,
 	},
+	ReplicationTaskFetcherStreamRetryWait: {
+		KeyName:      "history.replicationTaskFetcherStreamRetryWait",
+		Description:  "ReplicationTaskFetcherStreamRetryWait is the wait time before reopening a broken replication stream, replication tasks are polled in the meantime",
+		DefaultValue: time.Minute,
+	},
+	ReplicationTaskLongPollTimeout: {
+		KeyName:      "history.replicationTaskLongPollTimeout",
+		Description:  "ReplicationTaskLongPollTimeout is the max time a streamed replication request waits for new replication tasks in the source cluster",
+		DefaultValue: time.Second * 20,
+	},
 	ReplicationTaskProcessorErrorRetryWait: {
 		KeyName:      "history.ReplicationTaskProcessorErrorRetryWait",
 		Filters:      []Filter{ShardID},
@@ -4971,6 +5616,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "WorkerReplicationTaskMaxRetryDuration is the max retry duration for any task",
 		DefaultValue: time.Minute * 10,
 	},
+	WorkerDomainMigrationRedirectDuration: {
+		KeyName:      "worker.domainMigrationRedirectDuration",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkerDomainMigrationRedirectDuration is how long signals and queries of migrated workflows are still redirected to the target domain after the domain migration workflow completes",
+		DefaultValue: 30 * 24 * time.Hour,
+	},
 	ESAnalyzerTimeWindow: {
 		KeyName:      "worker.ESAnalyzerTimeWindow",
 		Description:  "ESAnalyzerTimeWindow defines the time window ElasticSearch Analyzer will consider while taking workflow averages",
@@ -4996,6 +5647,41 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ESAnalyzerBufferWaitTime controls min time required to consider a worklow stuck",
 		DefaultValue: time.Minute * 30,
 	},
+	IsolationGroupDrainerInterval: {
+		KeyName:      "worker.isolationGroupDrainerInterval",
+		Description:  "IsolationGroupDrainerInterval is the interval at which isolation group health signals are evaluated",
+		DefaultValue: time.Minute,
+	},
+	IsolationGroupDrainerMinDrainInterval: {
+		KeyName:      "worker.isolationGroupDrainerMinDrainInterval",
+		Description:  "IsolationGroupDrainerMinDrainInterval is the minimum time between two drains proposed by the isolation group drainer",
+		DefaultValue: time.Minute * 30,
+	},
+	AutoFailoverMonitorInterval: {
+		KeyName:      "worker.autoFailoverMonitorInterval",
+		Description:  "AutoFailoverMonitorInterval is the interval at which the auto failover monitor evaluates the health of the remote clusters",
+		DefaultValue: time.Minute,
+	},
+	AutoFailoverUnhealthyDuration: {
+		KeyName:      "worker.autoFailoverUnhealthyDuration",
+		Description:  "AutoFailoverUnhealthyDuration is how long a cluster must stay unhealthy before the domains active in it are failed over",
+		DefaultValue: time.Minute * 5,
+	},
+	AutoFailoverCooldown: {
+		KeyName:      "worker.autoFailoverCooldown",
+		Description:  "AutoFailoverCooldown is the minimum time between the last failover of a domain and its automatic failover",
+		DefaultValue: time.Hour,
+	},
+	AutoFailoverGracefulFailoverTimeout: {
+		KeyName:      "worker.autoFailoverGracefulFailoverTimeout",
+		Description:  "AutoFailoverGracefulFailoverTimeout is the graceful failover timeout used by automatic failovers",
+		DefaultValue: time.Minute * 5,
+	},
+	AutoFailoverMaxReplicationLag: {
+		KeyName:      "worker.autoFailoverMaxReplicationLag",
+		Description:  "AutoFailoverMaxReplicationLag is the age of the oldest replication task not yet received from a cluster above which it is considered unhealthy",
+		DefaultValue: time.Minute * 10,
+	},
 	AsyncTaskDispatchTimeout: {
 		KeyName:      "matching.asyncTaskDispatchTimeout",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -5076,6 +5762,10 @@ var ListKeys = map[ListKey]DynamicList{
 		KeyName:     "system.allIsolationGroups",
 		Description: "A list of all the isolation groups in a system",
 	},
+	IsolationGroupDrainerMonitoredTaskLists: {
+		KeyName:     "worker.isolationGroupDrainerMonitoredTaskLists",
+		Description: "IsolationGroupDrainerMonitoredTaskLists is the list of decision task lists, in the form of domain/tasklist, whose per isolation group poller counts are used as a liveness signal",
+	},
 	DefaultIsolationGroupConfigStoreManagerGlobalMapping: {
 		KeyName: "system.defaultIsolationGroupConfigStoreManagerGlobalMapping",
 		Description: "A configuration store for global isolation groups - used in isolation-group config only, not normal dynamic config." +
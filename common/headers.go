@@ -54,4 +54,7 @@ const (
 
 	// ClientIsolationGroupHeaderName refers to the name of the header that contains the isolation group which the client request is from
 	ClientIsolationGroupHeaderName = "cadence-client-isolation-group"
+	// ReplicationCapabilitiesHeaderName refers to the name of the header that contains the comma separated
+	// replication task encodings the cluster fetching replication messages is able to receive
+	ReplicationCapabilitiesHeaderName = "cadence-replication-capabilities"
 )
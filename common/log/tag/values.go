@@ -140,6 +140,10 @@ var (
 	ComponentMapQTreeNode               = component("mapq-tree-node")
 	ComponentRPCFactory                 = component("rpc-factory")
 	ComponentTaskListAdaptiveScaler     = component("task-list-adaptive-scaler")
+	ComponentIsolationGroupDrainer      = component("isolation-group-drainer")
+	ComponentAutoFailoverMonitor        = component("auto-failover-monitor")
+	ComponentDomainDeletion             = component("domain-deletion")
+	ComponentDomainMigration            = component("domain-migration")
 )
 
 // Predefined values for QueueTypes
@@ -243,6 +247,7 @@ var (
 	StoreOperationCreateDomain       = storeOperation("create-domain")
 	StoreOperationGetDomain          = storeOperation("get-domain")
 	StoreOperationUpdateDomain       = storeOperation("update-domain")
+	StoreOperationRenameDomain       = storeOperation("rename-domain")
 	StoreOperationDeleteDomain       = storeOperation("delete-domain")
 	StoreOperationDeleteDomainByName = storeOperation("delete-domain-by-name")
 	StoreOperationListDomains        = storeOperation("list-domains")
@@ -331,6 +336,18 @@ var (
 	AdminDeleteWorkflow                                       = clientOperation("admin-delete-workflow")
 	MaintainCorruptWorkflow                                   = clientOperation("maintain-corrupt-workflow")
 	AdminClientOperationUpdateTaskListPartitionConfig         = clientOperation("admin-update-task-list-partition-config")
+	AdminClientOperationUpdateActivityOptions                 = clientOperation("admin-update-activity-options")
+	AdminClientOperationListAuditLogEntries                   = clientOperation("admin-list-audit-log-entries")
+	AdminClientOperationReadHistoryTaskDLQMessages            = clientOperation("admin-read-history-task-dlq-messages")
+	AdminClientOperationDescribeHistoryTaskDLQMessage         = clientOperation("admin-describe-history-task-dlq-message")
+	AdminClientOperationMergeHistoryTaskDLQMessages           = clientOperation("admin-merge-history-task-dlq-messages")
+	AdminClientOperationPurgeHistoryTaskDLQMessages           = clientOperation("admin-purge-history-task-dlq-messages")
+	AdminClientOperationDeleteDomain                          = clientOperation("admin-delete-domain")
+	AdminClientOperationGetReplicationStatus                  = clientOperation("admin-get-replication-status")
+	AdminClientOperationRenameDomain                          = clientOperation("admin-rename-domain")
+	AdminClientOperationDescribeWorkflowVersionHistories      = clientOperation("admin-describe-workflow-version-histories")
+	AdminClientOperationRebuildWorkflowBranch                 = clientOperation("admin-rebuild-workflow-branch")
+	AdminClientOperationDescribeTaskList                      = clientOperation("admin-describe-task-list")
 
 	FrontendClientOperationDeprecateDomain                       = clientOperation("frontend-deprecate-domain")
 	FrontendClientOperationDescribeDomain                        = clientOperation("frontend-describe-domain")
@@ -412,6 +429,8 @@ var (
 	HistoryClientOperationQueryWorkflow                     = clientOperation("history-query-wf")
 	HistoryClientOperationReapplyEvents                     = clientOperation("history-reapply-events")
 	HistoryClientOperationCountDLQMessages                  = clientOperation("history-count-dlq-messages")
+	HistoryClientOperationGetReplicationStatus              = clientOperation("history-get-replication-status")
+	HistoryClientOperationRebuildWorkflowBranch             = clientOperation("history-rebuild-workflow-branch")
 	HistoryClientOperationReadDLQMessages                   = clientOperation("history-read-dlq-messages")
 	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
 	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
@@ -426,6 +445,7 @@ var (
 	HistoryClientOperationRespondDecisionTaskCompleted      = clientOperation("history-respond-decision-task-completed")
 	HistoryClientOperationRespondDecisionTaskFailed         = clientOperation("history-respond-decision-task-failed")
 	HistoryClientOperationRatelimitUpdate                   = clientOperation("history-ratelimit-update")
+	HistoryClientOperationUpdateActivityOptions             = clientOperation("history-update-activity-options")
 
 	MatchingClientOperationAddActivityTask                = clientOperation("matching-add-activity-task")
 	MatchingClientOperationAddDecisionTask                = clientOperation("matching-add-decision-task")
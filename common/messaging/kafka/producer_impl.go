@@ -22,6 +22,7 @@ package kafka
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/Shopify/sarama"
@@ -32,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -130,6 +132,17 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
+	case *types.AuditLogEntry:
+		payload, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		msg := &sarama.ProducerMessage{
+			Topic: p.topic,
+			Key:   sarama.StringEncoder(message.GetDomain()),
+			Value: sarama.ByteEncoder(payload),
+		}
+		return msg, nil
 	default:
 		return nil, errors.New("unknown producer message type")
 	}
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/.gen/go/indexer"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/types"
 )
 
 func TestNewKafkaProducer(t *testing.T) {
@@ -70,6 +71,16 @@ func TestPublish(t *testing.T) {
 			},
 			hasErr: false,
 		},
+		{
+			name: "Publish audit log entry succeeded",
+			message: &types.AuditLogEntry{
+				Actor:   "test-actor",
+				APIName: "TerminateWorkflowExecution",
+				Domain:  "test-domain",
+				Outcome: types.AuditLogOutcomeSuccess,
+			},
+			hasErr: false,
+		},
 		{
 			name:    "Unrecognized message type",
 			message: "This is not a recognized message type",
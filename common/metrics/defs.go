@@ -235,6 +235,8 @@ const (
 	PersistenceGetDomainScope
 	// PersistenceUpdateDomainScope tracks UpdateDomain calls made by service to persistence layer
 	PersistenceUpdateDomainScope
+	// PersistenceRenameDomainScope tracks RenameDomain calls made by service to persistence layer
+	PersistenceRenameDomainScope
 	// PersistenceDeleteDomainScope tracks DeleteDomain calls made by service to persistence layer
 	PersistenceDeleteDomainScope
 	// PersistenceDeleteDomainByNameScope tracks DeleteDomainByName calls made by service to persistence layer
@@ -382,6 +384,10 @@ const (
 	HistoryClientReapplyEventsScope
 	// HistoryClientCountDLQMessagesScope tracks RPC calls to history service
 	HistoryClientCountDLQMessagesScope
+	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
+	HistoryClientGetReplicationStatusScope
+	// HistoryClientRebuildWorkflowBranchScope tracks RPC calls to history service
+	HistoryClientRebuildWorkflowBranchScope
 	// HistoryClientReadDLQMessagesScope tracks RPC calls to history service
 	HistoryClientReadDLQMessagesScope
 	// HistoryClientPurgeDLQMessagesScope tracks RPC calls to history service
@@ -406,6 +412,8 @@ const (
 	HistoryClientWfIDCacheScope
 	// HistoryClientRatelimitUpdateScope tracks global ratelimiter related calls to history service
 	HistoryClientRatelimitUpdateScope
+	// HistoryClientUpdateActivityOptionsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityOptionsScope
 
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
@@ -605,6 +613,30 @@ const (
 	AdminClientUpdateDomainAsyncWorkflowConfiguratonScope
 	// AdminClientUpdateTaskListPartitionConfigScope is the metrics scope for admin.UpdateTaskListPartitionConfig
 	AdminClientUpdateTaskListPartitionConfigScope
+	// AdminClientUpdateActivityOptionsScope is the metrics scope for admin.UpdateActivityOptions
+	AdminClientUpdateActivityOptionsScope
+	// AdminClientListAuditLogEntriesScope is the metrics scope for admin.ListAuditLogEntries
+	AdminClientListAuditLogEntriesScope
+	// AdminClientReadHistoryTaskDLQMessagesScope is the metrics scope for admin.ReadHistoryTaskDLQMessages
+	AdminClientReadHistoryTaskDLQMessagesScope
+	// AdminClientDescribeHistoryTaskDLQMessageScope is the metrics scope for admin.DescribeHistoryTaskDLQMessage
+	AdminClientDescribeHistoryTaskDLQMessageScope
+	// AdminClientMergeHistoryTaskDLQMessagesScope is the metrics scope for admin.MergeHistoryTaskDLQMessages
+	AdminClientMergeHistoryTaskDLQMessagesScope
+	// AdminClientPurgeHistoryTaskDLQMessagesScope is the metrics scope for admin.PurgeHistoryTaskDLQMessages
+	AdminClientPurgeHistoryTaskDLQMessagesScope
+	// AdminClientDeleteDomainScope is the metrics scope for admin.DeleteDomain
+	AdminClientDeleteDomainScope
+	// AdminClientGetReplicationStatusScope is the metrics scope for admin.GetReplicationStatus
+	AdminClientGetReplicationStatusScope
+	// AdminClientRenameDomainScope is the metrics scope for admin.RenameDomain
+	AdminClientRenameDomainScope
+	// AdminClientDescribeWorkflowVersionHistoriesScope is the metrics scope for admin.DescribeWorkflowVersionHistories
+	AdminClientDescribeWorkflowVersionHistoriesScope
+	// AdminClientRebuildWorkflowBranchScope is the metrics scope for admin.RebuildWorkflowBranch
+	AdminClientRebuildWorkflowBranchScope
+	// AdminClientDescribeTaskListScope is the metrics scope for admin.DescribeTaskList
+	AdminClientDescribeTaskListScope
 
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
@@ -862,6 +894,11 @@ const (
 	// ShardDistributorClientGetShardOwnerScope tracks GetShardOwner calls made by service to shard distributor
 	ShardDistributorClientGetShardOwnerScope
 
+	// AuditLogScope is the metrics scope for the audit log writes
+	AuditLogScope
+	// AuthorizationPolicyShadowScope is the metrics scope for the policy authorizer decisions in shadow mode
+	AuthorizationPolicyShadowScope
+
 	NumCommonScopes
 )
 
@@ -937,6 +974,30 @@ const (
 	UpdateDomainAsyncWorkflowConfiguraton
 	// UpdateTaskListPartitionConfig is the scope for update task list partition config
 	UpdateTaskListPartitionConfig
+	// AdminUpdateActivityOptionsScope is the metric scope for admin.UpdateActivityOptions
+	AdminUpdateActivityOptionsScope
+	// AdminListAuditLogEntriesScope is the metric scope for admin.ListAuditLogEntries
+	AdminListAuditLogEntriesScope
+	// AdminReadHistoryTaskDLQMessagesScope is the metric scope for admin.ReadHistoryTaskDLQMessages
+	AdminReadHistoryTaskDLQMessagesScope
+	// AdminDescribeHistoryTaskDLQMessageScope is the metric scope for admin.DescribeHistoryTaskDLQMessage
+	AdminDescribeHistoryTaskDLQMessageScope
+	// AdminMergeHistoryTaskDLQMessagesScope is the metric scope for admin.MergeHistoryTaskDLQMessages
+	AdminMergeHistoryTaskDLQMessagesScope
+	// AdminPurgeHistoryTaskDLQMessagesScope is the metric scope for admin.PurgeHistoryTaskDLQMessages
+	AdminPurgeHistoryTaskDLQMessagesScope
+	// AdminDeleteDomainScope is the metric scope for admin.DeleteDomain
+	AdminDeleteDomainScope
+	// AdminGetReplicationStatusScope is the metric scope for admin.GetReplicationStatus
+	AdminGetReplicationStatusScope
+	// AdminRenameDomainScope is the metric scope for admin.RenameDomain
+	AdminRenameDomainScope
+	// AdminDescribeWorkflowVersionHistoriesScope is the metric scope for admin.DescribeWorkflowVersionHistories
+	AdminDescribeWorkflowVersionHistoriesScope
+	// AdminRebuildWorkflowBranchScope is the metric scope for admin.RebuildWorkflowBranch
+	AdminRebuildWorkflowBranchScope
+	// AdminDescribeTaskListScope is the metric scope for admin.DescribeTaskList
+	AdminDescribeTaskListScope
 
 	NumAdminScopes
 )
@@ -1038,6 +1099,8 @@ const (
 	FrontendGetSearchAttributesScope
 	// FrontendGetClusterInfoScope is the metric scope for frontend.GetClusterInfo
 	FrontendGetClusterInfoScope
+	// FrontendLoadShedderScope is the metric scope for frontend priority-aware load shedding
+	FrontendLoadShedderScope
 
 	NumFrontendScopes
 )
@@ -1108,6 +1171,10 @@ const (
 	HistoryGetDLQReplicationMessagesScope
 	// HistoryCountDLQMessagesScope tracks CountDLQMessages API calls received by service
 	HistoryCountDLQMessagesScope
+	// HistoryGetReplicationStatusScope tracks GetReplicationStatus API calls received by service
+	HistoryGetReplicationStatusScope
+	// HistoryRebuildWorkflowBranchScope tracks RebuildWorkflowBranch API calls received by service
+	HistoryRebuildWorkflowBranchScope
 	// HistoryReadDLQMessagesScope tracks ReadDLQMessages API calls received by service
 	HistoryReadDLQMessagesScope
 	// HistoryPurgeDLQMessagesScope tracks PurgeDLQMessages API calls received by service
@@ -1130,6 +1197,8 @@ const (
 	HistoryGetFailoverInfoScope
 	// HistoryRatelimitUpdateScope tracks RatelimitUpdate API calls received by the history service
 	HistoryRatelimitUpdateScope
+	// HistoryUpdateActivityOptionsScope tracks UpdateActivityOptions API calls received by service
+	HistoryUpdateActivityOptionsScope
 	// TaskPriorityAssignerScope is the scope used by all metric emitted by task priority assigner
 	TaskPriorityAssignerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -1398,6 +1467,10 @@ const (
 	AsyncWorkflowConsumerScope
 	// DiagnosticsWorkflowScope is scope used by diagnostics workflow
 	DiagnosticsWorkflowScope
+	// IsolationGroupDrainerScope is scope used by the isolation group drainer
+	IsolationGroupDrainerScope
+	// AutoFailoverMonitorScope is scope used by the auto failover monitor
+	AutoFailoverMonitorScope
 
 	NumWorkerScopes
 )
@@ -1463,6 +1536,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCreateDomainScope:                             {operation: "CreateDomain"},
 		PersistenceGetDomainScope:                                {operation: "GetDomain"},
 		PersistenceUpdateDomainScope:                             {operation: "UpdateDomain"},
+		PersistenceRenameDomainScope:                             {operation: "RenameDomain"},
 		PersistenceDeleteDomainScope:                             {operation: "DeleteDomain"},
 		PersistenceDeleteDomainByNameScope:                       {operation: "DeleteDomainByName"},
 		PersistenceListDomainsScope:                              {operation: "ListDomain"},
@@ -1547,6 +1621,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientQueryWorkflowScope:                     {operation: "HistoryClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientReapplyEventsScope:                     {operation: "HistoryClientReapplyEvents", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientCountDLQMessagesScope:                  {operation: "HistoryClientCountDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetReplicationStatusScope:              {operation: "HistoryClientGetReplicationStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRebuildWorkflowBranchScope:             {operation: "HistoryClientRebuildWorkflowBranch", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientReadDLQMessagesScope:                   {operation: "HistoryClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientPurgeDLQMessagesScope:                  {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                  {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1559,6 +1635,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientGetReplicationMessagesScope:            {operation: "HistoryClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientWfIDCacheScope:                         {operation: "HistoryClientWfIDCache", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRatelimitUpdateScope:                   {operation: "HistoryClientRatelimitUpdate", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateActivityOptionsScope:             {operation: "HistoryClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 
 		MatchingClientPollForDecisionTaskScope:            {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:            {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
@@ -1660,6 +1737,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientGetDomainAsyncWorkflowConfiguratonScope:    {operation: "AdminClientGetDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateDomainAsyncWorkflowConfiguratonScope: {operation: "AdminClientUpdateDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateTaskListPartitionConfigScope:         {operation: "AdminClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUpdateActivityOptionsScope:                 {operation: "AdminClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListAuditLogEntriesScope:                   {operation: "AdminClientListAuditLogEntries", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientReadHistoryTaskDLQMessagesScope:            {operation: "AdminClientReadHistoryTaskDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDescribeHistoryTaskDLQMessageScope:         {operation: "AdminClientDescribeHistoryTaskDLQMessage", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientMergeHistoryTaskDLQMessagesScope:           {operation: "AdminClientMergeHistoryTaskDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientPurgeHistoryTaskDLQMessagesScope:           {operation: "AdminClientPurgeHistoryTaskDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDeleteDomainScope:                          {operation: "AdminClientDeleteDomain", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetReplicationStatusScope:                  {operation: "AdminClientGetReplicationStatus", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRenameDomainScope:                          {operation: "AdminClientRenameDomain", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDescribeWorkflowVersionHistoriesScope:      {operation: "AdminClientDescribeWorkflowVersionHistories", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRebuildWorkflowBranchScope:                 {operation: "AdminClientRebuildWorkflowBranch", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDescribeTaskListScope:                      {operation: "AdminClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 
 		DCRedirectionDeprecateDomainScope:                       {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                        {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1782,6 +1871,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PartitionConfigProviderScope: {operation: "PartitionConfigProvider"},
 
 		ShardDistributorClientGetShardOwnerScope: {operation: "ShardDistributorClientGetShardOwner"},
+
+		AuditLogScope:                  {operation: "AuditLog"},
+		AuthorizationPolicyShadowScope: {operation: "AuthorizationPolicyShadow"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -1821,6 +1913,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		GetDomainAsyncWorkflowConfiguraton:          {operation: "GetDomainAsyncWorkflowConfiguraton"},
 		UpdateDomainAsyncWorkflowConfiguraton:       {operation: "UpdateDomainAsyncWorkflowConfiguraton"},
 		UpdateTaskListPartitionConfig:               {operation: "UpdateTaskListPartitionConfig"},
+		AdminUpdateActivityOptionsScope:             {operation: "AdminUpdateActivityOptions"},
+		AdminListAuditLogEntriesScope:               {operation: "AdminListAuditLogEntries"},
+		AdminReadHistoryTaskDLQMessagesScope:        {operation: "AdminReadHistoryTaskDLQMessages"},
+		AdminDescribeHistoryTaskDLQMessageScope:     {operation: "AdminDescribeHistoryTaskDLQMessage"},
+		AdminMergeHistoryTaskDLQMessagesScope:       {operation: "AdminMergeHistoryTaskDLQMessages"},
+		AdminPurgeHistoryTaskDLQMessagesScope:       {operation: "AdminPurgeHistoryTaskDLQMessages"},
+		AdminDeleteDomainScope:                      {operation: "AdminDeleteDomain"},
+		AdminGetReplicationStatusScope:              {operation: "AdminGetReplicationStatus"},
+		AdminRenameDomainScope:                      {operation: "AdminRenameDomain"},
+		AdminDescribeWorkflowVersionHistoriesScope:  {operation: "AdminDescribeWorkflowVersionHistories"},
+		AdminRebuildWorkflowBranchScope:             {operation: "AdminRebuildWorkflowBranch"},
+		AdminDescribeTaskListScope:                  {operation: "AdminDescribeTaskList"},
 
 		FrontendRestartWorkflowExecutionScope:              {operation: "RestartWorkflowExecution"},
 		FrontendStartWorkflowExecutionScope:                {operation: "StartWorkflowExecution"},
@@ -1869,6 +1973,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendResetStickyTaskListScope:                   {operation: "ResetStickyTaskList"},
 		FrontendGetSearchAttributesScope:                   {operation: "GetSearchAttributes"},
 		FrontendGetClusterInfoScope:                        {operation: "GetClusterInfo"},
+		FrontendLoadShedderScope:                           {operation: "FrontendLoadShedder"},
 	},
 	// History Scope Names
 	History: {
@@ -1907,6 +2012,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryGetReplicationMessagesScope:                              {operation: "GetReplicationMessages"},
 		HistoryGetDLQReplicationMessagesScope:                           {operation: "GetDLQReplicationMessages"},
 		HistoryCountDLQMessagesScope:                                    {operation: "CountDLQMessages"},
+		HistoryGetReplicationStatusScope:                                {operation: "GetReplicationStatus"},
+		HistoryRebuildWorkflowBranchScope:                               {operation: "RebuildWorkflowBranch"},
 		HistoryReadDLQMessagesScope:                                     {operation: "ReadDLQMessages"},
 		HistoryPurgeDLQMessagesScope:                                    {operation: "PurgeDLQMessages"},
 		HistoryMergeDLQMessagesScope:                                    {operation: "MergeDLQMessages"},
@@ -1918,6 +2025,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRespondCrossClusterTasksCompletedScope:                   {operation: "RespondCrossClusterTasksCompleted"},
 		HistoryGetFailoverInfoScope:                                     {operation: "GetFailoverInfo"},
 		HistoryRatelimitUpdateScope:                                     {operation: "RatelimitUpdate"},
+		HistoryUpdateActivityOptionsScope:                               {operation: "UpdateActivityOptions"},
 		TaskPriorityAssignerScope:                                       {operation: "TaskPriorityAssigner"},
 		TransferQueueProcessorScope:                                     {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                               {operation: "TransferActiveQueueProcessor"},
@@ -2049,6 +2157,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ESAnalyzerScope:                        {operation: "ESAnalyzer"},
 		AsyncWorkflowConsumerScope:             {operation: "AsyncWorkflowConsumer"},
 		DiagnosticsWorkflowScope:               {operation: "DiagnosticsWorkflow"},
+		IsolationGroupDrainerScope:             {operation: "IsolationGroupDrainer"},
+		AutoFailoverMonitorScope:               {operation: "AutoFailoverMonitor"},
 	},
 	ShardDistributor: {
 		ShardDistributorGetShardOwnerScope: {operation: "GetShardOwner"},
@@ -2288,6 +2398,10 @@ const (
 	GlobalRatelimiterRemovedLimits
 	GlobalRatelimiterRemovedHostLimits
 
+	// frontend load shedding metrics
+	LoadShedRequestsCount
+	LoadShedderInFlightRequests
+
 	// p2p rpc metrics
 	P2PPeersCount
 	P2PPeerAdded
@@ -2297,6 +2411,17 @@ const (
 	TaskListPartitionConfigNumReadGauge
 	TaskListPartitionConfigNumWriteGauge
 
+	// audit log metrics
+	AuditLogEntriesWritten
+	AuditLogEntriesDropped
+	AuditLogWriteFailures
+	AuditLogBackpressure
+
+	// authorization policy shadow mode metrics
+	AuthorizationPolicyShadowAllowed
+	AuthorizationPolicyShadowDenied
+	AuthorizationPolicyShadowMismatches
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -2322,6 +2447,8 @@ const (
 	TaskWorkflowBusyPerDomain
 	TaskDiscardedPerDomain
 	TaskUnsupportedPerDomain
+	TaskMovedToDLQPerDomain
+	TaskMoveToDLQFailedPerDomain
 	TaskAttemptTimerPerDomain
 	TaskStandbyRetryCounterPerDomain
 	TaskListNotOwnedByHostCounterPerDomain
@@ -2349,6 +2476,8 @@ const (
 	ProcessingQueueStuckTaskSplitCounter
 	ProcessingQueueSelectedDomainSplitCounter
 	ProcessingQueueRandomSplitCounter
+	ProcessingQueueDomainIsolationSplitCounter
+	ProcessingQueueIsolatedNumTimer
 	ProcessingQueueThrottledCounter
 
 	QueueValidatorLostTaskCounter
@@ -2404,6 +2533,9 @@ const (
 	AutoResetPointsLimitExceededCounter
 	AutoResetPointCorruptionCounter
 	ConcurrencyUpdateFailureCounter
+	DomainOpenWorkflowsLimitExceededCounter
+	DomainPendingActivitiesLimitExceededCounter
+	DomainPendingChildWorkflowsLimitExceededCounter
 	CadenceErrEventAlreadyStartedCounter
 	CadenceErrShardOwnershipLostCounter
 	HeartbeatTimeoutCounter
@@ -2530,6 +2662,7 @@ const (
 	ReplicationTasksReturned
 	ReplicationTasksReturnedDiff
 	ReplicationTasksAppliedLatency
+	ReplicationTaskBatchDecodeFailures
 	ReplicationDLQFailed
 	ReplicationDLQMaxLevelGauge
 	ReplicationDLQAckLevelGauge
@@ -2741,6 +2874,14 @@ const (
 	DiagnosticsWorkflowStartedCount
 	DiagnosticsWorkflowSuccess
 	DiagnosticsWorkflowExecutionLatency
+	IsolationGroupDrainProposedCount
+	IsolationGroupDrainAppliedCount
+	IsolationGroupDrainRejectedCount
+	IsolationGroupDrainerEvaluationFailures
+	AutoFailoverTriggeredCount
+	AutoFailoverFailedCount
+	AutoFailoverRejectedCount
+	AutoFailoverMonitorEvaluationFailures
 	NumWorkerMetrics
 )
 
@@ -3005,12 +3146,24 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		GlobalRatelimiterRemovedLimits:     {metricName: "global_ratelimiter_removed_limits", metricType: Histogram, buckets: GlobalRatelimiterUsageHistogram},
 		GlobalRatelimiterRemovedHostLimits: {metricName: "global_ratelimiter_removed_host_limits", metricType: Histogram, buckets: GlobalRatelimiterUsageHistogram},
 
+		LoadShedRequestsCount:       {metricName: "load_shed_requests", metricType: Counter},
+		LoadShedderInFlightRequests: {metricName: "load_shedder_inflight_requests", metricType: Gauge},
+
 		P2PPeersCount:                        {metricName: "peers_count", metricType: Gauge},
 		P2PPeerAdded:                         {metricName: "peer_added", metricType: Counter},
 		P2PPeerRemoved:                       {metricName: "peer_removed", metricType: Counter},
 		TaskListPartitionConfigVersionGauge:  {metricName: "task_list_partition_config_version", metricType: Gauge},
 		TaskListPartitionConfigNumReadGauge:  {metricName: "task_list_partition_config_num_read", metricType: Gauge},
 		TaskListPartitionConfigNumWriteGauge: {metricName: "task_list_partition_config_num_write", metricType: Gauge},
+
+		AuditLogEntriesWritten: {metricName: "audit_log_entries_written", metricType: Counter},
+		AuditLogEntriesDropped: {metricName: "audit_log_entries_dropped", metricType: Counter},
+		AuditLogWriteFailures:  {metricName: "audit_log_write_failures", metricType: Counter},
+		AuditLogBackpressure:   {metricName: "audit_log_backpressure", metricType: Counter},
+
+		AuthorizationPolicyShadowAllowed:    {metricName: "authorization_policy_shadow_allowed", metricType: Counter},
+		AuthorizationPolicyShadowDenied:     {metricName: "authorization_policy_shadow_denied", metricType: Counter},
+		AuthorizationPolicyShadowMismatches: {metricName: "authorization_policy_shadow_mismatches", metricType: Counter},
 	},
 	History: {
 		TaskRequests:             {metricName: "task_requests", metricType: Counter},
@@ -3034,6 +3187,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskWorkflowBusyPerDomain:                {metricName: "task_errors_workflow_busy_per_domain", metricRollupName: "task_errors_workflow_busy", metricType: Counter},
 		TaskDiscardedPerDomain:                   {metricName: "task_errors_discarded_per_domain", metricRollupName: "task_errors_discarded", metricType: Counter},
 		TaskUnsupportedPerDomain:                 {metricName: "task_errors_unsupported_per_domain", metricRollupName: "task_errors_discarded", metricType: Counter},
+		TaskMovedToDLQPerDomain:                  {metricName: "task_moved_to_dlq_per_domain", metricRollupName: "task_moved_to_dlq", metricType: Counter},
+		TaskMoveToDLQFailedPerDomain:             {metricName: "task_move_to_dlq_failed_per_domain", metricRollupName: "task_move_to_dlq_failed", metricType: Counter},
 		TaskStandbyRetryCounterPerDomain:         {metricName: "task_errors_standby_retry_counter_per_domain", metricRollupName: "task_errors_standby_retry_counter", metricType: Counter},
 		TaskListNotOwnedByHostCounterPerDomain:   {metricName: "task_errors_task_list_not_owned_by_host_counter_per_domain", metricRollupName: "task_errors_task_list_not_owned_by_host_counter", metricType: Counter},
 		TaskPendingActiveCounterPerDomain:        {metricName: "task_errors_pending_active_counter_per_domain", metricRollupName: "task_errors_pending_active_counter", metricType: Counter},
@@ -3059,6 +3214,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ProcessingQueueStuckTaskSplitCounter:                         {metricName: "processing_queue_stuck_task_split_counter", metricType: Counter},
 		ProcessingQueueSelectedDomainSplitCounter:                    {metricName: "processing_queue_selected_domain_split_counter", metricType: Counter},
 		ProcessingQueueRandomSplitCounter:                            {metricName: "processing_queue_random_split_counter", metricType: Counter},
+		ProcessingQueueDomainIsolationSplitCounter:                   {metricName: "processing_queue_domain_isolation_split_counter", metricType: Counter},
+		ProcessingQueueIsolatedNumTimer:                              {metricName: "processing_queue_isolated_num", metricType: Timer},
 		ProcessingQueueThrottledCounter:                              {metricName: "processing_queue_throttled_counter", metricType: Counter},
 		QueueValidatorLostTaskCounter:                                {metricName: "queue_validator_lost_task_counter", metricType: Counter},
 		QueueValidatorDropTaskCounter:                                {metricName: "queue_validator_drop_task_counter", metricType: Counter},
@@ -3110,6 +3267,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		AutoResetPointsLimitExceededCounter:                          {metricName: "auto_reset_points_exceed_limit", metricType: Counter},
 		AutoResetPointCorruptionCounter:                              {metricName: "auto_reset_point_corruption", metricType: Counter},
 		ConcurrencyUpdateFailureCounter:                              {metricName: "concurrency_update_failure", metricType: Counter},
+		DomainOpenWorkflowsLimitExceededCounter:                      {metricName: "domain_open_workflows_limit_exceeded", metricType: Counter},
+		DomainPendingActivitiesLimitExceededCounter:                  {metricName: "domain_pending_activities_limit_exceeded", metricType: Counter},
+		DomainPendingChildWorkflowsLimitExceededCounter:              {metricName: "domain_pending_child_workflows_limit_exceeded", metricType: Counter},
 		CadenceErrShardOwnershipLostCounter:                          {metricName: "cadence_errors_shard_ownership_lost", metricType: Counter},
 		CadenceErrEventAlreadyStartedCounter:                         {metricName: "cadence_errors_event_already_started", metricType: Counter},
 		HeartbeatTimeoutCounter:                                      {metricName: "heartbeat_timeout", metricType: Counter},
@@ -3232,6 +3392,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTasksReturned:                                     {metricName: "replication_tasks_returned", metricType: Timer},
 		ReplicationTasksReturnedDiff:                                 {metricName: "replication_tasks_returned_diff", metricType: Timer},
 		ReplicationTasksAppliedLatency:                               {metricName: "replication_tasks_applied_latency", metricType: Timer},
+		ReplicationTaskBatchDecodeFailures:                           {metricName: "replication_task_batch_decode_failures", metricType: Counter},
 		ReplicationDLQFailed:                                         {metricName: "replication_dlq_enqueue_failed", metricType: Counter},
 		ReplicationDLQMaxLevelGauge:                                  {metricName: "replication_dlq_max_level", metricType: Gauge},
 		ReplicationDLQAckLevelGauge:                                  {metricName: "replication_dlq_ack_level", metricType: Gauge},
@@ -3441,6 +3602,14 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DiagnosticsWorkflowStartedCount:               {metricName: "diagnostics_workflow_count", metricType: Counter},
 		DiagnosticsWorkflowSuccess:                    {metricName: "diagnostics_workflow_success", metricType: Counter},
 		DiagnosticsWorkflowExecutionLatency:           {metricName: "diagnostics_workflow_execution_latency", metricType: Timer},
+		IsolationGroupDrainProposedCount:              {metricName: "isolation_group_drain_proposed", metricType: Counter},
+		IsolationGroupDrainAppliedCount:               {metricName: "isolation_group_drain_applied", metricType: Counter},
+		IsolationGroupDrainRejectedCount:              {metricName: "isolation_group_drain_rejected", metricType: Counter},
+		IsolationGroupDrainerEvaluationFailures:       {metricName: "isolation_group_drainer_evaluation_failures", metricType: Counter},
+		AutoFailoverTriggeredCount:                    {metricName: "auto_failover_triggered", metricType: Counter},
+		AutoFailoverFailedCount:                       {metricName: "auto_failover_failed", metricType: Counter},
+		AutoFailoverRejectedCount:                     {metricName: "auto_failover_rejected", metricType: Counter},
+		AutoFailoverMonitorEvaluationFailures:         {metricName: "auto_failover_monitor_evaluation_failures", metricType: Counter},
 	},
 	ShardDistributor: {
 		ShardDistributorRequests:                 {metricName: "shard_distributor_requests", metricType: Counter},
@@ -69,6 +69,7 @@ const (
 	leakCause                 = "leak_cause"
 	topic                     = "topic"
 	mode                      = "mode"
+	criticality               = "criticality"
 
 	// limiter-side tags
 	globalRatelimitKey            = "global_ratelimit_key"
@@ -343,6 +344,11 @@ func ModeTag(value string) Tag {
 	return metricWithUnknown(mode, value)
 }
 
+// CriticalityTag reports the load shedding criticality tier of a request, e.g. "low"
+func CriticalityTag(value string) Tag {
+	return metricWithUnknown(criticality, value)
+}
+
 func NamespaceTag(namespace string) Tag {
 	return metricWithUnknown("namespace", namespace)
 }
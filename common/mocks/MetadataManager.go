@@ -174,6 +174,20 @@ func (_m *MetadataManager) ListDomains(ctx context.Context, request *persistence
 	return r0, r1
 }
 
+// RenameDomain provides a mock function with given fields: ctx, request
+func (_m *MetadataManager) RenameDomain(ctx context.Context, request *persistence.RenameDomainRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RenameDomainRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDomain provides a mock function with given fields: ctx, request
 func (_m *MetadataManager) UpdateDomain(ctx context.Context, request *persistence.UpdateDomainRequest) error {
 	ret := _m.Called(ctx, request)
@@ -51,6 +51,12 @@ type (
 		GetDomainReplicationQueueManager() persistence.QueueManager
 		SetDomainReplicationQueueManager(persistence.QueueManager)
 
+		GetAuditLogQueueManager() persistence.QueueManager
+		SetAuditLogQueueManager(persistence.QueueManager)
+
+		GetHistoryTaskQueueManager(int) (persistence.QueueManager, error)
+		SetHistoryTaskQueueManager(int, persistence.QueueManager)
+
 		GetShardManager() persistence.ShardManager
 		SetShardManager(persistence.ShardManager)
 
@@ -70,13 +76,21 @@ type (
 		taskManager                   persistence.TaskManager
 		visibilityManager             persistence.VisibilityManager
 		domainReplicationQueueManager persistence.QueueManager
+		auditLogQueueManager          persistence.QueueManager
 		shardManager                  persistence.ShardManager
 		historyManager                persistence.HistoryManager
 		configStoreManager            persistence.ConfigStoreManager
 		executionManagerFactory       persistence.ExecutionManagerFactory
+		historyTaskQueueFactory       HistoryTaskQueueManagerFactory
 
 		sync.RWMutex
-		shardIDToExecutionManager map[int]persistence.ExecutionManager
+		shardIDToExecutionManager        map[int]persistence.ExecutionManager
+		shardIDToHistoryTaskQueueManager map[int]persistence.QueueManager
+	}
+
+	// HistoryTaskQueueManagerFactory creates the history task queue of a shard
+	HistoryTaskQueueManagerFactory interface {
+		NewHistoryTaskQueueManager(shardID int) (persistence.QueueManager, error)
 	}
 
 	// Params contains dependencies for persistence
@@ -120,6 +134,11 @@ func NewBeanFromFactory(
 		return nil, err
 	}
 
+	auditLogQueue, err := factory.NewAuditLogQueueManager()
+	if err != nil {
+		return nil, err
+	}
+
 	shardMgr, err := factory.NewShardManager()
 	if err != nil {
 		return nil, err
@@ -140,10 +159,12 @@ func NewBeanFromFactory(
 		taskMgr,
 		visibilityMgr,
 		domainReplicationQueue,
+		auditLogQueue,
 		shardMgr,
 		historyMgr,
 		configStoreMgr,
 		factory,
+		factory,
 	), nil
 }
 
@@ -153,22 +174,27 @@ func NewBean(
 	taskManager persistence.TaskManager,
 	visibilityManager persistence.VisibilityManager,
 	domainReplicationQueueManager persistence.QueueManager,
+	auditLogQueueManager persistence.QueueManager,
 	shardManager persistence.ShardManager,
 	historyManager persistence.HistoryManager,
 	configStoreManager persistence.ConfigStoreManager,
 	executionManagerFactory persistence.ExecutionManagerFactory,
+	historyTaskQueueFactory HistoryTaskQueueManagerFactory,
 ) *BeanImpl {
 	return &BeanImpl{
 		domainManager:                 domainManager,
 		taskManager:                   taskManager,
 		visibilityManager:             visibilityManager,
 		domainReplicationQueueManager: domainReplicationQueueManager,
+		auditLogQueueManager:          auditLogQueueManager,
 		shardManager:                  shardManager,
 		historyManager:                historyManager,
 		configStoreManager:            configStoreManager,
 		executionManagerFactory:       executionManagerFactory,
+		historyTaskQueueFactory:       historyTaskQueueFactory,
 
-		shardIDToExecutionManager: make(map[int]persistence.ExecutionManager),
+		shardIDToExecutionManager:        make(map[int]persistence.ExecutionManager),
+		shardIDToHistoryTaskQueueManager: make(map[int]persistence.QueueManager),
 	}
 }
 
@@ -252,6 +278,68 @@ func (s *BeanImpl) SetDomainReplicationQueueManager(
 	s.domainReplicationQueueManager = domainReplicationQueueManager
 }
 
+// GetAuditLogQueueManager gets audit log QueueManager
+func (s *BeanImpl) GetAuditLogQueueManager() persistence.QueueManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.auditLogQueueManager
+}
+
+// SetAuditLogQueueManager sets audit log QueueManager
+func (s *BeanImpl) SetAuditLogQueueManager(
+	auditLogQueueManager persistence.QueueManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.auditLogQueueManager = auditLogQueueManager
+}
+
+// GetHistoryTaskQueueManager gets the history task QueueManager of a shard
+func (s *BeanImpl) GetHistoryTaskQueueManager(
+	shardID int,
+) (persistence.QueueManager, error) {
+
+	s.RLock()
+	historyTaskQueueManager, ok := s.shardIDToHistoryTaskQueueManager[shardID]
+	if ok {
+		s.RUnlock()
+		return historyTaskQueueManager, nil
+	}
+	s.RUnlock()
+
+	s.Lock()
+	defer s.Unlock()
+
+	historyTaskQueueManager, ok = s.shardIDToHistoryTaskQueueManager[shardID]
+	if ok {
+		return historyTaskQueueManager, nil
+	}
+
+	historyTaskQueueManager, err := s.historyTaskQueueFactory.NewHistoryTaskQueueManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.shardIDToHistoryTaskQueueManager[shardID] = historyTaskQueueManager
+	return historyTaskQueueManager, nil
+}
+
+// SetHistoryTaskQueueManager sets the history task QueueManager of a shard
+func (s *BeanImpl) SetHistoryTaskQueueManager(
+	shardID int,
+	historyTaskQueueManager persistence.QueueManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardIDToHistoryTaskQueueManager[shardID] = historyTaskQueueManager
+}
+
 // GetShardManager get ShardManager
 func (s *BeanImpl) GetShardManager() persistence.ShardManager {
 
@@ -367,6 +455,7 @@ func (s *BeanImpl) Close() {
 		s.visibilityManager.Close()
 	}
 	s.domainReplicationQueueManager.Close()
+	s.auditLogQueueManager.Close()
 	s.shardManager.Close()
 	s.historyManager.Close()
 	s.executionManagerFactory.Close()
@@ -374,4 +463,7 @@ func (s *BeanImpl) Close() {
 	for _, executionMgr := range s.shardIDToExecutionManager {
 		executionMgr.Close()
 	}
+	for _, historyTaskQueueMgr := range s.shardIDToHistoryTaskQueueManager {
+		historyTaskQueueMgr.Close()
+	}
 }
@@ -75,6 +75,20 @@ func (mr *MockBeanMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockBean)(nil).Close))
 }
 
+// GetAuditLogQueueManager mocks base method.
+func (m *MockBean) GetAuditLogQueueManager() persistence.QueueManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	return ret0
+}
+
+// GetAuditLogQueueManager indicates an expected call of GetAuditLogQueueManager.
+func (mr *MockBeanMockRecorder) GetAuditLogQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogQueueManager", reflect.TypeOf((*MockBean)(nil).GetAuditLogQueueManager))
+}
+
 // GetConfigStoreManager mocks base method.
 func (m *MockBean) GetConfigStoreManager() persistence.ConfigStoreManager {
 	m.ctrl.T.Helper()
@@ -146,6 +160,21 @@ func (mr *MockBeanMockRecorder) GetHistoryManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryManager", reflect.TypeOf((*MockBean)(nil).GetHistoryManager))
 }
 
+// GetHistoryTaskQueueManager mocks base method.
+func (m *MockBean) GetHistoryTaskQueueManager(arg0 int) (persistence.QueueManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryTaskQueueManager", arg0)
+	ret0, _ := ret[0].(persistence.QueueManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryTaskQueueManager indicates an expected call of GetHistoryTaskQueueManager.
+func (mr *MockBeanMockRecorder) GetHistoryTaskQueueManager(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryTaskQueueManager", reflect.TypeOf((*MockBean)(nil).GetHistoryTaskQueueManager), arg0)
+}
+
 // GetShardManager mocks base method.
 func (m *MockBean) GetShardManager() persistence.ShardManager {
 	m.ctrl.T.Helper()
@@ -188,6 +217,18 @@ func (mr *MockBeanMockRecorder) GetVisibilityManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityManager", reflect.TypeOf((*MockBean)(nil).GetVisibilityManager))
 }
 
+// SetAuditLogQueueManager mocks base method.
+func (m *MockBean) SetAuditLogQueueManager(arg0 persistence.QueueManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAuditLogQueueManager", arg0)
+}
+
+// SetAuditLogQueueManager indicates an expected call of SetAuditLogQueueManager.
+func (mr *MockBeanMockRecorder) SetAuditLogQueueManager(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuditLogQueueManager", reflect.TypeOf((*MockBean)(nil).SetAuditLogQueueManager), arg0)
+}
+
 // SetConfigStoreManager mocks base method.
 func (m *MockBean) SetConfigStoreManager(arg0 persistence.ConfigStoreManager) {
 	m.ctrl.T.Helper()
@@ -248,6 +289,18 @@ func (mr *MockBeanMockRecorder) SetHistoryManager(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHistoryManager", reflect.TypeOf((*MockBean)(nil).SetHistoryManager), arg0)
 }
 
+// SetHistoryTaskQueueManager mocks base method.
+func (m *MockBean) SetHistoryTaskQueueManager(arg0 int, arg1 persistence.QueueManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetHistoryTaskQueueManager", arg0, arg1)
+}
+
+// SetHistoryTaskQueueManager indicates an expected call of SetHistoryTaskQueueManager.
+func (mr *MockBeanMockRecorder) SetHistoryTaskQueueManager(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHistoryTaskQueueManager", reflect.TypeOf((*MockBean)(nil).SetHistoryTaskQueueManager), arg0, arg1)
+}
+
 // SetShardManager mocks base method.
 func (m *MockBean) SetShardManager(arg0 persistence.ShardManager) {
 	m.ctrl.T.Helper()
@@ -283,3 +336,42 @@ func (mr *MockBeanMockRecorder) SetVisibilityManager(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVisibilityManager", reflect.TypeOf((*MockBean)(nil).SetVisibilityManager), arg0)
 }
+
+// MockHistoryTaskQueueManagerFactory is a mock of HistoryTaskQueueManagerFactory interface.
+type MockHistoryTaskQueueManagerFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryTaskQueueManagerFactoryMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoryTaskQueueManagerFactoryMockRecorder is the mock recorder for MockHistoryTaskQueueManagerFactory.
+type MockHistoryTaskQueueManagerFactoryMockRecorder struct {
+	mock *MockHistoryTaskQueueManagerFactory
+}
+
+// NewMockHistoryTaskQueueManagerFactory creates a new mock instance.
+func NewMockHistoryTaskQueueManagerFactory(ctrl *gomock.Controller) *MockHistoryTaskQueueManagerFactory {
+	mock := &MockHistoryTaskQueueManagerFactory{ctrl: ctrl}
+	mock.recorder = &MockHistoryTaskQueueManagerFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryTaskQueueManagerFactory) EXPECT() *MockHistoryTaskQueueManagerFactoryMockRecorder {
+	return m.recorder
+}
+
+// NewHistoryTaskQueueManager mocks base method.
+func (m *MockHistoryTaskQueueManagerFactory) NewHistoryTaskQueueManager(shardID int) (persistence.QueueManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewHistoryTaskQueueManager", shardID)
+	ret0, _ := ret[0].(persistence.QueueManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewHistoryTaskQueueManager indicates an expected call of NewHistoryTaskQueueManager.
+func (mr *MockHistoryTaskQueueManagerFactoryMockRecorder) NewHistoryTaskQueueManager(shardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoryTaskQueueManager", reflect.TypeOf((*MockHistoryTaskQueueManagerFactory)(nil).NewHistoryTaskQueueManager), shardID)
+}
@@ -41,6 +41,8 @@ type beanmocks struct {
 	taskManager        *persistence.MockTaskManager
 	visibilityManager  *persistence.MockVisibilityManager
 	replicationManager *persistence.MockQueueManager
+	auditLogManager    *persistence.MockQueueManager
+	historyTaskManager *persistence.MockQueueManager
 	shardManager       *persistence.MockShardManager
 	historyManager     *persistence.MockHistoryManager
 	configManager      *persistence.MockConfigStoreManager
@@ -54,6 +56,8 @@ func beanSetup(t *testing.T) (f *MockFactory, m beanmocks, defaultMocks func())
 		taskManager:        persistence.NewMockTaskManager(ctrl),
 		visibilityManager:  persistence.NewMockVisibilityManager(ctrl),
 		replicationManager: persistence.NewMockQueueManager(ctrl),
+		auditLogManager:    persistence.NewMockQueueManager(ctrl),
+		historyTaskManager: persistence.NewMockQueueManager(ctrl),
 		shardManager:       persistence.NewMockShardManager(ctrl),
 		historyManager:     persistence.NewMockHistoryManager(ctrl),
 		configManager:      persistence.NewMockConfigStoreManager(ctrl),
@@ -65,6 +69,8 @@ func beanSetup(t *testing.T) (f *MockFactory, m beanmocks, defaultMocks func())
 		f.EXPECT().NewTaskManager().Return(m.taskManager, nil).MaxTimes(1)
 		f.EXPECT().NewVisibilityManager(gomock.Any(), gomock.Any()).Return(m.visibilityManager, nil).MaxTimes(1)
 		f.EXPECT().NewDomainReplicationQueueManager().Return(m.replicationManager, nil).MaxTimes(1)
+		f.EXPECT().NewAuditLogQueueManager().Return(m.auditLogManager, nil).MaxTimes(1)
+		f.EXPECT().NewHistoryTaskQueueManager(gomock.Any()).Return(m.historyTaskManager, nil).MaxTimes(1)
 		f.EXPECT().NewShardManager().Return(m.shardManager, nil).MaxTimes(1)
 		f.EXPECT().NewHistoryManager().Return(m.historyManager, nil).MaxTimes(1)
 		f.EXPECT().NewConfigStoreManager().Return(m.configManager, nil).MaxTimes(1)
@@ -111,6 +117,12 @@ func TestBeanCoverage(t *testing.T) {
 				},
 				err: "no domain replication queue manager",
 			},
+			"audit log queue manager error": {
+				mockSetup: func(t *testing.T, f *MockFactory) {
+					f.EXPECT().NewAuditLogQueueManager().Return(nil, fmt.Errorf("no audit log queue manager"))
+				},
+				err: "no audit log queue manager",
+			},
 			"shard manager error": {
 				mockSetup: func(t *testing.T, f *MockFactory) {
 					f.EXPECT().NewShardManager().Return(nil, fmt.Errorf("no shard manager"))
@@ -160,6 +172,7 @@ func TestBeanCoverage(t *testing.T) {
 		g.Go(errgroupAssertEqual(t, m.taskManager, impl.GetTaskManager))
 		g.Go(errgroupAssertEqual(t, m.visibilityManager, impl.GetVisibilityManager))
 		g.Go(errgroupAssertEqual(t, m.replicationManager, impl.GetDomainReplicationQueueManager))
+		g.Go(errgroupAssertEqual(t, m.auditLogManager, impl.GetAuditLogQueueManager))
 		g.Go(errgroupAssertEqual(t, m.shardManager, impl.GetShardManager))
 		g.Go(errgroupAssertEqual(t, m.historyManager, impl.GetHistoryManager))
 		g.Go(errgroupAssertEqual(t, m.configManager, impl.GetConfigStoreManager))
@@ -181,6 +194,7 @@ func TestBeanCoverage(t *testing.T) {
 		g.Go(errgroupAssertSets(t, m2.taskManager, impl.SetTaskManager, impl.GetTaskManager))
 		g.Go(errgroupAssertSets(t, m2.visibilityManager, impl.SetVisibilityManager, impl.GetVisibilityManager))
 		g.Go(errgroupAssertSets(t, m2.replicationManager, impl.SetDomainReplicationQueueManager, impl.GetDomainReplicationQueueManager))
+		g.Go(errgroupAssertSets(t, m2.auditLogManager, impl.SetAuditLogQueueManager, impl.GetAuditLogQueueManager))
 		g.Go(errgroupAssertSets(t, m2.shardManager, impl.SetShardManager, impl.GetShardManager))
 		g.Go(errgroupAssertSets(t, m2.historyManager, impl.SetHistoryManager, impl.GetHistoryManager))
 		g.Go(errgroupAssertSets(t, m2.configManager, impl.SetConfigStoreManager, impl.GetConfigStoreManager))
@@ -237,6 +251,43 @@ func TestBeanCoverage(t *testing.T) {
 		g.Go(errgroupAssertSetsExecutionManager(t, 2, ex2, impl))
 		require.NoError(t, g.Wait())
 	})
+	t.Run("History task queue manager getter", func(t *testing.T) {
+		t.Parallel()
+		f, m, _ := beanSetup(t)
+
+		// history task queues are per shard, like execution managers
+		q1, q2 := persistence.NewMockQueueManager(m.mockCtrl), persistence.NewMockQueueManager(m.mockCtrl)
+		f.EXPECT().NewHistoryTaskQueueManager(1).Return(q1, nil).Times(1)
+		f.EXPECT().NewHistoryTaskQueueManager(2).Return(q2, nil).Times(1)
+		f.EXPECT().NewHistoryTaskQueueManager(3).Return(nil, fmt.Errorf("no history task queue manager")).Times(1)
+		impl := NewBean(nil, nil, nil, nil, nil, nil, nil, nil, nil, f)
+
+		var g errgroup.Group
+		for i := 0; i < 2; i++ {
+			g.Go(func() error {
+				v, err := impl.GetHistoryTaskQueueManager(1)
+				assert.NoError(t, err)
+				assertMocksEqual(t, q1, v)
+				return nil
+			})
+			g.Go(func() error {
+				v, err := impl.GetHistoryTaskQueueManager(2)
+				assert.NoError(t, err)
+				assertMocksEqual(t, q2, v)
+				return nil
+			})
+		}
+		require.NoError(t, g.Wait())
+
+		_, err := impl.GetHistoryTaskQueueManager(3)
+		assert.ErrorContains(t, err, "no history task queue manager")
+
+		q3 := persistence.NewMockQueueManager(m.mockCtrl)
+		impl.SetHistoryTaskQueueManager(3, q3)
+		v, err := impl.GetHistoryTaskQueueManager(3)
+		assert.NoError(t, err)
+		assertMocksEqual(t, q3, v)
+	})
 	t.Run("Lifecycle", func(t *testing.T) {
 		t.Parallel()
 		f, m, defaultMocks := beanSetup(t)
@@ -251,6 +302,8 @@ func TestBeanCoverage(t *testing.T) {
 		m.taskManager.EXPECT().Close().Return().Times(1)
 		m.visibilityManager.EXPECT().Close().Return().Times(1)
 		m.replicationManager.EXPECT().Close().Return().Times(1)
+		m.auditLogManager.EXPECT().Close().Return().Times(1)
+		m.historyTaskManager.EXPECT().Close().Return().Times(1)
 		m.shardManager.EXPECT().Close().Return().Times(1)
 		m.historyManager.EXPECT().Close().Return().Times(1)
 		m.configManager.EXPECT().Close().Return().Times(1)
@@ -264,6 +317,7 @@ func TestBeanCoverage(t *testing.T) {
 		require.NoError(t, err)
 
 		// seed the execution managers (could call Set instead)
+		impl.SetHistoryTaskQueueManager(1, m.historyTaskManager)
 		v, err := impl.GetExecutionManager(1)
 		require.NoError(t, err)
 		require.NotNil(t, v)
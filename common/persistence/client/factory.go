@@ -70,6 +70,10 @@ type (
 		NewVisibilityManager(params *Params, serviceConfig *service.Config) (p.VisibilityManager, error)
 		// NewDomainReplicationQueueManager returns a new queue for domain replication
 		NewDomainReplicationQueueManager() (p.QueueManager, error)
+		// NewAuditLogQueueManager returns a new queue for audit log entries
+		NewAuditLogQueueManager() (p.QueueManager, error)
+		// NewHistoryTaskQueueManager returns a new queue whose DLQ holds the permanently failing history tasks of a shard
+		NewHistoryTaskQueueManager(shardID int) (p.QueueManager, error)
 		// NewConfigStoreManager returns a new config store manager
 		NewConfigStoreManager() (p.ConfigStoreManager, error)
 	}
@@ -108,8 +112,10 @@ type (
 		metricsClient metrics.Client
 		logger        log.Logger
 		datastores    map[storeType]Datastore
-		clusterName   string
-		dc            *p.DynamicConfiguration
+		// operationLimiters limit each operation per domain on top of the datastore ratelimit, may be nil
+		operationLimiters quotas.ICollection
+		clusterName       string
+		dc                *p.DynamicConfiguration
 	}
 
 	storeType int
@@ -143,10 +149,13 @@ var storeTypes = []storeType{
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration. In addition, all objects will emit metrics automatically.
+// When operationLimiters is not nil, every operation is also limited per domain by the limiter
+// of its key, see shared.OperationKey.
 func NewFactory(
 	cfg *config.Persistence,
 	persistenceMaxQPS quotas.RPSFunc,
+	operationLimiters quotas.ICollection,
 	clusterName string,
 	metricsClient metrics.Client,
 	logger log.Logger,
@@ -158,6 +167,8 @@ func NewFactory(
 		logger:        logger,
 		clusterName:   clusterName,
 		dc:            dc,
+
+		operationLimiters: operationLimiters,
 	}
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters)
@@ -175,8 +186,8 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewTaskManager(result, errorRate, f.logger)
 	}
-	if ds.ratelimit != nil {
-		result = ratelimited.NewTaskManager(result, ds.ratelimit)
+	if ds.ratelimit != nil || f.operationLimiters != nil {
+		result = ratelimited.NewTaskManager(result, ds.ratelimit, f.operationLimiters)
 	}
 	if f.metricsClient != nil {
 		result = metered.NewTaskManager(result, f.metricsClient, f.logger, f.config)
@@ -195,8 +206,8 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewShardManager(result, errorRate, f.logger)
 	}
-	if ds.ratelimit != nil {
-		result = ratelimited.NewShardManager(result, ds.ratelimit)
+	if ds.ratelimit != nil || f.operationLimiters != nil {
+		result = ratelimited.NewShardManager(result, ds.ratelimit, f.operationLimiters)
 	}
 	if f.metricsClient != nil {
 		result = metered.NewShardManager(result, f.metricsClient, f.logger, f.config)
@@ -215,8 +226,8 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewHistoryManager(result, errorRate, f.logger)
 	}
-	if ds.ratelimit != nil {
-		result = ratelimited.NewHistoryManager(result, ds.ratelimit)
+	if ds.ratelimit != nil || f.operationLimiters != nil {
+		result = ratelimited.NewHistoryManager(result, ds.ratelimit, f.operationLimiters)
 	}
 	if f.metricsClient != nil {
 		result = metered.NewHistoryManager(result, f.metricsClient, f.logger, f.config)
@@ -237,8 +248,8 @@ func (f *factoryImpl) NewDomainManager() (p.DomainManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewDomainManager(result, errorRate, f.logger)
 	}
-	if ds.ratelimit != nil {
-		result = ratelimited.NewDomainManager(result, ds.ratelimit)
+	if ds.ratelimit != nil || f.operationLimiters != nil {
+		result = ratelimited.NewDomainManager(result, ds.ratelimit, f.operationLimiters)
 	}
 	if f.metricsClient != nil {
 		result = metered.NewDomainManager(result, f.metricsClient, f.logger, f.config)
@@ -257,8 +268,8 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewExecutionManager(result, errorRate, f.logger)
 	}
-	if ds.ratelimit != nil {
-		result = ratelimited.NewExecutionManager(result, ds.ratelimit)
+	if ds.ratelimit != nil || f.operationLimiters != nil {
+		result = ratelimited.NewExecutionManager(result, ds.ratelimit, f.operationLimiters)
 	}
 	if f.metricsClient != nil {
 		result = metered.NewExecutionManager(result, f.metricsClient, f.logger, f.config, f.dc.PersistenceSampleLoggingRate, f.dc.EnableShardIDMetrics)
@@ -390,7 +401,7 @@ func newPinotVisibilityManager(
 	// wrap with rate limiter
 	if visibilityConfig.PersistenceMaxQPS != nil && visibilityConfig.PersistenceMaxQPS() != 0 {
 		pinotRateLimiter := quotas.NewDynamicRateLimiter(visibilityConfig.PersistenceMaxQPS.AsFloat64())
-		visibilityFromPinot = ratelimited.NewVisibilityManager(visibilityFromPinot, pinotRateLimiter)
+		visibilityFromPinot = ratelimited.NewVisibilityManager(visibilityFromPinot, pinotRateLimiter, nil)
 	}
 
 	if metricsClient != nil {
@@ -419,7 +430,7 @@ func newESVisibilityManager(
 	// wrap with rate limiter
 	if visibilityConfig.PersistenceMaxQPS != nil && visibilityConfig.PersistenceMaxQPS() != 0 {
 		esRateLimiter := quotas.NewDynamicRateLimiter(visibilityConfig.PersistenceMaxQPS.AsFloat64())
-		visibilityFromES = ratelimited.NewVisibilityManager(visibilityFromES, esRateLimiter)
+		visibilityFromES = ratelimited.NewVisibilityManager(visibilityFromES, esRateLimiter, nil)
 	}
 	if metricsClient != nil {
 		// wrap with metrics
@@ -448,8 +459,8 @@ func (f *factoryImpl) newDBVisibilityManager(
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewVisibilityManager(result, errorRate, f.logger)
 	}
-	if ds.ratelimit != nil {
-		result = ratelimited.NewVisibilityManager(result, ds.ratelimit)
+	if ds.ratelimit != nil || f.operationLimiters != nil {
+		result = ratelimited.NewVisibilityManager(result, ds.ratelimit, f.operationLimiters)
 	}
 	if visibilityConfig.EnableDBVisibilitySampling != nil && visibilityConfig.EnableDBVisibilitySampling() {
 		result = sampled.NewVisibilityManager(result, sampled.Params{
@@ -472,8 +483,20 @@ func (f *factoryImpl) newDBVisibilityManager(
 }
 
 func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.DomainReplicationQueueType)
+}
+
+func (f *factoryImpl) NewAuditLogQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.AuditLogQueueType)
+}
+
+func (f *factoryImpl) NewHistoryTaskQueueManager(shardID int) (p.QueueManager, error) {
+	return f.newQueueManager(p.HistoryTaskQueueType(shardID))
+}
+
+func (f *factoryImpl) newQueueManager(queueType p.QueueType) (p.QueueManager, error) {
 	ds := f.datastores[storeTypeQueue]
-	store, err := ds.factory.NewQueue(p.DomainReplicationQueueType)
+	store, err := ds.factory.NewQueue(queueType)
 	if err != nil {
 		return nil, err
 	}
@@ -481,8 +504,8 @@ func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewQueueManager(result, errorRate, f.logger)
 	}
-	if ds.ratelimit != nil {
-		result = ratelimited.NewQueueManager(result, ds.ratelimit)
+	if ds.ratelimit != nil || f.operationLimiters != nil {
+		result = ratelimited.NewQueueManager(result, ds.ratelimit, f.operationLimiters)
 	}
 	if f.metricsClient != nil {
 		result = metered.NewQueueManager(result, f.metricsClient, f.logger, f.config)
@@ -501,8 +524,8 @@ func (f *factoryImpl) NewConfigStoreManager() (p.ConfigStoreManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewConfigStoreManager(result, errorRate, f.logger)
 	}
-	if ds.ratelimit != nil {
-		result = ratelimited.NewConfigStoreManager(result, ds.ratelimit)
+	if ds.ratelimit != nil || f.operationLimiters != nil {
+		result = ratelimited.NewConfigStoreManager(result, ds.ratelimit, f.operationLimiters)
 	}
 	if f.metricsClient != nil {
 		result = metered.NewConfigStoreManager(result, f.metricsClient, f.logger, f.config)
@@ -281,7 +281,7 @@ func makeFactoryWithMetrics(t *testing.T, withMetrics bool) Factory {
 		},
 	}
 
-	return NewFactory(cfg, qpsFn, "test cluster", met, logger, pdc)
+	return NewFactory(cfg, qpsFn, nil, "test cluster", met, logger, pdc)
 }
 
 func mockDatastore(t *testing.T, fact Factory, store storeType) *MockDataStoreFactory {
@@ -92,8 +92,18 @@ type QueueType int
 // Negative numbers are reserved for DLQ
 const (
 	DomainReplicationQueueType QueueType = iota + 1
+	AuditLogQueueType
 )
 
+// historyTaskQueueTypeBase is the queue type of the history task queue of shard 0,
+// every shard has its own queue so the messages of a shard can be read and deleted on their own
+const historyTaskQueueTypeBase QueueType = 1 << 20
+
+// HistoryTaskQueueType returns the type of the queue whose DLQ holds the permanently failing history tasks of a shard
+func HistoryTaskQueueType(shardID int) QueueType {
+	return historyTaskQueueTypeBase + QueueType(shardID)
+}
+
 // Create Workflow Execution Mode
 const (
 	// Fail if current record exists
@@ -481,6 +491,10 @@ type (
 		Expiry                        time.Time
 		CreatedTime                   time.Time
 		PartitionConfig               map[string]string
+		// Priority is not persisted, it is implied by the task list the task is stored in
+		Priority int32
+		// FairnessKey is persisted by matching in the partition config of the task
+		FairnessKey string
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -1251,6 +1265,13 @@ type (
 		NotificationVersion         int64
 	}
 
+	// RenameDomainRequest is used to rename a domain, the domain keeps its ID and is updated
+	// to the given record under the new name in Info
+	RenameDomainRequest struct {
+		PreviousName string
+		UpdateDomainRequest
+	}
+
 	// DeleteDomainRequest is used to delete domain entry from domains table
 	DeleteDomainRequest struct {
 		ID string
@@ -1647,6 +1668,7 @@ type (
 		CreateDomain(ctx context.Context, request *CreateDomainRequest) (*CreateDomainResponse, error)
 		GetDomain(ctx context.Context, request *GetDomainRequest) (*GetDomainResponse, error)
 		UpdateDomain(ctx context.Context, request *UpdateDomainRequest) error
+		RenameDomain(ctx context.Context, request *RenameDomainRequest) error
 		DeleteDomain(ctx context.Context, request *DeleteDomainRequest) error
 		DeleteDomainByName(ctx context.Context, request *DeleteDomainByNameRequest) error
 		ListDomains(ctx context.Context, request *ListDomainsRequest) (*ListDomainsResponse, error)
@@ -1250,6 +1250,20 @@ func (mr *MockDomainManagerMockRecorder) ListDomains(ctx, request any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomains", reflect.TypeOf((*MockDomainManager)(nil).ListDomains), ctx, request)
 }
 
+// RenameDomain mocks base method.
+func (m *MockDomainManager) RenameDomain(ctx context.Context, request *RenameDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDomain", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDomain indicates an expected call of RenameDomain.
+func (mr *MockDomainManagerMockRecorder) RenameDomain(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockDomainManager)(nil).RenameDomain), ctx, request)
+}
+
 // UpdateDomain mocks base method.
 func (m *MockDomainManager) UpdateDomain(ctx context.Context, request *UpdateDomainRequest) error {
 	m.ctrl.T.Helper()
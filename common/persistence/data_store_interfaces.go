@@ -88,6 +88,7 @@ type (
 		CreateDomain(ctx context.Context, request *InternalCreateDomainRequest) (*CreateDomainResponse, error)
 		GetDomain(ctx context.Context, request *GetDomainRequest) (*InternalGetDomainResponse, error)
 		UpdateDomain(ctx context.Context, request *InternalUpdateDomainRequest) error
+		RenameDomain(ctx context.Context, request *InternalRenameDomainRequest) error
 		DeleteDomain(ctx context.Context, request *DeleteDomainRequest) error
 		DeleteDomainByName(ctx context.Context, request *DeleteDomainByNameRequest) error
 		ListDomains(ctx context.Context, request *ListDomainsRequest) (*InternalListDomainsResponse, error)
@@ -847,6 +848,12 @@ type (
 		NotificationVersion         int64
 	}
 
+	// InternalRenameDomainRequest is used to rename a domain
+	InternalRenameDomainRequest struct {
+		PreviousName string
+		InternalUpdateDomainRequest
+	}
+
 	// InternalListDomainsResponse is the response for GetDomain
 	InternalListDomainsResponse struct {
 		Domains       []*InternalGetDomainResponse
@@ -698,6 +698,20 @@ func (mr *MockDomainStoreMockRecorder) ListDomains(ctx, request any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomains", reflect.TypeOf((*MockDomainStore)(nil).ListDomains), ctx, request)
 }
 
+// RenameDomain mocks base method.
+func (m *MockDomainStore) RenameDomain(ctx context.Context, request *InternalRenameDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDomain", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDomain indicates an expected call of RenameDomain.
+func (mr *MockDomainStoreMockRecorder) RenameDomain(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockDomainStore)(nil).RenameDomain), ctx, request)
+}
+
 // UpdateDomain mocks base method.
 func (m *MockDomainStore) UpdateDomain(ctx context.Context, request *InternalUpdateDomainRequest) error {
 	m.ctrl.T.Helper()
@@ -107,10 +107,34 @@ func (m *domainManagerImpl) UpdateDomain(
 	ctx context.Context,
 	request *UpdateDomainRequest,
 ) error {
-	dc, err := m.toInternalDomainConfig(request.Config)
+	internalReq, err := m.toInternalUpdateDomainRequest(request)
+	if err != nil {
+		return err
+	}
+	return m.persistence.UpdateDomain(ctx, internalReq)
+}
+
+func (m *domainManagerImpl) RenameDomain(
+	ctx context.Context,
+	request *RenameDomainRequest,
+) error {
+	internalReq, err := m.toInternalUpdateDomainRequest(&request.UpdateDomainRequest)
 	if err != nil {
 		return err
 	}
+	return m.persistence.RenameDomain(ctx, &InternalRenameDomainRequest{
+		PreviousName:                request.PreviousName,
+		InternalUpdateDomainRequest: *internalReq,
+	})
+}
+
+func (m *domainManagerImpl) toInternalUpdateDomainRequest(
+	request *UpdateDomainRequest,
+) (*InternalUpdateDomainRequest, error) {
+	dc, err := m.toInternalDomainConfig(request.Config)
+	if err != nil {
+		return nil, err
+	}
 	internalReq := &InternalUpdateDomainRequest{
 		Info:                        request.Info,
 		Config:                      &dc,
@@ -125,7 +149,7 @@ func (m *domainManagerImpl) UpdateDomain(
 	if request.FailoverEndTime != nil {
 		internalReq.FailoverEndTime = common.TimePtr(time.Unix(0, *request.FailoverEndTime))
 	}
-	return m.persistence.UpdateDomain(ctx, internalReq)
+	return internalReq, nil
 }
 
 func (m *domainManagerImpl) DeleteDomain(
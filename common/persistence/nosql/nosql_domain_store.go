@@ -93,7 +93,31 @@ func (m *nosqlDomainStore) UpdateDomain(
 	ctx context.Context,
 	request *persistence.InternalUpdateDomainRequest,
 ) error {
-	row := &nosqlplugin.DomainRow{
+	err := m.db.UpdateDomain(ctx, toDomainRow(request))
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateDomain", err)
+	}
+
+	return nil
+}
+
+func (m *nosqlDomainStore) RenameDomain(
+	ctx context.Context,
+	request *persistence.InternalRenameDomainRequest,
+) error {
+	err := m.db.RenameDomain(ctx, toDomainRow(&request.InternalUpdateDomainRequest), request.PreviousName)
+	if err != nil {
+		if _, ok := err.(*types.DomainAlreadyExistsError); ok {
+			return err
+		}
+		return convertCommonErrors(m.db, "RenameDomain", err)
+	}
+
+	return nil
+}
+
+func toDomainRow(request *persistence.InternalUpdateDomainRequest) *nosqlplugin.DomainRow {
+	return &nosqlplugin.DomainRow{
 		Info:                        request.Info,
 		Config:                      request.Config,
 		ReplicationConfig:           request.ReplicationConfig,
@@ -105,13 +129,6 @@ func (m *nosqlDomainStore) UpdateDomain(
 		NotificationVersion:         request.NotificationVersion,
 		LastUpdatedTime:             request.LastUpdatedTime,
 	}
-
-	err := m.db.UpdateDomain(ctx, row)
-	if err != nil {
-		return convertCommonErrors(m.db, "UpdateDomain", err)
-	}
-
-	return nil
 }
 
 func (m *nosqlDomainStore) GetDomain(
@@ -272,6 +272,57 @@ func TestUpdateDomain(t *testing.T) {
 	}
 }
 
+func TestRenameDomain(t *testing.T) {
+	request := &persistence.InternalRenameDomainRequest{
+		PreviousName: "previous-domain",
+		InternalUpdateDomainRequest: persistence.InternalUpdateDomainRequest{
+			Info:              testFixtureDomainInfo(),
+			Config:            testFixtureInternalDomainConfig(),
+			ReplicationConfig: testFixtureDomainReplicationConfig(),
+			ConfigVersion:     1,
+			FailoverVersion:   2,
+			LastUpdatedTime:   time.Unix(1, 2),
+		},
+	}
+	testCases := []struct {
+		name        string
+		setupMock   func(*nosqlplugin.MockDB)
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMock: func(dbMock *nosqlplugin.MockDB) {
+				dbMock.EXPECT().RenameDomain(gomock.Any(), &nosqlplugin.DomainRow{
+					Info:              testFixtureDomainInfo(),
+					Config:            testFixtureInternalDomainConfig(),
+					ReplicationConfig: testFixtureDomainReplicationConfig(),
+					ConfigVersion:     1,
+					FailoverVersion:   2,
+					LastUpdatedTime:   time.Unix(1, 2),
+				}, "previous-domain").Return(nil).Times(1)
+			},
+		},
+		{
+			name: "domain already exists",
+			setupMock: func(dbMock *nosqlplugin.MockDB) {
+				dbMock.EXPECT().RenameDomain(gomock.Any(), gomock.Any(), "previous-domain").
+					Return(&types.DomainAlreadyExistsError{Message: "exists"}).Times(1)
+			},
+			expectedErr: &types.DomainAlreadyExistsError{Message: "exists"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store, dbMock, _ := setUpMocksForDomainStore(t)
+			tc.setupMock(dbMock)
+
+			err := store.RenameDomain(context.Background(), request)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
 func TestGetDomain(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -224,6 +224,109 @@ func (db *cdb) UpdateDomain(ctx context.Context, row *nosqlplugin.DomainRow) err
 	return nil
 }
 
+// Rename a domain
+// The domains table is pointed to the new name first, then the domain is moved to its new name
+// in domains_by_name_v2 within one conditional batch. The domains table is reverted if the batch fails.
+func (db *cdb) RenameDomain(ctx context.Context, row *nosqlplugin.DomainRow, previousName string) error {
+	previous, err := db.SelectDomain(ctx, nil, &previousName)
+	if err != nil {
+		return err
+	}
+	if previous.Info.ID != row.Info.ID {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+
+	query := db.session.Query(templateRenameDomainQuery, row.Info.Name, row.Info.ID).WithContext(ctx)
+	if err := query.Exec(); err != nil {
+		return err
+	}
+	revert := func() {
+		query := db.session.Query(templateRenameDomainQuery, previousName, row.Info.ID).WithContext(ctx)
+		if errRevert := query.Exec(); errRevert != nil {
+			db.logger.Warn("Unable to revert domain record of failed rename. Error", tag.Error(errRevert))
+		}
+	}
+
+	timeStamp := db.timeSrc.Now()
+	batch := db.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	failoverEndTime := emptyFailoverEndTime
+	if row.FailoverEndTime != nil {
+		failoverEndTime = row.FailoverEndTime.UnixNano()
+	}
+	isolationGroupData, isolationGroupEncoding := getIsolationGroupFields(row)
+	asyncWFConfigData, asyncWFConfigEncoding := getAsyncWFConfigFields(row)
+
+	batch.Query(templateCreateDomainByNameQueryWithinBatchV2,
+		constDomainPartition,
+		row.Info.Name,
+		row.Info.ID,
+		row.Info.Name,
+		row.Info.Status,
+		row.Info.Description,
+		row.Info.OwnerEmail,
+		row.Info.Data,
+		common.DurationToDays(row.Config.Retention),
+		row.Config.EmitMetric,
+		row.Config.ArchivalBucket,
+		row.Config.ArchivalStatus,
+		row.Config.HistoryArchivalStatus,
+		row.Config.HistoryArchivalURI,
+		row.Config.VisibilityArchivalStatus,
+		row.Config.VisibilityArchivalURI,
+		row.Config.BadBinaries.Data,
+		string(row.Config.BadBinaries.Encoding),
+		isolationGroupData,
+		isolationGroupEncoding,
+		asyncWFConfigData,
+		asyncWFConfigEncoding,
+		row.ReplicationConfig.ActiveClusterName,
+		persistence.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
+		previous.IsGlobalDomain,
+		row.ConfigVersion,
+		row.FailoverVersion,
+		row.FailoverNotificationVersion,
+		row.PreviousFailoverVersion,
+		failoverEndTime,
+		row.LastUpdatedTime.UnixNano(),
+		row.NotificationVersion,
+		timeStamp,
+	)
+	batch.Query(templateDeleteDomainByNameQueryV2, constDomainPartition, previousName)
+	db.updateMetadataBatch(batch, row.NotificationVersion)
+
+	previousRow := make(map[string]interface{})
+	applied, iter, err := db.session.MapExecuteBatchCAS(batch, previousRow)
+	defer func() {
+		if iter != nil {
+			_ = iter.Close()
+		}
+	}()
+
+	if err != nil {
+		revert()
+		return err
+	}
+	if !applied {
+		revert()
+		for {
+			// first iter MapScan is done inside MapExecuteBatchCAS
+			if domain, ok := previousRow["name"].(string); ok && domain == row.Info.Name {
+				db.logger.Warn("Domain already exists", tag.WorkflowDomainName(domain))
+				return &types.DomainAlreadyExistsError{
+					Message: fmt.Sprintf("Domain %v already exists", domain),
+				}
+			}
+
+			previousRow = make(map[string]interface{})
+			if !iter.MapScan(previousRow) {
+				break
+			}
+		}
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
+}
+
 // Get one domain data, either by domainID or domainName
 func (db *cdb) SelectDomain(
 	ctx context.Context,
@@ -43,6 +43,15 @@ func (db *ddb) UpdateDomain(
 	panic("TODO")
 }
 
+// Rename a domain
+func (db *ddb) RenameDomain(
+	ctx context.Context,
+	row *nosqlplugin.DomainRow,
+	previousName string,
+) error {
+	panic("TODO")
+}
+
 // Get one domain data, either by domainID or domainName
 func (db *ddb) SelectDomain(
 	ctx context.Context,
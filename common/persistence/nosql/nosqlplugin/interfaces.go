@@ -178,6 +178,10 @@ type (
 		// Update domain data
 		// Must return ConditionFailure error if update condition doesn't match
 		UpdateDomain(ctx context.Context, row *DomainRow) error
+		// Rename a domain from previousName to the name of the row, updating it to the row
+		// return types.DomainAlreadyExistsError error if another domain has the new name
+		// Must return ConditionFailure error if update condition doesn't match
+		RenameDomain(ctx context.Context, row *DomainRow, previousName string) error
 		// Get one domain data, either by domainID or domainName
 		SelectDomain(ctx context.Context, domainID *string, domainName *string) (*DomainRow, error)
 		// Get all domain data
@@ -764,6 +764,20 @@ func (mr *MockDBMockRecorder) RangeDeleteTransferTasks(ctx, shardID, exclusiveBe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteTransferTasks", reflect.TypeOf((*MockDB)(nil).RangeDeleteTransferTasks), ctx, shardID, exclusiveBeginTaskID, inclusiveEndTaskID)
 }
 
+// RenameDomain mocks base method.
+func (m *MockDB) RenameDomain(ctx context.Context, row *DomainRow, previousName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDomain", ctx, row, previousName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDomain indicates an expected call of RenameDomain.
+func (mr *MockDBMockRecorder) RenameDomain(ctx, row, previousName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockDB)(nil).RenameDomain), ctx, row, previousName)
+}
+
 // SelectAllCurrentWorkflows mocks base method.
 func (m *MockDB) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
 	m.ctrl.T.Helper()
@@ -1810,6 +1824,20 @@ func (mr *MocktableCRUDMockRecorder) RangeDeleteTransferTasks(ctx, shardID, excl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteTransferTasks", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteTransferTasks), ctx, shardID, exclusiveBeginTaskID, inclusiveEndTaskID)
 }
 
+// RenameDomain mocks base method.
+func (m *MocktableCRUD) RenameDomain(ctx context.Context, row *DomainRow, previousName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDomain", ctx, row, previousName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDomain indicates an expected call of RenameDomain.
+func (mr *MocktableCRUDMockRecorder) RenameDomain(ctx, row, previousName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MocktableCRUD)(nil).RenameDomain), ctx, row, previousName)
+}
+
 // SelectAllCurrentWorkflows mocks base method.
 func (m *MocktableCRUD) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
 	m.ctrl.T.Helper()
@@ -2737,6 +2765,20 @@ func (mr *MockDomainCRUDMockRecorder) InsertDomain(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDomain", reflect.TypeOf((*MockDomainCRUD)(nil).InsertDomain), ctx, row)
 }
 
+// RenameDomain mocks base method.
+func (m *MockDomainCRUD) RenameDomain(ctx context.Context, row *DomainRow, previousName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDomain", ctx, row, previousName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDomain indicates an expected call of RenameDomain.
+func (mr *MockDomainCRUDMockRecorder) RenameDomain(ctx, row, previousName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockDomainCRUD)(nil).RenameDomain), ctx, row, previousName)
+}
+
 // SelectAllDomains mocks base method.
 func (m *MockDomainCRUD) SelectAllDomains(ctx context.Context, pageSize int, pageToken []byte) ([]*DomainRow, []byte, error) {
 	m.ctrl.T.Helper()
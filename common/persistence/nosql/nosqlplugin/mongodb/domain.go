@@ -43,6 +43,15 @@ func (db *mdb) UpdateDomain(
 	panic("TODO")
 }
 
+// Rename a domain
+func (db *mdb) RenameDomain(
+	ctx context.Context,
+	row *nosqlplugin.DomainRow,
+	previousName string,
+) error {
+	panic("TODO")
+}
+
 // Get one domain data, either by domainID or domainName
 func (db *mdb) SelectDomain(
 	ctx context.Context,
@@ -1119,6 +1119,91 @@ func (m *MetadataPersistenceSuiteV2) TestDeleteDomain() {
 }
 
 // TestListDomains test
+// TestRenameDomain test
+func (m *MetadataPersistenceSuiteV2) TestRenameDomain() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	id := uuid.New()
+	name := "rename-domain-test-name"
+	newName := "rename-domain-test-new-name"
+	otherName := "rename-domain-test-other-name"
+	clusterActive := "some random active cluster name"
+	replicationConfig := &p.DomainReplicationConfig{
+		ActiveClusterName: clusterActive,
+		Clusters:          []*p.ClusterReplicationConfig{{ClusterName: clusterActive}},
+	}
+	config := &p.DomainConfig{
+		Retention:  10,
+		EmitMetric: true,
+	}
+
+	_, err := m.CreateDomain(
+		ctx,
+		&p.DomainInfo{ID: id, Name: name, Status: p.DomainStatusRegistered, Data: map[string]string{}},
+		config,
+		replicationConfig,
+		true,
+		1,
+		2,
+		0,
+	)
+	m.NoError(err)
+	_, err = m.CreateDomain(
+		ctx,
+		&p.DomainInfo{ID: uuid.New(), Name: otherName, Status: p.DomainStatusRegistered, Data: map[string]string{}},
+		config,
+		replicationConfig,
+		true,
+		1,
+		2,
+		0,
+	)
+	m.NoError(err)
+
+	renameRequest := func(newName string) *p.RenameDomainRequest {
+		metadata, err := m.DomainManager.GetMetadata(ctx)
+		m.NoError(err)
+		resp, err := m.GetDomain(ctx, id, "")
+		m.NoError(err)
+		resp.Info.Name = newName
+		return &p.RenameDomainRequest{
+			PreviousName: name,
+			UpdateDomainRequest: p.UpdateDomainRequest{
+				Info:                        resp.Info,
+				Config:                      resp.Config,
+				ReplicationConfig:           resp.ReplicationConfig,
+				ConfigVersion:               resp.ConfigVersion + 1,
+				FailoverVersion:             resp.FailoverVersion,
+				FailoverNotificationVersion: resp.FailoverNotificationVersion,
+				PreviousFailoverVersion:     resp.PreviousFailoverVersion,
+				NotificationVersion:         metadata.NotificationVersion,
+				LastUpdatedTime:             time.Now().UnixNano(),
+			},
+		}
+	}
+
+	err = m.DomainManager.RenameDomain(ctx, renameRequest(otherName))
+	m.IsType(&types.DomainAlreadyExistsError{}, err)
+	resp, err := m.GetDomain(ctx, id, "")
+	m.NoError(err)
+	m.Equal(name, resp.Info.Name)
+
+	err = m.DomainManager.RenameDomain(ctx, renameRequest(newName))
+	m.NoError(err)
+
+	resp, err = m.GetDomain(ctx, id, "")
+	m.NoError(err)
+	m.Equal(newName, resp.Info.Name)
+	m.Equal(int64(2), resp.ConfigVersion)
+	m.True(resp.IsGlobalDomain)
+	resp, err = m.GetDomain(ctx, "", newName)
+	m.NoError(err)
+	m.Equal(id, resp.Info.ID)
+	_, err = m.GetDomain(ctx, "", name)
+	m.IsType(&types.EntityNotExistsError{}, err)
+}
+
 func (m *MetadataPersistenceSuiteV2) TestListDomains() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
 	defer cancel()
@@ -0,0 +1,78 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+// This file defines method for persistence requests that affects ratelimited persistence wrapper.
+
+// If GetDomainName() string is defined, then per-operation limits are scoped by the domain of the request.
+// Requests of the execution manager define it in metered.go.
+
+func (r ReadHistoryBranchRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r AppendHistoryNodesRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r DeleteHistoryBranchRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r ForkHistoryBranchRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r GetHistoryTreeRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r CompleteTaskRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r CompleteTasksLessThanRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r CreateTasksRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r DeleteTaskListRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r GetTasksRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r LeaseTaskListRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r UpdateTaskListRequest) GetDomainName() string {
+	return r.DomainName
+}
+
+func (r GetTaskListSizeRequest) GetDomainName() string {
+	return r.DomainName
+}
@@ -365,6 +365,12 @@ func (m *sqlDomainStore) UpdateDomain(
 			DataEncoding: string(blob.Encoding),
 		})
 		if err != nil {
+			if m.db.IsDupEntryError(err) {
+				// the domain is renamed to the name of another domain
+				return &types.DomainAlreadyExistsError{
+					Message: fmt.Sprintf("name: %v", request.Info.Name),
+				}
+			}
 			return err
 		}
 		noRowsAffected, err := result.RowsAffected()
@@ -381,6 +387,14 @@ func (m *sqlDomainStore) UpdateDomain(
 	})
 }
 
+func (m *sqlDomainStore) RenameDomain(
+	ctx context.Context,
+	request *persistence.InternalRenameDomainRequest,
+) error {
+	// domain rows are keyed by ID, updating the row moves the domain to its new name
+	return m.UpdateDomain(ctx, &request.InternalUpdateDomainRequest)
+}
+
 func (m *sqlDomainStore) DeleteDomain(
 	ctx context.Context,
 	request *persistence.DeleteDomainRequest,
@@ -768,6 +768,27 @@ func TestUpdateDomain(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:              "Error case - name taken by another domain",
+			activeClusterName: "active",
+			req: &persistence.InternalUpdateDomainRequest{
+				Info: &persistence.DomainInfo{
+					ID:   "9a3dc7e2-1e67-41aa-8eaf-6d6e27f7e47c",
+					Name: "test",
+				},
+				Config:            &persistence.InternalDomainConfig{},
+				ReplicationConfig: &persistence.DomainReplicationConfig{},
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB, mockTx *sqlplugin.MockTx, mockParser *serialization.MockParser) {
+				mockParser.EXPECT().DomainInfoToBlob(gomock.Any()).Return(persistence.DataBlob{Data: []byte(`aaaa`), Encoding: common.EncodingTypeThriftRW}, nil)
+				mockDB.EXPECT().BeginTx(gomock.Any(), sqlplugin.DbDefaultShard).Return(mockTx, nil)
+				err := errors.New("duplicate entry")
+				mockTx.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, err)
+				mockDB.EXPECT().IsDupEntryError(err).Return(true)
+				mockTx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: true,
+		},
 		{
 			name:              "Error case - unable to update row",
 			activeClusterName: "active",
@@ -789,6 +810,7 @@ func TestUpdateDomain(t *testing.T) {
 					Data:         []byte(`aaaa`),
 					DataEncoding: string(common.EncodingTypeThriftRW),
 				}).Return(nil, err)
+				mockDB.EXPECT().IsDupEntryError(err).Return(false)
 				mockTx.EXPECT().Rollback().Return(nil)
 				mockDB.EXPECT().IsNotFoundError(err).Return(true)
 			},
@@ -152,6 +152,21 @@ func (c *injectorDomainManager) ListDomains(ctx context.Context, request *persis
 	return
 }
 
+func (c *injectorDomainManager) RenameDomain(ctx context.Context, request *persistence.RenameDomainRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.RenameDomain(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "DomainManager.RenameDomain", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *injectorDomainManager) UpdateDomain(ctx context.Context, request *persistence.UpdateDomainRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate)
 	var forwardCall bool
@@ -144,6 +144,8 @@ func domainManagerTags(op string) *tag.Tag {
 		return &tag.StoreOperationCreateDomain
 	case "DomainManager.GetDomain":
 		return &tag.StoreOperationGetDomain
+	case "DomainManager.RenameDomain":
+		return &tag.StoreOperationRenameDomain
 	case "DomainManager.DeleteDomain":
 		return &tag.StoreOperationDeleteDomain
 	case "DomainManager.DeleteDomainByName":
@@ -132,6 +132,17 @@ func (c *meteredDomainManager) ListDomains(ctx context.Context, request *persist
 	return
 }
 
+func (c *meteredDomainManager) RenameDomain(ctx context.Context, request *persistence.RenameDomainRequest) (err error) {
+	op := func() error {
+		err = c.wrapped.RenameDomain(ctx, request)
+		c.emptyMetric("DomainManager.RenameDomain", request, err, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceRenameDomainScope, op, getCustomMetricTags(request)...)
+	return
+}
+
 func (c *meteredDomainManager) UpdateDomain(ctx context.Context, request *persistence.UpdateDomainRequest) (err error) {
 	op := func() error {
 		err = c.wrapped.UpdateDomain(ctx, request)
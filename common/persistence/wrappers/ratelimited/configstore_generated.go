@@ -35,18 +35,22 @@ import (
 
 // ratelimitedConfigStoreManager implements persistence.ConfigStoreManager interface instrumented with rate limiter.
 type ratelimitedConfigStoreManager struct {
-	wrapped     persistence.ConfigStoreManager
-	rateLimiter quotas.Limiter
+	wrapped           persistence.ConfigStoreManager
+	rateLimiter       quotas.Limiter
+	operationLimiters quotas.ICollection
 }
 
 // NewConfigStoreManager creates a new instance of ConfigStoreManager with ratelimiter.
+// Either limiter may be nil, operationLimiters are keyed by domain and operation.
 func NewConfigStoreManager(
 	wrapped persistence.ConfigStoreManager,
 	rateLimiter quotas.Limiter,
+	operationLimiters quotas.ICollection,
 ) persistence.ConfigStoreManager {
 	return &ratelimitedConfigStoreManager{
-		wrapped:     wrapped,
-		rateLimiter: rateLimiter,
+		wrapped:           wrapped,
+		rateLimiter:       rateLimiter,
+		operationLimiters: operationLimiters,
 	}
 }
 
@@ -56,7 +60,7 @@ func (c *ratelimitedConfigStoreManager) Close() {
 }
 
 func (c *ratelimitedConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfgType persistence.ConfigType) (fp1 *persistence.FetchDynamicConfigResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ConfigStoreManager.FetchDynamicConfig", cfgType); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -64,7 +68,7 @@ func (c *ratelimitedConfigStoreManager) FetchDynamicConfig(ctx context.Context,
 }
 
 func (c *ratelimitedConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *persistence.UpdateDynamicConfigRequest, cfgType persistence.ConfigType) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ConfigStoreManager.UpdateDynamicConfig", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
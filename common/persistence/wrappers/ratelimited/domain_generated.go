@@ -35,18 +35,22 @@ import (
 
 // ratelimitedDomainManager implements persistence.DomainManager interface instrumented with rate limiter.
 type ratelimitedDomainManager struct {
-	wrapped     persistence.DomainManager
-	rateLimiter quotas.Limiter
+	wrapped           persistence.DomainManager
+	rateLimiter       quotas.Limiter
+	operationLimiters quotas.ICollection
 }
 
 // NewDomainManager creates a new instance of DomainManager with ratelimiter.
+// Either limiter may be nil, operationLimiters are keyed by domain and operation.
 func NewDomainManager(
 	wrapped persistence.DomainManager,
 	rateLimiter quotas.Limiter,
+	operationLimiters quotas.ICollection,
 ) persistence.DomainManager {
 	return &ratelimitedDomainManager{
-		wrapped:     wrapped,
-		rateLimiter: rateLimiter,
+		wrapped:           wrapped,
+		rateLimiter:       rateLimiter,
+		operationLimiters: operationLimiters,
 	}
 }
 
@@ -56,7 +60,7 @@ func (c *ratelimitedDomainManager) Close() {
 }
 
 func (c *ratelimitedDomainManager) CreateDomain(ctx context.Context, request *persistence.CreateDomainRequest) (cp1 *persistence.CreateDomainResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "DomainManager.CreateDomain", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -64,7 +68,7 @@ func (c *ratelimitedDomainManager) CreateDomain(ctx context.Context, request *pe
 }
 
 func (c *ratelimitedDomainManager) DeleteDomain(ctx context.Context, request *persistence.DeleteDomainRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "DomainManager.DeleteDomain", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -72,7 +76,7 @@ func (c *ratelimitedDomainManager) DeleteDomain(ctx context.Context, request *pe
 }
 
 func (c *ratelimitedDomainManager) DeleteDomainByName(ctx context.Context, request *persistence.DeleteDomainByNameRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "DomainManager.DeleteDomainByName", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -80,7 +84,7 @@ func (c *ratelimitedDomainManager) DeleteDomainByName(ctx context.Context, reque
 }
 
 func (c *ratelimitedDomainManager) GetDomain(ctx context.Context, request *persistence.GetDomainRequest) (gp1 *persistence.GetDomainResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "DomainManager.GetDomain", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -88,7 +92,7 @@ func (c *ratelimitedDomainManager) GetDomain(ctx context.Context, request *persi
 }
 
 func (c *ratelimitedDomainManager) GetMetadata(ctx context.Context) (gp1 *persistence.GetMetadataResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "DomainManager.GetMetadata", nil); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -100,15 +104,23 @@ func (c *ratelimitedDomainManager) GetName() (s1 string) {
 }
 
 func (c *ratelimitedDomainManager) ListDomains(ctx context.Context, request *persistence.ListDomainsRequest) (lp1 *persistence.ListDomainsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "DomainManager.ListDomains", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
 	return c.wrapped.ListDomains(ctx, request)
 }
 
+func (c *ratelimitedDomainManager) RenameDomain(ctx context.Context, request *persistence.RenameDomainRequest) (err error) {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "DomainManager.RenameDomain", request); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.RenameDomain(ctx, request)
+}
+
 func (c *ratelimitedDomainManager) UpdateDomain(ctx context.Context, request *persistence.UpdateDomainRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "DomainManager.UpdateDomain", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -35,18 +35,22 @@ import (
 
 // ratelimitedExecutionManager implements persistence.ExecutionManager interface instrumented with rate limiter.
 type ratelimitedExecutionManager struct {
-	wrapped     persistence.ExecutionManager
-	rateLimiter quotas.Limiter
+	wrapped           persistence.ExecutionManager
+	rateLimiter       quotas.Limiter
+	operationLimiters quotas.ICollection
 }
 
 // NewExecutionManager creates a new instance of ExecutionManager with ratelimiter.
+// Either limiter may be nil, operationLimiters are keyed by domain and operation.
 func NewExecutionManager(
 	wrapped persistence.ExecutionManager,
 	rateLimiter quotas.Limiter,
+	operationLimiters quotas.ICollection,
 ) persistence.ExecutionManager {
 	return &ratelimitedExecutionManager{
-		wrapped:     wrapped,
-		rateLimiter: rateLimiter,
+		wrapped:           wrapped,
+		rateLimiter:       rateLimiter,
+		operationLimiters: operationLimiters,
 	}
 }
 
@@ -56,7 +60,7 @@ func (c *ratelimitedExecutionManager) Close() {
 }
 
 func (c *ratelimitedExecutionManager) CompleteReplicationTask(ctx context.Context, request *persistence.CompleteReplicationTaskRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.CompleteReplicationTask", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -64,7 +68,7 @@ func (c *ratelimitedExecutionManager) CompleteReplicationTask(ctx context.Contex
 }
 
 func (c *ratelimitedExecutionManager) CompleteTimerTask(ctx context.Context, request *persistence.CompleteTimerTaskRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.CompleteTimerTask", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -72,7 +76,7 @@ func (c *ratelimitedExecutionManager) CompleteTimerTask(ctx context.Context, req
 }
 
 func (c *ratelimitedExecutionManager) CompleteTransferTask(ctx context.Context, request *persistence.CompleteTransferTaskRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.CompleteTransferTask", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -80,7 +84,7 @@ func (c *ratelimitedExecutionManager) CompleteTransferTask(ctx context.Context,
 }
 
 func (c *ratelimitedExecutionManager) ConflictResolveWorkflowExecution(ctx context.Context, request *persistence.ConflictResolveWorkflowExecutionRequest) (cp1 *persistence.ConflictResolveWorkflowExecutionResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.ConflictResolveWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -88,7 +92,7 @@ func (c *ratelimitedExecutionManager) ConflictResolveWorkflowExecution(ctx conte
 }
 
 func (c *ratelimitedExecutionManager) CreateFailoverMarkerTasks(ctx context.Context, request *persistence.CreateFailoverMarkersRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.CreateFailoverMarkerTasks", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -96,7 +100,7 @@ func (c *ratelimitedExecutionManager) CreateFailoverMarkerTasks(ctx context.Cont
 }
 
 func (c *ratelimitedExecutionManager) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (cp1 *persistence.CreateWorkflowExecutionResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.CreateWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -104,7 +108,7 @@ func (c *ratelimitedExecutionManager) CreateWorkflowExecution(ctx context.Contex
 }
 
 func (c *ratelimitedExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Context, request *persistence.DeleteCurrentWorkflowExecutionRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.DeleteCurrentWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -112,7 +116,7 @@ func (c *ratelimitedExecutionManager) DeleteCurrentWorkflowExecution(ctx context
 }
 
 func (c *ratelimitedExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.DeleteReplicationTaskFromDLQRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.DeleteReplicationTaskFromDLQ", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -120,7 +124,7 @@ func (c *ratelimitedExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.C
 }
 
 func (c *ratelimitedExecutionManager) DeleteWorkflowExecution(ctx context.Context, request *persistence.DeleteWorkflowExecutionRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.DeleteWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -128,7 +132,7 @@ func (c *ratelimitedExecutionManager) DeleteWorkflowExecution(ctx context.Contex
 }
 
 func (c *ratelimitedExecutionManager) GetCurrentExecution(ctx context.Context, request *persistence.GetCurrentExecutionRequest) (gp1 *persistence.GetCurrentExecutionResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.GetCurrentExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -140,7 +144,7 @@ func (c *ratelimitedExecutionManager) GetName() (s1 string) {
 }
 
 func (c *ratelimitedExecutionManager) GetReplicationDLQSize(ctx context.Context, request *persistence.GetReplicationDLQSizeRequest) (gp1 *persistence.GetReplicationDLQSizeResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.GetReplicationDLQSize", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -148,7 +152,7 @@ func (c *ratelimitedExecutionManager) GetReplicationDLQSize(ctx context.Context,
 }
 
 func (c *ratelimitedExecutionManager) GetReplicationTasks(ctx context.Context, request *persistence.GetReplicationTasksRequest) (gp1 *persistence.GetReplicationTasksResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.GetReplicationTasks", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -156,7 +160,7 @@ func (c *ratelimitedExecutionManager) GetReplicationTasks(ctx context.Context, r
 }
 
 func (c *ratelimitedExecutionManager) GetReplicationTasksFromDLQ(ctx context.Context, request *persistence.GetReplicationTasksFromDLQRequest) (gp1 *persistence.GetReplicationTasksFromDLQResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.GetReplicationTasksFromDLQ", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -168,7 +172,7 @@ func (c *ratelimitedExecutionManager) GetShardID() (i1 int) {
 }
 
 func (c *ratelimitedExecutionManager) GetTimerIndexTasks(ctx context.Context, request *persistence.GetTimerIndexTasksRequest) (gp1 *persistence.GetTimerIndexTasksResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.GetTimerIndexTasks", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -176,7 +180,7 @@ func (c *ratelimitedExecutionManager) GetTimerIndexTasks(ctx context.Context, re
 }
 
 func (c *ratelimitedExecutionManager) GetTransferTasks(ctx context.Context, request *persistence.GetTransferTasksRequest) (gp1 *persistence.GetTransferTasksResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.GetTransferTasks", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -184,7 +188,7 @@ func (c *ratelimitedExecutionManager) GetTransferTasks(ctx context.Context, requ
 }
 
 func (c *ratelimitedExecutionManager) GetWorkflowExecution(ctx context.Context, request *persistence.GetWorkflowExecutionRequest) (gp1 *persistence.GetWorkflowExecutionResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.GetWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -192,7 +196,7 @@ func (c *ratelimitedExecutionManager) GetWorkflowExecution(ctx context.Context,
 }
 
 func (c *ratelimitedExecutionManager) IsWorkflowExecutionExists(ctx context.Context, request *persistence.IsWorkflowExecutionExistsRequest) (ip1 *persistence.IsWorkflowExecutionExistsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.IsWorkflowExecutionExists", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -200,7 +204,7 @@ func (c *ratelimitedExecutionManager) IsWorkflowExecutionExists(ctx context.Cont
 }
 
 func (c *ratelimitedExecutionManager) ListConcreteExecutions(ctx context.Context, request *persistence.ListConcreteExecutionsRequest) (lp1 *persistence.ListConcreteExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.ListConcreteExecutions", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -208,7 +212,7 @@ func (c *ratelimitedExecutionManager) ListConcreteExecutions(ctx context.Context
 }
 
 func (c *ratelimitedExecutionManager) ListCurrentExecutions(ctx context.Context, request *persistence.ListCurrentExecutionsRequest) (lp1 *persistence.ListCurrentExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.ListCurrentExecutions", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -216,7 +220,7 @@ func (c *ratelimitedExecutionManager) ListCurrentExecutions(ctx context.Context,
 }
 
 func (c *ratelimitedExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *persistence.PutReplicationTaskToDLQRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.PutReplicationTaskToDLQ", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -224,7 +228,7 @@ func (c *ratelimitedExecutionManager) PutReplicationTaskToDLQ(ctx context.Contex
 }
 
 func (c *ratelimitedExecutionManager) RangeCompleteReplicationTask(ctx context.Context, request *persistence.RangeCompleteReplicationTaskRequest) (rp1 *persistence.RangeCompleteReplicationTaskResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.RangeCompleteReplicationTask", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -232,7 +236,7 @@ func (c *ratelimitedExecutionManager) RangeCompleteReplicationTask(ctx context.C
 }
 
 func (c *ratelimitedExecutionManager) RangeCompleteTimerTask(ctx context.Context, request *persistence.RangeCompleteTimerTaskRequest) (rp1 *persistence.RangeCompleteTimerTaskResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.RangeCompleteTimerTask", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -240,7 +244,7 @@ func (c *ratelimitedExecutionManager) RangeCompleteTimerTask(ctx context.Context
 }
 
 func (c *ratelimitedExecutionManager) RangeCompleteTransferTask(ctx context.Context, request *persistence.RangeCompleteTransferTaskRequest) (rp1 *persistence.RangeCompleteTransferTaskResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.RangeCompleteTransferTask", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -248,7 +252,7 @@ func (c *ratelimitedExecutionManager) RangeCompleteTransferTask(ctx context.Cont
 }
 
 func (c *ratelimitedExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.RangeDeleteReplicationTaskFromDLQRequest) (rp1 *persistence.RangeDeleteReplicationTaskFromDLQResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.RangeDeleteReplicationTaskFromDLQ", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -256,7 +260,7 @@ func (c *ratelimitedExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx cont
 }
 
 func (c *ratelimitedExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (up1 *persistence.UpdateWorkflowExecutionResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ExecutionManager.UpdateWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
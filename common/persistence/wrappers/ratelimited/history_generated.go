@@ -35,23 +35,27 @@ import (
 
 // ratelimitedHistoryManager implements persistence.HistoryManager interface instrumented with rate limiter.
 type ratelimitedHistoryManager struct {
-	wrapped     persistence.HistoryManager
-	rateLimiter quotas.Limiter
+	wrapped           persistence.HistoryManager
+	rateLimiter       quotas.Limiter
+	operationLimiters quotas.ICollection
 }
 
 // NewHistoryManager creates a new instance of HistoryManager with ratelimiter.
+// Either limiter may be nil, operationLimiters are keyed by domain and operation.
 func NewHistoryManager(
 	wrapped persistence.HistoryManager,
 	rateLimiter quotas.Limiter,
+	operationLimiters quotas.ICollection,
 ) persistence.HistoryManager {
 	return &ratelimitedHistoryManager{
-		wrapped:     wrapped,
-		rateLimiter: rateLimiter,
+		wrapped:           wrapped,
+		rateLimiter:       rateLimiter,
+		operationLimiters: operationLimiters,
 	}
 }
 
 func (c *ratelimitedHistoryManager) AppendHistoryNodes(ctx context.Context, request *persistence.AppendHistoryNodesRequest) (ap1 *persistence.AppendHistoryNodesResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "HistoryManager.AppendHistoryNodes", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -64,7 +68,7 @@ func (c *ratelimitedHistoryManager) Close() {
 }
 
 func (c *ratelimitedHistoryManager) DeleteHistoryBranch(ctx context.Context, request *persistence.DeleteHistoryBranchRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "HistoryManager.DeleteHistoryBranch", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -72,7 +76,7 @@ func (c *ratelimitedHistoryManager) DeleteHistoryBranch(ctx context.Context, req
 }
 
 func (c *ratelimitedHistoryManager) ForkHistoryBranch(ctx context.Context, request *persistence.ForkHistoryBranchRequest) (fp1 *persistence.ForkHistoryBranchResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "HistoryManager.ForkHistoryBranch", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -80,7 +84,7 @@ func (c *ratelimitedHistoryManager) ForkHistoryBranch(ctx context.Context, reque
 }
 
 func (c *ratelimitedHistoryManager) GetAllHistoryTreeBranches(ctx context.Context, request *persistence.GetAllHistoryTreeBranchesRequest) (gp1 *persistence.GetAllHistoryTreeBranchesResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "HistoryManager.GetAllHistoryTreeBranches", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -88,7 +92,7 @@ func (c *ratelimitedHistoryManager) GetAllHistoryTreeBranches(ctx context.Contex
 }
 
 func (c *ratelimitedHistoryManager) GetHistoryTree(ctx context.Context, request *persistence.GetHistoryTreeRequest) (gp1 *persistence.GetHistoryTreeResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "HistoryManager.GetHistoryTree", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -100,7 +104,7 @@ func (c *ratelimitedHistoryManager) GetName() (s1 string) {
 }
 
 func (c *ratelimitedHistoryManager) ReadHistoryBranch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (rp1 *persistence.ReadHistoryBranchResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "HistoryManager.ReadHistoryBranch", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -108,7 +112,7 @@ func (c *ratelimitedHistoryManager) ReadHistoryBranch(ctx context.Context, reque
 }
 
 func (c *ratelimitedHistoryManager) ReadHistoryBranchByBatch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (rp1 *persistence.ReadHistoryBranchByBatchResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "HistoryManager.ReadHistoryBranchByBatch", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -116,7 +120,7 @@ func (c *ratelimitedHistoryManager) ReadHistoryBranchByBatch(ctx context.Context
 }
 
 func (c *ratelimitedHistoryManager) ReadRawHistoryBranch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (rp1 *persistence.ReadRawHistoryBranchResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "HistoryManager.ReadRawHistoryBranch", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -0,0 +1,46 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ratelimited
+
+import (
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/quotas/global/shared"
+)
+
+type domainTaggedRequest interface {
+	GetDomainName() string
+}
+
+// allow checks the datastore limiter first, then the limiter of the operation for the domain of the request, if any.
+// Operation limiters are usually a global ratelimiter collection, sharing the limit across all hosts of a service.
+func allow(rateLimiter quotas.Limiter, operationLimiters quotas.ICollection, operation string, request any) bool {
+	if rateLimiter != nil && !rateLimiter.Allow() {
+		return false
+	}
+	if operationLimiters == nil {
+		return true
+	}
+	var domain string
+	if r, ok := request.(domainTaggedRequest); ok {
+		domain = r.GetDomainName()
+	}
+	return operationLimiters.For(string(shared.OperationKey(domain, operation))).Allow()
+}
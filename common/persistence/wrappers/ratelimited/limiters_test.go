@@ -0,0 +1,110 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ratelimited
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+)
+
+type recordingCollection struct {
+	limiter quotas.Limiter
+	keys    []string
+}
+
+func (c *recordingCollection) For(key string) quotas.Limiter {
+	c.keys = append(c.keys, key)
+	return c.limiter
+}
+
+func TestAllow(t *testing.T) {
+	tests := map[string]struct {
+		rateLimiter       quotas.Limiter
+		operationLimiter  quotas.Limiter
+		noOperationLimits bool
+		request           any
+		expected          bool
+		expectedKeys      []string
+	}{
+		"datastore limit reached": {
+			rateLimiter:      &limiterNeverAllow{},
+			operationLimiter: &limiterAlwaysAllow{},
+			request:          &persistence.CreateTasksRequest{DomainName: "test-domain"},
+			expected:         false,
+		},
+		"operation limit reached": {
+			rateLimiter:      &limiterAlwaysAllow{},
+			operationLimiter: &limiterNeverAllow{},
+			request:          &persistence.CreateTasksRequest{DomainName: "test-domain"},
+			expected:         false,
+			expectedKeys:     []string{"test-domain/TaskManager.CreateTasks"},
+		},
+		"no datastore limiter": {
+			operationLimiter: &limiterAlwaysAllow{},
+			request:          &persistence.CreateTasksRequest{DomainName: "test-domain"},
+			expected:         true,
+			expectedKeys:     []string{"test-domain/TaskManager.CreateTasks"},
+		},
+		"request without domain": {
+			rateLimiter:      &limiterAlwaysAllow{},
+			operationLimiter: &limiterAlwaysAllow{},
+			request:          &persistence.GetShardRequest{},
+			expected:         true,
+			expectedKeys:     []string{"TaskManager.CreateTasks"},
+		},
+		"no operation limiters": {
+			rateLimiter:       &limiterAlwaysAllow{},
+			noOperationLimits: true,
+			request:           &persistence.CreateTasksRequest{DomainName: "test-domain"},
+			expected:          true,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			collection := &recordingCollection{limiter: td.operationLimiter}
+			var operationLimiters quotas.ICollection = collection
+			if td.noOperationLimits {
+				operationLimiters = nil
+			}
+			assert.Equal(t, td.expected, allow(td.rateLimiter, operationLimiters, "TaskManager.CreateTasks", td.request))
+			assert.Equal(t, td.expectedKeys, collection.keys)
+		})
+	}
+}
+
+func TestOperationLimitersAreKeyedByDomainAndOperation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockExecutionManager(ctrl)
+	collection := &recordingCollection{limiter: &limiterAlwaysAllow{}}
+	wrapped := NewExecutionManager(mocked, nil, collection)
+
+	mocked.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{}, nil)
+	_, err := wrapped.GetWorkflowExecution(context.Background(), &persistence.GetWorkflowExecutionRequest{DomainName: "test-domain"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test-domain/ExecutionManager.GetWorkflowExecution"}, collection.keys)
+}
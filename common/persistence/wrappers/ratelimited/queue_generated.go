@@ -35,18 +35,22 @@ import (
 
 // ratelimitedQueueManager implements persistence.QueueManager interface instrumented with rate limiter.
 type ratelimitedQueueManager struct {
-	wrapped     persistence.QueueManager
-	rateLimiter quotas.Limiter
+	wrapped           persistence.QueueManager
+	rateLimiter       quotas.Limiter
+	operationLimiters quotas.ICollection
 }
 
 // NewQueueManager creates a new instance of QueueManager with ratelimiter.
+// Either limiter may be nil, operationLimiters are keyed by domain and operation.
 func NewQueueManager(
 	wrapped persistence.QueueManager,
 	rateLimiter quotas.Limiter,
+	operationLimiters quotas.ICollection,
 ) persistence.QueueManager {
 	return &ratelimitedQueueManager{
-		wrapped:     wrapped,
-		rateLimiter: rateLimiter,
+		wrapped:           wrapped,
+		rateLimiter:       rateLimiter,
+		operationLimiters: operationLimiters,
 	}
 }
 
@@ -56,7 +60,7 @@ func (c *ratelimitedQueueManager) Close() {
 }
 
 func (c *ratelimitedQueueManager) DeleteMessageFromDLQ(ctx context.Context, messageID int64) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.DeleteMessageFromDLQ", messageID); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -64,7 +68,7 @@ func (c *ratelimitedQueueManager) DeleteMessageFromDLQ(ctx context.Context, mess
 }
 
 func (c *ratelimitedQueueManager) DeleteMessagesBefore(ctx context.Context, messageID int64) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.DeleteMessagesBefore", messageID); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -72,7 +76,7 @@ func (c *ratelimitedQueueManager) DeleteMessagesBefore(ctx context.Context, mess
 }
 
 func (c *ratelimitedQueueManager) EnqueueMessage(ctx context.Context, messagePayload []byte) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.EnqueueMessage", messagePayload); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -80,7 +84,7 @@ func (c *ratelimitedQueueManager) EnqueueMessage(ctx context.Context, messagePay
 }
 
 func (c *ratelimitedQueueManager) EnqueueMessageToDLQ(ctx context.Context, messagePayload []byte) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.EnqueueMessageToDLQ", messagePayload); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -88,7 +92,7 @@ func (c *ratelimitedQueueManager) EnqueueMessageToDLQ(ctx context.Context, messa
 }
 
 func (c *ratelimitedQueueManager) GetAckLevels(ctx context.Context) (m1 map[string]int64, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.GetAckLevels", nil); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -96,7 +100,7 @@ func (c *ratelimitedQueueManager) GetAckLevels(ctx context.Context) (m1 map[stri
 }
 
 func (c *ratelimitedQueueManager) GetDLQAckLevels(ctx context.Context) (m1 map[string]int64, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.GetDLQAckLevels", nil); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -104,7 +108,7 @@ func (c *ratelimitedQueueManager) GetDLQAckLevels(ctx context.Context) (m1 map[s
 }
 
 func (c *ratelimitedQueueManager) GetDLQSize(ctx context.Context) (i1 int64, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.GetDLQSize", nil); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -112,7 +116,7 @@ func (c *ratelimitedQueueManager) GetDLQSize(ctx context.Context) (i1 int64, err
 }
 
 func (c *ratelimitedQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.RangeDeleteMessagesFromDLQ", firstMessageID); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -120,7 +124,7 @@ func (c *ratelimitedQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context
 }
 
 func (c *ratelimitedQueueManager) ReadMessages(ctx context.Context, lastMessageID int64, maxCount int) (q1 persistence.QueueMessageList, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.ReadMessages", lastMessageID); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -128,7 +132,7 @@ func (c *ratelimitedQueueManager) ReadMessages(ctx context.Context, lastMessageI
 }
 
 func (c *ratelimitedQueueManager) ReadMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) (qpa1 []*persistence.QueueMessage, ba1 []byte, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.ReadMessagesFromDLQ", firstMessageID); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -136,7 +140,7 @@ func (c *ratelimitedQueueManager) ReadMessagesFromDLQ(ctx context.Context, first
 }
 
 func (c *ratelimitedQueueManager) UpdateAckLevel(ctx context.Context, messageID int64, clusterName string) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.UpdateAckLevel", messageID); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -144,7 +148,7 @@ func (c *ratelimitedQueueManager) UpdateAckLevel(ctx context.Context, messageID
 }
 
 func (c *ratelimitedQueueManager) UpdateDLQAckLevel(ctx context.Context, messageID int64, clusterName string) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "QueueManager.UpdateDLQAckLevel", messageID); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -35,18 +35,22 @@ import (
 
 // ratelimitedShardManager implements persistence.ShardManager interface instrumented with rate limiter.
 type ratelimitedShardManager struct {
-	wrapped     persistence.ShardManager
-	rateLimiter quotas.Limiter
+	wrapped           persistence.ShardManager
+	rateLimiter       quotas.Limiter
+	operationLimiters quotas.ICollection
 }
 
 // NewShardManager creates a new instance of ShardManager with ratelimiter.
+// Either limiter may be nil, operationLimiters are keyed by domain and operation.
 func NewShardManager(
 	wrapped persistence.ShardManager,
 	rateLimiter quotas.Limiter,
+	operationLimiters quotas.ICollection,
 ) persistence.ShardManager {
 	return &ratelimitedShardManager{
-		wrapped:     wrapped,
-		rateLimiter: rateLimiter,
+		wrapped:           wrapped,
+		rateLimiter:       rateLimiter,
+		operationLimiters: operationLimiters,
 	}
 }
 
@@ -56,7 +60,7 @@ func (c *ratelimitedShardManager) Close() {
 }
 
 func (c *ratelimitedShardManager) CreateShard(ctx context.Context, request *persistence.CreateShardRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ShardManager.CreateShard", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -68,7 +72,7 @@ func (c *ratelimitedShardManager) GetName() (s1 string) {
 }
 
 func (c *ratelimitedShardManager) GetShard(ctx context.Context, request *persistence.GetShardRequest) (gp1 *persistence.GetShardResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ShardManager.GetShard", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -76,7 +80,7 @@ func (c *ratelimitedShardManager) GetShard(ctx context.Context, request *persist
 }
 
 func (c *ratelimitedShardManager) UpdateShard(ctx context.Context, request *persistence.UpdateShardRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "ShardManager.UpdateShard", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -35,18 +35,22 @@ import (
 
 // ratelimitedTaskManager implements persistence.TaskManager interface instrumented with rate limiter.
 type ratelimitedTaskManager struct {
-	wrapped     persistence.TaskManager
-	rateLimiter quotas.Limiter
+	wrapped           persistence.TaskManager
+	rateLimiter       quotas.Limiter
+	operationLimiters quotas.ICollection
 }
 
 // NewTaskManager creates a new instance of TaskManager with ratelimiter.
+// Either limiter may be nil, operationLimiters are keyed by domain and operation.
 func NewTaskManager(
 	wrapped persistence.TaskManager,
 	rateLimiter quotas.Limiter,
+	operationLimiters quotas.ICollection,
 ) persistence.TaskManager {
 	return &ratelimitedTaskManager{
-		wrapped:     wrapped,
-		rateLimiter: rateLimiter,
+		wrapped:           wrapped,
+		rateLimiter:       rateLimiter,
+		operationLimiters: operationLimiters,
 	}
 }
 
@@ -56,7 +60,7 @@ func (c *ratelimitedTaskManager) Close() {
 }
 
 func (c *ratelimitedTaskManager) CompleteTask(ctx context.Context, request *persistence.CompleteTaskRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.CompleteTask", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -64,7 +68,7 @@ func (c *ratelimitedTaskManager) CompleteTask(ctx context.Context, request *pers
 }
 
 func (c *ratelimitedTaskManager) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (cp1 *persistence.CompleteTasksLessThanResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.CompleteTasksLessThan", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -72,7 +76,7 @@ func (c *ratelimitedTaskManager) CompleteTasksLessThan(ctx context.Context, requ
 }
 
 func (c *ratelimitedTaskManager) CreateTasks(ctx context.Context, request *persistence.CreateTasksRequest) (cp1 *persistence.CreateTasksResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.CreateTasks", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -80,7 +84,7 @@ func (c *ratelimitedTaskManager) CreateTasks(ctx context.Context, request *persi
 }
 
 func (c *ratelimitedTaskManager) DeleteTaskList(ctx context.Context, request *persistence.DeleteTaskListRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.DeleteTaskList", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -92,7 +96,7 @@ func (c *ratelimitedTaskManager) GetName() (s1 string) {
 }
 
 func (c *ratelimitedTaskManager) GetOrphanTasks(ctx context.Context, request *persistence.GetOrphanTasksRequest) (gp1 *persistence.GetOrphanTasksResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.GetOrphanTasks", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -100,7 +104,7 @@ func (c *ratelimitedTaskManager) GetOrphanTasks(ctx context.Context, request *pe
 }
 
 func (c *ratelimitedTaskManager) GetTaskList(ctx context.Context, request *persistence.GetTaskListRequest) (gp1 *persistence.GetTaskListResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.GetTaskList", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -108,7 +112,7 @@ func (c *ratelimitedTaskManager) GetTaskList(ctx context.Context, request *persi
 }
 
 func (c *ratelimitedTaskManager) GetTaskListSize(ctx context.Context, request *persistence.GetTaskListSizeRequest) (gp1 *persistence.GetTaskListSizeResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.GetTaskListSize", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -116,7 +120,7 @@ func (c *ratelimitedTaskManager) GetTaskListSize(ctx context.Context, request *p
 }
 
 func (c *ratelimitedTaskManager) GetTasks(ctx context.Context, request *persistence.GetTasksRequest) (gp1 *persistence.GetTasksResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.GetTasks", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -124,7 +128,7 @@ func (c *ratelimitedTaskManager) GetTasks(ctx context.Context, request *persiste
 }
 
 func (c *ratelimitedTaskManager) LeaseTaskList(ctx context.Context, request *persistence.LeaseTaskListRequest) (lp1 *persistence.LeaseTaskListResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.LeaseTaskList", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -132,7 +136,7 @@ func (c *ratelimitedTaskManager) LeaseTaskList(ctx context.Context, request *per
 }
 
 func (c *ratelimitedTaskManager) ListTaskList(ctx context.Context, request *persistence.ListTaskListRequest) (lp1 *persistence.ListTaskListResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.ListTaskList", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -140,7 +144,7 @@ func (c *ratelimitedTaskManager) ListTaskList(ctx context.Context, request *pers
 }
 
 func (c *ratelimitedTaskManager) UpdateTaskList(ctx context.Context, request *persistence.UpdateTaskListRequest) (up1 *persistence.UpdateTaskListResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "TaskManager.UpdateTaskList", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -35,18 +35,22 @@ import (
 
 // ratelimitedVisibilityManager implements persistence.VisibilityManager interface instrumented with rate limiter.
 type ratelimitedVisibilityManager struct {
-	wrapped     persistence.VisibilityManager
-	rateLimiter quotas.Limiter
+	wrapped           persistence.VisibilityManager
+	rateLimiter       quotas.Limiter
+	operationLimiters quotas.ICollection
 }
 
 // NewVisibilityManager creates a new instance of VisibilityManager with ratelimiter.
+// Either limiter may be nil, operationLimiters are keyed by domain and operation.
 func NewVisibilityManager(
 	wrapped persistence.VisibilityManager,
 	rateLimiter quotas.Limiter,
+	operationLimiters quotas.ICollection,
 ) persistence.VisibilityManager {
 	return &ratelimitedVisibilityManager{
-		wrapped:     wrapped,
-		rateLimiter: rateLimiter,
+		wrapped:           wrapped,
+		rateLimiter:       rateLimiter,
+		operationLimiters: operationLimiters,
 	}
 }
 
@@ -56,7 +60,7 @@ func (c *ratelimitedVisibilityManager) Close() {
 }
 
 func (c *ratelimitedVisibilityManager) CountWorkflowExecutions(ctx context.Context, request *persistence.CountWorkflowExecutionsRequest) (cp1 *persistence.CountWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.CountWorkflowExecutions", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -64,7 +68,7 @@ func (c *ratelimitedVisibilityManager) CountWorkflowExecutions(ctx context.Conte
 }
 
 func (c *ratelimitedVisibilityManager) DeleteUninitializedWorkflowExecution(ctx context.Context, request *persistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.DeleteUninitializedWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -72,7 +76,7 @@ func (c *ratelimitedVisibilityManager) DeleteUninitializedWorkflowExecution(ctx
 }
 
 func (c *ratelimitedVisibilityManager) DeleteWorkflowExecution(ctx context.Context, request *persistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.DeleteWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -80,7 +84,7 @@ func (c *ratelimitedVisibilityManager) DeleteWorkflowExecution(ctx context.Conte
 }
 
 func (c *ratelimitedVisibilityManager) GetClosedWorkflowExecution(ctx context.Context, request *persistence.GetClosedWorkflowExecutionRequest) (gp1 *persistence.GetClosedWorkflowExecutionResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.GetClosedWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -92,7 +96,7 @@ func (c *ratelimitedVisibilityManager) GetName() (s1 string) {
 }
 
 func (c *ratelimitedVisibilityManager) ListClosedWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.ListClosedWorkflowExecutions", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -100,7 +104,7 @@ func (c *ratelimitedVisibilityManager) ListClosedWorkflowExecutions(ctx context.
 }
 
 func (c *ratelimitedVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *persistence.ListClosedWorkflowExecutionsByStatusRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.ListClosedWorkflowExecutionsByStatus", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -108,7 +112,7 @@ func (c *ratelimitedVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx
 }
 
 func (c *ratelimitedVisibilityManager) ListClosedWorkflowExecutionsByType(ctx context.Context, request *persistence.ListWorkflowExecutionsByTypeRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.ListClosedWorkflowExecutionsByType", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -116,7 +120,7 @@ func (c *ratelimitedVisibilityManager) ListClosedWorkflowExecutionsByType(ctx co
 }
 
 func (c *ratelimitedVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -124,7 +128,7 @@ func (c *ratelimitedVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(
 }
 
 func (c *ratelimitedVisibilityManager) ListOpenWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.ListOpenWorkflowExecutions", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -132,7 +136,7 @@ func (c *ratelimitedVisibilityManager) ListOpenWorkflowExecutions(ctx context.Co
 }
 
 func (c *ratelimitedVisibilityManager) ListOpenWorkflowExecutionsByType(ctx context.Context, request *persistence.ListWorkflowExecutionsByTypeRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.ListOpenWorkflowExecutionsByType", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -140,7 +144,7 @@ func (c *ratelimitedVisibilityManager) ListOpenWorkflowExecutionsByType(ctx cont
 }
 
 func (c *ratelimitedVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -148,7 +152,7 @@ func (c *ratelimitedVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ct
 }
 
 func (c *ratelimitedVisibilityManager) ListWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsByQueryRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.ListWorkflowExecutions", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -156,7 +160,7 @@ func (c *ratelimitedVisibilityManager) ListWorkflowExecutions(ctx context.Contex
 }
 
 func (c *ratelimitedVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Context, request *persistence.RecordWorkflowExecutionClosedRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.RecordWorkflowExecutionClosed", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -164,7 +168,7 @@ func (c *ratelimitedVisibilityManager) RecordWorkflowExecutionClosed(ctx context
 }
 
 func (c *ratelimitedVisibilityManager) RecordWorkflowExecutionStarted(ctx context.Context, request *persistence.RecordWorkflowExecutionStartedRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.RecordWorkflowExecutionStarted", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -172,7 +176,7 @@ func (c *ratelimitedVisibilityManager) RecordWorkflowExecutionStarted(ctx contex
 }
 
 func (c *ratelimitedVisibilityManager) RecordWorkflowExecutionUninitialized(ctx context.Context, request *persistence.RecordWorkflowExecutionUninitializedRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.RecordWorkflowExecutionUninitialized", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -180,7 +184,7 @@ func (c *ratelimitedVisibilityManager) RecordWorkflowExecutionUninitialized(ctx
 }
 
 func (c *ratelimitedVisibilityManager) ScanWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsByQueryRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.ScanWorkflowExecutions", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
@@ -188,7 +192,7 @@ func (c *ratelimitedVisibilityManager) ScanWorkflowExecutions(ctx context.Contex
 }
 
 func (c *ratelimitedVisibilityManager) UpsertWorkflowExecution(ctx context.Context, request *persistence.UpsertWorkflowExecutionRequest) (err error) {
-	if ok := c.rateLimiter.Allow(); !ok {
+	if ok := allow(c.rateLimiter, c.operationLimiters, "VisibilityManager.UpsertWorkflowExecution", request); !ok {
 		err = ErrPersistenceLimitExceeded
 		return
 	}
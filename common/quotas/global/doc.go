@@ -83,7 +83,10 @@ This package as a whole is only concerned with the high level request pattern in
   - aggregators decide per-limiter-and-key limits based on global data, and return it to limiters
 
 Currently:
-  - limiters are Frontends, but can trivially be any Cadence service as they only send outbound requests
+  - limiters are Frontends for request limits, Matching for task list dispatch limits, and every service
+    for persistence limits.  they only send outbound requests, and keys are prefixed by service and collection name
+    (e.g. "history-persistence:domain/ExecutionManager.UpdateWorkflowExecution" or
+    "matching-dispatch:domain/tasklist/activity") so services never share a key
   - aggregators are arbitrarily in History service because it already has a ring implemented (Frontend does not)
   - neither of these are fundamentally required, and they may change or be in multiple services later
 
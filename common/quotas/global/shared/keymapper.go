@@ -27,8 +27,14 @@ package shared
 import (
 	"fmt"
 	"strings"
+
+	"github.com/uber/cadence/common/service"
 )
 
+// operationKeySeparator separates the domain from the operation in keys built by OperationKey.
+// Operations never contain it, so the last occurrence is always the separator.
+const operationKeySeparator = "/"
+
 type (
 	// KeyMapper is used to ensure that all keys that get communicated to
 	// aggregators are uniquely identifiable, when multiple collections are used.
@@ -71,3 +77,28 @@ func PrefixKey(prefix string) KeyMapper {
 		prefix: prefix,
 	}
 }
+
+// ServiceCollectionName returns the name of a collection owned by a service, so that
+// the same kind of limits in different services do not share keys in aggregators.
+func ServiceCollectionName(serviceName, name string) string {
+	return service.ShortName(serviceName) + "-" + name
+}
+
+// OperationKey builds a local key scoped by domain and operation, for collections which
+// limit more than one operation.  The domain is empty for operations which are not made
+// on behalf of a domain.
+func OperationKey(domain, operation string) LocalKey {
+	if domain == "" {
+		return LocalKey(operation)
+	}
+	return LocalKey(domain + operationKeySeparator + operation)
+}
+
+// SplitOperationKey does the reverse of OperationKey.
+func SplitOperationKey(key LocalKey) (domain, operation string) {
+	k := string(key)
+	if i := strings.LastIndex(k, operationKeySeparator); i >= 0 {
+		return k[:i], k[i+1:]
+	}
+	return "", k
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/service"
+)
+
+func TestPrefixKey(t *testing.T) {
+	km := PrefixKey("user:")
+	gkey := km.LocalToGlobal("test-domain")
+	assert.Equal(t, GlobalKey("user:test-domain"), gkey)
+
+	lkey, err := km.GlobalToLocal(gkey)
+	require.NoError(t, err)
+	assert.Equal(t, LocalKey("test-domain"), lkey)
+
+	_, err = km.GlobalToLocal("worker:test-domain")
+	assert.Error(t, err)
+}
+
+func TestServiceCollectionName(t *testing.T) {
+	assert.Equal(t, "history-persistence", ServiceCollectionName(service.History, "persistence"))
+}
+
+func TestOperationKey(t *testing.T) {
+	tests := map[string]struct {
+		domain    string
+		operation string
+		key       LocalKey
+	}{
+		"with domain": {
+			domain:    "test-domain",
+			operation: "TaskManager.CreateTasks",
+			key:       "test-domain/TaskManager.CreateTasks",
+		},
+		"without domain": {
+			operation: "ShardManager.GetShard",
+			key:       "ShardManager.GetShard",
+		},
+	}
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			key := OperationKey(td.domain, td.operation)
+			assert.Equal(t, td.key, key)
+
+			domain, operation := SplitOperationKey(key)
+			assert.Equal(t, td.domain, domain)
+			assert.Equal(t, td.operation, operation)
+		})
+	}
+}
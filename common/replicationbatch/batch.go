@@ -0,0 +1,266 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package replicationbatch packs consecutive replication tasks of a workflow into a single task
+// so they can be compressed and sent to a remote cluster together.
+//
+// A batch is an ordinary history replication task whose events blob holds the encoded original tasks,
+// so it goes over the published admin IDL unchanged. Clusters only send batches to the clusters
+// advertising the capabilities through the replication capabilities header.
+package replicationbatch
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+const (
+	// CapabilityBatching is advertised by clusters able to unbatch replication tasks
+	CapabilityBatching = "batching"
+	// CapabilityZstd is advertised by clusters able to decompress zstd compressed batches
+	CapabilityZstd = "zstd"
+
+	envelopeVersion1 byte = 1
+	flagZstd         byte = 1 << 0
+)
+
+// envelopeMagic prefixes the events blob of a batch. Regular history replication tasks
+// carry thriftrw encoded events, which start with the thriftrw preamble instead.
+var envelopeMagic = []byte{0xCA, 0xDE, 0xBA, 0x7C}
+
+var (
+	encoder = codec.NewThriftRWEncoder()
+	// EncodeAll and DecodeAll are safe for concurrent use
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+type (
+	// Capabilities are the replication task encodings a cluster is able to receive
+	Capabilities struct {
+		Batching bool
+		Zstd     bool
+	}
+
+	// Options controls how replication tasks are batched
+	Options struct {
+		// MaxBatchSize is the max number of tasks in one batch, batching is disabled if it is less than 2
+		MaxBatchSize int
+		// Compress compresses batches with zstd, a batch is created even for a single task in this case
+		Compress bool
+	}
+)
+
+// SupportedCapabilities are the capabilities of this version of the replication task processor
+var SupportedCapabilities = Capabilities{Batching: true, Zstd: true}
+
+// String returns the value of the replication capabilities header for the capabilities
+func (c Capabilities) String() string {
+	var capabilities []string
+	if c.Batching {
+		capabilities = append(capabilities, CapabilityBatching)
+	}
+	if c.Zstd {
+		capabilities = append(capabilities, CapabilityZstd)
+	}
+	return strings.Join(capabilities, ",")
+}
+
+// ParseCapabilities parses the value of the replication capabilities header, unknown capabilities are ignored
+func ParseCapabilities(header string) Capabilities {
+	var c Capabilities
+	for _, capability := range strings.Split(header, ",") {
+		switch strings.TrimSpace(capability) {
+		case CapabilityBatching:
+			c.Batching = true
+		case CapabilityZstd:
+			c.Zstd = true
+		}
+	}
+	return c
+}
+
+// NegotiateOptions returns the batching options allowed by both the local config and the remote capabilities
+func NegotiateOptions(remote Capabilities, enableBatching bool, enableCompression bool, maxBatchSize int) Options {
+	var opts Options
+	if !remote.Batching {
+		return opts
+	}
+	if enableBatching {
+		opts.MaxBatchSize = maxBatchSize
+	}
+	if enableCompression && remote.Zstd {
+		opts.Compress = true
+		if opts.MaxBatchSize < 1 {
+			opts.MaxBatchSize = 1
+		}
+	}
+	return opts
+}
+
+// Batch packs runs of consecutive history replication tasks of the same workflow run into batches.
+// Other tasks and runs shorter than two tasks are left as they are unless compression is enabled.
+func Batch(tasks []*types.ReplicationTask, opts Options) ([]*types.ReplicationTask, error) {
+	if opts.MaxBatchSize < 2 && !opts.Compress {
+		return tasks, nil
+	}
+	maxBatchSize := opts.MaxBatchSize
+	if maxBatchSize < 1 {
+		maxBatchSize = 1
+	}
+
+	result := make([]*types.ReplicationTask, 0, len(tasks))
+	for start := 0; start < len(tasks); {
+		end := start + 1
+		if tasks[start].GetHistoryTaskV2Attributes() == nil {
+			result = append(result, tasks[start])
+			start = end
+			continue
+		}
+		for end < len(tasks) && end-start < maxBatchSize && sameWorkflowRun(tasks[start], tasks[end]) {
+			end++
+		}
+		if end-start == 1 && !opts.Compress {
+			result = append(result, tasks[start])
+			start = end
+			continue
+		}
+		batch, err := newBatch(tasks[start:end], opts.Compress)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, batch)
+		start = end
+	}
+	return result, nil
+}
+
+// Unbatch expands the batches among the tasks back into the original tasks
+func Unbatch(tasks []*types.ReplicationTask) ([]*types.ReplicationTask, error) {
+	batched := false
+	for _, task := range tasks {
+		if IsBatch(task) {
+			batched = true
+			break
+		}
+	}
+	if !batched {
+		return tasks, nil
+	}
+
+	result := make([]*types.ReplicationTask, 0, len(tasks))
+	for _, task := range tasks {
+		if !IsBatch(task) {
+			result = append(result, task)
+			continue
+		}
+		unbatched, err := decodeBatch(task.GetHistoryTaskV2Attributes().GetEvents().GetData())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, unbatched...)
+	}
+	return result, nil
+}
+
+// IsBatch returns whether the task is a batch of replication tasks
+func IsBatch(task *types.ReplicationTask) bool {
+	attributes := task.GetHistoryTaskV2Attributes()
+	if task.GetTaskType() != types.ReplicationTaskTypeHistoryV2 || attributes == nil {
+		return false
+	}
+	// history replication tasks always carry the version history of the events
+	return len(attributes.VersionHistoryItems) == 0 && bytes.HasPrefix(attributes.GetEvents().GetData(), envelopeMagic)
+}
+
+func sameWorkflowRun(first, second *types.ReplicationTask) bool {
+	a := first.GetHistoryTaskV2Attributes()
+	b := second.GetHistoryTaskV2Attributes()
+	return b != nil && a.DomainID == b.DomainID && a.WorkflowID == b.WorkflowID && a.RunID == b.RunID
+}
+
+func newBatch(tasks []*types.ReplicationTask, compress bool) (*types.ReplicationTask, error) {
+	payload, err := encoder.Encode(&replicator.ReplicationMessages{
+		ReplicationTasks: thrift.FromReplicationTaskArray(tasks),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var flags byte
+	if compress {
+		payload = zstdEncoder.EncodeAll(payload, nil)
+		flags |= flagZstd
+	}
+	data := make([]byte, 0, len(envelopeMagic)+2+len(payload))
+	data = append(data, envelopeMagic...)
+	data = append(data, envelopeVersion1, flags)
+	data = append(data, payload...)
+
+	first, last := tasks[0], tasks[len(tasks)-1]
+	attributes := first.GetHistoryTaskV2Attributes()
+	return &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskID: last.SourceTaskID,
+		HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+			DomainID:   attributes.DomainID,
+			WorkflowID: attributes.WorkflowID,
+			RunID:      attributes.RunID,
+			Events: &types.DataBlob{
+				EncodingType: types.EncodingTypeThriftRW.Ptr(),
+				Data:         data,
+			},
+		},
+		CreationTime: first.CreationTime,
+	}, nil
+}
+
+func decodeBatch(data []byte) ([]*types.ReplicationTask, error) {
+	headerSize := len(envelopeMagic) + 2
+	if len(data) < headerSize {
+		return nil, fmt.Errorf("replication task batch is truncated")
+	}
+	version, flags, payload := data[len(envelopeMagic)], data[len(envelopeMagic)+1], data[headerSize:]
+	if version != envelopeVersion1 {
+		return nil, fmt.Errorf("unknown replication task batch version %v", version)
+	}
+
+	if flags&flagZstd != 0 {
+		decompressed, err := zstdDecoder.DecodeAll(payload, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress replication task batch: %w", err)
+		}
+		payload = decompressed
+	}
+
+	var messages replicator.ReplicationMessages
+	if err := encoder.Decode(payload, &messages); err != nil {
+		return nil, fmt.Errorf("failed to decode replication task batch: %w", err)
+	}
+	return thrift.ToReplicationTaskArray(messages.ReplicationTasks), nil
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationbatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func historyTask(taskID int64, workflowID string) *types.ReplicationTask {
+	return &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskID: taskID,
+		HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+			DomainID:            "domain-id",
+			WorkflowID:          workflowID,
+			RunID:               "run-id",
+			VersionHistoryItems: []*types.VersionHistoryItem{{EventID: taskID, Version: 1}},
+			Events: &types.DataBlob{
+				EncodingType: types.EncodingTypeThriftRW.Ptr(),
+				Data:         []byte{0x59, 1, 2, 3, 4, 5, 6, 7, 8},
+			},
+		},
+		CreationTime: common.Int64Ptr(taskID * 1000),
+	}
+}
+
+func syncActivityTask(taskID int64, workflowID string) *types.ReplicationTask {
+	return &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeSyncActivity.Ptr(),
+		SourceTaskID: taskID,
+		SyncActivityTaskAttributes: &types.SyncActivityTaskAttributes{
+			DomainID:    "domain-id",
+			WorkflowID:  workflowID,
+			RunID:       "run-id",
+			ScheduledID: 5,
+		},
+		CreationTime: common.Int64Ptr(taskID * 1000),
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	assert.Equal(t, "", Capabilities{}.String())
+	assert.Equal(t, "batching,zstd", SupportedCapabilities.String())
+	assert.Equal(t, Capabilities{}, ParseCapabilities(""))
+	assert.Equal(t, SupportedCapabilities, ParseCapabilities(SupportedCapabilities.String()))
+	assert.Equal(t, Capabilities{Batching: true}, ParseCapabilities("batching, lz4"))
+}
+
+func TestNegotiateOptions(t *testing.T) {
+	tests := []struct {
+		name              string
+		remote            Capabilities
+		enableBatching    bool
+		enableCompression bool
+		expected          Options
+	}{
+		{
+			name:              "remote does not support batching",
+			remote:            Capabilities{},
+			enableBatching:    true,
+			enableCompression: true,
+			expected:          Options{},
+		},
+		{
+			name:              "batching only",
+			remote:            SupportedCapabilities,
+			enableBatching:    true,
+			enableCompression: false,
+			expected:          Options{MaxBatchSize: 10},
+		},
+		{
+			name:              "compression not supported by remote",
+			remote:            Capabilities{Batching: true},
+			enableBatching:    true,
+			enableCompression: true,
+			expected:          Options{MaxBatchSize: 10},
+		},
+		{
+			name:              "compression only",
+			remote:            SupportedCapabilities,
+			enableBatching:    false,
+			enableCompression: true,
+			expected:          Options{MaxBatchSize: 1, Compress: true},
+		},
+		{
+			name:              "batching and compression",
+			remote:            SupportedCapabilities,
+			enableBatching:    true,
+			enableCompression: true,
+			expected:          Options{MaxBatchSize: 10, Compress: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NegotiateOptions(tt.remote, tt.enableBatching, tt.enableCompression, 10))
+		})
+	}
+}
+
+func TestBatchUnbatch(t *testing.T) {
+	tasks := []*types.ReplicationTask{
+		historyTask(1, "wf-1"),
+		historyTask(2, "wf-1"),
+		historyTask(3, "wf-1"),
+		historyTask(4, "wf-2"),
+		syncActivityTask(5, "wf-2"),
+		historyTask(6, "wf-2"),
+		historyTask(7, "wf-2"),
+	}
+
+	tests := []struct {
+		name          string
+		opts          Options
+		expectedTypes []bool // whether each resulting task is a batch
+	}{
+		{
+			name:          "disabled",
+			opts:          Options{},
+			expectedTypes: []bool{false, false, false, false, false, false, false},
+		},
+		{
+			name:          "batching",
+			opts:          Options{MaxBatchSize: 10},
+			expectedTypes: []bool{true, false, false, true},
+		},
+		{
+			name:          "batching with max batch size",
+			opts:          Options{MaxBatchSize: 2},
+			expectedTypes: []bool{true, false, false, false, true},
+		},
+		{
+			name:          "compression without batching",
+			opts:          Options{MaxBatchSize: 1, Compress: true},
+			expectedTypes: []bool{true, true, true, true, false, true, true},
+		},
+		{
+			name:          "batching and compression",
+			opts:          Options{MaxBatchSize: 10, Compress: true},
+			expectedTypes: []bool{true, true, false, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batched, err := Batch(tasks, tt.opts)
+			require.NoError(t, err)
+			require.Len(t, batched, len(tt.expectedTypes))
+			for i, isBatch := range tt.expectedTypes {
+				assert.Equal(t, isBatch, IsBatch(batched[i]), "task %v", i)
+			}
+
+			unbatched, err := Unbatch(batched)
+			require.NoError(t, err)
+			assert.Equal(t, tasks, unbatched)
+		})
+	}
+}
+
+func TestBatch_Envelope(t *testing.T) {
+	tasks := []*types.ReplicationTask{historyTask(1, "wf-1"), historyTask(2, "wf-1")}
+
+	batched, err := Batch(tasks, Options{MaxBatchSize: 10, Compress: true})
+	require.NoError(t, err)
+	require.Len(t, batched, 1)
+
+	batch := batched[0]
+	assert.Equal(t, types.ReplicationTaskTypeHistoryV2, batch.GetTaskType())
+	assert.Equal(t, int64(2), batch.SourceTaskID)
+	assert.Equal(t, int64(1000), batch.GetCreationTime())
+	assert.Equal(t, "domain-id", batch.HistoryTaskV2Attributes.DomainID)
+	assert.Equal(t, "wf-1", batch.HistoryTaskV2Attributes.WorkflowID)
+	assert.Equal(t, "run-id", batch.HistoryTaskV2Attributes.RunID)
+	assert.Empty(t, batch.HistoryTaskV2Attributes.VersionHistoryItems)
+	assert.Equal(t, types.EncodingTypeThriftRW, batch.HistoryTaskV2Attributes.Events.GetEncodingType())
+}
+
+func TestUnbatch_Errors(t *testing.T) {
+	batched, err := Batch([]*types.ReplicationTask{historyTask(1, "wf-1")}, Options{Compress: true})
+	require.NoError(t, err)
+	data := batched[0].HistoryTaskV2Attributes.Events.Data
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "truncated header",
+			data: data[:len(envelopeMagic)+1],
+		},
+		{
+			name: "unknown version",
+			data: append(append(append([]byte{}, envelopeMagic...), 2, 0), data[len(envelopeMagic)+2:]...),
+		},
+		{
+			name: "corrupted payload",
+			data: data[:len(data)-2],
+		},
+		{
+			name: "payload not compressed",
+			data: append(append([]byte{}, envelopeMagic...), envelopeVersion1, 0, 1, 2, 3),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := historyTask(1, "wf-1")
+			task.HistoryTaskV2Attributes.VersionHistoryItems = nil
+			task.HistoryTaskV2Attributes.Events.Data = tt.data
+			_, err := Unbatch([]*types.ReplicationTask{task})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationfilter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// SingleClusterKey is the partition config key marking a workflow excluded from replication by the
+// replication filters of its domain, the value being the cluster the workflow was started in.
+// It is set once when a root workflow starts and carried over to its continue-as-new runs and children.
+const SingleClusterKey = "single-cluster"
+
+// Filter excludes the workflows of a global domain from cross cluster replication,
+// either by workflow type or by the value of a search attribute given at start time
+type Filter struct {
+	WorkflowType         string `json:"workflowType,omitempty"`
+	SearchAttribute      string `json:"searchAttribute,omitempty"`
+	SearchAttributeValue string `json:"searchAttributeValue,omitempty"`
+}
+
+// ParseFilters returns the replication filters stored in the domain data, validating them
+func ParseFilters(domainData map[string]string) ([]Filter, error) {
+	value, ok := domainData[common.DomainDataKeyForReplicationFilters]
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	var filters []Filter
+	if err := json.Unmarshal([]byte(value), &filters); err != nil {
+		return nil, fmt.Errorf("replication filters must be a JSON list of filters: %w", err)
+	}
+	for _, filter := range filters {
+		if err := filter.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return filters, nil
+}
+
+func (f Filter) validate() error {
+	switch {
+	case f.WorkflowType != "" && f.SearchAttribute != "":
+		return fmt.Errorf("replication filter must match either a workflow type or a search attribute, not both")
+	case f.WorkflowType == "" && f.SearchAttribute == "":
+		return fmt.Errorf("replication filter must match a workflow type or a search attribute")
+	case f.SearchAttribute != "" && f.SearchAttributeValue == "":
+		return fmt.Errorf("replication filter on search attribute %v must have a value", f.SearchAttribute)
+	case f.SearchAttribute == "" && f.SearchAttributeValue != "":
+		return fmt.Errorf("replication filter on workflow type %v cannot have a search attribute value", f.WorkflowType)
+	}
+	return nil
+}
+
+// Match returns true if a workflow started with the given type and search attributes matches any of the filters
+func Match(filters []Filter, workflowType string, searchAttributes *types.SearchAttributes) bool {
+	for _, filter := range filters {
+		if filter.WorkflowType != "" {
+			if filter.WorkflowType == workflowType {
+				return true
+			}
+			continue
+		}
+		if value, ok := searchAttributes.GetIndexedFields()[filter.SearchAttribute]; ok && searchAttributeMatches(value, filter.SearchAttributeValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchAttributeMatches compares a JSON encoded search attribute value with the expected one.
+// Keyword lists match when any of their elements is the expected value.
+func searchAttributeMatches(value []byte, expected string) bool {
+	var str string
+	if err := json.Unmarshal(value, &str); err == nil {
+		return str == expected
+	}
+	var list []string
+	if err := json.Unmarshal(value, &list); err == nil {
+		for _, str := range list {
+			if str == expected {
+				return true
+			}
+		}
+		return false
+	}
+	// numbers and booleans are compared with their JSON representation
+	return string(value) == expected
+}
+
+// IsSingleCluster returns true if the partition config of a workflow marks it as excluded from replication
+func IsSingleCluster(partitionConfig map[string]string) bool {
+	_, ok := partitionConfig[SingleClusterKey]
+	return ok
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestParseFilters(t *testing.T) {
+	tests := map[string]struct {
+		data      map[string]string
+		want      []Filter
+		wantError string
+	}{
+		"no filters": {
+			data: map[string]string{"key": "value"},
+		},
+		"empty filters": {
+			data: map[string]string{common.DomainDataKeyForReplicationFilters: ""},
+		},
+		"valid filters": {
+			data: map[string]string{
+				common.DomainDataKeyForReplicationFilters: `[{"workflowType":"local-workflow"},{"searchAttribute":"Region","searchAttributeValue":"us-east"}]`,
+			},
+			want: []Filter{
+				{WorkflowType: "local-workflow"},
+				{SearchAttribute: "Region", SearchAttributeValue: "us-east"},
+			},
+		},
+		"invalid JSON": {
+			data:      map[string]string{common.DomainDataKeyForReplicationFilters: `{"workflowType":"local-workflow"}`},
+			wantError: "replication filters must be a JSON list of filters",
+		},
+		"both workflow type and search attribute": {
+			data:      map[string]string{common.DomainDataKeyForReplicationFilters: `[{"workflowType":"local-workflow","searchAttribute":"Region","searchAttributeValue":"us-east"}]`},
+			wantError: "not both",
+		},
+		"empty filter": {
+			data:      map[string]string{common.DomainDataKeyForReplicationFilters: `[{}]`},
+			wantError: "must match a workflow type or a search attribute",
+		},
+		"search attribute without value": {
+			data:      map[string]string{common.DomainDataKeyForReplicationFilters: `[{"searchAttribute":"Region"}]`},
+			wantError: "must have a value",
+		},
+		"workflow type with search attribute value": {
+			data:      map[string]string{common.DomainDataKeyForReplicationFilters: `[{"workflowType":"local-workflow","searchAttributeValue":"us-east"}]`},
+			wantError: "cannot have a search attribute value",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseFilters(tt.data)
+			if tt.wantError != "" {
+				assert.ErrorContains(t, err, tt.wantError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	filters := []Filter{
+		{WorkflowType: "local-workflow"},
+		{SearchAttribute: "Region", SearchAttributeValue: "us-east"},
+		{SearchAttribute: "Tier", SearchAttributeValue: "2"},
+	}
+	searchAttributes := func(key, value string) *types.SearchAttributes {
+		return &types.SearchAttributes{IndexedFields: map[string][]byte{key: []byte(value)}}
+	}
+
+	tests := map[string]struct {
+		workflowType     string
+		searchAttributes *types.SearchAttributes
+		want             bool
+	}{
+		"workflow type":                       {workflowType: "local-workflow", want: true},
+		"other workflow type":                 {workflowType: "global-workflow", want: false},
+		"keyword search attribute":            {workflowType: "global-workflow", searchAttributes: searchAttributes("Region", `"us-east"`), want: true},
+		"other keyword search attribute":      {workflowType: "global-workflow", searchAttributes: searchAttributes("Region", `"us-west"`), want: false},
+		"keyword list search attribute":       {workflowType: "global-workflow", searchAttributes: searchAttributes("Region", `["eu","us-east"]`), want: true},
+		"other keyword list search attribute": {workflowType: "global-workflow", searchAttributes: searchAttributes("Region", `["eu"]`), want: false},
+		"int search attribute":                {workflowType: "global-workflow", searchAttributes: searchAttributes("Tier", `2`), want: true},
+		"missing search attribute":            {workflowType: "global-workflow", searchAttributes: searchAttributes("Other", `"us-east"`), want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Match(filters, tt.workflowType, tt.searchAttributes))
+		})
+	}
+	assert.False(t, Match(nil, "local-workflow", nil))
+}
+
+func TestIsSingleCluster(t *testing.T) {
+	assert.False(t, IsSingleCluster(nil))
+	assert.False(t, IsSingleCluster(map[string]string{"userid": "1"}))
+	assert.True(t, IsSingleCluster(map[string]string{SingleClusterKey: "active"}))
+}
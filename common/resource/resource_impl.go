@@ -21,6 +21,7 @@
 package resource
 
 import (
+	"context"
 	"math/rand"
 	"sync/atomic"
 	"time"
@@ -59,7 +60,10 @@ import (
 	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
 	persistenceClient "github.com/uber/cadence/common/persistence/client"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/quotas/global/collection"
 	qrpc "github.com/uber/cadence/common/quotas/global/rpc"
+	"github.com/uber/cadence/common/quotas/global/shared"
 	"github.com/uber/cadence/common/quotas/permember"
 	"github.com/uber/cadence/common/rpc"
 	"github.com/uber/cadence/common/service"
@@ -146,6 +150,7 @@ type Impl struct {
 	asyncWorkflowQueueProvider queue.Provider
 
 	ratelimiterAggregatorClient qrpc.Client
+	persistenceRatelimiter      *collection.Collection
 }
 
 var _ Resource = (*Impl)(nil)
@@ -190,6 +195,34 @@ func New(
 		return nil, err
 	}
 
+	var historyRawClient history.Client
+	if params.HistoryClientFn != nil {
+		logger.Debug("Using history client from HistoryClientFn")
+		historyRawClient = params.HistoryClientFn()
+	} else {
+		logger.Debug("Using history client from bean")
+		historyRawClient = clientBean.GetHistoryClient()
+	}
+
+	ratelimiterAggs := qrpc.New(
+		historyRawClient, // no retries, will retry internally if needed
+		clientBean.GetHistoryPeers(),
+		logger,
+		params.MetricsClient,
+	)
+
+	persistenceRatelimiter, err := newPersistenceRatelimiter(
+		serviceName,
+		dynamicCollection,
+		membershipResolver,
+		ratelimiterAggs,
+		logger,
+		params.MetricsClient,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	newPersistenceBeanFn := persistenceClient.NewBeanFromFactory
 	if params.NewPersistenceBeanFn != nil {
 		newPersistenceBeanFn = params.NewPersistenceBeanFn
@@ -204,6 +237,7 @@ func New(
 				membershipResolver,
 			)
 		},
+		persistenceRatelimiter,
 		params.ClusterMetadata.GetCurrentClusterName(),
 		params.MetricsClient,
 		logger,
@@ -271,14 +305,6 @@ func New(
 		)
 	}
 
-	var historyRawClient history.Client
-	if params.HistoryClientFn != nil {
-		logger.Debug("Using history client from HistoryClientFn")
-		historyRawClient = params.HistoryClientFn()
-	} else {
-		logger.Debug("Using history client from bean")
-		historyRawClient = clientBean.GetHistoryClient()
-	}
 	historyClient := retryable.NewHistoryClient(
 		historyRawClient,
 		common.CreateHistoryServiceRetryPolicy(),
@@ -319,13 +345,6 @@ func New(
 	}
 	partitioner := ensurePartitionerOrDefault(params, isolationGroupState)
 
-	ratelimiterAggs := qrpc.New(
-		historyRawClient, // no retries, will retry internally if needed
-		clientBean.GetHistoryPeers(),
-		logger,
-		params.MetricsClient,
-	)
-
 	impl = &Impl{
 		status: common.DaemonStatusInitialized,
 
@@ -395,6 +414,7 @@ func New(
 		asyncWorkflowQueueProvider: params.AsyncWorkflowQueueProvider,
 
 		ratelimiterAggregatorClient: ratelimiterAggs,
+		persistenceRatelimiter:      persistenceRatelimiter,
 	}
 	return impl, nil
 }
@@ -436,6 +456,9 @@ func (h *Impl) Start() {
 	if h.isolationGroupConfigStore != nil {
 		h.isolationGroupConfigStore.Start()
 	}
+	if err := h.persistenceRatelimiter.OnStart(context.Background()); err != nil {
+		h.logger.WithTags(tag.Error(err)).Fatal("fail to start persistence global ratelimiter")
+	}
 	// The service is now started up
 	h.logger.Info("service started")
 	// seed the random generator once for this service
@@ -462,6 +485,11 @@ func (h *Impl) Stop() {
 	h.rpcFactory.Stop()
 
 	h.runtimeMetricsReporter.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	if err := h.persistenceRatelimiter.OnStop(ctx); err != nil {
+		h.logger.WithTags(tag.Error(err)).Error("failed to stop persistence global ratelimiter")
+	}
+	cancel()
 	h.persistenceBean.Close()
 	if h.isolationGroupConfigStore != nil {
 		h.isolationGroupConfigStore.Stop()
@@ -741,6 +769,41 @@ func ensurePartitionerOrDefault(params *Params, state isolationgroup.State) part
 	return partition.NewDefaultPartitioner(params.Logger, state)
 }
 
+// newPersistenceRatelimiter creates the global ratelimiter collection limiting each persistence operation per domain,
+// across all the hosts of the service.  All keys are "disabled" by default, which passes through to unlimited local limiters.
+func newPersistenceRatelimiter(
+	serviceName string,
+	dc *dynamicconfig.Collection,
+	resolver membership.Resolver,
+	aggs qrpc.Client,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) (*collection.Collection, error) {
+	name := shared.ServiceCollectionName(serviceName, "persistence")
+	km := shared.PrefixKey(name + ":")
+	maxQPS := dc.GetIntPropertyFilteredByRatelimitKey(dynamicconfig.PersistenceGlobalOperationMaxQPS)
+	// limits are configured per operation, and apply to each domain separately
+	targetRPS := func(key string) int {
+		_, operation := shared.SplitOperationKey(shared.LocalKey(key))
+		return maxQPS(string(km.LocalToGlobal(shared.LocalKey(operation))))
+	}
+	unlimited := func(string) int { return dynamicconfig.UnlimitedRPS }
+	create := func() *quotas.Collection {
+		return quotas.NewCollection(permember.NewPerMemberDynamicRateLimiterFactory(serviceName, targetRPS, unlimited, resolver))
+	}
+	return collection.New(
+		name,
+		create(),
+		create(),
+		dc.GetDurationProperty(dynamicconfig.GlobalRatelimiterUpdateInterval),
+		targetRPS,
+		dc.GetStringPropertyFilteredByRatelimitKey(dynamicconfig.PersistenceGlobalRatelimiterMode),
+		aggs,
+		logger,
+		metricsClient,
+	)
+}
+
 func ensureGetAllIsolationGroupsFnIsSet(params *Params) {
 	if params.GetIsolationGroups == nil {
 		params.GetIsolationGroups = func() []string { return []string{} }
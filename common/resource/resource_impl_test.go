@@ -243,3 +243,21 @@ func TestStartStop(t *testing.T) {
 	assert.NotNil(t, i.GetPartitioner())
 	assert.Equal(t, params.AsyncWorkflowQueueProvider, i.GetAsyncWorkflowQueueProvider())
 }
+
+func TestNewPersistenceRatelimiter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dcClient := dynamicconfig.NewInMemoryClient()
+	dc := dynamicconfig.NewCollection(dcClient, testlogger.New(t))
+	resolver := membership.NewMockResolver(ctrl)
+	resolver.EXPECT().MemberCount(service.History).Return(2, nil).AnyTimes()
+
+	limiters, err := newPersistenceRatelimiter(service.History, dc, resolver, nil, testlogger.New(t), metrics.NewNoopMetricsClient())
+	assert.NoError(t, err)
+
+	// keys are disabled by default, and fall back to unlimited per-host limits
+	limiter := limiters.For("test-domain/TaskManager.CreateTasks")
+	assert.Equal(t, float64(dynamicconfig.UnlimitedRPS)/2, float64(limiter.Limit()))
+
+	assert.NoError(t, dcClient.UpdateValue(dynamicconfig.PersistenceGlobalOperationMaxQPS, 100))
+	assert.Equal(t, float64(50), float64(limiter.Limit()))
+}
@@ -69,15 +69,32 @@ func NewFactory(logger log.Logger, p Params) *FactoryImpl {
 	logger = logger.WithTags(tag.ComponentRPCFactory)
 
 	inbounds := yarpc.Inbounds{}
+	var err error
 	// Create TChannel transport
 	// This is here only because ringpop expects tchannel.ChannelTransport,
 	// everywhere else we use regular tchannel.Transport.
-	ch, err := tchannel.NewChannelTransport(
+	channelOptions := []tchannel.TransportOption{
 		tchannel.ServiceName(p.ServiceName),
-		tchannel.ListenAddr(p.TChannelAddress))
+		tchannel.ListenAddr(p.TChannelAddress),
+	}
+	var tlsCh *tlsChannel
+	if p.InboundTLS != nil {
+		// TChannel inbound accepts TLS connections as well, exposing client certificates to the authorizer
+		tlsCh, err = newTLSChannel(p.ServiceName, p.InboundTLS)
+		if err != nil {
+			logger.Fatal("Failed to create TLS channel", tag.Error(err))
+		}
+		channelOptions = append(channelOptions, tchannel.WithChannel(tlsCh))
+	}
+	ch, err := tchannel.NewChannelTransport(channelOptions...)
 	if err != nil {
 		logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
+	channel := ch.Channel()
+	if tlsCh != nil {
+		// ringpop requires the underlying TChannel
+		channel = tlsCh.Channel
+	}
 	tchannel, err := tchannel.NewTransport(tchannel.ServiceName(p.ServiceName))
 	if err != nil {
 		logger.Fatal("Failed to create tchannel transport", tag.Error(err))
@@ -155,7 +172,7 @@ func NewFactory(logger log.Logger, p Params) *FactoryImpl {
 	return &FactoryImpl{
 		maxMessageSize: p.GRPCMaxMsgSize,
 		dispatcher:     dispatcher,
-		channel:        ch.Channel(),
+		channel:        channel,
 		outbounds:      outbounds,
 		serviceName:    p.ServiceName,
 		logger:         logger,
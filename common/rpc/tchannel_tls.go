@@ -0,0 +1,167 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+
+	"github.com/opentracing/opentracing-go"
+	tchannelgo "github.com/uber/tchannel-go"
+
+	"github.com/uber/cadence/common/authorization"
+)
+
+// tlsRecordTypeHandshake is the first byte sent by a TLS client, TChannel frames start with a zero byte
+const tlsRecordTypeHandshake = 0x16
+
+type (
+	// tlsChannel is a TChannel accepting both TLS and plaintext connections on its listening port.
+	// Plaintext is still needed for peers which don't dial TChannel with TLS, e.g. ringpop.
+	tlsChannel struct {
+		*tchannelgo.Channel
+		tlsConfig *tls.Config
+	}
+
+	// tlsListener accepts connections which are served over TLS if the client starts a TLS handshake
+	tlsListener struct {
+		net.Listener
+		tlsConfig *tls.Config
+	}
+
+	// tlsSniffingConn detects on the first read whether the client uses TLS
+	tlsSniffingConn struct {
+		net.Conn
+		tlsConfig *tls.Config
+
+		once    sync.Once
+		conn    net.Conn
+		tlsConn *tls.Conn
+		err     error
+	}
+
+	// prefixConn replays the bytes already read from the connection
+	prefixConn struct {
+		net.Conn
+		prefix []byte
+	}
+)
+
+var _ authorization.TLSConnection = (*tlsSniffingConn)(nil)
+
+// newTLSChannel creates a TChannel which adds the inbound connection to the context of the requests,
+// so that the authorizer can identify the caller by its client certificate
+func newTLSChannel(serviceName string, tlsConfig *tls.Config) (*tlsChannel, error) {
+	ch, err := tchannelgo.NewChannel(serviceName, &tchannelgo.ChannelOptions{
+		Tracer: opentracing.GlobalTracer(),
+		ConnContext: func(ctx context.Context, conn net.Conn) context.Context {
+			if tlsConn, ok := conn.(authorization.TLSConnection); ok {
+				return authorization.ContextWithTLSConnection(ctx, tlsConn)
+			}
+			return ctx
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tlsChannel{Channel: ch, tlsConfig: tlsConfig}, nil
+}
+
+// ListenAndServe listens on the given address and serves both TLS and plaintext connections
+func (c *tlsChannel) ListenAndServe(hostPort string) error {
+	listener, err := net.Listen("tcp", hostPort)
+	if err != nil {
+		return err
+	}
+	return c.Serve(&tlsListener{Listener: listener, tlsConfig: c.tlsConfig})
+}
+
+func (l *tlsListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &tlsSniffingConn{Conn: conn, tlsConfig: l.tlsConfig}, nil
+}
+
+func (c *tlsSniffingConn) sniff() {
+	c.once.Do(func() {
+		first := make([]byte, 1)
+		if _, err := io.ReadFull(c.Conn, first); err != nil {
+			c.err = err
+			return
+		}
+		conn := &prefixConn{Conn: c.Conn, prefix: first}
+		if first[0] == tlsRecordTypeHandshake {
+			c.tlsConn = tls.Server(conn, c.tlsConfig)
+			c.conn = c.tlsConn
+			return
+		}
+		c.conn = conn
+	})
+}
+
+func (c *tlsSniffingConn) Read(b []byte) (int, error) {
+	c.sniff()
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.conn.Read(b)
+}
+
+func (c *tlsSniffingConn) Write(b []byte) (int, error) {
+	c.sniff()
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.conn.Write(b)
+}
+
+// TLSConnectionState returns the state of the TLS connection, or false if the client doesn't use TLS
+func (c *tlsSniffingConn) TLSConnectionState() (tls.ConnectionState, bool) {
+	c.sniff()
+	if c.tlsConn == nil {
+		return tls.ConnectionState{}, false
+	}
+	return c.tlsConn.ConnectionState(), true
+}
+
+// SyscallConn exposes the underlying TCP connection, it is used by TChannel for connection stats
+func (c *tlsSniffingConn) SyscallConn() (syscall.RawConn, error) {
+	if conn, ok := c.Conn.(syscall.Conn); ok {
+		return conn.SyscallConn()
+	}
+	return nil, errors.New("connection does not implement syscall.Conn")
+}
+
+func (c *prefixConn) Read(b []byte) (int, error) {
+	if len(c.prefix) > 0 {
+		n := copy(b, c.prefix)
+		c.prefix = c.prefix[n:]
+		return n, nil
+	}
+	return c.Conn.Read(b)
+}
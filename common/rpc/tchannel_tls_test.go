@@ -0,0 +1,159 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tchannelgo "github.com/uber/tchannel-go"
+
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/log/testlogger"
+)
+
+func selfSignedCertificate(t *testing.T, commonName string, usage x509.ExtKeyUsage) (tls.Certificate, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, cert
+}
+
+func TestTLSListener(t *testing.T) {
+	serverCert, serverX509 := selfSignedCertificate(t, "server", x509.ExtKeyUsageServerAuth)
+	clientCert, clientX509 := selfSignedCertificate(t, "orders-worker", x509.ExtKeyUsageClientAuth)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientX509)
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(serverX509)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l := &tlsListener{Listener: listener, tlsConfig: &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+	}}
+	defer l.Close()
+
+	tests := map[string]struct {
+		dial         func() (net.Conn, error)
+		expectedTLS  bool
+		expectedName string
+	}{
+		"tls": {
+			dial: func() (net.Conn, error) {
+				return tls.Dial("tcp", l.Addr().String(), &tls.Config{
+					Certificates: []tls.Certificate{clientCert},
+					RootCAs:      rootCAs,
+				})
+			},
+			expectedTLS:  true,
+			expectedName: "orders-worker",
+		},
+		"tls without client certificate": {
+			dial: func() (net.Conn, error) {
+				return tls.Dial("tcp", l.Addr().String(), &tls.Config{RootCAs: rootCAs})
+			},
+			expectedTLS: true,
+		},
+		"plaintext": {
+			dial: func() (net.Conn, error) {
+				return net.Dial("tcp", l.Addr().String())
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			clientErr := make(chan error, 1)
+			go func() {
+				conn, err := tc.dial()
+				if err != nil {
+					clientErr <- err
+					return
+				}
+				defer conn.Close()
+				if _, err := conn.Write([]byte("\x00ping")); err != nil {
+					clientErr <- err
+					return
+				}
+				_, err = io.ReadFull(conn, make([]byte, 4))
+				clientErr <- err
+			}()
+
+			conn, err := l.Accept()
+			require.NoError(t, err)
+			defer conn.Close()
+
+			request := make([]byte, 5)
+			_, err = io.ReadFull(conn, request)
+			require.NoError(t, err)
+			assert.Equal(t, "\x00ping", string(request))
+			_, err = conn.Write([]byte("pong"))
+			require.NoError(t, err)
+			require.NoError(t, <-clientErr)
+
+			state, isTLS := conn.(authorization.TLSConnection).TLSConnectionState()
+			assert.Equal(t, tc.expectedTLS, isTLS)
+			if tc.expectedName != "" {
+				require.Len(t, state.VerifiedChains, 1)
+				assert.Equal(t, tc.expectedName, state.VerifiedChains[0][0].Subject.CommonName)
+			} else {
+				assert.Empty(t, state.VerifiedChains)
+			}
+		})
+	}
+}
+
+func TestNewFactory_InboundTLS(t *testing.T) {
+	serverCert, _ := selfSignedCertificate(t, "server", x509.ExtKeyUsageServerAuth)
+	f := NewFactory(testlogger.New(t), Params{
+		ServiceName:     "service",
+		TChannelAddress: "localhost:0",
+		InboundTLS:      &tls.Config{Certificates: []tls.Certificate{serverCert}},
+	})
+
+	assert.IsType(t, &tchannelgo.Channel{}, f.GetTChannel(), "ringpop requires the underlying TChannel")
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskdlq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// emptyMessageID is the ID preceding the first message of a persistence queue
+	emptyMessageID = -1
+	// maxEnqueueAttempts bounds the retries of concurrent writes of the same message ID,
+	// as all task processors of a shard share its queue
+	maxEnqueueAttempts = 5
+	// scanPageSize is the number of messages read from persistence at a time,
+	// messages of all domains of a shard are stored in the same queue and filtered on read
+	scanPageSize = 1000
+)
+
+type (
+	dlqImpl struct {
+		queues QueueProvider
+	}
+)
+
+// NewDLQ creates a DLQ storing the tasks of every shard in the DLQ of the history task queue of the shard
+func NewDLQ(queues QueueProvider) DLQ {
+	return &dlqImpl{queues: queues}
+}
+
+// Enqueue adds a task to the DLQ of its shard, the message ID is assigned by the queue
+func (d *dlqImpl) Enqueue(ctx context.Context, message *types.HistoryTaskDLQMessage) error {
+	queue, err := d.queues.GetHistoryTaskQueueManager(int(message.ShardID))
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	for attempt := 1; ; attempt++ {
+		err = queue.EnqueueMessageToDLQ(ctx, payload)
+		var conditionFailed *persistence.ConditionFailedError
+		if err == nil || !errors.As(err, &conditionFailed) || attempt == maxEnqueueAttempts {
+			return err
+		}
+	}
+}
+
+// Read returns the messages of the shard with IDs not greater than inclusiveEndMessageID,
+// only the messages of the domain are returned unless domainID is empty
+func (d *dlqImpl) Read(
+	ctx context.Context,
+	shardID int32,
+	domainID string,
+	inclusiveEndMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*types.HistoryTaskDLQMessage, []byte, error) {
+	lastMessageID, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	queue, err := d.queues.GetHistoryTaskQueueManager(int(shardID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result []*types.HistoryTaskDLQMessage
+	for {
+		messages, _, err := queue.ReadMessagesFromDLQ(ctx, lastMessageID, inclusiveEndMessageID, scanPageSize, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, message := range messages {
+			lastMessageID = message.ID
+			dlqMessage, err := deserializeMessage(message)
+			if err != nil {
+				return nil, nil, err
+			}
+			if domainID != "" && dlqMessage.DomainID != domainID {
+				continue
+			}
+			result = append(result, dlqMessage)
+			if len(result) == pageSize {
+				return result, serializePageToken(lastMessageID), nil
+			}
+		}
+		if len(messages) < scanPageSize {
+			return result, nil, nil
+		}
+	}
+}
+
+// Get returns the message of the shard with the given ID
+func (d *dlqImpl) Get(ctx context.Context, shardID int32, messageID int64) (*types.HistoryTaskDLQMessage, error) {
+	queue, err := d.queues.GetHistoryTaskQueueManager(int(shardID))
+	if err != nil {
+		return nil, err
+	}
+	messages, _, err := queue.ReadMessagesFromDLQ(ctx, messageID-1, messageID, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("History task DLQ message %v not found in shard %v.", messageID, shardID)}
+	}
+	return deserializeMessage(messages[0])
+}
+
+// Delete removes the message of the shard with the given ID
+func (d *dlqImpl) Delete(ctx context.Context, shardID int32, messageID int64) error {
+	queue, err := d.queues.GetHistoryTaskQueueManager(int(shardID))
+	if err != nil {
+		return err
+	}
+	return queue.DeleteMessageFromDLQ(ctx, messageID)
+}
+
+// Purge removes the messages of the shard with IDs not greater than inclusiveEndMessageID,
+// only the messages of the domain are removed unless domainID is empty
+func (d *dlqImpl) Purge(ctx context.Context, shardID int32, domainID string, inclusiveEndMessageID int64) error {
+	if domainID == "" {
+		queue, err := d.queues.GetHistoryTaskQueueManager(int(shardID))
+		if err != nil {
+			return err
+		}
+		return queue.RangeDeleteMessagesFromDLQ(ctx, emptyMessageID, inclusiveEndMessageID)
+	}
+
+	var pageToken []byte
+	for {
+		messages, nextPageToken, err := d.Read(ctx, shardID, domainID, inclusiveEndMessageID, scanPageSize, pageToken)
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			if err := d.Delete(ctx, shardID, message.MessageID); err != nil {
+				return err
+			}
+		}
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func deserializeMessage(message *persistence.QueueMessage) (*types.HistoryTaskDLQMessage, error) {
+	result := &types.HistoryTaskDLQMessage{}
+	if err := json.Unmarshal(message.Payload, result); err != nil {
+		return nil, fmt.Errorf("decoding history task DLQ message %v: %w", message.ID, err)
+	}
+	result.MessageID = message.ID
+	return result, nil
+}
+
+func serializePageToken(lastMessageID int64) []byte {
+	token, _ := json.Marshal(lastMessageID)
+	return token
+}
+
+func deserializePageToken(token []byte) (int64, error) {
+	if len(token) == 0 {
+		return emptyMessageID, nil
+	}
+	var lastMessageID int64
+	if err := json.Unmarshal(token, &lastMessageID); err != nil {
+		return 0, &types.BadRequestError{Message: "Invalid next page token."}
+	}
+	return lastMessageID, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskdlq
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestEnqueue(t *testing.T) {
+	tests := map[string]struct {
+		queueFunc func(mock *persistence.MockQueueManager)
+		wantErr   bool
+	}{
+		"success": {
+			queueFunc: func(mock *persistence.MockQueueManager) {
+				mock.EXPECT().EnqueueMessageToDLQ(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"retries on condition failure": {
+			queueFunc: func(mock *persistence.MockQueueManager) {
+				mock.EXPECT().EnqueueMessageToDLQ(gomock.Any(), gomock.Any()).Return(&persistence.ConditionFailedError{})
+				mock.EXPECT().EnqueueMessageToDLQ(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"gives up after max attempts": {
+			queueFunc: func(mock *persistence.MockQueueManager) {
+				mock.EXPECT().EnqueueMessageToDLQ(gomock.Any(), gomock.Any()).
+					Return(&persistence.ConditionFailedError{}).Times(maxEnqueueAttempts)
+			},
+			wantErr: true,
+		},
+		"other errors are not retried": {
+			queueFunc: func(mock *persistence.MockQueueManager) {
+				mock.EXPECT().EnqueueMessageToDLQ(gomock.Any(), gomock.Any()).Return(&types.InternalServiceError{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			queue := persistence.NewMockQueueManager(gomock.NewController(t))
+			tt.queueFunc(queue)
+
+			err := newTestDLQ(t, 1, queue).Enqueue(context.Background(), &types.HistoryTaskDLQMessage{ShardID: 1})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	queue := persistence.NewMockQueueManager(gomock.NewController(t))
+	queue.EXPECT().ReadMessagesFromDLQ(gomock.Any(), int64(emptyMessageID), int64(10), scanPageSize, nil).
+		Return([]*persistence.QueueMessage{
+			newQueueMessage(t, 1, 1, "domain-a"),
+			newQueueMessage(t, 2, 1, "domain-b"),
+			newQueueMessage(t, 3, 1, "domain-a"),
+			newQueueMessage(t, 4, 1, "domain-a"),
+		}, nil, nil)
+	queue.EXPECT().ReadMessagesFromDLQ(gomock.Any(), int64(3), int64(10), scanPageSize, nil).
+		Return([]*persistence.QueueMessage{
+			newQueueMessage(t, 4, 1, "domain-a"),
+		}, nil, nil)
+	dlq := newTestDLQ(t, 1, queue)
+
+	messages, token, err := dlq.Read(context.Background(), 1, "domain-a", 10, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, int64(1), messages[0].MessageID)
+	assert.Equal(t, int64(3), messages[1].MessageID)
+	require.NotEmpty(t, token)
+
+	messages, token, err = dlq.Read(context.Background(), 1, "domain-a", 10, 2, token)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, int64(4), messages[0].MessageID)
+	assert.Empty(t, token)
+}
+
+func TestRead_AllDomains(t *testing.T) {
+	queue := persistence.NewMockQueueManager(gomock.NewController(t))
+	queue.EXPECT().ReadMessagesFromDLQ(gomock.Any(), int64(emptyMessageID), int64(10), scanPageSize, nil).
+		Return([]*persistence.QueueMessage{
+			newQueueMessage(t, 1, 2, "domain-a"),
+			newQueueMessage(t, 2, 2, "domain-b"),
+		}, nil, nil)
+
+	messages, token, err := newTestDLQ(t, 2, queue).Read(context.Background(), 2, "", 10, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Empty(t, token)
+}
+
+func TestRead_InvalidPageToken(t *testing.T) {
+	queue := persistence.NewMockQueueManager(gomock.NewController(t))
+
+	_, _, err := newTestDLQ(t, 1, queue).Read(context.Background(), 1, "", common.EndMessageID, 10, []byte("invalid"))
+	var badRequest *types.BadRequestError
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestGet(t *testing.T) {
+	queue := persistence.NewMockQueueManager(gomock.NewController(t))
+	queue.EXPECT().ReadMessagesFromDLQ(gomock.Any(), int64(4), int64(5), 1, nil).
+		Return([]*persistence.QueueMessage{newQueueMessage(t, 5, 3, "domain-a")}, nil, nil)
+	queue.EXPECT().ReadMessagesFromDLQ(gomock.Any(), int64(5), int64(6), 1, nil).
+		Return(nil, nil, nil)
+	dlq := newTestDLQ(t, 3, queue)
+
+	message, err := dlq.Get(context.Background(), 3, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), message.MessageID)
+	assert.Equal(t, int32(3), message.ShardID)
+
+	_, err = dlq.Get(context.Background(), 3, 6)
+	var notExists *types.EntityNotExistsError
+	assert.ErrorAs(t, err, &notExists)
+}
+
+func TestPurge(t *testing.T) {
+	queue := persistence.NewMockQueueManager(gomock.NewController(t))
+	queue.EXPECT().ReadMessagesFromDLQ(gomock.Any(), int64(emptyMessageID), common.EndMessageID, scanPageSize, nil).
+		Return([]*persistence.QueueMessage{
+			newQueueMessage(t, 1, 1, "domain-a"),
+			newQueueMessage(t, 2, 1, "domain-b"),
+			newQueueMessage(t, 3, 1, "domain-a"),
+		}, nil, nil)
+	queue.EXPECT().DeleteMessageFromDLQ(gomock.Any(), int64(1)).Return(nil)
+	queue.EXPECT().DeleteMessageFromDLQ(gomock.Any(), int64(3)).Return(nil)
+
+	assert.NoError(t, newTestDLQ(t, 1, queue).Purge(context.Background(), 1, "domain-a", common.EndMessageID))
+}
+
+func TestPurge_AllDomains(t *testing.T) {
+	queue := persistence.NewMockQueueManager(gomock.NewController(t))
+	queue.EXPECT().RangeDeleteMessagesFromDLQ(gomock.Any(), int64(emptyMessageID), int64(7)).Return(nil)
+
+	assert.NoError(t, newTestDLQ(t, 1, queue).Purge(context.Background(), 1, "", 7))
+}
+
+func TestQueueProviderError(t *testing.T) {
+	queues := NewMockQueueProvider(gomock.NewController(t))
+	queues.EXPECT().GetHistoryTaskQueueManager(1).Return(nil, &types.InternalServiceError{}).AnyTimes()
+	dlq := NewDLQ(queues)
+
+	assert.Error(t, dlq.Enqueue(context.Background(), &types.HistoryTaskDLQMessage{ShardID: 1}))
+	_, _, err := dlq.Read(context.Background(), 1, "", common.EndMessageID, 10, nil)
+	assert.Error(t, err)
+	_, err = dlq.Get(context.Background(), 1, 1)
+	assert.Error(t, err)
+	assert.Error(t, dlq.Delete(context.Background(), 1, 1))
+	assert.Error(t, dlq.Purge(context.Background(), 1, "", common.EndMessageID))
+}
+
+func newTestDLQ(t *testing.T, shardID int, queue persistence.QueueManager) DLQ {
+	queues := NewMockQueueProvider(gomock.NewController(t))
+	queues.EXPECT().GetHistoryTaskQueueManager(shardID).Return(queue, nil).AnyTimes()
+	return NewDLQ(queues)
+}
+
+func newQueueMessage(t *testing.T, id int64, shardID int32, domainID string) *persistence.QueueMessage {
+	payload, err := json.Marshal(&types.HistoryTaskDLQMessage{ShardID: shardID, DomainID: domainID})
+	require.NoError(t, err)
+	return &persistence.QueueMessage{ID: id, Payload: payload}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination interface_mock.go -self_package github.com/uber/cadence/common/taskdlq
+
+package taskdlq
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// DLQ stores the history transfer and timer tasks which failed permanently,
+	// so they stop blocking the ack level of their processing queue.
+	// Every shard has its own queue, reads and purges can be further scoped to a domain,
+	// an empty domainID matches the tasks of all domains.
+	DLQ interface {
+		Enqueue(ctx context.Context, message *types.HistoryTaskDLQMessage) error
+		Read(ctx context.Context, shardID int32, domainID string, inclusiveEndMessageID int64, pageSize int, pageToken []byte) ([]*types.HistoryTaskDLQMessage, []byte, error)
+		Get(ctx context.Context, shardID int32, messageID int64) (*types.HistoryTaskDLQMessage, error)
+		Delete(ctx context.Context, shardID int32, messageID int64) error
+		Purge(ctx context.Context, shardID int32, domainID string, inclusiveEndMessageID int64) error
+	}
+
+	// QueueProvider returns the persistence queue holding the tasks of a shard,
+	// it is implemented by the persistence bean
+	QueueProvider interface {
+		GetHistoryTaskQueueManager(shardID int) (persistence.QueueManager, error)
+	}
+)
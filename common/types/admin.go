@@ -438,3 +438,653 @@ type UpdateTaskListPartitionConfigRequest struct {
 }
 
 type UpdateTaskListPartitionConfigResponse struct{}
+
+// UpdateActivityOptionsRequest changes the options of a pending activity and
+// schedules its next attempt immediately. Nil fields are left unchanged.
+type UpdateActivityOptionsRequest struct {
+	Domain                        string
+	Execution                     *WorkflowExecution
+	ActivityID                    string
+	TaskList                      *TaskList
+	ScheduleToStartTimeoutSeconds *int32
+	ScheduleToCloseTimeoutSeconds *int32
+	StartToCloseTimeoutSeconds    *int32
+	HeartbeatTimeoutSeconds       *int32
+	RetryPolicy                   *RetryPolicy
+	// ResetAttempts restarts the attempt counter and the retry backoff of an activity waiting for its next attempt,
+	// the retry policy limits of a started activity are counted from its current attempt
+	ResetAttempts bool
+	Identity      string
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetResetAttempts is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetResetAttempts() (o bool) {
+	if v != nil {
+		return v.ResetAttempts
+	}
+	return
+}
+
+type UpdateActivityOptionsResponse struct {
+	Activity *PendingActivityInfo
+}
+
+// GetActivity is an internal getter (TBD...)
+func (v *UpdateActivityOptionsResponse) GetActivity() (o *PendingActivityInfo) {
+	if v != nil && v.Activity != nil {
+		return v.Activity
+	}
+	return
+}
+
+// AuditLogEntry records a call to an audited API
+type AuditLogEntry struct {
+	TimestampNano int64
+	Actor         string
+	APIName       string
+	Domain        string
+	Request       string
+	Outcome       AuditLogOutcome
+	Error         string
+}
+
+// GetTimestampNano is an internal getter (TBD...)
+func (v *AuditLogEntry) GetTimestampNano() (o int64) {
+	if v != nil {
+		return v.TimestampNano
+	}
+	return
+}
+
+// GetActor is an internal getter (TBD...)
+func (v *AuditLogEntry) GetActor() (o string) {
+	if v != nil {
+		return v.Actor
+	}
+	return
+}
+
+// GetAPIName is an internal getter (TBD...)
+func (v *AuditLogEntry) GetAPIName() (o string) {
+	if v != nil {
+		return v.APIName
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *AuditLogEntry) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// AuditLogOutcome is the outcome of an audited API call
+type AuditLogOutcome string
+
+const (
+	// AuditLogOutcomeSuccess means the call was authorized and succeeded
+	AuditLogOutcomeSuccess AuditLogOutcome = "success"
+	// AuditLogOutcomeDenied means the call was rejected by the authorizer
+	AuditLogOutcomeDenied AuditLogOutcome = "denied"
+	// AuditLogOutcomeFailed means the call was authorized but returned an error
+	AuditLogOutcomeFailed AuditLogOutcome = "failed"
+)
+
+// ListAuditLogEntriesRequest lists the audit log entries matching all the set filters
+type ListAuditLogEntriesRequest struct {
+	Domain        string
+	Actor         string
+	APIName       string
+	StartTimeNano *int64
+	EndTimeNano   *int64
+	PageSize      int32
+	NextPageToken []byte
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListAuditLogEntriesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetActor is an internal getter (TBD...)
+func (v *ListAuditLogEntriesRequest) GetActor() (o string) {
+	if v != nil {
+		return v.Actor
+	}
+	return
+}
+
+// GetAPIName is an internal getter (TBD...)
+func (v *ListAuditLogEntriesRequest) GetAPIName() (o string) {
+	if v != nil {
+		return v.APIName
+	}
+	return
+}
+
+// GetStartTimeNano is an internal getter (TBD...)
+func (v *ListAuditLogEntriesRequest) GetStartTimeNano() (o int64) {
+	if v != nil && v.StartTimeNano != nil {
+		return *v.StartTimeNano
+	}
+	return
+}
+
+// GetEndTimeNano is an internal getter (TBD...)
+func (v *ListAuditLogEntriesRequest) GetEndTimeNano() (o int64) {
+	if v != nil && v.EndTimeNano != nil {
+		return *v.EndTimeNano
+	}
+	return
+}
+
+// GetPageSize is an internal getter (TBD...)
+func (v *ListAuditLogEntriesRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListAuditLogEntriesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+type ListAuditLogEntriesResponse struct {
+	Entries       []*AuditLogEntry
+	NextPageToken []byte
+}
+
+// GetEntries is an internal getter (TBD...)
+func (v *ListAuditLogEntriesResponse) GetEntries() (o []*AuditLogEntry) {
+	if v != nil && v.Entries != nil {
+		return v.Entries
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListAuditLogEntriesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// HistoryTaskDLQMessage is a history transfer or timer task moved to the history task DLQ after failing permanently
+type HistoryTaskDLQMessage struct {
+	MessageID                 int64               `json:"messageID,omitempty"`
+	ShardID                   int32               `json:"shardID,omitempty"`
+	DomainID                  string              `json:"domainID,omitempty"`
+	WorkflowID                string              `json:"workflowID,omitempty"`
+	RunID                     string              `json:"runID,omitempty"`
+	TaskCategory              HistoryTaskCategory `json:"taskCategory,omitempty"`
+	TaskType                  int32               `json:"taskType,omitempty"`
+	TaskID                    int64               `json:"taskID,omitempty"`
+	VisibilityTimestampNano   int64               `json:"visibilityTimestampNano,omitempty"`
+	Attempt                   int32               `json:"attempt,omitempty"`
+	FirstAttemptTimestampNano int64               `json:"firstAttemptTimestampNano,omitempty"`
+	EnqueuedTimestampNano     int64               `json:"enqueuedTimestampNano,omitempty"`
+	LastError                 string              `json:"lastError,omitempty"`
+	TaskInfo                  string              `json:"taskInfo,omitempty"`
+}
+
+// HistoryTaskCategory is the queue a history task belongs to
+type HistoryTaskCategory string
+
+const (
+	// HistoryTaskCategoryTransfer is the category of transfer tasks
+	HistoryTaskCategoryTransfer HistoryTaskCategory = "transfer"
+	// HistoryTaskCategoryTimer is the category of timer tasks
+	HistoryTaskCategoryTimer HistoryTaskCategory = "timer"
+)
+
+// GetMessageID is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetMessageID() (o int64) {
+	if v != nil {
+		return v.MessageID
+	}
+	return
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetTaskCategory is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetTaskCategory() (o HistoryTaskCategory) {
+	if v != nil {
+		return v.TaskCategory
+	}
+	return
+}
+
+// GetTaskType is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetTaskType() (o int32) {
+	if v != nil {
+		return v.TaskType
+	}
+	return
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetVisibilityTimestampNano is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetVisibilityTimestampNano() (o int64) {
+	if v != nil {
+		return v.VisibilityTimestampNano
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetFirstAttemptTimestampNano is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetFirstAttemptTimestampNano() (o int64) {
+	if v != nil {
+		return v.FirstAttemptTimestampNano
+	}
+	return
+}
+
+// GetEnqueuedTimestampNano is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetEnqueuedTimestampNano() (o int64) {
+	if v != nil {
+		return v.EnqueuedTimestampNano
+	}
+	return
+}
+
+// GetLastError is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetLastError() (o string) {
+	if v != nil {
+		return v.LastError
+	}
+	return
+}
+
+// GetTaskInfo is an internal getter (TBD...)
+func (v *HistoryTaskDLQMessage) GetTaskInfo() (o string) {
+	if v != nil {
+		return v.TaskInfo
+	}
+	return
+}
+
+// ReadHistoryTaskDLQMessagesRequest reads the history task DLQ messages of a shard, optionally only those of a domain
+type ReadHistoryTaskDLQMessagesRequest struct {
+	ShardID               int32  `json:"shardID,omitempty"`
+	Domain                string `json:"domain,omitempty"`
+	InclusiveEndMessageID *int64 `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       int32  `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQMessagesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetInclusiveEndMessageID is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
+	}
+	return
+}
+
+// GetMaximumPageSize is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQMessagesRequest) GetMaximumPageSize() (o int32) {
+	if v != nil {
+		return v.MaximumPageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQMessagesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ReadHistoryTaskDLQMessagesResponse is the response to ReadHistoryTaskDLQMessages
+type ReadHistoryTaskDLQMessagesResponse struct {
+	Messages      []*HistoryTaskDLQMessage `json:"messages,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+}
+
+// GetMessages is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQMessagesResponse) GetMessages() (o []*HistoryTaskDLQMessage) {
+	if v != nil && v.Messages != nil {
+		return v.Messages
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQMessagesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// DescribeHistoryTaskDLQMessageRequest gets a single history task DLQ message of a shard
+type DescribeHistoryTaskDLQMessageRequest struct {
+	ShardID   int32 `json:"shardID,omitempty"`
+	MessageID int64 `json:"messageID,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *DescribeHistoryTaskDLQMessageRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetMessageID is an internal getter (TBD...)
+func (v *DescribeHistoryTaskDLQMessageRequest) GetMessageID() (o int64) {
+	if v != nil {
+		return v.MessageID
+	}
+	return
+}
+
+// DescribeHistoryTaskDLQMessageResponse is the response to DescribeHistoryTaskDLQMessage
+type DescribeHistoryTaskDLQMessageResponse struct {
+	Message *HistoryTaskDLQMessage `json:"message,omitempty"`
+}
+
+// GetMessage is an internal getter (TBD...)
+func (v *DescribeHistoryTaskDLQMessageResponse) GetMessage() (o *HistoryTaskDLQMessage) {
+	if v != nil && v.Message != nil {
+		return v.Message
+	}
+	return
+}
+
+// MergeHistoryTaskDLQMessagesRequest re-enqueues the history task DLQ messages of a shard, optionally only those of a domain
+type MergeHistoryTaskDLQMessagesRequest struct {
+	ShardID               int32  `json:"shardID,omitempty"`
+	Domain                string `json:"domain,omitempty"`
+	InclusiveEndMessageID *int64 `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       int32  `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *MergeHistoryTaskDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *MergeHistoryTaskDLQMessagesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetInclusiveEndMessageID is an internal getter (TBD...)
+func (v *MergeHistoryTaskDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
+	}
+	return
+}
+
+// GetMaximumPageSize is an internal getter (TBD...)
+func (v *MergeHistoryTaskDLQMessagesRequest) GetMaximumPageSize() (o int32) {
+	if v != nil {
+		return v.MaximumPageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *MergeHistoryTaskDLQMessagesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// MergeHistoryTaskDLQMessagesResponse is the response to MergeHistoryTaskDLQMessages
+type MergeHistoryTaskDLQMessagesResponse struct {
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *MergeHistoryTaskDLQMessagesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// PurgeHistoryTaskDLQMessagesRequest deletes the history task DLQ messages of a shard, optionally only those of a domain
+type PurgeHistoryTaskDLQMessagesRequest struct {
+	ShardID               int32  `json:"shardID,omitempty"`
+	Domain                string `json:"domain,omitempty"`
+	InclusiveEndMessageID *int64 `json:"inclusiveEndMessageID,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *PurgeHistoryTaskDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PurgeHistoryTaskDLQMessagesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetInclusiveEndMessageID is an internal getter (TBD...)
+func (v *PurgeHistoryTaskDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
+	}
+	return
+}
+
+// DescribeWorkflowVersionHistoriesRequest describes the version histories of a workflow execution
+type DescribeWorkflowVersionHistoriesRequest struct {
+	Domain    string             `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+	// Cluster is the cluster whose view of the execution is described, the current cluster if empty
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeWorkflowVersionHistoriesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *DescribeWorkflowVersionHistoriesRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetCluster is an internal getter (TBD...)
+func (v *DescribeWorkflowVersionHistoriesRequest) GetCluster() (o string) {
+	if v != nil {
+		return v.Cluster
+	}
+	return
+}
+
+// DescribeWorkflowVersionHistoriesResponse is the branch tree of a workflow execution as seen by a cluster
+type DescribeWorkflowVersionHistoriesResponse struct {
+	Cluster          string             `json:"cluster,omitempty"`
+	Execution        *WorkflowExecution `json:"execution,omitempty"`
+	VersionHistories *VersionHistories  `json:"versionHistories,omitempty"`
+}
+
+// GetCluster is an internal getter (TBD...)
+func (v *DescribeWorkflowVersionHistoriesResponse) GetCluster() (o string) {
+	if v != nil {
+		return v.Cluster
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *DescribeWorkflowVersionHistoriesResponse) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetVersionHistories is an internal getter (TBD...)
+func (v *DescribeWorkflowVersionHistoriesResponse) GetVersionHistories() (o *VersionHistories) {
+	if v != nil && v.VersionHistories != nil {
+		return v.VersionHistories
+	}
+	return
+}
+
+// RebuildWorkflowBranchRequest rebuilds the mutable state of a workflow execution from one of its branches,
+// the branch becomes the current branch of the execution
+type RebuildWorkflowBranchRequest struct {
+	Domain    string             `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+	// BranchToken is the branch to rebuild from, the current branch if empty
+	BranchToken []byte `json:"branchToken,omitempty"`
+	// Cluster is the cluster where the execution is rebuilt, the current cluster if empty
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RebuildWorkflowBranchRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *RebuildWorkflowBranchRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetBranchToken is an internal getter (TBD...)
+func (v *RebuildWorkflowBranchRequest) GetBranchToken() (o []byte) {
+	if v != nil && v.BranchToken != nil {
+		return v.BranchToken
+	}
+	return
+}
+
+// GetCluster is an internal getter (TBD...)
+func (v *RebuildWorkflowBranchRequest) GetCluster() (o string) {
+	if v != nil {
+		return v.Cluster
+	}
+	return
+}
@@ -632,6 +632,50 @@ func (v *HistoryRefreshWorkflowTasksRequest) GetRequest() (o *RefreshWorkflowTas
 	return
 }
 
+// HistoryRebuildWorkflowBranchRequest is an internal type (TBD...)
+type HistoryRebuildWorkflowBranchRequest struct {
+	DomainUUID string                        `json:"domainUUID,omitempty"`
+	Request    *RebuildWorkflowBranchRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryRebuildWorkflowBranchRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryRebuildWorkflowBranchRequest) GetRequest() (o *RebuildWorkflowBranchRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// HistoryUpdateActivityOptionsRequest is an internal type (TBD...)
+type HistoryUpdateActivityOptionsRequest struct {
+	DomainUUID string                        `json:"domainUUID,omitempty"`
+	Request    *UpdateActivityOptionsRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUpdateActivityOptionsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryUpdateActivityOptionsRequest) GetRequest() (o *UpdateActivityOptionsRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
 // RemoveSignalMutableStateRequest is an internal type (TBD...)
 type RemoveSignalMutableStateRequest struct {
 	DomainUUID        string             `json:"domainUUID,omitempty"`
@@ -918,6 +962,7 @@ type HistoryStartWorkflowExecutionRequest struct {
 	LastCompletionResult            []byte                         `json:"lastCompletionResult,omitempty"`
 	FirstDecisionTaskBackoffSeconds *int32                         `json:"firstDecisionTaskBackoffSeconds,omitempty"`
 	PartitionConfig                 map[string]string
+	RequestEagerExecution           bool `json:"requestEagerExecution,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -960,6 +1005,14 @@ func (v *HistoryStartWorkflowExecutionRequest) GetPartitionConfig() (o map[strin
 	return
 }
 
+// GetRequestEagerExecution is an internal getter (TBD...)
+func (v *HistoryStartWorkflowExecutionRequest) GetRequestEagerExecution() (o bool) {
+	if v != nil {
+		return v.RequestEagerExecution
+	}
+	return
+}
+
 // SyncActivityRequest is an internal type (TBD...)
 type SyncActivityRequest struct {
 	DomainID           string          `json:"domainId,omitempty"`
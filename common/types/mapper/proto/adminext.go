@@ -0,0 +1,794 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	adminextv1 "github.com/uber/cadence/.gen/proto/adminext/v1"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func FromAdminUpdateActivityOptionsRequest(t *types.UpdateActivityOptionsRequest) *adminextv1.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.UpdateActivityOptionsRequest{
+		Domain:                 t.Domain,
+		WorkflowExecution:      FromWorkflowExecution(t.Execution),
+		ActivityId:             t.ActivityID,
+		TaskList:               FromTaskList(t.TaskList),
+		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
+		ScheduleToCloseTimeout: secondsToDuration(t.ScheduleToCloseTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
+		ResetAttempts:          t.ResetAttempts,
+		Identity:               t.Identity,
+	}
+}
+
+func ToAdminUpdateActivityOptionsRequest(t *adminextv1.UpdateActivityOptionsRequest) *types.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsRequest{
+		Domain:                        t.Domain,
+		Execution:                     ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:                    t.ActivityId,
+		TaskList:                      ToTaskList(t.TaskList),
+		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+		ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+		StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		ResetAttempts:                 t.ResetAttempts,
+		Identity:                      t.Identity,
+	}
+}
+
+func FromAdminUpdateActivityOptionsResponse(t *types.UpdateActivityOptionsResponse) *adminextv1.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.UpdateActivityOptionsResponse{
+		Activity: FromPendingActivityInfo(t.Activity),
+	}
+}
+
+func ToAdminUpdateActivityOptionsResponse(t *adminextv1.UpdateActivityOptionsResponse) *types.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsResponse{
+		Activity: ToPendingActivityInfo(t.Activity),
+	}
+}
+
+func FromAdminListAuditLogEntriesRequest(t *types.ListAuditLogEntriesRequest) *adminextv1.ListAuditLogEntriesRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.ListAuditLogEntriesRequest{
+		Domain:        t.Domain,
+		Actor:         t.Actor,
+		ApiName:       t.APIName,
+		StartTime:     unixNanoToTime(t.StartTimeNano),
+		EndTime:       unixNanoToTime(t.EndTimeNano),
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminListAuditLogEntriesRequest(t *adminextv1.ListAuditLogEntriesRequest) *types.ListAuditLogEntriesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListAuditLogEntriesRequest{
+		Domain:        t.Domain,
+		Actor:         t.Actor,
+		APIName:       t.ApiName,
+		StartTimeNano: timeToUnixNano(t.StartTime),
+		EndTimeNano:   timeToUnixNano(t.EndTime),
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromAdminListAuditLogEntriesResponse(t *types.ListAuditLogEntriesResponse) *adminextv1.ListAuditLogEntriesResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.ListAuditLogEntriesResponse{
+		Entries:       FromAuditLogEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminListAuditLogEntriesResponse(t *adminextv1.ListAuditLogEntriesResponse) *types.ListAuditLogEntriesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListAuditLogEntriesResponse{
+		Entries:       ToAuditLogEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromAuditLogEntryArray(t []*types.AuditLogEntry) []*adminextv1.AuditLogEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminextv1.AuditLogEntry, len(t))
+	for i := range t {
+		v[i] = FromAuditLogEntry(t[i])
+	}
+	return v
+}
+
+func ToAuditLogEntryArray(t []*adminextv1.AuditLogEntry) []*types.AuditLogEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.AuditLogEntry, len(t))
+	for i := range t {
+		v[i] = ToAuditLogEntry(t[i])
+	}
+	return v
+}
+
+func FromAuditLogEntry(t *types.AuditLogEntry) *adminextv1.AuditLogEntry {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.AuditLogEntry{
+		Timestamp: unixNanoToTime(&t.TimestampNano),
+		Actor:     t.Actor,
+		ApiName:   t.APIName,
+		Domain:    t.Domain,
+		Request:   t.Request,
+		Outcome:   FromAuditLogOutcome(t.Outcome),
+		Error:     t.Error,
+	}
+}
+
+func ToAuditLogEntry(t *adminextv1.AuditLogEntry) *types.AuditLogEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.AuditLogEntry{
+		TimestampNano: common.Int64Default(timeToUnixNano(t.Timestamp)),
+		Actor:         t.Actor,
+		APIName:       t.ApiName,
+		Domain:        t.Domain,
+		Request:       t.Request,
+		Outcome:       ToAuditLogOutcome(t.Outcome),
+		Error:         t.Error,
+	}
+}
+
+func FromAuditLogOutcome(t types.AuditLogOutcome) adminextv1.AuditLogOutcome {
+	switch t {
+	case "":
+		return adminextv1.AuditLogOutcome_AUDIT_LOG_OUTCOME_INVALID
+	case types.AuditLogOutcomeSuccess:
+		return adminextv1.AuditLogOutcome_AUDIT_LOG_OUTCOME_SUCCESS
+	case types.AuditLogOutcomeDenied:
+		return adminextv1.AuditLogOutcome_AUDIT_LOG_OUTCOME_DENIED
+	case types.AuditLogOutcomeFailed:
+		return adminextv1.AuditLogOutcome_AUDIT_LOG_OUTCOME_FAILED
+	}
+	panic("unexpected enum value")
+}
+
+func ToAuditLogOutcome(t adminextv1.AuditLogOutcome) types.AuditLogOutcome {
+	switch t {
+	case adminextv1.AuditLogOutcome_AUDIT_LOG_OUTCOME_INVALID:
+		return ""
+	case adminextv1.AuditLogOutcome_AUDIT_LOG_OUTCOME_SUCCESS:
+		return types.AuditLogOutcomeSuccess
+	case adminextv1.AuditLogOutcome_AUDIT_LOG_OUTCOME_DENIED:
+		return types.AuditLogOutcomeDenied
+	case adminextv1.AuditLogOutcome_AUDIT_LOG_OUTCOME_FAILED:
+		return types.AuditLogOutcomeFailed
+	}
+	panic("unexpected enum value")
+}
+
+func FromAdminReadHistoryTaskDLQMessagesRequest(t *types.ReadHistoryTaskDLQMessagesRequest) *adminextv1.ReadHistoryTaskDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.ReadHistoryTaskDLQMessagesRequest{
+		ShardId:               t.ShardID,
+		Domain:                t.Domain,
+		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
+		PageSize:              t.MaximumPageSize,
+		NextPageToken:         t.NextPageToken,
+	}
+}
+
+func ToAdminReadHistoryTaskDLQMessagesRequest(t *adminextv1.ReadHistoryTaskDLQMessagesRequest) *types.ReadHistoryTaskDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadHistoryTaskDLQMessagesRequest{
+		ShardID:               t.ShardId,
+		Domain:                t.Domain,
+		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
+		MaximumPageSize:       t.PageSize,
+		NextPageToken:         t.NextPageToken,
+	}
+}
+
+func FromAdminReadHistoryTaskDLQMessagesResponse(t *types.ReadHistoryTaskDLQMessagesResponse) *adminextv1.ReadHistoryTaskDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.ReadHistoryTaskDLQMessagesResponse{
+		Messages:      FromHistoryTaskDLQMessageArray(t.Messages),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminReadHistoryTaskDLQMessagesResponse(t *adminextv1.ReadHistoryTaskDLQMessagesResponse) *types.ReadHistoryTaskDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadHistoryTaskDLQMessagesResponse{
+		Messages:      ToHistoryTaskDLQMessageArray(t.Messages),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromAdminDescribeHistoryTaskDLQMessageRequest(t *types.DescribeHistoryTaskDLQMessageRequest) *adminextv1.DescribeHistoryTaskDLQMessageRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.DescribeHistoryTaskDLQMessageRequest{
+		ShardId:   t.ShardID,
+		MessageId: t.MessageID,
+	}
+}
+
+func ToAdminDescribeHistoryTaskDLQMessageRequest(t *adminextv1.DescribeHistoryTaskDLQMessageRequest) *types.DescribeHistoryTaskDLQMessageRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeHistoryTaskDLQMessageRequest{
+		ShardID:   t.ShardId,
+		MessageID: t.MessageId,
+	}
+}
+
+func FromAdminDescribeHistoryTaskDLQMessageResponse(t *types.DescribeHistoryTaskDLQMessageResponse) *adminextv1.DescribeHistoryTaskDLQMessageResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.DescribeHistoryTaskDLQMessageResponse{
+		Message: FromHistoryTaskDLQMessage(t.Message),
+	}
+}
+
+func ToAdminDescribeHistoryTaskDLQMessageResponse(t *adminextv1.DescribeHistoryTaskDLQMessageResponse) *types.DescribeHistoryTaskDLQMessageResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeHistoryTaskDLQMessageResponse{
+		Message: ToHistoryTaskDLQMessage(t.Message),
+	}
+}
+
+func FromAdminMergeHistoryTaskDLQMessagesRequest(t *types.MergeHistoryTaskDLQMessagesRequest) *adminextv1.MergeHistoryTaskDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.MergeHistoryTaskDLQMessagesRequest{
+		ShardId:               t.ShardID,
+		Domain:                t.Domain,
+		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
+		PageSize:              t.MaximumPageSize,
+		NextPageToken:         t.NextPageToken,
+	}
+}
+
+func ToAdminMergeHistoryTaskDLQMessagesRequest(t *adminextv1.MergeHistoryTaskDLQMessagesRequest) *types.MergeHistoryTaskDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MergeHistoryTaskDLQMessagesRequest{
+		ShardID:               t.ShardId,
+		Domain:                t.Domain,
+		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
+		MaximumPageSize:       t.PageSize,
+		NextPageToken:         t.NextPageToken,
+	}
+}
+
+func FromAdminMergeHistoryTaskDLQMessagesResponse(t *types.MergeHistoryTaskDLQMessagesResponse) *adminextv1.MergeHistoryTaskDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.MergeHistoryTaskDLQMessagesResponse{
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminMergeHistoryTaskDLQMessagesResponse(t *adminextv1.MergeHistoryTaskDLQMessagesResponse) *types.MergeHistoryTaskDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MergeHistoryTaskDLQMessagesResponse{
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromAdminPurgeHistoryTaskDLQMessagesRequest(t *types.PurgeHistoryTaskDLQMessagesRequest) *adminextv1.PurgeHistoryTaskDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.PurgeHistoryTaskDLQMessagesRequest{
+		ShardId:               t.ShardID,
+		Domain:                t.Domain,
+		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
+	}
+}
+
+func ToAdminPurgeHistoryTaskDLQMessagesRequest(t *adminextv1.PurgeHistoryTaskDLQMessagesRequest) *types.PurgeHistoryTaskDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PurgeHistoryTaskDLQMessagesRequest{
+		ShardID:               t.ShardId,
+		Domain:                t.Domain,
+		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
+	}
+}
+
+func FromHistoryTaskDLQMessageArray(t []*types.HistoryTaskDLQMessage) []*adminextv1.HistoryTaskDLQMessage {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminextv1.HistoryTaskDLQMessage, len(t))
+	for i := range t {
+		v[i] = FromHistoryTaskDLQMessage(t[i])
+	}
+	return v
+}
+
+func ToHistoryTaskDLQMessageArray(t []*adminextv1.HistoryTaskDLQMessage) []*types.HistoryTaskDLQMessage {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.HistoryTaskDLQMessage, len(t))
+	for i := range t {
+		v[i] = ToHistoryTaskDLQMessage(t[i])
+	}
+	return v
+}
+
+func FromHistoryTaskDLQMessage(t *types.HistoryTaskDLQMessage) *adminextv1.HistoryTaskDLQMessage {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.HistoryTaskDLQMessage{
+		MessageId:         t.MessageID,
+		ShardId:           t.ShardID,
+		DomainId:          t.DomainID,
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		TaskCategory:      FromHistoryTaskCategory(t.TaskCategory),
+		TaskType:          t.TaskType,
+		TaskId:            t.TaskID,
+		VisibilityTime:    unixNanoToTime(&t.VisibilityTimestampNano),
+		Attempt:           t.Attempt,
+		FirstAttemptTime:  unixNanoToTime(&t.FirstAttemptTimestampNano),
+		EnqueuedTime:      unixNanoToTime(&t.EnqueuedTimestampNano),
+		LastError:         t.LastError,
+		TaskInfo:          t.TaskInfo,
+	}
+}
+
+func ToHistoryTaskDLQMessage(t *adminextv1.HistoryTaskDLQMessage) *types.HistoryTaskDLQMessage {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryTaskDLQMessage{
+		MessageID:                 t.MessageId,
+		ShardID:                   t.ShardId,
+		DomainID:                  t.DomainId,
+		WorkflowID:                ToWorkflowID(t.WorkflowExecution),
+		RunID:                     ToRunID(t.WorkflowExecution),
+		TaskCategory:              ToHistoryTaskCategory(t.TaskCategory),
+		TaskType:                  t.TaskType,
+		TaskID:                    t.TaskId,
+		VisibilityTimestampNano:   common.Int64Default(timeToUnixNano(t.VisibilityTime)),
+		Attempt:                   t.Attempt,
+		FirstAttemptTimestampNano: common.Int64Default(timeToUnixNano(t.FirstAttemptTime)),
+		EnqueuedTimestampNano:     common.Int64Default(timeToUnixNano(t.EnqueuedTime)),
+		LastError:                 t.LastError,
+		TaskInfo:                  t.TaskInfo,
+	}
+}
+
+func FromHistoryTaskCategory(t types.HistoryTaskCategory) adminextv1.HistoryTaskCategory {
+	switch t {
+	case "":
+		return adminextv1.HistoryTaskCategory_HISTORY_TASK_CATEGORY_INVALID
+	case types.HistoryTaskCategoryTransfer:
+		return adminextv1.HistoryTaskCategory_HISTORY_TASK_CATEGORY_TRANSFER
+	case types.HistoryTaskCategoryTimer:
+		return adminextv1.HistoryTaskCategory_HISTORY_TASK_CATEGORY_TIMER
+	}
+	panic("unexpected enum value")
+}
+
+func ToHistoryTaskCategory(t adminextv1.HistoryTaskCategory) types.HistoryTaskCategory {
+	switch t {
+	case adminextv1.HistoryTaskCategory_HISTORY_TASK_CATEGORY_INVALID:
+		return ""
+	case adminextv1.HistoryTaskCategory_HISTORY_TASK_CATEGORY_TRANSFER:
+		return types.HistoryTaskCategoryTransfer
+	case adminextv1.HistoryTaskCategory_HISTORY_TASK_CATEGORY_TIMER:
+		return types.HistoryTaskCategoryTimer
+	}
+	panic("unexpected enum value")
+}
+
+func FromAdminDeleteDomainRequest(t *types.DeleteDomainRequest) *adminextv1.DeleteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.DeleteDomainRequest{
+		Name:          t.Name,
+		SecurityToken: t.SecurityToken,
+	}
+}
+
+func ToAdminDeleteDomainRequest(t *adminextv1.DeleteDomainRequest) *types.DeleteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteDomainRequest{
+		Name:          t.Name,
+		SecurityToken: t.SecurityToken,
+	}
+}
+
+func FromAdminDeleteDomainResponse(t *types.DeleteDomainResponse) *adminextv1.DeleteDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.DeleteDomainResponse{
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+	}
+}
+
+func ToAdminDeleteDomainResponse(t *adminextv1.DeleteDomainResponse) *types.DeleteDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteDomainResponse{
+		WorkflowID: ToWorkflowID(t.WorkflowExecution),
+		RunID:      ToRunID(t.WorkflowExecution),
+	}
+}
+
+func FromAdminGetReplicationStatusRequest(t *types.GetReplicationStatusRequest) *adminextv1.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.GetReplicationStatusRequest{
+		Clusters:   t.Clusters,
+		ForceFetch: t.ForceFetch,
+	}
+}
+
+func ToAdminGetReplicationStatusRequest(t *adminextv1.GetReplicationStatusRequest) *types.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusRequest{
+		Clusters:   t.Clusters,
+		ForceFetch: t.ForceFetch,
+	}
+}
+
+func FromAdminGetReplicationStatusResponse(t *types.GetReplicationStatusResponse) *adminextv1.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.GetReplicationStatusResponse{
+		Clusters: FromAdminReplicationClusterStatusArray(t.Clusters),
+		Shards:   FromAdminReplicationShardStatusArray(t.Shards),
+	}
+}
+
+func ToAdminGetReplicationStatusResponse(t *adminextv1.GetReplicationStatusResponse) *types.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusResponse{
+		Clusters: ToAdminReplicationClusterStatusArray(t.Clusters),
+		Shards:   ToAdminReplicationShardStatusArray(t.Shards),
+	}
+}
+
+func FromAdminReplicationClusterStatus(t *types.ReplicationClusterStatus) *adminextv1.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.ReplicationClusterStatus{
+		RemoteCluster:        t.RemoteCluster,
+		ShardCount:           t.ShardCount,
+		TaskLag:              t.TaskLag,
+		LastReplicatedTime:   unixNanoToTime(t.LastReplicatedTimestamp),
+		DlqSize:              t.DLQSize,
+		OldestPendingTaskAge: millisToDuration(t.OldestPendingTaskAgeMs),
+	}
+}
+
+func ToAdminReplicationClusterStatus(t *adminextv1.ReplicationClusterStatus) *types.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationClusterStatus{
+		RemoteCluster:           t.RemoteCluster,
+		ShardCount:              t.ShardCount,
+		TaskLag:                 t.TaskLag,
+		LastReplicatedTimestamp: timeToUnixNano(t.LastReplicatedTime),
+		DLQSize:                 t.DlqSize,
+		OldestPendingTaskAgeMs:  durationToMillis(t.OldestPendingTaskAge),
+	}
+}
+
+func FromAdminReplicationClusterStatusArray(t []*types.ReplicationClusterStatus) []*adminextv1.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminextv1.ReplicationClusterStatus, len(t))
+	for i := range t {
+		v[i] = FromAdminReplicationClusterStatus(t[i])
+	}
+	return v
+}
+
+func ToAdminReplicationClusterStatusArray(t []*adminextv1.ReplicationClusterStatus) []*types.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationClusterStatus, len(t))
+	for i := range t {
+		v[i] = ToAdminReplicationClusterStatus(t[i])
+	}
+	return v
+}
+
+func FromAdminReplicationShardStatus(t *types.ReplicationShardStatus) *adminextv1.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.ReplicationShardStatus{
+		ShardId:              t.ShardID,
+		RemoteCluster:        t.RemoteCluster,
+		ReadLevel:            t.ReadLevel,
+		AckedLevel:           t.AckedLevel,
+		MaxReadLevel:         t.MaxReadLevel,
+		LastReplicatedTime:   unixNanoToTime(t.LastReplicatedTimestamp),
+		DlqSize:              t.DLQSize,
+		OldestPendingTaskAge: millisToDuration(t.OldestPendingTaskAgeMs),
+	}
+}
+
+func ToAdminReplicationShardStatus(t *adminextv1.ReplicationShardStatus) *types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationShardStatus{
+		ShardID:                 t.ShardId,
+		RemoteCluster:           t.RemoteCluster,
+		ReadLevel:               t.ReadLevel,
+		AckedLevel:              t.AckedLevel,
+		MaxReadLevel:            t.MaxReadLevel,
+		LastReplicatedTimestamp: timeToUnixNano(t.LastReplicatedTime),
+		DLQSize:                 t.DlqSize,
+		OldestPendingTaskAgeMs:  durationToMillis(t.OldestPendingTaskAge),
+	}
+}
+
+func FromAdminReplicationShardStatusArray(t []*types.ReplicationShardStatus) []*adminextv1.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminextv1.ReplicationShardStatus, len(t))
+	for i := range t {
+		v[i] = FromAdminReplicationShardStatus(t[i])
+	}
+	return v
+}
+
+func ToAdminReplicationShardStatusArray(t []*adminextv1.ReplicationShardStatus) []*types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationShardStatus, len(t))
+	for i := range t {
+		v[i] = ToAdminReplicationShardStatus(t[i])
+	}
+	return v
+}
+
+func FromAdminRenameDomainRequest(t *types.RenameDomainRequest) *adminextv1.RenameDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.RenameDomainRequest{
+		Name:          t.Name,
+		NewName:       t.NewName,
+		SecurityToken: t.SecurityToken,
+	}
+}
+
+func ToAdminRenameDomainRequest(t *adminextv1.RenameDomainRequest) *types.RenameDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RenameDomainRequest{
+		Name:          t.Name,
+		NewName:       t.NewName,
+		SecurityToken: t.SecurityToken,
+	}
+}
+
+func FromAdminRenameDomainResponse(t *types.RenameDomainResponse) *adminextv1.RenameDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.RenameDomainResponse{
+		DomainId:               t.DomainID,
+		PreviousNameExpiryTime: unixNanoToTime(&t.PreviousNameExpiryTimestamp),
+	}
+}
+
+func ToAdminRenameDomainResponse(t *adminextv1.RenameDomainResponse) *types.RenameDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RenameDomainResponse{
+		DomainID:                    t.DomainId,
+		PreviousNameExpiryTimestamp: common.Int64Default(timeToUnixNano(t.PreviousNameExpiryTime)),
+	}
+}
+
+func FromAdminDescribeWorkflowVersionHistoriesRequest(t *types.DescribeWorkflowVersionHistoriesRequest) *adminextv1.DescribeWorkflowVersionHistoriesRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.DescribeWorkflowVersionHistoriesRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+		Cluster:           t.Cluster,
+	}
+}
+
+func ToAdminDescribeWorkflowVersionHistoriesRequest(t *adminextv1.DescribeWorkflowVersionHistoriesRequest) *types.DescribeWorkflowVersionHistoriesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeWorkflowVersionHistoriesRequest{
+		Domain:    t.Domain,
+		Execution: ToWorkflowExecution(t.WorkflowExecution),
+		Cluster:   t.Cluster,
+	}
+}
+
+func FromAdminDescribeWorkflowVersionHistoriesResponse(t *types.DescribeWorkflowVersionHistoriesResponse) *adminextv1.DescribeWorkflowVersionHistoriesResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.DescribeWorkflowVersionHistoriesResponse{
+		Cluster:           t.Cluster,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+		VersionHistories:  FromVersionHistories(t.VersionHistories),
+	}
+}
+
+func ToAdminDescribeWorkflowVersionHistoriesResponse(t *adminextv1.DescribeWorkflowVersionHistoriesResponse) *types.DescribeWorkflowVersionHistoriesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeWorkflowVersionHistoriesResponse{
+		Cluster:          t.Cluster,
+		Execution:        ToWorkflowExecution(t.WorkflowExecution),
+		VersionHistories: ToVersionHistories(t.VersionHistories),
+	}
+}
+
+func FromAdminRebuildWorkflowBranchRequest(t *types.RebuildWorkflowBranchRequest) *adminextv1.RebuildWorkflowBranchRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.RebuildWorkflowBranchRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+		BranchToken:       t.BranchToken,
+		Cluster:           t.Cluster,
+	}
+}
+
+func ToAdminRebuildWorkflowBranchRequest(t *adminextv1.RebuildWorkflowBranchRequest) *types.RebuildWorkflowBranchRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RebuildWorkflowBranchRequest{
+		Domain:      t.Domain,
+		Execution:   ToWorkflowExecution(t.WorkflowExecution),
+		BranchToken: t.BranchToken,
+		Cluster:     t.Cluster,
+	}
+}
+
+func FromAdminDescribeTaskListRequest(t *types.DescribeTaskListRequest) *adminextv1.DescribeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.DescribeTaskListRequest{
+		Domain:                t.Domain,
+		TaskList:              FromTaskList(t.TaskList),
+		TaskListType:          FromTaskListType(t.TaskListType),
+		IncludeTaskListStatus: t.IncludeTaskListStatus,
+	}
+}
+
+func ToAdminDescribeTaskListRequest(t *adminextv1.DescribeTaskListRequest) *types.DescribeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListRequest{
+		Domain:                t.Domain,
+		TaskList:              ToTaskList(t.TaskList),
+		TaskListType:          ToTaskListType(t.TaskListType),
+		IncludeTaskListStatus: t.IncludeTaskListStatus,
+	}
+}
+
+func FromAdminDescribeTaskListResponse(t *types.DescribeTaskListResponse) *adminextv1.DescribeTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminextv1.DescribeTaskListResponse{
+		Pollers:          FromPollerInfoArray(t.Pollers),
+		TaskListStatus:   FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig:  FromAPITaskListPartitionConfig(t.PartitionConfig),
+		DispatchStats:    FromTaskListDispatchStats(t.TaskListStatus),
+		ScalingDecisions: FromTaskListScalingDecisionArray(t.ScalingDecisions),
+	}
+}
+
+func ToAdminDescribeTaskListResponse(t *adminextv1.DescribeTaskListResponse) *types.DescribeTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListResponse{
+		Pollers:          ToPollerInfoArray(t.Pollers),
+		TaskListStatus:   ToTaskListStatusWithDispatchStats(t.TaskListStatus, t.DispatchStats),
+		PartitionConfig:  ToAPITaskListPartitionConfig(t.PartitionConfig),
+		ScalingDecisions: ToTaskListScalingDecisionArray(t.ScalingDecisions),
+	}
+}
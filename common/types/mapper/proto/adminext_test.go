@@ -0,0 +1,190 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	adminextv1 "github.com/uber/cadence/.gen/proto/adminext/v1"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestAdminUpdateActivityOptionsRequest(t *testing.T) {
+	for _, item := range []*types.UpdateActivityOptionsRequest{nil, {}, &testdata.AdminUpdateActivityOptionsRequest} {
+		assert.Equal(t, item, ToAdminUpdateActivityOptionsRequest(FromAdminUpdateActivityOptionsRequest(item)))
+	}
+}
+func TestAdminUpdateActivityOptionsResponse(t *testing.T) {
+	for _, item := range []*types.UpdateActivityOptionsResponse{nil, {}, &testdata.AdminUpdateActivityOptionsResponse} {
+		assert.Equal(t, item, ToAdminUpdateActivityOptionsResponse(FromAdminUpdateActivityOptionsResponse(item)))
+	}
+}
+
+func TestAdminListAuditLogEntriesRequest(t *testing.T) {
+	for _, item := range []*types.ListAuditLogEntriesRequest{nil, {}, &testdata.AdminListAuditLogEntriesRequest} {
+		assert.Equal(t, item, ToAdminListAuditLogEntriesRequest(FromAdminListAuditLogEntriesRequest(item)))
+	}
+}
+
+func TestAdminListAuditLogEntriesResponse(t *testing.T) {
+	for _, item := range []*types.ListAuditLogEntriesResponse{nil, {}, &testdata.AdminListAuditLogEntriesResponse} {
+		assert.Equal(t, item, ToAdminListAuditLogEntriesResponse(FromAdminListAuditLogEntriesResponse(item)))
+	}
+}
+
+func TestAuditLogOutcome(t *testing.T) {
+	for _, item := range []types.AuditLogOutcome{"", types.AuditLogOutcomeSuccess, types.AuditLogOutcomeDenied, types.AuditLogOutcomeFailed} {
+		assert.Equal(t, item, ToAuditLogOutcome(FromAuditLogOutcome(item)))
+	}
+	assert.Panics(t, func() { FromAuditLogOutcome("unknown") })
+	assert.Panics(t, func() { ToAuditLogOutcome(adminextv1.AuditLogOutcome(999)) })
+}
+
+func TestAdminReadHistoryTaskDLQMessagesRequest(t *testing.T) {
+	for _, item := range []*types.ReadHistoryTaskDLQMessagesRequest{nil, {}, &testdata.AdminReadHistoryTaskDLQMessagesRequest} {
+		assert.Equal(t, item, ToAdminReadHistoryTaskDLQMessagesRequest(FromAdminReadHistoryTaskDLQMessagesRequest(item)))
+	}
+}
+
+func TestAdminReadHistoryTaskDLQMessagesResponse(t *testing.T) {
+	for _, item := range []*types.ReadHistoryTaskDLQMessagesResponse{nil, {}, &testdata.AdminReadHistoryTaskDLQMessagesResponse} {
+		assert.Equal(t, item, ToAdminReadHistoryTaskDLQMessagesResponse(FromAdminReadHistoryTaskDLQMessagesResponse(item)))
+	}
+}
+
+func TestAdminDescribeHistoryTaskDLQMessageRequest(t *testing.T) {
+	for _, item := range []*types.DescribeHistoryTaskDLQMessageRequest{nil, {}, &testdata.AdminDescribeHistoryTaskDLQMessageRequest} {
+		assert.Equal(t, item, ToAdminDescribeHistoryTaskDLQMessageRequest(FromAdminDescribeHistoryTaskDLQMessageRequest(item)))
+	}
+}
+
+func TestAdminDescribeHistoryTaskDLQMessageResponse(t *testing.T) {
+	for _, item := range []*types.DescribeHistoryTaskDLQMessageResponse{nil, {}, &testdata.AdminDescribeHistoryTaskDLQMessageResponse} {
+		assert.Equal(t, item, ToAdminDescribeHistoryTaskDLQMessageResponse(FromAdminDescribeHistoryTaskDLQMessageResponse(item)))
+	}
+}
+
+func TestAdminMergeHistoryTaskDLQMessagesRequest(t *testing.T) {
+	for _, item := range []*types.MergeHistoryTaskDLQMessagesRequest{nil, {}, &testdata.AdminMergeHistoryTaskDLQMessagesRequest} {
+		assert.Equal(t, item, ToAdminMergeHistoryTaskDLQMessagesRequest(FromAdminMergeHistoryTaskDLQMessagesRequest(item)))
+	}
+}
+
+func TestAdminMergeHistoryTaskDLQMessagesResponse(t *testing.T) {
+	for _, item := range []*types.MergeHistoryTaskDLQMessagesResponse{nil, {}, &testdata.AdminMergeHistoryTaskDLQMessagesResponse} {
+		assert.Equal(t, item, ToAdminMergeHistoryTaskDLQMessagesResponse(FromAdminMergeHistoryTaskDLQMessagesResponse(item)))
+	}
+}
+
+func TestAdminPurgeHistoryTaskDLQMessagesRequest(t *testing.T) {
+	for _, item := range []*types.PurgeHistoryTaskDLQMessagesRequest{nil, {}, &testdata.AdminPurgeHistoryTaskDLQMessagesRequest} {
+		assert.Equal(t, item, ToAdminPurgeHistoryTaskDLQMessagesRequest(FromAdminPurgeHistoryTaskDLQMessagesRequest(item)))
+	}
+}
+
+func TestHistoryTaskDLQMessage(t *testing.T) {
+	for _, item := range []*types.HistoryTaskDLQMessage{nil, {}, &testdata.AdminHistoryTaskDLQMessage} {
+		assert.Equal(t, item, ToHistoryTaskDLQMessage(FromHistoryTaskDLQMessage(item)))
+	}
+}
+
+func TestHistoryTaskCategory(t *testing.T) {
+	for _, item := range []types.HistoryTaskCategory{"", types.HistoryTaskCategoryTransfer, types.HistoryTaskCategoryTimer} {
+		assert.Equal(t, item, ToHistoryTaskCategory(FromHistoryTaskCategory(item)))
+	}
+	assert.Panics(t, func() { FromHistoryTaskCategory("unknown") })
+	assert.Panics(t, func() { ToHistoryTaskCategory(adminextv1.HistoryTaskCategory(999)) })
+}
+
+func TestAdminDeleteDomainRequest(t *testing.T) {
+	for _, item := range []*types.DeleteDomainRequest{nil, {}, &testdata.AdminDeleteDomainRequest} {
+		assert.Equal(t, item, ToAdminDeleteDomainRequest(FromAdminDeleteDomainRequest(item)))
+	}
+}
+
+func TestAdminDeleteDomainResponse(t *testing.T) {
+	for _, item := range []*types.DeleteDomainResponse{nil, {}, &testdata.AdminDeleteDomainResponse} {
+		assert.Equal(t, item, ToAdminDeleteDomainResponse(FromAdminDeleteDomainResponse(item)))
+	}
+}
+
+func TestAdminGetReplicationStatusRequest(t *testing.T) {
+	for _, item := range []*types.GetReplicationStatusRequest{nil, {}, &testdata.AdminGetReplicationStatusRequest} {
+		assert.Equal(t, item, ToAdminGetReplicationStatusRequest(FromAdminGetReplicationStatusRequest(item)))
+	}
+}
+
+func TestAdminGetReplicationStatusResponse(t *testing.T) {
+	for _, item := range []*types.GetReplicationStatusResponse{nil, {}, &testdata.AdminGetReplicationStatusResponse} {
+		assert.Equal(t, item, ToAdminGetReplicationStatusResponse(FromAdminGetReplicationStatusResponse(item)))
+	}
+}
+
+func TestAdminReplicationClusterStatus(t *testing.T) {
+	for _, item := range []*types.ReplicationClusterStatus{nil, {}, &testdata.ReplicationClusterStatus} {
+		assert.Equal(t, item, ToAdminReplicationClusterStatus(FromAdminReplicationClusterStatus(item)))
+	}
+}
+
+func TestAdminRenameDomainRequest(t *testing.T) {
+	for _, item := range []*types.RenameDomainRequest{nil, {}, &testdata.AdminRenameDomainRequest} {
+		assert.Equal(t, item, ToAdminRenameDomainRequest(FromAdminRenameDomainRequest(item)))
+	}
+}
+
+func TestAdminRenameDomainResponse(t *testing.T) {
+	for _, item := range []*types.RenameDomainResponse{nil, {}, &testdata.AdminRenameDomainResponse} {
+		assert.Equal(t, item, ToAdminRenameDomainResponse(FromAdminRenameDomainResponse(item)))
+	}
+}
+
+func TestAdminDescribeWorkflowVersionHistoriesRequest(t *testing.T) {
+	for _, item := range []*types.DescribeWorkflowVersionHistoriesRequest{nil, {}, &testdata.AdminDescribeWorkflowVersionHistoriesRequest} {
+		assert.Equal(t, item, ToAdminDescribeWorkflowVersionHistoriesRequest(FromAdminDescribeWorkflowVersionHistoriesRequest(item)))
+	}
+}
+
+func TestAdminDescribeWorkflowVersionHistoriesResponse(t *testing.T) {
+	for _, item := range []*types.DescribeWorkflowVersionHistoriesResponse{nil, {}, &testdata.AdminDescribeWorkflowVersionHistoriesResponse} {
+		assert.Equal(t, item, ToAdminDescribeWorkflowVersionHistoriesResponse(FromAdminDescribeWorkflowVersionHistoriesResponse(item)))
+	}
+}
+
+func TestAdminRebuildWorkflowBranchRequest(t *testing.T) {
+	for _, item := range []*types.RebuildWorkflowBranchRequest{nil, {}, &testdata.AdminRebuildWorkflowBranchRequest, &testdata.AdminRemoteRebuildWorkflowBranchRequest} {
+		assert.Equal(t, item, ToAdminRebuildWorkflowBranchRequest(FromAdminRebuildWorkflowBranchRequest(item)))
+	}
+}
+
+func TestAdminDescribeTaskListRequest(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListRequest{nil, {}, &testdata.AdminDescribeTaskListRequest} {
+		assert.Equal(t, item, ToAdminDescribeTaskListRequest(FromAdminDescribeTaskListRequest(item)))
+	}
+}
+
+func TestAdminDescribeTaskListResponse(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListResponse{nil, {}, &testdata.AdminDescribeTaskListResponse} {
+		assert.Equal(t, item, ToAdminDescribeTaskListResponse(FromAdminDescribeTaskListResponse(item)))
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	apiextv1 "github.com/uber/cadence/.gen/proto/apiext/v1"
+	"github.com/uber/cadence/common/types"
+)
+
+func FromWorkflowExtStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *apiextv1.StartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiextv1.StartWorkflowExecutionRequest{
+		Request:               FromStartWorkflowExecutionRequest(t),
+		RequestEagerExecution: t.RequestEagerExecution,
+	}
+}
+
+func ToWorkflowExtStartWorkflowExecutionRequest(t *apiextv1.StartWorkflowExecutionRequest) *types.StartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	request := ToStartWorkflowExecutionRequest(t.Request)
+	if request == nil {
+		request = &types.StartWorkflowExecutionRequest{}
+	}
+	request.RequestEagerExecution = t.RequestEagerExecution
+	return request
+}
+
+func FromWorkflowExtStartWorkflowExecutionResponse(t *types.StartWorkflowExecutionResponse) *apiextv1.StartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiextv1.StartWorkflowExecutionResponse{
+		RunId:        t.RunID,
+		DecisionTask: FromPollForDecisionTaskResponse(t.DecisionTask),
+	}
+}
+
+func ToWorkflowExtStartWorkflowExecutionResponse(t *apiextv1.StartWorkflowExecutionResponse) *types.StartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.StartWorkflowExecutionResponse{
+		RunID:        t.RunId,
+		DecisionTask: ToPollForDecisionTaskResponse(t.DecisionTask),
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apiextv1 "github.com/uber/cadence/.gen/proto/apiext/v1"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestWorkflowExtStartWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.StartWorkflowExecutionRequest{nil, {}, &testdata.StartWorkflowExecutionRequest, &testdata.StartWorkflowExecutionEagerRequest} {
+		assert.Equal(t, item, ToWorkflowExtStartWorkflowExecutionRequest(FromWorkflowExtStartWorkflowExecutionRequest(item)))
+	}
+	assert.Equal(t, &types.StartWorkflowExecutionRequest{RequestEagerExecution: true},
+		ToWorkflowExtStartWorkflowExecutionRequest(&apiextv1.StartWorkflowExecutionRequest{RequestEagerExecution: true}))
+}
+
+func TestWorkflowExtStartWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.StartWorkflowExecutionResponse{nil, {}, &testdata.StartWorkflowExecutionResponse, &testdata.StartWorkflowExecutionEagerResponse} {
+		assert.Equal(t, item, ToWorkflowExtStartWorkflowExecutionResponse(FromWorkflowExtStartWorkflowExecutionResponse(item)))
+	}
+}
@@ -62,6 +62,8 @@ func TestDomainOperation(t *testing.T) {
 		nil,
 		types.DomainOperationCreate.Ptr(),
 		types.DomainOperationUpdate.Ptr(),
+		types.DomainOperationDelete.Ptr(),
+		types.DomainOperationRename.Ptr(),
 	} {
 		assert.Equal(t, item, ToDomainOperation(FromDomainOperation(item)))
 	}
@@ -133,6 +133,24 @@ func durationToSeconds(d *gogo.Duration) *int32 {
 	return common.Int32Ptr(int32(common.DurationToSeconds(duration)))
 }
 
+func millisToDuration(ms int64) *gogo.Duration {
+	if ms == 0 {
+		return nil
+	}
+	return gogo.DurationProto(time.Duration(ms) * time.Millisecond)
+}
+
+func durationToMillis(d *gogo.Duration) int64 {
+	if d == nil {
+		return 0
+	}
+	duration, err := gogo.DurationFromProto(d)
+	if err != nil {
+		panic(err)
+	}
+	return duration.Milliseconds()
+}
+
 func int32To64(v *int32) *int64 {
 	if v == nil {
 		return nil
@@ -376,8 +376,9 @@ func FromHistoryGetReplicationMessagesRequest(t *types.GetReplicationMessagesReq
 		return nil
 	}
 	return &historyv1.GetReplicationMessagesRequest{
-		Tokens:      FromReplicationTokenArray(t.Tokens),
-		ClusterName: t.ClusterName,
+		Tokens:          FromReplicationTokenArray(t.Tokens),
+		ClusterName:     t.ClusterName,
+		WaitForNewTasks: t.WaitForNewTasks,
 	}
 }
 
@@ -386,8 +387,9 @@ func ToHistoryGetReplicationMessagesRequest(t *historyv1.GetReplicationMessagesR
 		return nil
 	}
 	return &types.GetReplicationMessagesRequest{
-		Tokens:      ToReplicationTokenArray(t.Tokens),
-		ClusterName: t.ClusterName,
+		Tokens:          ToReplicationTokenArray(t.Tokens),
+		ClusterName:     t.ClusterName,
+		WaitForNewTasks: t.WaitForNewTasks,
 	}
 }
 
@@ -445,6 +447,98 @@ func ToHistoryCountDLQMessagesResponse(t *historyv1.CountDLQMessagesResponse) *t
 	}
 }
 
+func FromHistoryGetReplicationStatusRequest(t *types.GetReplicationStatusRequest) *historyv1.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationStatusRequest{
+		Clusters:   t.Clusters,
+		ForceFetch: t.ForceFetch,
+	}
+}
+
+func ToHistoryGetReplicationStatusRequest(t *historyv1.GetReplicationStatusRequest) *types.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusRequest{
+		Clusters:   t.Clusters,
+		ForceFetch: t.ForceFetch,
+	}
+}
+
+func FromHistoryGetReplicationStatusResponse(t *types.HistoryGetReplicationStatusResponse) *historyv1.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationStatusResponse{
+		Shards: FromReplicationShardStatusArray(t.Shards),
+	}
+}
+
+func ToHistoryGetReplicationStatusResponse(t *historyv1.GetReplicationStatusResponse) *types.HistoryGetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetReplicationStatusResponse{
+		Shards: ToReplicationShardStatusArray(t.Shards),
+	}
+}
+
+func FromReplicationShardStatus(t *types.ReplicationShardStatus) *historyv1.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReplicationShardStatus{
+		ShardId:              t.ShardID,
+		RemoteCluster:        t.RemoteCluster,
+		ReadLevel:            t.ReadLevel,
+		AckedLevel:           t.AckedLevel,
+		MaxReadLevel:         t.MaxReadLevel,
+		LastReplicatedTime:   unixNanoToTime(t.LastReplicatedTimestamp),
+		DlqSize:              t.DLQSize,
+		OldestPendingTaskAge: millisToDuration(t.OldestPendingTaskAgeMs),
+	}
+}
+
+func ToReplicationShardStatus(t *historyv1.ReplicationShardStatus) *types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationShardStatus{
+		ShardID:                 t.ShardId,
+		RemoteCluster:           t.RemoteCluster,
+		ReadLevel:               t.ReadLevel,
+		AckedLevel:              t.AckedLevel,
+		MaxReadLevel:            t.MaxReadLevel,
+		LastReplicatedTimestamp: timeToUnixNano(t.LastReplicatedTime),
+		DLQSize:                 t.DlqSize,
+		OldestPendingTaskAgeMs:  durationToMillis(t.OldestPendingTaskAge),
+	}
+}
+
+func FromReplicationShardStatusArray(t []*types.ReplicationShardStatus) []*historyv1.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.ReplicationShardStatus, len(t))
+	for i := range t {
+		v[i] = FromReplicationShardStatus(t[i])
+	}
+	return v
+}
+
+func ToReplicationShardStatusArray(t []*historyv1.ReplicationShardStatus) []*types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationShardStatus, len(t))
+	for i := range t {
+		v[i] = ToReplicationShardStatus(t[i])
+	}
+	return v
+}
+
 func FromHistoryMergeDLQMessagesRequest(t *types.MergeDLQMessagesRequest) *historyv1.MergeDLQMessagesRequest {
 	if t == nil {
 		return nil
@@ -951,6 +1045,92 @@ func ToHistoryRefreshWorkflowTasksRequest(t *historyv1.RefreshWorkflowTasksReque
 	}
 }
 
+func FromHistoryRebuildWorkflowBranchRequest(t *types.HistoryRebuildWorkflowBranchRequest) *historyv1.RebuildWorkflowBranchRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.RebuildWorkflowBranchRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.Execution),
+		BranchToken:       t.Request.BranchToken,
+	}
+}
+
+func ToHistoryRebuildWorkflowBranchRequest(t *historyv1.RebuildWorkflowBranchRequest) *types.HistoryRebuildWorkflowBranchRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRebuildWorkflowBranchRequest{
+		Request: &types.RebuildWorkflowBranchRequest{
+			Domain:      t.Domain,
+			Execution:   ToWorkflowExecution(t.WorkflowExecution),
+			BranchToken: t.BranchToken,
+		},
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromHistoryUpdateActivityOptionsRequest(t *types.HistoryUpdateActivityOptionsRequest) *historyv1.UpdateActivityOptionsRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.UpdateActivityOptionsRequest{
+		DomainId:               t.DomainUUID,
+		Domain:                 t.Request.Domain,
+		WorkflowExecution:      FromWorkflowExecution(t.Request.Execution),
+		ActivityId:             t.Request.ActivityID,
+		TaskList:               FromTaskList(t.Request.TaskList),
+		ScheduleToStartTimeout: secondsToDuration(t.Request.ScheduleToStartTimeoutSeconds),
+		ScheduleToCloseTimeout: secondsToDuration(t.Request.ScheduleToCloseTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.Request.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.Request.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.Request.RetryPolicy),
+		ResetAttempts:          t.Request.ResetAttempts,
+		Identity:               t.Request.Identity,
+	}
+}
+
+func ToHistoryUpdateActivityOptionsRequest(t *historyv1.UpdateActivityOptionsRequest) *types.HistoryUpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.UpdateActivityOptionsRequest{
+			Domain:                        t.Domain,
+			Execution:                     ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:                    t.ActivityId,
+			TaskList:                      ToTaskList(t.TaskList),
+			ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+			ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+			StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+			HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+			RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+			ResetAttempts:                 t.ResetAttempts,
+			Identity:                      t.Identity,
+		},
+	}
+}
+
+func FromHistoryUpdateActivityOptionsResponse(t *types.UpdateActivityOptionsResponse) *historyv1.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateActivityOptionsResponse{
+		Activity: FromPendingActivityInfo(t.Activity),
+	}
+}
+
+func ToHistoryUpdateActivityOptionsResponse(t *historyv1.UpdateActivityOptionsResponse) *types.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsResponse{
+		Activity: ToPendingActivityInfo(t.Activity),
+	}
+}
+
 func FromHistoryRemoveSignalMutableStateRequest(t *types.RemoveSignalMutableStateRequest) *historyv1.RemoveSignalMutableStateRequest {
 	if t == nil {
 		return nil
@@ -1354,6 +1534,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		LastCompletionResult:     FromPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoff: secondsToDuration(t.FirstDecisionTaskBackoffSeconds),
 		PartitionConfig:          t.PartitionConfig,
+		RequestEagerExecution:    t.RequestEagerExecution,
 	}
 }
 
@@ -1373,6 +1554,7 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 		LastCompletionResult:            ToPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoffSeconds: durationToSeconds(t.FirstDecisionTaskBackoff),
 		PartitionConfig:                 t.PartitionConfig,
+		RequestEagerExecution:           t.RequestEagerExecution,
 	}
 }
 
@@ -1381,7 +1563,8 @@ func FromHistoryStartWorkflowExecutionResponse(t *types.StartWorkflowExecutionRe
 		return nil
 	}
 	return &historyv1.StartWorkflowExecutionResponse{
-		RunId: t.RunID,
+		RunId:        t.RunID,
+		DecisionTask: FromPollForDecisionTaskResponse(t.DecisionTask),
 	}
 }
 
@@ -1390,7 +1573,8 @@ func ToHistoryStartWorkflowExecutionResponse(t *historyv1.StartWorkflowExecution
 		return nil
 	}
 	return &types.StartWorkflowExecutionResponse{
-		RunID: t.RunId,
+		RunID:        t.RunId,
+		DecisionTask: ToPollForDecisionTaskResponse(t.DecisionTask),
 	}
 }
 
@@ -97,7 +97,7 @@ func TestHistoryGetMutableStateResponse(t *testing.T) {
 	}
 }
 func TestHistoryGetReplicationMessagesRequest(t *testing.T) {
-	for _, item := range []*types.GetReplicationMessagesRequest{nil, {}, &testdata.HistoryGetReplicationMessagesRequest} {
+	for _, item := range []*types.GetReplicationMessagesRequest{nil, {}, &testdata.HistoryGetReplicationMessagesRequest, {WaitForNewTasks: true}} {
 		assert.Equal(t, item, ToHistoryGetReplicationMessagesRequest(FromHistoryGetReplicationMessagesRequest(item)))
 	}
 }
@@ -116,6 +116,16 @@ func TestHistoryCountDLQMessagesResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryCountDLQMessagesResponse(FromHistoryCountDLQMessagesResponse(item)))
 	}
 }
+func TestHistoryGetReplicationStatusRequest(t *testing.T) {
+	for _, item := range []*types.GetReplicationStatusRequest{nil, {}, &testdata.HistoryGetReplicationStatusRequest} {
+		assert.Equal(t, item, ToHistoryGetReplicationStatusRequest(FromHistoryGetReplicationStatusRequest(item)))
+	}
+}
+func TestHistoryGetReplicationStatusResponse(t *testing.T) {
+	for _, item := range []*types.HistoryGetReplicationStatusResponse{nil, {}, &testdata.HistoryGetReplicationStatusResponse} {
+		assert.Equal(t, item, ToHistoryGetReplicationStatusResponse(FromHistoryGetReplicationStatusResponse(item)))
+	}
+}
 func TestHistoryMergeDLQMessagesRequest(t *testing.T) {
 	for _, item := range []*types.MergeDLQMessagesRequest{nil, {}, &testdata.HistoryMergeDLQMessagesRequest} {
 		assert.Equal(t, item, ToHistoryMergeDLQMessagesRequest(FromHistoryMergeDLQMessagesRequest(item)))
@@ -214,6 +224,23 @@ func TestHistoryRefreshWorkflowTasksRequest(t *testing.T) {
 	}
 	assert.Nil(t, FromHistoryRefreshWorkflowTasksRequest(&types.HistoryRefreshWorkflowTasksRequest{}))
 }
+func TestHistoryRebuildWorkflowBranchRequest(t *testing.T) {
+	for _, item := range []*types.HistoryRebuildWorkflowBranchRequest{nil, &testdata.HistoryRebuildWorkflowBranchRequest} {
+		assert.Equal(t, item, ToHistoryRebuildWorkflowBranchRequest(FromHistoryRebuildWorkflowBranchRequest(item)))
+	}
+	assert.Nil(t, FromHistoryRebuildWorkflowBranchRequest(&types.HistoryRebuildWorkflowBranchRequest{}))
+}
+func TestHistoryUpdateActivityOptionsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateActivityOptionsRequest{nil, &testdata.HistoryUpdateActivityOptionsRequest} {
+		assert.Equal(t, item, ToHistoryUpdateActivityOptionsRequest(FromHistoryUpdateActivityOptionsRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUpdateActivityOptionsRequest(&types.HistoryUpdateActivityOptionsRequest{}))
+}
+func TestHistoryUpdateActivityOptionsResponse(t *testing.T) {
+	for _, item := range []*types.UpdateActivityOptionsResponse{nil, {}, &testdata.AdminUpdateActivityOptionsResponse} {
+		assert.Equal(t, item, ToHistoryUpdateActivityOptionsResponse(FromHistoryUpdateActivityOptionsResponse(item)))
+	}
+}
 func TestHistoryRemoveSignalMutableStateRequest(t *testing.T) {
 	for _, item := range []*types.RemoveSignalMutableStateRequest{nil, {}, &testdata.HistoryRemoveSignalMutableStateRequest} {
 		assert.Equal(t, item, ToHistoryRemoveSignalMutableStateRequest(FromHistoryRemoveSignalMutableStateRequest(item)))
@@ -305,7 +332,9 @@ func TestHistorySignalWorkflowExecutionRequest(t *testing.T) {
 	}
 }
 func TestHistoryStartWorkflowExecutionRequest(t *testing.T) {
-	for _, item := range []*types.HistoryStartWorkflowExecutionRequest{nil, {}, &testdata.HistoryStartWorkflowExecutionRequest} {
+	eagerRequest := testdata.HistoryStartWorkflowExecutionRequest
+	eagerRequest.RequestEagerExecution = true
+	for _, item := range []*types.HistoryStartWorkflowExecutionRequest{nil, {}, &testdata.HistoryStartWorkflowExecutionRequest, &eagerRequest} {
 		assert.Equal(t, item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(item)))
 	}
 }
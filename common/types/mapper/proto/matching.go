@@ -41,6 +41,8 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:            t.ForwardedFrom,
 		ActivityTaskDispatchInfo: FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:          t.PartitionConfig,
+		Priority:                 t.Priority,
+		FairnessKey:              t.FairnessKey,
 	}
 }
 
@@ -59,6 +61,8 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		ForwardedFrom:                 t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
 	}
 }
 
@@ -75,6 +79,8 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
 		PartitionConfig:        t.PartitionConfig,
+		Priority:               t.Priority,
+		FairnessKey:            t.FairnessKey,
 	}
 }
 
@@ -91,6 +97,8 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
 	}
 }
 
@@ -217,9 +225,11 @@ func FromMatchingDescribeTaskListResponse(t *types.DescribeTaskListResponse) *ma
 		return nil
 	}
 	return &matchingv1.DescribeTaskListResponse{
-		Pollers:         FromPollerInfoArray(t.Pollers),
-		TaskListStatus:  FromTaskListStatus(t.TaskListStatus),
-		PartitionConfig: FromAPITaskListPartitionConfig(t.PartitionConfig),
+		Pollers:          FromPollerInfoArray(t.Pollers),
+		TaskListStatus:   FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig:  FromAPITaskListPartitionConfig(t.PartitionConfig),
+		DispatchStats:    FromTaskListDispatchStats(t.TaskListStatus),
+		ScalingDecisions: FromTaskListScalingDecisionArray(t.ScalingDecisions),
 	}
 }
 
@@ -228,9 +238,10 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 		return nil
 	}
 	return &types.DescribeTaskListResponse{
-		Pollers:         ToPollerInfoArray(t.Pollers),
-		TaskListStatus:  ToTaskListStatus(t.TaskListStatus),
-		PartitionConfig: ToAPITaskListPartitionConfig(t.PartitionConfig),
+		Pollers:          ToPollerInfoArray(t.Pollers),
+		TaskListStatus:   ToTaskListStatusWithDispatchStats(t.TaskListStatus, t.DispatchStats),
+		PartitionConfig:  ToAPITaskListPartitionConfig(t.PartitionConfig),
+		ScalingDecisions: ToTaskListScalingDecisionArray(t.ScalingDecisions),
 	}
 }
 
@@ -67,7 +67,7 @@ func TestMatchingDescribeTaskListRequest(t *testing.T) {
 }
 
 func TestMatchingDescribeTaskListResponse(t *testing.T) {
-	for _, item := range []*types.DescribeTaskListResponse{nil, {}, &testdata.MatchingDescribeTaskListResponse} {
+	for _, item := range []*types.DescribeTaskListResponse{nil, {}, &testdata.MatchingDescribeTaskListResponse, &testdata.MatchingDescribeTaskListResponseWithScalingDecisions} {
 		assert.Equal(t, item, ToMatchingDescribeTaskListResponse(FromMatchingDescribeTaskListResponse(item)))
 	}
 }
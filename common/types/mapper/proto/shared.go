@@ -22,6 +22,7 @@ package proto
 
 import (
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
+	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 
 	sharedv1 "github.com/uber/cadence/.gen/proto/shared/v1"
 	"github.com/uber/cadence/common"
@@ -486,6 +487,13 @@ func ToDLQType(t adminv1.DLQType) *types.DLQType {
 	panic("unexpected enum value")
 }
 
+// domainOperationDelete and domainOperationRename are not part of the IDL yet,
+// proto3 enums are open so the values are preserved on the wire
+const (
+	domainOperationDelete = adminv1.DomainOperation(3)
+	domainOperationRename = adminv1.DomainOperation(4)
+)
+
 func FromDomainOperation(t *types.DomainOperation) adminv1.DomainOperation {
 	if t == nil {
 		return adminv1.DomainOperation_DOMAIN_OPERATION_INVALID
@@ -495,6 +503,10 @@ func FromDomainOperation(t *types.DomainOperation) adminv1.DomainOperation {
 		return adminv1.DomainOperation_DOMAIN_OPERATION_CREATE
 	case types.DomainOperationUpdate:
 		return adminv1.DomainOperation_DOMAIN_OPERATION_UPDATE
+	case types.DomainOperationDelete:
+		return domainOperationDelete
+	case types.DomainOperationRename:
+		return domainOperationRename
 	}
 	panic("unexpected enum value")
 }
@@ -507,6 +519,10 @@ func ToDomainOperation(t adminv1.DomainOperation) *types.DomainOperation {
 		return types.DomainOperationCreate.Ptr()
 	case adminv1.DomainOperation_DOMAIN_OPERATION_UPDATE:
 		return types.DomainOperationUpdate.Ptr()
+	case domainOperationDelete:
+		return types.DomainOperationDelete.Ptr()
+	case domainOperationRename:
+		return types.DomainOperationRename.Ptr()
 	}
 	panic("unexpected enum value")
 }
@@ -1832,3 +1848,82 @@ func FromAny(t *types.Any) *sharedv1.Any {
 		Value:     t.Value,
 	}
 }
+
+// FromTaskListDispatchStats converts the dispatch statistics of the internal task list status,
+// which are not part of the published TaskListStatus
+func FromTaskListDispatchStats(t *types.TaskListStatus) *sharedv1.TaskListDispatchStats {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.TaskListDispatchStats{
+		SyncMatchRate:     t.SyncMatchRate,
+		DispatchLatencyMs: t.DispatchLatencyMs,
+	}
+}
+
+// ToTaskListStatusWithDispatchStats converts the published task list status together with its dispatch statistics
+func ToTaskListStatusWithDispatchStats(t *apiv1.TaskListStatus, stats *sharedv1.TaskListDispatchStats) *types.TaskListStatus {
+	status := ToTaskListStatus(t)
+	if status == nil || stats == nil {
+		return status
+	}
+	status.SyncMatchRate = stats.SyncMatchRate
+	status.DispatchLatencyMs = stats.DispatchLatencyMs
+	return status
+}
+
+func FromTaskListScalingDecision(t *types.TaskListScalingDecision) *sharedv1.TaskListScalingDecision {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.TaskListScalingDecision{
+		Timestamp:                  unixNanoToTime(&t.Timestamp),
+		Reason:                     t.Reason,
+		PreviousNumWritePartitions: t.PreviousNumWritePartitions,
+		NumWritePartitions:         t.NumWritePartitions,
+		NumReadPartitions:          t.NumReadPartitions,
+		Qps:                        t.QPS,
+		BacklogCountHint:           t.BacklogCountHint,
+		SyncMatchRate:              t.SyncMatchRate,
+		DispatchLatencyMs:          t.DispatchLatencyMs,
+	}
+}
+
+func ToTaskListScalingDecision(t *sharedv1.TaskListScalingDecision) *types.TaskListScalingDecision {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListScalingDecision{
+		Timestamp:                  common.Int64Default(timeToUnixNano(t.Timestamp)),
+		Reason:                     t.Reason,
+		PreviousNumWritePartitions: t.PreviousNumWritePartitions,
+		NumWritePartitions:         t.NumWritePartitions,
+		NumReadPartitions:          t.NumReadPartitions,
+		QPS:                        t.Qps,
+		BacklogCountHint:           t.BacklogCountHint,
+		SyncMatchRate:              t.SyncMatchRate,
+		DispatchLatencyMs:          t.DispatchLatencyMs,
+	}
+}
+
+func FromTaskListScalingDecisionArray(t []*types.TaskListScalingDecision) []*sharedv1.TaskListScalingDecision {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.TaskListScalingDecision, len(t))
+	for i := range t {
+		v[i] = FromTaskListScalingDecision(t[i])
+	}
+	return v
+}
+
+func ToTaskListScalingDecisionArray(t []*sharedv1.TaskListScalingDecision) []*types.TaskListScalingDecision {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.TaskListScalingDecision, len(t))
+	for i := range t {
+		v[i] = ToTaskListScalingDecision(t[i])
+	}
+	return v
+}
@@ -441,3 +441,16 @@ func TestAny(t *testing.T) {
 		assert.Equal(t, orig, &outOrig, "final round-tripped Any-contained data should be identical to the original object")
 	})
 }
+
+func TestTaskListScalingDecision(t *testing.T) {
+	for _, item := range []*types.TaskListScalingDecision{nil, {}, &testdata.TaskListScalingDecision} {
+		assert.Equal(t, item, ToTaskListScalingDecision(FromTaskListScalingDecision(item)))
+	}
+}
+
+func TestTaskListStatusWithDispatchStats(t *testing.T) {
+	item := &testdata.TaskListStatusWithDispatchStats
+	assert.Equal(t, item, ToTaskListStatusWithDispatchStats(FromTaskListStatus(item), FromTaskListDispatchStats(item)))
+	assert.Nil(t, ToTaskListStatusWithDispatchStats(nil, FromTaskListDispatchStats(item)))
+	assert.Zero(t, ToTaskListStatusWithDispatchStats(FromTaskListStatus(item), nil).SyncMatchRate)
+}
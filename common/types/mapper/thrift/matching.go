@@ -41,6 +41,7 @@ var (
 )
 
 // FromMatchingAddActivityTaskRequest converts internal AddActivityTaskRequest type to thrift
+// Priority and FairnessKey have no thrift field, so tasks sent over thrift use the default priority.
 func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matching.AddActivityTaskRequest {
 	if t == nil {
 		return nil
@@ -110,6 +111,7 @@ func ToActivityTaskDispatchInfo(t *matching.ActivityTaskDispatchInfo) *types.Act
 }
 
 // FromMatchingAddDecisionTaskRequest converts internal AddDecisionTaskRequest type to thrift
+// Priority and FairnessKey have no thrift field, so tasks sent over thrift use the default priority.
 func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matching.AddDecisionTaskRequest {
 	if t == nil {
 		return nil
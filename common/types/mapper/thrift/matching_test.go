@@ -52,7 +52,14 @@ func TestMatchingAddActivityTaskRequest(t *testing.T) {
 	for _, tc := range testCases {
 		thriftObj := FromMatchingAddActivityTaskRequest(tc.input)
 		roundTripObj := ToMatchingAddActivityTaskRequest(thriftObj)
-		assert.Equal(t, tc.input, roundTripObj)
+		expected := tc.input
+		if expected != nil {
+			// priority and fairness key only exist in the proto IDL
+			copied := *expected
+			copied.Priority, copied.FairnessKey = 0, ""
+			expected = &copied
+		}
+		assert.Equal(t, expected, roundTripObj)
 	}
 }
 
@@ -105,7 +112,14 @@ func TestMatchingAddDecisionTaskRequest(t *testing.T) {
 	for _, tc := range testCases {
 		thriftObj := FromMatchingAddDecisionTaskRequest(tc.input)
 		roundTripObj := ToMatchingAddDecisionTaskRequest(thriftObj)
-		assert.Equal(t, tc.input, roundTripObj)
+		expected := tc.input
+		if expected != nil {
+			// priority and fairness key only exist in the proto IDL
+			copied := *expected
+			copied.Priority, copied.FairnessKey = 0, ""
+			expected = &copied
+		}
+		assert.Equal(t, expected, roundTripObj)
 	}
 }
 
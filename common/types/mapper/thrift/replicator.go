@@ -57,6 +57,12 @@ func ToDLQType(t *replicator.DLQType) *types.DLQType {
 	panic("unexpected enum value")
 }
 
+// domainOperationDelete and domainOperationRename are not part of the IDL yet, they are encoded as their raw i32 values
+const (
+	domainOperationDelete = replicator.DomainOperation(2)
+	domainOperationRename = replicator.DomainOperation(3)
+)
+
 // FromDomainOperation converts internal DomainOperation type to thrift
 func FromDomainOperation(t *types.DomainOperation) *replicator.DomainOperation {
 	if t == nil {
@@ -69,6 +75,12 @@ func FromDomainOperation(t *types.DomainOperation) *replicator.DomainOperation {
 	case types.DomainOperationUpdate:
 		v := replicator.DomainOperationUpdate
 		return &v
+	case types.DomainOperationDelete:
+		v := domainOperationDelete
+		return &v
+	case types.DomainOperationRename:
+		v := domainOperationRename
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -85,6 +97,12 @@ func ToDomainOperation(t *replicator.DomainOperation) *types.DomainOperation {
 	case replicator.DomainOperationUpdate:
 		v := types.DomainOperationUpdate
 		return &v
+	case domainOperationDelete:
+		v := types.DomainOperationDelete
+		return &v
+	case domainOperationRename:
+		v := types.DomainOperationRename
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -61,6 +61,14 @@ func TestDomainOperation(t *testing.T) {
 			desc:  "non-nil input test",
 			input: types.DomainOperationCreate.Ptr(),
 		},
+		{
+			desc:  "delete operation test",
+			input: types.DomainOperationDelete.Ptr(),
+		},
+		{
+			desc:  "rename operation test",
+			input: types.DomainOperationRename.Ptr(),
+		},
 		{
 			desc:  "nil input test",
 			input: nil,
@@ -5407,7 +5407,8 @@ func ToStartWorkflowExecutionAsyncResponse(t *shared.StartWorkflowExecutionAsync
 	return &types.StartWorkflowExecutionAsyncResponse{}
 }
 
-// FromStartWorkflowExecutionRequest converts internal StartWorkflowExecutionRequest type to thrift
+// FromStartWorkflowExecutionRequest converts internal StartWorkflowExecutionRequest type to thrift.
+// The thrift IDL does not declare eager execution, clients request it through the WorkflowExtAPI.
 func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *shared.StartWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -5471,7 +5472,8 @@ func FromRestartWorkflowExecutionResponse(t *types.RestartWorkflowExecutionRespo
 	}
 }
 
-// FromStartWorkflowExecutionResponse converts internal StartWorkflowExecutionResponse type to thrift
+// FromStartWorkflowExecutionResponse converts internal StartWorkflowExecutionResponse type to thrift.
+// The eager decision task is left out, it is only returned through the WorkflowExtAPI.
 func FromStartWorkflowExecutionResponse(t *types.StartWorkflowExecutionResponse) *shared.StartWorkflowExecutionResponse {
 	if t == nil {
 		return nil
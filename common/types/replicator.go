@@ -93,6 +93,10 @@ func (e DomainOperation) String() string {
 		return "Create"
 	case 1:
 		return "Update"
+	case 2:
+		return "Delete"
+	case 3:
+		return "Rename"
 	}
 	return fmt.Sprintf("DomainOperation(%d)", w)
 }
@@ -106,6 +110,12 @@ func (e *DomainOperation) UnmarshalText(value []byte) error {
 	case "UPDATE":
 		*e = DomainOperationUpdate
 		return nil
+	case "DELETE":
+		*e = DomainOperationDelete
+		return nil
+	case "RENAME":
+		*e = DomainOperationRename
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -126,6 +136,10 @@ const (
 	DomainOperationCreate DomainOperation = iota
 	// DomainOperationUpdate is an option for DomainOperation
 	DomainOperationUpdate
+	// DomainOperationDelete is an option for DomainOperation
+	DomainOperationDelete
+	// DomainOperationRename is an option for DomainOperation
+	DomainOperationRename
 )
 
 // DomainTaskAttributes is an internal type (TBD...)
@@ -280,8 +294,9 @@ type GetDomainReplicationMessagesResponse struct {
 
 // GetReplicationMessagesRequest is an internal type (TBD...)
 type GetReplicationMessagesRequest struct {
-	Tokens      []*ReplicationToken `json:"tokens,omitempty"`
-	ClusterName string              `json:"clusterName,omitempty"`
+	Tokens          []*ReplicationToken `json:"tokens,omitempty"`
+	ClusterName     string              `json:"clusterName,omitempty"`
+	WaitForNewTasks bool                `json:"waitForNewTasks,omitempty"`
 }
 
 // GetClusterName is an internal getter (TBD...)
@@ -292,6 +307,14 @@ func (v *GetReplicationMessagesRequest) GetClusterName() (o string) {
 	return
 }
 
+// GetWaitForNewTasks is an internal getter (TBD...)
+func (v *GetReplicationMessagesRequest) GetWaitForNewTasks() (o bool) {
+	if v != nil {
+		return v.WaitForNewTasks
+	}
+	return
+}
+
 // GetReplicationMessagesResponse is an internal type (TBD...)
 type GetReplicationMessagesResponse struct {
 	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
@@ -383,6 +406,69 @@ type HistoryCountDLQMessagesResponse struct {
 	Entries map[HistoryDLQCountKey]int64
 }
 
+// GetReplicationStatusRequest is an internal type (TBD...)
+type GetReplicationStatusRequest struct {
+	// Clusters limits the result to the given remote clusters, all remote clusters are returned if empty.
+	Clusters []string `json:"clusters,omitempty"`
+	// ForceFetch will force fetching current DLQ sizes from DB
+	// instead of using cached values used for emitting metrics.
+	ForceFetch bool `json:"forceFetch,omitempty"`
+}
+
+// GetClusters is an internal getter (TBD...)
+func (v *GetReplicationStatusRequest) GetClusters() (o []string) {
+	if v != nil && v.Clusters != nil {
+		return v.Clusters
+	}
+	return
+}
+
+// GetForceFetch is an internal getter (TBD...)
+func (v *GetReplicationStatusRequest) GetForceFetch() (o bool) {
+	if v != nil {
+		return v.ForceFetch
+	}
+	return
+}
+
+// ReplicationShardStatus is the replication status of a single shard towards a remote cluster.
+// Read and acked levels refer to the replication tasks of the shard polled by the remote cluster,
+// while DLQSize counts the tasks from the remote cluster that failed to be applied to the shard.
+type ReplicationShardStatus struct {
+	ShardID                 int32  `json:"shardID,omitempty"`
+	RemoteCluster           string `json:"remoteCluster,omitempty"`
+	ReadLevel               int64  `json:"readLevel,omitempty"`
+	AckedLevel              int64  `json:"ackedLevel,omitempty"`
+	MaxReadLevel            int64  `json:"maxReadLevel,omitempty"`
+	LastReplicatedTimestamp *int64 `json:"lastReplicatedTimestamp,omitempty"`
+	DLQSize                 int64  `json:"dlqSize,omitempty"`
+	OldestPendingTaskAgeMs  int64  `json:"oldestPendingTaskAgeMs,omitempty"`
+}
+
+// ReplicationClusterStatus is the replication status towards a remote cluster aggregated across all shards.
+type ReplicationClusterStatus struct {
+	RemoteCluster string `json:"remoteCluster,omitempty"`
+	// ShardCount is the number of shards the status was gathered from.
+	ShardCount int32 `json:"shardCount,omitempty"`
+	// TaskLag is the sum of the distances between the max read level and the acked level of the shards.
+	TaskLag int64 `json:"taskLag,omitempty"`
+	// LastReplicatedTimestamp is the earliest last replicated time of the shards.
+	LastReplicatedTimestamp *int64 `json:"lastReplicatedTimestamp,omitempty"`
+	DLQSize                 int64  `json:"dlqSize,omitempty"`
+	OldestPendingTaskAgeMs  int64  `json:"oldestPendingTaskAgeMs,omitempty"`
+}
+
+// HistoryGetReplicationStatusResponse is an internal type (TBD...)
+type HistoryGetReplicationStatusResponse struct {
+	Shards []*ReplicationShardStatus `json:"shards,omitempty"`
+}
+
+// GetReplicationStatusResponse is an internal type (TBD...)
+type GetReplicationStatusResponse struct {
+	Clusters []*ReplicationClusterStatus `json:"clusters,omitempty"`
+	Shards   []*ReplicationShardStatus   `json:"shards,omitempty"`
+}
+
 // MergeDLQMessagesRequest is an internal type (TBD...)
 type MergeDLQMessagesRequest struct {
 	Type                  *DLQType `json:"type,omitempty"`
@@ -90,8 +90,14 @@ func TestDomainOperation_String(t *testing.T) {
 	domainOp = DomainOperationUpdate
 	assert.Equal(t, "Update", domainOp.String())
 
-	domainOp = 2
-	assert.Equal(t, "DomainOperation(2)", domainOp.String())
+	domainOp = DomainOperationDelete
+	assert.Equal(t, "Delete", domainOp.String())
+
+	domainOp = DomainOperationRename
+	assert.Equal(t, "Rename", domainOp.String())
+
+	domainOp = 4
+	assert.Equal(t, "DomainOperation(4)", domainOp.String())
 }
 
 func TestDomainOperation_UnmarshalText(t *testing.T) {
@@ -108,6 +114,14 @@ func TestDomainOperation_UnmarshalText(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, DomainOperation(2), domainOp)
 
+	err = domainOp.UnmarshalText([]byte("Delete"))
+	assert.NoError(t, err)
+	assert.Equal(t, DomainOperationDelete, domainOp)
+
+	err = domainOp.UnmarshalText([]byte("Rename"))
+	assert.NoError(t, err)
+	assert.Equal(t, DomainOperationRename, domainOp)
+
 	err = domainOp.UnmarshalText([]byte("Invalid"))
 	assert.Error(t, err)
 }
@@ -1504,6 +1504,72 @@ func (v *DeprecateDomainRequest) GetName() (o string) {
 	return
 }
 
+// DeleteDomainRequest is an internal type (TBD...)
+type DeleteDomainRequest struct {
+	Name          string `json:"name,omitempty"`
+	SecurityToken string `json:"securityToken,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *DeleteDomainRequest) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// DeleteDomainResponse is an internal type (TBD...)
+type DeleteDomainResponse struct {
+	WorkflowID string `json:"workflowId,omitempty"`
+	RunID      string `json:"runId,omitempty"`
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *DeleteDomainResponse) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// RenameDomainRequest is an internal type (TBD...)
+type RenameDomainRequest struct {
+	Name          string `json:"name,omitempty"`
+	NewName       string `json:"newName,omitempty"`
+	SecurityToken string `json:"securityToken,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *RenameDomainRequest) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// GetNewName is an internal getter (TBD...)
+func (v *RenameDomainRequest) GetNewName() (o string) {
+	if v != nil {
+		return v.NewName
+	}
+	return
+}
+
+// RenameDomainResponse is an internal type (TBD...)
+type RenameDomainResponse struct {
+	DomainID string `json:"domainId,omitempty"`
+	// PreviousNameExpiryTimestamp is the time in unix nanos until which the previous name resolves to the domain
+	PreviousNameExpiryTimestamp int64 `json:"previousNameExpiryTimestamp,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *RenameDomainResponse) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
 // DescribeDomainRequest is an internal type (TBD...)
 type DescribeDomainRequest struct {
 	Name *string `json:"name,omitempty"`
@@ -1693,6 +1759,9 @@ type DescribeTaskListResponse struct {
 	Pollers         []*PollerInfo            `json:"pollers,omitempty"`
 	TaskListStatus  *TaskListStatus          `json:"taskListStatus,omitempty"`
 	PartitionConfig *TaskListPartitionConfig `json:"partitionConfig,omitempty"`
+	// ScalingDecisions are the most recent adaptive scaler decisions of the root partition.
+	// Not part of the published IDL, they are only returned by matching and the admin DescribeTaskList API.
+	ScalingDecisions []*TaskListScalingDecision `json:"scalingDecisions,omitempty"`
 }
 
 // GetPollers is an internal getter (TBD...)
@@ -1711,6 +1780,14 @@ func (v *DescribeTaskListResponse) GetTaskListStatus() (o *TaskListStatus) {
 	return
 }
 
+// GetScalingDecisions is an internal getter (TBD...)
+func (v *DescribeTaskListResponse) GetScalingDecisions() (o []*TaskListScalingDecision) {
+	if v != nil && v.ScalingDecisions != nil {
+		return v.ScalingDecisions
+	}
+	return
+}
+
 // DescribeWorkflowExecutionRequest is an internal type (TBD...)
 type DescribeWorkflowExecutionRequest struct {
 	Domain    string             `json:"domain,omitempty"`
@@ -6144,6 +6221,7 @@ type StartWorkflowExecutionRequest struct {
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
 	JitterStartSeconds                  *int32                 `json:"jitterStartSeconds,omitempty"`
 	FirstRunAtTimeStamp                 *int64                 `json:"firstRunAtTimeStamp,omitempty"`
+	RequestEagerExecution               bool                   `json:"requestEagerExecution,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -6202,6 +6280,14 @@ func (v *StartWorkflowExecutionRequest) GetFirstRunAtTimeStamp() (o int64) {
 	return
 }
 
+// GetRequestEagerExecution is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetRequestEagerExecution() (o bool) {
+	if v != nil {
+		return v.RequestEagerExecution
+	}
+	return
+}
+
 // GetRequestID is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetRequestID() (o string) {
 	if v != nil {
@@ -6228,7 +6314,8 @@ func (v *StartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 
 // StartWorkflowExecutionResponse is an internal type (TBD...)
 type StartWorkflowExecutionResponse struct {
-	RunID string `json:"runId,omitempty"`
+	RunID        string                       `json:"runId,omitempty"`
+	DecisionTask *PollForDecisionTaskResponse `json:"decisionTask,omitempty"`
 }
 
 // GetRunID is an internal getter (TBD...)
@@ -6239,6 +6326,14 @@ func (v *StartWorkflowExecutionResponse) GetRunID() (o string) {
 	return
 }
 
+// GetDecisionTask is an internal getter (TBD...)
+func (v *StartWorkflowExecutionResponse) GetDecisionTask() (o *PollForDecisionTaskResponse) {
+	if v != nil {
+		return v.DecisionTask
+	}
+	return
+}
+
 type StartWorkflowExecutionAsyncRequest struct {
 	*StartWorkflowExecutionRequest
 }
@@ -6415,6 +6510,39 @@ type TaskListStatus struct {
 	TaskIDBlock           *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
 	IsolationGroupMetrics map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
 	NewTasksPerSecond     float64                           `json:"newTasksPerSecond,omitempty"`
+	// SyncMatchRate and DispatchLatencyMs are not part of the published IDL,
+	// they are only returned by matching and the admin DescribeTaskList API
+	SyncMatchRate     float64 `json:"syncMatchRate,omitempty"`
+	DispatchLatencyMs float64 `json:"dispatchLatencyMs,omitempty"`
+}
+
+// GetSyncMatchRate is an internal getter (TBD...)
+func (v *TaskListStatus) GetSyncMatchRate() (o float64) {
+	if v != nil {
+		return v.SyncMatchRate
+	}
+	return
+}
+
+// GetDispatchLatencyMs is an internal getter (TBD...)
+func (v *TaskListStatus) GetDispatchLatencyMs() (o float64) {
+	if v != nil {
+		return v.DispatchLatencyMs
+	}
+	return
+}
+
+// TaskListScalingDecision is an internal type recording a decision of the adaptive task list scaler
+type TaskListScalingDecision struct {
+	Timestamp                  int64   `json:"timestamp,omitempty"`
+	Reason                     string  `json:"reason,omitempty"`
+	PreviousNumWritePartitions int32   `json:"previousNumWritePartitions,omitempty"`
+	NumWritePartitions         int32   `json:"numWritePartitions,omitempty"`
+	NumReadPartitions          int32   `json:"numReadPartitions,omitempty"`
+	QPS                        float64 `json:"qps,omitempty"`
+	BacklogCountHint           int64   `json:"backlogCountHint,omitempty"`
+	SyncMatchRate              float64 `json:"syncMatchRate,omitempty"`
+	DispatchLatencyMs          float64 `json:"dispatchLatencyMs,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -7177,6 +7305,14 @@ func (v *WorkflowExecutionInfo) GetUpdateTime() (o int64) {
 	return
 }
 
+// GetMemo is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
 // GetSearchAttributes is an internal getter (TBD...)
 func (v *WorkflowExecutionInfo) GetSearchAttributes() (o *SearchAttributes) {
 	if v != nil && v.SearchAttributes != nil {
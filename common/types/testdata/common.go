@@ -248,6 +248,30 @@ var (
 		},
 		NewTasksPerSecond: 10,
 	}
+	TaskListStatusWithDispatchStats = types.TaskListStatus{
+		BacklogCountHint:  BacklogCountHint,
+		ReadLevel:         ReadLevel,
+		AckLevel:          AckLevel,
+		RatePerSecond:     RatePerSecond,
+		TaskIDBlock:       &TaskIDBlock,
+		NewTasksPerSecond: 10,
+		SyncMatchRate:     0.5,
+		DispatchLatencyMs: 20,
+	}
+	TaskListScalingDecision = types.TaskListScalingDecision{
+		Timestamp:                  Timestamp1,
+		Reason:                     "backlog",
+		PreviousNumWritePartitions: 1,
+		NumWritePartitions:         2,
+		NumReadPartitions:          2,
+		QPS:                        100,
+		BacklogCountHint:           BacklogCountHint,
+		SyncMatchRate:              0.5,
+		DispatchLatencyMs:          20,
+	}
+	TaskListScalingDecisionArray = []*types.TaskListScalingDecision{
+		&TaskListScalingDecision,
+	}
 	TaskIDBlock = types.TaskIDBlock{
 		StartID: 551,
 		EndID:   559,
@@ -205,4 +205,22 @@ var (
 		ReplicationTasksInfo: ReplicationTaskInfoArray,
 		NextPageToken:        Token1,
 	}
+	ReplicationShardStatus = types.ReplicationShardStatus{
+		ShardID:                 ShardID,
+		RemoteCluster:           ClusterName1,
+		ReadLevel:               TaskID + 1,
+		AckedLevel:              TaskID,
+		MaxReadLevel:            TaskID + 2,
+		LastReplicatedTimestamp: &Timestamp1,
+		DLQSize:                 10,
+		OldestPendingTaskAgeMs:  1000,
+	}
+	ReplicationClusterStatus = types.ReplicationClusterStatus{
+		RemoteCluster:           ClusterName1,
+		ShardCount:              2,
+		TaskLag:                 5,
+		LastReplicatedTimestamp: &Timestamp1,
+		DLQSize:                 10,
+		OldestPendingTaskAgeMs:  1000,
+	}
 )
@@ -212,6 +212,114 @@ var (
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
 	}
+	AdminRebuildWorkflowBranchRequest = types.RebuildWorkflowBranchRequest{
+		Domain:      DomainName,
+		Execution:   &WorkflowExecution,
+		BranchToken: BranchToken,
+	}
+	AdminRemoteRebuildWorkflowBranchRequest = types.RebuildWorkflowBranchRequest{
+		Domain:      DomainName,
+		Execution:   &WorkflowExecution,
+		BranchToken: BranchToken,
+		Cluster:     ClusterName1,
+	}
+	AdminDescribeWorkflowVersionHistoriesRequest = types.DescribeWorkflowVersionHistoriesRequest{
+		Domain:    DomainName,
+		Execution: &WorkflowExecution,
+		Cluster:   ClusterName1,
+	}
+	AdminDescribeWorkflowVersionHistoriesResponse = types.DescribeWorkflowVersionHistoriesResponse{
+		Cluster:          ClusterName1,
+		Execution:        &WorkflowExecution,
+		VersionHistories: &VersionHistories,
+	}
+	AdminUpdateActivityOptionsRequest = types.UpdateActivityOptionsRequest{
+		Domain:                        DomainName,
+		Execution:                     &WorkflowExecution,
+		ActivityID:                    ActivityID,
+		TaskList:                      &TaskList,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(Duration1),
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(Duration2),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(Duration3),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(Duration4),
+		RetryPolicy:                   &RetryPolicy,
+		ResetAttempts:                 true,
+		Identity:                      Identity,
+	}
+	AdminUpdateActivityOptionsResponse = types.UpdateActivityOptionsResponse{
+		Activity: &PendingActivityInfo,
+	}
+	AdminListAuditLogEntriesRequest = types.ListAuditLogEntriesRequest{
+		Domain:        DomainName,
+		Actor:         Identity,
+		APIName:       "TerminateWorkflowExecution",
+		StartTimeNano: &Timestamp1,
+		EndTimeNano:   &Timestamp2,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	AdminAuditLogEntry = types.AuditLogEntry{
+		TimestampNano: Timestamp1,
+		Actor:         Identity,
+		APIName:       "TerminateWorkflowExecution",
+		Domain:        DomainName,
+		Request:       string(Payload1),
+		Outcome:       types.AuditLogOutcomeFailed,
+		Error:         ErrorMessage,
+	}
+	AdminListAuditLogEntriesResponse = types.ListAuditLogEntriesResponse{
+		Entries:       []*types.AuditLogEntry{&AdminAuditLogEntry},
+		NextPageToken: NextPageToken,
+	}
+	AdminHistoryTaskDLQMessage = types.HistoryTaskDLQMessage{
+		MessageID:                 MessageID1,
+		ShardID:                   ShardID,
+		DomainID:                  DomainID,
+		WorkflowID:                WorkflowID,
+		RunID:                     RunID,
+		TaskCategory:              types.HistoryTaskCategoryTimer,
+		TaskType:                  int32(TaskType),
+		TaskID:                    TaskID,
+		VisibilityTimestampNano:   Timestamp1,
+		Attempt:                   Attempt,
+		FirstAttemptTimestampNano: Timestamp2,
+		EnqueuedTimestampNano:     Timestamp3,
+		LastError:                 ErrorMessage,
+		TaskInfo:                  string(Payload1),
+	}
+	AdminReadHistoryTaskDLQMessagesRequest = types.ReadHistoryTaskDLQMessagesRequest{
+		ShardID:               ShardID,
+		Domain:                DomainName,
+		InclusiveEndMessageID: common.Int64Ptr(MessageID2),
+		MaximumPageSize:       PageSize,
+		NextPageToken:         NextPageToken,
+	}
+	AdminReadHistoryTaskDLQMessagesResponse = types.ReadHistoryTaskDLQMessagesResponse{
+		Messages:      []*types.HistoryTaskDLQMessage{&AdminHistoryTaskDLQMessage},
+		NextPageToken: NextPageToken,
+	}
+	AdminDescribeHistoryTaskDLQMessageRequest = types.DescribeHistoryTaskDLQMessageRequest{
+		ShardID:   ShardID,
+		MessageID: MessageID1,
+	}
+	AdminDescribeHistoryTaskDLQMessageResponse = types.DescribeHistoryTaskDLQMessageResponse{
+		Message: &AdminHistoryTaskDLQMessage,
+	}
+	AdminMergeHistoryTaskDLQMessagesRequest = types.MergeHistoryTaskDLQMessagesRequest{
+		ShardID:               ShardID,
+		Domain:                DomainName,
+		InclusiveEndMessageID: common.Int64Ptr(MessageID2),
+		MaximumPageSize:       PageSize,
+		NextPageToken:         NextPageToken,
+	}
+	AdminMergeHistoryTaskDLQMessagesResponse = types.MergeHistoryTaskDLQMessagesResponse{
+		NextPageToken: NextPageToken,
+	}
+	AdminPurgeHistoryTaskDLQMessagesRequest = types.PurgeHistoryTaskDLQMessagesRequest{
+		ShardID:               ShardID,
+		Domain:                DomainName,
+		InclusiveEndMessageID: common.Int64Ptr(MessageID2),
+	}
 	AdminRemoveTaskRequest = types.RemoveTaskRequest{
 		ShardID:             ShardID,
 		Type:                common.Int32Ptr(QueueType),
@@ -270,4 +378,28 @@ var (
 		PartitionConfig: &TaskListPartitionConfig,
 	}
 	AdminUpdateTaskListPartitionConfigResponse = types.UpdateTaskListPartitionConfigResponse{}
+	AdminDeleteDomainRequest                   = types.DeleteDomainRequest{
+		Name:          DomainName,
+		SecurityToken: SecurityToken,
+	}
+	AdminDeleteDomainResponse = types.DeleteDomainResponse{
+		WorkflowID: WorkflowID,
+		RunID:      RunID,
+	}
+	AdminGetReplicationStatusRequest  = types.GetReplicationStatusRequest{Clusters: []string{ClusterName1}, ForceFetch: true}
+	AdminGetReplicationStatusResponse = types.GetReplicationStatusResponse{
+		Clusters: []*types.ReplicationClusterStatus{&ReplicationClusterStatus},
+		Shards:   []*types.ReplicationShardStatus{&ReplicationShardStatus},
+	}
+	AdminRenameDomainRequest = types.RenameDomainRequest{
+		Name:          DomainName,
+		NewName:       DomainName + "-renamed",
+		SecurityToken: SecurityToken,
+	}
+	AdminRenameDomainResponse = types.RenameDomainResponse{
+		DomainID:                    DomainID,
+		PreviousNameExpiryTimestamp: Timestamp1,
+	}
+	AdminDescribeTaskListRequest  = DescribeTaskListRequest
+	AdminDescribeTaskListResponse = MatchingDescribeTaskListResponseWithScalingDecisions
 )
@@ -327,6 +327,19 @@ var (
 	StartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
 	}
+	StartWorkflowExecutionEagerRequest = types.StartWorkflowExecutionRequest{
+		Domain:                DomainName,
+		WorkflowID:            WorkflowID,
+		WorkflowType:          &WorkflowType,
+		TaskList:              &TaskList,
+		Identity:              Identity,
+		RequestID:             RequestID,
+		RequestEagerExecution: true,
+	}
+	StartWorkflowExecutionEagerResponse = types.StartWorkflowExecutionResponse{
+		RunID:        RunID,
+		DecisionTask: &PollForDecisionTaskResponse,
+	}
 	StartWorkflowExecutionAsyncRequest = types.StartWorkflowExecutionAsyncRequest{
 		StartWorkflowExecutionRequest: &StartWorkflowExecutionRequest,
 	}
@@ -78,6 +78,8 @@ var (
 	HistoryGetReplicationMessagesResponse = AdminGetReplicationMessagesResponse
 	HistoryCountDLQMessagesRequest        = types.CountDLQMessagesRequest{ForceFetch: true}
 	HistoryCountDLQMessagesResponse       = types.HistoryCountDLQMessagesResponse{Entries: map[types.HistoryDLQCountKey]int64{types.HistoryDLQCountKey{1, "A"}: 10}}
+	HistoryGetReplicationStatusRequest    = types.GetReplicationStatusRequest{Clusters: []string{ClusterName1}, ForceFetch: true}
+	HistoryGetReplicationStatusResponse   = types.HistoryGetReplicationStatusResponse{Shards: []*types.ReplicationShardStatus{&ReplicationShardStatus}}
 	HistoryMergeDLQMessagesRequest        = AdminMergeDLQMessagesRequest
 	HistoryMergeDLQMessagesResponse       = AdminMergeDLQMessagesResponse
 	HistoryNotifyFailoverMarkersRequest   = types.NotifyFailoverMarkersRequest{
@@ -179,6 +181,14 @@ var (
 		DomainUIID: DomainID,
 		Request:    &AdminRefreshWorkflowTasksRequest,
 	}
+	HistoryRebuildWorkflowBranchRequest = types.HistoryRebuildWorkflowBranchRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminRebuildWorkflowBranchRequest,
+	}
+	HistoryUpdateActivityOptionsRequest = types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminUpdateActivityOptionsRequest,
+	}
 	HistoryRemoveSignalMutableStateRequest = types.RemoveSignalMutableStateRequest{
 		DomainUUID:        DomainID,
 		WorkflowExecution: &WorkflowExecution,
@@ -268,7 +278,8 @@ var (
 		PartitionConfig:                 PartitionConfig,
 	}
 	HistoryStartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
-		RunID: RunID,
+		RunID:        RunID,
+		DecisionTask: &PollForDecisionTaskResponse,
 	}
 	HistorySyncActivityRequest = types.SyncActivityRequest{
 		DomainID:           DomainID,
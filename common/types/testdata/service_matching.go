@@ -61,6 +61,8 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      2,
+		FairnessKey:                   "fairness-key",
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -71,6 +73,8 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      2,
+		FairnessKey:                   "fairness-key",
 	}
 	MatchingAddActivityTaskResponse = types.AddActivityTaskResponse{
 		PartitionConfig: &TaskListPartitionConfig,
@@ -92,6 +96,12 @@ var (
 		Pollers:        PollerInfoArray,
 		TaskListStatus: &TaskListStatus,
 	}
+	MatchingDescribeTaskListResponseWithScalingDecisions = types.DescribeTaskListResponse{
+		Pollers:          PollerInfoArray,
+		TaskListStatus:   &TaskListStatusWithDispatchStats,
+		PartitionConfig:  &TaskListPartitionConfig,
+		ScalingDecisions: TaskListScalingDecisionArray,
+	}
 	MatchingListTaskListPartitionsRequest = types.MatchingListTaskListPartitionsRequest{
 		Domain:   DomainName,
 		TaskList: &TaskList,
@@ -619,6 +619,30 @@ func CreateHistoryStartWorkflowRequest(
 	return histRequest, nil
 }
 
+// TaskPriorityFromHeader returns the task priority and fairness key carried by a workflow or activity header.
+// A missing or invalid priority is the default priority 0, a missing fairness key is empty.
+func TaskPriorityFromHeader(header *types.Header) (priority int32, fairnessKey string) {
+	if header == nil {
+		return 0, ""
+	}
+	if value, ok := header.Fields[TaskPriorityHeaderKey]; ok {
+		if p, err := strconv.ParseInt(string(value), 10, 32); err == nil {
+			priority = int32(p)
+		}
+	}
+	return priority, string(header.Fields[TaskFairnessKeyHeaderKey])
+}
+
+// TaskListPriorityBacklogName returns the name of the task list which persists the backlog of the given
+// task list partition for the given priority, the backlog of the default priority 0 is the partition itself.
+// The name has the form of a partition name, so it is accepted wherever a task list name is parsed.
+func TaskListPriorityBacklogName(taskList string, priority int) string {
+	if priority == 0 {
+		return taskList
+	}
+	return fmt.Sprintf("%v%v/%v", ReservedTaskListPriorityPrefix, taskList, priority)
+}
+
 // CheckEventBlobSizeLimit checks if a blob data exceeds limits. It logs a warning if it exceeds warnLimit,
 // and return ErrBlobSizeExceedsLimit if it exceeds errorLimit.
 func CheckEventBlobSizeLimit(
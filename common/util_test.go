@@ -1647,6 +1647,45 @@ func TestNewPerTaskListScope(t *testing.T) {
 	assert.NotNil(t, NewPerTaskListScope("test-domain", "test-tasklist", types.TaskListKindSticky, metrics.NewNoopMetricsClient(), 0))
 }
 
+func TestTaskPriorityFromHeader(t *testing.T) {
+	tests := map[string]struct {
+		header              *types.Header
+		expectedPriority    int32
+		expectedFairnessKey string
+	}{
+		"nil header": {
+			header: nil,
+		},
+		"no priority in header": {
+			header: &types.Header{Fields: map[string][]byte{"key": []byte("value")}},
+		},
+		"invalid priority is ignored": {
+			header: &types.Header{Fields: map[string][]byte{TaskPriorityHeaderKey: []byte("high")}},
+		},
+		"priority and fairness key": {
+			header: &types.Header{Fields: map[string][]byte{
+				TaskPriorityHeaderKey:    []byte("10"),
+				TaskFairnessKeyHeaderKey: []byte("tenant-1"),
+			}},
+			expectedPriority:    10,
+			expectedFairnessKey: "tenant-1",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			priority, fairnessKey := TaskPriorityFromHeader(tc.header)
+			assert.Equal(t, tc.expectedPriority, priority)
+			assert.Equal(t, tc.expectedFairnessKey, fairnessKey)
+		})
+	}
+}
+
+func TestTaskListPriorityBacklogName(t *testing.T) {
+	assert.Equal(t, "tl", TaskListPriorityBacklogName("tl", 0))
+	assert.Equal(t, "/__cadence_sys/__priority/tl/2", TaskListPriorityBacklogName("tl", 2))
+	assert.Equal(t, "/__cadence_sys/__priority//__cadence_sys/tl/3/1", TaskListPriorityBacklogName("/__cadence_sys/tl/3", 1))
+}
+
 func TestCheckEventBlobSizeLimit(t *testing.T) {
 	for name, c := range map[string]struct {
 		blobSize      int
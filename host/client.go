@@ -25,6 +25,8 @@ import (
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	"go.uber.org/yarpc"
 
+	adminextv1 "github.com/uber/cadence/.gen/proto/adminext/v1"
+	apiextv1 "github.com/uber/cadence/.gen/proto/apiext/v1"
 	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
@@ -55,7 +57,8 @@ type MatchingClient interface {
 
 // NewAdminClient creates a client to cadence admin client
 func NewAdminClient(d *yarpc.Dispatcher) AdminClient {
-	return grpc.NewAdminClient(adminv1.NewAdminAPIYARPCClient(d.ClientConfig(testOutboundName(service.Frontend))))
+	config := d.ClientConfig(testOutboundName(service.Frontend))
+	return grpc.NewAdminClient(adminv1.NewAdminAPIYARPCClient(config), adminextv1.NewAdminExtAPIYARPCClient(config))
 }
 
 // NewFrontendClient creates a client to cadence frontend client
@@ -66,6 +69,7 @@ func NewFrontendClient(d *yarpc.Dispatcher) FrontendClient {
 		apiv1.NewWorkflowAPIYARPCClient(config),
 		apiv1.NewWorkerAPIYARPCClient(config),
 		apiv1.NewVisibilityAPIYARPCClient(config),
+		apiextv1.NewWorkflowExtAPIYARPCClient(config),
 	)
 }
 
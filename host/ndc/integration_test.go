@@ -85,9 +85,9 @@ func (s *NDCIntegrationTestSuite) SetupSuite() {
 	s.mockAdminClient = make(map[string]adminClient.Client)
 	controller := gomock.NewController(s.T())
 	mockStandbyClient := adminClient.NewMockClient(controller)
-	mockStandbyClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).DoAndReturn(s.GetReplicationMessagesMock).AnyTimes()
+	mockStandbyClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(s.GetReplicationMessagesMock).AnyTimes()
 	mockOtherClient := adminClient.NewMockClient(controller)
-	mockOtherClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).Return(
+	mockOtherClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&types.GetReplicationMessagesResponse{
 			MessagesByShard: make(map[int32]*types.ReplicationMessages),
 		}, nil).AnyTimes()
@@ -645,7 +645,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]membership.HostInfo, star
 	params.PinotClient = c.pinotClient
 	params.GetIsolationGroups = getFromDynamicConfig(params)
 	var err error
-	authorizer, err := authorization.NewAuthorizer(c.authorizationConfig, params.Logger, nil)
+	authorizer, err := authorization.NewAuthorizer(c.authorizationConfig, params.Logger, nil, params.MetricsClient)
 	if err != nil {
 		c.logger.Fatal("Unable to create authorizer", tag.Error(err))
 	}
@@ -172,7 +172,7 @@ func NewCluster(t *testing.T, options *TestClusterConfig, logger log.Logger, par
 		MatchingConfig:                options.MatchingConfig,
 		WorkerConfig:                  options.WorkerConfig,
 		MockAdminClient:               options.MockAdminClient,
-		DomainReplicationTaskExecutor: domain.NewReplicationTaskExecutor(testBase.DomainManager, clock.NewRealTimeSource(), logger),
+		DomainReplicationTaskExecutor: domain.NewReplicationTaskExecutor(testBase.DomainManager, nil, clock.NewRealTimeSource(), logger),
 		AuthorizationConfig:           aConfig,
 		AsyncWFQueues:                 options.AsyncWFQueues,
 		TimeSource:                    options.TimeSource,
@@ -243,7 +243,7 @@ func NewPinotTestCluster(t *testing.T, options *TestClusterConfig, logger log.Lo
 		MatchingConfig:                options.MatchingConfig,
 		WorkerConfig:                  options.WorkerConfig,
 		MockAdminClient:               options.MockAdminClient,
-		DomainReplicationTaskExecutor: domain.NewReplicationTaskExecutor(testBase.DomainManager, clock.NewRealTimeSource(), logger),
+		DomainReplicationTaskExecutor: domain.NewReplicationTaskExecutor(testBase.DomainManager, nil, clock.NewRealTimeSource(), logger),
 		AuthorizationConfig:           aConfig,
 		PinotConfig:                   options.PinotConfig,
 		PinotClient:                   pinotClient,
@@ -26,14 +26,17 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/asyncworkflow/queueconfigapi"
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -48,13 +51,16 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationbatch"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/taskdlq"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/lookup"
+	"github.com/uber/cadence/service/worker/domaindeletion"
 )
 
 const (
@@ -78,6 +84,9 @@ type (
 		throttleRetry         *backoff.ThrottleRetry
 		isolationGroups       isolationgroupapi.Handler
 		asyncWFQueueConfigs   queueconfigapi.Handler
+		domainHandler         domain.Handler
+		auditor               audit.Auditor
+		historyTaskDLQ        taskdlq.DLQ
 	}
 
 	workflowQueryTemplate struct {
@@ -106,6 +115,9 @@ var (
 		execution.ErrMissingActivityScheduledEvent.Error(),
 		persistence.ErrCorruptedHistory.Error(),
 	}
+
+	errDomainHasOpenWorkflows = &types.BadRequestError{Message: "Domain still has open workflows, they have to be closed before the domain can be deleted."}
+	errNewDomainNameNotSet    = &types.BadRequestError{Message: "New domain name not set on request."}
 )
 
 // NewHandler creates a thrift service for the cadence admin service
@@ -114,10 +126,12 @@ func NewHandler(
 	params *resource.Params,
 	config *config.Config,
 	domainHandler domain.Handler,
+	auditor audit.Auditor,
 ) Handler {
 
 	domainReplicationTaskExecutor := domain.NewReplicationTaskExecutor(
 		resource.GetDomainManager(),
+		domaindeletion.NewWorkflowStarter(resource.GetFrontendClient()),
 		resource.GetTimeSource(),
 		resource.GetLogger(),
 	)
@@ -151,6 +165,9 @@ func NewHandler(
 		),
 		isolationGroups:     isolationgroupapi.New(resource.GetLogger(), resource.GetIsolationGroupStore(), domainHandler),
 		asyncWFQueueConfigs: queueconfigapi.New(resource.GetLogger(), domainHandler),
+		domainHandler:       domainHandler,
+		auditor:             auditor,
+		historyTaskDLQ:      taskdlq.NewDLQ(resource.GetPersistenceBean()),
 	}
 }
 
@@ -929,13 +946,49 @@ func (adh *adminHandlerImpl) GetReplicationMessages(
 		return nil, adh.error(validate.ErrClusterNameNotSet, scope)
 	}
 
+	if _, ok := ctx.Deadline(); !ok && request.GetWaitForNewTasks() {
+		// requests served on a replication stream carry no deadline, history calls need one
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, adh.config.ReplicationStreamRequestTimeout())
+		defer cancel()
+	}
+
 	resp, err = adh.GetHistoryRawClient().GetReplicationMessages(ctx, request)
 	if err != nil {
 		return nil, adh.error(err, scope)
 	}
+	if err := adh.batchReplicationMessages(ctx, resp); err != nil {
+		return nil, adh.error(err, scope)
+	}
 	return resp, nil
 }
 
+// batchReplicationMessages batches and compresses the replication tasks as far as both the config
+// and the capabilities advertised by the fetching cluster allow
+func (adh *adminHandlerImpl) batchReplicationMessages(ctx context.Context, resp *types.GetReplicationMessagesResponse) error {
+	call := yarpc.CallFromContext(ctx)
+	if call == nil {
+		return nil
+	}
+	opts := replicationbatch.NegotiateOptions(
+		replicationbatch.ParseCapabilities(call.Header(common.ReplicationCapabilitiesHeaderName)),
+		adh.config.EnableReplicationTaskBatching(),
+		adh.config.EnableReplicationTaskCompression(),
+		adh.config.ReplicationTaskMaxBatchSize(),
+	)
+	for _, messages := range resp.GetMessagesByShard() {
+		if messages == nil {
+			continue
+		}
+		tasks, err := replicationbatch.Batch(messages.ReplicationTasks, opts)
+		if err != nil {
+			return err
+		}
+		messages.ReplicationTasks = tasks
+	}
+	return nil
+}
+
 // GetDomainReplicationMessages returns new domain replication tasks since last retrieved task ID.
 func (adh *adminHandlerImpl) GetDomainReplicationMessages(
 	ctx context.Context,
@@ -1194,6 +1247,70 @@ func (adh *adminHandlerImpl) CountDLQMessages(
 	}, err
 }
 
+// GetReplicationStatus returns the replication status of every shard towards the remote clusters,
+// together with a summary per remote cluster aggregated across the shards
+func (adh *adminHandlerImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+) (resp *types.GetReplicationStatusResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetReplicationStatusScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	remoteClusters := adh.GetClusterMetadata().GetRemoteClusterInfo()
+	for _, cluster := range request.GetClusters() {
+		if _, ok := remoteClusters[cluster]; !ok {
+			return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf("Cluster %v is not a remote cluster.", cluster)}, scope)
+		}
+	}
+
+	history, err := adh.GetHistoryClient().GetReplicationStatus(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &types.GetReplicationStatusResponse{
+		Clusters: aggregateReplicationStatus(history.Shards),
+		Shards:   history.Shards,
+	}, nil
+}
+
+func aggregateReplicationStatus(shards []*types.ReplicationShardStatus) []*types.ReplicationClusterStatus {
+	statuses := make(map[string]*types.ReplicationClusterStatus)
+	var clusters []string
+	for _, shard := range shards {
+		status, ok := statuses[shard.RemoteCluster]
+		if !ok {
+			status = &types.ReplicationClusterStatus{RemoteCluster: shard.RemoteCluster}
+			statuses[shard.RemoteCluster] = status
+			clusters = append(clusters, shard.RemoteCluster)
+		}
+
+		status.ShardCount++
+		if shard.MaxReadLevel > shard.AckedLevel {
+			status.TaskLag += shard.MaxReadLevel - shard.AckedLevel
+		}
+		status.DLQSize += shard.DLQSize
+		if shard.OldestPendingTaskAgeMs > status.OldestPendingTaskAgeMs {
+			status.OldestPendingTaskAgeMs = shard.OldestPendingTaskAgeMs
+		}
+		if shard.LastReplicatedTimestamp != nil &&
+			(status.LastReplicatedTimestamp == nil || *shard.LastReplicatedTimestamp < *status.LastReplicatedTimestamp) {
+			status.LastReplicatedTimestamp = common.Int64Ptr(*shard.LastReplicatedTimestamp)
+		}
+	}
+
+	sort.Strings(clusters)
+	result := make([]*types.ReplicationClusterStatus, 0, len(clusters))
+	for _, cluster := range clusters {
+		result = append(result, statuses[cluster])
+	}
+	return result
+}
+
 // MergeDLQMessages merges DLQ messages
 func (adh *adminHandlerImpl) MergeDLQMessages(
 	ctx context.Context,
@@ -1281,6 +1398,470 @@ func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// DescribeWorkflowVersionHistories returns the version histories of a workflow execution as seen by the
+// current cluster or, when the request names a remote cluster, by that cluster
+func (adh *adminHandlerImpl) DescribeWorkflowVersionHistories(
+	ctx context.Context,
+	request *types.DescribeWorkflowVersionHistoriesRequest,
+) (resp *types.DescribeWorkflowVersionHistoriesResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDescribeWorkflowVersionHistoriesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	if adh.isRemoteClusterRequest(request.GetCluster()) {
+		if err := adh.validateRemoteCluster(request.GetCluster()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		remoteRequest := *request
+		remoteRequest.Cluster = ""
+		resp, err = adh.GetRemoteAdminClient(request.GetCluster()).DescribeWorkflowVersionHistories(ctx, &remoteRequest)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return resp, nil
+	}
+
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	mutableState, err := adh.GetHistoryClient().GetMutableState(ctx, &types.GetMutableStateRequest{
+		DomainUUID: domainID,
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.DescribeWorkflowVersionHistoriesResponse{
+		Cluster:          adh.GetClusterMetadata().GetCurrentClusterName(),
+		Execution:        mutableState.Execution,
+		VersionHistories: mutableState.GetVersionHistories(),
+	}, nil
+}
+
+// RebuildWorkflowBranch rebuilds the mutable state of a workflow execution from one of its version history
+// branches in the current cluster or, when the request names a remote cluster, in that cluster
+func (adh *adminHandlerImpl) RebuildWorkflowBranch(
+	ctx context.Context,
+	request *types.RebuildWorkflowBranchRequest,
+) (err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRebuildWorkflowBranchScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if adh.isRemoteClusterRequest(request.GetCluster()) {
+		if err := adh.validateRemoteCluster(request.GetCluster()); err != nil {
+			return adh.error(err, scope)
+		}
+		remoteRequest := *request
+		remoteRequest.Cluster = ""
+		if err := adh.GetRemoteAdminClient(request.GetCluster()).RebuildWorkflowBranch(ctx, &remoteRequest); err != nil {
+			return adh.error(err, scope)
+		}
+		return nil
+	}
+
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	err = adh.GetHistoryClient().RebuildWorkflowBranch(ctx, &types.HistoryRebuildWorkflowBranchRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeTaskList returns the pollers and status of a task list, together with the dispatch statistics
+// and the recent decisions of the adaptive scaler which the published DescribeTaskList API does not carry
+func (adh *adminHandlerImpl) DescribeTaskList(
+	ctx context.Context,
+	request *types.DescribeTaskListRequest,
+) (resp *types.DescribeTaskListResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDescribeTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.TaskList == nil || request.TaskList.GetName() == "" {
+		return nil, adh.error(validate.ErrTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(validate.ErrTaskListTypeNotSet, scope)
+	}
+
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp, err = adh.GetMatchingClient().DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+		DomainUUID:  domainID,
+		DescRequest: request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) isRemoteClusterRequest(cluster string) bool {
+	return cluster != "" && cluster != adh.GetClusterMetadata().GetCurrentClusterName()
+}
+
+func (adh *adminHandlerImpl) validateRemoteCluster(cluster string) error {
+	if _, ok := adh.GetClusterMetadata().GetRemoteClusterInfo()[cluster]; !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("Cluster %v is not a remote cluster.", cluster)}
+	}
+	return nil
+}
+
+// UpdateActivityOptions changes the options of a pending activity and schedules its next attempt immediately
+func (adh *adminHandlerImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.UpdateActivityOptionsRequest,
+) (resp *types.UpdateActivityOptionsResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUpdateActivityOptionsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetActivityID() == "" {
+		return nil, adh.error(validate.ErrActivityIDNotSet, scope)
+	}
+	for _, timeout := range []*int32{
+		request.ScheduleToStartTimeoutSeconds,
+		request.ScheduleToCloseTimeoutSeconds,
+		request.StartToCloseTimeoutSeconds,
+		request.HeartbeatTimeoutSeconds,
+	} {
+		if timeout != nil && *timeout < 0 {
+			return nil, adh.error(&types.BadRequestError{Message: "Activity timeouts cannot be negative."}, scope)
+		}
+	}
+	if request.TaskList != nil && request.TaskList.GetName() == "" {
+		return nil, adh.error(validate.ErrTaskListNotSet, scope)
+	}
+	if request.RetryPolicy != nil {
+		if err := common.ValidateRetryPolicy(request.RetryPolicy); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = adh.GetHistoryClient().UpdateActivityOptions(ctx, &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ListAuditLogEntries returns the audit log entries matching the request filters
+func (adh *adminHandlerImpl) ListAuditLogEntries(
+	ctx context.Context,
+	request *types.ListAuditLogEntriesRequest,
+) (resp *types.ListAuditLogEntriesResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListAuditLogEntriesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetPageSize() < 0 {
+		return nil, adh.error(&types.BadRequestError{Message: "PageSize cannot be negative."}, scope)
+	}
+	if request.StartTimeNano != nil && request.EndTimeNano != nil && *request.StartTimeNano > *request.EndTimeNano {
+		return nil, adh.error(&types.BadRequestError{Message: "StartTime cannot be after EndTime."}, scope)
+	}
+
+	resp, err = adh.auditor.List(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DeleteDomain deletes a deprecated domain which has no open workflows left. The domain is marked as deleted
+// and the domain deletion workflow is started to purge its data and remove the domain record afterwards.
+func (adh *adminHandlerImpl) DeleteDomain(
+	ctx context.Context,
+	request *types.DeleteDomainRequest,
+) (resp *types.DeleteDomainResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDeleteDomainScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetName() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+
+	describeResp, err := adh.domainHandler.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(request.GetName())})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	domainID := describeResp.GetDomainInfo().GetUUID()
+
+	openWorkflows, err := adh.GetVisibilityManager().ListOpenWorkflowExecutions(ctx, &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:   domainID,
+		Domain:       request.GetName(),
+		EarliestTime: 0,
+		LatestTime:   adh.GetTimeSource().Now().UnixNano(),
+		PageSize:     1,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if len(openWorkflows.Executions) > 0 {
+		return nil, adh.error(errDomainHasOpenWorkflows, scope)
+	}
+
+	if err := adh.domainHandler.DeleteDomain(ctx, request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = domaindeletion.NewWorkflowStarter(adh.GetFrontendClient()).StartDeletionWorkflow(ctx, request.GetName(), domainID)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// RenameDomain renames a domain while keeping its ID, the previous name stays resolvable as an alias
+// until the configured alias period expires
+func (adh *adminHandlerImpl) RenameDomain(
+	ctx context.Context,
+	request *types.RenameDomainRequest,
+) (resp *types.RenameDomainResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRenameDomainScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetName() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.GetNewName() == "" {
+		return nil, adh.error(errNewDomainNameNotSet, scope)
+	}
+
+	resp, err = adh.domainHandler.RenameDomain(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ReadHistoryTaskDLQMessages reads the permanently failing history tasks of a shard
+func (adh *adminHandlerImpl) ReadHistoryTaskDLQMessages(
+	ctx context.Context,
+	request *types.ReadHistoryTaskDLQMessagesRequest,
+) (resp *types.ReadHistoryTaskDLQMessagesResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminReadHistoryTaskDLQMessagesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.GetShardID()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	domainID, err := adh.getHistoryTaskDLQDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	messages, token, err := adh.historyTaskDLQ.Read(
+		ctx,
+		request.GetShardID(),
+		domainID,
+		getInclusiveEndMessageID(request.InclusiveEndMessageID),
+		getDLQPageSize(request.GetMaximumPageSize()),
+		request.GetNextPageToken(),
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.ReadHistoryTaskDLQMessagesResponse{
+		Messages:      messages,
+		NextPageToken: token,
+	}, nil
+}
+
+// DescribeHistoryTaskDLQMessage returns a single permanently failing history task
+func (adh *adminHandlerImpl) DescribeHistoryTaskDLQMessage(
+	ctx context.Context,
+	request *types.DescribeHistoryTaskDLQMessageRequest,
+) (resp *types.DescribeHistoryTaskDLQMessageResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDescribeHistoryTaskDLQMessageScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.GetShardID()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	message, err := adh.historyTaskDLQ.Get(ctx, request.GetShardID(), request.GetMessageID())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.DescribeHistoryTaskDLQMessageResponse{Message: message}, nil
+}
+
+// MergeHistoryTaskDLQMessages re-enqueues the permanently failing history tasks of a shard,
+// the tasks of each workflow are regenerated from its mutable state and the messages are deleted
+func (adh *adminHandlerImpl) MergeHistoryTaskDLQMessages(
+	ctx context.Context,
+	request *types.MergeHistoryTaskDLQMessagesRequest,
+) (resp *types.MergeHistoryTaskDLQMessagesResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminMergeHistoryTaskDLQMessagesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.GetShardID()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	domainID, err := adh.getHistoryTaskDLQDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	messages, token, err := adh.historyTaskDLQ.Read(
+		ctx,
+		request.GetShardID(),
+		domainID,
+		getInclusiveEndMessageID(request.InclusiveEndMessageID),
+		getDLQPageSize(request.GetMaximumPageSize()),
+		request.GetNextPageToken(),
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	for _, message := range messages {
+		err := adh.GetHistoryClient().RefreshWorkflowTasks(ctx, &types.HistoryRefreshWorkflowTasksRequest{
+			DomainUIID: message.GetDomainID(),
+			Request: &types.RefreshWorkflowTasksRequest{
+				Execution: &types.WorkflowExecution{
+					WorkflowID: message.GetWorkflowID(),
+					RunID:      message.GetRunID(),
+				},
+			},
+		})
+		var entityNotExists *types.EntityNotExistsError
+		if err != nil && !errors.As(err, &entityNotExists) {
+			return nil, adh.error(err, scope)
+		}
+		if err := adh.historyTaskDLQ.Delete(ctx, request.GetShardID(), message.GetMessageID()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return &types.MergeHistoryTaskDLQMessagesResponse{NextPageToken: token}, nil
+}
+
+// PurgeHistoryTaskDLQMessages deletes the permanently failing history tasks of a shard
+func (adh *adminHandlerImpl) PurgeHistoryTaskDLQMessages(
+	ctx context.Context,
+	request *types.PurgeHistoryTaskDLQMessagesRequest,
+) (err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminPurgeHistoryTaskDLQMessagesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.GetShardID()); err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID, err := adh.getHistoryTaskDLQDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.historyTaskDLQ.Purge(ctx, request.GetShardID(), domainID, getInclusiveEndMessageID(request.InclusiveEndMessageID)); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// getHistoryTaskDLQDomainID returns the ID of the domain the history task DLQ operation is scoped to,
+// an empty domain name scopes the operation to the whole shard
+func (adh *adminHandlerImpl) getHistoryTaskDLQDomainID(domain string) (string, error) {
+	if domain == "" {
+		return "", nil
+	}
+	return adh.GetDomainCache().GetDomainID(domain)
+}
+
+func (adh *adminHandlerImpl) validateShardID(shardID int32) error {
+	if shardID < 0 || int(shardID) >= adh.numberOfHistoryShards {
+		return &types.BadRequestError{Message: fmt.Sprintf("ShardID must be in [0, %v).", adh.numberOfHistoryShards)}
+	}
+	return nil
+}
+
+func getInclusiveEndMessageID(inclusiveEndMessageID *int64) int64 {
+	if inclusiveEndMessageID == nil {
+		return common.EndMessageID
+	}
+	return *inclusiveEndMessageID
+}
+
+func getDLQPageSize(pageSize int32) int {
+	if pageSize <= 0 {
+		return common.ReadDLQMessagesPageSize
+	}
+	return int(pageSize)
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *adminHandlerImpl) ResendReplicationTasks(
 	ctx context.Context,
@@ -1529,6 +2110,9 @@ func (adh *adminHandlerImpl) error(err error, scope metrics.Scope) error {
 		return err
 	case *types.EntityNotExistsError:
 		return err
+	case *types.DomainAlreadyExistsError:
+		scope.IncCounter(metrics.CadenceErrDomainAlreadyExistsCounter)
+		return err
 	default:
 		adh.GetLogger().Error("Uncategorized error", tag.Error(err))
 		scope.IncCounter(metrics.CadenceFailures)
@@ -35,14 +35,19 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/yarpctest"
 
+	adminClient "github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/asyncworkflow/queueconfigapi"
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -53,11 +58,14 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationbatch"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/taskdlq"
 	"github.com/uber/cadence/common/types"
 	frontendcfg "github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/worker/domaindeletion"
 )
 
 type (
@@ -71,6 +79,7 @@ type (
 		mockDomainCache   *cache.MockDomainCache
 		frontendClient    *frontend.MockClient
 		mockResolver      *membership.MockResolver
+		mockAuditor       *audit.MockAuditor
 
 		mockHistoryV2Mgr *mocks.HistoryV2Manager
 
@@ -99,6 +108,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockHistoryV2Mgr = s.mockResource.HistoryMgr
 	s.frontendClient = s.mockResource.FrontendClient
 	s.mockResolver = s.mockResource.MembershipResolver
+	s.mockAuditor = audit.NewMockAuditor(s.controller)
 
 	params := &resource.Params{
 		Logger:          testlogger.New(s.T()),
@@ -115,7 +125,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	}
 
 	dh := domain.NewMockHandler(s.controller)
-	s.handler = NewHandler(s.mockResource, params, config, dh).(*adminHandlerImpl)
+	s.handler = NewHandler(s.mockResource, params, config, dh, s.mockAuditor).(*adminHandlerImpl)
 	s.handler.Start()
 }
 
@@ -1571,6 +1581,21 @@ func TestGetReplicationMessages(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"waiting request without deadline": {
+			input: &types.GetReplicationMessagesRequest{
+				ClusterName:     "test-cluster",
+				WaitForNewTasks: true,
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, _ *types.GetReplicationMessagesRequest, _ ...yarpc.CallOption) (*types.GetReplicationMessagesResponse, error) {
+						_, ok := ctx.Deadline()
+						assert.True(t, ok, "history is called with a deadline")
+						return nil, nil
+					})
+			},
+			wantErr: false,
+		},
 		"return error": {
 			input: &types.GetReplicationMessagesRequest{
 				ClusterName: "test-cluster",
@@ -1595,6 +1620,9 @@ func TestGetReplicationMessages(t *testing.T) {
 					MetricsClient: metrics.NewNoopMetricsClient(),
 					HistoryClient: hcMock,
 				},
+				config: &frontendcfg.Config{
+					ReplicationStreamRequestTimeout: dynamicconfig.GetDurationPropertyFn(time.Second),
+				},
 			}
 
 			_, err := handler.GetReplicationMessages(context.Background(), td.input)
@@ -1607,6 +1635,91 @@ func TestGetReplicationMessages(t *testing.T) {
 	}
 }
 
+func TestGetReplicationMessages_Batching(t *testing.T) {
+	newTasks := func() []*types.ReplicationTask {
+		var tasks []*types.ReplicationTask
+		for i := int64(1); i <= 3; i++ {
+			tasks = append(tasks, &types.ReplicationTask{
+				TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+				SourceTaskID: i,
+				HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+					DomainID:            "domain-id",
+					WorkflowID:          "workflow-id",
+					RunID:               "run-id",
+					VersionHistoryItems: []*types.VersionHistoryItem{{EventID: i, Version: 1}},
+				},
+			})
+		}
+		return tasks
+	}
+
+	tests := map[string]struct {
+		capabilities      string
+		enableBatching    bool
+		enableCompression bool
+		wantTasks         int
+	}{
+		"no capabilities header": {
+			capabilities:      "",
+			enableBatching:    true,
+			enableCompression: true,
+			wantTasks:         3,
+		},
+		"batching disabled": {
+			capabilities: replicationbatch.SupportedCapabilities.String(),
+			wantTasks:    3,
+		},
+		"batching enabled": {
+			capabilities:   replicationbatch.CapabilityBatching,
+			enableBatching: true,
+			wantTasks:      2,
+		},
+		"batching and compression enabled": {
+			capabilities:      replicationbatch.SupportedCapabilities.String(),
+			enableBatching:    true,
+			enableCompression: true,
+			wantTasks:         2,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			hcMock := history.NewMockClient(goMock)
+			hcMock.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).Return(&types.GetReplicationMessagesResponse{
+				MessagesByShard: map[int32]*types.ReplicationMessages{
+					1: {ReplicationTasks: newTasks(), LastRetrievedMessageID: 3},
+				},
+			}, nil)
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					HistoryClient: hcMock,
+				},
+				config: &frontendcfg.Config{
+					EnableReplicationTaskBatching:    dynamicconfig.GetBoolPropertyFn(td.enableBatching),
+					EnableReplicationTaskCompression: dynamicconfig.GetBoolPropertyFn(td.enableCompression),
+					ReplicationTaskMaxBatchSize:      dynamicconfig.GetIntPropertyFn(2),
+				},
+			}
+			ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{
+				Headers: map[string]string{common.ReplicationCapabilitiesHeaderName: td.capabilities},
+			})
+
+			resp, err := handler.GetReplicationMessages(ctx, &types.GetReplicationMessagesRequest{ClusterName: "test-cluster"})
+			require.NoError(t, err)
+			tasks := resp.MessagesByShard[1].ReplicationTasks
+			assert.Len(t, tasks, td.wantTasks)
+			assert.Equal(t, int64(3), resp.MessagesByShard[1].LastRetrievedMessageID)
+
+			unbatched, err := replicationbatch.Unbatch(tasks)
+			require.NoError(t, err)
+			assert.Equal(t, newTasks(), unbatched)
+		})
+	}
+}
+
 func Test_GetDomainReplicationMessages(t *testing.T) {
 	tests := map[string]struct {
 		input          *types.GetDomainReplicationMessagesRequest
@@ -2148,6 +2261,84 @@ func Test_CountDQLMessages(t *testing.T) {
 	}
 }
 
+func Test_GetReplicationStatus(t *testing.T) {
+	shards := []*types.ReplicationShardStatus{
+		{ShardID: 1, RemoteCluster: cluster.TestAlternativeClusterName, ReadLevel: 15, AckedLevel: 10, MaxReadLevel: 20, LastReplicatedTimestamp: common.Int64Ptr(200), DLQSize: 1, OldestPendingTaskAgeMs: 500},
+		{ShardID: 2, RemoteCluster: cluster.TestAlternativeClusterName, ReadLevel: 30, AckedLevel: 30, MaxReadLevel: 30, LastReplicatedTimestamp: common.Int64Ptr(100)},
+		{ShardID: 3, RemoteCluster: cluster.TestAlternativeClusterName, ReadLevel: 5, AckedLevel: 5, MaxReadLevel: 8, DLQSize: 2, OldestPendingTaskAgeMs: 1000},
+		{ShardID: 1, RemoteCluster: "other", ReadLevel: 20, AckedLevel: 20, MaxReadLevel: 20},
+	}
+	tests := map[string]struct {
+		input         *types.GetReplicationStatusRequest
+		hcHandlerFunc func(mock *history.MockClient)
+		want          *types.GetReplicationStatusResponse
+		wantErr       bool
+	}{
+		"normal request": {
+			input: &types.GetReplicationStatusRequest{},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{}).
+					Return(&types.HistoryGetReplicationStatusResponse{Shards: shards}, nil)
+			},
+			want: &types.GetReplicationStatusResponse{
+				Clusters: []*types.ReplicationClusterStatus{
+					{RemoteCluster: "other", ShardCount: 1},
+					{
+						RemoteCluster:           cluster.TestAlternativeClusterName,
+						ShardCount:              3,
+						TaskLag:                 13,
+						LastReplicatedTimestamp: common.Int64Ptr(100),
+						DLQSize:                 3,
+						OldestPendingTaskAgeMs:  1000,
+					},
+				},
+				Shards: shards,
+			},
+		},
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"unknown cluster": {
+			input:   &types.GetReplicationStatusRequest{Clusters: []string{"unknown"}},
+			wantErr: true,
+		},
+		"history client error": {
+			input: &types.GetReplicationStatusRequest{Clusters: []string{cluster.TestAlternativeClusterName}},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			hcMock := history.NewMockClient(goMock)
+			if td.hcHandlerFunc != nil {
+				td.hcHandlerFunc(hcMock)
+			}
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:          testlogger.New(t),
+					MetricsClient:   metrics.NewNoopMetricsClient(),
+					HistoryClient:   hcMock,
+					ClusterMetadata: cluster.TestActiveClusterMetadata,
+				},
+			}
+
+			resp, err := handler.GetReplicationStatus(context.Background(), td.input)
+			if td.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, td.want, resp)
+			}
+		})
+	}
+}
+
 func Test_MergeDLQMessages(t *testing.T) {
 	tests := map[string]struct {
 		input            *types.MergeDLQMessagesRequest
@@ -2333,6 +2524,1015 @@ func Test_RefreshWorkflowTasks(t *testing.T) {
 	}
 }
 
+func Test_DescribeWorkflowVersionHistories(t *testing.T) {
+	execution := &types.WorkflowExecution{WorkflowID: "test-workflow-id", RunID: "3fa85f64-5717-4562-b3fc-2c963f66afa6"}
+	versionHistories := &types.VersionHistories{
+		CurrentVersionHistoryIndex: 1,
+		Histories: []*types.VersionHistory{
+			{BranchToken: []byte("branch-1"), Items: []*types.VersionHistoryItem{{EventID: 5, Version: 1}}},
+			{BranchToken: []byte("branch-2"), Items: []*types.VersionHistoryItem{{EventID: 3, Version: 1}, {EventID: 7, Version: 2}}},
+		},
+	}
+	tests := map[string]struct {
+		input             *types.DescribeWorkflowVersionHistoriesRequest
+		hcHandlerFunc     func(mock *history.MockClient)
+		dcHandlerFunc     func(mock *cache.MockDomainCache)
+		remoteHandlerFunc func(mock *adminClient.MockClient)
+		want              *types.DescribeWorkflowVersionHistoriesResponse
+		wantErr           bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"empty workflow execution": {
+			input:   &types.DescribeWorkflowVersionHistoriesRequest{Domain: "test-domain"},
+			wantErr: true,
+		},
+		"current cluster": {
+			input: &types.DescribeWorkflowVersionHistoriesRequest{Domain: "test-domain", Execution: execution, Cluster: cluster.TestCurrentClusterName},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().GetMutableState(gomock.Any(), &types.GetMutableStateRequest{
+					DomainUUID: "test-domain-id",
+					Execution:  execution,
+				}).Return(&types.GetMutableStateResponse{Execution: execution, VersionHistories: versionHistories}, nil)
+			},
+			want: &types.DescribeWorkflowVersionHistoriesResponse{
+				Cluster:          cluster.TestCurrentClusterName,
+				Execution:        execution,
+				VersionHistories: versionHistories,
+			},
+		},
+		"domain cache error": {
+			input: &types.DescribeWorkflowVersionHistoriesRequest{Domain: "test-domain", Execution: execution},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("", assert.AnError)
+			},
+			wantErr: true,
+		},
+		"history client error": {
+			input: &types.DescribeWorkflowVersionHistoriesRequest{Domain: "test-domain", Execution: execution},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		"remote cluster": {
+			input: &types.DescribeWorkflowVersionHistoriesRequest{Domain: "test-domain", Execution: execution, Cluster: cluster.TestAlternativeClusterName},
+			remoteHandlerFunc: func(mock *adminClient.MockClient) {
+				mock.EXPECT().DescribeWorkflowVersionHistories(gomock.Any(), &types.DescribeWorkflowVersionHistoriesRequest{
+					Domain:    "test-domain",
+					Execution: execution,
+				}).Return(&types.DescribeWorkflowVersionHistoriesResponse{
+					Cluster:          cluster.TestAlternativeClusterName,
+					Execution:        execution,
+					VersionHistories: versionHistories,
+				}, nil)
+			},
+			want: &types.DescribeWorkflowVersionHistoriesResponse{
+				Cluster:          cluster.TestAlternativeClusterName,
+				Execution:        execution,
+				VersionHistories: versionHistories,
+			},
+		},
+		"unknown cluster": {
+			input:   &types.DescribeWorkflowVersionHistoriesRequest{Domain: "test-domain", Execution: execution, Cluster: "unknown"},
+			wantErr: true,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			hcMock := history.NewMockClient(goMock)
+			dcMock := cache.NewMockDomainCache(goMock)
+			remoteMock := adminClient.NewMockClient(goMock)
+			if td.hcHandlerFunc != nil {
+				td.hcHandlerFunc(hcMock)
+			}
+			if td.dcHandlerFunc != nil {
+				td.dcHandlerFunc(dcMock)
+			}
+			if td.remoteHandlerFunc != nil {
+				td.remoteHandlerFunc(remoteMock)
+			}
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:            testlogger.New(t),
+					MetricsClient:     metrics.NewNoopMetricsClient(),
+					HistoryClient:     hcMock,
+					DomainCache:       dcMock,
+					RemoteAdminClient: remoteMock,
+					ClusterMetadata:   cluster.TestActiveClusterMetadata,
+				},
+			}
+
+			resp, err := handler.DescribeWorkflowVersionHistories(context.Background(), td.input)
+			if td.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, td.want, resp)
+			}
+		})
+	}
+}
+
+func Test_RebuildWorkflowBranch(t *testing.T) {
+	execution := &types.WorkflowExecution{WorkflowID: "test-workflow-id", RunID: "3fa85f64-5717-4562-b3fc-2c963f66afa6"}
+	tests := map[string]struct {
+		input             *types.RebuildWorkflowBranchRequest
+		hcHandlerFunc     func(mock *history.MockClient)
+		dcHandlerFunc     func(mock *cache.MockDomainCache)
+		remoteHandlerFunc func(mock *adminClient.MockClient)
+		wantErr           bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"empty workflow execution": {
+			input:   &types.RebuildWorkflowBranchRequest{Domain: "test-domain"},
+			wantErr: true,
+		},
+		"current cluster": {
+			input: &types.RebuildWorkflowBranchRequest{Domain: "test-domain", Execution: execution, BranchToken: []byte("branch-1")},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().RebuildWorkflowBranch(gomock.Any(), &types.HistoryRebuildWorkflowBranchRequest{
+					DomainUUID: "test-domain-id",
+					Request:    &types.RebuildWorkflowBranchRequest{Domain: "test-domain", Execution: execution, BranchToken: []byte("branch-1")},
+				}).Return(nil)
+			},
+		},
+		"history client error": {
+			input: &types.RebuildWorkflowBranchRequest{Domain: "test-domain", Execution: execution},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().RebuildWorkflowBranch(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		"remote cluster": {
+			input: &types.RebuildWorkflowBranchRequest{Domain: "test-domain", Execution: execution, Cluster: cluster.TestAlternativeClusterName},
+			remoteHandlerFunc: func(mock *adminClient.MockClient) {
+				mock.EXPECT().RebuildWorkflowBranch(gomock.Any(), &types.RebuildWorkflowBranchRequest{
+					Domain:    "test-domain",
+					Execution: execution,
+				}).Return(nil)
+			},
+		},
+		"unknown cluster": {
+			input:   &types.RebuildWorkflowBranchRequest{Domain: "test-domain", Execution: execution, Cluster: "unknown"},
+			wantErr: true,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			hcMock := history.NewMockClient(goMock)
+			dcMock := cache.NewMockDomainCache(goMock)
+			remoteMock := adminClient.NewMockClient(goMock)
+			if td.hcHandlerFunc != nil {
+				td.hcHandlerFunc(hcMock)
+			}
+			if td.dcHandlerFunc != nil {
+				td.dcHandlerFunc(dcMock)
+			}
+			if td.remoteHandlerFunc != nil {
+				td.remoteHandlerFunc(remoteMock)
+			}
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:            testlogger.New(t),
+					MetricsClient:     metrics.NewNoopMetricsClient(),
+					HistoryClient:     hcMock,
+					DomainCache:       dcMock,
+					RemoteAdminClient: remoteMock,
+					ClusterMetadata:   cluster.TestActiveClusterMetadata,
+				},
+			}
+
+			err := handler.RebuildWorkflowBranch(context.Background(), td.input)
+			if td.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_DescribeTaskList(t *testing.T) {
+	taskListType := types.TaskListTypeActivity
+	request := &types.DescribeTaskListRequest{
+		Domain:                "test-domain",
+		TaskList:              &types.TaskList{Name: "test-tasklist"},
+		TaskListType:          &taskListType,
+		IncludeTaskListStatus: true,
+	}
+	response := &types.DescribeTaskListResponse{
+		TaskListStatus:   &types.TaskListStatus{SyncMatchRate: 0.5, DispatchLatencyMs: 20},
+		ScalingDecisions: []*types.TaskListScalingDecision{{Reason: "backlog", NumWritePartitions: 2, NumReadPartitions: 2}},
+	}
+	tests := map[string]struct {
+		input         *types.DescribeTaskListRequest
+		mcHandlerFunc func(mock *matching.MockClient)
+		dcHandlerFunc func(mock *cache.MockDomainCache)
+		want          *types.DescribeTaskListResponse
+		wantErr       bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"domain not set": {
+			input:   &types.DescribeTaskListRequest{TaskList: request.TaskList, TaskListType: &taskListType},
+			wantErr: true,
+		},
+		"task list not set": {
+			input:   &types.DescribeTaskListRequest{Domain: "test-domain", TaskListType: &taskListType},
+			wantErr: true,
+		},
+		"task list type not set": {
+			input:   &types.DescribeTaskListRequest{Domain: "test-domain", TaskList: request.TaskList},
+			wantErr: true,
+		},
+		"success": {
+			input: request,
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			mcHandlerFunc: func(mock *matching.MockClient) {
+				mock.EXPECT().DescribeTaskList(gomock.Any(), &types.MatchingDescribeTaskListRequest{
+					DomainUUID:  "test-domain-id",
+					DescRequest: request,
+				}).Return(response, nil)
+			},
+			want: response,
+		},
+		"domain cache error": {
+			input: request,
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("", assert.AnError)
+			},
+			wantErr: true,
+		},
+		"matching client error": {
+			input: request,
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			mcHandlerFunc: func(mock *matching.MockClient) {
+				mock.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			mcMock := matching.NewMockClient(goMock)
+			dcMock := cache.NewMockDomainCache(goMock)
+			if td.mcHandlerFunc != nil {
+				td.mcHandlerFunc(mcMock)
+			}
+			if td.dcHandlerFunc != nil {
+				td.dcHandlerFunc(dcMock)
+			}
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:         testlogger.New(t),
+					MetricsClient:  metrics.NewNoopMetricsClient(),
+					MatchingClient: mcMock,
+					DomainCache:    dcMock,
+				},
+			}
+
+			resp, err := handler.DescribeTaskList(context.Background(), td.input)
+			if td.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, td.want, resp)
+			}
+		})
+	}
+}
+
+func Test_UpdateActivityOptions(t *testing.T) {
+	validExecution := &types.WorkflowExecution{
+		WorkflowID: "test-workflow-id",
+	}
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "test-domain-id"},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{},
+		0,
+	)
+	tests := map[string]struct {
+		input         *types.UpdateActivityOptionsRequest
+		hcHandlerFunc func(mock *history.MockClient)
+		dcHandlerFunc func(mock *cache.MockDomainCache)
+		wantErr       bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"empty workflow execution": {
+			input: &types.UpdateActivityOptionsRequest{
+				Domain:     "test-domain",
+				ActivityID: "test-activity-id",
+			},
+			wantErr: true,
+		},
+		"empty activity id": {
+			input: &types.UpdateActivityOptionsRequest{
+				Domain:    "test-domain",
+				Execution: validExecution,
+			},
+			wantErr: true,
+		},
+		"negative timeout": {
+			input: &types.UpdateActivityOptionsRequest{
+				Domain:                     "test-domain",
+				Execution:                  validExecution,
+				ActivityID:                 "test-activity-id",
+				StartToCloseTimeoutSeconds: common.Int32Ptr(-1),
+			},
+			wantErr: true,
+		},
+		"empty tasklist name": {
+			input: &types.UpdateActivityOptionsRequest{
+				Domain:     "test-domain",
+				Execution:  validExecution,
+				ActivityID: "test-activity-id",
+				TaskList:   &types.TaskList{},
+			},
+			wantErr: true,
+		},
+		"invalid retry policy": {
+			input: &types.UpdateActivityOptionsRequest{
+				Domain:      "test-domain",
+				Execution:   validExecution,
+				ActivityID:  "test-activity-id",
+				RetryPolicy: &types.RetryPolicy{InitialIntervalInSeconds: -1},
+			},
+			wantErr: true,
+		},
+		"get domain error": {
+			input: &types.UpdateActivityOptionsRequest{
+				Domain:     "test-domain",
+				Execution:  validExecution,
+				ActivityID: "test-activity-id",
+			},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomain("test-domain").Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		"update activity options error": {
+			input: &types.UpdateActivityOptionsRequest{
+				Domain:     "test-domain",
+				Execution:  validExecution,
+				ActivityID: "test-activity-id",
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpdateActivityOptions(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomain("test-domain").Return(domainEntry, nil)
+			},
+			wantErr: true,
+		},
+		"normal request": {
+			input: &types.UpdateActivityOptionsRequest{
+				Domain:        "test-domain",
+				Execution:     validExecution,
+				ActivityID:    "test-activity-id",
+				TaskList:      &types.TaskList{Name: "test-tasklist"},
+				ResetAttempts: true,
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpdateActivityOptions(gomock.Any(), &types.HistoryUpdateActivityOptionsRequest{
+					DomainUUID: "test-domain-id",
+					Request: &types.UpdateActivityOptionsRequest{
+						Domain:        "test-domain",
+						Execution:     validExecution,
+						ActivityID:    "test-activity-id",
+						TaskList:      &types.TaskList{Name: "test-tasklist"},
+						ResetAttempts: true,
+					},
+				}).Return(&types.UpdateActivityOptionsResponse{}, nil)
+			},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomain("test-domain").Return(domainEntry, nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			hcMock := history.NewMockClient(ctrl)
+			dcMock := cache.NewMockDomainCache(ctrl)
+			if tt.hcHandlerFunc != nil {
+				tt.hcHandlerFunc(hcMock)
+			}
+			if tt.dcHandlerFunc != nil {
+				tt.dcHandlerFunc(dcMock)
+			}
+
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					HistoryClient: hcMock,
+					DomainCache:   dcMock,
+				},
+			}
+
+			resp, err := handler.UpdateActivityOptions(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func Test_ListAuditLogEntries(t *testing.T) {
+	tests := map[string]struct {
+		input       *types.ListAuditLogEntriesRequest
+		auditorFunc func(mock *audit.MockAuditor)
+		wantErr     bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"negative page size": {
+			input:   &types.ListAuditLogEntriesRequest{PageSize: -1},
+			wantErr: true,
+		},
+		"start time after end time": {
+			input: &types.ListAuditLogEntriesRequest{
+				StartTimeNano: common.Int64Ptr(2),
+				EndTimeNano:   common.Int64Ptr(1),
+			},
+			wantErr: true,
+		},
+		"auditor error": {
+			input: &types.ListAuditLogEntriesRequest{Domain: "test-domain"},
+			auditorFunc: func(mock *audit.MockAuditor) {
+				mock.EXPECT().List(gomock.Any(), &types.ListAuditLogEntriesRequest{Domain: "test-domain"}).
+					Return(nil, &types.BadRequestError{Message: "no queryable sink"})
+			},
+			wantErr: true,
+		},
+		"success": {
+			input: &types.ListAuditLogEntriesRequest{Domain: "test-domain", PageSize: 10},
+			auditorFunc: func(mock *audit.MockAuditor) {
+				mock.EXPECT().List(gomock.Any(), &types.ListAuditLogEntriesRequest{Domain: "test-domain", PageSize: 10}).
+					Return(&types.ListAuditLogEntriesResponse{
+						Entries: []*types.AuditLogEntry{{Domain: "test-domain", APIName: "UpdateDomain"}},
+					}, nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			auditorMock := audit.NewMockAuditor(ctrl)
+			if tt.auditorFunc != nil {
+				tt.auditorFunc(auditorMock)
+			}
+
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+				},
+				auditor: auditorMock,
+			}
+
+			resp, err := handler.ListAuditLogEntries(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, resp.Entries, 1)
+			}
+		})
+	}
+}
+
+func Test_DeleteDomain(t *testing.T) {
+	describeResp := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: "test-domain", UUID: "test-domain-id"},
+	}
+	tests := map[string]struct {
+		input    *types.DeleteDomainRequest
+		mockFn   func(dh *domain.MockHandler, r *resource.Test)
+		wantResp *types.DeleteDomainResponse
+		wantErr  error
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"domain not set": {
+			input:   &types.DeleteDomainRequest{},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"open workflows": {
+			input: &types.DeleteDomainRequest{Name: "test-domain"},
+			mockFn: func(dh *domain.MockHandler, r *resource.Test) {
+				dh.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeResp, nil)
+				r.VisibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything, mock.MatchedBy(func(req *persistence.ListWorkflowExecutionsRequest) bool {
+					return req.DomainUUID == "test-domain-id" && req.PageSize == 1
+				})).Return(&persistence.ListWorkflowExecutionsResponse{
+					Executions: []*types.WorkflowExecutionInfo{{}},
+				}, nil).Once()
+			},
+			wantErr: errDomainHasOpenWorkflows,
+		},
+		"domain handler error": {
+			input: &types.DeleteDomainRequest{Name: "test-domain"},
+			mockFn: func(dh *domain.MockHandler, r *resource.Test) {
+				dh.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeResp, nil)
+				r.VisibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+				dh.EXPECT().DeleteDomain(gomock.Any(), &types.DeleteDomainRequest{Name: "test-domain"}).
+					Return(&types.BadRequestError{Message: "Domain has to be deprecated before it can be deleted."})
+			},
+			wantErr: &types.BadRequestError{Message: "Domain has to be deprecated before it can be deleted."},
+		},
+		"success": {
+			input: &types.DeleteDomainRequest{Name: "test-domain"},
+			mockFn: func(dh *domain.MockHandler, r *resource.Test) {
+				dh.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeResp, nil)
+				r.VisibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+				dh.EXPECT().DeleteDomain(gomock.Any(), gomock.Any()).Return(nil)
+				r.FrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, common.SystemLocalDomainName, req.Domain)
+						assert.Equal(t, domaindeletion.WorkflowTypeName, req.WorkflowType.GetName())
+						assert.JSONEq(t, `{"DomainName":"test-domain","DomainID":"test-domain-id"}`, string(req.Input))
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id"}, nil
+					})
+			},
+			wantResp: &types.DeleteDomainResponse{WorkflowID: domaindeletion.WorkflowIDPrefix + "test-domain-id", RunID: "run-id"},
+		},
+		"deletion workflow already running": {
+			input: &types.DeleteDomainRequest{Name: "test-domain"},
+			mockFn: func(dh *domain.MockHandler, r *resource.Test) {
+				dh.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeResp, nil)
+				r.VisibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+				dh.EXPECT().DeleteDomain(gomock.Any(), gomock.Any()).Return(nil)
+				r.FrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.WorkflowExecutionAlreadyStartedError{RunID: "running-run-id"})
+			},
+			wantResp: &types.DeleteDomainResponse{WorkflowID: domaindeletion.WorkflowIDPrefix + "test-domain-id", RunID: "running-run-id"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, ctrl, metrics.Frontend)
+			domainHandler := domain.NewMockHandler(ctrl)
+			if tt.mockFn != nil {
+				tt.mockFn(domainHandler, mockResource)
+			}
+
+			handler := adminHandlerImpl{
+				Resource:      mockResource,
+				domainHandler: domainHandler,
+			}
+
+			resp, err := handler.DeleteDomain(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+			}
+			mockResource.VisibilityMgr.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_RenameDomain(t *testing.T) {
+	tests := map[string]struct {
+		input    *types.RenameDomainRequest
+		mockFn   func(dh *domain.MockHandler)
+		wantResp *types.RenameDomainResponse
+		wantErr  error
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"domain not set": {
+			input:   &types.RenameDomainRequest{NewName: "new-domain"},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"new name not set": {
+			input:   &types.RenameDomainRequest{Name: "test-domain"},
+			wantErr: errNewDomainNameNotSet,
+		},
+		"domain handler error": {
+			input: &types.RenameDomainRequest{Name: "test-domain", NewName: "new-domain"},
+			mockFn: func(dh *domain.MockHandler) {
+				dh.EXPECT().RenameDomain(gomock.Any(), gomock.Any()).Return(nil, &types.DomainAlreadyExistsError{Message: "Domain already exists."})
+			},
+			wantErr: &types.DomainAlreadyExistsError{Message: "Domain already exists."},
+		},
+		"success": {
+			input: &types.RenameDomainRequest{Name: "test-domain", NewName: "new-domain"},
+			mockFn: func(dh *domain.MockHandler) {
+				dh.EXPECT().RenameDomain(gomock.Any(), &types.RenameDomainRequest{Name: "test-domain", NewName: "new-domain"}).
+					Return(&types.RenameDomainResponse{DomainID: "test-domain-id", PreviousNameExpiryTimestamp: 100}, nil)
+			},
+			wantResp: &types.RenameDomainResponse{DomainID: "test-domain-id", PreviousNameExpiryTimestamp: 100},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockResource := resource.NewTest(t, ctrl, metrics.Frontend)
+			domainHandler := domain.NewMockHandler(ctrl)
+			if tt.mockFn != nil {
+				tt.mockFn(domainHandler)
+			}
+
+			handler := adminHandlerImpl{
+				Resource:      mockResource,
+				domainHandler: domainHandler,
+			}
+
+			resp, err := handler.RenameDomain(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+			}
+		})
+	}
+}
+
+func Test_ReadHistoryTaskDLQMessages(t *testing.T) {
+	tests := map[string]struct {
+		input           *types.ReadHistoryTaskDLQMessagesRequest
+		dlqFunc         func(mock *taskdlq.MockDLQ)
+		domainCacheFunc func(mock *cache.MockDomainCache)
+		wantErr         bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"invalid shard": {
+			input:   &types.ReadHistoryTaskDLQMessagesRequest{ShardID: 10},
+			wantErr: true,
+		},
+		"read error": {
+			input: &types.ReadHistoryTaskDLQMessagesRequest{ShardID: 1},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Read(gomock.Any(), int32(1), "", common.EndMessageID, common.ReadDLQMessagesPageSize, nil).
+					Return(nil, nil, errors.New("read failed"))
+			},
+			wantErr: true,
+		},
+		"domain not found": {
+			input: &types.ReadHistoryTaskDLQMessagesRequest{ShardID: 1, Domain: "test-domain"},
+			domainCacheFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("", &types.EntityNotExistsError{})
+			},
+			wantErr: true,
+		},
+		"scoped to domain": {
+			input: &types.ReadHistoryTaskDLQMessagesRequest{ShardID: 1, Domain: "test-domain"},
+			domainCacheFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Read(gomock.Any(), int32(1), "test-domain-id", common.EndMessageID, common.ReadDLQMessagesPageSize, nil).
+					Return([]*types.HistoryTaskDLQMessage{{MessageID: 1, ShardID: 1}}, []byte("token"), nil)
+			},
+			wantErr: false,
+		},
+		"success": {
+			input: &types.ReadHistoryTaskDLQMessagesRequest{
+				ShardID:               1,
+				InclusiveEndMessageID: common.Int64Ptr(5),
+				MaximumPageSize:       2,
+			},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Read(gomock.Any(), int32(1), "", int64(5), 2, nil).
+					Return([]*types.HistoryTaskDLQMessage{{MessageID: 1, ShardID: 1}}, []byte("token"), nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			dlqMock := taskdlq.NewMockDLQ(ctrl)
+			if tt.dlqFunc != nil {
+				tt.dlqFunc(dlqMock)
+			}
+			domainCacheMock := cache.NewMockDomainCache(ctrl)
+			if tt.domainCacheFunc != nil {
+				tt.domainCacheFunc(domainCacheMock)
+			}
+
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					DomainCache:   domainCacheMock,
+				},
+				numberOfHistoryShards: 4,
+				historyTaskDLQ:        dlqMock,
+			}
+
+			resp, err := handler.ReadHistoryTaskDLQMessages(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, resp.Messages, 1)
+				assert.Equal(t, []byte("token"), resp.NextPageToken)
+			}
+		})
+	}
+}
+
+func Test_DescribeHistoryTaskDLQMessage(t *testing.T) {
+	tests := map[string]struct {
+		input   *types.DescribeHistoryTaskDLQMessageRequest
+		dlqFunc func(mock *taskdlq.MockDLQ)
+		wantErr bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"invalid shard": {
+			input:   &types.DescribeHistoryTaskDLQMessageRequest{ShardID: 4, MessageID: 3},
+			wantErr: true,
+		},
+		"not found": {
+			input: &types.DescribeHistoryTaskDLQMessageRequest{ShardID: 1, MessageID: 3},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Get(gomock.Any(), int32(1), int64(3)).Return(nil, &types.EntityNotExistsError{})
+			},
+			wantErr: true,
+		},
+		"success": {
+			input: &types.DescribeHistoryTaskDLQMessageRequest{ShardID: 1, MessageID: 3},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Get(gomock.Any(), int32(1), int64(3)).Return(&types.HistoryTaskDLQMessage{MessageID: 3}, nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			dlqMock := taskdlq.NewMockDLQ(ctrl)
+			if tt.dlqFunc != nil {
+				tt.dlqFunc(dlqMock)
+			}
+
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+				},
+				numberOfHistoryShards: 4,
+				historyTaskDLQ:        dlqMock,
+			}
+
+			resp, err := handler.DescribeHistoryTaskDLQMessage(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(3), resp.Message.MessageID)
+			}
+		})
+	}
+}
+
+func Test_MergeHistoryTaskDLQMessages(t *testing.T) {
+	message := &types.HistoryTaskDLQMessage{
+		MessageID:  7,
+		ShardID:    1,
+		DomainID:   "domain-id",
+		WorkflowID: "workflow-id",
+		RunID:      "run-id",
+	}
+	refreshRequest := &types.HistoryRefreshWorkflowTasksRequest{
+		DomainUIID: "domain-id",
+		Request: &types.RefreshWorkflowTasksRequest{
+			Execution: &types.WorkflowExecution{WorkflowID: "workflow-id", RunID: "run-id"},
+		},
+	}
+
+	tests := map[string]struct {
+		input         *types.MergeHistoryTaskDLQMessagesRequest
+		dlqFunc       func(mock *taskdlq.MockDLQ)
+		hcHandlerFunc func(mock *history.MockClient)
+		wantErr       bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"invalid shard": {
+			input:   &types.MergeHistoryTaskDLQMessagesRequest{ShardID: -1},
+			wantErr: true,
+		},
+		"refresh error": {
+			input: &types.MergeHistoryTaskDLQMessagesRequest{ShardID: 1},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Read(gomock.Any(), int32(1), "", common.EndMessageID, common.ReadDLQMessagesPageSize, nil).
+					Return([]*types.HistoryTaskDLQMessage{message}, nil, nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().RefreshWorkflowTasks(gomock.Any(), refreshRequest).Return(errors.New("refresh failed"))
+			},
+			wantErr: true,
+		},
+		"workflow gone": {
+			input: &types.MergeHistoryTaskDLQMessagesRequest{ShardID: 1},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Read(gomock.Any(), int32(1), "", common.EndMessageID, common.ReadDLQMessagesPageSize, nil).
+					Return([]*types.HistoryTaskDLQMessage{message}, nil, nil)
+				mock.EXPECT().Delete(gomock.Any(), int32(1), int64(7)).Return(nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().RefreshWorkflowTasks(gomock.Any(), refreshRequest).Return(&types.EntityNotExistsError{})
+			},
+			wantErr: false,
+		},
+		"success": {
+			input: &types.MergeHistoryTaskDLQMessagesRequest{ShardID: 1},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Read(gomock.Any(), int32(1), "", common.EndMessageID, common.ReadDLQMessagesPageSize, nil).
+					Return([]*types.HistoryTaskDLQMessage{message}, nil, nil)
+				mock.EXPECT().Delete(gomock.Any(), int32(1), int64(7)).Return(nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().RefreshWorkflowTasks(gomock.Any(), refreshRequest).Return(nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			dlqMock := taskdlq.NewMockDLQ(ctrl)
+			if tt.dlqFunc != nil {
+				tt.dlqFunc(dlqMock)
+			}
+			hcMock := history.NewMockClient(ctrl)
+			if tt.hcHandlerFunc != nil {
+				tt.hcHandlerFunc(hcMock)
+			}
+
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					HistoryClient: hcMock,
+				},
+				numberOfHistoryShards: 4,
+				historyTaskDLQ:        dlqMock,
+			}
+
+			resp, err := handler.MergeHistoryTaskDLQMessages(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func Test_PurgeHistoryTaskDLQMessages(t *testing.T) {
+	tests := map[string]struct {
+		input           *types.PurgeHistoryTaskDLQMessagesRequest
+		dlqFunc         func(mock *taskdlq.MockDLQ)
+		domainCacheFunc func(mock *cache.MockDomainCache)
+		wantErr         bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"invalid shard": {
+			input:   &types.PurgeHistoryTaskDLQMessagesRequest{ShardID: 4},
+			wantErr: true,
+		},
+		"purge error": {
+			input: &types.PurgeHistoryTaskDLQMessagesRequest{ShardID: 2},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Purge(gomock.Any(), int32(2), "", common.EndMessageID).Return(errors.New("purge failed"))
+			},
+			wantErr: true,
+		},
+		"scoped to domain": {
+			input: &types.PurgeHistoryTaskDLQMessagesRequest{ShardID: 2, Domain: "test-domain"},
+			domainCacheFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Purge(gomock.Any(), int32(2), "test-domain-id", common.EndMessageID).Return(nil)
+			},
+			wantErr: false,
+		},
+		"success": {
+			input: &types.PurgeHistoryTaskDLQMessagesRequest{ShardID: 2, InclusiveEndMessageID: common.Int64Ptr(9)},
+			dlqFunc: func(mock *taskdlq.MockDLQ) {
+				mock.EXPECT().Purge(gomock.Any(), int32(2), "", int64(9)).Return(nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			dlqMock := taskdlq.NewMockDLQ(ctrl)
+			if tt.dlqFunc != nil {
+				tt.dlqFunc(dlqMock)
+			}
+			domainCacheMock := cache.NewMockDomainCache(ctrl)
+			if tt.domainCacheFunc != nil {
+				tt.domainCacheFunc(domainCacheMock)
+			}
+
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					DomainCache:   domainCacheMock,
+				},
+				numberOfHistoryShards: 4,
+				historyTaskDLQ:        dlqMock,
+			}
+
+			err := handler.PurgeHistoryTaskDLQMessages(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_ResendReplicationTasks(t *testing.T) {
 	tests := map[string]struct {
 		input         *types.ResendReplicationTasksRequest
@@ -53,6 +53,14 @@ type Handler interface {
 	ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 	ReapplyEvents(context.Context, *types.ReapplyEventsRequest) error
 	RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest) error
+	DescribeWorkflowVersionHistories(context.Context, *types.DescribeWorkflowVersionHistoriesRequest) (*types.DescribeWorkflowVersionHistoriesResponse, error)
+	RebuildWorkflowBranch(context.Context, *types.RebuildWorkflowBranchRequest) error
+	UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error)
+	ListAuditLogEntries(context.Context, *types.ListAuditLogEntriesRequest) (*types.ListAuditLogEntriesResponse, error)
+	ReadHistoryTaskDLQMessages(context.Context, *types.ReadHistoryTaskDLQMessagesRequest) (*types.ReadHistoryTaskDLQMessagesResponse, error)
+	DescribeHistoryTaskDLQMessage(context.Context, *types.DescribeHistoryTaskDLQMessageRequest) (*types.DescribeHistoryTaskDLQMessageResponse, error)
+	MergeHistoryTaskDLQMessages(context.Context, *types.MergeHistoryTaskDLQMessagesRequest) (*types.MergeHistoryTaskDLQMessagesResponse, error)
+	PurgeHistoryTaskDLQMessages(context.Context, *types.PurgeHistoryTaskDLQMessagesRequest) error
 	RemoveTask(context.Context, *types.RemoveTaskRequest) error
 	ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest) error
 	ResetQueue(context.Context, *types.ResetQueueRequest) error
@@ -63,6 +71,10 @@ type Handler interface {
 	RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest) error
 	ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error)
+	DeleteDomain(context.Context, *types.DeleteDomainRequest) (*types.DeleteDomainResponse, error)
+	GetReplicationStatus(context.Context, *types.GetReplicationStatusRequest) (*types.GetReplicationStatusResponse, error)
+	RenameDomain(context.Context, *types.RenameDomainRequest) (*types.RenameDomainResponse, error)
+	DescribeTaskList(context.Context, *types.DescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 	MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error)
 	GetGlobalIsolationGroups(ctx context.Context, request *types.GetGlobalIsolationGroupsRequest) (*types.GetGlobalIsolationGroupsResponse, error)
 	UpdateGlobalIsolationGroups(ctx context.Context, request *types.UpdateGlobalIsolationGroupsRequest) (*types.UpdateGlobalIsolationGroupsResponse, error)
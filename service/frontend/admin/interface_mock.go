@@ -107,6 +107,21 @@ func (mr *MockHandlerMockRecorder) CountDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDLQMessages", reflect.TypeOf((*MockHandler)(nil).CountDLQMessages), arg0, arg1)
 }
 
+// DeleteDomain mocks base method.
+func (m *MockHandler) DeleteDomain(arg0 context.Context, arg1 *types.DeleteDomainRequest) (*types.DeleteDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomain", arg0, arg1)
+	ret0, _ := ret[0].(*types.DeleteDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDomain indicates an expected call of DeleteDomain.
+func (mr *MockHandlerMockRecorder) DeleteDomain(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockHandler)(nil).DeleteDomain), arg0, arg1)
+}
+
 // DeleteWorkflow mocks base method.
 func (m *MockHandler) DeleteWorkflow(arg0 context.Context, arg1 *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -152,6 +167,21 @@ func (mr *MockHandlerMockRecorder) DescribeHistoryHost(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockHandler)(nil).DescribeHistoryHost), arg0, arg1)
 }
 
+// DescribeHistoryTaskDLQMessage mocks base method.
+func (m *MockHandler) DescribeHistoryTaskDLQMessage(arg0 context.Context, arg1 *types.DescribeHistoryTaskDLQMessageRequest) (*types.DescribeHistoryTaskDLQMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryTaskDLQMessage", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeHistoryTaskDLQMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryTaskDLQMessage indicates an expected call of DescribeHistoryTaskDLQMessage.
+func (mr *MockHandlerMockRecorder) DescribeHistoryTaskDLQMessage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryTaskDLQMessage", reflect.TypeOf((*MockHandler)(nil).DescribeHistoryTaskDLQMessage), arg0, arg1)
+}
+
 // DescribeQueue mocks base method.
 func (m *MockHandler) DescribeQueue(arg0 context.Context, arg1 *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +212,21 @@ func (mr *MockHandlerMockRecorder) DescribeShardDistribution(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardDistribution", reflect.TypeOf((*MockHandler)(nil).DescribeShardDistribution), arg0, arg1)
 }
 
+// DescribeTaskList mocks base method.
+func (m *MockHandler) DescribeTaskList(arg0 context.Context, arg1 *types.DescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskList indicates an expected call of DescribeTaskList.
+func (mr *MockHandlerMockRecorder) DescribeTaskList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockHandler)(nil).DescribeTaskList), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHandler) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.AdminDescribeWorkflowExecutionRequest) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +242,21 @@ func (mr *MockHandlerMockRecorder) DescribeWorkflowExecution(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// DescribeWorkflowVersionHistories mocks base method.
+func (m *MockHandler) DescribeWorkflowVersionHistories(arg0 context.Context, arg1 *types.DescribeWorkflowVersionHistoriesRequest) (*types.DescribeWorkflowVersionHistoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowVersionHistories", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeWorkflowVersionHistoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowVersionHistories indicates an expected call of DescribeWorkflowVersionHistories.
+func (mr *MockHandlerMockRecorder) DescribeWorkflowVersionHistories(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowVersionHistories", reflect.TypeOf((*MockHandler)(nil).DescribeWorkflowVersionHistories), arg0, arg1)
+}
+
 // GetCrossClusterTasks mocks base method.
 func (m *MockHandler) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -317,6 +377,21 @@ func (mr *MockHandlerMockRecorder) GetReplicationMessages(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockHandler)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockHandler) GetReplicationStatus(arg0 context.Context, arg1 *types.GetReplicationStatusRequest) (*types.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockHandlerMockRecorder) GetReplicationStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockHandler)(nil).GetReplicationStatus), arg0, arg1)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method.
 func (m *MockHandler) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *types.GetWorkflowExecutionRawHistoryV2Request) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -332,6 +407,21 @@ func (mr *MockHandlerMockRecorder) GetWorkflowExecutionRawHistoryV2(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockHandler)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ListAuditLogEntries mocks base method.
+func (m *MockHandler) ListAuditLogEntries(arg0 context.Context, arg1 *types.ListAuditLogEntriesRequest) (*types.ListAuditLogEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogEntries", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListAuditLogEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogEntries indicates an expected call of ListAuditLogEntries.
+func (mr *MockHandlerMockRecorder) ListAuditLogEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogEntries", reflect.TypeOf((*MockHandler)(nil).ListAuditLogEntries), arg0, arg1)
+}
+
 // ListDynamicConfig mocks base method.
 func (m *MockHandler) ListDynamicConfig(arg0 context.Context, arg1 *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -377,6 +467,21 @@ func (mr *MockHandlerMockRecorder) MergeDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHandler)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MergeHistoryTaskDLQMessages mocks base method.
+func (m *MockHandler) MergeHistoryTaskDLQMessages(arg0 context.Context, arg1 *types.MergeHistoryTaskDLQMessagesRequest) (*types.MergeHistoryTaskDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeHistoryTaskDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*types.MergeHistoryTaskDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeHistoryTaskDLQMessages indicates an expected call of MergeHistoryTaskDLQMessages.
+func (mr *MockHandlerMockRecorder) MergeHistoryTaskDLQMessages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeHistoryTaskDLQMessages", reflect.TypeOf((*MockHandler)(nil).MergeHistoryTaskDLQMessages), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockHandler) PurgeDLQMessages(arg0 context.Context, arg1 *types.PurgeDLQMessagesRequest) error {
 	m.ctrl.T.Helper()
@@ -391,6 +496,20 @@ func (mr *MockHandlerMockRecorder) PurgeDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockHandler)(nil).PurgeDLQMessages), arg0, arg1)
 }
 
+// PurgeHistoryTaskDLQMessages mocks base method.
+func (m *MockHandler) PurgeHistoryTaskDLQMessages(arg0 context.Context, arg1 *types.PurgeHistoryTaskDLQMessagesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeHistoryTaskDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeHistoryTaskDLQMessages indicates an expected call of PurgeHistoryTaskDLQMessages.
+func (mr *MockHandlerMockRecorder) PurgeHistoryTaskDLQMessages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeHistoryTaskDLQMessages", reflect.TypeOf((*MockHandler)(nil).PurgeHistoryTaskDLQMessages), arg0, arg1)
+}
+
 // ReadDLQMessages mocks base method.
 func (m *MockHandler) ReadDLQMessages(arg0 context.Context, arg1 *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -406,6 +525,21 @@ func (mr *MockHandlerMockRecorder) ReadDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDLQMessages", reflect.TypeOf((*MockHandler)(nil).ReadDLQMessages), arg0, arg1)
 }
 
+// ReadHistoryTaskDLQMessages mocks base method.
+func (m *MockHandler) ReadHistoryTaskDLQMessages(arg0 context.Context, arg1 *types.ReadHistoryTaskDLQMessagesRequest) (*types.ReadHistoryTaskDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHistoryTaskDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*types.ReadHistoryTaskDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryTaskDLQMessages indicates an expected call of ReadHistoryTaskDLQMessages.
+func (mr *MockHandlerMockRecorder) ReadHistoryTaskDLQMessages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryTaskDLQMessages", reflect.TypeOf((*MockHandler)(nil).ReadHistoryTaskDLQMessages), arg0, arg1)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockHandler) ReapplyEvents(arg0 context.Context, arg1 *types.ReapplyEventsRequest) error {
 	m.ctrl.T.Helper()
@@ -420,6 +554,20 @@ func (mr *MockHandlerMockRecorder) ReapplyEvents(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockHandler)(nil).ReapplyEvents), arg0, arg1)
 }
 
+// RebuildWorkflowBranch mocks base method.
+func (m *MockHandler) RebuildWorkflowBranch(arg0 context.Context, arg1 *types.RebuildWorkflowBranchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildWorkflowBranch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildWorkflowBranch indicates an expected call of RebuildWorkflowBranch.
+func (mr *MockHandlerMockRecorder) RebuildWorkflowBranch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildWorkflowBranch", reflect.TypeOf((*MockHandler)(nil).RebuildWorkflowBranch), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockHandler) RefreshWorkflowTasks(arg0 context.Context, arg1 *types.RefreshWorkflowTasksRequest) error {
 	m.ctrl.T.Helper()
@@ -448,6 +596,21 @@ func (mr *MockHandlerMockRecorder) RemoveTask(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockHandler)(nil).RemoveTask), arg0, arg1)
 }
 
+// RenameDomain mocks base method.
+func (m *MockHandler) RenameDomain(arg0 context.Context, arg1 *types.RenameDomainRequest) (*types.RenameDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDomain", arg0, arg1)
+	ret0, _ := ret[0].(*types.RenameDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameDomain indicates an expected call of RenameDomain.
+func (mr *MockHandlerMockRecorder) RenameDomain(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockHandler)(nil).RenameDomain), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockHandler) ResendReplicationTasks(arg0 context.Context, arg1 *types.ResendReplicationTasksRequest) error {
 	m.ctrl.T.Helper()
@@ -529,6 +692,21 @@ func (mr *MockHandlerMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockHandler)(nil).Stop))
 }
 
+// UpdateActivityOptions mocks base method.
+func (m *MockHandler) UpdateActivityOptions(arg0 context.Context, arg1 *types.UpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockHandlerMockRecorder) UpdateActivityOptions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHandler)(nil).UpdateActivityOptions), arg0, arg1)
+}
+
 // UpdateDomainAsyncWorkflowConfiguraton mocks base method.
 func (m *MockHandler) UpdateDomainAsyncWorkflowConfiguraton(arg0 context.Context, arg1 *types.UpdateDomainAsyncWorkflowConfiguratonRequest) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// migratedExecution is the execution a workflow was moved to by a domain migration
+	migratedExecution struct {
+		domainName string
+		domainID   string
+		execution  *types.WorkflowExecution
+	}
+)
+
+// getMigratedExecution returns the execution in the target domain when the domain is being migrated, or was migrated
+// within the redirect duration, and the workflow was moved to the target domain, so that its signals and queries
+// can be redirected there
+func (wh *WorkflowHandler) getMigratedExecution(
+	ctx context.Context,
+	domainName string,
+	execution *types.WorkflowExecution,
+) (*migratedExecution, bool) {
+	domainEntry, err := wh.GetDomainCache().GetDomain(domainName)
+	if err != nil {
+		return nil, false
+	}
+	targetDomain := domainEntry.GetInfo().Data[common.DomainDataKeyForMigrationTarget]
+	if targetDomain == "" || targetDomain == domainEntry.GetInfo().Name {
+		return nil, false
+	}
+	// the expiry is empty while the migration is running
+	if expiry := domainEntry.GetInfo().Data[common.DomainDataKeyForMigrationRedirectExpiry]; expiry != "" {
+		expiryTime, err := time.Parse(time.RFC3339, expiry)
+		if err != nil || !wh.GetTimeSource().Now().Before(expiryTime) {
+			return nil, false
+		}
+	}
+	targetDomainID, err := wh.GetDomainCache().GetDomainID(targetDomain)
+	if err != nil {
+		return nil, false
+	}
+
+	resp, err := wh.GetHistoryClient().DescribeWorkflowExecution(ctx, &types.HistoryDescribeWorkflowExecutionRequest{
+		DomainUUID: targetDomainID,
+		Request: &types.DescribeWorkflowExecutionRequest{
+			Domain:    targetDomain,
+			Execution: &types.WorkflowExecution{WorkflowID: execution.GetWorkflowID()},
+		},
+	})
+	if err != nil {
+		return nil, false
+	}
+	memo := resp.GetWorkflowExecutionInfo().GetMemo().GetFields()
+	migratedFromDomain := getMemoString(memo, common.MemoKeyForMigratedFromDomain)
+	if migratedFromDomain != domainName && migratedFromDomain != domainEntry.GetInfo().Name {
+		return nil, false
+	}
+	if execution.GetRunID() != "" && execution.GetRunID() != getMemoString(memo, common.MemoKeyForMigratedFromRunID) {
+		// an earlier run of the workflow is addressed, it stays in the source domain
+		return nil, false
+	}
+	return &migratedExecution{
+		domainName: targetDomain,
+		domainID:   targetDomainID,
+		execution:  &types.WorkflowExecution{WorkflowID: execution.GetWorkflowID()},
+	}, true
+}
+
+func getMemoString(memo map[string][]byte, key string) string {
+	var value string
+	if err := json.Unmarshal(memo[key], &value); err != nil {
+		return ""
+	}
+	return value
+}
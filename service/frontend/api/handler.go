@@ -1654,6 +1654,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	// the first decision is started on behalf of the caller, so it needs an identity to be recorded with
+	historyRequest.RequestEagerExecution = startRequest.GetRequestEagerExecution() &&
+		startRequest.Identity != "" &&
+		wh.config.EnableEagerWorkflowStart(domainName)
 
 	// for debugging jitter workflow
 	// will be removed later
@@ -2175,6 +2179,14 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return &types.BadRequestError{fmt.Sprintf("Domain %s is drained from isolation group %s.", domainName, isolationGroup)}
 	}
 
+	if migrated, ok := wh.getMigratedExecution(ctx, domainName, wfExecution); ok {
+		redirectedRequest := *signalRequest
+		redirectedRequest.Domain = migrated.domainName
+		redirectedRequest.WorkflowExecution = migrated.execution
+		signalRequest = &redirectedRequest
+		domainID = migrated.domainID
+	}
+
 	err = wh.GetHistoryClient().SignalWorkflowExecution(ctx, &types.HistorySignalWorkflowExecutionRequest{
 		DomainUUID:    domainID,
 		SignalRequest: signalRequest,
@@ -2669,6 +2681,14 @@ func (wh *WorkflowHandler) QueryWorkflow(
 		return nil, err
 	}
 
+	if migrated, ok := wh.getMigratedExecution(ctx, domainName, wfExecution); ok {
+		redirectedRequest := *queryRequest
+		redirectedRequest.Domain = migrated.domainName
+		redirectedRequest.Execution = migrated.execution
+		queryRequest = &redirectedRequest
+		domainID = migrated.domainID
+	}
+
 	req := &types.HistoryQueryWorkflowRequest{
 		DomainUUID: domainID,
 		Request:    queryRequest,
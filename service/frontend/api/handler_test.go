@@ -763,6 +763,74 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_LogJitterTime() {
 	s.NoError(err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_EagerExecution() {
+	testCases := []struct {
+		name      string
+		enabled   bool
+		identity  string
+		wantEager bool
+	}{
+		{
+			name:      "eager workflow start enabled",
+			enabled:   true,
+			identity:  "worker",
+			wantEager: true,
+		},
+		{
+			name:      "caller without identity",
+			enabled:   true,
+			wantEager: false,
+		},
+		{
+			name:      "eager workflow start disabled",
+			enabled:   false,
+			identity:  "worker",
+			wantEager: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			config := s.newConfig(dc.NewInMemoryClient())
+			config.UserRPS = dc.GetIntPropertyFn(10)
+			config.EnableEagerWorkflowStart = dc.GetBoolPropertyFnFilteredByDomain(tc.enabled)
+			wh := s.getWorkflowHandler(config)
+
+			startRequest := &types.StartWorkflowExecutionRequest{
+				Domain:                              s.testDomain,
+				WorkflowID:                          "workflow-id",
+				WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+				TaskList:                            &types.TaskList{Name: "task-list"},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+				Identity:                            tc.identity,
+				RequestID:                           uuid.New(),
+				RequestEagerExecution:               true,
+			}
+			decisionTask := &types.PollForDecisionTaskResponse{TaskToken: []byte("token")}
+
+			s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(2)
+			s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req *types.HistoryStartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+					s.Equal(tc.wantEager, req.RequestEagerExecution)
+					resp := &types.StartWorkflowExecutionResponse{RunID: "run-id"}
+					if req.RequestEagerExecution {
+						resp.DecisionTask = decisionTask
+					}
+					return resp, nil
+				})
+
+			resp, err := wh.StartWorkflowExecution(context.Background(), startRequest)
+			s.NoError(err)
+			if tc.wantEager {
+				s.Equal(decisionTask, resp.DecisionTask)
+			} else {
+				s.Nil(resp.DecisionTask)
+			}
+		})
+	}
+}
+
 func (s *workflowHandlerSuite) TestDiagnoseWorkflowExecution_Success() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -3192,14 +3260,124 @@ func (s *workflowHandlerSuite) TestSignalWorkflowExecution() {
 			request: validRequest,
 			mockFn: func() {
 				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+					&persistence.DomainInfo{ID: s.testDomainID, Name: s.testDomain}, &persistence.DomainConfig{}, "active"), nil)
 				s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(errors.New("error"))
 			},
 			expectError: true,
 		},
+		"redirected to migration target domain": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+					&persistence.DomainInfo{
+						ID:   s.testDomainID,
+						Name: s.testDomain,
+						Data: map[string]string{common.DomainDataKeyForMigrationTarget: "target-domain"},
+					}, &persistence.DomainConfig{}, "active"), nil)
+				s.mockDomainCache.EXPECT().GetDomainID("target-domain").Return("target-domain-id", nil)
+				s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+						Memo: &types.Memo{Fields: map[string][]byte{
+							common.MemoKeyForMigratedFromDomain: []byte(`"` + s.testDomain + `"`),
+							common.MemoKeyForMigratedFromRunID:  []byte(`"` + testRunID + `"`),
+						}},
+					},
+				}, nil)
+				s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), &types.HistorySignalWorkflowExecutionRequest{
+					DomainUUID: "target-domain-id",
+					SignalRequest: &types.SignalWorkflowExecutionRequest{
+						Domain:            "target-domain",
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+						SignalName:        validRequest.SignalName,
+						RequestID:         validRequest.RequestID,
+						Input:             validRequest.Input,
+					},
+				}).Return(nil)
+			},
+			expectError: false,
+		},
+		"redirected within redirect duration after migration completed": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+					&persistence.DomainInfo{
+						ID:   s.testDomainID,
+						Name: s.testDomain,
+						Data: map[string]string{
+							common.DomainDataKeyForMigrationTarget:         "target-domain",
+							common.DomainDataKeyForMigrationRedirectExpiry: time.Now().Add(time.Hour).Format(time.RFC3339),
+						},
+					}, &persistence.DomainConfig{}, "active"), nil)
+				s.mockDomainCache.EXPECT().GetDomainID("target-domain").Return("target-domain-id", nil)
+				s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+						Memo: &types.Memo{Fields: map[string][]byte{
+							common.MemoKeyForMigratedFromDomain: []byte(`"` + s.testDomain + `"`),
+							common.MemoKeyForMigratedFromRunID:  []byte(`"` + testRunID + `"`),
+						}},
+					},
+				}, nil)
+				s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), &types.HistorySignalWorkflowExecutionRequest{
+					DomainUUID: "target-domain-id",
+					SignalRequest: &types.SignalWorkflowExecutionRequest{
+						Domain:            "target-domain",
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+						SignalName:        validRequest.SignalName,
+						RequestID:         validRequest.RequestID,
+						Input:             validRequest.Input,
+					},
+				}).Return(nil)
+			},
+			expectError: false,
+		},
+		"not redirected after redirect duration": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+					&persistence.DomainInfo{
+						ID:   s.testDomainID,
+						Name: s.testDomain,
+						Data: map[string]string{
+							common.DomainDataKeyForMigrationTarget:         "target-domain",
+							common.DomainDataKeyForMigrationRedirectExpiry: time.Now().Add(-time.Hour).Format(time.RFC3339),
+						},
+					}, &persistence.DomainConfig{}, "active"), nil)
+				s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), &types.HistorySignalWorkflowExecutionRequest{
+					DomainUUID:    s.testDomainID,
+					SignalRequest: validRequest,
+				}).Return(nil)
+			},
+			expectError: false,
+		},
+		"not redirected when workflow was not migrated": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+					&persistence.DomainInfo{
+						ID:   s.testDomainID,
+						Name: s.testDomain,
+						Data: map[string]string{common.DomainDataKeyForMigrationTarget: "target-domain"},
+					}, &persistence.DomainConfig{}, "active"), nil)
+				s.mockDomainCache.EXPECT().GetDomainID("target-domain").Return("target-domain-id", nil)
+				s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+				s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), &types.HistorySignalWorkflowExecutionRequest{
+					DomainUUID:    s.testDomainID,
+					SignalRequest: validRequest,
+				}).Return(nil)
+			},
+			expectError: false,
+		},
 		"success": {
 			request: validRequest,
 			mockFn: func() {
 				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+					&persistence.DomainInfo{ID: s.testDomainID, Name: s.testDomain}, &persistence.DomainConfig{}, "active"), nil)
 				s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectError: false,
@@ -3763,6 +3941,8 @@ func TestQueryWorkflow(t *testing.T) {
 			name: "Success case",
 			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
 				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("test-domain-id", nil).Times(1)
+				resourceMock.DomainCache.EXPECT().GetDomain("test-domain").Return(cache.NewLocalDomainCacheEntryForTest(
+					&persistence.DomainInfo{ID: "test-domain-id", Name: "test-domain"}, &persistence.DomainConfig{}, "active"), nil).Times(1)
 				resourceMock.HistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(
 					&types.HistoryQueryWorkflowResponse{
 						Response: &types.QueryWorkflowResponse{
@@ -3782,6 +3962,52 @@ func TestQueryWorkflow(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success case - redirected to migration target domain",
+			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
+				resourceMock.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+				resourceMock.DomainCache.EXPECT().GetDomain("test-domain").Return(cache.NewLocalDomainCacheEntryForTest(
+					&persistence.DomainInfo{
+						ID:   "test-domain-id",
+						Name: "test-domain",
+						Data: map[string]string{common.DomainDataKeyForMigrationTarget: "target-domain"},
+					}, &persistence.DomainConfig{}, "active"), nil).Times(1)
+				resourceMock.DomainCache.EXPECT().GetDomainID("target-domain").Return("target-domain-id", nil).Times(1)
+				resourceMock.HistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+					&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+							Memo: &types.Memo{Fields: map[string][]byte{
+								common.MemoKeyForMigratedFromDomain: []byte(`"test-domain"`),
+							}},
+						},
+					}, nil).Times(1)
+				resourceMock.HistoryClient.EXPECT().QueryWorkflow(gomock.Any(), &types.HistoryQueryWorkflowRequest{
+					DomainUUID: "target-domain-id",
+					Request: &types.QueryWorkflowRequest{
+						Domain:    "target-domain",
+						Execution: &types.WorkflowExecution{WorkflowID: "test-workflow-id"},
+						Query: &types.WorkflowQuery{
+							QueryType: "test-query-type",
+						},
+					},
+				}).Return(
+					&types.HistoryQueryWorkflowResponse{
+						Response: &types.QueryWorkflowResponse{
+							QueryResult: []byte("test-result"),
+						},
+					}, nil).Times(1)
+			},
+			inMemoryClient: dc.NewInMemoryClient(),
+			queryRequest: &types.QueryWorkflowRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+				},
+				Query: &types.WorkflowQuery{
+					QueryType: "test-query-type",
+				},
+			},
+		},
 		{
 			name:           "Error case - is shutting down",
 			setupMocks:     func(_ *client.MockVersionChecker, _ *resource.Test) {},
@@ -3901,6 +4127,8 @@ func TestQueryWorkflow(t *testing.T) {
 			name: "Error case - QueryWorkflow error",
 			setupMocks: func(mockVersionChecker *client.MockVersionChecker, resourceMock *resource.Test) {
 				resourceMock.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("test-domain-id", nil).Times(1)
+				resourceMock.DomainCache.EXPECT().GetDomain("test-domain").Return(cache.NewLocalDomainCacheEntryForTest(
+					&persistence.DomainInfo{ID: "test-domain-id", Name: "test-domain"}, &persistence.DomainConfig{}, "active"), nil).Times(1)
 				resourceMock.HistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(nil, errors.New("query-workflow-error")).Times(1)
 			},
 			inMemoryClient: dc.NewInMemoryClient(),
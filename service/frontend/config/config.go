@@ -65,9 +65,18 @@ type Config struct {
 	GlobalRatelimiterKeyMode        dynamicconfig.StringPropertyWithRatelimitKeyFilter
 	GlobalRatelimiterUpdateInterval dynamicconfig.DurationPropertyFn
 
+	// load shedding configuration
+	EnableLoadShedding              dynamicconfig.BoolPropertyFnWithDomainFilter
+	LoadSheddingMaxInFlightRequests dynamicconfig.IntPropertyFn
+	LoadSheddingLowTierThreshold    dynamicconfig.FloatPropertyFnWithDomainFilter
+	LoadSheddingNormalTierThreshold dynamicconfig.FloatPropertyFnWithDomainFilter
+	LoadSheddingHighTierThreshold   dynamicconfig.FloatPropertyFnWithDomainFilter
+
 	// isolation configuration
 	EnableTasklistIsolation dynamicconfig.BoolPropertyFnWithDomainFilter
 
+	EnableEagerWorkflowStart dynamicconfig.BoolPropertyFnWithDomainFilter
+
 	// id length limits
 	MaxIDLengthWarnLimit  dynamicconfig.IntPropertyFn
 	DomainNameMaxLength   dynamicconfig.IntPropertyFnWithDomainFilter
@@ -95,6 +104,13 @@ type Config struct {
 	DomainFailoverRefreshInterval               dynamicconfig.DurationPropertyFn
 	DomainFailoverRefreshTimerJitterCoefficient dynamicconfig.FloatPropertyFn
 
+	// Replication task batching and compression for remote clusters
+	EnableReplicationTaskBatching    dynamicconfig.BoolPropertyFn
+	EnableReplicationTaskCompression dynamicconfig.BoolPropertyFn
+	ReplicationTaskMaxBatchSize      dynamicconfig.IntPropertyFn
+	// ReplicationStreamRequestTimeout bounds the requests served on a replication stream, which carries no deadline
+	ReplicationStreamRequestTimeout dynamicconfig.DurationPropertyFn
+
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
@@ -148,6 +164,11 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		GlobalDomainAsyncRPS:                        dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendGlobalDomainAsyncRPS),
 		GlobalRatelimiterKeyMode:                    dc.GetStringPropertyFilteredByRatelimitKey(dynamicconfig.FrontendGlobalRatelimiterMode),
 		GlobalRatelimiterUpdateInterval:             dc.GetDurationProperty(dynamicconfig.GlobalRatelimiterUpdateInterval),
+		EnableLoadShedding:                          dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEnableLoadShedding),
+		LoadSheddingMaxInFlightRequests:             dc.GetIntProperty(dynamicconfig.FrontendLoadSheddingMaxInFlightRequests),
+		LoadSheddingLowTierThreshold:                dc.GetFloat64PropertyFilteredByDomain(dynamicconfig.FrontendLoadSheddingLowTierThreshold),
+		LoadSheddingNormalTierThreshold:             dc.GetFloat64PropertyFilteredByDomain(dynamicconfig.FrontendLoadSheddingNormalTierThreshold),
+		LoadSheddingHighTierThreshold:               dc.GetFloat64PropertyFilteredByDomain(dynamicconfig.FrontendLoadSheddingHighTierThreshold),
 		MaxIDLengthWarnLimit:                        dc.GetIntProperty(dynamicconfig.MaxIDLengthWarnLimit),
 		DomainNameMaxLength:                         dc.GetIntPropertyFilteredByDomain(dynamicconfig.DomainNameMaxLength),
 		IdentityMaxLength:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.IdentityMaxLength),
@@ -167,6 +188,10 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		EnableGracefulFailover:                      dc.GetBoolProperty(dynamicconfig.EnableGracefulFailover),
 		DomainFailoverRefreshInterval:               dc.GetDurationProperty(dynamicconfig.DomainFailoverRefreshInterval),
 		DomainFailoverRefreshTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.DomainFailoverRefreshTimerJitterCoefficient),
+		EnableReplicationTaskBatching:               dc.GetBoolProperty(dynamicconfig.FrontendEnableReplicationTaskBatching),
+		EnableReplicationTaskCompression:            dc.GetBoolProperty(dynamicconfig.FrontendEnableReplicationTaskCompression),
+		ReplicationTaskMaxBatchSize:                 dc.GetIntProperty(dynamicconfig.FrontendReplicationTaskMaxBatchSize),
+		ReplicationStreamRequestTimeout:             dc.GetDurationProperty(dynamicconfig.FrontendReplicationStreamRequestTimeout),
 		EnableClientVersionCheck:                    dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck),
 		EnableQueryAttributeValidation:              dc.GetBoolProperty(dynamicconfig.EnableQueryAttributeValidation),
 		ValidSearchAttributes:                       dc.GetMapProperty(dynamicconfig.ValidSearchAttributes),
@@ -180,13 +205,17 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		EmitSignalNameMetricsTag:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEmitSignalNameMetricsTag),
 		Lockdown:                                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.Lockdown),
 		EnableTasklistIsolation:                     dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTasklistIsolation),
+		EnableEagerWorkflowStart:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEnableEagerWorkflowStart),
 		DomainConfig: domain.Config{
-			MaxBadBinaryCount:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxBadBinaries),
-			MinRetentionDays:       dc.GetIntProperty(dynamicconfig.MinRetentionDays),
-			MaxRetentionDays:       dc.GetIntProperty(dynamicconfig.MaxRetentionDays),
-			FailoverCoolDown:       dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendFailoverCoolDown),
-			RequiredDomainDataKeys: dc.GetMapProperty(dynamicconfig.RequiredDomainDataKeys),
-			FailoverHistoryMaxSize: dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendFailoverHistoryMaxSize),
+			MaxBadBinaryCount:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxBadBinaries),
+			MinRetentionDays:        dc.GetIntProperty(dynamicconfig.MinRetentionDays),
+			MaxRetentionDays:        dc.GetIntProperty(dynamicconfig.MaxRetentionDays),
+			FailoverCoolDown:        dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendFailoverCoolDown),
+			RequiredDomainDataKeys:  dc.GetMapProperty(dynamicconfig.RequiredDomainDataKeys),
+			FailoverHistoryMaxSize:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendFailoverHistoryMaxSize),
+			DeletionGracePeriod:     dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendDomainDeletionGracePeriod),
+			RenameAliasDuration:     dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendDomainRenameAliasDuration),
+			EnableRenameReplication: dc.GetBoolProperty(dynamicconfig.EnableDomainRenameReplication),
 		},
 		HostName: hostName,
 	}
@@ -102,16 +102,29 @@ func TestNewConfig(t *testing.T) {
 		"EmitSignalNameMetricsTag":                    {dynamicconfig.FrontendEmitSignalNameMetricsTag, true},
 		"Lockdown":                                    {dynamicconfig.Lockdown, false},
 		"EnableTasklistIsolation":                     {dynamicconfig.EnableTasklistIsolation, true},
+		"EnableEagerWorkflowStart":                    {dynamicconfig.FrontendEnableEagerWorkflowStart, true},
 		"GlobalRatelimiterKeyMode":                    {dynamicconfig.FrontendGlobalRatelimiterMode, "disabled"},
 		"GlobalRatelimiterUpdateInterval":             {dynamicconfig.GlobalRatelimiterUpdateInterval, 3 * time.Second},
+		"EnableLoadShedding":                          {dynamicconfig.FrontendEnableLoadShedding, true},
+		"LoadSheddingMaxInFlightRequests":             {dynamicconfig.FrontendLoadSheddingMaxInFlightRequests, 45},
+		"LoadSheddingLowTierThreshold":                {dynamicconfig.FrontendLoadSheddingLowTierThreshold, 0.4},
+		"LoadSheddingNormalTierThreshold":             {dynamicconfig.FrontendLoadSheddingNormalTierThreshold, 0.6},
+		"LoadSheddingHighTierThreshold":               {dynamicconfig.FrontendLoadSheddingHighTierThreshold, 0.8},
+		"EnableReplicationTaskBatching":               {dynamicconfig.FrontendEnableReplicationTaskBatching, true},
+		"EnableReplicationTaskCompression":            {dynamicconfig.FrontendEnableReplicationTaskCompression, true},
+		"ReplicationTaskMaxBatchSize":                 {dynamicconfig.FrontendReplicationTaskMaxBatchSize, 46},
+		"ReplicationStreamRequestTimeout":             {dynamicconfig.FrontendReplicationStreamRequestTimeout, time.Duration(47)},
 	}
 	domainFields := map[string]configTestCase{
-		"MaxBadBinaryCount":      {dynamicconfig.FrontendMaxBadBinaries, 40},
-		"MinRetentionDays":       {dynamicconfig.MinRetentionDays, 41},
-		"MaxRetentionDays":       {dynamicconfig.MaxRetentionDays, 42},
-		"FailoverCoolDown":       {dynamicconfig.FrontendFailoverCoolDown, time.Duration(43)},
-		"RequiredDomainDataKeys": {dynamicconfig.RequiredDomainDataKeys, map[string]interface{}{"bar": "baz"}},
-		"FailoverHistoryMaxSize": {dynamicconfig.FrontendFailoverHistoryMaxSize, 44},
+		"MaxBadBinaryCount":       {dynamicconfig.FrontendMaxBadBinaries, 40},
+		"MinRetentionDays":        {dynamicconfig.MinRetentionDays, 41},
+		"MaxRetentionDays":        {dynamicconfig.MaxRetentionDays, 42},
+		"FailoverCoolDown":        {dynamicconfig.FrontendFailoverCoolDown, time.Duration(43)},
+		"RequiredDomainDataKeys":  {dynamicconfig.RequiredDomainDataKeys, map[string]interface{}{"bar": "baz"}},
+		"FailoverHistoryMaxSize":  {dynamicconfig.FrontendFailoverHistoryMaxSize, 44},
+		"DeletionGracePeriod":     {dynamicconfig.FrontendDomainDeletionGracePeriod, time.Duration(45)},
+		"RenameAliasDuration":     {dynamicconfig.FrontendDomainRenameAliasDuration, time.Duration(46)},
+		"EnableRenameReplication": {dynamicconfig.EnableDomainRenameReplication, true},
 	}
 	client := dynamicconfig.NewInMemoryClient()
 	dc := dynamicconfig.NewCollection(client, testlogger.New(t))
@@ -167,6 +180,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn("domain")
 		case dynamicconfig.FloatPropertyFn:
 			return fn()
+		case dynamicconfig.FloatPropertyFnWithDomainFilter:
+			return fn("domain")
 		case dynamicconfig.MapPropertyFn:
 			return fn()
 		case dynamicconfig.StringPropertyFn:
@@ -29,6 +29,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -57,6 +58,7 @@ type Service struct {
 	status                 int32
 	handler                *api.WorkflowHandler
 	adminHandler           admin.Handler
+	auditor                audit.Auditor
 	stopC                  chan struct{}
 	config                 *config.Config
 	params                 *resource.Params
@@ -150,15 +152,28 @@ func (s *Service) Start() {
 	visibilityRateLimiter := quotas.NewMultiStageRateLimiter(quotas.NewDynamicRateLimiter(s.config.VisibilityRPS.AsFloat64()), collections.visibility)
 	asyncRateLimiter := quotas.NewMultiStageRateLimiter(quotas.NewDynamicRateLimiter(s.config.AsyncRPS.AsFloat64()), collections.async)
 
+	auditor, err := audit.NewAuditor(
+		s.params.AuditConfig,
+		s.GetLogger(),
+		s.GetMetricsClient(),
+		s.GetTimeSource(),
+		s.GetMessagingClient(),
+		s.GetPersistenceBean().GetAuditLogQueueManager(),
+	)
+	if err != nil {
+		logger.Fatal("constructing auditor", tag.Error(err))
+	}
+	s.auditor = auditor
+
 	// Additional decorations
 	var handler api.Handler = s.handler
 	handler = versioncheck.NewAPIHandler(handler, s.config, client.NewVersionChecker())
-	handler = ratelimited.NewAPIHandler(handler, s.GetDomainCache(), userRateLimiter, workerRateLimiter, visibilityRateLimiter, asyncRateLimiter)
+	handler = ratelimited.NewAPIHandler(handler, s.GetDomainCache(), userRateLimiter, workerRateLimiter, visibilityRateLimiter, asyncRateLimiter, ratelimited.NewLoadShedder(s.config, s.GetMetricsClient()))
 	handler = metered.NewAPIHandler(handler, s.GetLogger(), s.GetMetricsClient(), s.GetDomainCache(), s.config)
 	if s.params.ClusterRedirectionPolicy != nil {
 		handler = clusterredirection.NewAPIHandler(handler, s, s.config, *s.params.ClusterRedirectionPolicy)
 	}
-	handler = accesscontrolled.NewAPIHandler(handler, s, s.params.Authorizer, s.params.AuthorizationConfig)
+	handler = accesscontrolled.NewAPIHandler(handler, s, s.params.Authorizer, s.params.AuthorizationConfig, auditor)
 
 	// Register the latest (most decorated) handler
 	thriftHandler := thrift.NewAPIHandler(handler)
@@ -167,8 +182,8 @@ func (s *Service) Start() {
 	grpcHandler := grpc.NewAPIHandler(handler)
 	grpcHandler.Register(s.GetDispatcher())
 
-	s.adminHandler = admin.NewHandler(s, s.params, s.config, dh)
-	s.adminHandler = accesscontrolled.NewAdminHandler(s.adminHandler, s, s.params.Authorizer, s.params.AuthorizationConfig)
+	s.adminHandler = admin.NewHandler(s, s.params, s.config, dh, auditor)
+	s.adminHandler = accesscontrolled.NewAdminHandler(s.adminHandler, s, s.params.Authorizer, s.params.AuthorizationConfig, auditor)
 
 	adminThriftHandler := thrift.NewAdminHandler(s.adminHandler)
 	adminThriftHandler.Register(s.GetDispatcher())
@@ -196,6 +211,7 @@ func (s *Service) Start() {
 	cancel()
 	s.ratelimiterCollections = collections // save so they can be stopped later
 
+	s.auditor.Start()
 	s.handler.Start()
 	s.adminHandler.Start()
 
@@ -302,6 +318,7 @@ func (s *Service) Stop() {
 
 	s.handler.Stop()
 	s.adminHandler.Stop()
+	s.auditor.Stop()
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second) // should take nearly no time at all
 	defer cancel()
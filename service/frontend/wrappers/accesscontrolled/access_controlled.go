@@ -37,6 +37,7 @@ func (a *adminHandler) isAuthorized(ctx context.Context, attr *authorization.Att
 	if err != nil {
 		return false, err
 	}
+	attr.Actor = result.Actor
 	isAuth := result.Decision == authorization.DecisionAllow
 	return isAuth, nil
 }
@@ -54,6 +55,7 @@ func (a *apiHandler) isAuthorized(
 		scope.IncCounter(metrics.CadenceErrAuthorizeFailedCounter)
 		return false, err
 	}
+	attr.Actor = result.Actor
 	isAuth := result.Decision == authorization.DecisionAllow
 	if !isAuth {
 		scope.IncCounter(metrics.CadenceErrUnauthorizedCounter)
@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/metrics/mocks"
@@ -145,3 +146,68 @@ func TestDescribeCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestCloseShardAudit(t *testing.T) {
+	someErr := errors.New("some random err")
+	testCases := []struct {
+		name      string
+		mockSetup func(*authorization.MockAuthorizer, *admin.MockHandler, *audit.MockAuditor)
+		wantErr   error
+	}{
+		{
+			name: "Success case",
+			mockSetup: func(authorizer *authorization.MockAuthorizer, adminHandler *admin.MockHandler, auditor *audit.MockAuditor) {
+				authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(authorization.Result{Decision: authorization.DecisionAllow, Actor: "alice"}, nil)
+				adminHandler.EXPECT().CloseShard(gomock.Any(), gomock.Any()).Return(nil)
+				auditor.EXPECT().Record(gomock.Any(), gomock.Any(), nil).Do(func(_ context.Context, attr *authorization.Attributes, _ error) {
+					assert.Equal(t, "CloseShard", attr.APIName)
+					assert.Equal(t, "alice", attr.Actor)
+				})
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Error case - handler error",
+			mockSetup: func(authorizer *authorization.MockAuthorizer, adminHandler *admin.MockHandler, auditor *audit.MockAuditor) {
+				authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(authorization.Result{Decision: authorization.DecisionAllow}, nil)
+				adminHandler.EXPECT().CloseShard(gomock.Any(), gomock.Any()).Return(someErr)
+				auditor.EXPECT().Record(gomock.Any(), gomock.Any(), someErr)
+			},
+			wantErr: someErr,
+		},
+		{
+			name: "Error case - unauthorized",
+			mockSetup: func(authorizer *authorization.MockAuthorizer, adminHandler *admin.MockHandler, auditor *audit.MockAuditor) {
+				authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(authorization.Result{Decision: authorization.DecisionDeny}, nil)
+				auditor.EXPECT().Record(gomock.Any(), gomock.Any(), errUnauthorized)
+			},
+			wantErr: errUnauthorized,
+		},
+		{
+			name: "Error case - authorization error",
+			mockSetup: func(authorizer *authorization.MockAuthorizer, adminHandler *admin.MockHandler, auditor *audit.MockAuditor) {
+				authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(authorization.Result{}, someErr)
+			},
+			wantErr: someErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			mockAuthorizer := authorization.NewMockAuthorizer(controller)
+			mockAdminHandler := admin.NewMockHandler(controller)
+			mockAuditor := audit.NewMockAuditor(controller)
+			tc.mockSetup(mockAuthorizer, mockAdminHandler, mockAuditor)
+
+			handler := &adminHandler{authorizer: mockAuthorizer, handler: mockAdminHandler, auditor: mockAuditor}
+			err := handler.CloseShard(context.Background(), &types.CloseShardRequest{ShardID: 1})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
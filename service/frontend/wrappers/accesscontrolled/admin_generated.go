@@ -29,6 +29,7 @@ package accesscontrolled
 import (
 	"context"
 
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
@@ -37,18 +38,19 @@ import (
 	"github.com/uber/cadence/service/frontend/admin"
 )
 
-// adminHandler frontend handler wrapper for authentication and authorization
+// adminHandler frontend handler wrapper for authentication, authorization and auditing
 type adminHandler struct {
 	handler    admin.Handler
 	authorizer authorization.Authorizer
+	auditor    audit.Auditor
 	resource.Resource
 }
 
 // NewAdminHandler creates frontend handler with authentication support
-func NewAdminHandler(handler admin.Handler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) admin.Handler {
+func NewAdminHandler(handler admin.Handler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization, auditor audit.Auditor) admin.Handler {
 	if authorizer == nil {
 		var err error
-		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache())
+		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache(), resource.GetMetricsClient())
 		if err != nil {
 			resource.GetLogger().Fatal("Error when initiating the Authorizer", tag.Error(err))
 		}
@@ -56,6 +58,7 @@ func NewAdminHandler(handler admin.Handler, resource resource.Resource, authoriz
 	return &adminHandler{
 		handler:    handler,
 		authorizer: authorizer,
+		auditor:    auditor,
 		Resource:   resource,
 	}
 }
@@ -71,8 +74,10 @@ func (a *adminHandler) AddSearchAttribute(ctx context.Context, ap1 *types.AddSea
 		return err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.AddSearchAttribute(ctx, ap1)
 }
 
@@ -87,8 +92,10 @@ func (a *adminHandler) CloseShard(ctx context.Context, cp1 *types.CloseShardRequ
 		return err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.CloseShard(ctx, cp1)
 }
 
@@ -108,6 +115,26 @@ func (a *adminHandler) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQ
 	return a.handler.CountDLQMessages(ctx, cp1)
 }
 
+func (a *adminHandler) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest) (dp2 *types.DeleteDomainResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DeleteDomain",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	// domain APIs are authorized without a domain, record the one they act on
+	attr.DomainName = dp1.GetName()
+	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
+		return nil, errUnauthorized
+	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
+	return a.handler.DeleteDomain(ctx, dp1)
+}
+
 func (a *adminHandler) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "DeleteWorkflow",
@@ -119,8 +146,10 @@ func (a *adminHandler) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDelet
 		return nil, err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.DeleteWorkflow(ctx, ap1)
 }
 
@@ -155,6 +184,22 @@ func (a *adminHandler) DescribeHistoryHost(ctx context.Context, dp1 *types.Descr
 	return a.handler.DescribeHistoryHost(ctx, dp1)
 }
 
+func (a *adminHandler) DescribeHistoryTaskDLQMessage(ctx context.Context, dp1 *types.DescribeHistoryTaskDLQMessageRequest) (dp2 *types.DescribeHistoryTaskDLQMessageResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DescribeHistoryTaskDLQMessage",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeHistoryTaskDLQMessage(ctx, dp1)
+}
+
 func (a *adminHandler) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueueRequest) (dp2 *types.DescribeQueueResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "DescribeQueue",
@@ -187,6 +232,22 @@ func (a *adminHandler) DescribeShardDistribution(ctx context.Context, dp1 *types
 	return a.handler.DescribeShardDistribution(ctx, dp1)
 }
 
+func (a *adminHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DescribeTaskList",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeTaskList(ctx, dp1)
+}
+
 func (a *adminHandler) DescribeWorkflowExecution(ctx context.Context, ap1 *types.AdminDescribeWorkflowExecutionRequest) (ap2 *types.AdminDescribeWorkflowExecutionResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "DescribeWorkflowExecution",
@@ -203,6 +264,22 @@ func (a *adminHandler) DescribeWorkflowExecution(ctx context.Context, ap1 *types
 	return a.handler.DescribeWorkflowExecution(ctx, ap1)
 }
 
+func (a *adminHandler) DescribeWorkflowVersionHistories(ctx context.Context, dp1 *types.DescribeWorkflowVersionHistoriesRequest) (dp2 *types.DescribeWorkflowVersionHistoriesResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DescribeWorkflowVersionHistories",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeWorkflowVersionHistories(ctx, dp1)
+}
+
 func (a *adminHandler) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "GetCrossClusterTasks",
@@ -331,6 +408,22 @@ func (a *adminHandler) GetReplicationMessages(ctx context.Context, gp1 *types.Ge
 	return a.handler.GetReplicationMessages(ctx, gp1)
 }
 
+func (a *adminHandler) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest) (gp2 *types.GetReplicationStatusResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "GetReplicationStatus",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetReplicationStatus(ctx, gp1)
+}
+
 func (a *adminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1 *types.GetWorkflowExecutionRawHistoryV2Request) (gp2 *types.GetWorkflowExecutionRawHistoryV2Response, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "GetWorkflowExecutionRawHistoryV2",
@@ -347,6 +440,22 @@ func (a *adminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1
 	return a.handler.GetWorkflowExecutionRawHistoryV2(ctx, gp1)
 }
 
+func (a *adminHandler) ListAuditLogEntries(ctx context.Context, lp1 *types.ListAuditLogEntriesRequest) (lp2 *types.ListAuditLogEntriesResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ListAuditLogEntries",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListAuditLogEntries(ctx, lp1)
+}
+
 func (a *adminHandler) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest) (lp2 *types.ListDynamicConfigResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ListDynamicConfig",
@@ -374,8 +483,10 @@ func (a *adminHandler) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.A
 		return nil, err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.MaintainCorruptWorkflow(ctx, ap1)
 }
 
@@ -390,11 +501,31 @@ func (a *adminHandler) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQ
 		return nil, err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.MergeDLQMessages(ctx, mp1)
 }
 
+func (a *adminHandler) MergeHistoryTaskDLQMessages(ctx context.Context, mp1 *types.MergeHistoryTaskDLQMessagesRequest) (mp2 *types.MergeHistoryTaskDLQMessagesResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "MergeHistoryTaskDLQMessages",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(mp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
+		return nil, errUnauthorized
+	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
+	return a.handler.MergeHistoryTaskDLQMessages(ctx, mp1)
+}
+
 func (a *adminHandler) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "PurgeDLQMessages",
@@ -406,11 +537,31 @@ func (a *adminHandler) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQ
 		return err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.PurgeDLQMessages(ctx, pp1)
 }
 
+func (a *adminHandler) PurgeHistoryTaskDLQMessages(ctx context.Context, pp1 *types.PurgeHistoryTaskDLQMessagesRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "PurgeHistoryTaskDLQMessages",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(pp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
+		return errUnauthorized
+	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
+	return a.handler.PurgeHistoryTaskDLQMessages(ctx, pp1)
+}
+
 func (a *adminHandler) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ReadDLQMessages",
@@ -427,6 +578,22 @@ func (a *adminHandler) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMe
 	return a.handler.ReadDLQMessages(ctx, rp1)
 }
 
+func (a *adminHandler) ReadHistoryTaskDLQMessages(ctx context.Context, rp1 *types.ReadHistoryTaskDLQMessagesRequest) (rp2 *types.ReadHistoryTaskDLQMessagesResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ReadHistoryTaskDLQMessages",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ReadHistoryTaskDLQMessages(ctx, rp1)
+}
+
 func (a *adminHandler) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEventsRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ReapplyEvents",
@@ -443,6 +610,24 @@ func (a *adminHandler) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEven
 	return a.handler.ReapplyEvents(ctx, rp1)
 }
 
+func (a *adminHandler) RebuildWorkflowBranch(ctx context.Context, rp1 *types.RebuildWorkflowBranchRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "RebuildWorkflowBranch",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
+		return errUnauthorized
+	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
+	return a.handler.RebuildWorkflowBranch(ctx, rp1)
+}
+
 func (a *adminHandler) RefreshWorkflowTasks(ctx context.Context, rp1 *types.RefreshWorkflowTasksRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "RefreshWorkflowTasks",
@@ -454,8 +639,10 @@ func (a *adminHandler) RefreshWorkflowTasks(ctx context.Context, rp1 *types.Refr
 		return err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.RefreshWorkflowTasks(ctx, rp1)
 }
 
@@ -470,11 +657,33 @@ func (a *adminHandler) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRequ
 		return err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.RemoveTask(ctx, rp1)
 }
 
+func (a *adminHandler) RenameDomain(ctx context.Context, rp1 *types.RenameDomainRequest) (rp2 *types.RenameDomainResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "RenameDomain",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	// domain APIs are authorized without a domain, record the one they act on
+	attr.DomainName = rp1.GetName()
+	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
+		return nil, errUnauthorized
+	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
+	return a.handler.RenameDomain(ctx, rp1)
+}
+
 func (a *adminHandler) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ResendReplicationTasks",
@@ -502,8 +711,10 @@ func (a *adminHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequ
 		return err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.ResetQueue(ctx, rp1)
 }
 
@@ -534,8 +745,10 @@ func (a *adminHandler) RestoreDynamicConfig(ctx context.Context, rp1 *types.Rest
 		return err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.RestoreDynamicConfig(ctx, rp1)
 }
 
@@ -547,6 +760,24 @@ func (a *adminHandler) Stop() {
 	a.handler.Stop()
 }
 
+func (a *adminHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "UpdateActivityOptions",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
+		return nil, errUnauthorized
+	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
+	return a.handler.UpdateActivityOptions(ctx, up1)
+}
+
 func (a *adminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, up1 *types.UpdateDomainAsyncWorkflowConfiguratonRequest) (up2 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "UpdateDomainAsyncWorkflowConfiguraton",
@@ -558,8 +789,10 @@ func (a *adminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context
 		return nil, err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.UpdateDomainAsyncWorkflowConfiguraton(ctx, up1)
 }
 
@@ -574,8 +807,10 @@ func (a *adminHandler) UpdateDomainIsolationGroups(ctx context.Context, request
 		return nil, err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.UpdateDomainIsolationGroups(ctx, request)
 }
 
@@ -590,8 +825,10 @@ func (a *adminHandler) UpdateDynamicConfig(ctx context.Context, up1 *types.Updat
 		return err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.UpdateDynamicConfig(ctx, up1)
 }
 
@@ -606,8 +843,10 @@ func (a *adminHandler) UpdateGlobalIsolationGroups(ctx context.Context, request
 		return nil, err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.UpdateGlobalIsolationGroups(ctx, request)
 }
 
@@ -622,7 +861,9 @@ func (a *adminHandler) UpdateTaskListPartitionConfig(ctx context.Context, up1 *t
 		return nil, err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.UpdateTaskListPartitionConfig(ctx, up1)
 }
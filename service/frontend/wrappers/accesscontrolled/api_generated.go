@@ -29,6 +29,7 @@ package accesscontrolled
 import (
 	"context"
 
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
@@ -38,18 +39,19 @@ import (
 	"github.com/uber/cadence/service/frontend/api"
 )
 
-// apiHandler frontend handler wrapper for authentication and authorization
+// apiHandler frontend handler wrapper for authentication, authorization and auditing
 type apiHandler struct {
 	handler    api.Handler
 	authorizer authorization.Authorizer
+	auditor    audit.Auditor
 	resource.Resource
 }
 
 // NewAPIHandler creates frontend handler with authentication support
-func NewAPIHandler(handler api.Handler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) api.Handler {
+func NewAPIHandler(handler api.Handler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization, auditor audit.Auditor) api.Handler {
 	if authorizer == nil {
 		var err error
-		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache())
+		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache(), resource.GetMetricsClient())
 		if err != nil {
 			resource.GetLogger().Fatal("Error when initiating the Authorizer", tag.Error(err))
 		}
@@ -57,6 +59,7 @@ func NewAPIHandler(handler api.Handler, resource resource.Resource, authorizer a
 	return &apiHandler{
 		handler:    handler,
 		authorizer: authorizer,
+		auditor:    auditor,
 		Resource:   resource,
 	}
 }
@@ -90,9 +93,13 @@ func (a *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDo
 	if err != nil {
 		return err
 	}
+	// domain APIs are authorized without a domain, record the one they act on
+	attr.DomainName = dp1.GetName()
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.DeprecateDomain(ctx, dp1)
 }
 
@@ -402,9 +409,13 @@ func (a *apiHandler) RegisterDomain(ctx context.Context, rp1 *types.RegisterDoma
 	if err != nil {
 		return err
 	}
+	// domain APIs are authorized without a domain, record the one they act on
+	attr.DomainName = rp1.GetName()
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.RegisterDomain(ctx, rp1)
 }
 
@@ -457,8 +468,10 @@ func (a *apiHandler) ResetWorkflowExecution(ctx context.Context, rp1 *types.Rese
 		return nil, err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.ResetWorkflowExecution(ctx, rp1)
 }
 
@@ -603,8 +616,10 @@ func (a *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.Star
 		return nil, err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.StartWorkflowExecution(ctx, sp1)
 }
 
@@ -639,8 +654,10 @@ func (a *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 		return err
 	}
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.TerminateWorkflowExecution(ctx, tp1)
 }
 
@@ -655,8 +672,12 @@ func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRe
 	if err != nil {
 		return nil, err
 	}
+	// domain APIs are authorized without a domain, record the one they act on
+	attr.DomainName = up1.GetName()
 	if !isAuthorized {
+		a.auditor.Record(ctx, attr, errUnauthorized)
 		return nil, errUnauthorized
 	}
+	defer func() { a.auditor.Record(ctx, attr, err) }()
 	return a.handler.UpdateDomain(ctx, up1)
 }
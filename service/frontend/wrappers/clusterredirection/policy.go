@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	frontendcfg "github.com/uber/cadence/service/frontend/config"
 )
@@ -132,6 +133,15 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	"RespondActivityTaskFailedByID":    {},
 }
 
+// deletedDomainAllowedAPIs contains the APIs the domain deletion workflow calls to purge the executions
+// of a deleted domain, every other API is rejected for deprecated and deleted domains.
+var deletedDomainAllowedAPIs = map[string]struct{}{
+	"ListOpenWorkflowExecutions":   {},
+	"ListClosedWorkflowExecutions": {},
+	"GetWorkflowExecutionHistory":  {},
+	"TerminateWorkflowExecution":   {},
+}
+
 // RedirectionPolicyGenerator generate corresponding redirection policy
 func RedirectionPolicyGenerator(clusterMetadata cluster.Metadata, config *frontendcfg.Config,
 	domainCache cache.DomainCache, policy config.ClusterRedirectionPolicy) ClusterRedirectionPolicy {
@@ -194,7 +204,7 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) WithDomainIDRedirect
 	if err != nil {
 		return err
 	}
-	if domainEntry.IsDeprecatedOrDeleted() {
+	if isDomainRejected(domainEntry, apiName) {
 		return &types.DomainNotActiveError{
 			Message:        "domain is deprecated.",
 			DomainName:     domainEntry.GetInfo().Name,
@@ -211,7 +221,7 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) WithDomainNameRedire
 	if err != nil {
 		return err
 	}
-	if domainEntry.IsDeprecatedOrDeleted() {
+	if isDomainRejected(domainEntry, apiName) {
 		return &types.DomainNotActiveError{
 			Message:        "domain is deprecated or deleted",
 			DomainName:     domainName,
@@ -222,6 +232,17 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) WithDomainNameRedire
 	return policy.withRedirect(ctx, domainEntry, apiName, call)
 }
 
+func isDomainRejected(domainEntry *cache.DomainCacheEntry, apiName string) bool {
+	if !domainEntry.IsDeprecatedOrDeleted() {
+		return false
+	}
+	if domainEntry.GetInfo().Status == persistence.DomainStatusDeleted {
+		_, ok := deletedDomainAllowedAPIs[apiName]
+		return !ok
+	}
+	return true
+}
+
 func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) withRedirect(ctx context.Context, domainEntry *cache.DomainCacheEntry, apiName string, call func(string) error) error {
 	targetDC, enableDomainNotActiveForwarding := policy.getTargetClusterAndIsDomainNotActiveAutoForwarding(ctx, domainEntry, apiName)
 
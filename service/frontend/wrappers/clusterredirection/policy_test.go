@@ -409,6 +409,60 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_G
 	s.Equal(0, alternativeClustercallCount)
 }
 
+func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_GlobalDomain_Forwarding_DeletedDomain() {
+	s.setupGlobalDomainWithStatus(persistence.DomainStatusDeleted)
+
+	callCount := 0
+	callFn := func(targetCluster string) error {
+		callCount++
+		return nil
+	}
+
+	// the domain deletion workflow purges the executions of a deleted domain through these APIs
+	for apiName := range deletedDomainAllowedAPIs {
+		s.NoError(s.policy.WithDomainIDRedirect(context.Background(), s.domainID, apiName, callFn))
+		s.NoError(s.policy.WithDomainNameRedirect(context.Background(), s.domainName, apiName, callFn))
+	}
+	s.Equal(2*len(deletedDomainAllowedAPIs), callCount)
+
+	for _, apiName := range []string{"StartWorkflowExecution", "SignalWorkflowExecution", "DescribeWorkflowExecution"} {
+		err := s.policy.WithDomainIDRedirect(context.Background(), s.domainID, apiName, callFn)
+		s.IsType(&types.DomainNotActiveError{}, err)
+
+		err = s.policy.WithDomainNameRedirect(context.Background(), s.domainName, apiName, callFn)
+		s.IsType(&types.DomainNotActiveError{}, err)
+	}
+	s.Equal(2*len(deletedDomainAllowedAPIs), callCount)
+}
+
+func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_GlobalDomain_Forwarding_DeprecatedDomainPurgeAPIs() {
+	s.setupGlobalDomainWithStatus(persistence.DomainStatusDeprecated)
+
+	for apiName := range deletedDomainAllowedAPIs {
+		err := s.policy.WithDomainIDRedirect(context.Background(), s.domainID, apiName, func(string) error { return nil })
+		s.IsType(&types.DomainNotActiveError{}, err)
+	}
+}
+
+func (s *selectedAPIsForwardingRedirectionPolicySuite) setupGlobalDomainWithStatus(status int) {
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName, Status: status},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: s.currentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1234, // not used
+	)
+
+	s.mockDomainCache.EXPECT().GetDomainByID(s.domainID).Return(domainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).AnyTimes()
+	s.mockConfig.EnableDomainNotActiveAutoForwarding = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+}
+
 func (s *selectedAPIsForwardingRedirectionPolicySuite) setupLocalDomain() {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
@@ -31,6 +31,7 @@ import (
 
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
 
+	adminextv1 "github.com/uber/cadence/.gen/proto/adminext/v1"
 	"github.com/uber/cadence/common/types/mapper/proto"
 	"github.com/uber/cadence/service/frontend/admin"
 )
@@ -58,6 +59,11 @@ func (g AdminHandler) CountDLQMessages(ctx context.Context, request *adminv1.Cou
 	return proto.FromAdminCountDLQMessagesResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) DeleteDomain(ctx context.Context, request *adminextv1.DeleteDomainRequest) (*adminextv1.DeleteDomainResponse, error) {
+	response, err := g.h.DeleteDomain(ctx, proto.ToAdminDeleteDomainRequest(request))
+	return proto.FromAdminDeleteDomainResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) DeleteWorkflow(ctx context.Context, request *adminv1.DeleteWorkflowRequest) (*adminv1.DeleteWorkflowResponse, error) {
 	response, err := g.h.DeleteWorkflow(ctx, proto.ToAdminDeleteWorkflowRequest(request))
 	return proto.FromAdminDeleteWorkflowResponse(response), proto.FromError(err)
@@ -73,6 +79,11 @@ func (g AdminHandler) DescribeHistoryHost(ctx context.Context, request *adminv1.
 	return proto.FromAdminDescribeHistoryHostResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) DescribeHistoryTaskDLQMessage(ctx context.Context, request *adminextv1.DescribeHistoryTaskDLQMessageRequest) (*adminextv1.DescribeHistoryTaskDLQMessageResponse, error) {
+	response, err := g.h.DescribeHistoryTaskDLQMessage(ctx, proto.ToAdminDescribeHistoryTaskDLQMessageRequest(request))
+	return proto.FromAdminDescribeHistoryTaskDLQMessageResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) DescribeQueue(ctx context.Context, request *adminv1.DescribeQueueRequest) (*adminv1.DescribeQueueResponse, error) {
 	response, err := g.h.DescribeQueue(ctx, proto.ToAdminDescribeQueueRequest(request))
 	return proto.FromAdminDescribeQueueResponse(response), proto.FromError(err)
@@ -83,11 +94,21 @@ func (g AdminHandler) DescribeShardDistribution(ctx context.Context, request *ad
 	return proto.FromAdminDescribeShardDistributionResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) DescribeTaskList(ctx context.Context, request *adminextv1.DescribeTaskListRequest) (*adminextv1.DescribeTaskListResponse, error) {
+	response, err := g.h.DescribeTaskList(ctx, proto.ToAdminDescribeTaskListRequest(request))
+	return proto.FromAdminDescribeTaskListResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) DescribeWorkflowExecution(ctx context.Context, request *adminv1.DescribeWorkflowExecutionRequest) (*adminv1.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.h.DescribeWorkflowExecution(ctx, proto.ToAdminDescribeWorkflowExecutionRequest(request))
 	return proto.FromAdminDescribeWorkflowExecutionResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) DescribeWorkflowVersionHistories(ctx context.Context, request *adminextv1.DescribeWorkflowVersionHistoriesRequest) (*adminextv1.DescribeWorkflowVersionHistoriesResponse, error) {
+	response, err := g.h.DescribeWorkflowVersionHistories(ctx, proto.ToAdminDescribeWorkflowVersionHistoriesRequest(request))
+	return proto.FromAdminDescribeWorkflowVersionHistoriesResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) GetCrossClusterTasks(ctx context.Context, request *adminv1.GetCrossClusterTasksRequest) (*adminv1.GetCrossClusterTasksResponse, error) {
 	response, err := g.h.GetCrossClusterTasks(ctx, proto.ToAdminGetCrossClusterTasksRequest(request))
 	return proto.FromAdminGetCrossClusterTasksResponse(response), proto.FromError(err)
@@ -128,11 +149,21 @@ func (g AdminHandler) GetReplicationMessages(ctx context.Context, request *admin
 	return proto.FromAdminGetReplicationMessagesResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) GetReplicationStatus(ctx context.Context, request *adminextv1.GetReplicationStatusRequest) (*adminextv1.GetReplicationStatusResponse, error) {
+	response, err := g.h.GetReplicationStatus(ctx, proto.ToAdminGetReplicationStatusRequest(request))
+	return proto.FromAdminGetReplicationStatusResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *adminv1.GetWorkflowExecutionRawHistoryV2Request) (*adminv1.GetWorkflowExecutionRawHistoryV2Response, error) {
 	response, err := g.h.GetWorkflowExecutionRawHistoryV2(ctx, proto.ToAdminGetWorkflowExecutionRawHistoryV2Request(request))
 	return proto.FromAdminGetWorkflowExecutionRawHistoryV2Response(response), proto.FromError(err)
 }
 
+func (g AdminHandler) ListAuditLogEntries(ctx context.Context, request *adminextv1.ListAuditLogEntriesRequest) (*adminextv1.ListAuditLogEntriesResponse, error) {
+	response, err := g.h.ListAuditLogEntries(ctx, proto.ToAdminListAuditLogEntriesRequest(request))
+	return proto.FromAdminListAuditLogEntriesResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) ListDynamicConfig(ctx context.Context, request *adminv1.ListDynamicConfigRequest) (*adminv1.ListDynamicConfigResponse, error) {
 	response, err := g.h.ListDynamicConfig(ctx, proto.ToAdminListDynamicConfigRequest(request))
 	return proto.FromAdminListDynamicConfigResponse(response), proto.FromError(err)
@@ -148,21 +179,41 @@ func (g AdminHandler) MergeDLQMessages(ctx context.Context, request *adminv1.Mer
 	return proto.FromAdminMergeDLQMessagesResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) MergeHistoryTaskDLQMessages(ctx context.Context, request *adminextv1.MergeHistoryTaskDLQMessagesRequest) (*adminextv1.MergeHistoryTaskDLQMessagesResponse, error) {
+	response, err := g.h.MergeHistoryTaskDLQMessages(ctx, proto.ToAdminMergeHistoryTaskDLQMessagesRequest(request))
+	return proto.FromAdminMergeHistoryTaskDLQMessagesResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) PurgeDLQMessages(ctx context.Context, request *adminv1.PurgeDLQMessagesRequest) (*adminv1.PurgeDLQMessagesResponse, error) {
 	err := g.h.PurgeDLQMessages(ctx, proto.ToAdminPurgeDLQMessagesRequest(request))
 	return &adminv1.PurgeDLQMessagesResponse{}, proto.FromError(err)
 }
 
+func (g AdminHandler) PurgeHistoryTaskDLQMessages(ctx context.Context, request *adminextv1.PurgeHistoryTaskDLQMessagesRequest) (*adminextv1.PurgeHistoryTaskDLQMessagesResponse, error) {
+	err := g.h.PurgeHistoryTaskDLQMessages(ctx, proto.ToAdminPurgeHistoryTaskDLQMessagesRequest(request))
+	return &adminextv1.PurgeHistoryTaskDLQMessagesResponse{}, proto.FromError(err)
+}
+
 func (g AdminHandler) ReadDLQMessages(ctx context.Context, request *adminv1.ReadDLQMessagesRequest) (*adminv1.ReadDLQMessagesResponse, error) {
 	response, err := g.h.ReadDLQMessages(ctx, proto.ToAdminReadDLQMessagesRequest(request))
 	return proto.FromAdminReadDLQMessagesResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) ReadHistoryTaskDLQMessages(ctx context.Context, request *adminextv1.ReadHistoryTaskDLQMessagesRequest) (*adminextv1.ReadHistoryTaskDLQMessagesResponse, error) {
+	response, err := g.h.ReadHistoryTaskDLQMessages(ctx, proto.ToAdminReadHistoryTaskDLQMessagesRequest(request))
+	return proto.FromAdminReadHistoryTaskDLQMessagesResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) ReapplyEvents(ctx context.Context, request *adminv1.ReapplyEventsRequest) (*adminv1.ReapplyEventsResponse, error) {
 	err := g.h.ReapplyEvents(ctx, proto.ToAdminReapplyEventsRequest(request))
 	return &adminv1.ReapplyEventsResponse{}, proto.FromError(err)
 }
 
+func (g AdminHandler) RebuildWorkflowBranch(ctx context.Context, request *adminextv1.RebuildWorkflowBranchRequest) (*adminextv1.RebuildWorkflowBranchResponse, error) {
+	err := g.h.RebuildWorkflowBranch(ctx, proto.ToAdminRebuildWorkflowBranchRequest(request))
+	return &adminextv1.RebuildWorkflowBranchResponse{}, proto.FromError(err)
+}
+
 func (g AdminHandler) RefreshWorkflowTasks(ctx context.Context, request *adminv1.RefreshWorkflowTasksRequest) (*adminv1.RefreshWorkflowTasksResponse, error) {
 	err := g.h.RefreshWorkflowTasks(ctx, proto.ToAdminRefreshWorkflowTasksRequest(request))
 	return &adminv1.RefreshWorkflowTasksResponse{}, proto.FromError(err)
@@ -173,6 +224,11 @@ func (g AdminHandler) RemoveTask(ctx context.Context, request *adminv1.RemoveTas
 	return &adminv1.RemoveTaskResponse{}, proto.FromError(err)
 }
 
+func (g AdminHandler) RenameDomain(ctx context.Context, request *adminextv1.RenameDomainRequest) (*adminextv1.RenameDomainResponse, error) {
+	response, err := g.h.RenameDomain(ctx, proto.ToAdminRenameDomainRequest(request))
+	return proto.FromAdminRenameDomainResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) ResendReplicationTasks(ctx context.Context, request *adminv1.ResendReplicationTasksRequest) (*adminv1.ResendReplicationTasksResponse, error) {
 	err := g.h.ResendReplicationTasks(ctx, proto.ToAdminResendReplicationTasksRequest(request))
 	return &adminv1.ResendReplicationTasksResponse{}, proto.FromError(err)
@@ -193,6 +249,11 @@ func (g AdminHandler) RestoreDynamicConfig(ctx context.Context, request *adminv1
 	return &adminv1.RestoreDynamicConfigResponse{}, proto.FromError(err)
 }
 
+func (g AdminHandler) UpdateActivityOptions(ctx context.Context, request *adminextv1.UpdateActivityOptionsRequest) (*adminextv1.UpdateActivityOptionsResponse, error) {
+	response, err := g.h.UpdateActivityOptions(ctx, proto.ToAdminUpdateActivityOptionsRequest(request))
+	return proto.FromAdminUpdateActivityOptionsResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *adminv1.UpdateDomainAsyncWorkflowConfiguratonRequest) (*adminv1.UpdateDomainAsyncWorkflowConfiguratonResponse, error) {
 	response, err := g.h.UpdateDomainAsyncWorkflowConfiguraton(ctx, proto.ToAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request))
 	return proto.FromAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), proto.FromError(err)
@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grpc
+
+import (
+	"context"
+	"io"
+
+	replicationv1 "github.com/uber/cadence/.gen/proto/replication/v1"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
+)
+
+type replicationStreamResult struct {
+	response *types.GetReplicationMessagesResponse
+	err      error
+}
+
+// StreamReplicationMessages serves replication messages to a remote cluster over a stream.
+// Every request carries the acknowledged read levels of all remote shards waiting for tasks and is
+// answered as soon as new replication tasks are written to any of them. A newer request supersedes
+// the one being served, so shards joining the stream do not wait for the current long poll to expire.
+func (g AdminHandler) StreamReplicationMessages(stream replicationv1.ReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) error {
+	requests := make(chan *types.GetReplicationMessagesRequest, 1)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			getRequest := proto.ToAdminGetReplicationMessagesRequest(request.GetRequest())
+			if getRequest == nil {
+				getRequest = &types.GetReplicationMessagesRequest{}
+			}
+			getRequest.WaitForNewTasks = true
+			// drop the request not picked up yet, the newer one carries all shards waiting for tasks
+			select {
+			case <-requests:
+			default:
+			}
+			requests <- getRequest
+		}
+	}()
+
+	var request *types.GetReplicationMessagesRequest
+	for {
+		if request == nil {
+			select {
+			case request = <-requests:
+			case err := <-recvErr:
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+		}
+
+		ctx, cancel := context.WithCancel(stream.Context())
+		results := make(chan replicationStreamResult, 1)
+		go func() {
+			response, err := g.h.GetReplicationMessages(ctx, request)
+			results <- replicationStreamResult{response: response, err: err}
+		}()
+
+		select {
+		case result := <-results:
+			cancel()
+			request = nil
+			if result.err != nil {
+				return proto.FromError(result.err)
+			}
+			err := stream.Send(&replicationv1.StreamReplicationMessagesResponse{
+				Response: proto.FromAdminGetReplicationMessagesResponse(result.response),
+			})
+			if err != nil {
+				return err
+			}
+		case next := <-requests:
+			cancel()
+			<-results
+			request = next
+		case err := <-recvErr:
+			cancel()
+			<-results
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
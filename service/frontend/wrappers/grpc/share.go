@@ -29,16 +29,29 @@ import (
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	"go.uber.org/yarpc"
 
+	adminextv1 "github.com/uber/cadence/.gen/proto/adminext/v1"
+	apiextv1 "github.com/uber/cadence/.gen/proto/apiext/v1"
+	replicationv1 "github.com/uber/cadence/.gen/proto/replication/v1"
 	"github.com/uber/cadence/common/types/mapper/proto"
+	"github.com/uber/cadence/service/frontend/api"
 )
 
+// workflowExtHandler serves the WorkflowExtAPI, its methods share their names with the WorkflowAPI
+// ones so they can't be served by APIHandler itself
+type workflowExtHandler struct {
+	h api.Handler
+}
+
 func (g AdminHandler) Register(dispatcher *yarpc.Dispatcher) {
 	dispatcher.Register(adminv1.BuildAdminAPIYARPCProcedures(g))
+	dispatcher.Register(adminextv1.BuildAdminExtAPIYARPCProcedures(g))
+	dispatcher.Register(replicationv1.BuildReplicationStreamAPIYARPCProcedures(g))
 }
 
 func (g APIHandler) Register(dispatcher *yarpc.Dispatcher) {
 	dispatcher.Register(apiv1.BuildDomainAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildWorkflowAPIYARPCProcedures(g))
+	dispatcher.Register(apiextv1.BuildWorkflowExtAPIYARPCProcedures(workflowExtHandler{g.h}))
 	dispatcher.Register(apiv1.BuildWorkerAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildVisibilityAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildMetaAPIYARPCProcedures(g))
@@ -48,3 +61,8 @@ func (g APIHandler) Health(ctx context.Context, request *apiv1.HealthRequest) (*
 	response, err := g.h.Health(ctx)
 	return proto.FromHealthResponse(response), proto.FromError(err)
 }
+
+func (g workflowExtHandler) StartWorkflowExecution(ctx context.Context, request *apiextv1.StartWorkflowExecutionRequest) (*apiextv1.StartWorkflowExecutionResponse, error) {
+	response, err := g.h.StartWorkflowExecution(ctx, proto.ToWorkflowExtStartWorkflowExecutionRequest(request))
+	return proto.FromWorkflowExtStartWorkflowExecutionResponse(response), proto.FromError(err)
+}
@@ -65,7 +65,7 @@ func (h *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.Cou
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("CountWorkflowExecutions")}
 	tags = append(tags, toCountWorkflowExecutionsRequestTags(cp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendCountWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(cp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendCountWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(cp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -111,7 +111,7 @@ func (h *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTa
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeTaskList")}
 	tags = append(tags, toDescribeTaskListRequestTags(dp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendDescribeTaskListScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(dp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendDescribeTaskListScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(dp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -127,7 +127,7 @@ func (h *apiHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.D
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeWorkflowExecution")}
 	tags = append(tags, toDescribeWorkflowExecutionRequestTags(dp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendDescribeWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(dp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendDescribeWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(dp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -143,7 +143,7 @@ func (h *apiHandler) DiagnoseWorkflowExecution(ctx context.Context, dp1 *types.D
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DiagnoseWorkflowExecution")}
 	tags = append(tags, toDiagnoseWorkflowExecutionRequestTags(dp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendDiagnoseWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(dp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendDiagnoseWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(dp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -189,7 +189,7 @@ func (h *apiHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTas
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("GetTaskListsByDomain")}
 	tags = append(tags, toGetTaskListsByDomainRequestTags(gp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendGetTaskListsByDomainScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetTaskListsByDomainScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(gp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -205,7 +205,7 @@ func (h *apiHandler) GetWorkflowExecutionHistory(ctx context.Context, gp1 *types
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("GetWorkflowExecutionHistory")}
 	tags = append(tags, toGetWorkflowExecutionHistoryRequestTags(gp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendGetWorkflowExecutionHistoryScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetWorkflowExecutionHistoryScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(gp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -224,7 +224,7 @@ func (h *apiHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *ty
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListArchivedWorkflowExecutions")}
 	tags = append(tags, toListArchivedWorkflowExecutionsRequestTags(lp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendListArchivedWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendListArchivedWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(lp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -240,7 +240,7 @@ func (h *apiHandler) ListClosedWorkflowExecutions(ctx context.Context, lp1 *type
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListClosedWorkflowExecutions")}
 	tags = append(tags, toListClosedWorkflowExecutionsRequestTags(lp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendListClosedWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendListClosedWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(lp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -271,7 +271,7 @@ func (h *apiHandler) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListOpenWorkflowExecutions")}
 	tags = append(tags, toListOpenWorkflowExecutionsRequestTags(lp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendListOpenWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendListOpenWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(lp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -287,7 +287,7 @@ func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.List
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListTaskListPartitions")}
 	tags = append(tags, toListTaskListPartitionsRequestTags(lp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendListTaskListPartitionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendListTaskListPartitionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(lp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -303,7 +303,7 @@ func (h *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListWorkflowExecutions")}
 	tags = append(tags, toListWorkflowExecutionsRequestTags(lp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendListWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendListWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(lp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -323,7 +323,7 @@ func (h *apiHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollFor
 	scope.IncCounter(metrics.CadenceRequestsPerTaskList)
 	sw := scope.StartTimer(metrics.CadenceLatencyPerTaskList)
 	defer sw.Stop()
-	swPerDomain := h.metricsClient.Scope(metrics.FrontendPollForActivityTaskScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(pp1.GetDomain()))...).StartTimer(metrics.CadenceLatency)
+	swPerDomain := h.metricsClient.Scope(metrics.FrontendPollForActivityTaskScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(pp1.GetDomain())))...).StartTimer(metrics.CadenceLatency)
 	defer swPerDomain.Stop()
 	logger := h.logger.WithTags(tags...)
 
@@ -341,7 +341,7 @@ func (h *apiHandler) PollForDecisionTask(ctx context.Context, pp1 *types.PollFor
 	scope.IncCounter(metrics.CadenceRequestsPerTaskList)
 	sw := scope.StartTimer(metrics.CadenceLatencyPerTaskList)
 	defer sw.Stop()
-	swPerDomain := h.metricsClient.Scope(metrics.FrontendPollForDecisionTaskScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(pp1.GetDomain()))...).StartTimer(metrics.CadenceLatency)
+	swPerDomain := h.metricsClient.Scope(metrics.FrontendPollForDecisionTaskScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(pp1.GetDomain())))...).StartTimer(metrics.CadenceLatency)
 	defer swPerDomain.Stop()
 	logger := h.logger.WithTags(tags...)
 
@@ -355,7 +355,7 @@ func (h *apiHandler) QueryWorkflow(ctx context.Context, qp1 *types.QueryWorkflow
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("QueryWorkflow")}
 	tags = append(tags, toQueryWorkflowRequestTags(qp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendQueryWorkflowScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(qp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendQueryWorkflowScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(qp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -395,7 +395,7 @@ func (h *apiHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, rp1 *t
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("RecordActivityTaskHeartbeatByID")}
 	tags = append(tags, toRecordActivityTaskHeartbeatByIDRequestTags(rp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendRecordActivityTaskHeartbeatByIDScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendRecordActivityTaskHeartbeatByIDScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(rp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -411,7 +411,7 @@ func (h *apiHandler) RefreshWorkflowTasks(ctx context.Context, rp1 *types.Refres
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("RefreshWorkflowTasks")}
 	tags = append(tags, toRefreshWorkflowTasksRequestTags(rp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendRefreshWorkflowTasksScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendRefreshWorkflowTasksScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(rp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -442,7 +442,7 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("RequestCancelWorkflowExecution")}
 	tags = append(tags, toRequestCancelWorkflowExecutionRequestTags(rp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendRequestCancelWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendRequestCancelWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(rp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -458,7 +458,7 @@ func (h *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetSt
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ResetStickyTaskList")}
 	tags = append(tags, toResetStickyTaskListRequestTags(rp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendResetStickyTaskListScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendResetStickyTaskListScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(rp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -474,7 +474,7 @@ func (h *apiHandler) ResetWorkflowExecution(ctx context.Context, rp1 *types.Rese
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ResetWorkflowExecution")}
 	tags = append(tags, toResetWorkflowExecutionRequestTags(rp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendResetWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendResetWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(rp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -514,7 +514,7 @@ func (h *apiHandler) RespondActivityTaskCanceledByID(ctx context.Context, rp1 *t
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("RespondActivityTaskCanceledByID")}
 	tags = append(tags, toRespondActivityTaskCanceledByIDRequestTags(rp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendRespondActivityTaskCanceledByIDScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendRespondActivityTaskCanceledByIDScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(rp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -554,7 +554,7 @@ func (h *apiHandler) RespondActivityTaskCompletedByID(ctx context.Context, rp1 *
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("RespondActivityTaskCompletedByID")}
 	tags = append(tags, toRespondActivityTaskCompletedByIDRequestTags(rp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendRespondActivityTaskCompletedByIDScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendRespondActivityTaskCompletedByIDScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(rp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -594,7 +594,7 @@ func (h *apiHandler) RespondActivityTaskFailedByID(ctx context.Context, rp1 *typ
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("RespondActivityTaskFailedByID")}
 	tags = append(tags, toRespondActivityTaskFailedByIDRequestTags(rp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendRespondActivityTaskFailedByIDScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendRespondActivityTaskFailedByIDScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(rp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -682,7 +682,7 @@ func (h *apiHandler) RestartWorkflowExecution(ctx context.Context, rp1 *types.Re
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("RestartWorkflowExecution")}
 	tags = append(tags, toRestartWorkflowExecutionRequestTags(rp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendRestartWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendRestartWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(rp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -698,7 +698,7 @@ func (h *apiHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.List
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ScanWorkflowExecutions")}
 	tags = append(tags, toScanWorkflowExecutionsRequestTags(lp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendScanWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendScanWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(lp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -714,7 +714,7 @@ func (h *apiHandler) SignalWithStartWorkflowExecution(ctx context.Context, sp1 *
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("SignalWithStartWorkflowExecution")}
 	tags = append(tags, toSignalWithStartWorkflowExecutionRequestTags(sp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendSignalWithStartWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(sp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendSignalWithStartWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(sp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -730,7 +730,7 @@ func (h *apiHandler) SignalWithStartWorkflowExecutionAsync(ctx context.Context,
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("SignalWithStartWorkflowExecutionAsync")}
 	tags = append(tags, toSignalWithStartWorkflowExecutionAsyncRequestTags(sp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendSignalWithStartWorkflowExecutionAsyncScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(sp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendSignalWithStartWorkflowExecutionAsyncScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(sp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -747,7 +747,7 @@ func (h *apiHandler) SignalWorkflowExecution(ctx context.Context, sp1 *types.Sig
 	ctx = h.withSignalName(ctx, sp1.GetDomain(), sp1.GetSignalName())
 	tags := []tag.Tag{tag.WorkflowHandlerName("SignalWorkflowExecution")}
 	tags = append(tags, toSignalWorkflowExecutionRequestTags(sp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendSignalWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(sp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendSignalWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(sp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -763,7 +763,7 @@ func (h *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.Star
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("StartWorkflowExecution")}
 	tags = append(tags, toStartWorkflowExecutionRequestTags(sp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendStartWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(sp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendStartWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(sp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -779,7 +779,7 @@ func (h *apiHandler) StartWorkflowExecutionAsync(ctx context.Context, sp1 *types
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("StartWorkflowExecutionAsync")}
 	tags = append(tags, toStartWorkflowExecutionAsyncRequestTags(sp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendStartWorkflowExecutionAsyncScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(sp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendStartWorkflowExecutionAsyncScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(sp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
@@ -795,7 +795,7 @@ func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("TerminateWorkflowExecution")}
 	tags = append(tags, toTerminateWorkflowExecutionRequestTags(tp1)...)
-	scope := h.metricsClient.Scope(metrics.FrontendTerminateWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(tp1.GetDomain()))...)
+	scope := h.metricsClient.Scope(metrics.FrontendTerminateWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(h.currentDomainName(tp1.GetDomain())))...)
 	scope.IncCounter(metrics.CadenceRequests)
 	sw := scope.StartTimer(metrics.CadenceLatency)
 	defer sw.Stop()
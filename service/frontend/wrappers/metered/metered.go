@@ -102,6 +102,19 @@ func (h *apiHandler) withSignalName(
 	return ctx
 }
 
+// currentDomainName resolves the name a request was made with to the current name of the domain,
+// so that requests made with the previous name of a renamed domain are tagged with its new name.
+func (h *apiHandler) currentDomainName(domainName string) string {
+	if domainName == "" || h.domainCache == nil {
+		return domainName
+	}
+	entry, err := h.domainCache.GetDomain(domainName)
+	if err != nil || entry.GetInfo() == nil {
+		return domainName
+	}
+	return entry.GetInfo().Name
+}
+
 func frontendInternalServiceError(fmtStr string, args ...interface{}) error {
 	// NOTE: For internal error, we can't return thrift error from cadence-frontend.
 	// Because in uber internal metrics, thrift errors are counted as user errors.
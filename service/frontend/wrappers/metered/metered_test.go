@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/frontend/api"
 	"github.com/uber/cadence/service/frontend/config"
@@ -65,6 +66,33 @@ func TestContextMetricsTags(t *testing.T) {
 	assert.Fail(t, "counter not found")
 }
 
+func TestRenamedDomainMetricsTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockHandler := api.NewMockHandler(ctrl)
+	mockHandler.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{}, nil).Times(1)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockDomainCache.EXPECT().GetDomain("old-name").Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-id", Name: "new-name"},
+		&persistence.DomainConfig{},
+		"active",
+	), nil).Times(1)
+	testScope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(testScope, metrics.Frontend)
+	handler := NewAPIHandler(mockHandler, testlogger.New(t), metricsClient, mockDomainCache, nil)
+
+	_, err := handler.CountWorkflowExecutions(context.Background(), &types.CountWorkflowExecutionsRequest{Domain: "old-name"})
+	assert.NoError(t, err)
+
+	snapshot := testScope.Snapshot()
+	for _, counter := range snapshot.Counters() {
+		if counter.Name() == "test.cadence_requests" {
+			assert.Equal(t, "new-name", counter.Tags()["domain"])
+			return
+		}
+	}
+	assert.Fail(t, "counter not found")
+}
+
 func TestSignalMetricHasSignalName(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockHandler := api.NewMockHandler(ctrl)
@@ -46,6 +46,7 @@ type apiHandler struct {
 	workerRateLimiter     quotas.Policy
 	visibilityRateLimiter quotas.Policy
 	asyncRateLimiter      quotas.Policy
+	loadShedder           *LoadShedder
 }
 
 // NewAPIHandler creates a new instance of Handler with ratelimiter.
@@ -56,6 +57,7 @@ func NewAPIHandler(
 	workerRateLimiter quotas.Policy,
 	visibilityRateLimiter quotas.Policy,
 	asyncRateLimiter quotas.Policy,
+	loadShedder *LoadShedder,
 ) api.Handler {
 	return &apiHandler{
 		wrapped:               wrapped,
@@ -65,6 +67,7 @@ func NewAPIHandler(
 		workerRateLimiter:     workerRateLimiter,
 		visibilityRateLimiter: visibilityRateLimiter,
 		asyncRateLimiter:      asyncRateLimiter,
+		loadShedder:           loadShedder,
 	}
 }
 
@@ -77,6 +80,11 @@ func (h *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.Cou
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(cp1.GetDomain(), criticalityLow)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeVisibility, cp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -101,6 +109,11 @@ func (h *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTa
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(dp1.GetDomain(), criticalityNormal)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, dp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -117,6 +130,11 @@ func (h *apiHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.D
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(dp1.GetDomain(), criticalityNormal)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, dp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -133,6 +151,11 @@ func (h *apiHandler) DiagnoseWorkflowExecution(ctx context.Context, dp1 *types.D
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(dp1.GetDomain(), criticalityNormal)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, dp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -157,6 +180,11 @@ func (h *apiHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTas
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(gp1.GetDomain(), criticalityNormal)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -173,6 +201,15 @@ func (h *apiHandler) GetWorkflowExecutionHistory(ctx context.Context, gp1 *types
 		err = validate.ErrDomainNotSet
 		return
 	}
+	tier := criticalityNormal
+	if gp1.GetWaitForNewEvent() {
+		tier = criticalityCritical
+	}
+	release, err := h.loadShedder.acquire(gp1.GetDomain(), tier)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -193,6 +230,11 @@ func (h *apiHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *ty
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(lp1.GetDomain(), criticalityLow)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeVisibility, lp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -209,6 +251,11 @@ func (h *apiHandler) ListClosedWorkflowExecutions(ctx context.Context, lp1 *type
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(lp1.GetDomain(), criticalityLow)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeVisibility, lp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -229,6 +276,11 @@ func (h *apiHandler) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(lp1.GetDomain(), criticalityLow)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeVisibility, lp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -245,6 +297,11 @@ func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.List
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(lp1.GetDomain(), criticalityNormal)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, lp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -261,6 +318,11 @@ func (h *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(lp1.GetDomain(), criticalityLow)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeVisibility, lp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -309,6 +371,11 @@ func (h *apiHandler) QueryWorkflow(ctx context.Context, qp1 *types.QueryWorkflow
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(qp1.GetDomain(), criticalityNormal)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, qp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -367,6 +434,11 @@ func (h *apiHandler) RefreshWorkflowTasks(ctx context.Context, rp1 *types.Refres
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(rp1.GetDomain(), criticalityNormal)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, rp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -387,6 +459,11 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(rp1.GetDomain(), criticalityHigh)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, rp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -418,6 +495,11 @@ func (h *apiHandler) ResetWorkflowExecution(ctx context.Context, rp1 *types.Rese
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(rp1.GetDomain(), criticalityHigh)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, rp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -641,6 +723,11 @@ func (h *apiHandler) RestartWorkflowExecution(ctx context.Context, rp1 *types.Re
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(rp1.GetDomain(), criticalityHigh)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, rp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -657,6 +744,11 @@ func (h *apiHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.List
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(lp1.GetDomain(), criticalityLow)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeVisibility, lp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -673,6 +765,11 @@ func (h *apiHandler) SignalWithStartWorkflowExecution(ctx context.Context, sp1 *
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(sp1.GetDomain(), criticalityHigh)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, sp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -689,6 +786,11 @@ func (h *apiHandler) SignalWithStartWorkflowExecutionAsync(ctx context.Context,
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(sp1.GetDomain(), criticalityLow)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeAsync, sp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -705,6 +807,11 @@ func (h *apiHandler) SignalWorkflowExecution(ctx context.Context, sp1 *types.Sig
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(sp1.GetDomain(), criticalityHigh)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, sp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -721,6 +828,11 @@ func (h *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.Star
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(sp1.GetDomain(), criticalityHigh)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, sp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -737,6 +849,11 @@ func (h *apiHandler) StartWorkflowExecutionAsync(ctx context.Context, sp1 *types
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(sp1.GetDomain(), criticalityLow)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeAsync, sp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
@@ -753,6 +870,11 @@ func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 		err = validate.ErrDomainNotSet
 		return
 	}
+	release, err := h.loadShedder.acquire(tp1.GetDomain(), criticalityHigh)
+	if err != nil {
+		return
+	}
+	defer release()
 	if ok := h.allowDomain(ratelimitTypeUser, tp1.GetDomain()); !ok {
 		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
 		return
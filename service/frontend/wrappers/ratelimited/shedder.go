@@ -0,0 +1,129 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ratelimited
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/config"
+)
+
+// criticality is the load shedding tier of an API. When a frontend host is overloaded,
+// the least critical tiers are rejected first so polls, heartbeats and task completions
+// keep flowing while bulk visibility calls and async starts back off.
+type criticality int
+
+const (
+	// criticalityCritical APIs are never shed: worker polls, heartbeats and task completions.
+	// They are also not counted as in-flight, as long polls would otherwise dominate the count.
+	criticalityCritical criticality = iota + 1
+	// criticalityHigh APIs mutate workflows on behalf of users: start, signal, cancel, terminate, reset.
+	criticalityHigh
+	// criticalityNormal APIs are user reads: describe, history, query.
+	// History long polls (WaitForNewEvent) are treated as critical instead.
+	criticalityNormal
+	// criticalityLow APIs are bulk or deferrable: visibility List/Scan/Count and async starts.
+	criticalityLow
+)
+
+// ServiceBusyReasonLoadShed is set as the ServiceBusyError reason of requests rejected by load shedding,
+// so clients can tell them apart from domain ratelimits.
+const ServiceBusyReasonLoadShed = "LOAD_SHED"
+
+func (c criticality) String() string {
+	switch c {
+	case criticalityCritical:
+		return "critical"
+	case criticalityHigh:
+		return "high"
+	case criticalityNormal:
+		return "normal"
+	case criticalityLow:
+		return "low"
+	default:
+		return "unknown"
+	}
+}
+
+// LoadShedder tracks the number of in-flight requests on a frontend host and rejects
+// requests of a tier once the in-flight count exceeds that tier's share of the host capacity.
+type LoadShedder struct {
+	config        *config.Config
+	metricsClient metrics.Client
+	inFlight      int64
+}
+
+// NewLoadShedder creates a LoadShedder configured by the frontend load shedding dynamic config
+func NewLoadShedder(config *config.Config, metricsClient metrics.Client) *LoadShedder {
+	return &LoadShedder{
+		config:        config,
+		metricsClient: metricsClient,
+	}
+}
+
+// acquire admits a request of the given tier, returning a release func that must be called when the request completes.
+// A ServiceBusyError is returned if the request is shed.
+func (s *LoadShedder) acquire(domain string, tier criticality) (func(), error) {
+	if tier == criticalityCritical {
+		return func() {}, nil
+	}
+
+	inFlight := atomic.AddInt64(&s.inFlight, 1)
+	release := func() { atomic.AddInt64(&s.inFlight, -1) }
+
+	if !s.config.EnableLoadShedding(domain) {
+		return release, nil
+	}
+	limit := s.config.LoadSheddingMaxInFlightRequests()
+	if limit <= 0 {
+		return release, nil
+	}
+
+	scope := s.metricsClient.Scope(metrics.FrontendLoadShedderScope, metrics.DomainTag(domain), metrics.CriticalityTag(tier.String()))
+	scope.UpdateGauge(metrics.LoadShedderInFlightRequests, float64(inFlight))
+	if float64(inFlight) <= s.threshold(domain, tier)*float64(limit) {
+		return release, nil
+	}
+
+	release()
+	scope.IncCounter(metrics.LoadShedRequestsCount)
+	return nil, &types.ServiceBusyError{
+		Message: fmt.Sprintf("Frontend host is overloaded, shedding %s criticality requests", tier),
+		Reason:  ServiceBusyReasonLoadShed,
+	}
+}
+
+// threshold returns the fraction of host capacity above which requests of the tier are shed
+func (s *LoadShedder) threshold(domain string, tier criticality) float64 {
+	switch tier {
+	case criticalityHigh:
+		return s.config.LoadSheddingHighTierThreshold(domain)
+	case criticalityNormal:
+		return s.config.LoadSheddingNormalTierThreshold(domain)
+	case criticalityLow:
+		return s.config.LoadSheddingLowTierThreshold(domain)
+	default:
+		panic("coding error, unrecognized request criticality value")
+	}
+}
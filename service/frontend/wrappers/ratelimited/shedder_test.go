@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ratelimited
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/api"
+	"github.com/uber/cadence/service/frontend/config"
+)
+
+func testShedderConfig(enabled bool, maxInFlight int) *config.Config {
+	return &config.Config{
+		EnableLoadShedding:              dynamicconfig.GetBoolPropertyFnFilteredByDomain(enabled),
+		LoadSheddingMaxInFlightRequests: dynamicconfig.GetIntPropertyFn(maxInFlight),
+		LoadSheddingLowTierThreshold:    dynamicconfig.GetFloatPropertyFnFilteredByDomain(0.5),
+		LoadSheddingNormalTierThreshold: dynamicconfig.GetFloatPropertyFnFilteredByDomain(0.75),
+		LoadSheddingHighTierThreshold:   dynamicconfig.GetFloatPropertyFnFilteredByDomain(1),
+	}
+}
+
+func TestLoadShedderShedsLowTiersFirst(t *testing.T) {
+	testScope := tally.NewTestScope("test", nil)
+	shedder := NewLoadShedder(testShedderConfig(true, 4), metrics.NewClient(testScope, metrics.Frontend))
+
+	// fill the host up to the low tier threshold
+	var releases []func()
+	for i := 0; i < 2; i++ {
+		release, err := shedder.acquire("domain", criticalityLow)
+		require.NoError(t, err)
+		releases = append(releases, release)
+	}
+
+	_, err := shedder.acquire("domain", criticalityLow)
+	var busy *types.ServiceBusyError
+	require.ErrorAs(t, err, &busy)
+	assert.Equal(t, ServiceBusyReasonLoadShed, busy.Reason)
+	assert.Contains(t, busy.Message, "low")
+
+	// more critical tiers are still admitted
+	release, err := shedder.acquire("domain", criticalityNormal)
+	require.NoError(t, err)
+	releases = append(releases, release)
+	release, err = shedder.acquire("domain", criticalityHigh)
+	require.NoError(t, err)
+	releases = append(releases, release)
+
+	_, err = shedder.acquire("domain", criticalityNormal)
+	assert.ErrorAs(t, err, &busy)
+	_, err = shedder.acquire("domain", criticalityHigh)
+	assert.ErrorAs(t, err, &busy)
+
+	// critical requests are never shed
+	release, err = shedder.acquire("domain", criticalityCritical)
+	require.NoError(t, err)
+	release()
+
+	for _, release := range releases {
+		release()
+	}
+	release, err = shedder.acquire("domain", criticalityLow)
+	assert.NoError(t, err)
+	release()
+
+	shed := testScope.Snapshot().Counters()["test.load_shed_requests+criticality=low,domain=domain,operation=FrontendLoadShedder"]
+	require.NotNil(t, shed)
+	assert.Equal(t, int64(1), shed.Value())
+}
+
+func TestLoadShedderDisabled(t *testing.T) {
+	for name, cfg := range map[string]*config.Config{
+		"disabled for domain": testShedderConfig(false, 1),
+		"no host limit":       testShedderConfig(true, 0),
+	} {
+		t.Run(name, func(t *testing.T) {
+			shedder := NewLoadShedder(cfg, metrics.NewNoopMetricsClient())
+			for i := 0; i < 10; i++ {
+				_, err := shedder.acquire("domain", criticalityLow)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerShedsBeforeRatelimiting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockHandler := api.NewMockHandler(ctrl)
+	mockHandler.EXPECT().ScanWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListWorkflowExecutionsResponse{}, nil).Times(1)
+	policy := &countingPolicy{}
+
+	shedder := NewLoadShedder(testShedderConfig(true, 2), metrics.NewNoopMetricsClient())
+	handler := NewAPIHandler(mockHandler, cache.NewMockDomainCache(ctrl), policy, policy, policy, policy, shedder)
+
+	request := &types.ListWorkflowExecutionsRequest{Domain: "domain"}
+	_, err := handler.ScanWorkflowExecutions(context.Background(), request)
+	require.NoError(t, err)
+	assert.Zero(t, shedder.inFlight, "in-flight requests should be released")
+
+	// hold the only low tier slot so the next request is shed without consuming a ratelimit token
+	release, err := shedder.acquire("domain", criticalityLow)
+	require.NoError(t, err)
+	defer release()
+	_, err = handler.ScanWorkflowExecutions(context.Background(), request)
+	var busy *types.ServiceBusyError
+	assert.ErrorAs(t, err, &busy)
+	assert.Equal(t, 1, policy.calls)
+}
+
+func TestAPIHandlerDoesNotShedHistoryLongPolls(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockHandler := api.NewMockHandler(ctrl)
+	policy := &countingPolicy{}
+
+	shedder := NewLoadShedder(testShedderConfig(true, 1), metrics.NewNoopMetricsClient())
+	handler := NewAPIHandler(mockHandler, cache.NewMockDomainCache(ctrl), policy, policy, policy, policy, shedder)
+
+	// hold the only slot so any request counted as in-flight is shed
+	release, err := shedder.acquire("domain", criticalityHigh)
+	require.NoError(t, err)
+	defer release()
+
+	mockHandler.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, *types.GetWorkflowExecutionHistoryRequest) (*types.GetWorkflowExecutionHistoryResponse, error) {
+			assert.Equal(t, int64(1), shedder.inFlight, "long polls should not be counted as in-flight")
+			return &types.GetWorkflowExecutionHistoryResponse{}, nil
+		}).Times(1)
+	_, err = handler.GetWorkflowExecutionHistory(context.Background(), &types.GetWorkflowExecutionHistoryRequest{Domain: "domain", WaitForNewEvent: true})
+	require.NoError(t, err)
+
+	_, err = handler.GetWorkflowExecutionHistory(context.Background(), &types.GetWorkflowExecutionHistoryRequest{Domain: "domain"})
+	var busy *types.ServiceBusyError
+	assert.ErrorAs(t, err, &busy)
+	assert.Equal(t, int64(1), shedder.inFlight)
+}
+
+type countingPolicy struct {
+	calls int
+}
+
+func (p *countingPolicy) Allow(quotas.Info) bool {
+	p.calls++
+	return true
+}
@@ -21,6 +21,7 @@
 package config
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -101,6 +102,9 @@ type Config struct {
 	StandbyTaskRedispatchInterval          dynamicconfig.DurationPropertyFn
 	StandbyTaskReReplicationContextTimeout dynamicconfig.DurationPropertyFnWithDomainIDFilter
 	EnableDropStuckTaskByDomainID          dynamicconfig.BoolPropertyFnWithDomainIDFilter
+	EnableTaskDLQByDomainID                dynamicconfig.BoolPropertyFnWithDomainIDFilter
+	TaskDLQMaxAttempts                     dynamicconfig.IntPropertyFn
+	TaskDLQMaxAge                          dynamicconfig.DurationPropertyFn
 	ResurrectionCheckMinDelay              dynamicconfig.DurationPropertyFnWithDomainFilter
 
 	// QueueProcessor settings
@@ -112,6 +116,11 @@ type Config struct {
 	QueueProcessorPendingTaskSplitThreshold            dynamicconfig.MapPropertyFn
 	QueueProcessorEnableStuckTaskSplitByDomainID       dynamicconfig.BoolPropertyFnWithDomainIDFilter
 	QueueProcessorStuckTaskSplitThreshold              dynamicconfig.MapPropertyFn
+	QueueProcessorEnableDomainIsolation                dynamicconfig.BoolPropertyFn
+	QueueProcessorEnableDomainIsolationForAllDomains   dynamicconfig.BoolPropertyFn
+	QueueProcessorEnableDomainIsolationByDomainID      dynamicconfig.BoolPropertyFnWithDomainIDFilter
+	QueueProcessorDomainIsolationHashBuckets           dynamicconfig.IntPropertyFn
+	QueueProcessorIsolatedQueueMaxPollRPS              dynamicconfig.IntPropertyFn
 	QueueProcessorSplitLookAheadDurationByDomainID     dynamicconfig.DurationPropertyFnWithDomainIDFilter
 	QueueProcessorPollBackoffInterval                  dynamicconfig.DurationPropertyFn
 	QueueProcessorPollBackoffIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
@@ -213,6 +222,12 @@ type Config struct {
 	PendingActivitiesCountLimitWarn  dynamicconfig.IntPropertyFn
 	PendingActivityValidationEnabled dynamicconfig.BoolPropertyFn
 
+	// Per domain limits, can be overridden by domain data
+	DomainOpenWorkflowsCountLimit         dynamicconfig.IntPropertyFnWithDomainFilter
+	DomainOpenWorkflowsCountCacheTTL      dynamicconfig.DurationPropertyFn
+	DomainPendingActivitiesCountLimit     dynamicconfig.IntPropertyFnWithDomainFilter
+	DomainPendingChildWorkflowsCountLimit dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	EnableQueryAttributeValidation    dynamicconfig.BoolPropertyFn
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
@@ -240,6 +255,9 @@ type Config struct {
 	ReplicationTaskFetcherTimerJitterCoefficient       dynamicconfig.FloatPropertyFn
 	ReplicationTaskFetcherErrorRetryWait               dynamicconfig.DurationPropertyFn
 	ReplicationTaskFetcherServiceBusyWait              dynamicconfig.DurationPropertyFn
+	ReplicationTaskFetcherEnableStreaming              dynamicconfig.BoolPropertyFn
+	ReplicationTaskFetcherStreamRetryWait              dynamicconfig.DurationPropertyFn
+	ReplicationTaskLongPollTimeout                     dynamicconfig.DurationPropertyFn
 	ReplicationTaskProcessorErrorRetryWait             dynamicconfig.DurationPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorErrorRetryMaxAttempts      dynamicconfig.IntPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorErrorSecondRetryWait       dynamicconfig.DurationPropertyFnWithShardIDFilter
@@ -267,6 +285,8 @@ type Config struct {
 
 	// EnableContextHeaderInVisibility whether to enable indexing context header in visibility
 	EnableContextHeaderInVisibility dynamicconfig.BoolPropertyFnWithDomainFilter
+	// EnableTaskPriority whether to send the task priority and fairness key from workflow and activity headers to matching
+	EnableTaskPriority dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	EnableCrossClusterOperationsForDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 
@@ -378,6 +398,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		StandbyTaskRedispatchInterval:          dc.GetDurationProperty(dynamicconfig.StandbyTaskRedispatchInterval),
 		StandbyTaskReReplicationContextTimeout: dc.GetDurationPropertyFilteredByDomainID(dynamicconfig.StandbyTaskReReplicationContextTimeout),
 		EnableDropStuckTaskByDomainID:          dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.EnableDropStuckTaskByDomainID),
+		EnableTaskDLQByDomainID:                dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.EnableTaskDLQByDomainID),
+		TaskDLQMaxAttempts:                     dc.GetIntProperty(dynamicconfig.TaskDLQMaxAttempts),
+		TaskDLQMaxAge:                          dc.GetDurationProperty(dynamicconfig.TaskDLQMaxAge),
 		ResurrectionCheckMinDelay:              dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ResurrectionCheckMinDelay),
 
 		QueueProcessorEnableSplit:                          dc.GetBoolProperty(dynamicconfig.QueueProcessorEnableSplit),
@@ -388,6 +411,11 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		QueueProcessorPendingTaskSplitThreshold:            dc.GetMapProperty(dynamicconfig.QueueProcessorPendingTaskSplitThreshold),
 		QueueProcessorEnableStuckTaskSplitByDomainID:       dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.QueueProcessorEnableStuckTaskSplitByDomainID),
 		QueueProcessorStuckTaskSplitThreshold:              dc.GetMapProperty(dynamicconfig.QueueProcessorStuckTaskSplitThreshold),
+		QueueProcessorEnableDomainIsolation:                dc.GetBoolProperty(dynamicconfig.QueueProcessorEnableDomainIsolation),
+		QueueProcessorEnableDomainIsolationForAllDomains:   dc.GetBoolProperty(dynamicconfig.QueueProcessorEnableDomainIsolationForAllDomains),
+		QueueProcessorEnableDomainIsolationByDomainID:      dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.QueueProcessorEnableDomainIsolationByDomainID),
+		QueueProcessorDomainIsolationHashBuckets:           dc.GetIntProperty(dynamicconfig.QueueProcessorDomainIsolationHashBuckets),
+		QueueProcessorIsolatedQueueMaxPollRPS:              dc.GetIntProperty(dynamicconfig.QueueProcessorIsolatedQueueMaxPollRPS),
 		QueueProcessorSplitLookAheadDurationByDomainID:     dc.GetDurationPropertyFilteredByDomainID(dynamicconfig.QueueProcessorSplitLookAheadDurationByDomainID),
 		QueueProcessorPollBackoffInterval:                  dc.GetDurationProperty(dynamicconfig.QueueProcessorPollBackoffInterval),
 		QueueProcessorPollBackoffIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.QueueProcessorPollBackoffIntervalJitterCoefficient),
@@ -471,6 +499,11 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		PendingActivitiesCountLimitWarn:  dc.GetIntProperty(dynamicconfig.PendingActivitiesCountLimitWarn),
 		PendingActivityValidationEnabled: dc.GetBoolProperty(dynamicconfig.EnablePendingActivityValidation),
 
+		DomainOpenWorkflowsCountLimit:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.DomainOpenWorkflowsCountLimit),
+		DomainOpenWorkflowsCountCacheTTL:      dc.GetDurationProperty(dynamicconfig.DomainOpenWorkflowsCountCacheTTL),
+		DomainPendingActivitiesCountLimit:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.DomainPendingActivitiesCountLimit),
+		DomainPendingChildWorkflowsCountLimit: dc.GetIntPropertyFilteredByDomain(dynamicconfig.DomainPendingChildWorkflowsCountLimit),
+
 		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS),
 		EnableStickyQuery: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableStickyQuery),
 
@@ -491,6 +524,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		ReplicationTaskFetcherTimerJitterCoefficient:       dc.GetFloat64Property(dynamicconfig.ReplicationTaskFetcherTimerJitterCoefficient),
 		ReplicationTaskFetcherErrorRetryWait:               dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherErrorRetryWait),
 		ReplicationTaskFetcherServiceBusyWait:              dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherServiceBusyWait),
+		ReplicationTaskFetcherEnableStreaming:              dc.GetBoolProperty(dynamicconfig.ReplicationTaskFetcherEnableStreaming),
+		ReplicationTaskFetcherStreamRetryWait:              dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherStreamRetryWait),
+		ReplicationTaskLongPollTimeout:                     dc.GetDurationProperty(dynamicconfig.ReplicationTaskLongPollTimeout),
 		ReplicationTaskProcessorErrorRetryWait:             dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorRetryWait),
 		ReplicationTaskProcessorErrorRetryMaxAttempts:      dc.GetIntPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorRetryMaxAttempts),
 		ReplicationTaskProcessorErrorSecondRetryWait:       dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorSecondRetryWait),
@@ -513,6 +549,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		EnableConsistentQuery:                 dc.GetBoolProperty(dynamicconfig.EnableConsistentQuery),
 		EnableConsistentQueryByDomain:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableConsistentQueryByDomain),
 		EnableContextHeaderInVisibility:       dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableContextHeaderInVisibility),
+		EnableTaskPriority:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTaskPriority),
 		EnableCrossClusterOperationsForDomain: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableCrossClusterOperationsForDomain),
 		MaxBufferedQueryCount:                 dc.GetIntProperty(dynamicconfig.MaxBufferedQueryCount),
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumGenProbability),
@@ -623,3 +660,20 @@ func NewForTestByShardNumber(shardNumber int) *Config {
 func (config *Config) GetShardID(workflowID string) int {
 	return common.WorkflowIDToHistoryShard(workflowID, config.NumberOfShards)
 }
+
+// GetDomainLimit returns the per domain limit stored in domain data under dataKey,
+// falling back to the dynamic config value when the domain data does not set it.
+// A non-positive limit means unlimited.
+func GetDomainLimit(
+	domainName string,
+	domainData map[string]string,
+	dataKey string,
+	limitFn dynamicconfig.IntPropertyFnWithDomainFilter,
+) int {
+	if value, ok := domainData[dataKey]; ok {
+		if limit, err := strconv.Atoi(value); err == nil {
+			return limit
+		}
+	}
+	return limitFn(domainName)
+}
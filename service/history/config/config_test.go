@@ -104,6 +104,9 @@ func TestNewConfig(t *testing.T) {
 		"StandbyTaskRedispatchInterval":                        {dynamicconfig.StandbyTaskRedispatchInterval, time.Second},
 		"StandbyTaskReReplicationContextTimeout":               {dynamicconfig.StandbyTaskReReplicationContextTimeout, time.Second},
 		"EnableDropStuckTaskByDomainID":                        {dynamicconfig.EnableDropStuckTaskByDomainID, true},
+		"EnableTaskDLQByDomainID":                              {dynamicconfig.EnableTaskDLQByDomainID, true},
+		"TaskDLQMaxAttempts":                                   {dynamicconfig.TaskDLQMaxAttempts, 100},
+		"TaskDLQMaxAge":                                        {dynamicconfig.TaskDLQMaxAge, time.Second},
 		"ResurrectionCheckMinDelay":                            {dynamicconfig.ResurrectionCheckMinDelay, time.Second},
 		"QueueProcessorEnableSplit":                            {dynamicconfig.QueueProcessorEnableSplit, true},
 		"QueueProcessorSplitMaxLevel":                          {dynamicconfig.QueueProcessorSplitMaxLevel, 38},
@@ -113,6 +116,11 @@ func TestNewConfig(t *testing.T) {
 		"QueueProcessorPendingTaskSplitThreshold":              {dynamicconfig.QueueProcessorPendingTaskSplitThreshold, map[string]interface{}{"a": 100}},
 		"QueueProcessorEnableStuckTaskSplitByDomainID":         {dynamicconfig.QueueProcessorEnableStuckTaskSplitByDomainID, true},
 		"QueueProcessorStuckTaskSplitThreshold":                {dynamicconfig.QueueProcessorStuckTaskSplitThreshold, map[string]interface{}{"b": 1}},
+		"QueueProcessorEnableDomainIsolation":                  {dynamicconfig.QueueProcessorEnableDomainIsolation, true},
+		"QueueProcessorEnableDomainIsolationForAllDomains":     {dynamicconfig.QueueProcessorEnableDomainIsolationForAllDomains, true},
+		"QueueProcessorEnableDomainIsolationByDomainID":        {dynamicconfig.QueueProcessorEnableDomainIsolationByDomainID, true},
+		"QueueProcessorDomainIsolationHashBuckets":             {dynamicconfig.QueueProcessorDomainIsolationHashBuckets, 101},
+		"QueueProcessorIsolatedQueueMaxPollRPS":                {dynamicconfig.QueueProcessorIsolatedQueueMaxPollRPS, 102},
 		"QueueProcessorSplitLookAheadDurationByDomainID":       {dynamicconfig.QueueProcessorSplitLookAheadDurationByDomainID, time.Second},
 		"QueueProcessorPollBackoffInterval":                    {dynamicconfig.QueueProcessorPollBackoffInterval, time.Second},
 		"QueueProcessorPollBackoffIntervalJitterCoefficient":   {dynamicconfig.QueueProcessorPollBackoffIntervalJitterCoefficient, 1.0},
@@ -189,6 +197,10 @@ func TestNewConfig(t *testing.T) {
 		"PendingActivitiesCountLimitError":                     {dynamicconfig.PendingActivitiesCountLimitError, 76},
 		"PendingActivitiesCountLimitWarn":                      {dynamicconfig.PendingActivitiesCountLimitWarn, 77},
 		"PendingActivityValidationEnabled":                     {dynamicconfig.EnablePendingActivityValidation, true},
+		"DomainOpenWorkflowsCountLimit":                        {dynamicconfig.DomainOpenWorkflowsCountLimit, 97},
+		"DomainOpenWorkflowsCountCacheTTL":                     {dynamicconfig.DomainOpenWorkflowsCountCacheTTL, time.Second},
+		"DomainPendingActivitiesCountLimit":                    {dynamicconfig.DomainPendingActivitiesCountLimit, 98},
+		"DomainPendingChildWorkflowsCountLimit":                {dynamicconfig.DomainPendingChildWorkflowsCountLimit, 99},
 		"EnableQueryAttributeValidation":                       {dynamicconfig.EnableQueryAttributeValidation, true},
 		"ValidSearchAttributes":                                {dynamicconfig.ValidSearchAttributes, map[string]interface{}{"key": 1}},
 		"SearchAttributesNumberOfKeysLimit":                    {dynamicconfig.SearchAttributesNumberOfKeysLimit, 78},
@@ -206,6 +218,9 @@ func TestNewConfig(t *testing.T) {
 		"ReplicationTaskFetcherTimerJitterCoefficient":         {dynamicconfig.ReplicationTaskFetcherTimerJitterCoefficient, 9.0},
 		"ReplicationTaskFetcherErrorRetryWait":                 {dynamicconfig.ReplicationTaskFetcherErrorRetryWait, time.Second},
 		"ReplicationTaskFetcherServiceBusyWait":                {dynamicconfig.ReplicationTaskFetcherServiceBusyWait, time.Second},
+		"ReplicationTaskFetcherEnableStreaming":                {dynamicconfig.ReplicationTaskFetcherEnableStreaming, true},
+		"ReplicationTaskFetcherStreamRetryWait":                {dynamicconfig.ReplicationTaskFetcherStreamRetryWait, time.Second},
+		"ReplicationTaskLongPollTimeout":                       {dynamicconfig.ReplicationTaskLongPollTimeout, time.Second},
 		"ReplicationTaskProcessorErrorRetryWait":               {dynamicconfig.ReplicationTaskProcessorErrorRetryWait, time.Second},
 		"ReplicationTaskProcessorErrorRetryMaxAttempts":        {dynamicconfig.ReplicationTaskProcessorErrorRetryMaxAttempts, 86},
 		"ReplicationTaskProcessorErrorSecondRetryWait":         {dynamicconfig.ReplicationTaskProcessorErrorSecondRetryWait, time.Second},
@@ -227,6 +242,7 @@ func TestNewConfig(t *testing.T) {
 		"EnableConsistentQueryByDomain":                        {dynamicconfig.EnableConsistentQueryByDomain, true},
 		"MaxBufferedQueryCount":                                {dynamicconfig.MaxBufferedQueryCount, 89},
 		"EnableContextHeaderInVisibility":                      {dynamicconfig.EnableContextHeaderInVisibility, true},
+		"EnableTaskPriority":                                   {dynamicconfig.EnableTaskPriority, true},
 		"EnableCrossClusterOperationsForDomain":                {dynamicconfig.EnableCrossClusterOperationsForDomain, true},
 		"MutableStateChecksumGenProbability":                   {dynamicconfig.MutableStateChecksumGenProbability, 90},
 		"MutableStateChecksumVerifyProbability":                {dynamicconfig.MutableStateChecksumVerifyProbability, 91},
@@ -276,6 +292,36 @@ func TestNewForTest(t *testing.T) {
 	assert.NotNil(t, cfg)
 }
 
+func TestGetDomainLimit(t *testing.T) {
+	limitFn := func(domain string) int { return 10 }
+	tests := map[string]struct {
+		data     map[string]string
+		expected int
+	}{
+		"no domain data": {
+			data:     nil,
+			expected: 10,
+		},
+		"domain data overrides dynamic config": {
+			data:     map[string]string{"key": "5"},
+			expected: 5,
+		},
+		"domain data disables the limit": {
+			data:     map[string]string{"key": "0"},
+			expected: 0,
+		},
+		"invalid domain data is ignored": {
+			data:     map[string]string{"key": "abc"},
+			expected: 10,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GetDomainLimit("domain", tc.data, "key", limitFn))
+		})
+	}
+}
+
 func assertFieldsMatch(t *testing.T, config interface{}, fields map[string]configTestCase) {
 	configType := reflect.ValueOf(config)
 
@@ -366,6 +366,60 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 	return nil
 }
 
+func (v *attrValidator) validatePendingActivitiesCountLimit(
+	domainEntry *cache.DomainCacheEntry,
+	mutableState execution.MutableState,
+	metricsScope int,
+) error {
+
+	domainName := domainEntry.GetInfo().Name
+	limit := config.GetDomainLimit(
+		domainName,
+		domainEntry.GetInfo().Data,
+		common.DomainDataKeyForPendingActivitiesCountLimit,
+		v.config.DomainPendingActivitiesCountLimit,
+	)
+	if limit <= 0 {
+		return nil
+	}
+	pendingActivitiesCount := len(mutableState.GetPendingActivityInfos())
+	if pendingActivitiesCount < limit {
+		return nil
+	}
+
+	v.metricsClient.Scope(metricsScope, metrics.DomainTag(domainName)).IncCounter(metrics.DomainPendingActivitiesLimitExceededCounter)
+	return &types.BadRequestError{
+		Message: fmt.Sprintf("Pending activities count %v reached the limit %v of domain %v.", pendingActivitiesCount, limit, domainName),
+	}
+}
+
+func (v *attrValidator) validatePendingChildWorkflowsCountLimit(
+	domainEntry *cache.DomainCacheEntry,
+	mutableState execution.MutableState,
+	metricsScope int,
+) error {
+
+	domainName := domainEntry.GetInfo().Name
+	limit := config.GetDomainLimit(
+		domainName,
+		domainEntry.GetInfo().Data,
+		common.DomainDataKeyForPendingChildWorkflowsCountLimit,
+		v.config.DomainPendingChildWorkflowsCountLimit,
+	)
+	if limit <= 0 {
+		return nil
+	}
+	pendingChildWorkflowsCount := len(mutableState.GetPendingChildExecutionInfos())
+	if pendingChildWorkflowsCount < limit {
+		return nil
+	}
+
+	v.metricsClient.Scope(metricsScope, metrics.DomainTag(domainName)).IncCounter(metrics.DomainPendingChildWorkflowsLimitExceededCounter)
+	return &types.BadRequestError{
+		Message: fmt.Sprintf("Pending child workflows count %v reached the limit %v of domain %v.", pendingChildWorkflowsCount, limit, domainName),
+	}
+}
+
 func (v *attrValidator) validateTimerScheduleAttributes(
 	attributes *types.StartTimerDecisionAttributes,
 	metricsScope int,
@@ -108,6 +108,8 @@ func (s *attrValidatorSuite) SetupTest() {
 			time.Duration(s.testActivityMaxScheduleToStartTimeoutForRetryInSeconds) * time.Second,
 		),
 		EnableCrossClusterOperationsForDomain: dynamicconfig.GetBoolPropertyFnFilteredByDomain(false),
+		DomainPendingActivitiesCountLimit:     dynamicconfig.GetIntPropertyFilteredByDomain(2),
+		DomainPendingChildWorkflowsCountLimit: dynamicconfig.GetIntPropertyFilteredByDomain(0),
 	}
 	s.validator = newAttrValidator(
 		s.mockDomainCache,
@@ -773,6 +775,60 @@ const (
 	testRunID      = "test-run-id"
 )
 
+func (s *attrValidatorSuite) TestValidatePendingActivitiesCountLimit() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	mutableState := execution.NewMockMutableState(s.controller)
+
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{1: {}}).Times(1)
+	err := s.validator.validatePendingActivitiesCountLimit(domainEntry, mutableState, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.NoError(err)
+
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{1: {}, 2: {}}).Times(1)
+	err = s.validator.validatePendingActivitiesCountLimit(domainEntry, mutableState, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+
+	// domain data overrides the dynamic config and disables the limit
+	domainEntry = cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			Name: s.testDomainID,
+			Data: map[string]string{common.DomainDataKeyForPendingActivitiesCountLimit: "0"},
+		},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	err = s.validator.validatePendingActivitiesCountLimit(domainEntry, mutableState, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.NoError(err)
+}
+
+func (s *attrValidatorSuite) TestValidatePendingChildWorkflowsCountLimit() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	mutableState := execution.NewMockMutableState(s.controller)
+
+	// no limit by default
+	err := s.validator.validatePendingChildWorkflowsCountLimit(domainEntry, mutableState, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.NoError(err)
+
+	domainEntry = cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			Name: s.testDomainID,
+			Data: map[string]string{common.DomainDataKeyForPendingChildWorkflowsCountLimit: "1"},
+		},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	mutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{1: {}}).Times(1)
+	err = s.validator.validatePendingChildWorkflowsCountLimit(domainEntry, mutableState, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func TestWorkflowSizeChecker_failWorkflowIfBlobSizeExceedsLimit(t *testing.T) {
 	var (
 		testDecisionTag = metrics.DecisionTypeTag(types.DecisionTypeCompleteWorkflowExecution.String())
@@ -232,12 +232,19 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 
 	if err := handler.validateDecisionAttr(
 		func() error {
-			return handler.attrValidator.validateActivityScheduleAttributes(
+			if err := handler.attrValidator.validateActivityScheduleAttributes(
 				domainID,
 				targetDomainID,
 				attr,
 				executionInfo.WorkflowTimeout,
 				metrics.HistoryRespondDecisionTaskCompletedScope,
+			); err != nil {
+				return err
+			}
+			return handler.attrValidator.validatePendingActivitiesCountLimit(
+				handler.domainEntry,
+				handler.mutableState,
+				metrics.HistoryRespondDecisionTaskCompletedScope,
 			)
 		},
 		types.DecisionTaskFailedCauseBadScheduleActivityAttributes,
@@ -866,12 +873,19 @@ func (handler *taskHandlerImpl) handleDecisionStartChildWorkflow(
 
 	if err := handler.validateDecisionAttr(
 		func() error {
-			return handler.attrValidator.validateStartChildExecutionAttributes(
+			if err := handler.attrValidator.validateStartChildExecutionAttributes(
 				domainID,
 				targetDomainID,
 				attr,
 				executionInfo,
 				metrics.HistoryRespondDecisionTaskCompletedScope,
+			); err != nil {
+				return err
+			}
+			return handler.attrValidator.validatePendingChildWorkflowsCountLimit(
+				handler.domainEntry,
+				handler.mutableState,
+				metrics.HistoryRespondDecisionTaskCompletedScope,
 			)
 		},
 		types.DecisionTaskFailedCauseBadStartChildExecutionAttributes,
@@ -121,50 +121,10 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 
 	if len(mutableState.GetPendingActivityInfos()) > 0 {
 		for _, ai := range mutableState.GetPendingActivityInfos() {
-			p := &types.PendingActivityInfo{
-				ActivityID: ai.ActivityID,
-				ScheduleID: ai.ScheduleID,
-			}
-			state := types.PendingActivityStateScheduled
-			if ai.CancelRequested {
-				state = types.PendingActivityStateCancelRequested
-			} else if ai.StartedID != common.EmptyEventID {
-				state = types.PendingActivityStateStarted
-			}
-			p.State = &state
-			lastHeartbeatUnixNano := ai.LastHeartBeatUpdatedTime.UnixNano()
-			if lastHeartbeatUnixNano > 0 {
-				p.LastHeartbeatTimestamp = common.Int64Ptr(lastHeartbeatUnixNano)
-				p.HeartbeatDetails = ai.Details
-			}
-			// TODO: move to mutable state instead of loading it from event
-			scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, ai.ScheduleID)
+			p, err := newPendingActivityInfo(ctx, mutableState, ai)
 			if err != nil {
 				return nil, err
 			}
-			p.ActivityType = scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType
-			if state == types.PendingActivityStateScheduled {
-				p.ScheduledTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
-			} else {
-				p.LastStartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
-			}
-			if ai.HasRetryPolicy {
-				p.Attempt = ai.Attempt
-				p.ExpirationTimestamp = common.Int64Ptr(ai.ExpirationTime.UnixNano())
-				if ai.MaximumAttempts != 0 {
-					p.MaximumAttempts = ai.MaximumAttempts
-				}
-				if ai.LastFailureReason != "" {
-					p.LastFailureReason = common.StringPtr(ai.LastFailureReason)
-					p.LastFailureDetails = ai.LastFailureDetails
-				}
-				if ai.LastWorkerIdentity != "" {
-					p.LastWorkerIdentity = ai.LastWorkerIdentity
-				}
-				if ai.StartedIdentity != "" {
-					p.StartedWorkerIdentity = ai.StartedIdentity
-				}
-			}
 			result.PendingActivities = append(result.PendingActivities, p)
 		}
 	}
@@ -213,3 +173,55 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 
 	return result, nil
 }
+
+func newPendingActivityInfo(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	ai *persistence.ActivityInfo,
+) (*types.PendingActivityInfo, error) {
+	p := &types.PendingActivityInfo{
+		ActivityID: ai.ActivityID,
+		ScheduleID: ai.ScheduleID,
+	}
+	state := types.PendingActivityStateScheduled
+	if ai.CancelRequested {
+		state = types.PendingActivityStateCancelRequested
+	} else if ai.StartedID != common.EmptyEventID {
+		state = types.PendingActivityStateStarted
+	}
+	p.State = &state
+	lastHeartbeatUnixNano := ai.LastHeartBeatUpdatedTime.UnixNano()
+	if lastHeartbeatUnixNano > 0 {
+		p.LastHeartbeatTimestamp = common.Int64Ptr(lastHeartbeatUnixNano)
+		p.HeartbeatDetails = ai.Details
+	}
+	// TODO: move to mutable state instead of loading it from event
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, ai.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	p.ActivityType = scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType
+	if state == types.PendingActivityStateScheduled {
+		p.ScheduledTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
+	} else {
+		p.LastStartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
+	}
+	if ai.HasRetryPolicy {
+		p.Attempt = ai.Attempt
+		p.ExpirationTimestamp = common.Int64Ptr(ai.ExpirationTime.UnixNano())
+		if ai.MaximumAttempts != 0 {
+			p.MaximumAttempts = ai.MaximumAttempts
+		}
+		if ai.LastFailureReason != "" {
+			p.LastFailureReason = common.StringPtr(ai.LastFailureReason)
+			p.LastFailureDetails = ai.LastFailureDetails
+		}
+		if ai.LastWorkerIdentity != "" {
+			p.LastWorkerIdentity = ai.LastWorkerIdentity
+		}
+		if ai.StartedIdentity != "" {
+			p.StartedWorkerIdentity = ai.StartedIdentity
+		}
+	}
+	return p, nil
+}
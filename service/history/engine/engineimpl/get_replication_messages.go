@@ -23,6 +23,7 @@ package engineimpl
 
 import (
 	"context"
+	"sort"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/tag"
@@ -92,3 +93,38 @@ func (e *historyEngineImpl) GetDLQReplicationMessages(
 
 	return tasks, nil
 }
+
+func (e *historyEngineImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+) ([]*types.ReplicationShardStatus, error) {
+
+	dlqCounts, err := e.replicationDLQHandler.GetMessageCount(ctx, request.GetForceFetch())
+	if err != nil {
+		return nil, err
+	}
+
+	remoteClusters := e.clusterMetadata.GetRemoteClusterInfo()
+	clusters := request.GetClusters()
+	if len(clusters) == 0 {
+		for cluster := range remoteClusters {
+			clusters = append(clusters, cluster)
+		}
+		sort.Strings(clusters)
+	}
+
+	statuses := make([]*types.ReplicationShardStatus, 0, len(clusters))
+	for _, cluster := range clusters {
+		if _, ok := remoteClusters[cluster]; !ok {
+			continue
+		}
+		status, err := e.replicationAckManager.GetStatus(ctx, cluster)
+		if err != nil {
+			e.logger.Error("Failed to get replication status.", tag.Error(err), tag.ClusterName(cluster))
+			return nil, err
+		}
+		status.DLQSize = dlqCounts[cluster]
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
@@ -54,6 +54,7 @@ import (
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/failover"
 	"github.com/uber/cadence/service/history/ndc"
+	"github.com/uber/cadence/service/history/openworkflows"
 	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/history/reset"
@@ -106,6 +107,7 @@ type historyEngineImpl struct {
 	replicationTaskProcessors []replication.TaskProcessor
 	replicationAckManager     replication.TaskAckManager
 	replicationTaskStore      *replication.TaskStore
+	replicationTaskNotifier   *replication.TaskNotifier
 	replicationHydrator       replication.TaskHydrator
 	replicationMetricsEmitter *replication.MetricsEmitterImpl
 	publicClient              workflowserviceclient.Interface
@@ -116,6 +118,7 @@ type historyEngineImpl struct {
 	replicationDLQHandler     replication.DLQHandler
 	failoverMarkerNotifier    failover.MarkerNotifier
 	wfIDCache                 workflowcache.WFCache
+	openWorkflowsCounter      *openworkflows.Counter
 
 	updateWithActionFn func(context.Context, execution.Cache, string, types.WorkflowExecution, bool, time.Time, func(wfContext execution.Context, mutableState execution.MutableState) error) error
 }
@@ -144,6 +147,7 @@ func NewEngineWithShardContext(
 	queueTaskProcessor task.Processor,
 	failoverCoordinator failover.Coordinator,
 	wfIDCache workflowcache.WFCache,
+	openWorkflowsCounter *openworkflows.Counter,
 	queueProcessorFactory queue.ProcessorFactory,
 ) engine.Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
@@ -224,11 +228,13 @@ func NewEngineWithShardContext(
 			replicationReader,
 			replicationTaskStore,
 		),
-		replicationTaskStore: replicationTaskStore,
+		replicationTaskStore:    replicationTaskStore,
+		replicationTaskNotifier: replication.NewTaskNotifier(),
 		replicationMetricsEmitter: replication.NewMetricsEmitter(
 			shard.GetShardID(), shard, replicationReader, shard.GetMetricsClient()),
-		wfIDCache:          wfIDCache,
-		updateWithActionFn: workflow.UpdateWithAction,
+		wfIDCache:            wfIDCache,
+		openWorkflowsCounter: openWorkflowsCounter,
+		updateWithActionFn:   workflow.UpdateWithAction,
 	}
 	historyEngImpl.decisionHandler = decision.NewHandler(
 		shard,
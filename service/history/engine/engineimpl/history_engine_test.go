@@ -3444,6 +3444,97 @@ func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
 	s.Equal(workflow.ErrMaxAttemptsExceeded, err)
 }
 
+func (s *engineSuite) TestRespondActivityTaskFailedAfterAttemptsReset() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	retryPolicy := &types.RetryPolicy{
+		InitialIntervalInSeconds:    1,
+		BackoffCoefficient:          2,
+		MaximumIntervalInSeconds:    1000,
+		MaximumAttempts:             10,
+		ExpirationIntervalInSeconds: 0,
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, ai := test.AddActivityTaskScheduledEventWithRetry(msBuilder, decisionCompletedEvent.ID, activityID,
+		"activity_type1", tl, []byte("input1"), 1000, 1000, 100, 0, retryPolicy)
+	// the activity waits for its 6th attempt, 32 seconds after its 5th attempt failed
+	ai.Attempt = 5
+	ai.ScheduledTime = time.Now().Add(32 * time.Second)
+
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Times(3)
+
+	_, err := s.mockHistoryEngine.UpdateActivityOptions(context.Background(), &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.UpdateActivityOptionsRequest{
+			Domain:        constants.TestDomainName,
+			Execution:     &we,
+			ActivityID:    activityID,
+			ResetAttempts: true,
+		},
+	})
+	s.NoError(err)
+	ai, ok := s.getBuilder(constants.TestDomainID, we).GetActivityInfo(activityScheduledEvent.ID)
+	s.True(ok)
+	s.Equal(int32(0), ai.Attempt)
+	s.WithinDuration(time.Now(), ai.ScheduledTime, time.Second)
+
+	_, err = s.mockHistoryEngine.RecordActivityTaskStarted(context.Background(), &types.RecordActivityTaskStartedRequest{
+		DomainUUID:        constants.TestDomainID,
+		WorkflowExecution: &we,
+		ScheduleID:        activityScheduledEvent.ID,
+		RequestID:         "reqId",
+		PollRequest: &types.PollForActivityTaskRequest{
+			TaskList: &types.TaskList{Name: tl},
+			Identity: identity,
+		},
+	})
+	s.NoError(err)
+
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID:      we.WorkflowID,
+		RunID:           we.RunID,
+		ScheduleID:      activityScheduledEvent.ID,
+		ScheduleAttempt: 0,
+	})
+	err = s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
+		DomainUUID: constants.TestDomainID,
+		FailedRequest: &types.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    common.StringPtr("failed"),
+			Identity:  identity,
+		},
+	})
+	s.NoError(err)
+
+	// the next retry is scheduled with the initial interval instead of the 64 seconds of the 7th attempt
+	ai, ok = s.getBuilder(constants.TestDomainID, we).GetActivityInfo(activityScheduledEvent.ID)
+	s.True(ok)
+	s.Equal(int32(1), ai.Attempt)
+	s.Equal(common.EmptyEventID, ai.StartedID)
+	s.WithinDuration(time.Now().Add(time.Second), ai.ScheduledTime, time.Second)
+}
+
 func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
 
 	we := types.WorkflowExecution{
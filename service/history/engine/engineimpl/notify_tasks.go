@@ -71,6 +71,13 @@ func (e *historyEngineImpl) NotifyNewReplicationTasks(info *hcommon.NotifyTaskIn
 		}
 		e.replicationTaskStore.Put(hTask)
 	}
+	if len(info.Tasks) > 0 {
+		e.replicationTaskNotifier.Notify()
+	}
+}
+
+func (e *historyEngineImpl) WatchReplicationTasks() <-chan struct{} {
+	return e.replicationTaskNotifier.Watch()
 }
 
 func hydrateReplicationTask(
@@ -105,7 +112,7 @@ func hydrateReplicationTask(
 	}
 
 	hydrator := replication.NewImmediateTaskHydrator(
-		exec.IsRunning(),
+		exec,
 		versionHistories,
 		activities,
 		history.Find(info.BranchToken, info.FirstEventID),
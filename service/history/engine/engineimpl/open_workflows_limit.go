@@ -0,0 +1,87 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2021 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+// checkOpenWorkflowsLimit rejects the start request if the domain already reached
+// its open workflows limit. The open workflow count comes from visibility and is
+// cached for a short period by the host, so the limit is approximately enforced.
+// Counting requires advanced visibility, the limit is not enforced with DB visibility.
+func (e *historyEngineImpl) checkOpenWorkflowsLimit(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	metricsScope int,
+) error {
+
+	domainName := domainEntry.GetInfo().Name
+	limit := config.GetDomainLimit(
+		domainName,
+		domainEntry.GetInfo().Data,
+		common.DomainDataKeyForOpenWorkflowsCountLimit,
+		e.config.DomainOpenWorkflowsCountLimit,
+	)
+	if limit <= 0 || e.visibilityMgr == nil || e.openWorkflowsCounter == nil {
+		return nil
+	}
+
+	count, err := e.openWorkflowsCounter.Count(ctx, domainEntry.GetInfo().ID, domainName)
+	if errors.Is(err, persistence.ErrVisibilityOperationNotSupported) {
+		e.throttledLogger.Error("Open workflows limit of domain is not enforced, it requires advanced visibility",
+			tag.WorkflowDomainName(domainName))
+		return nil
+	}
+	if err != nil {
+		// do not block workflow creation when visibility is unavailable
+		e.throttledLogger.Warn("Failed to count open workflows for domain limit",
+			tag.WorkflowDomainName(domainName),
+			tag.Error(err))
+		return nil
+	}
+
+	if count >= int64(limit) {
+		e.metricsClient.Scope(metricsScope, metrics.DomainTag(domainName)).IncCounter(metrics.DomainOpenWorkflowsLimitExceededCounter)
+		return &types.LimitExceededError{
+			Message: fmt.Sprintf("Open workflows count %v reached the limit %v of domain %v.", count, limit, domainName),
+		}
+	}
+	return nil
+}
+
+// workflowStarted accounts for a created workflow in the cached open workflow count of the domain
+func (e *historyEngineImpl) workflowStarted(domainID string) {
+	if e.openWorkflowsCounter != nil {
+		e.openWorkflowsCounter.Increment(domainID)
+	}
+}
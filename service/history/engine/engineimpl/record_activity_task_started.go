@@ -125,7 +125,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if err != nil {
 				return err
 			}
-			response.ScheduledEvent = scheduledEvent
+			response.ScheduledEvent = withActivityOptions(scheduledEvent, ai)
 			response.ScheduledTimestampOfThisAttempt = common.Int64Ptr(ai.ScheduledTime.UnixNano())
 
 			response.Attempt = int64(ai.Attempt)
@@ -167,3 +167,28 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 
 	return response, err
 }
+
+// withActivityOptions returns the scheduled event with the timeouts and task list overridden by
+// the ones recorded in activity info, as they may have been changed after the activity was scheduled.
+func withActivityOptions(
+	scheduledEvent *types.HistoryEvent,
+	ai *persistence.ActivityInfo,
+) *types.HistoryEvent {
+	attributes := scheduledEvent.GetActivityTaskScheduledEventAttributes()
+	if attributes == nil ||
+		(attributes.GetScheduleToStartTimeoutSeconds() == ai.ScheduleToStartTimeout &&
+			attributes.GetScheduleToCloseTimeoutSeconds() == ai.ScheduleToCloseTimeout &&
+			attributes.GetStartToCloseTimeoutSeconds() == ai.StartToCloseTimeout &&
+			attributes.GetHeartbeatTimeoutSeconds() == ai.HeartbeatTimeout) {
+		return scheduledEvent
+	}
+
+	event := *scheduledEvent
+	updatedAttributes := *attributes
+	updatedAttributes.ScheduleToStartTimeoutSeconds = common.Int32Ptr(ai.ScheduleToStartTimeout)
+	updatedAttributes.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(ai.ScheduleToCloseTimeout)
+	updatedAttributes.StartToCloseTimeoutSeconds = common.Int32Ptr(ai.StartToCloseTimeout)
+	updatedAttributes.HeartbeatTimeoutSeconds = common.Int32Ptr(ai.HeartbeatTimeout)
+	event.ActivityTaskScheduledEventAttributes = &updatedAttributes
+	return &event
+}
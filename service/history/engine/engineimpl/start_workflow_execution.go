@@ -82,6 +82,14 @@ func (e *historyEngineImpl) startWorkflowHelper(
 	}
 	e.overrideStartWorkflowExecutionRequest(domainEntry, request, metricsScope)
 
+	// child workflows are not limited, the parent's transfer task can only record a start failure
+	// for an already started child, so it would retry a rejected start forever
+	if startRequest.ParentExecutionInfo == nil {
+		if err := e.checkOpenWorkflowsLimit(ctx, domainEntry, metricsScope); err != nil {
+			return nil, err
+		}
+	}
+
 	workflowID := request.GetWorkflowID()
 	domainID := domainEntry.GetInfo().ID
 	domain := domainEntry.GetInfo().Name
@@ -163,6 +171,7 @@ func (e *historyEngineImpl) startWorkflowHelper(
 		workflowExecution,
 		startRequest,
 		signalWithStartRequest,
+		startRequest.GetRequestEagerExecution() && !isSignalWithStart,
 	)
 	if err != nil {
 		if e.shard.GetConfig().EnableRecordWorkflowExecutionUninitialized(domainEntry.GetInfo().Name) && e.visibilityMgr != nil {
@@ -311,10 +320,22 @@ func (e *historyEngineImpl) startWorkflowHelper(
 	if err != nil {
 		return nil, err
 	}
+	e.workflowStarted(domainID)
 
-	return &types.StartWorkflowExecutionResponse{
+	resp = &types.StartWorkflowExecutionResponse{
 		RunID: workflowExecution.RunID,
-	}, nil
+	}
+	if startRequest.GetRequestEagerExecution() && !isSignalWithStart {
+		resp.DecisionTask, err = e.createEagerDecisionTaskResponse(
+			curMutableState,
+			newWorkflowEventsSeq[0].Events,
+			int64(len(historyBlob.Data)),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
@@ -644,11 +665,14 @@ UpdateWorkflowLoop:
 			}
 		}
 
+		// the first decision is always dispatched through matching here, as the
+		// new run's events are not available to build an eager decision task response
 		err = e.addStartEventsAndTasks(
 			newMutableState,
 			workflowExecution,
 			startRequest,
 			signalWithStartRequest,
+			false,
 		)
 		if err != nil {
 			return nil, err
@@ -685,6 +709,7 @@ func (e *historyEngineImpl) addStartEventsAndTasks(
 	workflowExecution types.WorkflowExecution,
 	startRequest *types.HistoryStartWorkflowExecutionRequest,
 	signalWithStartRequest *types.HistorySignalWithStartWorkflowExecutionRequest,
+	eagerDecision bool,
 ) error {
 	// Add WF start event
 	startEvent, err := mutableState.AddWorkflowExecutionStartedEvent(
@@ -717,8 +742,9 @@ func (e *historyEngineImpl) addStartEventsAndTasks(
 	// Generate first decision task event if not child WF and no first decision task backoff
 	return e.generateFirstDecisionTask(
 		mutableState,
-		startRequest.ParentExecutionInfo,
+		startRequest,
 		startEvent,
+		eagerDecision,
 	)
 }
 
@@ -832,17 +858,83 @@ func (e *historyEngineImpl) createMutableState(domainEntry *cache.DomainCacheEnt
 
 func (e *historyEngineImpl) generateFirstDecisionTask(
 	mutableState execution.MutableState,
-	parentInfo *types.ParentExecutionInfo,
+	startRequest *types.HistoryStartWorkflowExecutionRequest,
 	startEvent *types.HistoryEvent,
+	eagerDecision bool,
 ) error {
 
-	if parentInfo == nil {
+	if startRequest.ParentExecutionInfo != nil {
 		// DecisionTask is only created when it is not a Child Workflow and no backoff is needed
-		if err := mutableState.AddFirstDecisionTaskScheduled(
-			startEvent,
-		); err != nil {
-			return err
-		}
+		return nil
 	}
-	return nil
+	if eagerDecision && startEvent.WorkflowExecutionStartedEventAttributes.GetFirstDecisionTaskBackoffSeconds() == 0 {
+		return e.addEagerDecisionTask(mutableState, startRequest.StartRequest)
+	}
+	return mutableState.AddFirstDecisionTaskScheduled(startEvent)
+}
+
+// addEagerDecisionTask schedules and starts the first decision as part of the workflow start.
+// No transfer task is generated for the decision, it is handed back to the starter instead of
+// being dispatched through matching. The decision start to close timer still applies, so the
+// decision is retried through matching if the starter never completes it.
+func (e *historyEngineImpl) addEagerDecisionTask(
+	mutableState execution.MutableState,
+	request *types.StartWorkflowExecutionRequest,
+) error {
+
+	decision, err := mutableState.AddDecisionTaskScheduledEvent(true)
+	if err != nil {
+		return &types.InternalServiceError{Message: "Failed to add decision scheduled event."}
+	}
+	_, _, err = mutableState.AddDecisionTaskStartedEvent(decision.ScheduleID, request.GetRequestID(), &types.PollForDecisionTaskRequest{
+		Domain:   request.GetDomain(),
+		TaskList: &types.TaskList{Name: decision.TaskList},
+		Identity: request.Identity,
+	})
+	return err
+}
+
+func (e *historyEngineImpl) createEagerDecisionTaskResponse(
+	mutableState execution.MutableState,
+	historyEvents []*types.HistoryEvent,
+	historySize int64,
+) (*types.PollForDecisionTaskResponse, error) {
+
+	decision, ok := mutableState.GetInFlightDecision()
+	if !ok {
+		// first decision is delayed, it will be dispatched through matching once the backoff fires
+		return nil, nil
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	token, err := e.tokenSerializer.Serialize(&common.TaskToken{
+		DomainID:        executionInfo.DomainID,
+		WorkflowID:      executionInfo.WorkflowID,
+		RunID:           executionInfo.RunID,
+		ScheduleID:      decision.ScheduleID,
+		ScheduleAttempt: decision.Attempt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PollForDecisionTaskResponse{
+		TaskToken: token,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: executionInfo.WorkflowID,
+			RunID:      executionInfo.RunID,
+		},
+		WorkflowType:   mutableState.GetWorkflowType(),
+		StartedEventID: decision.StartedID,
+		Attempt:        decision.Attempt,
+		History:        &types.History{Events: historyEvents},
+		WorkflowExecutionTaskList: &types.TaskList{
+			Name: executionInfo.TaskList,
+			Kind: types.TaskListKindNormal.Ptr(),
+		},
+		ScheduledTimestamp: common.Int64Ptr(decision.ScheduledTimestamp),
+		StartedTimestamp:   common.Int64Ptr(decision.StartedTimestamp),
+		NextEventID:        mutableState.GetNextEventID(),
+		TotalHistoryBytes:  historySize,
+	}, nil
 }
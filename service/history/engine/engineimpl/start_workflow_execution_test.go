@@ -32,18 +32,21 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/openworkflows"
 )
 
 func TestStartWorkflowExecution(t *testing.T) {
 	tests := []struct {
-		name       string
-		request    *types.HistoryStartWorkflowExecutionRequest
-		setupMocks func(*testing.T, *testdata.EngineForTest)
-		wantErr    bool
+		name         string
+		request      *types.HistoryStartWorkflowExecutionRequest
+		setupMocks   func(*testing.T, *testdata.EngineForTest)
+		wantErr      bool
+		assertResult func(*testing.T, *types.StartWorkflowExecutionResponse)
 	}{
 		{
 			name: "start workflow execution success",
@@ -230,6 +233,166 @@ func TestStartWorkflowExecution(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "open workflows limit of domain exceeded",
+			request: &types.HistoryStartWorkflowExecutionRequest{
+				DomainUUID: constants.TestDomainID,
+				StartRequest: &types.StartWorkflowExecutionRequest{
+					Domain:                              constants.TestDomainName,
+					WorkflowID:                          "workflow-id",
+					WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+					TaskList:                            &types.TaskList{Name: "default-task-list"},
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600), // 1 hour
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),   // 10 seconds
+					Identity:                            "workflow-starter",
+					RequestID:                           "request-id-for-start",
+				},
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.Engine.(*historyEngineImpl).config.DomainOpenWorkflowsCountLimit = dynamicconfig.GetIntPropertyFilteredByDomain(10)
+				eft.ShardCtx.Resource.VisibilityMgr.On("CountWorkflowExecutions", mock.Anything, &persistence.CountWorkflowExecutionsRequest{
+					DomainUUID: constants.TestDomainID,
+					Domain:     constants.TestDomainName,
+					Query:      openworkflows.CountQuery,
+				}).Return(&persistence.CountWorkflowExecutionsResponse{Count: 10}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "open workflows limit of domain is not enforced without advanced visibility",
+			request: &types.HistoryStartWorkflowExecutionRequest{
+				DomainUUID: constants.TestDomainID,
+				StartRequest: &types.StartWorkflowExecutionRequest{
+					Domain:                              constants.TestDomainName,
+					WorkflowID:                          "workflow-id",
+					WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+					TaskList:                            &types.TaskList{Name: "default-task-list"},
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600), // 1 hour
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),   // 10 seconds
+					Identity:                            "workflow-starter",
+					RequestID:                           "request-id-for-start",
+				},
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.Engine.(*historyEngineImpl).config.DomainOpenWorkflowsCountLimit = dynamicconfig.GetIntPropertyFilteredByDomain(10)
+				eft.ShardCtx.Resource.VisibilityMgr.On("CountWorkflowExecutions", mock.Anything, mock.Anything).
+					Return(nil, persistence.ErrVisibilityOperationNotSupported).Once()
+				eft.ShardCtx.Resource.ExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.CreateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+				eft.ShardCtx.Resource.ShardMgr.
+					On("UpdateShard", mock.Anything, mock.Anything).
+					Return(nil)
+				eft.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.AnythingOfType("*persistence.AppendHistoryNodesRequest")).
+					Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "open workflows limit of domain does not apply to child workflows",
+			request: &types.HistoryStartWorkflowExecutionRequest{
+				DomainUUID: constants.TestDomainID,
+				StartRequest: &types.StartWorkflowExecutionRequest{
+					Domain:                              constants.TestDomainName,
+					WorkflowID:                          "workflow-id",
+					WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+					TaskList:                            &types.TaskList{Name: "default-task-list"},
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600), // 1 hour
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),   // 10 seconds
+					Identity:                            "workflow-starter",
+					RequestID:                           "request-id-for-start",
+				},
+				ParentExecutionInfo: &types.ParentExecutionInfo{
+					DomainUUID:  constants.TestDomainID,
+					Domain:      constants.TestDomainName,
+					Execution:   &types.WorkflowExecution{WorkflowID: "parent-workflow-id", RunID: constants.TestRunID},
+					InitiatedID: 5,
+				},
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.Engine.(*historyEngineImpl).config.DomainOpenWorkflowsCountLimit = dynamicconfig.GetIntPropertyFilteredByDomain(10)
+				eft.ShardCtx.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestLocalDomainEntry, nil).AnyTimes()
+				eft.ShardCtx.Resource.ExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.CreateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+				eft.ShardCtx.Resource.ShardMgr.
+					On("UpdateShard", mock.Anything, mock.Anything).
+					Return(nil)
+				eft.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.AnythingOfType("*persistence.AppendHistoryNodesRequest")).
+					Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "eager workflow start returns the first decision task",
+			request: &types.HistoryStartWorkflowExecutionRequest{
+				DomainUUID: constants.TestDomainID,
+				StartRequest: &types.StartWorkflowExecutionRequest{
+					Domain:                              constants.TestDomainName,
+					WorkflowID:                          "workflow-id",
+					WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+					TaskList:                            &types.TaskList{Name: "default-task-list"},
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600), // 1 hour
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),   // 10 seconds
+					Identity:                            "workflow-starter",
+					RequestID:                           "request-id-for-start",
+				},
+				RequestEagerExecution: true,
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.ShardCtx.Resource.ExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.CreateWorkflowExecutionRequest) bool {
+					for _, task := range req.NewWorkflowSnapshot.TransferTasks {
+						if task.GetType() == persistence.TransferTaskTypeDecisionTask {
+							return false
+						}
+					}
+					return true
+				})).Return(&persistence.CreateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+				eft.ShardCtx.Resource.ShardMgr.
+					On("UpdateShard", mock.Anything, mock.Anything).
+					Return(nil)
+				eft.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.AnythingOfType("*persistence.AppendHistoryNodesRequest")).
+					Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+			},
+			wantErr: false,
+			assertResult: func(t *testing.T, resp *types.StartWorkflowExecutionResponse) {
+				decisionTask := resp.GetDecisionTask()
+				if assert.NotNil(t, decisionTask) {
+					assert.NotEmpty(t, decisionTask.TaskToken)
+					assert.Equal(t, resp.RunID, decisionTask.WorkflowExecution.GetRunID())
+					assert.Equal(t, int64(3), decisionTask.StartedEventID)
+					assert.Equal(t, int64(4), decisionTask.NextEventID)
+					assert.Len(t, decisionTask.History.Events, 3)
+					assert.Equal(t, types.EventTypeDecisionTaskStarted, decisionTask.History.Events[2].GetEventType())
+				}
+			},
+		},
+		{
+			name: "eager workflow start with delayed first decision falls back to regular dispatch",
+			request: &types.HistoryStartWorkflowExecutionRequest{
+				DomainUUID: constants.TestDomainID,
+				StartRequest: &types.StartWorkflowExecutionRequest{
+					Domain:                              constants.TestDomainName,
+					WorkflowID:                          "workflow-id",
+					WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+					TaskList:                            &types.TaskList{Name: "default-task-list"},
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600), // 1 hour
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),   // 10 seconds
+					Identity:                            "workflow-starter",
+					RequestID:                           "request-id-for-start",
+				},
+				FirstDecisionTaskBackoffSeconds: common.Int32Ptr(60),
+				RequestEagerExecution:           true,
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.ShardCtx.Resource.ExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.CreateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+				eft.ShardCtx.Resource.ShardMgr.
+					On("UpdateShard", mock.Anything, mock.Anything).
+					Return(nil)
+				eft.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.AnythingOfType("*persistence.AppendHistoryNodesRequest")).
+					Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+			},
+			wantErr: false,
+			assertResult: func(t *testing.T, resp *types.StartWorkflowExecutionResponse) {
+				assert.Nil(t, resp.GetDecisionTask())
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -240,10 +403,13 @@ func TestStartWorkflowExecution(t *testing.T) {
 
 			tc.setupMocks(t, eft)
 
-			_, err := eft.Engine.StartWorkflowExecution(context.Background(), tc.request)
+			resp, err := eft.Engine.StartWorkflowExecution(context.Background(), tc.request)
 			if (err != nil) != tc.wantErr {
 				t.Fatalf("%s: StartWorkflowExecution() error = %v, wantErr %v", tc.name, err, tc.wantErr)
 			}
+			if tc.assertResult != nil {
+				tc.assertResult(t, resp)
+			}
 		})
 	}
 }
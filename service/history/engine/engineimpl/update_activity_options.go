@@ -0,0 +1,180 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2021 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// UpdateActivityOptions changes the options of a pending activity.
+// If the activity is not started yet, its next attempt is scheduled immediately with the new options,
+// otherwise the new options take effect from the next attempt.
+func (e *historyEngineImpl) UpdateActivityOptions(
+	ctx context.Context,
+	updateRequest *types.HistoryUpdateActivityOptionsRequest,
+) (*types.UpdateActivityOptionsResponse, error) {
+
+	domainEntry, err := e.getActiveDomainByID(updateRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := updateRequest.Request
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.Execution.GetWorkflowID(),
+		RunID:      request.Execution.GetRunID(),
+	}
+
+	var response *types.UpdateActivityOptionsResponse
+	err = workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(request.GetActivityID())
+			if !ok {
+				return nil, workflow.ErrActivityTaskNotFound
+			}
+			if ai.CancelRequested {
+				return nil, &types.BadRequestError{Message: "Cannot update options of an activity with cancellation requested."}
+			}
+
+			now := e.timeSource.Now()
+			if request.TaskList != nil {
+				ai.TaskList = request.TaskList.GetName()
+			}
+			if request.ScheduleToStartTimeoutSeconds != nil {
+				ai.ScheduleToStartTimeout = *request.ScheduleToStartTimeoutSeconds
+			}
+			if request.ScheduleToCloseTimeoutSeconds != nil {
+				ai.ScheduleToCloseTimeout = *request.ScheduleToCloseTimeoutSeconds
+			}
+			if request.StartToCloseTimeoutSeconds != nil {
+				ai.StartToCloseTimeout = *request.StartToCloseTimeoutSeconds
+			}
+			if request.HeartbeatTimeoutSeconds != nil {
+				ai.HeartbeatTimeout = *request.HeartbeatTimeoutSeconds
+			}
+			if retryPolicy := request.RetryPolicy; retryPolicy != nil {
+				ai.HasRetryPolicy = true
+				ai.InitialInterval = retryPolicy.GetInitialIntervalInSeconds()
+				ai.BackoffCoefficient = retryPolicy.GetBackoffCoefficient()
+				ai.MaximumInterval = retryPolicy.GetMaximumIntervalInSeconds()
+				ai.MaximumAttempts = retryPolicy.GetMaximumAttempts()
+				ai.NonRetriableErrors = retryPolicy.GetNonRetriableErrorReasons()
+				ai.ExpirationTime = time.Time{}
+				if expiration := retryPolicy.GetExpirationIntervalInSeconds(); expiration > 0 {
+					ai.ExpirationTime = now.Add(common.SecondsToDuration(int64(expiration)))
+				}
+			}
+			if ai.StartedID == common.EmptyEventID {
+				// scheduling another attempt makes the retry timer of the current attempt stale, so the
+				// activity is dispatched only once. Resetting the attempts restarts the counter, so the
+				// backoff of the following retries restarts from the initial interval too.
+				if request.GetResetAttempts() {
+					ai.Attempt = 0
+				} else {
+					ai.Attempt++
+				}
+				ai.ScheduledTime = now
+			}
+			if request.GetResetAttempts() {
+				if err := resetActivityRetryBudget(ctx, mutableState, ai, request.RetryPolicy, now); err != nil {
+					return nil, err
+				}
+			}
+			ai.Version = mutableState.GetCurrentVersion()
+			if err := mutableState.UpdateActivity(ai); err != nil {
+				return nil, err
+			}
+
+			mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetClusterMetadata(),
+				e.shard.GetDomainCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetShardID(),
+			)
+			if err := mutableStateTaskRefresher.RefreshActivityTasks(ctx, mutableState, ai.ScheduleID); err != nil {
+				return nil, err
+			}
+
+			activity, err := newPendingActivityInfo(ctx, mutableState, ai)
+			if err != nil {
+				return nil, err
+			}
+			response = &types.UpdateActivityOptionsResponse{Activity: activity}
+			return workflow.UpdateWithoutDecision, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// resetActivityRetryBudget counts the maximum attempts and the expiration interval of the retry policy
+// from the current attempt on. The retry policy in the request is used if there is one, otherwise the
+// retry policy the activity was scheduled with. The attempt of a started activity is part of the task
+// token of its worker, so its counter and backoff keep going and only the budget is reset.
+func resetActivityRetryBudget(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	ai *persistence.ActivityInfo,
+	retryPolicy *types.RetryPolicy,
+	now time.Time,
+) error {
+	if !ai.HasRetryPolicy {
+		return nil
+	}
+	if retryPolicy == nil {
+		scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, ai.ScheduleID)
+		if err != nil {
+			return err
+		}
+		if attributes := scheduledEvent.GetActivityTaskScheduledEventAttributes(); attributes != nil {
+			retryPolicy = attributes.RetryPolicy
+		}
+	}
+	if maximumAttempts := retryPolicy.GetMaximumAttempts(); maximumAttempts > 0 {
+		ai.MaximumAttempts = ai.Attempt + maximumAttempts
+	}
+	if expiration := retryPolicy.GetExpirationIntervalInSeconds(); expiration > 0 {
+		ai.ExpirationTime = now.Add(common.SecondsToDuration(int64(expiration)))
+	}
+	return nil
+}
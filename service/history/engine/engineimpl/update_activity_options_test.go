@@ -0,0 +1,266 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+)
+
+func TestUpdateActivityOptions(t *testing.T) {
+	const (
+		activityID = "activity-id"
+		scheduleID = int64(5)
+	)
+	execution := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+
+	tests := []struct {
+		name            string
+		request         *types.UpdateActivityOptionsRequest
+		activityInfo    *persistence.ActivityInfo
+		getWFExecErr    error
+		updateWFExecErr error
+		wantErr         error
+		wantTimerTasks  int
+		assertActivity  func(*testing.T, *persistence.ActivityInfo, *types.PendingActivityInfo)
+	}{
+		{
+			name: "failed to get workflow execution",
+			request: &types.UpdateActivityOptionsRequest{
+				Execution:  &execution,
+				ActivityID: activityID,
+			},
+			getWFExecErr: errors.New("some random error"),
+			wantErr:      errors.New("some random error"),
+		},
+		{
+			name: "activity not found",
+			request: &types.UpdateActivityOptionsRequest{
+				Execution:  &execution,
+				ActivityID: "unknown-activity-id",
+			},
+			wantErr: &types.EntityNotExistsError{Message: "activity task not found"},
+		},
+		{
+			name: "activity cancel requested",
+			request: &types.UpdateActivityOptionsRequest{
+				Execution:  &execution,
+				ActivityID: activityID,
+			},
+			activityInfo: &persistence.ActivityInfo{
+				CancelRequested: true,
+			},
+			wantErr: &types.BadRequestError{Message: "Cannot update options of an activity with cancellation requested."},
+		},
+		{
+			name: "failed to update workflow execution",
+			request: &types.UpdateActivityOptionsRequest{
+				Execution:     &execution,
+				ActivityID:    activityID,
+				ResetAttempts: true,
+			},
+			// returning TimeoutError because it doesn't get retried
+			updateWFExecErr: &persistence.TimeoutError{},
+			wantErr:         &persistence.TimeoutError{},
+		},
+		{
+			name: "success - scheduled activity is retried immediately",
+			request: &types.UpdateActivityOptionsRequest{
+				Execution:                     &execution,
+				ActivityID:                    activityID,
+				TaskList:                      &types.TaskList{Name: "new-tasklist"},
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+				RetryPolicy: &types.RetryPolicy{
+					InitialIntervalInSeconds: 1,
+					BackoffCoefficient:       2,
+					MaximumAttempts:          10,
+				},
+				ResetAttempts: true,
+			},
+			// activity retry timer and schedule to close timer
+			wantTimerTasks: 2,
+			assertActivity: func(t *testing.T, ai *persistence.ActivityInfo, p *types.PendingActivityInfo) {
+				assert.Equal(t, "new-tasklist", ai.TaskList)
+				assert.Equal(t, int32(100), ai.ScheduleToCloseTimeout)
+				assert.Equal(t, int32(50), ai.StartToCloseTimeout)
+				assert.Equal(t, int32(10), ai.ScheduleToStartTimeout)
+				assert.True(t, ai.HasRetryPolicy)
+				// the attempt counter restarts
+				assert.Equal(t, int32(10), ai.MaximumAttempts)
+				assert.Equal(t, int32(0), ai.Attempt)
+
+				assert.Equal(t, activityID, p.ActivityID)
+				assert.Equal(t, types.PendingActivityStateScheduled, *p.State)
+				assert.Equal(t, int32(0), p.Attempt)
+				assert.Equal(t, int32(10), p.MaximumAttempts)
+			},
+		},
+		{
+			name: "success - scheduled activity keeps counting attempts without reset",
+			request: &types.UpdateActivityOptionsRequest{
+				Execution:  &execution,
+				ActivityID: activityID,
+				RetryPolicy: &types.RetryPolicy{
+					InitialIntervalInSeconds: 1,
+					BackoffCoefficient:       2,
+					MaximumAttempts:          10,
+				},
+			},
+			// activity retry timer and schedule to close timer
+			wantTimerTasks: 2,
+			assertActivity: func(t *testing.T, ai *persistence.ActivityInfo, p *types.PendingActivityInfo) {
+				assert.Equal(t, int32(4), ai.Attempt)
+				assert.Equal(t, int32(10), ai.MaximumAttempts)
+			},
+		},
+		{
+			name: "success - started activity resets attempts with the scheduled retry policy",
+			request: &types.UpdateActivityOptionsRequest{
+				Execution:     &execution,
+				ActivityID:    activityID,
+				ResetAttempts: true,
+			},
+			activityInfo: &persistence.ActivityInfo{
+				StartedID:       scheduleID + 1,
+				HasRetryPolicy:  true,
+				MaximumAttempts: 5,
+				ScheduledEvent: &types.HistoryEvent{ID: scheduleID, ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+					RetryPolicy: &types.RetryPolicy{MaximumAttempts: 5},
+				}},
+			},
+			// start to close timer
+			wantTimerTasks: 1,
+			assertActivity: func(t *testing.T, ai *persistence.ActivityInfo, p *types.PendingActivityInfo) {
+				assert.Equal(t, int32(3), ai.Attempt)
+				assert.Equal(t, int32(8), ai.MaximumAttempts)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+			eft.Engine.Start()
+			defer eft.Engine.Stop()
+
+			ai := &persistence.ActivityInfo{
+				ScheduleID:             scheduleID,
+				ScheduledEventBatchID:  scheduleID,
+				ScheduledEvent:         &types.HistoryEvent{ID: scheduleID, ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{}},
+				StartedID:              common.EmptyEventID,
+				DomainID:               constants.TestDomainID,
+				ActivityID:             activityID,
+				ScheduleToStartTimeout: 10,
+				ScheduleToCloseTimeout: 20,
+				StartToCloseTimeout:    10,
+				TaskList:               "tasklist",
+				Attempt:                3,
+			}
+			if tc.activityInfo != nil {
+				ai.CancelRequested = tc.activityInfo.CancelRequested
+				if tc.activityInfo.StartedID != 0 {
+					ai.StartedID = tc.activityInfo.StartedID
+				}
+				if tc.activityInfo.ScheduledEvent != nil {
+					ai.ScheduledEvent = tc.activityInfo.ScheduledEvent
+				}
+				ai.HasRetryPolicy = tc.activityInfo.HasRetryPolicy
+				ai.MaximumAttempts = tc.activityInfo.MaximumAttempts
+			}
+
+			// GetWorkflowExecution prep
+			getExecReq := &persistence.GetWorkflowExecutionRequest{
+				DomainID:   constants.TestDomainID,
+				Execution:  execution,
+				DomainName: constants.TestDomainName,
+				RangeID:    1,
+			}
+			getExecResp := &persistence.GetWorkflowExecutionResponse{
+				State: &persistence.WorkflowMutableState{
+					ExecutionInfo: &persistence.WorkflowExecutionInfo{
+						DomainID:   constants.TestDomainID,
+						WorkflowID: constants.TestWorkflowID,
+						RunID:      constants.TestRunID,
+						State:      persistence.WorkflowStateRunning,
+					},
+					ActivityInfos:  map[int64]*persistence.ActivityInfo{scheduleID: ai},
+					ExecutionStats: &persistence.ExecutionStats{},
+				},
+				MutableStateStats: &persistence.MutableStateStats{},
+			}
+			eft.ShardCtx.Resource.ExecutionMgr.
+				On("GetWorkflowExecution", mock.Anything, getExecReq).
+				Return(getExecResp, tc.getWFExecErr).
+				Once()
+
+			// UpdateWorkflowExecution prep
+			var gotUpdateExecReq *persistence.UpdateWorkflowExecutionRequest
+			eft.ShardCtx.Resource.ExecutionMgr.
+				On("UpdateWorkflowExecution", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					gotUpdateExecReq = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+				}).
+				Return(&persistence.UpdateWorkflowExecutionResponse{
+					MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+				}, tc.updateWFExecErr).
+				Once()
+
+			// UpdateShard prep. this is needed to update the shard's rangeID for failure cases.
+			eft.ShardCtx.Resource.ShardMgr.
+				On("UpdateShard", mock.Anything, mock.Anything).
+				Return(nil)
+
+			tc.request.Domain = constants.TestDomainName
+			resp, err := eft.Engine.UpdateActivityOptions(context.Background(), &types.HistoryUpdateActivityOptionsRequest{
+				DomainUUID: constants.TestDomainID,
+				Request:    tc.request,
+			})
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, gotUpdateExecReq)
+
+			mutation := gotUpdateExecReq.UpdateWorkflowMutation
+			require.Len(t, mutation.UpsertActivityInfos, 1)
+			assert.Len(t, mutation.TimerTasks, tc.wantTimerTasks)
+			tc.assertActivity(t, mutation.UpsertActivityInfos[0], resp.Activity)
+		})
+	}
+}
@@ -66,10 +66,13 @@ type (
 		QueryWorkflow(ctx context.Context, request *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
 		ReapplyEvents(ctx context.Context, domainUUID string, workflowID string, runID string, events []*types.HistoryEvent) error
 		CountDLQMessages(ctx context.Context, forceFetch bool) (map[string]int64, error)
+		GetReplicationStatus(ctx context.Context, request *types.GetReplicationStatusRequest) ([]*types.ReplicationShardStatus, error)
 		ReadDLQMessages(ctx context.Context, messagesRequest *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 		PurgeDLQMessages(ctx context.Context, messagesRequest *types.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error
+		RebuildWorkflowBranch(ctx context.Context, domainUUID string, execution types.WorkflowExecution, branchToken []byte) error
+		UpdateActivityOptions(ctx context.Context, request *types.HistoryUpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error)
 		ResetTransferQueue(ctx context.Context, clusterName string) error
 		ResetTimerQueue(ctx context.Context, clusterName string) error
 		DescribeTransferQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
@@ -79,5 +82,7 @@ type (
 		NotifyNewTransferTasks(info *hcommon.NotifyTaskInfo)
 		NotifyNewTimerTasks(info *hcommon.NotifyTaskInfo)
 		NotifyNewReplicationTasks(info *hcommon.NotifyTaskInfo)
+		// WatchReplicationTasks returns a channel which is closed once new replication tasks are written to the shard
+		WatchReplicationTasks() <-chan struct{}
 	}
 )
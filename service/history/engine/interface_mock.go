@@ -186,6 +186,21 @@ func (mr *MockEngineMockRecorder) GetReplicationMessages(ctx, pollingCluster, la
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetReplicationMessages), ctx, pollingCluster, lastReadMessageID)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockEngine) GetReplicationStatus(ctx context.Context, request *types.GetReplicationStatusRequest) ([]*types.ReplicationShardStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", ctx, request)
+	ret0, _ := ret[0].([]*types.ReplicationShardStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockEngineMockRecorder) GetReplicationStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockEngine)(nil).GetReplicationStatus), ctx, request)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockEngine) MergeDLQMessages(ctx context.Context, messagesRequest *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +337,20 @@ func (mr *MockEngineMockRecorder) ReapplyEvents(ctx, domainUUID, workflowID, run
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockEngine)(nil).ReapplyEvents), ctx, domainUUID, workflowID, runID, events)
 }
 
+// RebuildWorkflowBranch mocks base method.
+func (m *MockEngine) RebuildWorkflowBranch(ctx context.Context, domainUUID string, execution types.WorkflowExecution, branchToken []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildWorkflowBranch", ctx, domainUUID, execution, branchToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildWorkflowBranch indicates an expected call of RebuildWorkflowBranch.
+func (mr *MockEngineMockRecorder) RebuildWorkflowBranch(ctx, domainUUID, execution, branchToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildWorkflowBranch", reflect.TypeOf((*MockEngine)(nil).RebuildWorkflowBranch), ctx, domainUUID, execution, branchToken)
+}
+
 // RecordActivityTaskHeartbeat mocks base method.
 func (m *MockEngine) RecordActivityTaskHeartbeat(ctx context.Context, request *types.HistoryRecordActivityTaskHeartbeatRequest) (*types.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -689,3 +718,32 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
+
+// UpdateActivityOptions mocks base method.
+func (m *MockEngine) UpdateActivityOptions(ctx context.Context, request *types.HistoryUpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", ctx, request)
+	ret0, _ := ret[0].(*types.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockEngineMockRecorder) UpdateActivityOptions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockEngine)(nil).UpdateActivityOptions), ctx, request)
+}
+
+// WatchReplicationTasks mocks base method.
+func (m *MockEngine) WatchReplicationTasks() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchReplicationTasks")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// WatchReplicationTasks indicates an expected call of WatchReplicationTasks.
+func (mr *MockEngineMockRecorder) WatchReplicationTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchReplicationTasks", reflect.TypeOf((*MockEngine)(nil).WatchReplicationTasks))
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/failover"
+	"github.com/uber/cadence/service/history/openworkflows"
 	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/history/shard"
@@ -64,6 +65,7 @@ type NewEngineFn func(
 	queueTaskProcessor task.Processor,
 	failoverCoordinator failover.Coordinator,
 	wfIDCache workflowcache.WFCache,
+	openWorkflowsCounter *openworkflows.Counter,
 	queueProcessorFactory queue.ProcessorFactory,
 ) engine.Engine
 
@@ -165,6 +167,7 @@ func NewEngineForTest(t *testing.T, newEngineFn NewEngineFn) *EngineForTest {
 		queueTaskProcessor,
 		failoverCoordinator,
 		wfIDCache,
+		openworkflows.NewCounter(shardCtx.Resource.VisibilityMgr, shardCtx.GetTimeSource(), historyCfg.DomainOpenWorkflowsCountCacheTTL),
 		queueProcessorFactory,
 	)
 
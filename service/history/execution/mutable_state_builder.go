@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationfilter"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/events"
@@ -1736,6 +1737,7 @@ func (e *mutableStateBuilder) eventsToReplicationTask(
 
 	if transactionPolicy == TransactionPolicyPassive ||
 		!e.canReplicateEvents() ||
+		e.isReplicationFiltered() ||
 		len(events) == 0 {
 		return emptyTasks, nil
 	}
@@ -1780,7 +1782,8 @@ func (e *mutableStateBuilder) syncActivityToReplicationTask(
 ) []persistence.Task {
 
 	if transactionPolicy == TransactionPolicyPassive ||
-		!e.canReplicateEvents() {
+		!e.canReplicateEvents() ||
+		e.isReplicationFiltered() {
 		return emptyTasks
 	}
 
@@ -1822,6 +1825,12 @@ func (e *mutableStateBuilder) updateWithLastFirstEvent(
 	e.GetExecutionInfo().SetLastFirstEventID(lastFirstEvent.ID)
 }
 
+// isReplicationFiltered returns true if the workflow was excluded from replication by the replication filters
+// of its domain when it started. Unlike local domains, such workflows still go through the active cluster check.
+func (e *mutableStateBuilder) isReplicationFiltered() bool {
+	return replicationfilter.IsSingleCluster(e.executionInfo.PartitionConfig)
+}
+
 func (e *mutableStateBuilder) canReplicateEvents() bool {
 	if e.domainEntry.GetReplicationPolicy() == cache.ReplicationPolicyOneCluster {
 		return false
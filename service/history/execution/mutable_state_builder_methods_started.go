@@ -28,8 +28,10 @@ import (
 	"github.com/pborman/uuid"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationfilter"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -157,6 +159,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 		return nil, e.createInternalServerError(opTag)
 	}
 
+	startRequest = e.applyReplicationFilters(startRequest)
 	event := e.hBuilder.AddWorkflowExecutionStartedEvent(startRequest, nil, execution.GetRunID(), execution.GetRunID(),
 		time.Now())
 
@@ -176,6 +179,40 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 	return event, nil
 }
 
+// applyReplicationFilters marks a new root workflow as single-cluster in its partition config when it matches the
+// replication filters of its domain. The decision is made once at start, continue-as-new runs and child workflows
+// inherit it through the partition config, so a workflow is either replicated from its first event or not at all.
+// Child workflows always follow their parent, as a child kept out of the clusters its parent replicates to
+// would leave the parent waiting on a child that does not exist there.
+func (e *mutableStateBuilder) applyReplicationFilters(
+	startRequest *types.HistoryStartWorkflowExecutionRequest,
+) *types.HistoryStartWorkflowExecutionRequest {
+	if e.domainEntry.GetReplicationPolicy() == cache.ReplicationPolicyOneCluster ||
+		startRequest.ParentExecutionInfo != nil ||
+		replicationfilter.IsSingleCluster(startRequest.PartitionConfig) {
+		return startRequest
+	}
+
+	filters, err := replicationfilter.ParseFilters(e.domainEntry.GetInfo().Data)
+	if err != nil {
+		e.logger.Error("Failed to parse replication filters of domain, workflow will be replicated.", tag.Error(err))
+		return startRequest
+	}
+	request := startRequest.StartRequest
+	if !replicationfilter.Match(filters, request.WorkflowType.GetName(), request.SearchAttributes) {
+		return startRequest
+	}
+
+	partitionConfig := make(map[string]string, len(startRequest.PartitionConfig)+1)
+	for k, v := range startRequest.PartitionConfig {
+		partitionConfig[k] = v
+	}
+	partitionConfig[replicationfilter.SingleClusterKey] = e.clusterMetadata.GetCurrentClusterName()
+	filteredRequest := *startRequest
+	filteredRequest.PartitionConfig = partitionConfig
+	return &filteredRequest
+}
+
 func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	parentDomainID *string,
 	execution types.WorkflowExecution,
@@ -48,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationfilter"
 	"github.com/uber/cadence/common/testing/testdatagen"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
@@ -883,6 +884,108 @@ func (s *mutableStateSuite) TestUpdateCurrentVersion_WorkflowClosed() {
 	s.Equal(lastWriteVersion, s.msBuilder.GetCurrentVersion())
 }
 
+func (s *mutableStateSuite) TestAddWorkflowExecutionStartedEvent_ReplicationFilters() {
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   constants.TestDomainID,
+			Name: constants.TestDomainName,
+			Data: map[string]string{
+				common.DomainDataKeyForReplicationFilters: `[{"workflowType":"local-workflow"},{"searchAttribute":"Region","searchAttributeValue":"us-east"}]`,
+			},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		cluster.TestCurrentClusterInitialFailoverVersion,
+	)
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	parentExecutionInfo := &types.ParentExecutionInfo{
+		DomainUUID:  constants.TestDomainID,
+		Domain:      constants.TestDomainName,
+		Execution:   &types.WorkflowExecution{WorkflowID: "parent-workflow-id", RunID: constants.TestRunID},
+		InitiatedID: 5,
+	}
+
+	tests := map[string]struct {
+		workflowType          string
+		searchAttributes      *types.SearchAttributes
+		parentExecutionInfo   *types.ParentExecutionInfo
+		partitionConfig       map[string]string
+		expectPartitionConfig map[string]string
+	}{
+		"not filtered": {
+			workflowType:          "wType",
+			searchAttributes:      &types.SearchAttributes{IndexedFields: map[string][]byte{"Region": []byte(`"us-west"`)}},
+			partitionConfig:       map[string]string{"userid": "1"},
+			expectPartitionConfig: map[string]string{"userid": "1"},
+		},
+		"filtered by workflow type": {
+			workflowType:    "local-workflow",
+			partitionConfig: map[string]string{"userid": "1"},
+			expectPartitionConfig: map[string]string{
+				"userid":                           "1",
+				replicationfilter.SingleClusterKey: cluster.TestCurrentClusterName,
+			},
+		},
+		"filtered by search attribute": {
+			workflowType:          "wType",
+			searchAttributes:      &types.SearchAttributes{IndexedFields: map[string][]byte{"Region": []byte(`"us-east"`)}},
+			expectPartitionConfig: map[string]string{replicationfilter.SingleClusterKey: cluster.TestCurrentClusterName},
+		},
+		"inherited from parent": {
+			workflowType:          "wType",
+			parentExecutionInfo:   parentExecutionInfo,
+			partitionConfig:       map[string]string{replicationfilter.SingleClusterKey: cluster.TestAlternativeClusterName},
+			expectPartitionConfig: map[string]string{replicationfilter.SingleClusterKey: cluster.TestAlternativeClusterName},
+		},
+		"child of replicated parent is not filtered": {
+			workflowType:          "local-workflow",
+			parentExecutionInfo:   parentExecutionInfo,
+			partitionConfig:       map[string]string{"userid": "1"},
+			expectPartitionConfig: map[string]string{"userid": "1"},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			msBuilder := NewMutableStateBuilderWithVersionHistories(s.mockShard, s.logger, domainEntry).(*mutableStateBuilder)
+			event, err := msBuilder.AddWorkflowExecutionStartedEvent(
+				types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID},
+				&types.HistoryStartWorkflowExecutionRequest{
+					DomainUUID: constants.TestDomainID,
+					StartRequest: &types.StartWorkflowExecutionRequest{
+						Domain:                              constants.TestDomainName,
+						WorkflowID:                          constants.TestWorkflowID,
+						WorkflowType:                        &types.WorkflowType{Name: tc.workflowType},
+						TaskList:                            &types.TaskList{Name: "tasklist"},
+						ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+						TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+						SearchAttributes:                    tc.searchAttributes,
+					},
+					ParentExecutionInfo: tc.parentExecutionInfo,
+					PartitionConfig:     tc.partitionConfig,
+				},
+			)
+			s.NoError(err)
+			s.Equal(tc.expectPartitionConfig, event.WorkflowExecutionStartedEventAttributes.PartitionConfig)
+			s.Equal(tc.expectPartitionConfig, msBuilder.GetExecutionInfo().PartitionConfig)
+
+			replicationTasks, err := msBuilder.eventsToReplicationTask(TransactionPolicyActive, []*types.HistoryEvent{event})
+			s.NoError(err)
+			if replicationfilter.IsSingleCluster(tc.expectPartitionConfig) {
+				s.Empty(replicationTasks)
+			} else {
+				s.Len(replicationTasks, 1)
+			}
+		})
+	}
+}
+
 func (s *mutableStateSuite) newDomainCacheEntry() *cache.DomainCacheEntry {
 	return cache.NewDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: "mutableStateTest"},
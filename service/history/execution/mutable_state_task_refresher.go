@@ -24,6 +24,7 @@ package execution
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -41,6 +42,7 @@ type (
 	// MutableStateTaskRefresher refreshes workflow transfer and timer tasks
 	MutableStateTaskRefresher interface {
 		RefreshTasks(ctx context.Context, startTime time.Time, mutableState MutableState) error
+		RefreshActivityTasks(ctx context.Context, mutableState MutableState, activityScheduleID int64) error
 	}
 
 	mutableStateTaskRefresherImpl struct {
@@ -61,6 +63,7 @@ type (
 		refreshTasksForRequestCancelExternalWorkflowFn func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error
 		refreshTasksForSignalExternalWorkflowFn        func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error
 		refreshTasksForWorkflowSearchAttrFn            func(context.Context, MutableState, MutableStateTaskGenerator) error
+		refreshTasksForPendingActivityFn               func(context.Context, MutableState, MutableStateTaskGenerator, int64, func(MutableState) TimerSequence) error
 	}
 )
 
@@ -90,6 +93,7 @@ func NewMutableStateTaskRefresher(
 		refreshTasksForRequestCancelExternalWorkflowFn: refreshTasksForRequestCancelExternalWorkflow,
 		refreshTasksForSignalExternalWorkflowFn:        refreshTasksForSignalExternalWorkflow,
 		refreshTasksForWorkflowSearchAttrFn:            refreshTasksForWorkflowSearchAttr,
+		refreshTasksForPendingActivityFn:               refreshTasksForPendingActivity,
 	}
 }
 
@@ -201,6 +205,28 @@ func (r *mutableStateTaskRefresherImpl) RefreshTasks(
 	return nil
 }
 
+// RefreshActivityTasks regenerates the tasks of a single pending activity after its options have been changed.
+// An activity which is not started is dispatched again at its scheduled time.
+func (r *mutableStateTaskRefresherImpl) RefreshActivityTasks(
+	ctx context.Context,
+	mutableState MutableState,
+	activityScheduleID int64,
+) error {
+	taskGenerator := r.newMutableStateTaskGeneratorFn(
+		r.clusterMetadata,
+		r.domainCache,
+		mutableState,
+	)
+
+	return r.refreshTasksForPendingActivityFn(
+		ctx,
+		mutableState,
+		taskGenerator,
+		activityScheduleID,
+		NewTimerSequence,
+	)
+}
+
 func refreshTasksForWorkflowStart(
 	ctx context.Context,
 	startTime time.Time,
@@ -352,6 +378,47 @@ func refreshTasksForActivity(
 	return nil
 }
 
+func refreshTasksForPendingActivity(
+	ctx context.Context,
+	mutableState MutableState,
+	taskGenerator MutableStateTaskGenerator,
+	activityScheduleID int64,
+	newTimerSequenceFn func(MutableState) TimerSequence,
+) error {
+	activityInfo, ok := mutableState.GetActivityInfo(activityScheduleID)
+	if !ok {
+		return &types.InternalServiceError{
+			Message: fmt.Sprintf("it could be a bug, cannot get pending activity: %v", activityScheduleID),
+		}
+	}
+
+	// clear the activity timer task mask, so its timers are re-generated with the latest options
+	activityInfo.TimerTaskStatus = TimerTaskStatusNone
+	if err := mutableState.UpdateActivity(
+		activityInfo,
+	); err != nil {
+		return err
+	}
+
+	if activityInfo.StartedID == common.EmptyEventID {
+		// the retry timer dispatches the activity to matching once it fires,
+		// using the task list and timeouts currently recorded in activity info
+		if err := taskGenerator.GenerateActivityRetryTasks(
+			activityScheduleID,
+		); err != nil {
+			return err
+		}
+	}
+
+	if _, err := newTimerSequenceFn(
+		mutableState,
+	).CreateNextActivityTimer(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func refreshTasksForTimer(
 	ctx context.Context,
 	mutableState MutableState,
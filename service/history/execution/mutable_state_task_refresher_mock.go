@@ -63,6 +63,20 @@ func (m *MockMutableStateTaskRefresher) EXPECT() *MockMutableStateTaskRefresherM
 	return m.recorder
 }
 
+// RefreshActivityTasks mocks base method.
+func (m *MockMutableStateTaskRefresher) RefreshActivityTasks(ctx context.Context, mutableState MutableState, activityScheduleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshActivityTasks", ctx, mutableState, activityScheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshActivityTasks indicates an expected call of RefreshActivityTasks.
+func (mr *MockMutableStateTaskRefresherMockRecorder) RefreshActivityTasks(ctx, mutableState, activityScheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshActivityTasks", reflect.TypeOf((*MockMutableStateTaskRefresher)(nil).RefreshActivityTasks), ctx, mutableState, activityScheduleID)
+}
+
 // RefreshTasks mocks base method.
 func (m *MockMutableStateTaskRefresher) RefreshTasks(ctx context.Context, startTime time.Time, mutableState MutableState) error {
 	m.ctrl.T.Helper()
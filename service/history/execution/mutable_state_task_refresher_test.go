@@ -346,6 +346,82 @@ func TestRefreshTasksForActivity(t *testing.T) {
 	}
 }
 
+func TestRefreshTasksForPendingActivity(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mockSetup func(*MockMutableState, *MockMutableStateTaskGenerator, *MockTimerSequence)
+		wantErr   bool
+	}{
+		{
+			name: "activity not found",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(nil, false)
+			},
+			wantErr: true,
+		},
+		{
+			name: "failed to update activity",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusCreated, StartedID: common.EmptyEventID}, true)
+				ms.EXPECT().UpdateActivity(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusNone, StartedID: common.EmptyEventID}).Return(errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "failed to generate activity retry tasks",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusCreated, StartedID: common.EmptyEventID}, true)
+				ms.EXPECT().UpdateActivity(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusNone, StartedID: common.EmptyEventID}).Return(nil)
+				mtg.EXPECT().GenerateActivityRetryTasks(int64(12)).Return(errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "failed to create activity timer",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusCreated, StartedID: common.EmptyEventID}, true)
+				ms.EXPECT().UpdateActivity(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusNone, StartedID: common.EmptyEventID}).Return(nil)
+				mtg.EXPECT().GenerateActivityRetryTasks(int64(12)).Return(nil)
+				mt.EXPECT().CreateNextActivityTimer().Return(false, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "success - scheduled activity",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusCreated, StartedID: common.EmptyEventID}, true)
+				ms.EXPECT().UpdateActivity(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusNone, StartedID: common.EmptyEventID}).Return(nil)
+				mtg.EXPECT().GenerateActivityRetryTasks(int64(12)).Return(nil)
+				mt.EXPECT().CreateNextActivityTimer().Return(true, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - started activity",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusCreated, StartedID: 13}, true)
+				ms.EXPECT().UpdateActivity(&persistence.ActivityInfo{ScheduleID: 12, TimerTaskStatus: TimerTaskStatusNone, StartedID: 13}).Return(nil)
+				mt.EXPECT().CreateNextActivityTimer().Return(true, nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ms := NewMockMutableState(ctrl)
+			mtg := NewMockMutableStateTaskGenerator(ctrl)
+			mt := NewMockTimerSequence(ctrl)
+			tc.mockSetup(ms, mtg, mt)
+			err := refreshTasksForPendingActivity(context.Background(), ms, mtg, 12, func(MutableState) TimerSequence { return mt })
+			if (err != nil) != tc.wantErr {
+				t.Errorf("refreshTasksForPendingActivity err = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestRefreshTasksForTimer(t *testing.T) {
 	testCases := []struct {
 		name      string
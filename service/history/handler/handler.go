@@ -52,6 +52,7 @@ import (
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/failover"
 	"github.com/uber/cadence/service/history/lookup"
+	"github.com/uber/cadence/service/history/openworkflows"
 	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/history/resource"
@@ -80,6 +81,7 @@ type (
 		queueTaskProcessor      task.Processor
 		failoverCoordinator     failover.Coordinator
 		workflowIDCache         workflowcache.WFCache
+		openWorkflowsCounter    *openworkflows.Counter
 		queueProcessorFactory   queue.ProcessorFactory
 		ratelimitAggregator     algorithm.RequestWeighted
 	}
@@ -101,6 +103,12 @@ func NewHandler(
 		rateLimiter:         quotas.NewDynamicRateLimiter(config.RPS.AsFloat64()),
 		workflowIDCache:     wfCache,
 		ratelimitAggregator: resource.GetRatelimiterAlgorithm(),
+		// the open workflow counts are shared by the engines of all shards, the limit is for the whole domain
+		openWorkflowsCounter: openworkflows.NewCounter(
+			resource.GetVisibilityManager(),
+			resource.GetTimeSource(),
+			config.DomainOpenWorkflowsCountCacheTTL,
+		),
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -212,6 +220,7 @@ func (h *handlerImpl) CreateEngine(
 		h.queueTaskProcessor,
 		h.failoverCoordinator,
 		h.workflowIDCache,
+		h.openWorkflowsCounter,
 		queue.NewProcessorFactory(),
 	)
 }
@@ -1558,34 +1567,25 @@ func (h *handlerImpl) GetReplicationMessages(
 		return nil, constants.ErrShuttingDown
 	}
 
-	var wg sync.WaitGroup
-	wg.Add(len(request.Tokens))
-	result := new(sync.Map)
-
-	for _, token := range request.Tokens {
-		go func(token *types.ReplicationToken) {
-			defer wg.Done()
-
-			engine, err := h.controller.GetEngineForShard(int(token.GetShardID()))
-			if err != nil {
-				h.GetLogger().Warn("History engine not found for shard", tag.Error(err))
-				return
-			}
-			tasks, err := engine.GetReplicationMessages(
-				ctx,
-				request.GetClusterName(),
-				token.GetLastRetrievedMessageID(),
-			)
-			if err != nil {
-				h.GetLogger().Warn("Failed to get replication tasks for shard", tag.Error(err))
-				return
-			}
-
-			result.Store(token.GetShardID(), tasks)
-		}(token)
+	var newTasks <-chan struct{}
+	if request.GetWaitForNewTasks() {
+		// watch before reading so tasks written while reading wake up the wait below
+		var cancel context.CancelFunc
+		newTasks, cancel = h.watchReplicationTasks(ctx, request.Tokens)
+		defer cancel()
 	}
 
-	wg.Wait()
+	result := h.getReplicationMessagesByShard(ctx, request)
+	if request.GetWaitForNewTasks() && !hasReplicationProgress(request.Tokens, result) {
+		timer := time.NewTimer(h.config.ReplicationTaskLongPollTimeout())
+		select {
+		case <-newTasks:
+			result = h.getReplicationMessagesByShard(ctx, request)
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+		timer.Stop()
+	}
 
 	responseSize := 0
 	maxResponseSize := h.config.MaxResponseSize
@@ -1619,6 +1619,84 @@ func (h *handlerImpl) GetReplicationMessages(
 	return &types.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
 }
 
+func (h *handlerImpl) getReplicationMessagesByShard(
+	ctx context.Context,
+	request *types.GetReplicationMessagesRequest,
+) *sync.Map {
+	var wg sync.WaitGroup
+	wg.Add(len(request.Tokens))
+	result := new(sync.Map)
+
+	for _, token := range request.Tokens {
+		go func(token *types.ReplicationToken) {
+			defer wg.Done()
+
+			engine, err := h.controller.GetEngineForShard(int(token.GetShardID()))
+			if err != nil {
+				h.GetLogger().Warn("History engine not found for shard", tag.Error(err))
+				return
+			}
+			tasks, err := engine.GetReplicationMessages(
+				ctx,
+				request.GetClusterName(),
+				token.GetLastRetrievedMessageID(),
+			)
+			if err != nil {
+				h.GetLogger().Warn("Failed to get replication tasks for shard", tag.Error(err))
+				return
+			}
+
+			result.Store(token.GetShardID(), tasks)
+		}(token)
+	}
+
+	wg.Wait()
+	return result
+}
+
+// watchReplicationTasks returns a channel which is closed once new replication tasks are written to any of the shards.
+// The returned cancel func must be called to release the watches.
+func (h *handlerImpl) watchReplicationTasks(
+	ctx context.Context,
+	tokens []*types.ReplicationToken,
+) (<-chan struct{}, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	newTasks := make(chan struct{})
+	var once sync.Once
+	for _, token := range tokens {
+		engine, err := h.controller.GetEngineForShard(int(token.GetShardID()))
+		if err != nil {
+			continue
+		}
+		go func(watch <-chan struct{}) {
+			select {
+			case <-watch:
+				once.Do(func() { close(newTasks) })
+			case <-ctx.Done():
+			}
+		}(engine.WatchReplicationTasks())
+	}
+	return newTasks, cancel
+}
+
+// hasReplicationProgress returns whether any of the shards has replication messages worth returning right away
+func hasReplicationProgress(tokens []*types.ReplicationToken, result *sync.Map) bool {
+	for _, token := range tokens {
+		value, ok := result.Load(token.GetShardID())
+		if !ok {
+			// failed shards, e.g. shards not owned by this host, don't end the wait,
+			// otherwise the caller would retry them in a busy loop
+			continue
+		}
+		messages := value.(*types.ReplicationMessages)
+		if len(messages.ReplicationTasks) > 0 || messages.HasMore ||
+			messages.LastRetrievedMessageID != token.GetLastRetrievedMessageID() {
+			return true
+		}
+	}
+	return false
+}
+
 // GetDLQReplicationMessages is called by remote peers to get replicated messages for DLQ merging
 func (h *handlerImpl) GetDLQReplicationMessages(
 	ctx context.Context,
@@ -1785,6 +1863,50 @@ func (h *handlerImpl) CountDLQMessages(
 	return &types.HistoryCountDLQMessagesResponse{Entries: entries}, h.error(err, scope, "", "", "")
 }
 
+// GetReplicationStatus returns the replication status of the shards owned by this host
+func (h *handlerImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+) (resp *types.HistoryGetReplicationStatusResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetReplicationStatusScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	g := &errgroup.Group{}
+	var mu sync.Mutex
+	var shards []*types.ReplicationShardStatus
+	for _, shardID := range h.controller.ShardIDs() {
+		shardID := shardID
+		g.Go(func() (e error) {
+			defer func() { log.CapturePanic(recover(), h.GetLogger(), &e) }()
+
+			engine, err := h.controller.GetEngineForShard(int(shardID))
+			if err != nil {
+				return fmt.Errorf("replication status for shard %d: %w", shardID, err)
+			}
+
+			statuses, err := engine.GetReplicationStatus(ctx, request)
+			if err != nil {
+				return fmt.Errorf("replication status for shard %d: %w", shardID, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			shards = append(shards, statuses...)
+			return nil
+		})
+	}
+
+	err := g.Wait()
+	return &types.HistoryGetReplicationStatusResponse{Shards: shards}, h.error(err, scope, "", "", "")
+}
+
 // ReadDLQMessages reads replication DLQ messages
 func (h *handlerImpl) ReadDLQMessages(
 	ctx context.Context,
@@ -1894,6 +2016,86 @@ func (h *handlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// RebuildWorkflowBranch rebuilds the mutable state of a workflow from one of its version history branches
+func (h *handlerImpl) RebuildWorkflowBranch(
+	ctx context.Context,
+	request *types.HistoryRebuildWorkflowBranchRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRebuildWorkflowBranchScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowID()
+	runID := execution.GetRunID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, workflowID, runID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	err = engine.RebuildWorkflowBranch(
+		ctx,
+		domainID,
+		types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		request.GetRequest().GetBranchToken(),
+	)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+	return nil
+}
+
+// UpdateActivityOptions changes the options of a pending activity and schedules its next attempt immediately
+func (h *handlerImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.HistoryUpdateActivityOptionsRequest,
+) (resp *types.UpdateActivityOptionsResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateActivityOptionsScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowID()
+	runID := execution.GetRunID()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.UpdateActivityOptions(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
 // NotifyFailoverMarkers sends the failover markers to failover coordinator.
 // The coordinator decides when the failover finishes based on received failover marker.
 func (h *handlerImpl) NotifyFailoverMarkers(
@@ -2879,6 +2879,87 @@ func (s *handlerSuite) TestGetReplicationMessages() {
 	}
 }
 
+func (s *handlerSuite) TestGetReplicationMessages_WaitForNewTasks() {
+	request := &types.GetReplicationMessagesRequest{
+		ClusterName: "test",
+		Tokens: []*types.ReplicationToken{
+			{
+				ShardID:                1,
+				LastRetrievedMessageID: 10,
+			},
+		},
+		WaitForNewTasks: true,
+	}
+	noProgress := &types.ReplicationMessages{LastRetrievedMessageID: 10}
+	withTasks := &types.ReplicationMessages{
+		LastRetrievedMessageID: 11,
+		ReplicationTasks:       []*types.ReplicationTask{{TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(), SourceTaskID: 11}},
+	}
+
+	testInput := map[string]struct {
+		mockFn   func()
+		expected *types.ReplicationMessages
+	}{
+		"tasks available": {
+			mockFn: func() {
+				s.mockEngine.EXPECT().WatchReplicationTasks().Return(make(chan struct{})).Times(1)
+				s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), "test", int64(10)).Return(withTasks, nil).Times(1)
+			},
+			expected: withTasks,
+		},
+		"tasks written while waiting": {
+			mockFn: func() {
+				newTasks := make(chan struct{})
+				s.mockEngine.EXPECT().WatchReplicationTasks().Return(newTasks).Times(1)
+				gomock.InOrder(
+					s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), "test", int64(10)).DoAndReturn(
+						func(context.Context, string, int64) (*types.ReplicationMessages, error) {
+							close(newTasks)
+							return noProgress, nil
+						}).Times(1),
+					s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), "test", int64(10)).Return(withTasks, nil).Times(1),
+				)
+			},
+			expected: withTasks,
+		},
+		"no tasks until timeout": {
+			mockFn: func() {
+				s.handler.config.ReplicationTaskLongPollTimeout = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+				s.mockEngine.EXPECT().WatchReplicationTasks().Return(make(chan struct{})).Times(1)
+				s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), "test", int64(10)).Return(noProgress, nil).Times(1)
+			},
+			expected: noProgress,
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			s.mockShardController.EXPECT().GetEngineForShard(1).Return(s.mockEngine, nil).AnyTimes()
+			input.mockFn()
+			resp, err := s.handler.GetReplicationMessages(context.Background(), request)
+			s.NoError(err)
+			s.Equal(input.expected, resp.MessagesByShard[1])
+			goleak.VerifyNone(s.T())
+		})
+	}
+}
+
+func (s *handlerSuite) TestGetReplicationMessages_WaitForNewTasks_ShardNotOwned() {
+	request := &types.GetReplicationMessagesRequest{
+		ClusterName:     "test",
+		Tokens:          []*types.ReplicationToken{{ShardID: 1, LastRetrievedMessageID: 10}},
+		WaitForNewTasks: true,
+	}
+	s.handler.config.ReplicationTaskLongPollTimeout = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	s.mockShardController.EXPECT().GetEngineForShard(1).Return(nil, &persistence.ShardOwnershipLostError{ShardID: 1}).Times(2)
+
+	start := time.Now()
+	resp, err := s.handler.GetReplicationMessages(context.Background(), request)
+	s.NoError(err)
+	s.Empty(resp.MessagesByShard)
+	s.GreaterOrEqual(time.Since(start), 10*time.Millisecond, "the request waits instead of returning right away")
+}
+
 func (s *handlerSuite) TestGetDLQReplicationMessages() {
 	validInput := &types.GetDLQReplicationMessagesRequest{
 		TaskInfos: []*types.ReplicationTaskInfo{
@@ -3106,6 +3187,69 @@ func (s *handlerSuite) TestCountDLQMessages() {
 	}
 }
 
+func (s *handlerSuite) TestGetReplicationStatus() {
+	validInput := &types.GetReplicationStatusRequest{
+		ForceFetch: true,
+	}
+	status := &types.ReplicationShardStatus{ShardID: 0, RemoteCluster: "test", ReadLevel: 2, AckedLevel: 1, MaxReadLevel: 3}
+
+	testInput := map[string]struct {
+		input         *types.GetReplicationStatusRequest
+		expectedError bool
+		expected      *types.HistoryGetReplicationStatusResponse
+		mockFn        func()
+	}{
+		"shutting down": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.handler.shuttingDown = int32(1)
+			},
+		},
+		"cannot get engine": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(gomock.Any()).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"getReplicationStatus error": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(gomock.Any()).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().GetReplicationStatus(gomock.Any(), validInput).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"success": {
+			input:         validInput,
+			expectedError: false,
+			expected:      &types.HistoryGetReplicationStatusResponse{Shards: []*types.ReplicationShardStatus{status}},
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(gomock.Any()).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().GetReplicationStatus(gomock.Any(), validInput).Return([]*types.ReplicationShardStatus{status}, nil).Times(1)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := s.handler.GetReplicationStatus(context.Background(), input.input)
+			s.handler.shuttingDown = int32(0)
+			if input.expectedError {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(input.expected, resp)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestReadDLQMessages() {
 	validInput := &types.ReadDLQMessagesRequest{
 		ShardID: 1,
@@ -3277,6 +3421,81 @@ func (s *handlerSuite) TestMergeDLQMessages() {
 	}
 }
 
+func (s *handlerSuite) TestRebuildWorkflowBranch() {
+	validInput := &types.HistoryRebuildWorkflowBranchRequest{
+		DomainUUID: testDomainID,
+		Request: &types.RebuildWorkflowBranchRequest{
+			Execution: &types.WorkflowExecution{
+				WorkflowID: testWorkflowID,
+				RunID:      testValidUUID,
+			},
+			BranchToken: []byte("branch-token"),
+		},
+	}
+
+	testInput := map[string]struct {
+		input         *types.HistoryRebuildWorkflowBranchRequest
+		expectedError bool
+		mockFn        func()
+	}{
+		"shutting down": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.handler.shuttingDown = int32(1)
+			},
+		},
+		"domain not set": {
+			input: &types.HistoryRebuildWorkflowBranchRequest{
+				Request: validInput.Request,
+			},
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"cannot get engine": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngine(testWorkflowID).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"rebuildWorkflowBranch error": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngine(testWorkflowID).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().RebuildWorkflowBranch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("error")).Times(1)
+			},
+		},
+		"success": {
+			input:         validInput,
+			expectedError: false,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngine(testWorkflowID).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().RebuildWorkflowBranch(
+					gomock.Any(),
+					testDomainID,
+					types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testValidUUID},
+					[]byte("branch-token"),
+				).Return(nil).Times(1)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			err := s.handler.RebuildWorkflowBranch(context.Background(), input.input)
+			s.handler.shuttingDown = int32(0)
+			if input.expectedError {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestRefreshWorkflowTasks() {
 	validInput := &types.HistoryRefreshWorkflowTasksRequest{
 		DomainUIID: testDomainID,
@@ -3339,6 +3558,79 @@ func (s *handlerSuite) TestRefreshWorkflowTasks() {
 	}
 }
 
+func (s *handlerSuite) TestUpdateActivityOptions() {
+	validInput := &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: testDomainID,
+		Request: &types.UpdateActivityOptionsRequest{
+			Execution: &types.WorkflowExecution{
+				WorkflowID: testWorkflowID,
+				RunID:      testValidUUID,
+			},
+			ActivityID:    "activity-id",
+			ResetAttempts: true,
+		},
+	}
+
+	testInput := map[string]struct {
+		input         *types.HistoryUpdateActivityOptionsRequest
+		expectedError bool
+		mockFn        func()
+	}{
+		"shutting down": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.handler.shuttingDown = int32(1)
+			},
+		},
+		"empty domainID": {
+			input: &types.HistoryUpdateActivityOptionsRequest{
+				DomainUUID: "",
+			},
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"cannot get engine": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngine(testWorkflowID).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"updateActivityOptions error": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngine(testWorkflowID).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().UpdateActivityOptions(gomock.Any(), validInput).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"success": {
+			input:         validInput,
+			expectedError: false,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngine(testWorkflowID).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().UpdateActivityOptions(gomock.Any(), validInput).Return(&types.UpdateActivityOptionsResponse{}, nil).Times(1)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := s.handler.UpdateActivityOptions(context.Background(), input.input)
+			s.handler.shuttingDown = int32(0)
+			if input.expectedError {
+				s.Nil(resp)
+				s.Error(err)
+			} else {
+				s.NotNil(resp)
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestStartWorkflowExecution() {
 
 	request := &types.HistoryStartWorkflowExecutionRequest{
@@ -50,6 +50,7 @@ type Handler interface {
 	DescribeWorkflowExecution(context.Context, *types.HistoryDescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 	CountDLQMessages(context.Context, *types.CountDLQMessagesRequest) (*types.HistoryCountDLQMessagesResponse, error)
+	GetReplicationStatus(context.Context, *types.GetReplicationStatusRequest) (*types.HistoryGetReplicationStatusResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 	GetMutableState(context.Context, *types.GetMutableStateRequest) (*types.GetMutableStateResponse, error)
 	GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
@@ -65,6 +66,8 @@ type Handler interface {
 	RecordChildExecutionCompleted(context.Context, *types.RecordChildExecutionCompletedRequest) error
 	RecordDecisionTaskStarted(context.Context, *types.RecordDecisionTaskStartedRequest) (*types.RecordDecisionTaskStartedResponse, error)
 	RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest) error
+	RebuildWorkflowBranch(context.Context, *types.HistoryRebuildWorkflowBranchRequest) error
+	UpdateActivityOptions(context.Context, *types.HistoryUpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error)
 	RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest) error
 	RemoveTask(context.Context, *types.RemoveTaskRequest) error
 	ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request) error
@@ -229,6 +229,21 @@ func (mr *MockHandlerMockRecorder) GetReplicationMessages(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockHandler)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockHandler) GetReplicationStatus(arg0 context.Context, arg1 *types.GetReplicationStatusRequest) (*types.HistoryGetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryGetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockHandlerMockRecorder) GetReplicationStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockHandler)(nil).GetReplicationStatus), arg0, arg1)
+}
+
 // Health mocks base method.
 func (m *MockHandler) Health(arg0 context.Context) (*types.HealthStatus, error) {
 	m.ctrl.T.Helper()
@@ -375,6 +390,20 @@ func (mr *MockHandlerMockRecorder) ReapplyEvents(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockHandler)(nil).ReapplyEvents), arg0, arg1)
 }
 
+// RebuildWorkflowBranch mocks base method.
+func (m *MockHandler) RebuildWorkflowBranch(arg0 context.Context, arg1 *types.HistoryRebuildWorkflowBranchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildWorkflowBranch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildWorkflowBranch indicates an expected call of RebuildWorkflowBranch.
+func (mr *MockHandlerMockRecorder) RebuildWorkflowBranch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildWorkflowBranch", reflect.TypeOf((*MockHandler)(nil).RebuildWorkflowBranch), arg0, arg1)
+}
+
 // RecordActivityTaskHeartbeat mocks base method.
 func (m *MockHandler) RecordActivityTaskHeartbeat(arg0 context.Context, arg1 *types.HistoryRecordActivityTaskHeartbeatRequest) (*types.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -757,3 +786,18 @@ func (mr *MockHandlerMockRecorder) TerminateWorkflowExecution(arg0, arg1 any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
+
+// UpdateActivityOptions mocks base method.
+func (m *MockHandler) UpdateActivityOptions(arg0 context.Context, arg1 *types.HistoryUpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockHandlerMockRecorder) UpdateActivityOptions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHandler)(nil).UpdateActivityOptions), arg0, arg1)
+}
@@ -41,6 +41,11 @@ type (
 			branchIndex int,
 			incomingVersion int64,
 		) (execution.MutableState, bool, error)
+		rebuild(
+			ctx ctx.Context,
+			branchIndex int,
+			requestID string,
+		) (execution.MutableState, error)
 	}
 
 	conflictResolverImpl struct {
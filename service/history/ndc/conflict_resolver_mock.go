@@ -79,3 +79,18 @@ func (mr *MockconflictResolverMockRecorder) prepareMutableState(ctx, branchIndex
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "prepareMutableState", reflect.TypeOf((*MockconflictResolver)(nil).prepareMutableState), ctx, branchIndex, incomingVersion)
 }
+
+// rebuild mocks base method.
+func (m *MockconflictResolver) rebuild(ctx context.Context, branchIndex int, requestID string) (execution.MutableState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "rebuild", ctx, branchIndex, requestID)
+	ret0, _ := ret[0].(execution.MutableState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// rebuild indicates an expected call of rebuild.
+func (mr *MockconflictResolverMockRecorder) rebuild(ctx, branchIndex, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "rebuild", reflect.TypeOf((*MockconflictResolver)(nil).rebuild), ctx, branchIndex, requestID)
+}
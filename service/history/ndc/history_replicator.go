@@ -21,6 +21,7 @@
 package ndc
 
 import (
+	"bytes"
 	ctx "context"
 	"time"
 
@@ -50,6 +51,12 @@ type (
 			ctx ctx.Context,
 			request *types.ReplicateEventsV2Request,
 		) error
+		RebuildBranch(
+			ctx ctx.Context,
+			domainID string,
+			workflowExecution types.WorkflowExecution,
+			branchToken []byte,
+		) error
 	}
 
 	historyReplicatorImpl struct {
@@ -397,6 +404,84 @@ func (r *historyReplicatorImpl) applyEvents(
 	}
 }
 
+// RebuildBranch rebuilds the mutable state of a workflow from the version history branch with the given
+// branch token, or from the current branch if no token is given, and persists it with the branch as the
+// current branch. It is used to repair workflows whose mutable state diverged from their history
+func (r *historyReplicatorImpl) RebuildBranch(
+	ctx ctx.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+	branchToken []byte,
+) (retError error) {
+
+	context, releaseFn, err := r.executionCache.GetOrCreateWorkflowExecution(
+		ctx,
+		domainID,
+		workflowExecution,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rec := recover(); rec != nil {
+			releaseFn(errPanic)
+			panic(rec)
+		} else {
+			releaseFn(retError)
+		}
+	}()
+
+	mutableState, err := context.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return err
+	}
+	versionHistories := mutableState.GetVersionHistories()
+	if versionHistories == nil {
+		return execution.ErrMissingVersionHistories
+	}
+
+	branchIndex := versionHistories.GetCurrentVersionHistoryIndex()
+	if len(branchToken) != 0 {
+		branchIndex = -1
+		for index, versionHistory := range versionHistories.Histories {
+			if bytes.Equal(versionHistory.GetBranchToken(), branchToken) {
+				branchIndex = index
+				break
+			}
+		}
+		if branchIndex < 0 {
+			return &types.BadRequestError{Message: "branch token does not match any version history of the workflow"}
+		}
+	}
+
+	rebuiltMutableState, err := r.newConflictResolverFn(context, mutableState, r.logger).rebuild(ctx, branchIndex, uuid.New())
+	if err != nil {
+		return err
+	}
+
+	executionInfo := rebuiltMutableState.GetExecutionInfo()
+	r.logger.Info("nDCHistoryReplicator rebuilt workflow from version history branch",
+		tag.WorkflowDomainID(executionInfo.DomainID),
+		tag.WorkflowID(executionInfo.WorkflowID),
+		tag.WorkflowRunID(executionInfo.RunID),
+		tag.Value(branchIndex),
+	)
+
+	return r.transactionManager.updateWorkflow(
+		ctx,
+		r.shard.GetTimeSource().Now(),
+		true,
+		execution.NewWorkflow(
+			ctx,
+			r.clusterMetadata,
+			context,
+			rebuiltMutableState,
+			releaseFn,
+		),
+		nil,
+	)
+}
+
 func applyStartEvents(
 	ctx ctx.Context,
 	context execution.Context,
@@ -2238,3 +2238,102 @@ func Test_notify(t *testing.T) {
 		})
 	}
 }
+
+func Test_RebuildBranch(t *testing.T) {
+	currentBranchToken := []byte("current-branch-token")
+	otherBranchToken := []byte("other-branch-token")
+	newVersionHistories := func() *persistence.VersionHistories {
+		versionHistories := persistence.NewVersionHistories(persistence.NewVersionHistory(
+			currentBranchToken,
+			[]*persistence.VersionHistoryItem{persistence.NewVersionHistoryItem(5, 1), persistence.NewVersionHistoryItem(10, 2)},
+		))
+		_, _, err := versionHistories.AddVersionHistory(persistence.NewVersionHistory(
+			otherBranchToken,
+			[]*persistence.VersionHistoryItem{persistence.NewVersionHistoryItem(8, 1)},
+		))
+		assert.NoError(t, err)
+		return versionHistories
+	}
+
+	tests := map[string]struct {
+		branchToken          []byte
+		versionHistories     *persistence.VersionHistories
+		expectRebuildIndex   int
+		rebuildErr           error
+		expectUpdateWorkflow bool
+		expectError          error
+	}{
+		"rebuild current branch": {
+			versionHistories:     newVersionHistories(),
+			expectRebuildIndex:   0,
+			expectUpdateWorkflow: true,
+		},
+		"rebuild branch by token": {
+			branchToken:          otherBranchToken,
+			versionHistories:     newVersionHistories(),
+			expectRebuildIndex:   1,
+			expectUpdateWorkflow: true,
+		},
+		"unknown branch token": {
+			branchToken:        []byte("unknown-branch-token"),
+			versionHistories:   newVersionHistories(),
+			expectRebuildIndex: -1,
+			expectError:        &types.BadRequestError{Message: "branch token does not match any version history of the workflow"},
+		},
+		"missing version histories": {
+			expectRebuildIndex: -1,
+			expectError:        execution.ErrMissingVersionHistories,
+		},
+		"rebuild error": {
+			versionHistories:   newVersionHistories(),
+			expectRebuildIndex: 0,
+			rebuildErr:         fmt.Errorf("test error"),
+			expectError:        fmt.Errorf("test error"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			replicator := createTestHistoryReplicator(t)
+			mockShard := shard.NewMockContext(ctrl)
+			mockExecutionCache := execution.NewMockCache(ctrl)
+			mockExecutionContext := execution.NewMockContext(ctrl)
+			mockMutableState := execution.NewMockMutableState(ctrl)
+			mockRebuiltMutableState := execution.NewMockMutableState(ctrl)
+			mockConflictResolver := NewMockconflictResolver(ctrl)
+			mockTransactionManager := NewMocktransactionManager(ctrl)
+			replicator.shard = mockShard
+			replicator.executionCache = mockExecutionCache
+			replicator.transactionManager = mockTransactionManager
+			replicator.newConflictResolverFn = func(context execution.Context, mutableState execution.MutableState, logger log.Logger) conflictResolver {
+				return mockConflictResolver
+			}
+
+			workflowExecution := types.WorkflowExecution{WorkflowID: "test-workflow-id", RunID: "test-run-id"}
+			mockExecutionCache.EXPECT().GetOrCreateWorkflowExecution(gomock.Any(), "test-domain-id", workflowExecution).
+				Return(mockExecutionContext, func(err error) {}, nil).Times(1)
+			mockExecutionContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(mockMutableState, nil).Times(1)
+			mockMutableState.EXPECT().GetVersionHistories().Return(test.versionHistories).Times(1)
+			if test.expectRebuildIndex >= 0 {
+				mockConflictResolver.EXPECT().rebuild(gomock.Any(), test.expectRebuildIndex, gomock.Any()).
+					Return(mockRebuiltMutableState, test.rebuildErr).Times(1)
+			}
+			if test.expectUpdateWorkflow {
+				mockRebuiltMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   "test-domain-id",
+					WorkflowID: workflowExecution.WorkflowID,
+					RunID:      workflowExecution.RunID,
+				}).Times(1)
+				mockShard.EXPECT().GetTimeSource().Return(clock.NewMockedTimeSource()).Times(1)
+				mockTransactionManager.EXPECT().updateWorkflow(gomock.Any(), gomock.Any(), true, gomock.Any(), nil).
+					DoAndReturn(func(_ ctx.Context, _ time.Time, _ bool, targetWorkflow execution.Workflow, _ execution.Workflow) error {
+						assert.Equal(t, mockRebuiltMutableState, targetWorkflow.GetMutableState())
+						return nil
+					}).Times(1)
+			}
+
+			err := replicator.RebuildBranch(ctx.Background(), "test-domain-id", workflowExecution, test.branchToken)
+			assert.Equal(t, test.expectError, err)
+		})
+	}
+}
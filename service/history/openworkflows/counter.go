@@ -0,0 +1,119 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package openworkflows
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// CountQuery is the visibility query counting the open workflows of a domain
+const CountQuery = "CloseTime = missing"
+
+type (
+	// Counter counts the open workflows of domains to enforce the open workflows limit of a domain.
+	// The count comes from visibility and is cached for a short period, so that starting a workflow
+	// does not always require a visibility query. A single counter is shared by the history engines
+	// of all the shards owned by the host, so the limit is enforced for the domain rather than per shard.
+	Counter struct {
+		sync.Mutex
+		visibilityMgr persistence.VisibilityManager
+		timeSource    clock.TimeSource
+		ttl           dynamicconfig.DurationPropertyFn
+		counts        map[string]count
+	}
+
+	count struct {
+		count    int64
+		expireAt time.Time
+	}
+)
+
+// NewCounter creates a new Counter
+func NewCounter(
+	visibilityMgr persistence.VisibilityManager,
+	timeSource clock.TimeSource,
+	ttl dynamicconfig.DurationPropertyFn,
+) *Counter {
+	return &Counter{
+		visibilityMgr: visibilityMgr,
+		timeSource:    timeSource,
+		ttl:           ttl,
+		counts:        make(map[string]count),
+	}
+}
+
+// Count returns the number of open workflows of the domain
+func (c *Counter) Count(ctx context.Context, domainID string, domainName string) (int64, error) {
+	now := c.timeSource.Now()
+	if count, ok := c.get(domainID, now); ok {
+		return count, nil
+	}
+
+	resp, err := c.visibilityMgr.CountWorkflowExecutions(ctx, &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Domain:     domainName,
+		Query:      CountQuery,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	c.put(domainID, resp.Count, now.Add(c.ttl()))
+	return resp.Count, nil
+}
+
+// Increment accounts for a workflow of the domain started after the count was cached,
+// it must only be called once the workflow is created
+func (c *Counter) Increment(domainID string) {
+	c.Lock()
+	defer c.Unlock()
+
+	if entry, ok := c.counts[domainID]; ok {
+		entry.count++
+		c.counts[domainID] = entry
+	}
+}
+
+func (c *Counter) get(domainID string, now time.Time) (int64, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry, ok := c.counts[domainID]
+	if !ok || now.After(entry.expireAt) {
+		return 0, false
+	}
+	return entry.count, true
+}
+
+func (c *Counter) put(domainID string, value int64, expireAt time.Time) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.counts[domainID] = count{count: value, expireAt: expireAt}
+}
@@ -0,0 +1,78 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package openworkflows
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	testDomainID   = "B59344B2-4166-462D-9CBD-22B25D2A7B1B"
+	testDomainName = "testDomainName"
+)
+
+func TestCounter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityMgr := persistence.NewMockVisibilityManager(ctrl)
+	timeSource := clock.NewMockedTimeSource()
+	counter := NewCounter(visibilityMgr, timeSource, dynamicconfig.GetDurationPropertyFn(time.Minute))
+	request := &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Domain:     testDomainName,
+		Query:      CountQuery,
+	}
+
+	// a workflow started before the count is cached is already part of the count
+	counter.Increment(testDomainID)
+
+	visibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&persistence.CountWorkflowExecutionsResponse{Count: 5}, nil).Times(1)
+	count, err := counter.Count(context.Background(), testDomainID, testDomainName)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), count)
+
+	// the cached count accounts for the started workflows
+	counter.Increment(testDomainID)
+	count, err = counter.Count(context.Background(), testDomainID, testDomainName)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), count)
+
+	// the count is queried again once the cached count expires
+	timeSource.Advance(2 * time.Minute)
+	visibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(nil, errors.New("visibility error")).Times(1)
+	_, err = counter.Count(context.Background(), testDomainID, testDomainName)
+	assert.Error(t, err)
+	visibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&persistence.CountWorkflowExecutionsResponse{Count: 3}, nil).Times(1)
+	count, err = counter.Count(context.Background(), testDomainID, testDomainName)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
@@ -25,6 +25,12 @@ import "time"
 const (
 	defaultProcessingQueueLevel = 0
 
+	// domainIsolationQueueLevelBase is the first processing queue level reserved for isolated domains,
+	// levels created by the other split policies are always below it
+	domainIsolationQueueLevelBase = 1000
+	// maxDomainIsolationQueues is the number of isolated queue levels when isolated domains are not hashed into buckets
+	maxDomainIsolationQueues = 1 << 16
+
 	// gracefulShutdownTimeout is the the hardcoded timeout for queue components to wrap up shutting down.
 	// This is not ideal because we should have a top level deadline for shutdown propagating down to all components.
 	gracefulShutdownTimeout = time.Minute
@@ -88,6 +88,11 @@ func (s *processingQueueStateImpl) DomainFilter() DomainFilter {
 }
 
 func (s *processingQueueStateImpl) String() string {
+	if isDomainIsolationQueueLevel(s.level) {
+		return fmt.Sprintf("&{level: %+v, isolated: true, ackLevel: %+v, readLevel: %+v, maxLevel: %+v, domainFilter: %+v}",
+			s.level, s.ackLevel, s.readLevel, s.maxLevel, s.domainFilter,
+		)
+	}
 	return fmt.Sprintf("&{level: %+v, ackLevel: %+v, readLevel: %+v, maxLevel: %+v, domainFilter: %+v}",
 		s.level, s.ackLevel, s.readLevel, s.maxLevel, s.domainFilter,
 	)
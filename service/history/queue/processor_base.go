@@ -73,6 +73,9 @@ type (
 
 		rateLimiter quotas.Limiter
 
+		isolatedRateLimiterLock sync.Mutex
+		isolatedRateLimiters    map[int]quotas.Limiter // isolated queue level -> rate limiter
+
 		status         int32
 		shutdownWG     sync.WaitGroup
 		shutdownCh     chan struct{}
@@ -116,6 +119,7 @@ func newProcessorBase(
 		metricsClient:               metricsClient,
 		metricsScope:                metricsScope,
 		rateLimiter:                 quotas.NewDynamicRateLimiter(options.MaxPollRPS.AsFloat64()),
+		isolatedRateLimiters:        make(map[int]quotas.Limiter),
 		status:                      common.DaemonStatusInitialized,
 		shutdownCh:                  make(chan struct{}),
 		actionNotifyCh:              make(chan actionNotification),
@@ -179,13 +183,23 @@ func (p *processorBase) updateAckLevel() (bool, task.Key, error) {
 }
 
 func (p *processorBase) initializeSplitPolicy(lookAheadFunc lookAheadFunc) ProcessingQueueSplitPolicy {
+	// note the order of policies matters, check the comment for aggregated split policy
+	var policies []ProcessingQueueSplitPolicy
+
+	// domain isolation is static and should be applied regardless of other split policies
+	if isolationPolicy := p.initializeDomainIsolationSplitPolicy(); isolationPolicy != nil {
+		policies = append(policies, isolationPolicy)
+	}
+
 	if !p.options.EnableSplit() {
-		return nil
+		if len(policies) == 0 {
+			return nil
+		}
+		return NewAggregatedSplitPolicy(policies...)
 	}
 
-	// note the order of policies matters, check the comment for aggregated split policy
-	var policies []ProcessingQueueSplitPolicy
-	maxNewQueueLevel := p.options.SplitMaxLevel()
+	// levels starting from domainIsolationQueueLevelBase are reserved for isolated domains
+	maxNewQueueLevel := common.MinInt(p.options.SplitMaxLevel(), domainIsolationQueueLevelBase-1)
 
 	pendingTaskThresholds, err := common.ConvertDynamicConfigMapPropertyToIntMap(p.options.PendingTaskSplitThreshold())
 	if err != nil {
@@ -233,6 +247,38 @@ func (p *processorBase) initializeSplitPolicy(lookAheadFunc lookAheadFunc) Proce
 	return NewAggregatedSplitPolicy(policies...)
 }
 
+func (p *processorBase) initializeDomainIsolationSplitPolicy() ProcessingQueueSplitPolicy {
+	if !p.options.EnableDomainIsolation() {
+		return nil
+	}
+
+	return NewDomainIsolationSplitPolicy(
+		p.options.EnableDomainIsolationForAllDomains,
+		p.options.EnableDomainIsolationByDomainID,
+		p.options.DomainIsolationHashBuckets(),
+		p.logger,
+		p.metricsScope,
+	)
+}
+
+// getRateLimiter returns the rate limiter for loading tasks of the given queue level,
+// each isolated level has its own rate limiter while other levels share the same one
+func (p *processorBase) getRateLimiter(level int) quotas.Limiter {
+	if !isDomainIsolationQueueLevel(level) {
+		return p.rateLimiter
+	}
+
+	p.isolatedRateLimiterLock.Lock()
+	defer p.isolatedRateLimiterLock.Unlock()
+
+	rateLimiter, ok := p.isolatedRateLimiters[level]
+	if !ok {
+		rateLimiter = quotas.NewDynamicRateLimiter(p.options.IsolatedQueueMaxPollRPS.AsFloat64())
+		p.isolatedRateLimiters[level] = rateLimiter
+	}
+	return rateLimiter
+}
+
 func (p *processorBase) splitProcessingQueueCollection(splitPolicy ProcessingQueueSplitPolicy, upsertPollTimeFn func(int, time.Time)) {
 	defer p.emitProcessingQueueMetrics()
 
@@ -283,16 +329,22 @@ func (p *processorBase) splitProcessingQueueCollection(splitPolicy ProcessingQue
 
 func (p *processorBase) emitProcessingQueueMetrics() {
 	numProcessingQueues := 0
+	numIsolatedProcessingQueues := 0
 	maxProcessingQueueLevel := 0
 	for _, queueCollection := range p.processingQueueCollections {
 		size := len(queueCollection.Queues())
 		numProcessingQueues += size
+		if isDomainIsolationQueueLevel(queueCollection.Level()) {
+			numIsolatedProcessingQueues += size
+			continue
+		}
 		if size != 0 && queueCollection.Level() > maxProcessingQueueLevel {
 			maxProcessingQueueLevel = queueCollection.Level()
 		}
 	}
 	p.metricsScope.RecordTimer(metrics.ProcessingQueueNumTimer, time.Duration(numProcessingQueues))
 	p.metricsScope.RecordTimer(metrics.ProcessingQueueMaxLevelTimer, time.Duration(maxProcessingQueueLevel))
+	p.metricsScope.RecordTimer(metrics.ProcessingQueueIsolatedNumTimer, time.Duration(numIsolatedProcessingQueues))
 }
 
 func (p *processorBase) addAction(ctx context.Context, action *Action) (chan actionResultNotification, bool) {
@@ -354,6 +354,32 @@ func (s *processorBaseSuite) TestInitializeSplitPolicy_Enabled() {
 	s.Equal(3, len(aggPolicy.policies), "got %v policies, want 3: pending task policy, stuck task policy and random split policy", len(aggPolicy.policies))
 }
 
+func (s *processorBaseSuite) TestInitializeSplitPolicy_DomainIsolationOnly() {
+	processorBase := s.newTestProcessorBase(nil, nil, nil, nil, nil)
+
+	processorBase.options.EnableDomainIsolation = dynamicconfig.GetBoolPropertyFn(true)
+
+	splitPolicy := processorBase.initializeSplitPolicy(nil)
+	s.NotNil(splitPolicy, "got nil split policy, want non-nil")
+	aggPolicy, ok := splitPolicy.(*aggregatedSplitPolicy)
+	s.True(ok, "got %T, want *aggregatedSplitPolicy", splitPolicy)
+	s.Equal(1, len(aggPolicy.policies), "got %v policies, want 1: domain isolation policy", len(aggPolicy.policies))
+	_, ok = aggPolicy.policies[0].(*domainIsolationSplitPolicy)
+	s.True(ok, "got %T, want *domainIsolationSplitPolicy", aggPolicy.policies[0])
+}
+
+func (s *processorBaseSuite) TestGetRateLimiter() {
+	processorBase := s.newTestProcessorBase(nil, nil, nil, nil, nil)
+
+	s.Same(processorBase.rateLimiter, processorBase.getRateLimiter(defaultProcessingQueueLevel))
+	s.Same(processorBase.rateLimiter, processorBase.getRateLimiter(1))
+
+	isolatedRateLimiter := processorBase.getRateLimiter(domainIsolationQueueLevelBase)
+	s.NotSame(processorBase.rateLimiter, isolatedRateLimiter)
+	s.Same(isolatedRateLimiter, processorBase.getRateLimiter(domainIsolationQueueLevelBase))
+	s.NotSame(isolatedRateLimiter, processorBase.getRateLimiter(domainIsolationQueueLevelBase+1))
+}
+
 func (s *processorBaseSuite) TestResetProcessingQueueStates() {
 	processingQueueStates := []ProcessingQueueState{
 		NewProcessingQueueState(
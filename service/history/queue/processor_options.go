@@ -43,6 +43,11 @@ type queueProcessorOptions struct {
 	PendingTaskSplitThreshold            dynamicconfig.MapPropertyFn
 	EnableStuckTaskSplitByDomainID       dynamicconfig.BoolPropertyFnWithDomainIDFilter
 	StuckTaskSplitThreshold              dynamicconfig.MapPropertyFn
+	EnableDomainIsolation                dynamicconfig.BoolPropertyFn
+	EnableDomainIsolationForAllDomains   dynamicconfig.BoolPropertyFn
+	EnableDomainIsolationByDomainID      dynamicconfig.BoolPropertyFnWithDomainIDFilter
+	DomainIsolationHashBuckets           dynamicconfig.IntPropertyFn
+	IsolatedQueueMaxPollRPS              dynamicconfig.IntPropertyFn
 	SplitLookAheadDurationByDomainID     dynamicconfig.DurationPropertyFnWithDomainIDFilter
 	PollBackoffInterval                  dynamicconfig.DurationPropertyFn
 	PollBackoffIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
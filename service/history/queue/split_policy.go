@@ -24,6 +24,8 @@ import (
 	"fmt"
 	"math/rand"
 
+	"github.com/dgryski/go-farm"
+
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -37,6 +39,7 @@ const (
 	policyTypeStuckTask
 	policyTypeSelectedDomain
 	policyTypeRandom
+	policyTypeDomainIsolation
 )
 
 type (
@@ -79,6 +82,15 @@ type (
 		metricsScope metrics.Scope
 	}
 
+	domainIsolationSplitPolicy struct {
+		enabledForAllDomains dynamicconfig.BoolPropertyFn
+		enabledByDomainID    dynamicconfig.BoolPropertyFnWithDomainIDFilter
+		hashBuckets          int
+
+		logger       log.Logger
+		metricsScope metrics.Scope
+	}
+
 	aggregatedSplitPolicy struct {
 		policies []ProcessingQueueSplitPolicy
 	}
@@ -158,6 +170,28 @@ func NewRandomSplitPolicy(
 	}
 }
 
+// NewDomainIsolationSplitPolicy creates a new processing queue split policy
+// that moves isolated domains into their own processing queue level, and moves
+// domains which are no longer isolated back to the default level.
+// A domain is isolated when enabledForAllDomains or enabledByDomainID returns true.
+// When hashBuckets is positive, isolated domains are hashed into that many levels,
+// otherwise each isolated domain gets its own level
+func NewDomainIsolationSplitPolicy(
+	enabledForAllDomains dynamicconfig.BoolPropertyFn,
+	enabledByDomainID dynamicconfig.BoolPropertyFnWithDomainIDFilter,
+	hashBuckets int,
+	logger log.Logger,
+	metricsScope metrics.Scope,
+) ProcessingQueueSplitPolicy {
+	return &domainIsolationSplitPolicy{
+		enabledForAllDomains: enabledForAllDomains,
+		enabledByDomainID:    enabledByDomainID,
+		hashBuckets:          hashBuckets,
+		logger:               logger,
+		metricsScope:         metricsScope,
+	}
+}
+
 // NewAggregatedSplitPolicy creates a new processing queue split policy
 // that which combines other policies. Policies are evaluated in the order
 // they passed in, and if one policy returns an non-empty result, that result
@@ -171,8 +205,8 @@ func NewAggregatedSplitPolicy(policies ...ProcessingQueueSplitPolicy) Processing
 func (p *pendingTaskSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueueState {
 	queueImpl := queue.(*processingQueueImpl)
 
-	if queueImpl.state.level == p.maxNewQueueLevel {
-		// already reaches max level, skip splitting
+	if queueImpl.state.level >= p.maxNewQueueLevel {
+		// already reaches max level or the queue is isolated, skip splitting
 		return nil
 	}
 
@@ -220,8 +254,8 @@ func (p *pendingTaskSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQue
 func (p *stuckTaskSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueueState {
 	queueImpl := queue.(*processingQueueImpl)
 
-	if queueImpl.state.level == p.maxNewQueueLevel {
-		// already reaches max level, skip splitting
+	if queueImpl.state.level >= p.maxNewQueueLevel {
+		// already reaches max level or the queue is isolated, skip splitting
 		return nil
 	}
 
@@ -307,8 +341,8 @@ func (p *selectedDomainSplitPolicy) Evaluate(queue ProcessingQueue) []Processing
 func (p *randomSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueueState {
 	queueImpl := queue.(*processingQueueImpl)
 
-	if queueImpl.state.level == p.maxNewQueueLevel {
-		// already reaches max level, skip splitting
+	if queueImpl.state.level >= p.maxNewQueueLevel {
+		// already reaches max level or the queue is isolated, skip splitting
 		return nil
 	}
 
@@ -354,6 +388,80 @@ func (p *randomSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueueSta
 	)
 }
 
+func (p *domainIsolationSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueueState {
+	queueImpl := queue.(*processingQueueImpl)
+	currentLevel := queueImpl.state.level
+
+	domainIDs := make(map[string]struct{})
+	if !queueImpl.state.domainFilter.ReverseMatch {
+		for domainID := range queueImpl.state.domainFilter.DomainIDs {
+			domainIDs[domainID] = struct{}{}
+		}
+	}
+	for _, task := range queueImpl.outstandingTasks {
+		domainIDs[task.GetDomainID()] = struct{}{}
+	}
+
+	enabledForAllDomains := p.enabledForAllDomains()
+	domainToSplit := make(map[int]map[string]struct{}) // new queue level -> domainIDs
+	for domainID := range domainIDs {
+		newQueueLevel := currentLevel
+		if enabledForAllDomains || p.enabledByDomainID(domainID) {
+			newQueueLevel = domainIsolationQueueLevel(domainID, p.hashBuckets)
+		} else if isDomainIsolationQueueLevel(currentLevel) {
+			// domain is no longer isolated
+			newQueueLevel = defaultProcessingQueueLevel
+		}
+		if newQueueLevel == currentLevel {
+			continue
+		}
+
+		if _, ok := domainToSplit[newQueueLevel]; !ok {
+			domainToSplit[newQueueLevel] = make(map[string]struct{})
+		}
+		domainToSplit[newQueueLevel][domainID] = struct{}{}
+	}
+
+	if len(domainToSplit) == 0 {
+		return nil
+	}
+
+	newQueueStates := make([]ProcessingQueueState, 0, len(domainToSplit)+1)
+	remainingDomainFilter := queueImpl.state.domainFilter.copy()
+	for newQueueLevel, domainIDs := range domainToSplit {
+		p.logger.Info("Split processing queue",
+			tag.QueueLevel(newQueueLevel),
+			tag.PreviousQueueLevel(currentLevel),
+			tag.WorkflowDomainIDs(domainIDs),
+			tag.QueueSplitPolicyType(policyTypeDomainIsolation),
+		)
+
+		// isolated domains are moved for the entire range of the queue
+		// so that their tasks will never be loaded by the current queue again
+		newQueueStates = append(newQueueStates, newProcessingQueueState(
+			newQueueLevel,
+			queueImpl.state.ackLevel,
+			queueImpl.state.readLevel,
+			queueImpl.state.maxLevel,
+			NewDomainFilter(domainIDs, false),
+		))
+		remainingDomainFilter = remainingDomainFilter.Exclude(domainIDs)
+	}
+	p.metricsScope.IncCounter(metrics.ProcessingQueueDomainIsolationSplitCounter)
+
+	if remainingDomainFilter.ReverseMatch || len(remainingDomainFilter.DomainIDs) != 0 {
+		newQueueStates = append(newQueueStates, newProcessingQueueState(
+			currentLevel,
+			queueImpl.state.ackLevel,
+			queueImpl.state.readLevel,
+			queueImpl.state.maxLevel,
+			remainingDomainFilter,
+		))
+	}
+
+	return newQueueStates
+}
+
 func (p *aggregatedSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueueState {
 	for _, policy := range p.policies {
 		newStates := policy.Evaluate(queue)
@@ -431,3 +539,14 @@ func shouldSplit(probability float64) bool {
 	}
 	return rand.Intn(int(1.0/probability)) == 0
 }
+
+func domainIsolationQueueLevel(domainID string, hashBuckets int) int {
+	if hashBuckets <= 0 || hashBuckets > maxDomainIsolationQueues {
+		hashBuckets = maxDomainIsolationQueues
+	}
+	return domainIsolationQueueLevelBase + int(farm.Fingerprint32([]byte(domainID))%uint32(hashBuckets))
+}
+
+func isDomainIsolationQueueLevel(level int) bool {
+	return level >= domainIsolationQueueLevelBase
+}
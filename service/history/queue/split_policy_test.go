@@ -660,6 +660,194 @@ func (s *splitPolicySuite) TestRandomSplitPolicy() {
 	}
 }
 
+func (s *splitPolicySuite) TestDomainIsolationSplitPolicy() {
+	isolatedDomainLevel := domainIsolationQueueLevel("isolatedDomain", 1)
+	s.Equal(domainIsolationQueueLevelBase, isolatedDomainLevel)
+
+	domainIsolationSplitPolicy := NewDomainIsolationSplitPolicy(
+		dynamicconfig.GetBoolPropertyFn(false),
+		func(domainID string) bool {
+			return domainID == "isolatedDomain"
+		},
+		1,
+		s.logger,
+		s.metricsScope,
+	)
+
+	testCases := []struct {
+		currentState      ProcessingQueueState
+		pendingTaskDomain []string
+		expectedNewStates []ProcessingQueueState
+	}{
+		{
+			currentState: newProcessingQueueState(
+				0,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(map[string]struct{}{}, true),
+			),
+			pendingTaskDomain: []string{"testDomain1", "testDomain2"},
+			expectedNewStates: nil,
+		},
+		{
+			currentState: newProcessingQueueState(
+				0,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(map[string]struct{}{}, true),
+			),
+			pendingTaskDomain: []string{"testDomain1", "isolatedDomain"},
+			expectedNewStates: []ProcessingQueueState{
+				newProcessingQueueState(
+					0,
+					testKey{ID: 0},
+					testKey{ID: 5},
+					testKey{ID: 10},
+					NewDomainFilter(map[string]struct{}{"isolatedDomain": {}}, true),
+				),
+				newProcessingQueueState(
+					isolatedDomainLevel,
+					testKey{ID: 0},
+					testKey{ID: 5},
+					testKey{ID: 10},
+					NewDomainFilter(map[string]struct{}{"isolatedDomain": {}}, false),
+				),
+			},
+		},
+		{
+			currentState: newProcessingQueueState(
+				isolatedDomainLevel,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(map[string]struct{}{"isolatedDomain": {}}, false),
+			),
+			pendingTaskDomain: []string{"isolatedDomain"},
+			expectedNewStates: nil,
+		},
+		{
+			currentState: newProcessingQueueState(
+				isolatedDomainLevel,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(map[string]struct{}{"isolatedDomain": {}, "testDomain2": {}}, false),
+			),
+			expectedNewStates: []ProcessingQueueState{
+				newProcessingQueueState(
+					0,
+					testKey{ID: 0},
+					testKey{ID: 5},
+					testKey{ID: 10},
+					NewDomainFilter(map[string]struct{}{"testDomain2": {}}, false),
+				),
+				newProcessingQueueState(
+					isolatedDomainLevel,
+					testKey{ID: 0},
+					testKey{ID: 5},
+					testKey{ID: 10},
+					NewDomainFilter(map[string]struct{}{"isolatedDomain": {}}, false),
+				),
+			},
+		},
+		{
+			currentState: newProcessingQueueState(
+				isolatedDomainLevel,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(map[string]struct{}{"testDomain2": {}}, false),
+			),
+			expectedNewStates: []ProcessingQueueState{
+				newProcessingQueueState(
+					0,
+					testKey{ID: 0},
+					testKey{ID: 5},
+					testKey{ID: 10},
+					NewDomainFilter(map[string]struct{}{"testDomain2": {}}, false),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		outstandingTasks := make(map[task.Key]task.Task)
+		for _, domainID := range tc.pendingTaskDomain {
+			mockTask := task.NewMockTask(s.controller)
+			mockTask.EXPECT().GetDomainID().Return(domainID).MaxTimes(1)
+			outstandingTasks[task.NewMockKey(s.controller)] = mockTask
+		}
+
+		queue := newProcessingQueue(
+			tc.currentState,
+			outstandingTasks,
+			nil,
+			nil,
+		)
+
+		s.assertQueueStatesEqual(tc.expectedNewStates, domainIsolationSplitPolicy.Evaluate(queue))
+	}
+}
+
+func (s *splitPolicySuite) TestDomainIsolationSplitPolicy_AllDomains() {
+	domainIsolationSplitPolicy := NewDomainIsolationSplitPolicy(
+		dynamicconfig.GetBoolPropertyFn(true),
+		dynamicconfig.GetBoolPropertyFnFilteredByDomainID(false),
+		1,
+		s.logger,
+		s.metricsScope,
+	)
+
+	outstandingTasks := make(map[task.Key]task.Task)
+	for _, domainID := range []string{"testDomain1", "testDomain2"} {
+		mockTask := task.NewMockTask(s.controller)
+		mockTask.EXPECT().GetDomainID().Return(domainID).MaxTimes(1)
+		outstandingTasks[task.NewMockKey(s.controller)] = mockTask
+	}
+	queue := newProcessingQueue(
+		newProcessingQueueState(
+			0,
+			testKey{ID: 0},
+			testKey{ID: 5},
+			testKey{ID: 10},
+			NewDomainFilter(map[string]struct{}{}, true),
+		),
+		outstandingTasks,
+		nil,
+		nil,
+	)
+
+	// every domain is isolated without being enabled by domain ID, all hashed into the only bucket
+	s.assertQueueStatesEqual([]ProcessingQueueState{
+		newProcessingQueueState(
+			0,
+			testKey{ID: 0},
+			testKey{ID: 5},
+			testKey{ID: 10},
+			NewDomainFilter(map[string]struct{}{"testDomain1": {}, "testDomain2": {}}, true),
+		),
+		newProcessingQueueState(
+			domainIsolationQueueLevelBase,
+			testKey{ID: 0},
+			testKey{ID: 5},
+			testKey{ID: 10},
+			NewDomainFilter(map[string]struct{}{"testDomain1": {}, "testDomain2": {}}, false),
+		),
+	}, domainIsolationSplitPolicy.Evaluate(queue))
+}
+
+func (s *splitPolicySuite) TestDomainIsolationQueueLevel() {
+	for _, hashBuckets := range []int{0, 1, 16, maxDomainIsolationQueues + 1} {
+		level := domainIsolationQueueLevel("testDomain", hashBuckets)
+		s.True(isDomainIsolationQueueLevel(level))
+		s.Less(level, domainIsolationQueueLevelBase+maxDomainIsolationQueues)
+		s.Equal(level, domainIsolationQueueLevel("testDomain", hashBuckets))
+	}
+	s.False(isDomainIsolationQueueLevel(defaultProcessingQueueLevel))
+}
+
 func (s *splitPolicySuite) TestAggregatedSplitPolicy() {
 	expectedNewStates := []ProcessingQueueState{
 		NewMockProcessingQueueState(s.controller),
@@ -278,7 +278,7 @@ func (t *timerQueueProcessorBase) processQueueCollections(levels map[int]struct{
 		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), loadQueueTaskThrottleRetryDelay)
-		if err := t.rateLimiter.Wait(ctx); err != nil {
+		if err := t.getRateLimiter(level).Wait(ctx); err != nil {
 			cancel()
 			if level == defaultProcessingQueueLevel {
 				t.upsertPollTime(level, time.Time{})
@@ -678,11 +678,13 @@ func newTimerQueueProcessorOptions(
 		PollBackoffInterval:                  config.QueueProcessorPollBackoffInterval,
 		PollBackoffIntervalJitterCoefficient: config.QueueProcessorPollBackoffIntervalJitterCoefficient,
 		EnableGracefulSyncShutdown:           config.QueueProcessorEnableGracefulSyncShutdown,
+		IsolatedQueueMaxPollRPS:              config.QueueProcessorIsolatedQueueMaxPollRPS,
 	}
 
 	if isFailover {
 		// disable queue split for failover processor
 		options.EnableSplit = dynamicconfig.GetBoolPropertyFn(false)
+		options.EnableDomainIsolation = dynamicconfig.GetBoolPropertyFn(false)
 
 		// disable persist and load processing queue states for failover processor as it will never be split
 		options.EnablePersistQueueStates = dynamicconfig.GetBoolPropertyFn(false)
@@ -698,6 +700,10 @@ func newTimerQueueProcessorOptions(
 		options.PendingTaskSplitThreshold = config.QueueProcessorPendingTaskSplitThreshold
 		options.EnableStuckTaskSplitByDomainID = config.QueueProcessorEnableStuckTaskSplitByDomainID
 		options.StuckTaskSplitThreshold = config.QueueProcessorStuckTaskSplitThreshold
+		options.EnableDomainIsolation = config.QueueProcessorEnableDomainIsolation
+		options.EnableDomainIsolationForAllDomains = config.QueueProcessorEnableDomainIsolationForAllDomains
+		options.EnableDomainIsolationByDomainID = config.QueueProcessorEnableDomainIsolationByDomainID
+		options.DomainIsolationHashBuckets = config.QueueProcessorDomainIsolationHashBuckets
 		options.SplitLookAheadDurationByDomainID = config.QueueProcessorSplitLookAheadDurationByDomainID
 
 		options.EnablePersistQueueStates = config.QueueProcessorEnablePersistQueueStates
@@ -398,7 +398,7 @@ func (t *transferQueueProcessorBase) processQueueCollections() {
 		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), loadQueueTaskThrottleRetryDelay)
-		if err := t.rateLimiter.Wait(ctx); err != nil {
+		if err := t.getRateLimiter(level).Wait(ctx); err != nil {
 			cancel()
 			if level != defaultProcessingQueueLevel {
 				t.setupBackoffTimer(level)
@@ -493,7 +493,11 @@ func (t *transferQueueProcessorBase) splitQueue() {
 	}
 
 	if t.lastSplitTime.IsZero() || t.estimatedTasksPerMinute == 0 {
-		// skip the split as we can't estimate the look ahead taskID
+		// skip the split as we can't estimate the look ahead taskID,
+		// domain isolation doesn't need to look ahead so it's still applied
+		t.splitProcessingQueueCollection(t.initializeDomainIsolationSplitPolicy(), func(level int, _ time.Time) {
+			t.readyForProcess(level)
+		})
 		return
 	}
 
@@ -581,11 +585,13 @@ func newTransferQueueProcessorOptions(
 		EnableValidator:                      config.TransferProcessorEnableValidator,
 		ValidationInterval:                   config.TransferProcessorValidationInterval,
 		EnableGracefulSyncShutdown:           config.QueueProcessorEnableGracefulSyncShutdown,
+		IsolatedQueueMaxPollRPS:              config.QueueProcessorIsolatedQueueMaxPollRPS,
 	}
 
 	if isFailover {
 		// disable queue split for failover processor
 		options.EnableSplit = dynamicconfig.GetBoolPropertyFn(false)
+		options.EnableDomainIsolation = dynamicconfig.GetBoolPropertyFn(false)
 
 		// disable persist and load processing queue states for failover processor as it will never be split
 		options.EnablePersistQueueStates = dynamicconfig.GetBoolPropertyFn(false)
@@ -601,6 +607,10 @@ func newTransferQueueProcessorOptions(
 		options.PendingTaskSplitThreshold = config.QueueProcessorPendingTaskSplitThreshold
 		options.EnableStuckTaskSplitByDomainID = config.QueueProcessorEnableStuckTaskSplitByDomainID
 		options.StuckTaskSplitThreshold = config.QueueProcessorStuckTaskSplitThreshold
+		options.EnableDomainIsolation = config.QueueProcessorEnableDomainIsolation
+		options.EnableDomainIsolationForAllDomains = config.QueueProcessorEnableDomainIsolationForAllDomains
+		options.EnableDomainIsolationByDomainID = config.QueueProcessorEnableDomainIsolationByDomainID
+		options.DomainIsolationHashBuckets = config.QueueProcessorDomainIsolationHashBuckets
 		options.SplitLookAheadDurationByDomainID = config.QueueProcessorSplitLookAheadDurationByDomainID
 
 		options.EnablePersistQueueStates = config.QueueProcessorEnablePersistQueueStates
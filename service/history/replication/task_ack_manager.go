@@ -25,9 +25,11 @@ package replication
 import (
 	"context"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -38,13 +40,24 @@ import (
 type (
 	// TaskAckManager is the ack manager for replication tasks
 	TaskAckManager struct {
+		shardID   int
 		ackLevels ackLevelStore
 
-		scope  metrics.Scope
-		logger log.Logger
+		scope      metrics.Scope
+		logger     log.Logger
+		timeSource clock.TimeSource
 
 		reader taskReader
 		store  *TaskStore
+
+		progress *pollingProgress
+	}
+
+	// pollingProgress keeps in memory how far each polling cluster has read and when it last acked new tasks.
+	pollingProgress struct {
+		sync.Mutex
+		readLevels          map[string]int64
+		lastReplicatedTimes map[string]time.Time
 	}
 
 	ackLevelStore interface {
@@ -69,20 +82,27 @@ func NewTaskAckManager(
 ) TaskAckManager {
 
 	return TaskAckManager{
+		shardID:   shardID,
 		ackLevels: ackLevels,
 		scope: metricsClient.Scope(
 			metrics.ReplicatorQueueProcessorScope,
 			metrics.InstanceTag(strconv.Itoa(shardID)),
 		),
-		logger: logger.WithTags(tag.ComponentReplicationAckManager),
-		reader: reader,
-		store:  store,
+		logger:     logger.WithTags(tag.ComponentReplicationAckManager),
+		timeSource: clock.NewRealTimeSource(),
+		reader:     reader,
+		store:      store,
+		progress: &pollingProgress{
+			readLevels:          make(map[string]int64),
+			lastReplicatedTimes: make(map[string]time.Time),
+		},
 	}
 }
 
 func (t *TaskAckManager) GetTasks(ctx context.Context, pollingCluster string, lastReadTaskID int64) (*types.ReplicationMessages, error) {
+	ackLevel := t.ackLevels.GetClusterReplicationLevel(pollingCluster)
 	if lastReadTaskID == common.EmptyMessageID {
-		lastReadTaskID = t.ackLevels.GetClusterReplicationLevel(pollingCluster)
+		lastReadTaskID = ackLevel
 	}
 
 	taskGeneratedTimer := t.scope.StartTimer(metrics.TaskLatency)
@@ -128,6 +148,13 @@ TaskInfoLoop:
 		t.logger.Error("error updating replication level for hydrated task store", tag.Error(err), tag.OperationFailed)
 	}
 
+	t.progress.Lock()
+	t.progress.readLevels[pollingCluster] = readLevel
+	if lastReadTaskID > ackLevel {
+		t.progress.lastReplicatedTimes[pollingCluster] = t.timeSource.Now()
+	}
+	t.progress.Unlock()
+
 	t.logger.Debug("Get replication tasks", tag.SourceCluster(pollingCluster), tag.ShardReplicationAck(lastReadTaskID), tag.ReadLevel(readLevel))
 	return &types.ReplicationMessages{
 		ReplicationTasks:       replicationTasks,
@@ -135,3 +162,41 @@ TaskInfoLoop:
 		LastRetrievedMessageID: readLevel,
 	}, nil
 }
+
+// GetStatus returns the replication status of the shard towards the given polling cluster.
+// DLQ size is not known by the ack manager and is left for the caller to fill in.
+func (t *TaskAckManager) GetStatus(ctx context.Context, pollingCluster string) (*types.ReplicationShardStatus, error) {
+	ackLevel := t.ackLevels.GetClusterReplicationLevel(pollingCluster)
+	maxReadLevel := t.ackLevels.GetTransferMaxReadLevel()
+
+	status := &types.ReplicationShardStatus{
+		ShardID:       int32(t.shardID),
+		RemoteCluster: pollingCluster,
+		ReadLevel:     ackLevel,
+		AckedLevel:    ackLevel,
+		MaxReadLevel:  maxReadLevel,
+	}
+
+	t.progress.Lock()
+	if readLevel, ok := t.progress.readLevels[pollingCluster]; ok && readLevel > ackLevel {
+		status.ReadLevel = readLevel
+	}
+	if lastReplicatedTime, ok := t.progress.lastReplicatedTimes[pollingCluster]; ok {
+		status.LastReplicatedTimestamp = common.Int64Ptr(lastReplicatedTime.UnixNano())
+	}
+	t.progress.Unlock()
+
+	// The first task after the acked level is the oldest one the polling cluster has not applied yet.
+	tasks, _, err := t.reader.Read(ctx, ackLevel, maxReadLevel)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) > 0 {
+		age := t.timeSource.Now().Sub(time.Unix(0, tasks[0].CreationTime))
+		if age > 0 {
+			status.OldestPendingTaskAgeMs = age.Milliseconds()
+		}
+	}
+
+	return status, nil
+}
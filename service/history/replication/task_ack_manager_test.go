@@ -26,11 +26,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -233,6 +236,63 @@ func TestTaskAckManager_GetTasks(t *testing.T) {
 	}
 }
 
+func TestTaskAckManager_GetStatus(t *testing.T) {
+	now := time.Now()
+	task11 := persistence.ReplicationTaskInfo{TaskID: 11, DomainID: testDomainID, CreationTime: now.Add(-time.Minute).UnixNano()}
+	task12 := persistence.ReplicationTaskInfo{TaskID: 12, DomainID: testDomainID, CreationTime: now.Add(-time.Second).UnixNano()}
+
+	ackLevels := &fakeAckLevelStore{remote: map[string]int64{testClusterA: 2}, readLevel: 20}
+	taskStore := createTestTaskStore(t, fakeDomainCache{testDomainID: testDomain}, fakeTaskHydrator{
+		task11.TaskID: testHydratedTask11,
+		task12.TaskID: testHydratedTask12,
+	})
+	ackManager := NewTaskAckManager(testShardID, ackLevels, metrics.NewNoopMetricsClient(), log.NewNoop(), fakeTaskReader{&task11, &task12}, taskStore)
+	ackManager.timeSource = clock.NewMockedTimeSourceAt(now)
+
+	status, err := ackManager.GetStatus(context.Background(), testClusterA)
+	require.NoError(t, err)
+	assert.Equal(t, &types.ReplicationShardStatus{
+		ShardID:                int32(testShardID),
+		RemoteCluster:          testClusterA,
+		ReadLevel:              2,
+		AckedLevel:             2,
+		MaxReadLevel:           20,
+		OldestPendingTaskAgeMs: time.Minute.Milliseconds(),
+	}, status)
+
+	// cluster A acks up to task 5 and reads tasks 11 and 12
+	_, err = ackManager.GetTasks(context.Background(), testClusterA, 5)
+	require.NoError(t, err)
+
+	status, err = ackManager.GetStatus(context.Background(), testClusterA)
+	require.NoError(t, err)
+	assert.Equal(t, &types.ReplicationShardStatus{
+		ShardID:                 int32(testShardID),
+		RemoteCluster:           testClusterA,
+		ReadLevel:               12,
+		AckedLevel:              5,
+		MaxReadLevel:            20,
+		LastReplicatedTimestamp: common.Int64Ptr(now.UnixNano()),
+		OldestPendingTaskAgeMs:  time.Minute.Milliseconds(),
+	}, status)
+
+	// nothing is pending for cluster B
+	ackLevels.remote[testClusterB] = 20
+	status, err = ackManager.GetStatus(context.Background(), testClusterB)
+	require.NoError(t, err)
+	assert.Equal(t, &types.ReplicationShardStatus{
+		ShardID:       int32(testShardID),
+		RemoteCluster: testClusterB,
+		ReadLevel:     20,
+		AckedLevel:    20,
+		MaxReadLevel:  20,
+	}, status)
+
+	ackManager.reader = (fakeTaskReader)(nil)
+	_, err = ackManager.GetStatus(context.Background(), testClusterA)
+	assert.EqualError(t, err, "error reading replication tasks")
+}
+
 type fakeAckLevelStore struct {
 	remote    map[string]int64
 	readLevel int64
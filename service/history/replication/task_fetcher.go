@@ -22,10 +22,14 @@ package replication
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.uber.org/yarpc"
+
+	replicationv1 "github.com/uber/cadence/.gen/proto/replication/v1"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/common"
@@ -35,7 +39,9 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/replicationbatch"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
 	"github.com/uber/cadence/service/history/config"
 )
 
@@ -47,6 +53,8 @@ const (
 	requestChanBufferSize   = 1000
 )
 
+var errReplicationStreamTimeout = errors.New("timed out waiting for replication stream response")
+
 type (
 	// TaskFetcher is responsible for fetching replication messages from remote DC.
 	TaskFetcher interface {
@@ -72,6 +80,7 @@ type (
 		config         *config.Config
 		logger         log.Logger
 		remotePeer     admin.Client
+		streamClient   replicationv1.ReplicationStreamAPIYARPCClient
 		rateLimiter    *quotas.DynamicRateLimiter
 		timeSource     clock.TimeSource
 		requestChan    chan *request
@@ -104,12 +113,14 @@ func NewTaskFetchers(
 	var fetchers []TaskFetcher
 	for clusterName := range clusterMetadata.GetRemoteClusterInfo() {
 		remoteFrontendClient := clientBean.GetRemoteAdminClient(clusterName)
+		remoteStreamClient := clientBean.GetRemoteReplicationStreamClient(clusterName)
 		fetcher := newReplicationTaskFetcher(
 			logger,
 			clusterName,
 			currentCluster,
 			config,
 			remoteFrontendClient,
+			remoteStreamClient,
 		)
 		fetchers = append(fetchers, fetcher)
 	}
@@ -157,6 +168,7 @@ func newReplicationTaskFetcher(
 	currentCluster string,
 	config *config.Config,
 	sourceFrontend admin.Client,
+	sourceStream replicationv1.ReplicationStreamAPIYARPCClient,
 ) TaskFetcher {
 	ctx, cancel := context.WithCancel(context.Background())
 	fetcher := &taskFetcherImpl{
@@ -164,6 +176,7 @@ func newReplicationTaskFetcher(
 		config:         config,
 		logger:         logger.WithTags(tag.ClusterName(sourceCluster)),
 		remotePeer:     sourceFrontend,
+		streamClient:   sourceStream,
 		currentCluster: currentCluster,
 		sourceCluster:  sourceCluster,
 		rateLimiter:    quotas.NewDynamicRateLimiter(config.ReplicationTaskProcessorHostQPS.AsFloat64()),
@@ -215,19 +228,24 @@ func (f *taskFetcherImpl) fetchTasks() {
 	defer timer.Stop()
 
 	requestByShard := make(map[int32]*request)
+	var streamRetryTime time.Time
 	for {
 		select {
 		case request := <-f.requestChan:
 			// Here we only add the request to map. We will wait until timer fires to send the request to remote.
-			if req, ok := requestByShard[request.token.GetShardID()]; ok && req != request {
-				// since this replication task fetcher is per host and replication task processor is per shard
-				// during shard movement, duplicated requests can appear, if shard moved from this host to this host.
-				f.logger.Info("Get replication task request already exist for shard.", tag.ShardID(int(request.token.GetShardID())))
-				close(req.respChan)
-			}
-			requestByShard[request.token.GetShardID()] = request
+			f.addRequest(requestByShard, request)
 
 		case <-timer.Chan():
+			if f.streamClient != nil && f.config.ReplicationTaskFetcherEnableStreaming() && !f.timeSource.Now().Before(streamRetryTime) {
+				// streamTasks only returns when the stream is broken, fall back to polling until the retry wait passes
+				err := f.streamTasks(requestByShard)
+				if f.ctx.Err() != nil {
+					return
+				}
+				f.logger.Warn("Replication stream failed, polling replication tasks instead.", tag.Error(err))
+				streamRetryTime = f.timeSource.Now().Add(f.config.ReplicationTaskFetcherStreamRetryWait())
+			}
+
 			// When timer fires, we collect all the requests we have so far and attempt to send them to remote.
 			err := f.fetchAndDistributeTasksFn(requestByShard)
 			if err != nil {
@@ -271,14 +289,129 @@ func (f *taskFetcherImpl) fetchAndDistributeTasks(requestByShard map[int32]*requ
 	}
 
 	f.logger.Debug("Successfully fetched replication tasks.", tag.Counter(len(messagesByShard)))
+	distributeTasks(requestByShard, messagesByShard)
+	return nil
+}
+
+// streamTasks sends the requests of shards over a replication stream to source frontend, which answers
+// as soon as new replication tasks are available. It returns when the stream breaks or the fetcher stops.
+func (f *taskFetcherImpl) streamTasks(requestByShard map[int32]*request) error {
+	ctx, cancel := context.WithCancel(f.ctx)
+	defer cancel()
+
+	stream, err := f.streamClient.StreamReplicationMessages(
+		ctx,
+		yarpc.WithHeader(common.ReplicationCapabilitiesHeaderName, replicationbatch.SupportedCapabilities.String()),
+	)
+	if err != nil {
+		return err
+	}
+	defer stream.CloseSend()
+
+	responses := make(chan *types.GetReplicationMessagesResponse)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case responses <- proto.ToAdminGetReplicationMessagesResponse(response.GetResponse()):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// sendTimer aggregates requests of shards before sending them, responseTimer detects a stalled stream
+	sendTimer := f.timeSource.NewTimer(0)
+	defer sendTimer.Stop()
+	responseTimer := f.timeSource.NewTimer(fetchTaskRequestTimeout)
+	responseTimer.Stop()
+	defer responseTimer.Stop()
+	sendPending := true
+	for {
+		select {
+		case request := <-f.requestChan:
+			f.addRequest(requestByShard, request)
+			if !sendPending {
+				sendPending = true
+				sendTimer.Reset(backoff.JitDuration(
+					f.config.ReplicationTaskFetcherAggregationInterval(),
+					f.config.ReplicationTaskFetcherTimerJitterCoefficient(),
+				))
+			}
+
+		case <-sendTimer.Chan():
+			sendPending = false
+			if len(requestByShard) == 0 {
+				continue
+			}
+			// every request carries all shards waiting for tasks and supersedes the previous one
+			var tokens []*types.ReplicationToken
+			for _, request := range requestByShard {
+				tokens = append(tokens, request.token)
+			}
+			err := stream.Send(&replicationv1.StreamReplicationMessagesRequest{
+				Request: proto.FromAdminGetReplicationMessagesRequest(&types.GetReplicationMessagesRequest{
+					Tokens:      tokens,
+					ClusterName: f.currentCluster,
+				}),
+			})
+			if err != nil {
+				return err
+			}
+			responseTimer.Reset(fetchTaskRequestTimeout)
+
+		case response := <-responses:
+			responseTimer.Stop()
+			messagesByShard := response.GetMessagesByShard()
+			f.logger.Debug("Successfully streamed replication tasks.", tag.Counter(len(messagesByShard)))
+			distributeTasks(requestByShard, messagesByShard)
+			// shards left out of the response are no longer waited on by source frontend
+			if len(requestByShard) > 0 && !sendPending {
+				sendPending = true
+				sendTimer.Reset(backoff.JitDuration(
+					f.config.ReplicationTaskFetcherAggregationInterval(),
+					f.config.ReplicationTaskFetcherTimerJitterCoefficient(),
+				))
+			}
+
+		case <-responseTimer.Chan():
+			return errReplicationStreamTimeout
+
+		case err := <-recvErr:
+			return err
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *taskFetcherImpl) addRequest(requestByShard map[int32]*request, request *request) {
+	if req, ok := requestByShard[request.token.GetShardID()]; ok && req != request {
+		// since this replication task fetcher is per host and replication task processor is per shard
+		// during shard movement, duplicated requests can appear, if shard moved from this host to this host.
+		f.logger.Info("Get replication task request already exist for shard.", tag.ShardID(int(request.token.GetShardID())))
+		close(req.respChan)
+	}
+	requestByShard[request.token.GetShardID()] = request
+}
+
+func distributeTasks(requestByShard map[int32]*request, messagesByShard map[int32]*types.ReplicationMessages) {
 	for shardID, tasks := range messagesByShard {
-		request := requestByShard[shardID]
+		request, ok := requestByShard[shardID]
+		if !ok {
+			// the shard was answered by an earlier response of the stream
+			continue
+		}
 		request.respChan <- tasks
 		close(request.respChan)
 		delete(requestByShard, shardID)
 	}
-
-	return nil
 }
 
 func (f *taskFetcherImpl) getMessages(requestByShard map[int32]*request) (map[int32]*types.ReplicationMessages, error) {
@@ -294,7 +427,11 @@ func (f *taskFetcherImpl) getMessages(requestByShard map[int32]*request) (map[in
 		Tokens:      tokens,
 		ClusterName: f.currentCluster,
 	}
-	response, err := f.remotePeer.GetReplicationMessages(ctx, request)
+	response, err := f.remotePeer.GetReplicationMessages(
+		ctx,
+		request,
+		yarpc.WithHeader(common.ReplicationCapabilitiesHeaderName, replicationbatch.SupportedCapabilities.String()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -21,6 +21,8 @@
 package replication
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -29,9 +31,12 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
+	replicationv1 "github.com/uber/cadence/.gen/proto/replication/v1"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -39,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
 	"github.com/uber/cadence/service/history/config"
 )
 
@@ -53,8 +59,51 @@ type (
 		frontendClient *admin.MockClient
 		taskFetcher    *taskFetcherImpl
 	}
+
+	fakeReplicationStreamClient struct {
+		stream *fakeReplicationStream
+	}
+
+	fakeReplicationStream struct {
+		ctx       context.Context
+		requests  chan *replicationv1.StreamReplicationMessagesRequest
+		responses chan *replicationv1.StreamReplicationMessagesResponse
+		errs      chan error
+	}
 )
 
+func (c *fakeReplicationStreamClient) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (replicationv1.ReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient, error) {
+	c.stream.ctx = ctx
+	return c.stream, nil
+}
+
+func (s *fakeReplicationStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeReplicationStream) Send(request *replicationv1.StreamReplicationMessagesRequest, opts ...yarpc.StreamOption) error {
+	s.requests <- request
+	return nil
+}
+
+func (s *fakeReplicationStream) Recv(opts ...yarpc.StreamOption) (*replicationv1.StreamReplicationMessagesResponse, error) {
+	select {
+	case response := <-s.responses:
+		return response, nil
+	case err := <-s.errs:
+		return nil, err
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func (s *fakeReplicationStream) CloseSend(opts ...yarpc.StreamOption) error {
+	return nil
+}
+
 func TestReplicationTaskFetcherSuite(t *testing.T) {
 	s := new(taskFetcherSuite)
 	suite.Run(t, s)
@@ -84,6 +133,7 @@ func (s *taskFetcherSuite) SetupTest() {
 		"active",
 		s.config,
 		s.frontendClient,
+		nil,
 	).(*taskFetcherImpl)
 }
 
@@ -113,7 +163,11 @@ func (s *taskFetcherSuite) TestGetMessages() {
 	expectedResponse := &types.GetReplicationMessagesResponse{
 		MessagesByShard: messageByShared,
 	}
-	s.frontendClient.EXPECT().GetReplicationMessages(gomock.Any(), replicationMessageRequest).Return(expectedResponse, nil)
+	s.frontendClient.EXPECT().GetReplicationMessages(
+		gomock.Any(),
+		replicationMessageRequest,
+		yarpc.WithHeader(common.ReplicationCapabilitiesHeaderName, "batching,zstd"),
+	).Return(expectedResponse, nil)
 	response, err := s.taskFetcher.getMessages(requestByShard)
 	s.NoError(err)
 	s.Equal(messageByShared, response)
@@ -142,7 +196,11 @@ func (s *taskFetcherSuite) TestFetchAndDistributeTasks() {
 	expectedResponse := &types.GetReplicationMessagesResponse{
 		MessagesByShard: messageByShared,
 	}
-	s.frontendClient.EXPECT().GetReplicationMessages(gomock.Any(), replicationMessageRequest).Return(expectedResponse, nil)
+	s.frontendClient.EXPECT().GetReplicationMessages(
+		gomock.Any(),
+		replicationMessageRequest,
+		yarpc.WithHeader(common.ReplicationCapabilitiesHeaderName, "batching,zstd"),
+	).Return(expectedResponse, nil)
 	err := s.taskFetcher.fetchAndDistributeTasks(requestByShard)
 	s.NoError(err)
 	respToken := <-respChan
@@ -256,6 +314,55 @@ func (s *taskFetcherSuite) TestLifecycle() {
 	s.Equal(4, fetchAndDistributeTasksFnCall)
 }
 
+func (s *taskFetcherSuite) TestStreamTasks_FallbackToPolling() {
+	defer goleak.VerifyNone(s.T())
+	mockTimeSource := clock.NewMockedTimeSourceAt(time.Now())
+	s.taskFetcher.timeSource = mockTimeSource
+	s.config.ReplicationTaskFetcherEnableStreaming = dynamicconfig.GetBoolPropertyFn(true)
+	stream := &fakeReplicationStream{
+		requests:  make(chan *replicationv1.StreamReplicationMessagesRequest, 1),
+		responses: make(chan *replicationv1.StreamReplicationMessagesResponse, 1),
+		errs:      make(chan error, 1),
+	}
+	s.taskFetcher.streamClient = &fakeReplicationStreamClient{stream: stream}
+	polled := make(chan struct{})
+	s.taskFetcher.fetchAndDistributeTasksFn = func(requestByShard map[int32]*request) error {
+		close(polled)
+		return nil
+	}
+
+	token := &types.ReplicationToken{
+		ShardID:                0,
+		LastRetrievedMessageID: 10,
+		LastProcessedMessageID: 1,
+	}
+	respChan := make(chan *types.ReplicationMessages, 1)
+	s.taskFetcher.Start()
+	defer s.taskFetcher.Stop()
+	s.taskFetcher.GetRequestChan() <- &request{token: token, respChan: respChan}
+
+	// the stream is opened when the timer fires and sends the pending requests right away
+	mockTimeSource.BlockUntil(1)
+	mockTimeSource.Advance(s.config.ReplicationTaskFetcherAggregationInterval())
+	streamRequest := <-stream.requests
+	s.Equal(&types.GetReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token},
+		ClusterName: "active",
+	}, proto.ToAdminGetReplicationMessagesRequest(streamRequest.GetRequest()))
+
+	messages := &types.ReplicationMessages{LastRetrievedMessageID: 20}
+	stream.responses <- &replicationv1.StreamReplicationMessagesResponse{
+		Response: proto.FromAdminGetReplicationMessagesResponse(&types.GetReplicationMessagesResponse{
+			MessagesByShard: map[int32]*types.ReplicationMessages{0: messages},
+		}),
+	}
+	s.Equal(messages, <-respChan)
+
+	// a broken stream falls back to polling
+	stream.errs <- errors.New("stream broken")
+	<-polled
+}
+
 func TestTaskFetchers(t *testing.T) {
 	defer goleak.VerifyNone(t)
 	ctrl := gomock.NewController(t)
@@ -265,6 +372,7 @@ func TestTaskFetchers(t *testing.T) {
 	cfg := config.NewForTest()
 
 	mockBean.EXPECT().GetRemoteAdminClient(cluster.TestAlternativeClusterName).Return(mockAdminClient)
+	mockBean.EXPECT().GetRemoteReplicationStreamClient(cluster.TestAlternativeClusterName).Return(nil)
 	fetchers := NewTaskFetchers(logger, cfg, cluster.TestActiveClusterMetadata, mockBean)
 	assert.NotNil(t, fetchers)
 	assert.Len(t, fetchers.GetFetchers(), len(cluster.TestActiveClusterMetadata.GetRemoteClusterInfo()))
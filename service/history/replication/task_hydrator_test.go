@@ -39,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationfilter"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -372,6 +373,19 @@ func TestTaskHydrator_HydrateHistoryReplicationTask(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "workflow excluded by replication filters - return nil, no error",
+			task: task,
+			msProvider: &fakeMutableStateProvider{
+				workflows: map[definition.WorkflowIdentifier]mutableState{
+					testWorkflowIdentifier: &fakeMutableState{
+						versionHistories: &versionHistories,
+						partitionConfig:  map[string]string{replicationfilter.SingleClusterKey: "active"},
+					},
+				},
+			},
+			expectTask: nil,
+		},
 		{
 			name: "no version histories - return nil, no error",
 			task: task,
@@ -760,7 +774,7 @@ func TestImmediateTaskHydrator(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewImmediateTaskHydrator(true, tt.versionHistories, tt.activities, tt.blob, tt.nextRunBlob)
+			h := NewImmediateTaskHydrator(&persistence.WorkflowExecutionInfo{State: persistence.WorkflowStateRunning}, tt.versionHistories, tt.activities, tt.blob, tt.nextRunBlob)
 			result, err := h.Hydrate(context.Background(), tt.task)
 
 			if tt.expectErr != "" {
@@ -798,8 +812,12 @@ type fakeMutableState struct {
 	isWorkflowExecutionRunning bool
 	versionHistories           *persistence.VersionHistories
 	activityInfos              map[int64]persistence.ActivityInfo
+	partitionConfig            map[string]string
 }
 
+func (ms fakeMutableState) GetExecutionInfo() *persistence.WorkflowExecutionInfo {
+	return &persistence.WorkflowExecutionInfo{PartitionConfig: ms.partitionConfig}
+}
 func (ms fakeMutableState) IsWorkflowExecutionRunning() bool {
 	return ms.isWorkflowExecutionRunning
 }
@@ -0,0 +1,52 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import "sync"
+
+// TaskNotifier wakes up the callers waiting for new replication tasks of a shard.
+type TaskNotifier struct {
+	sync.Mutex
+	ch chan struct{}
+}
+
+// NewTaskNotifier creates a new TaskNotifier
+func NewTaskNotifier() *TaskNotifier {
+	return &TaskNotifier{ch: make(chan struct{})}
+}
+
+// Notify wakes up all the callers waiting on the channels returned by Watch so far
+func (n *TaskNotifier) Notify() {
+	n.Lock()
+	defer n.Unlock()
+
+	close(n.ch)
+	n.ch = make(chan struct{})
+}
+
+// Watch returns a channel which is closed once new replication tasks are written.
+// Callers should watch before reading tasks, so tasks written in between are not missed.
+func (n *TaskNotifier) Watch() <-chan struct{} {
+	n.Lock()
+	defer n.Unlock()
+
+	return n.ch
+}
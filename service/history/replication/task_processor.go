@@ -43,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/reconciliation"
 	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/replicationbatch"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -303,9 +304,17 @@ func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages)
 	}
 
 	scope := p.metricsClient.Scope(metrics.ReplicationTaskFetcherScope, metrics.TargetClusterTag(p.sourceCluster))
+	replicationTasks, err := replicationbatch.Unbatch(response.ReplicationTasks)
+	if err != nil {
+		// the source cluster sent a batch this version cannot decode, retrying fetches the same batch again
+		p.logger.Error("Failed to unbatch replication tasks.", tag.Error(err))
+		scope.IncCounter(metrics.ReplicationTaskBatchDecodeFailures)
+		return
+	}
+
 	batchRequestStartTime := time.Now()
 	ctx := context.Background()
-	for _, replicationTask := range response.ReplicationTasks {
+	for _, replicationTask := range replicationTasks {
 		// TODO: move to MultiStageRateLimiter
 		_ = p.hostRateLimiter.Wait(ctx)
 		_ = p.shardRateLimiter.Wait(ctx)
@@ -52,6 +52,7 @@ import (
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/reconciliation"
 	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/replicationbatch"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -175,6 +176,65 @@ func (s *taskProcessorSuite) TestProcessResponse_NoTask() {
 	s.Equal(int64(100), s.taskProcessor.lastRetrievedMessageID)
 }
 
+func (s *taskProcessorSuite) TestProcessResponse_BatchedTasks() {
+	var tasks []*types.ReplicationTask
+	for i := int64(0); i < 3; i++ {
+		tasks = append(tasks, &types.ReplicationTask{
+			TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+			SourceTaskID: testTaskID + i,
+			HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+				DomainID:            testDomainID,
+				WorkflowID:          testWorkflowID,
+				RunID:               testRunID,
+				VersionHistoryItems: []*types.VersionHistoryItem{{EventID: i + 1, Version: 1}},
+				Events:              &types.DataBlob{EncodingType: types.EncodingTypeThriftRW.Ptr(), Data: []byte{1, 2, 3}},
+			},
+		})
+	}
+	batched, err := replicationbatch.Batch(tasks, replicationbatch.Options{MaxBatchSize: 10, Compress: true})
+	s.NoError(err)
+	s.Len(batched, 1)
+
+	var executed []*types.ReplicationTask
+	s.taskExecutor.EXPECT().execute(gomock.Any(), false).DoAndReturn(func(task *types.ReplicationTask, _ bool) (int, error) {
+		executed = append(executed, task)
+		return 0, nil
+	}).Times(3)
+	s.mockDomainCache.EXPECT().GetDomainName(testDomainID).Return(testDomainName, nil).Times(3)
+
+	s.taskProcessor.processResponse(&types.ReplicationMessages{
+		ReplicationTasks:       batched,
+		LastRetrievedMessageID: 100,
+	})
+	s.Equal(tasks, executed)
+	s.Equal(int64(100), s.taskProcessor.lastProcessedMessageID)
+	s.Equal(int64(100), s.taskProcessor.lastRetrievedMessageID)
+}
+
+func (s *taskProcessorSuite) TestProcessResponse_InvalidBatch() {
+	batched, err := replicationbatch.Batch([]*types.ReplicationTask{
+		{
+			TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(),
+			HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+				DomainID:   testDomainID,
+				WorkflowID: testWorkflowID,
+				RunID:      testRunID,
+			},
+		},
+	}, replicationbatch.Options{Compress: true})
+	s.NoError(err)
+	// corrupt the compressed payload
+	data := batched[0].HistoryTaskV2Attributes.Events.Data
+	batched[0].HistoryTaskV2Attributes.Events.Data = data[:len(data)-2]
+
+	s.taskProcessor.processResponse(&types.ReplicationMessages{
+		ReplicationTasks:       batched,
+		LastRetrievedMessageID: 100,
+	})
+	s.Equal(int64(-1), s.taskProcessor.lastProcessedMessageID)
+	s.Equal(int64(-1), s.taskProcessor.lastRetrievedMessageID)
+}
+
 func (s *taskProcessorSuite) TestProcessorLoop_RequestChanPopulated() {
 	// start the process loop so it poppulates requestChan
 	s.taskProcessor.wg.Add(1)
@@ -31,6 +31,8 @@ const (
 
 	stickyTaskMaxRetryCount = 100
 
+	taskDLQEnqueueTimeout = 5 * time.Second
+
 	// noPriority is the value returned if no priority is ever assigned to the task
 	noPriority = -1
 )
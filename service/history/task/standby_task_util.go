@@ -114,23 +114,31 @@ type (
 	pushActivityToMatchingInfo struct {
 		activityScheduleToStartTimeout int32
 		partitionConfig                map[string]string
+		priority                       int32
+		fairnessKey                    string
 	}
 
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		tasklist                       types.TaskList
 		partitionConfig                map[string]string
+		priority                       int32
+		fairnessKey                    string
 	}
 )
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
 	partitionConfig map[string]string,
+	priority int32,
+	fairnessKey string,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
 		partitionConfig:                partitionConfig,
+		priority:                       priority,
+		fairnessKey:                    fairnessKey,
 	}
 }
 
@@ -138,12 +146,16 @@ func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist types.TaskList,
 	partitionConfig map[string]string,
+	priority int32,
+	fairnessKey string,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
 		partitionConfig:                partitionConfig,
+		priority:                       priority,
+		fairnessKey:                    fairnessKey,
 	}
 }
 
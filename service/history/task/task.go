@@ -21,6 +21,8 @@
 package task
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
@@ -36,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	ctask "github.com/uber/cadence/common/task"
+	"github.com/uber/cadence/common/taskdlq"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -331,6 +334,12 @@ func (t *taskImpl) HandleErr(err error) (retErr error) {
 		return nil
 	}
 
+	// discarded tasks and tasks of 2DC workflows have no error left at this point,
+	// they are acked and must not be moved to the DLQ
+	if err != nil && t.shouldMoveToDLQ() && t.moveToDLQ(err) == nil {
+		return nil
+	}
+
 	t.logger.Error("Fail to process task", tag.Error(err), tag.LifeCycleProcessingFailed)
 	return err
 }
@@ -420,6 +429,66 @@ func (t *taskImpl) GetQueueType() QueueType {
 	return t.queueType
 }
 
+// shouldMoveToDLQ returns whether the task has been failing for long enough to stop retrying it,
+// so it no longer blocks the ack level of its processing queue
+func (t *taskImpl) shouldMoveToDLQ() bool {
+	config := t.shard.GetConfig()
+	if !config.EnableTaskDLQByDomainID(t.GetDomainID()) {
+		return false
+	}
+	return t.GetAttempt() >= config.TaskDLQMaxAttempts() &&
+		t.timeSource.Now().Sub(t.submitTime) >= config.TaskDLQMaxAge()
+}
+
+func (t *taskImpl) moveToDLQ(taskErr error) error {
+	var lastError string
+	if taskErr != nil {
+		lastError = taskErr.Error()
+	}
+	taskInfo, err := json.Marshal(t.Info)
+	if err != nil {
+		t.logger.Error("Failed to serialize task for history task DLQ", tag.Error(err))
+		return err
+	}
+
+	category := types.HistoryTaskCategoryTimer
+	if t.queueType == QueueTypeActiveTransfer || t.queueType == QueueTypeStandbyTransfer {
+		category = types.HistoryTaskCategoryTransfer
+	}
+	message := &types.HistoryTaskDLQMessage{
+		ShardID:                   int32(t.shard.GetShardID()),
+		DomainID:                  t.GetDomainID(),
+		WorkflowID:                t.GetWorkflowID(),
+		RunID:                     t.GetRunID(),
+		TaskCategory:              category,
+		TaskType:                  int32(t.GetTaskType()),
+		TaskID:                    t.GetTaskID(),
+		VisibilityTimestampNano:   t.GetVisibilityTimestamp().UnixNano(),
+		Attempt:                   int32(t.GetAttempt() + 1),
+		FirstAttemptTimestampNano: t.submitTime.UnixNano(),
+		EnqueuedTimestampNano:     t.timeSource.Now().UnixNano(),
+		LastError:                 lastError,
+		TaskInfo:                  string(taskInfo),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), taskDLQEnqueueTimeout)
+	defer cancel()
+	dlq := taskdlq.NewDLQ(t.shard.GetService().GetPersistenceBean())
+	if err := dlq.Enqueue(ctx, message); err != nil {
+		t.scope.IncCounter(metrics.TaskMoveToDLQFailedPerDomain)
+		t.logger.Error("Failed to move task to history task DLQ", tag.Error(err))
+		return err
+	}
+
+	t.scope.IncCounter(metrics.TaskMovedToDLQPerDomain)
+	t.logger.Warn("Moved permanently failing task to history task DLQ",
+		tag.Error(taskErr),
+		tag.TaskType(t.GetTaskType()),
+		tag.AttemptCount(int(message.Attempt)),
+	)
+	return nil
+}
+
 func (t *taskImpl) shouldResubmitOnNack() bool {
 	// TODO: for now only resubmit active task on Nack()
 	// we can also consider resubmit standby tasks that fails due to certain error types
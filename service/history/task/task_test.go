@@ -22,6 +22,7 @@ package task
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -41,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
 )
 
@@ -253,6 +255,100 @@ func (s *taskSuite) TestHandleErr_UnknownErr() {
 	s.Equal(err, taskBase.HandleErr(err))
 }
 
+func (s *taskSuite) TestHandleErr_MoveToDLQ() {
+	testCases := []struct {
+		name        string
+		enabled     bool
+		attempt     int
+		enqueueErr  error
+		expectMoved bool
+	}{
+		{
+			name:    "dlq disabled",
+			enabled: false,
+			attempt: 10,
+		},
+		{
+			name:    "below max attempts",
+			enabled: true,
+			attempt: 4,
+		},
+		{
+			name:        "moved to dlq",
+			enabled:     true,
+			attempt:     5,
+			expectMoved: true,
+		},
+		{
+			name:       "enqueue failed",
+			enabled:    true,
+			attempt:    5,
+			enqueueErr: errors.New("some persistence error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			defer s.TearDownTest()
+
+			taskBase := s.newTestTask(func(task Info) (bool, error) {
+				return true, nil
+			}, nil)
+			taskBase.attempt = tc.attempt
+			shardConfig := s.mockShard.GetConfig()
+			shardConfig.EnableTaskDLQByDomainID = dynamicconfig.GetBoolPropertyFnFilteredByDomainID(tc.enabled)
+			shardConfig.TaskDLQMaxAttempts = dynamicconfig.GetIntPropertyFn(5)
+			shardConfig.TaskDLQMaxAge = dynamicconfig.GetDurationPropertyFn(0)
+
+			s.mockTaskInfo.EXPECT().GetTaskType().Return(persistence.TransferTaskTypeDecisionTask).AnyTimes()
+			if tc.enabled && tc.attempt >= 5 {
+				s.mockTaskInfo.EXPECT().GetWorkflowID().Return(constants.TestWorkflowID)
+				s.mockTaskInfo.EXPECT().GetRunID().Return(constants.TestRunID)
+				s.mockTaskInfo.EXPECT().GetTaskID().Return(int64(123))
+				s.mockTaskInfo.EXPECT().GetVisibilityTimestamp().Return(time.Unix(0, 100))
+				queue := persistence.NewMockQueueManager(s.controller)
+				s.mockShard.Resource.PersistenceBean.EXPECT().GetHistoryTaskQueueManager(10).Return(queue, nil)
+				queue.EXPECT().EnqueueMessageToDLQ(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, payload []byte) error {
+					message := &types.HistoryTaskDLQMessage{}
+					s.NoError(json.Unmarshal(payload, message))
+					s.Equal(int32(10), message.ShardID)
+					s.Equal(constants.TestDomainID, message.DomainID)
+					s.Equal(constants.TestWorkflowID, message.WorkflowID)
+					s.Equal(types.HistoryTaskCategoryTransfer, message.TaskCategory)
+					s.Equal(int32(persistence.TransferTaskTypeDecisionTask), message.TaskType)
+					s.Equal(int64(123), message.TaskID)
+					s.Equal(int32(tc.attempt+1), message.Attempt)
+					s.Equal("some random error", message.LastError)
+					return tc.enqueueErr
+				})
+			}
+
+			err := errors.New("some random error")
+			if tc.expectMoved {
+				s.NoError(taskBase.HandleErr(err))
+			} else {
+				s.Equal(err, taskBase.HandleErr(err))
+			}
+		})
+	}
+}
+
+func (s *taskSuite) TestHandleErr_DiscardedTaskNotMovedToDLQ() {
+	taskBase := s.newTestTask(func(task Info) (bool, error) {
+		return true, nil
+	}, nil)
+	taskBase.attempt = 5
+	shardConfig := s.mockShard.GetConfig()
+	shardConfig.EnableTaskDLQByDomainID = dynamicconfig.GetBoolPropertyFnFilteredByDomainID(true)
+	shardConfig.TaskDLQMaxAttempts = dynamicconfig.GetIntPropertyFn(5)
+	shardConfig.TaskDLQMaxAge = dynamicconfig.GetDurationPropertyFn(0)
+
+	// the persistence bean is not expected to be called, the task is acked without a DLQ message
+	s.NoError(taskBase.HandleErr(ErrTaskDiscarded))
+	s.NoError(taskBase.HandleErr(execution.ErrMissingVersionHistories))
+}
+
 func (s *taskSuite) TestTaskState() {
 	taskBase := s.newTestTask(func(task Info) (bool, error) {
 		return true, nil
@@ -41,6 +41,9 @@ import (
 
 const (
 	scanWorkflowTimeout = 30 * time.Second
+
+	// activityRetryTimerTolerance covers the precision loss of persisted timestamps, timer tasks never fire earlier otherwise
+	activityRetryTimerTolerance = time.Second
 )
 
 var (
@@ -601,6 +604,19 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 	return t.updateWorkflowExecution(ctx, wfContext, mutableState, true)
 }
 
+// isStaleActivityRetryTimer checks if the retry timer was created for another attempt than the one the activity is waiting for.
+// Resetting the attempts of an activity restarts its attempt counter, so a timer of an earlier attempt can carry the same
+// attempt as the current one, it is recognized by firing well before the current attempt is scheduled.
+func isStaleActivityRetryTimer(
+	task *persistence.TimerTaskInfo,
+	activityInfo *persistence.ActivityInfo,
+) bool {
+	if task.ScheduleAttempt != int64(activityInfo.Attempt) {
+		return true
+	}
+	return task.VisibilityTimestamp.Before(activityInfo.ScheduledTime.Add(-activityRetryTimerTolerance))
+}
+
 func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	ctx context.Context,
 	task *persistence.TimerTaskInfo,
@@ -630,7 +646,7 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	// generate activity task
 	scheduledID := task.EventID
 	activityInfo, ok := mutableState.GetActivityInfo(scheduledID)
-	if !ok || task.ScheduleAttempt < int64(activityInfo.Attempt) || activityInfo.StartedID != common.EmptyEventID {
+	if !ok || isStaleActivityRetryTimer(task, activityInfo) || activityInfo.StartedID != common.EmptyEventID {
 		if ok {
 			t.logger.Info("Duplicate activity retry timer task",
 				tag.WorkflowID(mutableState.GetExecutionInfo().WorkflowID),
@@ -1256,3 +1256,43 @@ func (s *timerActiveTaskExecutorSuite) TestActiveTaskTimeout() {
 	})
 	s.timerActiveTaskExecutor.Execute(deleteHistoryEventTask, true)
 }
+
+func TestIsStaleActivityRetryTimer(t *testing.T) {
+	scheduledTime := time.Unix(1000, 0)
+	tests := []struct {
+		name         string
+		task         *persistence.TimerTaskInfo
+		activityInfo *persistence.ActivityInfo
+		want         bool
+	}{
+		{
+			name:         "timer of the current attempt",
+			task:         &persistence.TimerTaskInfo{ScheduleAttempt: 3, VisibilityTimestamp: scheduledTime.Add(-time.Millisecond)},
+			activityInfo: &persistence.ActivityInfo{Attempt: 3, ScheduledTime: scheduledTime},
+			want:         false,
+		},
+		{
+			name:         "timer of an earlier attempt",
+			task:         &persistence.TimerTaskInfo{ScheduleAttempt: 2, VisibilityTimestamp: scheduledTime},
+			activityInfo: &persistence.ActivityInfo{Attempt: 3, ScheduledTime: scheduledTime},
+			want:         true,
+		},
+		{
+			name:         "timer created before the attempts were reset",
+			task:         &persistence.TimerTaskInfo{ScheduleAttempt: 3, VisibilityTimestamp: scheduledTime},
+			activityInfo: &persistence.ActivityInfo{Attempt: 0, ScheduledTime: scheduledTime.Add(-time.Minute)},
+			want:         true,
+		},
+		{
+			name:         "timer of the same attempt before the attempts were reset",
+			task:         &persistence.TimerTaskInfo{ScheduleAttempt: 3, VisibilityTimestamp: scheduledTime},
+			activityInfo: &persistence.ActivityInfo{Attempt: 3, ScheduledTime: scheduledTime.Add(time.Minute)},
+			want:         true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, isStaleActivityRetryTimer(tc.task, tc.activityInfo))
+		})
+	}
+}
@@ -197,6 +197,11 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	partitionConfig := mutableState.GetExecutionInfo().PartitionConfig
+	priority, fairnessKey, err := t.getActivityTaskPriority(ctx, mutableState, ai.ScheduleID)
+	if err != nil {
+		return err
+	}
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
@@ -206,7 +211,7 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 		return errWorkflowRateLimited
 	}
 
-	err = t.pushActivity(ctx, task, timeout, mutableState.GetExecutionInfo().PartitionConfig)
+	err = t.pushActivity(ctx, task, timeout, partitionConfig, priority, fairnessKey)
 	if err == nil {
 		scope := common.NewPerTaskListScope(domainName, task.TaskList, types.TaskListKindNormal, t.metricsClient, metrics.TransferActiveTaskActivityScope)
 		scope.RecordTimer(metrics.ScheduleToStartHistoryQueueLatencyPerTaskList, time.Since(task.GetVisibilityTimestamp()))
@@ -277,6 +282,10 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	// or even lost the decision if there's originally no timeout timer task
 	// for the decision. Using MaxTaskTimeout here for now so at least no
 	// decision will be lost.
+	priority, fairnessKey, err := t.getDecisionTaskPriority(ctx, mutableState)
+	if err != nil {
+		return err
+	}
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -287,7 +296,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		return errWorkflowRateLimited
 	}
 
-	err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig)
+	err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig, priority, fairnessKey)
 	if _, ok := err.(*types.StickyWorkerUnavailableError); ok {
 		// sticky worker is unavailable, switch to non-sticky task list
 		taskList = &types.TaskList{
@@ -299,7 +308,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		// There is no need to reset sticky, because if this task is picked by new worker, the new worker will reset
 		// the sticky queue to a new one. However, if worker is completely down, that schedule_to_start timeout task
 		// will re-create a new non-sticky task and reset sticky.
-		err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig)
+		err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig, priority, fairnessKey)
 	}
 	if err == nil {
 		tlKind := types.TaskListKindNormal
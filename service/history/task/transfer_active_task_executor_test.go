@@ -236,6 +236,52 @@ func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Success() {
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_WithTaskPriority() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	event, ai := test.AddActivityTaskScheduledEvent(
+		mutableState,
+		decisionCompletionID,
+		"activity-1",
+		"some random activity type",
+		mutableState.GetExecutionInfo().TaskList,
+		[]byte{}, 1, 1, 1, 1,
+	)
+	event.ActivityTaskScheduledEventAttributes.Header = &types.Header{
+		Fields: map[string][]byte{
+			common.TaskPriorityHeaderKey:    []byte("5"),
+			common.TaskFairnessKeyHeaderKey: []byte("tenant-1"),
+		},
+	}
+	mutableState.FlushBufferedEvents()
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:        s.version,
+		DomainID:       s.domainID,
+		TargetDomainID: constants.TestDomainID,
+		WorkflowID:     workflowExecution.GetWorkflowID(),
+		RunID:          workflowExecution.GetRunID(),
+		TaskID:         int64(59),
+		TaskList:       mutableState.GetExecutionInfo().TaskList,
+		TaskType:       persistence.TransferTaskTypeActivityTask,
+		ScheduleID:     event.ID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockShard.GetConfig().EnableTaskPriority = func(domain string) bool { return true }
+	expectedRequest := createAddActivityTaskRequest(transferTask, ai, mutableState.GetExecutionInfo().PartitionConfig)
+	expectedRequest.Priority = 5
+	expectedRequest.FairnessKey = "tenant-1"
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), expectedRequest).Return(&types.AddActivityTaskResponse{}, nil).Times(1)
+	s.mockWFCache.EXPECT().AllowInternal(constants.TestDomainID, constants.TestWorkflowID).Return(true).Times(1)
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Ratelimits() {
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, constants.TestDomainID)
 	s.NoError(err)
@@ -372,6 +418,46 @@ func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_FirstDecision(
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_WithTaskPriority() {
+
+	workflowExecution, mutableState, err := test.StartWorkflow(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	startEvent, err := mutableState.GetStartEvent(context.Background())
+	s.NoError(err)
+	startEvent.WorkflowExecutionStartedEventAttributes.Header = &types.Header{
+		Fields: map[string][]byte{
+			common.TaskPriorityHeaderKey:    []byte("3"),
+			common.TaskFairnessKeyHeaderKey: []byte("tenant-1"),
+		},
+	}
+
+	di := test.AddDecisionTaskScheduledEvent(mutableState)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		TaskList:   mutableState.GetExecutionInfo().TaskList,
+		TaskType:   persistence.TransferTaskTypeDecisionTask,
+		ScheduleID: di.ScheduleID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, di.ScheduleID, di.Version)
+	s.NoError(err)
+	s.mockShard.GetConfig().EnableTaskPriority = func(domain string) bool { return true }
+	expectedRequest := createAddDecisionTaskRequest(transferTask, mutableState)
+	expectedRequest.Priority = 3
+	expectedRequest.FairnessKey = "tenant-1"
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockWFCache.EXPECT().AllowInternal(constants.TestDomainID, constants.TestWorkflowID).Return(true).Times(1)
+	s.mockMatchingClient.EXPECT().AddDecisionTask(gomock.Any(), expectedRequest).Return(&types.AddDecisionTaskResponse{}, nil).Times(1)
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_NonFirstDecision() {
 
 	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
@@ -140,9 +140,15 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedID == common.EmptyEventID {
+			priority, fairnessKey, err := t.getActivityTaskPriority(ctx, mutableState, activityInfo.ScheduleID)
+			if err != nil {
+				return nil, err
+			}
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
 				mutableState.GetExecutionInfo().PartitionConfig,
+				priority,
+				fairnessKey,
 			), nil
 		}
 
@@ -194,10 +200,16 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 		}
 
 		if decisionInfo.StartedID == common.EmptyEventID {
+			priority, fairnessKey, err := t.getDecisionTaskPriority(ctx, mutableState)
+			if err != nil {
+				return nil, err
+			}
 			return newPushDecisionToMatchingInfo(
 				decisionTimeout,
 				types.TaskList{Name: executionInfo.TaskList}, // at standby, always use non-sticky tasklist
 				mutableState.GetExecutionInfo().PartitionConfig,
+				priority,
+				fairnessKey,
 			), nil
 		}
 
@@ -604,6 +616,8 @@ func (t *transferStandbyTaskExecutor) pushActivity(
 		task.(*persistence.TransferTaskInfo),
 		timeout,
 		pushActivityInfo.partitionConfig,
+		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
 	)
 }
 
@@ -626,6 +640,8 @@ func (t *transferStandbyTaskExecutor) pushDecision(
 		&pushDecisionInfo.tasklist,
 		timeout,
 		pushDecisionInfo.partitionConfig,
+		pushDecisionInfo.priority,
+		pushDecisionInfo.fairnessKey,
 	)
 }
 
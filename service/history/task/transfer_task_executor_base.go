@@ -95,6 +95,8 @@ func (t *transferTaskExecutorBase) pushActivity(
 	task *persistence.TransferTaskInfo,
 	activityScheduleToStartTimeout int32,
 	partitionConfig map[string]string,
+	priority int32,
+	fairnessKey string,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -115,6 +117,8 @@ func (t *transferTaskExecutorBase) pushActivity(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		Priority:                      priority,
+		FairnessKey:                   fairnessKey,
 	})
 	return err
 }
@@ -125,6 +129,8 @@ func (t *transferTaskExecutorBase) pushDecision(
 	tasklist *types.TaskList,
 	decisionScheduleToStartTimeout int32,
 	partitionConfig map[string]string,
+	priority int32,
+	fairnessKey string,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -144,10 +150,47 @@ func (t *transferTaskExecutorBase) pushDecision(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		Priority:                      priority,
+		FairnessKey:                   fairnessKey,
 	})
 	return err
 }
 
+// getActivityTaskPriority returns the priority and fairness key set in the header of the activity scheduled event
+func (t *transferTaskExecutorBase) getActivityTaskPriority(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	scheduleID int64,
+) (int32, string, error) {
+
+	if !t.config.EnableTaskPriority(mutableState.GetDomainEntry().GetInfo().Name) {
+		return 0, "", nil
+	}
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
+	if err != nil {
+		return 0, "", err
+	}
+	priority, fairnessKey := common.TaskPriorityFromHeader(scheduledEvent.ActivityTaskScheduledEventAttributes.Header)
+	return priority, fairnessKey, nil
+}
+
+// getDecisionTaskPriority returns the priority and fairness key set in the header of the workflow started event
+func (t *transferTaskExecutorBase) getDecisionTaskPriority(
+	ctx context.Context,
+	mutableState execution.MutableState,
+) (int32, string, error) {
+
+	if !t.config.EnableTaskPriority(mutableState.GetDomainEntry().GetInfo().Name) {
+		return 0, "", nil
+	}
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+	priority, fairnessKey := common.TaskPriorityFromHeader(startEvent.WorkflowExecutionStartedEventAttributes.Header)
+	return priority, fairnessKey, nil
+}
+
 func (t *transferTaskExecutorBase) recordWorkflowStarted(
 	ctx context.Context,
 	domainID string,
@@ -97,6 +97,11 @@ func (g GRPCHandler) GetReplicationMessages(ctx context.Context, request *histor
 	return proto.FromHistoryGetReplicationMessagesResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) GetReplicationStatus(ctx context.Context, request *historyv1.GetReplicationStatusRequest) (*historyv1.GetReplicationStatusResponse, error) {
+	response, err := g.h.GetReplicationStatus(ctx, proto.ToHistoryGetReplicationStatusRequest(request))
+	return proto.FromHistoryGetReplicationStatusResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) MergeDLQMessages(ctx context.Context, request *historyv1.MergeDLQMessagesRequest) (*historyv1.MergeDLQMessagesResponse, error) {
 	response, err := g.h.MergeDLQMessages(ctx, proto.ToHistoryMergeDLQMessagesRequest(request))
 	return proto.FromHistoryMergeDLQMessagesResponse(response), proto.FromError(err)
@@ -137,6 +142,11 @@ func (g GRPCHandler) ReapplyEvents(ctx context.Context, request *historyv1.Reapp
 	return &historyv1.ReapplyEventsResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) RebuildWorkflowBranch(ctx context.Context, request *historyv1.RebuildWorkflowBranchRequest) (*historyv1.RebuildWorkflowBranchResponse, error) {
+	err := g.h.RebuildWorkflowBranch(ctx, proto.ToHistoryRebuildWorkflowBranchRequest(request))
+	return &historyv1.RebuildWorkflowBranchResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) RecordActivityTaskHeartbeat(ctx context.Context, request *historyv1.RecordActivityTaskHeartbeatRequest) (*historyv1.RecordActivityTaskHeartbeatResponse, error) {
 	response, err := g.h.RecordActivityTaskHeartbeat(ctx, proto.ToHistoryRecordActivityTaskHeartbeatRequest(request))
 	return proto.FromHistoryRecordActivityTaskHeartbeatResponse(response), proto.FromError(err)
@@ -261,3 +271,8 @@ func (g GRPCHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g GRPCHandler) UpdateActivityOptions(ctx context.Context, request *historyv1.UpdateActivityOptionsRequest) (*historyv1.UpdateActivityOptionsResponse, error) {
+	response, err := g.h.UpdateActivityOptions(ctx, proto.ToHistoryUpdateActivityOptionsRequest(request))
+	return proto.FromHistoryUpdateActivityOptionsResponse(response), proto.FromError(err)
+}
@@ -131,6 +131,10 @@ func (h *historyHandler) GetReplicationMessages(ctx context.Context, gp1 *types.
 	return h.wrapped.GetReplicationMessages(ctx, gp1)
 }
 
+func (h *historyHandler) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest) (hp1 *types.HistoryGetReplicationStatusResponse, err error) {
+	return h.wrapped.GetReplicationStatus(ctx, gp1)
+}
+
 func (h *historyHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, err error) {
 	return h.wrapped.Health(ctx)
 }
@@ -171,6 +175,10 @@ func (h *historyHandler) ReapplyEvents(ctx context.Context, hp1 *types.HistoryRe
 	return h.wrapped.ReapplyEvents(ctx, hp1)
 }
 
+func (h *historyHandler) RebuildWorkflowBranch(ctx context.Context, hp1 *types.HistoryRebuildWorkflowBranchRequest) (err error) {
+	return h.wrapped.RebuildWorkflowBranch(ctx, hp1)
+}
+
 func (h *historyHandler) RecordActivityTaskHeartbeat(ctx context.Context, hp1 *types.HistoryRecordActivityTaskHeartbeatRequest) (rp1 *types.RecordActivityTaskHeartbeatResponse, err error) {
 	return h.wrapped.RecordActivityTaskHeartbeat(ctx, hp1)
 }
@@ -349,3 +357,7 @@ func (h *historyHandler) SyncShardStatus(ctx context.Context, sp1 *types.SyncSha
 func (h *historyHandler) TerminateWorkflowExecution(ctx context.Context, hp1 *types.HistoryTerminateWorkflowExecutionRequest) (err error) {
 	return h.wrapped.TerminateWorkflowExecution(ctx, hp1)
 }
+
+func (h *historyHandler) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	return h.wrapped.UpdateActivityOptions(ctx, hp1)
+}
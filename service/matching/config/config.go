@@ -38,10 +38,15 @@ type (
 		DomainWorkerRPS         dynamicconfig.IntPropertyFnWithDomainFilter
 		ShutdownDrainDuration   dynamicconfig.DurationPropertyFn
 
+		// global ratelimiter configuration
+		GlobalDispatchRatelimiterMode   dynamicconfig.StringPropertyWithRatelimitKeyFilter
+		GlobalRatelimiterUpdateInterval dynamicconfig.DurationPropertyFn
+
 		// taskListManager configuration
 		RangeSize                            int64
 		ReadRangeSize                        dynamicconfig.IntPropertyFn
 		GetTasksBatchSize                    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		TaskPriorityLevels                   dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		UpdateAckInterval                    dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		IdleTasklistCheckInterval            dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		MaxTasklistIdleTime                  dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
@@ -60,11 +65,18 @@ type (
 		PartitionUpscaleRPS                  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		PartitionDownscaleFactor             dynamicconfig.FloatPropertyFnWithTaskListInfoFilters
 		PartitionUpscaleSustainedDuration    dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleBacklogPerPartition  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleMaxPartitions        dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleMinSyncMatchRate     dynamicconfig.FloatPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleDispatchLatency      dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionScalingCooldown             dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		PartitionDownscaleSustainedDuration  dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		AdaptiveScalerUpdateInterval         dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		EnableAdaptiveScaler                 dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		EnablePartitionIsolationGroups       dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
 		EnableStandbyTaskCompletion          dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
 		EnableClientAutoConfig               dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		EnableTaskFairness                   dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
 		QPSTrackerInterval                   dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
@@ -114,6 +126,7 @@ type (
 		ReadRangeSize                       dynamicconfig.IntPropertyFn
 		ActivityTaskSyncMatchWaitTime       dynamicconfig.DurationPropertyFnWithDomainFilter
 		GetTasksBatchSize                   func() int
+		TaskPriorityLevels                  func() int
 		UpdateAckInterval                   func() time.Duration
 		IdleTasklistCheckInterval           func() time.Duration
 		MaxTasklistIdleTime                 func() time.Duration
@@ -125,6 +138,11 @@ type (
 		PartitionUpscaleRPS                 func() int
 		PartitionDownscaleFactor            func() float64
 		PartitionUpscaleSustainedDuration   func() time.Duration
+		PartitionUpscaleBacklogPerPartition func() int
+		PartitionUpscaleMaxPartitions       func() int
+		PartitionUpscaleMinSyncMatchRate    func() float64
+		PartitionUpscaleDispatchLatency     func() time.Duration
+		PartitionScalingCooldown            func() time.Duration
 		PartitionDownscaleSustainedDuration func() time.Duration
 		AdaptiveScalerUpdateInterval        func() time.Duration
 		QPSTrackerInterval                  func() time.Duration
@@ -135,6 +153,7 @@ type (
 		NumReadPartitions                    func() int
 		EnableGetNumberOfPartitionsFromCache func() bool
 		EnableAdaptiveScaler                 func() bool
+		EnablePartitionIsolationGroups       func() bool
 		// isolation configuration
 		EnableTasklistIsolation func() bool
 		// A function which returns all the isolation groups
@@ -151,6 +170,8 @@ type (
 		// standby task completion configuration
 		EnableStandbyTaskCompletion func() bool
 		EnableClientAutoConfig      func() bool
+		// task fairness configuration
+		EnableTaskFairness func() bool
 	}
 )
 
@@ -167,6 +188,7 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		RangeSize:                            100000,
 		ReadRangeSize:                        dc.GetIntProperty(dynamicconfig.MatchingReadRangeSize),
 		GetTasksBatchSize:                    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize),
+		TaskPriorityLevels:                   dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskPriorityLevels),
 		UpdateAckInterval:                    dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval),
 		IdleTasklistCheckInterval:            dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval),
 		MaxTasklistIdleTime:                  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime),
@@ -184,6 +206,8 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		ForwarderMaxChildrenPerNode:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode),
 		EnableGetNumberOfPartitionsFromCache: dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableGetNumberOfPartitionsFromCache),
 		ShutdownDrainDuration:                dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration),
+		GlobalDispatchRatelimiterMode:        dc.GetStringPropertyFilteredByRatelimitKey(dynamicconfig.MatchingGlobalDispatchRatelimiterMode),
+		GlobalRatelimiterUpdateInterval:      dc.GetDurationProperty(dynamicconfig.GlobalRatelimiterUpdateInterval),
 		EnableDebugMode:                      dc.GetBoolProperty(dynamicconfig.EnableDebugMode)(),
 		EnableTaskInfoLogByDomainID:          dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID),
 		ActivityTaskSyncMatchWaitTime:        dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingActivityTaskSyncMatchWaitTime),
@@ -195,9 +219,15 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		PartitionUpscaleRPS:                  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleRPS),
 		PartitionDownscaleFactor:             dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDownscaleFactor),
 		PartitionUpscaleSustainedDuration:    dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleSustainedDuration),
+		PartitionUpscaleBacklogPerPartition:  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleBacklogPerPartition),
+		PartitionUpscaleMaxPartitions:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleMaxPartitions),
+		PartitionUpscaleMinSyncMatchRate:     dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleMinSyncMatchRate),
+		PartitionUpscaleDispatchLatency:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleDispatchLatency),
+		PartitionScalingCooldown:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionScalingCooldown),
 		PartitionDownscaleSustainedDuration:  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDownscaleSustainedDuration),
 		AdaptiveScalerUpdateInterval:         dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingAdaptiveScalerUpdateInterval),
 		EnableAdaptiveScaler:                 dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableAdaptiveScaler),
+		EnablePartitionIsolationGroups:       dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnablePartitionIsolationGroupAssignment),
 		QPSTrackerInterval:                   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingQPSTrackerInterval),
 		TaskIsolationDuration:                dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.TaskIsolationDuration),
 		TaskIsolationPollerWindow:            dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.TaskIsolationPollerWindow),
@@ -208,5 +238,6 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		AllIsolationGroups:                   getIsolationGroups,
 		EnableStandbyTaskCompletion:          dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableStandbyTaskCompletion),
 		EnableClientAutoConfig:               dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableClientAutoConfig),
+		EnableTaskFairness:                   dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableTaskFairness),
 	}
 }
@@ -52,6 +52,7 @@ func TestNewConfig(t *testing.T) {
 		"RangeSize":                            {nil, int64(100000)},
 		"ReadRangeSize":                        {dynamicconfig.MatchingReadRangeSize, 50000},
 		"GetTasksBatchSize":                    {dynamicconfig.MatchingGetTasksBatchSize, 7},
+		"TaskPriorityLevels":                   {dynamicconfig.MatchingTaskPriorityLevels, 3},
 		"UpdateAckInterval":                    {dynamicconfig.MatchingUpdateAckInterval, time.Duration(8)},
 		"IdleTasklistCheckInterval":            {dynamicconfig.MatchingIdleTasklistCheckInterval, time.Duration(9)},
 		"MaxTasklistIdleTime":                  {dynamicconfig.MaxTasklistIdleTime, time.Duration(10)},
@@ -68,6 +69,8 @@ func TestNewConfig(t *testing.T) {
 		"ForwarderMaxRatePerSecond":            {dynamicconfig.MatchingForwarderMaxRatePerSecond, 21},
 		"ForwarderMaxChildrenPerNode":          {dynamicconfig.MatchingForwarderMaxChildrenPerNode, 22},
 		"ShutdownDrainDuration":                {dynamicconfig.MatchingShutdownDrainDuration, time.Duration(23)},
+		"GlobalDispatchRatelimiterMode":        {dynamicconfig.MatchingGlobalDispatchRatelimiterMode, "local"},
+		"GlobalRatelimiterUpdateInterval":      {dynamicconfig.GlobalRatelimiterUpdateInterval, time.Duration(26)},
 		"EnableDebugMode":                      {dynamicconfig.EnableDebugMode, false},
 		"EnableTaskInfoLogByDomainID":          {dynamicconfig.MatchingEnableTaskInfoLogByDomainID, true},
 		"ActivityTaskSyncMatchWaitTime":        {dynamicconfig.MatchingActivityTaskSyncMatchWaitTime, time.Duration(24)},
@@ -85,12 +88,19 @@ func TestNewConfig(t *testing.T) {
 		"PartitionUpscaleRPS":                  {dynamicconfig.MatchingPartitionUpscaleRPS, 30},
 		"PartitionDownscaleFactor":             {dynamicconfig.MatchingPartitionDownscaleFactor, 31.0},
 		"PartitionUpscaleSustainedDuration":    {dynamicconfig.MatchingPartitionUpscaleSustainedDuration, time.Duration(32)},
+		"PartitionUpscaleBacklogPerPartition":  {dynamicconfig.MatchingPartitionUpscaleBacklogPerPartition, 37},
+		"PartitionUpscaleMaxPartitions":        {dynamicconfig.MatchingPartitionUpscaleMaxPartitions, 38},
+		"PartitionUpscaleMinSyncMatchRate":     {dynamicconfig.MatchingPartitionUpscaleMinSyncMatchRate, 39.0},
+		"PartitionUpscaleDispatchLatency":      {dynamicconfig.MatchingPartitionUpscaleDispatchLatency, time.Duration(40)},
+		"PartitionScalingCooldown":             {dynamicconfig.MatchingPartitionScalingCooldown, time.Duration(41)},
 		"PartitionDownscaleSustainedDuration":  {dynamicconfig.MatchingPartitionDownscaleSustainedDuration, time.Duration(33)},
 		"AdaptiveScalerUpdateInterval":         {dynamicconfig.MatchingAdaptiveScalerUpdateInterval, time.Duration(34)},
 		"EnableAdaptiveScaler":                 {dynamicconfig.MatchingEnableAdaptiveScaler, true},
+		"EnablePartitionIsolationGroups":       {dynamicconfig.MatchingEnablePartitionIsolationGroupAssignment, true},
 		"QPSTrackerInterval":                   {dynamicconfig.MatchingQPSTrackerInterval, 5 * time.Second},
 		"EnableStandbyTaskCompletion":          {dynamicconfig.MatchingEnableStandbyTaskCompletion, false},
 		"EnableClientAutoConfig":               {dynamicconfig.MatchingEnableClientAutoConfig, false},
+		"EnableTaskFairness":                   {dynamicconfig.MatchingEnableTaskFairness, true},
 		"TaskIsolationDuration":                {dynamicconfig.TaskIsolationDuration, time.Duration(35)},
 		"TaskIsolationPollerWindow":            {dynamicconfig.TaskIsolationPollerWindow, time.Duration(36)},
 	}
@@ -161,6 +171,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicconfig.StringPropertyFn:
 			return fn()
+		case dynamicconfig.StringPropertyWithRatelimitKeyFilter:
+			return fn("ratelimitkey")
 		case dynamicconfig.FloatPropertyFnWithTaskListInfoFilters:
 			return fn("domain", "tasklist", int(types.TaskListTypeDecision))
 		case func() []string:
@@ -96,6 +96,7 @@ type (
 		membershipResolver   membership.Resolver
 		partitioner          partition.Partitioner
 		timeSource           clock.TimeSource
+		dispatchRatelimiter  *tasklist.DispatchRatelimiter
 
 		waitForQueryResultFn func(hCtx *handlerContext, isStrongConsistencyQuery bool, queryResultCh <-chan *queryResult) (*types.QueryWorkflowResponse, error)
 	}
@@ -134,6 +135,7 @@ func NewEngine(
 	resolver membership.Resolver,
 	partitioner partition.Partitioner,
 	timeSource clock.TimeSource,
+	dispatchRatelimiter *tasklist.DispatchRatelimiter,
 ) Engine {
 
 	e := &matchingEngineImpl{
@@ -154,6 +156,7 @@ func NewEngine(
 		membershipResolver:   resolver,
 		partitioner:          partitioner,
 		timeSource:           timeSource,
+		dispatchRatelimiter:  dispatchRatelimiter,
 	}
 
 	e.shutdownCompletion.Add(1)
@@ -246,6 +249,7 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *tasklist.Identifier, t
 		e.timeSource,
 		e.timeSource.Now(),
 		e.historyService,
+		e.dispatchRatelimiter,
 	)
 	if err != nil {
 		e.taskListsLock.Unlock()
@@ -397,6 +401,8 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleToStartTimeoutSeconds: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:                   e.timeSource.Now(),
 		PartitionConfig:               request.GetPartitionConfig(),
+		Priority:                      request.GetPriority(),
+		FairnessKey:                   request.GetFairnessKey(),
 	}
 
 	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
@@ -472,6 +478,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleToStartTimeoutSeconds: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:                   e.timeSource.Now(),
 		PartitionConfig:               request.GetPartitionConfig(),
+		Priority:                      request.GetPriority(),
+		FairnessKey:                   request.GetFairnessKey(),
 	}
 
 	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
@@ -535,6 +543,7 @@ pollLoop:
 		pollerCtx := tasklist.ContextWithPollerID(hCtx.Context, pollerID)
 		pollerCtx = tasklist.ContextWithIdentity(pollerCtx, request.GetIdentity())
 		pollerCtx = tasklist.ContextWithIsolationGroup(pollerCtx, req.GetIsolationGroup())
+		pollerCtx = tasklist.ContextWithPollForwardedFrom(pollerCtx, req.GetForwardedFrom())
 		tlMgr, err := e.getTaskListManager(taskListID, taskListKind)
 		if err != nil {
 			return nil, fmt.Errorf("couldn't load tasklist namanger: %w", err)
@@ -723,6 +732,7 @@ pollLoop:
 		pollerCtx := tasklist.ContextWithPollerID(hCtx.Context, pollerID)
 		pollerCtx = tasklist.ContextWithIdentity(pollerCtx, request.GetIdentity())
 		pollerCtx = tasklist.ContextWithIsolationGroup(pollerCtx, req.GetIsolationGroup())
+		pollerCtx = tasklist.ContextWithPollForwardedFrom(pollerCtx, req.GetForwardedFrom())
 		taskListKind := request.TaskList.Kind
 		tlMgr, err := e.getTaskListManager(taskListID, taskListKind)
 		if err != nil {
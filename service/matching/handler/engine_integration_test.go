@@ -177,6 +177,7 @@ func (s *matchingEngineSuite) newMatchingEngine(
 		s.mockMembershipResolver,
 		s.partitioner,
 		s.mockTimeSource,
+		nil,
 	).(*matchingEngineImpl)
 }
 
@@ -226,7 +227,8 @@ func (s *matchingEngineSuite) TestOnlyUnloadMatchingInstance() {
 		s.matchingEngine.config,
 		s.matchingEngine.timeSource,
 		s.matchingEngine.timeSource.Now(),
-		s.matchingEngine.historyService)
+		s.matchingEngine.historyService,
+		s.matchingEngine.dispatchRatelimiter)
 	s.Require().NoError(err)
 
 	// try to unload a different tlm instance with the same taskListID
@@ -21,15 +21,18 @@
 package matching
 
 import (
+	"context"
 	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/handler"
+	"github.com/uber/cadence/service/matching/tasklist"
 	"github.com/uber/cadence/service/matching/wrappers/grpc"
 	"github.com/uber/cadence/service/matching/wrappers/thrift"
 )
@@ -38,10 +41,11 @@ import (
 type Service struct {
 	resource.Resource
 
-	status  int32
-	handler handler.Handler
-	stopC   chan struct{}
-	config  *config.Config
+	status              int32
+	handler             handler.Handler
+	stopC               chan struct{}
+	config              *config.Config
+	dispatchRatelimiter *tasklist.DispatchRatelimiter
 }
 
 // NewService builds a new cadence-matching service
@@ -91,6 +95,17 @@ func (s *Service) Start() {
 	logger := s.GetLogger()
 	logger.Info("matching starting")
 
+	dispatchRatelimiter, err := tasklist.NewDispatchRatelimiter(
+		s.config,
+		s.GetMembershipResolver(),
+		s.GetRatelimiterAggregatorsClient(),
+		s.GetLogger(),
+		s.GetMetricsClient(),
+	)
+	if err != nil {
+		logger.Fatal("failed to create dispatch global ratelimiter collection", tag.Error(err))
+	}
+
 	engine := handler.NewEngine(
 		s.GetTaskManager(),
 		s.GetClusterMetadata(),
@@ -103,6 +118,7 @@ func (s *Service) Start() {
 		s.GetMembershipResolver(),
 		s.GetPartitioner(),
 		s.GetTimeSource(),
+		dispatchRatelimiter,
 	)
 
 	s.handler = handler.NewHandler(engine, s.config, s.GetDomainCache(), s.GetMetricsClient(), s.GetLogger(), s.GetThrottledLogger())
@@ -115,6 +131,15 @@ func (s *Service) Start() {
 
 	// must start base service first
 	s.Resource.Start()
+
+	startCtx, cancel := context.WithTimeout(context.Background(), time.Second) // should take nearly no time at all
+	defer cancel()
+	if err := dispatchRatelimiter.OnStart(startCtx); err != nil {
+		logger.Fatal("failed to start dispatch global ratelimiter collection", tag.Error(err))
+	}
+	cancel()
+	s.dispatchRatelimiter = dispatchRatelimiter // save so it can be stopped later
+
 	s.handler.Start()
 
 	logger.Info("matching started")
@@ -137,6 +162,14 @@ func (s *Service) Stop() {
 	close(s.stopC)
 
 	s.handler.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second) // should take nearly no time at all
+	defer cancel()
+	if err := s.dispatchRatelimiter.OnStop(ctx); err != nil {
+		s.GetLogger().Error("failed to stop dispatch global ratelimiter collection", tag.Error(err))
+	}
+	cancel()
+
 	s.Resource.Stop()
 
 	s.GetLogger().Info("matching stopped")
@@ -25,8 +25,10 @@ package tasklist
 import (
 	"context"
 	"math"
+	"slices"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -45,6 +47,8 @@ import (
 type (
 	AdaptiveScaler interface {
 		common.Daemon
+		// Decisions returns the most recent scaling decisions, oldest first
+		Decisions() []*types.TaskListScalingDecision
 	}
 
 	adaptiveScalerImpl struct {
@@ -64,12 +68,25 @@ type (
 		overLoad     clock.Sustain
 		underLoad    clock.Sustain
 		baseEvent    event.E
+
+		// lastBacklog is the total backlog seen by the previous run, used to tell a growing backlog from a draining one
+		lastBacklog int64
+		// lastScaleTime is when the number of write partitions was last changed, used for the cool-down
+		lastScaleTime time.Time
+
+		decisionsLock sync.Mutex
+		decisions     []*types.TaskListScalingDecision
 	}
 
 	aggregatePartitionMetrics struct {
 		totalQPS            float64
+		totalBacklog        int64
+		syncMatchRate       float64
+		dispatchLatencyMs   float64
 		qpsByIsolationGroup map[string]float64
-		byPartition         map[int]*partitionMetrics
+		// pollersByIsolationGroup is the number of recent pollers of each isolation group across all partitions
+		pollersByIsolationGroup map[string]int64
+		byPartition             map[int]*partitionMetrics
 	}
 
 	partitionMetrics struct {
@@ -79,6 +96,20 @@ type (
 	}
 )
 
+const (
+	// maxScalingDecisions is the number of scaling decisions kept for DescribeTaskList
+	maxScalingDecisions = 20
+
+	scalingReasonQPS             = "qps"
+	scalingReasonBacklog         = "backlog"
+	scalingReasonSyncMatchRate   = "sync-match-rate"
+	scalingReasonDispatchLatency = "dispatch-latency"
+	scalingReasonDownscale       = "downscale"
+	scalingReasonCooldown        = "cooldown"
+	scalingReasonDrain           = "drain-read-partitions"
+	scalingReasonIsolationGroups = "isolation-groups"
+)
+
 func NewAdaptiveScaler(
 	taskListID *Identifier,
 	tlMgr Manager,
@@ -154,12 +185,17 @@ func (a *adaptiveScalerImpl) run() {
 		a.logger.Error("Failed to collect partition metrics", tag.Error(err))
 		return
 	}
-	// adjust the number of write partitions based on qps
-	numWritePartitions := a.calculateWritePartitionCount(m.totalQPS, len(partitionConfig.WritePartitions))
+	// adjust the number of write partitions based on qps, backlog, sync match rate and dispatch latency
+	numWritePartitions, reason := a.calculateWritePartitionCount(m, len(partitionConfig.WritePartitions))
+	if numWritePartitions != len(partitionConfig.WritePartitions) && a.inCooldown() {
+		a.recordDecision(scalingReasonCooldown, m, len(partitionConfig.WritePartitions), numWritePartitions, len(partitionConfig.ReadPartitions))
+		numWritePartitions = len(partitionConfig.WritePartitions)
+	}
 	writePartitions, writeChanged := a.adjustWritePartitions(partitionConfig.WritePartitions, numWritePartitions)
-	// TODO: Rebalance isolation groups between partitions
 	// adjust the read partitions
 	readPartitions, readChanged := a.adjustReadPartitions(m, partitionConfig.ReadPartitions, writePartitions)
+	// place the isolation groups on the partitions
+	writePartitions, readPartitions, groupsChanged := a.assignIsolationGroups(m, writePartitions, readPartitions)
 
 	e := a.baseEvent
 	e.EventName = "AdaptiveScalerCalculationResult"
@@ -167,12 +203,20 @@ func (a *adaptiveScalerImpl) run() {
 		"NumReadPartitions":  len(readPartitions),
 		"NumWritePartitions": len(writePartitions),
 		"QPS":                m.totalQPS,
+		"Backlog":            m.totalBacklog,
+		"SyncMatchRate":      m.syncMatchRate,
+		"DispatchLatencyMs":  m.dispatchLatencyMs,
 	}
 	event.Log(e)
 
-	if !writeChanged && !readChanged {
+	if !writeChanged && !readChanged && !groupsChanged {
 		return
 	}
+	if !writeChanged && readChanged {
+		reason = scalingReasonDrain
+	} else if !writeChanged {
+		reason = scalingReasonIsolationGroups
+	}
 	a.logger.Info("adaptive scaler is updating number of partitions",
 		tag.CurrentQPS(m.totalQPS),
 		tag.NumReadPartitions(len(readPartitions)),
@@ -187,7 +231,44 @@ func (a *adaptiveScalerImpl) run() {
 	if err != nil {
 		a.logger.Error("failed to update task list partition config", tag.Error(err))
 		a.scope.IncCounter(metrics.CadenceFailures)
+		return
 	}
+	if writeChanged {
+		a.lastScaleTime = a.timeSource.Now()
+	}
+	a.recordDecision(reason, m, len(partitionConfig.WritePartitions), len(writePartitions), len(readPartitions))
+}
+
+// Decisions returns the most recent scaling decisions, oldest first
+func (a *adaptiveScalerImpl) Decisions() []*types.TaskListScalingDecision {
+	a.decisionsLock.Lock()
+	defer a.decisionsLock.Unlock()
+	result := make([]*types.TaskListScalingDecision, len(a.decisions))
+	copy(result, a.decisions)
+	return result
+}
+
+func (a *adaptiveScalerImpl) recordDecision(reason string, m *aggregatePartitionMetrics, previousWritePartitions, writePartitions, readPartitions int) {
+	a.decisionsLock.Lock()
+	defer a.decisionsLock.Unlock()
+	a.decisions = append(a.decisions, &types.TaskListScalingDecision{
+		Timestamp:                  a.timeSource.Now().UnixNano(),
+		Reason:                     reason,
+		PreviousNumWritePartitions: int32(previousWritePartitions),
+		NumWritePartitions:         int32(writePartitions),
+		NumReadPartitions:          int32(readPartitions),
+		QPS:                        m.totalQPS,
+		BacklogCountHint:           m.totalBacklog,
+		SyncMatchRate:              m.syncMatchRate,
+		DispatchLatencyMs:          m.dispatchLatencyMs,
+	})
+	if len(a.decisions) > maxScalingDecisions {
+		a.decisions = a.decisions[len(a.decisions)-maxScalingDecisions:]
+	}
+}
+
+func (a *adaptiveScalerImpl) inCooldown() bool {
+	return !a.lastScaleTime.IsZero() && a.timeSource.Since(a.lastScaleTime) < a.config.PartitionScalingCooldown()
 }
 
 func (a *adaptiveScalerImpl) getPartitionConfig() *types.TaskListPartitionConfig {
@@ -205,7 +286,8 @@ func (a *adaptiveScalerImpl) getPartitionConfig() *types.TaskListPartitionConfig
 	return partitionConfig
 }
 
-func (a *adaptiveScalerImpl) calculateWritePartitionCount(qps float64, numWritePartitions int) int {
+func (a *adaptiveScalerImpl) calculateWritePartitionCount(m *aggregatePartitionMetrics, numWritePartitions int) (int, string) {
+	qps := m.totalQPS
 	upscaleRps := float64(a.config.PartitionUpscaleRPS())
 	partitions := float64(numWritePartitions)
 	downscaleFactor := a.config.PartitionDownscaleFactor()
@@ -215,16 +297,55 @@ func (a *adaptiveScalerImpl) calculateWritePartitionCount(qps float64, numWriteP
 	a.scope.UpdateGauge(metrics.TaskListPartitionUpscaleThresholdGauge, upscaleThreshold)
 	a.scope.UpdateGauge(metrics.TaskListPartitionDownscaleThresholdGauge, downscaleThreshold)
 
+	// backlog, sync match rate and dispatch latency only add partitions up to a limit,
+	// as more partitions do not help when the task list is short of workers
+	pressure := ""
+	if numWritePartitions < a.config.PartitionUpscaleMaxPartitions() {
+		pressure = a.pressureReason(m, numWritePartitions, 1)
+	}
+	// hysteresis: those signals must also be well below their thresholds to allow downscaling
+	relieved := a.pressureReason(m, numWritePartitions, downscaleFactor) == ""
+	a.lastBacklog = m.totalBacklog
+
 	result := numWritePartitions
-	if a.overLoad.Check(qps > upscaleThreshold) {
-		result = getNumberOfPartitions(qps, upscaleRps)
-		a.logger.Info("adjust write partitions", tag.CurrentQPS(qps), tag.PartitionUpscaleThreshold(upscaleThreshold), tag.PartitionDownscaleThreshold(downscaleThreshold), tag.PartitionDownscaleFactor(downscaleFactor), tag.CurrentNumWritePartitions(numWritePartitions), tag.NumWritePartitions(result))
+	reason := ""
+	if a.overLoad.Check(qps > upscaleThreshold || pressure != "") {
+		result = max(getNumberOfPartitions(qps, upscaleRps), numWritePartitions+1)
+		reason = pressure
+		if qps > upscaleThreshold {
+			reason = scalingReasonQPS
+		}
+		a.logger.Info("adjust write partitions", tag.Dynamic("reason", reason), tag.CurrentQPS(qps), tag.PartitionUpscaleThreshold(upscaleThreshold), tag.PartitionDownscaleThreshold(downscaleThreshold), tag.PartitionDownscaleFactor(downscaleFactor), tag.CurrentNumWritePartitions(numWritePartitions), tag.NumWritePartitions(result))
 	}
-	if a.underLoad.Check(qps < downscaleThreshold) {
+	if a.underLoad.Check(qps < downscaleThreshold && relieved) {
 		result = getNumberOfPartitions(qps, upscaleRps)
-		a.logger.Info("adjust write partitions", tag.CurrentQPS(qps), tag.PartitionUpscaleThreshold(upscaleThreshold), tag.PartitionDownscaleThreshold(downscaleThreshold), tag.PartitionDownscaleFactor(downscaleFactor), tag.CurrentNumWritePartitions(numWritePartitions), tag.NumWritePartitions(result))
+		reason = scalingReasonDownscale
+		a.logger.Info("adjust write partitions", tag.Dynamic("reason", reason), tag.CurrentQPS(qps), tag.PartitionUpscaleThreshold(upscaleThreshold), tag.PartitionDownscaleThreshold(downscaleThreshold), tag.PartitionDownscaleFactor(downscaleFactor), tag.CurrentNumWritePartitions(numWritePartitions), tag.NumWritePartitions(result))
 	}
-	return result
+	return result, reason
+}
+
+// pressureReason returns which of backlog, sync match rate or dispatch latency is past its threshold scaled by factor,
+// or an empty string if none is. A zero threshold disables the signal.
+func (a *adaptiveScalerImpl) pressureReason(m *aggregatePartitionMetrics, numWritePartitions int, factor float64) string {
+	if backlogThreshold := a.config.PartitionUpscaleBacklogPerPartition(); backlogThreshold > 0 && numWritePartitions > 0 {
+		// only a backlog that is not draining calls for more partitions
+		backlogPerPartition := float64(m.totalBacklog) / float64(numWritePartitions)
+		if backlogPerPartition > float64(backlogThreshold)*factor && m.totalBacklog >= a.lastBacklog {
+			return scalingReasonBacklog
+		}
+	}
+	if minSyncMatchRate := a.config.PartitionUpscaleMinSyncMatchRate(); minSyncMatchRate > 0 && m.totalQPS > 0 {
+		if 1-m.syncMatchRate > (1-minSyncMatchRate)*factor {
+			return scalingReasonSyncMatchRate
+		}
+	}
+	if latencyThreshold := a.config.PartitionUpscaleDispatchLatency(); latencyThreshold > 0 {
+		if m.dispatchLatencyMs > float64(latencyThreshold.Milliseconds())*factor {
+			return scalingReasonDispatchLatency
+		}
+	}
+	return ""
 }
 
 func (a *adaptiveScalerImpl) adjustWritePartitions(writePartitions map[int]*types.TaskListPartition, targetWritePartitions int) (map[int]*types.TaskListPartition, bool) {
@@ -279,6 +400,68 @@ func (a *adaptiveScalerImpl) adjustReadPartitions(m *aggregatePartitionMetrics,
 	return result, changed
 }
 
+// assignIsolationGroups spreads the isolation groups that have pollers over the write partitions, in the same way the
+// isolation load balancer maps groups to partitions when there is no assignment. Read only partitions keep their
+// groups so the pollers of those groups can drain them. All assignments are removed when the feature is disabled.
+func (a *adaptiveScalerImpl) assignIsolationGroups(
+	m *aggregatePartitionMetrics,
+	writePartitions map[int]*types.TaskListPartition,
+	readPartitions map[int]*types.TaskListPartition,
+) (map[int]*types.TaskListPartition, map[int]*types.TaskListPartition, bool) {
+	enabled := a.config.EnablePartitionIsolationGroups() && a.config.EnableTasklistIsolation()
+	var groups []string
+	if enabled {
+		for group, pollers := range m.pollersByIsolationGroup {
+			if pollers > 0 {
+				groups = append(groups, group)
+			}
+		}
+		slices.Sort(groups)
+	}
+	changed := false
+	newWritePartitions := make(map[int]*types.TaskListPartition, len(writePartitions))
+	for id, p := range writePartitions {
+		assigned := isolationGroupsForPartition(groups, id, len(writePartitions))
+		if !slices.Equal(assigned, p.GetIsolationGroups()) {
+			changed = true
+		}
+		newWritePartitions[id] = &types.TaskListPartition{IsolationGroups: assigned}
+	}
+	newReadPartitions := make(map[int]*types.TaskListPartition, len(readPartitions))
+	for id, p := range readPartitions {
+		if w, ok := newWritePartitions[id]; ok {
+			newReadPartitions[id] = w
+		} else if enabled {
+			newReadPartitions[id] = p
+		} else {
+			if len(p.GetIsolationGroups()) > 0 {
+				changed = true
+			}
+			newReadPartitions[id] = &types.TaskListPartition{}
+		}
+	}
+	if changed {
+		a.logger.Info("adjust partition isolation groups", tag.PollerGroups(groups), tag.NumWritePartitions(len(newWritePartitions)))
+	}
+	return newWritePartitions, newReadPartitions, changed
+}
+
+// isolationGroupsForPartition returns the isolation groups of a write partition. With 3 groups [a, b, c] and 4
+// partitions, partitions 0 and 3 get a. With 4 groups [a, b, c, d] and 3 partitions, partition 0 gets a and d.
+func isolationGroupsForPartition(groups []string, partitionID int, numPartitions int) []string {
+	if len(groups) == 0 {
+		return nil
+	}
+	if len(groups) <= numPartitions {
+		return []string{groups[partitionID%len(groups)]}
+	}
+	var result []string
+	for i := partitionID; i < len(groups); i += numPartitions {
+		result = append(result, groups[i])
+	}
+	return result
+}
+
 func (a *adaptiveScalerImpl) collectPartitionMetrics(config *types.TaskListPartitionConfig) (*aggregatePartitionMetrics, error) {
 	if a.config.EnableTasklistIsolation() {
 		return a.fetchMetricsFromPartitions(config)
@@ -290,9 +473,13 @@ func (a *adaptiveScalerImpl) assumeEvenQPS(config *types.TaskListPartitionConfig
 	resp := a.tlMgr.DescribeTaskList(true)
 
 	totalQPS := resp.TaskListStatus.NewTasksPerSecond * float64(len(config.WritePartitions))
+	totalBacklog := resp.TaskListStatus.BacklogCountHint * int64(len(config.WritePartitions))
 
 	return &aggregatePartitionMetrics{
-		totalQPS: totalQPS,
+		totalQPS:          totalQPS,
+		totalBacklog:      totalBacklog,
+		syncMatchRate:     resp.TaskListStatus.SyncMatchRate,
+		dispatchLatencyMs: resp.TaskListStatus.DispatchLatencyMs,
 	}, nil
 }
 
@@ -344,20 +531,31 @@ func (a *adaptiveScalerImpl) describePartition(partitionID int) (*types.Describe
 
 func toAggregateMetrics(partitions map[int]*types.DescribeTaskListResponse) *aggregatePartitionMetrics {
 	total := 0.0
+	totalBacklog := int64(0)
 	byIsolationGroup := make(map[string]float64)
+	pollersByIsolationGroup := make(map[string]int64)
 	byPartition := make(map[int]*partitionMetrics, len(partitions))
 	for id, p := range partitions {
 		for ig, groupMetrics := range p.TaskListStatus.IsolationGroupMetrics {
 			byIsolationGroup[ig] += groupMetrics.NewTasksPerSecond
+			pollersByIsolationGroup[ig] += groupMetrics.PollerCount
 		}
 		total += p.TaskListStatus.NewTasksPerSecond
+		totalBacklog += p.TaskListStatus.BacklogCountHint
 
 		byPartition[id] = toPartitionMetrics(id, p)
 	}
+	// sync match rate and dispatch latency are only reported by the root partition, which is described in-process.
+	// Tasks are spread evenly over write partitions, so the root is representative of the whole task list.
+	root := partitions[0].GetTaskListStatus()
 	return &aggregatePartitionMetrics{
-		totalQPS:            total,
-		qpsByIsolationGroup: byIsolationGroup,
-		byPartition:         byPartition,
+		totalQPS:                total,
+		totalBacklog:            totalBacklog,
+		syncMatchRate:           root.GetSyncMatchRate(),
+		dispatchLatencyMs:       root.GetDispatchLatencyMs(),
+		qpsByIsolationGroup:     byIsolationGroup,
+		pollersByIsolationGroup: pollersByIsolationGroup,
+		byPartition:             byPartition,
 	}
 }
 
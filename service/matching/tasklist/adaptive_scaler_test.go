@@ -366,6 +366,203 @@ func TestAdaptiveScalerRun(t *testing.T) {
 			},
 			cycles: 3,
 		},
+		{
+			name: "isolation groups - assign groups with pollers to partitions",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				deps.config.EnableTasklistIsolation = func() bool {
+					return true
+				}
+				deps.config.EnablePartitionIsolationGroups = func() bool {
+					return true
+				}
+				mockDescribeTaskList(deps, 0, withIsolationGroupPollers(2, 100, map[string]int64{"a": 1, "c": 0}))
+				mockDescribeTaskList(deps, 1, withIsolationGroupPollers(2, 100, map[string]int64{"a": 2, "b": 1}))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+					WritePartitions: partitions(2),
+					ReadPartitions:  partitions(2),
+				})
+				assigned := map[int]*types.TaskListPartition{
+					0: {IsolationGroups: []string{"a"}},
+					1: {IsolationGroups: []string{"b"}},
+				}
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  assigned,
+					WritePartitions: assigned,
+				}).Return(nil)
+
+				// no change once the groups are assigned
+				mockDescribeTaskList(deps, 0, withIsolationGroupPollers(2, 100, map[string]int64{"a": 1}))
+				mockDescribeTaskList(deps, 1, withIsolationGroupPollers(2, 100, map[string]int64{"b": 1}))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+					WritePartitions: assigned,
+					ReadPartitions:  assigned,
+				})
+			},
+			cycles: 2,
+		},
+		{
+			name: "isolation groups - more groups than partitions, read only partitions keep their groups",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				deps.config.EnableTasklistIsolation = func() bool {
+					return true
+				}
+				deps.config.EnablePartitionIsolationGroups = func() bool {
+					return true
+				}
+				mockDescribeTaskList(deps, 0, withIsolationGroupPollers(2, 100, map[string]int64{"a": 1, "b": 1, "c": 1}))
+				mockDescribeTaskList(deps, 1, withIsolationGroupPollers(2, 100, nil))
+				mockDescribeTaskList(deps, 2, withPartitionsAndBacklog(3, 2, 10))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+					WritePartitions: partitions(2),
+					ReadPartitions: map[int]*types.TaskListPartition{
+						0: {},
+						1: {},
+						2: {IsolationGroups: []string{"c"}},
+					},
+				})
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions: map[int]*types.TaskListPartition{
+						0: {IsolationGroups: []string{"a", "c"}},
+						1: {IsolationGroups: []string{"b"}},
+						2: {IsolationGroups: []string{"c"}},
+					},
+					WritePartitions: map[int]*types.TaskListPartition{
+						0: {IsolationGroups: []string{"a", "c"}},
+						1: {IsolationGroups: []string{"b"}},
+					},
+				}).Return(nil)
+			},
+			cycles: 1,
+		},
+		{
+			name: "isolation groups - removed when disabled",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				mockDescribeTaskList(deps, 0, withPartitionsAndQPS(2, 200))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+					WritePartitions: map[int]*types.TaskListPartition{
+						0: {IsolationGroups: []string{"a"}},
+						1: {IsolationGroups: []string{"b"}},
+					},
+					ReadPartitions: map[int]*types.TaskListPartition{
+						0: {IsolationGroups: []string{"a"}},
+						1: {IsolationGroups: []string{"b"}},
+					},
+				})
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(2),
+					WritePartitions: partitions(2),
+				}).Return(nil)
+			},
+			cycles: 1,
+		},
+		{
+			name: "growing backlog sustained",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionUpscaleBacklogPerPartition, 100))
+				// backlog start, qps is well below the upscale threshold
+				mockDescribeTaskList(deps, 0, withPartitionsAndBacklog(1, 1, 500))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+
+				// backlog keeps growing past the sustained period
+				mockDescribeTaskList(deps, 0, withPartitionsAndBacklog(1, 1, 600))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(2),
+					WritePartitions: partitions(2),
+				}).Return(nil)
+			},
+			cycles: 2,
+		},
+		{
+			name: "draining backlog does not upscale",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionUpscaleBacklogPerPartition, 100))
+				mockDescribeTaskList(deps, 0, withPartitionsAndBacklog(1, 1, 600))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				mockDescribeTaskList(deps, 0, withPartitionsAndBacklog(1, 1, 500))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+			},
+			cycles: 2,
+		},
+		{
+			name: "backlog upscaling is capped",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionUpscaleBacklogPerPartition, 100))
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionUpscaleMaxPartitions, 2))
+				for i := 0; i < 2; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsAndBacklog(2, 2, 1000))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+						ReadPartitions:  partitions(2),
+						WritePartitions: partitions(2),
+					})
+				}
+			},
+			cycles: 2,
+		},
+		{
+			name: "dispatch latency and sync match rate sustained",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionUpscaleDispatchLatency, time.Second))
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionUpscaleMinSyncMatchRate, 0.9))
+				// slow dispatch
+				mockDescribeTaskList(deps, 0, withDispatchStats(1, 10, 1, 2000))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				// poor sync match rate keeps the overload sustained
+				mockDescribeTaskList(deps, 0, withDispatchStats(1, 10, 0.5, 0))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(2),
+					WritePartitions: partitions(2),
+				}).Return(nil)
+			},
+			cycles: 2,
+		},
+		{
+			name: "dispatch latency above downscale threshold prevents downscaling",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionUpscaleDispatchLatency, time.Second))
+				// qps is low enough to downscale, but latency is above 0.75 * 1s
+				for i := 0; i < 2; i++ {
+					mockDescribeTaskList(deps, 0, withDispatchStats(2, 0, 1, 800))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+						ReadPartitions:  partitions(2),
+						WritePartitions: partitions(2),
+					})
+				}
+			},
+			cycles: 2,
+		},
+		{
+			name: "cooldown delays the next change",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionScalingCooldown, 3*time.Second))
+				// overload start
+				mockDescribeTaskList(deps, 0, withPartitionsAndQPS(1, 300))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+
+				// overload passing sustained period
+				mockDescribeTaskList(deps, 0, withPartitionsAndQPS(1, 300))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(2),
+					WritePartitions: partitions(2),
+				}).Return(nil)
+
+				// overloaded again and sustained, but within the cool-down, then sustained again after it
+				for i := 0; i < 4; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsAndQPS(2, 300))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+						ReadPartitions:  partitions(2),
+						WritePartitions: partitions(2),
+					})
+				}
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(3),
+					WritePartitions: partitions(3),
+				}).Return(nil)
+			},
+			cycles: 6,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -389,6 +586,50 @@ func TestAdaptiveScalerRun(t *testing.T) {
 	}
 }
 
+func TestAdaptiveScalerRecordsDecisions(t *testing.T) {
+	taskListID, err := NewIdentifier("test-domain-id", "test-task-list", 0)
+	require.NoError(t, err)
+	scaler, deps := setupMocksForAdaptiveScaler(t, taskListID)
+	require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingEnableAdaptiveScaler, true))
+	require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingEnableGetNumberOfPartitionsFromCache, true))
+	require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionUpscaleBacklogPerPartition, 100))
+	require.NoError(t, deps.dynamicClient.UpdateValue(dynamicconfig.MatchingPartitionUpscaleSustainedDuration, time.Duration(0)))
+
+	mockDescribeTaskList(deps, 0, withPartitionsAndBacklog(1, 1, 500))
+	deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+	deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), gomock.Any()).Return(nil)
+	scaler.run()
+
+	// still backlogged, but within the cool-down
+	deps.mockTimeSource.Advance(time.Second)
+	mockDescribeTaskList(deps, 0, withPartitionsAndBacklog(2, 2, 1000))
+	deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+		ReadPartitions:  partitions(2),
+		WritePartitions: partitions(2),
+	})
+	scaler.run()
+
+	decisions := scaler.Decisions()
+	require.Len(t, decisions, 2)
+	assert.Equal(t, &types.TaskListScalingDecision{
+		Timestamp:                  decisions[0].Timestamp,
+		Reason:                     scalingReasonBacklog,
+		PreviousNumWritePartitions: 1,
+		NumWritePartitions:         2,
+		NumReadPartitions:          2,
+		BacklogCountHint:           500,
+	}, decisions[0])
+	assert.Equal(t, scalingReasonCooldown, decisions[1].Reason)
+	assert.Equal(t, int32(2), decisions[1].PreviousNumWritePartitions)
+	assert.Equal(t, int32(3), decisions[1].NumWritePartitions)
+	assert.Equal(t, int64(2000), decisions[1].BacklogCountHint, "backlog of the root partition is assumed for every write partition")
+
+	for i := 0; i < maxScalingDecisions; i++ {
+		scaler.recordDecision(scalingReasonQPS, &aggregatePartitionMetrics{}, 1, 1, 1)
+	}
+	assert.Len(t, scaler.Decisions(), maxScalingDecisions)
+}
+
 func withPartitionsAndQPS(numPartitions int, qps float64) *types.DescribeTaskListResponse {
 	return &types.DescribeTaskListResponse{
 		Pollers:        nil,
@@ -400,6 +641,30 @@ func withPartitionsAndQPS(numPartitions int, qps float64) *types.DescribeTaskLis
 	}
 }
 
+func withDispatchStats(numPartitions int, qps, syncMatchRate, dispatchLatencyMs float64) *types.DescribeTaskListResponse {
+	return &types.DescribeTaskListResponse{
+		Pollers: nil,
+		TaskListStatus: &types.TaskListStatus{
+			NewTasksPerSecond: qps,
+			SyncMatchRate:     syncMatchRate,
+			DispatchLatencyMs: dispatchLatencyMs,
+		},
+		PartitionConfig: &types.TaskListPartitionConfig{
+			ReadPartitions:  partitions(numPartitions),
+			WritePartitions: partitions(numPartitions),
+		},
+	}
+}
+
+func withIsolationGroupPollers(numPartitions int, qps float64, pollers map[string]int64) *types.DescribeTaskListResponse {
+	resp := withPartitionsAndQPS(numPartitions, qps)
+	resp.TaskListStatus.IsolationGroupMetrics = make(map[string]*types.IsolationGroupMetrics, len(pollers))
+	for group, count := range pollers {
+		resp.TaskListStatus.IsolationGroupMetrics[group] = &types.IsolationGroupMetrics{PollerCount: count}
+	}
+	return resp
+}
+
 func withPartitionsAndBacklog(numRead, numWrite int, backlog int64) *types.DescribeTaskListResponse {
 	return &types.DescribeTaskListResponse{
 		Pollers:        nil,
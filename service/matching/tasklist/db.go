@@ -30,6 +30,10 @@ import (
 	"github.com/uber/cadence/common/persistence"
 )
 
+// fairnessKeyPartitionConfigKey is the key of the partition config under which the fairness key of a
+// task is persisted, the task blob has no field for it. It is removed from the tasks read from persistence.
+const fairnessKeyPartitionConfigKey = "__cadence_fairness_key"
+
 type (
 	taskListDB struct {
 		sync.RWMutex
@@ -162,6 +166,18 @@ func (db *taskListDB) UpdateTaskListPartitionConfig(partitionConfig *persistence
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
 	defer db.Unlock()
+	for i, task := range tasks {
+		if task.Data.FairnessKey == "" {
+			continue
+		}
+		data := *task.Data
+		data.PartitionConfig = make(map[string]string, len(task.Data.PartitionConfig)+1)
+		for k, v := range task.Data.PartitionConfig {
+			data.PartitionConfig[k] = v
+		}
+		data.PartitionConfig[fairnessKeyPartitionConfigKey] = data.FairnessKey
+		tasks[i] = &persistence.CreateTaskInfo{TaskID: task.TaskID, Data: &data}
+	}
 	return db.store.CreateTasks(context.Background(), &persistence.CreateTasksRequest{
 		TaskListInfo: &persistence.TaskListInfo{
 			DomainID: db.domainID,
@@ -176,7 +192,7 @@ func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persist
 
 // GetTasks returns a batch of tasks between the given range
 func (db *taskListDB) GetTasks(minTaskID int64, maxTaskID int64, batchSize int) (*persistence.GetTasksResponse, error) {
-	return db.store.GetTasks(context.Background(), &persistence.GetTasksRequest{
+	resp, err := db.store.GetTasks(context.Background(), &persistence.GetTasksRequest{
 		DomainID:     db.domainID,
 		TaskList:     db.taskListName,
 		TaskType:     db.taskType,
@@ -185,6 +201,29 @@ func (db *taskListDB) GetTasks(minTaskID int64, maxTaskID int64, batchSize int)
 		MaxReadLevel: &maxTaskID, // inclusive
 		DomainName:   db.domainName,
 	})
+	if err != nil {
+		return nil, err
+	}
+	for i, task := range resp.Tasks {
+		fairnessKey, ok := task.PartitionConfig[fairnessKeyPartitionConfigKey]
+		if !ok {
+			continue
+		}
+		info := *task
+		info.FairnessKey = fairnessKey
+		info.PartitionConfig = nil
+		for k, v := range task.PartitionConfig {
+			if k == fairnessKeyPartitionConfigKey {
+				continue
+			}
+			if info.PartitionConfig == nil {
+				info.PartitionConfig = make(map[string]string, len(task.PartitionConfig)-1)
+			}
+			info.PartitionConfig[k] = v
+		}
+		resp.Tasks[i] = &info
+	}
+	return resp, nil
 }
 
 // CompleteTasksLessThan deletes of tasks less than the given taskID. Limit is
@@ -0,0 +1,186 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/quotas/global/collection"
+	"github.com/uber/cadence/common/quotas/global/rpc"
+	"github.com/uber/cadence/common/quotas/global/shared"
+	"github.com/uber/cadence/common/quotas/permember"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+type (
+	// DispatchRatelimiter limits how fast the tasks of a task list are dispatched across all of its
+	// partitions and all matching hosts, through the global ratelimiter.
+	//
+	// Pollers provide the rate of the whole task list, and the last poller wins, like for the
+	// per-partition limiters of the task matchers.  Task lists whose key is "disabled" are not
+	// limited here, each of their partitions limits itself to its share of the rate instead.
+	DispatchRatelimiter struct {
+		collection *collection.Collection
+		keyModes   dynamicconfig.StringPropertyWithRatelimitKeyFilter
+		km         shared.KeyMapper
+		defaultRPS float64
+
+		sync.Mutex
+		rates map[string]*dispatchRate
+	}
+
+	dispatchRate struct {
+		rps  float64
+		refs int // task list partitions loaded on this host
+	}
+)
+
+// NewDispatchRatelimiter creates the dispatch ratelimiter of a matching host.
+func NewDispatchRatelimiter(
+	cfg *config.Config,
+	resolver membership.Resolver,
+	aggs rpc.Client,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) (*DispatchRatelimiter, error) {
+	name := shared.ServiceCollectionName(service.Matching, "dispatch")
+	d := &DispatchRatelimiter{
+		keyModes:   cfg.GlobalDispatchRatelimiterMode,
+		km:         shared.PrefixKey(name + ":"),
+		defaultRPS: cfg.TaskDispatchRPS,
+		rates:      make(map[string]*dispatchRate),
+	}
+	unlimited := func(string) int { return dynamicconfig.UnlimitedRPS }
+	create := func() *quotas.Collection {
+		return quotas.NewCollection(permember.NewPerMemberDynamicRateLimiterFactory(service.Matching, d.targetRPS, unlimited, resolver))
+	}
+	c, err := collection.New(
+		name,
+		create(),
+		create(),
+		cfg.GlobalRatelimiterUpdateInterval,
+		d.targetRPS,
+		cfg.GlobalDispatchRatelimiterMode,
+		aggs,
+		logger,
+		metricsClient,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %v collection: %w", name, err)
+	}
+	d.collection = c
+	return d, nil
+}
+
+// OnStart follows fx's OnStart hook semantics.
+func (d *DispatchRatelimiter) OnStart(ctx context.Context) error {
+	return d.collection.OnStart(ctx)
+}
+
+// OnStop follows fx's OnStop hook semantics.
+func (d *DispatchRatelimiter) OnStop(ctx context.Context) error {
+	return d.collection.OnStop(ctx)
+}
+
+// dispatchRatelimitKey returns the key shared by all the partitions of a task list
+func dispatchRatelimitKey(domainName string, id *Identifier) string {
+	taskType := "decision"
+	if id.GetType() == persistence.TaskListTypeActivity {
+		taskType = "activity"
+	}
+	return fmt.Sprintf("%s/%s/%s", domainName, id.GetRoot(), taskType)
+}
+
+// register records that a partition of the task list is loaded on this host,
+// the rate of the task list is kept until all of its partitions are unloaded
+func (d *DispatchRatelimiter) register(key string) {
+	if d == nil {
+		return
+	}
+	d.Lock()
+	defer d.Unlock()
+	r, ok := d.rates[key]
+	if !ok {
+		r = &dispatchRate{rps: d.defaultRPS}
+		d.rates[key] = r
+	}
+	r.refs++
+}
+
+func (d *DispatchRatelimiter) unregister(key string) {
+	if d == nil {
+		return
+	}
+	d.Lock()
+	defer d.Unlock()
+	r, ok := d.rates[key]
+	if !ok {
+		return
+	}
+	r.refs--
+	if r.refs <= 0 {
+		delete(d.rates, key)
+	}
+}
+
+// updateRate sets the rate of the whole task list, as provided by a poller
+func (d *DispatchRatelimiter) updateRate(key string, rps float64) {
+	if d == nil {
+		return
+	}
+	d.Lock()
+	defer d.Unlock()
+	if r, ok := d.rates[key]; ok {
+		r.rps = rps
+	}
+}
+
+// limiter returns the limiter shared by all partitions of the task list,
+// or nil when the key is disabled and partitions should limit themselves
+func (d *DispatchRatelimiter) limiter(key string) quotas.Limiter {
+	if d == nil || d.keyModes(string(d.km.LocalToGlobal(shared.LocalKey(key)))) == "disabled" {
+		return nil
+	}
+	return d.collection.For(key)
+}
+
+func (d *DispatchRatelimiter) targetRPS(key string) int {
+	d.Lock()
+	rps := d.defaultRPS
+	if r, ok := d.rates[key]; ok {
+		rps = r.rps
+	}
+	d.Unlock()
+	// limits are whole numbers, round up so low rates are not fully blocked
+	return int(math.Min(math.Ceil(rps), dynamicconfig.UnlimitedRPS))
+}
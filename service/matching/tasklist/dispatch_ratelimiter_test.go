@@ -0,0 +1,103 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas/global/rpc"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+func TestDispatchRatelimitKey(t *testing.T) {
+	id, err := NewIdentifier("domain-id", "/__cadence_sys/tl/2", persistence.TaskListTypeActivity)
+	require.NoError(t, err)
+	assert.Equal(t, "domain/tl/activity", dispatchRatelimitKey("domain", id))
+
+	id, err = NewIdentifier("domain-id", "tl", persistence.TaskListTypeDecision)
+	require.NoError(t, err)
+	assert.Equal(t, "domain/tl/decision", dispatchRatelimitKey("domain", id))
+}
+
+func TestDispatchRatelimiter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	resolver := membership.NewMockResolver(ctrl)
+	resolver.EXPECT().MemberCount(service.Matching).Return(4, nil).AnyTimes()
+
+	mode := "disabled"
+	cfg := defaultTestConfig()
+	cfg.GlobalDispatchRatelimiterMode = func(key string) string {
+		assert.Equal(t, "matching-dispatch:domain/tl/decision", key)
+		return mode
+	}
+	limiter, err := NewDispatchRatelimiter(cfg, resolver, rpc.NewMockClient(ctrl), testlogger.New(t), metrics.NewNoopMetricsClient())
+	require.NoError(t, err)
+
+	id, err := NewIdentifier("domain-id", "tl", persistence.TaskListTypeDecision)
+	require.NoError(t, err)
+	key := dispatchRatelimitKey("domain", id)
+	tlCfg := newTaskListConfig(id, cfg, "domain")
+	numReadPartitions := func(*config.TaskListConfig) int { return 2 }
+	newMatcher := func() *taskMatcherImpl {
+		return newTaskMatcher(tlCfg, nil, metrics.NoopScope(metrics.Matching), nil, testlogger.New(t), id, types.TaskListKindNormal, numReadPartitions, limiter, key).(*taskMatcherImpl)
+	}
+	partition1, partition2 := newMatcher(), newMatcher()
+	assert.Equal(t, int(cfg.TaskDispatchRPS), limiter.targetRPS(key))
+
+	rps := 10.0
+	partition1.UpdateRatelimit(&rps)
+	assert.Equal(t, 10, limiter.targetRPS(key))
+
+	// disabled keys are limited by each partition to its share of the rate
+	assert.Nil(t, limiter.limiter(key))
+	assert.Equal(t, 5.0, partition1.Rate())
+
+	// enabled keys share the rate of the task list across the hosts
+	mode = "local"
+	assert.NotNil(t, limiter.limiter(key))
+	assert.Equal(t, rate.Limit(2.5), partition1.dispatchLimiter().Limit())
+	assert.Equal(t, 2.5, partition2.Rate())
+
+	// fractional rates are rounded up
+	rps = 0.5
+	partition2.UpdateRatelimit(&rps)
+	assert.Equal(t, 1, limiter.targetRPS(key))
+
+	// the rate is kept until all partitions are unloaded
+	partition1.DisconnectBlockedPollers()
+	assert.Equal(t, 1, limiter.targetRPS(key))
+	partition2.DisconnectBlockedPollers()
+	assert.Equal(t, int(cfg.TaskDispatchRPS), limiter.targetRPS(key))
+	assert.Empty(t, limiter.rates)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/stats"
+	"github.com/uber/cadence/service/matching/event"
+)
+
+// dispatchStats tracks how well a task list partition keeps up with its tasks,
+// which the adaptive scaler uses alongside add-task QPS and backlog.
+type dispatchStats struct {
+	syncMatches stats.QPSTracker
+	dispatches  stats.QPSTracker
+	// latencyMs accumulates the time from task creation to dispatch in milliseconds,
+	// divided by dispatches it gives a moving average of the dispatch latency
+	latencyMs  stats.QPSTracker
+	timeSource clock.TimeSource
+}
+
+func newDispatchStats(timeSource clock.TimeSource, interval time.Duration, baseEvent event.E) *dispatchStats {
+	return &dispatchStats{
+		syncMatches: stats.NewEmaFixedWindowQPSTracker(timeSource, 0.5, interval, baseEvent),
+		dispatches:  stats.NewEmaFixedWindowQPSTracker(timeSource, 0.5, interval, baseEvent),
+		latencyMs:   stats.NewEmaFixedWindowQPSTracker(timeSource, 0.5, interval, baseEvent),
+		timeSource:  timeSource,
+	}
+}
+
+func (s *dispatchStats) Start() {
+	s.syncMatches.Start()
+	s.dispatches.Start()
+	s.latencyMs.Start()
+}
+
+func (s *dispatchStats) Stop() {
+	s.syncMatches.Stop()
+	s.dispatches.Stop()
+	s.latencyMs.Stop()
+}
+
+func (s *dispatchStats) recordSyncMatch() {
+	s.syncMatches.ReportCounter(1)
+}
+
+func (s *dispatchStats) recordDispatch(createdTime time.Time) {
+	if createdTime.IsZero() {
+		return
+	}
+	latency := s.timeSource.Now().Sub(createdTime)
+	if latency < 0 {
+		latency = 0
+	}
+	s.dispatches.ReportCounter(1)
+	s.latencyMs.ReportCounter(latency.Milliseconds())
+}
+
+// syncMatchRate returns the fraction of added tasks that were sync matched, given the add-task QPS
+func (s *dispatchStats) syncMatchRate(addQPS float64) float64 {
+	if addQPS <= 0 {
+		return 0
+	}
+	return min(s.syncMatches.QPS()/addQPS, 1)
+}
+
+// dispatchLatencyMs returns the moving average of the time from task creation to dispatch
+func (s *dispatchStats) dispatchLatencyMs() float64 {
+	dispatches := s.dispatches.QPS()
+	if dispatches <= 0 {
+		return 0
+	}
+	return s.latencyMs.QPS() / dispatches
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/stats"
+)
+
+func TestDispatchStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	timeSource := clock.NewMockedTimeSource()
+	syncMatches := stats.NewMockQPSTracker(ctrl)
+	dispatches := stats.NewMockQPSTracker(ctrl)
+	latencyMs := stats.NewMockQPSTracker(ctrl)
+	s := &dispatchStats{
+		syncMatches: syncMatches,
+		dispatches:  dispatches,
+		latencyMs:   latencyMs,
+		timeSource:  timeSource,
+	}
+
+	syncMatches.EXPECT().ReportCounter(int64(1))
+	s.recordSyncMatch()
+
+	dispatches.EXPECT().ReportCounter(int64(1))
+	latencyMs.EXPECT().ReportCounter(int64(1500))
+	s.recordDispatch(timeSource.Now().Add(-1500 * time.Millisecond))
+	// tasks without a creation time are ignored
+	s.recordDispatch(time.Time{})
+
+	syncMatches.EXPECT().QPS().Return(5.0).Times(2)
+	assert.Equal(t, 0.5, s.syncMatchRate(10))
+	assert.Equal(t, 1.0, s.syncMatchRate(4), "sync match rate is capped at 1")
+	assert.Equal(t, 0.0, s.syncMatchRate(0))
+
+	dispatches.EXPECT().QPS().Return(4.0)
+	latencyMs.EXPECT().QPS().Return(2000.0)
+	assert.Equal(t, 500.0, s.dispatchLatencyMs())
+	dispatches.EXPECT().QPS().Return(0.0)
+	assert.Equal(t, 0.0, s.dispatchLatencyMs())
+}
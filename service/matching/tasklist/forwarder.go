@@ -23,10 +23,13 @@ package tasklist
 import (
 	"context"
 	"errors"
+	"math/rand"
+	"slices"
 	"sync/atomic"
 
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
@@ -62,6 +65,9 @@ type (
 		limiter *quotas.DynamicRateLimiter
 
 		isolationGroups []string
+		// partitionConfig returns the partition config of the task list, which tells
+		// which partitions the pollers of each isolation group are placed on
+		partitionConfig func() *types.TaskListPartitionConfig
 	}
 	// ForwarderReqToken is the token that must be acquired before
 	// making forwarder API calls. This type contains the state
@@ -84,7 +90,9 @@ var noopForwarderTokenC <-chan *ForwarderReqToken = make(chan *ForwarderReqToken
 
 // newForwarder returns an instance of Forwarder object which
 // can be used to forward api request calls from a task list
-// child partition to a task list parent partition. The returned
+// child partition to a task list parent partition, or to a sibling
+// partition that matching placed the pollers of the isolation group
+// of the request on. The returned
 // forwarder is tied to a single task list. All of the exposed
 // methods can return the following errors:
 // Returns following errors:
@@ -98,6 +106,7 @@ func newForwarder(
 	kind types.TaskListKind,
 	client matching.Client,
 	isolationGroups []string,
+	partitionConfig func() *types.TaskListPartitionConfig,
 	scope metrics.Scope,
 ) Forwarder {
 	rpsFunc := func() float64 { return float64(cfg.ForwarderMaxRatePerSecond()) }
@@ -110,6 +119,7 @@ func newForwarder(
 		outstandingPollsLimit: int32(cfg.ForwarderMaxOutstandingPolls()),
 		limiter:               quotas.NewDynamicRateLimiter(rpsFunc),
 		isolationGroups:       isolationGroups,
+		partitionConfig:       partitionConfig,
 		scope:                 scope,
 	}
 	fwdr.addReqToken.Store(newForwarderReqToken(int(fwdr.outstandingTasksLimit), nil))
@@ -131,6 +141,12 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 	if !fwdr.limiter.Allow() {
 		return ErrForwarderSlowDown
 	}
+	// tasks forwarded to us are only sync matched, so they are never sent to a sibling again
+	if !task.IsForwarded() {
+		if sibling := fwdr.isolationGroupPartition(task.Event.PartitionConfig[partition.IsolationGroupKey], true); sibling != "" {
+			name = sibling
+		}
+	}
 
 	var err error
 
@@ -150,6 +166,8 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
 			PartitionConfig:               task.Event.PartitionConfig,
+			Priority:                      task.Event.Priority,
+			FairnessKey:                   task.Event.FairnessKey,
 		})
 	case persistence.TaskListTypeActivity:
 		_, err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
@@ -165,6 +183,8 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
 			PartitionConfig:               task.Event.PartitionConfig,
+			Priority:                      task.Event.Priority,
+			FairnessKey:                   task.Event.FairnessKey,
 		})
 	default:
 		return ErrInvalidTaskListType
@@ -219,6 +239,11 @@ func (fwdr *forwarderImpl) ForwardPoll(ctx context.Context) (*InternalTask, erro
 	pollerID := PollerIDFromContext(ctx)
 	identity := IdentityFromContext(ctx)
 	isolationGroup := IsolationGroupFromContext(ctx)
+	if PollForwardedFromContext(ctx) == "" {
+		if sibling := fwdr.isolationGroupPartition(isolationGroup, false); sibling != "" {
+			name = sibling
+		}
+	}
 
 	switch fwdr.taskListID.GetType() {
 	case persistence.TaskListTypeDecision:
@@ -262,6 +287,27 @@ func (fwdr *forwarderImpl) ForwardPoll(ctx context.Context) (*InternalTask, erro
 	return nil, ErrInvalidTaskListType
 }
 
+// isolationGroupPartition returns the name of a partition that was assigned the isolation group, or an empty string
+// if this partition was assigned the group or no partition was, in which case the request goes to the parent
+func (fwdr *forwarderImpl) isolationGroupPartition(isolationGroup string, write bool) string {
+	if isolationGroup == "" || fwdr.partitionConfig == nil {
+		return ""
+	}
+	config := fwdr.partitionConfig()
+	if config == nil {
+		return ""
+	}
+	partitions := config.ReadPartitions
+	if write {
+		partitions = config.WritePartitions
+	}
+	candidates := matching.PartitionsForIsolationGroup(partitions, isolationGroup)
+	if len(candidates) == 0 || slices.Contains(candidates, fwdr.taskListID.Partition()) {
+		return ""
+	}
+	return fwdr.taskListID.GetPartition(candidates[rand.Intn(len(candidates))])
+}
+
 // AddReqTokenC returns a channel that can be used to wait for a token
 // that's necessary before making a ForwardTask or ForwardQueryTask API call.
 // After the API call is invoked, token.release() must be invoked
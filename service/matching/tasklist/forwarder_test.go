@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
@@ -49,6 +50,7 @@ type ForwarderTestSuite struct {
 	cfg             *config.ForwarderConfig
 	taskList        *Identifier
 	isolationGroups []string
+	partitionConfig *types.TaskListPartitionConfig
 }
 
 func TestForwarderSuite(t *testing.T) {
@@ -68,7 +70,9 @@ func (t *ForwarderTestSuite) SetupTest() {
 	t.NoError(err)
 	t.taskList = id
 	t.isolationGroups = []string{"abc", "xyz"}
-	t.fwdr = newForwarder(t.cfg, t.taskList, types.TaskListKindNormal, t.client, t.isolationGroups, metrics.NoopScope(metrics.Matching)).(*forwarderImpl)
+	t.partitionConfig = nil
+	partitionConfigFn := func() *types.TaskListPartitionConfig { return t.partitionConfig }
+	t.fwdr = newForwarder(t.cfg, t.taskList, types.TaskListKindNormal, t.client, t.isolationGroups, partitionConfigFn, metrics.NoopScope(metrics.Matching)).(*forwarderImpl)
 }
 
 func (t *ForwarderTestSuite) TearDownTest() {
@@ -133,6 +137,65 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	t.Equal(t.taskList.name, request.GetForwardedFrom())
 }
 
+func (t *ForwarderTestSuite) TestForwardTaskToIsolationGroupPartition() {
+	t.usingTasklistPartition(persistence.TaskListTypeActivity)
+	t.partitionConfig = &types.TaskListPartitionConfig{
+		WritePartitions: map[int]*types.TaskListPartition{
+			0: {IsolationGroups: []string{"abc"}},
+			1: {IsolationGroups: []string{"xyz"}},
+			2: {IsolationGroups: []string{"abc"}},
+		},
+	}
+
+	var requests []*types.AddActivityTaskRequest
+	t.client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Do(
+		func(arg0 context.Context, arg1 *types.AddActivityTaskRequest, option ...yarpc.CallOption) {
+			requests = append(requests, arg1)
+		},
+	).Return(&types.AddActivityTaskResponse{}, nil).Times(2)
+
+	// the pollers of the task's isolation group are on other partitions
+	taskInfo := t.newTaskInfo()
+	taskInfo.PartitionConfig = map[string]string{partition.IsolationGroupKey: "abc"}
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "")
+	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
+	t.Contains([]string{t.taskList.GetPartition(0), t.taskList.GetPartition(2)}, requests[0].TaskList.GetName())
+
+	// the pollers of the task's isolation group are on this partition
+	taskInfo.PartitionConfig = map[string]string{partition.IsolationGroupKey: "xyz"}
+	task = newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "")
+	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
+	t.Equal(t.taskList.Parent(20), requests[1].TaskList.GetName())
+}
+
+func (t *ForwarderTestSuite) TestForwardPollToIsolationGroupPartition() {
+	t.usingTasklistPartition(persistence.TaskListTypeDecision)
+	t.partitionConfig = &types.TaskListPartitionConfig{
+		ReadPartitions: map[int]*types.TaskListPartition{
+			0: {IsolationGroups: []string{"abc"}},
+			1: {IsolationGroups: []string{"abc"}},
+			2: {IsolationGroups: []string{"xyz"}},
+		},
+	}
+
+	var requests []*types.MatchingPollForDecisionTaskRequest
+	t.client.EXPECT().PollForDecisionTask(gomock.Any(), gomock.Any()).Do(
+		func(arg0 context.Context, arg1 *types.MatchingPollForDecisionTaskRequest, option ...yarpc.CallOption) {
+			requests = append(requests, arg1)
+		},
+	).Return(&types.MatchingPollForDecisionTaskResponse{}, nil).Times(2)
+
+	ctx := ContextWithIsolationGroup(context.Background(), "xyz")
+	_, err := t.fwdr.ForwardPoll(ctx)
+	t.NoError(err)
+	t.Equal(t.taskList.GetPartition(2), requests[0].GetPollRequest().GetTaskList().GetName())
+
+	// polls forwarded to this partition go to the parent
+	_, err = t.fwdr.ForwardPoll(ContextWithPollForwardedFrom(ctx, t.taskList.GetPartition(2)))
+	t.NoError(err)
+	t.Equal(t.taskList.Parent(20), requests[1].GetPollRequest().GetTaskList().GetName())
+}
+
 func (t *ForwarderTestSuite) TestForwardTaskRateExceeded() {
 	t.usingTasklistPartition(persistence.TaskListTypeActivity)
 
@@ -56,6 +56,9 @@ type taskMatcherImpl struct {
 	queryTaskC chan *InternalTask
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter *quotas.RateLimiter
+	// optional ratelimiter shared by all partitions of the task list, used instead of limiter when enabled for the key
+	dispatchRatelimiter *DispatchRatelimiter
+	dispatchKey         string
 
 	fwdr   Forwarder
 	scope  metrics.Scope // domain metric scope
@@ -85,9 +88,12 @@ func newTaskMatcher(
 	tasklist *Identifier,
 	tasklistKind types.TaskListKind,
 	numReadPartitionsFn func(*config.TaskListConfig) int,
+	dispatchRatelimiter *DispatchRatelimiter,
+	dispatchKey string,
 ) TaskMatcher {
 	dPtr := config.TaskDispatchRPS
 	limiter := quotas.NewRateLimiter(&dPtr, config.TaskDispatchRPSTTL, config.MinTaskThrottlingBurstSize())
+	dispatchRatelimiter.register(dispatchKey)
 	isolatedTaskC := make(map[string]chan *InternalTask)
 	for _, g := range isolationGroups {
 		isolatedTaskC[g] = make(chan *InternalTask)
@@ -109,12 +115,15 @@ func newTaskMatcher(
 		cancelCtx:           cancelCtx,
 		cancelFunc:          cancelFunc,
 		numReadPartitionsFn: numReadPartitionsFn,
+		dispatchRatelimiter: dispatchRatelimiter,
+		dispatchKey:         dispatchKey,
 	}
 }
 
 // DisconnectBlockedPollers gradually disconnects pollers which are blocked on long polling
 func (tm *taskMatcherImpl) DisconnectBlockedPollers() {
 	tm.cancelFunc()
+	tm.dispatchRatelimiter.unregister(tm.dispatchKey)
 }
 
 // Offer offers a task to a potential consumer (poller)
@@ -466,6 +475,7 @@ func (tm *taskMatcherImpl) UpdateRatelimit(rps *float64) {
 		return
 	}
 	rate := *rps
+	tm.dispatchRatelimiter.updateRate(tm.dispatchKey, rate)
 	nPartitions := tm.numReadPartitionsFn(tm.config)
 	if rate > float64(nPartitions) {
 		// divide the rate equally across all partitions
@@ -476,7 +486,16 @@ func (tm *taskMatcherImpl) UpdateRatelimit(rps *float64) {
 
 // Rate returns the current rate at which tasks are dispatched
 func (tm *taskMatcherImpl) Rate() float64 {
-	return float64(tm.limiter.Limit())
+	return float64(tm.dispatchLimiter().Limit())
+}
+
+// dispatchLimiter returns the limiter shared by all partitions of the task list when it is enabled,
+// or the limiter of this partition otherwise
+func (tm *taskMatcherImpl) dispatchLimiter() quotas.Limiter {
+	if l := tm.dispatchRatelimiter.limiter(tm.dispatchKey); l != nil {
+		return l
+	}
+	return tm.limiter
 }
 
 func (tm *taskMatcherImpl) pollOrForward(
@@ -772,7 +791,7 @@ func (tm *taskMatcherImpl) fwdrAddReqTokenC() <-chan *ForwarderReqToken {
 }
 
 func (tm *taskMatcherImpl) ratelimit(ctx context.Context) error {
-	err := tm.limiter.Wait(ctx)
+	err := tm.dispatchLimiter().Wait(ctx)
 	if errors.Is(err, clock.ErrCannotWait) {
 		// "err != ctx.Err()" may also be correct, as that would mean "gave up due to context".
 		//
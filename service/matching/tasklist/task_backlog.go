@@ -0,0 +1,93 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// taskBacklog is the persisted backlog of the tasks of one priority of a task list. The backlog of the
+// default priority 0 is the task list itself. The backlog of every other priority is persisted as a separate
+// task list with its own lease, read level and ack level, so that the backlog of a higher priority is read
+// from persistence without reading through the backlog of the lower priorities first.
+type taskBacklog struct {
+	priority       int32
+	db             *taskListDB
+	taskWriter     *taskWriter
+	taskAckManager messaging.AckManager // tracks ackLevel for delivered messages
+	taskGC         *taskGC
+	notifyC        chan struct{} // Used as signal to notify the pump of the backlog of new tasks
+}
+
+// newTaskBacklogs returns the backlogs of the task list, indexed by priority. The backlog of the default
+// priority uses the db, writer and ack manager of the task list manager.
+func newTaskBacklogs(tlMgr *taskListManagerImpl, taskManager persistence.TaskManager) []*taskBacklog {
+	levels := tlMgr.config.TaskPriorityLevels()
+	if levels < 1 || tlMgr.taskListKind == types.TaskListKindSticky {
+		levels = 1
+	}
+	backlogs := []*taskBacklog{{
+		db:             tlMgr.db,
+		taskWriter:     tlMgr.taskWriter,
+		taskAckManager: tlMgr.taskAckManager,
+		taskGC:         tlMgr.taskGC,
+		notifyC:        make(chan struct{}, 1),
+	}}
+	for priority := 1; priority < levels; priority++ {
+		db := newTaskListDB(
+			taskManager,
+			tlMgr.db.domainID,
+			tlMgr.db.domainName,
+			common.TaskListPriorityBacklogName(tlMgr.taskListID.GetName(), priority),
+			tlMgr.db.taskType,
+			tlMgr.db.taskListKind,
+			tlMgr.logger,
+		)
+		taskAckManager := messaging.NewAckManager(tlMgr.logger)
+		backlogs = append(backlogs, &taskBacklog{
+			priority:       int32(priority),
+			db:             db,
+			taskWriter:     newTaskWriter(tlMgr, db, taskAckManager),
+			taskAckManager: taskAckManager,
+			taskGC:         newTaskGC(db, tlMgr.config),
+			notifyC:        make(chan struct{}, 1),
+		})
+	}
+	return backlogs
+}
+
+// getTaskBacklog returns the backlog of the given priority, priorities out of range are capped to the
+// lowest and the highest priority of the task list
+func getTaskBacklog(backlogs []*taskBacklog, priority int32) *taskBacklog {
+	switch {
+	case priority <= 0:
+		return backlogs[0]
+	case int(priority) >= len(backlogs):
+		return backlogs[len(backlogs)-1]
+	default:
+		return backlogs[priority]
+	}
+}
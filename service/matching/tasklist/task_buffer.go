@@ -0,0 +1,208 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// taskBuffer is the in-memory queue of backlog tasks waiting for dispatch. Tasks with a larger
+	// priority are taken first. Every priority is bounded separately, so that a full lower priority
+	// does not block the tasks of a higher priority read from their own backlog. With fairness, tasks
+	// with the same priority are taken round-robin across their fairness keys, so that a single
+	// fairness key can not monopolise the pollers, otherwise they are taken in the order they are added.
+	taskBuffer struct {
+		sync.Mutex
+		capacity int
+		size     int
+		// priorities are the priorities with a level, in descending order
+		priorities []int32
+		levels     map[int32]*fairnessLevel
+		notEmpty   chan struct{}
+	}
+
+	// fairnessLevel holds the buffered tasks of one priority, queued by fairness key
+	fairnessLevel struct {
+		size int
+		// keys are the fairness keys with buffered tasks, in round-robin order
+		keys    []string
+		next    int
+		queues  map[string][]*persistence.TaskInfo
+		notFull chan struct{}
+	}
+)
+
+func newTaskBuffer(capacity int) *taskBuffer {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &taskBuffer{
+		capacity: capacity,
+		levels:   make(map[int32]*fairnessLevel),
+		notEmpty: make(chan struct{}, 1),
+	}
+}
+
+// Put adds the task to the buffer at the priority of the task, blocking while that priority is full.
+// Tasks added without fairness are dispatched in the order they are added. It returns false if the
+// context is done.
+func (b *taskBuffer) Put(ctx context.Context, task *persistence.TaskInfo, fair bool) bool {
+	fairnessKey := ""
+	if fair {
+		fairnessKey = getTaskFairnessKey(task)
+	}
+	for {
+		b.Lock()
+		level := b.getOrCreateLevelLocked(task.Priority)
+		if level.size < b.capacity {
+			level.push(task, fairnessKey)
+			b.size++
+			b.Unlock()
+			notify(b.notEmpty)
+			return true
+		}
+		b.Unlock()
+		select {
+		case <-level.notFull:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// Get removes the next task to dispatch from the buffer, blocking while the buffer is empty.
+// It returns false if the context is done.
+func (b *taskBuffer) Get(ctx context.Context) (*persistence.TaskInfo, bool) {
+	for {
+		b.Lock()
+		if b.size > 0 {
+			task, level := b.popLocked()
+			remaining := b.size
+			b.Unlock()
+			notify(level.notFull)
+			if remaining > 0 {
+				// wake up the next consumer, notifications of several puts may have been merged
+				notify(b.notEmpty)
+			}
+			return task, true
+		}
+		b.Unlock()
+		select {
+		case <-b.notEmpty:
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+}
+
+// Len returns the number of buffered tasks
+func (b *taskBuffer) Len() int {
+	b.Lock()
+	defer b.Unlock()
+	return b.size
+}
+
+// Cap returns the maximum number of buffered tasks of one priority
+func (b *taskBuffer) Cap() int {
+	return b.capacity
+}
+
+func (b *taskBuffer) getOrCreateLevelLocked(priority int32) *fairnessLevel {
+	level, ok := b.levels[priority]
+	if !ok {
+		// levels are kept once created, there are only as many priorities as persisted backlogs
+		level = &fairnessLevel{
+			queues:  make(map[string][]*persistence.TaskInfo),
+			notFull: make(chan struct{}, 1),
+		}
+		b.levels[priority] = level
+		idx := sort.Search(len(b.priorities), func(i int) bool { return b.priorities[i] < priority })
+		b.priorities = append(b.priorities, 0)
+		copy(b.priorities[idx+1:], b.priorities[idx:])
+		b.priorities[idx] = priority
+	}
+	return level
+}
+
+func (b *taskBuffer) popLocked() (*persistence.TaskInfo, *fairnessLevel) {
+	for _, priority := range b.priorities {
+		if level := b.levels[priority]; level.size > 0 {
+			b.size--
+			return level.pop(), level
+		}
+	}
+	panic("taskBuffer: no level holds a task while the buffer is not empty")
+}
+
+func (l *fairnessLevel) push(task *persistence.TaskInfo, fairnessKey string) {
+	if _, ok := l.queues[fairnessKey]; !ok {
+		// a new key is served last in the current round
+		if l.next == 0 {
+			l.keys = append(l.keys, fairnessKey)
+		} else {
+			l.keys = append(l.keys[:l.next], append([]string{fairnessKey}, l.keys[l.next:]...)...)
+			l.next++
+		}
+	}
+	l.queues[fairnessKey] = append(l.queues[fairnessKey], task)
+	l.size++
+}
+
+func (l *fairnessLevel) pop() *persistence.TaskInfo {
+	key := l.keys[l.next]
+	queue := l.queues[key]
+	task := queue[0]
+	queue[0] = nil
+	if len(queue) > 1 {
+		l.queues[key] = queue[1:]
+		l.next++
+	} else {
+		delete(l.queues, key)
+		l.keys = append(l.keys[:l.next], l.keys[l.next+1:]...)
+	}
+	if l.next >= len(l.keys) {
+		l.next = 0
+	}
+	l.size--
+	return task
+}
+
+func notify(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
+
+// getTaskFairnessKey returns the fairness key of the task, it falls back to the workflow ID
+func getTaskFairnessKey(task *persistence.TaskInfo) string {
+	if task.FairnessKey != "" {
+		return task.FairnessKey
+	}
+	return task.WorkflowID
+}
@@ -0,0 +1,181 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestTaskBufferOrder(t *testing.T) {
+	newTask := func(taskID int64, workflowID string, priority int32, fairnessKey string) *persistence.TaskInfo {
+		return &persistence.TaskInfo{
+			TaskID:      taskID,
+			WorkflowID:  workflowID,
+			Priority:    priority,
+			FairnessKey: fairnessKey,
+		}
+	}
+
+	tests := map[string]struct {
+		tasks           []*persistence.TaskInfo
+		fair            bool
+		expectedTaskIDs []int64
+	}{
+		"without fairness tasks of the same priority keep their order": {
+			tasks: []*persistence.TaskInfo{
+				newTask(1, "wf-1", 0, "batch"),
+				newTask(2, "wf-2", 0, "batch"),
+				newTask(3, "wf-3", 0, "online"),
+			},
+			expectedTaskIDs: []int64{1, 2, 3},
+		},
+		"without fairness larger priority first": {
+			tasks: []*persistence.TaskInfo{
+				newTask(1, "wf-1", 0, ""),
+				newTask(2, "wf-2", 2, ""),
+				newTask(3, "wf-3", 1, ""),
+				newTask(4, "wf-4", 2, ""),
+			},
+			expectedTaskIDs: []int64{2, 4, 3, 1},
+		},
+		"no fairness key keeps the order of a single workflow": {
+			tasks: []*persistence.TaskInfo{
+				newTask(1, "wf", 0, ""),
+				newTask(2, "wf", 0, ""),
+				newTask(3, "wf", 0, ""),
+			},
+			fair:            true,
+			expectedTaskIDs: []int64{1, 2, 3},
+		},
+		"round robin across fairness keys": {
+			tasks: []*persistence.TaskInfo{
+				newTask(1, "wf-1", 0, "batch"),
+				newTask(2, "wf-2", 0, "batch"),
+				newTask(3, "wf-3", 0, "batch"),
+				newTask(4, "wf-4", 0, "online"),
+				newTask(5, "wf-5", 0, ""),
+			},
+			fair:            true,
+			expectedTaskIDs: []int64{1, 4, 5, 2, 3},
+		},
+		"priority before fairness": {
+			tasks: []*persistence.TaskInfo{
+				newTask(1, "wf-1", 0, "batch"),
+				newTask(2, "wf-2", 0, "batch"),
+				newTask(3, "wf-3", 1, "online"),
+				newTask(4, "wf-4", 0, "online"),
+			},
+			fair:            true,
+			expectedTaskIDs: []int64{3, 1, 4, 2},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			buffer := newTaskBuffer(len(tc.tasks))
+			for _, task := range tc.tasks {
+				assert.True(t, buffer.Put(context.Background(), task, tc.fair))
+			}
+			assert.Equal(t, len(tc.tasks), buffer.Len())
+			taskIDs := []int64{}
+			for buffer.Len() > 0 {
+				task, ok := buffer.Get(context.Background())
+				assert.True(t, ok)
+				taskIDs = append(taskIDs, task.TaskID)
+			}
+			assert.Equal(t, tc.expectedTaskIDs, taskIDs)
+		})
+	}
+}
+
+func TestTaskBufferFairnessAcrossPuts(t *testing.T) {
+	buffer := newTaskBuffer(10)
+	put := func(taskID int64, fairnessKey string) {
+		assert.True(t, buffer.Put(context.Background(), &persistence.TaskInfo{
+			TaskID:      taskID,
+			FairnessKey: fairnessKey,
+		}, true))
+	}
+	get := func() int64 {
+		task, ok := buffer.Get(context.Background())
+		assert.True(t, ok)
+		return task.TaskID
+	}
+
+	put(1, "batch")
+	put(2, "batch")
+	put(3, "batch")
+	put(4, "online")
+	assert.Equal(t, int64(1), get())
+	// a key added later is served after the keys already waiting in the current round
+	put(5, "other")
+	assert.Equal(t, int64(4), get())
+	assert.Equal(t, int64(2), get())
+	assert.Equal(t, int64(5), get())
+	assert.Equal(t, int64(3), get())
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func TestTaskBufferBlocking(t *testing.T) {
+	buffer := newTaskBuffer(1)
+	assert.Equal(t, 1, buffer.Cap())
+	assert.True(t, buffer.Put(context.Background(), &persistence.TaskInfo{TaskID: 1}, false))
+
+	// a full priority blocks the producer until the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.False(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 2}, false))
+
+	// a full priority does not block the producer of another priority
+	assert.True(t, buffer.Put(context.Background(), &persistence.TaskInfo{TaskID: 4, Priority: 1}, false))
+	assert.Equal(t, 2, buffer.Len())
+
+	// the producer is unblocked by a consumer
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.True(t, buffer.Put(context.Background(), &persistence.TaskInfo{TaskID: 3}, false))
+	}()
+	task, ok := buffer.Get(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), task.TaskID)
+	task, ok = buffer.Get(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), task.TaskID)
+	<-done
+	task, ok = buffer.Get(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), task.TaskID)
+
+	// an empty buffer blocks the consumer until the context is done
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, ok = buffer.Get(ctx)
+	assert.False(t, ok)
+}
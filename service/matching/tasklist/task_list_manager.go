@@ -75,9 +75,10 @@ var (
 )
 
 type (
-	pollerIDCtxKey       struct{}
-	identityCtxKey       struct{}
-	isolationGroupCtxKey struct{}
+	pollerIDCtxKey          struct{}
+	identityCtxKey          struct{}
+	isolationGroupCtxKey    struct{}
+	pollForwardedFromCtxKey struct{}
 
 	AddTaskParams struct {
 		TaskInfo                 *persistence.TaskInfo
@@ -104,6 +105,7 @@ type (
 		liveness        *liveness.Liveness
 		taskGC          *taskGC
 		taskAckManager  messaging.AckManager // tracks ackLevel for delivered messages
+		backlogs        []*taskBacklog       // persisted backlogs indexed by priority, the first one is the task list itself
 		matcher         TaskMatcher          // for matching a task producer with a poller
 		clusterMetadata cluster.Metadata
 		domainCache     cache.DomainCache
@@ -130,6 +132,7 @@ type (
 		throttleRetry        *backoff.ThrottleRetry
 
 		qpsTracker     stats.QPSTrackerGroup
+		dispatchStats  *dispatchStats
 		adaptiveScaler AdaptiveScaler
 
 		partitionConfigLock sync.RWMutex
@@ -161,6 +164,7 @@ func NewManager(
 	timeSource clock.TimeSource,
 	createTime time.Time,
 	historyService history.Client,
+	dispatchRatelimiter *DispatchRatelimiter,
 ) (Manager, error) {
 	domainName, err := domainCache.GetDomainName(taskList.GetDomainID())
 	if err != nil {
@@ -225,6 +229,7 @@ func NewManager(
 	if taskList.IsRoot() && *taskListKind == types.TaskListKindNormal {
 		adaptiveScalerScope := common.NewPerTaskListScope(domainName, taskList.GetName(), *taskListKind, metricsClient, metrics.MatchingAdaptiveScalerScope).
 			Tagged(getTaskListTypeTag(taskList.GetType()))
+		tlMgr.dispatchStats = newDispatchStats(timeSource, taskListConfig.QPSTrackerInterval(), baseEvent)
 		tlMgr.adaptiveScaler = NewAdaptiveScaler(taskList, tlMgr, taskListConfig, timeSource, tlMgr.logger, adaptiveScalerScope, matchingClient, baseEvent)
 	}
 	var isolationGroups []string
@@ -233,7 +238,7 @@ func NewManager(
 	}
 	var fwdr Forwarder
 	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
-		fwdr = newForwarder(&taskListConfig.ForwarderConfig, taskList, *taskListKind, matchingClient, isolationGroups, scope)
+		fwdr = newForwarder(&taskListConfig.ForwarderConfig, taskList, *taskListKind, matchingClient, isolationGroups, tlMgr.TaskListPartitionConfig, scope)
 	}
 	numReadPartitionsFn := func(cfg *config.TaskListConfig) int {
 		if cfg.EnableGetNumberOfPartitionsFromCache() {
@@ -246,8 +251,16 @@ func NewManager(
 		}
 		return cfg.NumReadPartitions()
 	}
-	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.logger, taskList, *taskListKind, numReadPartitionsFn).(*taskMatcherImpl)
-	tlMgr.taskWriter = newTaskWriter(tlMgr)
+	var dispatchKey string
+	if *taskListKind == types.TaskListKindNormal {
+		// sticky task lists have a single partition, which is limited by its own matcher
+		dispatchKey = dispatchRatelimitKey(domainName, taskList)
+	} else {
+		dispatchRatelimiter = nil
+	}
+	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.logger, taskList, *taskListKind, numReadPartitionsFn, dispatchRatelimiter, dispatchKey).(*taskMatcherImpl)
+	tlMgr.taskWriter = newTaskWriter(tlMgr, db, tlMgr.taskAckManager)
+	tlMgr.backlogs = newTaskBacklogs(tlMgr, taskManager)
 	tlMgr.taskReader = newTaskReader(tlMgr, isolationGroups)
 	tlMgr.taskCompleter = newTaskCompleter(tlMgr, historyServiceOperationRetryPolicy)
 	tlMgr.startWG.Add(1)
@@ -281,9 +294,11 @@ func (c *taskListManagerImpl) Start() error {
 			c.partitionConfig = info.AdaptivePartitionConfig.ToInternalType()
 		}
 	}
-	if err := c.taskWriter.Start(); err != nil {
-		c.Stop()
-		return err
+	for _, b := range c.backlogs {
+		if err := b.taskWriter.Start(); err != nil {
+			c.Stop()
+			return err
+		}
 	}
 	if c.taskListID.IsRoot() && c.taskListKind == types.TaskListKindNormal {
 		c.partitionConfig = c.db.PartitionConfig().ToInternalType()
@@ -301,6 +316,9 @@ func (c *taskListManagerImpl) Start() error {
 	c.liveness.Start()
 	c.taskReader.Start()
 	c.qpsTracker.Start()
+	if c.dispatchStats != nil {
+		c.dispatchStats.Start()
+	}
 	if c.adaptiveScaler != nil {
 		c.adaptiveScaler.Start()
 	}
@@ -317,9 +335,14 @@ func (c *taskListManagerImpl) Stop() {
 	if c.adaptiveScaler != nil {
 		c.adaptiveScaler.Stop()
 	}
+	if c.dispatchStats != nil {
+		c.dispatchStats.Stop()
+	}
 	c.qpsTracker.Stop()
 	c.liveness.Stop()
-	c.taskWriter.Stop()
+	for _, b := range c.backlogs {
+		b.taskWriter.Stop()
+	}
 	c.taskReader.Stop()
 	c.matcher.DisconnectBlockedPollers()
 	c.stopWG.Wait()
@@ -367,7 +390,7 @@ func (c *taskListManagerImpl) LoadBalancerHints() *types.LoadBalancerHints {
 		return nil
 	}
 	return &types.LoadBalancerHints{
-		BacklogCount:  c.taskAckManager.GetBacklogCount(),
+		BacklogCount:  c.taskReader.getBacklogCount(),
 		RatePerSecond: c.qpsTracker.QPS(),
 	}
 }
@@ -549,7 +572,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 				return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 			}
 
-			r, err := c.taskWriter.appendTask(params.TaskInfo)
+			r, err := getTaskBacklog(c.backlogs, params.TaskInfo.Priority).taskWriter.appendTask(params.TaskInfo)
 			return r, err
 		}
 
@@ -578,12 +601,15 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 
 		e.EventName = "Task Sent to Writer"
 		event.Log(e)
-		return c.taskWriter.appendTask(params.TaskInfo)
+		return getTaskBacklog(c.backlogs, params.TaskInfo.Priority).taskWriter.appendTask(params.TaskInfo)
 	})
 
 	if err == nil && !syncMatch {
 		c.taskReader.Signal()
 	}
+	if err == nil && syncMatch && params.ForwardedFrom == "" && c.dispatchStats != nil {
+		c.dispatchStats.recordSyncMatch()
+	}
 
 	return syncMatch, err
 }
@@ -650,7 +676,10 @@ func (c *taskListManagerImpl) GetTask(
 		return nil, fmt.Errorf("couldn't get task: %w", err)
 	}
 	task.domainName = c.domainName
-	task.BacklogCountHint = c.taskAckManager.GetBacklogCount()
+	task.BacklogCountHint = c.taskReader.getBacklogCount()
+	if c.dispatchStats != nil && task.Event != nil {
+		c.dispatchStats.recordDispatch(task.Event.CreatedTime)
+	}
 	return task, nil
 }
 
@@ -751,7 +780,7 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 	response.TaskListStatus = &types.TaskListStatus{
 		ReadLevel:        c.taskAckManager.GetReadLevel(),
 		AckLevel:         c.taskAckManager.GetAckLevel(),
-		BacklogCountHint: c.taskAckManager.GetBacklogCount(),
+		BacklogCountHint: c.taskReader.getBacklogCount(),
 		RatePerSecond:    c.matcher.Rate(),
 		TaskIDBlock: &types.TaskIDBlock{
 			StartID: idBlock.start,
@@ -760,6 +789,13 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 		IsolationGroupMetrics: isolationGroupMetrics,
 		NewTasksPerSecond:     c.qpsTracker.QPS(),
 	}
+	if c.dispatchStats != nil {
+		response.TaskListStatus.SyncMatchRate = c.dispatchStats.syncMatchRate(response.TaskListStatus.NewTasksPerSecond)
+		response.TaskListStatus.DispatchLatencyMs = c.dispatchStats.dispatchLatencyMs()
+	}
+	if c.adaptiveScaler != nil {
+		response.ScalingDecisions = c.adaptiveScaler.Decisions()
+	}
 
 	return response
 }
@@ -1039,6 +1075,9 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		GetTasksBatchSize: func() int {
 			return cfg.GetTasksBatchSize(domainName, taskListName, taskType)
 		},
+		TaskPriorityLevels: func() int {
+			return cfg.TaskPriorityLevels(domainName, taskListName, taskType)
+		},
 		UpdateAckInterval: func() time.Duration {
 			return cfg.UpdateAckInterval(domainName, taskListName, taskType)
 		},
@@ -1096,6 +1135,21 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		PartitionDownscaleSustainedDuration: func() time.Duration {
 			return cfg.PartitionDownscaleSustainedDuration(domainName, taskListName, taskType)
 		},
+		PartitionUpscaleBacklogPerPartition: func() int {
+			return cfg.PartitionUpscaleBacklogPerPartition(domainName, taskListName, taskType)
+		},
+		PartitionUpscaleMaxPartitions: func() int {
+			return cfg.PartitionUpscaleMaxPartitions(domainName, taskListName, taskType)
+		},
+		PartitionUpscaleMinSyncMatchRate: func() float64 {
+			return cfg.PartitionUpscaleMinSyncMatchRate(domainName, taskListName, taskType)
+		},
+		PartitionUpscaleDispatchLatency: func() time.Duration {
+			return cfg.PartitionUpscaleDispatchLatency(domainName, taskListName, taskType)
+		},
+		PartitionScalingCooldown: func() time.Duration {
+			return cfg.PartitionScalingCooldown(domainName, taskListName, taskType)
+		},
 		AdaptiveScalerUpdateInterval: func() time.Duration {
 			return cfg.AdaptiveScalerUpdateInterval(domainName, taskListName, taskType)
 		},
@@ -1105,6 +1159,9 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		EnableAdaptiveScaler: func() bool {
 			return cfg.EnableAdaptiveScaler(domainName, taskListName, taskType)
 		},
+		EnablePartitionIsolationGroups: func() bool {
+			return cfg.EnablePartitionIsolationGroups(domainName, taskListName, taskType)
+		},
 		TaskIsolationDuration: func() time.Duration {
 			return cfg.TaskIsolationDuration(domainName, taskListName, taskType)
 		},
@@ -1135,6 +1192,9 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		EnableClientAutoConfig: func() bool {
 			return cfg.EnableClientAutoConfig(domainName, taskListName, taskType)
 		},
+		EnableTaskFairness: func() bool {
+			return cfg.EnableTaskFairness(domainName, taskListName, taskType)
+		},
 	}
 }
 
@@ -1173,3 +1233,15 @@ func IsolationGroupFromContext(ctx context.Context) string {
 func ContextWithIsolationGroup(ctx context.Context, isolationGroup string) context.Context {
 	return context.WithValue(ctx, isolationGroupCtxKey{}, isolationGroup)
 }
+
+func PollForwardedFromContext(ctx context.Context) string {
+	val, ok := ctx.Value(pollForwardedFromCtxKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+func ContextWithPollForwardedFrom(ctx context.Context, forwardedFrom string) context.Context {
+	return context.WithValue(ctx, pollForwardedFromCtxKey{}, forwardedFrom)
+}
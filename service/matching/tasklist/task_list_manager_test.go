@@ -103,6 +103,7 @@ func setupMocksForTaskListManager(t *testing.T, taskListID *Identifier, taskList
 		deps.mockTimeSource,
 		deps.mockTimeSource.Now(),
 		mockHistoryService,
+		nil,
 	)
 	require.NoError(t, err)
 	return tlm.(*taskListManagerImpl), deps
@@ -128,7 +129,7 @@ func TestDeliverBufferTasks(t *testing.T) {
 		func(tlm *taskListManagerImpl) {
 			rps := 0.1
 			tlm.matcher.UpdateRatelimit(&rps)
-			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Put(context.Background(), &persistence.TaskInfo{}, false)
 			err := tlm.matcher.(*taskMatcherImpl).ratelimit(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.cancelFunc()
@@ -162,7 +163,7 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	logger := testlogger.New(t)
 
 	tlm := createTestTaskListManager(t, logger, controller)
-	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Put(context.Background(), &persistence.TaskInfo{}, false)
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -239,7 +240,7 @@ func createTestTaskListManagerWithConfig(t *testing.T, logger log.Logger, contro
 		panic(err)
 	}
 	tlKind := types.TaskListKindNormal
-	tlMgr, err := NewManager(mockDomainCache, logger, metrics.NewClient(tally.NoopScope, metrics.Matching), tm, cluster.GetTestClusterMetadata(true), mockPartitioner, nil, func(Manager) {}, tlID, &tlKind, cfg, timeSource, timeSource.Now(), mockHistoryService)
+	tlMgr, err := NewManager(mockDomainCache, logger, metrics.NewClient(tally.NoopScope, metrics.Matching), tm, cluster.GetTestClusterMetadata(true), mockPartitioner, nil, func(Manager) {}, tlID, &tlKind, cfg, timeSource, timeSource.Now(), mockHistoryService, nil)
 	if err != nil {
 		logger.Fatal("error when createTestTaskListManager", tag.Error(err))
 	}
@@ -850,6 +851,7 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 		timeSource,
 		timeSource.Now(),
 		mockHistoryService,
+		nil,
 	)
 	assert.NoError(t, err)
 	tlm := tlMgr.(*taskListManagerImpl)
@@ -875,9 +877,9 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := common.MinInt(cap(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]), taskCount)
+	expectedBufSize := common.MinInt(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Cap(), taskCount)
 	assert.True(t, awaitCondition(func() bool {
-		return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) == expectedBufSize
+		return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Len() == expectedBufSize
 	}, 10*time.Second))
 
 	// stop all goroutines that read / write tasks in the background
@@ -887,14 +889,14 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 	// SetReadLevel should NEVER be called without updating ackManager.outstandingTasks
 	// This is only for unit test purpose
 	tlm.taskAckManager.SetReadLevel(tlm.taskWriter.GetMaxReadLevel())
-	tasks, readLevel, isReadBatchDone, err := tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err := tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.NoError(t, err)
 	assert.Equal(t, 0, len(tasks))
 	assert.Equal(t, readLevel, tlm.taskWriter.GetMaxReadLevel())
 	assert.True(t, isReadBatchDone)
 
 	tlm.taskAckManager.SetReadLevel(0)
-	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.NoError(t, err)
 	assert.Equal(t, rangeSize/2, len(tasks))
 	assert.Equal(t, rangeSize/2, int(readLevel))
@@ -921,6 +923,7 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 		timeSource,
 		timeSource.Now(),
 		mockHistoryService,
+		nil,
 	)
 	assert.NoError(t, err)
 	tlm = tlMgr.(*taskListManagerImpl)
@@ -937,13 +940,61 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 		task.Finish(nil)
 	}
 	assert.Equal(t, taskCount-rangeSize, tm.GetTaskCount(taskListID))
-	tasks, _, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, _, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.NoError(t, err)
 	assert.True(t, 0 < len(tasks) && len(tasks) <= rangeSize)
 	assert.True(t, isReadBatchDone)
 	tlm.Stop()
 }
 
+func TestTaskListManagerReadsHigherPriorityBacklogFirst(t *testing.T) {
+	controller := gomock.NewController(t)
+	cfg := defaultTestConfig()
+	cfg.TaskPriorityLevels = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, cfg, clock.NewRealTimeSource())
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	addTask := func(scheduleID int64, priority int32) {
+		_, err := tlm.AddTask(context.Background(), AddTaskParams{
+			TaskInfo: &persistence.TaskInfo{
+				DomainID:                      "domain",
+				RunID:                         "run",
+				WorkflowID:                    "workflow",
+				ScheduleID:                    scheduleID,
+				ScheduleToStartTimeoutSeconds: 100,
+				Priority:                      priority,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// fill the buffer of the default priority, the rest of its tasks stay in the persisted backlog
+	for i := int64(1); i <= 10; i++ {
+		addTask(i, 0)
+	}
+	buffer := tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]
+	require.True(t, awaitCondition(func() bool { return buffer.Len() == buffer.Cap() }, 10*time.Second))
+
+	// the priority is capped to the highest priority of the task list, whose backlog is read on its own
+	addTask(100, 5)
+	backlog := tlm.backlogs[2]
+	require.True(t, awaitCondition(func() bool { return backlog.taskAckManager.GetBacklogCount() == 1 }, 10*time.Second))
+	assert.Equal(t, int64(0), tlm.backlogs[1].taskAckManager.GetBacklogCount())
+
+	// the dispatcher already holds the first task of the default priority
+	var scheduleIDs []int64
+	for i := 0; i < 3; i++ {
+		task, err := tlm.GetTask(context.Background(), nil)
+		require.NoError(t, err)
+		scheduleIDs = append(scheduleIDs, task.Event.ScheduleID)
+		task.Finish(nil)
+	}
+	assert.Equal(t, []int64{1, 100, 2}, scheduleIDs)
+	assert.Equal(t, int64(0), backlog.taskAckManager.GetBacklogCount())
+}
+
 func TestTaskListReaderPumpAdvancesAckLevelAfterEmptyReads(t *testing.T) {
 	const taskCount = 5
 	const rangeSize = 10
@@ -980,6 +1031,7 @@ func TestTaskListReaderPumpAdvancesAckLevelAfterEmptyReads(t *testing.T) {
 		timeSource,
 		timeSource.Now(),
 		mockHistoryService,
+		nil,
 	)
 	require.NoError(t, err)
 	tlm := tlMgr.(*taskListManagerImpl)
@@ -1045,7 +1097,7 @@ func TestTaskListManagerGetTaskBatch_ReadBatchDone(t *testing.T) {
 
 	tlm.taskAckManager.SetReadLevel(0)
 	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, maxReadLevel)
-	tasks, readLevel, isReadBatchDone, err := tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err := tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.Empty(t, tasks)
 	assert.Equal(t, int64(rangeSize/2*10), readLevel)
 	assert.False(t, isReadBatchDone)
@@ -1053,14 +1105,14 @@ func TestTaskListManagerGetTaskBatch_ReadBatchDone(t *testing.T) {
 
 	tlm.taskAckManager.SetReadLevel(readLevel)
 	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, maxReadLevel)
-	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.Empty(t, tasks)
 	assert.Equal(t, 2*int64(rangeSize/2*10), readLevel)
 	assert.False(t, isReadBatchDone)
 	assert.NoError(t, err)
 
 	tlm.taskAckManager.SetReadLevel(readLevel)
-	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.Empty(t, tasks)
 	assert.Equal(t, maxReadLevel, readLevel)
 	assert.True(t, isReadBatchDone)
@@ -1127,6 +1179,7 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 				timeSource,
 				timeSource.Now(),
 				mockHistoryService,
+				nil,
 			)
 			assert.NoError(t, err)
 			tlm := tlMgr.(*taskListManagerImpl)
@@ -1155,7 +1208,7 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 			// wait until all tasks are loaded by into in-memory buffers by task list manager
 			// the buffer size should be one less than expected because dispatcher will dequeue the head
 			assert.True(t, awaitCondition(func() bool {
-				return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) >= (taskCount/2 - 1)
+				return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Len() >= (taskCount/2 - 1)
 			}, time.Second))
 
 			remaining := taskCount
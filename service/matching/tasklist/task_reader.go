@@ -36,7 +36,6 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
-	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -57,15 +56,12 @@ type (
 		// that are enqueued for pollers to pickup. It's written to by
 		// - getTasksPump - the primary means of loading async matching tasks
 		// - task dispatch redirection - when a task is redirected from another isolation group
-		taskBuffers     map[string]chan *persistence.TaskInfo
-		notifyC         chan struct{} // Used as signal to notify pump of new tasks
+		taskBuffers map[string]*taskBuffer
+		// backlogs are the persisted backlogs of the task list indexed by priority, each is read by its own pump
+		backlogs        []*taskBacklog
 		tlMgr           *taskListManagerImpl
 		taskListID      *Identifier
 		config          *config.TaskListConfig
-		db              *taskListDB
-		taskWriter      *taskWriter
-		taskGC          *taskGC
-		taskAckManager  messaging.AckManager
 		domainCache     cache.DomainCache
 		clusterMetadata cluster.Metadata
 		timeSource      clock.TimeSource
@@ -93,22 +89,18 @@ type (
 
 func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
-	taskBuffers := make(map[string]chan *persistence.TaskInfo)
-	taskBuffers[defaultTaskBufferIsolationGroup] = make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1)
+	taskBuffers := make(map[string]*taskBuffer)
+	taskBuffers[defaultTaskBufferIsolationGroup] = newTaskBuffer(tlMgr.config.GetTasksBatchSize() - 1)
 	for _, g := range isolationGroups {
-		taskBuffers[g] = make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1)
+		taskBuffers[g] = newTaskBuffer(tlMgr.config.GetTasksBatchSize() - 1)
 	}
 	return &taskReader{
-		tlMgr:          tlMgr,
-		taskListID:     tlMgr.taskListID,
-		config:         tlMgr.config,
-		db:             tlMgr.db,
-		taskWriter:     tlMgr.taskWriter,
-		taskGC:         tlMgr.taskGC,
-		taskAckManager: tlMgr.taskAckManager,
-		cancelCtx:      ctx,
-		cancelFunc:     cancel,
-		notifyC:        make(chan struct{}, 1),
+		tlMgr:      tlMgr,
+		taskListID: tlMgr.taskListID,
+		config:     tlMgr.config,
+		backlogs:   tlMgr.backlogs,
+		cancelCtx:  ctx,
+		cancelFunc: cancel,
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffers:              taskBuffers,
@@ -139,61 +131,69 @@ func (tr *taskReader) Start() {
 			tr.dispatchBufferedTasks(g)
 		}()
 	}
-	tr.stopWg.Add(1)
-	go func() {
-		defer tr.stopWg.Done()
-		tr.getTasksPump()
-	}()
+	for _, b := range tr.backlogs {
+		b := b
+		tr.stopWg.Add(1)
+		go func() {
+			defer tr.stopWg.Done()
+			tr.getTasksPump(b)
+		}()
+	}
 }
 
 func (tr *taskReader) Stop() {
 	if atomic.CompareAndSwapInt64(&tr.stopped, 0, 1) {
 		tr.cancelFunc()
-		if err := tr.persistAckLevel(); err != nil {
-			tr.logger.Error("Persistent store operation failure",
-				tag.StoreOperationUpdateTaskList,
-				tag.Error(err))
+		for _, b := range tr.backlogs {
+			if err := tr.persistAckLevel(b); err != nil {
+				tr.logger.Error("Persistent store operation failure",
+					tag.StoreOperationUpdateTaskList,
+					tag.Error(err))
+			}
+			b.taskGC.RunNow(b.taskAckManager.GetAckLevel())
 		}
-		tr.taskGC.RunNow(tr.taskAckManager.GetAckLevel())
 		tr.stopWg.Wait()
 	}
 }
 
+// Signal notifies the pumps of all backlogs to check persistence for new tasks, a pump which has read
+// up to the max read level of its backlog returns to wait without reading from persistence
 func (tr *taskReader) Signal() {
+	for _, b := range tr.backlogs {
+		tr.signal(b)
+	}
+}
+
+func (tr *taskReader) signal(b *taskBacklog) {
 	var event struct{}
 	select {
-	case tr.notifyC <- event:
+	case b.notifyC <- event:
 	default: // channel already has an event, don't block
 	}
 }
 
 func (tr *taskReader) dispatchBufferedTasks(isolationGroup string) {
-dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffers[isolationGroup]:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
-			}
-			event.Log(event.E{
-				TaskListName: tr.taskListID.GetName(),
-				TaskListType: tr.taskListID.GetType(),
-				TaskListKind: &tr.tlMgr.taskListKind,
-				TaskInfo:     *taskInfo,
-				EventName:    "Attempting to Dispatch Buffered Task",
-			})
-			breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
-			if breakDispatchLoop {
-				// shutting down
-				break dispatchLoop
-			}
-		case <-tr.cancelCtx.Done():
-			break dispatchLoop
+		taskInfo, ok := tr.taskBuffers[isolationGroup].Get(tr.cancelCtx)
+		if !ok { // Task list is shutting down
+			return
+		}
+		event.Log(event.E{
+			TaskListName: tr.taskListID.GetName(),
+			TaskListType: tr.taskListID.GetType(),
+			TaskListKind: &tr.tlMgr.taskListKind,
+			TaskInfo:     *taskInfo,
+			EventName:    "Attempting to Dispatch Buffered Task",
+		})
+		breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
+		if breakDispatchLoop {
+			// shutting down
+			return
 		}
 	}
 }
 
-func (tr *taskReader) getTasksPump() {
+func (tr *taskReader) getTasksPump(b *taskBacklog) {
 	updateAckTimer := tr.timeSource.NewTimer(tr.config.UpdateAckInterval())
 	defer updateAckTimer.Stop()
 getTasksPumpLoop:
@@ -201,30 +201,30 @@ getTasksPumpLoop:
 		select {
 		case <-tr.cancelCtx.Done():
 			break getTasksPumpLoop
-		case <-tr.notifyC:
+		case <-b.notifyC:
 			{
-				initialReadLevel := tr.taskAckManager.GetReadLevel()
-				maxReadLevel := tr.taskWriter.GetMaxReadLevel()
+				initialReadLevel := b.taskAckManager.GetReadLevel()
+				maxReadLevel := b.taskWriter.GetMaxReadLevel()
 
-				tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch(initialReadLevel, maxReadLevel)
+				tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch(b, initialReadLevel, maxReadLevel)
 				if err != nil {
-					tr.Signal() // re-enqueue the event
+					tr.signal(b) // re-enqueue the event
 					// TODO: Should we ever stop retrying on db errors?
 					continue getTasksPumpLoop
 				}
 
 				if len(tasks) == 0 {
-					tr.taskAckManager.SetReadLevel(readLevel)
+					b.taskAckManager.SetReadLevel(readLevel)
 
-					if tr.taskAckManager.GetAckLevel() == initialReadLevel {
+					if b.taskAckManager.GetAckLevel() == initialReadLevel {
 						// Even though we didn't handle any tasks, we want to advance the ack-level
 						// in order to avoid needless querying database the next time.
 						// This is safe since we started reading exactly from the current AckLevel and read no tasks
-						tr.taskAckManager.SetAckLevel(readLevel)
+						b.taskAckManager.SetAckLevel(readLevel)
 					}
 
 					if !isReadBatchDone {
-						tr.Signal()
+						tr.signal(b)
 					}
 					continue getTasksPumpLoop
 				}
@@ -233,32 +233,50 @@ getTasksPumpLoop:
 					break getTasksPumpLoop
 				}
 				// There maybe more tasks. We yield now, but signal pump to check again later.
-				tr.Signal()
+				tr.signal(b)
 			}
 		case <-updateAckTimer.Chan():
 			{
-				ackLevel := tr.taskAckManager.GetAckLevel()
-				if size, err := tr.db.GetTaskListSize(ackLevel); err == nil {
-					tr.scope.UpdateGauge(metrics.TaskCountPerTaskListGauge, float64(size))
+				ackLevel := b.taskAckManager.GetAckLevel()
+				if _, err := b.db.GetTaskListSize(ackLevel); err == nil {
+					tr.scope.UpdateGauge(metrics.TaskCountPerTaskListGauge, float64(tr.getTaskListSize()))
 				}
-				if err := tr.handleErr(tr.persistAckLevel()); err != nil {
+				if err := tr.handleErr(tr.persistAckLevel(b)); err != nil {
 					tr.logger.Error("Persistent store operation failure",
 						tag.StoreOperationUpdateTaskList,
 						tag.Error(err))
 					// keep going as saving ack is not critical
 				}
-				tr.Signal() // periodically signal pump to check persistence for tasks
+				tr.signal(b) // periodically signal pump to check persistence for tasks
 				updateAckTimer.Reset(tr.config.UpdateAckInterval())
 			}
 		}
-		tr.scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.taskAckManager.GetBacklogCount()))
+		tr.scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.getBacklogCount()))
+	}
+}
+
+// getBacklogCount returns the number of tasks read from persistence and not yet acked, of all backlogs
+func (tr *taskReader) getBacklogCount() int64 {
+	var count int64
+	for _, b := range tr.backlogs {
+		count += b.taskAckManager.GetBacklogCount()
 	}
+	return count
 }
 
-func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
+// getTaskListSize returns the last known number of persisted tasks, of all backlogs
+func (tr *taskReader) getTaskListSize() int64 {
+	var size int64
+	for _, b := range tr.backlogs {
+		size += b.db.BacklogCount()
+	}
+	return size
+}
+
+func (tr *taskReader) getTaskBatchWithRange(b *taskBacklog, readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
 	var response *persistence.GetTasksResponse
 	op := func() (err error) {
-		response, err = tr.db.GetTasks(readLevel, maxReadLevel, tr.config.GetTasksBatchSize())
+		response, err = b.db.GetTasks(readLevel, maxReadLevel, tr.config.GetTasksBatchSize())
 		return
 	}
 	err := tr.throttleRetry.Do(context.Background(), op)
@@ -270,13 +288,17 @@ func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64)
 			tag.WorkflowTaskListType(tr.taskListID.GetType()))
 		return nil, err
 	}
+	for _, task := range response.Tasks {
+		// the priority is not persisted, it is the priority of the backlog
+		task.Priority = b.priority
+	}
 	return response.Tasks, nil
 }
 
 // Returns a batch of tasks from persistence starting form current read level.
 // Also return a number that can be used to update readLevel
 // Also return a bool to indicate whether read is finished
-func (tr *taskReader) getTaskBatch(readLevel, maxReadLevel int64) ([]*persistence.TaskInfo, int64, bool, error) {
+func (tr *taskReader) getTaskBatch(b *taskBacklog, readLevel, maxReadLevel int64) ([]*persistence.TaskInfo, int64, bool, error) {
 	var tasks []*persistence.TaskInfo
 
 	// counter i is used to break and let caller check whether tasklist is still alive and need resume read.
@@ -285,7 +307,7 @@ func (tr *taskReader) getTaskBatch(readLevel, maxReadLevel int64) ([]*persistenc
 		if upper > maxReadLevel {
 			upper = maxReadLevel
 		}
-		tasks, err := tr.getTaskBatchWithRange(readLevel, upper)
+		tasks, err := tr.getTaskBatchWithRange(b, readLevel, upper)
 		if err != nil {
 			return nil, readLevel, true, err
 		}
@@ -312,14 +334,15 @@ func (tr *taskReader) addTasksToBuffer(tasks []*persistence.TaskInfo) bool {
 }
 
 func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
+	b := getTaskBacklog(tr.backlogs, task.Priority)
 	if tr.isTaskExpired(task) {
 		tr.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
 		// Also increment readLevel for expired tasks otherwise it could result in
 		// looping over the same tasks if all tasks read in the batch are expired
-		tr.taskAckManager.SetReadLevel(task.TaskID)
+		b.taskAckManager.SetReadLevel(task.TaskID)
 		return true
 	}
-	err := tr.taskAckManager.ReadItem(task.TaskID)
+	err := b.taskAckManager.ReadItem(task.TaskID)
 	if err != nil {
 		tr.logger.Fatal("critical bug when adding item to ackManager", tag.Error(err))
 	}
@@ -338,32 +361,39 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 		}
 		return true
 	}
-	select {
-	case tr.taskBuffers[isolationGroup] <- task:
-		return true
-	case <-tr.cancelCtx.Done():
-		return false
-	}
+	// the buffer dispatches the tasks of a higher priority first, tasks it already holds from earlier reads included
+	return tr.taskBuffers[isolationGroup].Put(tr.cancelCtx, task, tr.config.EnableTaskFairness())
 }
 
-func (tr *taskReader) persistAckLevel() error {
-	ackLevel := tr.taskAckManager.GetAckLevel()
+func (tr *taskReader) persistAckLevel(b *taskBacklog) error {
+	ackLevel := b.taskAckManager.GetAckLevel()
 	if ackLevel >= 0 {
-		maxReadLevel := tr.taskWriter.GetMaxReadLevel()
 		// note: this metrics is only an estimation for the lag. taskID in DB may not be continuous,
 		// especially when task list ownership changes.
-		tr.scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(maxReadLevel-ackLevel))
+		tr.scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(tr.getTaskLag()))
 
-		return tr.db.UpdateState(ackLevel)
+		return b.db.UpdateState(ackLevel)
 	}
 	return nil
 }
 
+// getTaskLag returns the distance between the ack level and the max read level, summed over all backlogs
+func (tr *taskReader) getTaskLag() int64 {
+	var lag int64
+	for _, b := range tr.backlogs {
+		if ackLevel := b.taskAckManager.GetAckLevel(); ackLevel >= 0 {
+			lag += b.taskWriter.GetMaxReadLevel() - ackLevel
+		}
+	}
+	return lag
+}
+
 // completeTask marks a task as processed. Only tasks created by taskReader (i.e. backlog from db) reach
 // here. As part of completion:
 //   - task is deleted from the database when err is nil
 //   - new task is created and current task is deleted when err is not nil
 func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
+	b := getTaskBacklog(tr.backlogs, task.Priority)
 	if err != nil {
 		// failed to start the task.
 		// We cannot just remove it from persistence because then it will be lost.
@@ -373,7 +403,7 @@ func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
 		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
 		// re-written to persistence frequently.
 		op := func() error {
-			_, err := tr.taskWriter.appendTask(task)
+			_, err := b.taskWriter.appendTask(task)
 			return err
 		}
 		err = tr.throttleRetry.Do(context.Background(), op)
@@ -385,10 +415,10 @@ func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
 			tr.onFatalErr()
 			return
 		}
-		tr.Signal()
+		tr.signal(b)
 	}
-	ackLevel := tr.taskAckManager.AckItem(task.TaskID)
-	tr.taskGC.Run(ackLevel)
+	ackLevel := b.taskAckManager.AckItem(task.TaskID)
+	b.taskGC.Run(ackLevel)
 }
 
 func (tr *taskReader) newDispatchContext(isolationGroup string, isolationDuration time.Duration) (context.Context, context.CancelFunc) {
@@ -293,6 +293,64 @@ func TestGetDispatchTimeout(t *testing.T) {
 	}
 }
 
+func TestAddTasksToBufferWithPriority(t *testing.T) {
+	controller := gomock.NewController(t)
+	timeSource := clock.NewMockedTimeSource()
+	c := defaultConfig()
+	c.TaskPriorityLevels = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, c, timeSource)
+	assert.Len(t, tlm.backlogs, 3)
+	reader := tlm.taskReader
+	reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration, error) {
+		return "", -1, nil
+	}
+
+	newPriorityTask := func(taskID int64, priority int32, expired bool) *persistence.TaskInfo {
+		task := newTask(timeSource)
+		task.TaskID = taskID
+		task.Priority = priority
+		if expired {
+			task.Expiry = timeSource.Now().Add(-time.Second)
+		}
+		return task
+	}
+	tasks := []*persistence.TaskInfo{
+		newPriorityTask(11, 0, false),
+		newPriorityTask(12, 1, false),
+		newPriorityTask(13, 2, true),
+		newPriorityTask(14, 2, false),
+	}
+
+	assert.True(t, reader.addTasksToBuffer(tasks))
+	// every task is tracked by the ack manager of its backlog
+	assert.Equal(t, int64(11), tlm.backlogs[0].taskAckManager.GetReadLevel())
+	assert.Equal(t, int64(12), tlm.backlogs[1].taskAckManager.GetReadLevel())
+	assert.Equal(t, int64(14), tlm.backlogs[2].taskAckManager.GetReadLevel())
+	assert.Equal(t, int64(1), tlm.backlogs[2].taskAckManager.GetBacklogCount())
+	assert.Equal(t, int64(3), reader.getBacklogCount())
+
+	// a task read later is dispatched before the buffered tasks with a smaller priority
+	assert.True(t, reader.addTasksToBuffer([]*persistence.TaskInfo{newPriorityTask(15, 1, false)}))
+
+	buffer := reader.taskBuffers[defaultTaskBufferIsolationGroup]
+	var dispatched []int64
+	for buffer.Len() > 0 {
+		task, ok := buffer.Get(context.Background())
+		assert.True(t, ok)
+		dispatched = append(dispatched, task.TaskID)
+	}
+	assert.Equal(t, []int64{14, 12, 15, 11}, dispatched)
+}
+
+func TestGetTaskBacklog(t *testing.T) {
+	backlogs := []*taskBacklog{{priority: 0}, {priority: 1}, {priority: 2}}
+	assert.Equal(t, int32(0), getTaskBacklog(backlogs, -1).priority)
+	assert.Equal(t, int32(0), getTaskBacklog(backlogs, 0).priority)
+	assert.Equal(t, int32(1), getTaskBacklog(backlogs, 1).priority)
+	assert.Equal(t, int32(2), getTaskBacklog(backlogs, 2).priority)
+	assert.Equal(t, int32(2), getTaskBacklog(backlogs, 10).priority)
+}
+
 func defaultConfig() *config.Config {
 	config := config.NewConfig(dynamicconfig.NewNopCollection(), "some random hostname", func() []string {
 		return defaultIsolationGroups
@@ -0,0 +1,293 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package autofailover
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+const (
+	// ringKey is looked up in the worker ring to pick the single host running the monitor
+	ringKey = "cadence-auto-failover-monitor"
+)
+
+type (
+	// Config is the config of the auto failover monitor
+	Config struct {
+		Interval                dynamicconfig.DurationPropertyFn
+		UnhealthyDuration       dynamicconfig.DurationPropertyFn
+		Cooldown                dynamicconfig.DurationPropertyFn
+		GracefulFailoverTimeout dynamicconfig.DurationPropertyFn
+
+		MaxReplicationLag dynamicconfig.DurationPropertyFn
+	}
+
+	// Monitor periodically evaluates the health signals of the remote clusters and fails over the domains
+	// which opted in, to the current cluster, once their active cluster stays unhealthy for long enough.
+	// Domains opt in by naming the current cluster as their preferred standby in the domain data,
+	// so only the monitor of that cluster fails a domain over. The failover is called off while
+	// another remote cluster disputes that the active cluster is unhealthy.
+	Monitor struct {
+		status             int32
+		stopCh             chan struct{}
+		wg                 sync.WaitGroup
+		config             *Config
+		signals            []Signal
+		peers              PeerCheck
+		clusterMetadata    cluster.Metadata
+		domainCache        cache.DomainCache
+		frontendClient     frontend.Client
+		membershipResolver membership.Resolver
+		logger             log.Logger
+		metricsScope       metrics.Scope
+		timeSource         clock.TimeSource
+
+		// unhealthySince is when each remote cluster was first found unhealthy in the current streak
+		unhealthySince map[string]time.Time
+		// lastFailoverTimes is when the monitor last failed over each domain
+		lastFailoverTimes map[string]time.Time
+	}
+)
+
+// New creates a new auto failover monitor
+func New(
+	config *Config,
+	signals []Signal,
+	peers PeerCheck,
+	clusterMetadata cluster.Metadata,
+	domainCache cache.DomainCache,
+	frontendClient frontend.Client,
+	membershipResolver membership.Resolver,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	timeSource clock.TimeSource,
+) *Monitor {
+	return &Monitor{
+		status:             common.DaemonStatusInitialized,
+		stopCh:             make(chan struct{}),
+		config:             config,
+		signals:            signals,
+		peers:              peers,
+		clusterMetadata:    clusterMetadata,
+		domainCache:        domainCache,
+		frontendClient:     frontendClient,
+		membershipResolver: membershipResolver,
+		logger:             logger.WithTags(tag.ComponentAutoFailoverMonitor),
+		metricsScope:       metricsClient.Scope(metrics.AutoFailoverMonitorScope),
+		timeSource:         timeSource,
+		unhealthySince:     make(map[string]time.Time),
+		lastFailoverTimes:  make(map[string]time.Time),
+	}
+}
+
+// Start starts the monitor
+func (m *Monitor) Start() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	m.wg.Add(1)
+	go m.loop()
+	m.logger.Info("auto failover monitor started")
+}
+
+// Stop stops the monitor
+func (m *Monitor) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(m.stopCh)
+	m.wg.Wait()
+	m.logger.Info("auto failover monitor stopped")
+}
+
+func (m *Monitor) loop() {
+	defer m.wg.Done()
+	workercommon.RunPeriodically(m.timeSource, m.config.Interval, m.stopCh, m.evaluate)
+}
+
+// evaluate runs one round of health evaluation and fails over the domains whose active cluster
+// has been unhealthy for long enough
+func (m *Monitor) evaluate(ctx context.Context) {
+	if !workercommon.IsRingOwner(m.membershipResolver, ringKey, m.logger) {
+		return
+	}
+
+	reasons := make(map[string][]string)
+	remoteClusters := m.clusterMetadata.GetRemoteClusterInfo()
+	for remoteCluster := range remoteClusters {
+		for _, signal := range m.signals {
+			reason, err := signal.Unhealthy(ctx, remoteCluster)
+			if err != nil {
+				// a cluster is never failed over on partial information
+				m.logger.Warn("failed to evaluate cluster health signal", tag.ClusterName(remoteCluster), tag.Name(signal.Name()), tag.Error(err))
+				m.metricsScope.IncCounter(metrics.AutoFailoverMonitorEvaluationFailures)
+				return
+			}
+			if reason != "" {
+				reasons[remoteCluster] = append(reasons[remoteCluster], fmt.Sprintf("%v: %v", signal.Name(), reason))
+			}
+		}
+	}
+
+	now := m.timeSource.Now()
+	unhealthyClusters := make(map[string][]string)
+	for remoteCluster := range remoteClusters {
+		if _, ok := reasons[remoteCluster]; !ok {
+			delete(m.unhealthySince, remoteCluster)
+			continue
+		}
+		since, ok := m.unhealthySince[remoteCluster]
+		if !ok {
+			since = now
+			m.unhealthySince[remoteCluster] = now
+			m.logger.Warn("cluster became unhealthy", tag.ClusterName(remoteCluster), tag.Value(reasons[remoteCluster]))
+		}
+		if now.Sub(since) >= m.config.UnhealthyDuration() {
+			unhealthyClusters[remoteCluster] = reasons[remoteCluster]
+		}
+	}
+	if len(unhealthyClusters) == 0 {
+		return
+	}
+
+	disputes := make(map[string]string)
+	for _, domainEntry := range m.candidates(unhealthyClusters) {
+		domainName := domainEntry.GetInfo().Name
+		activeCluster := domainEntry.GetReplicationConfig().ActiveClusterName
+		if lastFailoverTime := m.lastFailoverTime(domainEntry); now.Sub(lastFailoverTime) < m.config.Cooldown() {
+			m.logger.Warn("auto failover rejected by cooldown",
+				tag.WorkflowDomainName(domainName),
+				tag.Dynamic("last-failover-time", lastFailoverTime))
+			m.metricsScope.IncCounter(metrics.AutoFailoverRejectedCount)
+			continue
+		}
+		dispute, ok := disputes[activeCluster]
+		if !ok {
+			dispute = m.peers.Disputed(ctx, activeCluster, m.unhealthySince[activeCluster])
+			disputes[activeCluster] = dispute
+		}
+		if dispute != "" {
+			m.logger.Warn("auto failover rejected by peer cluster",
+				tag.WorkflowDomainName(domainName),
+				tag.ClusterName(activeCluster),
+				tag.Value(dispute))
+			m.metricsScope.IncCounter(metrics.AutoFailoverRejectedCount)
+			continue
+		}
+		m.failover(ctx, domainEntry, unhealthyClusters[activeCluster])
+	}
+}
+
+// candidates returns the domains which opted in to be failed over to the current cluster and are active
+// in one of the unhealthy clusters, ordered by name
+func (m *Monitor) candidates(unhealthyClusters map[string][]string) []*cache.DomainCacheEntry {
+	currentCluster := m.clusterMetadata.GetCurrentClusterName()
+	var result []*cache.DomainCacheEntry
+	for _, domainEntry := range m.domainCache.GetAllDomain() {
+		if !domainEntry.IsGlobalDomain() || domainEntry.IsDeprecatedOrDeleted() {
+			continue
+		}
+		if domainEntry.GetInfo().Data[common.DomainDataKeyForAutoFailoverCluster] != currentCluster {
+			continue
+		}
+		if _, ok := unhealthyClusters[domainEntry.GetReplicationConfig().ActiveClusterName]; !ok {
+			continue
+		}
+		if !domainEntry.HasReplicationCluster(currentCluster) {
+			continue
+		}
+		// a graceful failover is already ongoing
+		if domainEntry.GetFailoverEndTime() != nil {
+			continue
+		}
+		result = append(result, domainEntry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetInfo().Name < result[j].GetInfo().Name
+	})
+	return result
+}
+
+// lastFailoverTime returns when the domain was last failed over, either by this monitor or by anyone else
+// as recorded in the failover history of the domain
+func (m *Monitor) lastFailoverTime(domainEntry *cache.DomainCacheEntry) time.Time {
+	result := m.lastFailoverTimes[domainEntry.GetInfo().Name]
+	var failoverHistory []domain.FailoverEvent
+	if err := json.Unmarshal([]byte(domainEntry.GetInfo().Data[common.DomainDataKeyForFailoverHistory]), &failoverHistory); err != nil {
+		return result
+	}
+	for _, event := range failoverHistory {
+		if event.EventTime.After(result) {
+			result = event.EventTime
+		}
+	}
+	return result
+}
+
+// failover starts a graceful failover of the domain to the current cluster, the failover completes
+// once the unhealthy cluster catches up or the graceful failover times out
+func (m *Monitor) failover(ctx context.Context, domainEntry *cache.DomainCacheEntry, reasons []string) {
+	domainName := domainEntry.GetInfo().Name
+	fromCluster := domainEntry.GetReplicationConfig().ActiveClusterName
+	toCluster := m.clusterMetadata.GetCurrentClusterName()
+	_, err := m.frontendClient.UpdateDomain(ctx, &types.UpdateDomainRequest{
+		Name:                     domainName,
+		ActiveClusterName:        common.StringPtr(toCluster),
+		FailoverTimeoutInSeconds: common.Int32Ptr(int32(m.config.GracefulFailoverTimeout().Seconds())),
+	})
+	if err != nil {
+		m.logger.Error("failed to auto failover domain",
+			tag.WorkflowDomainName(domainName),
+			tag.Dynamic("from-cluster", fromCluster),
+			tag.Dynamic("to-cluster", toCluster),
+			tag.Error(err))
+		m.metricsScope.IncCounter(metrics.AutoFailoverFailedCount)
+		return
+	}
+
+	m.lastFailoverTimes[domainName] = m.timeSource.Now()
+	m.logger.Warn("domain auto failover started",
+		tag.WorkflowDomainName(domainName),
+		tag.Dynamic("from-cluster", fromCluster),
+		tag.Dynamic("to-cluster", toCluster),
+		tag.Value(reasons))
+	m.metricsScope.IncCounter(metrics.AutoFailoverTriggeredCount)
+}
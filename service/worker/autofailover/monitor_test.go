@@ -0,0 +1,261 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package autofailover
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+type fakeSignal struct {
+	unhealthy map[string]string
+	err       error
+}
+
+func (s *fakeSignal) Name() string {
+	return "fake"
+}
+
+func (s *fakeSignal) Unhealthy(ctx context.Context, cluster string) (string, error) {
+	return s.unhealthy[cluster], s.err
+}
+
+type fakePeerCheck struct {
+	disputes map[string]string
+	calls    int
+}
+
+func (c *fakePeerCheck) Disputed(ctx context.Context, cluster string, unhealthySince time.Time) string {
+	c.calls++
+	return c.disputes[cluster]
+}
+
+type monitorDeps struct {
+	domainCache    *cache.MockDomainCache
+	frontendClient *frontend.MockClient
+	resolver       *membership.MockResolver
+	peers          *fakePeerCheck
+	timeSource     clock.MockedTimeSource
+}
+
+func setupMonitor(t *testing.T, signal Signal) (*Monitor, *monitorDeps) {
+	ctrl := gomock.NewController(t)
+	deps := &monitorDeps{
+		domainCache:    cache.NewMockDomainCache(ctrl),
+		frontendClient: frontend.NewMockClient(ctrl),
+		resolver:       membership.NewMockResolver(ctrl),
+		peers:          &fakePeerCheck{},
+		timeSource:     clock.NewMockedTimeSource(),
+	}
+	self := membership.NewHostInfo("self")
+	deps.resolver.EXPECT().Lookup(service.Worker, ringKey).Return(self, nil).AnyTimes()
+	deps.resolver.EXPECT().WhoAmI().Return(self, nil).AnyTimes()
+
+	config := &Config{
+		Interval:                dynamicconfig.GetDurationPropertyFn(time.Minute),
+		UnhealthyDuration:       dynamicconfig.GetDurationPropertyFn(5 * time.Minute),
+		Cooldown:                dynamicconfig.GetDurationPropertyFn(time.Hour),
+		GracefulFailoverTimeout: dynamicconfig.GetDurationPropertyFn(2 * time.Minute),
+	}
+	monitor := New(
+		config,
+		[]Signal{signal},
+		deps.peers,
+		cluster.TestActiveClusterMetadata,
+		deps.domainCache,
+		deps.frontendClient,
+		deps.resolver,
+		testlogger.New(t),
+		metrics.NewNoopMetricsClient(),
+		deps.timeSource,
+	)
+	return monitor, deps
+}
+
+func newTestDomainEntry(name string, data map[string]string, activeCluster string) *cache.DomainCacheEntry {
+	return cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: name + "-id", Name: name, Data: data},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: activeCluster,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1,
+	)
+}
+
+func TestEvaluate_FailsOverAfterUnhealthyDuration(t *testing.T) {
+	signal := &fakeSignal{unhealthy: map[string]string{cluster.TestAlternativeClusterName: "cannot reach cluster"}}
+	monitor, deps := setupMonitor(t, signal)
+	optedIn := map[string]string{common.DomainDataKeyForAutoFailoverCluster: cluster.TestCurrentClusterName}
+
+	// first evaluation only records when the cluster became unhealthy
+	monitor.evaluate(context.Background())
+	assert.Equal(t, deps.timeSource.Now(), monitor.unhealthySince[cluster.TestAlternativeClusterName])
+
+	deps.timeSource.Advance(5 * time.Minute)
+	deps.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"opted-in":            newTestDomainEntry("opted-in", optedIn, cluster.TestAlternativeClusterName),
+		"not-opted-in":        newTestDomainEntry("not-opted-in", nil, cluster.TestAlternativeClusterName),
+		"already-active-here": newTestDomainEntry("already-active-here", optedIn, cluster.TestCurrentClusterName),
+		"other-standby": newTestDomainEntry("other-standby", map[string]string{
+			common.DomainDataKeyForAutoFailoverCluster: "other-cluster",
+		}, cluster.TestAlternativeClusterName),
+	})
+	deps.frontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:                     "opted-in",
+		ActiveClusterName:        common.StringPtr(cluster.TestCurrentClusterName),
+		FailoverTimeoutInSeconds: common.Int32Ptr(120),
+	}).Return(&types.UpdateDomainResponse{}, nil)
+	monitor.evaluate(context.Background())
+	assert.Equal(t, deps.timeSource.Now(), monitor.lastFailoverTimes["opted-in"])
+}
+
+func TestEvaluate_RespectsCooldown(t *testing.T) {
+	tests := map[string]struct {
+		lastFailover   time.Duration
+		recordedBySelf bool
+		expectFailover bool
+	}{
+		"recent failover in history": {
+			lastFailover: -10 * time.Minute,
+		},
+		"recent failover by the monitor": {
+			lastFailover:   -10 * time.Minute,
+			recordedBySelf: true,
+		},
+		"cooldown elapsed": {
+			lastFailover:   -2 * time.Hour,
+			expectFailover: true,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			signal := &fakeSignal{unhealthy: map[string]string{cluster.TestAlternativeClusterName: "no history hosts in membership"}}
+			monitor, deps := setupMonitor(t, signal)
+			monitor.unhealthySince[cluster.TestAlternativeClusterName] = deps.timeSource.Now().Add(-time.Hour)
+
+			lastFailoverTime := deps.timeSource.Now().Add(td.lastFailover)
+			data := map[string]string{common.DomainDataKeyForAutoFailoverCluster: cluster.TestCurrentClusterName}
+			if td.recordedBySelf {
+				monitor.lastFailoverTimes["test-domain"] = lastFailoverTime
+			} else {
+				history, err := json.Marshal([]domain.FailoverEvent{{EventTime: lastFailoverTime}})
+				assert.NoError(t, err)
+				data[common.DomainDataKeyForFailoverHistory] = string(history)
+			}
+			deps.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+				"test-domain": newTestDomainEntry("test-domain", data, cluster.TestAlternativeClusterName),
+			})
+			if td.expectFailover {
+				deps.frontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(&types.UpdateDomainResponse{}, nil)
+			}
+			monitor.evaluate(context.Background())
+		})
+	}
+}
+
+func TestEvaluate_DisputedByPeer(t *testing.T) {
+	signal := &fakeSignal{unhealthy: map[string]string{cluster.TestAlternativeClusterName: "cannot reach cluster"}}
+	monitor, deps := setupMonitor(t, signal)
+	monitor.unhealthySince[cluster.TestAlternativeClusterName] = deps.timeSource.Now().Add(-time.Hour)
+	deps.peers.disputes = map[string]string{cluster.TestAlternativeClusterName: "cluster peer saw standby acknowledge replication tasks"}
+	optedIn := map[string]string{common.DomainDataKeyForAutoFailoverCluster: cluster.TestCurrentClusterName}
+
+	deps.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"domain-1": newTestDomainEntry("domain-1", optedIn, cluster.TestAlternativeClusterName),
+		"domain-2": newTestDomainEntry("domain-2", optedIn, cluster.TestAlternativeClusterName),
+	})
+	monitor.evaluate(context.Background())
+	assert.Empty(t, monitor.lastFailoverTimes)
+	assert.Equal(t, 1, deps.peers.calls, "peers are asked once per cluster")
+}
+
+func TestEvaluate_HealthyClusterResetsStreak(t *testing.T) {
+	monitor, deps := setupMonitor(t, &fakeSignal{})
+	monitor.unhealthySince[cluster.TestAlternativeClusterName] = deps.timeSource.Now().Add(-time.Hour)
+
+	monitor.evaluate(context.Background())
+	assert.Empty(t, monitor.unhealthySince)
+}
+
+func TestEvaluate_SignalFailureSkipsEvaluation(t *testing.T) {
+	monitor, deps := setupMonitor(t, &fakeSignal{err: errors.New("invalid replication lag")})
+	since := deps.timeSource.Now().Add(-time.Hour)
+	monitor.unhealthySince[cluster.TestAlternativeClusterName] = since
+
+	monitor.evaluate(context.Background())
+	assert.Equal(t, since, monitor.unhealthySince[cluster.TestAlternativeClusterName])
+}
+
+func TestEvaluate_FailedFailoverIsRetried(t *testing.T) {
+	signal := &fakeSignal{unhealthy: map[string]string{cluster.TestAlternativeClusterName: "cannot reach cluster"}}
+	monitor, deps := setupMonitor(t, signal)
+	monitor.unhealthySince[cluster.TestAlternativeClusterName] = deps.timeSource.Now().Add(-time.Hour)
+	entry := newTestDomainEntry("test-domain", map[string]string{
+		common.DomainDataKeyForAutoFailoverCluster: cluster.TestCurrentClusterName,
+	}, cluster.TestAlternativeClusterName)
+
+	deps.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{"test-domain": entry}).Times(2)
+	deps.frontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, errors.New("frontend unavailable"))
+	monitor.evaluate(context.Background())
+	assert.Empty(t, monitor.lastFailoverTimes)
+
+	deps.frontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(&types.UpdateDomainResponse{}, nil)
+	monitor.evaluate(context.Background())
+	assert.Contains(t, monitor.lastFailoverTimes, "test-domain")
+}
+
+func TestEvaluate_NotOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	resolver := membership.NewMockResolver(ctrl)
+	resolver.EXPECT().Lookup(service.Worker, ringKey).Return(membership.NewHostInfo("other"), nil)
+	resolver.EXPECT().WhoAmI().Return(membership.NewHostInfo("self"), nil)
+
+	monitor, _ := setupMonitor(t, &fakeSignal{unhealthy: map[string]string{cluster.TestAlternativeClusterName: "unhealthy"}})
+	monitor.membershipResolver = resolver
+	monitor.evaluate(context.Background())
+	assert.Empty(t, monitor.unhealthySince)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package autofailover
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// PeerCheck asks the other remote clusters for a second opinion on a cluster found unhealthy,
+	// since the signals only judge it from the current cluster.
+	// The reason is human readable and empty unless a peer disputes that the cluster is unhealthy.
+	PeerCheck interface {
+		Disputed(ctx context.Context, cluster string, unhealthySince time.Time) string
+	}
+
+	replicationPeerCheck struct {
+		clientBean      client.Bean
+		clusterMetadata cluster.Metadata
+	}
+)
+
+// NewPeerCheck creates a PeerCheck disputing an unhealthy cluster while another remote cluster has seen it
+// acknowledge replication tasks since it was found unhealthy. Peers that can't be reached, or had no tasks
+// acknowledged by the cluster in the meantime, give no opinion.
+func NewPeerCheck(clientBean client.Bean, clusterMetadata cluster.Metadata) PeerCheck {
+	return &replicationPeerCheck{
+		clientBean:      clientBean,
+		clusterMetadata: clusterMetadata,
+	}
+}
+
+func (c *replicationPeerCheck) Disputed(ctx context.Context, cluster string, unhealthySince time.Time) string {
+	var peers []string
+	for peer := range c.clusterMetadata.GetRemoteClusterInfo() {
+		if peer != cluster {
+			peers = append(peers, peer)
+		}
+	}
+	sort.Strings(peers)
+
+	for _, peer := range peers {
+		resp, err := c.clientBean.GetRemoteAdminClient(peer).GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+			Clusters: []string{cluster},
+		})
+		if err != nil || resp == nil {
+			continue
+		}
+		for _, shard := range resp.Shards {
+			if shard == nil || shard.RemoteCluster != cluster || shard.LastReplicatedTimestamp == nil {
+				continue
+			}
+			if lastReplicated := time.Unix(0, *shard.LastReplicatedTimestamp); lastReplicated.After(unhealthySince) {
+				return fmt.Sprintf("cluster %v saw %v acknowledge replication tasks at %v", peer, cluster, lastReplicated)
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package autofailover
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestPeerCheck(t *testing.T) {
+	unhealthySince := time.Unix(1000, 0)
+	tests := map[string]struct {
+		resp     *types.GetReplicationStatusResponse
+		err      error
+		expected string
+	}{
+		"peer saw replication acks since the cluster became unhealthy": {
+			resp: &types.GetReplicationStatusResponse{Shards: []*types.ReplicationShardStatus{
+				{ShardID: 1, RemoteCluster: "active", LastReplicatedTimestamp: common.Int64Ptr(unhealthySince.Add(-time.Minute).UnixNano())},
+				{ShardID: 2, RemoteCluster: "active", LastReplicatedTimestamp: common.Int64Ptr(unhealthySince.Add(time.Minute).UnixNano())},
+			}},
+			expected: "cluster peer saw active acknowledge replication tasks at " + unhealthySince.Add(time.Minute).String(),
+		},
+		"peer saw no replication acks since": {
+			resp: &types.GetReplicationStatusResponse{Shards: []*types.ReplicationShardStatus{
+				{ShardID: 1, RemoteCluster: "active", LastReplicatedTimestamp: common.Int64Ptr(unhealthySince.Add(-time.Minute).UnixNano())},
+				{ShardID: 2, RemoteCluster: "active"},
+			}},
+		},
+		"unreachable peer gives no opinion": {
+			err: errors.New("connection refused"),
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			clientBean := client.NewMockBean(ctrl)
+			adminClient := admin.NewMockClient(ctrl)
+			clientBean.EXPECT().GetRemoteAdminClient("peer").Return(adminClient)
+			adminClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{
+				Clusters: []string{"active"},
+			}).Return(td.resp, td.err)
+
+			clusterMetadata := cluster.NewMetadata(
+				10,
+				"current",
+				"current",
+				map[string]config.ClusterInformation{
+					"current": {Enabled: true, InitialFailoverVersion: 0},
+					"active":  {Enabled: true, InitialFailoverVersion: 1},
+					"peer":    {Enabled: true, InitialFailoverVersion: 2},
+				},
+				func(string) bool { return false },
+				metrics.NewNoopMetricsClient(),
+				testlogger.New(t),
+			)
+			reason := NewPeerCheck(clientBean, clusterMetadata).Disputed(context.Background(), "active", unhealthySince)
+			assert.Equal(t, td.expected, reason)
+		})
+	}
+}
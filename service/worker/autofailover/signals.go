@@ -0,0 +1,173 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package autofailover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Signal reports whether a remote cluster looks unhealthy.
+	// The reason is human readable and empty when the cluster looks healthy, an error means the signal could not be evaluated.
+	Signal interface {
+		Name() string
+		Unhealthy(ctx context.Context, cluster string) (string, error)
+	}
+
+	reachabilitySignal struct {
+		clientBean client.Bean
+	}
+
+	historyAvailabilitySignal struct {
+		clientBean client.Bean
+	}
+
+	frontendAvailabilitySignal struct {
+		clientBean client.Bean
+	}
+
+	replicationLagSignal struct {
+		clientBean     client.Bean
+		currentCluster string
+		maxLag         dynamicconfig.DurationPropertyFn
+	}
+)
+
+// NewSignals creates the reachability, history and frontend availability, and replication lag signals
+func NewSignals(config *Config, clientBean client.Bean, currentCluster string) []Signal {
+	return []Signal{
+		NewReachabilitySignal(clientBean),
+		NewHistoryAvailabilitySignal(clientBean),
+		NewFrontendAvailabilitySignal(clientBean),
+		NewReplicationLagSignal(clientBean, currentCluster, config.MaxReplicationLag),
+	}
+}
+
+// NewReachabilitySignal creates a Signal reporting remote clusters whose admin endpoint cannot be reached
+func NewReachabilitySignal(clientBean client.Bean) Signal {
+	return &reachabilitySignal{
+		clientBean: clientBean,
+	}
+}
+
+// NewHistoryAvailabilitySignal creates a Signal reporting remote clusters without any history host in their membership ring
+func NewHistoryAvailabilitySignal(clientBean client.Bean) Signal {
+	return &historyAvailabilitySignal{
+		clientBean: clientBean,
+	}
+}
+
+// NewFrontendAvailabilitySignal creates a Signal reporting remote clusters whose frontend fails to serve requests
+func NewFrontendAvailabilitySignal(clientBean client.Bean) Signal {
+	return &frontendAvailabilitySignal{
+		clientBean: clientBean,
+	}
+}
+
+// NewReplicationLagSignal creates a Signal reporting remote clusters whose oldest replication task not yet
+// received by the current cluster is older than the max lag, as reported by the replication status of the remote cluster
+func NewReplicationLagSignal(
+	clientBean client.Bean,
+	currentCluster string,
+	maxLag dynamicconfig.DurationPropertyFn,
+) Signal {
+	return &replicationLagSignal{
+		clientBean:     clientBean,
+		currentCluster: currentCluster,
+		maxLag:         maxLag,
+	}
+}
+
+func (s *reachabilitySignal) Name() string {
+	return "reachability"
+}
+
+func (s *reachabilitySignal) Unhealthy(ctx context.Context, cluster string) (string, error) {
+	if _, err := s.clientBean.GetRemoteAdminClient(cluster).DescribeCluster(ctx); err != nil {
+		return fmt.Sprintf("cannot reach cluster: %v", err), nil
+	}
+	return "", nil
+}
+
+func (s *historyAvailabilitySignal) Name() string {
+	return "history-availability"
+}
+
+func (s *historyAvailabilitySignal) Unhealthy(ctx context.Context, cluster string) (string, error) {
+	resp, err := s.clientBean.GetRemoteAdminClient(cluster).DescribeCluster(ctx)
+	if err != nil {
+		// an unreachable cluster is reported by the reachability signal
+		return "", nil
+	}
+	if resp.MembershipInfo != nil {
+		for _, ring := range resp.MembershipInfo.Rings {
+			if ring != nil && ring.Role == service.History && ring.MemberCount > 0 {
+				return "", nil
+			}
+		}
+	}
+	return "no history hosts in membership", nil
+}
+
+func (s *frontendAvailabilitySignal) Name() string {
+	return "frontend-availability"
+}
+
+func (s *frontendAvailabilitySignal) Unhealthy(ctx context.Context, cluster string) (string, error) {
+	if _, err := s.clientBean.GetRemoteFrontendClient(cluster).GetClusterInfo(ctx); err != nil {
+		return fmt.Sprintf("frontend failed to serve request: %v", err), nil
+	}
+	return "", nil
+}
+
+func (s *replicationLagSignal) Name() string {
+	return "replication-lag"
+}
+
+func (s *replicationLagSignal) Unhealthy(ctx context.Context, cluster string) (string, error) {
+	resp, err := s.clientBean.GetRemoteAdminClient(cluster).GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+		Clusters: []string{s.currentCluster},
+	})
+	if err != nil {
+		// an unreachable cluster is reported by the reachability signal
+		return "", nil
+	}
+	if resp == nil {
+		return "", nil
+	}
+	for _, status := range resp.Clusters {
+		if status == nil || status.RemoteCluster != s.currentCluster {
+			continue
+		}
+		lag := time.Duration(status.OldestPendingTaskAgeMs) * time.Millisecond
+		if maxLag := s.maxLag(); lag > maxLag {
+			return fmt.Sprintf("replication lag %v is above %v", lag, maxLag), nil
+		}
+	}
+	return "", nil
+}
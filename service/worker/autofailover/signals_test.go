@@ -0,0 +1,157 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package autofailover
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestReachabilitySignal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clientBean := client.NewMockBean(ctrl)
+	adminClient := admin.NewMockClient(ctrl)
+	clientBean.EXPECT().GetRemoteAdminClient("standby").Return(adminClient).AnyTimes()
+	signal := NewReachabilitySignal(clientBean)
+
+	adminClient.EXPECT().DescribeCluster(gomock.Any()).Return(&types.DescribeClusterResponse{}, nil)
+	reason, err := signal.Unhealthy(context.Background(), "standby")
+	assert.NoError(t, err)
+	assert.Empty(t, reason)
+
+	adminClient.EXPECT().DescribeCluster(gomock.Any()).Return(nil, errors.New("connection refused"))
+	reason, err = signal.Unhealthy(context.Background(), "standby")
+	assert.NoError(t, err)
+	assert.Equal(t, "cannot reach cluster: connection refused", reason)
+}
+
+func TestHistoryAvailabilitySignal(t *testing.T) {
+	tests := map[string]struct {
+		resp     *types.DescribeClusterResponse
+		err      error
+		expected string
+	}{
+		"history hosts available": {
+			resp: &types.DescribeClusterResponse{MembershipInfo: &types.MembershipInfo{
+				Rings: []*types.RingInfo{{Role: service.History, MemberCount: 3}},
+			}},
+		},
+		"no history hosts": {
+			resp: &types.DescribeClusterResponse{MembershipInfo: &types.MembershipInfo{
+				Rings: []*types.RingInfo{
+					{Role: service.Frontend, MemberCount: 3},
+					{Role: service.History, MemberCount: 0},
+				},
+			}},
+			expected: "no history hosts in membership",
+		},
+		"unreachable cluster is left to the reachability signal": {
+			err: errors.New("connection refused"),
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			clientBean := client.NewMockBean(ctrl)
+			adminClient := admin.NewMockClient(ctrl)
+			clientBean.EXPECT().GetRemoteAdminClient("standby").Return(adminClient)
+			adminClient.EXPECT().DescribeCluster(gomock.Any()).Return(td.resp, td.err)
+
+			reason, err := NewHistoryAvailabilitySignal(clientBean).Unhealthy(context.Background(), "standby")
+			assert.NoError(t, err)
+			assert.Equal(t, td.expected, reason)
+		})
+	}
+}
+
+func TestFrontendAvailabilitySignal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clientBean := client.NewMockBean(ctrl)
+	frontendClient := frontend.NewMockClient(ctrl)
+	clientBean.EXPECT().GetRemoteFrontendClient("standby").Return(frontendClient).AnyTimes()
+	signal := NewFrontendAvailabilitySignal(clientBean)
+
+	frontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{}, nil)
+	reason, err := signal.Unhealthy(context.Background(), "standby")
+	assert.NoError(t, err)
+	assert.Empty(t, reason)
+
+	frontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(nil, &types.InternalServiceError{Message: "overloaded"})
+	reason, err = signal.Unhealthy(context.Background(), "standby")
+	assert.NoError(t, err)
+	assert.Equal(t, "frontend failed to serve request: overloaded", reason)
+}
+
+func TestReplicationLagSignal(t *testing.T) {
+	tests := map[string]struct {
+		resp     *types.GetReplicationStatusResponse
+		err      error
+		expected string
+	}{
+		"lag above max": {
+			resp: &types.GetReplicationStatusResponse{Clusters: []*types.ReplicationClusterStatus{
+				{RemoteCluster: "current", OldestPendingTaskAgeMs: (15 * time.Minute).Milliseconds()},
+			}},
+			expected: "replication lag 15m0s is above 10m0s",
+		},
+		"lag below max": {
+			resp: &types.GetReplicationStatusResponse{Clusters: []*types.ReplicationClusterStatus{
+				{RemoteCluster: "current", OldestPendingTaskAgeMs: (30 * time.Second).Milliseconds()},
+			}},
+		},
+		"no status for the current cluster": {
+			resp: &types.GetReplicationStatusResponse{},
+		},
+		"unreachable cluster is left to the reachability signal": {
+			err: errors.New("connection refused"),
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			clientBean := client.NewMockBean(ctrl)
+			adminClient := admin.NewMockClient(ctrl)
+			clientBean.EXPECT().GetRemoteAdminClient("standby").Return(adminClient)
+			adminClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{
+				Clusters: []string{"current"},
+			}).Return(td.resp, td.err)
+
+			signal := NewReplicationLagSignal(clientBean, "current", dynamicconfig.GetDurationPropertyFn(10*time.Minute))
+			reason, err := signal.Unhealthy(context.Background(), "standby")
+			assert.NoError(t, err)
+			assert.Equal(t, td.expected, reason)
+		})
+	}
+}
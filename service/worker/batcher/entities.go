@@ -56,6 +56,32 @@ type ReplicateParams struct {
 	TargetCluster string
 }
 
+// ResetParams is the parameters for resetting workflow
+type ResetParams struct {
+	// ResetType is the point to reset to, must be one of AllResetTypes
+	ResetType string
+	// DecisionOffset is only for ResetTypeLastDecisionCompleted and ResetTypeLastDecisionScheduled.
+	// It must be zero or negative, e.g. -1 means the decision before the last one. Default to 0.
+	DecisionOffset int
+	// BadBinaryChecksum is required for ResetTypeBadBinary
+	BadBinaryChecksum string
+	// EarliestTime is required for ResetTypeDecisionCompletedTime, in unix nanoseconds
+	EarliestTime int64
+	// SkipSignalReapply indicates whether to skip reapplying signals received after the reset point
+	SkipSignalReapply bool
+	// SkipCurrentOpen skips the workflow if the current run is open
+	SkipCurrentOpen bool
+	// SkipCurrentCompleted skips the workflow if the current run is completed
+	SkipCurrentCompleted bool
+}
+
+// DeleteParams is the parameters for deleting workflow
+type DeleteParams struct {
+	// SkipErrors indicates whether to continue deleting the remaining records of a workflow
+	// when one of them fails to be deleted
+	SkipErrors bool
+}
+
 // BatchParams is the parameters for batch operation workflow
 type BatchParams struct {
 	// Target domain to execute batch operation
@@ -64,7 +90,7 @@ type BatchParams struct {
 	Query string
 	// Reason for the operation
 	Reason string
-	// Supporting: reset,terminate
+	// Supporting: AllBatchTypes
 	BatchType string
 
 	// Below are all optional
@@ -76,6 +102,10 @@ type BatchParams struct {
 	SignalParams SignalParams
 	// ReplicateParams is params only for BatchTypeReplicate
 	ReplicateParams ReplicateParams
+	// ResetParams is params only for BatchTypeReset
+	ResetParams ResetParams
+	// DeleteParams is params only for BatchTypeDelete
+	DeleteParams DeleteParams
 	// RPS of processing. Default to DefaultRPS
 	// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 	RPS int
@@ -0,0 +1,370 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ResetTypeFirstDecisionCompleted resets to the first DecisionTaskCompleted event
+	ResetTypeFirstDecisionCompleted = "FirstDecisionCompleted"
+	// ResetTypeLastDecisionCompleted resets to the last DecisionTaskCompleted event, shifted by DecisionOffset
+	ResetTypeLastDecisionCompleted = "LastDecisionCompleted"
+	// ResetTypeLastContinuedAsNew resets to the last DecisionTaskCompleted event of the previous run
+	ResetTypeLastContinuedAsNew = "LastContinuedAsNew"
+	// ResetTypeBadBinary resets to the first DecisionTaskCompleted event processed by the bad binary
+	ResetTypeBadBinary = "BadBinary"
+	// ResetTypeDecisionCompletedTime resets to the first DecisionTaskCompleted event after EarliestTime
+	ResetTypeDecisionCompletedTime = "DecisionCompletedTime"
+	// ResetTypeFirstDecisionScheduled resets to the first DecisionTaskScheduled event
+	ResetTypeFirstDecisionScheduled = "FirstDecisionScheduled"
+	// ResetTypeLastDecisionScheduled resets to the last DecisionTaskScheduled event, shifted by DecisionOffset
+	ResetTypeLastDecisionScheduled = "LastDecisionScheduled"
+
+	resetHistoryPageSize = 1000
+)
+
+// AllResetTypes is the reset types we supported
+var AllResetTypes = []string{
+	ResetTypeFirstDecisionCompleted,
+	ResetTypeLastDecisionCompleted,
+	ResetTypeLastContinuedAsNew,
+	ResetTypeBadBinary,
+	ResetTypeDecisionCompletedTime,
+	ResetTypeFirstDecisionScheduled,
+	ResetTypeLastDecisionScheduled,
+}
+
+func validateResetParams(params ResetParams) error {
+	switch params.ResetType {
+	case ResetTypeBadBinary:
+		if params.BadBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum")
+		}
+	case ResetTypeDecisionCompletedTime:
+		if params.EarliestTime <= 0 {
+			return fmt.Errorf("must provide earliest time")
+		}
+	case ResetTypeLastDecisionCompleted, ResetTypeLastDecisionScheduled:
+		if params.DecisionOffset > 0 {
+			return fmt.Errorf("only decision offset <= 0 is supported")
+		}
+	case ResetTypeFirstDecisionCompleted, ResetTypeLastContinuedAsNew, ResetTypeFirstDecisionScheduled:
+	default:
+		return fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+	return nil
+}
+
+// resetWorkflow resets a single workflow according to the reset params,
+// heartbeat is called between history pages to keep the activity alive when reading long histories.
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchID string,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	heartbeat func(),
+) error {
+	params := batchParams.ResetParams
+	requestID := resetRequestID(batchID, workflowID, runID)
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: batchParams.DomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	currentInfo := resp.GetWorkflowExecutionInfo()
+	if currentInfo == nil {
+		return &types.InternalServiceError{Message: "missing workflow execution info"}
+	}
+	isOpen := currentInfo.CloseStatus == nil
+	if isOpen && params.SkipCurrentOpen {
+		return nil
+	}
+	if !isOpen && currentInfo.GetCloseStatus() == types.WorkflowExecutionCloseStatusCompleted && params.SkipCurrentCompleted {
+		return nil
+	}
+	if runID == "" {
+		runID = currentInfo.GetExecution().GetRunID()
+	}
+
+	resetBaseRunID, decisionFinishID, err := getResetEventID(ctx, client, batchParams.DomainName, workflowID, runID, params, heartbeat)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+		Domain: batchParams.DomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      resetBaseRunID,
+		},
+		Reason:                batchParams.Reason,
+		DecisionFinishEventID: decisionFinishID,
+		RequestID:             requestID,
+		SkipSignalReapply:     params.SkipSignalReapply,
+	})
+	return err
+}
+
+// resetRequestID derives the reset request ID from the batch and the workflow being reset,
+// so a retried reset is deduplicated by history instead of resetting the workflow again.
+func resetRequestID(batchID, workflowID, runID string) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(batchID+"/"+workflowID+"/"+runID)).String()
+}
+
+func getResetEventID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	params ResetParams,
+	heartbeat func(),
+) (resetBaseRunID string, decisionFinishID int64, err error) {
+	// default to the same runID
+	resetBaseRunID = runID
+
+	switch params.ResetType {
+	case ResetTypeFirstDecisionCompleted:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, heartbeat, firstEventOfType(types.EventTypeDecisionTaskCompleted))
+	case ResetTypeLastDecisionCompleted:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, heartbeat, lastEventOfType(types.EventTypeDecisionTaskCompleted, params.DecisionOffset))
+	case ResetTypeLastContinuedAsNew:
+		// this reset type changes the base runID to the previous run
+		resetBaseRunID, err = getContinuedExecutionRunID(ctx, client, domain, workflowID, runID)
+		if err != nil {
+			return "", 0, err
+		}
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, resetBaseRunID, heartbeat, lastEventOfType(types.EventTypeDecisionTaskCompleted, 0))
+	case ResetTypeBadBinary:
+		decisionFinishID, err = getBadBinaryDecisionCompletedID(ctx, client, domain, workflowID, runID, params.BadBinaryChecksum)
+	case ResetTypeDecisionCompletedTime:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, heartbeat, firstDecisionCompletedAfter(params.EarliestTime))
+	case ResetTypeFirstDecisionScheduled:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, heartbeat, firstEventOfType(types.EventTypeDecisionTaskScheduled))
+		// decisionFinishID is exclusive in reset API
+		decisionFinishID++
+	case ResetTypeLastDecisionScheduled:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, heartbeat, lastEventOfType(types.EventTypeDecisionTaskScheduled, params.DecisionOffset))
+		// decisionFinishID is exclusive in reset API
+		decisionFinishID++
+	default:
+		return "", 0, fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	return resetBaseRunID, decisionFinishID, nil
+}
+
+// eventMatcher is fed with history events in order and returns true once the reset event is found.
+// result returns the found event ID, or zero if nothing matches.
+type eventMatcher interface {
+	match(event *types.HistoryEvent) (done bool)
+	result() int64
+}
+
+type firstEventMatcher struct {
+	matchFn func(event *types.HistoryEvent) bool
+	eventID int64
+}
+
+func (m *firstEventMatcher) match(event *types.HistoryEvent) bool {
+	if m.matchFn(event) {
+		m.eventID = event.ID
+		return true
+	}
+	return false
+}
+
+func (m *firstEventMatcher) result() int64 {
+	return m.eventID
+}
+
+func firstEventOfType(eventType types.EventType) eventMatcher {
+	return &firstEventMatcher{
+		matchFn: func(event *types.HistoryEvent) bool {
+			return event.GetEventType() == eventType
+		},
+	}
+}
+
+func firstDecisionCompletedAfter(earliestTime int64) eventMatcher {
+	return &firstEventMatcher{
+		matchFn: func(event *types.HistoryEvent) bool {
+			return event.GetEventType() == types.EventTypeDecisionTaskCompleted && event.GetTimestamp() >= earliestTime
+		},
+	}
+}
+
+type lastEventMatcher struct {
+	eventType types.EventType
+	size      int
+	// remembers the last size event IDs, so the offset event is the first one
+	eventIDs []int64
+}
+
+func (m *lastEventMatcher) match(event *types.HistoryEvent) bool {
+	if event.GetEventType() == m.eventType {
+		m.eventIDs = append(m.eventIDs, event.ID)
+		if len(m.eventIDs) > m.size {
+			m.eventIDs = m.eventIDs[1:]
+		}
+	}
+	return false
+}
+
+func (m *lastEventMatcher) result() int64 {
+	if len(m.eventIDs) == 0 {
+		return 0
+	}
+	return m.eventIDs[0]
+}
+
+func lastEventOfType(eventType types.EventType, decisionOffset int) eventMatcher {
+	return &lastEventMatcher{
+		eventType: eventType,
+		size:      -decisionOffset + 1,
+	}
+}
+
+func findDecisionEventID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	heartbeat func(),
+	matcher eventMatcher,
+) (int64, error) {
+	req := &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: resetHistoryPageSize,
+	}
+
+Loop:
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range resp.GetHistory().GetEvents() {
+			if matcher.match(event) {
+				break Loop
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+		heartbeat()
+	}
+
+	decisionFinishID := matcher.result()
+	if decisionFinishID == 0 {
+		return 0, &types.BadRequestError{Message: "no reset point found for the reset type"}
+	}
+	return decisionFinishID, nil
+}
+
+func getContinuedExecutionRunID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+) (string, error) {
+	resp, err := client.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return "", &types.BadRequestError{Message: "workflow history is empty"}
+	}
+	continuedRunID := events[0].GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunID()
+	if continuedRunID == "" {
+		return "", &types.BadRequestError{Message: "workflow is not continued from a previous run"}
+	}
+	return continuedRunID, nil
+}
+
+func getBadBinaryDecisionCompletedID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	binaryChecksum string,
+) (int64, error) {
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	info := resp.GetWorkflowExecutionInfo()
+	if info == nil || info.AutoResetPoints == nil {
+		return 0, &types.BadRequestError{Message: "no reset point found for the bad binary"}
+	}
+	now := time.Now().UnixNano()
+	for _, p := range info.AutoResetPoints.Points {
+		if p.GetBinaryChecksum() != binaryChecksum || !p.GetResettable() {
+			continue
+		}
+		if p.GetExpiringTimeNano() > 0 && now > p.GetExpiringTimeNano() {
+			// reset point has expired and the history may already be deleted
+			continue
+		}
+		return p.GetFirstDecisionCompletedID(), nil
+	}
+	return 0, &types.BadRequestError{Message: "no reset point found for the bad binary"}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidateResetParams(t *testing.T) {
+	tests := map[string]struct {
+		params  ResetParams
+		wantErr string
+	}{
+		"unsupported reset type": {
+			params:  ResetParams{ResetType: "invalid"},
+			wantErr: "not supported reset type",
+		},
+		"bad binary without checksum": {
+			params:  ResetParams{ResetType: ResetTypeBadBinary},
+			wantErr: "must provide bad binary checksum",
+		},
+		"decision completed time without earliest time": {
+			params:  ResetParams{ResetType: ResetTypeDecisionCompletedTime},
+			wantErr: "must provide earliest time",
+		},
+		"positive decision offset": {
+			params:  ResetParams{ResetType: ResetTypeLastDecisionCompleted, DecisionOffset: 1},
+			wantErr: "only decision offset <= 0 is supported",
+		},
+		"valid": {
+			params: ResetParams{ResetType: ResetTypeFirstDecisionCompleted},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateResetParams(tt.params)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResetRequestID(t *testing.T) {
+	requestID := resetRequestID("batch-id", "workflow-id", "run-id")
+	assert.Equal(t, requestID, resetRequestID("batch-id", "workflow-id", "run-id"), "retries should reuse the request ID")
+	assert.NotEqual(t, requestID, resetRequestID("batch-id", "workflow-id", "other-run-id"))
+	assert.NotEqual(t, requestID, resetRequestID("other-batch-id", "workflow-id", "run-id"))
+}
+
+func TestGetResetEventID(t *testing.T) {
+	history := &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr(), WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{ContinuedExecutionRunID: "prev-rid"}},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+			{ID: 3, EventType: types.EventTypeDecisionTaskStarted.Ptr()},
+			{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr(), Timestamp: common.Int64Ptr(100)},
+			{ID: 5, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+			{ID: 6, EventType: types.EventTypeDecisionTaskStarted.Ptr()},
+			{ID: 7, EventType: types.EventTypeDecisionTaskCompleted.Ptr(), Timestamp: common.Int64Ptr(200)},
+		}},
+	}
+
+	tests := map[string]struct {
+		params         ResetParams
+		mockFn         func(client *frontend.MockClient)
+		wantBaseRunID  string
+		wantDecisionID int64
+		wantErr        bool
+	}{
+		"first decision completed": {
+			params: ResetParams{ResetType: ResetTypeFirstDecisionCompleted},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil)
+			},
+			wantBaseRunID:  "rid",
+			wantDecisionID: 4,
+		},
+		"last decision completed": {
+			params: ResetParams{ResetType: ResetTypeLastDecisionCompleted},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil)
+			},
+			wantBaseRunID:  "rid",
+			wantDecisionID: 7,
+		},
+		"last decision completed with offset": {
+			params: ResetParams{ResetType: ResetTypeLastDecisionCompleted, DecisionOffset: -1},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil)
+			},
+			wantBaseRunID:  "rid",
+			wantDecisionID: 4,
+		},
+		"first decision scheduled": {
+			params: ResetParams{ResetType: ResetTypeFirstDecisionScheduled},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil)
+			},
+			wantBaseRunID:  "rid",
+			wantDecisionID: 3,
+		},
+		"last decision scheduled": {
+			params: ResetParams{ResetType: ResetTypeLastDecisionScheduled},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil)
+			},
+			wantBaseRunID:  "rid",
+			wantDecisionID: 6,
+		},
+		"decision completed time": {
+			params: ResetParams{ResetType: ResetTypeDecisionCompletedTime, EarliestTime: 150},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil)
+			},
+			wantBaseRunID:  "rid",
+			wantDecisionID: 7,
+		},
+		"decision completed time not found": {
+			params: ResetParams{ResetType: ResetTypeDecisionCompletedTime, EarliestTime: 300},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil)
+			},
+			wantErr: true,
+		},
+		"last continued as new": {
+			params: ResetParams{ResetType: ResetTypeLastContinuedAsNew},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(2)
+			},
+			wantBaseRunID:  "prev-rid",
+			wantDecisionID: 7,
+		},
+		"bad binary": {
+			params: ResetParams{ResetType: ResetTypeBadBinary, BadBinaryChecksum: "bad-checksum"},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+						AutoResetPoints: &types.ResetPoints{Points: []*types.ResetPointInfo{
+							{BinaryChecksum: "good-checksum", FirstDecisionCompletedID: 4, Resettable: true},
+							{BinaryChecksum: "bad-checksum", FirstDecisionCompletedID: 7, Resettable: true, ExpiringTimeNano: common.Int64Ptr(time.Now().Add(time.Hour).UnixNano())},
+						}},
+					},
+				}, nil)
+			},
+			wantBaseRunID:  "rid",
+			wantDecisionID: 7,
+		},
+		"bad binary not found": {
+			params: ResetParams{ResetType: ResetTypeBadBinary, BadBinaryChecksum: "bad-checksum"},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		"get history error": {
+			params: ResetParams{ResetType: ResetTypeFirstDecisionCompleted},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := frontend.NewMockClient(gomock.NewController(t))
+			tt.mockFn(client)
+
+			baseRunID, decisionID, err := getResetEventID(context.Background(), client, "domain", "wid", "rid", tt.params, func() {})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBaseRunID, baseRunID)
+			assert.Equal(t, tt.wantDecisionID, decisionID)
+		})
+	}
+}
@@ -79,10 +79,14 @@ const (
 	BatchTypeSignal = "signal"
 	// BatchTypeReplicate is batch type for replicating workflows
 	BatchTypeReplicate = "replicate"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting workflows
+	BatchTypeDelete = "delete"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypeReset, BatchTypeDelete}
 
 var (
 	BatchActivityRetryPolicy = cadence.RetryPolicy{
@@ -141,9 +145,9 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeReset:
+		return validateResetParams(params.ResetParams)
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -193,6 +197,9 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		}
 		adminClient = batcher.clientBean.GetRemoteAdminClient(batchParams.ReplicateParams.TargetCluster)
 	}
+	if batchParams.BatchType == BatchTypeDelete {
+		adminClient = batcher.clientBean.GetRemoteAdminClient(batcher.cfg.ClusterMetadata.GetCurrentClusterName())
+	}
 
 	domainResp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{
 		Name: &batchParams.DomainName,
@@ -371,6 +378,18 @@ func startTaskProcessor(
 							RemoteCluster: batchParams.ReplicateParams.SourceCluster,
 						})
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, activity.GetInfo(ctx).WorkflowExecution.ID, batchParams, workflowID, runID, func() {
+							activity.RecordHeartbeat(ctx, task.hbd)
+						})
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return deleteWorkflow(ctx, client, adminClient, batchParams, workflowID, runID)
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -451,6 +470,37 @@ func processTask(
 	return nil
 }
 
+// deleteWorkflow terminates the workflow if it is still running and then deletes it
+func deleteWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	adminClient admin.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) error {
+	execution := &types.WorkflowExecution{
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+	err := client.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+		Domain:            batchParams.DomainName,
+		WorkflowExecution: execution,
+		Reason:            batchParams.Reason,
+		Identity:          BatchWFTypeName,
+	})
+	// EntityNotExistsError means wf is already closed
+	if _, ok := err.(*types.EntityNotExistsError); err != nil && !ok {
+		return err
+	}
+	_, err = adminClient.DeleteWorkflow(ctx, &types.AdminDeleteWorkflowRequest{
+		Domain:     batchParams.DomainName,
+		Execution:  execution,
+		SkipErrors: batchParams.DeleteParams.SkipErrors,
+	})
+	return err
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -71,11 +71,23 @@ func (s *workflowSuite) SetupTest() {
 	}, nil).AnyTimes()
 	mockResource.FrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{Count: 1}, nil).AnyTimes()
 	mockResource.FrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{}, nil).AnyTimes()
+	mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}},
+	}, nil).AnyTimes()
 	mockResource.FrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockResource.FrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+			{ID: 3, EventType: types.EventTypeDecisionTaskStarted.Ptr()},
+			{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+		}},
+	}, nil).AnyTimes()
+	mockResource.FrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.ResetWorkflowExecutionResponse{RunID: "new-rid"}, nil).AnyTimes()
 
 	mockResource.RemoteAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockResource.RemoteAdminClient.EXPECT().DeleteWorkflow(gomock.Any(), gomock.Any()).Return(&types.AdminDeleteWorkflowResponse{}, nil).AnyTimes()
 
 	ctx := context.WithValue(context.Background(), batcherContextKey, batcher)
 	workerOpts := worker.Options{
@@ -120,6 +132,18 @@ func (s *workflowSuite) TestActivity_BatchReplicate() {
 	s.NoError(err)
 }
 
+func (s *workflowSuite) TestActivity_BatchReset() {
+	params := createParams(BatchTypeReset)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_BatchDelete() {
+	params := createParams(BatchTypeDelete)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
 func (s *workflowSuite) TestWorkflow_BatchTypeCancelValidationError() {
 	params := createParams(BatchTypeCancel)
 	params.Query = ""
@@ -159,6 +183,14 @@ func (s *workflowSuite) TestWorkflow_BatchTypeReplicateTargetCLusterValidation()
 	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide target cluster")
 }
 
+func (s *workflowSuite) TestWorkflow_BatchTypeResetValidation() {
+	params := createParams(BatchTypeReset)
+	params.ResetParams.ResetType = ResetTypeBadBinary
+	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide bad binary checksum")
+}
+
 func (s *workflowSuite) TearDownTest() {
 	s.workflowEnv.AssertExpectations(s.T())
 }
@@ -182,6 +214,9 @@ func createParams(batchType string) BatchParams {
 			SourceCluster: "test-primary-cluster",
 			TargetCluster: "test-secondary-cluster",
 		},
+		ResetParams: ResetParams{
+			ResetType: ResetTypeLastDecisionCompleted,
+		},
 		RPS:                      5,
 		Concurrency:              5,
 		PageSize:                 10,
@@ -0,0 +1,134 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// Config defines the configuration for domain deletion
+	Config struct {
+		// DeletionRPS is the rate limit of executions and task lists purged per second
+		DeletionRPS dynamicconfig.IntPropertyFn
+		// EnableDeleteReplication enables replicating the removal of the domain record to other clusters,
+		// older clusters can not decode the domain delete operation
+		EnableDeleteReplication dynamicconfig.BoolPropertyFn
+		// NumReadPartitions and NumWritePartitions are used to find the partitions of the task lists to purge
+		NumReadPartitions  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		NumWritePartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		// TaskPriorityLevels is used to find the backlogs of the task priorities to purge
+		TaskPriorityLevels dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		// ClusterMetadata contains the metadata for this cluster
+		ClusterMetadata cluster.Metadata
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the domain deletion worker
+	BootstrapParams struct {
+		// Config contains the configuration for domain deletion
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+		// DomainManager is used to remove the domain record
+		DomainManager persistence.DomainManager
+		// TaskManager is used to purge the task lists of the domain
+		TaskManager persistence.TaskManager
+		// DomainReplicator is used to replicate the domain deletion to other clusters
+		DomainReplicator domain.Replicator
+	}
+
+	// Deleter is the domain deletion worker of cadence worker service
+	Deleter struct {
+		cfg              Config
+		svcClient        workflowserviceclient.Interface
+		clientBean       client.Bean
+		domainManager    persistence.DomainManager
+		taskManager      persistence.TaskManager
+		domainReplicator domain.Replicator
+		metricsClient    metrics.Client
+		tallyScope       tally.Scope
+		logger           log.Logger
+		worker           worker.Worker
+	}
+)
+
+// New returns a new instance of Deleter
+func New(params *BootstrapParams) *Deleter {
+	return &Deleter{
+		cfg:              params.Config,
+		svcClient:        params.ServiceClient,
+		clientBean:       params.ClientBean,
+		domainManager:    params.DomainManager,
+		taskManager:      params.TaskManager,
+		domainReplicator: params.DomainReplicator,
+		metricsClient:    params.MetricsClient,
+		tallyScope:       params.TallyScope,
+		logger:           params.Logger.WithTags(tag.ComponentDomainDeletion),
+	}
+}
+
+// Start starts the worker
+func (s *Deleter) Start() error {
+	ctx := context.WithValue(context.Background(), domainDeletionContextKey, s)
+	workerOpts := worker.Options{
+		MetricsScope:              s.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	deletionWorker := worker.New(s.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	deletionWorker.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	deletionWorker.RegisterActivityWithOptions(ClearArchivalActivity, activity.RegisterOptions{Name: clearArchivalActivityName})
+	deletionWorker.RegisterActivityWithOptions(PurgeExecutionsActivity, activity.RegisterOptions{Name: purgeExecutionsActivityName})
+	deletionWorker.RegisterActivityWithOptions(PurgeTaskListsActivity, activity.RegisterOptions{Name: purgeTaskListsActivityName})
+	deletionWorker.RegisterActivityWithOptions(DeleteDomainActivity, activity.RegisterOptions{Name: deleteDomainActivityName})
+	s.worker = deletionWorker
+	return deletionWorker.Start()
+}
+
+// Stop stops the worker
+func (s *Deleter) Stop() {
+	s.worker.Stop()
+}
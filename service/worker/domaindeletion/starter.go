@@ -0,0 +1,81 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/types"
+)
+
+type workflowStarter struct {
+	frontendClient frontend.Client
+}
+
+// NewWorkflowStarter returns a starter of the domain deletion workflow in the cluster the frontend client calls
+func NewWorkflowStarter(frontendClient frontend.Client) domain.DeletionWorkflowStarter {
+	return &workflowStarter{
+		frontendClient: frontendClient,
+	}
+}
+
+// StartDeletionWorkflow starts the domain deletion workflow, or returns the run of the workflow
+// if the domain is being purged already
+func (s *workflowStarter) StartDeletionWorkflow(
+	ctx context.Context,
+	domainName string,
+	domainID string,
+) (*types.DeleteDomainResponse, error) {
+	input, err := json.Marshal(Params{
+		DomainName: domainName,
+		DomainID:   domainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	workflowID := WorkflowIDPrefix + domainID
+	resp, err := s.frontendClient.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		WorkflowID:                          workflowID,
+		WorkflowType:                        &types.WorkflowType{Name: WorkflowTypeName},
+		TaskList:                            &types.TaskList{Name: TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(WorkflowExecutionTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(DecisionTaskTimeout.Seconds())),
+		RequestID:                           uuid.New().String(),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+	})
+	if err != nil {
+		var alreadyStartedErr *types.WorkflowExecutionAlreadyStartedError
+		if errors.As(err, &alreadyStartedErr) {
+			return &types.DeleteDomainResponse{WorkflowID: workflowID, RunID: alreadyStartedErr.RunID}, nil
+		}
+		return nil, err
+	}
+	return &types.DeleteDomainResponse{WorkflowID: workflowID, RunID: resp.GetRunID()}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestStartDeletionWorkflow(t *testing.T) {
+	workflowID := WorkflowIDPrefix + testDomainID
+	tests := []struct {
+		name     string
+		startErr error
+		want     *types.DeleteDomainResponse
+		wantErr  error
+	}{
+		{
+			name: "started",
+			want: &types.DeleteDomainResponse{WorkflowID: workflowID, RunID: "run-id"},
+		},
+		{
+			name:     "already started",
+			startErr: &types.WorkflowExecutionAlreadyStartedError{RunID: "running-run-id"},
+			want:     &types.DeleteDomainResponse{WorkflowID: workflowID, RunID: "running-run-id"},
+		},
+		{
+			name:     "start failure",
+			startErr: errors.New("start failed"),
+			wantErr:  errors.New("start failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := frontend.NewMockClient(gomock.NewController(t))
+			client.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+					assert.Equal(t, common.SystemLocalDomainName, req.Domain)
+					assert.Equal(t, workflowID, req.WorkflowID)
+					assert.Equal(t, WorkflowTypeName, req.WorkflowType.GetName())
+					assert.Equal(t, TaskListName, req.TaskList.GetName())
+					var params Params
+					assert.NoError(t, json.Unmarshal(req.Input, &params))
+					assert.Equal(t, Params{DomainName: testDomainName, DomainID: testDomainID}, params)
+					if tt.startErr != nil {
+						return nil, tt.startErr
+					}
+					return &types.StartWorkflowExecutionResponse{RunID: "run-id"}, nil
+				})
+
+			resp, err := NewWorkflowStarter(client).StartDeletionWorkflow(context.Background(), testDomainName, testDomainID)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
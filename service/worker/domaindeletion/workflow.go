@@ -0,0 +1,641 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	domainDeletionContextKey contextKey = "domainDeletionContext"
+	// TaskListName is the tasklist name
+	TaskListName = "cadence-sys-domain-deletion-tasklist"
+	// WorkflowTypeName is the workflow type name
+	WorkflowTypeName = "cadence-sys-domain-deletion-workflow"
+	// WorkflowIDPrefix is the prefix of the workflow ID, the domain ID is appended to ensure
+	// only one deletion workflow is running per domain
+	WorkflowIDPrefix = "cadence-sys-domain-deletion-"
+	// WorkflowExecutionTimeout is long enough to purge large domains with a low rate limit
+	WorkflowExecutionTimeout = 30 * 24 * time.Hour
+	// DecisionTaskTimeout is the decision task timeout of the workflow
+	DecisionTaskTimeout = 10 * time.Second
+
+	clearArchivalActivityName   = "cadence-sys-domain-deletion-clear-archival-activity"
+	purgeExecutionsActivityName = "cadence-sys-domain-deletion-purge-executions-activity"
+	purgeTaskListsActivityName  = "cadence-sys-domain-deletion-purge-tasklists-activity"
+	deleteDomainActivityName    = "cadence-sys-domain-deletion-delete-domain-activity"
+
+	listPageSize    = 100
+	historyPageSize = 1000
+	taskBatchSize   = 1000
+	terminateReason = "domain is deleted"
+
+	errMsgDomainNameIsEmpty = "domain name is empty"
+	errMsgDomainIDIsEmpty   = "domain ID is empty"
+	errMsgDomainNotDeleted  = "domain is not marked as deleted"
+)
+
+type (
+	// Params is the arg for the domain deletion workflow
+	Params struct {
+		DomainName string
+		DomainID   string
+	}
+
+	// Result is the result of the domain deletion workflow
+	Result struct {
+		ExecutionsDeleted int
+		ExecutionsFailed  int
+		TaskListsDeleted  int
+	}
+
+	// PurgeExecutionsResult is the result of the purge executions activity
+	PurgeExecutionsResult struct {
+		ExecutionsDeleted int
+		ExecutionsFailed  int
+		// TaskLists are the decision, sticky and activity task lists seen in the history of the purged executions
+		TaskLists []string
+	}
+
+	// PurgeTaskListsParams is the arg for the purge task lists activity
+	PurgeTaskListsParams struct {
+		DomainName string
+		DomainID   string
+		TaskLists  []string
+	}
+
+	purgeExecutionsHeartbeat struct {
+		ClosedExecutions bool
+		PageToken        []byte
+		Result           PurgeExecutionsResult
+	}
+
+	purgeTaskListsHeartbeat struct {
+		NextIndex        int
+		TaskListsDeleted int
+	}
+)
+
+// Workflow purges all the data of a deleted domain and then removes the domain record.
+// Every step is idempotent, so the workflow can be restarted for a domain at any time.
+func Workflow(ctx workflow.Context, params Params) (*Result, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+	ctx = workflow.WithActivityOptions(ctx, getActivityOptions())
+
+	if err := workflow.ExecuteActivity(ctx, ClearArchivalActivity, params).Get(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	var executionsResult PurgeExecutionsResult
+	if err := workflow.ExecuteActivity(ctx, PurgeExecutionsActivity, params).Get(ctx, &executionsResult); err != nil {
+		return nil, err
+	}
+
+	taskListsParams := PurgeTaskListsParams{
+		DomainName: params.DomainName,
+		DomainID:   params.DomainID,
+		TaskLists:  executionsResult.TaskLists,
+	}
+	var taskListsDeleted int
+	if err := workflow.ExecuteActivity(ctx, PurgeTaskListsActivity, taskListsParams).Get(ctx, &taskListsDeleted); err != nil {
+		return nil, err
+	}
+
+	if err := workflow.ExecuteActivity(ctx, DeleteDomainActivity, params).Get(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	workflow.GetLogger(ctx).Info("domain deletion completed")
+	return &Result{
+		ExecutionsDeleted: executionsResult.ExecutionsDeleted,
+		ExecutionsFailed:  executionsResult.ExecutionsFailed,
+		TaskListsDeleted:  taskListsDeleted,
+	}, nil
+}
+
+// ClearArchivalActivity disables archival of the domain and clears its archival URIs,
+// so nothing points at archived data of the domain any more
+func ClearArchivalActivity(ctx context.Context, params Params) error {
+	deleter := getDeleter(ctx)
+	metadata, err := deleter.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := getDeletedDomain(ctx, params)
+	if err != nil || resp == nil {
+		return err
+	}
+
+	resp.Config.HistoryArchivalStatus = types.ArchivalStatusDisabled
+	resp.Config.HistoryArchivalURI = ""
+	resp.Config.VisibilityArchivalStatus = types.ArchivalStatusDisabled
+	resp.Config.VisibilityArchivalURI = ""
+	return deleter.domainManager.UpdateDomain(ctx, &persistence.UpdateDomainRequest{
+		Info:                        resp.Info,
+		Config:                      resp.Config,
+		ReplicationConfig:           resp.ReplicationConfig,
+		ConfigVersion:               resp.ConfigVersion,
+		FailoverVersion:             resp.FailoverVersion,
+		FailoverNotificationVersion: resp.FailoverNotificationVersion,
+		PreviousFailoverVersion:     resp.PreviousFailoverVersion,
+		FailoverEndTime:             resp.FailoverEndTime,
+		LastUpdatedTime:             time.Now().UnixNano(),
+		NotificationVersion:         metadata.NotificationVersion,
+	})
+}
+
+// PurgeExecutionsActivity terminates the open executions of the domain and deletes
+// the history, mutable state and visibility records of all its executions
+func PurgeExecutionsActivity(ctx context.Context, params Params) (*PurgeExecutionsResult, error) {
+	deleter := getDeleter(ctx)
+	logger := getActivityLogger(ctx)
+	frontendClient := deleter.clientBean.GetFrontendClient()
+	adminClient := deleter.clientBean.GetRemoteAdminClient(deleter.cfg.ClusterMetadata.GetCurrentClusterName())
+	limiter := newRateLimiter(deleter)
+
+	var hbd purgeExecutionsHeartbeat
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
+			logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			hbd = purgeExecutionsHeartbeat{}
+		}
+	}
+	taskLists := make(map[string]struct{}, len(hbd.Result.TaskLists))
+	for _, taskList := range hbd.Result.TaskLists {
+		taskLists[taskList] = struct{}{}
+	}
+
+	startTimeFilter := &types.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(0),
+		LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+	}
+	for {
+		executions, nextPageToken, err := listExecutions(ctx, frontendClient, params.DomainName, hbd.ClosedExecutions, hbd.PageToken, startTimeFilter)
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range executions {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			// the task lists have to be collected before the history is deleted
+			executionTaskLists, err := getExecutionTaskLists(ctx, frontendClient, params.DomainName, execution)
+			if err != nil {
+				logger.Warn("Failed to read task lists of workflow execution",
+					tag.WorkflowID(execution.Execution.GetWorkflowID()),
+					tag.WorkflowRunID(execution.Execution.GetRunID()),
+					tag.Error(err),
+				)
+			}
+			for _, taskList := range executionTaskLists {
+				if _, ok := taskLists[taskList]; !ok {
+					taskLists[taskList] = struct{}{}
+					hbd.Result.TaskLists = append(hbd.Result.TaskLists, taskList)
+				}
+			}
+			if err := purgeExecution(ctx, frontendClient, adminClient, params.DomainName, execution.Execution, !hbd.ClosedExecutions); err != nil {
+				logger.Warn("Failed to purge workflow execution",
+					tag.WorkflowID(execution.Execution.GetWorkflowID()),
+					tag.WorkflowRunID(execution.Execution.GetRunID()),
+					tag.Error(err),
+				)
+				hbd.Result.ExecutionsFailed++
+			} else {
+				hbd.Result.ExecutionsDeleted++
+			}
+		}
+
+		hbd.PageToken = nextPageToken
+		if len(nextPageToken) == 0 {
+			if hbd.ClosedExecutions {
+				break
+			}
+			hbd.ClosedExecutions = true
+		}
+		activity.RecordHeartbeat(ctx, hbd)
+	}
+	return &hbd.Result, nil
+}
+
+// PurgeTaskListsActivity deletes the tasks and the task lists of the domain, including all their partitions
+func PurgeTaskListsActivity(ctx context.Context, params PurgeTaskListsParams) (int, error) {
+	deleter := getDeleter(ctx)
+	limiter := newRateLimiter(deleter)
+
+	var hbd purgeTaskListsHeartbeat
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
+			getActivityLogger(ctx).Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			hbd = purgeTaskListsHeartbeat{}
+		}
+	}
+
+	for ; hbd.NextIndex < len(params.TaskLists); hbd.NextIndex++ {
+		for _, taskType := range []int{persistence.TaskListTypeDecision, persistence.TaskListTypeActivity} {
+			deleted, err := purgeTaskListPartitions(ctx, deleter, limiter, params, params.TaskLists[hbd.NextIndex], taskType)
+			if err != nil {
+				return 0, err
+			}
+			hbd.TaskListsDeleted += deleted
+		}
+		activity.RecordHeartbeat(ctx, purgeTaskListsHeartbeat{
+			NextIndex:        hbd.NextIndex + 1,
+			TaskListsDeleted: hbd.TaskListsDeleted,
+		})
+	}
+	return hbd.TaskListsDeleted, nil
+}
+
+// DeleteDomainActivity replicates the domain deletion to the other clusters and removes the domain record
+func DeleteDomainActivity(ctx context.Context, params Params) error {
+	deleter := getDeleter(ctx)
+	resp, err := getDeletedDomain(ctx, params)
+	if err != nil || resp == nil {
+		return err
+	}
+
+	isActive := resp.ReplicationConfig.ActiveClusterName == deleter.cfg.ClusterMetadata.GetCurrentClusterName()
+	if resp.IsGlobalDomain && isActive && deleter.cfg.EnableDeleteReplication() {
+		// replicate before removing the record, so a retry after a failed removal replicates again.
+		// Without replication the other clusters keep the record in deleted status.
+		// The other clusters purge their data on receiving the replication, so only the active cluster replicates.
+		if err := deleter.domainReplicator.HandleTransmissionTask(
+			ctx,
+			types.DomainOperationDelete,
+			resp.Info,
+			resp.Config,
+			resp.ReplicationConfig,
+			resp.ConfigVersion,
+			resp.FailoverVersion,
+			resp.PreviousFailoverVersion,
+			resp.IsGlobalDomain,
+		); err != nil {
+			return err
+		}
+	}
+	if err := deleter.domainManager.DeleteDomain(ctx, &persistence.DeleteDomainRequest{ID: params.DomainID}); err != nil {
+		return err
+	}
+	getActivityLogger(ctx).Info("Domain record is deleted")
+	return nil
+}
+
+// getDeletedDomain returns nil if the domain record is already removed
+func getDeletedDomain(ctx context.Context, params Params) (*persistence.GetDomainResponse, error) {
+	deleter := getDeleter(ctx)
+	resp, err := deleter.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{ID: params.DomainID})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if resp.Info.Name != params.DomainName || resp.Info.Status != persistence.DomainStatusDeleted {
+		return nil, cadence.NewCustomError(errMsgDomainNotDeleted)
+	}
+	return resp, nil
+}
+
+func listExecutions(
+	ctx context.Context,
+	client frontend.Client,
+	domainName string,
+	closed bool,
+	pageToken []byte,
+	startTimeFilter *types.StartTimeFilter,
+) ([]*types.WorkflowExecutionInfo, []byte, error) {
+	if closed {
+		resp, err := client.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+			Domain:          domainName,
+			MaximumPageSize: listPageSize,
+			NextPageToken:   pageToken,
+			StartTimeFilter: startTimeFilter,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.GetExecutions(), resp.NextPageToken, nil
+	}
+	resp, err := client.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+		Domain:          domainName,
+		MaximumPageSize: listPageSize,
+		NextPageToken:   pageToken,
+		StartTimeFilter: startTimeFilter,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.GetExecutions(), resp.NextPageToken, nil
+}
+
+func purgeExecution(
+	ctx context.Context,
+	frontendClient frontend.Client,
+	adminClient admin.Client,
+	domainName string,
+	execution *types.WorkflowExecution,
+	open bool,
+) error {
+	if open {
+		err := frontendClient.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+			Domain:            domainName,
+			WorkflowExecution: execution,
+			Reason:            terminateReason,
+			Identity:          WorkflowTypeName,
+		})
+		switch err.(type) {
+		case nil:
+		case *types.EntityNotExistsError:
+			// the workflow is already closed
+		case *types.DomainNotActiveError:
+			// the workflow is terminated by the purge running in the active cluster
+		default:
+			return err
+		}
+	}
+	_, err := adminClient.DeleteWorkflow(ctx, &types.AdminDeleteWorkflowRequest{
+		Domain:     domainName,
+		Execution:  execution,
+		SkipErrors: true,
+	})
+	return err
+}
+
+// getExecutionTaskLists returns the task lists the decisions and activities of the execution were scheduled on,
+// which includes the sticky task lists of the workers
+func getExecutionTaskLists(
+	ctx context.Context,
+	client frontend.Client,
+	domainName string,
+	execution *types.WorkflowExecutionInfo,
+) ([]string, error) {
+	var taskLists []string
+	if execution.TaskList != "" {
+		taskLists = append(taskLists, execution.TaskList)
+	}
+	var pageToken []byte
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+			Domain:          domainName,
+			Execution:       execution.Execution,
+			MaximumPageSize: historyPageSize,
+			NextPageToken:   pageToken,
+		})
+		if err != nil {
+			return taskLists, err
+		}
+		for _, event := range resp.GetHistory().GetEvents() {
+			var taskList *types.TaskList
+			switch event.GetEventType() {
+			case types.EventTypeWorkflowExecutionStarted:
+				taskList = event.WorkflowExecutionStartedEventAttributes.TaskList
+			case types.EventTypeDecisionTaskScheduled:
+				taskList = event.DecisionTaskScheduledEventAttributes.TaskList
+			case types.EventTypeActivityTaskScheduled:
+				taskList = event.ActivityTaskScheduledEventAttributes.TaskList
+			case types.EventTypeWorkflowExecutionContinuedAsNew:
+				taskList = event.WorkflowExecutionContinuedAsNewEventAttributes.TaskList
+			}
+			if taskList.GetName() != "" {
+				taskLists = append(taskLists, taskList.GetName())
+			}
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return taskLists, nil
+		}
+	}
+}
+
+// purgeTaskListPartitions purges the root partition and all the child partitions of the task list,
+// the number of partitions is the larger one of the dynamic config and the adaptive partition config.
+// The backlogs of the higher task priorities of every partition are purged as well.
+func purgeTaskListPartitions(
+	ctx context.Context,
+	deleter *Deleter,
+	limiter *rate.Limiter,
+	params PurgeTaskListsParams,
+	taskList string,
+	taskType int,
+) (int, error) {
+	if err := limiter.Wait(ctx); err != nil {
+		return 0, err
+	}
+	info, err := purgeTaskList(ctx, deleter.taskManager, params, taskList, taskType)
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	if info != nil {
+		deleted++
+	}
+	if strings.HasPrefix(taskList, common.ReservedTaskListPrefix) {
+		return deleted, nil
+	}
+	n, err := purgeTaskListPriorityBacklogs(ctx, deleter, limiter, params, taskList, taskList, taskType)
+	if err != nil {
+		return 0, err
+	}
+	deleted += n
+
+	numPartitions := deleter.cfg.NumReadPartitions(params.DomainName, taskList, taskType)
+	if n := deleter.cfg.NumWritePartitions(params.DomainName, taskList, taskType); n > numPartitions {
+		numPartitions = n
+	}
+	if info != nil && info.AdaptivePartitionConfig != nil {
+		for _, partitions := range []map[int]*persistence.TaskListPartition{
+			info.AdaptivePartitionConfig.ReadPartitions,
+			info.AdaptivePartitionConfig.WritePartitions,
+		} {
+			for partition := range partitions {
+				if partition+1 > numPartitions {
+					numPartitions = partition + 1
+				}
+			}
+		}
+	}
+	for partition := 1; partition < numPartitions; partition++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return 0, err
+		}
+		partitionName := fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, taskList, partition)
+		info, err := purgeTaskList(ctx, deleter.taskManager, params, partitionName, taskType)
+		if err != nil {
+			return 0, err
+		}
+		if info != nil {
+			deleted++
+		}
+		n, err := purgeTaskListPriorityBacklogs(ctx, deleter, limiter, params, taskList, partitionName, taskType)
+		if err != nil {
+			return 0, err
+		}
+		deleted += n
+	}
+	return deleted, nil
+}
+
+// purgeTaskListPriorityBacklogs purges the persisted backlogs of the priorities above the default one of a partition,
+// the number of priorities is read from the dynamic config of the task list
+func purgeTaskListPriorityBacklogs(
+	ctx context.Context,
+	deleter *Deleter,
+	limiter *rate.Limiter,
+	params PurgeTaskListsParams,
+	taskList string,
+	partitionName string,
+	taskType int,
+) (int, error) {
+	deleted := 0
+	for priority := 1; priority < deleter.cfg.TaskPriorityLevels(params.DomainName, taskList, taskType); priority++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return 0, err
+		}
+		info, err := purgeTaskList(ctx, deleter.taskManager, params, common.TaskListPriorityBacklogName(partitionName, priority), taskType)
+		if err != nil {
+			return 0, err
+		}
+		if info != nil {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// purgeTaskList returns the info of the deleted task list, or nil if the task list does not exist
+func purgeTaskList(
+	ctx context.Context,
+	taskManager persistence.TaskManager,
+	params PurgeTaskListsParams,
+	taskList string,
+	taskType int,
+) (*persistence.TaskListInfo, error) {
+	resp, err := taskManager.GetTaskList(ctx, &persistence.GetTaskListRequest{
+		DomainID:   params.DomainID,
+		DomainName: params.DomainName,
+		TaskList:   taskList,
+		TaskType:   taskType,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for {
+		completeResp, err := taskManager.CompleteTasksLessThan(ctx, &persistence.CompleteTasksLessThanRequest{
+			DomainID:     params.DomainID,
+			DomainName:   params.DomainName,
+			TaskListName: taskList,
+			TaskType:     taskType,
+			TaskID:       math.MaxInt64,
+			Limit:        taskBatchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// UnknownNumRowsAffected means all the tasks are deleted at once
+		if completeResp.TasksCompleted < taskBatchSize {
+			break
+		}
+	}
+
+	err = taskManager.DeleteTaskList(ctx, &persistence.DeleteTaskListRequest{
+		DomainID:     params.DomainID,
+		DomainName:   params.DomainName,
+		TaskListName: taskList,
+		TaskListType: taskType,
+		RangeID:      resp.TaskListInfo.RangeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.TaskListInfo, nil
+}
+
+func validateParams(params Params) error {
+	if params.DomainName == "" {
+		return errors.New(errMsgDomainNameIsEmpty)
+	}
+	if params.DomainID == "" {
+		return errors.New(errMsgDomainIDIsEmpty)
+	}
+	return nil
+}
+
+func getActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    24 * time.Hour,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       WorkflowExecutionTimeout,
+			NonRetriableErrorReasons: []string{errMsgDomainNotDeleted},
+		},
+	}
+}
+
+func newRateLimiter(deleter *Deleter) *rate.Limiter {
+	rps := deleter.cfg.DeletionRPS()
+	return rate.NewLimiter(rate.Limit(rps), rps)
+}
+
+func getDeleter(ctx context.Context) *Deleter {
+	return ctx.Value(domainDeletionContextKey).(*Deleter)
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	deleter := getDeleter(ctx)
+	info := activity.GetInfo(ctx)
+	return deleter.logger.WithTags(
+		tag.WorkflowID(info.WorkflowExecution.ID),
+		tag.WorkflowRunID(info.WorkflowExecution.RunID),
+		tag.WorkflowDomainName(info.WorkflowDomain),
+	)
+}
@@ -0,0 +1,422 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainName = "test-domain"
+	testDomainID   = "test-domain-id"
+)
+
+type domainDeletionWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+
+	mockResource      *resource.Test
+	mockDomainManager *persistence.MockDomainManager
+	mockTaskManager   *persistence.MockTaskManager
+	mockReplicator    *domain.MockReplicator
+	deleter           *Deleter
+}
+
+func TestDomainDeletionWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(domainDeletionWorkflowTestSuite))
+}
+
+func (s *domainDeletionWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(ClearArchivalActivity, activity.RegisterOptions{Name: clearArchivalActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(PurgeExecutionsActivity, activity.RegisterOptions{Name: purgeExecutionsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(PurgeTaskListsActivity, activity.RegisterOptions{Name: purgeTaskListsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(DeleteDomainActivity, activity.RegisterOptions{Name: deleteDomainActivityName})
+
+	controller := gomock.NewController(s.T())
+	s.mockResource = resource.NewTest(s.T(), controller, metrics.Worker)
+	s.mockDomainManager = persistence.NewMockDomainManager(controller)
+	s.mockTaskManager = persistence.NewMockTaskManager(controller)
+	s.mockReplicator = domain.NewMockReplicator(controller)
+
+	deleter := &Deleter{
+		cfg: Config{
+			DeletionRPS:             dynamicconfig.GetIntPropertyFn(1000),
+			EnableDeleteReplication: dynamicconfig.GetBoolPropertyFn(true),
+			NumReadPartitions:       dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1),
+			NumWritePartitions:      dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1),
+			TaskPriorityLevels:      dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1),
+			ClusterMetadata:         cluster.GetTestClusterMetadata(true),
+		},
+		clientBean:       s.mockResource.ClientBean,
+		domainManager:    s.mockDomainManager,
+		taskManager:      s.mockTaskManager,
+		domainReplicator: s.mockReplicator,
+		logger:           testlogger.New(s.T()),
+	}
+	s.deleter = deleter
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.RegisterActivityWithOptions(ClearArchivalActivity, activity.RegisterOptions{Name: clearArchivalActivityName})
+	s.activityEnv.RegisterActivityWithOptions(PurgeExecutionsActivity, activity.RegisterOptions{Name: purgeExecutionsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(PurgeTaskListsActivity, activity.RegisterOptions{Name: purgeTaskListsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(DeleteDomainActivity, activity.RegisterOptions{Name: deleteDomainActivityName})
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), domainDeletionContextKey, deleter),
+	})
+}
+
+func (s *domainDeletionWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+	s.mockResource.Finish(s.T())
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestValidateParams() {
+	s.EqualError(validateParams(Params{}), errMsgDomainNameIsEmpty)
+	s.EqualError(validateParams(Params{DomainName: testDomainName}), errMsgDomainIDIsEmpty)
+	s.NoError(validateParams(Params{DomainName: testDomainName, DomainID: testDomainID}))
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestWorkflow_Success() {
+	params := Params{DomainName: testDomainName, DomainID: testDomainID}
+	s.workflowEnv.OnActivity(clearArchivalActivityName, mock.Anything, params).Return(nil)
+	s.workflowEnv.OnActivity(purgeExecutionsActivityName, mock.Anything, params).Return(&PurgeExecutionsResult{
+		ExecutionsDeleted: 3,
+		ExecutionsFailed:  1,
+		TaskLists:         []string{"tl"},
+	}, nil)
+	s.workflowEnv.OnActivity(purgeTaskListsActivityName, mock.Anything, PurgeTaskListsParams{
+		DomainName: testDomainName,
+		DomainID:   testDomainID,
+		TaskLists:  []string{"tl"},
+	}).Return(2, nil)
+	s.workflowEnv.OnActivity(deleteDomainActivityName, mock.Anything, params).Return(nil)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var result Result
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(Result{ExecutionsDeleted: 3, ExecutionsFailed: 1, TaskListsDeleted: 2}, result)
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, Params{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestWorkflow_DomainNotDeleted() {
+	params := Params{DomainName: testDomainName, DomainID: testDomainID}
+	s.workflowEnv.OnActivity(clearArchivalActivityName, mock.Anything, params).Return(cadence.NewCustomError(errMsgDomainNotDeleted)).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestClearArchivalActivity() {
+	s.mockDomainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 10}, nil)
+	s.mockDomainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: testDomainID}).Return(s.deletedDomain(false), nil)
+	s.mockDomainManager.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.UpdateDomainRequest) error {
+			s.Equal(types.ArchivalStatusDisabled, req.Config.HistoryArchivalStatus)
+			s.Empty(req.Config.HistoryArchivalURI)
+			s.Equal(types.ArchivalStatusDisabled, req.Config.VisibilityArchivalStatus)
+			s.Empty(req.Config.VisibilityArchivalURI)
+			s.Equal(int64(10), req.NotificationVersion)
+			return nil
+		})
+
+	_, err := s.activityEnv.ExecuteActivity(clearArchivalActivityName, Params{DomainName: testDomainName, DomainID: testDomainID})
+	s.NoError(err)
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestClearArchivalActivity_DomainNotDeleted() {
+	resp := s.deletedDomain(false)
+	resp.Info.Status = persistence.DomainStatusDeprecated
+	s.mockDomainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 10}, nil)
+	s.mockDomainManager.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
+
+	_, err := s.activityEnv.ExecuteActivity(clearArchivalActivityName, Params{DomainName: testDomainName, DomainID: testDomainID})
+	s.ErrorContains(err, errMsgDomainNotDeleted)
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestPurgeExecutionsActivity() {
+	openExecution := &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}
+	closedExecution := &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}
+	failedExecution := &types.WorkflowExecution{WorkflowID: "wid3", RunID: "rid3"}
+
+	s.mockResource.FrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{{Execution: openExecution, TaskList: "tl1"}},
+	}, nil)
+	s.mockResource.FrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.ListClosedWorkflowExecutionsRequest, _ ...interface{}) (*types.ListClosedWorkflowExecutionsResponse, error) {
+			if len(req.NextPageToken) == 0 {
+				return &types.ListClosedWorkflowExecutionsResponse{
+					Executions:    []*types.WorkflowExecutionInfo{{Execution: closedExecution, TaskList: "tl1"}},
+					NextPageToken: []byte("token"),
+				}, nil
+			}
+			return &types.ListClosedWorkflowExecutionsResponse{
+				Executions: []*types.WorkflowExecutionInfo{{Execution: failedExecution, TaskList: "tl2"}},
+			}, nil
+		}).Times(2)
+	s.mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.GetWorkflowExecutionHistoryRequest, _ ...interface{}) (*types.GetWorkflowExecutionHistoryResponse, error) {
+			switch req.Execution.GetWorkflowID() {
+			case openExecution.WorkflowID:
+				return &types.GetWorkflowExecutionHistoryResponse{History: &types.History{Events: []*types.HistoryEvent{
+					{
+						EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
+						WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{TaskList: &types.TaskList{Name: "tl1"}},
+					},
+					{
+						EventType:                            types.EventTypeDecisionTaskScheduled.Ptr(),
+						DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{TaskList: &types.TaskList{Name: "sticky-tl"}},
+					},
+					{
+						EventType:                            types.EventTypeActivityTaskScheduled.Ptr(),
+						ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{TaskList: &types.TaskList{Name: "activity-tl"}},
+					},
+				}}}, nil
+			case closedExecution.WorkflowID:
+				return &types.GetWorkflowExecutionHistoryResponse{History: &types.History{}}, nil
+			}
+			return nil, &types.EntityNotExistsError{}
+		}).Times(3)
+	s.mockResource.FrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), &types.TerminateWorkflowExecutionRequest{
+		Domain:            testDomainName,
+		WorkflowExecution: openExecution,
+		Reason:            terminateReason,
+		Identity:          WorkflowTypeName,
+	}).Return(nil)
+	s.mockResource.RemoteAdminClient.EXPECT().DeleteWorkflow(gomock.Any(), &types.AdminDeleteWorkflowRequest{
+		Domain:     testDomainName,
+		Execution:  openExecution,
+		SkipErrors: true,
+	}).Return(&types.AdminDeleteWorkflowResponse{}, nil)
+	s.mockResource.RemoteAdminClient.EXPECT().DeleteWorkflow(gomock.Any(), &types.AdminDeleteWorkflowRequest{
+		Domain:     testDomainName,
+		Execution:  closedExecution,
+		SkipErrors: true,
+	}).Return(&types.AdminDeleteWorkflowResponse{}, nil)
+	s.mockResource.RemoteAdminClient.EXPECT().DeleteWorkflow(gomock.Any(), &types.AdminDeleteWorkflowRequest{
+		Domain:     testDomainName,
+		Execution:  failedExecution,
+		SkipErrors: true,
+	}).Return(nil, errors.New("delete failed"))
+
+	value, err := s.activityEnv.ExecuteActivity(purgeExecutionsActivityName, Params{DomainName: testDomainName, DomainID: testDomainID})
+	s.NoError(err)
+	var result PurgeExecutionsResult
+	s.NoError(value.Get(&result))
+	s.Equal(PurgeExecutionsResult{
+		ExecutionsDeleted: 2,
+		ExecutionsFailed:  1,
+		TaskLists:         []string{"tl1", "sticky-tl", "activity-tl", "tl2"},
+	}, result)
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestPurgeTaskListsActivity() {
+	s.mockTaskManager.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+		DomainID:   testDomainID,
+		DomainName: testDomainName,
+		TaskList:   "tl",
+		TaskType:   persistence.TaskListTypeDecision,
+	}).Return(&persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
+		RangeID: 5,
+		AdaptivePartitionConfig: &persistence.TaskListPartitionConfig{
+			ReadPartitions:  map[int]*persistence.TaskListPartition{0: {}, 1: {}},
+			WritePartitions: map[int]*persistence.TaskListPartition{0: {}},
+		},
+	}}, nil)
+	s.mockTaskManager.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+		DomainID:   testDomainID,
+		DomainName: testDomainName,
+		TaskList:   "/__cadence_sys/tl/1",
+		TaskType:   persistence.TaskListTypeDecision,
+	}).Return(&persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{RangeID: 6}}, nil)
+	s.mockTaskManager.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+		DomainID:   testDomainID,
+		DomainName: testDomainName,
+		TaskList:   "tl",
+		TaskType:   persistence.TaskListTypeActivity,
+	}).Return(nil, &types.EntityNotExistsError{})
+	gomock.InOrder(
+		s.mockTaskManager.EXPECT().CompleteTasksLessThan(gomock.Any(), gomock.Any()).
+			Return(&persistence.CompleteTasksLessThanResponse{TasksCompleted: taskBatchSize}, nil),
+		s.mockTaskManager.EXPECT().CompleteTasksLessThan(gomock.Any(), gomock.Any()).
+			Return(&persistence.CompleteTasksLessThanResponse{TasksCompleted: 10}, nil),
+		s.mockTaskManager.EXPECT().CompleteTasksLessThan(gomock.Any(), gomock.Any()).
+			Return(&persistence.CompleteTasksLessThanResponse{TasksCompleted: 0}, nil),
+	)
+	s.mockTaskManager.EXPECT().DeleteTaskList(gomock.Any(), &persistence.DeleteTaskListRequest{
+		DomainID:     testDomainID,
+		DomainName:   testDomainName,
+		TaskListName: "tl",
+		TaskListType: persistence.TaskListTypeDecision,
+		RangeID:      5,
+	}).Return(nil)
+	s.mockTaskManager.EXPECT().DeleteTaskList(gomock.Any(), &persistence.DeleteTaskListRequest{
+		DomainID:     testDomainID,
+		DomainName:   testDomainName,
+		TaskListName: "/__cadence_sys/tl/1",
+		TaskListType: persistence.TaskListTypeDecision,
+		RangeID:      6,
+	}).Return(nil)
+
+	value, err := s.activityEnv.ExecuteActivity(purgeTaskListsActivityName, PurgeTaskListsParams{
+		DomainName: testDomainName,
+		DomainID:   testDomainID,
+		TaskLists:  []string{"tl"},
+	})
+	s.NoError(err)
+	var deleted int
+	s.NoError(value.Get(&deleted))
+	s.Equal(2, deleted)
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestPurgeTaskListsActivity_PriorityBacklogs() {
+	s.deleter.cfg.NumReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	s.deleter.cfg.TaskPriorityLevels = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	for _, taskList := range []string{"tl", "/__cadence_sys/__priority/tl/1", "/__cadence_sys/tl/1"} {
+		s.mockTaskManager.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+			DomainID:   testDomainID,
+			DomainName: testDomainName,
+			TaskList:   taskList,
+			TaskType:   persistence.TaskListTypeDecision,
+		}).Return(&persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{RangeID: 5}}, nil)
+		s.mockTaskManager.EXPECT().CompleteTasksLessThan(gomock.Any(), gomock.Any()).
+			Return(&persistence.CompleteTasksLessThanResponse{TasksCompleted: 0}, nil)
+		s.mockTaskManager.EXPECT().DeleteTaskList(gomock.Any(), &persistence.DeleteTaskListRequest{
+			DomainID:     testDomainID,
+			DomainName:   testDomainName,
+			TaskListName: taskList,
+			TaskListType: persistence.TaskListTypeDecision,
+			RangeID:      5,
+		}).Return(nil)
+	}
+	s.mockTaskManager.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+		DomainID:   testDomainID,
+		DomainName: testDomainName,
+		TaskList:   "/__cadence_sys/__priority//__cadence_sys/tl/1/1",
+		TaskType:   persistence.TaskListTypeDecision,
+	}).Return(nil, &types.EntityNotExistsError{})
+	s.mockTaskManager.EXPECT().GetTaskList(gomock.Any(), gomock.Any()).
+		Return(nil, &types.EntityNotExistsError{}).Times(4)
+
+	value, err := s.activityEnv.ExecuteActivity(purgeTaskListsActivityName, PurgeTaskListsParams{
+		DomainName: testDomainName,
+		DomainID:   testDomainID,
+		TaskLists:  []string{"tl"},
+	})
+	s.NoError(err)
+	var deleted int
+	s.NoError(value.Get(&deleted))
+	s.Equal(3, deleted)
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestDeleteDomainActivity() {
+	resp := s.deletedDomain(true)
+	s.mockDomainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: testDomainID}).Return(resp, nil)
+	s.mockReplicator.EXPECT().HandleTransmissionTask(
+		gomock.Any(),
+		types.DomainOperationDelete,
+		resp.Info,
+		resp.Config,
+		resp.ReplicationConfig,
+		resp.ConfigVersion,
+		resp.FailoverVersion,
+		resp.PreviousFailoverVersion,
+		true,
+	).Return(nil)
+	s.mockDomainManager.EXPECT().DeleteDomain(gomock.Any(), &persistence.DeleteDomainRequest{ID: testDomainID}).Return(nil)
+
+	_, err := s.activityEnv.ExecuteActivity(deleteDomainActivityName, Params{DomainName: testDomainName, DomainID: testDomainID})
+	s.NoError(err)
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestDeleteDomainActivity_ReplicationDisabled() {
+	s.deleter.cfg.EnableDeleteReplication = dynamicconfig.GetBoolPropertyFn(false)
+	s.mockDomainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: testDomainID}).Return(s.deletedDomain(true), nil)
+	s.mockDomainManager.EXPECT().DeleteDomain(gomock.Any(), &persistence.DeleteDomainRequest{ID: testDomainID}).Return(nil)
+
+	_, err := s.activityEnv.ExecuteActivity(deleteDomainActivityName, Params{DomainName: testDomainName, DomainID: testDomainID})
+	s.NoError(err)
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestDeleteDomainActivity_PassiveCluster() {
+	// the deletion is replicated by the active cluster, which started the purge in this cluster
+	resp := s.deletedDomain(true)
+	resp.ReplicationConfig.ActiveClusterName = cluster.TestAlternativeClusterName
+	s.mockDomainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: testDomainID}).Return(resp, nil)
+	s.mockDomainManager.EXPECT().DeleteDomain(gomock.Any(), &persistence.DeleteDomainRequest{ID: testDomainID}).Return(nil)
+
+	_, err := s.activityEnv.ExecuteActivity(deleteDomainActivityName, Params{DomainName: testDomainName, DomainID: testDomainID})
+	s.NoError(err)
+}
+
+func (s *domainDeletionWorkflowTestSuite) TestDeleteDomainActivity_AlreadyDeleted() {
+	s.mockDomainManager.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+
+	_, err := s.activityEnv.ExecuteActivity(deleteDomainActivityName, Params{DomainName: testDomainName, DomainID: testDomainID})
+	s.NoError(err)
+}
+
+func (s *domainDeletionWorkflowTestSuite) deletedDomain(isGlobal bool) *persistence.GetDomainResponse {
+	return &persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{ID: testDomainID, Name: testDomainName, Status: persistence.DomainStatusDeleted},
+		Config: &persistence.DomainConfig{
+			HistoryArchivalStatus:    types.ArchivalStatusEnabled,
+			HistoryArchivalURI:       "file:///history",
+			VisibilityArchivalStatus: types.ArchivalStatusEnabled,
+			VisibilityArchivalURI:    "file:///visibility",
+		},
+		ReplicationConfig: &persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+		IsGlobalDomain:    isGlobal,
+		ConfigVersion:     3,
+		FailoverVersion:   4,
+	}
+}
@@ -0,0 +1,590 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainmigration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	domainMigrationContextKey contextKey = "domainMigrationContext"
+	// TaskListName is the tasklist name
+	TaskListName = "cadence-sys-domain-migration-tasklist"
+	// WorkflowTypeName is the workflow type name
+	WorkflowTypeName = "cadence-sys-domain-migration-workflow"
+	// WorkflowIDPrefix is the prefix of the workflow ID, the source domain name is appended to ensure
+	// only one migration workflow is running per source domain
+	WorkflowIDPrefix = "cadence-sys-domain-migration-"
+	// WorkflowExecutionTimeout is long enough to migrate large domains with a low rate limit
+	WorkflowExecutionTimeout = 30 * 24 * time.Hour
+	// DecisionTaskTimeout is the decision task timeout of the workflow
+	DecisionTaskTimeout = 10 * time.Second
+	// QueryType is the query type returning the progress of the migration
+	QueryType = "progress"
+
+	// WorkflowRunning state
+	WorkflowRunning = "running"
+	// WorkflowCompleted state
+	WorkflowCompleted = "completed"
+	// WorkflowAborted state
+	WorkflowAborted = "aborted"
+
+	prepareActivityName           = "cadence-sys-domain-migration-prepare-activity"
+	migrateExecutionsActivityName = "cadence-sys-domain-migration-migrate-executions-activity"
+	finishActivityName            = "cadence-sys-domain-migration-finish-activity"
+
+	listPageSize = 100
+	// historyPageSize is the number of history batches read per page when copying the history of an execution
+	historyPageSize = 100
+	// pagesPerRun bounds the history size of a run, the workflow continues as new afterwards
+	pagesPerRun = 50
+	// maxFailedWorkflowIDs bounds the failed workflow IDs reported in the progress
+	maxFailedWorkflowIDs = 100
+	terminateReason      = "workflow is migrated to domain %v"
+
+	errMsgSourceDomainIsEmpty       = "source domain is empty"
+	errMsgTargetDomainIsEmpty       = "target domain is empty"
+	errMsgSameDomain                = "source and target domain are the same"
+	errMsgWorkflowTypesIsEmpty      = "workflow types are empty"
+	errMsgTargetDomainNotRegistered = "target domain is not registered"
+	errMsgDomainNotActive           = "source and target domain must be active in the current cluster"
+	errMsgTargetDomainReplicated    = "target domain must not be replicated to other clusters"
+)
+
+var (
+	// errExecutionClosed is returned for an execution which closed before the migration terminated it
+	errExecutionClosed = errors.New("workflow execution is closed")
+	// errRelatedExecutions is returned for an execution with a parent or pending child workflows,
+	// which can not follow the execution to the target domain
+	errRelatedExecutions = errors.New("workflow execution has a parent or pending child workflows")
+	// errWorkflowIDInUse is returned when another run of the workflow is open in the target domain
+	errWorkflowIDInUse = errors.New("workflow ID is used by an open workflow execution in the target domain")
+)
+
+type (
+	// Params is the arg for the domain migration workflow
+	Params struct {
+		SourceDomain string
+		TargetDomain string
+		// WorkflowTypes are the workflow types opted in to the migration, executions of other types
+		// are left in the source domain
+		WorkflowTypes []string
+		// NextPageToken and Progress carry the state of the migration over to the next run
+		NextPageToken []byte
+		Progress      *Progress
+	}
+
+	// Progress is the progress of the domain migration workflow returned by its query
+	Progress struct {
+		SourceDomain      string
+		TargetDomain      string
+		State             string
+		Migrated          int
+		Skipped           int
+		Failed            int
+		FailedWorkflowIDs []string
+	}
+
+	// MigrateExecutionsParams is the arg for the migrate executions activity
+	MigrateExecutionsParams struct {
+		SourceDomain  string
+		TargetDomain  string
+		WorkflowTypes []string
+		NextPageToken []byte
+	}
+
+	// MigrateExecutionsResult is the result of the migrate executions activity
+	MigrateExecutionsResult struct {
+		Migrated          int
+		Skipped           int
+		Failed            int
+		FailedWorkflowIDs []string
+		NextPageToken     []byte
+	}
+
+	// migrateExecutionsHeartbeat is the heartbeat of the migrate executions activity. A terminated execution
+	// is no longer listed as open, so a retried activity first copies the history of the execution
+	// terminated by the previous attempt.
+	migrateExecutionsHeartbeat struct {
+		Terminated *types.WorkflowExecution
+	}
+)
+
+// Workflow moves the open executions of the opted in workflow types of the source domain to the target domain.
+// Every execution is terminated in the source domain and its history, up to the termination, is copied to the
+// target domain, where the execution continues with the same workflow ID and run ID. Executions with a parent or
+// pending child workflows are not migrated. Signals and queries for the migrated executions are redirected to
+// the target domain by the frontend from the time the migration is prepared until the redirect duration passes
+// after it completes.
+func Workflow(ctx workflow.Context, params Params) (*Progress, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+	progress := params.Progress
+	if progress == nil {
+		progress = &Progress{
+			SourceDomain: params.SourceDomain,
+			TargetDomain: params.TargetDomain,
+			State:        WorkflowRunning,
+		}
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryType, func() (*Progress, error) {
+		return progress, nil
+	}); err != nil {
+		return nil, err
+	}
+	ctx = workflow.WithActivityOptions(ctx, getActivityOptions())
+
+	if params.Progress == nil {
+		if err := workflow.ExecuteActivity(ctx, PrepareActivity, params).Get(ctx, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	nextPageToken := params.NextPageToken
+	for page := 0; page < pagesPerRun; page++ {
+		var result MigrateExecutionsResult
+		if err := workflow.ExecuteActivity(ctx, MigrateExecutionsActivity, MigrateExecutionsParams{
+			SourceDomain:  params.SourceDomain,
+			TargetDomain:  params.TargetDomain,
+			WorkflowTypes: params.WorkflowTypes,
+			NextPageToken: nextPageToken,
+		}).Get(ctx, &result); err != nil {
+			return nil, err
+		}
+		progress.Migrated += result.Migrated
+		progress.Skipped += result.Skipped
+		progress.Failed += result.Failed
+		for _, workflowID := range result.FailedWorkflowIDs {
+			if len(progress.FailedWorkflowIDs) >= maxFailedWorkflowIDs {
+				break
+			}
+			progress.FailedWorkflowIDs = append(progress.FailedWorkflowIDs, workflowID)
+		}
+
+		nextPageToken = result.NextPageToken
+		if len(nextPageToken) == 0 {
+			if err := workflow.ExecuteActivity(ctx, FinishActivity, params).Get(ctx, nil); err != nil {
+				return nil, err
+			}
+			progress.State = WorkflowCompleted
+			workflow.GetLogger(ctx).Info("domain migration completed")
+			return progress, nil
+		}
+	}
+
+	return nil, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, Params{
+		SourceDomain:  params.SourceDomain,
+		TargetDomain:  params.TargetDomain,
+		WorkflowTypes: params.WorkflowTypes,
+		NextPageToken: nextPageToken,
+		Progress:      progress,
+	})
+}
+
+// PrepareActivity validates the domains and records the target domain as the migration target of the source domain,
+// from then on signals and queries of the migrated executions are redirected to the target domain
+func PrepareActivity(ctx context.Context, params Params) error {
+	migrator := getMigrator(ctx)
+	frontendClient := migrator.clientBean.GetFrontendClient()
+	currentCluster := migrator.cfg.ClusterMetadata.GetCurrentClusterName()
+
+	target, err := frontendClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(params.TargetDomain)})
+	if err != nil {
+		return err
+	}
+	if target.GetDomainInfo().GetStatus() != types.DomainStatusRegistered {
+		return cadence.NewCustomError(errMsgTargetDomainNotRegistered)
+	}
+	// the history is copied as replicated events, which are not replicated further to other clusters
+	if len(target.ReplicationConfiguration.GetClusters()) > 1 {
+		return cadence.NewCustomError(errMsgTargetDomainReplicated)
+	}
+
+	source, err := frontendClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(params.SourceDomain)})
+	if err != nil {
+		return err
+	}
+	if source.ReplicationConfiguration.GetActiveClusterName() != currentCluster ||
+		target.ReplicationConfiguration.GetActiveClusterName() != currentCluster {
+		return cadence.NewCustomError(errMsgDomainNotActive)
+	}
+	data := source.GetDomainInfo().GetData()
+	if data[common.DomainDataKeyForMigrationTarget] == params.TargetDomain && data[common.DomainDataKeyForMigrationRedirectExpiry] == "" {
+		return nil
+	}
+	// domain data is merged on update, the empty expiry redirects until the migration completes
+	_, err = frontendClient.UpdateDomain(ctx, &types.UpdateDomainRequest{
+		Name: params.SourceDomain,
+		Data: map[string]string{
+			common.DomainDataKeyForMigrationTarget:         params.TargetDomain,
+			common.DomainDataKeyForMigrationRedirectExpiry: "",
+		},
+	})
+	return err
+}
+
+// FinishActivity records until when signals and queries of the migrated executions are still redirected.
+// Clients keep addressing the source domain for a while after the migration completes, afterwards the frontend
+// no longer looks up the target domain for every signal and query of the source domain.
+func FinishActivity(ctx context.Context, params Params) error {
+	migrator := getMigrator(ctx)
+	frontendClient := migrator.clientBean.GetFrontendClient()
+
+	source, err := frontendClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(params.SourceDomain)})
+	if err != nil {
+		return err
+	}
+	data := source.GetDomainInfo().GetData()
+	if data[common.DomainDataKeyForMigrationTarget] != params.TargetDomain || data[common.DomainDataKeyForMigrationRedirectExpiry] != "" {
+		return nil
+	}
+	expiry := time.Now().Add(migrator.cfg.RedirectDuration(params.SourceDomain))
+	_, err = frontendClient.UpdateDomain(ctx, &types.UpdateDomainRequest{
+		Name: params.SourceDomain,
+		Data: map[string]string{common.DomainDataKeyForMigrationRedirectExpiry: expiry.Format(time.RFC3339)},
+	})
+	return err
+}
+
+// MigrateExecutionsActivity moves a page of the open executions of the source domain to the target domain
+func MigrateExecutionsActivity(ctx context.Context, params MigrateExecutionsParams) (*MigrateExecutionsResult, error) {
+	migrator := getMigrator(ctx)
+	logger := getActivityLogger(ctx)
+	frontendClient := migrator.clientBean.GetFrontendClient()
+	limiter := newRateLimiter(migrator)
+
+	target, err := frontendClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(params.TargetDomain)})
+	if err != nil {
+		return nil, err
+	}
+	targetDomainID := target.GetDomainInfo().GetUUID()
+
+	result := &MigrateExecutionsResult{}
+	if activity.HasHeartbeatDetails(ctx) {
+		var hbd migrateExecutionsHeartbeat
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
+			logger.Error("Failed to recover from last heartbeat", tag.Error(err))
+		} else if hbd.Terminated != nil {
+			err := copyHistory(ctx, migrator, params, targetDomainID, hbd.Terminated)
+			if err != nil && !errors.Is(err, errExecutionClosed) {
+				return nil, err
+			}
+			if err == nil {
+				result.Migrated++
+			}
+			activity.RecordHeartbeat(ctx, migrateExecutionsHeartbeat{})
+		}
+	}
+
+	resp, err := frontendClient.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+		Domain:          params.SourceDomain,
+		MaximumPageSize: listPageSize,
+		NextPageToken:   params.NextPageToken,
+		StartTimeFilter: &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workflowTypes := make(map[string]struct{}, len(params.WorkflowTypes))
+	for _, workflowType := range params.WorkflowTypes {
+		workflowTypes[workflowType] = struct{}{}
+	}
+
+	result.NextPageToken = resp.NextPageToken
+	for _, execution := range resp.GetExecutions() {
+		if _, ok := workflowTypes[execution.GetType().GetName()]; !ok {
+			result.Skipped++
+			continue
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		terminated, err := migrateExecution(ctx, migrator, params, targetDomainID, execution.Execution)
+		switch {
+		case err == nil:
+			result.Migrated++
+		case errors.Is(err, errExecutionClosed):
+			result.Skipped++
+		case terminated:
+			// the execution is no longer listed as open, the activity is retried to finish copying its history
+			return nil, err
+		default:
+			logger.Warn("Failed to migrate workflow execution",
+				tag.WorkflowID(execution.Execution.GetWorkflowID()),
+				tag.WorkflowRunID(execution.Execution.GetRunID()),
+				tag.Error(err),
+			)
+			result.Failed++
+			result.FailedWorkflowIDs = append(result.FailedWorkflowIDs, execution.Execution.GetWorkflowID())
+		}
+		activity.RecordHeartbeat(ctx, migrateExecutionsHeartbeat{})
+	}
+	return result, nil
+}
+
+// migrateExecution terminates the execution in the source domain, so that its history does not change anymore,
+// and copies its history to the target domain. The execution continues in the target domain from the state it had
+// before the termination: completed activities, timers and child workflows are not run again, and a decision or
+// activity in flight times out and is retried. Every step is idempotent, so a failed migration can be retried.
+// It returns whether the execution is terminated in the source domain.
+func migrateExecution(
+	ctx context.Context,
+	migrator *Migrator,
+	params MigrateExecutionsParams,
+	targetDomainID string,
+	execution *types.WorkflowExecution,
+) (bool, error) {
+	frontendClient := migrator.clientBean.GetFrontendClient()
+
+	source, err := frontendClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    params.SourceDomain,
+		Execution: execution,
+	})
+	if err != nil {
+		return false, err
+	}
+	if source.GetWorkflowExecutionInfo().CloseStatus != nil {
+		// the execution is terminated by a previous attempt or closed on its own
+		return true, copyHistory(ctx, migrator, params, targetDomainID, execution)
+	}
+	if source.GetWorkflowExecutionInfo().ParentExecution != nil || len(source.PendingChildren) > 0 {
+		return false, errRelatedExecutions
+	}
+	// an open run of the workflow in the target domain would be closed by the copied history
+	target, err := frontendClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    params.TargetDomain,
+		Execution: &types.WorkflowExecution{WorkflowID: execution.GetWorkflowID()},
+	})
+	var entityNotExistsErr *types.EntityNotExistsError
+	if err != nil && !errors.As(err, &entityNotExistsErr) {
+		return false, err
+	}
+	if err == nil && target.GetWorkflowExecutionInfo().CloseStatus == nil {
+		return false, errWorkflowIDInUse
+	}
+
+	err = frontendClient.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+		Domain:            params.SourceDomain,
+		WorkflowExecution: execution,
+		Reason:            fmt.Sprintf(terminateReason, params.TargetDomain),
+		Identity:          WorkflowTypeName,
+	})
+	var alreadyCompletedErr *types.WorkflowExecutionAlreadyCompletedError
+	if err != nil && !errors.As(err, &alreadyCompletedErr) {
+		return false, err
+	}
+	activity.RecordHeartbeat(ctx, migrateExecutionsHeartbeat{Terminated: execution})
+	return true, copyHistory(ctx, migrator, params, targetDomainID, execution)
+}
+
+// copyHistory applies the history of the execution terminated by the migration to the target domain as replicated
+// events, the last batch holding the termination is left out. The memo of the started event records the source
+// of the migration, the frontend redirects signals and queries sent to the source domain based on it.
+// Applying replicated events is idempotent, so a retried copy only applies the events missing in the target domain.
+func copyHistory(
+	ctx context.Context,
+	migrator *Migrator,
+	params MigrateExecutionsParams,
+	targetDomainID string,
+	execution *types.WorkflowExecution,
+) error {
+	closeResp, err := migrator.clientBean.GetFrontendClient().GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 params.SourceDomain,
+		Execution:              execution,
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+	})
+	if err != nil {
+		return err
+	}
+	closeEvents := closeResp.GetHistory().GetEvents()
+	if len(closeEvents) == 0 || !isMigrationTermination(closeEvents[len(closeEvents)-1], params.TargetDomain) {
+		return errExecutionClosed
+	}
+	closeEventID := closeEvents[len(closeEvents)-1].ID
+
+	adminClient := migrator.clientBean.GetRemoteAdminClient(migrator.cfg.ClusterMetadata.GetCurrentClusterName())
+	historyClient := migrator.clientBean.GetHistoryClient()
+	serializer := persistence.NewPayloadSerializer()
+	applyBatch := func(batch *types.DataBlob, versionHistoryItems []*types.VersionHistoryItem) error {
+		events, err := serializer.DeserializeBatchEvents(persistence.NewDataBlobFromInternal(batch))
+		if err != nil {
+			return err
+		}
+		if len(events) > 0 && events[0].WorkflowExecutionStartedEventAttributes != nil {
+			attributes := events[0].WorkflowExecutionStartedEventAttributes
+			if attributes.Memo, err = getMigratedMemo(attributes.Memo, params.SourceDomain, execution.GetRunID()); err != nil {
+				return err
+			}
+			blob, err := serializer.SerializeBatchEvents(events, persistence.NewDataBlobFromInternal(batch).GetEncoding())
+			if err != nil {
+				return err
+			}
+			batch = blob.ToInternal()
+		}
+		return historyClient.ReplicateEventsV2(ctx, &types.ReplicateEventsV2Request{
+			DomainUUID:          targetDomainID,
+			WorkflowExecution:   execution,
+			VersionHistoryItems: versionHistoryItems,
+			Events:              batch,
+		})
+	}
+
+	// a batch is applied once the next one is read, as the termination is in the last batch
+	var pending *types.DataBlob
+	var nextPageToken []byte
+	for {
+		resp, err := adminClient.GetWorkflowExecutionRawHistoryV2(ctx, &types.GetWorkflowExecutionRawHistoryV2Request{
+			Domain:          params.SourceDomain,
+			Execution:       execution,
+			MaximumPageSize: historyPageSize,
+			NextPageToken:   nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, batch := range resp.GetHistoryBatches() {
+			if pending != nil {
+				if err := applyBatch(pending, resp.GetVersionHistory().GetItems()); err != nil {
+					return err
+				}
+			}
+			pending = batch
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	if pending == nil {
+		return fmt.Errorf("workflow execution history is empty")
+	}
+	lastBatch, err := serializer.DeserializeBatchEvents(persistence.NewDataBlobFromInternal(pending))
+	if err != nil {
+		return err
+	}
+	if len(lastBatch) == 0 || lastBatch[len(lastBatch)-1].ID != closeEventID {
+		return fmt.Errorf("last history batch does not end with the termination of the migration")
+	}
+	return nil
+}
+
+// isMigrationTermination returns whether the close event is the termination of the execution by the migration
+func isMigrationTermination(closeEvent *types.HistoryEvent, targetDomain string) bool {
+	attributes := closeEvent.GetWorkflowExecutionTerminatedEventAttributes()
+	return attributes != nil &&
+		attributes.GetIdentity() == WorkflowTypeName &&
+		attributes.GetReason() == fmt.Sprintf(terminateReason, targetDomain)
+}
+
+// getMigratedMemo adds the source of the migration to the memo, the frontend redirects
+// signals and queries sent to the source domain based on it
+func getMigratedMemo(memo *types.Memo, sourceDomain string, sourceRunID string) (*types.Memo, error) {
+	fields := make(map[string][]byte, len(memo.GetFields())+2)
+	for k, v := range memo.GetFields() {
+		fields[k] = v
+	}
+	for k, v := range map[string]string{
+		common.MemoKeyForMigratedFromDomain: sourceDomain,
+		common.MemoKeyForMigratedFromRunID:  sourceRunID,
+	} {
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		fields[k] = value
+	}
+	return &types.Memo{Fields: fields}, nil
+}
+
+func validateParams(params Params) error {
+	if params.SourceDomain == "" {
+		return errors.New(errMsgSourceDomainIsEmpty)
+	}
+	if params.TargetDomain == "" {
+		return errors.New(errMsgTargetDomainIsEmpty)
+	}
+	if params.SourceDomain == params.TargetDomain {
+		return errors.New(errMsgSameDomain)
+	}
+	if len(params.WorkflowTypes) == 0 {
+		return errors.New(errMsgWorkflowTypesIsEmpty)
+	}
+	return nil
+}
+
+func getActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Hour,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       WorkflowExecutionTimeout,
+			NonRetriableErrorReasons: []string{errMsgTargetDomainNotRegistered, errMsgDomainNotActive, errMsgTargetDomainReplicated},
+		},
+	}
+}
+
+func newRateLimiter(migrator *Migrator) *rate.Limiter {
+	rps := migrator.cfg.MigrationRPS()
+	return rate.NewLimiter(rate.Limit(rps), rps)
+}
+
+func getMigrator(ctx context.Context) *Migrator {
+	return ctx.Value(domainMigrationContextKey).(*Migrator)
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	migrator := getMigrator(ctx)
+	info := activity.GetInfo(ctx)
+	return migrator.logger.WithTags(
+		tag.WorkflowID(info.WorkflowExecution.ID),
+		tag.WorkflowRunID(info.WorkflowExecution.RunID),
+		tag.WorkflowDomainName(info.WorkflowDomain),
+	)
+}
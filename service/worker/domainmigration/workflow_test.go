@@ -0,0 +1,461 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainmigration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testSourceDomain   = "source-domain"
+	testTargetDomain   = "target-domain"
+	testTargetDomainID = "target-domain-id"
+	testWorkflowType   = "wf-type"
+)
+
+type domainMigrationWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+
+	mockResource *resource.Test
+}
+
+func TestDomainMigrationWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(domainMigrationWorkflowTestSuite))
+}
+
+func (s *domainMigrationWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(PrepareActivity, activity.RegisterOptions{Name: prepareActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(MigrateExecutionsActivity, activity.RegisterOptions{Name: migrateExecutionsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(FinishActivity, activity.RegisterOptions{Name: finishActivityName})
+
+	controller := gomock.NewController(s.T())
+	s.mockResource = resource.NewTest(s.T(), controller, metrics.Worker)
+
+	migrator := &Migrator{
+		cfg: Config{
+			MigrationRPS:     dynamicconfig.GetIntPropertyFn(1000),
+			RedirectDuration: dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Hour),
+			ClusterMetadata:  cluster.GetTestClusterMetadata(true),
+		},
+		clientBean: s.mockResource.ClientBean,
+		logger:     testlogger.New(s.T()),
+	}
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.RegisterActivityWithOptions(PrepareActivity, activity.RegisterOptions{Name: prepareActivityName})
+	s.activityEnv.RegisterActivityWithOptions(MigrateExecutionsActivity, activity.RegisterOptions{Name: migrateExecutionsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(FinishActivity, activity.RegisterOptions{Name: finishActivityName})
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), domainMigrationContextKey, migrator),
+	})
+}
+
+func (s *domainMigrationWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+	s.mockResource.Finish(s.T())
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestValidateParams() {
+	s.EqualError(validateParams(Params{}), errMsgSourceDomainIsEmpty)
+	s.EqualError(validateParams(Params{SourceDomain: testSourceDomain}), errMsgTargetDomainIsEmpty)
+	s.EqualError(validateParams(Params{SourceDomain: testSourceDomain, TargetDomain: testSourceDomain}), errMsgSameDomain)
+	s.EqualError(validateParams(Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain}), errMsgWorkflowTypesIsEmpty)
+	s.NoError(validateParams(Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain, WorkflowTypes: []string{testWorkflowType}}))
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestWorkflow_Success() {
+	params := Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain, WorkflowTypes: []string{testWorkflowType}}
+	s.workflowEnv.OnActivity(prepareActivityName, mock.Anything, params).Return(nil).Once()
+	s.workflowEnv.OnActivity(migrateExecutionsActivityName, mock.Anything, MigrateExecutionsParams{
+		SourceDomain:  testSourceDomain,
+		TargetDomain:  testTargetDomain,
+		WorkflowTypes: []string{testWorkflowType},
+	}).Return(&MigrateExecutionsResult{Migrated: 2, Skipped: 4, Failed: 1, FailedWorkflowIDs: []string{"wid1"}, NextPageToken: []byte("token")}, nil).Once()
+	s.workflowEnv.OnActivity(migrateExecutionsActivityName, mock.Anything, MigrateExecutionsParams{
+		SourceDomain:  testSourceDomain,
+		TargetDomain:  testTargetDomain,
+		WorkflowTypes: []string{testWorkflowType},
+		NextPageToken: []byte("token"),
+	}).Return(&MigrateExecutionsResult{Migrated: 3}, nil).Once()
+	s.workflowEnv.OnActivity(finishActivityName, mock.Anything, params).Return(nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var progress Progress
+	s.NoError(s.workflowEnv.GetWorkflowResult(&progress))
+	expected := Progress{
+		SourceDomain:      testSourceDomain,
+		TargetDomain:      testTargetDomain,
+		State:             WorkflowCompleted,
+		Migrated:          5,
+		Skipped:           4,
+		Failed:            1,
+		FailedWorkflowIDs: []string{"wid1"},
+	}
+	s.Equal(expected, progress)
+
+	value, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var queried Progress
+	s.NoError(value.Get(&queried))
+	s.Equal(expected, queried)
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestWorkflow_ContinueAsNew() {
+	params := Params{
+		SourceDomain:  testSourceDomain,
+		TargetDomain:  testTargetDomain,
+		WorkflowTypes: []string{testWorkflowType},
+		NextPageToken: []byte("token"),
+		Progress:      &Progress{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain, State: WorkflowRunning, Migrated: 10},
+	}
+	// the migration is prepared by the first run already
+	s.workflowEnv.OnActivity(migrateExecutionsActivityName, mock.Anything, mock.Anything).
+		Return(&MigrateExecutionsResult{Migrated: 1, NextPageToken: []byte("token")}, nil).Times(pagesPerRun)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.True(errors.As(s.workflowEnv.GetWorkflowError(), &continueAsNewErr))
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestWorkflow_TargetDomainNotRegistered() {
+	params := Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain, WorkflowTypes: []string{testWorkflowType}}
+	s.workflowEnv.OnActivity(prepareActivityName, mock.Anything, params).Return(cadence.NewCustomError(errMsgTargetDomainNotRegistered)).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestPrepareActivity() {
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testTargetDomain)}).
+		Return(s.describeDomainResponse(testTargetDomain, nil), nil)
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testSourceDomain)}).
+		Return(s.describeDomainResponse(testSourceDomain, map[string]string{
+			common.DomainDataKeyForMigrationTarget:         testTargetDomain,
+			common.DomainDataKeyForMigrationRedirectExpiry: "2026-01-01T00:00:00Z",
+		}), nil)
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name: testSourceDomain,
+		Data: map[string]string{
+			common.DomainDataKeyForMigrationTarget:         testTargetDomain,
+			common.DomainDataKeyForMigrationRedirectExpiry: "",
+		},
+	}).Return(&types.UpdateDomainResponse{}, nil)
+
+	_, err := s.activityEnv.ExecuteActivity(prepareActivityName, Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain})
+	s.NoError(err)
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestPrepareActivity_AlreadyPrepared() {
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testTargetDomain)}).
+		Return(s.describeDomainResponse(testTargetDomain, nil), nil)
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testSourceDomain)}).
+		Return(s.describeDomainResponse(testSourceDomain, map[string]string{common.DomainDataKeyForMigrationTarget: testTargetDomain}), nil)
+
+	_, err := s.activityEnv.ExecuteActivity(prepareActivityName, Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain})
+	s.NoError(err)
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestPrepareActivity_TargetDomainNotRegistered() {
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeDomainResponse{DomainInfo: &types.DomainInfo{Name: testTargetDomain, Status: types.DomainStatusDeprecated.Ptr()}}, nil)
+
+	_, err := s.activityEnv.ExecuteActivity(prepareActivityName, Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain})
+	s.ErrorContains(err, errMsgTargetDomainNotRegistered)
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestPrepareActivity_TargetDomainReplicated() {
+	target := s.describeDomainResponse(testTargetDomain, nil)
+	target.ReplicationConfiguration.Clusters = []*types.ClusterReplicationConfiguration{
+		{ClusterName: cluster.TestCurrentClusterName},
+		{ClusterName: cluster.TestAlternativeClusterName},
+	}
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(target, nil)
+
+	_, err := s.activityEnv.ExecuteActivity(prepareActivityName, Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain})
+	s.ErrorContains(err, errMsgTargetDomainReplicated)
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestPrepareActivity_DomainNotActive() {
+	source := s.describeDomainResponse(testSourceDomain, nil)
+	source.ReplicationConfiguration.ActiveClusterName = cluster.TestAlternativeClusterName
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testTargetDomain)}).
+		Return(s.describeDomainResponse(testTargetDomain, nil), nil)
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testSourceDomain)}).
+		Return(source, nil)
+
+	_, err := s.activityEnv.ExecuteActivity(prepareActivityName, Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain})
+	s.ErrorContains(err, errMsgDomainNotActive)
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestFinishActivity() {
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testSourceDomain)}).
+		Return(s.describeDomainResponse(testSourceDomain, map[string]string{common.DomainDataKeyForMigrationTarget: testTargetDomain}), nil)
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.UpdateDomainRequest, _ ...interface{}) (*types.UpdateDomainResponse, error) {
+			s.Equal(testSourceDomain, req.Name)
+			// the migration target is kept, so that signals and queries are redirected until the expiry
+			s.Len(req.Data, 1)
+			expiry, err := time.Parse(time.RFC3339, req.Data[common.DomainDataKeyForMigrationRedirectExpiry])
+			s.NoError(err)
+			s.WithinDuration(time.Now().Add(time.Hour), expiry, time.Minute)
+			return &types.UpdateDomainResponse{}, nil
+		})
+
+	_, err := s.activityEnv.ExecuteActivity(finishActivityName, Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain})
+	s.NoError(err)
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestFinishActivity_AlreadyFinished() {
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testSourceDomain)}).
+		Return(s.describeDomainResponse(testSourceDomain, map[string]string{
+			common.DomainDataKeyForMigrationTarget:         testTargetDomain,
+			common.DomainDataKeyForMigrationRedirectExpiry: "2026-01-01T00:00:00Z",
+		}), nil)
+
+	_, err := s.activityEnv.ExecuteActivity(finishActivityName, Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain})
+	s.NoError(err)
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestFinishActivity_MigratedToOtherDomain() {
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testSourceDomain)}).
+		Return(s.describeDomainResponse(testSourceDomain, map[string]string{common.DomainDataKeyForMigrationTarget: "other-domain"}), nil)
+
+	_, err := s.activityEnv.ExecuteActivity(finishActivityName, Params{SourceDomain: testSourceDomain, TargetDomain: testTargetDomain})
+	s.NoError(err)
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestMigrateExecutionsActivity() {
+	migratedExecution := &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}
+	failedExecution := &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}
+	skippedExecution := &types.WorkflowExecution{WorkflowID: "wid3", RunID: "rid3"}
+	closedExecution := &types.WorkflowExecution{WorkflowID: "wid4", RunID: "rid4"}
+	serializer := persistence.NewPayloadSerializer()
+	serialize := func(events ...*types.HistoryEvent) *types.DataBlob {
+		blob, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+		s.NoError(err)
+		return blob.ToInternal()
+	}
+	startedEvent := &types.HistoryEvent{
+		ID:        1,
+		EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+			WorkflowType: &types.WorkflowType{Name: testWorkflowType},
+			TaskList:     &types.TaskList{Name: "tl"},
+			Memo:         &types.Memo{Fields: map[string][]byte{"key": []byte(`"value"`)}},
+		},
+	}
+	scheduledEvent := &types.HistoryEvent{
+		ID:                                   2,
+		EventType:                            types.EventTypeDecisionTaskScheduled.Ptr(),
+		DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{TaskList: &types.TaskList{Name: "tl"}},
+	}
+	terminatedEvent := &types.HistoryEvent{
+		ID:        3,
+		EventType: types.EventTypeWorkflowExecutionTerminated.Ptr(),
+		WorkflowExecutionTerminatedEventAttributes: &types.WorkflowExecutionTerminatedEventAttributes{
+			Reason:   "workflow is migrated to domain target-domain",
+			Identity: WorkflowTypeName,
+		},
+	}
+	versionHistory := &types.VersionHistory{Items: []*types.VersionHistoryItem{{EventID: 3, Version: 1}}}
+
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testTargetDomain)}).
+		Return(&types.DescribeDomainResponse{DomainInfo: &types.DomainInfo{Name: testTargetDomain, UUID: testTargetDomainID}}, nil)
+	s.mockResource.FrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.ListOpenWorkflowExecutionsRequest, _ ...interface{}) (*types.ListOpenWorkflowExecutionsResponse, error) {
+			s.Equal(testSourceDomain, req.Domain)
+			s.Equal([]byte("token"), req.NextPageToken)
+			return &types.ListOpenWorkflowExecutionsResponse{
+				Executions: []*types.WorkflowExecutionInfo{
+					{Execution: migratedExecution, Type: &types.WorkflowType{Name: testWorkflowType}},
+					{Execution: failedExecution, Type: &types.WorkflowType{Name: testWorkflowType}},
+					{Execution: skippedExecution, Type: &types.WorkflowType{Name: "other-type"}},
+					{Execution: closedExecution, Type: &types.WorkflowType{Name: testWorkflowType}},
+				},
+				NextPageToken: []byte("next-token"),
+			}, nil
+		})
+
+	// the migrated execution is terminated and its history up to the termination is applied to the target domain
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
+		Domain:    testSourceDomain,
+		Execution: migratedExecution,
+	}).Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: migratedExecution}}, nil)
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
+		Domain:    testTargetDomain,
+		Execution: &types.WorkflowExecution{WorkflowID: "wid1"},
+	}).Return(nil, &types.EntityNotExistsError{})
+	s.mockResource.FrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), &types.TerminateWorkflowExecutionRequest{
+		Domain:            testSourceDomain,
+		WorkflowExecution: migratedExecution,
+		Reason:            "workflow is migrated to domain target-domain",
+		Identity:          WorkflowTypeName,
+	}).Return(nil)
+	s.mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 testSourceDomain,
+		Execution:              migratedExecution,
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+	}).Return(&types.GetWorkflowExecutionHistoryResponse{History: &types.History{Events: []*types.HistoryEvent{terminatedEvent}}}, nil)
+	s.mockResource.RemoteAdminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), &types.GetWorkflowExecutionRawHistoryV2Request{
+		Domain:          testSourceDomain,
+		Execution:       migratedExecution,
+		MaximumPageSize: historyPageSize,
+	}).Return(&types.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*types.DataBlob{serialize(startedEvent)},
+		VersionHistory: versionHistory,
+		NextPageToken:  []byte("history-token"),
+	}, nil)
+	s.mockResource.RemoteAdminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), &types.GetWorkflowExecutionRawHistoryV2Request{
+		Domain:          testSourceDomain,
+		Execution:       migratedExecution,
+		MaximumPageSize: historyPageSize,
+		NextPageToken:   []byte("history-token"),
+	}).Return(&types.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*types.DataBlob{serialize(scheduledEvent), serialize(terminatedEvent)},
+		VersionHistory: versionHistory,
+	}, nil)
+	var appliedEvents []*types.HistoryEvent
+	s.mockResource.HistoryClient.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.ReplicateEventsV2Request, _ ...interface{}) error {
+			s.Equal(testTargetDomainID, req.DomainUUID)
+			s.Equal(migratedExecution, req.WorkflowExecution)
+			s.Equal(versionHistory.Items, req.VersionHistoryItems)
+			events, err := serializer.DeserializeBatchEvents(persistence.NewDataBlobFromInternal(req.Events))
+			s.NoError(err)
+			appliedEvents = append(appliedEvents, events...)
+			return nil
+		}).Times(2)
+
+	// the failed execution has a pending child workflow, which can not follow it to the target domain
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
+		Domain:    testSourceDomain,
+		Execution: failedExecution,
+	}).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: failedExecution},
+		PendingChildren:       []*types.PendingChildExecutionInfo{{WorkflowID: "child"}},
+	}, nil)
+
+	// the closed execution completed on its own before the migration got to it
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
+		Domain:    testSourceDomain,
+		Execution: closedExecution,
+	}).Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+		Execution:   closedExecution,
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	}}, nil)
+	s.mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 testSourceDomain,
+		Execution:              closedExecution,
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+	}).Return(&types.GetWorkflowExecutionHistoryResponse{History: &types.History{Events: []*types.HistoryEvent{{
+		ID:        5,
+		EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+		WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{},
+	}}}}, nil)
+
+	value, err := s.activityEnv.ExecuteActivity(migrateExecutionsActivityName, MigrateExecutionsParams{
+		SourceDomain:  testSourceDomain,
+		TargetDomain:  testTargetDomain,
+		WorkflowTypes: []string{testWorkflowType},
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	var result MigrateExecutionsResult
+	s.NoError(value.Get(&result))
+	s.Equal(MigrateExecutionsResult{
+		Migrated:          1,
+		Skipped:           2,
+		Failed:            1,
+		FailedWorkflowIDs: []string{"wid2"},
+		NextPageToken:     []byte("next-token"),
+	}, result)
+
+	s.Len(appliedEvents, 2)
+	s.Equal(scheduledEvent, appliedEvents[1])
+	s.Equal(map[string][]byte{
+		"key":                               []byte(`"value"`),
+		common.MemoKeyForMigratedFromDomain: []byte(`"source-domain"`),
+		common.MemoKeyForMigratedFromRunID:  []byte(`"rid1"`),
+	}, appliedEvents[0].WorkflowExecutionStartedEventAttributes.Memo.GetFields())
+}
+
+func (s *domainMigrationWorkflowTestSuite) TestMigrateExecutionsActivity_CopyFailedAfterTermination() {
+	execution := &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}
+	s.mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeDomainResponse{DomainInfo: &types.DomainInfo{Name: testTargetDomain, UUID: testTargetDomainID}}, nil)
+	s.mockResource.FrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.ListOpenWorkflowExecutionsResponse{
+			Executions: []*types.WorkflowExecutionInfo{{Execution: execution, Type: &types.WorkflowType{Name: testWorkflowType}}},
+		}, nil)
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: execution}}, nil)
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, &types.EntityNotExistsError{})
+	s.mockResource.FrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	s.mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(nil, errors.New("history error"))
+
+	// the terminated execution is no longer listed as open, the activity fails to be retried
+	_, err := s.activityEnv.ExecuteActivity(migrateExecutionsActivityName, MigrateExecutionsParams{
+		SourceDomain:  testSourceDomain,
+		TargetDomain:  testTargetDomain,
+		WorkflowTypes: []string{testWorkflowType},
+	})
+	s.ErrorContains(err, "history error")
+}
+
+func (s *domainMigrationWorkflowTestSuite) describeDomainResponse(name string, data map[string]string) *types.DescribeDomainResponse {
+	return &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: name, Status: types.DomainStatusRegistered.Ptr(), Data: data},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters:          []*types.ClusterReplicationConfiguration{{ClusterName: cluster.TestCurrentClusterName}},
+		},
+	}
+}
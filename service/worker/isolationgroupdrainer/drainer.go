@@ -0,0 +1,309 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package isolationgroupdrainer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/audit"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/isolationgroup/isolationgroupapi"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+const (
+	// ringKey is looked up in the worker ring to pick the single host running the drainer
+	ringKey = "cadence-isolation-group-drainer"
+	// drainerActor is the actor recorded in the audit log for the drains
+	drainerActor = "cadence-worker-isolation-group-drainer"
+
+	applyDrainAPIName   = "UpdateGlobalIsolationGroups"
+	proposeDrainAPIName = "ProposeIsolationGroupDrain"
+)
+
+type (
+	// Config is the config of the isolation group drainer
+	Config struct {
+		Interval             dynamicconfig.DurationPropertyFn
+		MinDrainInterval     dynamicconfig.DurationPropertyFn
+		AutoApply            dynamicconfig.BoolPropertyFn
+		MaxDrainedGroups     dynamicconfig.IntPropertyFn
+		UnhealthyEvaluations dynamicconfig.IntPropertyFn
+
+		MonitoredTaskLists dynamicconfig.ListPropertyFn
+		// NumReadPartitions is used to find the partitions of the monitored task lists without a partition config
+		NumReadPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	}
+
+	// Drainer periodically evaluates the health signals of the isolation groups and proposes, or applies,
+	// a drain of the groups which stay unhealthy. Drains are only ever added: undraining is left to operators.
+	Drainer struct {
+		status             int32
+		stopCh             chan struct{}
+		wg                 sync.WaitGroup
+		config             *Config
+		signals            []Signal
+		handler            isolationgroupapi.Handler
+		auditor            audit.Auditor
+		membershipResolver membership.Resolver
+		allGroups          func() []string
+		logger             log.Logger
+		metricsScope       metrics.Scope
+		timeSource         clock.TimeSource
+
+		// unhealthyEvaluations is the number of consecutive evaluations each group was found unhealthy
+		unhealthyEvaluations map[string]int
+		lastDrainTime        time.Time
+	}
+
+	// drainRecord is the request body recorded in the audit log, explaining why a drain happened
+	drainRecord struct {
+		IsolationGroup string    `json:"isolationGroup"`
+		Reasons        []string  `json:"reasons"`
+		Applied        bool      `json:"applied"`
+		Time           time.Time `json:"time"`
+	}
+)
+
+// New creates a new isolation group drainer, the auditor is started and stopped with the drainer
+func New(
+	config *Config,
+	signals []Signal,
+	handler isolationgroupapi.Handler,
+	auditor audit.Auditor,
+	membershipResolver membership.Resolver,
+	allGroups func() []string,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	timeSource clock.TimeSource,
+) *Drainer {
+	return &Drainer{
+		status:               common.DaemonStatusInitialized,
+		stopCh:               make(chan struct{}),
+		config:               config,
+		signals:              signals,
+		handler:              handler,
+		auditor:              auditor,
+		membershipResolver:   membershipResolver,
+		allGroups:            allGroups,
+		logger:               logger.WithTags(tag.ComponentIsolationGroupDrainer),
+		metricsScope:         metricsClient.Scope(metrics.IsolationGroupDrainerScope),
+		timeSource:           timeSource,
+		unhealthyEvaluations: make(map[string]int),
+	}
+}
+
+// Start starts the drainer
+func (d *Drainer) Start() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	d.auditor.Start()
+	d.wg.Add(1)
+	go d.loop()
+	d.logger.Info("isolation group drainer started")
+}
+
+// Stop stops the drainer
+func (d *Drainer) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(d.stopCh)
+	d.wg.Wait()
+	d.auditor.Stop()
+	d.logger.Info("isolation group drainer stopped")
+}
+
+func (d *Drainer) loop() {
+	defer d.wg.Done()
+	workercommon.RunPeriodically(d.timeSource, d.config.Interval, d.stopCh, d.evaluate)
+}
+
+// evaluate runs one round of health evaluation and drains the groups which have been unhealthy for long enough
+func (d *Drainer) evaluate(ctx context.Context) {
+	if !workercommon.IsRingOwner(d.membershipResolver, ringKey, d.logger) {
+		return
+	}
+	groups := d.allGroups()
+	if len(groups) < 2 {
+		return
+	}
+
+	reasons := make(map[string][]string)
+	for _, signal := range d.signals {
+		unhealthy, err := signal.Unhealthy(ctx, groups)
+		if err != nil {
+			// an evaluation with missing signals is skipped entirely rather than counted as healthy
+			d.logger.Warn("failed to evaluate isolation group health signal", tag.Name(signal.Name()), tag.Error(err))
+			d.metricsScope.IncCounter(metrics.IsolationGroupDrainerEvaluationFailures)
+			return
+		}
+		for group, reason := range unhealthy {
+			reasons[group] = append(reasons[group], fmt.Sprintf("%v: %v", signal.Name(), reason))
+		}
+	}
+
+	for _, group := range groups {
+		if _, ok := reasons[group]; ok {
+			d.unhealthyEvaluations[group]++
+		} else {
+			delete(d.unhealthyEvaluations, group)
+		}
+	}
+
+	candidates := d.candidates(groups)
+	if len(candidates) == 0 {
+		return
+	}
+
+	state, err := d.handler.GetGlobalState(ctx)
+	if err != nil {
+		d.logger.Warn("failed to get global isolation group state", tag.Error(err))
+		d.metricsScope.IncCounter(metrics.IsolationGroupDrainerEvaluationFailures)
+		return
+	}
+	current := types.IsolationGroupConfiguration{}
+	if state != nil {
+		for name, partition := range state.IsolationGroups {
+			current[name] = partition
+		}
+	}
+
+	for _, group := range candidates {
+		if partition, ok := current[group]; ok && partition.State == types.IsolationGroupStateDrained {
+			continue
+		}
+		if reject := d.checkLimits(group, current, groups); reject != "" {
+			d.logger.Warn("isolation group drain rejected",
+				tag.IsolationGroup(group),
+				tag.Value(reasons[group]),
+				tag.Dynamic("reject-reason", reject))
+			d.metricsScope.IncCounter(metrics.IsolationGroupDrainRejectedCount)
+			continue
+		}
+		if err := d.drain(ctx, group, reasons[group], current); err != nil {
+			d.logger.Error("failed to drain isolation group", tag.IsolationGroup(group), tag.Error(err))
+			d.metricsScope.IncCounter(metrics.IsolationGroupDrainerEvaluationFailures)
+			return
+		}
+		current[group] = types.IsolationGroupPartition{Name: group, State: types.IsolationGroupStateDrained}
+	}
+}
+
+// candidates returns the groups unhealthy for enough consecutive evaluations, the longest unhealthy first
+func (d *Drainer) candidates(groups []string) []string {
+	threshold := d.config.UnhealthyEvaluations()
+	var result []string
+	for _, group := range groups {
+		if d.unhealthyEvaluations[group] >= threshold {
+			result = append(result, group)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return d.unhealthyEvaluations[result[i]] > d.unhealthyEvaluations[result[j]]
+	})
+	return result
+}
+
+// checkLimits returns why draining the group would break the safety limits, or an empty string if it wouldn't
+func (d *Drainer) checkLimits(group string, current types.IsolationGroupConfiguration, groups []string) string {
+	drained := 0
+	for _, g := range groups {
+		if partition, ok := current[g]; ok && partition.State == types.IsolationGroupStateDrained {
+			drained++
+		}
+	}
+	if drained+1 >= len(groups) {
+		return "draining would leave no healthy isolation group"
+	}
+	if drained+1 > d.config.MaxDrainedGroups() {
+		return fmt.Sprintf("%v isolation groups are already drained", drained)
+	}
+	if !d.lastDrainTime.IsZero() && d.timeSource.Now().Sub(d.lastDrainTime) < d.config.MinDrainInterval() {
+		return fmt.Sprintf("last drain happened at %v", d.lastDrainTime)
+	}
+	return ""
+}
+
+// drain applies, or only proposes, the drain of the group and records why it happened
+func (d *Drainer) drain(ctx context.Context, group string, reasons []string, current types.IsolationGroupConfiguration) error {
+	applied := d.config.AutoApply()
+	record := &drainRecord{
+		IsolationGroup: group,
+		Reasons:        reasons,
+		Applied:        applied,
+		Time:           d.timeSource.Now(),
+	}
+
+	apiName := proposeDrainAPIName
+	var err error
+	if applied {
+		apiName = applyDrainAPIName
+		update := types.IsolationGroupConfiguration{}
+		for name, partition := range current {
+			update[name] = partition
+		}
+		update[group] = types.IsolationGroupPartition{Name: group, State: types.IsolationGroupStateDrained}
+		err = d.handler.UpdateGlobalState(ctx, types.UpdateGlobalIsolationGroupsRequest{IsolationGroups: update})
+	}
+	d.auditor.Record(ctx, &authorization.Attributes{
+		Actor:       drainerActor,
+		APIName:     apiName,
+		RequestBody: record,
+	}, err)
+	if err != nil {
+		return err
+	}
+
+	d.lastDrainTime = record.Time
+	if applied {
+		d.logger.Warn("isolation group drained", tag.IsolationGroup(group), tag.Value(reasons))
+		d.metricsScope.IncCounter(metrics.IsolationGroupDrainAppliedCount)
+	} else {
+		d.logger.Warn("isolation group drain proposed", tag.IsolationGroup(group), tag.Value(reasons))
+		d.metricsScope.IncCounter(metrics.IsolationGroupDrainProposedCount)
+	}
+	return nil
+}
+
+// SerializeForLogging implements authorization.FilteredRequestBody
+func (r *drainRecord) SerializeForLogging() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package isolationgroupdrainer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/audit"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/isolationgroup/isolationgroupapi"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+type fakeSignal struct {
+	unhealthy map[string]string
+	err       error
+}
+
+func (s *fakeSignal) Name() string {
+	return "fake"
+}
+
+func (s *fakeSignal) Unhealthy(ctx context.Context, groups []string) (map[string]string, error) {
+	return s.unhealthy, s.err
+}
+
+type drainerDeps struct {
+	handler    *isolationgroupapi.MockHandler
+	auditor    *audit.MockAuditor
+	resolver   *membership.MockResolver
+	timeSource clock.MockedTimeSource
+}
+
+func setupDrainer(t *testing.T, signal Signal, autoApply bool) (*Drainer, *drainerDeps) {
+	ctrl := gomock.NewController(t)
+	deps := &drainerDeps{
+		handler:    isolationgroupapi.NewMockHandler(ctrl),
+		auditor:    audit.NewMockAuditor(ctrl),
+		resolver:   membership.NewMockResolver(ctrl),
+		timeSource: clock.NewMockedTimeSource(),
+	}
+	self := membership.NewHostInfo("self")
+	deps.resolver.EXPECT().Lookup(service.Worker, ringKey).Return(self, nil).AnyTimes()
+	deps.resolver.EXPECT().WhoAmI().Return(self, nil).AnyTimes()
+
+	config := &Config{
+		Interval:             dynamicconfig.GetDurationPropertyFn(time.Minute),
+		MinDrainInterval:     dynamicconfig.GetDurationPropertyFn(30 * time.Minute),
+		AutoApply:            dynamicconfig.GetBoolPropertyFn(autoApply),
+		MaxDrainedGroups:     dynamicconfig.GetIntPropertyFn(1),
+		UnhealthyEvaluations: dynamicconfig.GetIntPropertyFn(2),
+	}
+	drainer := New(
+		config,
+		[]Signal{signal},
+		deps.handler,
+		deps.auditor,
+		deps.resolver,
+		func() []string { return []string{"zone-1", "zone-2", "zone-3"} },
+		testlogger.New(t),
+		metrics.NewNoopMetricsClient(),
+		deps.timeSource,
+	)
+	return drainer, deps
+}
+
+func TestEvaluate_AppliesDrainAfterConsecutiveUnhealthyEvaluations(t *testing.T) {
+	signal := &fakeSignal{unhealthy: map[string]string{"zone-1": "no pollers"}}
+	drainer, deps := setupDrainer(t, signal, true)
+
+	// first evaluation only counts the group as unhealthy
+	drainer.evaluate(context.Background())
+
+	deps.handler.EXPECT().GetGlobalState(gomock.Any()).Return(&types.GetGlobalIsolationGroupsResponse{}, nil)
+	deps.handler.EXPECT().UpdateGlobalState(gomock.Any(), types.UpdateGlobalIsolationGroupsRequest{
+		IsolationGroups: types.IsolationGroupConfiguration{
+			"zone-1": {Name: "zone-1", State: types.IsolationGroupStateDrained},
+		},
+	}).Return(nil)
+	deps.auditor.EXPECT().Record(gomock.Any(), gomock.Any(), nil).Do(
+		func(ctx context.Context, attributes *authorization.Attributes, err error) {
+			assert.Equal(t, drainerActor, attributes.Actor)
+			assert.Equal(t, applyDrainAPIName, attributes.APIName)
+			body, serializeErr := attributes.RequestBody.SerializeForLogging()
+			assert.NoError(t, serializeErr)
+			assert.Contains(t, body, `"isolationGroup":"zone-1"`)
+			assert.Contains(t, body, "fake: no pollers")
+			assert.Contains(t, body, `"applied":true`)
+		})
+	drainer.evaluate(context.Background())
+	assert.Equal(t, deps.timeSource.Now(), drainer.lastDrainTime)
+}
+
+func TestEvaluate_ProposesDrainWithoutAutoApply(t *testing.T) {
+	signal := &fakeSignal{unhealthy: map[string]string{"zone-2": "error-rate 0.90 is above 0.50"}}
+	drainer, deps := setupDrainer(t, signal, false)
+	drainer.unhealthyEvaluations["zone-2"] = 1
+
+	deps.handler.EXPECT().GetGlobalState(gomock.Any()).Return(&types.GetGlobalIsolationGroupsResponse{}, nil)
+	deps.auditor.EXPECT().Record(gomock.Any(), gomock.Any(), nil).Do(
+		func(ctx context.Context, attributes *authorization.Attributes, err error) {
+			assert.Equal(t, proposeDrainAPIName, attributes.APIName)
+		})
+	drainer.evaluate(context.Background())
+}
+
+func TestEvaluate_RespectsSafetyLimits(t *testing.T) {
+	tests := map[string]struct {
+		unhealthy     map[string]string
+		state         types.IsolationGroupConfiguration
+		lastDrainTime time.Duration
+		expectDrain   string
+	}{
+		"already drained group is skipped": {
+			unhealthy: map[string]string{"zone-1": "unhealthy"},
+			state: types.IsolationGroupConfiguration{
+				"zone-1": {Name: "zone-1", State: types.IsolationGroupStateDrained},
+			},
+		},
+		"max drained groups reached": {
+			unhealthy: map[string]string{"zone-2": "unhealthy"},
+			state: types.IsolationGroupConfiguration{
+				"zone-1": {Name: "zone-1", State: types.IsolationGroupStateDrained},
+			},
+		},
+		"only one group is drained in a round": {
+			unhealthy:   map[string]string{"zone-1": "unhealthy", "zone-2": "unhealthy"},
+			expectDrain: "zone-1",
+		},
+		"min drain interval not elapsed": {
+			unhealthy:     map[string]string{"zone-1": "unhealthy"},
+			lastDrainTime: -time.Minute,
+		},
+		"min drain interval elapsed": {
+			unhealthy:     map[string]string{"zone-1": "unhealthy"},
+			lastDrainTime: -time.Hour,
+			expectDrain:   "zone-1",
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			drainer, deps := setupDrainer(t, &fakeSignal{unhealthy: td.unhealthy}, true)
+			for group := range td.unhealthy {
+				drainer.unhealthyEvaluations[group] = 1
+			}
+			// zone-1 has been unhealthy for longer and is preferred
+			drainer.unhealthyEvaluations["zone-1"]++
+			if td.lastDrainTime != 0 {
+				drainer.lastDrainTime = deps.timeSource.Now().Add(td.lastDrainTime)
+			}
+
+			deps.handler.EXPECT().GetGlobalState(gomock.Any()).Return(&types.GetGlobalIsolationGroupsResponse{IsolationGroups: td.state}, nil)
+			if td.expectDrain != "" {
+				deps.handler.EXPECT().UpdateGlobalState(gomock.Any(), types.UpdateGlobalIsolationGroupsRequest{
+					IsolationGroups: types.IsolationGroupConfiguration{
+						td.expectDrain: {Name: td.expectDrain, State: types.IsolationGroupStateDrained},
+					},
+				}).Return(nil)
+				deps.auditor.EXPECT().Record(gomock.Any(), gomock.Any(), nil)
+			}
+			drainer.evaluate(context.Background())
+		})
+	}
+}
+
+func TestEvaluate_NeverDrainsAllGroups(t *testing.T) {
+	drainer, deps := setupDrainer(t, &fakeSignal{unhealthy: map[string]string{"zone-3": "unhealthy"}}, true)
+	drainer.config.MaxDrainedGroups = dynamicconfig.GetIntPropertyFn(3)
+	drainer.unhealthyEvaluations["zone-3"] = 2
+
+	deps.handler.EXPECT().GetGlobalState(gomock.Any()).Return(&types.GetGlobalIsolationGroupsResponse{
+		IsolationGroups: types.IsolationGroupConfiguration{
+			"zone-1": {Name: "zone-1", State: types.IsolationGroupStateDrained},
+			"zone-2": {Name: "zone-2", State: types.IsolationGroupStateDrained},
+		},
+	}, nil)
+	drainer.evaluate(context.Background())
+}
+
+func TestEvaluate_SignalFailureSkipsEvaluation(t *testing.T) {
+	drainer, _ := setupDrainer(t, &fakeSignal{err: errors.New("matching unavailable")}, true)
+	drainer.unhealthyEvaluations["zone-1"] = 5
+
+	drainer.evaluate(context.Background())
+	assert.Equal(t, 5, drainer.unhealthyEvaluations["zone-1"])
+}
+
+func TestEvaluate_HealthyGroupResetsCount(t *testing.T) {
+	drainer, _ := setupDrainer(t, &fakeSignal{}, true)
+	drainer.unhealthyEvaluations["zone-1"] = 1
+
+	drainer.evaluate(context.Background())
+	assert.Empty(t, drainer.unhealthyEvaluations)
+}
+
+func TestEvaluate_FailedDrainIsRecorded(t *testing.T) {
+	drainer, deps := setupDrainer(t, &fakeSignal{unhealthy: map[string]string{"zone-1": "unhealthy"}}, true)
+	drainer.unhealthyEvaluations["zone-1"] = 2
+	updateErr := errors.New("config store unavailable")
+
+	deps.handler.EXPECT().GetGlobalState(gomock.Any()).Return(&types.GetGlobalIsolationGroupsResponse{}, nil)
+	deps.handler.EXPECT().UpdateGlobalState(gomock.Any(), gomock.Any()).Return(updateErr)
+	deps.auditor.EXPECT().Record(gomock.Any(), gomock.Any(), updateErr)
+	drainer.evaluate(context.Background())
+	assert.True(t, drainer.lastDrainTime.IsZero())
+}
+
+func TestEvaluate_NotOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	resolver := membership.NewMockResolver(ctrl)
+	resolver.EXPECT().Lookup(service.Worker, ringKey).Return(membership.NewHostInfo("other"), nil)
+	resolver.EXPECT().WhoAmI().Return(membership.NewHostInfo("self"), nil)
+
+	drainer, _ := setupDrainer(t, &fakeSignal{unhealthy: map[string]string{"zone-1": "unhealthy"}}, true)
+	drainer.membershipResolver = resolver
+	drainer.evaluate(context.Background())
+	assert.Empty(t, drainer.unhealthyEvaluations)
+}
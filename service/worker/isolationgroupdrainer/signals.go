@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package isolationgroupdrainer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Signal reports which isolation groups look unhealthy.
+	// The result maps each unhealthy group to a human readable reason, groups missing from it are considered healthy.
+	Signal interface {
+		Name() string
+		Unhealthy(ctx context.Context, groups []string) (map[string]string, error)
+	}
+
+	pollerSignal struct {
+		matchingClient    matching.Client
+		domainCache       cache.DomainCache
+		taskLists         dynamicconfig.ListPropertyFn
+		numReadPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	}
+)
+
+// NewSignals creates the poller liveness signal.
+// Error rates and history host health are not reported per isolation group by any service, so they are left out.
+func NewSignals(config *Config, matchingClient matching.Client, domainCache cache.DomainCache) []Signal {
+	return []Signal{
+		NewPollerSignal(matchingClient, domainCache, config.MonitoredTaskLists, config.NumReadPartitions),
+	}
+}
+
+// NewPollerSignal creates a Signal reporting isolation groups that lost all pollers of the monitored task lists
+// while other isolation groups still poll them. Pollers are counted across all read partitions of the task lists.
+func NewPollerSignal(
+	matchingClient matching.Client,
+	domainCache cache.DomainCache,
+	taskLists dynamicconfig.ListPropertyFn,
+	numReadPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters,
+) Signal {
+	return &pollerSignal{
+		matchingClient:    matchingClient,
+		domainCache:       domainCache,
+		taskLists:         taskLists,
+		numReadPartitions: numReadPartitions,
+	}
+}
+
+func (s *pollerSignal) Name() string {
+	return "poller-liveness"
+}
+
+func (s *pollerSignal) Unhealthy(ctx context.Context, groups []string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, entry := range s.taskLists() {
+		domainName, taskListName, err := parseMonitoredTaskList(entry)
+		if err != nil {
+			return nil, err
+		}
+		domainID, err := s.domainCache.GetDomainID(domainName)
+		if err != nil {
+			return nil, err
+		}
+		pollers, err := s.countPollers(ctx, domainID, domainName, taskListName)
+		if err != nil {
+			return nil, err
+		}
+
+		var total int64
+		for _, count := range pollers {
+			total += count
+		}
+		// a task list nobody polls tells nothing about any particular isolation group
+		if total == 0 {
+			continue
+		}
+		for _, group := range groups {
+			if pollers[group] > 0 {
+				continue
+			}
+			reason := fmt.Sprintf("no pollers on task list %v/%v", domainName, taskListName)
+			if previous, ok := result[group]; ok {
+				reason = previous + "; " + reason
+			}
+			result[group] = reason
+		}
+	}
+	return result, nil
+}
+
+// countPollers returns the number of pollers of each isolation group summed across the read partitions of the task list.
+// The partitions are taken from the partition config of the root partition, or from the static config if it has none.
+func (s *pollerSignal) countPollers(ctx context.Context, domainID, domainName, taskListName string) (map[string]int64, error) {
+	pollers := make(map[string]int64)
+	root, err := s.describePartition(ctx, domainID, domainName, taskListName, pollers)
+	if err != nil {
+		return nil, err
+	}
+
+	var partitions []int
+	if root != nil && root.PartitionConfig != nil {
+		for partition := range root.PartitionConfig.ReadPartitions {
+			partitions = append(partitions, partition)
+		}
+	} else {
+		for partition := 0; partition < s.numReadPartitions(domainName, taskListName, persistence.TaskListTypeDecision); partition++ {
+			partitions = append(partitions, partition)
+		}
+	}
+	sort.Ints(partitions)
+	for _, partition := range partitions {
+		if partition == 0 {
+			continue
+		}
+		partitionName := fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, taskListName, partition)
+		if _, err := s.describePartition(ctx, domainID, domainName, partitionName, pollers); err != nil {
+			return nil, err
+		}
+	}
+	return pollers, nil
+}
+
+// describePartition describes a decision task list partition and adds its pollers to the per isolation group counts
+func (s *pollerSignal) describePartition(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+	partitionName string,
+	pollers map[string]int64,
+) (*types.DescribeTaskListResponse, error) {
+	resp, err := s.matchingClient.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: domainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			Domain:                domainName,
+			TaskList:              &types.TaskList{Name: partitionName, Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType:          types.TaskListTypeDecision.Ptr(),
+			IncludeTaskListStatus: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing task list %v/%v: %w", domainName, partitionName, err)
+	}
+	if status := resp.GetTaskListStatus(); status != nil {
+		for group, metrics := range status.IsolationGroupMetrics {
+			if metrics != nil {
+				pollers[group] += metrics.PollerCount
+			}
+		}
+	}
+	return resp, nil
+}
+
+// parseMonitoredTaskList splits a monitored task list entry of the form domain/tasklist
+func parseMonitoredTaskList(entry interface{}) (string, string, error) {
+	value, ok := entry.(string)
+	if !ok {
+		return "", "", fmt.Errorf("invalid monitored task list %v, expected a string", entry)
+	}
+	parts := strings.SplitN(value, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid monitored task list %q, expected domain/tasklist", value)
+	}
+	return parts[0], parts[1], nil
+}
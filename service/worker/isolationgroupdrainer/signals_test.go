@@ -0,0 +1,161 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package isolationgroupdrainer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestPollerSignal(t *testing.T) {
+	tests := map[string]struct {
+		metrics  map[string]*types.IsolationGroupMetrics
+		expected map[string]string
+	}{
+		"group without pollers is unhealthy": {
+			metrics: map[string]*types.IsolationGroupMetrics{
+				"zone-1": {PollerCount: 3},
+				"zone-2": {PollerCount: 0},
+			},
+			expected: map[string]string{
+				"zone-2": "no pollers on task list test-domain/test-tasklist",
+				"zone-3": "no pollers on task list test-domain/test-tasklist",
+			},
+		},
+		"all groups polling": {
+			metrics: map[string]*types.IsolationGroupMetrics{
+				"zone-1": {PollerCount: 3},
+				"zone-2": {PollerCount: 1},
+				"zone-3": {PollerCount: 2},
+			},
+			expected: map[string]string{},
+		},
+		"task list without any poller is ignored": {
+			metrics:  map[string]*types.IsolationGroupMetrics{},
+			expected: map[string]string{},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			matchingClient := matching.NewMockClient(ctrl)
+			domainCache := cache.NewMockDomainCache(ctrl)
+			domainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			matchingClient.EXPECT().DescribeTaskList(gomock.Any(), &types.MatchingDescribeTaskListRequest{
+				DomainUUID: "test-domain-id",
+				DescRequest: &types.DescribeTaskListRequest{
+					Domain:                "test-domain",
+					TaskList:              &types.TaskList{Name: "test-tasklist", Kind: types.TaskListKindNormal.Ptr()},
+					TaskListType:          types.TaskListTypeDecision.Ptr(),
+					IncludeTaskListStatus: true,
+				},
+			}).Return(&types.DescribeTaskListResponse{
+				TaskListStatus: &types.TaskListStatus{IsolationGroupMetrics: td.metrics},
+			}, nil)
+
+			signal := NewPollerSignal(
+				matchingClient,
+				domainCache,
+				dynamicconfig.GetListPropertyFn([]interface{}{"test-domain/test-tasklist"}),
+				dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1),
+			)
+			unhealthy, err := signal.Unhealthy(context.Background(), []string{"zone-1", "zone-2", "zone-3"})
+			assert.NoError(t, err)
+			assert.Equal(t, td.expected, unhealthy)
+		})
+	}
+}
+
+func TestPollerSignal_Partitions(t *testing.T) {
+	tests := map[string]struct {
+		partitionConfig   *types.TaskListPartitionConfig
+		numReadPartitions int
+		partitions        []string
+	}{
+		"read partitions of the partition config": {
+			partitionConfig: &types.TaskListPartitionConfig{
+				ReadPartitions: map[int]*types.TaskListPartition{0: {}, 1: {}, 2: {}},
+			},
+			numReadPartitions: 1,
+			partitions:        []string{"/__cadence_sys/test-tasklist/1", "/__cadence_sys/test-tasklist/2"},
+		},
+		"read partitions of the static config": {
+			numReadPartitions: 2,
+			partitions:        []string{"/__cadence_sys/test-tasklist/1"},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			matchingClient := matching.NewMockClient(ctrl)
+			domainCache := cache.NewMockDomainCache(ctrl)
+			domainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			describe := func(name string, metrics map[string]*types.IsolationGroupMetrics, config *types.TaskListPartitionConfig) {
+				matchingClient.EXPECT().DescribeTaskList(gomock.Any(), &types.MatchingDescribeTaskListRequest{
+					DomainUUID: "test-domain-id",
+					DescRequest: &types.DescribeTaskListRequest{
+						Domain:                "test-domain",
+						TaskList:              &types.TaskList{Name: name, Kind: types.TaskListKindNormal.Ptr()},
+						TaskListType:          types.TaskListTypeDecision.Ptr(),
+						IncludeTaskListStatus: true,
+					},
+				}).Return(&types.DescribeTaskListResponse{
+					TaskListStatus:  &types.TaskListStatus{IsolationGroupMetrics: metrics},
+					PartitionConfig: config,
+				}, nil)
+			}
+			// the root partition is only polled from zone-1, the other partitions are polled from zone-2
+			describe("test-tasklist", map[string]*types.IsolationGroupMetrics{
+				"zone-1": {PollerCount: 1},
+				"zone-2": {PollerCount: 0},
+			}, td.partitionConfig)
+			for _, partition := range td.partitions {
+				describe(partition, map[string]*types.IsolationGroupMetrics{"zone-2": {PollerCount: 1}}, nil)
+			}
+
+			signal := NewPollerSignal(
+				matchingClient,
+				domainCache,
+				dynamicconfig.GetListPropertyFn([]interface{}{"test-domain/test-tasklist"}),
+				dynamicconfig.GetIntPropertyFilteredByTaskListInfo(td.numReadPartitions),
+			)
+			unhealthy, err := signal.Unhealthy(context.Background(), []string{"zone-1", "zone-2", "zone-3"})
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"zone-3": "no pollers on task list test-domain/test-tasklist"}, unhealthy)
+		})
+	}
+}
+
+func TestPollerSignal_InvalidTaskList(t *testing.T) {
+	signal := NewPollerSignal(nil, nil, dynamicconfig.GetListPropertyFn([]interface{}{"no-separator"}), dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1))
+	_, err := signal.Unhealthy(context.Background(), []string{"zone-1"})
+	assert.Error(t, err)
+}
@@ -28,10 +28,12 @@ import (
 	"sync/atomic"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/isolationgroup/isolationgroupapi"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
@@ -39,11 +41,15 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/asyncworkflow"
+	"github.com/uber/cadence/service/worker/autofailover"
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/service/worker/diagnostics"
+	"github.com/uber/cadence/service/worker/domaindeletion"
+	"github.com/uber/cadence/service/worker/domainmigration"
 	"github.com/uber/cadence/service/worker/esanalyzer"
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
+	"github.com/uber/cadence/service/worker/isolationgroupdrainer"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
@@ -75,7 +81,11 @@ type (
 		ScannerCfg                          *scanner.Config
 		BatcherCfg                          *batcher.Config
 		ESAnalyzerCfg                       *esanalyzer.Config
+		IsolationGroupDrainerCfg            *isolationgroupdrainer.Config
+		AutoFailoverCfg                     *autofailover.Config
 		failoverManagerCfg                  *failovermanager.Config
+		DomainDeletionCfg                   *domaindeletion.Config
+		DomainMigrationCfg                  *domainmigration.Config
 		ThrottledLogRPS                     dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS             dynamicconfig.IntPropertyFn
 		PersistenceMaxQPS                   dynamicconfig.IntPropertyFn
@@ -83,9 +93,13 @@ type (
 		EnableParentClosePolicyWorker       dynamicconfig.BoolPropertyFn
 		NumParentClosePolicySystemWorkflows dynamicconfig.IntPropertyFn
 		EnableFailoverManager               dynamicconfig.BoolPropertyFn
+		EnableDomainDeletion                dynamicconfig.BoolPropertyFn
+		EnableDomainMigration               dynamicconfig.BoolPropertyFn
 		DomainReplicationMaxRetryDuration   dynamicconfig.DurationPropertyFn
 		EnableESAnalyzer                    dynamicconfig.BoolPropertyFn
 		EnableAsyncWorkflowConsumption      dynamicconfig.BoolPropertyFn
+		EnableIsolationGroupDrainer         dynamicconfig.BoolPropertyFn
+		EnableAutoFailoverMonitor           dynamicconfig.BoolPropertyFn
 		HostName                            string
 	}
 )
@@ -159,6 +173,19 @@ func NewConfig(params *resource.Params) *Config {
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
+		DomainDeletionCfg: &domaindeletion.Config{
+			DeletionRPS:             dc.GetIntProperty(dynamicconfig.WorkerDomainDeletionRPS),
+			EnableDeleteReplication: dc.GetBoolProperty(dynamicconfig.EnableDomainDeletionReplication),
+			NumReadPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
+			NumWritePartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions),
+			TaskPriorityLevels:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskPriorityLevels),
+			ClusterMetadata:         params.ClusterMetadata,
+		},
+		DomainMigrationCfg: &domainmigration.Config{
+			MigrationRPS:     dc.GetIntProperty(dynamicconfig.WorkerDomainMigrationRPS),
+			RedirectDuration: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.WorkerDomainMigrationRedirectDuration),
+			ClusterMetadata:  params.ClusterMetadata,
+		},
 		ESAnalyzerCfg: &esanalyzer.Config{
 			ESAnalyzerPause:                          dc.GetBoolProperty(dynamicconfig.ESAnalyzerPause),
 			ESAnalyzerTimeWindow:                     dc.GetDurationProperty(dynamicconfig.ESAnalyzerTimeWindow),
@@ -174,16 +201,36 @@ func NewConfig(params *resource.Params) *Config {
 			ESAnalyzerWorkflowVersionDomains:         dc.GetStringProperty(dynamicconfig.ESAnalyzerWorkflowVersionMetricDomains),
 			ESAnalyzerWorkflowTypeDomains:            dc.GetStringProperty(dynamicconfig.ESAnalyzerWorkflowTypeMetricDomains),
 		},
+		IsolationGroupDrainerCfg: &isolationgroupdrainer.Config{
+			Interval:             dc.GetDurationProperty(dynamicconfig.IsolationGroupDrainerInterval),
+			MinDrainInterval:     dc.GetDurationProperty(dynamicconfig.IsolationGroupDrainerMinDrainInterval),
+			AutoApply:            dc.GetBoolProperty(dynamicconfig.IsolationGroupDrainerAutoApply),
+			MaxDrainedGroups:     dc.GetIntProperty(dynamicconfig.IsolationGroupDrainerMaxDrainedGroups),
+			UnhealthyEvaluations: dc.GetIntProperty(dynamicconfig.IsolationGroupDrainerUnhealthyEvaluations),
+			MonitoredTaskLists:   dc.GetListProperty(dynamicconfig.IsolationGroupDrainerMonitoredTaskLists),
+			NumReadPartitions:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
+		},
+		AutoFailoverCfg: &autofailover.Config{
+			Interval:                dc.GetDurationProperty(dynamicconfig.AutoFailoverMonitorInterval),
+			UnhealthyDuration:       dc.GetDurationProperty(dynamicconfig.AutoFailoverUnhealthyDuration),
+			Cooldown:                dc.GetDurationProperty(dynamicconfig.AutoFailoverCooldown),
+			GracefulFailoverTimeout: dc.GetDurationProperty(dynamicconfig.AutoFailoverGracefulFailoverTimeout),
+			MaxReplicationLag:       dc.GetDurationProperty(dynamicconfig.AutoFailoverMaxReplicationLag),
+		},
 		EnableBatcher:                       dc.GetBoolProperty(dynamicconfig.EnableBatcher),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicconfig.EnableESAnalyzer),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicconfig.EnableFailoverManager),
+		EnableDomainDeletion:                dc.GetBoolProperty(dynamicconfig.EnableDomainDeletion),
+		EnableDomainMigration:               dc.GetBoolProperty(dynamicconfig.EnableDomainMigration),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS),
 		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS),
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.WorkerPersistenceMaxQPS),
 		DomainReplicationMaxRetryDuration:   dc.GetDurationProperty(dynamicconfig.WorkerReplicationTaskMaxRetryDuration),
 		EnableAsyncWorkflowConsumption:      dc.GetBoolProperty(dynamicconfig.EnableAsyncWorkflowConsumption),
+		EnableIsolationGroupDrainer:         dc.GetBoolProperty(dynamicconfig.EnableIsolationGroupDrainer),
+		EnableAutoFailoverMonitor:           dc.GetBoolProperty(dynamicconfig.EnableAutoFailoverMonitor),
 		HostName:                            params.HostName,
 	}
 	advancedVisWritingMode := dc.GetStringProperty(
@@ -246,6 +293,20 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.config.EnableDomainDeletion() {
+		s.startDomainDeletion()
+	}
+	if s.config.EnableDomainMigration() {
+		s.startDomainMigration()
+	}
+	if s.config.EnableIsolationGroupDrainer() {
+		drainer := s.startIsolationGroupDrainer()
+		defer drainer.Stop()
+	}
+	if s.config.EnableAutoFailoverMonitor() {
+		monitor := s.startAutoFailoverMonitor()
+		defer monitor.Stop()
+	}
 
 	cm := s.startAsyncWorkflowConsumerManager()
 	defer cm.Stop()
@@ -307,6 +368,50 @@ func (s *Service) startESAnalyzer() {
 	}
 }
 
+func (s *Service) startIsolationGroupDrainer() *isolationgroupdrainer.Drainer {
+	auditor, err := audit.NewAuditor(
+		s.params.AuditConfig,
+		s.GetLogger(),
+		s.GetMetricsClient(),
+		s.GetTimeSource(),
+		s.GetMessagingClient(),
+		s.GetPersistenceBean().GetAuditLogQueueManager(),
+	)
+	if err != nil {
+		s.GetLogger().Fatal("error creating auditor for isolation group drainer", tag.Error(err))
+	}
+	drainer := isolationgroupdrainer.New(
+		s.config.IsolationGroupDrainerCfg,
+		isolationgroupdrainer.NewSignals(s.config.IsolationGroupDrainerCfg, s.GetMatchingClient(), s.GetDomainCache()),
+		isolationgroupapi.New(s.GetLogger(), s.GetIsolationGroupStore(), nil),
+		auditor,
+		s.GetMembershipResolver(),
+		s.params.GetIsolationGroups,
+		s.GetLogger(),
+		s.GetMetricsClient(),
+		s.GetTimeSource(),
+	)
+	drainer.Start()
+	return drainer
+}
+
+func (s *Service) startAutoFailoverMonitor() *autofailover.Monitor {
+	monitor := autofailover.New(
+		s.config.AutoFailoverCfg,
+		autofailover.NewSignals(s.config.AutoFailoverCfg, s.GetClientBean(), s.GetClusterMetadata().GetCurrentClusterName()),
+		autofailover.NewPeerCheck(s.GetClientBean(), s.GetClusterMetadata()),
+		s.GetClusterMetadata(),
+		s.GetDomainCache(),
+		s.GetClientBean().GetFrontendClient(),
+		s.GetMembershipResolver(),
+		s.GetLogger(),
+		s.GetMetricsClient(),
+		s.GetTimeSource(),
+	)
+	monitor.Start()
+	return monitor
+}
+
 func (s *Service) startBatcher() {
 	params := &batcher.BootstrapParams{
 		Config:        *s.config.BatcherCfg,
@@ -321,6 +426,37 @@ func (s *Service) startBatcher() {
 	}
 }
 
+func (s *Service) startDomainDeletion() {
+	params := &domaindeletion.BootstrapParams{
+		Config:           *s.config.DomainDeletionCfg,
+		ServiceClient:    s.params.PublicClient,
+		MetricsClient:    s.GetMetricsClient(),
+		Logger:           s.GetLogger(),
+		TallyScope:       s.params.MetricScope,
+		ClientBean:       s.GetClientBean(),
+		DomainManager:    s.GetDomainManager(),
+		TaskManager:      s.GetTaskManager(),
+		DomainReplicator: domain.NewDomainReplicator(s.GetDomainReplicationQueue(), s.GetLogger()),
+	}
+	if err := domaindeletion.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting domain deletion worker", tag.Error(err))
+	}
+}
+
+func (s *Service) startDomainMigration() {
+	params := &domainmigration.BootstrapParams{
+		Config:        *s.config.DomainMigrationCfg,
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := domainmigration.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting domain migration worker", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config:     *s.config.ScannerCfg,
@@ -360,6 +496,7 @@ func (s *Service) startDiagnostics() {
 func (s *Service) startReplicator() {
 	domainReplicationTaskExecutor := domain.NewReplicationTaskExecutor(
 		s.Resource.GetDomainManager(),
+		domaindeletion.NewWorkflowStarter(s.GetFrontendClient()),
 		s.Resource.GetTimeSource(),
 		s.Resource.GetLogger(),
 	)
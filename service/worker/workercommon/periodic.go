@@ -0,0 +1,76 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workercommon
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/service"
+)
+
+// periodicEvaluationTimeout bounds every call made by RunPeriodically
+const periodicEvaluationTimeout = 30 * time.Second
+
+// RunPeriodically calls evaluate every interval until stopCh is closed.
+// Each call gets its own context, bounded so a stuck dependency doesn't stall the next evaluations.
+func RunPeriodically(
+	timeSource clock.TimeSource,
+	interval dynamicconfig.DurationPropertyFn,
+	stopCh <-chan struct{},
+	evaluate func(ctx context.Context),
+) {
+	timer := timeSource.NewTimer(interval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.Chan():
+			ctx, cancel := context.WithTimeout(context.Background(), periodicEvaluationTimeout)
+			evaluate(ctx)
+			cancel()
+			timer.Reset(interval())
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// IsRingOwner returns whether this host owns the key in the worker ring,
+// so that a component running on every worker host only does its work on one of them
+func IsRingOwner(resolver membership.Resolver, key string, logger log.Logger) bool {
+	owner, err := resolver.Lookup(service.Worker, key)
+	if err != nil {
+		logger.Warn("failed to lookup ring owner", tag.Name(key), tag.Error(err))
+		return false
+	}
+	self, err := resolver.WhoAmI()
+	if err != nil {
+		logger.Warn("failed to lookup self host info", tag.Error(err))
+		return false
+	}
+	return owner.Identity() == self.Identity()
+}
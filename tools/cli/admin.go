@@ -155,6 +155,58 @@ func newAdminWorkflowCommands() []*cli.Command {
 			},
 			Action: AdminMaintainCorruptWorkflow,
 		},
+		{
+			Name:    "version-histories",
+			Aliases: []string{"vh"},
+			Usage:   "Show the version history branches of a workflow and optionally compare them with another cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"w", "wid"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "RunID",
+				},
+				&cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Cluster to describe the workflow in, defaults to the current cluster",
+				},
+				&cli.StringFlag{
+					Name:  FlagCompareCluster,
+					Usage: "Cluster to compare the current branch with",
+				},
+			},
+			Action: AdminDescribeWorkflowVersionHistories,
+		},
+		{
+			Name:    "rebuild-branch",
+			Aliases: []string{"rb"},
+			Usage:   "Rebuild the mutable state of a workflow from the events of a version history branch",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"w", "wid"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "RunID",
+				},
+				&cli.StringFlag{
+					Name:  FlagBranchToken,
+					Usage: "Hex encoded branch token as shown by version-histories, defaults to the current branch",
+				},
+				&cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Cluster to rebuild the workflow in, defaults to the current cluster",
+				},
+			},
+			Action: AdminRebuildWorkflowBranch,
+		},
 	}
 }
 
@@ -414,6 +466,84 @@ func newAdminDomainCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete a deprecated workflow domain and purge its data",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "Name of the deprecated domain to delete",
+				},
+				&cli.StringFlag{
+					Name:    FlagSecurityToken,
+					Aliases: []string{"st"},
+					Usage:   "Optional token for security check",
+				},
+			},
+			Action: AdminDeleteDomain,
+		},
+		{
+			Name:    "rename",
+			Aliases: []string{"rn"},
+			Usage:   "Rename a workflow domain, the previous name keeps resolving to the domain for an alias period",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "Current name of the domain",
+				},
+				&cli.StringFlag{
+					Name:  FlagNewName,
+					Usage: "New name of the domain",
+				},
+				&cli.StringFlag{
+					Name:    FlagSecurityToken,
+					Aliases: []string{"st"},
+					Usage:   "Optional token for security check",
+				},
+			},
+			Action: AdminRenameDomain,
+		},
+		{
+			Name:    "migrate",
+			Aliases: []string{"mg"},
+			Usage:   "Move the open workflows of a domain to another domain",
+			Subcommands: []*cli.Command{
+				{
+					Name:    "start",
+					Aliases: []string{"s"},
+					Usage:   "Start the workflow migrating the open workflows, signals and queries of migrated workflows are redirected to the destination domain until a while after the migration completes",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  FlagDomain,
+							Usage: "Name of the domain to migrate workflows from",
+						},
+						&cli.StringFlag{
+							Name:  FlagDestinationDomain,
+							Usage: "Name of the registered domain to migrate workflows to",
+						},
+						&cli.StringSliceFlag{
+							Name: FlagWorkflowType,
+							Usage: "Workflow types to migrate, can be passed multiple times. Migrated workflows continue from their history in the destination domain, " +
+								"workflows with a parent or pending child workflows are not migrated",
+						},
+					},
+					Action: AdminDomainMigrationStart,
+				},
+				{
+					Name:    "progress",
+					Aliases: []string{"p"},
+					Usage:   "Report the progress of the workflow migrating the open workflows of a domain",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  FlagDomain,
+							Usage: "Name of the domain workflows are migrated from",
+						},
+					},
+					Action: AdminDomainMigrationProgress,
+				},
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
@@ -801,6 +931,27 @@ func newAdminClusterCommands() []*cli.Command {
 			Usage:       "Rebalance the domains active cluster",
 			Subcommands: newAdminRebalanceCommands(),
 		},
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Show read level, acked level, DLQ size and oldest pending task age of replication to remote clusters",
+			Flags: []cli.Flag{
+				getFormatFlag(),
+				&cli.StringSliceFlag{
+					Name:  FlagCluster,
+					Usage: "Remote cluster to show the replication status of, can be repeated. All remote clusters are shown if not set",
+				},
+				&cli.BoolFlag{
+					Name:  FlagShowDetail,
+					Usage: "Show the replication status of every shard instead of the summary per remote cluster",
+				},
+				&cli.BoolFlag{
+					Name:  FlagForce,
+					Usage: "Force fetch latest DLQ sizes (will put additional stress on DB)",
+				},
+			},
+			Action: AdminGetReplicationStatus,
+		},
 	}
 }
 
@@ -880,6 +1031,77 @@ func newAdminDLQCommands() []*cli.Command {
 	}
 }
 
+func getHistoryTaskDLQFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagDomain,
+			Usage: "Only the messages of this domain. Default to all the messages of the shards",
+		},
+		&cli.StringFlag{
+			Name:  FlagShards,
+			Usage: "Comma separated shard IDs or inclusive ranges. Example: \"2,5-6,10\".  Alternatively, feed one shard ID per line via STDIN.",
+		},
+		&cli.IntFlag{
+			Name:    FlagLastMessageID,
+			Aliases: []string{"lm"},
+			Usage:   "The upper boundary of the read message",
+		},
+	}
+}
+
+func newAdminHistoryTaskDLQCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "read",
+			Aliases: []string{"r"},
+			Usage:   "Read history task DLQ messages",
+			Flags: append(getHistoryTaskDLQFlags(),
+				&cli.IntFlag{
+					Name:    FlagMaxMessageCount,
+					Aliases: []string{"mmc"},
+					Usage:   "Max message size to fetch",
+				},
+				getFormatFlag(),
+			),
+			Action: AdminReadHistoryTaskDLQMessages,
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe a history task DLQ message",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Aliases:  []string{"sid"},
+					Usage:    "ID of the shard of the history task DLQ message",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:     FlagMessageID,
+					Aliases:  []string{"mid"},
+					Usage:    "ID of the history task DLQ message",
+					Required: true,
+				},
+			},
+			Action: AdminDescribeHistoryTaskDLQMessage,
+		},
+		{
+			Name:    "merge",
+			Aliases: []string{"m"},
+			Usage:   "Re-enqueue history task DLQ messages with equal or smaller ids than the provided message id",
+			Flags:   getHistoryTaskDLQFlags(),
+			Action:  AdminMergeHistoryTaskDLQMessages,
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Delete history task DLQ messages with equal or smaller ids than the provided message id",
+			Flags:   getHistoryTaskDLQFlags(),
+			Action:  AdminPurgeHistoryTaskDLQMessages,
+		},
+	}
+}
+
 func newAdminQueueCommands() []*cli.Command {
 	return []*cli.Command{
 		{
@@ -1452,3 +1674,50 @@ func newAdminIsolationGroupCommands() []*cli.Command {
 		},
 	}
 }
+
+func newAdminAuditCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "list",
+			Usage: "List audit log entries of domain, admin and destructive workflow operations",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "Only list entries of this domain",
+				},
+				&cli.StringFlag{
+					Name:  FlagActor,
+					Usage: "Only list entries of this caller identity",
+				},
+				&cli.StringFlag{
+					Name:  FlagAPIName,
+					Usage: "Only list entries of this API, e.g. TerminateWorkflowExecution",
+				},
+				&cli.StringFlag{
+					Name:    FlagEarliestTime,
+					Aliases: []string{"et"},
+					Usage: "Only list entries recorded after this time, supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), e.g. '15m' implies last 15 minutes",
+				},
+				&cli.StringFlag{
+					Name:    FlagLatestTime,
+					Aliases: []string{"lt"},
+					Usage: "Only list entries recorded before this time, supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), e.g. '15m' implies last 15 minutes",
+				},
+				&cli.IntFlag{
+					Name:    FlagPageSize,
+					Aliases: []string{"ps"},
+					Value:   100,
+					Usage:   "Result page size",
+				},
+				&cli.BoolFlag{
+					Name:    FlagAll,
+					Aliases: []string{"a"},
+					Usage:   "List all pages",
+				},
+			},
+			Action: AdminListAuditLogEntries,
+		},
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// AdminListAuditLogEntries lists the audit log entries matching the given filters
+func AdminListAuditLogEntries(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	request := &types.ListAuditLogEntriesRequest{
+		Domain:   c.String(FlagDomain),
+		Actor:    c.String(FlagActor),
+		APIName:  c.String(FlagAPIName),
+		PageSize: int32(c.Int(FlagPageSize)),
+	}
+	if c.IsSet(FlagEarliestTime) {
+		earliestTime, err := parseTime(c.String(FlagEarliestTime), 0)
+		if err != nil {
+			return commoncli.Problem("Invalid earliest time: ", err)
+		}
+		request.StartTimeNano = &earliestTime
+	}
+	if c.IsSet(FlagLatestTime) {
+		latestTime, err := parseTime(c.String(FlagLatestTime), 0)
+		if err != nil {
+			return commoncli.Problem("Invalid latest time: ", err)
+		}
+		request.EndTimeNano = &latestTime
+	}
+
+	output := getDeps(c).Output()
+	var entries []*types.AuditLogEntry
+	for {
+		ctx, cancel, err := newContext(c)
+		if err != nil {
+			cancel()
+			return commoncli.Problem("Error in creating context: ", err)
+		}
+		resp, err := adminClient.ListAuditLogEntries(ctx, request)
+		cancel()
+		if err != nil {
+			return commoncli.Problem("Failed to list audit log entries", err)
+		}
+		entries = append(entries, resp.GetEntries()...)
+		if len(resp.GetNextPageToken()) == 0 || !c.Bool(FlagAll) {
+			break
+		}
+		request.NextPageToken = resp.GetNextPageToken()
+	}
+
+	prettyPrintJSONObject(output, entries)
+	return nil
+}
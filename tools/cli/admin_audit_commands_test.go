@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func (s *cliAppSuite) TestAdminListAuditLogEntries() {
+	entry := &types.AuditLogEntry{
+		Actor:   "alice",
+		APIName: "TerminateWorkflowExecution",
+		Domain:  "test-domain",
+		Outcome: types.AuditLogOutcomeSuccess,
+	}
+	testCases := []testcase{
+		{
+			name:    "happy",
+			command: `cadence admin audit list --domain test-domain --actor alice --api_name TerminateWorkflowExecution --et 100 --lt 200 --ps 10`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().ListAuditLogEntries(gomock.Any(), &types.ListAuditLogEntriesRequest{
+					Domain:        "test-domain",
+					Actor:         "alice",
+					APIName:       "TerminateWorkflowExecution",
+					StartTimeNano: common.Int64Ptr(100),
+					EndTimeNano:   common.Int64Ptr(200),
+					PageSize:      10,
+				}).Return(&types.ListAuditLogEntriesResponse{Entries: []*types.AuditLogEntry{entry}, NextPageToken: []byte("1")}, nil)
+			},
+		},
+		{
+			name:    "all pages",
+			command: `cadence admin audit list --all`,
+			mock: func() {
+				gomock.InOrder(
+					s.serverAdminClient.EXPECT().ListAuditLogEntries(gomock.Any(), &types.ListAuditLogEntriesRequest{PageSize: 100}).
+						Return(&types.ListAuditLogEntriesResponse{Entries: []*types.AuditLogEntry{entry}, NextPageToken: []byte("1")}, nil),
+					s.serverAdminClient.EXPECT().ListAuditLogEntries(gomock.Any(), &types.ListAuditLogEntriesRequest{PageSize: 100, NextPageToken: []byte("1")}).
+						Return(&types.ListAuditLogEntriesResponse{Entries: []*types.AuditLogEntry{entry}}, nil),
+				)
+			},
+		},
+		{
+			name:    "invalid time",
+			command: `cadence admin audit list --et invalid`,
+			err:     "Invalid earliest time",
+		},
+		{
+			name:    "list failed",
+			command: `cadence admin audit list`,
+			err:     "Failed to list audit log entries",
+			mock: func() {
+				s.serverAdminClient.EXPECT().ListAuditLogEntries(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{Message: "no queryable audit log sink is configured"})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
@@ -23,6 +23,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/pborman/uuid"
@@ -176,6 +177,87 @@ func AdminRebalanceList(c *cli.Context) error {
 	return ListWorkflow(c)
 }
 
+// ReplicationClusterStatusRow is the replication status towards a remote cluster aggregated across shards
+type ReplicationClusterStatusRow struct {
+	RemoteCluster        string        `header:"Remote Cluster" json:"remoteCluster"`
+	Shards               int32         `header:"Shards" json:"shards"`
+	TaskLag              int64         `header:"Task Lag" json:"taskLag"`
+	LastReplicated       string        `header:"Last Replicated" json:"lastReplicated"`
+	DLQSize              int64         `header:"DLQ Size" json:"dlqSize"`
+	OldestPendingTaskAge time.Duration `header:"Oldest Pending Task Age" json:"oldestPendingTaskAge"`
+}
+
+// ReplicationShardStatusRow is the replication status of a single shard towards a remote cluster
+type ReplicationShardStatusRow struct {
+	ShardID              int32         `header:"Shard ID" json:"shardID"`
+	RemoteCluster        string        `header:"Remote Cluster" json:"remoteCluster"`
+	ReadLevel            int64         `header:"Read Level" json:"readLevel"`
+	AckedLevel           int64         `header:"Acked Level" json:"ackedLevel"`
+	MaxReadLevel         int64         `header:"Max Read Level" json:"maxReadLevel"`
+	LastReplicated       string        `header:"Last Replicated" json:"lastReplicated"`
+	DLQSize              int64         `header:"DLQ Size" json:"dlqSize"`
+	OldestPendingTaskAge time.Duration `header:"Oldest Pending Task Age" json:"oldestPendingTaskAge"`
+}
+
+// AdminGetReplicationStatus shows how far the remote clusters are behind in replicating the tasks of this cluster
+func AdminGetReplicationStatus(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	response, err := adminClient.GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+		Clusters:   c.StringSlice(FlagCluster),
+		ForceFetch: c.Bool(FlagForce),
+	})
+	if err != nil {
+		return commoncli.Problem("Operation GetReplicationStatus failed.", err)
+	}
+
+	if c.Bool(FlagShowDetail) {
+		table := make([]ReplicationShardStatusRow, 0, len(response.Shards))
+		for _, shard := range response.Shards {
+			table = append(table, ReplicationShardStatusRow{
+				ShardID:              shard.ShardID,
+				RemoteCluster:        shard.RemoteCluster,
+				ReadLevel:            shard.ReadLevel,
+				AckedLevel:           shard.AckedLevel,
+				MaxReadLevel:         shard.MaxReadLevel,
+				LastReplicated:       formatReplicatedTime(shard.LastReplicatedTimestamp),
+				DLQSize:              shard.DLQSize,
+				OldestPendingTaskAge: time.Duration(shard.OldestPendingTaskAgeMs) * time.Millisecond,
+			})
+		}
+		return Render(c, table, RenderOptions{Color: true, DefaultTemplate: templateTable})
+	}
+
+	table := make([]ReplicationClusterStatusRow, 0, len(response.Clusters))
+	for _, cluster := range response.Clusters {
+		table = append(table, ReplicationClusterStatusRow{
+			RemoteCluster:        cluster.RemoteCluster,
+			Shards:               cluster.ShardCount,
+			TaskLag:              cluster.TaskLag,
+			LastReplicated:       formatReplicatedTime(cluster.LastReplicatedTimestamp),
+			DLQSize:              cluster.DLQSize,
+			OldestPendingTaskAge: time.Duration(cluster.OldestPendingTaskAgeMs) * time.Millisecond,
+		})
+	}
+	return Render(c, table, RenderOptions{Color: true, DefaultTemplate: templateTable})
+}
+
+// formatReplicatedTime returns an empty string when nothing has been replicated since the shard was loaded
+func formatReplicatedTime(timestamp *int64) string {
+	if timestamp == nil {
+		return ""
+	}
+	return time.Unix(0, *timestamp).Format(defaultDateTimeFormat)
+}
+
 func intValTypeToString(valType int) string {
 	switch valType {
 	case 0:
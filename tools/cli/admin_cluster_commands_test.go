@@ -416,3 +416,48 @@ func TestAdminAddSearchAttribute_errors(t *testing.T) {
 		})
 	}
 }
+
+func (s *cliAppSuite) TestAdminGetReplicationStatus() {
+	response := &types.GetReplicationStatusResponse{
+		Clusters: []*types.ReplicationClusterStatus{
+			{RemoteCluster: "standby", ShardCount: 1, TaskLag: 10, DLQSize: 2, OldestPendingTaskAgeMs: 1000},
+		},
+		Shards: []*types.ReplicationShardStatus{
+			{ShardID: 1, RemoteCluster: "standby", ReadLevel: 15, AckedLevel: 10, MaxReadLevel: 20, LastReplicatedTimestamp: common.Int64Ptr(1), DLQSize: 2, OldestPendingTaskAgeMs: 1000},
+		},
+	}
+	testCases := []testcase{
+		{
+			name:    "summary",
+			command: `cadence admin cluster replication-status`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{}).Return(response, nil)
+			},
+		},
+		{
+			name:    "shard detail",
+			command: `cadence admin cl rs --cluster standby --show_detail --force`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{
+					Clusters:   []string{"standby"},
+					ForceFetch: true,
+				}).Return(response, nil)
+			},
+		},
+		{
+			name:    "failed",
+			command: `cadence admin cluster replication-status --cluster unknown`,
+			err:     "Operation GetReplicationStatus failed",
+			mock: func() {
+				s.serverAdminClient.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any()).
+					Return(nil, &types.BadRequestError{Message: "Cluster unknown is not a remote cluster."})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
@@ -379,6 +379,70 @@ func AdminDeleteWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// AdminDeleteDomain deletes a deprecated domain and starts the workflow purging its data
+func AdminDeleteDomain(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := adminClient.DeleteDomain(ctx, &types.DeleteDomainRequest{
+		Name:          domainName,
+		SecurityToken: c.String(FlagSecurityToken),
+	})
+	if err != nil {
+		return commoncli.Problem("Operation DeleteDomain failed.", err)
+	}
+
+	fmt.Fprintf(getDeps(c).Output(), "Domain %s deleted, purging its data in workflow %s (run %s).\n", domainName, resp.GetWorkflowID(), resp.RunID)
+	return nil
+}
+
+// AdminRenameDomain renames a domain, keeping its previous name as an alias until the alias period expires
+func AdminRenameDomain(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	newName, err := getRequiredOption(c, FlagNewName)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := adminClient.RenameDomain(ctx, &types.RenameDomainRequest{
+		Name:          domainName,
+		NewName:       newName,
+		SecurityToken: c.String(FlagSecurityToken),
+	})
+	if err != nil {
+		return commoncli.Problem("Operation RenameDomain failed.", err)
+	}
+
+	fmt.Fprintf(getDeps(c).Output(), "Domain %s (%s) renamed to %s, the previous name is resolvable until %s.\n",
+		domainName, resp.GetDomainID(), newName, time.Unix(0, resp.PreviousNameExpiryTimestamp).UTC().Format(time.RFC3339))
+	return nil
+}
+
 // AdminGetDomainIDOrName map domain
 func AdminGetDomainIDOrName(c *cli.Context) error {
 	domainID := c.String(FlagDomainID)
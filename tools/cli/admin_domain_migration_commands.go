@@ -0,0 +1,154 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/domainmigration"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// AdminDomainMigrationStart starts the workflow moving the open executions of a domain to another domain
+func AdminDomainMigrationStart(c *cli.Context) error {
+	sourceDomain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	targetDomain, err := getRequiredOption(c, FlagDestinationDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	if sourceDomain == targetDomain {
+		return commoncli.Problem("Source and destination domain must be different", nil)
+	}
+	workflowTypes := c.StringSlice(FlagWorkflowType)
+	if len(workflowTypes) == 0 {
+		return commoncli.Problem("Required flag not found: ", fmt.Errorf("option %s is required", FlagWorkflowType))
+	}
+
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	op, err := getOperatorFn()
+	if err != nil {
+		return commoncli.Problem("Error in getting operator: ", err)
+	}
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		common.MemoKeyForOperator: op,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to serialize memo", err)
+	}
+	input, err := json.Marshal(domainmigration.Params{
+		SourceDomain:  sourceDomain,
+		TargetDomain:  targetDomain,
+		WorkflowTypes: workflowTypes,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to serialize domain migration params", err)
+	}
+
+	workflowID := domainmigration.WorkflowIDPrefix + sourceDomain
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		RequestID:                           uuidFn(),
+		WorkflowID:                          workflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: domainmigration.TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(domainmigration.WorkflowExecutionTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(domainmigration.DecisionTaskTimeout.Seconds())),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: domainmigration.WorkflowTypeName},
+	}
+	wf, err := client.StartWorkflowExecution(tcCtx, request)
+	if err != nil {
+		return commoncli.Problem("Failed to start domain migration workflow", err)
+	}
+	fmt.Fprintln(getDeps(c).Output(), "Domain migration workflow started")
+	fmt.Fprintln(getDeps(c).Output(), "wid: "+workflowID)
+	fmt.Fprintln(getDeps(c).Output(), "rid: "+wf.GetRunID())
+	return nil
+}
+
+// AdminDomainMigrationProgress reports the progress of the domain migration workflow of a domain
+func AdminDomainMigrationProgress(c *cli.Context) error {
+	sourceDomain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	execution := &types.WorkflowExecution{
+		WorkflowID: domainmigration.WorkflowIDPrefix + sourceDomain,
+	}
+	queryResp, err := client.QueryWorkflow(tcCtx, &types.QueryWorkflowRequest{
+		Domain:    common.SystemLocalDomainName,
+		Execution: execution,
+		Query: &types.WorkflowQuery{
+			QueryType: domainmigration.QueryType,
+		},
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to query domain migration workflow", err)
+	}
+	if queryResp.GetQueryResult() == nil {
+		return commoncli.Problem("QueryResult has no value", nil)
+	}
+	var progress domainmigration.Progress
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &progress); err != nil {
+		return commoncli.Problem("Unable to deserialize QueryResult", err)
+	}
+
+	descResp, err := client.DescribeWorkflowExecution(tcCtx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    common.SystemLocalDomainName,
+		Execution: execution,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to describe workflow", err)
+	}
+	if isWorkflowTerminated(descResp) {
+		progress.State = domainmigration.WorkflowAborted
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), progress)
+	return nil
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// HistoryTaskDLQRow is a row of the history task DLQ table
+type HistoryTaskDLQRow struct {
+	MessageID  int64                     `header:"Message ID" json:"messageID"`
+	ShardID    int32                     `header:"Shard ID" json:"shardID"`
+	DomainID   string                    `header:"Domain ID" json:"domainID"`
+	WorkflowID string                    `header:"Workflow ID" json:"workflowID"`
+	RunID      string                    `header:"Run ID" json:"runID"`
+	Category   types.HistoryTaskCategory `header:"Category" json:"category"`
+	TaskType   int32                     `header:"Task Type" json:"taskType"`
+	TaskID     int64                     `header:"Task ID" json:"taskID"`
+	Attempt    int32                     `header:"Attempt" json:"attempt"`
+	Enqueued   time.Time                 `header:"Enqueued" json:"enqueued"`
+	LastError  string                    `header:"Last Error" json:"lastError"`
+}
+
+// AdminReadHistoryTaskDLQMessages lists the permanently failing history tasks of the given shards, optionally only those of a domain
+func AdminReadHistoryTaskDLQMessages(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	remainingMessageCount := common.EndMessageID
+	if c.IsSet(FlagMaxMessageCount) {
+		remainingMessageCount = c.Int64(FlagMaxMessageCount)
+	}
+	var lastMessageID *int64
+	if c.IsSet(FlagLastMessageID) {
+		lastMessageID = common.Int64Ptr(c.Int64(FlagLastMessageID))
+	}
+
+	readShard := func(shardID int) ([]HistoryTaskDLQRow, error) {
+		var rows []HistoryTaskDLQRow
+		request := &types.ReadHistoryTaskDLQMessagesRequest{
+			ShardID:               int32(shardID),
+			Domain:                c.String(FlagDomain),
+			InclusiveEndMessageID: lastMessageID,
+			MaximumPageSize:       defaultPageSize,
+		}
+		for {
+			ctx, cancel, err := newContext(c)
+			if err != nil {
+				cancel()
+				return nil, commoncli.Problem("Error in creating context: ", err)
+			}
+			resp, err := adminClient.ReadHistoryTaskDLQMessages(ctx, request)
+			cancel()
+			if err != nil {
+				return nil, err
+			}
+			for _, message := range resp.GetMessages() {
+				rows = append(rows, newHistoryTaskDLQRow(message))
+				remainingMessageCount--
+				if remainingMessageCount <= 0 {
+					return rows, nil
+				}
+			}
+			if len(resp.GetNextPageToken()) == 0 {
+				return rows, nil
+			}
+			request.NextPageToken = resp.GetNextPageToken()
+		}
+	}
+
+	table := []HistoryTaskDLQRow{}
+	for shardID := range getShards(c) {
+		if remainingMessageCount <= 0 {
+			break
+		}
+		rows, err := readShard(shardID)
+		if err != nil {
+			return fmt.Errorf("failed to read history task DLQ messages in shard %v: %w", shardID, err)
+		}
+		table = append(table, rows...)
+	}
+
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// AdminDescribeHistoryTaskDLQMessage prints a single permanently failing history task
+func AdminDescribeHistoryTaskDLQMessage(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	resp, err := adminClient.DescribeHistoryTaskDLQMessage(ctx, &types.DescribeHistoryTaskDLQMessageRequest{
+		ShardID:   int32(c.Int(FlagShardID)),
+		MessageID: c.Int64(FlagMessageID),
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to describe history task DLQ message", err)
+	}
+
+	prettyPrintJSONObject(getDeps(c).Output(), resp.GetMessage())
+	return nil
+}
+
+// AdminMergeHistoryTaskDLQMessages re-enqueues the permanently failing history tasks of the given shards, optionally only those of a domain
+func AdminMergeHistoryTaskDLQMessages(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	var lastMessageID *int64
+	if c.IsSet(FlagLastMessageID) {
+		lastMessageID = common.Int64Ptr(c.Int64(FlagLastMessageID))
+	}
+
+ShardIDLoop:
+	for shardID := range getShards(c) {
+		request := &types.MergeHistoryTaskDLQMessagesRequest{
+			ShardID:               int32(shardID),
+			Domain:                c.String(FlagDomain),
+			InclusiveEndMessageID: lastMessageID,
+			MaximumPageSize:       defaultPageSize,
+		}
+
+		for {
+			ctx, cancel, err := newContext(c)
+			if err != nil {
+				cancel()
+				return commoncli.Problem("Error in creating context: ", err)
+			}
+			response, err := adminClient.MergeHistoryTaskDLQMessages(ctx, request)
+			cancel()
+			if err != nil {
+				fmt.Printf("Failed to merge history task DLQ messages in shard %v with error: %v.\n", shardID, err)
+				continue ShardIDLoop
+			}
+
+			if len(response.GetNextPageToken()) == 0 {
+				break
+			}
+			request.NextPageToken = response.GetNextPageToken()
+		}
+		fmt.Printf("Successfully merged all history task DLQ messages in shard %v.\n", shardID)
+	}
+	return nil
+}
+
+// AdminPurgeHistoryTaskDLQMessages deletes the permanently failing history tasks of the given shards, optionally only those of a domain
+func AdminPurgeHistoryTaskDLQMessages(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	var lastMessageID *int64
+	if c.IsSet(FlagLastMessageID) {
+		lastMessageID = common.Int64Ptr(c.Int64(FlagLastMessageID))
+	}
+
+	for shardID := range getShards(c) {
+		ctx, cancel, err := newContext(c)
+		if err != nil {
+			cancel()
+			return commoncli.Problem("Error in creating context: ", err)
+		}
+		err = adminClient.PurgeHistoryTaskDLQMessages(ctx, &types.PurgeHistoryTaskDLQMessagesRequest{
+			ShardID:               int32(shardID),
+			Domain:                c.String(FlagDomain),
+			InclusiveEndMessageID: lastMessageID,
+		})
+		cancel()
+		if err != nil {
+			fmt.Printf("Failed to purge history task DLQ messages in shard %v with error: %v.\n", shardID, err)
+			continue
+		}
+		fmt.Printf("Successfully purged history task DLQ messages in shard %v.\n", shardID)
+	}
+	return nil
+}
+
+func newHistoryTaskDLQRow(message *types.HistoryTaskDLQMessage) HistoryTaskDLQRow {
+	return HistoryTaskDLQRow{
+		MessageID:  message.GetMessageID(),
+		ShardID:    message.GetShardID(),
+		DomainID:   message.GetDomainID(),
+		WorkflowID: message.GetWorkflowID(),
+		RunID:      message.GetRunID(),
+		Category:   message.GetTaskCategory(),
+		TaskType:   message.GetTaskType(),
+		TaskID:     message.GetTaskID(),
+		Attempt:    message.GetAttempt(),
+		Enqueued:   time.Unix(0, message.GetEnqueuedTimestampNano()),
+		LastError:  message.GetLastError(),
+	}
+}
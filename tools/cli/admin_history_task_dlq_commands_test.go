@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func (s *cliAppSuite) TestAdminDescribeHistoryTaskDLQMessage() {
+	testCases := []testcase{
+		{
+			name:    "happy",
+			command: `cadence admin history-task-dlq describe --shard_id 1 --message_id 5`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().DescribeHistoryTaskDLQMessage(gomock.Any(), &types.DescribeHistoryTaskDLQMessageRequest{ShardID: 1, MessageID: 5}).
+					Return(&types.DescribeHistoryTaskDLQMessageResponse{
+						Message: &types.HistoryTaskDLQMessage{
+							MessageID:    5,
+							ShardID:      1,
+							WorkflowID:   "workflow-id",
+							TaskCategory: types.HistoryTaskCategoryTransfer,
+							LastError:    "some error",
+						},
+					}, nil)
+			},
+		},
+		{
+			name:    "missing message id",
+			command: `cadence admin htdlq desc --sid 1`,
+			err:     "Required flag",
+		},
+		{
+			name:    "describe failed",
+			command: `cadence admin htdlq desc --sid 1 --mid 5`,
+			err:     "Failed to describe history task DLQ message",
+			mock: func() {
+				s.serverAdminClient.EXPECT().DescribeHistoryTaskDLQMessage(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{Message: "not found"})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
+func (s *cliAppSuite) TestAdminPurgeHistoryTaskDLQMessages() {
+	testCases := []testcase{
+		{
+			name:    "all domains",
+			command: `cadence admin htdlq purge --shards 1-2`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().PurgeHistoryTaskDLQMessages(gomock.Any(), &types.PurgeHistoryTaskDLQMessagesRequest{ShardID: 1}).Return(nil)
+				s.serverAdminClient.EXPECT().PurgeHistoryTaskDLQMessages(gomock.Any(), &types.PurgeHistoryTaskDLQMessagesRequest{ShardID: 2}).Return(nil)
+			},
+		},
+		{
+			name:    "scoped to domain",
+			command: `cadence admin htdlq purge --domain test-domain --shards 3 --lm 10`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().PurgeHistoryTaskDLQMessages(gomock.Any(), &types.PurgeHistoryTaskDLQMessagesRequest{
+					ShardID:               3,
+					Domain:                "test-domain",
+					InclusiveEndMessageID: common.Int64Ptr(10),
+				}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
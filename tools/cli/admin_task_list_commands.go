@@ -25,6 +25,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 
@@ -39,12 +40,25 @@ type (
 		PollerCount int    `header:"Poller Count"`
 	}
 	TaskListStatusRow struct {
-		ReadLevel int64   `header:"Read Level"`
-		AckLevel  int64   `header:"Ack Level"`
-		Backlog   int64   `header:"Backlog"`
-		RPS       float64 `header:"RPS"`
-		StartID   int64   `header:"Lease Start TaskID"`
-		EndID     int64   `header:"Lease End TaskID"`
+		ReadLevel         int64   `header:"Read Level"`
+		AckLevel          int64   `header:"Ack Level"`
+		Backlog           int64   `header:"Backlog"`
+		RPS               float64 `header:"RPS"`
+		SyncMatchRate     float64 `header:"Sync Match Rate"`
+		DispatchLatencyMs float64 `header:"Dispatch Latency (ms)"`
+		StartID           int64   `header:"Lease Start TaskID"`
+		EndID             int64   `header:"Lease End TaskID"`
+	}
+	TaskListScalingDecisionRow struct {
+		Time                    time.Time `header:"Time"`
+		Reason                  string    `header:"Reason"`
+		PreviousWritePartitions int32     `header:"Previous Write Partitions"`
+		WritePartitions         int32     `header:"Write Partitions"`
+		ReadPartitions          int32     `header:"Read Partitions"`
+		QPS                     float64   `header:"QPS"`
+		Backlog                 int64     `header:"Backlog"`
+		SyncMatchRate           float64   `header:"Sync Match Rate"`
+		DispatchLatencyMs       float64   `header:"Dispatch Latency (ms)"`
 	}
 	TaskListPartitionConfigRow struct {
 		Version         int64                            `header:"Version"`
@@ -55,7 +69,7 @@ type (
 
 // AdminDescribeTaskList displays poller and status information of task list.
 func AdminDescribeTaskList(c *cli.Context) error {
-	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	adminClient, err := getDeps(c).ServerAdminClient(c)
 	if err != nil {
 		return err
 	}
@@ -84,7 +98,8 @@ func AdminDescribeTaskList(c *cli.Context) error {
 		IncludeTaskListStatus: true,
 	}
 
-	response, err := frontendClient.DescribeTaskList(ctx, request)
+	// the admin API also returns the dispatch statistics and scaling decisions, which the published API does not carry
+	response, err := adminClient.DescribeTaskList(ctx, request)
 	if err != nil {
 		return commoncli.Problem("Operation DescribeTaskList failed.", err)
 	}
@@ -103,6 +118,12 @@ func AdminDescribeTaskList(c *cli.Context) error {
 		}
 		getDeps(c).Output().Write([]byte("\n"))
 	}
+	if len(response.ScalingDecisions) > 0 {
+		if err := printTaskListScalingDecisions(getDeps(c).Output(), response.ScalingDecisions); err != nil {
+			return fmt.Errorf("failed to print task list scaling decisions: %w", err)
+		}
+		getDeps(c).Output().Write([]byte("\n"))
+	}
 	pollers := response.Pollers
 	if len(pollers) == 0 {
 		return commoncli.Problem(colorMagenta("No poller for tasklist: "+taskList), nil)
@@ -147,12 +168,14 @@ func AdminListTaskList(c *cli.Context) error {
 
 func printTaskListStatus(w io.Writer, taskListStatus *types.TaskListStatus) error {
 	table := []TaskListStatusRow{{
-		ReadLevel: taskListStatus.GetReadLevel(),
-		AckLevel:  taskListStatus.GetAckLevel(),
-		Backlog:   taskListStatus.GetBacklogCountHint(),
-		RPS:       taskListStatus.GetRatePerSecond(),
-		StartID:   taskListStatus.GetTaskIDBlock().GetStartID(),
-		EndID:     taskListStatus.GetTaskIDBlock().GetEndID(),
+		ReadLevel:         taskListStatus.GetReadLevel(),
+		AckLevel:          taskListStatus.GetAckLevel(),
+		Backlog:           taskListStatus.GetBacklogCountHint(),
+		RPS:               taskListStatus.GetRatePerSecond(),
+		SyncMatchRate:     taskListStatus.GetSyncMatchRate(),
+		DispatchLatencyMs: taskListStatus.GetDispatchLatencyMs(),
+		StartID:           taskListStatus.GetTaskIDBlock().GetStartID(),
+		EndID:             taskListStatus.GetTaskIDBlock().GetEndID(),
 	}}
 	return RenderTable(w, table, RenderOptions{Color: true})
 }
@@ -166,6 +189,24 @@ func printTaskListPartitionConfig(w io.Writer, config *types.TaskListPartitionCo
 	return RenderTable(w, table, RenderOptions{Color: true})
 }
 
+func printTaskListScalingDecisions(w io.Writer, decisions []*types.TaskListScalingDecision) error {
+	table := make([]TaskListScalingDecisionRow, 0, len(decisions))
+	for _, d := range decisions {
+		table = append(table, TaskListScalingDecisionRow{
+			Time:                    time.Unix(0, d.Timestamp),
+			Reason:                  d.Reason,
+			PreviousWritePartitions: d.PreviousNumWritePartitions,
+			WritePartitions:         d.NumWritePartitions,
+			ReadPartitions:          d.NumReadPartitions,
+			QPS:                     d.QPS,
+			Backlog:                 d.BacklogCountHint,
+			SyncMatchRate:           d.SyncMatchRate,
+			DispatchLatencyMs:       d.DispatchLatencyMs,
+		})
+	}
+	return RenderTable(w, table, RenderOptions{Color: true})
+}
+
 func AdminUpdateTaskListPartitionConfig(c *cli.Context) error {
 	adminClient, err := getDeps(c).ServerAdminClient(c)
 	if err != nil {
@@ -25,6 +25,7 @@ package cli
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
@@ -49,17 +50,48 @@ func TestAdminDescribeTaskList(t *testing.T) {
 			BacklogCountHint: 10,
 		},
 	}
-	td.mockFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(expectedResponse, nil).Times(1)
+	td.mockAdminClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(expectedResponse, nil).Times(1)
 
 	cliCtx := newTaskListCLIContext(t, td.app)
 	err := AdminDescribeTaskList(cliCtx)
 	assert.NoError(t, err)
 }
 
+func TestAdminDescribeTaskList_WithScalingDecisions(t *testing.T) {
+	td := newCLITestData(t)
+
+	expectedResponse := &types.DescribeTaskListResponse{
+		Pollers: []*types.PollerInfo{
+			{
+				Identity: "test-poller",
+			},
+		},
+		TaskListStatus: &types.TaskListStatus{
+			BacklogCountHint: 10,
+		},
+		ScalingDecisions: []*types.TaskListScalingDecision{
+			{
+				Timestamp:                  time.Now().UnixNano(),
+				Reason:                     "backlog",
+				PreviousNumWritePartitions: 1,
+				NumWritePartitions:         2,
+				NumReadPartitions:          2,
+				BacklogCountHint:           2000,
+			},
+		},
+	}
+	td.mockAdminClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(expectedResponse, nil).Times(1)
+
+	cliCtx := newTaskListCLIContext(t, td.app)
+	err := AdminDescribeTaskList(cliCtx)
+	assert.NoError(t, err)
+	assert.Contains(t, td.consoleOutput(), "backlog")
+}
+
 func TestAdminDescribeTaskList_DescribeTaskListFails(t *testing.T) {
 	td := newCLITestData(t)
 
-	td.mockFrontendClient.EXPECT().
+	td.mockAdminClient.EXPECT().
 		DescribeTaskList(gomock.Any(), gomock.Any()).
 		Return(nil, fmt.Errorf("DescribeTaskList failed")).
 		Times(1)
@@ -77,7 +109,7 @@ func TestAdminDescribeTaskList_NoTaskListStatus(t *testing.T) {
 		TaskListStatus: nil,
 	}
 
-	td.mockFrontendClient.EXPECT().
+	td.mockAdminClient.EXPECT().
 		DescribeTaskList(gomock.Any(), gomock.Any()).
 		Return(expectedResponse, nil).
 		Times(1)
@@ -96,7 +128,7 @@ func TestAdminDescribeTaskList_NoPollers(t *testing.T) {
 		},
 	}
 
-	td.mockFrontendClient.EXPECT().
+	td.mockAdminClient.EXPECT().
 		DescribeTaskList(gomock.Any(), gomock.Any()).
 		Return(expectedResponse, nil).
 		Times(1)
@@ -149,7 +181,7 @@ func TestAdminDescribeTaskList_InvalidTaskListType(t *testing.T) {
 			BacklogCountHint: 10,
 		},
 	}
-	td.mockFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(expectedResponse, nil).Times(1)
+	td.mockAdminClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(expectedResponse, nil).Times(1)
 
 	cliCtx := clitest.NewCLIContext(
 		t,
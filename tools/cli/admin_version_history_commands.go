@@ -0,0 +1,239 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// VersionHistoryRow is a single branch of the version history tree of a workflow as seen by one cluster
+type VersionHistoryRow struct {
+	Cluster     string `header:"Cluster" json:"cluster"`
+	Index       int    `header:"Index" json:"index"`
+	Current     bool   `header:"Current" json:"current"`
+	BranchID    string `header:"Branch ID" json:"branchID"`
+	Items       string `header:"Items (EventID:Version)" json:"items"`
+	BranchToken string `header:"Branch Token" json:"branchToken"`
+}
+
+// AdminDescribeWorkflowVersionHistories renders the version history branches of a workflow,
+// optionally comparing the current branch with the one of another cluster
+func AdminDescribeWorkflowVersionHistories(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	execution := &types.WorkflowExecution{
+		WorkflowID: wid,
+		RunID:      c.String(FlagRunID),
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	clusters := []string{c.String(FlagCluster)}
+	if c.IsSet(FlagCompareCluster) {
+		clusters = append(clusters, c.String(FlagCompareCluster))
+	}
+	responses := make([]*types.DescribeWorkflowVersionHistoriesResponse, 0, len(clusters))
+	for _, cluster := range clusters {
+		response, err := adminClient.DescribeWorkflowVersionHistories(ctx, &types.DescribeWorkflowVersionHistoriesRequest{
+			Domain:    domain,
+			Execution: execution,
+			Cluster:   cluster,
+		})
+		if err != nil {
+			return commoncli.Problem("Operation DescribeWorkflowVersionHistories failed.", err)
+		}
+		// pin the run so that both clusters are describing the same execution
+		execution = response.Execution
+		responses = append(responses, response)
+	}
+
+	var table []VersionHistoryRow
+	for _, response := range responses {
+		table = append(table, versionHistoryRows(response)...)
+	}
+	if err := Render(c, table, RenderOptions{Color: true, DefaultTemplate: templateTable}); err != nil {
+		return err
+	}
+
+	if len(responses) < 2 {
+		return nil
+	}
+	summary, err := compareCurrentVersionHistories(responses[0], responses[1])
+	if err != nil {
+		return commoncli.Problem("Failed to compare version histories", err)
+	}
+	fmt.Fprintln(getDeps(c).Output(), summary)
+	return nil
+}
+
+// AdminRebuildWorkflowBranch rebuilds the mutable state of a workflow from the events of one of its branches
+func AdminRebuildWorkflowBranch(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	var branchToken []byte
+	if c.IsSet(FlagBranchToken) {
+		branchToken, err = decodeUserInput(c.String(FlagBranchToken), "hex")
+		if err != nil {
+			return commoncli.Problem("Invalid branch token", err)
+		}
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	err = adminClient.RebuildWorkflowBranch(ctx, &types.RebuildWorkflowBranchRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      c.String(FlagRunID),
+		},
+		BranchToken: branchToken,
+		Cluster:     c.String(FlagCluster),
+	})
+	if err != nil {
+		return commoncli.Problem("Rebuild workflow branch failed", err)
+	}
+	fmt.Fprintln(getDeps(c).Output(), "Rebuild workflow branch succeeded.")
+	return nil
+}
+
+func versionHistoryRows(response *types.DescribeWorkflowVersionHistoriesResponse) []VersionHistoryRow {
+	histories := response.GetVersionHistories()
+	if histories == nil {
+		return nil
+	}
+	rows := make([]VersionHistoryRow, 0, len(histories.Histories))
+	for index, history := range histories.Histories {
+		items := make([]string, 0, len(history.GetItems()))
+		for _, item := range history.GetItems() {
+			items = append(items, fmt.Sprintf("%d:%d", item.EventID, item.Version))
+		}
+		rows = append(rows, VersionHistoryRow{
+			Cluster:     response.GetCluster(),
+			Index:       index,
+			Current:     int32(index) == histories.CurrentVersionHistoryIndex,
+			BranchID:    decodeBranchID(history.BranchToken),
+			Items:       strings.Join(items, " "),
+			BranchToken: hex.EncodeToString(history.BranchToken),
+		})
+	}
+	return rows
+}
+
+// decodeBranchID returns an empty string for tokens that are not thrift encoded history branches
+func decodeBranchID(branchToken []byte) string {
+	branch := shared.HistoryBranch{}
+	if err := codec.NewThriftRWEncoder().Decode(branchToken, &branch); err != nil {
+		return ""
+	}
+	return branch.GetBranchID()
+}
+
+// compareCurrentVersionHistories describes how the current branches of two clusters relate to each other.
+// Branch tokens are local to a cluster so only the version history items are compared.
+func compareCurrentVersionHistories(
+	local *types.DescribeWorkflowVersionHistoriesResponse,
+	remote *types.DescribeWorkflowVersionHistoriesResponse,
+) (string, error) {
+	localHistory, err := currentVersionHistory(local)
+	if err != nil {
+		return "", err
+	}
+	remoteHistory, err := currentVersionHistory(remote)
+	if err != nil {
+		return "", err
+	}
+	lcaItem, err := localHistory.FindLCAItem(remoteHistory)
+	if err != nil {
+		return "", err
+	}
+	localLastItem, err := localHistory.GetLastItem()
+	if err != nil {
+		return "", err
+	}
+	remoteLastItem, err := remoteHistory.GetLastItem()
+	if err != nil {
+		return "", err
+	}
+
+	localName, remoteName := local.GetCluster(), remote.GetCluster()
+	localIsLCA, remoteIsLCA := localLastItem.Equals(lcaItem), remoteLastItem.Equals(lcaItem)
+	switch {
+	case localIsLCA && remoteIsLCA:
+		return fmt.Sprintf("Current branches of %v and %v are identical, last event %v at version %v.",
+			localName, remoteName, lcaItem.EventID, lcaItem.Version), nil
+	case localIsLCA:
+		return fmt.Sprintf("Current branch of %v is behind %v: %v is at event %v version %v, %v is at event %v version %v.",
+			localName, remoteName, localName, localLastItem.EventID, localLastItem.Version, remoteName, remoteLastItem.EventID, remoteLastItem.Version), nil
+	case remoteIsLCA:
+		return fmt.Sprintf("Current branch of %v is behind %v: %v is at event %v version %v, %v is at event %v version %v.",
+			remoteName, localName, remoteName, remoteLastItem.EventID, remoteLastItem.Version, localName, localLastItem.EventID, localLastItem.Version), nil
+	default:
+		return fmt.Sprintf("Current branches of %v and %v diverged after event %v version %v: %v is at event %v version %v, %v is at event %v version %v.",
+			localName, remoteName, lcaItem.EventID, lcaItem.Version,
+			localName, localLastItem.EventID, localLastItem.Version, remoteName, remoteLastItem.EventID, remoteLastItem.Version), nil
+	}
+}
+
+func currentVersionHistory(response *types.DescribeWorkflowVersionHistoriesResponse) (*persistence.VersionHistory, error) {
+	if response.GetVersionHistories() == nil {
+		return nil, fmt.Errorf("cluster %v returned no version histories", response.GetCluster())
+	}
+	return persistence.NewVersionHistoriesFromInternalType(response.GetVersionHistories()).GetCurrentVersionHistory()
+}
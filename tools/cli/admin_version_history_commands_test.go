@@ -0,0 +1,91 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestCompareCurrentVersionHistories(t *testing.T) {
+	response := func(cluster string, items ...*types.VersionHistoryItem) *types.DescribeWorkflowVersionHistoriesResponse {
+		return &types.DescribeWorkflowVersionHistoriesResponse{
+			Cluster: cluster,
+			VersionHistories: &types.VersionHistories{
+				Histories: []*types.VersionHistory{{BranchToken: []byte(cluster), Items: items}},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		local   *types.DescribeWorkflowVersionHistoriesResponse
+		remote  *types.DescribeWorkflowVersionHistoriesResponse
+		want    string
+		wantErr bool
+	}{
+		"identical": {
+			local:  response("active", &types.VersionHistoryItem{EventID: 3, Version: 1}, &types.VersionHistoryItem{EventID: 7, Version: 2}),
+			remote: response("standby", &types.VersionHistoryItem{EventID: 3, Version: 1}, &types.VersionHistoryItem{EventID: 7, Version: 2}),
+			want:   "Current branches of active and standby are identical, last event 7 at version 2.",
+		},
+		"local behind": {
+			local:  response("active", &types.VersionHistoryItem{EventID: 3, Version: 1}),
+			remote: response("standby", &types.VersionHistoryItem{EventID: 3, Version: 1}, &types.VersionHistoryItem{EventID: 7, Version: 2}),
+			want:   "Current branch of active is behind standby: active is at event 3 version 1, standby is at event 7 version 2.",
+		},
+		"remote behind": {
+			local:  response("active", &types.VersionHistoryItem{EventID: 5, Version: 1}),
+			remote: response("standby", &types.VersionHistoryItem{EventID: 3, Version: 1}),
+			want:   "Current branch of standby is behind active: standby is at event 3 version 1, active is at event 5 version 1.",
+		},
+		"diverged": {
+			local:  response("active", &types.VersionHistoryItem{EventID: 3, Version: 1}, &types.VersionHistoryItem{EventID: 7, Version: 2}),
+			remote: response("standby", &types.VersionHistoryItem{EventID: 5, Version: 1}, &types.VersionHistoryItem{EventID: 6, Version: 3}),
+			want:   "Current branches of active and standby diverged after event 3 version 1: active is at event 7 version 2, standby is at event 6 version 3.",
+		},
+		"no common ancestor": {
+			local:   response("active", &types.VersionHistoryItem{EventID: 3, Version: 1}),
+			remote:  response("standby", &types.VersionHistoryItem{EventID: 3, Version: 2}),
+			wantErr: true,
+		},
+		"missing version histories": {
+			local:   response("active", &types.VersionHistoryItem{EventID: 3, Version: 1}),
+			remote:  &types.DescribeWorkflowVersionHistoriesResponse{Cluster: "standby"},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := compareCurrentVersionHistories(tt.local, tt.remote)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
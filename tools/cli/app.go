@@ -209,6 +209,12 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 					Usage:       "Run admin operation on DLQ",
 					Subcommands: newAdminDLQCommands(),
 				},
+				{
+					Name:        "history-task-dlq",
+					Aliases:     []string{"htdlq"},
+					Usage:       "Run admin operation on the DLQ of permanently failing history transfer and timer tasks",
+					Subcommands: newAdminHistoryTaskDLQCommands(),
+				},
 				{
 					Name:        "database",
 					Aliases:     []string{"db"},
@@ -233,6 +239,11 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 					Usage:       "Run admin operation on config store",
 					Subcommands: newAdminConfigStoreCommands(),
 				},
+				{
+					Name:        "audit",
+					Usage:       "Run admin operation on audit log",
+					Subcommands: newAdminAuditCommands(),
+				},
 			},
 		},
 		{
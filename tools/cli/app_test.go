@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -38,12 +39,14 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/domainmigration"
 )
 
 type (
@@ -249,6 +252,136 @@ func (s *cliAppSuite) TestDomainDeprecate_Failed_Force() {
 	s.Error(s.app.Run([]string{"", "--do", domainName, "domain", "deprecate", "--force"}))
 }
 
+func (s *cliAppSuite) TestAdminDeleteDomain() {
+	testCases := []testcase{
+		{
+			name:    "happy",
+			command: `cadence admin domain delete --domain test-domain --st token`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().DeleteDomain(gomock.Any(), &types.DeleteDomainRequest{
+					Name:          "test-domain",
+					SecurityToken: "token",
+				}).Return(&types.DeleteDomainResponse{WorkflowID: "cadence-sys-domain-deletion-id", RunID: "run-id"}, nil)
+			},
+		},
+		{
+			name:    "missing domain",
+			command: `cadence admin domain delete`,
+			err:     "domain",
+		},
+		{
+			name:    "delete failed",
+			command: `cadence admin domain delete --domain test-domain`,
+			err:     "Operation DeleteDomain failed",
+			mock: func() {
+				s.serverAdminClient.EXPECT().DeleteDomain(gomock.Any(), gomock.Any()).
+					Return(nil, &types.BadRequestError{Message: "Domain has to be deprecated before it can be deleted."})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
+func (s *cliAppSuite) TestAdminRenameDomain() {
+	testCases := []testcase{
+		{
+			name:    "happy",
+			command: `cadence admin domain rename --domain test-domain --new_name new-domain --st token`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().RenameDomain(gomock.Any(), &types.RenameDomainRequest{
+					Name:          "test-domain",
+					NewName:       "new-domain",
+					SecurityToken: "token",
+				}).Return(&types.RenameDomainResponse{DomainID: "test-domain-id", PreviousNameExpiryTimestamp: time.Now().UnixNano()}, nil)
+			},
+		},
+		{
+			name:    "missing new name",
+			command: `cadence admin domain rename --domain test-domain`,
+			err:     "new_name",
+		},
+		{
+			name:    "rename failed",
+			command: `cadence admin domain rename --domain test-domain --new_name new-domain`,
+			err:     "Operation RenameDomain failed",
+			mock: func() {
+				s.serverAdminClient.EXPECT().RenameDomain(gomock.Any(), gomock.Any()).
+					Return(nil, &types.DomainAlreadyExistsError{Message: "Domain already exists."})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
+func (s *cliAppSuite) TestAdminDomainMigration() {
+	testCases := []testcase{
+		{
+			name:    "start",
+			command: `cadence admin domain migrate start --domain test-domain --destination_domain new-domain --workflow_type wf-a --workflow_type wf-b`,
+			mock: func() {
+				s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						s.Equal(common.SystemLocalDomainName, req.Domain)
+						s.Equal(domainmigration.WorkflowIDPrefix+"test-domain", req.WorkflowID)
+						s.Equal(domainmigration.WorkflowTypeName, req.WorkflowType.GetName())
+						s.JSONEq(`{"SourceDomain":"test-domain","TargetDomain":"new-domain","WorkflowTypes":["wf-a","wf-b"],"NextPageToken":null,"Progress":null}`, string(req.Input))
+						return &types.StartWorkflowExecutionResponse{RunID: uuid.New()}, nil
+					})
+			},
+		},
+		{
+			name:    "start with same domains",
+			command: `cadence admin domain migrate start --domain test-domain --destination_domain test-domain`,
+			err:     "Source and destination domain must be different",
+		},
+		{
+			name:    "start without workflow types",
+			command: `cadence admin domain migrate start --domain test-domain --destination_domain new-domain`,
+			err:     "workflow_type",
+		},
+		{
+			name:    "start without destination domain",
+			command: `cadence admin domain migrate start --domain test-domain`,
+			err:     "destination_domain",
+		},
+		{
+			name:    "progress",
+			command: `cadence admin domain migrate progress --domain test-domain`,
+			mock: func() {
+				s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(&types.QueryWorkflowResponse{QueryResult: []byte(`{"State":"running","Migrated":10}`)}, nil)
+				s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{}}, nil)
+			},
+		},
+		{
+			name:    "progress query failed",
+			command: `cadence admin domain migrate progress --domain test-domain`,
+			err:     "Failed to query domain migration workflow",
+			mock: func() {
+				s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
 func (s *cliAppSuite) TestDomainDescribe() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -594,6 +727,124 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Error(s.app.Run(([]string{"", "--do", domainName, "admin", "wf", "describe", "-w", "test-wf-id"})))
 }
 
+func (s *cliAppSuite) TestAdminDescribeWorkflowVersionHistories() {
+	execution := &types.WorkflowExecution{WorkflowID: "test-wf-id", RunID: "test-run-id"}
+	activeResponse := &types.DescribeWorkflowVersionHistoriesResponse{
+		Cluster:   "active",
+		Execution: execution,
+		VersionHistories: &types.VersionHistories{
+			CurrentVersionHistoryIndex: 1,
+			Histories: []*types.VersionHistory{
+				{BranchToken: []byte{1}, Items: []*types.VersionHistoryItem{{EventID: 5, Version: 1}}},
+				{BranchToken: []byte{2}, Items: []*types.VersionHistoryItem{{EventID: 3, Version: 1}, {EventID: 7, Version: 2}}},
+			},
+		},
+	}
+	standbyResponse := &types.DescribeWorkflowVersionHistoriesResponse{
+		Cluster:   "standby",
+		Execution: execution,
+		VersionHistories: &types.VersionHistories{
+			Histories: []*types.VersionHistory{
+				{BranchToken: []byte{3}, Items: []*types.VersionHistoryItem{{EventID: 5, Version: 1}}},
+			},
+		},
+	}
+	testCases := []testcase{
+		{
+			name:    "current cluster",
+			command: `cadence --do test-domain admin wf version-histories -w test-wf-id`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().DescribeWorkflowVersionHistories(gomock.Any(), &types.DescribeWorkflowVersionHistoriesRequest{
+					Domain:    "test-domain",
+					Execution: &types.WorkflowExecution{WorkflowID: "test-wf-id"},
+				}).Return(activeResponse, nil)
+			},
+		},
+		{
+			name:    "compare clusters",
+			command: `cadence --do test-domain admin wf vh -w test-wf-id --compare_cluster standby`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().DescribeWorkflowVersionHistories(gomock.Any(), &types.DescribeWorkflowVersionHistoriesRequest{
+					Domain:    "test-domain",
+					Execution: &types.WorkflowExecution{WorkflowID: "test-wf-id"},
+				}).Return(activeResponse, nil)
+				s.serverAdminClient.EXPECT().DescribeWorkflowVersionHistories(gomock.Any(), &types.DescribeWorkflowVersionHistoriesRequest{
+					Domain:    "test-domain",
+					Execution: execution,
+					Cluster:   "standby",
+				}).Return(standbyResponse, nil)
+			},
+		},
+		{
+			name:    "missing workflow ID",
+			command: `cadence --do test-domain admin wf version-histories`,
+			err:     "Required flag not found",
+		},
+		{
+			name:    "describe failed",
+			command: `cadence --do test-domain admin wf version-histories -w test-wf-id --cluster unknown`,
+			err:     "Operation DescribeWorkflowVersionHistories failed",
+			mock: func() {
+				s.serverAdminClient.EXPECT().DescribeWorkflowVersionHistories(gomock.Any(), gomock.Any()).
+					Return(nil, &types.BadRequestError{Message: "Cluster unknown is not a remote cluster."})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
+func (s *cliAppSuite) TestAdminRebuildWorkflowBranch() {
+	testCases := []testcase{
+		{
+			name:    "current branch",
+			command: `cadence --do test-domain admin wf rebuild-branch -w test-wf-id -r test-run-id`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().RebuildWorkflowBranch(gomock.Any(), &types.RebuildWorkflowBranchRequest{
+					Domain:    "test-domain",
+					Execution: &types.WorkflowExecution{WorkflowID: "test-wf-id", RunID: "test-run-id"},
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "branch of remote cluster",
+			command: `cadence --do test-domain admin wf rb -w test-wf-id --branch_token 0a0b --cluster standby`,
+			mock: func() {
+				s.serverAdminClient.EXPECT().RebuildWorkflowBranch(gomock.Any(), &types.RebuildWorkflowBranchRequest{
+					Domain:      "test-domain",
+					Execution:   &types.WorkflowExecution{WorkflowID: "test-wf-id"},
+					BranchToken: []byte{10, 11},
+					Cluster:     "standby",
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "invalid branch token",
+			command: `cadence --do test-domain admin wf rebuild-branch -w test-wf-id --branch_token xyz`,
+			err:     "Invalid branch token",
+		},
+		{
+			name:    "rebuild failed",
+			command: `cadence --do test-domain admin wf rebuild-branch -w test-wf-id`,
+			err:     "Rebuild workflow branch failed",
+			mock: func() {
+				s.serverAdminClient.EXPECT().RebuildWorkflowBranch(gomock.Any(), gomock.Any()).
+					Return(&types.BadRequestError{Message: "branch token does not match any version history of the workflow"})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	var promptMsg string
 	promptFn = func(msg string) {
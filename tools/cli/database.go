@@ -258,6 +258,7 @@ func (f *defaultManagerFactory) initPersistenceFactory(c *cli.Context) (client.F
 	return client.NewFactory(
 		&cfg.Persistence,
 		func() float64 { return rps },
+		nil,
 		cfg.ClusterGroupMetadata.CurrentClusterName,
 		metrics.NewNoopMetricsClient(),
 		log.NewNoop(),
@@ -120,7 +120,7 @@ func newDomainCommands() []*cli.Command {
 		{
 			Name:    "migration",
 			Aliases: []string{"mi"},
-			Usage:   "Migrate existing domain to new domain. This command only validates the settings. It does not perform actual data migration",
+			Usage:   "Migrate existing domain to new domain. This command only validates the settings, use 'admin domain migrate start' to move the open workflows",
 			Flags:   migrateDomainFlags,
 			Action: func(c *cli.Context) error {
 				err := checkNoAdditionalArgsPassed(c)
@@ -141,7 +141,7 @@ func (d *domainCLIImpl) RegisterDomain(c *cli.Context) error {
 		Name:                                   domainName,
 		Description:                            description,
 		OwnerEmail:                             ownerEmail,
-		Data:                                   domainData.Value(),
+		Data:                                   withReplicationFilters(c, domainData.Value()),
 		WorkflowExecutionRetentionPeriodInDays: int32(retentionDays),
 		Clusters:                               clusters,
 		ActiveClusterName:                      activeClusterName,
@@ -270,7 +270,7 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) error {
 			Name:                                   domainName,
 			Description:                            common.StringPtr(description),
 			OwnerEmail:                             common.StringPtr(ownerEmail),
-			Data:                                   domainData.Value(),
+			Data:                                   withReplicationFilters(c, domainData.Value()),
 			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(retentionDays),
 			EmitMetric:                             common.BoolPtr(emitMetric),
 			HistoryArchivalStatus:                  has,
@@ -296,6 +296,20 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) error {
 	return nil
 }
 
+// withReplicationFilters adds the replication filters given by flag to the domain data,
+// they are JSON encoded and cannot be passed through the domain data flag
+func withReplicationFilters(c *cli.Context, domainData map[string]string) map[string]string {
+	if !c.IsSet(FlagReplicationFilters) {
+		return domainData
+	}
+	data := make(map[string]string, len(domainData)+1)
+	for k, v := range domainData {
+		data[k] = v
+	}
+	data[common.DomainDataKeyForReplicationFilters] = c.String(FlagReplicationFilters)
+	return data
+}
+
 func (d *domainCLIImpl) DeprecateDomain(c *cli.Context) error {
 	domainName, err := getRequiredOption(c, FlagDomain)
 	if err != nil {
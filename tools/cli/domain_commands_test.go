@@ -57,6 +57,22 @@ func (s *cliAppSuite) TestDomainRegister() {
 				}).Return(nil)
 			},
 		},
+		{
+			"global with replication filters",
+			`cadence --do test-domain domain register --global_domain true --domain_data key1=value1 --replication_filters '[{"searchAttribute":"Region","searchAttributeValue":"us-east"}]'`,
+			"",
+			func() {
+				s.serverFrontendClient.EXPECT().RegisterDomain(gomock.Any(), &types.RegisterDomainRequest{
+					Name:                                   "test-domain",
+					WorkflowExecutionRetentionPeriodInDays: 3,
+					IsGlobalDomain:                         true,
+					Data: map[string]string{
+						"key1": "value1",
+						common.DomainDataKeyForReplicationFilters: `[{"searchAttribute":"Region","searchAttributeValue":"us-east"}]`,
+					},
+				}).Return(nil)
+			},
+		},
 		{
 			"domain with other options",
 			"cadence --do test-domain domain register --global_domain true --retention 5 --desc description --active_cluster c1 --clusters c1,c2 --domain_data key1=value1,key2=value2",
@@ -195,6 +211,28 @@ func (s *cliAppSuite) TestDomainUpdate() {
 	}
 
 	testCases := []testcase{
+		{
+			"update replication filters",
+			`cadence --do test-domain domain update --replication_filters '[{"workflowType":"wType"}]'`,
+			"",
+			func() {
+				s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{
+					Name: common.StringPtr("test-domain"),
+				}).Return(describeResponse, nil)
+				s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+					Name:        "test-domain",
+					Description: common.StringPtr("a test domain"),
+					OwnerEmail:  common.StringPtr("test@cadence.io"),
+					Data: map[string]string{
+						common.DomainDataKeyForReplicationFilters: `[{"workflowType":"wType"}]`,
+					},
+					WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(3),
+					EmitMetric:                             common.BoolPtr(false),
+					HistoryArchivalURI:                     common.StringPtr(""),
+					VisibilityArchivalURI:                  common.StringPtr(""),
+				}).Return(&types.UpdateDomainResponse{}, nil)
+			},
+		},
 		{
 			"update nothing",
 			"cadence --do test-domain domain update",
@@ -89,6 +89,10 @@ var (
 			Usage:   "Domain data of key value pairs (must be in key1=value1,key2=value2,...,keyN=valueN format, e.g. cluster=dca or cluster=dca,instance=cadence)",
 			Value:   &flag.StringMap{},
 		},
+		&cli.StringFlag{
+			Name:  FlagReplicationFilters,
+			Usage: "JSON list of filters excluding workflows from cross cluster replication, e.g. '[{\"workflowType\":\"wType\"},{\"searchAttribute\":\"Region\",\"searchAttributeValue\":\"us-east\"}]'",
+		},
 		&cli.StringFlag{
 			Name:    FlagSecurityToken,
 			Aliases: []string{"st"},
@@ -147,6 +151,10 @@ var (
 			Usage: "Domain data of key value pairs (must be in key1=value1,key2=value2,...,keyN=valueN format, e.g. cluster=dca or cluster=dca,instance=cadence)",
 			Value: &flag.StringMap{},
 		},
+		&cli.StringFlag{
+			Name:  FlagReplicationFilters,
+			Usage: "JSON list of filters excluding workflows from cross cluster replication, e.g. '[{\"workflowType\":\"wType\"},{\"searchAttribute\":\"Region\",\"searchAttributeValue\":\"us-east\"}]'",
+		},
 		&cli.StringFlag{
 			Name:    FlagSecurityToken,
 			Aliases: []string{"st"},
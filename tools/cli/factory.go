@@ -45,6 +45,8 @@ import (
 
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	adminextv1 "github.com/uber/cadence/.gen/proto/adminext/v1"
+	apiextv1 "github.com/uber/cadence/.gen/proto/apiext/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
@@ -130,9 +132,10 @@ func (b *clientFactory) ServerFrontendClient(c *cli.Context) (frontend.Client, e
 			apiv1.NewWorkflowAPIYARPCClient(clientConfig),
 			apiv1.NewWorkerAPIYARPCClient(clientConfig),
 			apiv1.NewVisibilityAPIYARPCClient(clientConfig),
+			apiextv1.NewWorkflowExtAPIYARPCClient(clientConfig),
 		), nil
 	}
-	return thrift.NewFrontendClient(serverFrontend.New(clientConfig)), nil
+	return thrift.NewFrontendClient(serverFrontend.New(clientConfig), apiextv1.NewWorkflowExtAPIYARPCClient(clientConfig)), nil
 }
 
 // ServerAdminClient builds an admin client (based on server side thrift interface)
@@ -143,9 +146,9 @@ func (b *clientFactory) ServerAdminClient(c *cli.Context) (admin.Client, error)
 	}
 	clientConfig := b.dispatcher.ClientConfig(cadenceFrontendService)
 	if c.String(FlagTransport) == grpcTransport {
-		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig)), nil
+		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig), adminextv1.NewAdminExtAPIYARPCClient(clientConfig)), nil
 	}
-	return thrift.NewAdminClient(serverAdmin.New(clientConfig)), nil
+	return thrift.NewAdminClient(serverAdmin.New(clientConfig), adminextv1.NewAdminExtAPIYARPCClient(clientConfig)), nil
 }
 
 // ServerFrontendClientForMigration builds a frontend client (based on server side thrift interface)
@@ -161,9 +164,10 @@ func (b *clientFactory) ServerFrontendClientForMigration(c *cli.Context) (fronte
 			apiv1.NewWorkflowAPIYARPCClient(clientConfig),
 			apiv1.NewWorkerAPIYARPCClient(clientConfig),
 			apiv1.NewVisibilityAPIYARPCClient(clientConfig),
+			apiextv1.NewWorkflowExtAPIYARPCClient(clientConfig),
 		), nil
 	}
-	return thrift.NewFrontendClient(serverFrontend.New(clientConfig)), nil
+	return thrift.NewFrontendClient(serverFrontend.New(clientConfig), apiextv1.NewWorkflowExtAPIYARPCClient(clientConfig)), nil
 }
 
 // ServerAdminClientForMigration builds an admin client (based on server side thrift interface)
@@ -174,9 +178,9 @@ func (b *clientFactory) ServerAdminClientForMigration(c *cli.Context) (admin.Cli
 	}
 	clientConfig := b.dispatcherMigration.ClientConfig(cadenceFrontendService)
 	if c.String(FlagTransport) == grpcTransport {
-		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig)), nil
+		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig), adminextv1.NewAdminExtAPIYARPCClient(clientConfig)), nil
 	}
-	return thrift.NewAdminClient(serverAdmin.New(clientConfig)), nil
+	return thrift.NewAdminClient(serverAdmin.New(clientConfig), adminextv1.NewAdminExtAPIYARPCClient(clientConfig)), nil
 }
 
 // ElasticSearchClient builds an ElasticSearch client
@@ -0,0 +1,215 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/grpc"
+	"go.uber.org/yarpc/transport/tchannel"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/admin"
+	grpcHandler "github.com/uber/cadence/service/frontend/wrappers/grpc"
+	thriftHandler "github.com/uber/cadence/service/frontend/wrappers/thrift"
+)
+
+// startTestFrontend serves the given admin handler the same way the frontend does:
+// thrift and proto procedures registered on one dispatcher with TChannel and gRPC inbounds.
+func startTestFrontend(t *testing.T, handler admin.Handler) (tchannelAddress, grpcAddress string) {
+	ch, err := tchannel.NewChannelTransport(tchannel.ServiceName(cadenceFrontendService), tchannel.ListenAddr("127.0.0.1:0"))
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name:     cadenceFrontendService,
+		Inbounds: yarpc.Inbounds{ch.NewInbound(), grpc.NewTransport().NewInbound(listener)},
+	})
+	thriftHandler.NewAdminHandler(handler).Register(dispatcher)
+	grpcHandler.NewAdminHandler(handler).Register(dispatcher)
+	require.NoError(t, dispatcher.Start())
+	t.Cleanup(func() { assert.NoError(t, dispatcher.Stop()) })
+
+	return ch.Channel().PeerInfo().HostPort, listener.Addr().String()
+}
+
+func TestAdminCommandsReachFrontend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := admin.NewMockHandler(ctrl)
+	tchannelAddress, grpcAddress := startTestFrontend(t, handler)
+
+	tests := []struct {
+		name    string
+		command []string
+		mock    func()
+	}{
+		{
+			name:    "update activity options",
+			command: []string{"--domain", "test-domain", "workflow", "activity", "update", "--wid", "wid", "--aid", "aid", "--reset_attempts"},
+			mock: func() {
+				handler.EXPECT().UpdateActivityOptions(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.UpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error) {
+						assert.Equal(t, "test-domain", request.Domain)
+						assert.Equal(t, "wid", request.Execution.WorkflowID)
+						assert.Equal(t, "aid", request.ActivityID)
+						assert.True(t, request.ResetAttempts)
+						return &types.UpdateActivityOptionsResponse{}, nil
+					})
+			},
+		},
+		{
+			name:    "list audit log entries",
+			command: []string{"admin", "audit", "list", "--domain", "test-domain"},
+			mock: func() {
+				handler.EXPECT().ListAuditLogEntries(gomock.Any(), &types.ListAuditLogEntriesRequest{Domain: "test-domain", PageSize: 100}).
+					Return(&types.ListAuditLogEntriesResponse{Entries: []*types.AuditLogEntry{{Actor: "alice", Domain: "test-domain", Outcome: types.AuditLogOutcomeSuccess}}}, nil)
+			},
+		},
+		{
+			name:    "read history task DLQ messages",
+			command: []string{"admin", "htdlq", "read", "--domain", "test-domain", "--shards", "1"},
+			mock: func() {
+				handler.EXPECT().ReadHistoryTaskDLQMessages(gomock.Any(), &types.ReadHistoryTaskDLQMessagesRequest{ShardID: 1, Domain: "test-domain", MaximumPageSize: defaultPageSize}).
+					Return(&types.ReadHistoryTaskDLQMessagesResponse{Messages: []*types.HistoryTaskDLQMessage{{MessageID: 5, ShardID: 1, TaskCategory: types.HistoryTaskCategoryTransfer}}}, nil)
+			},
+		},
+		{
+			name:    "describe history task DLQ message",
+			command: []string{"admin", "htdlq", "describe", "--shard_id", "1", "--message_id", "5"},
+			mock: func() {
+				handler.EXPECT().DescribeHistoryTaskDLQMessage(gomock.Any(), &types.DescribeHistoryTaskDLQMessageRequest{ShardID: 1, MessageID: 5}).
+					Return(&types.DescribeHistoryTaskDLQMessageResponse{Message: &types.HistoryTaskDLQMessage{MessageID: 5, ShardID: 1, TaskCategory: types.HistoryTaskCategoryTimer}}, nil)
+			},
+		},
+		{
+			name:    "merge history task DLQ messages",
+			command: []string{"admin", "htdlq", "merge", "--domain", "test-domain", "--shards", "1", "--lm", "5"},
+			mock: func() {
+				handler.EXPECT().MergeHistoryTaskDLQMessages(gomock.Any(), &types.MergeHistoryTaskDLQMessagesRequest{ShardID: 1, Domain: "test-domain", InclusiveEndMessageID: common.Int64Ptr(5), MaximumPageSize: defaultPageSize}).
+					Return(&types.MergeHistoryTaskDLQMessagesResponse{}, nil)
+			},
+		},
+		{
+			name:    "purge history task DLQ messages",
+			command: []string{"admin", "htdlq", "purge", "--domain", "test-domain", "--shards", "1"},
+			mock: func() {
+				handler.EXPECT().PurgeHistoryTaskDLQMessages(gomock.Any(), &types.PurgeHistoryTaskDLQMessagesRequest{ShardID: 1, Domain: "test-domain"}).
+					Return(nil)
+			},
+		},
+		{
+			name:    "delete domain",
+			command: []string{"admin", "domain", "delete", "--domain", "test-domain", "--st", "token"},
+			mock: func() {
+				handler.EXPECT().DeleteDomain(gomock.Any(), &types.DeleteDomainRequest{Name: "test-domain", SecurityToken: "token"}).
+					Return(&types.DeleteDomainResponse{WorkflowID: "workflow-id", RunID: "run-id"}, nil)
+			},
+		},
+		{
+			name:    "get replication status",
+			command: []string{"admin", "cluster", "replication-status", "--cluster", "standby", "--show_detail", "--force"},
+			mock: func() {
+				handler.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{Clusters: []string{"standby"}, ForceFetch: true}).
+					Return(&types.GetReplicationStatusResponse{
+						Clusters: []*types.ReplicationClusterStatus{{RemoteCluster: "standby", ShardCount: 1}},
+						Shards:   []*types.ReplicationShardStatus{{ShardID: 1, RemoteCluster: "standby", LastReplicatedTimestamp: common.Int64Ptr(1)}},
+					}, nil)
+			},
+		},
+		{
+			name:    "rename domain",
+			command: []string{"admin", "domain", "rename", "--domain", "test-domain", "--new_name", "new-domain", "--st", "token"},
+			mock: func() {
+				handler.EXPECT().RenameDomain(gomock.Any(), &types.RenameDomainRequest{Name: "test-domain", NewName: "new-domain", SecurityToken: "token"}).
+					Return(&types.RenameDomainResponse{DomainID: "domain-id", PreviousNameExpiryTimestamp: 1}, nil)
+			},
+		},
+		{
+			name:    "compare version histories with remote cluster",
+			command: []string{"--do", "test-domain", "admin", "wf", "vh", "-w", "wid", "--compare_cluster", "standby"},
+			mock: func() {
+				execution := &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+				versionHistories := &types.VersionHistories{
+					Histories: []*types.VersionHistory{{BranchToken: []byte{1}, Items: []*types.VersionHistoryItem{{EventID: 5, Version: 1}}}},
+				}
+				handler.EXPECT().DescribeWorkflowVersionHistories(gomock.Any(), &types.DescribeWorkflowVersionHistoriesRequest{
+					Domain:    "test-domain",
+					Execution: &types.WorkflowExecution{WorkflowID: "wid"},
+				}).Return(&types.DescribeWorkflowVersionHistoriesResponse{Cluster: "active", Execution: execution, VersionHistories: versionHistories}, nil)
+				handler.EXPECT().DescribeWorkflowVersionHistories(gomock.Any(), &types.DescribeWorkflowVersionHistoriesRequest{
+					Domain:    "test-domain",
+					Execution: execution,
+					Cluster:   "standby",
+				}).Return(&types.DescribeWorkflowVersionHistoriesResponse{Cluster: "standby", Execution: execution, VersionHistories: versionHistories}, nil)
+			},
+		},
+		{
+			name:    "rebuild workflow branch in remote cluster",
+			command: []string{"--do", "test-domain", "admin", "wf", "rb", "-w", "wid", "-r", "rid", "--branch_token", "0a0b", "--cluster", "standby"},
+			mock: func() {
+				handler.EXPECT().RebuildWorkflowBranch(gomock.Any(), &types.RebuildWorkflowBranchRequest{
+					Domain:      "test-domain",
+					Execution:   &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+					BranchToken: []byte{10, 11},
+					Cluster:     "standby",
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "describe task list",
+			command: []string{"--domain", "test-domain", "admin", "tasklist", "describe", "--tasklist", "test-tasklist"},
+			mock: func() {
+				handler.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeTaskListResponse{
+						Pollers:          []*types.PollerInfo{{Identity: "poller"}},
+						TaskListStatus:   &types.TaskListStatus{SyncMatchRate: 0.5, DispatchLatencyMs: 20},
+						ScalingDecisions: []*types.TaskListScalingDecision{{Reason: "backlog", NumWritePartitions: 2, NumReadPartitions: 2}},
+					}, nil)
+			},
+		},
+	}
+
+	for _, transport := range []struct {
+		name    string
+		address string
+	}{
+		{name: thriftTransport, address: tchannelAddress},
+		{name: grpcTransport, address: grpcAddress},
+	} {
+		for _, tt := range tests {
+			t.Run(transport.name+"/"+tt.name, func(t *testing.T) {
+				tt.mock()
+				app := NewCliApp(NewClientFactory(zap.NewNop()))
+				args := append([]string{"cadence", "--address", transport.address, "--transport", transport.name}, tt.command...)
+				assert.NoError(t, app.Run(args))
+			})
+		}
+	}
+}
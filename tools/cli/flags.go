@@ -85,6 +85,8 @@ const (
 	FlagHostFile                       = "host_file"
 	FlagCluster                        = "cluster"
 	FlagInputCluster                   = "input_cluster"
+	FlagCompareCluster                 = "compare_cluster"
+	FlagBranchToken                    = "branch_token"
 	FlagStartOffset                    = "start_offset"
 	FlagTopic                          = "topic"
 	FlagGroup                          = "group"
@@ -118,6 +120,7 @@ const (
 	FlagVisibilityArchivalStatus       = "visibility_archival_status"
 	FlagVisibilityArchivalURI          = "visibility_uri"
 	FlagName                           = "name"
+	FlagNewName                        = "new_name"
 	FlagOutputFilename                 = "output_filename"
 	FlagOutputFormat                   = "output"
 	FlagQueryType                      = "query_type"
@@ -128,6 +131,7 @@ const (
 	FlagClusters                       = "clusters"
 	FlagIsGlobalDomain                 = "global_domain"
 	FlagDomainData                     = "domain_data"
+	FlagReplicationFilters             = "replication_filters"
 	FlagEventID                        = "event_id"
 	FlagActivityID                     = "activity_id"
 	FlagMaxFieldLength                 = "max_field_length"
@@ -186,6 +190,11 @@ const (
 	FlagFailoverType                   = "failover_type"
 	FlagFailoverTimeout                = "failover_timeout_seconds"
 	FlagActivityHeartBeatTimeout       = "heart_beat_timeout_seconds"
+	FlagScheduleToStartTimeout         = "schedule_to_start_timeout"
+	FlagScheduleToCloseTimeout         = "schedule_to_close_timeout"
+	FlagStartToCloseTimeout            = "start_to_close_timeout"
+	FlagHeartbeatTimeout               = "heartbeat_timeout"
+	FlagResetAttempts                  = "reset_attempts"
 	FlagFailoverWaitTime               = "failover_wait_time_second"
 	FlagFailoverBatchSize              = "failover_batch_size"
 	FlagFailoverDomains                = "domains"
@@ -222,6 +231,9 @@ const (
 	FlagSearchAttribute                = "search_attr"
 	FlagNumReadPartitions              = "num_read_partitions"
 	FlagNumWritePartitions             = "num_write_partitions"
+	FlagActor                          = "actor"
+	FlagAPIName                        = "api_name"
+	FlagMessageID                      = "message_id"
 
 	FlagClustersUsage = "Clusters (example: --clusters clusterA,clusterB or --cl clusterA --cl clusterB)"
 )
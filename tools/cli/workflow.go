@@ -416,6 +416,79 @@ func newActivityCommands() []*cli.Command {
 			},
 			Action: FailActivity,
 		},
+		{
+			Name:  "update",
+			Usage: "update options of a pending activity and schedule its next attempt immediately",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"wid", "w"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"rid", "r"},
+					Usage:   "RunID",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: []string{"aid"},
+					Usage:   "The activityID to operate on",
+				},
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "Optional new TaskList of the activity",
+				},
+				&cli.IntFlag{
+					Name:  FlagScheduleToStartTimeout,
+					Usage: "Optional new schedule to start timeout in seconds",
+				},
+				&cli.IntFlag{
+					Name:  FlagScheduleToCloseTimeout,
+					Usage: "Optional new schedule to close timeout in seconds",
+				},
+				&cli.IntFlag{
+					Name:  FlagStartToCloseTimeout,
+					Usage: "Optional new start to close timeout in seconds",
+				},
+				&cli.IntFlag{
+					Name:  FlagHeartbeatTimeout,
+					Usage: "Optional new heartbeat timeout in seconds",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryExpiration,
+					Usage: "Optional retry expiration in seconds. If set activity will be retried for the specified period of time.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryAttempts,
+					Usage: "Optional retry attempts. If set activity will be retried the specified amount of times.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryInterval,
+					Value: 10,
+					Usage: "Optional retry interval in seconds.",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRetryBackoff,
+					Value: 1.0,
+					Usage: "Optional retry backoff coeficient. Must be or equal or greater than 1.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryMaxInterval,
+					Usage: "Optional retry maximum interval in seconds. If set will give an upper bound for retry interval. Must be equal or greater than retry interval.",
+				},
+				&cli.BoolFlag{
+					Name:  FlagResetAttempts,
+					Usage: "Restart the attempt counter and the retry backoff of the activity, the retry policy limits of a started activity are counted from its current attempt",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: UpdateActivityOptions,
+		},
 	}
 }
 
@@ -505,6 +578,41 @@ func newBatchCommands() []*cli.Command {
 					Aliases: []string{"tc"},
 					Usage:   "Required for batch replicate",
 				},
+				&cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, where to reset. Support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				&cli.IntFlag{
+					Name:  FlagDecisionOffset,
+					Usage: "Optional for batch reset, this offset will move the reset point by decision. Only negative number is supported, and only works with LastDecisionCompleted and LastDecisionScheduled.",
+				},
+				&cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Binary checksum for batch reset with resetType of BadBinary",
+				},
+				&cli.StringFlag{
+					Name:    FlagEarliestTime,
+					Aliases: []string{"et"},
+					Usage: "EarliestTime of decision start time, required for batch reset with resetType of DecisionCompletedTime. " +
+						"Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and time range (N<duration>), e.g. '15m'",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipSignalReapply,
+					Usage: "Optional for batch reset, whether or not skipping signals reapply after the reset point",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipCurrentOpen,
+					Usage: "Optional for batch reset, skip the workflow if the current run is open",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipCurrentCompleted,
+					Usage: "Optional for batch reset, skip the workflow if the current run is completed",
+				},
+				&cli.BoolFlag{
+					Name:    FlagSkipErrorMode,
+					Aliases: []string{"serr"},
+					Usage:   "Optional for batch delete, skip errors when deleting history",
+				},
 				&cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
@@ -227,6 +227,22 @@ func StartBatchJob(c *cli.Context) error {
 			return commoncli.Problem("Required flag not found: ", err)
 		}
 	}
+	var resetParams batcher.ResetParams
+	if batchType == batcher.BatchTypeReset {
+		resetParams.ResetType, err = getRequiredOption(c, FlagResetType)
+		if err != nil {
+			return commoncli.Problem("Required flag not found: ", err)
+		}
+		resetParams.EarliestTime, err = parseTime(c.String(FlagEarliestTime), 0)
+		if err != nil {
+			return commoncli.Problem("Invalid earliest time: ", err)
+		}
+		resetParams.DecisionOffset = c.Int(FlagDecisionOffset)
+		resetParams.BadBinaryChecksum = c.String(FlagResetBadBinaryChecksum)
+		resetParams.SkipSignalReapply = c.Bool(FlagSkipSignalReapply)
+		resetParams.SkipCurrentOpen = c.Bool(FlagSkipCurrentOpen)
+		resetParams.SkipCurrentCompleted = c.Bool(FlagSkipCurrentCompleted)
+	}
 	rps := c.Int(FlagRPS)
 	pageSize := c.Int(FlagPageSize)
 	concurrency := c.Int(FlagConcurrency)
@@ -291,6 +307,10 @@ func StartBatchJob(c *cli.Context) error {
 			SourceCluster: sourceCluster,
 			TargetCluster: targetCluster,
 		},
+		ResetParams: resetParams,
+		DeleteParams: batcher.DeleteParams{
+			SkipErrors: c.Bool(FlagSkipErrorMode),
+		},
 		RPS:                      rps,
 		Concurrency:              concurrency,
 		PageSize:                 pageSize,
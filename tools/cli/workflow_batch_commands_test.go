@@ -130,6 +130,48 @@ func TestStartBatchJob(t *testing.T) {
 			},
 			expectedError: "batchType is not valid, supported:terminate,cancel,signal,replicate",
 		},
+		{
+			name: "Valid Start Batch Reset Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
+					Count: 100,
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, req *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						var params batcher.BatchParams
+						assert.NoError(t, json.Unmarshal(req.Input, &params))
+						assert.Equal(t, batcher.ResetParams{
+							ResetType:         batcher.ResetTypeBadBinary,
+							BadBinaryChecksum: "bad-checksum",
+							SkipSignalReapply: true,
+						}, params.ResetParams)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id-example"}, nil
+					})
+			},
+			flags: map[string]interface{}{
+				FlagDomain:                 "test-domain",
+				FlagListQuery:              "workflowType='batch'",
+				FlagReason:                 "Testing batch job",
+				FlagBatchType:              batcher.BatchTypeReset,
+				FlagResetType:              batcher.ResetTypeBadBinary,
+				FlagResetBadBinaryChecksum: "bad-checksum",
+				FlagSkipSignalReapply:      true,
+				FlagYes:                    true,
+			},
+			expectedError:  "",
+			expectedOutput: "batch job is started",
+		},
+		{
+			name:  "Missing Reset Type",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch job",
+				FlagBatchType: batcher.BatchTypeReset,
+			},
+			expectedError: "Required flag not found: : option reset_type is required",
+		},
 		{
 			name: "Count Workflow Executions Failure",
 			setup: func(mockClient *frontend.MockClient) {
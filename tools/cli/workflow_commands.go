@@ -2574,6 +2574,82 @@ func FailActivity(c *cli.Context) error {
 	return nil
 }
 
+// UpdateActivityOptions updates options of a pending activity
+func UpdateActivityOptions(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	activityID, err := getRequiredOption(c, FlagActivityID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	request := &types.UpdateActivityOptionsRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      c.String(FlagRunID),
+		},
+		ActivityID:    activityID,
+		ResetAttempts: c.Bool(FlagResetAttempts),
+		Identity:      getCliIdentity(),
+	}
+	if c.IsSet(FlagIdentity) {
+		request.Identity = c.String(FlagIdentity)
+	}
+	if c.IsSet(FlagTaskList) {
+		request.TaskList = &types.TaskList{Name: c.String(FlagTaskList)}
+	}
+	if c.IsSet(FlagScheduleToStartTimeout) {
+		request.ScheduleToStartTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagScheduleToStartTimeout)))
+	}
+	if c.IsSet(FlagScheduleToCloseTimeout) {
+		request.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagScheduleToCloseTimeout)))
+	}
+	if c.IsSet(FlagStartToCloseTimeout) {
+		request.StartToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagStartToCloseTimeout)))
+	}
+	if c.IsSet(FlagHeartbeatTimeout) {
+		request.HeartbeatTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagHeartbeatTimeout)))
+	}
+	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) {
+		request.RetryPolicy = &types.RetryPolicy{
+			InitialIntervalInSeconds: int32(c.Int(FlagRetryInterval)),
+			BackoffCoefficient:       c.Float64(FlagRetryBackoff),
+		}
+		if c.IsSet(FlagRetryAttempts) {
+			request.RetryPolicy.MaximumAttempts = int32(c.Int(FlagRetryAttempts))
+		}
+		if c.IsSet(FlagRetryExpiration) {
+			request.RetryPolicy.ExpirationIntervalInSeconds = int32(c.Int(FlagRetryExpiration))
+		}
+		if c.IsSet(FlagRetryMaxInterval) {
+			request.RetryPolicy.MaximumIntervalInSeconds = int32(c.Int(FlagRetryMaxInterval))
+		}
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	resp, err := adminClient.UpdateActivityOptions(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Updating activity options failed", err)
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), resp.Activity)
+	return nil
+}
+
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
@@ -752,6 +752,53 @@ func (s *cliAppSuite) TestFailActivity() {
 	}
 }
 
+func (s *cliAppSuite) TestUpdateActivityOptions() {
+	testCases := []testcase{
+		{
+			name:    "happy",
+			command: `cadence --do test-domain wf activity update -w wid -r rid -aid 3 --start_to_close_timeout 30 --retry_attempts 5 --reset_attempts --identity tester`,
+			err:     "",
+			mock: func() {
+				s.serverAdminClient.EXPECT().UpdateActivityOptions(gomock.Any(), &types.UpdateActivityOptionsRequest{
+					Domain: "test-domain",
+					Execution: &types.WorkflowExecution{
+						WorkflowID: "wid",
+						RunID:      "rid",
+					},
+					ActivityID:                 "3",
+					StartToCloseTimeoutSeconds: common.Int32Ptr(30),
+					RetryPolicy: &types.RetryPolicy{
+						InitialIntervalInSeconds: 10,
+						BackoffCoefficient:       1.0,
+						MaximumAttempts:          5,
+					},
+					ResetAttempts: true,
+					Identity:      "tester",
+				}).Return(&types.UpdateActivityOptionsResponse{Activity: &types.PendingActivityInfo{ActivityID: "3"}}, nil)
+			},
+		},
+		{
+			name:    "missing activity id",
+			command: `cadence --do test-domain wf activity update -w wid -r rid`,
+			err:     "Required flag not found",
+		},
+		{
+			name:    "update failed",
+			command: `cadence --do test-domain wf activity update -w wid -aid 3 --reset_attempts`,
+			err:     "Updating activity options failed",
+			mock: func() {
+				s.serverAdminClient.EXPECT().UpdateActivityOptions(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "activity task not found"})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
 func (s *cliAppSuite) TestListAllWorkflow() {
 	testCases := []testcase{
 		{